@@ -0,0 +1,65 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AppArmorIncludeDirectory is the directory relative to the AppArmor policy
+// directory of the nodes, in which the AppArmorIncludes get written.
+const AppArmorIncludeDirectory = "spo"
+
+// AppArmorIncludeSpec defines the desired state of AppArmorInclude.
+type AppArmorIncludeSpec struct {
+	// Content is the policy fragment, for example a set of rules or
+	// variable definitions, which gets included by the AppArmor profiles.
+	// +kubebuilder:validation:MinLength=1
+	Content string `json:"content"`
+}
+
+// +kubebuilder:object:root=true
+
+// AppArmorInclude is a cluster scoped AppArmor policy fragment, which gets
+// written to every node with AppArmor enabled. AppArmor profiles include it
+// with "#include <spo/NAME>" and get reloaded when it changes.
+// +kubebuilder:resource:scope=Cluster,shortName=aai
+type AppArmorInclude struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec AppArmorIncludeSpec `json:"spec,omitempty"`
+}
+
+// IncludePath returns the path which AppArmor profiles use to include the
+// fragment, relative to the AppArmor policy directory.
+func (ai *AppArmorInclude) IncludePath() string {
+	return AppArmorIncludeDirectory + "/" + ai.GetName()
+}
+
+// +kubebuilder:object:root=true
+
+// AppArmorIncludeList contains a list of AppArmorInclude.
+type AppArmorIncludeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AppArmorInclude `json:"items"`
+}
+
+func init() { //nolint:gochecknoinits // required to init the scheme
+	SchemeBuilder.Register(&AppArmorInclude{}, &AppArmorIncludeList{})
+}
@@ -29,6 +29,30 @@ var (
 	// Ensure AppArmorProfile implements the StatusBaseUser and SecurityProfileBase interfaces.
 	_ profilebasev1alpha1.StatusBaseUser      = &AppArmorProfile{}
 	_ profilebasev1alpha1.SecurityProfileBase = &AppArmorProfile{}
+	_ AppArmorProfileObject                   = &AppArmorProfile{}
+)
+
+// AppArmorProfileObject is the common interface of the namespaced and
+// cluster scoped AppArmor profiles.
+// +k8s:deepcopy-gen=false
+type AppArmorProfileObject interface {
+	profilebasev1alpha1.StatusBaseUser
+	profilebasev1alpha1.SecurityProfileBase
+
+	GetSpec() *AppArmorProfileSpec
+	GetStatus() *AppArmorProfileStatus
+	GetProfileName() string
+}
+
+// AppArmorProfileMode is the mode in which an AppArmor profile is loaded.
+type AppArmorProfileMode string
+
+const (
+	// AppArmorProfileModeEnforce denies and logs the violations of the
+	// profile.
+	AppArmorProfileModeEnforce AppArmorProfileMode = "enforce"
+	// AppArmorProfileModeComplain only logs the violations of the profile.
+	AppArmorProfileModeComplain AppArmorProfileMode = "complain"
 )
 
 // AppArmorProfileSpec defines the desired state of AppArmorProfile.
@@ -37,11 +61,19 @@ type AppArmorProfileSpec struct {
 	profilebasev1alpha1.SpecBase `json:",inline"`
 
 	Policy string `json:"policy,omitempty"`
+
+	// Mode in which the profiles of the policy get loaded. It replaces the
+	// mode flags of the profiles in the policy, which are used as written
+	// if the mode is not set.
+	// +optional
+	// +kubebuilder:validation:Enum=enforce;complain
+	Mode AppArmorProfileMode `json:"mode,omitempty"`
 }
 
 // AppArmorProfileStatus defines the observed state of AppArmorProfile.
 type AppArmorProfileStatus struct {
-	profilebasev1alpha1.StatusBase `json:",inline"`
+	profilebasev1alpha1.StatusBase      `json:",inline"`
+	profilebasev1alpha1.WorkloadsStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -50,6 +82,8 @@ type AppArmorProfileStatus struct {
 // +kubebuilder:resource:shortName=aa
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=`.status.status`
+// +kubebuilder:printcolumn:name="Mode",type="string",JSONPath=`.spec.mode`
+// +kubebuilder:printcolumn:name="Workloads",type="integer",priority=10,JSONPath=`.status.workloadCount`
 type AppArmorProfile struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -85,6 +119,10 @@ func (sp *AppArmorProfile) IsDisabled() bool {
 	return profilebasev1alpha1.IsDisabled(&sp.Spec.SpecBase)
 }
 
+func (sp *AppArmorProfile) GetNodeSelector() *metav1.LabelSelector {
+	return sp.Spec.NodeSelector
+}
+
 func (sp *AppArmorProfile) IsReconcilable() bool {
 	return profilebasev1alpha1.IsReconcilable(sp)
 }
@@ -105,3 +143,15 @@ func init() { //nolint:gochecknoinits // required to init the scheme
 func (sp *AppArmorProfile) GetProfileName() string {
 	return sp.GetName()
 }
+
+func (sp *AppArmorProfile) GetSpec() *AppArmorProfileSpec {
+	return &sp.Spec
+}
+
+func (sp *AppArmorProfile) GetStatus() *AppArmorProfileStatus {
+	return &sp.Status
+}
+
+func (sp *AppArmorProfile) GetWorkloadsStatus() *profilebasev1alpha1.WorkloadsStatus {
+	return &sp.Status.WorkloadsStatus
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	profilebasev1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+)
+
+// Ensure ClusterAppArmorProfile implements the AppArmorProfileObject interface.
+var _ AppArmorProfileObject = &ClusterAppArmorProfile{}
+
+// ClusterAppArmorProfileKind is the kind of the ClusterAppArmorProfile.
+const ClusterAppArmorProfileKind = "ClusterAppArmorProfile"
+
+// +kubebuilder:object:root=true
+
+// ClusterAppArmorProfile is a cluster scoped AppArmor profile, which can be
+// used by workloads in all namespaces.
+// +kubebuilder:resource:scope=Cluster,shortName=caa
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=`.status.status`
+// +kubebuilder:printcolumn:name="Mode",type="string",JSONPath=`.spec.mode`
+type ClusterAppArmorProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AppArmorProfileSpec   `json:"spec,omitempty"`
+	Status AppArmorProfileStatus `json:"status,omitempty"`
+}
+
+func (sp *ClusterAppArmorProfile) GetStatusBase() *profilebasev1alpha1.StatusBase {
+	return &sp.Status.StatusBase
+}
+
+func (sp *ClusterAppArmorProfile) DeepCopyToStatusBaseIf() profilebasev1alpha1.StatusBaseUser {
+	return sp.DeepCopy()
+}
+
+func (sp *ClusterAppArmorProfile) SetImplementationStatus() {
+}
+
+func (sp *ClusterAppArmorProfile) ListProfilesByRecording(
+	ctx context.Context,
+	cli client.Client,
+	recording string,
+) ([]metav1.Object, error) {
+	return profilebasev1alpha1.ListProfilesByRecording(ctx, cli, recording, "", &ClusterAppArmorProfileList{})
+}
+
+func (sp *ClusterAppArmorProfile) IsPartial() bool {
+	return profilebasev1alpha1.IsPartial(sp)
+}
+
+func (sp *ClusterAppArmorProfile) IsDisabled() bool {
+	return profilebasev1alpha1.IsDisabled(&sp.Spec.SpecBase)
+}
+
+func (sp *ClusterAppArmorProfile) GetNodeSelector() *metav1.LabelSelector {
+	return sp.Spec.NodeSelector
+}
+
+func (sp *ClusterAppArmorProfile) IsReconcilable() bool {
+	return profilebasev1alpha1.IsReconcilable(sp)
+}
+
+func (sp *ClusterAppArmorProfile) GetSpec() *AppArmorProfileSpec {
+	return &sp.Spec
+}
+
+func (sp *ClusterAppArmorProfile) GetStatus() *AppArmorProfileStatus {
+	return &sp.Status
+}
+
+// GetProfileName returns the name of the profile as loaded into the kernel.
+func (sp *ClusterAppArmorProfile) GetProfileName() string {
+	return sp.GetName()
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterAppArmorProfileList contains a list of ClusterAppArmorProfile.
+type ClusterAppArmorProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterAppArmorProfile `json:"items"`
+}
+
+func init() { //nolint:gochecknoinits // required to init the scheme
+	SchemeBuilder.Register(&ClusterAppArmorProfile{}, &ClusterAppArmorProfileList{})
+}
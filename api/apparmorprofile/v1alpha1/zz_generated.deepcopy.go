@@ -25,11 +25,84 @@ import (
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AppArmorProfile) DeepCopyInto(out *AppArmorProfile) {
+func (in *AppArmorInclude) DeepCopyInto(out *AppArmorInclude) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	out.Spec = in.Spec
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppArmorInclude.
+func (in *AppArmorInclude) DeepCopy() *AppArmorInclude {
+	if in == nil {
+		return nil
+	}
+	out := new(AppArmorInclude)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AppArmorInclude) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppArmorIncludeList) DeepCopyInto(out *AppArmorIncludeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AppArmorInclude, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppArmorIncludeList.
+func (in *AppArmorIncludeList) DeepCopy() *AppArmorIncludeList {
+	if in == nil {
+		return nil
+	}
+	out := new(AppArmorIncludeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AppArmorIncludeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppArmorIncludeSpec) DeepCopyInto(out *AppArmorIncludeSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppArmorIncludeSpec.
+func (in *AppArmorIncludeSpec) DeepCopy() *AppArmorIncludeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AppArmorIncludeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppArmorProfile) DeepCopyInto(out *AppArmorProfile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -86,7 +159,7 @@ func (in *AppArmorProfileList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AppArmorProfileSpec) DeepCopyInto(out *AppArmorProfileSpec) {
 	*out = *in
-	out.SpecBase = in.SpecBase
+	in.SpecBase.DeepCopyInto(&out.SpecBase)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppArmorProfileSpec.
@@ -103,6 +176,7 @@ func (in *AppArmorProfileSpec) DeepCopy() *AppArmorProfileSpec {
 func (in *AppArmorProfileStatus) DeepCopyInto(out *AppArmorProfileStatus) {
 	*out = *in
 	in.StatusBase.DeepCopyInto(&out.StatusBase)
+	in.WorkloadsStatus.DeepCopyInto(&out.WorkloadsStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppArmorProfileStatus.
@@ -114,3 +188,62 @@ func (in *AppArmorProfileStatus) DeepCopy() *AppArmorProfileStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAppArmorProfile) DeepCopyInto(out *ClusterAppArmorProfile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAppArmorProfile.
+func (in *ClusterAppArmorProfile) DeepCopy() *ClusterAppArmorProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAppArmorProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterAppArmorProfile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAppArmorProfileList) DeepCopyInto(out *ClusterAppArmorProfileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterAppArmorProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAppArmorProfileList.
+func (in *ClusterAppArmorProfileList) DeepCopy() *ClusterAppArmorProfileList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAppArmorProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterAppArmorProfileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
@@ -34,18 +34,20 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	BpfRecorder_Start_FullMethodName              = "/api_bpfrecorder.BpfRecorder/Start"
-	BpfRecorder_Stop_FullMethodName               = "/api_bpfrecorder.BpfRecorder/Stop"
-	BpfRecorder_SyscallsForProfile_FullMethodName = "/api_bpfrecorder.BpfRecorder/SyscallsForProfile"
+	BpfRecorder_Start_FullMethodName                       = "/api_bpfrecorder.BpfRecorder/Start"
+	BpfRecorder_Stop_FullMethodName                        = "/api_bpfrecorder.BpfRecorder/Stop"
+	BpfRecorder_SyscallsForProfile_FullMethodName          = "/api_bpfrecorder.BpfRecorder/SyscallsForProfile"
+	BpfRecorder_SyscallsForLocalhostProfile_FullMethodName = "/api_bpfrecorder.BpfRecorder/SyscallsForLocalhostProfile"
 )
 
 // BpfRecorderClient is the client API for BpfRecorder service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type BpfRecorderClient interface {
-	Start(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
-	Stop(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	Start(ctx context.Context, in *StartRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	Stop(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 	SyscallsForProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*SyscallsResponse, error)
+	SyscallsForLocalhostProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*SyscallsResponse, error)
 }
 
 type bpfRecorderClient struct {
@@ -56,7 +58,7 @@ func NewBpfRecorderClient(cc grpc.ClientConnInterface) BpfRecorderClient {
 	return &bpfRecorderClient{cc}
 }
 
-func (c *bpfRecorderClient) Start(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+func (c *bpfRecorderClient) Start(ctx context.Context, in *StartRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
 	out := new(EmptyResponse)
 	err := c.cc.Invoke(ctx, BpfRecorder_Start_FullMethodName, in, out, opts...)
 	if err != nil {
@@ -65,7 +67,7 @@ func (c *bpfRecorderClient) Start(ctx context.Context, in *EmptyRequest, opts ..
 	return out, nil
 }
 
-func (c *bpfRecorderClient) Stop(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+func (c *bpfRecorderClient) Stop(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
 	out := new(EmptyResponse)
 	err := c.cc.Invoke(ctx, BpfRecorder_Stop_FullMethodName, in, out, opts...)
 	if err != nil {
@@ -83,13 +85,23 @@ func (c *bpfRecorderClient) SyscallsForProfile(ctx context.Context, in *ProfileR
 	return out, nil
 }
 
+func (c *bpfRecorderClient) SyscallsForLocalhostProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*SyscallsResponse, error) {
+	out := new(SyscallsResponse)
+	err := c.cc.Invoke(ctx, BpfRecorder_SyscallsForLocalhostProfile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BpfRecorderServer is the server API for BpfRecorder service.
 // All implementations must embed UnimplementedBpfRecorderServer
 // for forward compatibility
 type BpfRecorderServer interface {
-	Start(context.Context, *EmptyRequest) (*EmptyResponse, error)
-	Stop(context.Context, *EmptyRequest) (*EmptyResponse, error)
+	Start(context.Context, *StartRequest) (*EmptyResponse, error)
+	Stop(context.Context, *StopRequest) (*EmptyResponse, error)
 	SyscallsForProfile(context.Context, *ProfileRequest) (*SyscallsResponse, error)
+	SyscallsForLocalhostProfile(context.Context, *ProfileRequest) (*SyscallsResponse, error)
 	mustEmbedUnimplementedBpfRecorderServer()
 }
 
@@ -97,15 +109,18 @@ type BpfRecorderServer interface {
 type UnimplementedBpfRecorderServer struct {
 }
 
-func (UnimplementedBpfRecorderServer) Start(context.Context, *EmptyRequest) (*EmptyResponse, error) {
+func (UnimplementedBpfRecorderServer) Start(context.Context, *StartRequest) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Start not implemented")
 }
-func (UnimplementedBpfRecorderServer) Stop(context.Context, *EmptyRequest) (*EmptyResponse, error) {
+func (UnimplementedBpfRecorderServer) Stop(context.Context, *StopRequest) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Stop not implemented")
 }
 func (UnimplementedBpfRecorderServer) SyscallsForProfile(context.Context, *ProfileRequest) (*SyscallsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyscallsForProfile not implemented")
 }
+func (UnimplementedBpfRecorderServer) SyscallsForLocalhostProfile(context.Context, *ProfileRequest) (*SyscallsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyscallsForLocalhostProfile not implemented")
+}
 func (UnimplementedBpfRecorderServer) mustEmbedUnimplementedBpfRecorderServer() {}
 
 // UnsafeBpfRecorderServer may be embedded to opt out of forward compatibility for this service.
@@ -120,7 +135,7 @@ func RegisterBpfRecorderServer(s grpc.ServiceRegistrar, srv BpfRecorderServer) {
 }
 
 func _BpfRecorder_Start_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+	in := new(StartRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -132,13 +147,13 @@ func _BpfRecorder_Start_Handler(srv interface{}, ctx context.Context, dec func(i
 		FullMethod: BpfRecorder_Start_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BpfRecorderServer).Start(ctx, req.(*EmptyRequest))
+		return srv.(BpfRecorderServer).Start(ctx, req.(*StartRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _BpfRecorder_Stop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+	in := new(StopRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -150,7 +165,7 @@ func _BpfRecorder_Stop_Handler(srv interface{}, ctx context.Context, dec func(in
 		FullMethod: BpfRecorder_Stop_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BpfRecorderServer).Stop(ctx, req.(*EmptyRequest))
+		return srv.(BpfRecorderServer).Stop(ctx, req.(*StopRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -173,6 +188,24 @@ func _BpfRecorder_SyscallsForProfile_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BpfRecorder_SyscallsForLocalhostProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BpfRecorderServer).SyscallsForLocalhostProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BpfRecorder_SyscallsForLocalhostProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BpfRecorderServer).SyscallsForLocalhostProfile(ctx, req.(*ProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BpfRecorder_ServiceDesc is the grpc.ServiceDesc for BpfRecorder service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -192,6 +225,10 @@ var BpfRecorder_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SyscallsForProfile",
 			Handler:    _BpfRecorder_SyscallsForProfile_Handler,
 		},
+		{
+			MethodName: "SyscallsForLocalhostProfile",
+			Handler:    _BpfRecorder_SyscallsForLocalhostProfile_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/grpc/bpfrecorder/api.proto",
@@ -231,6 +231,223 @@ func (x *AvcResponse) GetAvc() []*AvcResponse_SelinuxAvc {
 	return nil
 }
 
+type ViolationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind      string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ViolationsRequest) Reset() {
+	*x = ViolationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_enricher_api_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ViolationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ViolationsRequest) ProtoMessage() {}
+
+func (x *ViolationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_enricher_api_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ViolationsRequest.ProtoReflect.Descriptor instead.
+func (*ViolationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_grpc_enricher_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ViolationsRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ViolationsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ViolationsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// UsageRequest queries the operations observed for an installed profile
+// since the provided unix timestamp, independently of resets.
+type UsageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind      string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Since     int64  `protobuf:"varint,4,opt,name=since,proto3" json:"since,omitempty"`
+}
+
+func (x *UsageRequest) Reset() {
+	*x = UsageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_enricher_api_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageRequest) ProtoMessage() {}
+
+func (x *UsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_enricher_api_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageRequest.ProtoReflect.Descriptor instead.
+func (*UsageRequest) Descriptor() ([]byte, []int) {
+	return file_api_grpc_enricher_api_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UsageRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *UsageRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *UsageRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UsageRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+type ViolationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Syscalls []string                             `protobuf:"bytes,1,rep,name=syscalls,proto3" json:"syscalls,omitempty"`
+	GoArch   string                               `protobuf:"bytes,2,opt,name=go_arch,json=goArch,proto3" json:"go_arch,omitempty"`
+	Avc      []*AvcResponse_SelinuxAvc            `protobuf:"bytes,3,rep,name=avc,proto3" json:"avc,omitempty"`
+	Apparmor []*ViolationsResponse_ApparmorDenial `protobuf:"bytes,4,rep,name=apparmor,proto3" json:"apparmor,omitempty"`
+	// Unix timestamp of the most recent violation, which is kept when the
+	// violations get reset.
+	LastViolation int64 `protobuf:"varint,5,opt,name=last_violation,json=lastViolation,proto3" json:"last_violation,omitempty"`
+}
+
+func (x *ViolationsResponse) Reset() {
+	*x = ViolationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_enricher_api_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ViolationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ViolationsResponse) ProtoMessage() {}
+
+func (x *ViolationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_enricher_api_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ViolationsResponse.ProtoReflect.Descriptor instead.
+func (*ViolationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_grpc_enricher_api_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ViolationsResponse) GetSyscalls() []string {
+	if x != nil {
+		return x.Syscalls
+	}
+	return nil
+}
+
+func (x *ViolationsResponse) GetGoArch() string {
+	if x != nil {
+		return x.GoArch
+	}
+	return ""
+}
+
+func (x *ViolationsResponse) GetAvc() []*AvcResponse_SelinuxAvc {
+	if x != nil {
+		return x.Avc
+	}
+	return nil
+}
+
+func (x *ViolationsResponse) GetApparmor() []*ViolationsResponse_ApparmorDenial {
+	if x != nil {
+		return x.Apparmor
+	}
+	return nil
+}
+
+func (x *ViolationsResponse) GetLastViolation() int64 {
+	if x != nil {
+		return x.LastViolation
+	}
+	return 0
+}
+
 type EmptyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -240,7 +457,7 @@ type EmptyResponse struct {
 func (x *EmptyResponse) Reset() {
 	*x = EmptyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_grpc_enricher_api_proto_msgTypes[4]
+		mi := &file_api_grpc_enricher_api_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -253,7 +470,7 @@ func (x *EmptyResponse) String() string {
 func (*EmptyResponse) ProtoMessage() {}
 
 func (x *EmptyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_enricher_api_proto_msgTypes[4]
+	mi := &file_api_grpc_enricher_api_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -266,7 +483,7 @@ func (x *EmptyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmptyResponse.ProtoReflect.Descriptor instead.
 func (*EmptyResponse) Descriptor() ([]byte, []int) {
-	return file_api_grpc_enricher_api_proto_rawDescGZIP(), []int{4}
+	return file_api_grpc_enricher_api_proto_rawDescGZIP(), []int{7}
 }
 
 type AvcResponse_SelinuxAvc struct {
@@ -283,7 +500,7 @@ type AvcResponse_SelinuxAvc struct {
 func (x *AvcResponse_SelinuxAvc) Reset() {
 	*x = AvcResponse_SelinuxAvc{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_grpc_enricher_api_proto_msgTypes[5]
+		mi := &file_api_grpc_enricher_api_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -296,7 +513,7 @@ func (x *AvcResponse_SelinuxAvc) String() string {
 func (*AvcResponse_SelinuxAvc) ProtoMessage() {}
 
 func (x *AvcResponse_SelinuxAvc) ProtoReflect() protoreflect.Message {
-	mi := &file_api_grpc_enricher_api_proto_msgTypes[5]
+	mi := &file_api_grpc_enricher_api_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -340,6 +557,79 @@ func (x *AvcResponse_SelinuxAvc) GetTclass() string {
 	return ""
 }
 
+type ViolationsResponse_ApparmorDenial struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operation  string `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DeniedMask string `protobuf:"bytes,3,opt,name=denied_mask,json=deniedMask,proto3" json:"denied_mask,omitempty"`
+	// Whether the operation got allowed because the profile is loaded in
+	// complain mode.
+	Complain bool `protobuf:"varint,4,opt,name=complain,proto3" json:"complain,omitempty"`
+}
+
+func (x *ViolationsResponse_ApparmorDenial) Reset() {
+	*x = ViolationsResponse_ApparmorDenial{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_enricher_api_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ViolationsResponse_ApparmorDenial) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ViolationsResponse_ApparmorDenial) ProtoMessage() {}
+
+func (x *ViolationsResponse_ApparmorDenial) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_enricher_api_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ViolationsResponse_ApparmorDenial.ProtoReflect.Descriptor instead.
+func (*ViolationsResponse_ApparmorDenial) Descriptor() ([]byte, []int) {
+	return file_api_grpc_enricher_api_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *ViolationsResponse_ApparmorDenial) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *ViolationsResponse_ApparmorDenial) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ViolationsResponse_ApparmorDenial) GetDeniedMask() string {
+	if x != nil {
+		return x.DeniedMask
+	}
+	return ""
+}
+
+func (x *ViolationsResponse_ApparmorDenial) GetComplain() bool {
+	if x != nil {
+		return x.Complain
+	}
+	return false
+}
+
 var File_api_grpc_enricher_api_proto protoreflect.FileDescriptor
 
 var file_api_grpc_enricher_api_proto_rawDesc = []byte{
@@ -367,8 +657,44 @@ var file_api_grpc_enricher_api_proto_rawDesc = []byte{
 	0x1a, 0x0a, 0x08, 0x74, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x08, 0x74, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74,
 	0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x63, 0x6c,
-	0x61, 0x73, 0x73, 0x22, 0x0f, 0x0a, 0x0d, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x32, 0xab, 0x02, 0x0a, 0x08, 0x45, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65,
+	0x61, 0x73, 0x73, 0x22, 0x59, 0x0a, 0x11, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x6a,
+	0x0a, 0x0c, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x22, 0xf6, 0x02, 0x0a, 0x12, 0x56,
+	0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x17, 0x0a,
+	0x07, 0x67, 0x6f, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x67, 0x6f, 0x41, 0x72, 0x63, 0x68, 0x12, 0x36, 0x0a, 0x03, 0x61, 0x76, 0x63, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68,
+	0x65, 0x72, 0x2e, 0x41, 0x76, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53,
+	0x65, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x41, 0x76, 0x63, 0x52, 0x03, 0x61, 0x76, 0x63, 0x12, 0x4b,
+	0x0a, 0x08, 0x61, 0x70, 0x70, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e,
+	0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x44, 0x65, 0x6e, 0x69, 0x61,
+	0x6c, 0x52, 0x08, 0x61, 0x70, 0x70, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x1a, 0x7f, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x44, 0x65,
+	0x6e, 0x69, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x6e, 0x69, 0x65, 0x64,
+	0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x6e,
+	0x69, 0x65, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x61, 0x69, 0x6e, 0x22, 0x0f, 0x0a, 0x0d, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x32, 0x9a, 0x04, 0x0a, 0x08, 0x45, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65,
 	0x72, 0x12, 0x4b, 0x0a, 0x08, 0x53, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x1d, 0x2e,
 	0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x79, 0x73,
 	0x63, 0x61, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61,
@@ -387,8 +713,23 @@ var file_api_grpc_enricher_api_proto_rawDesc = []byte{
 	0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x41, 0x76, 0x63, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68,
 	0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x42, 0x0f, 0x5a, 0x0d, 0x2f, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63,
-	0x68, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x22, 0x00, 0x12, 0x51, 0x0a, 0x0a, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e,
+	0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72,
+	0x2e, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x65, 0x74, 0x56, 0x69,
+	0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65,
+	0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x5f,
+	0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x05, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72,
+	0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x56, 0x69, 0x6f,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x42, 0x0f, 0x5a, 0x0d, 0x2f, 0x61, 0x70, 0x69, 0x5f, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68,
+	0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -403,30 +744,42 @@ func file_api_grpc_enricher_api_proto_rawDescGZIP() []byte {
 	return file_api_grpc_enricher_api_proto_rawDescData
 }
 
-var file_api_grpc_enricher_api_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_api_grpc_enricher_api_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_api_grpc_enricher_api_proto_goTypes = []interface{}{
-	(*SyscallsRequest)(nil),        // 0: api_enricher.SyscallsRequest
-	(*SyscallsResponse)(nil),       // 1: api_enricher.SyscallsResponse
-	(*AvcRequest)(nil),             // 2: api_enricher.AvcRequest
-	(*AvcResponse)(nil),            // 3: api_enricher.AvcResponse
-	(*EmptyResponse)(nil),          // 4: api_enricher.EmptyResponse
-	(*AvcResponse_SelinuxAvc)(nil), // 5: api_enricher.AvcResponse.SelinuxAvc
+	(*SyscallsRequest)(nil),                   // 0: api_enricher.SyscallsRequest
+	(*SyscallsResponse)(nil),                  // 1: api_enricher.SyscallsResponse
+	(*AvcRequest)(nil),                        // 2: api_enricher.AvcRequest
+	(*AvcResponse)(nil),                       // 3: api_enricher.AvcResponse
+	(*ViolationsRequest)(nil),                 // 4: api_enricher.ViolationsRequest
+	(*UsageRequest)(nil),                      // 5: api_enricher.UsageRequest
+	(*ViolationsResponse)(nil),                // 6: api_enricher.ViolationsResponse
+	(*EmptyResponse)(nil),                     // 7: api_enricher.EmptyResponse
+	(*AvcResponse_SelinuxAvc)(nil),            // 8: api_enricher.AvcResponse.SelinuxAvc
+	(*ViolationsResponse_ApparmorDenial)(nil), // 9: api_enricher.ViolationsResponse.ApparmorDenial
 }
 var file_api_grpc_enricher_api_proto_depIdxs = []int32{
-	5, // 0: api_enricher.AvcResponse.avc:type_name -> api_enricher.AvcResponse.SelinuxAvc
-	0, // 1: api_enricher.Enricher.Syscalls:input_type -> api_enricher.SyscallsRequest
-	0, // 2: api_enricher.Enricher.ResetSyscalls:input_type -> api_enricher.SyscallsRequest
-	2, // 3: api_enricher.Enricher.Avcs:input_type -> api_enricher.AvcRequest
-	2, // 4: api_enricher.Enricher.ResetAvcs:input_type -> api_enricher.AvcRequest
-	1, // 5: api_enricher.Enricher.Syscalls:output_type -> api_enricher.SyscallsResponse
-	4, // 6: api_enricher.Enricher.ResetSyscalls:output_type -> api_enricher.EmptyResponse
-	3, // 7: api_enricher.Enricher.Avcs:output_type -> api_enricher.AvcResponse
-	4, // 8: api_enricher.Enricher.ResetAvcs:output_type -> api_enricher.EmptyResponse
-	5, // [5:9] is the sub-list for method output_type
-	1, // [1:5] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	8,  // 0: api_enricher.AvcResponse.avc:type_name -> api_enricher.AvcResponse.SelinuxAvc
+	8,  // 1: api_enricher.ViolationsResponse.avc:type_name -> api_enricher.AvcResponse.SelinuxAvc
+	9,  // 2: api_enricher.ViolationsResponse.apparmor:type_name -> api_enricher.ViolationsResponse.ApparmorDenial
+	0,  // 3: api_enricher.Enricher.Syscalls:input_type -> api_enricher.SyscallsRequest
+	0,  // 4: api_enricher.Enricher.ResetSyscalls:input_type -> api_enricher.SyscallsRequest
+	2,  // 5: api_enricher.Enricher.Avcs:input_type -> api_enricher.AvcRequest
+	2,  // 6: api_enricher.Enricher.ResetAvcs:input_type -> api_enricher.AvcRequest
+	4,  // 7: api_enricher.Enricher.Violations:input_type -> api_enricher.ViolationsRequest
+	4,  // 8: api_enricher.Enricher.ResetViolations:input_type -> api_enricher.ViolationsRequest
+	5,  // 9: api_enricher.Enricher.Usage:input_type -> api_enricher.UsageRequest
+	1,  // 10: api_enricher.Enricher.Syscalls:output_type -> api_enricher.SyscallsResponse
+	7,  // 11: api_enricher.Enricher.ResetSyscalls:output_type -> api_enricher.EmptyResponse
+	3,  // 12: api_enricher.Enricher.Avcs:output_type -> api_enricher.AvcResponse
+	7,  // 13: api_enricher.Enricher.ResetAvcs:output_type -> api_enricher.EmptyResponse
+	6,  // 14: api_enricher.Enricher.Violations:output_type -> api_enricher.ViolationsResponse
+	7,  // 15: api_enricher.Enricher.ResetViolations:output_type -> api_enricher.EmptyResponse
+	6,  // 16: api_enricher.Enricher.Usage:output_type -> api_enricher.ViolationsResponse
+	10, // [10:17] is the sub-list for method output_type
+	3,  // [3:10] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_api_grpc_enricher_api_proto_init() }
@@ -484,7 +837,7 @@ func file_api_grpc_enricher_api_proto_init() {
 			}
 		}
 		file_api_grpc_enricher_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EmptyResponse); i {
+			switch v := v.(*ViolationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -496,6 +849,42 @@ func file_api_grpc_enricher_api_proto_init() {
 			}
 		}
 		file_api_grpc_enricher_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UsageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_enricher_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ViolationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_enricher_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmptyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_grpc_enricher_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AvcResponse_SelinuxAvc); i {
 			case 0:
 				return &v.state
@@ -507,6 +896,18 @@ func file_api_grpc_enricher_api_proto_init() {
 				return nil
 			}
 		}
+		file_api_grpc_enricher_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ViolationsResponse_ApparmorDenial); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -514,7 +915,7 @@ func file_api_grpc_enricher_api_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_grpc_enricher_api_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -34,10 +34,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Enricher_Syscalls_FullMethodName      = "/api_enricher.Enricher/Syscalls"
-	Enricher_ResetSyscalls_FullMethodName = "/api_enricher.Enricher/ResetSyscalls"
-	Enricher_Avcs_FullMethodName          = "/api_enricher.Enricher/Avcs"
-	Enricher_ResetAvcs_FullMethodName     = "/api_enricher.Enricher/ResetAvcs"
+	Enricher_Syscalls_FullMethodName        = "/api_enricher.Enricher/Syscalls"
+	Enricher_ResetSyscalls_FullMethodName   = "/api_enricher.Enricher/ResetSyscalls"
+	Enricher_Avcs_FullMethodName            = "/api_enricher.Enricher/Avcs"
+	Enricher_ResetAvcs_FullMethodName       = "/api_enricher.Enricher/ResetAvcs"
+	Enricher_Violations_FullMethodName      = "/api_enricher.Enricher/Violations"
+	Enricher_ResetViolations_FullMethodName = "/api_enricher.Enricher/ResetViolations"
+	Enricher_Usage_FullMethodName           = "/api_enricher.Enricher/Usage"
 )
 
 // EnricherClient is the client API for Enricher service.
@@ -48,6 +51,9 @@ type EnricherClient interface {
 	ResetSyscalls(ctx context.Context, in *SyscallsRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 	Avcs(ctx context.Context, in *AvcRequest, opts ...grpc.CallOption) (*AvcResponse, error)
 	ResetAvcs(ctx context.Context, in *AvcRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	Violations(ctx context.Context, in *ViolationsRequest, opts ...grpc.CallOption) (*ViolationsResponse, error)
+	ResetViolations(ctx context.Context, in *ViolationsRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	Usage(ctx context.Context, in *UsageRequest, opts ...grpc.CallOption) (*ViolationsResponse, error)
 }
 
 type enricherClient struct {
@@ -94,6 +100,33 @@ func (c *enricherClient) ResetAvcs(ctx context.Context, in *AvcRequest, opts ...
 	return out, nil
 }
 
+func (c *enricherClient) Violations(ctx context.Context, in *ViolationsRequest, opts ...grpc.CallOption) (*ViolationsResponse, error) {
+	out := new(ViolationsResponse)
+	err := c.cc.Invoke(ctx, Enricher_Violations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enricherClient) ResetViolations(ctx context.Context, in *ViolationsRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, Enricher_ResetViolations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enricherClient) Usage(ctx context.Context, in *UsageRequest, opts ...grpc.CallOption) (*ViolationsResponse, error) {
+	out := new(ViolationsResponse)
+	err := c.cc.Invoke(ctx, Enricher_Usage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EnricherServer is the server API for Enricher service.
 // All implementations must embed UnimplementedEnricherServer
 // for forward compatibility
@@ -102,6 +135,9 @@ type EnricherServer interface {
 	ResetSyscalls(context.Context, *SyscallsRequest) (*EmptyResponse, error)
 	Avcs(context.Context, *AvcRequest) (*AvcResponse, error)
 	ResetAvcs(context.Context, *AvcRequest) (*EmptyResponse, error)
+	Violations(context.Context, *ViolationsRequest) (*ViolationsResponse, error)
+	ResetViolations(context.Context, *ViolationsRequest) (*EmptyResponse, error)
+	Usage(context.Context, *UsageRequest) (*ViolationsResponse, error)
 	mustEmbedUnimplementedEnricherServer()
 }
 
@@ -121,6 +157,15 @@ func (UnimplementedEnricherServer) Avcs(context.Context, *AvcRequest) (*AvcRespo
 func (UnimplementedEnricherServer) ResetAvcs(context.Context, *AvcRequest) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetAvcs not implemented")
 }
+func (UnimplementedEnricherServer) Violations(context.Context, *ViolationsRequest) (*ViolationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Violations not implemented")
+}
+func (UnimplementedEnricherServer) ResetViolations(context.Context, *ViolationsRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetViolations not implemented")
+}
+func (UnimplementedEnricherServer) Usage(context.Context, *UsageRequest) (*ViolationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Usage not implemented")
+}
 func (UnimplementedEnricherServer) mustEmbedUnimplementedEnricherServer() {}
 
 // UnsafeEnricherServer may be embedded to opt out of forward compatibility for this service.
@@ -206,6 +251,60 @@ func _Enricher_ResetAvcs_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Enricher_Violations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ViolationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnricherServer).Violations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Enricher_Violations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnricherServer).Violations(ctx, req.(*ViolationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Enricher_ResetViolations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ViolationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnricherServer).ResetViolations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Enricher_ResetViolations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnricherServer).ResetViolations(ctx, req.(*ViolationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Enricher_Usage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnricherServer).Usage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Enricher_Usage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnricherServer).Usage(ctx, req.(*UsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Enricher_ServiceDesc is the grpc.ServiceDesc for Enricher service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,6 +328,18 @@ var Enricher_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetAvcs",
 			Handler:    _Enricher_ResetAvcs_Handler,
 		},
+		{
+			MethodName: "Violations",
+			Handler:    _Enricher_Violations_Handler,
+		},
+		{
+			MethodName: "ResetViolations",
+			Handler:    _Enricher_ResetViolations_Handler,
+		},
+		{
+			MethodName: "Usage",
+			Handler:    _Enricher_Usage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/grpc/enricher/api.proto",
@@ -135,9 +135,10 @@ type BpfRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Node           string `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	MountNamespace uint32 `protobuf:"varint,2,opt,name=mount_namespace,json=mountNamespace,proto3" json:"mount_namespace,omitempty"`
-	Profile        string `protobuf:"bytes,3,opt,name=profile,proto3" json:"profile,omitempty"`
+	Node           string                     `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	MountNamespace uint32                     `protobuf:"varint,2,opt,name=mount_namespace,json=mountNamespace,proto3" json:"mount_namespace,omitempty"`
+	Profile        string                     `protobuf:"bytes,3,opt,name=profile,proto3" json:"profile,omitempty"`
+	MapOverflowReq *BpfRequest_MapOverflowReq `protobuf:"bytes,4,opt,name=mapOverflowReq,proto3" json:"mapOverflowReq,omitempty"`
 }
 
 func (x *BpfRequest) Reset() {
@@ -193,6 +194,13 @@ func (x *BpfRequest) GetProfile() string {
 	return ""
 }
 
+func (x *BpfRequest) GetMapOverflowReq() *BpfRequest_MapOverflowReq {
+	if x != nil {
+		return x.MapOverflowReq
+	}
+	return nil
+}
+
 type EmptyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -333,6 +341,61 @@ func (x *AuditRequest_SelinuxAuditReq) GetTcontext() string {
 	return ""
 }
 
+type BpfRequest_MapOverflowReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Map   string `protobuf:"bytes,1,opt,name=map,proto3" json:"map,omitempty"`
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *BpfRequest_MapOverflowReq) Reset() {
+	*x = BpfRequest_MapOverflowReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_grpc_metrics_api_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BpfRequest_MapOverflowReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BpfRequest_MapOverflowReq) ProtoMessage() {}
+
+func (x *BpfRequest_MapOverflowReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_grpc_metrics_api_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BpfRequest_MapOverflowReq.ProtoReflect.Descriptor instead.
+func (*BpfRequest_MapOverflowReq) Descriptor() ([]byte, []int) {
+	return file_api_grpc_metrics_api_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *BpfRequest_MapOverflowReq) GetMap() string {
+	if x != nil {
+		return x.Map
+	}
+	return ""
+}
+
+func (x *BpfRequest_MapOverflowReq) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
 var File_api_grpc_metrics_api_proto protoreflect.FileDescriptor
 
 var file_api_grpc_metrics_api_proto_rawDesc = []byte{
@@ -364,25 +427,34 @@ var file_api_grpc_metrics_api_proto_rawDesc = []byte{
 	0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1a,
 	0x0a, 0x08, 0x74, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x74, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x63, 0x0a, 0x0a, 0x42, 0x70,
-	0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x0f,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22,
-	0x0f, 0x0a, 0x0d, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x32, 0x93, 0x01, 0x0a, 0x07, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x45, 0x0a, 0x08,
-	0x41, 0x75, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x63, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x6d,
-	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x28, 0x01, 0x12, 0x41, 0x0a, 0x06, 0x42, 0x70, 0x66, 0x49, 0x6e, 0x63, 0x12, 0x17, 0x2e,
-	0x61, 0x70, 0x69, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x42, 0x70, 0x66, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x6d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x42, 0x0e, 0x5a, 0x0c, 0x2f, 0x61, 0x70, 0x69, 0x5f, 0x6d,
-	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x08, 0x74, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0xed, 0x01, 0x0a, 0x0a, 0x42,
+	0x70, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a,
+	0x0f, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x12, 0x4e, 0x0a, 0x0e, 0x6d, 0x61, 0x70, 0x4f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x52,
+	0x65, 0x71, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x42, 0x70, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x4d, 0x61, 0x70, 0x4f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71,
+	0x52, 0x0e, 0x6d, 0x61, 0x70, 0x4f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71,
+	0x1a, 0x38, 0x0a, 0x0e, 0x4d, 0x61, 0x70, 0x4f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x52,
+	0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6d, 0x61, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x0f, 0x0a, 0x0d, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x93, 0x01, 0x0a, 0x07,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x45, 0x0a, 0x08, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x49, 0x6e, 0x63, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x61, 0x70, 0x69, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x41,
+	0x0a, 0x06, 0x42, 0x70, 0x66, 0x49, 0x6e, 0x63, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x42, 0x70, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28,
+	0x01, 0x42, 0x0e, 0x5a, 0x0c, 0x2f, 0x61, 0x70, 0x69, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -397,26 +469,28 @@ func file_api_grpc_metrics_api_proto_rawDescGZIP() []byte {
 	return file_api_grpc_metrics_api_proto_rawDescData
 }
 
-var file_api_grpc_metrics_api_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_api_grpc_metrics_api_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_api_grpc_metrics_api_proto_goTypes = []interface{}{
 	(*AuditRequest)(nil),                 // 0: api_metrics.AuditRequest
 	(*BpfRequest)(nil),                   // 1: api_metrics.BpfRequest
 	(*EmptyResponse)(nil),                // 2: api_metrics.EmptyResponse
 	(*AuditRequest_SeccompAuditReq)(nil), // 3: api_metrics.AuditRequest.SeccompAuditReq
 	(*AuditRequest_SelinuxAuditReq)(nil), // 4: api_metrics.AuditRequest.SelinuxAuditReq
+	(*BpfRequest_MapOverflowReq)(nil),    // 5: api_metrics.BpfRequest.MapOverflowReq
 }
 var file_api_grpc_metrics_api_proto_depIdxs = []int32{
 	3, // 0: api_metrics.AuditRequest.seccompReq:type_name -> api_metrics.AuditRequest.SeccompAuditReq
 	4, // 1: api_metrics.AuditRequest.selinuxReq:type_name -> api_metrics.AuditRequest.SelinuxAuditReq
-	0, // 2: api_metrics.Metrics.AuditInc:input_type -> api_metrics.AuditRequest
-	1, // 3: api_metrics.Metrics.BpfInc:input_type -> api_metrics.BpfRequest
-	2, // 4: api_metrics.Metrics.AuditInc:output_type -> api_metrics.EmptyResponse
-	2, // 5: api_metrics.Metrics.BpfInc:output_type -> api_metrics.EmptyResponse
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	5, // 2: api_metrics.BpfRequest.mapOverflowReq:type_name -> api_metrics.BpfRequest.MapOverflowReq
+	0, // 3: api_metrics.Metrics.AuditInc:input_type -> api_metrics.AuditRequest
+	1, // 4: api_metrics.Metrics.BpfInc:input_type -> api_metrics.BpfRequest
+	2, // 5: api_metrics.Metrics.AuditInc:output_type -> api_metrics.EmptyResponse
+	2, // 6: api_metrics.Metrics.BpfInc:output_type -> api_metrics.EmptyResponse
+	5, // [5:7] is the sub-list for method output_type
+	3, // [3:5] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_api_grpc_metrics_api_proto_init() }
@@ -485,6 +559,18 @@ func file_api_grpc_metrics_api_proto_init() {
 				return nil
 			}
 		}
+		file_api_grpc_metrics_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BpfRequest_MapOverflowReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -492,7 +578,7 @@ func file_api_grpc_metrics_api_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_grpc_metrics_api_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -31,3 +31,56 @@ func (in *StatusBase) DeepCopy() *StatusBase {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpecBase) DeepCopyInto(out *SpecBase) {
+	*out = *in
+	if in.NodeSelector != nil {
+		out.NodeSelector = in.NodeSelector.DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpecBase.
+func (in *SpecBase) DeepCopy() *SpecBase {
+	if in == nil {
+		return nil
+	}
+	out := new(SpecBase)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadsStatus) DeepCopyInto(out *WorkloadsStatus) {
+	*out = *in
+	if in.Workloads != nil {
+		in, out := &in.Workloads, &out.Workloads
+		*out = make([]WorkloadReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadsStatus.
+func (in *WorkloadsStatus) DeepCopy() *WorkloadsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RevisionStatus) DeepCopyInto(out *RevisionStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RevisionStatus.
+func (in *RevisionStatus) DeepCopy() *RevisionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RevisionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -22,6 +22,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -30,7 +31,55 @@ import (
 	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
 )
 
-const ProfilePartialLabel = "spo.x-k8s.io/partial"
+const (
+	ProfilePartialLabel = "spo.x-k8s.io/partial"
+
+	// SuggestedPatchAnnotation contains a JSON encoded addition to the
+	// profile spec which has been built from the violations observed on the
+	// nodes while the profile was in use.
+	SuggestedPatchAnnotation = "spo.x-k8s.io/suggested-patch"
+
+	// ApprovePatchAnnotation can be set to "true" to merge the suggested
+	// patch into the profile spec.
+	ApprovePatchAnnotation = "spo.x-k8s.io/approve-patch"
+
+	// ProposedSpecAnnotation contains a JSON encoded profile spec whose
+	// impact should be analyzed before it gets applied. The value "null"
+	// analyzes the deletion of the profile.
+	ProposedSpecAnnotation = "spo.x-k8s.io/proposed-spec"
+
+	// ImpactWindowAnnotation is the duration for which the usage of removed
+	// operations should be checked, defaults to 24h.
+	ImpactWindowAnnotation = "spo.x-k8s.io/impact-window"
+
+	// ImpactReportAnnotation contains the JSON encoded result of analyzing
+	// the proposed spec.
+	ImpactReportAnnotation = "spo.x-k8s.io/impact-report"
+
+	// MaxStatusWorkloads is the maximum number of workloads listed in the
+	// status of a profile.
+	MaxStatusWorkloads = 100
+
+	// RollbackAnnotation can be set to a revision number or to "previous"
+	// to restore the profile spec of that revision.
+	RollbackAnnotation = "spo.x-k8s.io/rollback-to"
+
+	// RollbackPrevious is the RollbackAnnotation value which restores the
+	// revision before the active one.
+	RollbackPrevious = "previous"
+
+	// RevisionProfileKindLabel is the kind of the profile a revision
+	// belongs to.
+	RevisionProfileKindLabel = "spo.x-k8s.io/profile-kind"
+
+	// RevisionProfileNameLabel is the name of the profile a revision
+	// belongs to.
+	RevisionProfileNameLabel = "spo.x-k8s.io/profile-name"
+
+	// MaxProfileRevisions is the maximum number of spec revisions kept for
+	// a profile.
+	MaxProfileRevisions = 10
+)
 
 type SecurityProfileBase interface {
 	client.Object
@@ -39,6 +88,7 @@ type SecurityProfileBase interface {
 	IsPartial() bool
 	IsDisabled() bool
 	IsReconcilable() bool
+	GetNodeSelector() *metav1.LabelSelector
 }
 
 func IsPartial(obj metav1.Object) bool {
@@ -54,6 +104,21 @@ func IsReconcilable(prfBase SecurityProfileBase) bool {
 	return !(prfBase.IsDisabled() || prfBase.IsPartial())
 }
 
+// SelectsNode returns true if the profile should be installed on a node with
+// the provided labels.
+func SelectsNode(prfBase SecurityProfileBase, nodeLabels map[string]string) (bool, error) {
+	nodeSelector := prfBase.GetNodeSelector()
+	if nodeSelector == nil {
+		return true, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(nodeSelector)
+	if err != nil {
+		return false, fmt.Errorf("parsing node selector: %w", err)
+	}
+	return selector.Matches(labels.Set(nodeLabels)), nil
+}
+
 func ListProfilesByRecording(
 	ctx context.Context,
 	cli client.Client,
@@ -108,4 +173,63 @@ type SpecBase struct {
 	// Whether the profile is disabled and should be skipped during reconciliation.
 	// +kubebuilder:default=false
 	Disabled bool `json:"disabled"`
+
+	// NodeSelector restricts the nodes the profile gets installed on. The
+	// profile is installed on all nodes running the operator daemon if
+	// unset, which allows for example to install AppArmor profiles only on
+	// the node pools supporting AppArmor.
+	// +optional
+	NodeSelector *metav1.LabelSelector `json:"nodeSelector,omitempty"`
+}
+
+// WorkloadReference identifies a workload using a profile. Pods are resolved
+// to their owning Deployment, StatefulSet, DaemonSet, Job or CronJob through
+// their owner references. Pods without a controller are referenced directly.
+type WorkloadReference struct {
+	// Kind of the workload, for example Deployment or Pod.
+	Kind string `json:"kind"`
+	// Namespace of the workload.
+	Namespace string `json:"namespace"`
+	// Name of the workload.
+	Name string `json:"name"`
+	// Replicas is the number of pods of the workload using the profile.
+	Replicas int32 `json:"replicas"`
+}
+
+// WorkloadsStatus contains the workloads which are using a profile.
+type WorkloadsStatus struct {
+	// Workloads are the workloads using the profile, sorted by namespace,
+	// kind and name. The list is limited to 100 entries.
+	// +optional
+	// +listType=atomic
+	Workloads []WorkloadReference `json:"workloads,omitempty"`
+	// WorkloadCount is the total number of workloads using the profile,
+	// which can exceed the number of listed workloads.
+	// +optional
+	WorkloadCount int32 `json:"workloadCount,omitempty"`
+}
+
+// RevisionStatus contains the revision of the active profile spec.
+type RevisionStatus struct {
+	// ActiveRevision is the number of the revision matching the spec.
+	// +optional
+	ActiveRevision int64 `json:"activeRevision,omitempty"`
+	// ActiveRevisionName is the name of the ControllerRevision which
+	// contains the spec.
+	// +optional
+	ActiveRevisionName string `json:"activeRevisionName,omitempty"`
+}
+
+// RevisionStatusUser is a profile keeping a history of its spec revisions.
+type RevisionStatusUser interface {
+	client.Object
+	// GetRevisionStatus returns the revision status of the profile.
+	GetRevisionStatus() *RevisionStatus
+}
+
+// WorkloadsStatusUser is a profile tracking the workloads using it.
+type WorkloadsStatusUser interface {
+	client.Object
+	// GetWorkloadsStatus returns the workloads status of the profile.
+	GetWorkloadsStatus() *WorkloadsStatus
 }
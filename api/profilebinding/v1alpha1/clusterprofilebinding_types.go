@@ -0,0 +1,85 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterProfileBindingSpec defines the desired state of ClusterProfileBinding.
+type ClusterProfileBindingSpec struct {
+	// ProfileRef references a ClusterSeccompProfile or ClusterSelinuxProfile.
+	ProfileRef ClusterProfileRef `json:"profileRef"`
+	// Image name within pod containers to match to the profile.
+	Image string `json:"image"`
+	// NamespaceSelector selects the namespaces in which the binding applies.
+	// The binding applies in all namespaces if the selector is empty.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	// MCSAllocation selects whether the bound containers get a SELinux MCS
+	// level with categories which are unique to their namespace or to this
+	// binding. Only applies to SELinux profiles.
+	// +optional
+	// +kubebuilder:default=None
+	// +kubebuilder:validation:Enum=None;Namespace;ProfileBinding
+	MCSAllocation MCSAllocationScope `json:"mcsAllocation,omitempty"`
+}
+
+// ClusterProfileRef contains information that points to the cluster scoped
+// profile being used.
+type ClusterProfileRef struct {
+	// Kind of object to be bound.
+	// +kubebuilder:validation:Enum=ClusterSeccompProfile;ClusterSelinuxProfile
+	Kind ProfileBindingKind `json:"kind"`
+	// Name of the cluster scoped profile to which to bind the selected pods.
+	Name string `json:"name"`
+}
+
+// ClusterProfileBindingStatus contains status of the ClusterProfileBinding.
+type ClusterProfileBindingStatus struct {
+	// ActiveNamespaces contains the namespaces with pods using the profile.
+	// The pods are not listed individually to keep the status bounded for
+	// bindings which apply to many namespaces.
+	ActiveNamespaces []string `json:"activeNamespaces,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterProfileBinding binds a cluster scoped profile to the containers of
+// an image in all selected namespaces.
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+type ClusterProfileBinding struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterProfileBindingSpec   `json:"spec,omitempty"`
+	Status ClusterProfileBindingStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterProfileBindingList contains a list of ClusterProfileBinding.
+type ClusterProfileBindingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterProfileBinding `json:"items"`
+}
+
+func init() { //nolint:gochecknoinits // required to register the scheme
+	SchemeBuilder.Register(&ClusterProfileBinding{}, &ClusterProfileBindingList{})
+}
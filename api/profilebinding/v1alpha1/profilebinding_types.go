@@ -25,6 +25,24 @@ type ProfileBindingKind string
 const (
 	ProfileBindingKindSeccompProfile ProfileBindingKind = "SeccompProfile"
 	ProfileBindingKindSelinuxProfile ProfileBindingKind = "SelinuxProfile"
+
+	ProfileBindingKindClusterSeccompProfile ProfileBindingKind = "ClusterSeccompProfile"
+	ProfileBindingKindClusterSelinuxProfile ProfileBindingKind = "ClusterSelinuxProfile"
+)
+
+// MCSAllocationScope selects which workloads share the SELinux MCS
+// categories allocated by the operator.
+type MCSAllocationScope string
+
+const (
+	// MCSAllocationNone leaves the MCS level to the container runtime.
+	MCSAllocationNone MCSAllocationScope = "None"
+	// MCSAllocationNamespace shares the categories between all bound
+	// workloads of a namespace.
+	MCSAllocationNamespace MCSAllocationScope = "Namespace"
+	// MCSAllocationProfileBinding shares the categories between the
+	// workloads bound by the same binding.
+	MCSAllocationProfileBinding MCSAllocationScope = "ProfileBinding"
 )
 
 // ProfileBindingSpec defines the desired state of ProfileBinding.
@@ -33,12 +51,20 @@ type ProfileBindingSpec struct {
 	ProfileRef ProfileRef `json:"profileRef"`
 	// Image name within pod containers to match to the profile.
 	Image string `json:"image"`
+	// MCSAllocation selects whether the bound containers get a SELinux MCS
+	// level with categories which are unique to their namespace or to this
+	// binding. Only applies to SELinux profiles.
+	// +optional
+	// +kubebuilder:default=None
+	// +kubebuilder:validation:Enum=None;Namespace;ProfileBinding
+	MCSAllocation MCSAllocationScope `json:"mcsAllocation,omitempty"`
 }
 
 // ProfileRef contains information that points to the profile being used.
 type ProfileRef struct {
-	// Kind of object to be bound.
-	// +kubebuilder:validation:Enum=SeccompProfile;SelinuxProfile
+	// Kind of object to be bound. Cluster scoped profiles can be bound
+	// from any namespace.
+	// +kubebuilder:validation:Enum=SeccompProfile;SelinuxProfile;ClusterSeccompProfile;ClusterSelinuxProfile
 	Kind ProfileBindingKind `json:"kind"`
 	// Name of the profile within the current namespace to which to bind the selected pods.
 	Name string `json:"name"`
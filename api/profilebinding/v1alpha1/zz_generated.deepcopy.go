@@ -21,9 +21,125 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterProfileBinding) DeepCopyInto(out *ClusterProfileBinding) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterProfileBinding.
+func (in *ClusterProfileBinding) DeepCopy() *ClusterProfileBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterProfileBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterProfileBinding) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterProfileBindingList) DeepCopyInto(out *ClusterProfileBindingList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterProfileBinding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterProfileBindingList.
+func (in *ClusterProfileBindingList) DeepCopy() *ClusterProfileBindingList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterProfileBindingList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterProfileBindingList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterProfileBindingSpec) DeepCopyInto(out *ClusterProfileBindingSpec) {
+	*out = *in
+	out.ProfileRef = in.ProfileRef
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterProfileBindingSpec.
+func (in *ClusterProfileBindingSpec) DeepCopy() *ClusterProfileBindingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterProfileBindingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterProfileBindingStatus) DeepCopyInto(out *ClusterProfileBindingStatus) {
+	*out = *in
+	if in.ActiveNamespaces != nil {
+		in, out := &in.ActiveNamespaces, &out.ActiveNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterProfileBindingStatus.
+func (in *ClusterProfileBindingStatus) DeepCopy() *ClusterProfileBindingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterProfileBindingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterProfileRef) DeepCopyInto(out *ClusterProfileRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterProfileRef.
+func (in *ClusterProfileRef) DeepCopy() *ClusterProfileRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterProfileRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProfileBinding) DeepCopyInto(out *ProfileBinding) {
 	*out = *in
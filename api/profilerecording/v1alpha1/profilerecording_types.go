@@ -21,8 +21,10 @@ import (
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	utilrand "k8s.io/apimachinery/pkg/util/rand"
 
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
 )
 
@@ -43,20 +45,77 @@ const (
 type ProfileMergeStrategy string
 
 const (
-	ProfileMergeNone       ProfileMergeStrategy = "none"
+	// ProfileMergeNone records a profile per container instance.
+	ProfileMergeNone ProfileMergeStrategy = "none"
+	// ProfileMergeContainers merges the profiles of all instances of the
+	// same container into one profile per container.
 	ProfileMergeContainers ProfileMergeStrategy = "containers"
+	// ProfileMergePods merges the profiles of all containers of the recorded
+	// pods into a single profile.
+	ProfileMergePods ProfileMergeStrategy = "pods"
+	// ProfileMergeIntersection merges the profiles of all instances of the
+	// same container, but keeps only the syscalls or permissions observed
+	// in a minimum number of replicas.
+	ProfileMergeIntersection ProfileMergeStrategy = "intersection"
 )
 
+// IsMerging returns true if the strategy merges the recorded profiles.
+func (s ProfileMergeStrategy) IsMerging() bool {
+	switch s {
+	case ProfileMergeContainers, ProfileMergePods, ProfileMergeIntersection:
+		return true
+	case ProfileMergeNone:
+	}
+	return false
+}
+
 const (
 	// ProfileToRecordingLabel is the name of the ProfileRecording CR that produced this profile.
 	ProfileToRecordingLabel = "spo.x-k8s.io/recording-id"
 	// ProfileToContainerLabel is the name of the container that produced this profile.
 	ProfileToContainerLabel = "spo.x-k8s.io/container-id"
+	// ProfileToPodUIDLabel is the UID of the pod that produced this profile.
+	ProfileToPodUIDLabel = "spo.x-k8s.io/pod-uid"
 	// RecordingHasUnmergedProfiles is a finalizer that indicates that the recording has partial policies. Its
 	// main use is to hold off the deletion of the recording until all partial profiles are merged.
 	RecordingHasUnmergedProfiles = "spo.x-k8s.io/has-unmerged-profiles"
 )
 
+// Condition types of a ProfileRecording.
+const (
+	// TypeCompleted recordings have recorded all of their workloads and their
+	// profiles are final.
+	TypeCompleted = "Completed"
+)
+
+// Reasons a ProfileRecording is or is not completed.
+const (
+	ReasonJobRunning         = "JobRunning"
+	ReasonCollectingProfiles = "CollectingProfiles"
+	ReasonJobFinished        = "JobFinished"
+	ReasonWindowNotStarted   = "WindowNotStarted"
+	ReasonWindowOpen         = "WindowOpen"
+	ReasonWindowClosed       = "WindowClosed"
+)
+
+// JobKind is the kind of workload a recording can be scoped to.
+type JobKind string
+
+const (
+	JobKindJob     JobKind = "Job"
+	JobKindCronJob JobKind = "CronJob"
+)
+
+// JobReference references a Job or CronJob in the namespace of the recording.
+type JobReference struct {
+	// Kind of the referenced workload.
+	// +kubebuilder:validation:Enum=Job;CronJob
+	Kind JobKind `json:"kind"`
+
+	// Name of the referenced workload.
+	Name string `json:"name"`
+}
+
 // ProfileRecordingSpec defines the desired state of ProfileRecording.
 type ProfileRecordingSpec struct {
 	// Kind of object to be recorded.
@@ -67,13 +126,27 @@ type ProfileRecordingSpec struct {
 	// +kubebuilder:validation:Enum=bpf;logs
 	Recorder ProfileRecorder `json:"recorder"`
 
-	// Whether or how to merge recorded profiles. Can be one of "none" or "containers".
-	// Default is "none".
+	// Whether or how to merge recorded profiles. Can be one of "none",
+	// "containers", "pods" or "intersection". Default is "none".
 	// +optional
 	// +kubebuilder:default="none"
-	// +kubebuilder:validation:Enum=none;containers
+	// +kubebuilder:validation:Enum=none;containers;pods;intersection
 	MergeStrategy ProfileMergeStrategy `json:"mergeStrategy"`
 
+	// MinReplicas is the number of replicas in which a syscall or permission
+	// has to be observed to be kept by the "intersection" merge strategy.
+	// Defaults to all recorded replicas of a container.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MinReplicas int32 `json:"minReplicas,omitempty"`
+
+	// MergeWithExisting adds the merged profiles to the existing profiles of
+	// the same name instead of replacing them, which allows to accumulate
+	// the profile over several recording sessions. Only used if the merge
+	// strategy is not "none".
+	// +optional
+	MergeWithExisting bool `json:"mergeWithExisting,omitempty"`
+
 	// PodSelector selects the pods to record. This field follows standard
 	// label selector semantics. An empty podSelector matches all pods in this
 	// namespace.
@@ -91,11 +164,81 @@ type ProfileRecordingSpec struct {
 	// This Defaults to false.
 	// +kubebuilder:default=false
 	DisableProfileAfterRecording bool `json:"disableProfileAfterRecording"`
+
+	// Job scopes the recording to a Job or CronJob. The recording of a Job
+	// completes once all of its pods finished and their profiles got
+	// collected. The recording of a CronJob completes once its recording
+	// window, set by duration or deadline, closed and all Jobs started
+	// within it finished. The profiles get merged at this point if the
+	// merge strategy is "containers". The pods of the Job still need to be
+	// selected by the podSelector.
+	// +optional
+	Job *JobReference `json:"job,omitempty"`
+
+	// Schedule delays the start of the recording until the provided time.
+	// Pods created before are not recorded. Defaults to the creation time
+	// of the recording.
+	// +optional
+	Schedule *metav1.Time `json:"schedule,omitempty"`
+
+	// Duration stops the recording once it ran for the provided duration,
+	// measured from its start.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// Deadline stops the recording at the provided time. The recording
+	// stops at the earlier point in time if both duration and deadline are
+	// set. Once the recording stopped, the profiles of the running pods get
+	// collected, the profiles get merged according to the merge strategy
+	// and the recording completes. Recordings scoped to a job complete
+	// with the job instead.
+	// +optional
+	Deadline *metav1.Time `json:"deadline,omitempty"`
+}
+
+// RecordingSummary summarizes the results of a completed recording.
+type RecordingSummary struct {
+	// Profiles are the names of the profiles produced by the recording.
+	// +optional
+	// +listType=atomic
+	Profiles []string `json:"profiles,omitempty"`
+	// PodsObserved is the number of pods whose profiles got recorded.
+	PodsObserved int32 `json:"podsObserved"`
+	// Syscalls is the number of distinct syscalls found in the recorded
+	// seccomp profiles.
+	// +optional
+	Syscalls int32 `json:"syscalls,omitempty"`
+	// Permissions is the number of distinct permissions found in the
+	// recorded SELinux profiles.
+	// +optional
+	Permissions int32 `json:"permissions,omitempty"`
+}
+
+// RecordedJob is a Job whose pods got recorded.
+type RecordedJob struct {
+	// Name of the Job.
+	Name string `json:"name"`
+	// UID of the Job.
+	UID types.UID `json:"uid"`
+	// Finished is true once the Job completed, failed or got deleted.
+	// +optional
+	Finished bool `json:"finished,omitempty"`
 }
 
 // ProfileRecordingStatus contains status of the ProfileRecording.
 type ProfileRecordingStatus struct {
-	ActiveWorkloads []string `json:"activeWorkloads,omitempty"`
+	spodv1alpha1.ConditionedStatus `json:",inline"`
+	ActiveWorkloads                []string `json:"activeWorkloads,omitempty"`
+	// Summary of the recording, set once a recording with a time window
+	// completed.
+	// +optional
+	Summary *RecordingSummary `json:"summary,omitempty"`
+	// Jobs are the Jobs recorded by a recording of a Job or CronJob. They
+	// are tracked until the recording completes, even if they got deleted.
+	// +optional
+	// +listType=map
+	// +listMapKey=uid
+	Jobs []RecordedJob `json:"jobs,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -124,6 +267,34 @@ func (pr *ProfileRecording) CtrAnnotation(ctrName string) (key, value string, er
 	)
 }
 
+// RecordingWindow returns the start and end of the time window in which
+// pods get recorded. The end is zero if the recording does not stop.
+func (pr *ProfileRecording) RecordingWindow() (start, end time.Time) {
+	start = pr.CreationTimestamp.Time
+	if pr.Spec.Schedule != nil {
+		start = pr.Spec.Schedule.Time
+	}
+
+	if pr.Spec.Duration != nil {
+		end = start.Add(pr.Spec.Duration.Duration)
+	}
+	if pr.Spec.Deadline != nil && (end.IsZero() || pr.Spec.Deadline.Time.Before(end)) {
+		end = pr.Spec.Deadline.Time
+	}
+
+	return start, end
+}
+
+// IsActiveAt returns true if the provided time is within the recording
+// window.
+func (pr *ProfileRecording) IsActiveAt(t time.Time) bool {
+	start, end := pr.RecordingWindow()
+	if t.Before(start) {
+		return false
+	}
+	return end.IsZero() || t.Before(end)
+}
+
 func (pr *ProfileRecording) IsKindSupported() bool {
 	switch pr.Spec.Kind {
 	case ProfileRecordingKindSelinuxProfile, ProfileRecordingKindSeccompProfile:
@@ -21,9 +21,25 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobReference) DeepCopyInto(out *JobReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobReference.
+func (in *JobReference) DeepCopy() *JobReference {
+	if in == nil {
+		return nil
+	}
+	out := new(JobReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProfileRecording) DeepCopyInto(out *ProfileRecording) {
 	*out = *in
@@ -92,6 +108,24 @@ func (in *ProfileRecordingSpec) DeepCopyInto(out *ProfileRecordingSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Job != nil {
+		in, out := &in.Job, &out.Job
+		*out = new(JobReference)
+		**out = **in
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = (*in).DeepCopy()
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Deadline != nil {
+		in, out := &in.Deadline, &out.Deadline
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileRecordingSpec.
@@ -107,11 +141,22 @@ func (in *ProfileRecordingSpec) DeepCopy() *ProfileRecordingSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProfileRecordingStatus) DeepCopyInto(out *ProfileRecordingStatus) {
 	*out = *in
+	in.ConditionedStatus.DeepCopyInto(&out.ConditionedStatus)
 	if in.ActiveWorkloads != nil {
 		in, out := &in.ActiveWorkloads, &out.ActiveWorkloads
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Summary != nil {
+		in, out := &in.Summary, &out.Summary
+		*out = new(RecordingSummary)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Jobs != nil {
+		in, out := &in.Jobs, &out.Jobs
+		*out = make([]RecordedJob, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileRecordingStatus.
@@ -123,3 +168,38 @@ func (in *ProfileRecordingStatus) DeepCopy() *ProfileRecordingStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecordedJob) DeepCopyInto(out *RecordedJob) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecordedJob.
+func (in *RecordedJob) DeepCopy() *RecordedJob {
+	if in == nil {
+		return nil
+	}
+	out := new(RecordedJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecordingSummary) DeepCopyInto(out *RecordingSummary) {
+	*out = *in
+	if in.Profiles != nil {
+		in, out := &in.Profiles, &out.Profiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecordingSummary.
+func (in *RecordingSummary) DeepCopy() *RecordingSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(RecordingSummary)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"path"
+	"path/filepath"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	profilebase "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+)
+
+// Ensure ClusterSeccompProfile implements the SeccompProfileObject interface.
+var _ SeccompProfileObject = &ClusterSeccompProfile{}
+
+// ClusterSeccompProfileKind is the kind of the ClusterSeccompProfile.
+const ClusterSeccompProfileKind = "ClusterSeccompProfile"
+
+// +kubebuilder:object:root=true
+
+// ClusterSeccompProfile is a cluster scoped seccomp profile, which can be
+// used by workloads in all namespaces.
+// See https://github.com/opencontainers/runtime-spec/blob/master/config-linux.md#seccomp
+// +kubebuilder:resource:scope=Cluster,shortName=csp
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.status`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:printcolumn:name="LocalhostProfile",type=string,priority=10,JSONPath=`.status.localhostProfile`
+type ClusterSeccompProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SeccompProfileSpec   `json:"spec,omitempty"`
+	Status SeccompProfileStatus `json:"status,omitempty"`
+}
+
+func (sp *ClusterSeccompProfile) GetStatusBase() *profilebase.StatusBase {
+	return &sp.Status.StatusBase
+}
+
+func (sp *ClusterSeccompProfile) DeepCopyToStatusBaseIf() profilebase.StatusBaseUser {
+	return sp.DeepCopy()
+}
+
+func (sp *ClusterSeccompProfile) SetImplementationStatus() {
+	profilePath := sp.GetProfilePath()
+	sp.Status.LocalhostProfile = strings.TrimPrefix(profilePath, config.KubeletSeccompRootPath()+"/")
+}
+
+func (sp *ClusterSeccompProfile) GetSpec() *SeccompProfileSpec {
+	return &sp.Spec
+}
+
+func (sp *ClusterSeccompProfile) GetStatus() *SeccompProfileStatus {
+	return &sp.Status
+}
+
+func (sp *ClusterSeccompProfile) GetProfileFile() string {
+	pfile := sp.GetName()
+	if !strings.HasSuffix(pfile, ExtJSON) {
+		pfile = sp.GetName() + ExtJSON
+	}
+	return pfile
+}
+
+// GetProfilePath returns the path of the profile on the node. Cluster scoped
+// profiles are stored in the root of the operator directory, which cannot
+// conflict with the namespace directories of the namespaced profiles.
+func (sp *ClusterSeccompProfile) GetProfilePath() string {
+	return path.Join(config.ProfilesRootPath(), filepath.Base(sp.GetProfileFile()))
+}
+
+func (sp *ClusterSeccompProfile) GetProfileOperatorPath() string {
+	return path.Join(config.OperatorRoot, filepath.Base(sp.GetProfileFile()))
+}
+
+func (sp *ClusterSeccompProfile) ListProfilesByRecording(
+	ctx context.Context,
+	cli client.Client,
+	recording string,
+) ([]metav1.Object, error) {
+	return profilebase.ListProfilesByRecording(ctx, cli, recording, "", &ClusterSeccompProfileList{})
+}
+
+func (sp *ClusterSeccompProfile) IsPartial() bool {
+	return profilebase.IsPartial(sp)
+}
+
+func (sp *ClusterSeccompProfile) IsDisabled() bool {
+	return profilebase.IsDisabled(&sp.Spec.SpecBase)
+}
+
+func (sp *ClusterSeccompProfile) GetNodeSelector() *metav1.LabelSelector {
+	return sp.Spec.NodeSelector
+}
+
+func (sp *ClusterSeccompProfile) IsReconcilable() bool {
+	return profilebase.IsReconcilable(sp)
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterSeccompProfileList contains a list of ClusterSeccompProfile.
+type ClusterSeccompProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterSeccompProfile `json:"items"`
+}
+
+func init() { //nolint:gochecknoinits // required to init scheme
+	SchemeBuilder.Register(&ClusterSeccompProfile{}, &ClusterSeccompProfileList{})
+}
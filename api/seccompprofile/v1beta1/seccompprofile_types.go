@@ -27,6 +27,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	profilebase "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	secprofnodestatusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
 )
 
@@ -34,10 +35,25 @@ import (
 var (
 	_ profilebase.StatusBaseUser      = &SeccompProfile{}
 	_ profilebase.SecurityProfileBase = &SeccompProfile{}
+	_ SeccompProfileObject            = &SeccompProfile{}
 )
 
 const ExtJSON = ".json"
 
+// SeccompProfileObject is implemented by the namespaced SeccompProfile and
+// the cluster scoped ClusterSeccompProfile.
+// +k8s:deepcopy-gen=false
+type SeccompProfileObject interface {
+	profilebase.StatusBaseUser
+	profilebase.SecurityProfileBase
+
+	GetSpec() *SeccompProfileSpec
+	GetStatus() *SeccompProfileStatus
+	GetProfileFile() string
+	GetProfilePath() string
+	GetProfileOperatorPath() string
+}
+
 // SeccompProfileSpec defines the desired state of SeccompProfile.
 type SeccompProfileSpec struct {
 	// Common spec fields for all profiles.
@@ -45,7 +61,8 @@ type SeccompProfileSpec struct {
 
 	// BaseProfileName is the name of base profile (in the same namespace) that
 	// will be unioned into this profile. Base profiles can be references as
-	// remote OCI artifacts as well when prefixed with `oci://`.
+	// remote OCI artifacts as well when prefixed with `oci://`, or as
+	// cluster scoped profiles when prefixed with `ClusterSeccompProfile/`.
 	BaseProfileName string `json:"baseProfileName,omitempty"`
 
 	// Properties from containers/common/pkg/seccomp.Seccomp type
@@ -117,12 +134,21 @@ type Arg struct {
 
 // SeccompProfileStatus contains status of the deployed SeccompProfile.
 type SeccompProfileStatus struct {
-	profilebase.StatusBase `json:",inline"`
-	Path                   string   `json:"path,omitempty"`
-	ActiveWorkloads        []string `json:"activeWorkloads,omitempty"`
+	profilebase.StatusBase      `json:",inline"`
+	profilebase.WorkloadsStatus `json:",inline"`
+	profilebase.RevisionStatus  `json:",inline"`
+	Path                        string `json:"path,omitempty"`
+	// ActiveWorkloads contains the pods using the profile, limited to the
+	// first 100 pods in alphabetical order.
+	// Deprecated: superseded by workloads, still populated until removed.
+	ActiveWorkloads []string `json:"activeWorkloads,omitempty"`
 	// The path that should be provided to the `securityContext.seccompProfile.localhostProfile`
 	// field of a Pod or container spec
 	LocalhostProfile string `json:"localhostProfile,omitempty"`
+	// Filter is the largest BPF filter compiled from the profile on any
+	// node. The filter of every node is part of its node status.
+	// +optional
+	Filter *secprofnodestatusv1alpha1.SeccompFilterStatus `json:"filter,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -134,6 +160,8 @@ type SeccompProfileStatus struct {
 // +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.status`
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 // +kubebuilder:printcolumn:name="LocalhostProfile",type=string,priority=10,JSONPath=`.status.localhostProfile`
+// +kubebuilder:printcolumn:name="Workloads",type=integer,priority=10,JSONPath=`.status.workloadCount`
+// +kubebuilder:printcolumn:name="Revision",type=integer,priority=10,JSONPath=`.status.activeRevision`
 type SeccompProfile struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -155,6 +183,22 @@ func (sp *SeccompProfile) SetImplementationStatus() {
 	sp.Status.LocalhostProfile = strings.TrimPrefix(profilePath, config.KubeletSeccompRootPath()+"/")
 }
 
+func (sp *SeccompProfile) GetSpec() *SeccompProfileSpec {
+	return &sp.Spec
+}
+
+func (sp *SeccompProfile) GetStatus() *SeccompProfileStatus {
+	return &sp.Status
+}
+
+func (sp *SeccompProfile) GetWorkloadsStatus() *profilebase.WorkloadsStatus {
+	return &sp.Status.WorkloadsStatus
+}
+
+func (sp *SeccompProfile) GetRevisionStatus() *profilebase.RevisionStatus {
+	return &sp.Status.RevisionStatus
+}
+
 func (sp *SeccompProfile) GetProfileFile() string {
 	pfile := sp.GetName()
 	if !strings.HasSuffix(pfile, ExtJSON) {
@@ -197,6 +241,10 @@ func (sp *SeccompProfile) IsDisabled() bool {
 	return profilebase.IsDisabled(&sp.Spec.SpecBase)
 }
 
+func (sp *SeccompProfile) GetNodeSelector() *metav1.LabelSelector {
+	return sp.Spec.NodeSelector
+}
+
 func (sp *SeccompProfile) IsReconcilable() bool {
 	return profilebase.IsReconcilable(sp)
 }
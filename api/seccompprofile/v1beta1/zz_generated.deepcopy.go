@@ -22,6 +22,7 @@ package v1beta1
 
 import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -39,6 +40,65 @@ func (in *Arg) DeepCopy() *Arg {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterSeccompProfile) DeepCopyInto(out *ClusterSeccompProfile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSeccompProfile.
+func (in *ClusterSeccompProfile) DeepCopy() *ClusterSeccompProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterSeccompProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterSeccompProfile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterSeccompProfileList) DeepCopyInto(out *ClusterSeccompProfileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterSeccompProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSeccompProfileList.
+func (in *ClusterSeccompProfileList) DeepCopy() *ClusterSeccompProfileList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterSeccompProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterSeccompProfileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeccompProfile) DeepCopyInto(out *SeccompProfile) {
 	*out = *in
@@ -101,7 +161,7 @@ func (in *SeccompProfileList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeccompProfileSpec) DeepCopyInto(out *SeccompProfileSpec) {
 	*out = *in
-	out.SpecBase = in.SpecBase
+	in.SpecBase.DeepCopyInto(&out.SpecBase)
 	if in.Architectures != nil {
 		in, out := &in.Architectures, &out.Architectures
 		*out = make([]Arch, len(*in))
@@ -145,11 +205,18 @@ func (in *SeccompProfileSpec) DeepCopy() *SeccompProfileSpec {
 func (in *SeccompProfileStatus) DeepCopyInto(out *SeccompProfileStatus) {
 	*out = *in
 	in.StatusBase.DeepCopyInto(&out.StatusBase)
+	in.WorkloadsStatus.DeepCopyInto(&out.WorkloadsStatus)
+	in.RevisionStatus.DeepCopyInto(&out.RevisionStatus)
 	if in.ActiveWorkloads != nil {
 		in, out := &in.ActiveWorkloads, &out.ActiveWorkloads
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(v1alpha1.SeccompFilterStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeccompProfileStatus.
@@ -56,6 +56,10 @@ const (
 	StatusKindLabel = "spo.x-k8s.io/profile-kind"
 )
 
+// ReasonRolloutHalted is the reason of the error status of a canary node
+// which observed violations of a profile update during a staged rollout.
+const ReasonRolloutHalted = "ProfileRolloutHalted"
+
 // LowestState defines the "lowest" state for the profiles to be at.
 // All of the statuses would need to reach this for us to get here.
 const LowestState ProfileState = ProfileStateInstalled
@@ -86,6 +90,9 @@ func LowerOfTwoStates(currentLowest, candidate ProfileState) ProfileState {
 // +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status`
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 // +kubebuilder:printcolumn:name="Node",type=string,priority=10,JSONPath=`.nodeName`
+// +kubebuilder:printcolumn:name="Generation",type=integer,priority=10,JSONPath=`.observedGeneration`
+// +kubebuilder:printcolumn:name="Reason",type=string,priority=10,JSONPath=`.reason`
+// +kubebuilder:printcolumn:name="Mode",type=string,priority=10,JSONPath=`.loadedMode`
 type SecurityProfileNodeStatus struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -94,10 +101,83 @@ type SecurityProfileNodeStatus struct {
 
 	NodeName string       `json:"nodeName"`
 	Status   ProfileState `json:"status,omitempty"`
+
+	// ObservedGeneration is the generation of the profile the status
+	// refers to.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// LastTransitionTime is the time the status or observed generation
+	// changed the last time.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	// ContentDigest is the digest of the profile content installed on the
+	// node, for example "sha256:<hex>".
+	// +optional
+	ContentDigest string `json:"contentDigest,omitempty"`
+	// Reason is a machine readable reason for the current status, for
+	// example why the profile could not be installed.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// Message is a human readable message with details about the current
+	// status.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// SuggestedPatch is the JSON encoded addition to the profile spec which
+	// would allow the violations of the profile observed on the node.
+	// +optional
+	SuggestedPatch string `json:"suggestedPatch,omitempty"`
+	// ImpactReport is the JSON encoded impact report of the proposed spec of
+	// the profile, containing the operations observed on the node.
+	// +optional
+	ImpactReport string `json:"impactReport,omitempty"`
+	// SeccompFilter is the BPF filter compiled from a seccomp profile on the
+	// node.
+	// +optional
+	SeccompFilter *SeccompFilterStatus `json:"seccompFilter,omitempty"`
+	// LoadedMode is the mode in which an AppArmor profile is actually loaded
+	// on the node, either enforce or complain.
+	// +optional
+	LoadedMode string `json:"loadedMode,omitempty"`
 }
 
 type SecurityProfileNodeStatusSpec struct{}
 
+// SeccompFilterStatus describes the BPF filter libseccomp generates from a
+// seccomp profile.
+type SeccompFilterStatus struct {
+	// Rules is the number of rules added to the filter. Syscalls unknown to
+	// the node are not part of the filter.
+	Rules int32 `json:"rules"`
+	// Instructions is the number of BPF instructions of the filter covering
+	// all architectures of the profile.
+	Instructions int32 `json:"instructions"`
+	// ExceedsThreshold is true if the filter has more instructions than
+	// the seccompFilterThreshold of the SPOD configuration.
+	// +optional
+	ExceedsThreshold bool `json:"exceedsThreshold,omitempty"`
+	// Architectures contains the filter generated for every single
+	// architecture of the profile.
+	// +optional
+	Architectures []SeccompArchFilterStatus `json:"architectures,omitempty"`
+}
+
+// SeccompArchFilterStatus describes the BPF filter libseccomp generates from
+// a seccomp profile for a single architecture.
+type SeccompArchFilterStatus struct {
+	// Arch is the seccomp architecture of the filter, for example
+	// SCMP_ARCH_X86_64.
+	Arch string `json:"arch"`
+	// Valid is true if the profile compiles to a filter for the architecture.
+	Valid bool `json:"valid"`
+	// Instructions is the number of BPF instructions of the filter.
+	// +optional
+	Instructions int32 `json:"instructions,omitempty"`
+	// Error is the reason why the profile does not compile for the
+	// architecture.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // SecurityProfileNodeStatusList contains a list of SecurityProfileNodeStatus.
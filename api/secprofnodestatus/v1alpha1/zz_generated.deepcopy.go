@@ -24,12 +24,53 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeccompArchFilterStatus) DeepCopyInto(out *SeccompArchFilterStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeccompArchFilterStatus.
+func (in *SeccompArchFilterStatus) DeepCopy() *SeccompArchFilterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SeccompArchFilterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeccompFilterStatus) DeepCopyInto(out *SeccompFilterStatus) {
+	*out = *in
+	if in.Architectures != nil {
+		in, out := &in.Architectures, &out.Architectures
+		*out = make([]SeccompArchFilterStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeccompFilterStatus.
+func (in *SeccompFilterStatus) DeepCopy() *SeccompFilterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SeccompFilterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecurityProfileNodeStatus) DeepCopyInto(out *SecurityProfileNodeStatus) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	out.Spec = in.Spec
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	if in.SeccompFilter != nil {
+		in, out := &in.SeccompFilter, &out.SeccompFilter
+		*out = new(SeccompFilterStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityProfileNodeStatus.
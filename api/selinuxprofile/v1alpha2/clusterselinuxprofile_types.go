@@ -0,0 +1,123 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	profilebasev1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+)
+
+// Ensure ClusterSelinuxProfile implements the StatusBaseUser and SelinuxProfileObject interfaces.
+var (
+	_ profilebasev1alpha1.StatusBaseUser = &ClusterSelinuxProfile{}
+	_ SelinuxProfileObject               = &ClusterSelinuxProfile{}
+)
+
+// ClusterSelinuxProfileKind is the kind of the ClusterSelinuxProfile.
+const ClusterSelinuxProfileKind = "ClusterSelinuxProfile"
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterSelinuxProfile is a cluster scoped SELinux profile, which can be
+// used by workloads in all namespaces.
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=clusterselinuxprofiles,scope=Cluster
+// +kubebuilder:printcolumn:name="Usage",type="string",JSONPath=`.status.usage`
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=`.status.status`
+type ClusterSelinuxProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SelinuxProfileSpec   `json:"spec,omitempty"`
+	Status SelinuxProfileStatus `json:"status,omitempty"`
+}
+
+func (sp *ClusterSelinuxProfile) GetStatusBase() *profilebasev1alpha1.StatusBase {
+	return &sp.Status.StatusBase
+}
+
+func (sp *ClusterSelinuxProfile) DeepCopyToStatusBaseIf() profilebasev1alpha1.StatusBaseUser {
+	return sp.DeepCopy()
+}
+
+func (sp *ClusterSelinuxProfile) SetImplementationStatus() {
+	sp.Status.Usage = sp.GetPolicyUsage()
+}
+
+// GetPolicyName gets the policy module name in the format that
+// we're expecting for parsing. The name lacks the namespace which
+// follows the separator for namespaced profiles, so it cannot conflict
+// with their names.
+func (sp *ClusterSelinuxProfile) GetPolicyName() string {
+	return sp.GetName() + "_"
+}
+
+// GetPolicyUsage is the representation of how a pod will call this
+// SELinux module.
+func (sp *ClusterSelinuxProfile) GetPolicyUsage() string {
+	return sp.GetPolicyName() + ".process"
+}
+
+// ToSelinuxProfile returns a SelinuxProfile with the spec of the cluster
+// scoped profile, which has the same policy name.
+func (sp *ClusterSelinuxProfile) ToSelinuxProfile() *SelinuxProfile {
+	return &SelinuxProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: sp.GetName()},
+		Spec:       *sp.Spec.DeepCopy(),
+	}
+}
+
+func (sp *ClusterSelinuxProfile) ListProfilesByRecording(
+	ctx context.Context,
+	cli client.Client,
+	recording string,
+) ([]metav1.Object, error) {
+	return profilebasev1alpha1.ListProfilesByRecording(ctx, cli, recording, "", &ClusterSelinuxProfileList{})
+}
+
+func (sp *ClusterSelinuxProfile) IsPartial() bool {
+	return profilebasev1alpha1.IsPartial(sp)
+}
+
+func (sp *ClusterSelinuxProfile) IsDisabled() bool {
+	return profilebasev1alpha1.IsDisabled(&sp.Spec.SpecBase)
+}
+
+func (sp *ClusterSelinuxProfile) GetNodeSelector() *metav1.LabelSelector {
+	return sp.Spec.NodeSelector
+}
+
+func (sp *ClusterSelinuxProfile) IsReconcilable() bool {
+	return profilebasev1alpha1.IsReconcilable(sp)
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterSelinuxProfileList contains a list of ClusterSelinuxProfile.
+type ClusterSelinuxProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterSelinuxProfile `json:"items"`
+}
+
+func init() { //nolint:gochecknoinits // required to init the scheme
+	SchemeBuilder.Register(&ClusterSelinuxProfile{}, &ClusterSelinuxProfileList{})
+}
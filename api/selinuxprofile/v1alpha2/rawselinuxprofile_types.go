@@ -94,6 +94,10 @@ func (sp *RawSelinuxProfile) IsDisabled() bool {
 	return profilebasev1alpha1.IsDisabled(&sp.Spec.SpecBase)
 }
 
+func (sp *RawSelinuxProfile) GetNodeSelector() *metav1.LabelSelector {
+	return sp.Spec.NodeSelector
+}
+
 func (sp *RawSelinuxProfile) IsReconcilable() bool {
 	return profilebasev1alpha1.IsReconcilable(sp)
 }
@@ -0,0 +1,125 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// MCSOwnerKind is the kind of object owning a MCS category allocation.
+type MCSOwnerKind string
+
+const (
+	MCSOwnerKindNamespace             MCSOwnerKind = "Namespace"
+	MCSOwnerKindProfileBinding        MCSOwnerKind = "ProfileBinding"
+	MCSOwnerKindClusterProfileBinding MCSOwnerKind = "ClusterProfileBinding"
+)
+
+const (
+	// MCSCategoryCount is the number of MCS categories, c0 to c1023, of the
+	// default SELinux policies.
+	MCSCategoryCount = 1024
+
+	// MCSSensitivity is the sensitivity of the allocated MCS levels.
+	MCSSensitivity = "s0"
+)
+
+// MCSOwner references the object the MCS categories are allocated to.
+type MCSOwner struct {
+	// Kind of the owner.
+	// +kubebuilder:validation:Enum=Namespace;ProfileBinding;ClusterProfileBinding
+	Kind MCSOwnerKind `json:"kind"`
+	// Namespace of the owner. For namespaces, this is the name of the
+	// namespace itself. Empty for ClusterProfileBindings.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Name of the ProfileBinding or ClusterProfileBinding. Empty for
+	// namespaces.
+	// +optional
+	Name string `json:"name,omitempty"`
+}
+
+// SelinuxMCSAllocationSpec defines the allocated MCS categories.
+type SelinuxMCSAllocationSpec struct {
+	// Owner is the object the categories are allocated to.
+	Owner MCSOwner `json:"owner"`
+	// Categories are the two distinct categories of the MCS level, in
+	// ascending order.
+	// +kubebuilder:validation:MinItems=2
+	// +kubebuilder:validation:MaxItems=2
+	Categories []int `json:"categories"`
+}
+
+// +kubebuilder:object:root=true
+
+// SelinuxMCSAllocation records a pair of MCS categories which the operator
+// allocated to a namespace or a profile binding. The name of an allocation
+// is derived from its owner, so that every owner gets only one allocation.
+// +kubebuilder:resource:path=selinuxmcsallocations,scope=Cluster
+// +kubebuilder:printcolumn:name="Categories",type="string",JSONPath=`.spec.categories`
+// +kubebuilder:printcolumn:name="Owner Kind",type="string",JSONPath=`.spec.owner.kind`
+// +kubebuilder:printcolumn:name="Owner Namespace",type="string",JSONPath=`.spec.owner.namespace`
+// +kubebuilder:printcolumn:name="Owner Name",type="string",JSONPath=`.spec.owner.name`
+type SelinuxMCSAllocation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec SelinuxMCSAllocationSpec `json:"spec,omitempty"`
+}
+
+// Level returns the MCS level of the allocation, for example s0:c1,c2.
+func (a *SelinuxMCSAllocation) Level() string {
+	if len(a.Spec.Categories) != 2 {
+		return ""
+	}
+	return fmt.Sprintf("%s:c%d,c%d", MCSSensitivity, a.Spec.Categories[0], a.Spec.Categories[1])
+}
+
+// MCSAllocationName returns the name of the allocation of the owner, which
+// joins the kind, namespace and name of the owner with dots. Names exceeding
+// the maximum length of object names get hashed.
+func MCSAllocationName(owner MCSOwner) string {
+	parts := []string{strings.ToLower(string(owner.Kind))}
+	for _, part := range []string{owner.Namespace, owner.Name} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	name := strings.Join(parts, ".")
+	if len(name) <= validation.DNS1123SubdomainMaxLength {
+		return name
+	}
+	return fmt.Sprintf("%s.%x", parts[0], sha256.Sum256([]byte(name)))
+}
+
+// +kubebuilder:object:root=true
+
+// SelinuxMCSAllocationList contains a list of SelinuxMCSAllocation.
+type SelinuxMCSAllocationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SelinuxMCSAllocation `json:"items"`
+}
+
+func init() { //nolint:gochecknoinits // required to init the scheme
+	SchemeBuilder.Register(&SelinuxMCSAllocation{}, &SelinuxMCSAllocationList{})
+}
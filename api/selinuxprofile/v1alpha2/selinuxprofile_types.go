@@ -41,12 +41,12 @@ var (
 
 type PolicyRef struct {
 	// The Kind of the policy that this inherits from.
-	// Can be a SelinuxProfile object Or "System" if an already
-	// installed policy will be used.
+	// Can be a SelinuxProfile or ClusterSelinuxProfile object Or "System"
+	// if an already installed policy will be used.
 	// The allowed "System" policies are available in the
 	// SecurityProfilesOperatorDaemon instance.
 	// +kubebuilder:default="System"
-	// +kubebuilder:validation:Enum=System;SelinuxProfile;
+	// +kubebuilder:validation:Enum=System;SelinuxProfile;ClusterSelinuxProfile
 	Kind string `json:"kind,omitempty"`
 	// The name of the policy that this inherits from.
 	Name string `json:"name"`
@@ -58,7 +58,8 @@ type SelinuxProfileSpec struct {
 	profilebasev1alpha1.SpecBase `json:",inline"`
 
 	// A SELinuxProfile or set of profiles that this inherits from.
-	// Note that they need to be in the same namespace.
+	// Note that they need to be in the same namespace, except for
+	// ClusterSelinuxProfiles.
 	// +optional
 	// +kubebuilder:default={{kind:"System",name:"container"}}
 	Inherit []PolicyRef `json:"inherit,omitempty"`
@@ -69,6 +70,107 @@ type SelinuxProfileSpec struct {
 	Permissive bool `json:"permissive,omitempty"`
 	// Defines the allow policy for the profile
 	Allow Allow `json:"allow,omitempty"`
+	// Types declares additional types within the policy of the profile,
+	// for example to label the files of the workload. Allow rules, file and
+	// port contexts and type transitions can reference them by name.
+	// +optional
+	Types []TypeDefinition `json:"types,omitempty"`
+	// FileContexts label the files matching a path with a type. They apply
+	// to the whole node, which is why they are only allowed in
+	// ClusterSelinuxProfiles.
+	// +optional
+	FileContexts []FileContext `json:"fileContexts,omitempty"`
+	// PortContexts label ports with a type. They apply to the whole node,
+	// which is why they are only allowed in ClusterSelinuxProfiles.
+	// +optional
+	PortContexts []PortContext `json:"portContexts,omitempty"`
+	// TypeTransitions transition the process into a process type of the
+	// profile when executing a file of the entrypoint type.
+	// +optional
+	TypeTransitions []TypeTransition `json:"typeTransitions,omitempty"`
+	// TypeAttributes add the process or the types of the profile to
+	// attributes of the system policy. They grant the permissions of the
+	// attributes, which is why they are only allowed in
+	// ClusterSelinuxProfiles.
+	// +optional
+	TypeAttributes []TypeAttribute `json:"typeAttributes,omitempty"`
+}
+
+// TypeKind is the kind of a type declared by a profile.
+type TypeKind string
+
+const (
+	// TypeKindFile types label files and directories.
+	TypeKindFile TypeKind = "file"
+	// TypeKindProcess types label processes.
+	TypeKindProcess TypeKind = "process"
+	// TypeKindPort types label network ports.
+	TypeKindPort TypeKind = "port"
+)
+
+// TypeDefinition declares a type within the policy of the profile.
+type TypeDefinition struct {
+	// Name of the type, which is local to the profile.
+	// +kubebuilder:validation:Pattern=`^[a-z][a-z0-9_]*$`
+	Name string `json:"name"`
+	// Kind of the type. File and port types are assigned to the file_type
+	// and port_type attributes, process types to the domain attribute.
+	// +optional
+	// +kubebuilder:default=file
+	// +kubebuilder:validation:Enum=file;process;port
+	Kind TypeKind `json:"kind,omitempty"`
+}
+
+// FileContext labels the files matching a path.
+type FileContext struct {
+	// Path is a regular expression matching the full path of the files,
+	// for example "/var/lib/app(/.*)?".
+	Path string `json:"path"`
+	// FileType restricts the label to a type of file.
+	// +optional
+	// +kubebuilder:default=any
+	// +kubebuilder:validation:Enum=any;file;dir;symlink;pipe;socket;char;block
+	FileType string `json:"fileType,omitempty"`
+	// Type of the files, either a type of the profile or a type of the
+	// system policy.
+	Type string `json:"type"`
+}
+
+// PortContext labels a port or a range of ports.
+type PortContext struct {
+	// Protocol of the port.
+	// +kubebuilder:validation:Enum=tcp;udp;sctp;dccp
+	Protocol string `json:"protocol"`
+	// Port to be labeled.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+	// EndPort labels all ports from port to endPort if set.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	EndPort int32 `json:"endPort,omitempty"`
+	// Type of the port, either a type of the profile or a type of the
+	// system policy.
+	Type string `json:"type"`
+}
+
+// TypeTransition transitions the process into another process type on exec.
+type TypeTransition struct {
+	// Entrypoint is the type of the executed files, either a type of the
+	// profile or a type of the system policy.
+	Entrypoint string `json:"entrypoint"`
+	// Target is the process type of the profile to transition to.
+	Target string `json:"target"`
+}
+
+// TypeAttribute adds types to an attribute of the system policy.
+type TypeAttribute struct {
+	// Name of the attribute.
+	Name string `json:"name"`
+	// Types added to the attribute. Either types of the profile or @self
+	// for the process of the profile.
+	Types []string `json:"types"`
 }
 
 type LabelKey string
@@ -108,10 +210,17 @@ func SortObjectClassKeys(ock map[ObjectClassKey]PermissionSet) []ObjectClassKey
 type SelinuxProfileStatus struct {
 	// Common status fields for all profiles.
 	profilebasev1alpha1.StatusBase `json:",inline"`
+	// The workloads which are using the profile.
+	profilebasev1alpha1.WorkloadsStatus `json:",inline"`
+	// The revision of the active spec.
+	profilebasev1alpha1.RevisionStatus `json:",inline"`
 
 	// Represents the string that the SelinuxProfile object can be
 	// referenced as in a pod seLinuxOptions section.
-	Usage           string   `json:"usage,omitempty"`
+	Usage string `json:"usage,omitempty"`
+	// ActiveWorkloads contains the pods using the profile, limited to the
+	// first 100 pods in alphabetical order.
+	// Deprecated: superseded by workloads, still populated until removed.
 	ActiveWorkloads []string `json:"activeWorkloads,omitempty"`
 }
 
@@ -123,6 +232,8 @@ type SelinuxProfileStatus struct {
 // +kubebuilder:resource:path=selinuxprofiles,scope=Namespaced
 // +kubebuilder:printcolumn:name="Usage",type="string",JSONPath=`.status.usage`
 // +kubebuilder:printcolumn:name="State",type="string",JSONPath=`.status.status`
+// +kubebuilder:printcolumn:name="Workloads",type="integer",priority=10,JSONPath=`.status.workloadCount`
+// +kubebuilder:printcolumn:name="Revision",type="integer",priority=10,JSONPath=`.status.activeRevision`
 type SelinuxProfile struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -135,6 +246,14 @@ func (sp *SelinuxProfile) GetStatusBase() *profilebasev1alpha1.StatusBase {
 	return &sp.Status.StatusBase
 }
 
+func (sp *SelinuxProfile) GetWorkloadsStatus() *profilebasev1alpha1.WorkloadsStatus {
+	return &sp.Status.WorkloadsStatus
+}
+
+func (sp *SelinuxProfile) GetRevisionStatus() *profilebasev1alpha1.RevisionStatus {
+	return &sp.Status.RevisionStatus
+}
+
 func (sp *SelinuxProfile) DeepCopyToStatusBaseIf() profilebasev1alpha1.StatusBaseUser {
 	return sp.DeepCopy()
 }
@@ -171,6 +290,10 @@ func (sp *SelinuxProfile) IsDisabled() bool {
 	return profilebasev1alpha1.IsDisabled(&sp.Spec.SpecBase)
 }
 
+func (sp *SelinuxProfile) GetNodeSelector() *metav1.LabelSelector {
+	return sp.Spec.NodeSelector
+}
+
 func (sp *SelinuxProfile) IsReconcilable() bool {
 	return profilebasev1alpha1.IsReconcilable(sp)
 }
@@ -65,6 +65,95 @@ func (in Allow) DeepCopy() Allow {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterSelinuxProfile) DeepCopyInto(out *ClusterSelinuxProfile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSelinuxProfile.
+func (in *ClusterSelinuxProfile) DeepCopy() *ClusterSelinuxProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterSelinuxProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterSelinuxProfile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterSelinuxProfileList) DeepCopyInto(out *ClusterSelinuxProfileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterSelinuxProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSelinuxProfileList.
+func (in *ClusterSelinuxProfileList) DeepCopy() *ClusterSelinuxProfileList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterSelinuxProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterSelinuxProfileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileContext) DeepCopyInto(out *FileContext) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileContext.
+func (in *FileContext) DeepCopy() *FileContext {
+	if in == nil {
+		return nil
+	}
+	out := new(FileContext)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCSOwner) DeepCopyInto(out *MCSOwner) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCSOwner.
+func (in *MCSOwner) DeepCopy() *MCSOwner {
+	if in == nil {
+		return nil
+	}
+	out := new(MCSOwner)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in PermissionSet) DeepCopyInto(out *PermissionSet) {
 	{
@@ -99,12 +188,27 @@ func (in *PolicyRef) DeepCopy() *PolicyRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortContext) DeepCopyInto(out *PortContext) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortContext.
+func (in *PortContext) DeepCopy() *PortContext {
+	if in == nil {
+		return nil
+	}
+	out := new(PortContext)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RawSelinuxProfile) DeepCopyInto(out *RawSelinuxProfile) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -161,7 +265,7 @@ func (in *RawSelinuxProfileList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RawSelinuxProfileSpec) DeepCopyInto(out *RawSelinuxProfileSpec) {
 	*out = *in
-	out.SpecBase = in.SpecBase
+	in.SpecBase.DeepCopyInto(&out.SpecBase)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RawSelinuxProfileSpec.
@@ -174,6 +278,85 @@ func (in *RawSelinuxProfileSpec) DeepCopy() *RawSelinuxProfileSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SelinuxMCSAllocation) DeepCopyInto(out *SelinuxMCSAllocation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelinuxMCSAllocation.
+func (in *SelinuxMCSAllocation) DeepCopy() *SelinuxMCSAllocation {
+	if in == nil {
+		return nil
+	}
+	out := new(SelinuxMCSAllocation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SelinuxMCSAllocation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SelinuxMCSAllocationList) DeepCopyInto(out *SelinuxMCSAllocationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SelinuxMCSAllocation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelinuxMCSAllocationList.
+func (in *SelinuxMCSAllocationList) DeepCopy() *SelinuxMCSAllocationList {
+	if in == nil {
+		return nil
+	}
+	out := new(SelinuxMCSAllocationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SelinuxMCSAllocationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SelinuxMCSAllocationSpec) DeepCopyInto(out *SelinuxMCSAllocationSpec) {
+	*out = *in
+	out.Owner = in.Owner
+	if in.Categories != nil {
+		in, out := &in.Categories, &out.Categories
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelinuxMCSAllocationSpec.
+func (in *SelinuxMCSAllocationSpec) DeepCopy() *SelinuxMCSAllocationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SelinuxMCSAllocationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SelinuxProfile) DeepCopyInto(out *SelinuxProfile) {
 	*out = *in
@@ -236,7 +419,7 @@ func (in *SelinuxProfileList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SelinuxProfileSpec) DeepCopyInto(out *SelinuxProfileSpec) {
 	*out = *in
-	out.SpecBase = in.SpecBase
+	in.SpecBase.DeepCopyInto(&out.SpecBase)
 	if in.Inherit != nil {
 		in, out := &in.Inherit, &out.Inherit
 		*out = make([]PolicyRef, len(*in))
@@ -269,6 +452,33 @@ func (in *SelinuxProfileSpec) DeepCopyInto(out *SelinuxProfileSpec) {
 			(*out)[key] = outVal
 		}
 	}
+	if in.Types != nil {
+		in, out := &in.Types, &out.Types
+		*out = make([]TypeDefinition, len(*in))
+		copy(*out, *in)
+	}
+	if in.FileContexts != nil {
+		in, out := &in.FileContexts, &out.FileContexts
+		*out = make([]FileContext, len(*in))
+		copy(*out, *in)
+	}
+	if in.PortContexts != nil {
+		in, out := &in.PortContexts, &out.PortContexts
+		*out = make([]PortContext, len(*in))
+		copy(*out, *in)
+	}
+	if in.TypeTransitions != nil {
+		in, out := &in.TypeTransitions, &out.TypeTransitions
+		*out = make([]TypeTransition, len(*in))
+		copy(*out, *in)
+	}
+	if in.TypeAttributes != nil {
+		in, out := &in.TypeAttributes, &out.TypeAttributes
+		*out = make([]TypeAttribute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelinuxProfileSpec.
@@ -285,6 +495,8 @@ func (in *SelinuxProfileSpec) DeepCopy() *SelinuxProfileSpec {
 func (in *SelinuxProfileStatus) DeepCopyInto(out *SelinuxProfileStatus) {
 	*out = *in
 	in.StatusBase.DeepCopyInto(&out.StatusBase)
+	in.WorkloadsStatus.DeepCopyInto(&out.WorkloadsStatus)
+	in.RevisionStatus.DeepCopyInto(&out.RevisionStatus)
 	if in.ActiveWorkloads != nil {
 		in, out := &in.ActiveWorkloads, &out.ActiveWorkloads
 		*out = make([]string, len(*in))
@@ -301,3 +513,53 @@ func (in *SelinuxProfileStatus) DeepCopy() *SelinuxProfileStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TypeAttribute) DeepCopyInto(out *TypeAttribute) {
+	*out = *in
+	if in.Types != nil {
+		in, out := &in.Types, &out.Types
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TypeAttribute.
+func (in *TypeAttribute) DeepCopy() *TypeAttribute {
+	if in == nil {
+		return nil
+	}
+	out := new(TypeAttribute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TypeDefinition) DeepCopyInto(out *TypeDefinition) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TypeDefinition.
+func (in *TypeDefinition) DeepCopy() *TypeDefinition {
+	if in == nil {
+		return nil
+	}
+	out := new(TypeDefinition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TypeTransition) DeepCopyInto(out *TypeTransition) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TypeTransition.
+func (in *TypeTransition) DeepCopy() *TypeTransition {
+	if in == nil {
+		return nil
+	}
+	out := new(TypeTransition)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -23,12 +23,16 @@ import (
 	admissionregv1 "k8s.io/api/admissionregistration/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // Condition types.
 const (
 	// TypeReady resources are believed to be ready to handle work.
 	TypeReady = "Ready"
+	// TypeNodesUpToDate profiles are installed in their current generation
+	// on all selected nodes.
+	TypeNodesUpToDate = "NodesUpToDate"
 )
 
 // Reasons a resource is or is not ready.
@@ -41,6 +45,14 @@ const (
 	ReasonUpdating    = "Updating"
 )
 
+// Reasons the nodes of a profile are or are not up to date.
+const (
+	ReasonUpToDate        = "UpToDate"
+	ReasonStaleGeneration = "StaleGeneration"
+	ReasonNodeErrors      = "NodeErrors"
+	ReasonContentMismatch = "ContentMismatch"
+)
+
 // Equal returns true if the condition is identical to the supplied condition,
 // ignoring the LastTransitionTime.
 //
@@ -207,6 +219,59 @@ type SelinuxOptions struct {
 	// policy.
 	// +kubebuilder:default={"container"}
 	AllowedSystemProfiles []string `json:"allowedSystemProfiles,omitempty"`
+	// Backend installs the SELinux policies on the nodes. "selinuxd" runs
+	// selinuxd as a sidecar of the daemon, "semodule" installs the policies
+	// directly from the daemon and requires a daemon image which ships
+	// semodule, like the UBI based one. Daemons without semodule fall back
+	// to the selinuxd sidecar.
+	// +optional
+	// +kubebuilder:default=selinuxd
+	Backend SelinuxBackend `json:"backend,omitempty"`
+}
+
+// SelinuxBackend is the backend installing the SELinux policies on the
+// nodes.
+// +kubebuilder:validation:Enum=selinuxd;semodule
+type SelinuxBackend string
+
+const (
+	// SelinuxBackendSelinuxd installs the policies through selinuxd.
+	SelinuxBackendSelinuxd SelinuxBackend = "selinuxd"
+	// SelinuxBackendSemodule installs the policies with semodule.
+	SelinuxBackendSemodule SelinuxBackend = "semodule"
+)
+
+// BpfRecorderOptions defines options specific to the eBPF based profile
+// recorder of the SecurityProfilesOperator.
+type BpfRecorderOptions struct {
+	// MaxMountNamespaces is the maximum number of mount namespaces, which
+	// roughly corresponds to containers, the recorder tracks syscalls for at
+	// the same time. Defaults to 8192.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxMountNamespaces *uint32 `json:"maxMountNamespaces,omitempty"`
+	// MaxProcesses is the maximum number of running processes the recorder
+	// tracks at the same time. Defaults to 8192.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxProcesses *uint32 `json:"maxProcesses,omitempty"`
+}
+
+// ProfileRolloutStrategy configures the staged rollout of profile updates
+// across the nodes.
+type ProfileRolloutStrategy struct {
+	// Canary is the number or percentage of nodes which install profile
+	// updates first. Percentages get rounded up to at least one node.
+	// +optional
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:XIntOrString
+	Canary intstr.IntOrString `json:"canary,omitempty"`
+	// SoakDuration is the time the update has to run on the canary nodes
+	// without errors or new violations observed by the log enricher, before
+	// it gets installed on the remaining nodes.
+	// +optional
+	// +kubebuilder:default="10m"
+	SoakDuration metav1.Duration `json:"soakDuration,omitempty"`
 }
 
 type WebhookOptions struct {
@@ -248,9 +313,18 @@ type SPODSpec struct {
 	// tells the operator whether or not to enable bpf recorder support for this
 	// SPOD instance.
 	EnableBpfRecorder bool `json:"enableBpfRecorder,omitempty"`
+	// Defines options specific to the eBPF based profile recorder.
+	// +optional
+	BpfRecorderOpts *BpfRecorderOptions `json:"bpfRecorderOptions,omitempty"`
 	// tells the operator whether or not to enable AppArmor support for this
 	// SPOD instance.
 	EnableAppArmor bool `json:"enableAppArmor,omitempty"`
+	// tells the operator whether or not to run the seccomp notify agent in
+	// the daemon. Seccomp profiles using the SCMP_ACT_NOTIFY action pass their
+	// notifications to it by setting the listenerPath to the socket
+	// "seccomp-notifier.sock" in the operator profiles directory, which is
+	// "/var/lib/kubelet/seccomp/operator" by default.
+	EnableSeccompNotifier bool `json:"enableSeccompNotifier,omitempty"`
 	// If specified, the SPOD's tolerations.
 	// +optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
@@ -278,6 +352,13 @@ type SPODSpec struct {
 	// AllowedSeccompActions if specified, a list of allowed seccomp actions.
 	// +optional
 	AllowedSeccompActions []seccomp.Action `json:"allowedSeccompActions"`
+	// SeccompFilterThreshold if specified, the number of BPF instructions a
+	// filter compiled from a seccomp profile may have before the profile
+	// gets flagged. Defaults to 4096, the maximum size of a BPF program
+	// accepted by the kernel.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	SeccompFilterThreshold *uint32 `json:"seccompFilterThreshold,omitempty"`
 	// Affinity if specified, the SPOD's affinity.
 	// +optional
 	Affinity *corev1.Affinity `json:"affinity,omitempty"`
@@ -300,6 +381,21 @@ type SPODSpec struct {
 	// artifact signature verification.
 	// +optional
 	DisableOCIArtifactSignatureVerification bool `json:"disableOciArtifactSignatureVerification"`
+
+	// ProfileRollout enables the staged rollout of profile updates. The
+	// updates get installed on the canary nodes first and on the remaining
+	// nodes after the soak duration passed. Updates are installed on all
+	// nodes at once if unset.
+	// +optional
+	ProfileRollout *ProfileRolloutStrategy `json:"profileRollout,omitempty"`
+
+	// NamespaceSelector restricts the namespaces in which the operator
+	// manages profiles, bindings and recordings to the ones matching the
+	// selector. Changes to the selector or to the namespace labels are
+	// applied without restarting the operator. All namespaces are managed
+	// if unset.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
 }
 
 // SPODState defines the state that the spod is in.
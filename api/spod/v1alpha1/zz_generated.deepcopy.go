@@ -28,6 +28,31 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BpfRecorderOptions) DeepCopyInto(out *BpfRecorderOptions) {
+	*out = *in
+	if in.MaxMountNamespaces != nil {
+		in, out := &in.MaxMountNamespaces, &out.MaxMountNamespaces
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaxProcesses != nil {
+		in, out := &in.MaxProcesses, &out.MaxProcesses
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BpfRecorderOptions.
+func (in *BpfRecorderOptions) DeepCopy() *BpfRecorderOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(BpfRecorderOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConditionedStatus) DeepCopyInto(out *ConditionedStatus) {
 	*out = *in
@@ -50,6 +75,23 @@ func (in *ConditionedStatus) DeepCopy() *ConditionedStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfileRolloutStrategy) DeepCopyInto(out *ProfileRolloutStrategy) {
+	*out = *in
+	out.Canary = in.Canary
+	out.SoakDuration = in.SoakDuration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileRolloutStrategy.
+func (in *ProfileRolloutStrategy) DeepCopy() *ProfileRolloutStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfileRolloutStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SPODSpec) DeepCopyInto(out *SPODSpec) {
 	*out = *in
@@ -58,6 +100,11 @@ func (in *SPODSpec) DeepCopyInto(out *SPODSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.BpfRecorderOpts != nil {
+		in, out := &in.BpfRecorderOpts, &out.BpfRecorderOpts
+		*out = new(BpfRecorderOptions)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Tolerations != nil {
 		in, out := &in.Tolerations, &out.Tolerations
 		*out = make([]corev1.Toleration, len(*in))
@@ -83,6 +130,11 @@ func (in *SPODSpec) DeepCopyInto(out *SPODSpec) {
 		*out = make([]seccomp.Action, len(*in))
 		copy(*out, *in)
 	}
+	if in.SeccompFilterThreshold != nil {
+		in, out := &in.SeccompFilterThreshold, &out.SeccompFilterThreshold
+		*out = new(uint32)
+		**out = **in
+	}
 	if in.Affinity != nil {
 		in, out := &in.Affinity, &out.Affinity
 		*out = new(corev1.Affinity)
@@ -98,6 +150,16 @@ func (in *SPODSpec) DeepCopyInto(out *SPODSpec) {
 		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ProfileRollout != nil {
+		in, out := &in.ProfileRollout, &out.ProfileRollout
+		*out = new(ProfileRolloutStrategy)
+		**out = **in
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SPODSpec.
@@ -44,6 +44,7 @@ import (
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
 	profilebindingv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
 	profilerecording1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
 	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
@@ -55,12 +56,17 @@ import (
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/controller"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/apparmorprofile"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/bpfrecorder"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/common"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/profilepatcher"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/profilerecorder"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/seccompnotifier"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/seccompprofile"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/selinuxprofile"
 	nodestatus "sigs.k8s.io/security-profiles-operator/internal/pkg/manager/nodestatus"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/patchaggregator"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/profilerevision"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/recordingmerger"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/spod"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/spod/bindata"
@@ -73,14 +79,16 @@ import (
 )
 
 const (
-	spocCmd            string = "spoc"
-	jsonFlag           string = "json"
-	recordingFlag      string = "with-recording"
-	selinuxFlag        string = "with-selinux"
-	apparmorFlag       string = "with-apparmor"
-	webhookFlag        string = "webhook"
-	memOptimFlag       string = "with-mem-optim"
-	defaultWebhookPort int    = 9443
+	spocCmd             string = "spoc"
+	jsonFlag            string = "json"
+	recordingFlag       string = "with-recording"
+	selinuxFlag         string = "with-selinux"
+	selinuxBackendFlag  string = "selinux-backend"
+	apparmorFlag        string = "with-apparmor"
+	seccompNotifierFlag string = "with-seccomp-notifier"
+	webhookFlag         string = "webhook"
+	memOptimFlag        string = "with-mem-optim"
+	defaultWebhookPort  int    = 9443
 )
 
 var (
@@ -127,6 +135,11 @@ func main() {
 					Usage: "Listen for SELinux API resources",
 					Value: false,
 				},
+				&cli.StringFlag{
+					Name:  selinuxBackendFlag,
+					Usage: "The backend installing the SELinux policies (values: selinuxd, semodule)",
+					Value: string(spodv1alpha1.SelinuxBackendSelinuxd),
+				},
 				&cli.BoolFlag{
 					Name:  apparmorFlag,
 					Usage: "Listen for AppArmor API resources",
@@ -138,6 +151,11 @@ func main() {
 					Value:   false,
 					EnvVars: []string{config.EnableRecordingEnvKey},
 				},
+				&cli.BoolFlag{
+					Name:  seccompNotifierFlag,
+					Usage: "Run the seccomp notify agent for profiles using SCMP_ACT_NOTIFY",
+					Value: false,
+				},
 				&cli.BoolFlag{
 					Name:  memOptimFlag,
 					Usage: "Enable memory optimization by watching only labeled pods",
@@ -332,6 +350,9 @@ func runManager(ctx *cli.Context, info *version.Info) error {
 	if err := selxv1alpha2.AddToScheme(mgr.GetScheme()); err != nil {
 		return fmt.Errorf("add selinuxprofile API to scheme: %w", err)
 	}
+	if err := apparmorprofileapi.AddToScheme(mgr.GetScheme()); err != nil {
+		return fmt.Errorf("add apparmorprofile API to scheme: %w", err)
+	}
 	if err := monitoringv1.AddToScheme(mgr.GetScheme()); err != nil {
 		return fmt.Errorf("add ServiceMonitor API to scheme: %w", err)
 	}
@@ -343,6 +364,11 @@ func runManager(ctx *cli.Context, info *version.Info) error {
 			spod.NewController(),
 			workloadannotator.NewController(),
 			recordingmerger.NewController(),
+			profilerevision.NewSeccompController(),
+			profilerevision.NewSelinuxController(),
+			patchaggregator.NewSeccompController(),
+			patchaggregator.NewSelinuxController(),
+			patchaggregator.NewAppArmorController(),
 		}, mgr, nil); err != nil {
 		return fmt.Errorf("enable controllers: %w", err)
 	}
@@ -357,6 +383,10 @@ func runManager(ctx *cli.Context, info *version.Info) error {
 }
 
 func setControllerOptionsForNamespaces(opts *ctrl.Options) {
+	// The namespaces get further restricted by the namespace selector of the
+	// SPOD instance, which may change at runtime.
+	opts.NewCache = common.NewNamespaceScopedCache(opts.NewCache)
+
 	namespace, ok := os.LookupEnv(config.RestrictNamespaceEnvKey)
 	if !ok {
 		namespace = os.Getenv("WATCH_NAMESPACE")
@@ -392,9 +422,11 @@ func setControllerOptionsForNamespaces(opts *ctrl.Options) {
 	}
 }
 
-func getEnabledControllers(ctx *cli.Context) []controller.Controller {
+func getEnabledControllers(ctx *cli.Context) ([]controller.Controller, error) {
 	controllers := []controller.Controller{
 		seccompprofile.NewController(),
+		seccompprofile.NewClusterController(),
+		profilepatcher.NewSeccompController(),
 	}
 
 	if ctx.Bool(recordingFlag) {
@@ -402,16 +434,29 @@ func getEnabledControllers(ctx *cli.Context) []controller.Controller {
 	}
 
 	if ctx.Bool(selinuxFlag) {
+		backend, err := selinuxprofile.NewPolicyBackend(
+			spodv1alpha1.SelinuxBackend(ctx.String(selinuxBackendFlag)),
+			ctrl.Log.WithName("selinux-policy-backend"),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("create SELinux policy backend: %w", err)
+		}
 		controllers = append(controllers,
-			selinuxprofile.NewController(),
-			selinuxprofile.NewRawController())
+			selinuxprofile.NewController(backend),
+			selinuxprofile.NewClusterController(backend),
+			selinuxprofile.NewRawController(backend),
+			profilepatcher.NewSelinuxController())
 	}
 
 	if ctx.Bool(apparmorFlag) {
-		controllers = append(controllers, apparmorprofile.NewController())
+		controllers = append(controllers,
+			apparmorprofile.NewController(),
+			apparmorprofile.NewClusterController(),
+			apparmorprofile.NewIncludeController(),
+			profilepatcher.NewAppArmorController())
 	}
 
-	return controllers
+	return controllers, nil
 }
 
 // newMemoryOptimizedCache creates a memory optimized cache for daemon controller.
@@ -438,7 +483,10 @@ func runDaemon(ctx *cli.Context, info *version.Info) error {
 	// security-profiles-operator-daemon
 	printInfo("spod", info)
 
-	enabledControllers := getEnabledControllers(ctx)
+	enabledControllers, err := getEnabledControllers(ctx)
+	if err != nil {
+		return err
+	}
 	if len(enabledControllers) == 0 {
 		return errors.New("no controllers enabled")
 	}
@@ -484,11 +532,22 @@ func runDaemon(ctx *cli.Context, info *version.Info) error {
 	if err := spodv1alpha1.AddToScheme(mgr.GetScheme()); err != nil {
 		return fmt.Errorf("add SPOD config API to scheme: %w", err)
 	}
+	// Required for the impact analysis of proposed profile specs
+	if err := profilebindingv1alpha1.AddToScheme(mgr.GetScheme()); err != nil {
+		return fmt.Errorf("add profilebinding API to scheme: %w", err)
+	}
 
 	if err := setupEnabledControllers(ctx.Context, enabledControllers, mgr, met); err != nil {
 		return fmt.Errorf("enable controllers: %w", err)
 	}
 
+	if ctx.Bool(seccompNotifierFlag) {
+		notifier := seccompnotifier.New(ctrl.Log.WithName("seccomp-notifier"), met)
+		if err := mgr.Add(notifier); err != nil {
+			return fmt.Errorf("add seccomp notifier: %w", err)
+		}
+	}
+
 	setupLog.Info("starting daemon")
 	if err := mgr.Start(sigHandler); err != nil {
 		return fmt.Errorf("SPOd error: %w", err)
@@ -568,7 +627,7 @@ func runWebhook(ctx *cli.Context, info *version.Info) error {
 
 	setupLog.Info("registering webhooks")
 	hookserver := mgr.GetWebhookServer()
-	binding.RegisterWebhook(hookserver, mgr.GetScheme(), mgr.GetClient())
+	binding.RegisterWebhook(hookserver, mgr.GetScheme(), mgr.GetClient(), mgr.GetAPIReader())
 	recording.RegisterWebhook(hookserver, mgr.GetScheme(), mgr.GetEventRecorderFor("recording-webhook"), mgr.GetClient())
 
 	sigHandler := ctrl.SetupSignalHandler()
@@ -26,6 +26,8 @@ import (
 
 	"sigs.k8s.io/security-profiles-operator/cmd"
 	spocli "sigs.k8s.io/security-profiles-operator/internal/pkg/cli"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/generator"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/importer"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/puller"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/pusher"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/recorder"
@@ -169,6 +171,78 @@ func main() {
 				},
 			},
 		},
+		&cli.Command{
+			Name:      "generate",
+			Aliases:   []string{"g"},
+			Usage:     "generate a SELinux profile from a workload or container spec",
+			Action:    generate,
+			ArgsUsage: "FILE",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:        generator.FlagOutputFile,
+					Aliases:     []string{"o"},
+					Usage:       "the output file path for the generated profile",
+					DefaultText: generator.DefaultOutputFile,
+					TakesFile:   true,
+				},
+				&cli.StringFlag{
+					Name:        generator.FlagName,
+					Aliases:     []string{"n"},
+					Usage:       "the name of the generated profile",
+					DefaultText: "the name of the workload or container",
+				},
+				&cli.StringSliceFlag{
+					Name:    generator.FlagContainers,
+					Aliases: []string{"c"},
+					Usage:   "the containers to be taken into account, all containers if unset",
+				},
+				&cli.StringSliceFlag{
+					Name:    generator.FlagFileContexts,
+					Aliases: []string{"f"},
+					Usage:   "the SELinux type of host paths in `PATH:TYPE` format, overriding the defaults",
+				},
+				&cli.BoolFlag{
+					Name:  generator.FlagCIL,
+					Usage: "write the CIL policy instead of the SelinuxProfile",
+				},
+			},
+		},
+		&cli.Command{
+			Name:      "import",
+			Aliases:   []string{"i"},
+			Usage:     "import a CIL or type enforcement policy into a SELinux profile",
+			Action:    importPolicy,
+			ArgsUsage: "FILE",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:        importer.FlagOutputFile,
+					Aliases:     []string{"o"},
+					Usage:       "the output file path for the imported profile",
+					DefaultText: importer.DefaultOutputFile,
+					TakesFile:   true,
+				},
+				&cli.StringFlag{
+					Name:        importer.FlagName,
+					Aliases:     []string{"n"},
+					Usage:       "the name of the imported profile",
+					DefaultText: "the name of the CIL block or module",
+				},
+				&cli.StringFlag{
+					Name:  importer.FlagNamespace,
+					Usage: "the namespace of the imported profile",
+				},
+				&cli.StringFlag{
+					Name:        importer.FlagFormat,
+					Aliases:     []string{"f"},
+					Usage:       fmt.Sprintf("the format of the policy, either %q or %q", importer.FormatCIL, importer.FormatTE),
+					DefaultText: "te for .te files, cil otherwise",
+				},
+				&cli.BoolFlag{
+					Name:  importer.FlagStrict,
+					Usage: "fail if the policy contains statements which cannot be represented by the profile",
+				},
+			},
+		},
 	)
 
 	if err := app.Run(os.Args); err != nil {
@@ -231,3 +305,31 @@ func pull(ctx *cli.Context) error {
 
 	return nil
 }
+
+// generate runs the `spoc generate` subcommand.
+func generate(ctx *cli.Context) error {
+	options, err := generator.FromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("build options: %w", err)
+	}
+
+	if err := generator.New(options).Run(); err != nil {
+		return fmt.Errorf("run generator: %w", err)
+	}
+
+	return nil
+}
+
+// importPolicy runs the `spoc import` subcommand.
+func importPolicy(ctx *cli.Context) error {
+	options, err := importer.FromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("build options: %w", err)
+	}
+
+	if err := importer.New(options).Run(); err != nil {
+		return fmt.Errorf("run importer: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,451 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package apparmorpolicy parses the subset of the AppArmor policy grammar
+// used by AppArmorProfiles to validate policies before loading them.
+package apparmorpolicy
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	// ErrSyntax is returned if the policy cannot be parsed.
+	ErrSyntax = errors.New("invalid AppArmor policy")
+
+	// ErrNoProfile is returned if the policy does not declare a profile.
+	ErrNoProfile = errors.New("policy does not declare a profile")
+
+	// ErrProfileNameMismatch is returned if the declared profile does not
+	// match the name of the custom resource.
+	ErrProfileNameMismatch = errors.New("declared profile name does not match the AppArmorProfile name")
+
+	// ErrMultipleProfiles is returned if the policy declares more than one
+	// top-level profile.
+	ErrMultipleProfiles = errors.New("policy declares more than one top-level profile")
+
+	// ErrDuplicateHat is returned if a profile declares a hat twice.
+	ErrDuplicateHat = errors.New("duplicate hat")
+
+	// ErrUnknownInclude is returned if the policy includes a file which is
+	// not known to be available on the nodes.
+	ErrUnknownInclude = errors.New("unknown include")
+)
+
+var (
+	flagsRegex = regexp.MustCompile(`\s*flags\s*=\s*\(([^)]*)\)`)
+
+	variableRegex = regexp.MustCompile(`^@\{\w+\}\s*\+?=`)
+
+	includeRegex = regexp.MustCompile(`^#?include(\s+if\s+exists)?\s+(<[^>]*>|"[^"]*")$`)
+
+	// permissionsRegex matches the permissions of file rules which are
+	// written in front of the path, for example "rix /usr/bin/foo,".
+	permissionsRegex = regexp.MustCompile(`^[rwaklmixpucPUCI]+$`)
+
+	// ruleQualifiers may precede the keyword of a rule.
+	ruleQualifiers = map[string]bool{
+		"audit": true, "allow": true, "deny": true, "owner": true, "other": true,
+		"quiet": true,
+	}
+
+	// ruleKeywords are the keywords a rule may start with, besides a path.
+	ruleKeywords = map[string]bool{
+		"abi": true, "all": true, "capability": true, "change_profile": true,
+		"dbus": true, "file": true, "io_uring": true, "link": true,
+		"mount": true, "mqueue": true, "network": true, "pivot_root": true,
+		"ptrace": true, "remount": true, "set": true, "signal": true,
+		"umount": true, "unix": true, "userns": true,
+	}
+)
+
+// Policy is the parsed representation of an AppArmor policy.
+type Policy struct {
+	// Profiles are the top-level profiles of the policy.
+	Profiles []*Profile
+
+	// Includes are the includes outside of the profiles.
+	Includes []Include
+}
+
+// Profile is a profile, child profile or hat of a policy.
+type Profile struct {
+	Name string
+	// Attachment is the executable path the profile attaches to, if it
+	// differs from the name.
+	Attachment string
+	Flags      []string
+	Line       int
+
+	// HeaderStart and HeaderEnd are the byte offsets of the profile header
+	// in the policy, without the opening brace and trailing comments.
+	HeaderStart int
+	HeaderEnd   int
+
+	// Hats are the hats declared with "^name" or "hat name".
+	Hats []*Profile
+	// Children are the child profiles declared with "profile name".
+	Children []*Profile
+	Includes []Include
+}
+
+// Include is an include statement of a policy.
+type Include struct {
+	// Path is the included path without the surrounding brackets or quotes.
+	Path string
+	// System is true for includes of the form "<path>", which are resolved
+	// relative to the AppArmor policy directory.
+	System bool
+	// IfExists is true for "include if exists" statements.
+	IfExists bool
+	Line     int
+}
+
+// AllIncludes returns the includes of the policy and of all of its profiles.
+func (p *Policy) AllIncludes() []Include {
+	res := append([]Include{}, p.Includes...)
+	var walk func(*Profile)
+	walk = func(profile *Profile) {
+		res = append(res, profile.Includes...)
+		for _, hat := range profile.Hats {
+			walk(hat)
+		}
+		for _, child := range profile.Children {
+			walk(child)
+		}
+	}
+	for _, profile := range p.Profiles {
+		walk(profile)
+	}
+	return res
+}
+
+// block is a block of the policy which is currently parsed.
+type block struct {
+	// profile is the profile of the block, which is nil for the top-level
+	// and inherited for conditional blocks.
+	profile *Profile
+	line    int
+}
+
+// header is the header of a block together with its position in the
+// policy.
+type header struct {
+	text       string
+	line       int
+	start, end int
+}
+
+type parser struct {
+	policy *Policy
+	stack  []block
+	errs   []error
+}
+
+// Parse parses the policy. It returns an error wrapping ErrSyntax for every
+// statement which cannot be parsed.
+func Parse(policy string) (*Policy, error) {
+	p := &parser{policy: &Policy{}, stack: []block{{}}}
+
+	var (
+		stmt      strings.Builder
+		stmtLine  int
+		stmtStart int
+		stmtEnd   int
+		inQuote   bool
+		parens    int
+		alternate int
+		offset    int
+	)
+	lines := strings.Split(policy, "\n")
+	for i, line := range lines {
+		lineNo := i + 1
+		for j := 0; j < len(line); j++ {
+			c := line[j]
+			space := c == ' ' || c == '\t' || c == '\r'
+			if stmt.Len() == 0 && space {
+				continue
+			}
+			if stmt.Len() == 0 {
+				stmtLine = lineNo
+				stmtStart = offset + j
+			}
+
+			switch {
+			case inQuote:
+				if c == '"' {
+					inQuote = false
+				}
+			case c == '"':
+				inQuote = true
+			case c == '#':
+				if stmt.Len() == 0 && strings.HasPrefix(line[j:], "#include") {
+					break
+				}
+				j = len(line)
+				continue
+			case c == '(':
+				parens++
+			case c == ')':
+				parens--
+			case c == '{' && parens == 0 && opensBlock(stmt.String(), line[j+1:], alternate):
+				p.openBlock(header{
+					text:  strings.TrimSpace(stmt.String()),
+					line:  stmtLine,
+					start: stmtStart,
+					end:   stmtEnd,
+				})
+				stmt.Reset()
+				continue
+			case c == '{':
+				alternate++
+			case c == '}' && alternate > 0:
+				alternate--
+			case c == '}':
+				if s := strings.TrimSpace(stmt.String()); s != "" {
+					p.errorf(stmtLine, "missing comma after %q", s)
+					stmt.Reset()
+				}
+				p.closeBlock(lineNo)
+				continue
+			case c == ',' && parens == 0 && alternate == 0:
+				p.statement(strings.TrimSpace(stmt.String()), stmtLine)
+				stmt.Reset()
+				continue
+			}
+			stmt.WriteByte(c)
+			if !space {
+				stmtEnd = offset + j + 1
+			}
+		}
+		offset += len(line) + 1
+
+		// Includes and variable assignments are terminated by the end of the
+		// line instead of a comma.
+		if s := strings.TrimSpace(stmt.String()); !inQuote && parens == 0 && alternate == 0 &&
+			(isInclude(s) || variableRegex.MatchString(s)) {
+			p.statement(s, stmtLine)
+			stmt.Reset()
+		} else if stmt.Len() > 0 {
+			stmt.WriteByte(' ')
+		}
+	}
+
+	if s := strings.TrimSpace(stmt.String()); s != "" {
+		p.errorf(stmtLine, "unterminated statement %q", s)
+	}
+	for len(p.stack) > 1 {
+		p.errorf(p.stack[len(p.stack)-1].line, "unclosed block")
+		p.stack = p.stack[:len(p.stack)-1]
+	}
+
+	if len(p.errs) > 0 {
+		return nil, errors.Join(p.errs...)
+	}
+	return p.policy, nil
+}
+
+// restIsEmpty returns true if the rest of a line contains only whitespace
+// or a comment.
+func restIsEmpty(rest string) bool {
+	rest = strings.TrimSpace(rest)
+	return rest == "" || strings.HasPrefix(rest, "#")
+}
+
+// opensBlock returns true if a brace following the statement opens a block
+// instead of an alternation like /usr/{bin,sbin}/foo. Besides braces ending
+// the line, braces separated by whitespace from the header and the rest of
+// the line open a block, as in "profile foo { /etc/passwd r, }".
+func opensBlock(stmt, rest string, alternate int) bool {
+	if restIsEmpty(rest) {
+		return true
+	}
+	if alternate > 0 || strings.TrimSpace(stmt) == "" {
+		return false
+	}
+	last := stmt[len(stmt)-1]
+	return (last == ' ' || last == '\t' || last == ')' || last == '"') &&
+		(rest[0] == ' ' || rest[0] == '\t')
+}
+
+func isInclude(stmt string) bool {
+	return strings.HasPrefix(stmt, "include ") || strings.HasPrefix(stmt, "#include ")
+}
+
+func (p *parser) errorf(line int, format string, args ...any) {
+	p.errs = append(p.errs, fmt.Errorf("%w: line %d: %s", ErrSyntax, line, fmt.Sprintf(format, args...)))
+}
+
+func (p *parser) current() *Profile {
+	return p.stack[len(p.stack)-1].profile
+}
+
+func (p *parser) openBlock(h header) {
+	parent := p.current()
+	line := h.line
+	fields := splitHeader(flagsRegex.ReplaceAllString(h.text, ""))
+	if len(fields) == 0 {
+		p.errorf(line, "block without header")
+		p.stack = append(p.stack, block{profile: parent, line: line})
+		return
+	}
+
+	// Conditional blocks belong to the surrounding profile
+	if fields[0] == "if" || fields[0] == "else" {
+		if parent == nil {
+			p.errorf(line, "conditional block outside of a profile")
+		}
+		p.stack = append(p.stack, block{profile: parent, line: line})
+		return
+	}
+
+	profile := &Profile{Line: line, HeaderStart: h.start, HeaderEnd: h.end}
+	if m := flagsRegex.FindStringSubmatch(h.text); m != nil {
+		profile.Flags = strings.FieldsFunc(m[1], func(r rune) bool {
+			return r == ',' || r == ' ' || r == '\t'
+		})
+	}
+
+	hat := false
+	switch {
+	case fields[0] == "profile" && len(fields) >= 2 && len(fields) <= 3:
+		profile.Name = fields[1]
+		if len(fields) == 3 {
+			profile.Attachment = fields[2]
+		}
+	case fields[0] == "hat" && len(fields) == 2:
+		profile.Name = fields[1]
+		hat = true
+	case strings.HasPrefix(fields[0], "^") && len(fields) == 1:
+		profile.Name = strings.TrimPrefix(fields[0], "^")
+		hat = true
+	case isPath(fields[0]) && len(fields) == 1:
+		profile.Name = fields[0]
+	default:
+		p.errorf(line, "invalid profile header %q", h.text)
+	}
+	profile.Name = strings.Trim(profile.Name, `"`)
+	profile.Attachment = strings.Trim(profile.Attachment, `"`)
+
+	switch {
+	case parent == nil && hat:
+		p.errorf(line, "hat %q outside of a profile", profile.Name)
+	case parent == nil:
+		p.policy.Profiles = append(p.policy.Profiles, profile)
+	case hat:
+		parent.Hats = append(parent.Hats, profile)
+	default:
+		parent.Children = append(parent.Children, profile)
+	}
+	p.stack = append(p.stack, block{profile: profile, line: line})
+}
+
+// splitHeader splits a block header at whitespace outside of quotes, so that
+// quoted names like "foo bar" stay a single field.
+func splitHeader(header string) []string {
+	var (
+		fields  []string
+		field   strings.Builder
+		inQuote bool
+	)
+	for i := 0; i < len(header); i++ {
+		c := header[i]
+		switch {
+		case c == '"':
+			inQuote = !inQuote
+		case !inQuote && (c == ' ' || c == '\t'):
+			if field.Len() > 0 {
+				fields = append(fields, field.String())
+				field.Reset()
+			}
+			continue
+		}
+		field.WriteByte(c)
+	}
+	if field.Len() > 0 {
+		fields = append(fields, field.String())
+	}
+	return fields
+}
+
+func (p *parser) closeBlock(line int) {
+	if len(p.stack) == 1 {
+		p.errorf(line, "unexpected closing brace")
+		return
+	}
+	p.stack = p.stack[:len(p.stack)-1]
+}
+
+// statement handles an include, variable assignment or rule.
+func (p *parser) statement(stmt string, line int) {
+	if stmt == "" {
+		return
+	}
+	profile := p.current()
+
+	if isInclude(stmt) {
+		m := includeRegex.FindStringSubmatch(stmt)
+		if m == nil {
+			p.errorf(line, "invalid include %q", stmt)
+			return
+		}
+		include := Include{
+			Path:     m[2][1 : len(m[2])-1],
+			System:   strings.HasPrefix(m[2], "<"),
+			IfExists: m[1] != "",
+			Line:     line,
+		}
+		if profile == nil {
+			p.policy.Includes = append(p.policy.Includes, include)
+		} else {
+			profile.Includes = append(profile.Includes, include)
+		}
+		return
+	}
+
+	fields := strings.Fields(stmt)
+	switch {
+	case variableRegex.MatchString(stmt):
+		if profile != nil {
+			p.errorf(line, "variable assignment inside of a profile")
+		}
+	case fields[0] == "abi" || fields[0] == "alias":
+		if profile != nil && fields[0] == "alias" {
+			p.errorf(line, "alias inside of a profile")
+		}
+	case profile == nil:
+		p.errorf(line, "rule outside of a profile: %q", stmt)
+	default:
+		for len(fields) > 1 && ruleQualifiers[fields[0]] {
+			fields = fields[1:]
+		}
+		if !ruleKeywords[fields[0]] && !isPath(fields[0]) && !ruleQualifiers[fields[0]] &&
+			!permissionsRegex.MatchString(fields[0]) {
+			p.errorf(line, "unknown rule %q", stmt)
+		}
+	}
+}
+
+// isPath returns true if the token is a path, which may start with a
+// variable, an alternation or a quote.
+func isPath(token string) bool {
+	token = strings.TrimPrefix(token, `"`)
+	return strings.HasPrefix(token, "/") || strings.HasPrefix(token, "@{") ||
+		strings.HasPrefix(token, "{") || strings.HasPrefix(token, "**")
+}
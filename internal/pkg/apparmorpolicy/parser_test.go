@@ -0,0 +1,315 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apparmorpolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testPolicy = `abi <abi/3.0>,
+#include <tunables/global>
+
+@{APP_DIRS}={/opt/app,/srv/app}
+
+profile app /usr/bin/{app,app-server} flags=(attach_disconnected, mediate_deleted) {
+  #include <abstractions/base>
+  include if exists <local/app>
+
+  file,
+  owner @{APP_DIRS}/** rw,
+  deny /etc/{shadow,gshadow} r, # no secrets
+  rix /usr/bin/helper,
+  dbus send
+       bus=session
+       member=Notify,
+
+  if defined @{X} {
+    network inet tcp,
+  }
+
+  ^worker {
+    signal (receive) peer=app,
+    /var/lib/app/** r,
+  }
+
+  profile helper {
+    capability net_bind_service,
+  }
+}
+`
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	policy, err := Parse(testPolicy)
+	require.NoError(t, err)
+
+	require.Equal(t, []Include{{Path: "tunables/global", System: true, Line: 2}}, policy.Includes)
+	require.Len(t, policy.Profiles, 1)
+
+	profile := policy.Profiles[0]
+	require.Equal(t, "app", profile.Name)
+	require.Equal(t, "/usr/bin/{app,app-server}", profile.Attachment)
+	require.Equal(t, []string{"attach_disconnected", "mediate_deleted"}, profile.Flags)
+	require.Equal(t, 6, profile.Line)
+	require.Equal(t, []Include{
+		{Path: "abstractions/base", System: true, Line: 7},
+		{Path: "local/app", System: true, IfExists: true, Line: 8},
+	}, profile.Includes)
+
+	require.Len(t, profile.Hats, 1)
+	require.Equal(t, "worker", profile.Hats[0].Name)
+	require.Equal(t, 22, profile.Hats[0].Line)
+	require.Len(t, profile.Children, 1)
+	require.Equal(t, "helper", profile.Children[0].Name)
+
+	require.Len(t, policy.AllIncludes(), 3)
+}
+
+func TestParseHeaders(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name           string
+		policy         string
+		wantName       string
+		wantAttachment string
+		wantChildren   int
+		wantHeader     string
+	}{
+		{
+			name:       "one-liner",
+			policy:     "profile foo { /etc/passwd r, }\n",
+			wantName:   "foo",
+			wantHeader: "profile foo",
+		},
+		{
+			name:       "one-liner with flags",
+			policy:     "profile foo flags=(complain) { /usr/{bin,sbin}/foo rix, }",
+			wantName:   "foo",
+			wantHeader: "profile foo flags=(complain)",
+		},
+		{
+			name:         "nested one-liner",
+			policy:       "profile foo {\n  profile bar { file, }\n}\n",
+			wantName:     "foo",
+			wantChildren: 1,
+			wantHeader:   "profile foo",
+		},
+		{
+			name:       "brace on next line with comment",
+			policy:     "  profile foo # comment\n{\n  file,\n}\n",
+			wantName:   "foo",
+			wantHeader: "profile foo",
+		},
+		{
+			name:       "quoted name",
+			policy:     "profile \"foo bar\" {\n  file,\n}\n",
+			wantName:   "foo bar",
+			wantHeader: "profile \"foo bar\"",
+		},
+		{
+			name:           "quoted name and attachment",
+			policy:         "profile \"foo bar\" \"/opt/my app/bin\" { file, }\n",
+			wantName:       "foo bar",
+			wantAttachment: "/opt/my app/bin",
+			wantHeader:     "profile \"foo bar\" \"/opt/my app/bin\"",
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			policy, err := Parse(tc.policy)
+			require.NoError(t, err)
+			require.Len(t, policy.Profiles, 1)
+			require.Equal(t, tc.wantName, policy.Profiles[0].Name)
+			require.Equal(t, tc.wantAttachment, policy.Profiles[0].Attachment)
+			require.Len(t, policy.Profiles[0].Children, tc.wantChildren)
+			profile := policy.Profiles[0]
+			require.Equal(t, tc.wantHeader, tc.policy[profile.HeaderStart:profile.HeaderEnd])
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name    string
+		policy  string
+		wantErr string
+	}{
+		{
+			name:    "missing comma",
+			policy:  "profile app {\n  file,\n  deny /** w\n}\n",
+			wantErr: `line 3: missing comma after "deny /** w"`,
+		},
+		{
+			name:    "unclosed block",
+			policy:  "profile app {\n  file,\n",
+			wantErr: "line 1: unclosed block",
+		},
+		{
+			name:    "unexpected closing brace",
+			policy:  "profile app {\n}\n}\n",
+			wantErr: "line 3: unexpected closing brace",
+		},
+		{
+			name:    "rule outside of profile",
+			policy:  "file,\nprofile app {\n}\n",
+			wantErr: `line 1: rule outside of a profile: "file"`,
+		},
+		{
+			name:    "unknown rule",
+			policy:  "profile app {\n  capabilty sys_admin,\n}\n",
+			wantErr: `line 2: unknown rule "capabilty sys_admin"`,
+		},
+		{
+			name:    "invalid header",
+			policy:  "profile app /usr/bin/app extra {\n}\n",
+			wantErr: `line 1: invalid profile header "profile app /usr/bin/app extra"`,
+		},
+		{
+			name:    "hat outside of profile",
+			policy:  "^worker {\n}\n",
+			wantErr: `line 1: hat "worker" outside of a profile`,
+		},
+		{
+			name:    "invalid include",
+			policy:  "include <tunables/global\nprofile app {\n}\n",
+			wantErr: `line 1: invalid include "include <tunables/global"`,
+		},
+		{
+			name:    "unterminated statement",
+			policy:  "profile app {\n}\nabi <abi/3.0>",
+			wantErr: `line 3: unterminated statement "abi <abi/3.0>"`,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Parse(tc.policy)
+			require.ErrorIs(t, err, ErrSyntax)
+			require.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name           string
+		policy         string
+		profileName    string
+		includeAllowed func(Include) bool
+		wantErr        error
+		wantErrMsg     string
+	}{
+		{
+			name:        "valid",
+			policy:      testPolicy,
+			profileName: "app",
+		},
+		{
+			name:        "name mismatch",
+			policy:      testPolicy,
+			profileName: "other",
+			wantErr:     ErrProfileNameMismatch,
+			wantErrMsg:  `line 6: profile "app" must be named "other"`,
+		},
+		{
+			name:        "path name mismatch",
+			policy:      "/usr/bin/app {\n}\n",
+			profileName: "app",
+			wantErr:     ErrProfileNameMismatch,
+		},
+		{
+			name:        "no profile",
+			policy:      "#include <tunables/global>\n",
+			profileName: "app",
+			wantErr:     ErrNoProfile,
+		},
+		{
+			name:        "multiple profiles",
+			policy:      "profile app {\n}\nprofile other {\n}\n",
+			profileName: "app",
+			wantErr:     ErrMultipleProfiles,
+			wantErrMsg:  "app, other",
+		},
+		{
+			name:        "duplicate hat",
+			policy:      "profile app {\n  ^worker {\n  }\n  hat worker {\n  }\n}\n",
+			profileName: "app",
+			wantErr:     ErrDuplicateHat,
+			wantErrMsg:  "line 4: worker in profile app",
+		},
+		{
+			name:        "local include",
+			policy:      "profile app {\n  #include \"/etc/apparmor.d/app\"\n}\n",
+			profileName: "app",
+			wantErr:     ErrUnknownInclude,
+			wantErrMsg:  "line 2: /etc/apparmor.d/app",
+		},
+		{
+			name:        "unknown system include",
+			policy:      "profile app {\n  include <app/base>\n}\n",
+			profileName: "app",
+			wantErr:     ErrUnknownInclude,
+		},
+		{
+			name:        "required local override",
+			policy:      "profile app {\n  include <local/app>\n}\n",
+			profileName: "app",
+			wantErr:     ErrUnknownInclude,
+		},
+		{
+			name:        "path traversal",
+			policy:      "profile app {\n  include <abstractions/../app>\n}\n",
+			profileName: "app",
+			wantErr:     ErrUnknownInclude,
+		},
+		{
+			name:        "custom allowed include",
+			policy:      "profile app {\n  include <app/base>\n}\n",
+			profileName: "app",
+			includeAllowed: func(include Include) bool {
+				return include.Path == "app/base"
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			policy, err := Parse(tc.policy)
+			require.NoError(t, err)
+
+			err = policy.Validate(tc.profileName, tc.includeAllowed)
+			if tc.wantErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tc.wantErr)
+			require.ErrorContains(t, err, tc.wantErrMsg)
+		})
+	}
+}
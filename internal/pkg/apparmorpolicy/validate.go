@@ -0,0 +1,102 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apparmorpolicy
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// systemIncludePrefixes are the directories of the AppArmor policy directory
+// whose files are shipped with AppArmor.
+var systemIncludePrefixes = []string{"abi/", "abstractions/", "tunables/"}
+
+// DefaultIncludeAllowed returns true for the includes which are available
+// on every node with AppArmor: the abstractions and tunables shipped with
+// AppArmor, as well as optional includes of local overrides.
+func DefaultIncludeAllowed(include Include) bool {
+	if !include.System || strings.Contains(include.Path, "..") {
+		return false
+	}
+	if include.IfExists && strings.HasPrefix(include.Path, "local/") {
+		return true
+	}
+	for _, prefix := range systemIncludePrefixes {
+		if strings.HasPrefix(include.Path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks that the policy declares exactly one top-level profile
+// with the given name, that the hats of its profiles are unique and that
+// all includes are allowed. The includes are checked with
+// DefaultIncludeAllowed if includeAllowed is nil.
+func (p *Policy) Validate(name string, includeAllowed func(Include) bool) error {
+	if includeAllowed == nil {
+		includeAllowed = DefaultIncludeAllowed
+	}
+
+	var errs []error
+	switch {
+	case len(p.Profiles) == 0:
+		errs = append(errs, ErrNoProfile)
+	case len(p.Profiles) > 1:
+		names := make([]string, 0, len(p.Profiles))
+		for _, profile := range p.Profiles {
+			names = append(names, profile.Name)
+		}
+		errs = append(errs, fmt.Errorf("%w: %s", ErrMultipleProfiles, strings.Join(names, ", ")))
+	case p.Profiles[0].Name != name:
+		errs = append(errs, fmt.Errorf(
+			"%w: line %d: profile %q must be named %q",
+			ErrProfileNameMismatch, p.Profiles[0].Line, p.Profiles[0].Name, name,
+		))
+	}
+
+	for _, profile := range p.Profiles {
+		errs = append(errs, validateHats(profile)...)
+	}
+
+	for _, include := range p.AllIncludes() {
+		if !includeAllowed(include) {
+			errs = append(errs, fmt.Errorf("%w: line %d: %s", ErrUnknownInclude, include.Line, include.Path))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func validateHats(profile *Profile) []error {
+	var errs []error
+	hats := map[string]bool{}
+	for _, hat := range profile.Hats {
+		if hats[hat.Name] {
+			errs = append(errs, fmt.Errorf(
+				"%w: line %d: %s in profile %s", ErrDuplicateHat, hat.Line, hat.Name, profile.Name,
+			))
+		}
+		hats[hat.Name] = true
+		errs = append(errs, validateHats(hat)...)
+	}
+	for _, child := range profile.Children {
+		errs = append(errs, validateHats(child)...)
+	}
+	return errs
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/printers"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/yaml"
+
+	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/translator"
+)
+
+// Generator is the main structure of this package.
+type Generator struct {
+	impl
+	options *Options
+}
+
+// New returns a new Generator instance.
+func New(options *Options) *Generator {
+	return &Generator{
+		impl:    &defaultImpl{},
+		options: options,
+	}
+}
+
+// Run the Generator.
+func (g *Generator) Run() error {
+	log.Printf("Generating SELinux profile from: %s", g.options.inputFile)
+
+	content, err := g.ReadFile(g.options.inputFile)
+	if err != nil {
+		return fmt.Errorf("read input file: %w", err)
+	}
+
+	meta, podSpec, err := decodePodSpec(content)
+	if err != nil {
+		return fmt.Errorf("decode input file: %w", err)
+	}
+
+	profile := &selxv1alpha2.SelinuxProfile{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "SelinuxProfile",
+			APIVersion: selxv1alpha2.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      meta.Name,
+			Namespace: meta.Namespace,
+		},
+		Spec: translator.PodSpec2Selinux(podSpec, g.options.containers, g.options.fileContexts),
+	}
+	if g.options.name != "" {
+		profile.Name = g.options.name
+	}
+	if profile.Name == "" {
+		return errors.New("no profile name provided")
+	}
+
+	var data []byte
+	if g.options.cil {
+		inherits := []string{}
+		for _, inherit := range profile.Spec.Inherit {
+			inherits = append(inherits, inherit.Name)
+		}
+		data = []byte(translator.Object2CIL(inherits, nil, profile))
+	} else {
+		buf := &bytes.Buffer{}
+		if err := (&printers.YAMLPrinter{}).PrintObj(profile, buf); err != nil {
+			return fmt.Errorf("print YAML: %w", err)
+		}
+		data = buf.Bytes()
+	}
+
+	log.Printf("Saving profile in: %s", g.options.outputFile)
+	const defaultFileMode = os.FileMode(0o644)
+	if err := g.WriteFile(g.options.outputFile, data, defaultFileMode); err != nil {
+		return fmt.Errorf("save profile: %w", err)
+	}
+
+	return nil
+}
+
+// decodePodSpec decodes a workload or a bare container spec and returns its
+// metadata and pod spec. The metadata of a container spec contains its name.
+func decodePodSpec(content []byte) (*metav1.ObjectMeta, *corev1.PodSpec, error) {
+	obj, _, err := clientgoscheme.Codecs.UniversalDeserializer().Decode(content, nil, nil)
+	if err != nil {
+		if !runtime.IsMissingKind(err) {
+			return nil, nil, fmt.Errorf("decode object: %w", err)
+		}
+
+		ctr := corev1.Container{}
+		if err := yaml.UnmarshalStrict(content, &ctr); err != nil {
+			return nil, nil, fmt.Errorf("decode container spec: %w", err)
+		}
+		return &metav1.ObjectMeta{Name: ctr.Name}, &corev1.PodSpec{
+			Containers: []corev1.Container{ctr},
+		}, nil
+	}
+
+	switch o := obj.(type) {
+	case *corev1.Pod:
+		return &o.ObjectMeta, &o.Spec, nil
+	case *corev1.PodTemplate:
+		return &o.ObjectMeta, &o.Template.Spec, nil
+	case *corev1.ReplicationController:
+		if o.Spec.Template != nil {
+			return &o.ObjectMeta, &o.Spec.Template.Spec, nil
+		}
+	case *appsv1.Deployment:
+		return &o.ObjectMeta, &o.Spec.Template.Spec, nil
+	case *appsv1.StatefulSet:
+		return &o.ObjectMeta, &o.Spec.Template.Spec, nil
+	case *appsv1.DaemonSet:
+		return &o.ObjectMeta, &o.Spec.Template.Spec, nil
+	case *appsv1.ReplicaSet:
+		return &o.ObjectMeta, &o.Spec.Template.Spec, nil
+	case *batchv1.Job:
+		return &o.ObjectMeta, &o.Spec.Template.Spec, nil
+	case *batchv1.CronJob:
+		return &o.ObjectMeta, &o.Spec.JobTemplate.Spec.Template.Spec, nil
+	}
+
+	return nil, nil, fmt.Errorf("unsupported object kind: %s", obj.GetObjectKind().GroupVersionKind().Kind)
+}
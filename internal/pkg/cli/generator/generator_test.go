@@ -0,0 +1,168 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/generator/generatorfakes"
+)
+
+var errTest = errors.New("test")
+
+const testDeployment = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  namespace: default
+spec:
+  selector:
+    matchLabels:
+      app: web
+  template:
+    metadata:
+      labels:
+        app: web
+    spec:
+      containers:
+      - name: nginx
+        image: nginx
+        ports:
+        - containerPort: 80
+        volumeMounts:
+        - name: logs
+          mountPath: /var/log/nginx
+      volumes:
+      - name: logs
+        hostPath:
+          path: /var/log/nginx
+`
+
+const testContainer = `
+name: app
+image: app
+securityContext:
+  capabilities:
+    add: ["NET_ADMIN"]
+`
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name    string
+		options func(*Options)
+		prepare func(mock *generatorfakes.FakeImpl)
+		assert  func(*generatorfakes.FakeImpl, error)
+	}{
+		{
+			name: "success deployment",
+			prepare: func(mock *generatorfakes.FakeImpl) {
+				mock.ReadFileReturns([]byte(testDeployment), nil)
+			},
+			assert: func(mock *generatorfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				_, data, _ := mock.WriteFileArgsForCall(0)
+				require.Contains(t, string(data), "kind: SelinuxProfile")
+				require.Contains(t, string(data), "name: web")
+				require.Contains(t, string(data), "name: log_container")
+				require.Contains(t, string(data), "name: net_container")
+				require.Contains(t, string(data), "http_port_t")
+			},
+		},
+		{
+			name: "success container spec as CIL",
+			options: func(opts *Options) {
+				opts.cil = true
+			},
+			prepare: func(mock *generatorfakes.FakeImpl) {
+				mock.ReadFileReturns([]byte(testContainer), nil)
+			},
+			assert: func(mock *generatorfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				_, data, _ := mock.WriteFileArgsForCall(0)
+				require.Contains(t, string(data), "(block app_")
+				require.Contains(t, string(data), "(allow process app_.process ( capability ( net_admin )))")
+			},
+		},
+		{
+			name: "failure unsupported kind",
+			prepare: func(mock *generatorfakes.FakeImpl) {
+				mock.ReadFileReturns([]byte("apiVersion: v1\nkind: Service\nmetadata:\n  name: svc\n"), nil)
+			},
+			assert: func(_ *generatorfakes.FakeImpl, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "failure no name",
+			prepare: func(mock *generatorfakes.FakeImpl) {
+				mock.ReadFileReturns([]byte("image: app\n"), nil)
+			},
+			assert: func(_ *generatorfakes.FakeImpl, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "failure on ReadFile",
+			prepare: func(mock *generatorfakes.FakeImpl) {
+				mock.ReadFileReturns(nil, errTest)
+			},
+			assert: func(_ *generatorfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "failure on WriteFile",
+			prepare: func(mock *generatorfakes.FakeImpl) {
+				mock.ReadFileReturns([]byte(testDeployment), nil)
+				mock.WriteFileReturns(errTest)
+			},
+			assert: func(_ *generatorfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+	} {
+		prepare := tc.prepare
+		assert := tc.assert
+		options := tc.options
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &generatorfakes.FakeImpl{}
+			prepare(mock)
+
+			opts := Default()
+			opts.inputFile = "input.yaml"
+			opts.outputFile = os.DevNull
+			if options != nil {
+				options(opts)
+			}
+
+			sut := New(opts)
+			sut.impl = mock
+
+			err := sut.Run()
+			assert(mock, err)
+		})
+	}
+}
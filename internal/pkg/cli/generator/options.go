@@ -0,0 +1,77 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	ucli "github.com/urfave/cli/v2"
+)
+
+// Options define all possible options for the generator.
+type Options struct {
+	inputFile    string
+	outputFile   string
+	name         string
+	containers   []string
+	fileContexts map[string]string
+	cil          bool
+}
+
+// Default returns a default options instance.
+func Default() *Options {
+	return &Options{
+		outputFile:   DefaultOutputFile,
+		fileContexts: map[string]string{},
+	}
+}
+
+// FromContext can be used to create Options from an CLI context.
+func FromContext(ctx *ucli.Context) (*Options, error) {
+	options := Default()
+
+	args := ctx.Args().Slice()
+	if len(args) == 0 {
+		return nil, errors.New("no input file provided")
+	}
+	options.inputFile = args[0]
+
+	if ctx.IsSet(FlagOutputFile) {
+		options.outputFile = ctx.String(FlagOutputFile)
+	}
+	if options.outputFile == "" {
+		return nil, errors.New("no filename provided")
+	}
+
+	options.name = ctx.String(FlagName)
+	options.containers = ctx.StringSlice(FlagContainers)
+	options.cil = ctx.Bool(FlagCIL)
+
+	for _, fc := range ctx.StringSlice(FlagFileContexts) {
+		path, fileType, ok := strings.Cut(fc, ":")
+		path = strings.TrimSpace(path)
+		fileType = strings.TrimSpace(fileType)
+		if !ok || path == "" || fileType == "" {
+			return nil, fmt.Errorf("wrong file context format: %s", fc)
+		}
+		options.fileContexts[path] = fileType
+	}
+
+	return options, nil
+}
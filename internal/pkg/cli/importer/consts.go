@@ -0,0 +1,49 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package importer
+
+import "sigs.k8s.io/security-profiles-operator/internal/pkg/cli"
+
+// DefaultOutputFile defines the default output location for the importer.
+var DefaultOutputFile = cli.DefaultFile
+
+const (
+	// FlagOutputFile is the flag for defining the output file location.
+	FlagOutputFile string = cli.FlagOutputFile
+
+	// FlagName is the flag for overriding the name of the imported profile.
+	FlagName string = "name"
+
+	// FlagNamespace is the flag for defining the namespace of the imported
+	// profile.
+	FlagNamespace string = "namespace"
+
+	// FlagFormat is the flag for defining the format of the imported policy.
+	FlagFormat string = "format"
+
+	// FlagStrict is the flag for failing if the policy contains statements
+	// which cannot be represented by the profile.
+	FlagStrict string = "strict"
+)
+
+const (
+	// FormatCIL is the format of CIL policies.
+	FormatCIL string = "cil"
+
+	// FormatTE is the format of type enforcement modules.
+	FormatTE string = "te"
+)
@@ -0,0 +1,91 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package importer
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"os"
+
+	"k8s.io/cli-runtime/pkg/printers"
+
+	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/translator"
+)
+
+// Importer is the main structure of this package.
+type Importer struct {
+	impl
+	options *Options
+}
+
+// New returns a new Importer instance.
+func New(options *Options) *Importer {
+	return &Importer{
+		impl:    &defaultImpl{},
+		options: options,
+	}
+}
+
+// Run the Importer.
+func (i *Importer) Run() error {
+	log.Printf("Importing %s policy from: %s", i.options.format, i.options.inputFile)
+
+	content, err := i.ReadFile(i.options.inputFile)
+	if err != nil {
+		return fmt.Errorf("read input file: %w", err)
+	}
+
+	var (
+		profile     *selxv1alpha2.SelinuxProfile
+		unsupported []translator.UnsupportedStatement
+	)
+	if i.options.format == FormatTE {
+		profile, unsupported, err = translator.TE2Object(string(content))
+	} else {
+		profile, unsupported, err = translator.CIL2Object(string(content))
+	}
+	if err != nil {
+		return fmt.Errorf("convert policy: %w", err)
+	}
+
+	for _, stmt := range unsupported {
+		log.Printf("Unsupported statement on %s", stmt)
+	}
+	if len(unsupported) > 0 && i.options.strict {
+		return fmt.Errorf("policy contains %d statement(s) which cannot be represented", len(unsupported))
+	}
+
+	if i.options.name != "" {
+		profile.Name = i.options.name
+	}
+	profile.Namespace = i.options.namespace
+
+	buf := &bytes.Buffer{}
+	if err := (&printers.YAMLPrinter{}).PrintObj(profile, buf); err != nil {
+		return fmt.Errorf("print YAML: %w", err)
+	}
+
+	log.Printf("Saving profile in: %s", i.options.outputFile)
+	const defaultFileMode = os.FileMode(0o644)
+	if err := i.WriteFile(i.options.outputFile, buf.Bytes(), defaultFileMode); err != nil {
+		return fmt.Errorf("save profile: %w", err)
+	}
+
+	return nil
+}
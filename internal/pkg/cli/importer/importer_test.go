@@ -0,0 +1,157 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package importer
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/cli/importer/importerfakes"
+)
+
+var errTest = errors.New("test")
+
+const testCIL = `(block app_default
+    (blockinherit container)
+    (allow process var_log_t (file (open read)))
+    (dontaudit process var_t (dir (search)))
+)
+`
+
+const testTE = `module app 1.0;
+
+require {
+	type var_log_t;
+}
+
+type app_t;
+allow app_t var_log_t:file { open read };
+`
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name    string
+		options func(*Options)
+		prepare func(mock *importerfakes.FakeImpl)
+		assert  func(*importerfakes.FakeImpl, error)
+	}{
+		{
+			name: "success CIL",
+			options: func(opts *Options) {
+				opts.name = "app"
+				opts.namespace = "default"
+			},
+			prepare: func(mock *importerfakes.FakeImpl) {
+				mock.ReadFileReturns([]byte(testCIL), nil)
+			},
+			assert: func(mock *importerfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				_, data, _ := mock.WriteFileArgsForCall(0)
+				require.Contains(t, string(data), "kind: SelinuxProfile")
+				require.Contains(t, string(data), "name: app\n")
+				require.Contains(t, string(data), "namespace: default")
+				require.Contains(t, string(data), "var_log_t")
+				require.NotContains(t, string(data), "var_t")
+			},
+		},
+		{
+			name: "success TE",
+			options: func(opts *Options) {
+				opts.format = FormatTE
+			},
+			prepare: func(mock *importerfakes.FakeImpl) {
+				mock.ReadFileReturns([]byte(testTE), nil)
+			},
+			assert: func(mock *importerfakes.FakeImpl, err error) {
+				require.NoError(t, err)
+				_, data, _ := mock.WriteFileArgsForCall(0)
+				require.Contains(t, string(data), "name: app\n")
+				require.Contains(t, string(data), "name: container")
+				require.Contains(t, string(data), "var_log_t")
+			},
+		},
+		{
+			name: "failure strict with unsupported statements",
+			options: func(opts *Options) {
+				opts.strict = true
+			},
+			prepare: func(mock *importerfakes.FakeImpl) {
+				mock.ReadFileReturns([]byte(testCIL), nil)
+			},
+			assert: func(mock *importerfakes.FakeImpl, err error) {
+				require.Error(t, err)
+				require.Zero(t, mock.WriteFileCallCount())
+			},
+		},
+		{
+			name: "failure invalid policy",
+			prepare: func(mock *importerfakes.FakeImpl) {
+				mock.ReadFileReturns([]byte("(block app"), nil)
+			},
+			assert: func(_ *importerfakes.FakeImpl, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "failure on ReadFile",
+			prepare: func(mock *importerfakes.FakeImpl) {
+				mock.ReadFileReturns(nil, errTest)
+			},
+			assert: func(_ *importerfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "failure on WriteFile",
+			prepare: func(mock *importerfakes.FakeImpl) {
+				mock.ReadFileReturns([]byte(testCIL), nil)
+				mock.WriteFileReturns(errTest)
+			},
+			assert: func(_ *importerfakes.FakeImpl, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+	} {
+		prepare := tc.prepare
+		assert := tc.assert
+		options := tc.options
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &importerfakes.FakeImpl{}
+			prepare(mock)
+
+			opts := Default()
+			opts.inputFile = "input.cil"
+			opts.outputFile = os.DevNull
+			if options != nil {
+				options(opts)
+			}
+
+			sut := New(opts)
+			sut.impl = mock
+
+			err := sut.Run()
+			assert(mock, err)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package importer
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	ucli "github.com/urfave/cli/v2"
+)
+
+// Options define all possible options for the importer.
+type Options struct {
+	inputFile  string
+	outputFile string
+	name       string
+	namespace  string
+	format     string
+	strict     bool
+}
+
+// Default returns a default options instance.
+func Default() *Options {
+	return &Options{
+		outputFile: DefaultOutputFile,
+		format:     FormatCIL,
+	}
+}
+
+// FromContext can be used to create Options from an CLI context.
+func FromContext(ctx *ucli.Context) (*Options, error) {
+	options := Default()
+
+	args := ctx.Args().Slice()
+	if len(args) == 0 {
+		return nil, errors.New("no input file provided")
+	}
+	options.inputFile = args[0]
+
+	if ctx.IsSet(FlagOutputFile) {
+		options.outputFile = ctx.String(FlagOutputFile)
+	}
+	if options.outputFile == "" {
+		return nil, errors.New("no filename provided")
+	}
+
+	// The format defaults to the extension of the input file
+	if ext := strings.TrimPrefix(filepath.Ext(options.inputFile), "."); ext == FormatTE {
+		options.format = FormatTE
+	}
+	if ctx.IsSet(FlagFormat) {
+		options.format = ctx.String(FlagFormat)
+	}
+	if options.format != FormatCIL && options.format != FormatTE {
+		return nil, fmt.Errorf("unsupported format %q, must be %s or %s", options.format, FormatCIL, FormatTE)
+	}
+
+	options.name = ctx.String(FlagName)
+	options.namespace = ctx.String(FlagNamespace)
+	options.strict = ctx.Bool(FlagStrict)
+
+	return options, nil
+}
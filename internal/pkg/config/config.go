@@ -52,6 +52,10 @@ const (
 	// profiles are stored.
 	OperatorProfilesFolder = "operator"
 
+	// SeccompNotifierSocketFile is the name of the socket of the seccomp
+	// notifier in the operator profiles directory.
+	SeccompNotifierSocketFile = "seccomp-notifier.sock"
+
 	// OperatorRoot is the root directory of the operator.
 	OperatorRoot = "/var/lib/security-profiles-operator"
 
@@ -88,6 +92,14 @@ const (
 	// EnableBpfRecorderEnvKey is the environment variable key for enabling the BPF recorder.
 	EnableBpfRecorderEnvKey = "ENABLE_BPF_RECORDER"
 
+	// BpfRecorderMaxMountNamespacesEnvKey is the environment variable key for
+	// the maximum number of mount namespaces tracked by the BPF recorder.
+	BpfRecorderMaxMountNamespacesEnvKey = "BPF_RECORDER_MAX_MOUNT_NAMESPACES"
+
+	// BpfRecorderMaxProcessesEnvKey is the environment variable key for the
+	// maximum number of processes tracked by the BPF recorder.
+	BpfRecorderMaxProcessesEnvKey = "BPF_RECORDER_MAX_PROCESSES"
+
 	// EnableRecordingEnvKey is the environment variable key to enabling profile recording.
 	EnableRecordingEnvKey = "ENABLE_RECORDING"
 
@@ -223,6 +235,12 @@ func ProfilesRootPath() string {
 	return path.Join(KubeletSeccompRootPath(), OperatorProfilesFolder)
 }
 
+// SeccompNotifierSocketPath returns the path of the socket on which the
+// seccomp notifier of the daemon listens for the container runtimes.
+func SeccompNotifierSocketPath() string {
+	return path.Join(ProfilesRootPath(), SeccompNotifierSocketFile)
+}
+
 // KubeletConfigFilePath returns the kubelet config file path.
 func KubeletConfigFilePath() string {
 	return path.Join(OperatorRoot, KubeletConfigFile)
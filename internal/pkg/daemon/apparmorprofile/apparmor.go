@@ -18,11 +18,15 @@ package apparmorprofile
 
 import (
 	"github.com/go-logr/logr"
+
+	"sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
 )
 
 type aaProfileManager struct {
 	loadProfile   func(_ logr.Logger, _, _ string) (bool, error)
 	removeProfile func(_ logr.Logger, _ string) error
+	loadedMode    func(_ logr.Logger, _ string) (v1alpha1.AppArmorProfileMode, error)
+	syncIncludes  func(_ logr.Logger, _ map[string]string) error
 	logger        logr.Logger
 }
 
@@ -30,6 +34,8 @@ func NewAppArmorProfileManager(logger logr.Logger) ProfileManager {
 	return &aaProfileManager{
 		loadProfile:   loadProfile,
 		removeProfile: removeProfile,
+		loadedMode:    loadedMode,
+		syncIncludes:  syncIncludes,
 		logger:        logger,
 	}
 }
@@ -37,11 +37,16 @@ import (
 var (
 	hostSupportsAppArmor bool
 	checkHostSupport     sync.Once
+
+	// includesMutex serializes the synchronization of the include directory
+	// between the controllers.
+	includesMutex sync.Mutex
 )
 
 const (
 	customResourceTypeName string = "AppArmorProfile"
 	targetProfileDir       string = "/etc/apparmor.d/"
+	loadedProfilesPath     string = "/sys/kernel/security/apparmor/profiles"
 
 	errInvalidCustomResourceType string = "invalid CRD kind"
 )
@@ -61,7 +66,7 @@ func (a *aaProfileManager) Enabled() bool {
 }
 
 func (a *aaProfileManager) RemoveProfile(bp profilebasev1alpha1.StatusBaseUser) error {
-	profile, ok := bp.(*v1alpha1.AppArmorProfile)
+	profile, ok := bp.(v1alpha1.AppArmorProfileObject)
 	if !ok {
 		return errors.New(errInvalidCustomResourceType)
 	}
@@ -69,12 +74,25 @@ func (a *aaProfileManager) RemoveProfile(bp profilebasev1alpha1.StatusBaseUser)
 }
 
 func (a *aaProfileManager) InstallProfile(bp profilebasev1alpha1.StatusBaseUser) (bool, error) {
-	profile, ok := bp.(*v1alpha1.AppArmorProfile)
+	profile, ok := bp.(v1alpha1.AppArmorProfileObject)
 	if !ok {
 		return false, errors.New(errInvalidCustomResourceType)
 	}
 
-	return a.loadProfile(a.logger, profile.GetProfileName(), profile.Spec.Policy)
+	spec := profile.GetSpec()
+	return a.loadProfile(a.logger, profile.GetProfileName(), applyProfileMode(spec.Policy, spec.Mode))
+}
+
+func (a *aaProfileManager) LoadedMode(bp profilebasev1alpha1.StatusBaseUser) (v1alpha1.AppArmorProfileMode, error) {
+	profile, ok := bp.(v1alpha1.AppArmorProfileObject)
+	if !ok {
+		return "", errors.New(errInvalidCustomResourceType)
+	}
+	return a.loadedMode(a.logger, profile.GetProfileName())
+}
+
+func (a *aaProfileManager) SyncIncludes(includes map[string]string) error {
+	return a.syncIncludes(a.logger, includes)
 }
 
 func (a *aaProfileManager) CustomResourceTypeName() string {
@@ -108,6 +126,37 @@ func loadProfile(_ logr.Logger, name, content string) (bool, error) {
 	return err != nil, err
 }
 
+func loadedMode(_ logr.Logger, profileName string) (mode v1alpha1.AppArmorProfileMode, err error) {
+	mount := hostop.NewMountHostOp(hostop.WithAssumeContainer())
+
+	err = mount.Do(func() error {
+		profiles, err := os.ReadFile(loadedProfilesPath)
+		if err != nil {
+			return fmt.Errorf("cannot read loaded profiles: %w", err)
+		}
+
+		var loaded bool
+		mode, loaded = parseLoadedMode(string(profiles), profileName)
+		if !loaded {
+			return fmt.Errorf("policy %q is not loaded", profileName)
+		}
+		return nil
+	})
+
+	return mode, err
+}
+
+func syncIncludes(logger logr.Logger, includes map[string]string) error {
+	includesMutex.Lock()
+	defer includesMutex.Unlock()
+
+	mount := hostop.NewMountHostOp(hostop.WithAssumeContainer())
+
+	return mount.Do(func() error {
+		return syncIncludeDir(logger, filepath.Join(targetProfileDir, v1alpha1.AppArmorIncludeDirectory), includes)
+	})
+}
+
 func removeProfile(logger logr.Logger, profileName string) error {
 	mount := hostop.NewMountHostOp(hostop.WithAssumeContainer())
 	a := aa.NewAppArmor()
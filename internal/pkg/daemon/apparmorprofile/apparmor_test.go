@@ -55,6 +55,22 @@ func TestInstallProfile(t *testing.T) {
 			sut:     aaProfileManager{loadProfile: func(_ logr.Logger, _, _ string) (bool, error) { return false, nil }},
 			profile: &v1alpha1.AppArmorProfile{},
 		},
+		{
+			name: "profile in complain mode",
+			sut: aaProfileManager{loadProfile: func(_ logr.Logger, _, content string) (bool, error) {
+				if content != "profile test flags=(complain) {\n}\n" {
+					return false, errInvalidCRD
+				}
+				return true, nil
+			}},
+			profile: &v1alpha1.AppArmorProfile{
+				Spec: v1alpha1.AppArmorProfileSpec{
+					Policy: "profile test {\n}",
+					Mode:   v1alpha1.AppArmorProfileModeComplain,
+				},
+			},
+			wantResult: true,
+		},
 	}
 
 	for _, tc := range cases {
@@ -117,5 +133,6 @@ func TestNewAppArmorProfileManager(t *testing.T) {
 	require.True(t, ok)
 	require.NotNil(t, internal.loadProfile)
 	require.NotNil(t, internal.removeProfile)
+	require.NotNil(t, internal.loadedMode)
 	require.Equal(t, log.Log, internal.logger)
 }
@@ -24,6 +24,7 @@ import (
 
 	"github.com/go-logr/logr"
 
+	"sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
 	profilebasev1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
 )
 
@@ -41,6 +42,18 @@ func (a *aaProfileManager) InstallProfile(profilebasev1alpha1.StatusBaseUser) (b
 	return false, errAppArmorNotSupported
 }
 
+func (a *aaProfileManager) LoadedMode(profilebasev1alpha1.StatusBaseUser) (v1alpha1.AppArmorProfileMode, error) {
+	return "", errAppArmorNotSupported
+}
+
+func (a *aaProfileManager) SyncIncludes(map[string]string) error {
+	return errAppArmorNotSupported
+}
+
+func loadedMode(logr.Logger, string) (v1alpha1.AppArmorProfileMode, error) {
+	return "", errAppArmorNotSupported
+}
+
 func loadProfile(logr.Logger, string, string) (bool, error) {
 	return false, errAppArmorNotSupported
 }
@@ -48,3 +61,7 @@ func loadProfile(logr.Logger, string, string) (bool, error) {
 func removeProfile(logr.Logger, string) error {
 	return errAppArmorNotSupported
 }
+
+func syncIncludes(logr.Logger, map[string]string) error {
+	return errAppArmorNotSupported
+}
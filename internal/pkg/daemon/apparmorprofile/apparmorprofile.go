@@ -36,8 +36,11 @@ import (
 
 	"sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
 	statusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/apparmorpolicy"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/controller"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher"
+	enrichertypes "sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/nodestatus"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
@@ -58,6 +61,7 @@ const (
 	reasonCannotUnloadProfile   string = "CannotUnloadAppArmorProfile"
 	reasonCannotUpdateProfile   string = "CannotUpdateAppArmorProfile"
 	reasonLoadedAppArmorProfile string = "LoadedAppArmorProfile"
+	reasonInvalidProfile        string = "InvalidAppArmorProfile"
 )
 
 // NewController returns a new empty controller instance.
@@ -65,6 +69,12 @@ func NewController() controller.Controller {
 	return &Reconciler{}
 }
 
+// NewClusterController returns a new empty controller instance for cluster
+// scoped AppArmor profiles.
+func NewClusterController() controller.Controller {
+	return &Reconciler{cluster: true}
+}
+
 // A Reconciler reconciles AppArmor profiles.
 type Reconciler struct {
 	client  client.Client
@@ -72,13 +82,26 @@ type Reconciler struct {
 	record  record.EventRecorder
 	metrics *metrics.Metrics
 	manager ProfileManager
+	cluster bool
 }
 
 // Name returns the name of the controller.
 func (r *Reconciler) Name() string {
+	if r.cluster {
+		return "cluster-apparmor-spod"
+	}
 	return "apparmor-spod"
 }
 
+// newProfile returns an empty profile of the kind reconciled by the
+// controller.
+func (r *Reconciler) newProfile() v1alpha1.AppArmorProfileObject {
+	if r.cluster {
+		return &v1alpha1.ClusterAppArmorProfile{}
+	}
+	return &v1alpha1.AppArmorProfile{}
+}
+
 // SchemeBuilder returns the API scheme of the controller.
 func (r *Reconciler) SchemeBuilder() *scheme.Builder {
 	return v1alpha1.SchemeBuilder
@@ -101,6 +124,9 @@ func (r *Reconciler) checkAppArmor() error {
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=apparmorprofiles,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=apparmorprofiles/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=apparmorprofiles/finalizers,verbs=delete;get;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=clusterapparmorprofiles,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=clusterapparmorprofiles/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=clusterapparmorprofiles/finalizers,verbs=delete;get;update;patch
 
 // Reconcile reconciles a AppArmorProfile.
 func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
@@ -131,7 +157,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		return reconcile.Result{}, nil
 	}
 
-	appArmorProfile := &v1alpha1.AppArmorProfile{}
+	appArmorProfile := r.newProfile()
 	if err := r.client.Get(ctx, req.NamespacedName, appArmorProfile); err != nil {
 		// Expected to find an AppArmorProfile, return an error and requeue
 		if util.IgnoreNotFound(err) == nil {
@@ -144,7 +170,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 }
 
 func (r *Reconciler) reconcileAppArmorProfile(
-	ctx context.Context, sp *v1alpha1.AppArmorProfile, l logr.Logger,
+	ctx context.Context, sp v1alpha1.AppArmorProfileObject, l logr.Logger,
 ) (reconcile.Result, error) {
 	if sp == nil {
 		return reconcile.Result{}, errors.New(errAppArmorProfileNil)
@@ -159,6 +185,14 @@ func (r *Reconciler) reconcileAppArmorProfile(
 		return r.reconcileDeletion(ctx, sp, nodeStatus)
 	}
 
+	selected, err := nodeStatus.NodeSelected(ctx)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("checking node selector: %w", err)
+	}
+	if !selected {
+		return r.reconcileDeselected(ctx, sp, nodeStatus, l)
+	}
+
 	// The object is not being deleted
 	exists, existErr := nodeStatus.Exists(ctx)
 	if existErr != nil {
@@ -173,16 +207,65 @@ func (r *Reconciler) reconcileAppArmorProfile(
 		return reconcile.Result{RequeueAfter: wait}, nil
 	}
 
+	allowed, requeueAfter, err := nodeStatus.RolloutAllowed(ctx)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("checking profile rollout: %w", err)
+	}
+	if !allowed {
+		l.Info("Waiting for the profile update to be rolled out to the canary nodes")
+		return reconcile.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	includes, err := listIncludes(ctx, r.client)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// Validate the policy before touching the host, because a policy which
+	// does not declare the profile would still be written and loaded.
+	if err := validatePolicy(sp, includes); err != nil {
+		l.Error(err, "invalid profile")
+		r.metrics.IncAppArmorProfileError(reasonInvalidProfile)
+		r.record.Event(sp, util.EventTypeWarning, reasonInvalidProfile, err.Error())
+		if statusErr := nodeStatus.SetNodeStatusError(ctx, reasonInvalidProfile, err.Error()); statusErr != nil {
+			l.Error(statusErr, "cannot update node status")
+			return reconcile.Result{}, fmt.Errorf("setting node status to error: %w", statusErr)
+		}
+		// Retrying does not help, the profile or the includes have to change
+		// first, which triggers a new reconciliation.
+		return reconcile.Result{}, nil
+	}
+
+	// The includes may have changed since the include controller wrote them
+	if err := r.manager.SyncIncludes(includes); err != nil {
+		l.Error(err, "cannot synchronize includes")
+		r.metrics.IncAppArmorProfileError(reasonCannotSyncIncludes)
+		r.record.Event(sp, util.EventTypeWarning, reasonCannotSyncIncludes, err.Error())
+		return reconcile.Result{}, fmt.Errorf("synchronizing AppArmor includes: %w", err)
+	}
+
 	// TODO: backoff policy
 	updated, err := r.manager.InstallProfile(sp)
 	if err != nil {
 		l.Error(err, "cannot load profile into node")
 		r.metrics.IncAppArmorProfileError(reasonCannotLoadProfile)
 		r.record.Event(sp, util.EventTypeWarning, reasonCannotLoadProfile, err.Error())
+		if statusErr := nodeStatus.SetNodeStatusError(ctx, reasonCannotLoadProfile, err.Error()); statusErr != nil {
+			l.Error(statusErr, "cannot update node status")
+		}
 		return reconcile.Result{}, fmt.Errorf("cannot load profile into node: %w", err)
 	}
 
-	isAlreadyInstalled, getErr := nodeStatus.Matches(ctx, statusv1alpha1.ProfileStateInstalled)
+	mode, err := r.manager.LoadedMode(sp)
+	if err != nil {
+		l.Error(err, "cannot get the mode of the loaded profile")
+	} else if err := nodeStatus.SetLoadedMode(ctx, string(mode)); err != nil {
+		l.Error(err, "cannot update loaded mode")
+		r.metrics.IncAppArmorProfileError(reasonCannotUpdateStatus)
+		return reconcile.Result{}, fmt.Errorf("updating loaded mode of AppArmorProfile: %w", err)
+	}
+
+	isAlreadyInstalled, getErr := nodeStatus.IsInstalled(ctx)
 	if getErr != nil {
 		l.Error(getErr, "couldn't get current status")
 		return reconcile.Result{}, fmt.Errorf("getting status for installed AppArmorProfile: %w", getErr)
@@ -190,10 +273,10 @@ func (r *Reconciler) reconcileAppArmorProfile(
 
 	if isAlreadyInstalled {
 		l.Info("Already in the expected Installed state")
-		return reconcile.Result{}, nil
+		return r.checkCanary(ctx, sp, nodeStatus, l)
 	}
 
-	if err := nodeStatus.SetNodeStatus(ctx, statusv1alpha1.ProfileStateInstalled); err != nil {
+	if err := nodeStatus.SetNodeStatusInstalled(ctx, []byte(sp.GetSpec().Policy)); err != nil {
 		l.Error(err, "cannot update node status")
 		r.metrics.IncAppArmorProfileError(reasonCannotUpdateStatus)
 		r.record.Event(sp, util.EventTypeWarning, reasonCannotUpdateStatus, err.Error())
@@ -210,12 +293,25 @@ func (r *Reconciler) reconcileAppArmorProfile(
 		r.metrics.IncAppArmorProfileUpdate()
 		r.record.Event(sp, util.EventTypeNormal, reasonLoadedAppArmorProfile, evstr)
 	}
-	return reconcile.Result{}, nil
+	return r.checkCanary(ctx, sp, nodeStatus, l)
+}
+
+// checkCanary halts the staged rollout of the profile if violations of it
+// got observed on this canary node.
+func (r *Reconciler) checkCanary(
+	ctx context.Context,
+	sp v1alpha1.AppArmorProfileObject,
+	nsc *nodestatus.StatusClient,
+	l logr.Logger,
+) (reconcile.Result, error) {
+	return nsc.ReconcileCanary(ctx, r.record, l, func(ctx context.Context) (time.Time, error) {
+		return enricher.LastViolation(ctx, enrichertypes.AuditTypeApparmor, sp.GetNamespace(), sp.GetName())
+	}, r.metrics.IncAppArmorProfileError)
 }
 
 func (r *Reconciler) reconcileDeletion(
 	ctx context.Context,
-	sp *v1alpha1.AppArmorProfile,
+	sp v1alpha1.AppArmorProfileObject,
 	nsc *nodestatus.StatusClient,
 ) (reconcile.Result, error) {
 	hasStatus, err := nsc.Exists(ctx)
@@ -264,7 +360,39 @@ func (r *Reconciler) reconcileDeletion(
 	return ctrl.Result{}, nil
 }
 
-func (r *Reconciler) handleDeletion(sp *v1alpha1.AppArmorProfile) error {
+// reconcileDeselected unloads the profile from the node if it got loaded
+// before the node selector of the profile stopped matching the node.
+func (r *Reconciler) reconcileDeselected(
+	ctx context.Context,
+	sp v1alpha1.AppArmorProfileObject,
+	nsc *nodestatus.StatusClient,
+	l logr.Logger,
+) (reconcile.Result, error) {
+	exists, err := nsc.Exists(ctx)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("checking if node status exists: %w", err)
+	}
+	if !exists {
+		l.Info("Profile does not select this node, skipping")
+		return reconcile.Result{}, nil
+	}
+
+	l.Info("Profile does not select this node anymore, unloading it")
+	return r.reconcileDeletion(ctx, sp, nsc)
+}
+
+// validatePolicy parses the policy of the profile and checks that it
+// declares the profile with the name of the custom resource and includes only
+// files available on the node, including the given AppArmorIncludes.
+func validatePolicy(sp v1alpha1.AppArmorProfileObject, includes map[string]string) error {
+	policy, err := apparmorpolicy.Parse(sp.GetSpec().Policy)
+	if err != nil {
+		return fmt.Errorf("parsing policy: %w", err)
+	}
+	return policy.Validate(sp.GetProfileName(), includeAllowed(includes))
+}
+
+func (r *Reconciler) handleDeletion(sp v1alpha1.AppArmorProfileObject) error {
 	if err := r.manager.RemoveProfile(sp); err != nil {
 		return fmt.Errorf("unloading profile from host: %w", err)
 	}
@@ -23,14 +23,23 @@ import (
 	_ "github.com/go-logr/logr"
 	"github.com/stretchr/testify/require"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
 	profilebasev1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	statusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/apparmorpolicy"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/nodestatus"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
 )
 
@@ -105,15 +114,96 @@ func TestReconcile(t *testing.T) {
 			gotResult, gotErr := tc.rec.Reconcile(context.Background(), tc.req)
 			if tc.wantErr != nil {
 				require.EqualError(t, gotErr, tc.wantErr.Error())
+			} else {
+				require.NoError(t, gotErr)
 			}
 			require.Equal(t, tc.wantResult, gotResult)
 		})
 	}
 }
 
+func TestReconcileInvalidProfile(t *testing.T) {
+	t.Setenv(config.NodeNameEnvKey, "node")
+
+	schemeInstance := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(schemeInstance))
+	require.NoError(t, statusv1alpha1.AddToScheme(schemeInstance))
+	profile := &v1alpha1.AppArmorProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "invalid", Namespace: "ns"},
+		Spec: v1alpha1.AppArmorProfileSpec{
+			Policy: "profile invalid {\n  file\n}\n",
+		},
+	}
+	cli := fake.NewClientBuilder().WithScheme(schemeInstance).
+		WithObjects(profile).WithStatusSubresource(profile).Build()
+
+	nodeStatus, err := nodestatus.NewForProfile(profile, cli)
+	require.NoError(t, err)
+	require.NoError(t, nodeStatus.Create(context.Background()))
+
+	r := &Reconciler{
+		client:  cli,
+		log:     log.Log,
+		record:  record.NewFakeRecorder(10),
+		metrics: metrics.New(),
+		manager: &FakeProfileManager{enabled: true},
+	}
+	res, err := r.Reconcile(context.Background(), reconcile.Request{
+		NamespacedName: types.NamespacedName{Namespace: "ns", Name: "invalid"},
+	})
+
+	// An invalid spec does not get retried until it changes
+	require.NoError(t, err)
+	require.Equal(t, reconcile.Result{}, res)
+	isError, err := nodeStatus.Matches(context.Background(), statusv1alpha1.ProfileStateError)
+	require.NoError(t, err)
+	require.True(t, isError)
+}
+
+func TestReconcileLoadedMode(t *testing.T) {
+	t.Setenv(config.NodeNameEnvKey, "node")
+	t.Setenv(config.OperatorNamespaceEnvKey, "security-profiles-operator")
+
+	schemeInstance := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(schemeInstance))
+	require.NoError(t, statusv1alpha1.AddToScheme(schemeInstance))
+	require.NoError(t, spodv1alpha1.AddToScheme(schemeInstance))
+	profile := &v1alpha1.AppArmorProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-profile", Namespace: "ns"},
+		Spec: v1alpha1.AppArmorProfileSpec{
+			Policy: "profile test-profile {\n  file,\n}\n",
+			Mode:   v1alpha1.AppArmorProfileModeComplain,
+		},
+	}
+	cli := fake.NewClientBuilder().WithScheme(schemeInstance).
+		WithObjects(profile).WithStatusSubresource(profile).Build()
+
+	nodeStatus, err := nodestatus.NewForProfile(profile, cli)
+	require.NoError(t, err)
+	require.NoError(t, nodeStatus.Create(context.Background()))
+
+	r := &Reconciler{
+		client:  cli,
+		log:     log.Log,
+		record:  record.NewFakeRecorder(10),
+		metrics: metrics.New(),
+		manager: &FakeProfileManager{enabled: true, mode: v1alpha1.AppArmorProfileModeComplain},
+	}
+	_, err = r.Reconcile(context.Background(), reconcile.Request{
+		NamespacedName: types.NamespacedName{Namespace: "ns", Name: "test-profile"},
+	})
+	require.NoError(t, err)
+
+	status := &statusv1alpha1.SecurityProfileNodeStatus{}
+	require.NoError(t, cli.Get(context.Background(), nodestatus.NamespacedName(profile, "node"), status))
+	require.Equal(t, statusv1alpha1.ProfileStateInstalled, status.Status)
+	require.Equal(t, string(v1alpha1.AppArmorProfileModeComplain), status.LoadedMode)
+}
+
 type FakeProfileManager struct {
 	enabled   bool
 	installed bool
+	mode      v1alpha1.AppArmorProfileMode
 	err       error
 }
 
@@ -128,3 +218,29 @@ func (f *FakeProfileManager) InstallProfile(profilebasev1alpha1.StatusBaseUser)
 func (f *FakeProfileManager) RemoveProfile(profilebasev1alpha1.StatusBaseUser) error {
 	return f.err
 }
+
+func (f *FakeProfileManager) LoadedMode(profilebasev1alpha1.StatusBaseUser) (v1alpha1.AppArmorProfileMode, error) {
+	return f.mode, f.err
+}
+
+func (f *FakeProfileManager) SyncIncludes(map[string]string) error {
+	return f.err
+}
+
+func TestValidatePolicy(t *testing.T) {
+	t.Parallel()
+
+	profile := &v1alpha1.AppArmorProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-profile"},
+		Spec: v1alpha1.AppArmorProfileSpec{
+			Policy: "#include <tunables/global>\nprofile test-profile {\n  file,\n}\n",
+		},
+	}
+	require.NoError(t, validatePolicy(profile, nil))
+
+	profile.Spec.Policy = "profile other {\n  file,\n}\n"
+	require.ErrorIs(t, validatePolicy(profile, nil), apparmorpolicy.ErrProfileNameMismatch)
+
+	profile.Spec.Policy = "profile test-profile {\n  file\n}\n"
+	require.ErrorIs(t, validatePolicy(profile, nil), apparmorpolicy.ErrSyntax)
+}
@@ -0,0 +1,261 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apparmorprofile
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+
+	"sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/apparmorpolicy"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/controller"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+const reasonCannotSyncIncludes string = "CannotSyncAppArmorIncludes"
+
+// NewIncludeController returns a new empty controller instance for
+// AppArmorIncludes.
+func NewIncludeController() controller.Controller {
+	return &IncludeReconciler{}
+}
+
+// An IncludeReconciler writes the AppArmorIncludes to the node and removes
+// the deleted ones. The profiles which include them get reloaded by their
+// own controllers.
+type IncludeReconciler struct {
+	client  client.Client
+	log     logr.Logger
+	record  record.EventRecorder
+	metrics *metrics.Metrics
+	manager ProfileManager
+}
+
+// Name returns the name of the controller.
+func (r *IncludeReconciler) Name() string {
+	return "apparmor-include-spod"
+}
+
+// SchemeBuilder returns the API scheme of the controller.
+func (r *IncludeReconciler) SchemeBuilder() *scheme.Builder {
+	return v1alpha1.SchemeBuilder
+}
+
+// Healthz is the liveness probe endpoint of the controller.
+func (r *IncludeReconciler) Healthz(*http.Request) error {
+	return nil
+}
+
+// Setup adds a controller that reconciles AppArmorIncludes.
+func (r *IncludeReconciler) Setup(
+	_ context.Context,
+	mgr ctrl.Manager,
+	met *metrics.Metrics,
+) error {
+	r.client = mgr.GetClient()
+	r.log = ctrl.Log.WithName(r.Name())
+	r.record = mgr.GetEventRecorderFor("apparmorinclude")
+	r.metrics = met
+	r.manager = NewAppArmorProfileManager(r.log)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("apparmorinclude").
+		For(&v1alpha1.AppArmorInclude{}).
+		Complete(r)
+}
+
+// Security Profiles Operator RBAC permissions to read AppArmorIncludes
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=apparmorincludes,verbs=get;list;watch
+
+// Reconcile synchronizes the AppArmorIncludes with the node. Every change
+// results in a full synchronization, which also removes the includes
+// deleted in the meantime.
+func (r *IncludeReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	logger := r.log.WithValues("apparmorinclude", req.Name)
+
+	if !r.manager.Enabled() {
+		return reconcile.Result{}, nil
+	}
+
+	includes, err := listIncludes(ctx, r.client)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	logger.Info("Synchronizing AppArmor includes", "count", len(includes))
+	if err := r.manager.SyncIncludes(includes); err != nil {
+		r.metrics.IncAppArmorProfileError(reasonCannotSyncIncludes)
+		include := &v1alpha1.AppArmorInclude{}
+		if getErr := r.client.Get(ctx, req.NamespacedName, include); getErr == nil {
+			r.record.Event(include, util.EventTypeWarning, reasonCannotSyncIncludes, err.Error())
+		}
+		return reconcile.Result{}, fmt.Errorf("synchronizing AppArmor includes: %w", err)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// listIncludes returns the content of all AppArmorIncludes by their name.
+func listIncludes(ctx context.Context, c client.Client) (map[string]string, error) {
+	list := &v1alpha1.AppArmorIncludeList{}
+	if err := c.List(ctx, list); err != nil {
+		return nil, fmt.Errorf("listing AppArmor includes: %w", err)
+	}
+
+	includes := make(map[string]string, len(list.Items))
+	for i := range list.Items {
+		includes[list.Items[i].GetName()] = list.Items[i].Spec.Content
+	}
+	return includes, nil
+}
+
+// includeAllowed returns a function which accepts the default includes as
+// well as the includes of the given AppArmorIncludes. Optional includes of
+// missing AppArmorIncludes are accepted as well.
+func includeAllowed(includes map[string]string) func(apparmorpolicy.Include) bool {
+	return func(include apparmorpolicy.Include) bool {
+		if apparmorpolicy.DefaultIncludeAllowed(include) {
+			return true
+		}
+		name, ok := includeName(include)
+		if !ok {
+			return false
+		}
+		_, exists := includes[name]
+		return exists || include.IfExists
+	}
+}
+
+// includeName returns the name of the AppArmorInclude an include refers to.
+func includeName(include apparmorpolicy.Include) (string, bool) {
+	name, ok := strings.CutPrefix(include.Path, v1alpha1.AppArmorIncludeDirectory+"/")
+	if !include.System || !ok || name == "" || strings.Contains(name, "/") {
+		return "", false
+	}
+	return name, true
+}
+
+// includesOf returns the names of the AppArmorIncludes used by the policy.
+func includesOf(policy string) []string {
+	parsed, err := apparmorpolicy.Parse(policy)
+	if err != nil {
+		return nil
+	}
+
+	names := []string{}
+	for _, include := range parsed.AllIncludes() {
+		if name, ok := includeName(include); ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// enqueueDependents returns a map function which enqueues the profiles
+// including the changed AppArmorInclude, to reload them.
+func enqueueDependents(c client.Client, newList func() client.ObjectList) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		list := newList()
+		if err := c.List(ctx, list); err != nil {
+			return nil
+		}
+
+		var requests []reconcile.Request
+		for _, profile := range profilesOf(list) {
+			for _, name := range includesOf(profile.GetSpec().Policy) {
+				if name == obj.GetName() {
+					requests = append(requests, reconcile.Request{
+						NamespacedName: util.NamespacedName(profile.GetName(), profile.GetNamespace()),
+					})
+					break
+				}
+			}
+		}
+		return requests
+	}
+}
+
+func profilesOf(list client.ObjectList) []v1alpha1.AppArmorProfileObject {
+	var profiles []v1alpha1.AppArmorProfileObject
+	switch l := list.(type) {
+	case *v1alpha1.AppArmorProfileList:
+		for i := range l.Items {
+			profiles = append(profiles, &l.Items[i])
+		}
+	case *v1alpha1.ClusterAppArmorProfileList:
+		for i := range l.Items {
+			profiles = append(profiles, &l.Items[i])
+		}
+	}
+	return profiles
+}
+
+// syncIncludeDir writes the includes into the directory and removes all
+// other files from it.
+func syncIncludeDir(logger logr.Logger, dir string, includes map[string]string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil { //nolint:gomnd // the policy directory is world readable
+		return fmt.Errorf("creating include directory: %w", err)
+	}
+
+	for name, content := range includes {
+		path := filepath.Join(dir, name)
+		existing, err := os.ReadFile(path)
+		if err == nil && bytes.Equal(existing, []byte(content)) {
+			continue
+		} else if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("reading include %s: %w", name, err)
+		}
+
+		logger.Info("Writing AppArmor include", "path", path)
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil { //nolint:gosec // file permissions are fine
+			return fmt.Errorf("writing include %s: %w", name, err)
+		}
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("reading include directory: %w", err)
+	}
+	for _, entry := range entries {
+		if _, ok := includes[entry.Name()]; ok || !entry.Type().IsRegular() {
+			continue
+		}
+
+		path := filepath.Join(dir, entry.Name())
+		logger.Info("Removing AppArmor include", "path", path)
+		if err := os.Remove(path); err != nil {
+			return fmt.Errorf("removing include %s: %w", entry.Name(), err)
+		}
+	}
+
+	return nil
+}
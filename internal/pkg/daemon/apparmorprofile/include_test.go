@@ -0,0 +1,139 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apparmorprofile
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/apparmorpolicy"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+func TestSyncIncludeDir(t *testing.T) {
+	t.Parallel()
+
+	dir := filepath.Join(t.TempDir(), v1alpha1.AppArmorIncludeDirectory)
+
+	require.NoError(t, syncIncludeDir(log.Log, dir, map[string]string{
+		"app-base": "/etc/app/** r,\n",
+		"app-vars": "@{APP}=/opt/app\n",
+	}))
+	content, err := os.ReadFile(filepath.Join(dir, "app-base"))
+	require.NoError(t, err)
+	require.Equal(t, "/etc/app/** r,\n", string(content))
+
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "subdir"), 0o755))
+	require.NoError(t, syncIncludeDir(log.Log, dir, map[string]string{
+		"app-base": "/etc/app/** rw,\n",
+	}))
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	names := []string{}
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	require.Equal(t, []string{"app-base", "subdir"}, names)
+
+	content, err = os.ReadFile(filepath.Join(dir, "app-base"))
+	require.NoError(t, err)
+	require.Equal(t, "/etc/app/** rw,\n", string(content))
+}
+
+func TestValidatePolicyIncludes(t *testing.T) {
+	t.Parallel()
+
+	includes := map[string]string{"app-base": "/etc/app/** r,\n"}
+	for _, tc := range []struct {
+		include string
+		wantErr bool
+	}{
+		{include: "#include <spo/app-base>"},
+		{include: "include if exists <spo/missing>"},
+		{include: "#include <abstractions/base>"},
+		{include: "#include <spo/missing>", wantErr: true},
+		{include: `#include "spo/app-base"`, wantErr: true},
+		{include: "#include <spo/app-base/nested>", wantErr: true},
+	} {
+		profile := &v1alpha1.AppArmorProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "app"},
+			Spec: v1alpha1.AppArmorProfileSpec{
+				Policy: "profile app {\n  " + tc.include + "\n  file,\n}\n",
+			},
+		}
+
+		err := validatePolicy(profile, includes)
+		if tc.wantErr {
+			require.ErrorIs(t, err, apparmorpolicy.ErrUnknownInclude, tc.include)
+		} else {
+			require.NoError(t, err, tc.include)
+		}
+	}
+}
+
+func TestEnqueueDependents(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	newProfile := func(name, namespace, policy string) client.Object {
+		return &v1alpha1.AppArmorProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       v1alpha1.AppArmorProfileSpec{Policy: policy},
+		}
+	}
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		newProfile("app", "ns1", "profile app {\n  #include <spo/app-base>\n}\n"),
+		newProfile("hat", "ns2", "profile hat {\n  ^worker {\n    include if exists <spo/app-base>\n  }\n}\n"),
+		newProfile("other", "ns1", "profile other {\n  #include <spo/other>\n}\n"),
+		newProfile("invalid", "ns1", "profile invalid {\n  #include <spo/app-base>\n"),
+		&v1alpha1.ClusterAppArmorProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+			Spec: v1alpha1.AppArmorProfileSpec{
+				Policy: "profile cluster {\n  #include <spo/app-base>\n}\n",
+			},
+		},
+	).Build()
+
+	include := &v1alpha1.AppArmorInclude{ObjectMeta: metav1.ObjectMeta{Name: "app-base"}}
+
+	requests := enqueueDependents(cli, func() client.ObjectList {
+		return &v1alpha1.AppArmorProfileList{}
+	})(context.Background(), include)
+	require.ElementsMatch(t, []reconcile.Request{
+		{NamespacedName: util.NamespacedName("app", "ns1")},
+		{NamespacedName: util.NamespacedName("hat", "ns2")},
+	}, requests)
+
+	requests = enqueueDependents(cli, func() client.ObjectList {
+		return &v1alpha1.ClusterAppArmorProfileList{}
+	})(context.Background(), include)
+	require.Equal(t, []reconcile.Request{{NamespacedName: util.NamespacedName("cluster", "")}}, requests)
+}
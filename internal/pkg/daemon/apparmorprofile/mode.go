@@ -0,0 +1,117 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apparmorprofile
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/apparmorpolicy"
+)
+
+var (
+	profileFlagsRegex = regexp.MustCompile(`\s*flags\s*=\s*\(([^)]*)\)`)
+
+	// modeFlags are the profile flags which select the mode of a profile.
+	modeFlags = map[string]bool{
+		"enforce":    true,
+		"complain":   true,
+		"kill":       true,
+		"unconfined": true,
+		"prompt":     true,
+	}
+)
+
+// applyProfileMode replaces the mode flags of all profiles and hats of the
+// policy with the mode. The policy is returned unchanged if the mode is
+// empty or if the policy cannot be parsed. Enforce mode is the default of
+// AppArmor, so it removes the mode flags instead of adding a flag.
+func applyProfileMode(policy string, mode v1alpha1.AppArmorProfileMode) string {
+	if mode == "" {
+		return policy
+	}
+	parsed, err := apparmorpolicy.Parse(policy)
+	if err != nil {
+		return policy
+	}
+
+	var profiles []*apparmorpolicy.Profile
+	var walk func(*apparmorpolicy.Profile)
+	walk = func(profile *apparmorpolicy.Profile) {
+		profiles = append(profiles, profile)
+		for _, hat := range profile.Hats {
+			walk(hat)
+		}
+		for _, child := range profile.Children {
+			walk(child)
+		}
+	}
+	for _, profile := range parsed.Profiles {
+		walk(profile)
+	}
+	sort.Slice(profiles, func(i, j int) bool {
+		return profiles[i].HeaderStart < profiles[j].HeaderStart
+	})
+
+	var sb strings.Builder
+	last := 0
+	for _, profile := range profiles {
+		sb.WriteString(policy[last:profile.HeaderStart])
+		sb.WriteString(setModeFlag(policy[profile.HeaderStart:profile.HeaderEnd], mode))
+		last = profile.HeaderEnd
+	}
+	sb.WriteString(policy[last:])
+	return sb.String()
+}
+
+// setModeFlag replaces the mode flags of a profile header without the
+// opening brace.
+func setModeFlag(header string, mode v1alpha1.AppArmorProfileMode) string {
+	flags := []string{}
+	if m := profileFlagsRegex.FindStringSubmatch(header); m != nil {
+		for _, flag := range strings.FieldsFunc(m[1], func(r rune) bool {
+			return r == ',' || r == ' ' || r == '\t'
+		}) {
+			if !modeFlags[flag] {
+				flags = append(flags, flag)
+			}
+		}
+		header = profileFlagsRegex.ReplaceAllString(header, "")
+	}
+
+	if mode == v1alpha1.AppArmorProfileModeComplain {
+		flags = append(flags, string(mode))
+	}
+	if len(flags) == 0 {
+		return header
+	}
+	return header + " flags=(" + strings.Join(flags, ",") + ")"
+}
+
+// parseLoadedMode returns the mode of the profile from the list of loaded
+// profiles of the kernel, which has lines in the format "name (mode)".
+func parseLoadedMode(profiles, name string) (v1alpha1.AppArmorProfileMode, bool) {
+	prefix := name + " ("
+	for _, line := range strings.Split(profiles, "\n") {
+		if strings.HasPrefix(line, prefix) && strings.HasSuffix(line, ")") {
+			return v1alpha1.AppArmorProfileMode(strings.TrimSuffix(strings.TrimPrefix(line, prefix), ")")), true
+		}
+	}
+	return "", false
+}
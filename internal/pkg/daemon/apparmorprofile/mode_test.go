@@ -0,0 +1,156 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apparmorprofile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+)
+
+func TestApplyProfileMode(t *testing.T) {
+	t.Parallel()
+
+	policy := `#include <tunables/global>
+
+profile test-profile flags=(attach_disconnected, complain) {
+  #include <abstractions/base>
+  file,
+  /{usr/,}bin/** rix,
+
+  ^hat {
+    network,
+  }
+
+  profile child /usr/bin/child {
+    capability chown,
+  }
+}
+`
+	for _, tc := range []struct {
+		name     string
+		mode     v1alpha1.AppArmorProfileMode
+		expected string
+	}{
+		{
+			name:     "unset",
+			expected: policy,
+		},
+		{
+			name: "complain",
+			mode: v1alpha1.AppArmorProfileModeComplain,
+			expected: `#include <tunables/global>
+
+profile test-profile flags=(attach_disconnected,complain) {
+  #include <abstractions/base>
+  file,
+  /{usr/,}bin/** rix,
+
+  ^hat flags=(complain) {
+    network,
+  }
+
+  profile child /usr/bin/child flags=(complain) {
+    capability chown,
+  }
+}
+`,
+		},
+		{
+			name: "enforce",
+			mode: v1alpha1.AppArmorProfileModeEnforce,
+			expected: `#include <tunables/global>
+
+profile test-profile flags=(attach_disconnected) {
+  #include <abstractions/base>
+  file,
+  /{usr/,}bin/** rix,
+
+  ^hat {
+    network,
+  }
+
+  profile child /usr/bin/child {
+    capability chown,
+  }
+}
+`,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.expected, applyProfileMode(policy, tc.mode))
+		})
+	}
+}
+
+func TestApplyProfileModePathProfile(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t,
+		"/usr/bin/foo flags=(complain) {\n  file,\n}",
+		applyProfileMode("/usr/bin/foo flags=(enforce) {\n  file,\n}", v1alpha1.AppArmorProfileModeComplain),
+	)
+}
+
+func TestApplyProfileModeHeaders(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		policy   string
+		expected string
+	}{
+		{
+			name:     "one line profile",
+			policy:   "profile foo { /etc/passwd r, }\n",
+			expected: "profile foo flags=(complain) { /etc/passwd r, }\n",
+		},
+		{
+			name:     "brace on next line",
+			policy:   "profile foo flags=(attach_disconnected)\n{\n  /etc/passwd r,\n}\n",
+			expected: "profile foo flags=(attach_disconnected,complain)\n{\n  /etc/passwd r,\n}\n",
+		},
+		{
+			name:     "trailing comment",
+			policy:   "profile foo { # the foo profile\n  ^hat { # a hat\n    /etc/passwd r,\n  }\n}\n",
+			expected: "profile foo flags=(complain) { # the foo profile\n  ^hat flags=(complain) { # a hat\n    /etc/passwd r,\n  }\n}\n",
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.expected, applyProfileMode(tc.policy, v1alpha1.AppArmorProfileModeComplain))
+		})
+	}
+}
+
+func TestParseLoadedMode(t *testing.T) {
+	t.Parallel()
+
+	profiles := "test-profile-2 (enforce)\ntest-profile (complain)\ntest-profile//hat (complain)\n"
+
+	mode, ok := parseLoadedMode(profiles, "test-profile")
+	require.True(t, ok)
+	require.Equal(t, v1alpha1.AppArmorProfileModeComplain, mode)
+
+	_, ok = parseLoadedMode(profiles, "test")
+	require.False(t, ok)
+}
@@ -16,7 +16,10 @@ limitations under the License.
 
 package apparmorprofile
 
-import profilebasev1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+import (
+	"sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	profilebasev1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+)
 
 type ProfileManager interface {
 	// Enabled checks whether the given profile technology is supported and
@@ -28,4 +31,12 @@ type ProfileManager interface {
 
 	// RemoveProfile ensure the profile is uninstalled/deleted/unloaded from the host.
 	RemoveProfile(p profilebasev1alpha1.StatusBaseUser) error
+
+	// LoadedMode returns the mode in which the profile is loaded into the host.
+	LoadedMode(p profilebasev1alpha1.StatusBaseUser) (v1alpha1.AppArmorProfileMode, error)
+
+	// SyncIncludes ensures the include directory of the operator in the host
+	// contains exactly the given includes, which map the file names to their
+	// content.
+	SyncIncludes(includes map[string]string) error
 }
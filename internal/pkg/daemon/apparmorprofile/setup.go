@@ -20,14 +20,18 @@ import (
 	"context"
 
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 
 	"sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/common"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/nodestatus"
 )
 
 // Setup adds a controller that reconciles AppArmor profiles.
 func (r *Reconciler) Setup(
-	_ context.Context,
+	ctx context.Context,
 	mgr ctrl.Manager,
 	met *metrics.Metrics,
 ) error {
@@ -39,9 +43,32 @@ func (r *Reconciler) Setup(
 
 	r.logNodeInfo()
 
-	// Register the regular reconciler to manage AppArmorProfiles
-	return ctrl.NewControllerManagedBy(mgr).
-		Named("apparmorprofile").
-		For(&v1alpha1.AppArmorProfile{}).
+	name := "apparmorprofile"
+	if r.cluster {
+		name = "clusterapparmorprofile"
+	}
+
+	newList := func() client.ObjectList {
+		if r.cluster {
+			return &v1alpha1.ClusterAppArmorProfileList{}
+		}
+		return &v1alpha1.AppArmorProfileList{}
+	}
+
+	b := ctrl.NewControllerManagedBy(mgr)
+	if !r.cluster {
+		b = common.WatchNamespaceScope(ctx, b, r.client)
+	}
+	b = nodestatus.WatchNodeLabels(b, r.client, newList)
+
+	// Register the regular reconciler to manage AppArmorProfiles, which get
+	// reloaded when one of their includes changes
+	return b.
+		Named(name).
+		For(r.newProfile()).
+		Watches(
+			&v1alpha1.AppArmorInclude{},
+			handler.EnqueueRequestsFromMapFunc(enqueueDependents(r.client, newList)),
+		).
 		Complete(r)
 }
@@ -4,6 +4,7 @@
 #include <bpf/bpf_core_read.h>
 #include <bpf/bpf_helpers.h>
 
+// Default map sizes, which can be changed from userspace before loading.
 #define MAX_ENTRIES 8 * 1024
 #define MAX_SYSCALLS 1024
 #define MAX_COMM_LEN 64
@@ -24,6 +25,18 @@ struct {
     __type(value, u32);  // mntns ID
 } pid_mntns SEC(".maps");
 
+// Indexes of the map_overflows map.
+#define OVERFLOW_PID_MNTNS 0
+#define OVERFLOW_MNTNS_SYSCALLS 1
+#define OVERFLOW_MAPS 2
+
+struct {
+    __uint(type, BPF_MAP_TYPE_ARRAY);
+    __uint(max_entries, OVERFLOW_MAPS);
+    __type(key, u32);    // map index
+    __type(value, u64);  // failed inserts
+} map_overflows SEC(".maps");
+
 struct {
     __uint(type, BPF_MAP_TYPE_RINGBUF);
     __uint(max_entries, 1 << 24);
@@ -32,11 +45,13 @@ struct {
 struct event_t {
     u32 pid;
     u32 mntns;
+    u64 cgroup_id;
 };
 
 const volatile char filter_name[MAX_COMM_LEN] = {};
 
 static inline bool is_filtered(char * comm);
+static inline void record_overflow(u32 index);
 
 SEC("tracepoint/raw_syscalls/sys_enter")
 int sys_enter(struct trace_event_raw_sys_enter * args)
@@ -72,23 +87,28 @@ int sys_enter(struct trace_event_raw_sys_enter * args)
     }
 
     // Notify the userspace when a new PID is found. This will allow
-    // the userspace to look up the container ID from cgroups of the
-    // process. And using the container ID, it will search further the
-    // security profile assigned to this container in the cluster.
+    // the userspace to look up the container ID from the cgroup of the
+    // process, even if it exits before the userspace handles the event.
+    // And using the container ID, it will search further the security
+    // profile assigned to this container in the cluster.
     u32 * current_pid_mntns = NULL;
     current_pid_mntns = bpf_map_lookup_elem(&pid_mntns, &pid);
     if (current_pid_mntns == NULL) {
         struct event_t * event =
             bpf_ringbuf_reserve(&events, sizeof(struct event_t), 0);
         if (event) {
-            bpf_printk("send event pid: %u, mntns: %u, comm: %s\n", pid, mntns,
-                       comm);
+            u64 cgroup_id = bpf_get_current_cgroup_id();
+            bpf_printk("send event pid: %u, mntns: %u, cgroup: %llu\n", pid,
+                       mntns, cgroup_id);
 
             event->pid = pid;
             event->mntns = mntns;
+            event->cgroup_id = cgroup_id;
             bpf_ringbuf_submit(event, 0);
 
-            bpf_map_update_elem(&pid_mntns, &pid, &mntns, BPF_ANY);
+            if (bpf_map_update_elem(&pid_mntns, &pid, &mntns, BPF_ANY) != 0) {
+                record_overflow(OVERFLOW_PID_MNTNS);
+            }
         }
     }
 
@@ -100,7 +120,11 @@ int sys_enter(struct trace_event_raw_sys_enter * args)
     } else {
         // Initialise the syscalls recording buffer and record this syscall.
         static const char init[MAX_SYSCALLS];
-        bpf_map_update_elem(&mntns_syscalls, &mntns, &init, BPF_ANY);
+        if (bpf_map_update_elem(&mntns_syscalls, &mntns, &init, BPF_ANY) !=
+            0) {
+            record_overflow(OVERFLOW_MNTNS_SYSCALLS);
+            return 0;
+        }
         u8 * const value = bpf_map_lookup_elem(&mntns_syscalls, &mntns);
         if (!value) {
             // Should not happen, we updated the element straight ahead
@@ -116,6 +140,29 @@ int sys_enter(struct trace_event_raw_sys_enter * args)
     return 0;
 }
 
+SEC("tracepoint/sched/sched_process_exit")
+int sched_process_exit(void * ctx)
+{
+    u64 pid_tgid = bpf_get_current_pid_tgid();
+    u32 pid = pid_tgid >> 32;
+
+    // Only the exit of the thread group leader ends the process
+    if ((u32)pid_tgid != pid) {
+        return 0;
+    }
+
+    bpf_map_delete_elem(&pid_mntns, &pid);
+    return 0;
+}
+
+static inline void record_overflow(u32 index)
+{
+    u64 * count = bpf_map_lookup_elem(&map_overflows, &index);
+    if (count) {
+        __sync_fetch_and_add(count, 1);
+    }
+}
+
 static inline bool is_filtered(char * comm)
 {
     // No filter set
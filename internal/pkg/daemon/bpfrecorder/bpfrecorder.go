@@ -44,6 +44,7 @@ import (
 	"golang.org/x/sync/semaphore"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
 
@@ -62,19 +63,59 @@ const (
 	maxCacheItems       uint64        = 1000
 	defaultHostPid      uint32        = 1
 	defaultByteNum      int           = 4
+	cgroupRoot          string        = "/sys/fs/cgroup"
+	// mapMaintenanceInterval is the interval for evicting stale entries
+	// from the BPF maps and reporting map overflows.
+	mapMaintenanceInterval time.Duration = time.Minute
+	// cgroupScanInterval is the minimum interval between two scans of the
+	// cgroup hierarchy.
+	cgroupScanInterval time.Duration = time.Second
+	// cgroupScanTimeout is the maximum time an event waits for a scan of the
+	// cgroup hierarchy.
+	cgroupScanTimeout time.Duration = 10 * time.Second
+	// cgroupMissTimeout is the time a cgroup without container is remembered
+	// for, before another scan of the cgroup hierarchy may be requested for it.
+	cgroupMissTimeout time.Duration = time.Minute
 )
 
+// errMapFull is logged if inserts into a BPF map failed because it is full.
+var errMapFull = errors.New("bpf map is full")
+
+// overflowMaps are the BPF maps for which failed inserts get counted, in the
+// order of their index in the map_overflows map.
+var overflowMaps = []string{"pid_mntns", "mntns_syscalls"}
+
+// event is the event_t sent by the BPF program for every new process.
+type event struct {
+	Pid      uint32
+	Mntns    uint32
+	CgroupID uint64
+}
+
 // BpfRecorder is the main structure of this package.
 type BpfRecorder struct {
 	api.UnimplementedBpfRecorderServer
 	impl
 	logger                  logr.Logger
 	startRequests           int64
+	recordings              map[string]*recording
+	recordingsMutex         sync.Mutex
 	syscalls                *bpf.BPFMap
 	mntns                   *bpf.BPFMap
+	overflows               *bpf.BPFMap
+	reportedOverflows       []uint64
+	maxMountNamespaces      uint32
+	maxProcesses            uint32
+	stopMaintenance         chan struct{}
 	btfPath                 string
 	syscallIDtoNameCache    *ttlcache.Cache[string, string]
 	pidToContainerIDCache   *ttlcache.Cache[string, string]
+	cgroupMissCache         *ttlcache.Cache[string, string]
+	cgroupContainerIDs      map[uint64]string
+	cgroupMntns             map[uint64]uint32
+	cgroupMutex             sync.RWMutex
+	cgroupScanDone          chan struct{}
+	cgroupScanRequests      chan struct{}
 	mntnsToContainerIDMap   *bimap.BiMap[uint32, string]
 	containerIDToProfileMap *bimap.BiMap[string, string]
 	nodeName                string
@@ -85,6 +126,17 @@ type BpfRecorder struct {
 	programNameFilter       string
 }
 
+// recording is a ProfileRecording for which the bpf recorder got started.
+type recording struct {
+	// requests is the number of start requests which have not been stopped
+	// yet, usually one per recorded pod.
+	requests int64
+	// profiles are the profile annotations of the recorded containers.
+	profiles map[string]struct{}
+	// mntns are the mount namespaces of the recorded containers.
+	mntns map[uint32]struct{}
+}
+
 // New returns a new BpfRecorder instance.
 func New(logger logr.Logger) *BpfRecorder {
 	return &BpfRecorder{
@@ -98,9 +150,18 @@ func New(logger logr.Logger) *BpfRecorder {
 			ttlcache.WithTTL[string, string](defaultCacheTimeout),
 			ttlcache.WithCapacity[string, string](maxCacheItems),
 		),
+		cgroupMissCache: ttlcache.New(
+			ttlcache.WithTTL[string, string](cgroupMissTimeout),
+			ttlcache.WithCapacity[string, string](maxCacheItems),
+		),
+		cgroupContainerIDs:      map[uint64]string{},
+		cgroupMntns:             map[uint64]uint32{},
+		cgroupScanDone:          make(chan struct{}),
+		cgroupScanRequests:      make(chan struct{}, 1),
 		mntnsToContainerIDMap:   bimap.New[uint32, string](),
 		containerIDToProfileMap: bimap.New[string, string](),
 		loadUnloadMutex:         sync.RWMutex{},
+		recordings:              map[string]*recording{},
 	}
 }
 
@@ -120,6 +181,7 @@ func (b *BpfRecorder) Run() error {
 	b.logger.Info(fmt.Sprintf("Setting up caches with expiry of %v", defaultCacheTimeout))
 	for _, cache := range []*ttlcache.Cache[string, string]{
 		b.pidToContainerIDCache,
+		b.cgroupMissCache,
 		b.syscallIDtoNameCache,
 	} {
 		go cache.Start()
@@ -133,6 +195,9 @@ func (b *BpfRecorder) Run() error {
 	}
 	b.logger.Info("Starting ebpf recorder on node: " + b.nodeName)
 
+	b.maxMountNamespaces = b.mapSizeFromEnv(config.BpfRecorderMaxMountNamespacesEnvKey)
+	b.maxProcesses = b.mapSizeFromEnv(config.BpfRecorderMaxProcessesEnvKey)
+
 	clusterConfig, err := b.InClusterConfig()
 	if err != nil {
 		return fmt.Errorf("get in-cluster config: %w", err)
@@ -200,6 +265,22 @@ func (b *BpfRecorder) Run() error {
 	return b.Serve(grpcServer, listener)
 }
 
+// mapSizeFromEnv returns the BPF map size configured by the provided
+// environment variable or zero if the default size should be used.
+func (b *BpfRecorder) mapSizeFromEnv(key string) uint32 {
+	value := b.Getenv(key)
+	if value == "" {
+		return 0
+	}
+
+	size, err := strconv.ParseUint(value, 10, 32)
+	if err != nil || size == 0 {
+		b.logger.Info("Ignoring invalid BPF map size", "key", key, "value", value)
+		return 0
+	}
+	return uint32(size)
+}
+
 func (b *BpfRecorder) connectMetrics() (conn *grpc.ClientConn, cancel context.CancelFunc, err error) {
 	if err := util.Retry(func() (err error) {
 		conn, cancel, err = b.DialMetrics()
@@ -241,33 +322,64 @@ func Dial() (*grpc.ClientConn, context.CancelFunc, error) {
 	return conn, cancel, nil
 }
 
+// Start the bpf recorder for the provided recording. The eBPF program gets
+// loaded on the first request of any recording.
 func (b *BpfRecorder) Start(
-	context.Context, *api.EmptyRequest,
+	_ context.Context, r *api.StartRequest,
 ) (*api.EmptyResponse, error) {
-	if b.startRequests == 0 {
-		b.logger.Info("Starting bpf recorder")
+	b.recordingsMutex.Lock()
+	defer b.recordingsMutex.Unlock()
+
+	if atomic.LoadInt64(&b.startRequests) == 0 {
+		b.logger.Info("Starting bpf recorder", "recording", r.Recording)
 		//nolint:contextcheck // no context intended here
 		if err := b.Load(true); err != nil {
 			return nil, fmt.Errorf("load bpf: %w", err)
 		}
 	} else {
-		b.logger.Info("bpf recorder already running")
+		b.logger.Info("bpf recorder already running", "recording", r.Recording)
+	}
+
+	rec, ok := b.recordings[r.Recording]
+	if !ok {
+		rec = &recording{
+			profiles: map[string]struct{}{},
+			mntns:    map[uint32]struct{}{},
+		}
+		b.recordings[r.Recording] = rec
+	}
+	rec.requests++
+	for _, profile := range r.Profiles {
+		rec.profiles[profile] = struct{}{}
 	}
 
 	atomic.AddInt64(&b.startRequests, 1)
 	return &api.EmptyResponse{}, nil
 }
 
+// Stop the bpf recorder for the provided recording. The data of a recording
+// gets removed once all of its start requests got stopped, and the eBPF
+// program gets unloaded if no other recording is in progress.
 func (b *BpfRecorder) Stop(
-	context.Context, *api.EmptyRequest,
+	_ context.Context, r *api.StopRequest,
 ) (*api.EmptyResponse, error) {
-	if b.startRequests == 0 {
-		b.logger.Info("bpf recorder not running")
+	b.recordingsMutex.Lock()
+	defer b.recordingsMutex.Unlock()
+
+	rec, ok := b.recordings[r.Recording]
+	if !ok {
+		b.logger.Info("bpf recorder not running for recording", "recording", r.Recording)
 		return &api.EmptyResponse{}, nil
 	}
 
-	atomic.AddInt64(&b.startRequests, -1)
-	if b.startRequests == 0 {
+	rec.requests--
+	if rec.requests == 0 {
+		b.logger.Info("Finished recording", "recording", r.Recording)
+		delete(b.recordings, r.Recording)
+		b.cleanupRecording(rec)
+	}
+
+	if atomic.AddInt64(&b.startRequests, -1) == 0 {
 		b.logger.Info("Stopping bpf recorder")
 		b.Unload()
 	} else {
@@ -276,44 +388,69 @@ func (b *BpfRecorder) Stop(
 	return &api.EmptyResponse{}, nil
 }
 
+// cleanupRecording removes the cached containers and the recorded syscalls of
+// a finished recording.
+func (b *BpfRecorder) cleanupRecording(rec *recording) {
+	for profile := range rec.profiles {
+		b.deleteContainerIDFromCache(profile)
+	}
+
+	b.loadUnloadMutex.Lock()
+	defer b.loadUnloadMutex.Unlock()
+	for mntns := range rec.mntns {
+		b.mntnsToContainerIDMap.Delete(mntns)
+		if b.syscalls == nil {
+			continue
+		}
+		if err := b.DeleteKey(b.syscalls, mntns); err != nil {
+			b.logger.V(config.VerboseLevel).Info(
+				"Unable to cleanup syscalls map", "mntns", mntns, "err", err.Error(),
+			)
+		}
+	}
+}
+
+// trackRecordingMntns assigns the mount namespace to the active recording of
+// the profile.
+func (b *BpfRecorder) trackRecordingMntns(mntns uint32, profile string) {
+	b.recordingsMutex.Lock()
+	defer b.recordingsMutex.Unlock()
+
+	for name, rec := range b.recordings {
+		if _, ok := rec.profiles[profile]; ok {
+			b.logger.V(config.VerboseLevel).Info(
+				"Assigned mount namespace to recording",
+				"mntns", mntns, "profile", profile, "recording", name,
+			)
+			rec.mntns[mntns] = struct{}{}
+			return
+		}
+	}
+}
+
 // SyscallsForProfile returns the syscall names for the provided profile name.
 func (b *BpfRecorder) SyscallsForProfile(
-	_ context.Context, r *api.ProfileRequest,
+	ctx context.Context, r *api.ProfileRequest,
 ) (*api.SyscallsResponse, error) {
-	if b.startRequests == 0 {
+	if atomic.LoadInt64(&b.startRequests) == 0 {
 		return nil, errors.New("bpf recorder not running")
 	}
 	b.logger.Info("Getting syscalls for profile " + r.Name)
 
-	// There is a chance to miss the PID if concurrent processes are being
-	// analyzed. If we request the `SyscallsForProfile` exactly between two
-	// events, while the first one is from a different recording container and
-	// we have to expect the profile in the second event. We try to overcome
-	// this race by retrying, but with a more loose backoff strategy than
-	// retrying to retrieve the in-cluster container ID.
-	var (
-		mntns uint32
-		try   = -1
-	)
-	if err := util.Retry(
-		func() error {
-			try++
-			b.logger.Info("Looking up mount namespace for profile", "try", try, "profile", r.Name)
-
-			if foundMntns, ok := b.getMntnsForProfile(r.Name); ok {
-				mntns = foundMntns
-				b.logger.Info("Found mount namespace for profile", "mntns", mntns, "profile", r.Name)
-				b.deleteContainerIDFromCache(r.Name)
-				return nil
-			}
-
-			b.logger.Info("No mount namespace found for profile", "profile", r.Name)
-			return ErrNotFound
-		},
-		func(error) bool { return true },
-	); err != nil {
+	// The events of the container may still be processed, which means that
+	// its profile is not yet known. The mount namespace of every cgroup gets
+	// tracked as soon as the first event of it arrives, so that it can be
+	// looked up directly by the cgroup of the container.
+	mntns, ok := b.getMntnsForProfile(r.Name)
+	if !ok {
+		mntns, ok = b.lookupMntnsForProfile(ctx, r.Name)
+	}
+	if !ok {
+		b.logger.Info("No mount namespace found for profile", "profile", r.Name)
 		return nil, ErrNotFound
 	}
+	b.logger.Info("Found mount namespace for profile", "mntns", mntns, "profile", r.Name)
+	b.deleteContainerIDFromCache(r.Name)
 
 	b.loadUnloadMutex.RLock()
 	syscalls, err := b.GetValue(b.syscalls, mntns)
@@ -331,6 +468,61 @@ func (b *BpfRecorder) SyscallsForProfile(
 		b.logger.Error(err, "Unable to cleanup syscalls map", "mntns", mntns)
 	}
 	b.loadUnloadMutex.Unlock()
+	b.deleteCgroupMntns(mntns)
+
+	return &api.SyscallsResponse{
+		Syscalls: sortUnique(syscallNames),
+		GoArch:   runtime.GOARCH,
+	}, nil
+}
+
+// SyscallsForLocalhostProfile returns the syscall names recorded for the
+// running containers of the node which use the provided seccomp localhost
+// profile. Other than for recordings, the syscalls are kept in the map,
+// because the containers are still running. Only the syscalls since the bpf
+// recorder got loaded for a recording are available.
+func (b *BpfRecorder) SyscallsForLocalhostProfile(
+	ctx context.Context, r *api.ProfileRequest,
+) (*api.SyscallsResponse, error) {
+	if atomic.LoadInt64(&b.startRequests) == 0 {
+		return nil, errors.New("bpf recorder not running")
+	}
+
+	pods, err := b.ListPods(ctx, b.clientset, b.nodeName)
+	if err != nil {
+		return nil, fmt.Errorf("list node pods: %w", err)
+	}
+
+	syscallNames := []string{}
+	for p := range pods.Items {
+		pod := &pods.Items[p]
+		//nolint:gocritic // We explicitly do not want to append to the same slice
+		statuses := append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...)
+		for c := range statuses {
+			if seccompLocalhostProfile(pod, statuses[c].Name) != r.Name {
+				continue
+			}
+			containerID := util.ContainerIDRegex.FindString(statuses[c].ContainerID)
+			if containerID == "" {
+				continue
+			}
+			mntns, ok := b.mntnsToContainerIDMap.GetBackwards(containerID)
+			if !ok {
+				continue
+			}
+
+			b.loadUnloadMutex.RLock()
+			syscalls, err := b.GetValue(b.syscalls, mntns)
+			b.loadUnloadMutex.RUnlock()
+			if err != nil {
+				b.logger.V(config.VerboseLevel).Info(
+					"No syscalls found for mntns", "mntns", mntns, "err", err.Error(),
+				)
+				continue
+			}
+			syscallNames = append(syscallNames, b.convertSyscallIDsToNames(syscalls)...)
+		}
+	}
 
 	return &api.SyscallsResponse{
 		Syscalls: sortUnique(syscallNames),
@@ -338,6 +530,27 @@ func (b *BpfRecorder) SyscallsForProfile(
 	}, nil
 }
 
+// seccompLocalhostProfile returns the seccomp localhost profile of the
+// container, which falls back to the one of the pod.
+func seccompLocalhostProfile(pod *v1.Pod, containerName string) string {
+	var profile *v1.SeccompProfile
+	if pod.Spec.SecurityContext != nil {
+		profile = pod.Spec.SecurityContext.SeccompProfile
+	}
+	//nolint:gocritic // We explicitly do not want to append to the same slice
+	for _, container := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+		if container.Name == containerName &&
+			container.SecurityContext != nil &&
+			container.SecurityContext.SeccompProfile != nil {
+			profile = container.SecurityContext.SeccompProfile
+		}
+	}
+	if profile == nil || profile.Type != v1.SeccompProfileTypeLocalhost || profile.LocalhostProfile == nil {
+		return ""
+	}
+	return *profile.LocalhostProfile
+}
+
 func (b *BpfRecorder) getMntnsForProfile(profile string) (uint32, bool) {
 	if containerID, ok := b.containerIDToProfileMap.GetBackwards(profile); ok {
 		if mntns, ok := b.mntnsToContainerIDMap.GetBackwards(containerID); ok {
@@ -347,6 +560,42 @@ func (b *BpfRecorder) getMntnsForProfile(profile string) (uint32, bool) {
 	return 0, false
 }
 
+// lookupMntnsForProfile resolves the mount namespace of the container of the
+// profile without waiting for its events to be processed. The container ID
+// gets looked up in the cluster and the mount namespace by the cgroup of the
+// container.
+func (b *BpfRecorder) lookupMntnsForProfile(ctx context.Context, profile string) (uint32, bool) {
+	if _, err := b.cacheProfilesInCluster(ctx); err != nil {
+		b.logger.Error(err, "Unable to look up profiles in cluster", "profile", profile)
+		return 0, false
+	}
+	containerID, ok := b.containerIDToProfileMap.GetBackwards(profile)
+	if !ok {
+		return 0, false
+	}
+	if mntns, ok := b.mntnsToContainerIDMap.GetBackwards(containerID); ok {
+		return mntns, true
+	}
+
+	cgroupID, ok := b.scannedCgroupID(containerID)
+	if !ok {
+		if !b.waitForCgroupScan() {
+			return 0, false
+		}
+		if cgroupID, ok = b.scannedCgroupID(containerID); !ok {
+			return 0, false
+		}
+	}
+
+	b.cgroupMutex.RLock()
+	mntns, ok := b.cgroupMntns[cgroupID]
+	b.cgroupMutex.RUnlock()
+	if ok {
+		b.mntnsToContainerIDMap.Insert(mntns, containerID)
+	}
+	return mntns, ok
+}
+
 func (b *BpfRecorder) deleteContainerIDFromCache(profile string) {
 	if containerID, ok := b.containerIDToProfileMap.GetBackwards(profile); ok {
 		b.containerIDToProfileMap.Delete(containerID)
@@ -411,6 +660,19 @@ func (b *BpfRecorder) Load(startEventProcessor bool) (err error) {
 		}
 	}
 
+	for mapName, size := range map[string]uint32{
+		"mntns_syscalls": b.maxMountNamespaces,
+		"pid_mntns":      b.maxProcesses,
+	} {
+		if size == 0 {
+			continue
+		}
+		b.logger.Info("Resizing bpf map", "map", mapName, "maxEntries", size)
+		if err := b.ResizeMap(module, mapName, size); err != nil {
+			return fmt.Errorf("resize %s map: %w", mapName, err)
+		}
+	}
+
 	b.logger.Info("Loading bpf object from module")
 	if err := b.BPFLoadObject(module); err != nil {
 		return fmt.Errorf("load bpf object: %w", err)
@@ -428,6 +690,24 @@ func (b *BpfRecorder) Load(startEventProcessor bool) (err error) {
 		return fmt.Errorf("attach tracepoint: %w", err)
 	}
 
+	// Objects built before the process exit program and the overflow map got
+	// introduced do not provide them, which leaves the removal of exited
+	// processes to the map maintenance and disables the overflow reporting.
+	const exitProgramName = "sched_process_exit"
+	b.logger.Info("Getting bpf program " + exitProgramName)
+	exitProgram, err := b.GetProgram(module, exitProgramName)
+	if err != nil {
+		b.logger.Info(
+			"BPF object does not provide the process exit program",
+			"program", exitProgramName, "err", err.Error(),
+		)
+	} else {
+		b.logger.Info("Attaching bpf process exit tracepoint")
+		if _, err := b.AttachTracepoint(exitProgram, "sched", exitProgramName); err != nil {
+			return fmt.Errorf("attach process exit tracepoint: %w", err)
+		}
+	}
+
 	b.logger.Info("Getting syscalls map")
 	syscalls, err := b.GetMap(module, "mntns_syscalls")
 	if err != nil {
@@ -440,8 +720,20 @@ func (b *BpfRecorder) Load(startEventProcessor bool) (err error) {
 		return fmt.Errorf("get pid_mntns: %w", err)
 	}
 
+	b.logger.Info("Getting map_overflows map")
+	overflows, err := b.GetMap(module, "map_overflows")
+	if err != nil {
+		b.logger.Info(
+			"BPF object does not provide the map overflow counters",
+			"err", err.Error(),
+		)
+		overflows = nil
+	}
+
 	b.syscalls = syscalls
 	b.mntns = mntns
+	b.overflows = overflows
+	b.reportedOverflows = make([]uint64, len(overflowMaps))
 
 	// Update the host mntns into pid_mntns map
 	b.updateSystemMntns()
@@ -456,6 +748,10 @@ func (b *BpfRecorder) Load(startEventProcessor bool) (err error) {
 
 	if startEventProcessor {
 		go b.processEvents(events)
+
+		b.stopMaintenance = make(chan struct{})
+		go b.maintainMaps(b.stopMaintenance)
+		go b.scanCgroups(b.stopMaintenance)
 	}
 
 	b.logger.Info("Module successfully loaded")
@@ -572,14 +868,35 @@ func (b *BpfRecorder) processEvents(events chan []byte) {
 	const workers = 1000
 	sem := semaphore.NewWeighted(workers)
 
-	for event := range events {
+	for data := range events {
+		e := event{}
+		if err := binary.Read(bytes.NewReader(data), binary.LittleEndian, &e); err != nil {
+			b.logger.Error(err, "Unable to read event")
+			continue
+		}
+		b.logger.V(config.VerboseLevel).Info(fmt.Sprintf(
+			"Received event: pid: %d, mntns: %d, cgroup: %d", e.Pid, e.Mntns, e.CgroupID,
+		))
+
+		// Track the mount namespace of the cgroup and resolve containers
+		// known without waiting before handling the next event, so that
+		// profiles can be looked up while their events are still handled.
+		if e.CgroupID != 0 {
+			b.cgroupMutex.Lock()
+			b.cgroupMntns[e.CgroupID] = e.Mntns
+			b.cgroupMutex.Unlock()
+		}
+		containerID, err := b.knownContainerID(e.Pid, e.CgroupID)
+		if err == nil {
+			b.mntnsToContainerIDMap.Insert(e.Mntns, containerID)
+		}
+
 		if err := sem.Acquire(context.Background(), 1); err != nil {
 			b.logger.Error(err, "Unable to acquire semaphore, stopping event processor")
 			break
 		}
-		eventCopy := event
 		go func() {
-			b.handleEvent(eventCopy)
+			b.handleEvent(e, containerID, err)
 			sem.Release(1)
 		}()
 	}
@@ -594,32 +911,32 @@ func (b *BpfRecorder) updateSystemMntns() {
 	}
 }
 
-func (b *BpfRecorder) handleEvent(event []byte) {
-	e := struct {
-		Pid   uint32
-		Mntns uint32
-	}{}
-
-	if err := binary.Read(bytes.NewReader(event), binary.LittleEndian, &e); err != nil {
-		b.logger.Error(err, "Unable to read event")
-		return
-	}
-
-	b.logger.V(config.VerboseLevel).Info(fmt.Sprintf("Received event: pid: %d, mntns: %d", e.Pid, e.Mntns))
-
+// handleEvent finds the profile of the container of an event. The container
+// ID has already been looked up without waiting, which failed if lookupErr is
+// set.
+func (b *BpfRecorder) handleEvent(e event, containerID string, lookupErr error) {
 	pid := e.Pid
 	mntns := e.Mntns
 
-	// Look up the container ID based on PID from cgroup file.
-	containerID, err := b.ContainerIDForPID(b.pidToContainerIDCache, int(pid))
-	if err != nil {
-		b.logger.V(config.VerboseLevel).Info(
-			"No container ID found for PID",
-			"pid", pid, "mntns", mntns, "err", err.Error(),
-		)
-		return
+	if lookupErr != nil {
+		if e.CgroupID == 0 {
+			b.logger.V(config.VerboseLevel).Info(
+				"No container ID found for PID",
+				"pid", pid, "mntns", mntns, "err", lookupErr.Error(),
+			)
+			return
+		}
+		rescannedID, ok := b.rescannedContainerID(e.CgroupID)
+		if !ok {
+			b.logger.V(config.VerboseLevel).Info(
+				"No container ID found for PID",
+				"pid", pid, "mntns", mntns, "err", lookupErr.Error(),
+			)
+			return
+		}
+		containerID = rescannedID
+		b.mntnsToContainerIDMap.Insert(mntns, containerID)
 	}
-	b.mntnsToContainerIDMap.Insert(mntns, containerID)
 
 	b.logger.V(config.VerboseLevel).Info(
 		"Found container ID for PID", "pid", pid,
@@ -637,9 +954,155 @@ func (b *BpfRecorder) handleEvent(event []byte) {
 		"pid", pid, "mntns", mntns, "profile", profile,
 	)
 
+	b.trackRecordingMntns(mntns, profile)
 	b.trackProfileMetric(mntns, profile)
 }
 
+// containerIDForProcess resolves the container ID of a process like
+// knownContainerID. If the process exited in the meantime, it waits for a new
+// scan of the cgroup hierarchy.
+func (b *BpfRecorder) containerIDForProcess(pid uint32, cgroupID uint64) (string, error) {
+	containerID, err := b.knownContainerID(pid, cgroupID)
+	if err == nil || cgroupID == 0 {
+		return containerID, err
+	}
+
+	if containerID, ok := b.rescannedContainerID(cgroupID); ok {
+		return containerID, nil
+	}
+	return "", err
+}
+
+// knownContainerID resolves the container ID of a process without waiting,
+// by its cgroup ID from the last scan of the cgroup hierarchy, or otherwise by
+// the cgroup file of the PID.
+func (b *BpfRecorder) knownContainerID(pid uint32, cgroupID uint64) (string, error) {
+	if cgroupID != 0 {
+		if containerID, ok := b.scannedContainerID(cgroupID); ok {
+			return containerID, nil
+		}
+	}
+
+	// Look up the container ID based on PID from cgroup file.
+	return b.ContainerIDForPID(b.pidToContainerIDCache, int(pid))
+}
+
+// scannedContainerID returns the container ID of the cgroup from the last scan
+// of the cgroup hierarchy.
+func (b *BpfRecorder) scannedContainerID(cgroupID uint64) (string, bool) {
+	b.cgroupMutex.RLock()
+	defer b.cgroupMutex.RUnlock()
+	containerID, ok := b.cgroupContainerIDs[cgroupID]
+	return containerID, ok
+}
+
+// rescannedContainerID waits for a new scan of the cgroup hierarchy and
+// returns the container ID of the cgroup from it. Cgroups which do not belong
+// to any container, like the ones of system services, are remembered to not
+// request a scan for every of their processes.
+func (b *BpfRecorder) rescannedContainerID(cgroupID uint64) (string, bool) {
+	key := strconv.FormatUint(cgroupID, 10)
+	if b.cgroupMissCache.Get(key) != nil {
+		return "", false
+	}
+
+	if !b.waitForCgroupScan() {
+		return "", false
+	}
+
+	containerID, ok := b.scannedContainerID(cgroupID)
+	if !ok {
+		b.cgroupMissCache.Set(key, "", ttlcache.DefaultTTL)
+	}
+	return containerID, ok
+}
+
+// scannedCgroupID returns the cgroup ID of the container from the last scan of
+// the cgroup hierarchy.
+func (b *BpfRecorder) scannedCgroupID(containerID string) (uint64, bool) {
+	b.cgroupMutex.RLock()
+	defer b.cgroupMutex.RUnlock()
+	for cgroupID, id := range b.cgroupContainerIDs {
+		if id == containerID {
+			return cgroupID, true
+		}
+	}
+	return 0, false
+}
+
+// waitForCgroupScan requests a new scan of the cgroup hierarchy and waits for
+// it. It returns false if the scan did not finish within cgroupScanTimeout.
+func (b *BpfRecorder) waitForCgroupScan() bool {
+	// Only scans started after the request can know new cgroups.
+	b.cgroupMutex.RLock()
+	done := b.cgroupScanDone
+	b.cgroupMutex.RUnlock()
+
+	select {
+	case b.cgroupScanRequests <- struct{}{}:
+	default:
+		// A scan has already been requested.
+	}
+
+	select {
+	case <-done:
+		return true
+	case <-time.After(cgroupScanTimeout):
+		return false
+	}
+}
+
+// deleteCgroupMntns stops tracking the cgroups of the mount namespace.
+func (b *BpfRecorder) deleteCgroupMntns(mntns uint32) {
+	b.cgroupMutex.Lock()
+	defer b.cgroupMutex.Unlock()
+	for cgroupID, m := range b.cgroupMntns {
+		if m == mntns {
+			delete(b.cgroupMntns, cgroupID)
+		}
+	}
+}
+
+// scanCgroups scans the cgroup hierarchy for the container IDs of all cgroups
+// on request, until the stop channel gets closed. Requests get coalesced and
+// the scans are rate limited by the cgroupScanInterval.
+func (b *BpfRecorder) scanCgroups(stop <-chan struct{}) {
+	// Scan once initially to resolve the containers which already run.
+	select {
+	case b.cgroupScanRequests <- struct{}{}:
+	default:
+	}
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-b.cgroupScanRequests:
+		}
+
+		b.cgroupMutex.Lock()
+		done := b.cgroupScanDone
+		b.cgroupScanDone = make(chan struct{})
+		b.cgroupMutex.Unlock()
+
+		containerIDs, err := b.ContainerIDsForCgroups(cgroupRoot)
+		if err != nil {
+			b.logger.V(config.VerboseLevel).Info("Unable to scan cgroup hierarchy", "err", err.Error())
+		} else {
+			b.cgroupMutex.Lock()
+			b.cgroupContainerIDs = containerIDs
+			b.cgroupMutex.Unlock()
+		}
+		close(done)
+
+		select {
+		case <-stop:
+			return
+		case <-time.After(cgroupScanInterval):
+		}
+	}
+}
+
 // FindProcMountNamespace is looking up the mnt ns for a given PID.
 func (b *BpfRecorder) FindProcMountNamespace(pid uint32) (uint32, error) {
 	// This requires the container to run with host PID, otherwise we will get
@@ -695,71 +1158,14 @@ func (b *BpfRecorder) findProfileForContainerID(id string) (string, error) {
 		func() error {
 			try++
 			b.logger.Info("Looking up container ID in cluster", "id", id, "try", try)
-			pods, err := b.ListPods(ctx, b.clientset, b.nodeName)
+			containerIDs, err := b.cacheProfilesInCluster(ctx)
 			if err != nil {
-				return fmt.Errorf("list node pods: %w", err)
-			}
-			if pods == nil {
-				return fmt.Errorf("no pods found in cluster")
+				return err
 			}
 
-			for p := range pods.Items {
-				pod := &pods.Items[p]
-				//nolint:gocritic // We explicitly do not want to append to the same slice
-				statuses := append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...)
-				for c := range statuses {
-					containerStatus := statuses[c]
-					fullContainerID := containerStatus.ContainerID
-					containerName := containerStatus.Name
-
-					// The container ID is not yet available in the container status of the pod.
-					// This container can be skipped for now, the status will be checked again later.
-					if fullContainerID == "" {
-						b.logger.Info(
-							"Container ID not yet available in cluster",
-							"containerID", id,
-							"podName", pod.Name,
-							"containerName", containerName,
-						)
-						continue
-					}
-
-					containerID := util.ContainerIDRegex.FindString(fullContainerID)
-					if containerID == "" {
-						b.logger.Error(err,
-							"Unable to parse container ID from container status available in pod",
-							"fullContainerID", fullContainerID,
-							"podName", pod.Name,
-							"containerName", containerName,
-						)
-						continue
-					}
-
-					b.logger.V(config.VerboseLevel).Info(
-						"Found Container ID in cluster",
-						"containerID", containerID,
-						"podName", pod.Name,
-						"containerName", containerName,
-					)
-
-					key := config.SeccompProfileRecordBpfAnnotationKey + containerName
-					profile, ok := pod.Annotations[key]
-					if ok && profile != "" {
-						b.logger.Info(
-							"Cache this profile found in cluster",
-							"profile", profile,
-							"containerID", containerID,
-							"podName", pod.Name,
-							"containerName", containerName,
-						)
-						b.containerIDToProfileMap.Insert(containerID, profile)
-					}
-
-					// Stop looking for this container ID regadless of a profile was found or not.
-					if containerID == id {
-						return nil
-					}
-				}
+			// Stop looking for this container ID regadless of a profile was found or not.
+			if _, ok := containerIDs[id]; ok {
+				return nil
 			}
 			return fmt.Errorf("container ID not found in cluster: %s", id)
 		},
@@ -780,16 +1186,245 @@ func (b *BpfRecorder) findProfileForContainerID(id string) (string, error) {
 	return "", fmt.Errorf("container ID not found: %s", id)
 }
 
+// cacheProfilesInCluster caches the profiles of all recorded containers of the
+// node and returns the IDs of all containers found in the cluster.
+func (b *BpfRecorder) cacheProfilesInCluster(ctx context.Context) (map[string]struct{}, error) {
+	pods, err := b.ListPods(ctx, b.clientset, b.nodeName)
+	if err != nil {
+		return nil, fmt.Errorf("list node pods: %w", err)
+	}
+	if pods == nil {
+		return nil, fmt.Errorf("no pods found in cluster")
+	}
+
+	containerIDs := map[string]struct{}{}
+	for p := range pods.Items {
+		pod := &pods.Items[p]
+		//nolint:gocritic // We explicitly do not want to append to the same slice
+		statuses := append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...)
+		firstEphemeral := len(statuses)
+		statuses = append(statuses, pod.Status.EphemeralContainerStatuses...)
+		for c := range statuses {
+			containerStatus := statuses[c]
+			fullContainerID := containerStatus.ContainerID
+			containerName := containerStatus.Name
+
+			// The container ID is not yet available in the container status of the pod.
+			// This container can be skipped for now, the status will be checked again later.
+			if fullContainerID == "" {
+				b.logger.Info(
+					"Container ID not yet available in cluster",
+					"podName", pod.Name,
+					"containerName", containerName,
+				)
+				continue
+			}
+
+			containerID := util.ContainerIDRegex.FindString(fullContainerID)
+			if containerID == "" {
+				b.logger.Error(err,
+					"Unable to parse container ID from container status available in pod",
+					"fullContainerID", fullContainerID,
+					"podName", pod.Name,
+					"containerName", containerName,
+				)
+				continue
+			}
+
+			b.logger.V(config.VerboseLevel).Info(
+				"Found Container ID in cluster",
+				"containerID", containerID,
+				"podName", pod.Name,
+				"containerName", containerName,
+			)
+			containerIDs[containerID] = struct{}{}
+
+			key := config.SeccompProfileRecordBpfAnnotationKey + containerName
+			profile, ok := pod.Annotations[key]
+			if !ok && c >= firstEphemeral {
+				profile, ok = util.EphemeralRecordingAnnotation(
+					pod.Annotations, config.SeccompProfileRecordBpfAnnotationKey, containerName,
+				)
+			}
+			if ok && profile != "" {
+				b.logger.Info(
+					"Cache this profile found in cluster",
+					"profile", profile,
+					"containerID", containerID,
+					"podName", pod.Name,
+					"containerName", containerName,
+				)
+				b.containerIDToProfileMap.Insert(containerID, profile)
+			}
+		}
+	}
+
+	return containerIDs, nil
+}
+
 // Unload can be used to reset the bpf recorder.
 func (b *BpfRecorder) Unload() {
 	b.logger.Info("Unloading bpf module")
 	b.loadUnloadMutex.Lock()
+	if b.stopMaintenance != nil {
+		close(b.stopMaintenance)
+		b.stopMaintenance = nil
+	}
 	b.CloseModule(b.syscalls)
+	// All maps belong to the closed module, so none of them may be used
+	// anymore by a concurrent map maintenance.
 	b.syscalls = nil
+	b.mntns = nil
+	b.overflows = nil
 	os.RemoveAll(b.btfPath)
 	b.loadUnloadMutex.Unlock()
 }
 
+// maintainMaps periodically evicts stale entries from the BPF maps and
+// reports failed inserts, until the stop channel gets closed.
+func (b *BpfRecorder) maintainMaps(stop <-chan struct{}) {
+	ticker := time.NewTicker(mapMaintenanceInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			b.evictMountNamespaces()
+			b.reportMapOverflows()
+		}
+	}
+}
+
+// evictMountNamespaces removes the recorded syscalls of mount namespaces
+// without any running process, unless they belong to a container whose
+// profile has not been collected yet. The map keys get listed before scanning
+// the running processes, so that a mount namespace recorded in the meantime
+// is never evicted before a process of it got scanned.
+func (b *BpfRecorder) evictMountNamespaces() {
+	b.loadUnloadMutex.RLock()
+	syscalls := b.syscalls
+	if syscalls == nil {
+		b.loadUnloadMutex.RUnlock()
+		return
+	}
+	keys, err := b.MapKeys(syscalls)
+	b.loadUnloadMutex.RUnlock()
+	if err != nil {
+		b.logger.Error(err, "Unable to list mount namespaces in syscalls map")
+		return
+	}
+
+	// Scanning the processes does not require the lock, which would
+	// otherwise block the syscall lookups for the duration of the scan.
+	active, err := b.activeMountNamespaces()
+	if err != nil {
+		b.logger.Error(err, "Unable to find active mount namespaces")
+		return
+	}
+
+	b.loadUnloadMutex.Lock()
+	defer b.loadUnloadMutex.Unlock()
+	if b.syscalls != syscalls {
+		// The program got unloaded or reloaded in the meantime
+		return
+	}
+
+	evicted := 0
+	for _, mntns := range keys {
+		if _, ok := active[mntns]; ok {
+			continue
+		}
+
+		if containerID, ok := b.mntnsToContainerIDMap.Get(mntns); ok {
+			if _, ok := b.containerIDToProfileMap.Get(containerID); ok {
+				continue
+			}
+		}
+
+		if err := b.DeleteKey(b.syscalls, mntns); err != nil {
+			b.logger.Error(err, "Unable to evict mount namespace from syscalls map", "mntns", mntns)
+			continue
+		}
+		b.mntnsToContainerIDMap.Delete(mntns)
+		b.deleteCgroupMntns(mntns)
+		evicted++
+	}
+
+	if evicted > 0 {
+		b.logger.V(config.VerboseLevel).Info("Evicted stale mount namespaces", "count", evicted)
+	}
+}
+
+// activeMountNamespaces returns the mount namespaces of all running processes.
+func (b *BpfRecorder) activeMountNamespaces() (map[uint32]struct{}, error) {
+	entries, err := b.ReadDir("/proc")
+	if err != nil {
+		return nil, fmt.Errorf("read proc directory: %w", err)
+	}
+
+	active := map[uint32]struct{}{}
+	for _, entry := range entries {
+		pid, err := strconv.ParseUint(entry.Name(), 10, 32)
+		if err != nil || !entry.IsDir() {
+			continue
+		}
+
+		// The process may have exited in the meantime.
+		mntns, err := b.FindProcMountNamespace(uint32(pid))
+		if err != nil {
+			continue
+		}
+		active[mntns] = struct{}{}
+	}
+
+	return active, nil
+}
+
+// reportMapOverflows logs and reports the inserts which failed since the
+// last check because a BPF map has been full.
+func (b *BpfRecorder) reportMapOverflows() {
+	b.loadUnloadMutex.RLock()
+	defer b.loadUnloadMutex.RUnlock()
+	if b.overflows == nil {
+		return
+	}
+
+	for i, mapName := range overflowMaps {
+		value, err := b.GetValue(b.overflows, uint32(i))
+		if err != nil {
+			b.logger.Error(err, "Unable to get overflow count", "map", mapName)
+			continue
+		}
+
+		var count uint64
+		if err := binary.Read(bytes.NewReader(value), binary.LittleEndian, &count); err != nil {
+			b.logger.Error(err, "Unable to read overflow count", "map", mapName)
+			continue
+		}
+		if count <= b.reportedOverflows[i] {
+			continue
+		}
+		failed := count - b.reportedOverflows[i]
+		b.reportedOverflows[i] = count
+
+		b.logger.Error(
+			errMapFull, "Unable to record processes or syscalls",
+			"map", mapName, "failedInserts", failed,
+		)
+		if err := b.SendMetric(b.metricsClient, &apimetrics.BpfRequest{
+			Node: b.nodeName,
+			MapOverflowReq: &apimetrics.BpfRequest_MapOverflowReq{
+				Map:   mapName,
+				Count: failed,
+			},
+		}); err != nil {
+			b.logger.Error(err, "Unable to update metrics")
+		}
+	}
+}
+
 func (b *BpfRecorder) syscallNameForID(id int) (string, error) {
 	key := strconv.Itoa(id)
 	item := b.syscallIDtoNameCache.Get(key)
@@ -24,12 +24,16 @@ import (
 	"encoding/binary"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
+	"path/filepath"
+	"strconv"
 	"sync"
 	"syscall"
 	"testing"
 	"time"
 
+	bpf "github.com/aquasecurity/libbpfgo"
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
@@ -259,6 +263,26 @@ func TestRun(t *testing.T) {
 				require.NotNil(t, err)
 			},
 		},
+		{ // load:process exit program missing in object
+			prepare: func(mock *bpfrecorderfakes.FakeImpl) {
+				mock.GetenvReturns(node)
+				mock.GoArchReturns(validGoArch)
+				mock.GetProgramReturnsOnCall(1, nil, errTest)
+			},
+			assert: func(err error) {
+				require.Nil(t, err)
+			},
+		},
+		{ // load:overflow map missing in object
+			prepare: func(mock *bpfrecorderfakes.FakeImpl) {
+				mock.GetenvReturns(node)
+				mock.GoArchReturns(validGoArch)
+				mock.GetMapReturnsOnCall(2, nil, errTest)
+			},
+			assert: func(err error) {
+				require.Nil(t, err)
+			},
+		},
 		{ // load:InitRingBuf fails
 			prepare: func(mock *bpfrecorderfakes.FakeImpl) {
 				mock.GetenvReturns(node)
@@ -448,7 +472,7 @@ func TestStart(t *testing.T) {
 			assert: func(sut *BpfRecorder, err error) {
 				require.Nil(t, err)
 				require.EqualValues(t, 1, sut.startRequests)
-				_, err = sut.Start(context.Background(), &api.EmptyRequest{})
+				_, err = sut.Start(context.Background(), &api.StartRequest{})
 				require.Nil(t, err)
 				require.EqualValues(t, 2, sut.startRequests)
 			},
@@ -469,7 +493,7 @@ func TestStart(t *testing.T) {
 		sut := New(logr.Discard())
 		sut.impl = mock
 
-		_, err := sut.Start(context.Background(), &api.EmptyRequest{})
+		_, err := sut.Start(context.Background(), &api.StartRequest{})
 		tc.assert(sut, err)
 	}
 }
@@ -491,7 +515,7 @@ func TestStop(t *testing.T) {
 		{ // Success with start
 			prepare: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl) {
 				mock.GoArchReturns(validGoArch)
-				_, err := sut.Start(context.Background(), &api.EmptyRequest{})
+				_, err := sut.Start(context.Background(), &api.StartRequest{})
 				require.Nil(t, err)
 			},
 			assert: func(sut *BpfRecorder, err error) {
@@ -502,9 +526,9 @@ func TestStop(t *testing.T) {
 		{ // Success with double start
 			prepare: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl) {
 				mock.GoArchReturns(validGoArch)
-				_, err := sut.Start(context.Background(), &api.EmptyRequest{})
+				_, err := sut.Start(context.Background(), &api.StartRequest{})
 				require.Nil(t, err)
-				_, err = sut.Start(context.Background(), &api.EmptyRequest{})
+				_, err = sut.Start(context.Background(), &api.StartRequest{})
 				require.Nil(t, err)
 			},
 			assert: func(sut *BpfRecorder, err error) {
@@ -520,11 +544,71 @@ func TestStop(t *testing.T) {
 
 		tc.prepare(sut, mock)
 
-		_, err := sut.Stop(context.Background(), &api.EmptyRequest{})
+		_, err := sut.Stop(context.Background(), &api.StopRequest{})
 		tc.assert(sut, err)
 	}
 }
 
+func TestConcurrentRecordings(t *testing.T) {
+	t.Parallel()
+
+	const (
+		recordingA = "ns/recording-a"
+		recordingB = "ns/recording-b"
+	)
+	otherMntns := mntns + 1
+
+	sut := New(logr.Discard())
+	mock := &bpfrecorderfakes.FakeImpl{}
+	mock.GoArchReturns(validGoArch)
+	mock.GetMapReturns(&bpf.BPFMap{}, nil)
+	sut.impl = mock
+
+	_, err := sut.Start(context.Background(), &api.StartRequest{
+		Recording: recordingA, Profiles: []string{profile},
+	})
+	require.Nil(t, err)
+	_, err = sut.Start(context.Background(), &api.StartRequest{
+		Recording: recordingB, Profiles: []string{"other"},
+	})
+	require.Nil(t, err)
+	require.Equal(t, 1, mock.NewModuleFromBufferArgsCallCount())
+	require.EqualValues(t, 2, sut.startRequests)
+
+	sut.containerIDToProfileMap.Insert(containerID, profile)
+	sut.mntnsToContainerIDMap.Insert(mntns, containerID)
+	sut.trackRecordingMntns(mntns, profile)
+	sut.containerIDToProfileMap.Insert("other-id", "other")
+	sut.mntnsToContainerIDMap.Insert(otherMntns, "other-id")
+	sut.trackRecordingMntns(otherMntns, "other")
+
+	// Stopping an unknown recording does not affect the others.
+	_, err = sut.Stop(context.Background(), &api.StopRequest{Recording: "ns/unknown"})
+	require.Nil(t, err)
+	require.EqualValues(t, 2, sut.startRequests)
+
+	// Finishing the first recording only cleans up its own data.
+	_, err = sut.Stop(context.Background(), &api.StopRequest{Recording: recordingA})
+	require.Nil(t, err)
+	require.EqualValues(t, 1, sut.startRequests)
+	require.Equal(t, 0, mock.CloseModuleCallCount())
+	require.Equal(t, 1, mock.DeleteKeyCallCount())
+	_, key := mock.DeleteKeyArgsForCall(0)
+	require.EqualValues(t, mntns, key)
+	require.False(t, sut.mntnsToContainerIDMap.Exists(mntns))
+	require.False(t, sut.containerIDToProfileMap.Exists(containerID))
+	foundMntns, ok := sut.getMntnsForProfile("other")
+	require.True(t, ok)
+	require.Equal(t, otherMntns, foundMntns)
+
+	// The program gets detached once no recording needs it anymore.
+	_, err = sut.Stop(context.Background(), &api.StopRequest{Recording: recordingB})
+	require.Nil(t, err)
+	require.EqualValues(t, 0, sut.startRequests)
+	require.Equal(t, 1, mock.CloseModuleCallCount())
+	require.Empty(t, sut.recordings)
+}
+
 func TestSyscallsForProfile(t *testing.T) {
 	t.Parallel()
 
@@ -535,7 +619,7 @@ func TestSyscallsForProfile(t *testing.T) {
 		{ // Success
 			prepare: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl) {
 				mock.GoArchReturns(validGoArch)
-				_, err := sut.Start(context.Background(), &api.EmptyRequest{})
+				_, err := sut.Start(context.Background(), &api.StartRequest{})
 				require.Nil(t, err)
 				sut.containerIDToProfileMap.Insert(containerID, profile)
 				sut.mntnsToContainerIDMap.Insert(mntns, containerID)
@@ -559,7 +643,7 @@ func TestSyscallsForProfile(t *testing.T) {
 		{ // Success with unable to resolve syscall name
 			prepare: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl) {
 				mock.GoArchReturns(validGoArch)
-				_, err := sut.Start(context.Background(), &api.EmptyRequest{})
+				_, err := sut.Start(context.Background(), &api.StartRequest{})
 				require.Nil(t, err)
 				sut.containerIDToProfileMap.Insert(containerID, profile)
 				sut.mntnsToContainerIDMap.Insert(mntns, containerID)
@@ -576,6 +660,37 @@ func TestSyscallsForProfile(t *testing.T) {
 				require.Equal(t, "syscall_b", resp.Syscalls[1])
 			},
 		},
+		{ // Success by the cgroup of a container whose events are still handled
+			prepare: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl) {
+				mock.GoArchReturns(validGoArch)
+				_, err := sut.Start(context.Background(), &api.StartRequest{})
+				require.Nil(t, err)
+				sut.cgroupMntns[42] = mntns
+				sut.cgroupContainerIDs[42] = containerID
+				mock.ListPodsReturns(&v1.PodList{Items: []v1.Pod{{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      pod,
+						Namespace: namespace,
+						Annotations: map[string]string{
+							config.SeccompProfileRecordBpfAnnotationKey + "ctr": profile,
+						},
+					},
+					Status: v1.PodStatus{
+						ContainerStatuses: []v1.ContainerStatus{{
+							ContainerID: crioPrefix + containerID,
+							Name:        "ctr",
+						}},
+					},
+				}}}, nil)
+				mock.GetValueReturns([]byte{0, 1}, nil)
+				mock.GetNameReturns("syscall_a", nil)
+			},
+			assert: func(sut *BpfRecorder, resp *api.SyscallsResponse, err error) {
+				require.Nil(t, err)
+				require.Equal(t, []string{"syscall_a"}, resp.Syscalls)
+				require.Empty(t, sut.cgroupMntns)
+			},
+		},
 		{ // recorder not running
 			prepare: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl) {},
 			assert: func(sut *BpfRecorder, resp *api.SyscallsResponse, err error) {
@@ -585,7 +700,7 @@ func TestSyscallsForProfile(t *testing.T) {
 		{ // no PID for container
 			prepare: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl) {
 				mock.GoArchReturns(validGoArch)
-				_, err := sut.Start(context.Background(), &api.EmptyRequest{})
+				_, err := sut.Start(context.Background(), &api.StartRequest{})
 				require.Nil(t, err)
 			},
 			assert: func(sut *BpfRecorder, resp *api.SyscallsResponse, err error) {
@@ -595,7 +710,7 @@ func TestSyscallsForProfile(t *testing.T) {
 		{ // no syscall found for profile
 			prepare: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl) {
 				mock.GoArchReturns(validGoArch)
-				_, err := sut.Start(context.Background(), &api.EmptyRequest{})
+				_, err := sut.Start(context.Background(), &api.StartRequest{})
 				require.Nil(t, err)
 				sut.containerIDToProfileMap.Insert(containerID, profile)
 				sut.mntnsToContainerIDMap.Insert(mntns, containerID)
@@ -608,7 +723,7 @@ func TestSyscallsForProfile(t *testing.T) {
 		{ // Failed to clean syscalls map
 			prepare: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl) {
 				mock.GoArchReturns(validGoArch)
-				_, err := sut.Start(context.Background(), &api.EmptyRequest{})
+				_, err := sut.Start(context.Background(), &api.StartRequest{})
 				require.Nil(t, err)
 				sut.containerIDToProfileMap.Insert(containerID, profile)
 				sut.mntnsToContainerIDMap.Insert(mntns, containerID)
@@ -641,6 +756,108 @@ func TestSyscallsForProfile(t *testing.T) {
 	}
 }
 
+func TestSyscallsForLocalhostProfile(t *testing.T) {
+	t.Parallel()
+
+	const (
+		localhostProfile = "operator/test-namespace/profile.json"
+		otherContainerID = "318ce99dd8b33f6f9b6565863d7cd47dc880963ddd2cd987bcb2d330c65144bf"
+		otherMntns       = uint32(1338)
+	)
+
+	podWithProfile := func(podProfile, containerProfile string) v1.Pod {
+		localhost := func(name string) *v1.SeccompProfile {
+			if name == "" {
+				return nil
+			}
+			return &v1.SeccompProfile{Type: v1.SeccompProfileTypeLocalhost, LocalhostProfile: &name}
+		}
+		return v1.Pod{
+			Spec: v1.PodSpec{
+				SecurityContext: &v1.PodSecurityContext{SeccompProfile: localhost(podProfile)},
+				Containers: []v1.Container{{
+					Name:            "container",
+					SecurityContext: &v1.SecurityContext{SeccompProfile: localhost(containerProfile)},
+				}},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		name    string
+		prepare func(*BpfRecorder, *bpfrecorderfakes.FakeImpl)
+		assert  func(*bpfrecorderfakes.FakeImpl, *api.SyscallsResponse, error)
+	}{
+		{
+			name: "not running",
+			prepare: func(*BpfRecorder, *bpfrecorderfakes.FakeImpl) {
+			},
+			assert: func(_ *bpfrecorderfakes.FakeImpl, _ *api.SyscallsResponse, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "success",
+			prepare: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl) {
+				mock.GoArchReturns(validGoArch)
+				_, err := sut.Start(context.Background(), &api.StartRequest{})
+				require.Nil(t, err)
+
+				usingProfile := podWithProfile(localhostProfile, "")
+				usingProfile.Status.ContainerStatuses = []v1.ContainerStatus{{
+					Name: "container", ContainerID: crioPrefix + containerID,
+				}}
+				overridden := podWithProfile(localhostProfile, "operator/other.json")
+				overridden.Status.ContainerStatuses = []v1.ContainerStatus{{
+					Name: "container", ContainerID: crioPrefix + otherContainerID,
+				}}
+				mock.ListPodsReturns(&v1.PodList{Items: []v1.Pod{usingProfile, overridden}}, nil)
+				sut.mntnsToContainerIDMap.Insert(mntns, containerID)
+				sut.mntnsToContainerIDMap.Insert(otherMntns, otherContainerID)
+
+				mock.GetValueReturns([]byte{1, 1}, nil)
+				mock.GetNameReturnsOnCall(0, "syscall_b", nil)
+				mock.GetNameReturnsOnCall(1, "syscall_a", nil)
+			},
+			assert: func(mock *bpfrecorderfakes.FakeImpl, resp *api.SyscallsResponse, err error) {
+				require.Nil(t, err)
+				require.Equal(t, []string{"syscall_a", "syscall_b"}, resp.Syscalls)
+				require.Equal(t, 1, mock.GetValueCallCount())
+				_, key := mock.GetValueArgsForCall(0)
+				require.Equal(t, mntns, key)
+				require.Zero(t, mock.DeleteKeyCallCount())
+			},
+		},
+		{
+			name: "list pods fails",
+			prepare: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl) {
+				mock.GoArchReturns(validGoArch)
+				_, err := sut.Start(context.Background(), &api.StartRequest{})
+				require.Nil(t, err)
+				mock.ListPodsReturns(nil, errTest)
+			},
+			assert: func(_ *bpfrecorderfakes.FakeImpl, _ *api.SyscallsResponse, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sut := New(logr.Discard())
+			mock := &bpfrecorderfakes.FakeImpl{}
+			sut.impl = mock
+			tc.prepare(sut, mock)
+
+			resp, err := sut.SyscallsForLocalhostProfile(
+				context.Background(), &api.ProfileRequest{Name: localhostProfile},
+			)
+			tc.assert(mock, resp, err)
+		})
+	}
+}
+
 type Logger struct {
 	messages []string
 	mutex    sync.RWMutex
@@ -691,6 +908,7 @@ func TestProcessEvents(t *testing.T) {
 				return []byte{
 					1, 0, 0, 0,
 					1, 0, 1, 0,
+					0, 0, 0, 0, 0, 0, 0, 0,
 				}
 			},
 			assert: func(sut *BpfRecorder, logger *Logger) {
@@ -708,6 +926,43 @@ func TestProcessEvents(t *testing.T) {
 				require.Equal(t, mntns, foundMntns)
 			},
 		},
+		{ // Success with container ID from cgroup
+			prepare: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl) []byte {
+				mock.ContainerIDForPIDReturns("", errTest)
+				mock.ContainerIDsForCgroupsReturns(map[uint64]string{42: containerID}, nil)
+				mock.ListPodsReturns(&v1.PodList{Items: []v1.Pod{{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      pod,
+						Namespace: namespace,
+						Annotations: map[string]string{
+							config.SeccompProfileRecordBpfAnnotationKey + "ctr": "profile.json",
+						},
+					},
+					Status: v1.PodStatus{
+						ContainerStatuses: []v1.ContainerStatus{{
+							ContainerID: crioPrefix + containerID,
+							Name:        "ctr",
+						}},
+					},
+				}}}, nil)
+				return []byte{
+					1, 0, 0, 0,
+					1, 0, 1, 0,
+					42, 0, 0, 0, 0, 0, 0, 0,
+				}
+			},
+			assert: func(sut *BpfRecorder, logger *Logger) {
+				mntns := binary.LittleEndian.Uint32([]byte{1, 0, 1, 0})
+				var foundMntns uint32
+				for i := 0; i < 100; i++ {
+					if foundMntns, _ = sut.getMntnsForProfile("profile.json"); foundMntns != 0 {
+						break
+					}
+					time.Sleep(100 * time.Millisecond)
+				}
+				require.Equal(t, mntns, foundMntns)
+			},
+		},
 		{ // invalid event length
 			prepare: func(sut *BpfRecorder, mock *bpfrecorderfakes.FakeImpl) []byte {
 				return []byte{1, 0, 0}
@@ -732,6 +987,7 @@ func TestProcessEvents(t *testing.T) {
 				return []byte{
 					1, 0, 0, 0,
 					1, 1, 0, 0,
+					0, 0, 0, 0, 0, 0, 0, 0,
 				}
 			},
 			assert: func(sut *BpfRecorder, logger *Logger) {
@@ -755,6 +1011,7 @@ func TestProcessEvents(t *testing.T) {
 				return []byte{
 					1, 0, 0, 0,
 					1, 0, 1, 0,
+					0, 0, 0, 0, 0, 0, 0, 0,
 				}
 			},
 			assert: func(sut *BpfRecorder, logger *Logger) {
@@ -780,11 +1037,211 @@ func TestProcessEvents(t *testing.T) {
 
 		msg := tc.prepare(sut, mock)
 
+		stop := make(chan struct{})
+		go sut.scanCgroups(stop)
+
 		ch := make(chan []byte)
 		go sut.processEvents(ch)
 		ch <- msg
 
 		tc.assert(sut, logSink)
 		close(ch)
+		close(stop)
+	}
+}
+
+func TestContainerIDForProcess(t *testing.T) {
+	t.Parallel()
+
+	mock := &bpfrecorderfakes.FakeImpl{}
+	mock.ContainerIDsForCgroupsReturns(map[uint64]string{42: containerID}, nil)
+	mock.ContainerIDForPIDReturns("", errTest)
+
+	sut := New(logr.Discard())
+	sut.impl = mock
+
+	stop := make(chan struct{})
+	defer close(stop)
+	go sut.scanCgroups(stop)
+
+	// Exited processes get resolved by a scan of the cgroup hierarchy.
+	id, err := sut.containerIDForProcess(1, 42)
+	require.Nil(t, err)
+	require.Equal(t, containerID, id)
+
+	// Known cgroups do not need the PID nor another scan.
+	scans := mock.ContainerIDsForCgroupsCallCount()
+	pidLookups := mock.ContainerIDForPIDCallCount()
+	id, err = sut.containerIDForProcess(1, 42)
+	require.Nil(t, err)
+	require.Equal(t, containerID, id)
+	require.Equal(t, scans, mock.ContainerIDsForCgroupsCallCount())
+	require.Equal(t, pidLookups, mock.ContainerIDForPIDCallCount())
+
+	// Cgroups without containers get remembered to not scan again.
+	_, err = sut.containerIDForProcess(1, 43)
+	require.ErrorIs(t, err, errTest)
+	scans = mock.ContainerIDsForCgroupsCallCount()
+	_, err = sut.containerIDForProcess(1, 43)
+	require.ErrorIs(t, err, errTest)
+	require.Equal(t, scans, mock.ContainerIDsForCgroupsCallCount())
+
+	// Events without cgroup ID fall back to the cgroup of the PID.
+	_, err = sut.containerIDForProcess(1, 0)
+	require.ErrorIs(t, err, errTest)
+	require.Equal(t, scans, mock.ContainerIDsForCgroupsCallCount())
+}
+
+func TestContainerIDForProcessPrefersPID(t *testing.T) {
+	t.Parallel()
+
+	mock := &bpfrecorderfakes.FakeImpl{}
+	mock.ContainerIDForPIDReturns(containerID, nil)
+
+	sut := New(logr.Discard())
+	sut.impl = mock
+
+	// Running processes do not wait for a scan of the cgroup hierarchy.
+	id, err := sut.containerIDForProcess(1, 42)
+	require.Nil(t, err)
+	require.Equal(t, containerID, id)
+	require.Zero(t, mock.ContainerIDsForCgroupsCallCount())
+}
+
+func TestLoadResizeMaps(t *testing.T) {
+	t.Parallel()
+
+	mock := &bpfrecorderfakes.FakeImpl{}
+	mock.GoArchReturns(validGoArch)
+	mock.GetenvCalls(func(key string) string {
+		switch key {
+		case config.BpfRecorderMaxMountNamespacesEnvKey:
+			return "16384"
+		case config.BpfRecorderMaxProcessesEnvKey:
+			return "invalid"
+		}
+		return ""
+	})
+
+	sut := New(logr.Discard())
+	sut.impl = mock
+	sut.maxMountNamespaces = sut.mapSizeFromEnv(config.BpfRecorderMaxMountNamespacesEnvKey)
+	sut.maxProcesses = sut.mapSizeFromEnv(config.BpfRecorderMaxProcessesEnvKey)
+	require.EqualValues(t, 16384, sut.maxMountNamespaces)
+	require.EqualValues(t, 0, sut.maxProcesses)
+
+	require.Nil(t, sut.Load(false))
+	require.Equal(t, 1, mock.ResizeMapCallCount())
+	_, mapName, size := mock.ResizeMapArgsForCall(0)
+	require.Equal(t, "mntns_syscalls", mapName)
+	require.EqualValues(t, 16384, size)
+
+	mock.ResizeMapReturns(errTest)
+	require.ErrorIs(t, sut.Load(false), errTest)
+}
+
+func TestEvictMountNamespaces(t *testing.T) {
+	t.Parallel()
+
+	const (
+		activeMntns   uint32 = 100
+		recordedMntns uint32 = 200
+		staleMntns    uint32 = 300
+	)
+
+	proc := t.TempDir()
+	for _, name := range []string{"1", "2", "self"} {
+		require.Nil(t, os.Mkdir(filepath.Join(proc, name), 0o700))
 	}
+
+	sut := New(logr.Discard())
+
+	calls := []string{}
+	mock := &bpfrecorderfakes.FakeImpl{}
+	mock.ReadDirCalls(func(string) ([]os.DirEntry, error) {
+		calls = append(calls, "ReadDir")
+		// The processes get scanned without holding the lock
+		require.True(t, sut.loadUnloadMutex.TryLock())
+		sut.loadUnloadMutex.Unlock()
+		return os.ReadDir(proc)
+	})
+	mock.ReadlinkCalls(func(name string) (string, error) {
+		if name == "/proc/1/ns/mnt" {
+			return fmt.Sprintf("mnt:[%d]", activeMntns), nil
+		}
+		return "", errTest
+	})
+	mock.ParseUintCalls(func(s string) (uint32, error) {
+		v, err := strconv.ParseUint(s, 10, 32)
+		return uint32(v), err
+	})
+	mock.MapKeysCalls(func(*bpf.BPFMap) ([]uint32, error) {
+		calls = append(calls, "MapKeys")
+		return []uint32{activeMntns, recordedMntns, staleMntns}, nil
+	})
+
+	sut.impl = mock
+	sut.syscalls = &bpf.BPFMap{}
+	sut.mntnsToContainerIDMap.Insert(recordedMntns, containerID)
+	sut.mntnsToContainerIDMap.Insert(staleMntns, "other")
+	sut.containerIDToProfileMap.Insert(containerID, profile)
+
+	sut.evictMountNamespaces()
+
+	require.Equal(t, 1, mock.DeleteKeyCallCount())
+	_, key := mock.DeleteKeyArgsForCall(0)
+	require.Equal(t, staleMntns, key)
+	require.False(t, sut.mntnsToContainerIDMap.Exists(staleMntns))
+	require.True(t, sut.mntnsToContainerIDMap.Exists(recordedMntns))
+
+	// Keys recorded after the process scan must not get evicted
+	require.Equal(t, []string{"MapKeys", "ReadDir"}, calls)
+}
+
+func TestReportMapOverflows(t *testing.T) {
+	t.Parallel()
+
+	count := uint64(3)
+	mock := &bpfrecorderfakes.FakeImpl{}
+	mock.GetValueCalls(func(_ *bpf.BPFMap, key uint32) ([]byte, error) {
+		value := make([]byte, 8)
+		if key == 0 {
+			binary.LittleEndian.PutUint64(value, count)
+		}
+		return value, nil
+	})
+
+	sut := New(logr.Discard())
+	sut.impl = mock
+	sut.nodeName = node
+	sut.overflows = &bpf.BPFMap{}
+	sut.reportedOverflows = make([]uint64, len(overflowMaps))
+
+	sut.reportMapOverflows()
+	require.Equal(t, 1, mock.SendMetricCallCount())
+	_, req := mock.SendMetricArgsForCall(0)
+	require.Equal(t, node, req.GetNode())
+	require.Equal(t, "pid_mntns", req.GetMapOverflowReq().GetMap())
+	require.EqualValues(t, 3, req.GetMapOverflowReq().GetCount())
+
+	// Only new overflows get reported
+	sut.reportMapOverflows()
+	require.Equal(t, 1, mock.SendMetricCallCount())
+
+	count = 5
+	sut.reportMapOverflows()
+	require.Equal(t, 2, mock.SendMetricCallCount())
+	_, req = mock.SendMetricArgsForCall(1)
+	require.EqualValues(t, 2, req.GetMapOverflowReq().GetCount())
+
+	// The maps of an unloaded module must not be accessed anymore
+	sut.syscalls = &bpf.BPFMap{}
+	sut.mntns = &bpf.BPFMap{}
+	sut.Unload()
+	require.Nil(t, sut.syscalls)
+	require.Nil(t, sut.mntns)
+	require.Nil(t, sut.overflows)
+	getValueCalls := mock.GetValueCallCount()
+	sut.reportMapOverflows()
+	require.Equal(t, getValueCalls, mock.GetValueCallCount())
 }
@@ -51,13 +51,13 @@ func Dial() (*grpc.ClientConn, context.CancelFunc, error) {
 }
 
 func (b *BpfRecorder) Start(
-	context.Context, *api.EmptyRequest,
+	context.Context, *api.StartRequest,
 ) (*api.EmptyResponse, error) {
 	return nil, errUnsupported
 }
 
 func (b *BpfRecorder) Stop(
-	context.Context, *api.EmptyRequest,
+	context.Context, *api.StopRequest,
 ) (*api.EmptyResponse, error) {
 	return nil, errUnsupported
 }
@@ -68,3 +68,11 @@ func (b *BpfRecorder) SyscallsForProfile(
 ) (*api.SyscallsResponse, error) {
 	return nil, errUnsupported
 }
+
+// SyscallsForLocalhostProfile returns the syscall names recorded for the
+// containers using the provided seccomp localhost profile.
+func (b *BpfRecorder) SyscallsForLocalhostProfile(
+	context.Context, *api.ProfileRequest,
+) (*api.SyscallsResponse, error) {
+	return nil, errUnsupported
+}
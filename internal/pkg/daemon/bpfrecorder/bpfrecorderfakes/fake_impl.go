@@ -121,6 +121,19 @@ type FakeImpl struct {
 		result1 string
 		result2 error
 	}
+	ContainerIDsForCgroupsStub        func(string) (map[uint64]string, error)
+	containerIDsForCgroupsMutex       sync.RWMutex
+	containerIDsForCgroupsArgsForCall []struct {
+		arg1 string
+	}
+	containerIDsForCgroupsReturns struct {
+		result1 map[uint64]string
+		result2 error
+	}
+	containerIDsForCgroupsReturnsOnCall map[int]struct {
+		result1 map[uint64]string
+		result2 error
+	}
 	DeleteKeyStub        func(*libbpfgo.BPFMap, uint32) error
 	deleteKeyMutex       sync.RWMutex
 	deleteKeyArgsForCall []struct {
@@ -318,6 +331,19 @@ type FakeImpl struct {
 		result1 net.Listener
 		result2 error
 	}
+	MapKeysStub        func(*libbpfgo.BPFMap) ([]uint32, error)
+	mapKeysMutex       sync.RWMutex
+	mapKeysArgsForCall []struct {
+		arg1 *libbpfgo.BPFMap
+	}
+	mapKeysReturns struct {
+		result1 []uint32
+		result2 error
+	}
+	mapKeysReturnsOnCall map[int]struct {
+		result1 []uint32
+		result2 error
+	}
 	NewForConfigStub        func(*rest.Config) (*kubernetes.Clientset, error)
 	newForConfigMutex       sync.RWMutex
 	newForConfigArgsForCall []struct {
@@ -363,6 +389,19 @@ type FakeImpl struct {
 		arg1 *libbpfgo.RingBuffer
 		arg2 int
 	}
+	ReadDirStub        func(string) ([]fs.DirEntry, error)
+	readDirMutex       sync.RWMutex
+	readDirArgsForCall []struct {
+		arg1 string
+	}
+	readDirReturns struct {
+		result1 []fs.DirEntry
+		result2 error
+	}
+	readDirReturnsOnCall map[int]struct {
+		result1 []fs.DirEntry
+		result2 error
+	}
 	ReadOSReleaseStub        func() (map[string]string, error)
 	readOSReleaseMutex       sync.RWMutex
 	readOSReleaseArgsForCall []struct {
@@ -399,6 +438,19 @@ type FakeImpl struct {
 	removeAllReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ResizeMapStub        func(*libbpfgo.Module, string, uint32) error
+	resizeMapMutex       sync.RWMutex
+	resizeMapArgsForCall []struct {
+		arg1 *libbpfgo.Module
+		arg2 string
+		arg3 uint32
+	}
+	resizeMapReturns struct {
+		result1 error
+	}
+	resizeMapReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SendMetricStub        func(api_metrics.Metrics_BpfIncClient, *api_metrics.BpfRequest) error
 	sendMetricMutex       sync.RWMutex
 	sendMetricArgsForCall []struct {
@@ -929,6 +981,70 @@ func (fake *FakeImpl) ContainerIDForPIDReturnsOnCall(i int, result1 string, resu
 	}{result1, result2}
 }
 
+func (fake *FakeImpl) ContainerIDsForCgroups(arg1 string) (map[uint64]string, error) {
+	fake.containerIDsForCgroupsMutex.Lock()
+	ret, specificReturn := fake.containerIDsForCgroupsReturnsOnCall[len(fake.containerIDsForCgroupsArgsForCall)]
+	fake.containerIDsForCgroupsArgsForCall = append(fake.containerIDsForCgroupsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ContainerIDsForCgroupsStub
+	fakeReturns := fake.containerIDsForCgroupsReturns
+	fake.recordInvocation("ContainerIDsForCgroups", []interface{}{arg1})
+	fake.containerIDsForCgroupsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) ContainerIDsForCgroupsCallCount() int {
+	fake.containerIDsForCgroupsMutex.RLock()
+	defer fake.containerIDsForCgroupsMutex.RUnlock()
+	return len(fake.containerIDsForCgroupsArgsForCall)
+}
+
+func (fake *FakeImpl) ContainerIDsForCgroupsCalls(stub func(string) (map[uint64]string, error)) {
+	fake.containerIDsForCgroupsMutex.Lock()
+	defer fake.containerIDsForCgroupsMutex.Unlock()
+	fake.ContainerIDsForCgroupsStub = stub
+}
+
+func (fake *FakeImpl) ContainerIDsForCgroupsArgsForCall(i int) string {
+	fake.containerIDsForCgroupsMutex.RLock()
+	defer fake.containerIDsForCgroupsMutex.RUnlock()
+	argsForCall := fake.containerIDsForCgroupsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) ContainerIDsForCgroupsReturns(result1 map[uint64]string, result2 error) {
+	fake.containerIDsForCgroupsMutex.Lock()
+	defer fake.containerIDsForCgroupsMutex.Unlock()
+	fake.ContainerIDsForCgroupsStub = nil
+	fake.containerIDsForCgroupsReturns = struct {
+		result1 map[uint64]string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) ContainerIDsForCgroupsReturnsOnCall(i int, result1 map[uint64]string, result2 error) {
+	fake.containerIDsForCgroupsMutex.Lock()
+	defer fake.containerIDsForCgroupsMutex.Unlock()
+	fake.ContainerIDsForCgroupsStub = nil
+	if fake.containerIDsForCgroupsReturnsOnCall == nil {
+		fake.containerIDsForCgroupsReturnsOnCall = make(map[int]struct {
+			result1 map[uint64]string
+			result2 error
+		})
+	}
+	fake.containerIDsForCgroupsReturnsOnCall[i] = struct {
+		result1 map[uint64]string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeImpl) DeleteKey(arg1 *libbpfgo.BPFMap, arg2 uint32) error {
 	fake.deleteKeyMutex.Lock()
 	ret, specificReturn := fake.deleteKeyReturnsOnCall[len(fake.deleteKeyArgsForCall)]
@@ -1866,6 +1982,70 @@ func (fake *FakeImpl) ListenReturnsOnCall(i int, result1 net.Listener, result2 e
 	}{result1, result2}
 }
 
+func (fake *FakeImpl) MapKeys(arg1 *libbpfgo.BPFMap) ([]uint32, error) {
+	fake.mapKeysMutex.Lock()
+	ret, specificReturn := fake.mapKeysReturnsOnCall[len(fake.mapKeysArgsForCall)]
+	fake.mapKeysArgsForCall = append(fake.mapKeysArgsForCall, struct {
+		arg1 *libbpfgo.BPFMap
+	}{arg1})
+	stub := fake.MapKeysStub
+	fakeReturns := fake.mapKeysReturns
+	fake.recordInvocation("MapKeys", []interface{}{arg1})
+	fake.mapKeysMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) MapKeysCallCount() int {
+	fake.mapKeysMutex.RLock()
+	defer fake.mapKeysMutex.RUnlock()
+	return len(fake.mapKeysArgsForCall)
+}
+
+func (fake *FakeImpl) MapKeysCalls(stub func(*libbpfgo.BPFMap) ([]uint32, error)) {
+	fake.mapKeysMutex.Lock()
+	defer fake.mapKeysMutex.Unlock()
+	fake.MapKeysStub = stub
+}
+
+func (fake *FakeImpl) MapKeysArgsForCall(i int) *libbpfgo.BPFMap {
+	fake.mapKeysMutex.RLock()
+	defer fake.mapKeysMutex.RUnlock()
+	argsForCall := fake.mapKeysArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) MapKeysReturns(result1 []uint32, result2 error) {
+	fake.mapKeysMutex.Lock()
+	defer fake.mapKeysMutex.Unlock()
+	fake.MapKeysStub = nil
+	fake.mapKeysReturns = struct {
+		result1 []uint32
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) MapKeysReturnsOnCall(i int, result1 []uint32, result2 error) {
+	fake.mapKeysMutex.Lock()
+	defer fake.mapKeysMutex.Unlock()
+	fake.MapKeysStub = nil
+	if fake.mapKeysReturnsOnCall == nil {
+		fake.mapKeysReturnsOnCall = make(map[int]struct {
+			result1 []uint32
+			result2 error
+		})
+	}
+	fake.mapKeysReturnsOnCall[i] = struct {
+		result1 []uint32
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeImpl) NewForConfig(arg1 *rest.Config) (*kubernetes.Clientset, error) {
 	fake.newForConfigMutex.Lock()
 	ret, specificReturn := fake.newForConfigReturnsOnCall[len(fake.newForConfigArgsForCall)]
@@ -2091,6 +2271,70 @@ func (fake *FakeImpl) PollRingBufferArgsForCall(i int) (*libbpfgo.RingBuffer, in
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeImpl) ReadDir(arg1 string) ([]fs.DirEntry, error) {
+	fake.readDirMutex.Lock()
+	ret, specificReturn := fake.readDirReturnsOnCall[len(fake.readDirArgsForCall)]
+	fake.readDirArgsForCall = append(fake.readDirArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ReadDirStub
+	fakeReturns := fake.readDirReturns
+	fake.recordInvocation("ReadDir", []interface{}{arg1})
+	fake.readDirMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) ReadDirCallCount() int {
+	fake.readDirMutex.RLock()
+	defer fake.readDirMutex.RUnlock()
+	return len(fake.readDirArgsForCall)
+}
+
+func (fake *FakeImpl) ReadDirCalls(stub func(string) ([]fs.DirEntry, error)) {
+	fake.readDirMutex.Lock()
+	defer fake.readDirMutex.Unlock()
+	fake.ReadDirStub = stub
+}
+
+func (fake *FakeImpl) ReadDirArgsForCall(i int) string {
+	fake.readDirMutex.RLock()
+	defer fake.readDirMutex.RUnlock()
+	argsForCall := fake.readDirArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) ReadDirReturns(result1 []fs.DirEntry, result2 error) {
+	fake.readDirMutex.Lock()
+	defer fake.readDirMutex.Unlock()
+	fake.ReadDirStub = nil
+	fake.readDirReturns = struct {
+		result1 []fs.DirEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) ReadDirReturnsOnCall(i int, result1 []fs.DirEntry, result2 error) {
+	fake.readDirMutex.Lock()
+	defer fake.readDirMutex.Unlock()
+	fake.ReadDirStub = nil
+	if fake.readDirReturnsOnCall == nil {
+		fake.readDirReturnsOnCall = make(map[int]struct {
+			result1 []fs.DirEntry
+			result2 error
+		})
+	}
+	fake.readDirReturnsOnCall[i] = struct {
+		result1 []fs.DirEntry
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeImpl) ReadOSRelease() (map[string]string, error) {
 	fake.readOSReleaseMutex.Lock()
 	ret, specificReturn := fake.readOSReleaseReturnsOnCall[len(fake.readOSReleaseArgsForCall)]
@@ -2272,6 +2516,69 @@ func (fake *FakeImpl) RemoveAllReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeImpl) ResizeMap(arg1 *libbpfgo.Module, arg2 string, arg3 uint32) error {
+	fake.resizeMapMutex.Lock()
+	ret, specificReturn := fake.resizeMapReturnsOnCall[len(fake.resizeMapArgsForCall)]
+	fake.resizeMapArgsForCall = append(fake.resizeMapArgsForCall, struct {
+		arg1 *libbpfgo.Module
+		arg2 string
+		arg3 uint32
+	}{arg1, arg2, arg3})
+	stub := fake.ResizeMapStub
+	fakeReturns := fake.resizeMapReturns
+	fake.recordInvocation("ResizeMap", []interface{}{arg1, arg2, arg3})
+	fake.resizeMapMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) ResizeMapCallCount() int {
+	fake.resizeMapMutex.RLock()
+	defer fake.resizeMapMutex.RUnlock()
+	return len(fake.resizeMapArgsForCall)
+}
+
+func (fake *FakeImpl) ResizeMapCalls(stub func(*libbpfgo.Module, string, uint32) error) {
+	fake.resizeMapMutex.Lock()
+	defer fake.resizeMapMutex.Unlock()
+	fake.ResizeMapStub = stub
+}
+
+func (fake *FakeImpl) ResizeMapArgsForCall(i int) (*libbpfgo.Module, string, uint32) {
+	fake.resizeMapMutex.RLock()
+	defer fake.resizeMapMutex.RUnlock()
+	argsForCall := fake.resizeMapArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeImpl) ResizeMapReturns(result1 error) {
+	fake.resizeMapMutex.Lock()
+	defer fake.resizeMapMutex.Unlock()
+	fake.ResizeMapStub = nil
+	fake.resizeMapReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) ResizeMapReturnsOnCall(i int, result1 error) {
+	fake.resizeMapMutex.Lock()
+	defer fake.resizeMapMutex.Unlock()
+	fake.ResizeMapStub = nil
+	if fake.resizeMapReturnsOnCall == nil {
+		fake.resizeMapReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.resizeMapReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeImpl) SendMetric(arg1 api_metrics.Metrics_BpfIncClient, arg2 *api_metrics.BpfRequest) error {
 	fake.sendMetricMutex.Lock()
 	ret, specificReturn := fake.sendMetricReturnsOnCall[len(fake.sendMetricArgsForCall)]
@@ -2876,6 +3183,8 @@ func (fake *FakeImpl) Invocations() map[string][][]interface{} {
 	defer fake.closeModuleMutex.RUnlock()
 	fake.containerIDForPIDMutex.RLock()
 	defer fake.containerIDForPIDMutex.RUnlock()
+	fake.containerIDsForCgroupsMutex.RLock()
+	defer fake.containerIDsForCgroupsMutex.RUnlock()
 	fake.deleteKeyMutex.RLock()
 	defer fake.deleteKeyMutex.RUnlock()
 	fake.deleteKey64Mutex.RLock()
@@ -2906,6 +3215,8 @@ func (fake *FakeImpl) Invocations() map[string][][]interface{} {
 	defer fake.listPodsMutex.RUnlock()
 	fake.listenMutex.RLock()
 	defer fake.listenMutex.RUnlock()
+	fake.mapKeysMutex.RLock()
+	defer fake.mapKeysMutex.RUnlock()
 	fake.newForConfigMutex.RLock()
 	defer fake.newForConfigMutex.RUnlock()
 	fake.newModuleFromBufferArgsMutex.RLock()
@@ -2914,12 +3225,16 @@ func (fake *FakeImpl) Invocations() map[string][][]interface{} {
 	defer fake.parseUintMutex.RUnlock()
 	fake.pollRingBufferMutex.RLock()
 	defer fake.pollRingBufferMutex.RUnlock()
+	fake.readDirMutex.RLock()
+	defer fake.readDirMutex.RUnlock()
 	fake.readOSReleaseMutex.RLock()
 	defer fake.readOSReleaseMutex.RUnlock()
 	fake.readlinkMutex.RLock()
 	defer fake.readlinkMutex.RUnlock()
 	fake.removeAllMutex.RLock()
 	defer fake.removeAllMutex.RUnlock()
+	fake.resizeMapMutex.RLock()
+	defer fake.resizeMapMutex.RUnlock()
 	fake.sendMetricMutex.RLock()
 	defer fake.sendMetricMutex.RUnlock()
 	fake.serveMutex.RLock()
@@ -23,87 +23,106 @@ var bpfObjects = map[string][]byte{
 	"amd64": {
 		127, 69, 76, 70, 2, 1, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1,
 		0, 247, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 88, 28, 1, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 64, 0, 0, 0, 0, 0, 64, 0, 14, 0, 1, 0, 121,
-		24, 8, 0, 0, 0, 0, 0, 103, 8, 0, 0, 32, 0, 0, 0, 119,
-		8, 0, 0, 32, 0, 0, 0, 37, 8, 114, 0, 255, 3, 0, 0, 133,
+		0, 0, 0, 0, 0, 0, 0, 224, 152, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 64, 0, 0, 0, 0, 0, 64, 0, 16, 0, 1, 0, 121,
+		25, 8, 0, 0, 0, 0, 0, 103, 9, 0, 0, 32, 0, 0, 0, 119,
+		9, 0, 0, 32, 0, 0, 0, 37, 9, 127, 0, 255, 3, 0, 0, 133,
 		0, 0, 0, 14, 0, 0, 0, 119, 0, 0, 0, 32, 0, 0, 0, 99,
 		10, 252, 255, 0, 0, 0, 0, 133, 0, 0, 0, 35, 0, 0, 0, 183,
 		1, 0, 0, 48, 12, 0, 0, 15, 16, 0, 0, 0, 0, 0, 0, 191,
-		166, 0, 0, 0, 0, 0, 0, 7, 6, 0, 0, 176, 255, 255, 255, 191,
-		97, 0, 0, 0, 0, 0, 0, 183, 2, 0, 0, 8, 0, 0, 0, 191,
-		3, 0, 0, 0, 0, 0, 0, 133, 0, 0, 0, 113, 0, 0, 0, 183,
-		1, 0, 0, 24, 0, 0, 0, 121, 163, 176, 255, 0, 0, 0, 0, 15,
-		19, 0, 0, 0, 0, 0, 0, 191, 97, 0, 0, 0, 0, 0, 0, 183,
+		161, 0, 0, 0, 0, 0, 0, 7, 1, 0, 0, 168, 255, 255, 255, 183,
+		2, 0, 0, 8, 0, 0, 0, 191, 3, 0, 0, 0, 0, 0, 0, 133,
+		0, 0, 0, 113, 0, 0, 0, 183, 1, 0, 0, 24, 0, 0, 0, 121,
+		163, 168, 255, 0, 0, 0, 0, 15, 19, 0, 0, 0, 0, 0, 0, 191,
+		161, 0, 0, 0, 0, 0, 0, 7, 1, 0, 0, 240, 255, 255, 255, 183,
 		2, 0, 0, 8, 0, 0, 0, 133, 0, 0, 0, 113, 0, 0, 0, 183,
-		1, 0, 0, 16, 0, 0, 0, 121, 163, 176, 255, 0, 0, 0, 0, 15,
+		1, 0, 0, 16, 0, 0, 0, 121, 163, 240, 255, 0, 0, 0, 0, 15,
 		19, 0, 0, 0, 0, 0, 0, 191, 161, 0, 0, 0, 0, 0, 0, 7,
-		1, 0, 0, 244, 255, 255, 255, 183, 2, 0, 0, 4, 0, 0, 0, 133,
-		0, 0, 0, 113, 0, 0, 0, 97, 166, 244, 255, 0, 0, 0, 0, 99,
-		106, 248, 255, 0, 0, 0, 0, 21, 6, 86, 0, 0, 0, 0, 0, 183,
-		1, 0, 0, 1, 0, 0, 0, 99, 26, 244, 255, 0, 0, 0, 0, 191,
-		162, 0, 0, 0, 0, 0, 0, 7, 2, 0, 0, 244, 255, 255, 255, 24,
+		1, 0, 0, 236, 255, 255, 255, 183, 2, 0, 0, 4, 0, 0, 0, 133,
+		0, 0, 0, 113, 0, 0, 0, 97, 166, 236, 255, 0, 0, 0, 0, 99,
+		106, 248, 255, 0, 0, 0, 0, 21, 6, 99, 0, 0, 0, 0, 0, 183,
+		1, 0, 0, 1, 0, 0, 0, 99, 26, 236, 255, 0, 0, 0, 0, 191,
+		162, 0, 0, 0, 0, 0, 0, 7, 2, 0, 0, 236, 255, 255, 255, 24,
 		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 133,
 		0, 0, 0, 1, 0, 0, 0, 21, 0, 2, 0, 0, 0, 0, 0, 97,
-		1, 0, 0, 0, 0, 0, 0, 29, 97, 76, 0, 0, 0, 0, 0, 183,
-		7, 0, 0, 0, 0, 0, 0, 123, 122, 232, 255, 0, 0, 0, 0, 123,
-		122, 224, 255, 0, 0, 0, 0, 123, 122, 216, 255, 0, 0, 0, 0, 123,
-		122, 208, 255, 0, 0, 0, 0, 123, 122, 200, 255, 0, 0, 0, 0, 123,
-		122, 192, 255, 0, 0, 0, 0, 123, 122, 184, 255, 0, 0, 0, 0, 123,
-		122, 176, 255, 0, 0, 0, 0, 191, 161, 0, 0, 0, 0, 0, 0, 7,
-		1, 0, 0, 176, 255, 255, 255, 183, 2, 0, 0, 64, 0, 0, 0, 133,
+		1, 0, 0, 0, 0, 0, 0, 29, 97, 89, 0, 0, 0, 0, 0, 183,
+		7, 0, 0, 0, 0, 0, 0, 123, 122, 224, 255, 0, 0, 0, 0, 123,
+		122, 216, 255, 0, 0, 0, 0, 123, 122, 208, 255, 0, 0, 0, 0, 123,
+		122, 200, 255, 0, 0, 0, 0, 123, 122, 192, 255, 0, 0, 0, 0, 123,
+		122, 184, 255, 0, 0, 0, 0, 123, 122, 176, 255, 0, 0, 0, 0, 123,
+		122, 168, 255, 0, 0, 0, 0, 191, 161, 0, 0, 0, 0, 0, 0, 7,
+		1, 0, 0, 168, 255, 255, 255, 183, 2, 0, 0, 64, 0, 0, 0, 133,
 		0, 0, 0, 16, 0, 0, 0, 24, 1, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 113, 17, 0, 0, 0, 0, 0, 0, 21,
 		1, 14, 0, 0, 0, 0, 0, 191, 113, 0, 0, 0, 0, 0, 0, 24,
 		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 15,
 		19, 0, 0, 0, 0, 0, 0, 191, 162, 0, 0, 0, 0, 0, 0, 7,
-		2, 0, 0, 176, 255, 255, 255, 15, 18, 0, 0, 0, 0, 0, 0, 113,
+		2, 0, 0, 168, 255, 255, 255, 15, 18, 0, 0, 0, 0, 0, 0, 113,
 		34, 0, 0, 0, 0, 0, 0, 113, 51, 0, 0, 0, 0, 0, 0, 93,
-		50, 49, 0, 0, 0, 0, 0, 21, 2, 3, 0, 0, 0, 0, 0, 191,
+		50, 62, 0, 0, 0, 0, 0, 21, 2, 3, 0, 0, 0, 0, 0, 191,
 		23, 0, 0, 0, 0, 0, 0, 7, 7, 0, 0, 1, 0, 0, 0, 85,
 		1, 242, 255, 63, 0, 0, 0, 191, 162, 0, 0, 0, 0, 0, 0, 7,
 		2, 0, 0, 252, 255, 255, 255, 24, 1, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 133, 0, 0, 0, 1, 0, 0, 0, 85,
-		0, 30, 0, 0, 0, 0, 0, 24, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 183, 2, 0, 0, 8, 0, 0, 0, 183,
+		0, 43, 0, 0, 0, 0, 0, 24, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 183, 2, 0, 0, 16, 0, 0, 0, 183,
 		3, 0, 0, 0, 0, 0, 0, 133, 0, 0, 0, 131, 0, 0, 0, 191,
-		7, 0, 0, 0, 0, 0, 0, 21, 7, 23, 0, 0, 0, 0, 0, 97,
-		163, 252, 255, 0, 0, 0, 0, 191, 165, 0, 0, 0, 0, 0, 0, 7,
-		5, 0, 0, 176, 255, 255, 255, 24, 1, 0, 0, 64, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 183, 2, 0, 0, 41, 0, 0, 0, 191,
-		100, 0, 0, 0, 0, 0, 0, 133, 0, 0, 0, 6, 0, 0, 0, 97,
-		161, 252, 255, 0, 0, 0, 0, 99, 23, 0, 0, 0, 0, 0, 0, 97,
-		161, 248, 255, 0, 0, 0, 0, 99, 23, 4, 0, 0, 0, 0, 0, 191,
-		113, 0, 0, 0, 0, 0, 0, 183, 2, 0, 0, 0, 0, 0, 0, 133,
-		0, 0, 0, 132, 0, 0, 0, 191, 162, 0, 0, 0, 0, 0, 0, 7,
-		2, 0, 0, 252, 255, 255, 255, 191, 163, 0, 0, 0, 0, 0, 0, 7,
-		3, 0, 0, 248, 255, 255, 255, 24, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 183, 4, 0, 0, 0, 0, 0, 0, 133,
-		0, 0, 0, 2, 0, 0, 0, 191, 162, 0, 0, 0, 0, 0, 0, 7,
+		7, 0, 0, 0, 0, 0, 0, 21, 7, 36, 0, 0, 0, 0, 0, 133,
+		0, 0, 0, 80, 0, 0, 0, 191, 8, 0, 0, 0, 0, 0, 0, 97,
+		163, 252, 255, 0, 0, 0, 0, 24, 1, 0, 0, 64, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 183, 2, 0, 0, 45, 0, 0, 0, 191,
+		100, 0, 0, 0, 0, 0, 0, 191, 133, 0, 0, 0, 0, 0, 0, 133,
+		0, 0, 0, 6, 0, 0, 0, 97, 161, 252, 255, 0, 0, 0, 0, 99,
+		23, 0, 0, 0, 0, 0, 0, 97, 161, 248, 255, 0, 0, 0, 0, 99,
+		23, 4, 0, 0, 0, 0, 0, 123, 135, 8, 0, 0, 0, 0, 0, 183,
+		6, 0, 0, 0, 0, 0, 0, 191, 113, 0, 0, 0, 0, 0, 0, 183,
+		2, 0, 0, 0, 0, 0, 0, 133, 0, 0, 0, 132, 0, 0, 0, 191,
+		162, 0, 0, 0, 0, 0, 0, 7, 2, 0, 0, 252, 255, 255, 255, 191,
+		163, 0, 0, 0, 0, 0, 0, 7, 3, 0, 0, 248, 255, 255, 255, 24,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 183,
+		4, 0, 0, 0, 0, 0, 0, 133, 0, 0, 0, 2, 0, 0, 0, 21,
+		0, 9, 0, 0, 0, 0, 0, 99, 106, 240, 255, 0, 0, 0, 0, 191,
+		162, 0, 0, 0, 0, 0, 0, 7, 2, 0, 0, 240, 255, 255, 255, 24,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 133,
+		0, 0, 0, 1, 0, 0, 0, 21, 0, 2, 0, 0, 0, 0, 0, 183,
+		1, 0, 0, 1, 0, 0, 0, 219, 16, 0, 0, 0, 0, 0, 0, 191,
+		162, 0, 0, 0, 0, 0, 0, 7, 2, 0, 0, 248, 255, 255, 255, 24,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 133,
+		0, 0, 0, 1, 0, 0, 0, 21, 0, 5, 0, 0, 0, 0, 0, 15,
+		144, 0, 0, 0, 0, 0, 0, 183, 1, 0, 0, 1, 0, 0, 0, 115,
+		16, 0, 0, 0, 0, 0, 0, 183, 0, 0, 0, 0, 0, 0, 0, 149,
+		0, 0, 0, 0, 0, 0, 0, 191, 162, 0, 0, 0, 0, 0, 0, 7,
 		2, 0, 0, 248, 255, 255, 255, 24, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 133, 0, 0, 0, 1, 0, 0, 0, 21,
-		0, 5, 0, 0, 0, 0, 0, 15, 128, 0, 0, 0, 0, 0, 0, 183,
-		1, 0, 0, 1, 0, 0, 0, 115, 16, 0, 0, 0, 0, 0, 0, 183,
-		0, 0, 0, 0, 0, 0, 0, 149, 0, 0, 0, 0, 0, 0, 0, 191,
-		166, 0, 0, 0, 0, 0, 0, 7, 6, 0, 0, 248, 255, 255, 255, 24,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 191,
-		98, 0, 0, 0, 0, 0, 0, 24, 3, 0, 0, 105, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 24, 3, 0, 0, 109, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 183, 4, 0, 0, 0, 0, 0, 0, 133,
-		0, 0, 0, 2, 0, 0, 0, 24, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 191, 98, 0, 0, 0, 0, 0, 0, 133,
-		0, 0, 0, 1, 0, 0, 0, 85, 0, 237, 255, 0, 0, 0, 0, 97,
+		0, 0, 0, 2, 0, 0, 0, 21, 0, 10, 0, 0, 0, 0, 0, 183,
+		6, 0, 0, 1, 0, 0, 0, 99, 106, 240, 255, 0, 0, 0, 0, 191,
+		162, 0, 0, 0, 0, 0, 0, 7, 2, 0, 0, 240, 255, 255, 255, 24,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 133,
+		0, 0, 0, 1, 0, 0, 0, 21, 0, 237, 255, 0, 0, 0, 0, 219,
+		96, 0, 0, 0, 0, 0, 0, 5, 0, 235, 255, 0, 0, 0, 0, 191,
+		162, 0, 0, 0, 0, 0, 0, 7, 2, 0, 0, 248, 255, 255, 255, 24,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 133,
+		0, 0, 0, 1, 0, 0, 0, 85, 0, 226, 255, 0, 0, 0, 0, 97,
 		164, 248, 255, 0, 0, 0, 0, 97, 163, 252, 255, 0, 0, 0, 0, 191,
-		165, 0, 0, 0, 0, 0, 0, 7, 5, 0, 0, 176, 255, 255, 255, 24,
-		1, 0, 0, 105, 4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 183,
+		165, 0, 0, 0, 0, 0, 0, 7, 5, 0, 0, 168, 255, 255, 255, 24,
+		1, 0, 0, 109, 4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 183,
 		2, 0, 0, 72, 0, 0, 0, 133, 0, 0, 0, 6, 0, 0, 0, 5,
-		0, 231, 255, 0, 0, 0, 0, 68, 117, 97, 108, 32, 66, 83, 68, 47,
+		0, 220, 255, 0, 0, 0, 0, 133, 0, 0, 0, 14, 0, 0, 0, 191,
+		1, 0, 0, 0, 0, 0, 0, 119, 1, 0, 0, 32, 0, 0, 0, 99,
+		26, 252, 255, 0, 0, 0, 0, 103, 0, 0, 0, 32, 0, 0, 0, 119,
+		0, 0, 0, 32, 0, 0, 0, 93, 16, 5, 0, 0, 0, 0, 0, 191,
+		162, 0, 0, 0, 0, 0, 0, 7, 2, 0, 0, 252, 255, 255, 255, 24,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 133,
+		0, 0, 0, 3, 0, 0, 0, 183, 0, 0, 0, 0, 0, 0, 0, 149,
+		0, 0, 0, 0, 0, 0, 0, 68, 117, 97, 108, 32, 66, 83, 68, 47,
 		71, 80, 76, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 115, 101, 110, 100, 32, 101, 118, 101, 110, 116, 32, 112,
 		105, 100, 58, 32, 37, 117, 44, 32, 109, 110, 116, 110, 115, 58, 32, 37,
-		117, 44, 32, 99, 111, 109, 109, 58, 32, 37, 115, 10, 0, 0, 0, 0,
+		117, 44, 32, 99, 103, 114, 111, 117, 112, 58, 32, 37, 108, 108, 117, 10,
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -167,3504 +186,1692 @@ var bpfObjects = map[string][]byte{
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 108, 111, 111,
-		107, 32, 117, 112, 32, 105, 116, 101, 109, 32, 105, 110, 32, 109, 110, 116,
-		110, 115, 95, 115, 121, 115, 99, 97, 108, 108, 115, 32, 109, 97, 112, 32,
-		102, 97, 105, 108, 101, 100, 32, 112, 105, 100, 58, 32, 37, 117, 44, 32,
-		109, 110, 116, 110, 115, 58, 32, 37, 117, 44, 32, 99, 111, 109, 109, 58,
-		32, 37, 115, 10, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 108, 111, 111, 107, 32, 117, 112, 32, 105, 116, 101, 109, 32, 105, 110,
+		32, 109, 110, 116, 110, 115, 95, 115, 121, 115, 99, 97, 108, 108, 115, 32,
+		109, 97, 112, 32, 102, 97, 105, 108, 101, 100, 32, 112, 105, 100, 58, 32,
+		37, 117, 44, 32, 109, 110, 116, 110, 115, 58, 32, 37, 117, 44, 32, 99,
+		111, 109, 109, 58, 32, 37, 115, 10, 0, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 159, 235, 1, 0, 24, 0, 0, 0, 0,
-		0, 0, 0, 44, 164, 0, 0, 44, 164, 0, 0, 34, 98, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 3, 0, 0, 0, 1, 0, 0, 0, 0,
-		0, 0, 1, 4, 0, 0, 0, 32, 0, 0, 1, 0, 0, 0, 0, 0,
-		0, 0, 3, 0, 0, 0, 0, 2, 0, 0, 0, 4, 0, 0, 0, 1,
-		0, 0, 0, 5, 0, 0, 0, 0, 0, 0, 1, 4, 0, 0, 0, 32,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 6, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 159,
+		235, 1, 0, 24, 0, 0, 0, 0, 0, 0, 0, 128, 72, 0, 0, 128,
+		72, 0, 0, 90, 53, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 3,
+		0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 1, 4, 0, 0, 0, 32,
+		0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 2,
+		0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0, 5, 0, 0, 0, 0,
+		0, 0, 1, 4, 0, 0, 0, 32, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 6, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
+		0, 0, 0, 2, 0, 0, 0, 4, 0, 0, 0, 0, 32, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 8, 0, 0, 0, 25, 0, 0, 0, 0,
+		0, 0, 8, 9, 0, 0, 0, 29, 0, 0, 0, 0, 0, 0, 8, 10,
+		0, 0, 0, 35, 0, 0, 0, 0, 0, 0, 1, 4, 0, 0, 0, 32,
+		0, 0, 0, 0, 0, 0, 0, 4, 0, 0, 4, 32, 0, 0, 0, 48,
+		0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 53, 0, 0, 0, 5,
+		0, 0, 0, 64, 0, 0, 0, 65, 0, 0, 0, 7, 0, 0, 0, 128,
+		0, 0, 0, 69, 0, 0, 0, 7, 0, 0, 0, 192, 0, 0, 0, 75,
+		0, 0, 0, 0, 0, 0, 14, 11, 0, 0, 0, 1, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 14, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 3, 0, 0, 0, 0, 2, 0, 0, 0, 4, 0, 0, 0, 27,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 16, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 2, 0, 0, 0, 4,
-		0, 0, 0, 0, 32, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 8,
-		0, 0, 0, 25, 0, 0, 0, 0, 0, 0, 8, 9, 0, 0, 0, 29,
-		0, 0, 0, 0, 0, 0, 8, 10, 0, 0, 0, 35, 0, 0, 0, 0,
-		0, 0, 1, 4, 0, 0, 0, 32, 0, 0, 0, 0, 0, 0, 0, 4,
-		0, 0, 4, 32, 0, 0, 0, 48, 0, 0, 0, 1, 0, 0, 0, 0,
-		0, 0, 0, 53, 0, 0, 0, 5, 0, 0, 0, 64, 0, 0, 0, 65,
-		0, 0, 0, 7, 0, 0, 0, 128, 0, 0, 0, 69, 0, 0, 0, 7,
-		0, 0, 0, 192, 0, 0, 0, 75, 0, 0, 0, 0, 0, 0, 14, 11,
-		0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 14,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 2,
-		0, 0, 0, 4, 0, 0, 0, 27, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 16, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
-		0, 0, 0, 2, 0, 0, 0, 4, 0, 0, 0, 0, 0, 0, 1, 0,
-		0, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 48, 0, 0, 0, 13,
-		0, 0, 0, 0, 0, 0, 0, 53, 0, 0, 0, 15, 0, 0, 0, 64,
-		0, 0, 0, 85, 0, 0, 0, 0, 0, 0, 14, 17, 0, 0, 0, 1,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 23, 0, 0, 0, 92,
-		0, 0, 0, 0, 0, 0, 8, 21, 0, 0, 0, 95, 0, 0, 0, 0,
-		0, 0, 8, 22, 0, 0, 0, 100, 0, 0, 0, 0, 0, 0, 1, 1,
-		0, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
-		0, 0, 0, 20, 0, 0, 0, 4, 0, 0, 0, 0, 4, 0, 0, 0,
-		0, 0, 0, 4, 0, 0, 4, 32, 0, 0, 0, 48, 0, 0, 0, 1,
-		0, 0, 0, 0, 0, 0, 0, 53, 0, 0, 0, 5, 0, 0, 0, 64,
-		0, 0, 0, 65, 0, 0, 0, 7, 0, 0, 0, 128, 0, 0, 0, 69,
-		0, 0, 0, 19, 0, 0, 0, 192, 0, 0, 0, 114, 0, 0, 0, 0,
-		0, 0, 14, 24, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 27, 0, 0, 0, 129, 0, 0, 0, 4, 0, 0, 4, 64,
-		0, 0, 0, 155, 0, 0, 0, 28, 0, 0, 0, 0, 0, 0, 0, 159,
-		0, 0, 0, 30, 0, 0, 0, 64, 0, 0, 0, 162, 0, 0, 0, 32,
-		0, 0, 0, 128, 0, 0, 0, 167, 0, 0, 0, 34, 0, 0, 0, 0,
-		2, 0, 0, 174, 0, 0, 0, 4, 0, 0, 4, 8, 0, 0, 0, 48,
-		0, 0, 0, 29, 0, 0, 0, 0, 0, 0, 0, 186, 0, 0, 0, 22,
-		0, 0, 0, 16, 0, 0, 0, 192, 0, 0, 0, 22, 0, 0, 0, 24,
-		0, 0, 0, 206, 0, 0, 0, 2, 0, 0, 0, 32, 0, 0, 0, 210,
-		0, 0, 0, 0, 0, 0, 1, 2, 0, 0, 0, 16, 0, 0, 0, 225,
-		0, 0, 0, 0, 0, 0, 1, 8, 0, 0, 0, 64, 0, 0, 1, 230,
-		0, 0, 0, 0, 0, 0, 1, 8, 0, 0, 0, 64, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 31, 0, 0, 0, 4,
-		0, 0, 0, 6, 0, 0, 0, 244, 0, 0, 0, 0, 0, 0, 1, 1,
-		0, 0, 0, 8, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 3, 0,
-		0, 0, 0, 33, 0, 0, 0, 4, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 1, 0, 0, 13, 2, 0, 0, 0, 162, 0, 0, 0, 26,
-		0, 0, 0, 249, 0, 0, 0, 1, 0, 0, 12, 35, 0, 0, 0, 83,
-		2, 0, 0, 0, 1, 0, 132, 128, 53, 0, 0, 95, 2, 0, 0, 38,
-		0, 0, 0, 0, 0, 0, 0, 107, 2, 0, 0, 10, 0, 0, 0, 192,
-		0, 0, 0, 115, 2, 0, 0, 39, 0, 0, 0, 0, 1, 0, 0, 121,
-		2, 0, 0, 40, 0, 0, 0, 64, 1, 0, 0, 186, 0, 0, 0, 10,
-		0, 0, 0, 96, 1, 0, 0, 127, 2, 0, 0, 10, 0, 0, 0, 128,
-		1, 0, 0, 134, 2, 0, 0, 2, 0, 0, 0, 160, 1, 0, 0, 141,
-		2, 0, 0, 44, 0, 0, 0, 192, 1, 0, 0, 152, 2, 0, 0, 10,
-		0, 0, 0, 64, 2, 0, 0, 164, 2, 0, 0, 31, 0, 0, 0, 128,
-		2, 0, 0, 184, 2, 0, 0, 50, 0, 0, 0, 192, 2, 0, 0, 195,
-		2, 0, 0, 2, 0, 0, 0, 0, 3, 0, 0, 211, 2, 0, 0, 2,
-		0, 0, 0, 32, 3, 0, 0, 220, 2, 0, 0, 2, 0, 0, 0, 64,
-		3, 0, 0, 226, 2, 0, 0, 2, 0, 0, 0, 96, 3, 0, 0, 231,
-		2, 0, 0, 2, 0, 0, 0, 128, 3, 0, 0, 243, 2, 0, 0, 2,
-		0, 0, 0, 160, 3, 0, 0, 255, 2, 0, 0, 10, 0, 0, 0, 192,
-		3, 0, 0, 11, 3, 0, 0, 51, 0, 0, 0, 0, 4, 0, 0, 14,
-		3, 0, 0, 80, 0, 0, 0, 0, 12, 0, 0, 17, 3, 0, 0, 82,
-		0, 0, 0, 128, 13, 0, 0, 20, 3, 0, 0, 92, 0, 0, 0, 128,
-		20, 0, 0, 32, 3, 0, 0, 53, 0, 0, 0, 192, 20, 0, 0, 42,
-		3, 0, 0, 31, 0, 0, 0, 128, 21, 0, 0, 54, 3, 0, 0, 10,
-		0, 0, 0, 192, 21, 0, 0, 70, 3, 0, 0, 76, 0, 0, 0, 0,
-		22, 0, 0, 87, 3, 0, 0, 119, 0, 0, 0, 0, 24, 0, 0, 93,
-		3, 0, 0, 120, 0, 0, 0, 0, 32, 0, 0, 111, 3, 0, 0, 10,
-		0, 0, 0, 64, 32, 0, 0, 122, 3, 0, 0, 10, 0, 0, 0, 96,
-		32, 0, 0, 129, 3, 0, 0, 2, 0, 0, 0, 128, 32, 0, 0, 145,
-		3, 0, 0, 122, 0, 0, 0, 192, 32, 0, 0, 154, 3, 0, 0, 125,
-		0, 0, 0, 0, 33, 0, 0, 168, 3, 0, 0, 124, 0, 0, 0, 64,
-		33, 0, 0, 178, 3, 0, 0, 39, 0, 0, 0, 64, 65, 0, 0, 196,
-		3, 0, 0, 29, 0, 0, 0, 128, 65, 0, 0, 215, 3, 0, 0, 29,
-		0, 0, 0, 144, 65, 0, 0, 231, 3, 0, 0, 2, 0, 0, 0, 160,
-		65, 0, 0, 253, 3, 0, 0, 128, 0, 0, 0, 192, 65, 0, 0, 21,
-		4, 0, 0, 55, 0, 0, 0, 0, 66, 0, 0, 36, 4, 0, 0, 130,
-		0, 0, 0, 128, 66, 0, 0, 53, 4, 0, 0, 31, 0, 0, 0, 192,
-		66, 0, 0, 69, 4, 0, 0, 20, 0, 0, 0, 0, 67, 0, 0, 87,
-		4, 0, 0, 20, 0, 0, 0, 8, 67, 0, 0, 101, 4, 0, 0, 2,
-		0, 0, 0, 32, 67, 0, 0, 120, 4, 0, 0, 55, 0, 0, 0, 64,
-		67, 0, 0, 143, 4, 0, 0, 2, 0, 0, 0, 192, 67, 0, 0, 162,
-		4, 0, 0, 2, 0, 0, 0, 224, 67, 0, 0, 177, 4, 0, 0, 128,
-		0, 0, 0, 0, 68, 0, 0, 196, 4, 0, 0, 55, 0, 0, 0, 64,
-		68, 0, 0, 213, 4, 0, 0, 55, 0, 0, 0, 192, 68, 0, 0, 227,
-		4, 0, 0, 2, 0, 0, 0, 64, 69, 0, 0, 240, 4, 0, 0, 131,
-		0, 0, 0, 128, 69, 0, 0, 251, 4, 0, 0, 55, 0, 0, 0, 128,
-		70, 0, 0, 1, 5, 0, 0, 132, 0, 0, 0, 0, 71, 0, 0, 16,
-		5, 0, 0, 53, 0, 0, 0, 64, 72, 0, 0, 34, 5, 0, 0, 133,
-		0, 0, 0, 0, 73, 0, 0, 37, 5, 0, 0, 133, 0, 0, 0, 64,
-		73, 0, 0, 47, 5, 0, 0, 201, 0, 0, 0, 128, 73, 0, 0, 56,
-		5, 0, 0, 216, 0, 0, 0, 192, 74, 0, 0, 65, 5, 0, 0, 2,
-		0, 0, 0, 96, 75, 0, 0, 76, 5, 0, 0, 2, 0, 0, 0, 128,
-		75, 0, 0, 86, 5, 0, 0, 2, 0, 0, 0, 160, 75, 0, 0, 98,
-		5, 0, 0, 2, 0, 0, 0, 192, 75, 0, 0, 112, 5, 0, 0, 31,
-		0, 0, 0, 0, 76, 0, 0, 119, 5, 0, 0, 10, 0, 0, 0, 64,
-		76, 0, 0, 131, 5, 0, 0, 10, 0, 0, 0, 96, 76, 0, 1, 151,
-		5, 0, 0, 10, 0, 0, 0, 97, 76, 0, 1, 177, 5, 0, 0, 10,
-		0, 0, 0, 98, 76, 0, 1, 192, 5, 0, 0, 10, 0, 0, 0, 99,
-		76, 0, 1, 215, 5, 0, 0, 10, 0, 0, 0, 128, 76, 0, 1, 235,
-		5, 0, 0, 10, 0, 0, 0, 129, 76, 0, 1, 245, 5, 0, 0, 10,
-		0, 0, 0, 130, 76, 0, 1, 255, 5, 0, 0, 10, 0, 0, 0, 131,
-		76, 0, 1, 15, 6, 0, 0, 10, 0, 0, 0, 132, 76, 0, 1, 29,
-		6, 0, 0, 10, 0, 0, 0, 133, 76, 0, 1, 49, 6, 0, 0, 10,
-		0, 0, 0, 134, 76, 0, 1, 56, 6, 0, 0, 10, 0, 0, 0, 135,
-		76, 0, 1, 69, 6, 0, 0, 10, 0, 0, 0, 136, 76, 0, 1, 81,
-		6, 0, 0, 10, 0, 0, 0, 137, 76, 0, 1, 95, 6, 0, 0, 10,
-		0, 0, 0, 138, 76, 0, 1, 106, 6, 0, 0, 10, 0, 0, 0, 139,
-		76, 0, 1, 122, 6, 0, 0, 10, 0, 0, 0, 140, 76, 0, 1, 142,
-		6, 0, 0, 31, 0, 0, 0, 192, 76, 0, 0, 155, 6, 0, 0, 218,
-		0, 0, 0, 0, 77, 0, 0, 206, 0, 0, 0, 233, 0, 0, 0, 192,
-		78, 0, 0, 169, 6, 0, 0, 233, 0, 0, 0, 224, 78, 0, 0, 174,
-		6, 0, 0, 31, 0, 0, 0, 0, 79, 0, 0, 187, 6, 0, 0, 50,
-		0, 0, 0, 64, 79, 0, 0, 199, 6, 0, 0, 50, 0, 0, 0, 128,
-		79, 0, 0, 206, 6, 0, 0, 55, 0, 0, 0, 192, 79, 0, 0, 215,
-		6, 0, 0, 55, 0, 0, 0, 64, 80, 0, 0, 223, 6, 0, 0, 50,
-		0, 0, 0, 192, 80, 0, 0, 236, 6, 0, 0, 55, 0, 0, 0, 0,
-		81, 0, 0, 244, 6, 0, 0, 55, 0, 0, 0, 128, 81, 0, 0, 1,
-		7, 0, 0, 164, 0, 0, 0, 0, 82, 0, 0, 12, 7, 0, 0, 244,
-		0, 0, 0, 64, 82, 0, 0, 22, 7, 0, 0, 55, 0, 0, 0, 64,
-		84, 0, 0, 35, 7, 0, 0, 55, 0, 0, 0, 192, 84, 0, 0, 47,
-		7, 0, 0, 245, 0, 0, 0, 64, 85, 0, 0, 58, 7, 0, 0, 246,
-		0, 0, 0, 128, 85, 0, 0, 72, 7, 0, 0, 246, 0, 0, 0, 192,
-		85, 0, 0, 88, 7, 0, 0, 39, 0, 0, 0, 0, 86, 0, 0, 103,
-		7, 0, 0, 57, 0, 0, 0, 64, 86, 0, 0, 109, 7, 0, 0, 57,
-		0, 0, 0, 128, 86, 0, 0, 115, 7, 0, 0, 57, 0, 0, 0, 192,
-		86, 0, 0, 121, 7, 0, 0, 247, 0, 0, 0, 0, 87, 0, 0, 134,
-		7, 0, 0, 248, 0, 0, 0, 192, 87, 0, 0, 140, 7, 0, 0, 42,
-		0, 0, 0, 64, 89, 0, 0, 154, 7, 0, 0, 31, 0, 0, 0, 128,
-		89, 0, 0, 160, 7, 0, 0, 31, 0, 0, 0, 192, 89, 0, 0, 167,
-		7, 0, 0, 57, 0, 0, 0, 0, 90, 0, 0, 178, 7, 0, 0, 57,
-		0, 0, 0, 64, 90, 0, 0, 193, 7, 0, 0, 31, 0, 0, 0, 128,
-		90, 0, 0, 201, 7, 0, 0, 31, 0, 0, 0, 192, 90, 0, 0, 209,
-		7, 0, 0, 250, 0, 0, 0, 0, 91, 0, 0, 225, 7, 0, 0, 254,
-		0, 0, 0, 128, 93, 0, 0, 246, 7, 0, 0, 170, 0, 0, 0, 64,
-		94, 0, 0, 3, 8, 0, 0, 170, 0, 0, 0, 128, 94, 0, 0, 13,
-		8, 0, 0, 170, 0, 0, 0, 192, 94, 0, 0, 18, 8, 0, 0, 7,
-		1, 0, 0, 0, 95, 0, 0, 39, 8, 0, 0, 150, 1, 0, 0, 64,
-		95, 0, 0, 44, 8, 0, 0, 151, 1, 0, 0, 192, 95, 0, 0, 54,
-		8, 0, 0, 152, 1, 0, 0, 0, 96, 0, 0, 62, 8, 0, 0, 154,
-		1, 0, 0, 64, 96, 0, 0, 70, 8, 0, 0, 155, 1, 0, 0, 192,
-		96, 0, 0, 73, 8, 0, 0, 156, 1, 0, 0, 0, 97, 0, 0, 79,
-		8, 0, 0, 157, 1, 0, 0, 64, 97, 0, 0, 88, 8, 0, 0, 158,
-		1, 0, 0, 128, 97, 0, 0, 96, 8, 0, 0, 159, 1, 0, 0, 192,
-		97, 0, 0, 103, 8, 0, 0, 160, 1, 0, 0, 0, 98, 0, 0, 111,
-		8, 0, 0, 161, 1, 0, 0, 64, 98, 0, 0, 119, 8, 0, 0, 161,
-		1, 0, 0, 128, 98, 0, 0, 132, 8, 0, 0, 161, 1, 0, 0, 192,
-		98, 0, 0, 146, 8, 0, 0, 164, 1, 0, 0, 0, 99, 0, 0, 154,
-		8, 0, 0, 31, 0, 0, 0, 192, 99, 0, 0, 164, 8, 0, 0, 31,
-		1, 0, 0, 0, 100, 0, 0, 176, 8, 0, 0, 10, 0, 0, 0, 64,
-		100, 0, 0, 189, 8, 0, 0, 110, 0, 0, 0, 128, 100, 0, 0, 200,
-		8, 0, 0, 165, 1, 0, 0, 192, 100, 0, 0, 214, 8, 0, 0, 166,
-		0, 0, 0, 0, 101, 0, 0, 223, 8, 0, 0, 10, 0, 0, 0, 32,
-		101, 0, 0, 233, 8, 0, 0, 166, 1, 0, 0, 64, 101, 0, 0, 241,
-		8, 0, 0, 168, 1, 0, 0, 192, 101, 0, 0, 2, 9, 0, 0, 57,
-		0, 0, 0, 192, 102, 0, 0, 17, 9, 0, 0, 57, 0, 0, 0, 0,
-		103, 0, 0, 30, 9, 0, 0, 148, 0, 0, 0, 64, 103, 0, 0, 41,
-		9, 0, 0, 68, 0, 0, 0, 96, 103, 0, 0, 49, 9, 0, 0, 169,
-		1, 0, 0, 128, 103, 0, 0, 56, 9, 0, 0, 63, 0, 0, 0, 192,
-		103, 0, 0, 67, 9, 0, 0, 50, 0, 0, 0, 64, 104, 0, 0, 79,
-		9, 0, 0, 171, 1, 0, 0, 128, 104, 0, 0, 93, 9, 0, 0, 39,
-		0, 0, 0, 192, 104, 0, 0, 106, 9, 0, 0, 172, 1, 0, 0, 0,
-		105, 0, 0, 115, 9, 0, 0, 173, 1, 0, 0, 64, 105, 0, 0, 120,
-		9, 0, 0, 174, 1, 0, 0, 128, 105, 0, 0, 134, 9, 0, 0, 175,
-		1, 0, 0, 192, 105, 0, 0, 151, 9, 0, 0, 176, 1, 0, 0, 0,
-		106, 0, 0, 162, 9, 0, 0, 177, 1, 0, 0, 64, 106, 0, 0, 178,
-		9, 0, 0, 31, 0, 0, 0, 128, 106, 0, 0, 193, 9, 0, 0, 178,
-		1, 0, 0, 192, 106, 0, 0, 206, 9, 0, 0, 180, 1, 0, 0, 0,
-		107, 0, 0, 211, 9, 0, 0, 10, 0, 0, 0, 192, 108, 0, 0, 221,
-		9, 0, 0, 57, 0, 0, 0, 0, 109, 0, 0, 235, 9, 0, 0, 57,
-		0, 0, 0, 64, 109, 0, 0, 248, 9, 0, 0, 57, 0, 0, 0, 128,
-		109, 0, 0, 5, 10, 0, 0, 181, 1, 0, 0, 192, 109, 0, 0, 18,
-		10, 0, 0, 184, 1, 0, 0, 192, 113, 0, 0, 35, 10, 0, 0, 2,
-		0, 0, 0, 224, 113, 0, 0, 59, 10, 0, 0, 2, 0, 0, 0, 0,
-		114, 0, 0, 84, 10, 0, 0, 186, 1, 0, 0, 64, 114, 0, 0, 92,
-		10, 0, 0, 55, 0, 0, 0, 128, 114, 0, 0, 100, 10, 0, 0, 8,
-		0, 0, 0, 0, 115, 0, 0, 107, 10, 0, 0, 8, 0, 0, 0, 32,
-		115, 0, 0, 112, 10, 0, 0, 187, 1, 0, 0, 64, 115, 0, 0, 124,
-		10, 0, 0, 188, 1, 0, 0, 128, 115, 0, 0, 143, 10, 0, 0, 55,
-		0, 0, 0, 192, 115, 0, 0, 157, 10, 0, 0, 189, 1, 0, 0, 64,
-		116, 0, 0, 172, 10, 0, 0, 152, 0, 0, 0, 128, 116, 0, 0, 189,
-		10, 0, 0, 10, 0, 0, 0, 128, 117, 0, 0, 201, 10, 0, 0, 196,
-		1, 0, 0, 192, 117, 0, 0, 217, 10, 0, 0, 152, 0, 0, 0, 64,
-		118, 0, 0, 234, 10, 0, 0, 55, 0, 0, 0, 64, 119, 0, 0, 250,
-		10, 0, 0, 31, 0, 0, 0, 192, 119, 0, 0, 13, 11, 0, 0, 212,
-		0, 0, 0, 0, 120, 0, 0, 23, 11, 0, 0, 194, 0, 0, 0, 64,
-		120, 0, 0, 31, 11, 0, 0, 194, 0, 0, 0, 80, 120, 0, 0, 46,
-		11, 0, 0, 2, 0, 0, 0, 96, 120, 0, 0, 60, 11, 0, 0, 10,
-		0, 0, 0, 128, 120, 0, 0, 77, 11, 0, 0, 10, 0, 0, 0, 160,
-		120, 0, 0, 98, 11, 0, 0, 2, 0, 0, 0, 192, 120, 0, 0, 117,
-		11, 0, 0, 31, 0, 0, 0, 0, 121, 0, 0, 136, 11, 0, 0, 57,
-		0, 0, 0, 64, 121, 0, 0, 147, 11, 0, 0, 57, 0, 0, 0, 128,
-		121, 0, 0, 172, 11, 0, 0, 57, 0, 0, 0, 192, 121, 0, 0, 194,
-		11, 0, 0, 109, 0, 0, 0, 0, 122, 0, 0, 204, 11, 0, 0, 199,
-		1, 0, 0, 128, 122, 0, 0, 215, 11, 0, 0, 200, 1, 0, 0, 192,
-		122, 0, 0, 227, 11, 0, 0, 31, 0, 0, 0, 0, 123, 0, 0, 245,
-		11, 0, 0, 201, 1, 0, 0, 64, 123, 0, 0, 10, 12, 0, 0, 31,
-		0, 0, 0, 0, 124, 0, 0, 30, 12, 0, 0, 202, 1, 0, 0, 64,
-		124, 0, 0, 35, 12, 0, 0, 8, 0, 0, 0, 128, 124, 0, 0, 44,
-		12, 0, 0, 31, 0, 0, 0, 192, 124, 0, 0, 60, 12, 0, 0, 203,
-		1, 0, 0, 0, 125, 0, 0, 0, 0, 0, 0, 205, 1, 0, 0, 64,
-		157, 0, 0, 68, 12, 0, 0, 206, 1, 0, 0, 192, 157, 0, 0, 80,
-		12, 0, 0, 207, 1, 0, 0, 0, 158, 0, 0, 90, 12, 0, 0, 209,
-		1, 0, 0, 128, 158, 0, 0, 97, 12, 0, 0, 2, 0, 0, 0, 192,
-		158, 0, 0, 108, 12, 0, 0, 2, 0, 0, 0, 224, 158, 0, 0, 125,
-		12, 0, 0, 31, 0, 0, 0, 0, 159, 0, 0, 143, 12, 0, 0, 2,
-		0, 0, 0, 64, 159, 0, 0, 164, 12, 0, 0, 212, 1, 0, 0, 128,
-		159, 0, 0, 179, 12, 0, 0, 57, 0, 0, 0, 128, 23, 1, 0, 194,
-		12, 0, 0, 57, 0, 0, 0, 192, 23, 1, 0, 217, 12, 0, 0, 213,
-		1, 0, 0, 0, 24, 1, 0, 228, 12, 0, 0, 2, 0, 0, 0, 64,
-		24, 1, 0, 243, 12, 0, 0, 2, 0, 0, 0, 96, 24, 1, 0, 2,
-		13, 0, 0, 214, 1, 0, 0, 128, 24, 1, 0, 12, 13, 0, 0, 59,
-		0, 0, 0, 192, 24, 1, 0, 29, 13, 0, 0, 42, 0, 0, 0, 0,
-		25, 1, 0, 43, 13, 0, 0, 42, 0, 0, 0, 32, 25, 1, 0, 63,
-		13, 0, 0, 31, 0, 0, 0, 64, 25, 1, 0, 69, 13, 0, 0, 31,
-		0, 0, 0, 128, 25, 1, 0, 85, 13, 0, 0, 215, 1, 0, 0, 192,
-		25, 1, 0, 98, 13, 0, 0, 216, 1, 0, 0, 0, 26, 1, 0, 117,
-		13, 0, 0, 2, 0, 0, 0, 32, 26, 1, 0, 133, 13, 0, 0, 10,
-		0, 0, 0, 64, 26, 1, 0, 158, 13, 0, 0, 215, 1, 0, 0, 128,
-		26, 1, 0, 171, 13, 0, 0, 8, 2, 0, 0, 192, 26, 1, 0, 186,
-		13, 0, 0, 9, 2, 0, 0, 0, 27, 1, 0, 192, 13, 0, 0, 10,
-		0, 0, 0, 64, 27, 1, 0, 206, 13, 0, 0, 10, 0, 0, 0, 96,
-		27, 1, 0, 224, 13, 0, 0, 10, 2, 0, 0, 128, 27, 1, 0, 234,
-		13, 0, 0, 2, 0, 0, 0, 128, 27, 1, 0, 253, 13, 0, 0, 50,
-		0, 0, 0, 192, 27, 1, 0, 13, 14, 0, 0, 225, 1, 0, 0, 0,
-		28, 1, 0, 30, 14, 0, 0, 11, 2, 0, 0, 64, 29, 1, 0, 44,
-		14, 0, 0, 40, 0, 0, 0, 128, 29, 1, 0, 59, 14, 0, 0, 2,
-		0, 0, 0, 160, 29, 1, 0, 71, 14, 0, 0, 39, 0, 0, 0, 192,
-		29, 1, 0, 80, 14, 0, 0, 12, 2, 0, 0, 0, 30, 1, 0, 92,
-		14, 0, 0, 13, 2, 0, 0, 64, 30, 1, 0, 100, 14, 0, 0, 39,
-		0, 0, 0, 128, 30, 1, 0, 110, 14, 0, 0, 58, 0, 0, 0, 192,
-		30, 1, 0, 121, 14, 0, 0, 57, 0, 0, 0, 0, 31, 1, 0, 130,
-		14, 0, 0, 58, 0, 0, 0, 64, 31, 1, 1, 139, 14, 0, 0, 58,
-		0, 0, 0, 65, 31, 1, 1, 154, 14, 0, 0, 58, 0, 0, 0, 66,
-		31, 1, 62, 169, 14, 0, 0, 109, 0, 0, 0, 128, 31, 1, 0, 181,
-		14, 0, 0, 2, 0, 0, 0, 0, 32, 1, 0, 191, 14, 0, 0, 14,
-		2, 0, 0, 64, 32, 1, 0, 211, 14, 0, 0, 14, 2, 0, 0, 128,
-		32, 1, 0, 220, 14, 0, 0, 109, 0, 0, 0, 192, 32, 1, 0, 235,
-		14, 0, 0, 15, 2, 0, 0, 0, 34, 1, 0, 95, 2, 0, 0, 4,
-		0, 0, 4, 24, 0, 0, 0, 186, 0, 0, 0, 31, 0, 0, 0, 0,
-		0, 0, 0, 242, 14, 0, 0, 31, 0, 0, 0, 64, 0, 0, 0, 255,
-		14, 0, 0, 8, 0, 0, 0, 128, 0, 0, 0, 6, 15, 0, 0, 8,
-		0, 0, 0, 160, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 0,
-		0, 0, 0, 10, 15, 0, 0, 0, 0, 0, 8, 41, 0, 0, 0, 21,
-		15, 0, 0, 1, 0, 0, 4, 4, 0, 0, 0, 37, 15, 0, 0, 42,
-		0, 0, 0, 0, 0, 0, 0, 42, 15, 0, 0, 0, 0, 0, 8, 43,
-		0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 4, 4, 0, 0, 0, 51,
-		15, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 59, 15, 0, 0, 4,
-		0, 0, 4, 16, 0, 0, 0, 78, 15, 0, 0, 45, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 47, 0, 0, 0, 64, 0, 0, 0, 84,
-		15, 0, 0, 48, 0, 0, 0, 96, 0, 0, 0, 88, 15, 0, 0, 48,
-		0, 0, 0, 112, 0, 0, 0, 92, 15, 0, 0, 1, 0, 0, 4, 8,
-		0, 0, 0, 103, 15, 0, 0, 46, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 45, 0, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 5, 4, 0, 0, 0, 108, 15, 0, 0, 10, 0, 0, 0, 0,
-		0, 0, 0, 116, 15, 0, 0, 42, 0, 0, 0, 0, 0, 0, 0, 124,
-		15, 0, 0, 0, 0, 0, 8, 49, 0, 0, 0, 128, 15, 0, 0, 0,
-		0, 0, 8, 29, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 37,
-		0, 0, 0, 134, 15, 0, 0, 15, 0, 0, 4, 0, 1, 0, 0, 147,
-		15, 0, 0, 52, 0, 0, 0, 0, 0, 0, 0, 152, 15, 0, 0, 53,
-		0, 0, 0, 128, 0, 0, 0, 161, 15, 0, 0, 55, 0, 0, 0, 64,
-		1, 0, 0, 220, 2, 0, 0, 10, 0, 0, 0, 192, 1, 0, 0, 172,
-		15, 0, 0, 57, 0, 0, 0, 0, 2, 0, 0, 183, 15, 0, 0, 57,
-		0, 0, 0, 64, 2, 0, 0, 200, 15, 0, 0, 57, 0, 0, 0, 128,
-		2, 0, 0, 209, 15, 0, 0, 57, 0, 0, 0, 192, 2, 0, 0, 231,
-		15, 0, 0, 57, 0, 0, 0, 0, 3, 0, 0, 245, 15, 0, 0, 2,
-		0, 0, 0, 64, 3, 0, 0, 199, 6, 0, 0, 60, 0, 0, 0, 128,
-		3, 0, 0, 251, 15, 0, 0, 61, 0, 0, 0, 192, 3, 0, 0, 2,
-		16, 0, 0, 61, 0, 0, 0, 0, 4, 0, 0, 7, 16, 0, 0, 31,
-		0, 0, 0, 64, 4, 0, 0, 23, 16, 0, 0, 65, 0, 0, 0, 0,
-		6, 0, 0, 27, 16, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 39,
-		16, 0, 0, 31, 0, 0, 0, 0, 0, 0, 0, 46, 16, 0, 0, 8,
-		0, 0, 0, 64, 0, 0, 0, 57, 16, 0, 0, 3, 0, 0, 4, 24,
-		0, 0, 0, 65, 16, 0, 0, 31, 0, 0, 0, 0, 0, 0, 0, 83,
-		16, 0, 0, 54, 0, 0, 0, 64, 0, 0, 0, 92, 16, 0, 0, 54,
-		0, 0, 0, 128, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 53,
-		0, 0, 0, 100, 16, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 103,
-		15, 0, 0, 56, 0, 0, 0, 0, 0, 0, 0, 110, 16, 0, 0, 56,
-		0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 55,
-		0, 0, 0, 115, 16, 0, 0, 0, 0, 0, 8, 58, 0, 0, 0, 119,
-		16, 0, 0, 0, 0, 0, 8, 59, 0, 0, 0, 125, 16, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 2, 0, 0, 4, 16,
+		0, 0, 0, 48, 0, 0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 53,
+		0, 0, 0, 15, 0, 0, 0, 64, 0, 0, 0, 85, 0, 0, 0, 0,
+		0, 0, 14, 17, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 23, 0, 0, 0, 92, 0, 0, 0, 0, 0, 0, 8, 21,
+		0, 0, 0, 95, 0, 0, 0, 0, 0, 0, 8, 22, 0, 0, 0, 100,
+		0, 0, 0, 0, 0, 0, 1, 1, 0, 0, 0, 8, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 20, 0, 0, 0, 4,
+		0, 0, 0, 0, 4, 0, 0, 0, 0, 0, 0, 4, 0, 0, 4, 32,
+		0, 0, 0, 48, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 53,
+		0, 0, 0, 5, 0, 0, 0, 64, 0, 0, 0, 65, 0, 0, 0, 7,
+		0, 0, 0, 128, 0, 0, 0, 69, 0, 0, 0, 19, 0, 0, 0, 192,
+		0, 0, 0, 114, 0, 0, 0, 0, 0, 0, 14, 24, 0, 0, 0, 1,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 27, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 2, 0, 0, 0, 4,
+		0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 29,
+		0, 0, 0, 129, 0, 0, 0, 0, 0, 0, 8, 30, 0, 0, 0, 133,
+		0, 0, 0, 0, 0, 0, 8, 31, 0, 0, 0, 139, 0, 0, 0, 0,
+		0, 0, 1, 8, 0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 4, 32, 0, 0, 0, 48, 0, 0, 0, 26, 0, 0, 0, 0,
+		0, 0, 0, 53, 0, 0, 0, 26, 0, 0, 0, 64, 0, 0, 0, 65,
+		0, 0, 0, 7, 0, 0, 0, 128, 0, 0, 0, 69, 0, 0, 0, 28,
+		0, 0, 0, 192, 0, 0, 0, 158, 0, 0, 0, 0, 0, 0, 14, 32,
+		0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 35,
+		0, 0, 0, 172, 0, 0, 0, 4, 0, 0, 4, 64, 0, 0, 0, 198,
+		0, 0, 0, 36, 0, 0, 0, 0, 0, 0, 0, 202, 0, 0, 0, 38,
+		0, 0, 0, 64, 0, 0, 0, 205, 0, 0, 0, 40, 0, 0, 0, 128,
+		0, 0, 0, 210, 0, 0, 0, 42, 0, 0, 0, 0, 2, 0, 0, 217,
+		0, 0, 0, 4, 0, 0, 4, 8, 0, 0, 0, 48, 0, 0, 0, 37,
+		0, 0, 0, 0, 0, 0, 0, 229, 0, 0, 0, 22, 0, 0, 0, 16,
+		0, 0, 0, 235, 0, 0, 0, 22, 0, 0, 0, 24, 0, 0, 0, 249,
+		0, 0, 0, 2, 0, 0, 0, 32, 0, 0, 0, 253, 0, 0, 0, 0,
+		0, 0, 1, 2, 0, 0, 0, 16, 0, 0, 0, 12, 1, 0, 0, 0,
+		0, 0, 1, 8, 0, 0, 0, 64, 0, 0, 1, 17, 1, 0, 0, 0,
 		0, 0, 1, 8, 0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 51, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 62,
-		0, 0, 0, 251, 15, 0, 0, 37, 0, 0, 4, 192, 1, 0, 0, 147,
-		15, 0, 0, 52, 0, 0, 0, 0, 0, 0, 0, 144, 16, 0, 0, 10,
-		0, 0, 0, 128, 0, 0, 0, 155, 16, 0, 0, 10, 0, 0, 0, 160,
-		0, 0, 0, 168, 16, 0, 0, 10, 0, 0, 0, 192, 0, 0, 0, 184,
-		16, 0, 0, 10, 0, 0, 0, 224, 0, 0, 0, 202, 16, 0, 0, 57,
-		0, 0, 0, 0, 1, 0, 0, 213, 16, 0, 0, 57, 0, 0, 0, 64,
-		1, 0, 0, 226, 16, 0, 0, 10, 0, 0, 0, 128, 1, 0, 0, 240,
-		16, 0, 0, 57, 0, 0, 0, 192, 1, 0, 0, 0, 17, 0, 0, 63,
-		0, 0, 0, 0, 2, 0, 0, 15, 17, 0, 0, 60, 0, 0, 0, 128,
-		2, 0, 0, 103, 15, 0, 0, 60, 0, 0, 0, 192, 2, 0, 0, 20,
-		17, 0, 0, 60, 0, 0, 0, 0, 3, 0, 0, 25, 17, 0, 0, 60,
-		0, 0, 0, 64, 3, 0, 0, 30, 17, 0, 0, 10, 0, 0, 0, 128,
-		3, 0, 0, 23, 16, 0, 0, 65, 0, 0, 0, 0, 4, 0, 0, 45,
-		17, 0, 0, 67, 0, 0, 0, 0, 6, 0, 0, 53, 17, 0, 0, 31,
-		0, 0, 0, 0, 8, 0, 0, 73, 17, 0, 0, 30, 0, 0, 0, 64,
-		8, 0, 0, 83, 17, 0, 0, 30, 0, 0, 0, 128, 8, 0, 0, 101,
-		17, 0, 0, 31, 0, 0, 0, 192, 8, 0, 0, 108, 17, 0, 0, 57,
-		0, 0, 0, 0, 9, 0, 0, 127, 17, 0, 0, 60, 0, 0, 0, 64,
-		9, 0, 0, 139, 17, 0, 0, 75, 0, 0, 0, 128, 9, 0, 0, 142,
-		17, 0, 0, 2, 0, 0, 0, 192, 9, 0, 0, 150, 17, 0, 0, 55,
-		0, 0, 0, 0, 10, 0, 0, 167, 17, 0, 0, 76, 0, 0, 0, 128,
-		10, 0, 0, 170, 17, 0, 0, 2, 0, 0, 0, 192, 10, 0, 0, 175,
-		17, 0, 0, 2, 0, 0, 0, 224, 10, 0, 0, 191, 17, 0, 0, 77,
-		0, 0, 0, 0, 11, 0, 0, 209, 17, 0, 0, 57, 0, 0, 0, 64,
-		11, 0, 0, 229, 17, 0, 0, 57, 0, 0, 0, 128, 11, 0, 0, 245,
-		17, 0, 0, 57, 0, 0, 0, 192, 11, 0, 0, 10, 18, 0, 0, 57,
-		0, 0, 0, 0, 12, 0, 0, 36, 18, 0, 0, 2, 0, 0, 0, 64,
-		12, 0, 0, 46, 18, 0, 0, 2, 0, 0, 0, 96, 12, 0, 0, 61,
-		18, 0, 0, 55, 0, 0, 0, 128, 12, 0, 0, 76, 18, 0, 0, 2,
-		0, 0, 4, 16, 0, 0, 0, 91, 18, 0, 0, 64, 0, 0, 0, 0,
-		0, 0, 0, 99, 18, 0, 0, 54, 0, 0, 0, 64, 0, 0, 0, 91,
-		18, 0, 0, 1, 0, 0, 4, 8, 0, 0, 0, 57, 16, 0, 0, 54,
-		0, 0, 0, 0, 0, 0, 0, 111, 18, 0, 0, 9, 0, 0, 4, 64,
-		0, 0, 0, 121, 18, 0, 0, 57, 0, 0, 0, 0, 0, 0, 0, 138,
-		18, 0, 0, 57, 0, 0, 0, 64, 0, 0, 0, 147, 18, 0, 0, 57,
-		0, 0, 0, 128, 0, 0, 0, 160, 18, 0, 0, 8, 0, 0, 0, 192,
-		0, 0, 0, 169, 18, 0, 0, 8, 0, 0, 0, 224, 0, 0, 0, 184,
-		18, 0, 0, 31, 0, 0, 0, 0, 1, 0, 0, 193, 18, 0, 0, 31,
-		0, 0, 0, 64, 1, 0, 0, 206, 18, 0, 0, 31, 0, 0, 0, 128,
-		1, 0, 0, 215, 18, 0, 0, 66, 0, 0, 0, 192, 1, 0, 0, 215,
-		18, 0, 0, 2, 0, 0, 4, 8, 0, 0, 0, 224, 18, 0, 0, 10,
-		0, 0, 0, 0, 0, 0, 0, 233, 18, 0, 0, 10, 0, 0, 0, 32,
-		0, 0, 0, 0, 0, 0, 0, 5, 0, 0, 4, 64, 0, 0, 0, 238,
-		18, 0, 0, 68, 0, 0, 0, 0, 0, 0, 0, 243, 18, 0, 0, 2,
-		0, 0, 0, 32, 0, 0, 0, 184, 18, 0, 0, 31, 0, 0, 0, 64,
-		0, 0, 0, 206, 18, 0, 0, 31, 0, 0, 0, 128, 0, 0, 0, 193,
-		18, 0, 0, 31, 0, 0, 0, 192, 0, 0, 0, 246, 18, 0, 0, 0,
-		0, 0, 8, 69, 0, 0, 0, 5, 19, 0, 0, 1, 0, 0, 4, 4,
-		0, 0, 0, 18, 19, 0, 0, 70, 0, 0, 0, 0, 0, 0, 0, 27,
-		19, 0, 0, 0, 0, 0, 8, 71, 0, 0, 0, 43, 19, 0, 0, 1,
-		0, 0, 4, 4, 0, 0, 0, 0, 0, 0, 0, 72, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 5, 4, 0, 0, 0, 53,
-		19, 0, 0, 42, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 73,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 74, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 4, 2, 0, 0, 0, 57,
-		19, 0, 0, 20, 0, 0, 0, 0, 0, 0, 0, 146, 8, 0, 0, 20,
-		0, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 4, 4,
-		0, 0, 0, 64, 19, 0, 0, 48, 0, 0, 0, 0, 0, 0, 0, 79,
-		19, 0, 0, 48, 0, 0, 0, 16, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 192, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 94,
-		0, 0, 0, 84, 19, 0, 0, 0, 0, 0, 8, 78, 0, 0, 0, 88,
-		19, 0, 0, 0, 0, 0, 8, 79, 0, 0, 0, 94, 19, 0, 0, 0,
-		0, 0, 1, 8, 0, 0, 0, 64, 0, 0, 1, 104, 19, 0, 0, 7,
-		0, 0, 4, 48, 0, 0, 0, 120, 19, 0, 0, 55, 0, 0, 0, 0,
-		0, 0, 0, 129, 19, 0, 0, 31, 0, 0, 0, 128, 0, 0, 0, 137,
-		19, 0, 0, 31, 0, 0, 0, 192, 0, 0, 0, 152, 19, 0, 0, 10,
-		0, 0, 0, 0, 1, 0, 0, 220, 2, 0, 0, 29, 0, 0, 0, 32,
-		1, 0, 0, 142, 17, 0, 0, 29, 0, 0, 0, 48, 1, 0, 0, 163,
-		19, 0, 0, 81, 0, 0, 0, 64, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 80, 0, 0, 0, 168, 19, 0, 0, 16, 0, 0, 132, 224,
-		0, 0, 0, 57, 16, 0, 0, 53, 0, 0, 0, 0, 0, 0, 0, 184,
-		19, 0, 0, 57, 0, 0, 0, 192, 0, 0, 0, 195, 19, 0, 0, 57,
-		0, 0, 0, 0, 1, 0, 0, 207, 19, 0, 0, 57, 0, 0, 0, 64,
-		1, 0, 0, 217, 19, 0, 0, 57, 0, 0, 0, 128, 1, 0, 0, 223,
-		19, 0, 0, 57, 0, 0, 0, 192, 1, 0, 0, 234, 19, 0, 0, 77,
-		0, 0, 0, 0, 2, 0, 0, 242, 19, 0, 0, 57, 0, 0, 0, 64,
-		2, 0, 0, 186, 0, 0, 0, 10, 0, 0, 0, 128, 2, 0, 0, 251,
-		19, 0, 0, 10, 0, 0, 0, 160, 2, 0, 1, 8, 20, 0, 0, 10,
-		0, 0, 0, 161, 2, 0, 1, 19, 20, 0, 0, 10, 0, 0, 0, 162,
-		2, 0, 1, 37, 20, 0, 0, 10, 0, 0, 0, 163, 2, 0, 1, 48,
-		20, 0, 0, 83, 0, 0, 0, 192, 2, 0, 0, 57, 20, 0, 0, 83,
-		0, 0, 0, 192, 4, 0, 0, 72, 20, 0, 0, 91, 0, 0, 0, 192,
-		6, 0, 0, 78, 20, 0, 0, 8, 0, 0, 4, 64, 0, 0, 0, 86,
-		20, 0, 0, 84, 0, 0, 0, 0, 0, 0, 0, 91, 20, 0, 0, 85,
-		0, 0, 0, 0, 1, 0, 0, 104, 20, 0, 0, 86, 0, 0, 0, 64,
-		1, 0, 0, 113, 20, 0, 0, 90, 0, 0, 0, 128, 1, 0, 0, 118,
-		20, 0, 0, 20, 0, 0, 0, 192, 1, 0, 0, 124, 20, 0, 0, 20,
-		0, 0, 0, 200, 1, 0, 0, 131, 20, 0, 0, 20, 0, 0, 0, 208,
-		1, 0, 0, 139, 20, 0, 0, 20, 0, 0, 0, 216, 1, 0, 0, 147,
-		20, 0, 0, 2, 0, 0, 4, 32, 0, 0, 0, 86, 20, 0, 0, 53,
-		0, 0, 0, 0, 0, 0, 0, 163, 20, 0, 0, 85, 0, 0, 0, 192,
-		0, 0, 0, 171, 20, 0, 0, 0, 0, 0, 8, 77, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 87, 0, 0, 0, 0, 0, 0, 0, 1,
-		0, 0, 13, 88, 0, 0, 0, 0, 0, 0, 0, 89, 0, 0, 0, 179,
-		20, 0, 0, 2, 0, 0, 6, 4, 0, 0, 0, 195, 20, 0, 0, 0,
-		0, 0, 0, 213, 20, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 83, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 211,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 82, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 93, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 10, 53, 4, 0, 0, 229, 20, 0, 0, 13, 0, 0, 4, 128,
-		2, 0, 0, 240, 20, 0, 0, 95, 0, 0, 0, 0, 0, 0, 0, 11,
-		3, 0, 0, 115, 0, 0, 0, 64, 6, 0, 0, 251, 15, 0, 0, 116,
-		0, 0, 0, 128, 6, 0, 0, 244, 20, 0, 0, 31, 0, 0, 0, 192,
-		6, 0, 0, 170, 17, 0, 0, 2, 0, 0, 0, 0, 7, 0, 0, 184,
-		18, 0, 0, 101, 0, 0, 0, 0, 8, 0, 0, 251, 20, 0, 0, 109,
-		0, 0, 0, 64, 8, 0, 0, 255, 20, 0, 0, 55, 0, 0, 0, 192,
-		8, 0, 0, 199, 6, 0, 0, 76, 0, 0, 0, 64, 9, 0, 0, 4,
-		21, 0, 0, 55, 0, 0, 0, 128, 9, 0, 0, 206, 6, 0, 0, 55,
-		0, 0, 0, 0, 10, 0, 0, 13, 21, 0, 0, 117, 0, 0, 0, 128,
-		10, 0, 0, 23, 21, 0, 0, 118, 0, 0, 0, 192, 10, 0, 0, 37,
-		21, 0, 0, 13, 0, 0, 4, 200, 0, 0, 0, 57, 21, 0, 0, 96,
-		0, 0, 0, 0, 0, 0, 0, 64, 21, 0, 0, 97, 0, 0, 0, 64,
-		0, 0, 0, 67, 21, 0, 0, 98, 0, 0, 0, 128, 0, 0, 0, 215,
-		6, 0, 0, 55, 0, 0, 0, 0, 1, 0, 0, 206, 6, 0, 0, 55,
-		0, 0, 0, 128, 1, 0, 0, 74, 21, 0, 0, 55, 0, 0, 0, 0,
-		2, 0, 0, 159, 0, 0, 0, 2, 0, 0, 0, 128, 2, 0, 0, 186,
-		0, 0, 0, 10, 0, 0, 0, 160, 2, 0, 0, 89, 21, 0, 0, 57,
-		0, 0, 0, 192, 2, 0, 0, 99, 21, 0, 0, 42, 0, 0, 0, 0,
-		3, 0, 0, 110, 21, 0, 0, 100, 0, 0, 0, 64, 3, 0, 0, 123,
-		21, 0, 0, 108, 0, 0, 0, 64, 4, 0, 0, 199, 6, 0, 0, 114,
-		0, 0, 0, 0, 6, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 221,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 232, 3, 0, 0, 137,
-		21, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 148, 21, 0, 0, 31,
-		0, 0, 0, 0, 0, 0, 0, 165, 21, 0, 0, 99, 0, 0, 0, 64,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 235, 3, 0, 0, 170,
-		21, 0, 0, 3, 0, 0, 4, 32, 0, 0, 0, 165, 21, 0, 0, 101,
-		0, 0, 0, 0, 0, 0, 0, 182, 21, 0, 0, 55, 0, 0, 0, 64,
-		0, 0, 0, 188, 21, 0, 0, 104, 0, 0, 0, 192, 0, 0, 0, 193,
-		21, 0, 0, 0, 0, 0, 8, 102, 0, 0, 0, 207, 21, 0, 0, 0,
-		0, 0, 8, 103, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 4, 8,
-		0, 0, 0, 51, 15, 0, 0, 77, 0, 0, 0, 0, 0, 0, 0, 218,
-		21, 0, 0, 0, 0, 0, 8, 105, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 106, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 13, 0,
-		0, 0, 0, 0, 0, 0, 0, 107, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 100, 0, 0, 0, 230, 21, 0, 0, 3, 0, 0, 4, 56,
-		0, 0, 0, 239, 21, 0, 0, 100, 0, 0, 0, 0, 0, 0, 0, 251,
-		20, 0, 0, 109, 0, 0, 0, 0, 1, 0, 0, 244, 21, 0, 0, 113,
-		0, 0, 0, 128, 1, 0, 0, 247, 21, 0, 0, 2, 0, 0, 4, 16,
-		0, 0, 0, 103, 15, 0, 0, 110, 0, 0, 0, 0, 0, 0, 0, 188,
-		21, 0, 0, 111, 0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 109, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 112,
-		0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 13, 0, 0, 0, 0, 0,
-		0, 0, 0, 110, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 227,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 95, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 60, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 61, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 52,
-		4, 0, 0, 23, 21, 0, 0, 18, 0, 0, 4, 240, 0, 0, 0, 238,
-		18, 0, 0, 68, 0, 0, 0, 0, 0, 0, 0, 5, 22, 0, 0, 85,
-		0, 0, 0, 64, 0, 0, 0, 12, 22, 0, 0, 57, 0, 0, 0, 128,
-		0, 0, 0, 234, 19, 0, 0, 57, 0, 0, 0, 192, 0, 0, 0, 18,
-		22, 0, 0, 57, 0, 0, 0, 0, 1, 0, 0, 24, 22, 0, 0, 57,
-		0, 0, 0, 64, 1, 0, 0, 37, 22, 0, 0, 77, 0, 0, 0, 128,
-		1, 0, 0, 170, 17, 0, 0, 20, 0, 0, 0, 192, 1, 0, 0, 56,
-		22, 0, 0, 20, 0, 0, 0, 200, 1, 0, 0, 70, 22, 0, 0, 20,
-		0, 0, 0, 208, 1, 0, 0, 84, 22, 0, 0, 83, 0, 0, 0, 0,
-		2, 0, 0, 97, 22, 0, 0, 83, 0, 0, 0, 0, 4, 0, 0, 109,
-		22, 0, 0, 55, 0, 0, 0, 0, 6, 0, 0, 126, 22, 0, 0, 2,
-		0, 0, 0, 128, 6, 0, 0, 137, 22, 0, 0, 2, 0, 0, 0, 160,
-		6, 0, 0, 150, 22, 0, 0, 2, 0, 0, 0, 192, 6, 0, 0, 159,
-		22, 0, 0, 57, 0, 0, 0, 0, 7, 0, 0, 174, 22, 0, 0, 57,
-		0, 0, 0, 64, 7, 0, 0, 185, 22, 0, 0, 29, 0, 0, 4, 0,
-		1, 0, 0, 202, 22, 0, 0, 57, 0, 0, 0, 0, 0, 0, 0, 213,
-		22, 0, 0, 57, 0, 0, 0, 64, 0, 0, 0, 222, 22, 0, 0, 57,
-		0, 0, 0, 128, 0, 0, 0, 233, 22, 0, 0, 57, 0, 0, 0, 192,
-		0, 0, 0, 242, 22, 0, 0, 57, 0, 0, 0, 0, 1, 0, 0, 255,
-		22, 0, 0, 57, 0, 0, 0, 64, 1, 0, 0, 10, 23, 0, 0, 57,
-		0, 0, 0, 128, 1, 0, 0, 22, 23, 0, 0, 57, 0, 0, 0, 192,
-		1, 0, 0, 32, 23, 0, 0, 77, 0, 0, 0, 0, 2, 0, 0, 50,
-		23, 0, 0, 57, 0, 0, 0, 64, 2, 0, 0, 62, 23, 0, 0, 57,
-		0, 0, 0, 128, 2, 0, 0, 72, 23, 0, 0, 77, 0, 0, 0, 192,
-		2, 0, 0, 90, 23, 0, 0, 57, 0, 0, 0, 0, 3, 0, 0, 99,
-		23, 0, 0, 57, 0, 0, 0, 64, 3, 0, 0, 109, 23, 0, 0, 57,
-		0, 0, 0, 128, 3, 0, 0, 128, 23, 0, 0, 57, 0, 0, 0, 192,
-		3, 0, 0, 156, 23, 0, 0, 57, 0, 0, 0, 0, 4, 0, 0, 185,
-		23, 0, 0, 57, 0, 0, 0, 64, 4, 0, 0, 210, 23, 0, 0, 57,
-		0, 0, 0, 128, 4, 0, 0, 231, 23, 0, 0, 57, 0, 0, 0, 192,
-		4, 0, 0, 242, 23, 0, 0, 57, 0, 0, 0, 0, 5, 0, 0, 2,
-		24, 0, 0, 57, 0, 0, 0, 64, 5, 0, 0, 21, 24, 0, 0, 57,
-		0, 0, 0, 128, 5, 0, 0, 38, 24, 0, 0, 57, 0, 0, 0, 192,
-		5, 0, 0, 56, 24, 0, 0, 57, 0, 0, 0, 0, 6, 0, 0, 74,
-		24, 0, 0, 57, 0, 0, 0, 64, 6, 0, 0, 101, 24, 0, 0, 57,
-		0, 0, 0, 128, 6, 0, 0, 120, 24, 0, 0, 57, 0, 0, 0, 192,
-		6, 0, 0, 136, 24, 0, 0, 57, 0, 0, 0, 0, 7, 0, 0, 155,
-		24, 0, 0, 1, 0, 0, 4, 8, 0, 0, 0, 166, 24, 0, 0, 121,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 242,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 123, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 10, 124, 0, 0, 0, 172, 24, 0, 0, 0,
-		0, 0, 8, 126, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 124,
-		0, 0, 0, 182, 24, 0, 0, 1, 0, 0, 4, 0, 4, 0, 0, 190,
-		24, 0, 0, 127, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 3, 0, 0, 0, 0, 31, 0, 0, 0, 4, 0, 0, 0, 128,
-		0, 0, 0, 195, 24, 0, 0, 2, 0, 0, 5, 4, 0, 0, 0, 207,
-		24, 0, 0, 129, 0, 0, 0, 0, 0, 0, 0, 209, 24, 0, 0, 8,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 4, 0, 0, 4, 4,
-		0, 0, 0, 111, 8, 0, 0, 20, 0, 0, 0, 0, 0, 0, 0, 211,
-		24, 0, 0, 20, 0, 0, 0, 8, 0, 0, 0, 219, 24, 0, 0, 20,
-		0, 0, 0, 16, 0, 0, 0, 228, 24, 0, 0, 20, 0, 0, 0, 24,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 54, 4, 0, 0, 240,
-		4, 0, 0, 4, 0, 0, 4, 32, 0, 0, 0, 236, 24, 0, 0, 31,
-		0, 0, 0, 0, 0, 0, 0, 243, 24, 0, 0, 59, 0, 0, 0, 64,
-		0, 0, 0, 253, 24, 0, 0, 59, 0, 0, 0, 128, 0, 0, 0, 10,
-		25, 0, 0, 59, 0, 0, 0, 192, 0, 0, 0, 22, 25, 0, 0, 3,
-		0, 0, 4, 40, 0, 0, 0, 226, 2, 0, 0, 2, 0, 0, 0, 0,
-		0, 0, 0, 33, 25, 0, 0, 55, 0, 0, 0, 64, 0, 0, 0, 43,
-		25, 0, 0, 55, 0, 0, 0, 192, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 134, 0, 0, 0, 53, 25, 0, 0, 2, 0, 0, 4, 24,
-		4, 0, 0, 0, 0, 0, 0, 135, 0, 0, 0, 0, 0, 0, 0, 63,
-		25, 0, 0, 200, 0, 0, 0, 192, 32, 0, 0, 0, 0, 0, 0, 62,
-		0, 0, 4, 24, 4, 0, 0, 74, 25, 0, 0, 136, 0, 0, 0, 0,
-		0, 0, 0, 79, 25, 0, 0, 64, 0, 0, 0, 64, 0, 0, 0, 85,
-		25, 0, 0, 57, 0, 0, 0, 128, 0, 0, 0, 101, 25, 0, 0, 137,
-		0, 0, 0, 192, 0, 0, 0, 119, 25, 0, 0, 31, 0, 0, 0, 0,
-		1, 0, 0, 129, 25, 0, 0, 31, 0, 0, 0, 64, 1, 0, 0, 146,
-		25, 0, 0, 31, 0, 0, 0, 128, 1, 0, 0, 163, 25, 0, 0, 31,
-		0, 0, 0, 192, 1, 0, 0, 187, 25, 0, 0, 31, 0, 0, 0, 0,
-		2, 0, 0, 197, 25, 0, 0, 31, 0, 0, 0, 64, 2, 0, 0, 212,
-		25, 0, 0, 176, 0, 0, 0, 128, 2, 0, 0, 216, 25, 0, 0, 42,
-		0, 0, 0, 192, 2, 0, 0, 233, 25, 0, 0, 42, 0, 0, 0, 224,
-		2, 0, 0, 242, 25, 0, 0, 42, 0, 0, 0, 0, 3, 0, 0, 251,
-		25, 0, 0, 101, 0, 0, 0, 64, 3, 0, 0, 10, 26, 0, 0, 2,
-		0, 0, 0, 128, 3, 0, 0, 20, 26, 0, 0, 148, 0, 0, 0, 160,
-		3, 0, 0, 36, 26, 0, 0, 180, 0, 0, 0, 192, 3, 0, 0, 46,
-		26, 0, 0, 55, 0, 0, 0, 0, 5, 0, 0, 53, 26, 0, 0, 31,
-		0, 0, 0, 128, 5, 0, 0, 65, 26, 0, 0, 31, 0, 0, 0, 192,
-		5, 0, 0, 76, 26, 0, 0, 31, 0, 0, 0, 0, 6, 0, 0, 85,
-		26, 0, 0, 31, 0, 0, 0, 64, 6, 0, 0, 95, 26, 0, 0, 102,
-		0, 0, 0, 128, 6, 0, 0, 105, 26, 0, 0, 31, 0, 0, 0, 192,
-		6, 0, 0, 113, 26, 0, 0, 31, 0, 0, 0, 0, 7, 0, 0, 121,
-		26, 0, 0, 31, 0, 0, 0, 64, 7, 0, 0, 130, 26, 0, 0, 31,
-		0, 0, 0, 128, 7, 0, 0, 140, 26, 0, 0, 181, 0, 0, 0, 192,
-		7, 0, 0, 158, 26, 0, 0, 148, 0, 0, 0, 224, 7, 0, 0, 167,
-		26, 0, 0, 31, 0, 0, 0, 0, 8, 0, 0, 178, 26, 0, 0, 31,
-		0, 0, 0, 64, 8, 0, 0, 187, 26, 0, 0, 31, 0, 0, 0, 128,
-		8, 0, 0, 198, 26, 0, 0, 31, 0, 0, 0, 192, 8, 0, 0, 207,
-		26, 0, 0, 31, 0, 0, 0, 0, 9, 0, 0, 217, 26, 0, 0, 31,
-		0, 0, 0, 64, 9, 0, 0, 221, 26, 0, 0, 31, 0, 0, 0, 128,
-		9, 0, 0, 233, 26, 0, 0, 31, 0, 0, 0, 192, 9, 0, 0, 243,
-		26, 0, 0, 31, 0, 0, 0, 0, 10, 0, 0, 251, 26, 0, 0, 31,
-		0, 0, 0, 64, 10, 0, 0, 5, 27, 0, 0, 31, 0, 0, 0, 128,
-		10, 0, 0, 13, 27, 0, 0, 183, 0, 0, 0, 192, 10, 0, 0, 56,
-		5, 0, 0, 184, 0, 0, 0, 192, 22, 0, 0, 24, 27, 0, 0, 186,
-		0, 0, 0, 192, 23, 0, 0, 31, 27, 0, 0, 187, 0, 0, 0, 0,
-		24, 0, 0, 186, 0, 0, 0, 31, 0, 0, 0, 0, 28, 0, 0, 39,
-		27, 0, 0, 148, 0, 0, 0, 64, 28, 0, 0, 50, 27, 0, 0, 195,
-		0, 0, 0, 128, 28, 0, 0, 62, 27, 0, 0, 50, 0, 0, 0, 192,
-		28, 0, 0, 68, 27, 0, 0, 196, 0, 0, 0, 0, 29, 0, 0, 76,
-		27, 0, 0, 139, 0, 0, 0, 64, 29, 0, 0, 85, 27, 0, 0, 197,
-		0, 0, 0, 128, 29, 0, 0, 108, 27, 0, 0, 31, 0, 0, 0, 192,
-		29, 0, 0, 123, 27, 0, 0, 31, 0, 0, 0, 0, 30, 0, 0, 46,
-		11, 0, 0, 2, 0, 0, 0, 64, 30, 0, 0, 140, 27, 0, 0, 42,
-		0, 0, 0, 96, 30, 0, 0, 158, 27, 0, 0, 42, 0, 0, 0, 128,
-		30, 0, 0, 176, 27, 0, 0, 198, 0, 0, 0, 192, 30, 0, 0, 190,
-		27, 0, 0, 101, 0, 0, 0, 0, 31, 0, 0, 204, 27, 0, 0, 100,
-		0, 0, 0, 64, 31, 0, 0, 219, 27, 0, 0, 8, 0, 0, 0, 64,
-		32, 0, 0, 225, 27, 0, 0, 31, 0, 0, 0, 128, 32, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 202, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 138, 0, 0, 0, 0, 0, 0, 0, 5, 0, 0, 13, 31,
-		0, 0, 0, 0, 0, 0, 0, 139, 0, 0, 0, 0, 0, 0, 0, 31,
-		0, 0, 0, 0, 0, 0, 0, 31, 0, 0, 0, 0, 0, 0, 0, 31,
-		0, 0, 0, 0, 0, 0, 0, 31, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 140, 0, 0, 0, 243, 27, 0, 0, 20, 0, 0, 4, 232,
-		0, 0, 0, 0, 0, 0, 0, 141, 0, 0, 0, 0, 0, 0, 0, 248,
-		27, 0, 0, 142, 0, 0, 0, 128, 0, 0, 0, 255, 27, 0, 0, 145,
-		0, 0, 0, 0, 1, 0, 0, 7, 28, 0, 0, 146, 0, 0, 0, 64,
-		1, 0, 0, 12, 28, 0, 0, 148, 0, 0, 0, 128, 1, 0, 0, 19,
-		28, 0, 0, 101, 0, 0, 0, 192, 1, 0, 0, 27, 28, 0, 0, 10,
-		0, 0, 0, 0, 2, 0, 0, 35, 28, 0, 0, 151, 0, 0, 0, 32,
-		2, 0, 0, 42, 28, 0, 0, 152, 0, 0, 0, 64, 2, 0, 0, 53,
-		28, 0, 0, 154, 0, 0, 0, 64, 3, 0, 0, 59, 28, 0, 0, 156,
-		0, 0, 0, 128, 3, 0, 0, 67, 28, 0, 0, 170, 0, 0, 0, 128,
-		4, 0, 0, 74, 28, 0, 0, 172, 0, 0, 0, 192, 4, 0, 0, 79,
-		28, 0, 0, 57, 0, 0, 0, 192, 5, 0, 0, 89, 28, 0, 0, 39,
-		0, 0, 0, 0, 6, 0, 0, 100, 28, 0, 0, 39, 0, 0, 0, 64,
-		6, 0, 0, 113, 28, 0, 0, 173, 0, 0, 0, 128, 6, 0, 0, 118,
-		28, 0, 0, 174, 0, 0, 0, 192, 6, 0, 0, 128, 28, 0, 0, 175,
-		0, 0, 0, 0, 7, 0, 0, 137, 28, 0, 0, 175, 0, 0, 0, 32,
-		7, 0, 0, 0, 0, 0, 0, 3, 0, 0, 5, 16, 0, 0, 0, 146,
-		28, 0, 0, 45, 0, 0, 0, 0, 0, 0, 0, 154, 28, 0, 0, 109,
-		0, 0, 0, 0, 0, 0, 0, 164, 28, 0, 0, 10, 0, 0, 0, 0,
-		0, 0, 0, 177, 28, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 182,
-		28, 0, 0, 143, 0, 0, 0, 0, 0, 0, 0, 186, 28, 0, 0, 144,
-		0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 194,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 198, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 54, 2, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 147, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 72,
-		2, 0, 0, 193, 28, 0, 0, 0, 0, 0, 8, 149, 0, 0, 0, 204,
-		28, 0, 0, 1, 0, 0, 4, 4, 0, 0, 0, 0, 0, 0, 0, 150,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 5, 4,
-		0, 0, 0, 213, 28, 0, 0, 69, 0, 0, 0, 0, 0, 0, 0, 219,
-		28, 0, 0, 0, 0, 0, 8, 10, 0, 0, 0, 227, 28, 0, 0, 4,
-		0, 0, 4, 32, 0, 0, 0, 62, 27, 0, 0, 101, 0, 0, 0, 0,
-		0, 0, 0, 233, 28, 0, 0, 68, 0, 0, 0, 64, 0, 0, 0, 243,
-		28, 0, 0, 153, 0, 0, 0, 96, 0, 0, 0, 247, 28, 0, 0, 55,
-		0, 0, 0, 128, 0, 0, 0, 1, 29, 0, 0, 1, 0, 0, 4, 4,
-		0, 0, 0, 79, 19, 0, 0, 42, 0, 0, 0, 0, 0, 0, 0, 23,
-		29, 0, 0, 0, 0, 0, 8, 155, 0, 0, 0, 30, 29, 0, 0, 0,
-		0, 0, 8, 79, 0, 0, 0, 46, 29, 0, 0, 6, 0, 0, 4, 32,
-		0, 0, 0, 238, 18, 0, 0, 157, 0, 0, 0, 0, 0, 0, 0, 206,
-		0, 0, 0, 164, 0, 0, 0, 64, 0, 0, 0, 58, 29, 0, 0, 165,
-		0, 0, 0, 128, 0, 0, 0, 67, 29, 0, 0, 166, 0, 0, 0, 160,
-		0, 0, 0, 71, 29, 0, 0, 166, 0, 0, 0, 192, 0, 0, 0, 76,
-		29, 0, 0, 2, 0, 0, 0, 224, 0, 0, 0, 83, 29, 0, 0, 0,
-		0, 0, 8, 158, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 4, 8,
-		0, 0, 0, 18, 19, 0, 0, 159, 0, 0, 0, 0, 0, 0, 0, 92,
-		29, 0, 0, 0, 0, 0, 8, 160, 0, 0, 0, 106, 29, 0, 0, 2,
-		0, 0, 4, 8, 0, 0, 0, 0, 0, 0, 0, 161, 0, 0, 0, 0,
-		0, 0, 0, 233, 28, 0, 0, 70, 0, 0, 0, 32, 0, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 5, 4, 0, 0, 0, 114, 29, 0, 0, 42,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 162, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 4, 4, 0, 0, 0, 119,
-		29, 0, 0, 20, 0, 0, 0, 0, 0, 0, 0, 127, 29, 0, 0, 163,
-		0, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
-		0, 0, 0, 20, 0, 0, 0, 4, 0, 0, 0, 3, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 235, 0, 0, 0, 58, 29, 0, 0, 5,
-		0, 0, 6, 4, 0, 0, 0, 136, 29, 0, 0, 0, 0, 0, 0, 148,
-		29, 0, 0, 1, 0, 0, 0, 161, 29, 0, 0, 2, 0, 0, 0, 174,
-		29, 0, 0, 3, 0, 0, 0, 186, 29, 0, 0, 4, 0, 0, 0, 198,
-		29, 0, 0, 0, 0, 0, 8, 167, 0, 0, 0, 0, 0, 0, 0, 1,
-		0, 0, 4, 4, 0, 0, 0, 53, 19, 0, 0, 168, 0, 0, 0, 0,
-		0, 0, 0, 205, 29, 0, 0, 0, 0, 0, 8, 169, 0, 0, 0, 211,
-		29, 0, 0, 0, 0, 0, 8, 10, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 171, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 255,
-		0, 0, 0, 228, 29, 0, 0, 6, 0, 0, 4, 32, 0, 0, 0, 242,
-		29, 0, 0, 31, 0, 0, 0, 0, 0, 0, 0, 248, 29, 0, 0, 10,
-		0, 0, 0, 64, 0, 0, 0, 253, 29, 0, 0, 10, 0, 0, 0, 96,
-		0, 0, 0, 8, 30, 0, 0, 10, 0, 0, 0, 128, 0, 0, 0, 17,
-		30, 0, 0, 10, 0, 0, 0, 160, 0, 0, 0, 27, 30, 0, 0, 154,
-		0, 0, 0, 192, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 120,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 53, 2, 0, 0, 36,
-		30, 0, 0, 0, 0, 0, 8, 8, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 177, 0, 0, 0, 45, 30, 0, 0, 0, 0, 0, 8, 178,
-		0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 4, 8, 0, 0, 0, 212,
-		25, 0, 0, 179, 0, 0, 0, 0, 0, 0, 0, 51, 30, 0, 0, 0,
-		0, 0, 8, 31, 0, 0, 0, 60, 30, 0, 0, 5, 0, 0, 4, 40,
-		0, 0, 0, 73, 30, 0, 0, 101, 0, 0, 0, 0, 0, 0, 0, 62,
-		27, 0, 0, 101, 0, 0, 0, 64, 0, 0, 0, 243, 28, 0, 0, 153,
-		0, 0, 0, 128, 0, 0, 0, 233, 28, 0, 0, 68, 0, 0, 0, 160,
-		0, 0, 0, 247, 28, 0, 0, 55, 0, 0, 0, 192, 0, 0, 0, 79,
-		30, 0, 0, 0, 0, 0, 8, 182, 0, 0, 0, 90, 30, 0, 0, 1,
-		0, 0, 4, 4, 0, 0, 0, 99, 30, 0, 0, 10, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 31,
-		0, 0, 0, 4, 0, 0, 0, 48, 0, 0, 0, 108, 30, 0, 0, 1,
-		0, 0, 4, 32, 0, 0, 0, 73, 30, 0, 0, 185, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 101,
-		0, 0, 0, 4, 0, 0, 0, 4, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 45, 4, 0, 0, 120, 30, 0, 0, 0, 0, 0, 8, 188,
-		0, 0, 0, 0, 0, 0, 0, 11, 0, 0, 4, 128, 0, 0, 0, 133,
-		30, 0, 0, 57, 0, 0, 0, 0, 0, 0, 0, 140, 30, 0, 0, 102,
-		0, 0, 0, 64, 0, 0, 0, 148, 30, 0, 0, 180, 0, 0, 0, 128,
-		0, 0, 0, 160, 30, 0, 0, 189, 0, 0, 0, 192, 1, 0, 0, 186,
-		0, 0, 0, 29, 0, 0, 0, 0, 2, 0, 0, 238, 18, 0, 0, 152,
-		0, 0, 0, 64, 2, 0, 0, 164, 30, 0, 0, 39, 0, 0, 0, 64,
-		3, 0, 0, 169, 30, 0, 0, 190, 0, 0, 0, 128, 3, 0, 0, 180,
-		30, 0, 0, 42, 0, 0, 0, 192, 3, 0, 0, 199, 30, 0, 0, 48,
-		0, 0, 0, 224, 3, 0, 0, 219, 30, 0, 0, 192, 0, 0, 0, 240,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 47, 4, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 191, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 10, 48, 4, 0, 0, 237, 30, 0, 0, 0, 0, 0, 8, 193,
-		0, 0, 0, 241, 30, 0, 0, 0, 0, 0, 8, 194, 0, 0, 0, 247,
-		30, 0, 0, 0, 0, 0, 1, 2, 0, 0, 0, 16, 0, 0, 1, 0,
-		0, 0, 0, 0, 0, 0, 2, 49, 4, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 111, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 50,
-		4, 0, 0, 176, 27, 0, 0, 1, 0, 0, 4, 8, 0, 0, 0, 253,
-		30, 0, 0, 199, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 51, 4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
-		0, 0, 0, 31, 0, 0, 0, 4, 0, 0, 0, 0, 0, 0, 0, 47,
-		5, 0, 0, 2, 0, 0, 4, 40, 0, 0, 0, 6, 31, 0, 0, 57,
-		0, 0, 0, 0, 0, 0, 0, 13, 31, 0, 0, 215, 0, 0, 0, 64,
-		0, 0, 0, 18, 31, 0, 0, 19, 0, 0, 4, 200, 0, 0, 0, 33,
-		31, 0, 0, 31, 0, 0, 0, 0, 0, 0, 0, 42, 31, 0, 0, 31,
-		0, 0, 0, 64, 0, 0, 0, 49, 31, 0, 0, 136, 0, 0, 0, 128,
-		0, 0, 0, 57, 31, 0, 0, 136, 0, 0, 0, 192, 0, 0, 0, 65,
-		31, 0, 0, 53, 0, 0, 0, 0, 1, 0, 0, 71, 31, 0, 0, 31,
-		0, 0, 0, 192, 1, 0, 0, 86, 31, 0, 0, 133, 0, 0, 0, 0,
-		2, 0, 0, 92, 31, 0, 0, 203, 0, 0, 0, 64, 2, 0, 0, 105,
-		31, 0, 0, 31, 0, 0, 0, 128, 2, 0, 0, 0, 0, 0, 0, 206,
-		0, 0, 0, 192, 2, 0, 0, 114, 31, 0, 0, 55, 0, 0, 0, 192,
-		3, 0, 0, 129, 31, 0, 0, 209, 0, 0, 0, 64, 4, 0, 0, 138,
-		31, 0, 0, 210, 0, 0, 0, 128, 4, 0, 0, 145, 31, 0, 0, 31,
-		0, 0, 0, 192, 4, 0, 0, 154, 31, 0, 0, 139, 0, 0, 0, 0,
-		5, 0, 0, 162, 31, 0, 0, 39, 0, 0, 0, 64, 5, 0, 0, 178,
-		31, 0, 0, 101, 0, 0, 0, 128, 5, 0, 0, 198, 31, 0, 0, 212,
-		0, 0, 0, 192, 5, 0, 0, 208, 31, 0, 0, 213, 0, 0, 0, 0,
-		6, 0, 0, 227, 31, 0, 0, 0, 0, 0, 8, 204, 0, 0, 0, 236,
-		31, 0, 0, 1, 0, 0, 4, 8, 0, 0, 0, 236, 31, 0, 0, 205,
-		0, 0, 0, 0, 0, 0, 0, 243, 31, 0, 0, 0, 0, 0, 8, 31,
-		0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 5, 32, 0, 0, 0, 255,
-		31, 0, 0, 207, 0, 0, 0, 0, 0, 0, 0, 6, 32, 0, 0, 208,
+		0, 0, 3, 0, 0, 0, 0, 39, 0, 0, 0, 4, 0, 0, 0, 6,
+		0, 0, 0, 31, 1, 0, 0, 0, 0, 0, 1, 1, 0, 0, 0, 8,
+		0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 41,
+		0, 0, 0, 4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1,
+		0, 0, 13, 2, 0, 0, 0, 205, 0, 0, 0, 34, 0, 0, 0, 36,
+		1, 0, 0, 1, 0, 0, 12, 43, 0, 0, 0, 105, 2, 0, 0, 0,
+		1, 0, 132, 128, 53, 0, 0, 117, 2, 0, 0, 46, 0, 0, 0, 0,
+		0, 0, 0, 129, 2, 0, 0, 10, 0, 0, 0, 192, 0, 0, 0, 137,
+		2, 0, 0, 47, 0, 0, 0, 0, 1, 0, 0, 143, 2, 0, 0, 48,
+		0, 0, 0, 64, 1, 0, 0, 229, 0, 0, 0, 10, 0, 0, 0, 96,
+		1, 0, 0, 149, 2, 0, 0, 10, 0, 0, 0, 128, 1, 0, 0, 156,
+		2, 0, 0, 2, 0, 0, 0, 160, 1, 0, 0, 163, 2, 0, 0, 52,
+		0, 0, 0, 192, 1, 0, 0, 174, 2, 0, 0, 10, 0, 0, 0, 64,
+		2, 0, 0, 186, 2, 0, 0, 39, 0, 0, 0, 128, 2, 0, 0, 206,
+		2, 0, 0, 58, 0, 0, 0, 192, 2, 0, 0, 217, 2, 0, 0, 2,
+		0, 0, 0, 0, 3, 0, 0, 233, 2, 0, 0, 2, 0, 0, 0, 32,
+		3, 0, 0, 242, 2, 0, 0, 2, 0, 0, 0, 64, 3, 0, 0, 248,
+		2, 0, 0, 2, 0, 0, 0, 96, 3, 0, 0, 253, 2, 0, 0, 2,
+		0, 0, 0, 128, 3, 0, 0, 9, 3, 0, 0, 2, 0, 0, 0, 160,
+		3, 0, 0, 21, 3, 0, 0, 10, 0, 0, 0, 192, 3, 0, 0, 33,
+		3, 0, 0, 59, 0, 0, 0, 0, 4, 0, 0, 36, 3, 0, 0, 69,
+		0, 0, 0, 0, 12, 0, 0, 39, 3, 0, 0, 71, 0, 0, 0, 128,
+		13, 0, 0, 42, 3, 0, 0, 84, 0, 0, 0, 128, 20, 0, 0, 54,
+		3, 0, 0, 61, 0, 0, 0, 192, 20, 0, 0, 64, 3, 0, 0, 39,
+		0, 0, 0, 128, 21, 0, 0, 76, 3, 0, 0, 10, 0, 0, 0, 192,
+		21, 0, 0, 92, 3, 0, 0, 86, 0, 0, 0, 0, 22, 0, 0, 109,
+		3, 0, 0, 87, 0, 0, 0, 0, 24, 0, 0, 115, 3, 0, 0, 88,
+		0, 0, 0, 0, 32, 0, 0, 133, 3, 0, 0, 10, 0, 0, 0, 64,
+		32, 0, 0, 144, 3, 0, 0, 10, 0, 0, 0, 96, 32, 0, 0, 151,
+		3, 0, 0, 2, 0, 0, 0, 128, 32, 0, 0, 167, 3, 0, 0, 90,
+		0, 0, 0, 192, 32, 0, 0, 176, 3, 0, 0, 93, 0, 0, 0, 0,
+		33, 0, 0, 190, 3, 0, 0, 92, 0, 0, 0, 64, 33, 0, 0, 200,
+		3, 0, 0, 47, 0, 0, 0, 64, 65, 0, 0, 218, 3, 0, 0, 37,
+		0, 0, 0, 128, 65, 0, 0, 237, 3, 0, 0, 37, 0, 0, 0, 144,
+		65, 0, 0, 253, 3, 0, 0, 2, 0, 0, 0, 160, 65, 0, 0, 19,
+		4, 0, 0, 96, 0, 0, 0, 192, 65, 0, 0, 43, 4, 0, 0, 63,
+		0, 0, 0, 0, 66, 0, 0, 58, 4, 0, 0, 98, 0, 0, 0, 128,
+		66, 0, 0, 75, 4, 0, 0, 39, 0, 0, 0, 192, 66, 0, 0, 91,
+		4, 0, 0, 20, 0, 0, 0, 0, 67, 0, 0, 109, 4, 0, 0, 20,
+		0, 0, 0, 8, 67, 0, 0, 123, 4, 0, 0, 2, 0, 0, 0, 32,
+		67, 0, 0, 142, 4, 0, 0, 63, 0, 0, 0, 64, 67, 0, 0, 165,
+		4, 0, 0, 2, 0, 0, 0, 192, 67, 0, 0, 184, 4, 0, 0, 2,
+		0, 0, 0, 224, 67, 0, 0, 199, 4, 0, 0, 96, 0, 0, 0, 0,
+		68, 0, 0, 218, 4, 0, 0, 63, 0, 0, 0, 64, 68, 0, 0, 235,
+		4, 0, 0, 63, 0, 0, 0, 192, 68, 0, 0, 249, 4, 0, 0, 2,
+		0, 0, 0, 64, 69, 0, 0, 6, 5, 0, 0, 99, 0, 0, 0, 128,
+		69, 0, 0, 17, 5, 0, 0, 63, 0, 0, 0, 128, 70, 0, 0, 23,
+		5, 0, 0, 100, 0, 0, 0, 0, 71, 0, 0, 38, 5, 0, 0, 61,
+		0, 0, 0, 64, 72, 0, 0, 56, 5, 0, 0, 101, 0, 0, 0, 0,
+		73, 0, 0, 59, 5, 0, 0, 101, 0, 0, 0, 64, 73, 0, 0, 69,
+		5, 0, 0, 102, 0, 0, 0, 128, 73, 0, 0, 78, 5, 0, 0, 122,
+		0, 0, 0, 192, 74, 0, 0, 87, 5, 0, 0, 2, 0, 0, 0, 96,
+		75, 0, 0, 98, 5, 0, 0, 2, 0, 0, 0, 128, 75, 0, 0, 108,
+		5, 0, 0, 2, 0, 0, 0, 160, 75, 0, 0, 120, 5, 0, 0, 2,
+		0, 0, 0, 192, 75, 0, 0, 134, 5, 0, 0, 39, 0, 0, 0, 0,
+		76, 0, 0, 141, 5, 0, 0, 10, 0, 0, 0, 64, 76, 0, 0, 153,
+		5, 0, 0, 10, 0, 0, 0, 96, 76, 0, 1, 173, 5, 0, 0, 10,
+		0, 0, 0, 97, 76, 0, 1, 199, 5, 0, 0, 10, 0, 0, 0, 98,
+		76, 0, 1, 214, 5, 0, 0, 10, 0, 0, 0, 99, 76, 0, 1, 237,
+		5, 0, 0, 10, 0, 0, 0, 128, 76, 0, 1, 1, 6, 0, 0, 10,
+		0, 0, 0, 129, 76, 0, 1, 11, 6, 0, 0, 10, 0, 0, 0, 130,
+		76, 0, 1, 21, 6, 0, 0, 10, 0, 0, 0, 131, 76, 0, 1, 37,
+		6, 0, 0, 10, 0, 0, 0, 132, 76, 0, 1, 51, 6, 0, 0, 10,
+		0, 0, 0, 133, 76, 0, 1, 71, 6, 0, 0, 10, 0, 0, 0, 134,
+		76, 0, 1, 78, 6, 0, 0, 10, 0, 0, 0, 135, 76, 0, 1, 91,
+		6, 0, 0, 10, 0, 0, 0, 136, 76, 0, 1, 103, 6, 0, 0, 10,
+		0, 0, 0, 137, 76, 0, 1, 117, 6, 0, 0, 10, 0, 0, 0, 138,
+		76, 0, 1, 128, 6, 0, 0, 10, 0, 0, 0, 139, 76, 0, 1, 144,
+		6, 0, 0, 10, 0, 0, 0, 140, 76, 0, 1, 164, 6, 0, 0, 39,
+		0, 0, 0, 192, 76, 0, 0, 177, 6, 0, 0, 124, 0, 0, 0, 0,
+		77, 0, 0, 249, 0, 0, 0, 139, 0, 0, 0, 192, 78, 0, 0, 191,
+		6, 0, 0, 139, 0, 0, 0, 224, 78, 0, 0, 196, 6, 0, 0, 39,
+		0, 0, 0, 0, 79, 0, 0, 209, 6, 0, 0, 58, 0, 0, 0, 64,
+		79, 0, 0, 221, 6, 0, 0, 58, 0, 0, 0, 128, 79, 0, 0, 228,
+		6, 0, 0, 63, 0, 0, 0, 192, 79, 0, 0, 237, 6, 0, 0, 63,
+		0, 0, 0, 64, 80, 0, 0, 245, 6, 0, 0, 58, 0, 0, 0, 192,
+		80, 0, 0, 2, 7, 0, 0, 63, 0, 0, 0, 0, 81, 0, 0, 10,
+		7, 0, 0, 63, 0, 0, 0, 128, 81, 0, 0, 23, 7, 0, 0, 141,
+		0, 0, 0, 0, 82, 0, 0, 34, 7, 0, 0, 144, 0, 0, 0, 64,
+		82, 0, 0, 44, 7, 0, 0, 63, 0, 0, 0, 64, 84, 0, 0, 57,
+		7, 0, 0, 63, 0, 0, 0, 192, 84, 0, 0, 69, 7, 0, 0, 145,
+		0, 0, 0, 64, 85, 0, 0, 80, 7, 0, 0, 146, 0, 0, 0, 128,
+		85, 0, 0, 94, 7, 0, 0, 146, 0, 0, 0, 192, 85, 0, 0, 110,
+		7, 0, 0, 47, 0, 0, 0, 0, 86, 0, 0, 125, 7, 0, 0, 29,
+		0, 0, 0, 64, 86, 0, 0, 131, 7, 0, 0, 29, 0, 0, 0, 128,
+		86, 0, 0, 137, 7, 0, 0, 29, 0, 0, 0, 192, 86, 0, 0, 143,
+		7, 0, 0, 147, 0, 0, 0, 0, 87, 0, 0, 156, 7, 0, 0, 155,
+		0, 0, 0, 192, 87, 0, 0, 162, 7, 0, 0, 50, 0, 0, 0, 64,
+		89, 0, 0, 176, 7, 0, 0, 39, 0, 0, 0, 128, 89, 0, 0, 182,
+		7, 0, 0, 39, 0, 0, 0, 192, 89, 0, 0, 189, 7, 0, 0, 29,
+		0, 0, 0, 0, 90, 0, 0, 200, 7, 0, 0, 29, 0, 0, 0, 64,
+		90, 0, 0, 215, 7, 0, 0, 39, 0, 0, 0, 128, 90, 0, 0, 223,
+		7, 0, 0, 39, 0, 0, 0, 192, 90, 0, 0, 231, 7, 0, 0, 159,
+		0, 0, 0, 0, 91, 0, 0, 247, 7, 0, 0, 165, 0, 0, 0, 128,
+		93, 0, 0, 12, 8, 0, 0, 170, 0, 0, 0, 64, 94, 0, 0, 25,
+		8, 0, 0, 170, 0, 0, 0, 128, 94, 0, 0, 35, 8, 0, 0, 170,
+		0, 0, 0, 192, 94, 0, 0, 40, 8, 0, 0, 172, 0, 0, 0, 0,
+		95, 0, 0, 61, 8, 0, 0, 173, 0, 0, 0, 64, 95, 0, 0, 66,
+		8, 0, 0, 174, 0, 0, 0, 192, 95, 0, 0, 76, 8, 0, 0, 175,
+		0, 0, 0, 0, 96, 0, 0, 84, 8, 0, 0, 177, 0, 0, 0, 64,
+		96, 0, 0, 92, 8, 0, 0, 178, 0, 0, 0, 192, 96, 0, 0, 95,
+		8, 0, 0, 179, 0, 0, 0, 0, 97, 0, 0, 101, 8, 0, 0, 180,
+		0, 0, 0, 64, 97, 0, 0, 110, 8, 0, 0, 181, 0, 0, 0, 128,
+		97, 0, 0, 118, 8, 0, 0, 182, 0, 0, 0, 192, 97, 0, 0, 125,
+		8, 0, 0, 183, 0, 0, 0, 0, 98, 0, 0, 133, 8, 0, 0, 184,
+		0, 0, 0, 64, 98, 0, 0, 141, 8, 0, 0, 184, 0, 0, 0, 128,
+		98, 0, 0, 154, 8, 0, 0, 184, 0, 0, 0, 192, 98, 0, 0, 168,
+		8, 0, 0, 187, 0, 0, 0, 0, 99, 0, 0, 176, 8, 0, 0, 39,
+		0, 0, 0, 192, 99, 0, 0, 186, 8, 0, 0, 188, 0, 0, 0, 0,
+		100, 0, 0, 198, 8, 0, 0, 10, 0, 0, 0, 64, 100, 0, 0, 211,
+		8, 0, 0, 167, 0, 0, 0, 128, 100, 0, 0, 222, 8, 0, 0, 191,
+		0, 0, 0, 192, 100, 0, 0, 236, 8, 0, 0, 192, 0, 0, 0, 0,
+		101, 0, 0, 245, 8, 0, 0, 10, 0, 0, 0, 32, 101, 0, 0, 255,
+		8, 0, 0, 196, 0, 0, 0, 64, 101, 0, 0, 7, 9, 0, 0, 198,
+		0, 0, 0, 192, 101, 0, 0, 24, 9, 0, 0, 29, 0, 0, 0, 192,
+		102, 0, 0, 39, 9, 0, 0, 29, 0, 0, 0, 0, 103, 0, 0, 52,
+		9, 0, 0, 202, 0, 0, 0, 64, 103, 0, 0, 63, 9, 0, 0, 148,
+		0, 0, 0, 96, 103, 0, 0, 71, 9, 0, 0, 205, 0, 0, 0, 128,
+		103, 0, 0, 78, 9, 0, 0, 162, 0, 0, 0, 192, 103, 0, 0, 89,
+		9, 0, 0, 58, 0, 0, 0, 64, 104, 0, 0, 101, 9, 0, 0, 207,
+		0, 0, 0, 128, 104, 0, 0, 115, 9, 0, 0, 47, 0, 0, 0, 192,
+		104, 0, 0, 128, 9, 0, 0, 208, 0, 0, 0, 0, 105, 0, 0, 137,
+		9, 0, 0, 209, 0, 0, 0, 64, 105, 0, 0, 142, 9, 0, 0, 210,
+		0, 0, 0, 128, 105, 0, 0, 156, 9, 0, 0, 211, 0, 0, 0, 192,
+		105, 0, 0, 173, 9, 0, 0, 212, 0, 0, 0, 0, 106, 0, 0, 184,
+		9, 0, 0, 213, 0, 0, 0, 64, 106, 0, 0, 200, 9, 0, 0, 39,
+		0, 0, 0, 128, 106, 0, 0, 215, 9, 0, 0, 214, 0, 0, 0, 192,
+		106, 0, 0, 228, 9, 0, 0, 216, 0, 0, 0, 0, 107, 0, 0, 233,
+		9, 0, 0, 10, 0, 0, 0, 192, 108, 0, 0, 243, 9, 0, 0, 29,
+		0, 0, 0, 0, 109, 0, 0, 1, 10, 0, 0, 29, 0, 0, 0, 64,
+		109, 0, 0, 14, 10, 0, 0, 29, 0, 0, 0, 128, 109, 0, 0, 27,
+		10, 0, 0, 217, 0, 0, 0, 192, 109, 0, 0, 40, 10, 0, 0, 220,
+		0, 0, 0, 192, 113, 0, 0, 57, 10, 0, 0, 2, 0, 0, 0, 224,
+		113, 0, 0, 81, 10, 0, 0, 2, 0, 0, 0, 0, 114, 0, 0, 106,
+		10, 0, 0, 222, 0, 0, 0, 64, 114, 0, 0, 114, 10, 0, 0, 63,
+		0, 0, 0, 128, 114, 0, 0, 122, 10, 0, 0, 8, 0, 0, 0, 0,
+		115, 0, 0, 129, 10, 0, 0, 8, 0, 0, 0, 32, 115, 0, 0, 134,
+		10, 0, 0, 223, 0, 0, 0, 64, 115, 0, 0, 146, 10, 0, 0, 224,
+		0, 0, 0, 128, 115, 0, 0, 165, 10, 0, 0, 63, 0, 0, 0, 192,
+		115, 0, 0, 179, 10, 0, 0, 225, 0, 0, 0, 64, 116, 0, 0, 194,
+		10, 0, 0, 226, 0, 0, 0, 128, 116, 0, 0, 211, 10, 0, 0, 10,
+		0, 0, 0, 128, 117, 0, 0, 223, 10, 0, 0, 234, 0, 0, 0, 192,
+		117, 0, 0, 239, 10, 0, 0, 226, 0, 0, 0, 64, 118, 0, 0, 0,
+		11, 0, 0, 63, 0, 0, 0, 64, 119, 0, 0, 16, 11, 0, 0, 39,
+		0, 0, 0, 192, 119, 0, 0, 35, 11, 0, 0, 118, 0, 0, 0, 0,
+		120, 0, 0, 45, 11, 0, 0, 235, 0, 0, 0, 64, 120, 0, 0, 53,
+		11, 0, 0, 235, 0, 0, 0, 80, 120, 0, 0, 68, 11, 0, 0, 2,
+		0, 0, 0, 96, 120, 0, 0, 82, 11, 0, 0, 10, 0, 0, 0, 128,
+		120, 0, 0, 99, 11, 0, 0, 10, 0, 0, 0, 160, 120, 0, 0, 120,
+		11, 0, 0, 2, 0, 0, 0, 192, 120, 0, 0, 139, 11, 0, 0, 39,
+		0, 0, 0, 0, 121, 0, 0, 158, 11, 0, 0, 29, 0, 0, 0, 64,
+		121, 0, 0, 169, 11, 0, 0, 29, 0, 0, 0, 128, 121, 0, 0, 194,
+		11, 0, 0, 29, 0, 0, 0, 192, 121, 0, 0, 216, 11, 0, 0, 166,
+		0, 0, 0, 0, 122, 0, 0, 226, 11, 0, 0, 236, 0, 0, 0, 128,
+		122, 0, 0, 237, 11, 0, 0, 237, 0, 0, 0, 192, 122, 0, 0, 249,
+		11, 0, 0, 39, 0, 0, 0, 0, 123, 0, 0, 11, 12, 0, 0, 238,
+		0, 0, 0, 64, 123, 0, 0, 32, 12, 0, 0, 39, 0, 0, 0, 0,
+		124, 0, 0, 52, 12, 0, 0, 239, 0, 0, 0, 64, 124, 0, 0, 57,
+		12, 0, 0, 8, 0, 0, 0, 128, 124, 0, 0, 66, 12, 0, 0, 39,
+		0, 0, 0, 192, 124, 0, 0, 82, 12, 0, 0, 240, 0, 0, 0, 0,
+		125, 0, 0, 0, 0, 0, 0, 242, 0, 0, 0, 64, 157, 0, 0, 90,
+		12, 0, 0, 243, 0, 0, 0, 192, 157, 0, 0, 102, 12, 0, 0, 244,
+		0, 0, 0, 0, 158, 0, 0, 112, 12, 0, 0, 246, 0, 0, 0, 128,
+		158, 0, 0, 119, 12, 0, 0, 2, 0, 0, 0, 192, 158, 0, 0, 130,
+		12, 0, 0, 2, 0, 0, 0, 224, 158, 0, 0, 147, 12, 0, 0, 39,
+		0, 0, 0, 0, 159, 0, 0, 165, 12, 0, 0, 2, 0, 0, 0, 64,
+		159, 0, 0, 186, 12, 0, 0, 249, 0, 0, 0, 128, 159, 0, 0, 201,
+		12, 0, 0, 29, 0, 0, 0, 128, 23, 1, 0, 216, 12, 0, 0, 29,
+		0, 0, 0, 192, 23, 1, 0, 239, 12, 0, 0, 250, 0, 0, 0, 0,
+		24, 1, 0, 250, 12, 0, 0, 2, 0, 0, 0, 64, 24, 1, 0, 9,
+		13, 0, 0, 2, 0, 0, 0, 96, 24, 1, 0, 24, 13, 0, 0, 251,
+		0, 0, 0, 128, 24, 1, 0, 34, 13, 0, 0, 31, 0, 0, 0, 192,
+		24, 1, 0, 51, 13, 0, 0, 50, 0, 0, 0, 0, 25, 1, 0, 65,
+		13, 0, 0, 50, 0, 0, 0, 32, 25, 1, 0, 85, 13, 0, 0, 39,
+		0, 0, 0, 64, 25, 1, 0, 91, 13, 0, 0, 39, 0, 0, 0, 128,
+		25, 1, 0, 107, 13, 0, 0, 252, 0, 0, 0, 192, 25, 1, 0, 120,
+		13, 0, 0, 253, 0, 0, 0, 0, 26, 1, 0, 139, 13, 0, 0, 2,
+		0, 0, 0, 32, 26, 1, 0, 155, 13, 0, 0, 10, 0, 0, 0, 64,
+		26, 1, 0, 180, 13, 0, 0, 252, 0, 0, 0, 128, 26, 1, 0, 193,
+		13, 0, 0, 254, 0, 0, 0, 192, 26, 1, 0, 208, 13, 0, 0, 255,
+		0, 0, 0, 0, 27, 1, 0, 214, 13, 0, 0, 10, 0, 0, 0, 64,
+		27, 1, 0, 228, 13, 0, 0, 10, 0, 0, 0, 96, 27, 1, 0, 246,
+		13, 0, 0, 0, 1, 0, 0, 128, 27, 1, 0, 0, 14, 0, 0, 2,
+		0, 0, 0, 128, 27, 1, 0, 19, 14, 0, 0, 58, 0, 0, 0, 192,
+		27, 1, 0, 35, 14, 0, 0, 1, 1, 0, 0, 0, 28, 1, 0, 52,
+		14, 0, 0, 5, 1, 0, 0, 64, 29, 1, 0, 66, 14, 0, 0, 48,
+		0, 0, 0, 128, 29, 1, 0, 81, 14, 0, 0, 2, 0, 0, 0, 160,
+		29, 1, 0, 93, 14, 0, 0, 47, 0, 0, 0, 192, 29, 1, 0, 102,
+		14, 0, 0, 6, 1, 0, 0, 0, 30, 1, 0, 114, 14, 0, 0, 7,
+		1, 0, 0, 64, 30, 1, 0, 122, 14, 0, 0, 47, 0, 0, 0, 128,
+		30, 1, 0, 132, 14, 0, 0, 30, 0, 0, 0, 192, 30, 1, 0, 143,
+		14, 0, 0, 29, 0, 0, 0, 0, 31, 1, 0, 152, 14, 0, 0, 30,
+		0, 0, 0, 64, 31, 1, 1, 161, 14, 0, 0, 30, 0, 0, 0, 65,
+		31, 1, 1, 176, 14, 0, 0, 30, 0, 0, 0, 66, 31, 1, 62, 191,
+		14, 0, 0, 166, 0, 0, 0, 128, 31, 1, 0, 203, 14, 0, 0, 2,
+		0, 0, 0, 0, 32, 1, 0, 213, 14, 0, 0, 8, 1, 0, 0, 64,
+		32, 1, 0, 233, 14, 0, 0, 8, 1, 0, 0, 128, 32, 1, 0, 242,
+		14, 0, 0, 166, 0, 0, 0, 192, 32, 1, 0, 1, 15, 0, 0, 9,
+		1, 0, 0, 0, 34, 1, 0, 117, 2, 0, 0, 4, 0, 0, 4, 24,
+		0, 0, 0, 229, 0, 0, 0, 39, 0, 0, 0, 0, 0, 0, 0, 8,
+		15, 0, 0, 39, 0, 0, 0, 64, 0, 0, 0, 21, 15, 0, 0, 8,
+		0, 0, 0, 128, 0, 0, 0, 28, 15, 0, 0, 8, 0, 0, 0, 160,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 32,
+		15, 0, 0, 0, 0, 0, 8, 49, 0, 0, 0, 43, 15, 0, 0, 1,
+		0, 0, 4, 4, 0, 0, 0, 59, 15, 0, 0, 50, 0, 0, 0, 0,
+		0, 0, 0, 64, 15, 0, 0, 0, 0, 0, 8, 51, 0, 0, 0, 0,
+		0, 0, 0, 1, 0, 0, 4, 4, 0, 0, 0, 73, 15, 0, 0, 2,
+		0, 0, 0, 0, 0, 0, 0, 81, 15, 0, 0, 4, 0, 0, 4, 16,
+		0, 0, 0, 100, 15, 0, 0, 53, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 55, 0, 0, 0, 64, 0, 0, 0, 106, 15, 0, 0, 56,
+		0, 0, 0, 96, 0, 0, 0, 110, 15, 0, 0, 56, 0, 0, 0, 112,
+		0, 0, 0, 114, 15, 0, 0, 1, 0, 0, 4, 8, 0, 0, 0, 125,
+		15, 0, 0, 54, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 53, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 5, 4,
+		0, 0, 0, 130, 15, 0, 0, 10, 0, 0, 0, 0, 0, 0, 0, 138,
+		15, 0, 0, 50, 0, 0, 0, 0, 0, 0, 0, 146, 15, 0, 0, 0,
+		0, 0, 8, 57, 0, 0, 0, 150, 15, 0, 0, 0, 0, 0, 8, 37,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 45, 0, 0, 0, 156,
+		15, 0, 0, 15, 0, 0, 4, 0, 1, 0, 0, 169, 15, 0, 0, 60,
+		0, 0, 0, 0, 0, 0, 0, 174, 15, 0, 0, 61, 0, 0, 0, 128,
+		0, 0, 0, 183, 15, 0, 0, 63, 0, 0, 0, 64, 1, 0, 0, 242,
+		2, 0, 0, 10, 0, 0, 0, 192, 1, 0, 0, 194, 15, 0, 0, 29,
+		0, 0, 0, 0, 2, 0, 0, 205, 15, 0, 0, 29, 0, 0, 0, 64,
+		2, 0, 0, 222, 15, 0, 0, 29, 0, 0, 0, 128, 2, 0, 0, 231,
+		15, 0, 0, 29, 0, 0, 0, 192, 2, 0, 0, 253, 15, 0, 0, 29,
+		0, 0, 0, 0, 3, 0, 0, 11, 16, 0, 0, 2, 0, 0, 0, 64,
+		3, 0, 0, 221, 6, 0, 0, 65, 0, 0, 0, 128, 3, 0, 0, 17,
+		16, 0, 0, 66, 0, 0, 0, 192, 3, 0, 0, 24, 16, 0, 0, 66,
+		0, 0, 0, 0, 4, 0, 0, 29, 16, 0, 0, 39, 0, 0, 0, 64,
+		4, 0, 0, 45, 16, 0, 0, 67, 0, 0, 0, 0, 6, 0, 0, 49,
+		16, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 61, 16, 0, 0, 39,
+		0, 0, 0, 0, 0, 0, 0, 68, 16, 0, 0, 8, 0, 0, 0, 64,
+		0, 0, 0, 79, 16, 0, 0, 3, 0, 0, 4, 24, 0, 0, 0, 87,
+		16, 0, 0, 39, 0, 0, 0, 0, 0, 0, 0, 105, 16, 0, 0, 62,
+		0, 0, 0, 64, 0, 0, 0, 114, 16, 0, 0, 62, 0, 0, 0, 128,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 61, 0, 0, 0, 122,
+		16, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 125, 15, 0, 0, 64,
+		0, 0, 0, 0, 0, 0, 0, 132, 16, 0, 0, 64, 0, 0, 0, 64,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 63, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 59, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 154, 1, 0, 0, 137, 16, 0, 0, 9, 0, 0, 4, 64,
+		0, 0, 0, 147, 16, 0, 0, 29, 0, 0, 0, 0, 0, 0, 0, 164,
+		16, 0, 0, 29, 0, 0, 0, 64, 0, 0, 0, 173, 16, 0, 0, 29,
+		0, 0, 0, 128, 0, 0, 0, 186, 16, 0, 0, 8, 0, 0, 0, 192,
+		0, 0, 0, 195, 16, 0, 0, 8, 0, 0, 0, 224, 0, 0, 0, 210,
+		16, 0, 0, 39, 0, 0, 0, 0, 1, 0, 0, 219, 16, 0, 0, 39,
+		0, 0, 0, 64, 1, 0, 0, 232, 16, 0, 0, 39, 0, 0, 0, 128,
+		1, 0, 0, 241, 16, 0, 0, 68, 0, 0, 0, 192, 1, 0, 0, 241,
+		16, 0, 0, 2, 0, 0, 4, 8, 0, 0, 0, 250, 16, 0, 0, 10,
+		0, 0, 0, 0, 0, 0, 0, 3, 17, 0, 0, 10, 0, 0, 0, 32,
+		0, 0, 0, 8, 17, 0, 0, 7, 0, 0, 4, 48, 0, 0, 0, 24,
+		17, 0, 0, 63, 0, 0, 0, 0, 0, 0, 0, 33, 17, 0, 0, 39,
+		0, 0, 0, 128, 0, 0, 0, 41, 17, 0, 0, 39, 0, 0, 0, 192,
+		0, 0, 0, 56, 17, 0, 0, 10, 0, 0, 0, 0, 1, 0, 0, 242,
+		2, 0, 0, 37, 0, 0, 0, 32, 1, 0, 0, 67, 17, 0, 0, 37,
+		0, 0, 0, 48, 1, 0, 0, 75, 17, 0, 0, 70, 0, 0, 0, 64,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 69, 0, 0, 0, 80,
+		17, 0, 0, 16, 0, 0, 132, 224, 0, 0, 0, 79, 16, 0, 0, 61,
+		0, 0, 0, 0, 0, 0, 0, 96, 17, 0, 0, 29, 0, 0, 0, 192,
+		0, 0, 0, 107, 17, 0, 0, 29, 0, 0, 0, 0, 1, 0, 0, 119,
+		17, 0, 0, 29, 0, 0, 0, 64, 1, 0, 0, 129, 17, 0, 0, 29,
+		0, 0, 0, 128, 1, 0, 0, 135, 17, 0, 0, 29, 0, 0, 0, 192,
+		1, 0, 0, 146, 17, 0, 0, 72, 0, 0, 0, 0, 2, 0, 0, 154,
+		17, 0, 0, 29, 0, 0, 0, 64, 2, 0, 0, 229, 0, 0, 0, 10,
+		0, 0, 0, 128, 2, 0, 0, 163, 17, 0, 0, 10, 0, 0, 0, 160,
+		2, 0, 1, 176, 17, 0, 0, 10, 0, 0, 0, 161, 2, 0, 1, 187,
+		17, 0, 0, 10, 0, 0, 0, 162, 2, 0, 1, 205, 17, 0, 0, 10,
+		0, 0, 0, 163, 2, 0, 1, 216, 17, 0, 0, 75, 0, 0, 0, 192,
+		2, 0, 0, 225, 17, 0, 0, 75, 0, 0, 0, 192, 4, 0, 0, 240,
+		17, 0, 0, 83, 0, 0, 0, 192, 6, 0, 0, 246, 17, 0, 0, 0,
+		0, 0, 8, 73, 0, 0, 0, 250, 17, 0, 0, 0, 0, 0, 8, 74,
+		0, 0, 0, 0, 18, 0, 0, 0, 0, 0, 1, 8, 0, 0, 0, 64,
+		0, 0, 1, 10, 18, 0, 0, 8, 0, 0, 4, 64, 0, 0, 0, 18,
+		18, 0, 0, 76, 0, 0, 0, 0, 0, 0, 0, 23, 18, 0, 0, 77,
+		0, 0, 0, 0, 1, 0, 0, 36, 18, 0, 0, 78, 0, 0, 0, 64,
+		1, 0, 0, 45, 18, 0, 0, 82, 0, 0, 0, 128, 1, 0, 0, 50,
+		18, 0, 0, 20, 0, 0, 0, 192, 1, 0, 0, 56, 18, 0, 0, 20,
+		0, 0, 0, 200, 1, 0, 0, 63, 18, 0, 0, 20, 0, 0, 0, 208,
+		1, 0, 0, 71, 18, 0, 0, 20, 0, 0, 0, 216, 1, 0, 0, 79,
+		18, 0, 0, 2, 0, 0, 4, 32, 0, 0, 0, 18, 18, 0, 0, 61,
+		0, 0, 0, 0, 0, 0, 0, 95, 18, 0, 0, 77, 0, 0, 0, 192,
+		0, 0, 0, 103, 18, 0, 0, 0, 0, 0, 8, 72, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 79, 0, 0, 0, 0, 0, 0, 0, 1,
+		0, 0, 13, 80, 0, 0, 0, 0, 0, 0, 0, 81, 0, 0, 0, 111,
+		18, 0, 0, 2, 0, 0, 6, 4, 0, 0, 0, 127, 18, 0, 0, 0,
+		0, 0, 0, 145, 18, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 75, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 168,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 71, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 85, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 10, 204, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 210,
+		1, 0, 0, 161, 18, 0, 0, 29, 0, 0, 4, 0, 1, 0, 0, 178,
+		18, 0, 0, 29, 0, 0, 0, 0, 0, 0, 0, 189, 18, 0, 0, 29,
+		0, 0, 0, 64, 0, 0, 0, 198, 18, 0, 0, 29, 0, 0, 0, 128,
+		0, 0, 0, 209, 18, 0, 0, 29, 0, 0, 0, 192, 0, 0, 0, 218,
+		18, 0, 0, 29, 0, 0, 0, 0, 1, 0, 0, 231, 18, 0, 0, 29,
+		0, 0, 0, 64, 1, 0, 0, 242, 18, 0, 0, 29, 0, 0, 0, 128,
+		1, 0, 0, 254, 18, 0, 0, 29, 0, 0, 0, 192, 1, 0, 0, 8,
+		19, 0, 0, 72, 0, 0, 0, 0, 2, 0, 0, 26, 19, 0, 0, 29,
+		0, 0, 0, 64, 2, 0, 0, 38, 19, 0, 0, 29, 0, 0, 0, 128,
+		2, 0, 0, 48, 19, 0, 0, 72, 0, 0, 0, 192, 2, 0, 0, 66,
+		19, 0, 0, 29, 0, 0, 0, 0, 3, 0, 0, 75, 19, 0, 0, 29,
+		0, 0, 0, 64, 3, 0, 0, 85, 19, 0, 0, 29, 0, 0, 0, 128,
+		3, 0, 0, 104, 19, 0, 0, 29, 0, 0, 0, 192, 3, 0, 0, 132,
+		19, 0, 0, 29, 0, 0, 0, 0, 4, 0, 0, 161, 19, 0, 0, 29,
+		0, 0, 0, 64, 4, 0, 0, 186, 19, 0, 0, 29, 0, 0, 0, 128,
+		4, 0, 0, 207, 19, 0, 0, 29, 0, 0, 0, 192, 4, 0, 0, 218,
+		19, 0, 0, 29, 0, 0, 0, 0, 5, 0, 0, 234, 19, 0, 0, 29,
+		0, 0, 0, 64, 5, 0, 0, 253, 19, 0, 0, 29, 0, 0, 0, 128,
+		5, 0, 0, 14, 20, 0, 0, 29, 0, 0, 0, 192, 5, 0, 0, 32,
+		20, 0, 0, 29, 0, 0, 0, 0, 6, 0, 0, 50, 20, 0, 0, 29,
+		0, 0, 0, 64, 6, 0, 0, 77, 20, 0, 0, 29, 0, 0, 0, 128,
+		6, 0, 0, 96, 20, 0, 0, 29, 0, 0, 0, 192, 6, 0, 0, 112,
+		20, 0, 0, 29, 0, 0, 0, 0, 7, 0, 0, 131, 20, 0, 0, 1,
+		0, 0, 4, 8, 0, 0, 0, 142, 20, 0, 0, 89, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 142, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 91, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 10, 92, 0, 0, 0, 148, 20, 0, 0, 0, 0, 0, 8, 94,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 92, 0, 0, 0, 158,
+		20, 0, 0, 1, 0, 0, 4, 0, 4, 0, 0, 166, 20, 0, 0, 95,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
+		0, 0, 0, 39, 0, 0, 0, 4, 0, 0, 0, 128, 0, 0, 0, 171,
+		20, 0, 0, 2, 0, 0, 5, 4, 0, 0, 0, 183, 20, 0, 0, 97,
+		0, 0, 0, 0, 0, 0, 0, 185, 20, 0, 0, 8, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 4, 0, 0, 4, 4, 0, 0, 0, 133,
+		8, 0, 0, 20, 0, 0, 0, 0, 0, 0, 0, 187, 20, 0, 0, 20,
+		0, 0, 0, 8, 0, 0, 0, 195, 20, 0, 0, 20, 0, 0, 0, 16,
+		0, 0, 0, 204, 20, 0, 0, 20, 0, 0, 0, 24, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 198, 1, 0, 0, 6, 5, 0, 0, 4,
+		0, 0, 4, 32, 0, 0, 0, 212, 20, 0, 0, 39, 0, 0, 0, 0,
+		0, 0, 0, 219, 20, 0, 0, 31, 0, 0, 0, 64, 0, 0, 0, 229,
+		20, 0, 0, 31, 0, 0, 0, 128, 0, 0, 0, 242, 20, 0, 0, 31,
+		0, 0, 0, 192, 0, 0, 0, 254, 20, 0, 0, 3, 0, 0, 4, 40,
+		0, 0, 0, 248, 2, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 9,
+		21, 0, 0, 63, 0, 0, 0, 64, 0, 0, 0, 19, 21, 0, 0, 63,
+		0, 0, 0, 192, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 179,
+		1, 0, 0, 69, 5, 0, 0, 2, 0, 0, 4, 40, 0, 0, 0, 29,
+		21, 0, 0, 29, 0, 0, 0, 0, 0, 0, 0, 36, 21, 0, 0, 121,
+		0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 104,
+		0, 0, 0, 41, 21, 0, 0, 19, 0, 0, 4, 200, 0, 0, 0, 56,
+		21, 0, 0, 39, 0, 0, 0, 0, 0, 0, 0, 65, 21, 0, 0, 39,
+		0, 0, 0, 64, 0, 0, 0, 72, 21, 0, 0, 103, 0, 0, 0, 128,
+		0, 0, 0, 80, 21, 0, 0, 103, 0, 0, 0, 192, 0, 0, 0, 88,
+		21, 0, 0, 61, 0, 0, 0, 0, 1, 0, 0, 94, 21, 0, 0, 39,
+		0, 0, 0, 192, 1, 0, 0, 109, 21, 0, 0, 101, 0, 0, 0, 0,
+		2, 0, 0, 115, 21, 0, 0, 105, 0, 0, 0, 64, 2, 0, 0, 128,
+		21, 0, 0, 39, 0, 0, 0, 128, 2, 0, 0, 0, 0, 0, 0, 108,
+		0, 0, 0, 192, 2, 0, 0, 137, 21, 0, 0, 63, 0, 0, 0, 192,
+		3, 0, 0, 152, 21, 0, 0, 111, 0, 0, 0, 64, 4, 0, 0, 161,
+		21, 0, 0, 112, 0, 0, 0, 128, 4, 0, 0, 168, 21, 0, 0, 39,
+		0, 0, 0, 192, 4, 0, 0, 177, 21, 0, 0, 114, 0, 0, 0, 0,
+		5, 0, 0, 185, 21, 0, 0, 47, 0, 0, 0, 64, 5, 0, 0, 201,
+		21, 0, 0, 115, 0, 0, 0, 128, 5, 0, 0, 221, 21, 0, 0, 118,
+		0, 0, 0, 192, 5, 0, 0, 231, 21, 0, 0, 119, 0, 0, 0, 0,
+		6, 0, 0, 250, 21, 0, 0, 0, 0, 0, 8, 106, 0, 0, 0, 3,
+		22, 0, 0, 1, 0, 0, 4, 8, 0, 0, 0, 3, 22, 0, 0, 107,
+		0, 0, 0, 0, 0, 0, 0, 10, 22, 0, 0, 0, 0, 0, 8, 39,
+		0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 5, 32, 0, 0, 0, 22,
+		22, 0, 0, 109, 0, 0, 0, 0, 0, 0, 0, 29, 22, 0, 0, 110,
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 4, 32,
-		0, 0, 0, 16, 32, 0, 0, 53, 0, 0, 0, 0, 0, 0, 0, 19,
-		32, 0, 0, 31, 0, 0, 0, 192, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 191, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 193,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 211, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 10, 190, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 197, 1, 0, 0, 208, 31, 0, 0, 1, 0, 0, 4, 8,
-		0, 0, 0, 35, 32, 0, 0, 214, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 44, 4, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 3, 0, 0, 0, 0, 136, 0, 0, 0, 4, 0, 0, 0, 4,
-		0, 0, 0, 39, 32, 0, 0, 2, 0, 0, 4, 20, 0, 0, 0, 85,
-		0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 73, 30, 0, 0, 217,
-		0, 0, 0, 32, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
-		0, 0, 0, 2, 0, 0, 0, 4, 0, 0, 0, 4, 0, 0, 0, 155,
-		6, 0, 0, 3, 0, 0, 4, 56, 0, 0, 0, 53, 32, 0, 0, 31,
-		0, 0, 0, 0, 0, 0, 0, 63, 32, 0, 0, 219, 0, 0, 0, 64,
-		0, 0, 0, 0, 0, 0, 0, 222, 0, 0, 0, 128, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 220, 0, 0, 0, 0, 0, 0, 0, 1,
-		0, 0, 13, 30, 0, 0, 0, 0, 0, 0, 0, 221, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 218, 0, 0, 0, 0, 0, 0, 0, 3,
-		0, 0, 5, 40, 0, 0, 0, 66, 32, 0, 0, 223, 0, 0, 0, 0,
-		0, 0, 0, 72, 32, 0, 0, 224, 0, 0, 0, 0, 0, 0, 0, 82,
-		32, 0, 0, 231, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6,
-		0, 0, 4, 40, 0, 0, 0, 87, 32, 0, 0, 7, 0, 0, 0, 0,
-		0, 0, 0, 53, 19, 0, 0, 8, 0, 0, 0, 64, 0, 0, 0, 186,
-		0, 0, 0, 8, 0, 0, 0, 96, 0, 0, 0, 93, 32, 0, 0, 8,
-		0, 0, 0, 128, 0, 0, 0, 100, 32, 0, 0, 57, 0, 0, 0, 192,
-		0, 0, 0, 105, 32, 0, 0, 7, 0, 0, 0, 0, 1, 0, 0, 0,
-		0, 0, 0, 4, 0, 0, 4, 24, 0, 0, 0, 112, 32, 0, 0, 225,
-		0, 0, 0, 0, 0, 0, 0, 48, 0, 0, 0, 227, 0, 0, 0, 32,
-		0, 0, 0, 0, 0, 0, 0, 228, 0, 0, 0, 64, 0, 0, 0, 163,
-		20, 0, 0, 57, 0, 0, 0, 128, 0, 0, 0, 120, 32, 0, 0, 0,
-		0, 0, 8, 226, 0, 0, 0, 130, 32, 0, 0, 0, 0, 0, 8, 2,
-		0, 0, 0, 149, 32, 0, 0, 3, 0, 0, 6, 4, 0, 0, 0, 163,
-		32, 0, 0, 0, 0, 0, 0, 171, 32, 0, 0, 1, 0, 0, 0, 181,
-		32, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 5, 8,
-		0, 0, 0, 191, 32, 0, 0, 229, 0, 0, 0, 0, 0, 0, 0, 196,
-		32, 0, 0, 230, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 4, 4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 5,
-		4, 0, 0, 0, 0, 0, 0, 5, 0, 0, 4, 32, 0, 0, 0, 208,
-		32, 0, 0, 232, 0, 0, 0, 0, 0, 0, 0, 213, 32, 0, 0, 2,
-		0, 0, 0, 64, 0, 0, 0, 218, 32, 0, 0, 2, 0, 0, 0, 96,
-		0, 0, 0, 230, 32, 0, 0, 31, 0, 0, 0, 128, 0, 0, 0, 237,
-		32, 0, 0, 31, 0, 0, 0, 192, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 6, 4, 0, 0, 245, 32, 0, 0, 0, 0, 0, 8, 234,
-		0, 0, 0, 251, 32, 0, 0, 0, 0, 0, 8, 2, 0, 0, 0, 206,
-		0, 0, 0, 8, 0, 0, 4, 112, 0, 0, 0, 73, 30, 0, 0, 40,
-		0, 0, 0, 0, 0, 0, 0, 10, 33, 0, 0, 10, 0, 0, 0, 32,
-		0, 0, 0, 238, 18, 0, 0, 148, 0, 0, 0, 64, 0, 0, 0, 251,
-		4, 0, 0, 236, 0, 0, 0, 128, 0, 0, 0, 16, 33, 0, 0, 120,
-		0, 0, 0, 128, 1, 0, 0, 23, 33, 0, 0, 237, 0, 0, 0, 192,
-		1, 0, 0, 251, 20, 0, 0, 109, 0, 0, 0, 128, 2, 0, 0, 34,
-		33, 0, 0, 241, 0, 0, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 3, 0, 0, 0, 0, 120, 0, 0, 0, 4, 0, 0, 0, 4,
-		0, 0, 0, 42, 33, 0, 0, 0, 0, 0, 8, 238, 0, 0, 0, 60,
-		33, 0, 0, 2, 0, 0, 4, 24, 0, 0, 0, 238, 18, 0, 0, 148,
-		0, 0, 0, 0, 0, 0, 0, 76, 33, 0, 0, 55, 0, 0, 0, 64,
-		0, 0, 0, 81, 33, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 243,
-		18, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 86, 33, 0, 0, 240,
-		0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 79,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 239,
-		0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0, 89, 33, 0, 0, 2,
-		0, 0, 4, 16, 0, 0, 0, 103, 15, 0, 0, 121, 0, 0, 0, 0,
-		0, 0, 0, 100, 33, 0, 0, 243, 0, 0, 0, 64, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 121, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 3, 0, 0, 0, 0, 242, 0, 0, 0, 4, 0, 0, 0, 4,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 130, 1, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 2, 0, 0, 0, 121, 7, 0, 0, 3,
-		0, 0, 4, 24, 0, 0, 0, 103, 7, 0, 0, 57, 0, 0, 0, 0,
-		0, 0, 0, 109, 7, 0, 0, 57, 0, 0, 0, 64, 0, 0, 0, 238,
-		18, 0, 0, 68, 0, 0, 0, 128, 0, 0, 0, 134, 7, 0, 0, 7,
-		0, 0, 4, 48, 0, 0, 0, 90, 30, 0, 0, 181, 0, 0, 0, 0,
-		0, 0, 0, 106, 33, 0, 0, 59, 0, 0, 0, 64, 0, 0, 0, 118,
-		20, 0, 0, 249, 0, 0, 0, 128, 0, 0, 0, 6, 15, 0, 0, 10,
-		0, 0, 0, 160, 0, 0, 0, 103, 7, 0, 0, 57, 0, 0, 0, 192,
-		0, 0, 0, 109, 7, 0, 0, 57, 0, 0, 0, 0, 1, 0, 0, 115,
-		7, 0, 0, 57, 0, 0, 0, 64, 1, 0, 0, 116, 33, 0, 0, 5,
-		0, 0, 6, 4, 0, 0, 0, 128, 33, 0, 0, 0, 0, 0, 0, 143,
-		33, 0, 0, 1, 0, 0, 0, 154, 33, 0, 0, 2, 0, 0, 0, 164,
-		33, 0, 0, 3, 0, 0, 0, 175, 33, 0, 0, 4, 0, 0, 0, 209,
-		7, 0, 0, 3, 0, 0, 4, 80, 0, 0, 0, 187, 33, 0, 0, 253,
-		0, 0, 0, 0, 0, 0, 0, 193, 33, 0, 0, 10, 0, 0, 0, 64,
-		2, 0, 0, 207, 33, 0, 0, 10, 0, 0, 0, 96, 2, 0, 0, 221,
-		33, 0, 0, 2, 0, 0, 4, 24, 0, 0, 0, 241, 33, 0, 0, 57,
-		0, 0, 0, 0, 0, 0, 0, 249, 33, 0, 0, 252, 0, 0, 0, 64,
-		0, 0, 0, 0, 34, 0, 0, 1, 0, 0, 4, 16, 0, 0, 0, 91,
-		18, 0, 0, 63, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 3, 0, 0, 0, 0, 251, 0, 0, 0, 4, 0, 0, 0, 3,
-		0, 0, 0, 225, 7, 0, 0, 2, 0, 0, 4, 24, 0, 0, 0, 239,
-		21, 0, 0, 109, 0, 0, 0, 0, 0, 0, 0, 16, 34, 0, 0, 10,
-		0, 0, 0, 128, 0, 0, 0, 13, 8, 0, 0, 26, 0, 0, 4, 176,
-		0, 0, 0, 121, 2, 0, 0, 42, 0, 0, 0, 0, 0, 0, 0, 67,
-		29, 0, 0, 166, 0, 0, 0, 32, 0, 0, 0, 26, 34, 0, 0, 0,
-		1, 0, 0, 64, 0, 0, 0, 30, 34, 0, 0, 166, 0, 0, 0, 96,
-		0, 0, 0, 35, 34, 0, 0, 0, 1, 0, 0, 128, 0, 0, 0, 71,
-		29, 0, 0, 166, 0, 0, 0, 160, 0, 0, 0, 40, 34, 0, 0, 0,
-		1, 0, 0, 192, 0, 0, 0, 45, 34, 0, 0, 166, 0, 0, 0, 224,
-		0, 0, 0, 51, 34, 0, 0, 0, 1, 0, 0, 0, 1, 0, 0, 57,
-		34, 0, 0, 10, 0, 0, 0, 32, 1, 0, 0, 68, 34, 0, 0, 4,
-		1, 0, 0, 64, 1, 0, 0, 84, 34, 0, 0, 4, 1, 0, 0, 128,
-		1, 0, 0, 98, 34, 0, 0, 4, 1, 0, 0, 192, 1, 0, 0, 112,
-		34, 0, 0, 4, 1, 0, 0, 0, 2, 0, 0, 121, 34, 0, 0, 4,
-		1, 0, 0, 64, 2, 0, 0, 133, 34, 0, 0, 22, 0, 0, 0, 128,
-		2, 0, 0, 145, 34, 0, 0, 7, 1, 0, 0, 192, 2, 0, 0, 161,
-		34, 0, 0, 7, 1, 0, 0, 0, 3, 0, 0, 177, 34, 0, 0, 7,
-		1, 0, 0, 64, 3, 0, 0, 192, 34, 0, 0, 7, 1, 0, 0, 128,
-		3, 0, 0, 71, 14, 0, 0, 39, 0, 0, 0, 192, 3, 0, 0, 209,
-		34, 0, 0, 110, 1, 0, 0, 0, 4, 0, 0, 68, 27, 0, 0, 196,
-		0, 0, 0, 64, 4, 0, 0, 214, 34, 0, 0, 144, 1, 0, 0, 128,
-		4, 0, 0, 222, 34, 0, 0, 148, 1, 0, 0, 192, 4, 0, 0, 0,
-		0, 0, 0, 149, 1, 0, 0, 0, 5, 0, 0, 233, 34, 0, 0, 0,
-		0, 0, 8, 1, 1, 0, 0, 0, 0, 0, 0, 1, 0, 0, 4, 4,
-		0, 0, 0, 53, 19, 0, 0, 2, 1, 0, 0, 0, 0, 0, 0, 240,
-		34, 0, 0, 0, 0, 0, 8, 3, 1, 0, 0, 246, 34, 0, 0, 0,
-		0, 0, 8, 10, 0, 0, 0, 7, 35, 0, 0, 0, 0, 0, 8, 5,
-		1, 0, 0, 20, 35, 0, 0, 1, 0, 0, 4, 8, 0, 0, 0, 38,
-		35, 0, 0, 6, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 3, 0, 0, 0, 0, 9, 0, 0, 0, 4, 0, 0, 0, 2,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 8, 1, 0, 0, 65,
-		0, 0, 0, 19, 0, 0, 4, 224, 0, 0, 0, 121, 2, 0, 0, 40,
-		0, 0, 0, 0, 0, 0, 0, 42, 35, 0, 0, 9, 1, 0, 0, 32,
-		0, 0, 0, 0, 0, 0, 0, 13, 1, 0, 0, 64, 0, 0, 0, 49,
-		35, 0, 0, 14, 1, 0, 0, 0, 1, 0, 0, 58, 35, 0, 0, 180,
-		0, 0, 0, 64, 1, 0, 0, 209, 34, 0, 0, 15, 1, 0, 0, 128,
-		2, 0, 0, 71, 14, 0, 0, 39, 0, 0, 0, 192, 2, 0, 0, 0,
-		0, 0, 0, 16, 1, 0, 0, 0, 3, 0, 0, 62, 35, 0, 0, 17,
-		1, 0, 0, 64, 3, 0, 0, 67, 29, 0, 0, 166, 0, 0, 0, 128,
-		3, 0, 0, 26, 34, 0, 0, 0, 1, 0, 0, 160, 3, 0, 0, 75,
-		35, 0, 0, 18, 1, 0, 0, 192, 3, 0, 0, 80, 35, 0, 0, 29,
-		0, 0, 0, 224, 3, 0, 0, 89, 35, 0, 0, 29, 0, 0, 0, 240,
-		3, 0, 0, 118, 20, 0, 0, 194, 0, 0, 0, 0, 4, 0, 0, 186,
-		0, 0, 0, 31, 0, 0, 0, 64, 4, 0, 0, 0, 0, 0, 0, 20,
-		1, 0, 0, 128, 4, 0, 0, 0, 0, 0, 0, 105, 1, 0, 0, 192,
-		5, 0, 0, 97, 35, 0, 0, 80, 1, 0, 0, 192, 6, 0, 0, 111,
-		35, 0, 0, 0, 0, 0, 8, 10, 1, 0, 0, 124, 35, 0, 0, 0,
-		0, 0, 8, 11, 1, 0, 0, 132, 35, 0, 0, 0, 0, 0, 8, 12,
-		1, 0, 0, 136, 35, 0, 0, 0, 0, 0, 8, 2, 0, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 5, 24, 0, 0, 0, 142, 35, 0, 0, 55,
-		0, 0, 0, 0, 0, 0, 0, 157, 35, 0, 0, 53, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 242, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 243, 3, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 5, 8, 0, 0, 0, 169, 35, 0, 0, 17, 1, 0, 0, 0,
-		0, 0, 0, 176, 35, 0, 0, 17, 1, 0, 0, 0, 0, 0, 0, 187,
-		35, 0, 0, 0, 0, 0, 8, 78, 0, 0, 0, 196, 35, 0, 0, 0,
-		0, 0, 8, 19, 1, 0, 0, 207, 35, 0, 0, 0, 0, 0, 8, 8,
-		0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 5, 40, 0, 0, 0, 216,
-		35, 0, 0, 21, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 29,
-		1, 0, 0, 0, 0, 0, 0, 226, 35, 0, 0, 5, 0, 0, 4, 40,
-		0, 0, 0, 244, 35, 0, 0, 31, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 22, 1, 0, 0, 64, 0, 0, 0, 48, 0, 0, 0, 25,
-		1, 0, 0, 128, 0, 0, 0, 249, 35, 0, 0, 26, 1, 0, 0, 192,
-		0, 0, 0, 4, 36, 0, 0, 27, 1, 0, 0, 0, 1, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 5, 8, 0, 0, 0, 0, 0, 0, 0, 23,
-		1, 0, 0, 0, 0, 0, 0, 16, 36, 0, 0, 31, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 4, 8, 0, 0, 0, 18,
-		36, 0, 0, 48, 0, 0, 0, 0, 0, 0, 0, 27, 36, 0, 0, 24,
-		1, 0, 0, 16, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
-		0, 0, 0, 33, 0, 0, 0, 4, 0, 0, 0, 6, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 30, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 104, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 28,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 33, 0, 0, 0, 0,
-		0, 0, 0, 5, 0, 0, 4, 40, 0, 0, 0, 244, 35, 0, 0, 31,
-		0, 0, 0, 0, 0, 0, 0, 32, 36, 0, 0, 31, 0, 0, 0, 64,
-		0, 0, 0, 48, 0, 0, 0, 25, 1, 0, 0, 128, 0, 0, 0, 249,
-		35, 0, 0, 26, 1, 0, 0, 192, 0, 0, 0, 4, 36, 0, 0, 40,
-		1, 0, 0, 0, 1, 0, 0, 41, 36, 0, 0, 21, 0, 0, 4, 168,
-		0, 0, 0, 50, 36, 0, 0, 27, 1, 0, 0, 0, 0, 0, 0, 55,
-		36, 0, 0, 31, 1, 0, 0, 64, 0, 0, 0, 186, 0, 0, 0, 10,
-		0, 0, 0, 128, 0, 0, 0, 67, 36, 0, 0, 34, 1, 0, 0, 192,
-		0, 0, 0, 83, 36, 0, 0, 36, 1, 0, 0, 0, 1, 0, 0, 92,
-		36, 0, 0, 45, 1, 0, 0, 64, 1, 0, 0, 106, 36, 0, 0, 47,
-		1, 0, 0, 128, 1, 0, 0, 118, 36, 0, 0, 47, 1, 0, 0, 192,
-		1, 0, 0, 125, 36, 0, 0, 49, 1, 0, 0, 0, 2, 0, 0, 140,
-		36, 0, 0, 61, 1, 0, 0, 64, 2, 0, 0, 151, 36, 0, 0, 63,
-		1, 0, 0, 128, 2, 0, 0, 158, 36, 0, 0, 63, 1, 0, 0, 192,
-		2, 0, 0, 166, 36, 0, 0, 65, 1, 0, 0, 0, 3, 0, 0, 175,
-		36, 0, 0, 73, 1, 0, 0, 64, 3, 0, 0, 180, 36, 0, 0, 75,
-		1, 0, 0, 128, 3, 0, 0, 192, 36, 0, 0, 78, 1, 0, 0, 192,
-		3, 0, 0, 211, 36, 0, 0, 89, 1, 0, 0, 0, 4, 0, 0, 222,
-		36, 0, 0, 98, 1, 0, 0, 64, 4, 0, 0, 234, 36, 0, 0, 101,
-		1, 0, 0, 128, 4, 0, 0, 0, 37, 0, 0, 55, 0, 0, 0, 192,
-		4, 0, 0, 5, 37, 0, 0, 103, 1, 0, 0, 64, 5, 0, 0, 16,
-		37, 0, 0, 0, 0, 0, 8, 32, 1, 0, 0, 23, 37, 0, 0, 0,
-		0, 0, 8, 33, 1, 0, 0, 39, 37, 0, 0, 0, 0, 0, 8, 31,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 35, 1, 0, 0, 0,
-		0, 0, 0, 1, 0, 0, 13, 2, 0, 0, 0, 0, 0, 0, 0, 27,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 37, 1, 0, 0, 0,
-		0, 0, 0, 1, 0, 0, 13, 2, 0, 0, 0, 0, 0, 0, 0, 38,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 39, 1, 0, 0, 56,
-		37, 0, 0, 7, 0, 0, 4, 80, 0, 0, 0, 78, 37, 0, 0, 27,
-		1, 0, 0, 0, 0, 0, 0, 4, 36, 0, 0, 40, 1, 0, 0, 64,
-		0, 0, 0, 95, 37, 0, 0, 41, 1, 0, 0, 128, 0, 0, 0, 165,
-		21, 0, 0, 43, 1, 0, 0, 128, 1, 0, 0, 89, 35, 0, 0, 31,
-		1, 0, 0, 192, 1, 0, 0, 80, 35, 0, 0, 31, 1, 0, 0, 0,
-		2, 0, 0, 169, 35, 0, 0, 17, 1, 0, 0, 64, 2, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 33, 0, 0, 0, 103, 37, 0, 0, 2,
-		0, 0, 5, 32, 0, 0, 0, 115, 37, 0, 0, 39, 0, 0, 0, 0,
-		0, 0, 0, 165, 21, 0, 0, 42, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 39, 22, 0, 0, 61, 0, 0, 0, 0, 0, 0, 0, 42,
+		22, 0, 0, 39, 0, 0, 0, 192, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 145, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 144,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 113, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 10, 218, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 162, 1, 0, 0, 58, 22, 0, 0, 0, 0, 0, 8, 116,
+		0, 0, 0, 72, 22, 0, 0, 0, 0, 0, 8, 117, 0, 0, 0, 0,
+		0, 0, 0, 1, 0, 0, 4, 8, 0, 0, 0, 73, 15, 0, 0, 72,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 178,
+		1, 0, 0, 231, 21, 0, 0, 1, 0, 0, 4, 8, 0, 0, 0, 83,
+		22, 0, 0, 120, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 216, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
+		0, 0, 0, 103, 0, 0, 0, 4, 0, 0, 0, 4, 0, 0, 0, 87,
+		22, 0, 0, 2, 0, 0, 4, 20, 0, 0, 0, 85, 0, 0, 0, 2,
+		0, 0, 0, 0, 0, 0, 0, 101, 22, 0, 0, 123, 0, 0, 0, 32,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 2,
+		0, 0, 0, 4, 0, 0, 0, 4, 0, 0, 0, 177, 6, 0, 0, 3,
+		0, 0, 4, 56, 0, 0, 0, 107, 22, 0, 0, 39, 0, 0, 0, 0,
+		0, 0, 0, 117, 22, 0, 0, 125, 0, 0, 0, 64, 0, 0, 0, 0,
+		0, 0, 0, 128, 0, 0, 0, 128, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 126, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 13, 38,
+		0, 0, 0, 0, 0, 0, 0, 127, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 124, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 5, 40,
+		0, 0, 0, 120, 22, 0, 0, 129, 0, 0, 0, 0, 0, 0, 0, 126,
+		22, 0, 0, 130, 0, 0, 0, 0, 0, 0, 0, 136, 22, 0, 0, 137,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6, 0, 0, 4, 40,
+		0, 0, 0, 141, 22, 0, 0, 7, 0, 0, 0, 0, 0, 0, 0, 147,
+		22, 0, 0, 8, 0, 0, 0, 64, 0, 0, 0, 229, 0, 0, 0, 8,
+		0, 0, 0, 96, 0, 0, 0, 151, 22, 0, 0, 8, 0, 0, 0, 128,
+		0, 0, 0, 158, 22, 0, 0, 29, 0, 0, 0, 192, 0, 0, 0, 163,
+		22, 0, 0, 7, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 4, 24, 0, 0, 0, 170, 22, 0, 0, 131, 0, 0, 0, 0,
+		0, 0, 0, 48, 0, 0, 0, 133, 0, 0, 0, 32, 0, 0, 0, 0,
+		0, 0, 0, 134, 0, 0, 0, 64, 0, 0, 0, 95, 18, 0, 0, 29,
+		0, 0, 0, 128, 0, 0, 0, 178, 22, 0, 0, 0, 0, 0, 8, 132,
+		0, 0, 0, 188, 22, 0, 0, 0, 0, 0, 8, 2, 0, 0, 0, 207,
+		22, 0, 0, 3, 0, 0, 6, 4, 0, 0, 0, 221, 22, 0, 0, 0,
+		0, 0, 0, 229, 22, 0, 0, 1, 0, 0, 0, 239, 22, 0, 0, 2,
+		0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 5, 8, 0, 0, 0, 249,
+		22, 0, 0, 135, 0, 0, 0, 0, 0, 0, 0, 254, 22, 0, 0, 136,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 143,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 184, 1, 0, 0, 0,
+		0, 0, 0, 5, 0, 0, 4, 32, 0, 0, 0, 10, 23, 0, 0, 138,
+		0, 0, 0, 0, 0, 0, 0, 15, 23, 0, 0, 2, 0, 0, 0, 64,
+		0, 0, 0, 20, 23, 0, 0, 2, 0, 0, 0, 96, 0, 0, 0, 32,
+		23, 0, 0, 39, 0, 0, 0, 128, 0, 0, 0, 39, 23, 0, 0, 39,
+		0, 0, 0, 192, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 196,
+		1, 0, 0, 47, 23, 0, 0, 0, 0, 0, 8, 140, 0, 0, 0, 53,
+		23, 0, 0, 0, 0, 0, 8, 2, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 192, 1, 0, 0, 68, 23, 0, 0, 2, 0, 0, 4, 16,
+		0, 0, 0, 125, 15, 0, 0, 89, 0, 0, 0, 0, 0, 0, 0, 79,
+		23, 0, 0, 143, 0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 89, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
+		0, 0, 0, 142, 0, 0, 0, 4, 0, 0, 0, 4, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 157, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 2, 0, 0, 0, 143, 7, 0, 0, 3, 0, 0, 4, 24,
+		0, 0, 0, 125, 7, 0, 0, 29, 0, 0, 0, 0, 0, 0, 0, 131,
+		7, 0, 0, 29, 0, 0, 0, 64, 0, 0, 0, 85, 23, 0, 0, 148,
+		0, 0, 0, 128, 0, 0, 0, 90, 23, 0, 0, 0, 0, 0, 8, 149,
+		0, 0, 0, 105, 23, 0, 0, 1, 0, 0, 4, 4, 0, 0, 0, 118,
+		23, 0, 0, 150, 0, 0, 0, 0, 0, 0, 0, 127, 23, 0, 0, 0,
+		0, 0, 8, 151, 0, 0, 0, 143, 23, 0, 0, 1, 0, 0, 4, 4,
+		0, 0, 0, 0, 0, 0, 0, 152, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 3, 0, 0, 5, 4, 0, 0, 0, 147, 22, 0, 0, 50,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 153, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 154, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 2, 0, 0, 4, 2, 0, 0, 0, 153, 23, 0, 0, 20,
+		0, 0, 0, 0, 0, 0, 0, 168, 8, 0, 0, 20, 0, 0, 0, 8,
+		0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 4, 4, 0, 0, 0, 160,
+		23, 0, 0, 56, 0, 0, 0, 0, 0, 0, 0, 175, 23, 0, 0, 56,
+		0, 0, 0, 16, 0, 0, 0, 156, 7, 0, 0, 7, 0, 0, 4, 48,
+		0, 0, 0, 180, 23, 0, 0, 156, 0, 0, 0, 0, 0, 0, 0, 189,
+		23, 0, 0, 31, 0, 0, 0, 64, 0, 0, 0, 50, 18, 0, 0, 158,
+		0, 0, 0, 128, 0, 0, 0, 28, 15, 0, 0, 10, 0, 0, 0, 160,
+		0, 0, 0, 125, 7, 0, 0, 29, 0, 0, 0, 192, 0, 0, 0, 131,
+		7, 0, 0, 29, 0, 0, 0, 0, 1, 0, 0, 137, 7, 0, 0, 29,
+		0, 0, 0, 64, 1, 0, 0, 199, 23, 0, 0, 0, 0, 0, 8, 157,
+		0, 0, 0, 180, 23, 0, 0, 1, 0, 0, 4, 4, 0, 0, 0, 210,
+		23, 0, 0, 10, 0, 0, 0, 0, 0, 0, 0, 219, 23, 0, 0, 5,
+		0, 0, 6, 4, 0, 0, 0, 231, 23, 0, 0, 0, 0, 0, 0, 246,
+		23, 0, 0, 1, 0, 0, 0, 1, 24, 0, 0, 2, 0, 0, 0, 11,
+		24, 0, 0, 3, 0, 0, 0, 22, 24, 0, 0, 4, 0, 0, 0, 231,
+		7, 0, 0, 3, 0, 0, 4, 80, 0, 0, 0, 34, 24, 0, 0, 164,
+		0, 0, 0, 0, 0, 0, 0, 40, 24, 0, 0, 10, 0, 0, 0, 64,
+		2, 0, 0, 54, 24, 0, 0, 10, 0, 0, 0, 96, 2, 0, 0, 68,
+		24, 0, 0, 2, 0, 0, 4, 24, 0, 0, 0, 88, 24, 0, 0, 29,
+		0, 0, 0, 0, 0, 0, 0, 96, 24, 0, 0, 161, 0, 0, 0, 64,
+		0, 0, 0, 103, 24, 0, 0, 1, 0, 0, 4, 16, 0, 0, 0, 119,
+		24, 0, 0, 162, 0, 0, 0, 0, 0, 0, 0, 127, 24, 0, 0, 2,
+		0, 0, 4, 16, 0, 0, 0, 119, 24, 0, 0, 163, 0, 0, 0, 0,
+		0, 0, 0, 142, 24, 0, 0, 62, 0, 0, 0, 64, 0, 0, 0, 119,
+		24, 0, 0, 1, 0, 0, 4, 8, 0, 0, 0, 79, 16, 0, 0, 62,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
+		0, 0, 0, 160, 0, 0, 0, 4, 0, 0, 0, 3, 0, 0, 0, 247,
+		7, 0, 0, 2, 0, 0, 4, 24, 0, 0, 0, 154, 24, 0, 0, 166,
+		0, 0, 0, 0, 0, 0, 0, 159, 24, 0, 0, 10, 0, 0, 0, 128,
+		0, 0, 0, 169, 24, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 125,
+		15, 0, 0, 167, 0, 0, 0, 0, 0, 0, 0, 183, 24, 0, 0, 168,
+		0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 166,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 169, 0, 0, 0, 0,
+		0, 0, 0, 1, 0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 0, 167,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 171, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 10, 158, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 174, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
+		0, 0, 0, 41, 0, 0, 0, 4, 0, 0, 0, 16, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 181, 1, 0, 0, 188, 24, 0, 0, 1,
+		0, 0, 4, 8, 0, 0, 0, 197, 24, 0, 0, 176, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 206, 1, 0, 0, 207,
+		24, 0, 0, 1, 0, 0, 4, 16, 0, 0, 0, 216, 24, 0, 0, 63,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 164,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 163, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 171, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 111, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 208,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 207, 1, 0, 0, 226,
+		24, 0, 0, 0, 0, 0, 8, 185, 0, 0, 0, 0, 0, 0, 0, 1,
+		0, 0, 4, 8, 0, 0, 0, 235, 24, 0, 0, 186, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 39,
+		0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0, 239, 24, 0, 0, 2,
+		0, 0, 4, 24, 0, 0, 0, 250, 24, 0, 0, 63, 0, 0, 0, 0,
+		0, 0, 0, 118, 8, 0, 0, 184, 0, 0, 0, 128, 0, 0, 0, 255,
+		24, 0, 0, 0, 0, 0, 8, 189, 0, 0, 0, 6, 25, 0, 0, 0,
+		0, 0, 8, 190, 0, 0, 0, 22, 25, 0, 0, 0, 0, 0, 8, 39,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 146, 1, 0, 0, 39,
+		25, 0, 0, 0, 0, 0, 8, 193, 0, 0, 0, 0, 0, 0, 0, 1,
+		0, 0, 4, 4, 0, 0, 0, 147, 22, 0, 0, 194, 0, 0, 0, 0,
+		0, 0, 0, 46, 25, 0, 0, 0, 0, 0, 8, 195, 0, 0, 0, 52,
+		25, 0, 0, 0, 0, 0, 8, 10, 0, 0, 0, 255, 8, 0, 0, 3,
+		0, 0, 4, 16, 0, 0, 0, 69, 25, 0, 0, 2, 0, 0, 0, 0,
+		0, 0, 0, 74, 25, 0, 0, 50, 0, 0, 0, 32, 0, 0, 0, 87,
+		25, 0, 0, 197, 0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 205, 1, 0, 0, 94, 25, 0, 0, 4, 0, 0, 4, 32,
+		0, 0, 0, 116, 25, 0, 0, 199, 0, 0, 0, 0, 0, 0, 0, 125,
+		25, 0, 0, 39, 0, 0, 0, 64, 0, 0, 0, 132, 25, 0, 0, 39,
+		0, 0, 0, 128, 0, 0, 0, 136, 25, 0, 0, 200, 0, 0, 0, 192,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 41, 0, 0, 0, 148,
+		25, 0, 0, 0, 0, 0, 8, 201, 0, 0, 0, 153, 25, 0, 0, 0,
+		0, 0, 1, 1, 0, 0, 0, 8, 0, 0, 4, 159, 25, 0, 0, 0,
+		0, 0, 8, 203, 0, 0, 0, 170, 25, 0, 0, 1, 0, 0, 4, 4,
+		0, 0, 0, 0, 0, 0, 0, 204, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 1, 0, 0, 5, 4, 0, 0, 0, 179, 25, 0, 0, 149,
+		0, 0, 0, 0, 0, 0, 0, 185, 25, 0, 0, 1, 0, 0, 4, 8,
+		0, 0, 0, 125, 15, 0, 0, 206, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 205, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 203, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 148,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 149, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 199, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 147, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 170,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 153, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 215, 0, 0, 0, 197, 25, 0, 0, 0,
+		0, 0, 8, 173, 1, 0, 0, 214, 25, 0, 0, 7, 0, 0, 4, 56,
+		0, 0, 0, 233, 25, 0, 0, 29, 0, 0, 0, 0, 0, 0, 0, 239,
+		25, 0, 0, 29, 0, 0, 0, 64, 0, 0, 0, 245, 25, 0, 0, 29,
+		0, 0, 0, 128, 0, 0, 0, 251, 25, 0, 0, 29, 0, 0, 0, 192,
+		0, 0, 0, 1, 26, 0, 0, 29, 0, 0, 0, 0, 1, 0, 0, 12,
+		26, 0, 0, 29, 0, 0, 0, 64, 1, 0, 0, 24, 26, 0, 0, 29,
+		0, 0, 0, 128, 1, 0, 0, 46, 26, 0, 0, 0, 0, 0, 8, 218,
+		0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 4, 128, 0, 0, 0, 166,
+		20, 0, 0, 219, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 3, 0, 0, 0, 0, 39, 0, 0, 0, 4, 0, 0, 0, 16,
+		0, 0, 0, 57, 26, 0, 0, 0, 0, 0, 8, 221, 0, 0, 0, 77,
+		26, 0, 0, 1, 0, 0, 4, 4, 0, 0, 0, 180, 23, 0, 0, 156,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 159,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 201, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 156, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 167, 1, 0, 0, 95, 26, 0, 0, 4, 0, 0, 4, 32,
+		0, 0, 0, 101, 26, 0, 0, 115, 0, 0, 0, 0, 0, 0, 0, 107,
+		26, 0, 0, 148, 0, 0, 0, 64, 0, 0, 0, 117, 26, 0, 0, 227,
+		0, 0, 0, 96, 0, 0, 0, 121, 26, 0, 0, 63, 0, 0, 0, 128,
+		0, 0, 0, 131, 26, 0, 0, 1, 0, 0, 4, 4, 0, 0, 0, 175,
+		23, 0, 0, 50, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 229, 0, 0, 0, 153, 26, 0, 0, 30, 0, 0, 4, 24,
+		1, 0, 0, 172, 26, 0, 0, 230, 0, 0, 0, 0, 0, 0, 0, 85,
+		23, 0, 0, 148, 0, 0, 0, 64, 0, 0, 0, 95, 26, 0, 0, 226,
+		0, 0, 0, 128, 0, 0, 0, 176, 26, 0, 0, 63, 0, 0, 0, 128,
+		1, 0, 0, 192, 26, 0, 0, 231, 0, 0, 0, 0, 2, 0, 0, 206,
+		26, 0, 0, 231, 0, 0, 0, 128, 2, 0, 0, 222, 26, 0, 0, 63,
+		0, 0, 0, 0, 3, 0, 0, 233, 26, 0, 0, 63, 0, 0, 0, 128,
+		3, 0, 0, 247, 26, 0, 0, 63, 0, 0, 0, 0, 4, 0, 0, 7,
+		27, 0, 0, 2, 0, 0, 0, 128, 4, 0, 0, 17, 27, 0, 0, 2,
+		0, 0, 0, 160, 4, 0, 0, 27, 27, 0, 0, 2, 0, 0, 0, 192,
+		4, 0, 0, 35, 27, 0, 0, 2, 0, 0, 0, 224, 4, 0, 0, 45,
+		27, 0, 0, 2, 0, 0, 0, 0, 5, 0, 0, 53, 27, 0, 0, 2,
+		0, 0, 0, 32, 5, 0, 0, 61, 27, 0, 0, 2, 0, 0, 0, 64,
+		5, 0, 0, 76, 27, 0, 0, 2, 0, 0, 0, 96, 5, 0, 0, 93,
+		27, 0, 0, 48, 0, 0, 0, 128, 5, 0, 0, 102, 27, 0, 0, 58,
+		0, 0, 0, 192, 5, 0, 0, 158, 22, 0, 0, 29, 0, 0, 0, 0,
+		6, 0, 0, 107, 27, 0, 0, 29, 0, 0, 0, 64, 6, 0, 0, 117,
+		27, 0, 0, 29, 0, 0, 0, 128, 6, 0, 0, 128, 27, 0, 0, 228,
+		0, 0, 0, 192, 6, 0, 0, 139, 27, 0, 0, 29, 0, 0, 0, 0,
+		7, 0, 0, 150, 27, 0, 0, 29, 0, 0, 0, 64, 7, 0, 0, 161,
+		27, 0, 0, 2, 0, 0, 0, 128, 7, 0, 0, 171, 27, 0, 0, 2,
+		0, 0, 0, 160, 7, 0, 0, 182, 27, 0, 0, 47, 0, 0, 0, 192,
+		7, 0, 0, 169, 24, 0, 0, 166, 0, 0, 0, 0, 8, 0, 0, 196,
+		27, 0, 0, 232, 0, 0, 0, 128, 8, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 195, 1, 0, 0, 207, 27, 0, 0, 2, 0, 0, 4, 16,
+		0, 0, 0, 225, 27, 0, 0, 163, 0, 0, 0, 0, 0, 0, 0, 230,
+		27, 0, 0, 29, 0, 0, 0, 64, 0, 0, 0, 236, 27, 0, 0, 0,
+		0, 0, 8, 233, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 4, 8,
+		0, 0, 0, 244, 27, 0, 0, 115, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 228, 0, 0, 0, 4,
+		0, 0, 0, 2, 0, 0, 0, 246, 27, 0, 0, 0, 0, 0, 1, 2,
+		0, 0, 0, 16, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 2, 183,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 39, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 39, 0, 0, 0, 4,
-		0, 0, 0, 4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 44,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 46, 1, 0, 0, 0, 0, 0, 0, 1,
-		0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 0, 38, 1, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 48, 1, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 13, 2, 0, 0, 0, 0, 0, 0, 0, 7, 1, 0, 0, 0,
-		0, 0, 0, 38, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 50,
-		1, 0, 0, 0, 0, 0, 0, 1, 0, 0, 13, 2, 0, 0, 0, 0,
-		0, 0, 0, 51, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 52,
-		1, 0, 0, 125, 37, 0, 0, 4, 0, 0, 4, 32, 0, 0, 0, 140,
-		37, 0, 0, 53, 1, 0, 0, 0, 0, 0, 0, 144, 37, 0, 0, 43,
-		1, 0, 0, 64, 0, 0, 0, 153, 37, 0, 0, 39, 0, 0, 0, 128,
-		0, 0, 0, 163, 37, 0, 0, 10, 0, 0, 0, 192, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 54, 1, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 13, 55, 1, 0, 0, 0, 0, 0, 0, 57, 1, 0, 0, 0,
-		0, 0, 0, 59, 1, 0, 0, 175, 37, 0, 0, 0, 0, 0, 8, 56,
-		1, 0, 0, 180, 37, 0, 0, 0, 0, 0, 1, 1, 0, 0, 0, 8,
-		0, 0, 4, 0, 0, 0, 0, 0, 0, 0, 2, 58, 1, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 10, 8, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 60, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 52,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 62, 1, 0, 0, 0,
-		0, 0, 0, 1, 0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 0, 51,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 64, 1, 0, 0, 0,
-		0, 0, 0, 1, 0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 0, 7,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 66, 1, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 0, 57,
-		1, 0, 0, 0, 0, 0, 0, 67, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 68, 1, 0, 0, 186, 37, 0, 0, 12, 0, 0, 4, 120,
-		0, 0, 0, 195, 37, 0, 0, 40, 1, 0, 0, 0, 0, 0, 0, 248,
-		29, 0, 0, 31, 1, 0, 0, 64, 0, 0, 0, 199, 37, 0, 0, 31,
-		1, 0, 0, 128, 0, 0, 0, 73, 30, 0, 0, 31, 1, 0, 0, 192,
-		0, 0, 0, 204, 37, 0, 0, 31, 1, 0, 0, 0, 1, 0, 0, 214,
-		37, 0, 0, 154, 0, 0, 0, 64, 1, 0, 0, 220, 37, 0, 0, 154,
-		0, 0, 0, 128, 1, 0, 0, 238, 18, 0, 0, 152, 0, 0, 0, 192,
-		1, 0, 0, 229, 37, 0, 0, 69, 1, 0, 0, 192, 2, 0, 0, 232,
-		37, 0, 0, 2, 0, 0, 0, 0, 3, 0, 0, 243, 27, 0, 0, 71,
-		1, 0, 0, 64, 3, 0, 0, 243, 37, 0, 0, 39, 0, 0, 0, 128,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 70, 1, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 10, 223, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 72, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 140,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 74, 1, 0, 0, 0,
-		0, 0, 0, 3, 0, 0, 13, 30, 0, 0, 0, 0, 0, 0, 0, 57,
-		1, 0, 0, 0, 0, 0, 0, 40, 1, 0, 0, 0, 0, 0, 0, 31,
-		1, 0, 0, 251, 37, 0, 0, 0, 0, 0, 8, 76, 1, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 77, 1, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 13, 2, 0, 0, 0, 0, 0, 0, 0, 7, 1, 0, 0, 0,
-		0, 0, 0, 39, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 79,
-		1, 0, 0, 0, 0, 0, 0, 1, 0, 0, 13, 80, 1, 0, 0, 0,
-		0, 0, 0, 27, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 81,
-		1, 0, 0, 15, 38, 0, 0, 3, 0, 0, 4, 24, 0, 0, 0, 31,
-		38, 0, 0, 82, 1, 0, 0, 0, 0, 0, 0, 65, 0, 0, 0, 7,
-		1, 0, 0, 64, 0, 0, 0, 37, 38, 0, 0, 25, 1, 0, 0, 128,
-		0, 0, 0, 45, 38, 0, 0, 0, 0, 0, 8, 83, 1, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 84, 1, 0, 0, 0, 0, 0, 0, 4,
-		0, 0, 13, 2, 0, 0, 0, 0, 0, 0, 0, 7, 1, 0, 0, 0,
-		0, 0, 0, 85, 1, 0, 0, 0, 0, 0, 0, 87, 1, 0, 0, 0,
-		0, 0, 0, 7, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 86,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 30, 1, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 88, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 10, 41, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 90,
-		1, 0, 0, 0, 0, 0, 0, 2, 0, 0, 13, 2, 0, 0, 0, 0,
-		0, 0, 0, 91, 1, 0, 0, 0, 0, 0, 0, 96, 1, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 92, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 10, 93, 1, 0, 0, 70, 38, 0, 0, 7, 0, 0, 132, 48,
-		0, 0, 0, 65, 0, 0, 0, 7, 1, 0, 0, 0, 0, 0, 0, 89,
-		38, 0, 0, 27, 1, 0, 0, 64, 0, 0, 0, 98, 38, 0, 0, 27,
-		1, 0, 0, 128, 0, 0, 0, 108, 38, 0, 0, 40, 1, 0, 0, 192,
-		0, 0, 0, 113, 38, 0, 0, 9, 0, 0, 0, 0, 1, 0, 0, 0,
-		0, 0, 0, 94, 1, 0, 0, 32, 1, 0, 0, 229, 37, 0, 0, 95,
-		1, 0, 0, 64, 1, 0, 8, 0, 0, 0, 0, 2, 0, 0, 5, 4,
-		0, 0, 0, 120, 38, 0, 0, 9, 0, 0, 0, 0, 0, 0, 0, 128,
-		38, 0, 0, 9, 0, 0, 0, 0, 0, 0, 0, 136, 38, 0, 0, 4,
-		0, 0, 6, 4, 0, 0, 0, 158, 38, 0, 0, 0, 0, 0, 0, 178,
-		38, 0, 0, 1, 0, 0, 0, 198, 38, 0, 0, 2, 0, 0, 0, 215,
-		38, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 97,
-		1, 0, 0, 234, 38, 0, 0, 6, 0, 0, 4, 16, 0, 0, 0, 252,
-		38, 0, 0, 9, 0, 0, 0, 0, 0, 0, 0, 10, 39, 0, 0, 9,
-		0, 0, 0, 32, 0, 0, 0, 19, 39, 0, 0, 49, 0, 0, 0, 64,
-		0, 0, 0, 33, 39, 0, 0, 49, 0, 0, 0, 80, 0, 0, 0, 46,
-		39, 0, 0, 49, 0, 0, 0, 96, 0, 0, 0, 59, 39, 0, 0, 49,
-		0, 0, 0, 112, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 99,
-		1, 0, 0, 0, 0, 0, 0, 3, 0, 0, 13, 2, 0, 0, 0, 0,
-		0, 0, 0, 100, 1, 0, 0, 0, 0, 0, 0, 43, 1, 0, 0, 0,
-		0, 0, 0, 39, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 93,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 102, 1, 0, 0, 0,
-		0, 0, 0, 3, 0, 0, 13, 2, 0, 0, 0, 0, 0, 0, 0, 100,
-		1, 0, 0, 0, 0, 0, 0, 43, 1, 0, 0, 0, 0, 0, 0, 43,
-		1, 0, 0, 72, 39, 0, 0, 0, 0, 0, 4, 0, 0, 0, 0, 87,
-		39, 0, 0, 3, 0, 0, 4, 24, 0, 0, 0, 251, 20, 0, 0, 109,
-		0, 0, 0, 0, 0, 0, 0, 121, 2, 0, 0, 40, 0, 0, 0, 128,
-		0, 0, 0, 45, 17, 0, 0, 55, 1, 0, 0, 160, 0, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 5, 32, 0, 0, 0, 95, 37, 0, 0, 41,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 106, 1, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 4, 32, 0, 0, 0, 95,
-		39, 0, 0, 55, 0, 0, 0, 0, 0, 0, 0, 105, 39, 0, 0, 107,
-		1, 0, 0, 128, 0, 0, 0, 110, 39, 0, 0, 2, 0, 0, 4, 16,
-		0, 0, 0, 122, 39, 0, 0, 108, 1, 0, 0, 0, 0, 0, 0, 127,
-		39, 0, 0, 31, 0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 109, 1, 0, 0, 145, 39, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 169, 2, 0, 0, 161,
-		39, 0, 0, 19, 0, 0, 4, 112, 2, 0, 0, 176, 39, 0, 0, 112,
-		1, 0, 0, 0, 0, 0, 0, 184, 39, 0, 0, 112, 1, 0, 0, 64,
-		2, 0, 0, 192, 39, 0, 0, 112, 1, 0, 0, 128, 4, 0, 0, 199,
-		6, 0, 0, 196, 0, 0, 0, 192, 6, 0, 0, 10, 33, 0, 0, 2,
-		0, 0, 0, 0, 7, 0, 0, 62, 27, 0, 0, 166, 0, 0, 0, 32,
-		7, 0, 0, 203, 39, 0, 0, 0, 1, 0, 0, 64, 7, 0, 0, 86,
-		33, 0, 0, 118, 1, 0, 0, 128, 7, 0, 0, 186, 0, 0, 0, 31,
-		0, 0, 0, 64, 8, 0, 0, 209, 39, 0, 0, 55, 1, 0, 0, 128,
-		8, 0, 0, 230, 39, 0, 0, 55, 0, 0, 0, 192, 8, 0, 0, 248,
-		39, 0, 0, 7, 1, 0, 0, 64, 9, 0, 0, 14, 40, 0, 0, 180,
-		0, 0, 0, 128, 9, 0, 0, 26, 40, 0, 0, 7, 1, 0, 0, 192,
-		10, 0, 0, 239, 21, 0, 0, 100, 0, 0, 0, 0, 11, 0, 0, 54,
-		40, 0, 0, 121, 1, 0, 0, 0, 12, 0, 0, 58, 40, 0, 0, 143,
-		1, 0, 0, 0, 15, 0, 0, 214, 34, 0, 0, 144, 1, 0, 0, 64,
-		15, 0, 0, 66, 40, 0, 0, 145, 1, 0, 0, 128, 15, 0, 0, 77,
-		40, 0, 0, 2, 0, 0, 4, 72, 0, 0, 0, 89, 40, 0, 0, 8,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 113, 1, 0, 0, 64,
-		0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 5, 64, 0, 0, 0, 100,
-		40, 0, 0, 115, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 116,
-		1, 0, 0, 0, 0, 0, 0, 107, 40, 0, 0, 3, 0, 0, 4, 12,
-		0, 0, 0, 166, 24, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 122,
-		40, 0, 0, 8, 0, 0, 0, 32, 0, 0, 0, 73, 30, 0, 0, 8,
-		0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
-		0, 0, 0, 114, 1, 0, 0, 4, 0, 0, 0, 5, 0, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 134, 40, 0, 0, 117,
-		1, 0, 0, 0, 0, 0, 0, 142, 40, 0, 0, 117, 1, 0, 0, 64,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 114, 1, 0, 0, 150,
-		40, 0, 0, 4, 0, 0, 4, 24, 0, 0, 0, 160, 40, 0, 0, 101,
-		0, 0, 0, 0, 0, 0, 0, 168, 40, 0, 0, 119, 1, 0, 0, 64,
-		0, 0, 0, 172, 40, 0, 0, 10, 0, 0, 0, 128, 0, 0, 0, 73,
-		30, 0, 0, 40, 0, 0, 0, 160, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 120, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 200,
-		3, 0, 0, 177, 40, 0, 0, 2, 0, 0, 4, 96, 0, 0, 0, 191,
-		40, 0, 0, 122, 1, 0, 0, 0, 0, 0, 0, 199, 40, 0, 0, 125,
-		1, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 123,
-		1, 0, 0, 0, 0, 0, 0, 1, 0, 0, 13, 2, 0, 0, 0, 0,
-		0, 0, 0, 124, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 121,
-		1, 0, 0, 203, 40, 0, 0, 2, 0, 0, 4, 88, 0, 0, 0, 211,
-		40, 0, 0, 126, 1, 0, 0, 0, 0, 0, 0, 122, 39, 0, 0, 64,
-		0, 0, 0, 128, 2, 0, 0, 218, 40, 0, 0, 8, 0, 0, 4, 80,
-		0, 0, 0, 0, 0, 0, 0, 127, 1, 0, 0, 0, 0, 0, 0, 235,
-		40, 0, 0, 245, 0, 0, 0, 192, 0, 0, 0, 249, 40, 0, 0, 129,
-		1, 0, 0, 0, 1, 0, 0, 122, 39, 0, 0, 140, 1, 0, 0, 64,
-		1, 0, 0, 54, 40, 0, 0, 124, 1, 0, 0, 128, 1, 0, 0, 199,
-		6, 0, 0, 141, 1, 0, 0, 192, 1, 0, 0, 86, 20, 0, 0, 142,
-		1, 0, 0, 0, 2, 0, 0, 16, 33, 0, 0, 120, 0, 0, 0, 64,
-		2, 0, 0, 0, 0, 0, 0, 2, 0, 0, 5, 24, 0, 0, 0, 0,
-		0, 0, 0, 128, 1, 0, 0, 0, 0, 0, 0, 251, 20, 0, 0, 109,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 4, 0, 0, 4, 24,
-		0, 0, 0, 7, 41, 0, 0, 129, 1, 0, 0, 0, 0, 0, 0, 17,
-		41, 0, 0, 2, 0, 0, 0, 64, 0, 0, 0, 73, 30, 0, 0, 2,
-		0, 0, 0, 96, 0, 0, 0, 22, 41, 0, 0, 2, 0, 0, 0, 128,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 132, 1, 0, 0, 27,
-		41, 0, 0, 2, 0, 0, 4, 32, 0, 0, 0, 38, 41, 0, 0, 10,
-		0, 0, 0, 0, 0, 0, 0, 43, 41, 0, 0, 131, 1, 0, 0, 64,
-		0, 0, 0, 48, 41, 0, 0, 2, 0, 0, 4, 24, 0, 0, 0, 238,
-		18, 0, 0, 68, 0, 0, 0, 0, 0, 0, 0, 65, 41, 0, 0, 55,
-		0, 0, 0, 64, 0, 0, 0, 7, 41, 0, 0, 9, 0, 0, 4, 64,
-		0, 0, 0, 75, 41, 0, 0, 27, 1, 0, 0, 0, 0, 0, 0, 165,
-		21, 0, 0, 39, 0, 0, 0, 64, 0, 0, 0, 84, 41, 0, 0, 2,
-		0, 0, 0, 128, 0, 0, 0, 91, 41, 0, 0, 133, 1, 0, 0, 160,
-		0, 0, 0, 96, 41, 0, 0, 129, 1, 0, 0, 192, 0, 0, 0, 102,
-		41, 0, 0, 134, 1, 0, 0, 0, 1, 0, 0, 82, 32, 0, 0, 139,
-		1, 0, 0, 64, 1, 0, 0, 115, 41, 0, 0, 39, 0, 0, 0, 128,
-		1, 0, 0, 122, 41, 0, 0, 39, 0, 0, 0, 192, 1, 0, 0, 129,
-		41, 0, 0, 0, 0, 0, 8, 29, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 135, 1, 0, 0, 102, 41, 0, 0, 0, 0, 0, 8, 136,
-		1, 0, 0, 0, 0, 0, 0, 5, 0, 0, 13, 2, 0, 0, 0, 0,
-		0, 0, 0, 129, 1, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0,
-		0, 0, 0, 39, 0, 0, 0, 0, 0, 0, 0, 137, 1, 0, 0, 0,
-		0, 0, 0, 138, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 31,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 154, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 212, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 213, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 125,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 214, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 126, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 146, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
-		0, 0, 0, 30, 0, 0, 0, 4, 0, 0, 0, 16, 0, 0, 0, 214,
-		34, 0, 0, 5, 0, 0, 4, 160, 0, 0, 0, 86, 20, 0, 0, 242,
-		0, 0, 0, 0, 0, 0, 0, 86, 33, 0, 0, 196, 0, 0, 0, 128,
-		0, 0, 0, 67, 29, 0, 0, 166, 0, 0, 0, 192, 0, 0, 0, 73,
-		30, 0, 0, 42, 0, 0, 0, 224, 0, 0, 0, 137, 41, 0, 0, 147,
-		1, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
-		0, 0, 0, 101, 0, 0, 0, 4, 0, 0, 0, 16, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 244, 3, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 5, 16, 0, 0, 0, 144, 41, 0, 0, 2, 0, 0, 0, 0,
-		0, 0, 0, 251, 20, 0, 0, 109, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 33, 0, 0, 0, 4,
-		0, 0, 0, 16, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 7,
-		4, 0, 0, 152, 41, 0, 0, 1, 0, 0, 4, 8, 0, 0, 0, 161,
-		41, 0, 0, 153, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 8, 4, 0, 0, 171, 41, 0, 0, 1, 0, 0, 4, 16,
-		0, 0, 0, 180, 41, 0, 0, 55, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 241, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 9, 4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 10,
-		4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 164, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 12, 4, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 2, 4, 0, 0, 190, 41, 0, 0, 0, 0, 0, 8, 162,
-		1, 0, 0, 0, 0, 0, 0, 1, 0, 0, 4, 8, 0, 0, 0, 199,
-		41, 0, 0, 163, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 3, 0, 0, 0, 0, 31, 0, 0, 0, 4, 0, 0, 0, 1,
-		0, 0, 0, 203, 41, 0, 0, 2, 0, 0, 4, 24, 0, 0, 0, 255,
-		20, 0, 0, 55, 0, 0, 0, 0, 0, 0, 0, 96, 8, 0, 0, 161,
-		1, 0, 0, 128, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 3,
-		4, 0, 0, 233, 8, 0, 0, 3, 0, 0, 4, 16, 0, 0, 0, 91,
-		41, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 214, 41, 0, 0, 42,
-		0, 0, 0, 32, 0, 0, 0, 227, 41, 0, 0, 167, 1, 0, 0, 64,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 55, 4, 0, 0, 234,
-		41, 0, 0, 4, 0, 0, 4, 32, 0, 0, 0, 0, 42, 0, 0, 40,
-		1, 0, 0, 0, 0, 0, 0, 9, 42, 0, 0, 31, 0, 0, 0, 64,
-		0, 0, 0, 16, 42, 0, 0, 31, 0, 0, 0, 128, 0, 0, 0, 20,
-		42, 0, 0, 55, 1, 0, 0, 192, 0, 0, 0, 32, 42, 0, 0, 1,
-		0, 0, 4, 8, 0, 0, 0, 103, 15, 0, 0, 170, 1, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 169, 1, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 56, 4, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 253, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 11,
-		4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 57, 4, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 254, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 245, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 58,
-		4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 179, 1, 0, 0, 44,
-		42, 0, 0, 0, 0, 0, 8, 46, 4, 0, 0, 61, 42, 0, 0, 7,
-		0, 0, 4, 56, 0, 0, 0, 80, 42, 0, 0, 57, 0, 0, 0, 0,
-		0, 0, 0, 86, 42, 0, 0, 57, 0, 0, 0, 64, 0, 0, 0, 92,
-		42, 0, 0, 57, 0, 0, 0, 128, 0, 0, 0, 98, 42, 0, 0, 57,
-		0, 0, 0, 192, 0, 0, 0, 104, 42, 0, 0, 57, 0, 0, 0, 0,
-		1, 0, 0, 115, 42, 0, 0, 57, 0, 0, 0, 64, 1, 0, 0, 127,
-		42, 0, 0, 57, 0, 0, 0, 128, 1, 0, 0, 149, 42, 0, 0, 0,
-		0, 0, 8, 182, 1, 0, 0, 0, 0, 0, 0, 1, 0, 0, 4, 128,
-		0, 0, 0, 190, 24, 0, 0, 183, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 31, 0, 0, 0, 4,
-		0, 0, 0, 16, 0, 0, 0, 160, 42, 0, 0, 0, 0, 0, 8, 185,
-		1, 0, 0, 180, 42, 0, 0, 1, 0, 0, 4, 4, 0, 0, 0, 90,
-		30, 0, 0, 181, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 220, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 59,
-		4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 60, 4, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 61, 4, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 191, 1, 0, 0, 198, 42, 0, 0, 30, 0, 0, 4, 24,
-		1, 0, 0, 217, 42, 0, 0, 192, 1, 0, 0, 0, 0, 0, 0, 238,
-		18, 0, 0, 68, 0, 0, 0, 64, 0, 0, 0, 227, 28, 0, 0, 152,
-		0, 0, 0, 128, 0, 0, 0, 221, 42, 0, 0, 55, 0, 0, 0, 128,
-		1, 0, 0, 237, 42, 0, 0, 193, 1, 0, 0, 0, 2, 0, 0, 251,
-		42, 0, 0, 193, 1, 0, 0, 128, 2, 0, 0, 11, 43, 0, 0, 55,
-		0, 0, 0, 0, 3, 0, 0, 22, 43, 0, 0, 55, 0, 0, 0, 128,
-		3, 0, 0, 36, 43, 0, 0, 55, 0, 0, 0, 0, 4, 0, 0, 52,
-		43, 0, 0, 2, 0, 0, 0, 128, 4, 0, 0, 62, 43, 0, 0, 2,
-		0, 0, 0, 160, 4, 0, 0, 72, 43, 0, 0, 2, 0, 0, 0, 192,
-		4, 0, 0, 80, 43, 0, 0, 2, 0, 0, 0, 224, 4, 0, 0, 90,
-		43, 0, 0, 2, 0, 0, 0, 0, 5, 0, 0, 98, 43, 0, 0, 2,
-		0, 0, 0, 32, 5, 0, 0, 106, 43, 0, 0, 2, 0, 0, 0, 64,
-		5, 0, 0, 121, 43, 0, 0, 2, 0, 0, 0, 96, 5, 0, 0, 138,
-		43, 0, 0, 40, 0, 0, 0, 128, 5, 0, 0, 147, 43, 0, 0, 50,
-		0, 0, 0, 192, 5, 0, 0, 100, 32, 0, 0, 57, 0, 0, 0, 0,
-		6, 0, 0, 152, 43, 0, 0, 57, 0, 0, 0, 64, 6, 0, 0, 162,
-		43, 0, 0, 57, 0, 0, 0, 128, 6, 0, 0, 173, 43, 0, 0, 190,
-		1, 0, 0, 192, 6, 0, 0, 184, 43, 0, 0, 57, 0, 0, 0, 0,
-		7, 0, 0, 195, 43, 0, 0, 57, 0, 0, 0, 64, 7, 0, 0, 206,
-		43, 0, 0, 2, 0, 0, 0, 128, 7, 0, 0, 216, 43, 0, 0, 2,
-		0, 0, 0, 160, 7, 0, 0, 227, 43, 0, 0, 39, 0, 0, 0, 192,
-		7, 0, 0, 247, 21, 0, 0, 109, 0, 0, 0, 0, 8, 0, 0, 241,
-		43, 0, 0, 194, 1, 0, 0, 128, 8, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 20, 2, 0, 0, 252, 43, 0, 0, 2, 0, 0, 4, 16,
-		0, 0, 0, 14, 44, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 214,
-		37, 0, 0, 57, 0, 0, 0, 64, 0, 0, 0, 19, 44, 0, 0, 0,
-		0, 0, 8, 195, 1, 0, 0, 0, 0, 0, 0, 1, 0, 0, 4, 8,
-		0, 0, 0, 27, 44, 0, 0, 101, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 190, 1, 0, 0, 4,
-		0, 0, 0, 2, 0, 0, 0, 13, 11, 0, 0, 6, 0, 0, 4, 16,
-		1, 0, 0, 67, 21, 0, 0, 42, 0, 0, 0, 0, 0, 0, 0, 91,
-		41, 0, 0, 29, 0, 0, 0, 32, 0, 0, 0, 186, 0, 0, 0, 29,
-		0, 0, 0, 48, 0, 0, 0, 29, 44, 0, 0, 181, 1, 0, 0, 64,
-		0, 0, 0, 35, 44, 0, 0, 2, 0, 0, 0, 64, 4, 0, 0, 45,
-		44, 0, 0, 198, 1, 0, 0, 128, 4, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 5, 128, 0, 0, 0, 47, 44, 0, 0, 181, 1, 0, 0, 0,
-		0, 0, 0, 67, 44, 0, 0, 181, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 62, 4, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 31, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
-		0, 0, 0, 31, 0, 0, 0, 4, 0, 0, 0, 3, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 63, 4, 0, 0, 81, 44, 0, 0, 3,
-		0, 0, 4, 8, 4, 0, 0, 102, 44, 0, 0, 204, 1, 0, 0, 0,
-		0, 0, 0, 107, 44, 0, 0, 55, 1, 0, 0, 0, 32, 0, 0, 122,
-		44, 0, 0, 55, 1, 0, 0, 8, 32, 0, 0, 131, 44, 0, 0, 1,
-		0, 0, 4, 0, 4, 0, 0, 182, 24, 0, 0, 126, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 5, 16, 0, 0, 0, 157,
-		44, 0, 0, 40, 0, 0, 0, 0, 0, 0, 0, 251, 20, 0, 0, 109,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 149,
-		2, 0, 0, 167, 44, 0, 0, 3, 0, 0, 4, 16, 0, 0, 0, 177,
-		44, 0, 0, 208, 1, 0, 0, 0, 0, 0, 0, 9, 42, 0, 0, 9,
-		0, 0, 0, 64, 0, 0, 0, 248, 29, 0, 0, 9, 0, 0, 0, 96,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 150, 2, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 64, 4, 0, 0, 164, 12, 0, 0, 4,
-		0, 0, 4, 120, 0, 0, 0, 182, 44, 0, 0, 211, 1, 0, 0, 0,
-		0, 0, 0, 73, 30, 0, 0, 10, 0, 0, 0, 0, 3, 0, 0, 100,
-		32, 0, 0, 31, 0, 0, 0, 64, 3, 0, 0, 192, 44, 0, 0, 31,
-		0, 0, 0, 128, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
-		0, 0, 0, 31, 0, 0, 0, 4, 0, 0, 0, 12, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 210, 1, 0, 0, 4,
-		0, 0, 0, 32, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 248,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 65, 4, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 217, 1, 0, 0, 196, 44, 0, 0, 0,
-		0, 0, 8, 10, 0, 0, 0, 202, 44, 0, 0, 46, 0, 0, 4, 192,
-		16, 0, 0, 240, 20, 0, 0, 95, 0, 0, 0, 0, 0, 0, 0, 159,
-		0, 0, 0, 218, 1, 0, 0, 64, 6, 0, 0, 213, 44, 0, 0, 219,
-		1, 0, 0, 128, 6, 0, 0, 0, 0, 0, 0, 221, 1, 0, 0, 0,
-		10, 0, 0, 220, 44, 0, 0, 219, 1, 0, 0, 128, 13, 0, 0, 225,
-		44, 0, 0, 219, 1, 0, 0, 0, 17, 0, 0, 232, 44, 0, 0, 100,
-		0, 0, 0, 128, 20, 0, 0, 242, 44, 0, 0, 31, 0, 0, 0, 128,
-		21, 0, 0, 252, 44, 0, 0, 31, 0, 0, 0, 192, 21, 0, 0, 7,
-		45, 0, 0, 222, 1, 0, 0, 0, 22, 0, 0, 18, 45, 0, 0, 55,
-		1, 0, 0, 192, 25, 0, 0, 28, 45, 0, 0, 55, 1, 0, 0, 200,
-		25, 0, 0, 37, 45, 0, 0, 2, 0, 0, 0, 224, 25, 0, 0, 47,
-		45, 0, 0, 2, 0, 0, 0, 0, 26, 0, 0, 58, 45, 0, 0, 2,
-		0, 0, 0, 32, 26, 0, 0, 75, 45, 0, 0, 223, 1, 0, 0, 64,
-		26, 0, 0, 87, 45, 0, 0, 223, 1, 0, 0, 0, 28, 0, 0, 105,
-		45, 0, 0, 223, 1, 0, 0, 192, 29, 0, 0, 122, 45, 0, 0, 152,
-		0, 0, 0, 128, 31, 0, 0, 138, 45, 0, 0, 229, 1, 0, 0, 128,
-		32, 0, 0, 149, 45, 0, 0, 229, 1, 0, 0, 0, 33, 0, 0, 166,
-		45, 0, 0, 55, 0, 0, 0, 128, 33, 0, 0, 177, 45, 0, 0, 31,
-		0, 0, 0, 0, 34, 0, 0, 202, 45, 0, 0, 148, 0, 0, 0, 64,
-		34, 0, 0, 212, 45, 0, 0, 31, 0, 0, 0, 128, 34, 0, 0, 228,
-		45, 0, 0, 235, 1, 0, 0, 0, 36, 0, 0, 235, 45, 0, 0, 236,
-		1, 0, 0, 0, 36, 0, 0, 243, 45, 0, 0, 239, 1, 0, 0, 0,
-		116, 0, 0, 1, 46, 0, 0, 239, 1, 0, 0, 64, 118, 0, 0, 21,
-		46, 0, 0, 31, 0, 0, 0, 128, 120, 0, 0, 37, 46, 0, 0, 55,
-		1, 0, 0, 192, 120, 0, 0, 51, 46, 0, 0, 2, 0, 0, 0, 224,
-		120, 0, 0, 67, 46, 0, 0, 2, 0, 0, 0, 0, 121, 0, 0, 77,
-		46, 0, 0, 240, 1, 0, 0, 64, 121, 0, 0, 83, 46, 0, 0, 55,
-		0, 0, 0, 128, 121, 0, 0, 94, 46, 0, 0, 235, 1, 0, 0, 0,
-		122, 0, 0, 101, 46, 0, 0, 42, 0, 0, 0, 0, 122, 0, 0, 116,
-		46, 0, 0, 50, 0, 0, 0, 64, 122, 0, 0, 131, 46, 0, 0, 241,
-		1, 0, 0, 128, 122, 0, 0, 146, 46, 0, 0, 55, 0, 0, 0, 192,
-		122, 0, 0, 156, 46, 0, 0, 242, 1, 0, 0, 64, 123, 0, 0, 168,
-		46, 0, 0, 249, 1, 0, 0, 0, 127, 0, 0, 11, 43, 0, 0, 55,
-		0, 0, 0, 0, 132, 0, 0, 177, 46, 0, 0, 148, 0, 0, 0, 128,
-		132, 0, 0, 193, 46, 0, 0, 250, 1, 0, 0, 192, 132, 0, 0, 214,
-		46, 0, 0, 7, 2, 0, 0, 192, 133, 0, 0, 223, 46, 0, 0, 2,
-		0, 0, 4, 8, 0, 0, 0, 159, 0, 0, 0, 2, 0, 0, 0, 0,
-		0, 0, 0, 237, 46, 0, 0, 40, 0, 0, 0, 32, 0, 0, 0, 241,
-		46, 0, 0, 14, 0, 0, 4, 112, 0, 0, 0, 121, 2, 0, 0, 101,
-		0, 0, 0, 0, 0, 0, 0, 254, 46, 0, 0, 31, 0, 0, 0, 64,
-		0, 0, 0, 2, 47, 0, 0, 31, 0, 0, 0, 128, 0, 0, 0, 6,
-		47, 0, 0, 31, 0, 0, 0, 192, 0, 0, 0, 192, 44, 0, 0, 31,
-		0, 0, 0, 0, 1, 0, 0, 11, 47, 0, 0, 31, 0, 0, 0, 64,
-		1, 0, 0, 16, 47, 0, 0, 101, 0, 0, 0, 128, 1, 0, 0, 26,
-		47, 0, 0, 101, 0, 0, 0, 192, 1, 0, 0, 45, 47, 0, 0, 31,
-		0, 0, 0, 0, 2, 0, 0, 50, 47, 0, 0, 101, 0, 0, 0, 64,
-		2, 0, 0, 60, 47, 0, 0, 101, 0, 0, 0, 128, 2, 0, 0, 79,
-		47, 0, 0, 31, 0, 0, 0, 192, 2, 0, 0, 89, 47, 0, 0, 31,
-		0, 0, 0, 0, 3, 0, 0, 199, 6, 0, 0, 220, 1, 0, 0, 64,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 219, 1, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 5, 112, 0, 0, 0, 97, 47, 0, 0, 219,
-		1, 0, 0, 0, 0, 0, 0, 102, 47, 0, 0, 219, 1, 0, 0, 0,
-		0, 0, 0, 7, 45, 0, 0, 8, 0, 0, 4, 120, 0, 0, 0, 108,
-		47, 0, 0, 31, 0, 0, 0, 0, 0, 0, 0, 116, 47, 0, 0, 31,
-		0, 0, 0, 64, 0, 0, 0, 126, 47, 0, 0, 31, 0, 0, 0, 128,
-		0, 0, 0, 139, 47, 0, 0, 31, 0, 0, 0, 192, 0, 0, 0, 154,
-		47, 0, 0, 148, 0, 0, 0, 0, 1, 0, 0, 85, 0, 0, 0, 55,
-		0, 0, 0, 64, 1, 0, 0, 162, 47, 0, 0, 152, 0, 0, 0, 192,
-		1, 0, 0, 239, 21, 0, 0, 100, 0, 0, 0, 192, 2, 0, 0, 174,
-		47, 0, 0, 3, 0, 0, 4, 56, 0, 0, 0, 186, 47, 0, 0, 224,
-		1, 0, 0, 0, 0, 0, 0, 189, 47, 0, 0, 31, 0, 0, 0, 64,
-		0, 0, 0, 201, 47, 0, 0, 225, 1, 0, 0, 128, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 34, 2, 0, 0, 214, 47, 0, 0, 4,
-		0, 0, 4, 40, 0, 0, 0, 182, 21, 0, 0, 242, 0, 0, 0, 0,
-		0, 0, 0, 163, 20, 0, 0, 31, 0, 0, 0, 128, 0, 0, 0, 104,
-		20, 0, 0, 226, 1, 0, 0, 192, 0, 0, 0, 186, 0, 0, 0, 8,
-		0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 227,
-		1, 0, 0, 0, 0, 0, 0, 1, 0, 0, 13, 0, 0, 0, 0, 0,
-		0, 0, 0, 228, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 225,
-		1, 0, 0, 225, 47, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 247,
-		47, 0, 0, 230, 1, 0, 0, 0, 0, 0, 0, 255, 47, 0, 0, 230,
-		1, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 231,
-		1, 0, 0, 5, 48, 0, 0, 3, 0, 0, 4, 8, 0, 0, 0, 30,
-		48, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 248, 29, 0, 0, 10,
-		0, 0, 0, 32, 0, 0, 0, 48, 48, 0, 0, 234, 1, 0, 0, 64,
-		0, 0, 0, 56, 48, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 77,
-		48, 0, 0, 233, 1, 0, 0, 0, 0, 0, 0, 85, 48, 0, 0, 31,
-		0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 229,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 232,
-		1, 0, 0, 4, 0, 0, 0, 0, 0, 0, 0, 95, 48, 0, 0, 1,
-		0, 0, 4, 0, 0, 0, 0, 16, 36, 0, 0, 34, 0, 0, 0, 0,
-		0, 0, 0, 109, 48, 0, 0, 4, 0, 0, 4, 0, 10, 0, 0, 118,
-		20, 0, 0, 237, 1, 0, 0, 0, 0, 0, 0, 85, 0, 0, 0, 238,
-		1, 0, 0, 0, 12, 0, 0, 123, 48, 0, 0, 237, 1, 0, 0, 0,
-		40, 0, 0, 137, 48, 0, 0, 238, 1, 0, 0, 0, 52, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 30, 0, 0, 0, 4,
-		0, 0, 0, 48, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
-		0, 0, 0, 31, 0, 0, 0, 4, 0, 0, 0, 112, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 101, 0, 0, 0, 4,
-		0, 0, 0, 9, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 228,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 230, 3, 0, 0, 152,
-		48, 0, 0, 6, 0, 0, 4, 120, 0, 0, 0, 238, 18, 0, 0, 148,
-		0, 0, 0, 0, 0, 0, 0, 162, 48, 0, 0, 243, 1, 0, 0, 64,
-		0, 0, 0, 84, 22, 0, 0, 225, 1, 0, 0, 192, 1, 0, 0, 174,
-		48, 0, 0, 31, 0, 0, 0, 0, 3, 0, 0, 186, 48, 0, 0, 31,
-		0, 0, 0, 64, 3, 0, 0, 205, 48, 0, 0, 31, 0, 0, 0, 128,
-		3, 0, 0, 217, 48, 0, 0, 3, 0, 0, 4, 48, 0, 0, 0, 85,
-		0, 0, 0, 244, 1, 0, 0, 0, 0, 0, 0, 5, 22, 0, 0, 10,
-		0, 0, 0, 64, 1, 0, 0, 99, 30, 0, 0, 181, 0, 0, 0, 96,
-		1, 0, 0, 230, 48, 0, 0, 4, 0, 0, 4, 40, 0, 0, 0, 238,
-		18, 0, 0, 68, 0, 0, 0, 0, 0, 0, 0, 73, 30, 0, 0, 77,
-		0, 0, 0, 64, 0, 0, 0, 255, 20, 0, 0, 55, 0, 0, 0, 128,
-		0, 0, 0, 245, 48, 0, 0, 245, 1, 0, 0, 0, 1, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 11, 1, 0, 0, 254, 48, 0, 0, 4,
-		0, 0, 4, 40, 0, 0, 0, 13, 49, 0, 0, 57, 0, 0, 0, 0,
-		0, 0, 0, 20, 49, 0, 0, 2, 0, 0, 0, 64, 0, 0, 0, 29,
-		49, 0, 0, 57, 0, 0, 0, 128, 0, 0, 0, 38, 41, 0, 0, 247,
-		1, 0, 0, 192, 0, 0, 0, 32, 49, 0, 0, 2, 0, 0, 4, 16,
-		0, 0, 0, 46, 49, 0, 0, 42, 0, 0, 0, 0, 0, 0, 0, 50,
-		49, 0, 0, 248, 1, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 237, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
-		0, 0, 0, 246, 1, 0, 0, 4, 0, 0, 0, 4, 0, 0, 0, 56,
-		49, 0, 0, 3, 0, 0, 4, 32, 0, 0, 0, 71, 49, 0, 0, 148,
-		0, 0, 0, 0, 0, 0, 0, 88, 49, 0, 0, 55, 0, 0, 0, 64,
-		0, 0, 0, 100, 49, 0, 0, 31, 0, 0, 0, 192, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 252, 1, 0, 0, 116, 49, 0, 0, 10,
-		0, 0, 4, 56, 4, 0, 0, 136, 49, 0, 0, 253, 1, 0, 0, 0,
-		0, 0, 0, 143, 49, 0, 0, 1, 2, 0, 0, 128, 4, 0, 0, 163,
-		49, 0, 0, 2, 2, 0, 0, 192, 4, 0, 0, 176, 49, 0, 0, 4,
-		2, 0, 0, 64, 25, 0, 0, 190, 49, 0, 0, 5, 2, 0, 0, 128,
-		31, 0, 0, 195, 49, 0, 0, 6, 2, 0, 0, 0, 32, 0, 0, 209,
-		49, 0, 0, 53, 0, 0, 0, 64, 32, 0, 0, 219, 49, 0, 0, 31,
-		0, 0, 0, 0, 33, 0, 0, 235, 49, 0, 0, 55, 1, 0, 0, 64,
-		33, 0, 0, 243, 49, 0, 0, 215, 1, 0, 0, 128, 33, 0, 0, 136,
-		49, 0, 0, 8, 0, 0, 4, 144, 0, 0, 0, 249, 49, 0, 0, 254,
-		1, 0, 0, 0, 0, 0, 0, 255, 49, 0, 0, 148, 0, 0, 0, 128,
-		2, 0, 0, 8, 50, 0, 0, 31, 0, 0, 0, 192, 2, 0, 0, 18,
-		50, 0, 0, 31, 0, 0, 0, 0, 3, 0, 0, 28, 50, 0, 0, 101,
-		0, 0, 0, 64, 3, 0, 0, 44, 50, 0, 0, 255, 1, 0, 0, 128,
-		3, 0, 0, 186, 0, 0, 0, 31, 0, 0, 0, 0, 4, 0, 0, 53,
-		50, 0, 0, 0, 2, 0, 0, 64, 4, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 3, 0, 0, 0, 0, 55, 0, 0, 0, 4, 0, 0, 0, 5,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 31,
-		0, 0, 0, 4, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 231, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 238,
-		3, 0, 0, 163, 49, 0, 0, 2, 0, 0, 4, 144, 2, 0, 0, 118,
-		20, 0, 0, 3, 2, 0, 0, 0, 0, 0, 0, 123, 48, 0, 0, 3,
-		2, 0, 0, 64, 10, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
-		0, 0, 0, 30, 0, 0, 0, 4, 0, 0, 0, 41, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 31, 0, 0, 0, 4,
-		0, 0, 0, 25, 0, 0, 0, 59, 50, 0, 0, 2, 0, 0, 4, 16,
-		0, 0, 0, 83, 50, 0, 0, 215, 1, 0, 0, 0, 0, 0, 0, 195,
-		43, 0, 0, 10, 0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 239, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
-		0, 0, 0, 251, 1, 0, 0, 4, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 252, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 66, 4, 0, 0, 224, 13, 0, 0, 0, 0, 0, 4, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 67, 4, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 236, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 68, 4, 0, 0, 92, 50, 0, 0, 1, 0, 0, 4, 8,
-		0, 0, 0, 166, 24, 0, 0, 46, 0, 0, 0, 0, 0, 0, 0, 103,
-		50, 0, 0, 20, 0, 0, 132, 64, 17, 0, 0, 117, 50, 0, 0, 17,
-		2, 0, 0, 0, 0, 0, 0, 127, 50, 0, 0, 31, 0, 0, 0, 192,
-		0, 0, 0, 130, 50, 0, 0, 29, 0, 0, 0, 0, 1, 0, 0, 133,
-		50, 0, 0, 29, 0, 0, 0, 16, 1, 0, 0, 136, 50, 0, 0, 29,
-		0, 0, 0, 32, 1, 0, 0, 144, 50, 0, 0, 29, 0, 0, 0, 48,
-		1, 0, 0, 152, 50, 0, 0, 31, 0, 0, 0, 64, 1, 0, 0, 159,
-		50, 0, 0, 31, 0, 0, 0, 128, 1, 0, 0, 166, 50, 0, 0, 140,
-		3, 0, 0, 192, 1, 0, 0, 177, 50, 0, 0, 31, 0, 0, 0, 192,
-		2, 0, 0, 189, 50, 0, 0, 31, 0, 0, 0, 0, 3, 0, 0, 200,
-		50, 0, 0, 31, 0, 0, 0, 64, 3, 0, 0, 204, 50, 0, 0, 31,
-		0, 0, 0, 128, 3, 0, 0, 212, 50, 0, 0, 31, 0, 0, 0, 192,
-		3, 0, 0, 223, 50, 0, 0, 141, 3, 0, 0, 0, 4, 0, 0, 233,
-		50, 0, 0, 31, 0, 0, 0, 64, 4, 0, 0, 243, 50, 0, 0, 10,
-		0, 0, 0, 128, 4, 0, 1, 253, 50, 0, 0, 10, 0, 0, 0, 129,
-		4, 0, 1, 16, 51, 0, 0, 8, 0, 0, 0, 160, 4, 0, 0, 21,
-		51, 0, 0, 142, 3, 0, 0, 0, 6, 0, 0, 25, 51, 0, 0, 13,
-		0, 0, 132, 8, 0, 0, 0, 37, 51, 0, 0, 48, 0, 0, 0, 0,
-		0, 0, 0, 44, 51, 0, 0, 48, 0, 0, 0, 16, 0, 0, 0, 50,
-		51, 0, 0, 48, 0, 0, 0, 32, 0, 0, 8, 48, 0, 0, 0, 48,
-		0, 0, 0, 40, 0, 0, 4, 209, 24, 0, 0, 48, 0, 0, 0, 44,
-		0, 0, 1, 56, 51, 0, 0, 48, 0, 0, 0, 45, 0, 0, 2, 60,
-		51, 0, 0, 48, 0, 0, 0, 47, 0, 0, 1, 62, 51, 0, 0, 48,
-		0, 0, 0, 48, 0, 0, 4, 69, 51, 0, 0, 48, 0, 0, 0, 52,
-		0, 0, 1, 73, 51, 0, 0, 48, 0, 0, 0, 53, 0, 0, 1, 75,
-		51, 0, 0, 48, 0, 0, 0, 54, 0, 0, 1, 77, 51, 0, 0, 48,
-		0, 0, 0, 55, 0, 0, 1, 79, 51, 0, 0, 48, 0, 0, 0, 56,
-		0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 16,
-		2, 0, 0, 4, 0, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 19, 2, 0, 0, 85, 51, 0, 0, 75, 0, 0, 4, 208,
-		4, 0, 0, 96, 51, 0, 0, 55, 0, 0, 0, 0, 0, 0, 0, 108,
-		51, 0, 0, 55, 0, 0, 0, 128, 0, 0, 0, 121, 51, 0, 0, 55,
-		0, 0, 0, 0, 1, 0, 0, 161, 15, 0, 0, 53, 0, 0, 0, 128,
-		1, 0, 0, 133, 51, 0, 0, 57, 0, 0, 0, 64, 2, 0, 0, 145,
-		51, 0, 0, 55, 0, 0, 0, 128, 2, 0, 0, 159, 51, 0, 0, 242,
-		0, 0, 0, 0, 3, 0, 0, 171, 51, 0, 0, 55, 0, 0, 0, 128,
-		3, 0, 0, 184, 51, 0, 0, 2, 0, 0, 0, 0, 4, 0, 0, 196,
-		51, 0, 0, 2, 0, 0, 0, 32, 4, 0, 0, 207, 51, 0, 0, 2,
-		0, 0, 0, 64, 4, 0, 0, 223, 6, 0, 0, 18, 2, 0, 0, 128,
-		4, 0, 0, 217, 42, 0, 0, 192, 1, 0, 0, 192, 4, 0, 0, 218,
-		51, 0, 0, 39, 0, 0, 0, 0, 5, 0, 0, 118, 20, 0, 0, 48,
-		3, 0, 0, 64, 5, 0, 0, 230, 51, 0, 0, 10, 0, 0, 0, 96,
-		5, 0, 0, 73, 30, 0, 0, 49, 3, 0, 0, 128, 5, 0, 0, 243,
-		51, 0, 0, 102, 0, 0, 0, 192, 5, 0, 0, 255, 51, 0, 0, 57,
-		0, 0, 0, 0, 6, 0, 0, 18, 52, 0, 0, 57, 0, 0, 0, 64,
-		6, 0, 0, 37, 52, 0, 0, 57, 0, 0, 0, 128, 6, 0, 0, 44,
-		52, 0, 0, 51, 3, 0, 0, 192, 6, 0, 0, 49, 52, 0, 0, 48,
-		0, 0, 0, 192, 10, 0, 0, 61, 52, 0, 0, 48, 0, 0, 0, 208,
-		10, 0, 0, 76, 52, 0, 0, 48, 0, 0, 0, 224, 10, 0, 0, 86,
-		52, 0, 0, 56, 3, 0, 0, 0, 11, 0, 0, 35, 32, 0, 0, 190,
-		1, 0, 0, 0, 17, 0, 0, 138, 43, 0, 0, 101, 0, 0, 0, 64,
-		17, 0, 0, 89, 52, 0, 0, 102, 0, 0, 0, 128, 17, 0, 0, 114,
-		52, 0, 0, 102, 0, 0, 0, 192, 17, 0, 0, 139, 52, 0, 0, 152,
-		0, 0, 0, 0, 18, 0, 0, 151, 52, 0, 0, 55, 0, 0, 0, 0,
-		19, 0, 0, 199, 6, 0, 0, 18, 2, 0, 0, 128, 19, 0, 0, 162,
-		52, 0, 0, 2, 0, 0, 0, 192, 19, 0, 0, 6, 15, 0, 0, 2,
-		0, 0, 0, 224, 19, 0, 0, 168, 52, 0, 0, 55, 0, 0, 0, 0,
-		20, 0, 0, 62, 27, 0, 0, 50, 0, 0, 0, 128, 20, 0, 0, 180,
-		52, 0, 0, 152, 0, 0, 0, 192, 20, 0, 0, 191, 52, 0, 0, 42,
-		0, 0, 0, 192, 21, 0, 0, 16, 32, 0, 0, 42, 3, 0, 0, 0,
-		22, 0, 0, 202, 52, 0, 0, 55, 0, 0, 0, 64, 22, 0, 0, 211,
-		52, 0, 0, 31, 0, 0, 0, 192, 22, 0, 0, 223, 52, 0, 0, 2,
-		0, 0, 0, 0, 23, 0, 0, 50, 49, 0, 0, 237, 0, 0, 0, 64,
-		23, 0, 0, 235, 52, 0, 0, 166, 2, 0, 0, 0, 24, 0, 0, 242,
-		52, 0, 0, 10, 0, 0, 0, 64, 24, 0, 0, 1, 53, 0, 0, 10,
-		0, 0, 0, 96, 24, 0, 0, 14, 53, 0, 0, 10, 0, 0, 0, 128,
-		24, 0, 0, 30, 53, 0, 0, 10, 0, 0, 0, 160, 24, 0, 0, 46,
-		53, 0, 0, 31, 0, 0, 0, 192, 24, 0, 0, 59, 53, 0, 0, 72,
-		3, 0, 0, 0, 25, 0, 0, 71, 53, 0, 0, 109, 0, 0, 0, 0,
-		26, 0, 0, 84, 53, 0, 0, 10, 0, 0, 0, 128, 26, 0, 0, 97,
-		53, 0, 0, 42, 0, 0, 0, 160, 26, 0, 0, 109, 53, 0, 0, 77,
-		3, 0, 0, 192, 26, 0, 0, 122, 53, 0, 0, 78, 3, 0, 0, 128,
-		27, 0, 0, 141, 53, 0, 0, 31, 0, 0, 0, 192, 27, 0, 0, 158,
-		53, 0, 0, 18, 2, 0, 0, 0, 28, 0, 0, 158, 36, 0, 0, 23,
-		3, 0, 0, 64, 28, 0, 0, 247, 21, 0, 0, 109, 0, 0, 0, 128,
-		28, 0, 0, 86, 33, 0, 0, 240, 0, 0, 0, 0, 29, 0, 0, 159,
-		0, 0, 0, 57, 0, 0, 0, 64, 29, 0, 0, 168, 53, 0, 0, 102,
-		0, 0, 0, 128, 29, 0, 0, 181, 53, 0, 0, 91, 3, 0, 0, 192,
-		29, 0, 0, 187, 53, 0, 0, 93, 3, 0, 0, 0, 30, 0, 0, 204,
-		53, 0, 0, 39, 0, 0, 0, 64, 30, 0, 0, 229, 53, 0, 0, 93,
-		3, 0, 0, 128, 30, 0, 0, 251, 53, 0, 0, 110, 3, 0, 0, 192,
-		30, 0, 0, 0, 54, 0, 0, 57, 0, 0, 0, 0, 31, 0, 0, 11,
-		54, 0, 0, 110, 2, 0, 0, 64, 31, 0, 0, 227, 41, 0, 0, 117,
-		3, 0, 0, 128, 31, 0, 0, 20, 54, 0, 0, 124, 3, 0, 0, 192,
-		31, 0, 0, 31, 54, 0, 0, 139, 3, 0, 0, 128, 37, 0, 0, 71,
-		14, 0, 0, 39, 0, 0, 0, 192, 37, 0, 0, 36, 54, 0, 0, 55,
-		0, 0, 0, 0, 38, 0, 0, 217, 42, 0, 0, 39, 0, 0, 4, 40,
-		1, 0, 0, 182, 21, 0, 0, 55, 0, 0, 0, 0, 0, 0, 0, 44,
-		54, 0, 0, 21, 2, 0, 0, 128, 0, 0, 0, 51, 54, 0, 0, 22,
-		2, 0, 0, 192, 0, 0, 0, 55, 54, 0, 0, 23, 2, 0, 0, 0,
-		1, 0, 0, 67, 54, 0, 0, 23, 2, 0, 0, 64, 1, 0, 0, 50,
-		36, 0, 0, 27, 1, 0, 0, 128, 1, 0, 0, 48, 0, 0, 0, 2,
-		0, 0, 0, 192, 1, 0, 0, 79, 54, 0, 0, 2, 0, 0, 0, 224,
-		1, 0, 0, 92, 54, 0, 0, 246, 0, 0, 0, 0, 2, 0, 0, 110,
-		54, 0, 0, 12, 3, 0, 0, 64, 2, 0, 0, 126, 54, 0, 0, 42,
-		0, 0, 0, 128, 2, 0, 0, 140, 54, 0, 0, 2, 0, 0, 0, 160,
-		2, 0, 0, 152, 54, 0, 0, 2, 0, 0, 0, 192, 2, 0, 0, 172,
-		54, 0, 0, 10, 0, 0, 0, 224, 2, 0, 0, 188, 54, 0, 0, 13,
-		3, 0, 0, 0, 3, 0, 0, 199, 54, 0, 0, 13, 3, 0, 0, 64,
-		3, 0, 0, 211, 54, 0, 0, 15, 3, 0, 0, 128, 3, 0, 0, 222,
-		54, 0, 0, 17, 3, 0, 0, 192, 3, 0, 0, 235, 54, 0, 0, 17,
-		3, 0, 0, 0, 4, 0, 0, 250, 54, 0, 0, 19, 3, 0, 0, 64,
-		4, 0, 0, 254, 54, 0, 0, 21, 3, 0, 0, 128, 4, 0, 0, 242,
-		29, 0, 0, 21, 3, 0, 0, 192, 4, 0, 0, 2, 55, 0, 0, 21,
-		3, 0, 0, 0, 5, 0, 0, 175, 36, 0, 0, 23, 3, 0, 0, 64,
-		5, 0, 0, 7, 55, 0, 0, 25, 3, 0, 0, 128, 5, 0, 0, 17,
-		55, 0, 0, 27, 3, 0, 0, 192, 5, 0, 0, 28, 55, 0, 0, 13,
-		3, 0, 0, 0, 6, 0, 0, 39, 55, 0, 0, 15, 3, 0, 0, 64,
-		6, 0, 0, 49, 55, 0, 0, 29, 3, 0, 0, 128, 6, 0, 0, 60,
-		55, 0, 0, 31, 3, 0, 0, 192, 6, 0, 0, 75, 55, 0, 0, 32,
-		3, 0, 0, 0, 7, 0, 0, 89, 55, 0, 0, 34, 3, 0, 0, 64,
-		7, 0, 0, 99, 55, 0, 0, 36, 3, 0, 0, 128, 7, 0, 0, 108,
-		55, 0, 0, 38, 3, 0, 0, 192, 7, 0, 0, 121, 55, 0, 0, 44,
-		3, 0, 0, 0, 8, 0, 0, 143, 55, 0, 0, 23, 3, 0, 0, 64,
-		8, 0, 0, 161, 55, 0, 0, 15, 3, 0, 0, 128, 8, 0, 0, 178,
-		55, 0, 0, 15, 3, 0, 0, 192, 8, 0, 0, 191, 55, 0, 0, 46,
-		3, 0, 0, 0, 9, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 73,
-		2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 209, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 24, 2, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 25, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 26,
-		2, 0, 0, 204, 55, 0, 0, 5, 0, 0, 4, 40, 0, 0, 0, 50,
-		36, 0, 0, 27, 1, 0, 0, 0, 0, 0, 0, 220, 55, 0, 0, 27,
-		2, 0, 0, 64, 0, 0, 0, 231, 55, 0, 0, 47, 2, 0, 0, 128,
-		0, 0, 0, 246, 55, 0, 0, 10, 3, 0, 0, 192, 0, 0, 0, 252,
-		55, 0, 0, 11, 3, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 28, 2, 0, 0, 0, 0, 0, 0, 3, 0, 0, 13, 133,
-		1, 0, 0, 0, 0, 0, 0, 29, 2, 0, 0, 0, 0, 0, 0, 45,
-		2, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 30, 2, 0, 0, 6, 56, 0, 0, 12, 0, 0, 132, 64,
-		0, 0, 0, 50, 36, 0, 0, 27, 1, 0, 0, 0, 0, 0, 0, 182,
-		21, 0, 0, 55, 0, 0, 0, 64, 0, 0, 0, 199, 6, 0, 0, 29,
-		2, 0, 0, 192, 0, 0, 0, 14, 56, 0, 0, 31, 2, 0, 0, 0,
-		1, 0, 0, 19, 56, 0, 0, 32, 2, 0, 0, 64, 1, 0, 0, 25,
-		56, 0, 0, 224, 1, 0, 0, 128, 1, 0, 0, 28, 56, 0, 0, 44,
-		2, 0, 0, 192, 1, 0, 0, 33, 56, 0, 0, 10, 0, 0, 0, 224,
-		1, 0, 1, 51, 56, 0, 0, 10, 0, 0, 0, 225, 1, 0, 1, 66,
-		56, 0, 0, 10, 0, 0, 0, 226, 1, 0, 1, 88, 56, 0, 0, 10,
-		0, 0, 0, 227, 1, 0, 1, 113, 56, 0, 0, 10, 0, 0, 0, 228,
-		1, 0, 1, 0, 0, 0, 0, 0, 0, 0, 2, 196, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 33, 2, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 10, 203, 3, 0, 0, 129, 56, 0, 0, 13, 0, 0, 4, 128,
-		0, 0, 0, 73, 30, 0, 0, 42, 0, 0, 0, 0, 0, 0, 0, 141,
-		56, 0, 0, 42, 0, 0, 0, 32, 0, 0, 0, 199, 6, 0, 0, 224,
-		1, 0, 0, 64, 0, 0, 0, 50, 36, 0, 0, 27, 1, 0, 0, 128,
-		0, 0, 0, 16, 32, 0, 0, 53, 0, 0, 0, 192, 0, 0, 0, 86,
-		33, 0, 0, 43, 1, 0, 0, 128, 1, 0, 0, 244, 35, 0, 0, 10,
-		0, 0, 0, 192, 1, 0, 0, 0, 0, 0, 0, 35, 2, 0, 0, 0,
-		2, 0, 0, 148, 56, 0, 0, 39, 0, 0, 0, 0, 3, 0, 0, 159,
-		0, 0, 0, 57, 0, 0, 0, 64, 3, 0, 0, 186, 0, 0, 0, 29,
-		0, 0, 0, 128, 3, 0, 0, 91, 41, 0, 0, 133, 1, 0, 0, 144,
-		3, 0, 0, 153, 56, 0, 0, 43, 2, 0, 0, 192, 3, 0, 0, 0,
-		0, 0, 0, 3, 0, 0, 5, 32, 0, 0, 0, 199, 40, 0, 0, 36,
-		2, 0, 0, 0, 0, 0, 0, 159, 56, 0, 0, 38, 2, 0, 0, 0,
-		0, 0, 0, 44, 52, 0, 0, 39, 2, 0, 0, 0, 0, 0, 0, 167,
-		56, 0, 0, 4, 0, 0, 4, 32, 0, 0, 0, 183, 56, 0, 0, 31,
-		0, 0, 0, 0, 0, 0, 0, 206, 6, 0, 0, 64, 0, 0, 0, 64,
-		0, 0, 0, 122, 39, 0, 0, 37, 2, 0, 0, 128, 0, 0, 0, 191,
-		56, 0, 0, 31, 0, 0, 0, 192, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 222, 3, 0, 0, 195, 56, 0, 0, 1, 0, 0, 4, 8,
-		0, 0, 0, 215, 56, 0, 0, 224, 1, 0, 0, 0, 0, 0, 0, 225,
-		56, 0, 0, 4, 0, 0, 4, 32, 0, 0, 0, 168, 40, 0, 0, 40,
-		2, 0, 0, 0, 0, 0, 0, 242, 56, 0, 0, 42, 2, 0, 0, 64,
-		0, 0, 0, 248, 29, 0, 0, 154, 0, 0, 0, 128, 0, 0, 0, 247,
-		56, 0, 0, 224, 1, 0, 0, 192, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 41, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 224,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 225, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 226, 3, 0, 0, 28, 56, 0, 0, 1,
-		0, 0, 4, 4, 0, 0, 0, 138, 43, 0, 0, 40, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 46, 2, 0, 0, 3,
-		57, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 50, 36, 0, 0, 27,
-		1, 0, 0, 0, 0, 0, 0, 91, 41, 0, 0, 133, 1, 0, 0, 64,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 48, 2, 0, 0, 0,
-		0, 0, 0, 3, 0, 0, 13, 133, 1, 0, 0, 0, 0, 0, 0, 29,
-		2, 0, 0, 0, 0, 0, 0, 49, 2, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 50, 2, 0, 0, 13,
-		57, 0, 0, 7, 0, 0, 4, 64, 0, 0, 0, 44, 52, 0, 0, 46,
-		2, 0, 0, 0, 0, 0, 0, 248, 29, 0, 0, 31, 1, 0, 0, 128,
-		0, 0, 0, 243, 37, 0, 0, 39, 0, 0, 0, 192, 0, 0, 0, 118,
-		28, 0, 0, 51, 2, 0, 0, 0, 1, 0, 0, 175, 36, 0, 0, 6,
-		3, 0, 0, 64, 1, 0, 0, 27, 57, 0, 0, 6, 3, 0, 0, 128,
-		1, 0, 0, 74, 25, 0, 0, 8, 3, 0, 0, 192, 1, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 52, 2, 0, 0, 0, 0, 0, 0, 1,
-		0, 0, 13, 174, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 33,
-		57, 0, 0, 15, 0, 0, 4, 192, 0, 0, 0, 47, 57, 0, 0, 145,
-		0, 0, 0, 0, 0, 0, 0, 52, 57, 0, 0, 3, 3, 0, 0, 64,
-		0, 0, 0, 60, 57, 0, 0, 180, 0, 0, 0, 192, 0, 0, 0, 76,
-		57, 0, 0, 216, 1, 0, 0, 0, 2, 0, 0, 85, 57, 0, 0, 42,
-		0, 0, 0, 32, 2, 0, 0, 101, 57, 0, 0, 63, 0, 0, 0, 64,
-		2, 0, 0, 108, 57, 0, 0, 180, 0, 0, 0, 192, 2, 0, 0, 121,
-		57, 0, 0, 31, 0, 0, 0, 0, 4, 0, 0, 129, 57, 0, 0, 31,
-		0, 0, 0, 64, 4, 0, 0, 145, 57, 0, 0, 4, 3, 0, 0, 128,
-		4, 0, 0, 186, 0, 0, 0, 31, 0, 0, 0, 192, 4, 0, 0, 151,
-		57, 0, 0, 175, 0, 0, 0, 0, 5, 0, 0, 158, 57, 0, 0, 148,
-		0, 0, 0, 32, 5, 0, 0, 171, 57, 0, 0, 55, 0, 0, 0, 64,
-		5, 0, 0, 100, 28, 0, 0, 39, 0, 0, 0, 192, 5, 0, 0, 184,
-		57, 0, 0, 54, 0, 0, 4, 120, 2, 0, 0, 190, 57, 0, 0, 133,
-		1, 0, 0, 0, 0, 0, 0, 197, 57, 0, 0, 29, 0, 0, 0, 16,
-		0, 0, 0, 207, 57, 0, 0, 166, 0, 0, 0, 32, 0, 0, 0, 213,
-		57, 0, 0, 0, 1, 0, 0, 64, 0, 0, 0, 219, 57, 0, 0, 10,
-		0, 0, 0, 96, 0, 0, 0, 227, 57, 0, 0, 55, 2, 0, 0, 128,
-		0, 0, 0, 233, 57, 0, 0, 55, 2, 0, 0, 192, 0, 0, 0, 247,
-		57, 0, 0, 60, 2, 0, 0, 0, 1, 0, 0, 252, 57, 0, 0, 62,
-		2, 0, 0, 64, 1, 0, 0, 1, 58, 0, 0, 174, 0, 0, 0, 128,
-		1, 0, 0, 11, 58, 0, 0, 39, 0, 0, 0, 192, 1, 0, 0, 22,
-		58, 0, 0, 31, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 63,
-		2, 0, 0, 64, 2, 0, 0, 28, 58, 0, 0, 65, 2, 0, 0, 96,
-		2, 0, 0, 35, 58, 0, 0, 154, 0, 0, 0, 128, 2, 0, 0, 42,
-		58, 0, 0, 67, 2, 0, 0, 192, 2, 0, 0, 50, 58, 0, 0, 67,
-		2, 0, 0, 64, 3, 0, 0, 58, 58, 0, 0, 67, 2, 0, 0, 192,
-		3, 0, 0, 66, 58, 0, 0, 148, 0, 0, 0, 64, 4, 0, 0, 73,
-		58, 0, 0, 29, 0, 0, 0, 96, 4, 0, 0, 81, 58, 0, 0, 20,
-		0, 0, 0, 112, 4, 0, 0, 91, 58, 0, 0, 20, 0, 0, 0, 120,
-		4, 0, 0, 104, 58, 0, 0, 68, 2, 0, 0, 128, 4, 0, 0, 113,
-		58, 0, 0, 31, 0, 0, 0, 192, 4, 0, 0, 121, 58, 0, 0, 180,
-		0, 0, 0, 0, 5, 0, 0, 129, 58, 0, 0, 31, 0, 0, 0, 64,
-		6, 0, 0, 142, 58, 0, 0, 31, 0, 0, 0, 128, 6, 0, 0, 160,
-		58, 0, 0, 242, 0, 0, 0, 192, 6, 0, 0, 167, 58, 0, 0, 55,
-		0, 0, 0, 64, 7, 0, 0, 177, 58, 0, 0, 69, 2, 0, 0, 192,
-		7, 0, 0, 182, 58, 0, 0, 2, 0, 0, 0, 0, 8, 0, 0, 198,
-		58, 0, 0, 48, 0, 0, 0, 32, 8, 0, 0, 216, 58, 0, 0, 48,
-		0, 0, 0, 48, 8, 0, 0, 233, 58, 0, 0, 55, 0, 0, 0, 64,
-		8, 0, 0, 239, 58, 0, 0, 55, 0, 0, 0, 192, 8, 0, 0, 249,
-		58, 0, 0, 55, 0, 0, 0, 64, 9, 0, 0, 0, 0, 0, 0, 70,
-		2, 0, 0, 192, 9, 0, 0, 3, 59, 0, 0, 102, 0, 0, 0, 64,
-		10, 0, 0, 13, 59, 0, 0, 102, 0, 0, 0, 128, 10, 0, 0, 24,
-		59, 0, 0, 42, 0, 0, 0, 192, 10, 0, 0, 32, 59, 0, 0, 42,
-		0, 0, 0, 224, 10, 0, 0, 44, 59, 0, 0, 42, 0, 0, 0, 0,
-		11, 0, 0, 57, 59, 0, 0, 42, 0, 0, 0, 32, 11, 0, 0, 0,
-		0, 0, 0, 71, 2, 0, 0, 64, 11, 0, 0, 69, 59, 0, 0, 253,
-		2, 0, 0, 128, 11, 0, 0, 77, 59, 0, 0, 53, 2, 0, 0, 192,
-		11, 0, 0, 84, 59, 0, 0, 55, 0, 0, 0, 192, 17, 0, 0, 0,
-		0, 0, 0, 254, 2, 0, 0, 64, 18, 0, 0, 94, 59, 0, 0, 9,
-		0, 0, 0, 128, 18, 0, 0, 107, 59, 0, 0, 9, 0, 0, 0, 160,
-		18, 0, 0, 123, 59, 0, 0, 0, 3, 0, 0, 192, 18, 0, 0, 140,
-		59, 0, 0, 1, 3, 0, 0, 0, 19, 0, 0, 153, 59, 0, 0, 2,
-		3, 0, 0, 64, 19, 0, 0, 167, 59, 0, 0, 39, 0, 0, 0, 128,
-		19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 56, 2, 0, 0, 177,
-		59, 0, 0, 4, 0, 0, 4, 32, 0, 0, 0, 187, 59, 0, 0, 40,
-		0, 0, 0, 0, 0, 0, 0, 198, 59, 0, 0, 109, 0, 0, 0, 64,
-		0, 0, 0, 204, 59, 0, 0, 10, 0, 0, 0, 192, 0, 0, 0, 212,
-		59, 0, 0, 59, 2, 0, 0, 224, 0, 0, 0, 222, 59, 0, 0, 3,
-		0, 0, 4, 8, 0, 0, 0, 238, 59, 0, 0, 194, 0, 0, 0, 0,
-		0, 0, 0, 244, 59, 0, 0, 29, 0, 0, 0, 16, 0, 0, 0, 0,
-		0, 0, 0, 58, 2, 0, 0, 32, 0, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 5, 4, 0, 0, 0, 251, 59, 0, 0, 166, 0, 0, 0, 0,
-		0, 0, 0, 1, 60, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 57, 2, 0, 0, 4,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 61,
-		2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 197, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 195, 3, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 5, 4, 0, 0, 0, 7, 60, 0, 0, 64, 2, 0, 0, 0,
-		0, 0, 0, 15, 60, 0, 0, 10, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 10, 10, 0, 0, 0, 25, 60, 0, 0, 0,
-		0, 0, 8, 66, 2, 0, 0, 31, 60, 0, 0, 0, 0, 0, 8, 8,
-		0, 0, 0, 46, 60, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 230,
-		32, 0, 0, 17, 1, 0, 0, 0, 0, 0, 0, 237, 32, 0, 0, 30,
-		0, 0, 0, 64, 0, 0, 0, 57, 60, 0, 0, 0, 0, 0, 8, 57,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 255, 3, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 5, 16, 0, 0, 0, 66, 60, 0, 0, 120,
-		0, 0, 0, 0, 0, 0, 0, 75, 60, 0, 0, 109, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 5, 8, 0, 0, 0, 81,
-		60, 0, 0, 146, 0, 0, 0, 0, 0, 0, 0, 87, 60, 0, 0, 251,
-		2, 0, 0, 0, 0, 0, 0, 98, 60, 0, 0, 33, 0, 0, 4, 8,
-		1, 0, 0, 62, 27, 0, 0, 21, 2, 0, 0, 0, 0, 0, 0, 114,
-		60, 0, 0, 122, 2, 0, 0, 64, 0, 0, 0, 175, 36, 0, 0, 124,
-		2, 0, 0, 128, 0, 0, 0, 27, 57, 0, 0, 129, 2, 0, 0, 192,
-		0, 0, 0, 121, 60, 0, 0, 131, 2, 0, 0, 0, 1, 0, 0, 131,
-		60, 0, 0, 131, 2, 0, 0, 64, 1, 0, 0, 142, 60, 0, 0, 174,
-		2, 0, 0, 128, 1, 0, 0, 149, 60, 0, 0, 181, 2, 0, 0, 192,
-		1, 0, 0, 157, 60, 0, 0, 181, 2, 0, 0, 0, 2, 0, 0, 82,
-		32, 0, 0, 188, 2, 0, 0, 64, 2, 0, 0, 172, 60, 0, 0, 196,
-		2, 0, 0, 128, 2, 0, 0, 187, 60, 0, 0, 196, 2, 0, 0, 192,
-		2, 0, 0, 74, 25, 0, 0, 198, 2, 0, 0, 0, 3, 0, 0, 200,
-		60, 0, 0, 31, 0, 0, 0, 64, 3, 0, 0, 242, 56, 0, 0, 200,
-		2, 0, 0, 128, 3, 0, 0, 221, 60, 0, 0, 202, 2, 0, 0, 192,
-		3, 0, 0, 227, 60, 0, 0, 200, 2, 0, 0, 0, 4, 0, 0, 235,
-		60, 0, 0, 205, 2, 0, 0, 64, 4, 0, 0, 235, 52, 0, 0, 207,
-		2, 0, 0, 128, 4, 0, 0, 238, 18, 0, 0, 209, 2, 0, 0, 192,
-		4, 0, 0, 241, 60, 0, 0, 224, 2, 0, 0, 0, 5, 0, 0, 101,
-		25, 0, 0, 137, 0, 0, 0, 64, 5, 0, 0, 250, 60, 0, 0, 226,
-		2, 0, 0, 128, 5, 0, 0, 6, 61, 0, 0, 209, 2, 0, 0, 192,
-		5, 0, 0, 12, 61, 0, 0, 228, 2, 0, 0, 0, 6, 0, 0, 25,
-		61, 0, 0, 230, 2, 0, 0, 64, 6, 0, 0, 37, 61, 0, 0, 232,
-		2, 0, 0, 128, 6, 0, 0, 46, 61, 0, 0, 236, 2, 0, 0, 192,
-		6, 0, 0, 56, 61, 0, 0, 238, 2, 0, 0, 0, 7, 0, 0, 68,
-		61, 0, 0, 240, 2, 0, 0, 64, 7, 0, 0, 84, 61, 0, 0, 242,
-		2, 0, 0, 128, 7, 0, 0, 101, 61, 0, 0, 205, 2, 0, 0, 192,
-		7, 0, 0, 109, 61, 0, 0, 244, 2, 0, 0, 0, 8, 0, 0, 44,
-		54, 0, 0, 76, 0, 0, 4, 192, 3, 0, 0, 118, 20, 0, 0, 74,
-		2, 0, 0, 0, 0, 0, 0, 255, 20, 0, 0, 55, 0, 0, 0, 64,
-		0, 0, 0, 50, 36, 0, 0, 75, 2, 0, 0, 192, 0, 0, 0, 119,
-		61, 0, 0, 76, 2, 0, 0, 128, 2, 0, 0, 125, 61, 0, 0, 78,
-		2, 0, 0, 128, 5, 0, 0, 139, 61, 0, 0, 27, 1, 0, 0, 192,
-		5, 0, 0, 147, 61, 0, 0, 27, 1, 0, 0, 0, 6, 0, 0, 158,
-		61, 0, 0, 29, 2, 0, 0, 64, 6, 0, 0, 170, 61, 0, 0, 79,
-		2, 0, 0, 128, 6, 0, 0, 175, 61, 0, 0, 81, 2, 0, 0, 192,
-		6, 0, 0, 180, 61, 0, 0, 10, 0, 0, 0, 0, 7, 0, 0, 189,
-		61, 0, 0, 152, 0, 0, 0, 64, 7, 0, 0, 200, 61, 0, 0, 83,
-		2, 0, 0, 64, 8, 0, 0, 203, 61, 0, 0, 10, 0, 0, 0, 128,
-		8, 0, 0, 210, 61, 0, 0, 10, 0, 0, 0, 160, 8, 0, 0, 223,
-		61, 0, 0, 79, 2, 0, 0, 192, 8, 0, 0, 232, 61, 0, 0, 81,
-		2, 0, 0, 0, 9, 0, 0, 241, 61, 0, 0, 55, 1, 0, 0, 64,
-		9, 0, 0, 7, 62, 0, 0, 55, 1, 0, 0, 72, 9, 0, 0, 14,
-		62, 0, 0, 55, 1, 0, 0, 80, 9, 0, 0, 36, 62, 0, 0, 10,
-		0, 0, 0, 96, 9, 0, 0, 50, 62, 0, 0, 85, 2, 0, 0, 128,
-		9, 0, 0, 58, 62, 0, 0, 86, 2, 0, 0, 192, 9, 0, 0, 63,
-		62, 0, 0, 88, 2, 0, 0, 0, 10, 0, 0, 75, 62, 0, 0, 88,
-		2, 0, 0, 128, 12, 0, 0, 102, 44, 0, 0, 92, 2, 0, 0, 0,
-		15, 0, 0, 87, 62, 0, 0, 31, 0, 0, 0, 192, 15, 0, 0, 94,
-		62, 0, 0, 10, 0, 0, 0, 0, 16, 0, 0, 103, 62, 0, 0, 55,
-		0, 0, 0, 64, 16, 0, 0, 112, 62, 0, 0, 94, 2, 0, 0, 192,
-		16, 0, 0, 122, 62, 0, 0, 95, 2, 0, 0, 0, 17, 0, 0, 131,
-		62, 0, 0, 96, 2, 0, 0, 64, 17, 0, 0, 145, 62, 0, 0, 99,
-		2, 0, 0, 64, 18, 0, 0, 156, 62, 0, 0, 100, 2, 0, 0, 128,
-		18, 0, 0, 162, 0, 0, 0, 40, 1, 0, 0, 192, 18, 0, 0, 168,
-		62, 0, 0, 39, 0, 0, 0, 0, 19, 0, 0, 175, 62, 0, 0, 10,
-		0, 0, 0, 64, 19, 0, 0, 187, 62, 0, 0, 39, 0, 0, 0, 128,
-		19, 0, 0, 206, 62, 0, 0, 10, 0, 0, 0, 192, 19, 0, 0, 224,
-		62, 0, 0, 10, 0, 0, 0, 224, 19, 0, 0, 240, 62, 0, 0, 101,
-		2, 0, 0, 0, 20, 0, 0, 1, 63, 0, 0, 10, 0, 0, 0, 64,
-		20, 0, 0, 18, 63, 0, 0, 104, 2, 0, 0, 128, 20, 0, 0, 35,
-		63, 0, 0, 10, 0, 0, 0, 192, 20, 0, 0, 54, 63, 0, 0, 106,
-		2, 0, 0, 0, 21, 0, 0, 69, 63, 0, 0, 10, 0, 0, 0, 64,
-		21, 0, 0, 83, 63, 0, 0, 39, 0, 0, 0, 128, 21, 0, 0, 92,
-		63, 0, 0, 107, 2, 0, 0, 192, 21, 0, 0, 105, 63, 0, 0, 10,
-		0, 0, 0, 0, 22, 0, 0, 122, 63, 0, 0, 10, 0, 0, 0, 32,
-		22, 0, 0, 144, 63, 0, 0, 108, 2, 0, 0, 64, 22, 0, 0, 168,
-		63, 0, 0, 109, 2, 0, 0, 128, 22, 0, 0, 181, 63, 0, 0, 10,
-		0, 0, 0, 192, 22, 0, 0, 198, 63, 0, 0, 111, 2, 0, 0, 0,
-		23, 0, 0, 210, 63, 0, 0, 10, 0, 0, 0, 64, 23, 0, 0, 226,
-		63, 0, 0, 10, 0, 0, 0, 96, 23, 0, 0, 247, 63, 0, 0, 200,
-		1, 0, 0, 128, 23, 0, 0, 8, 64, 0, 0, 39, 0, 0, 0, 192,
-		23, 0, 0, 27, 64, 0, 0, 10, 0, 0, 0, 0, 24, 0, 0, 45,
-		64, 0, 0, 200, 1, 0, 0, 64, 24, 0, 0, 62, 64, 0, 0, 10,
-		0, 0, 0, 128, 24, 0, 0, 83, 64, 0, 0, 2, 0, 0, 0, 160,
-		24, 0, 0, 105, 64, 0, 0, 113, 2, 0, 0, 192, 24, 0, 0, 123,
-		64, 0, 0, 2, 0, 0, 0, 0, 25, 0, 0, 140, 64, 0, 0, 114,
-		2, 0, 0, 64, 25, 0, 0, 153, 64, 0, 0, 55, 1, 0, 0, 128,
-		25, 0, 0, 157, 64, 0, 0, 55, 1, 0, 0, 136, 25, 0, 0, 167,
-		64, 0, 0, 116, 2, 0, 0, 192, 25, 0, 0, 176, 64, 0, 0, 10,
-		0, 0, 0, 0, 26, 0, 0, 194, 64, 0, 0, 117, 2, 0, 0, 64,
-		26, 0, 0, 213, 64, 0, 0, 55, 0, 0, 0, 128, 26, 0, 0, 225,
-		64, 0, 0, 55, 0, 0, 0, 0, 27, 0, 0, 237, 64, 0, 0, 119,
-		2, 0, 0, 128, 27, 0, 0, 67, 21, 0, 0, 42, 0, 0, 0, 192,
-		27, 0, 0, 242, 64, 0, 0, 121, 2, 0, 0, 0, 28, 0, 0, 251,
-		64, 0, 0, 10, 0, 0, 0, 64, 28, 0, 0, 8, 65, 0, 0, 4,
-		0, 0, 6, 4, 0, 0, 0, 21, 65, 0, 0, 0, 0, 0, 0, 39,
-		65, 0, 0, 1, 0, 0, 0, 59, 65, 0, 0, 2, 0, 0, 0, 78,
-		65, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
-		0, 0, 0, 33, 0, 0, 0, 4, 0, 0, 0, 56, 0, 0, 0, 100,
-		65, 0, 0, 5, 0, 0, 4, 96, 0, 0, 0, 115, 65, 0, 0, 30,
-		2, 0, 0, 0, 0, 0, 0, 120, 65, 0, 0, 21, 2, 0, 0, 0,
-		2, 0, 0, 124, 65, 0, 0, 29, 2, 0, 0, 64, 2, 0, 0, 136,
-		65, 0, 0, 77, 2, 0, 0, 128, 2, 0, 0, 139, 65, 0, 0, 245,
-		0, 0, 0, 192, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 13,
-		4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 14, 4, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 80, 2, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 10, 84, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 82,
-		2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 11, 1, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 15, 4, 0, 0, 155, 65, 0, 0, 3,
-		0, 0, 4, 12, 0, 0, 0, 169, 65, 0, 0, 2, 0, 0, 0, 0,
-		0, 0, 0, 182, 65, 0, 0, 2, 0, 0, 0, 32, 0, 0, 0, 194,
-		65, 0, 0, 2, 0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 240, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 87,
-		2, 0, 0, 0, 0, 0, 0, 1, 0, 0, 13, 2, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 211, 65, 0, 0, 6, 0, 0, 4, 80,
-		0, 0, 0, 113, 20, 0, 0, 39, 0, 0, 0, 0, 0, 0, 0, 248,
-		29, 0, 0, 10, 0, 0, 0, 64, 0, 0, 0, 225, 65, 0, 0, 10,
-		0, 0, 0, 96, 0, 0, 0, 235, 65, 0, 0, 10, 0, 0, 0, 128,
-		0, 0, 0, 243, 65, 0, 0, 10, 0, 0, 0, 160, 0, 0, 0, 6,
-		66, 0, 0, 89, 2, 0, 0, 192, 0, 0, 0, 10, 66, 0, 0, 2,
-		0, 0, 4, 56, 0, 0, 0, 120, 65, 0, 0, 21, 2, 0, 0, 0,
-		0, 0, 0, 86, 20, 0, 0, 90, 2, 0, 0, 64, 0, 0, 0, 24,
-		66, 0, 0, 1, 0, 0, 4, 48, 0, 0, 0, 86, 20, 0, 0, 91,
-		2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
-		0, 0, 0, 53, 0, 0, 0, 4, 0, 0, 0, 2, 0, 0, 0, 40,
-		66, 0, 0, 3, 0, 0, 4, 24, 0, 0, 0, 58, 66, 0, 0, 10,
-		0, 0, 0, 0, 0, 0, 0, 67, 66, 0, 0, 246, 0, 0, 0, 64,
-		0, 0, 0, 81, 66, 0, 0, 93, 2, 0, 0, 128, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 16, 4, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 17, 4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 96,
-		2, 0, 0, 92, 66, 0, 0, 4, 0, 0, 4, 32, 0, 0, 0, 105,
-		66, 0, 0, 97, 2, 0, 0, 0, 0, 0, 0, 112, 66, 0, 0, 10,
-		0, 0, 0, 64, 0, 0, 0, 123, 66, 0, 0, 40, 1, 0, 0, 128,
-		0, 0, 0, 130, 66, 0, 0, 40, 1, 0, 0, 192, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 98, 2, 0, 0, 138, 66, 0, 0, 0,
-		0, 0, 8, 18, 4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 19,
-		4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 20, 4, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 102, 2, 0, 0, 148, 66, 0, 0, 0,
-		0, 0, 8, 103, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 2,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 105, 2, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 22, 4, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 23, 4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 25,
-		4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 27, 1, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 110, 2, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 111, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 112,
-		2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 36, 4, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 26, 4, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 115, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 247,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 249, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 118, 2, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 21, 4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 120,
-		2, 0, 0, 0, 0, 0, 0, 1, 0, 0, 13, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 250,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 123, 2, 0, 0, 0,
-		0, 0, 0, 3, 0, 0, 13, 154, 0, 0, 0, 0, 0, 0, 0, 139,
-		0, 0, 0, 0, 0, 0, 0, 154, 0, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 125, 2, 0, 0, 0,
-		0, 0, 0, 4, 0, 0, 13, 126, 2, 0, 0, 0, 0, 0, 0, 139,
-		0, 0, 0, 0, 0, 0, 0, 40, 1, 0, 0, 0, 0, 0, 0, 31,
-		1, 0, 0, 0, 0, 0, 0, 138, 1, 0, 0, 165, 66, 0, 0, 0,
-		0, 0, 8, 127, 2, 0, 0, 173, 66, 0, 0, 0, 0, 0, 8, 128,
-		2, 0, 0, 190, 66, 0, 0, 0, 0, 0, 8, 30, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 130, 2, 0, 0, 0, 0, 0, 0, 4,
-		0, 0, 13, 126, 2, 0, 0, 0, 0, 0, 0, 139, 0, 0, 0, 0,
-		0, 0, 0, 27, 1, 0, 0, 0, 0, 0, 0, 31, 1, 0, 0, 0,
-		0, 0, 0, 138, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 132,
-		2, 0, 0, 0, 0, 0, 0, 2, 0, 0, 13, 126, 2, 0, 0, 0,
-		0, 0, 0, 133, 2, 0, 0, 0, 0, 0, 0, 138, 2, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 134, 2, 0, 0, 206, 66, 0, 0, 7,
-		0, 0, 4, 48, 0, 0, 0, 212, 66, 0, 0, 139, 0, 0, 0, 0,
-		0, 0, 0, 220, 66, 0, 0, 154, 0, 0, 0, 64, 0, 0, 0, 227,
-		66, 0, 0, 135, 2, 0, 0, 128, 0, 0, 0, 243, 37, 0, 0, 39,
-		0, 0, 0, 192, 0, 0, 0, 239, 66, 0, 0, 2, 0, 0, 0, 0,
-		1, 0, 0, 248, 66, 0, 0, 48, 0, 0, 0, 32, 1, 0, 0, 2,
-		67, 0, 0, 137, 2, 0, 0, 64, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 136, 2, 0, 0, 0, 0, 0, 0, 2, 0, 0, 13, 0,
-		0, 0, 0, 0, 0, 0, 0, 133, 2, 0, 0, 0, 0, 0, 0, 30,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 208, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 139, 2, 0, 0, 11, 67, 0, 0, 8,
-		0, 0, 4, 40, 0, 0, 0, 20, 67, 0, 0, 20, 0, 0, 0, 0,
-		0, 0, 0, 30, 67, 0, 0, 55, 1, 0, 0, 8, 0, 0, 0, 38,
-		67, 0, 0, 55, 1, 0, 0, 16, 0, 0, 0, 50, 67, 0, 0, 55,
-		1, 0, 0, 24, 0, 0, 0, 0, 0, 0, 0, 140, 2, 0, 0, 64,
-		0, 0, 0, 73, 30, 0, 0, 31, 1, 0, 0, 128, 0, 0, 0, 0,
-		0, 0, 0, 141, 2, 0, 0, 192, 0, 0, 0, 0, 0, 0, 0, 172,
-		2, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 2, 0, 0, 5, 8,
-		0, 0, 0, 62, 67, 0, 0, 31, 1, 0, 0, 0, 0, 0, 0, 73,
-		67, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6,
-		0, 0, 5, 8, 0, 0, 0, 85, 67, 0, 0, 142, 2, 0, 0, 0,
-		0, 0, 0, 89, 67, 0, 0, 144, 2, 0, 0, 0, 0, 0, 0, 94,
-		67, 0, 0, 146, 2, 0, 0, 0, 0, 0, 0, 99, 67, 0, 0, 148,
-		2, 0, 0, 0, 0, 0, 0, 106, 67, 0, 0, 206, 1, 0, 0, 0,
-		0, 0, 0, 111, 67, 0, 0, 39, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 143, 2, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 10, 204, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 145,
-		2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 205, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 147, 2, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 10, 206, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 3,
-		3, 0, 0, 116, 67, 0, 0, 21, 0, 0, 4, 176, 0, 0, 0, 227,
-		28, 0, 0, 152, 0, 0, 0, 0, 0, 0, 0, 132, 67, 0, 0, 237,
-		0, 0, 0, 0, 1, 0, 0, 140, 67, 0, 0, 237, 0, 0, 0, 192,
-		1, 0, 0, 76, 33, 0, 0, 10, 0, 0, 0, 128, 2, 0, 0, 79,
-		19, 0, 0, 10, 0, 0, 0, 160, 2, 0, 0, 148, 67, 0, 0, 10,
-		0, 0, 0, 192, 2, 0, 0, 158, 67, 0, 0, 10, 0, 0, 0, 224,
-		2, 0, 0, 168, 67, 0, 0, 55, 1, 0, 0, 0, 3, 0, 0, 178,
-		67, 0, 0, 10, 0, 0, 0, 32, 3, 0, 0, 191, 67, 0, 0, 10,
-		0, 0, 0, 64, 3, 0, 0, 199, 67, 0, 0, 10, 0, 0, 0, 96,
-		3, 0, 0, 73, 8, 0, 0, 10, 0, 0, 0, 128, 3, 0, 0, 207,
-		67, 0, 0, 10, 0, 0, 0, 160, 3, 0, 0, 217, 67, 0, 0, 10,
-		0, 0, 0, 192, 3, 0, 0, 227, 67, 0, 0, 55, 1, 0, 0, 224,
-		3, 0, 0, 238, 67, 0, 0, 208, 1, 0, 0, 0, 4, 0, 0, 247,
-		67, 0, 0, 166, 2, 0, 0, 64, 4, 0, 0, 6, 68, 0, 0, 166,
-		2, 0, 0, 128, 4, 0, 0, 21, 68, 0, 0, 168, 2, 0, 0, 192,
-		4, 0, 0, 209, 34, 0, 0, 110, 1, 0, 0, 0, 5, 0, 0, 26,
-		68, 0, 0, 171, 2, 0, 0, 64, 5, 0, 0, 177, 44, 0, 0, 5,
-		0, 0, 4, 64, 0, 0, 0, 186, 0, 0, 0, 31, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 151, 2, 0, 0, 64, 0, 0, 0, 0,
-		0, 0, 0, 165, 2, 0, 0, 128, 1, 0, 0, 38, 68, 0, 0, 42,
-		0, 0, 0, 160, 1, 0, 0, 48, 68, 0, 0, 31, 0, 0, 0, 192,
-		1, 0, 0, 0, 0, 0, 0, 7, 0, 0, 5, 40, 0, 0, 0, 0,
-		0, 0, 0, 152, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 155,
-		2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 158, 2, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 159, 2, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 160, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 163,
-		2, 0, 0, 0, 0, 0, 0, 247, 21, 0, 0, 109, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 4, 0, 0, 4, 40, 0, 0, 0, 0,
-		0, 0, 0, 153, 2, 0, 0, 0, 0, 0, 0, 59, 68, 0, 0, 174,
-		0, 0, 0, 128, 0, 0, 0, 214, 37, 0, 0, 31, 0, 0, 0, 192,
-		0, 0, 0, 243, 37, 0, 0, 31, 0, 0, 0, 0, 1, 0, 0, 0,
-		0, 0, 0, 4, 0, 0, 5, 16, 0, 0, 0, 67, 68, 0, 0, 55,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 154, 2, 0, 0, 0,
-		0, 0, 0, 71, 68, 0, 0, 55, 0, 0, 0, 0, 0, 0, 0, 82,
-		68, 0, 0, 55, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 4, 16, 0, 0, 0, 91, 68, 0, 0, 39, 0, 0, 0, 0,
-		0, 0, 0, 100, 68, 0, 0, 10, 0, 0, 0, 64, 0, 0, 0, 0,
-		0, 0, 0, 5, 0, 0, 4, 40, 0, 0, 0, 112, 68, 0, 0, 31,
-		0, 0, 0, 0, 0, 0, 0, 121, 68, 0, 0, 156, 2, 0, 0, 64,
-		0, 0, 0, 124, 68, 0, 0, 31, 0, 0, 0, 128, 0, 0, 0, 140,
-		68, 0, 0, 31, 0, 0, 0, 192, 0, 0, 0, 0, 0, 0, 0, 157,
-		2, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 42,
-		4, 0, 0, 0, 0, 0, 0, 2, 0, 0, 5, 8, 0, 0, 0, 149,
-		68, 0, 0, 31, 0, 0, 0, 0, 0, 0, 0, 164, 68, 0, 0, 101,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6, 0, 0, 4, 24,
-		0, 0, 0, 178, 68, 0, 0, 31, 0, 0, 0, 0, 0, 0, 0, 192,
-		68, 0, 0, 22, 0, 0, 0, 64, 0, 0, 0, 206, 68, 0, 0, 22,
-		0, 0, 0, 72, 0, 0, 0, 221, 68, 0, 0, 42, 0, 0, 0, 96,
-		0, 0, 0, 239, 68, 0, 0, 42, 0, 0, 0, 128, 0, 0, 0, 1,
-		69, 0, 0, 10, 0, 0, 0, 160, 0, 0, 0, 0, 0, 0, 0, 3,
-		0, 0, 4, 32, 0, 0, 0, 13, 69, 0, 0, 31, 0, 0, 0, 0,
-		0, 0, 0, 29, 69, 0, 0, 31, 0, 0, 0, 64, 0, 0, 0, 45,
-		69, 0, 0, 55, 0, 0, 0, 128, 0, 0, 0, 0, 0, 0, 0, 5,
-		0, 0, 4, 40, 0, 0, 0, 59, 69, 0, 0, 31, 0, 0, 0, 0,
-		0, 0, 0, 69, 69, 0, 0, 161, 2, 0, 0, 64, 0, 0, 0, 82,
-		69, 0, 0, 31, 0, 0, 0, 128, 0, 0, 0, 0, 0, 0, 0, 162,
-		2, 0, 0, 192, 0, 0, 0, 92, 69, 0, 0, 148, 0, 0, 0, 0,
-		1, 0, 0, 96, 69, 0, 0, 0, 0, 0, 8, 208, 1, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 5, 8, 0, 0, 0, 106, 69, 0, 0, 133,
-		0, 0, 0, 0, 0, 0, 0, 112, 69, 0, 0, 42, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 129,
-		69, 0, 0, 164, 2, 0, 0, 0, 0, 0, 0, 135, 69, 0, 0, 39,
-		0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 43,
-		4, 0, 0, 0, 0, 0, 0, 2, 0, 0, 5, 4, 0, 0, 0, 152,
-		69, 0, 0, 42, 0, 0, 0, 0, 0, 0, 0, 162, 69, 0, 0, 10,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 167,
-		2, 0, 0, 172, 69, 0, 0, 6, 0, 0, 4, 48, 0, 0, 0, 186,
-		69, 0, 0, 157, 0, 0, 0, 0, 0, 0, 0, 194, 69, 0, 0, 2,
-		0, 0, 0, 64, 0, 0, 0, 200, 69, 0, 0, 2, 0, 0, 0, 96,
-		0, 0, 0, 206, 69, 0, 0, 166, 2, 0, 0, 128, 0, 0, 0, 214,
-		69, 0, 0, 139, 0, 0, 0, 192, 0, 0, 0, 222, 69, 0, 0, 109,
-		0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 207,
-		3, 0, 0, 229, 69, 0, 0, 9, 0, 0, 4, 144, 0, 0, 0, 241,
-		69, 0, 0, 40, 0, 0, 0, 0, 0, 0, 0, 249, 69, 0, 0, 244,
-		1, 0, 0, 64, 0, 0, 0, 7, 70, 0, 0, 31, 0, 0, 0, 128,
-		1, 0, 0, 21, 70, 0, 0, 101, 0, 0, 0, 192, 1, 0, 0, 31,
-		70, 0, 0, 242, 0, 0, 0, 0, 2, 0, 0, 67, 29, 0, 0, 166,
-		0, 0, 0, 128, 2, 0, 0, 85, 26, 0, 0, 101, 0, 0, 0, 192,
-		2, 0, 0, 44, 70, 0, 0, 42, 0, 0, 0, 0, 3, 0, 0, 55,
-		70, 0, 0, 170, 2, 0, 0, 64, 3, 0, 0, 65, 70, 0, 0, 7,
-		0, 0, 4, 40, 0, 0, 0, 238, 18, 0, 0, 68, 0, 0, 0, 0,
-		0, 0, 0, 81, 70, 0, 0, 2, 0, 0, 0, 32, 0, 0, 0, 18,
-		22, 0, 0, 2, 0, 0, 0, 64, 0, 0, 0, 90, 70, 0, 0, 2,
-		0, 0, 0, 96, 0, 0, 0, 98, 70, 0, 0, 2, 0, 0, 0, 128,
-		0, 0, 0, 105, 70, 0, 0, 31, 0, 0, 0, 192, 0, 0, 0, 186,
-		0, 0, 0, 31, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 199, 3, 0, 0, 0, 0, 0, 0, 3, 0, 0, 5, 8,
-		0, 0, 0, 111, 70, 0, 0, 31, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 173, 2, 0, 0, 0, 0, 0, 0, 119, 70, 0, 0, 154,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 4, 8,
-		0, 0, 0, 76, 33, 0, 0, 10, 0, 0, 0, 0, 0, 0, 0, 132,
-		70, 0, 0, 10, 0, 0, 0, 32, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 175, 2, 0, 0, 0, 0, 0, 0, 3, 0, 0, 13, 2,
-		0, 0, 0, 0, 0, 0, 0, 133, 2, 0, 0, 0, 0, 0, 0, 176,
-		2, 0, 0, 0, 0, 0, 0, 10, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 177, 2, 0, 0, 143, 70, 0, 0, 3, 0, 0, 4, 24,
-		0, 0, 0, 157, 70, 0, 0, 178, 2, 0, 0, 0, 0, 0, 0, 166,
-		70, 0, 0, 55, 1, 0, 0, 64, 0, 0, 0, 174, 70, 0, 0, 179,
-		2, 0, 0, 128, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 251,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 180, 2, 0, 0, 0,
-		0, 0, 0, 1, 0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 0, 176,
-		2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 182, 2, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 13, 2, 0, 0, 0, 0, 0, 0, 0, 139,
-		0, 0, 0, 0, 0, 0, 0, 183, 2, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 184, 2, 0, 0, 183, 70, 0, 0, 2, 0, 0, 4, 16,
-		0, 0, 0, 195, 70, 0, 0, 185, 2, 0, 0, 0, 0, 0, 0, 201,
-		70, 0, 0, 154, 0, 0, 0, 64, 0, 0, 0, 205, 70, 0, 0, 0,
-		0, 0, 8, 186, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 187,
-		2, 0, 0, 0, 0, 0, 0, 6, 0, 0, 13, 2, 0, 0, 0, 0,
-		0, 0, 0, 183, 2, 0, 0, 0, 0, 0, 0, 27, 1, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 154, 0, 0, 0, 0,
-		0, 0, 0, 57, 0, 0, 0, 0, 0, 0, 0, 10, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 189, 2, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 13, 190, 2, 0, 0, 0, 0, 0, 0, 139, 0, 0, 0, 0,
-		0, 0, 0, 191, 2, 0, 0, 215, 70, 0, 0, 0, 0, 0, 8, 10,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 192, 2, 0, 0, 224,
-		70, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 242, 70, 0, 0, 193,
-		2, 0, 0, 0, 0, 0, 0, 249, 70, 0, 0, 190, 2, 0, 0, 64,
-		0, 0, 0, 254, 70, 0, 0, 0, 0, 0, 8, 194, 2, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 195, 2, 0, 0, 0, 0, 0, 0, 3,
-		0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 0, 139, 0, 0, 0, 0,
-		0, 0, 0, 248, 1, 0, 0, 0, 0, 0, 0, 191, 2, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 197, 2, 0, 0, 0, 0, 0, 0, 3,
-		0, 0, 13, 30, 0, 0, 0, 0, 0, 0, 0, 139, 0, 0, 0, 0,
-		0, 0, 0, 10, 0, 0, 0, 0, 0, 0, 0, 31, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 199, 2, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 13, 2, 0, 0, 0, 0, 0, 0, 0, 139, 0, 0, 0, 0,
-		0, 0, 0, 136, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 201,
-		2, 0, 0, 0, 0, 0, 0, 2, 0, 0, 13, 2, 0, 0, 0, 0,
-		0, 0, 0, 145, 0, 0, 0, 0, 0, 0, 0, 139, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 203, 2, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 13, 2, 0, 0, 0, 0, 0, 0, 0, 139, 0, 0, 0, 0,
-		0, 0, 0, 204, 2, 0, 0, 14, 71, 0, 0, 0, 0, 0, 8, 39,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 206, 2, 0, 0, 0,
-		0, 0, 0, 4, 0, 0, 13, 2, 0, 0, 0, 0, 0, 0, 0, 139,
-		0, 0, 0, 0, 0, 0, 0, 154, 0, 0, 0, 0, 0, 0, 0, 154,
-		0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 208, 2, 0, 0, 0, 0, 0, 0, 3, 0, 0, 13, 2,
-		0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 139,
-		0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 210, 2, 0, 0, 0, 0, 0, 0, 3, 0, 0, 13, 2,
-		0, 0, 0, 0, 0, 0, 0, 139, 0, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 0, 0, 0, 0, 0, 211, 2, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 212, 2, 0, 0, 25, 71, 0, 0, 20, 0, 0, 4, 216,
-		0, 0, 0, 35, 71, 0, 0, 211, 2, 0, 0, 0, 0, 0, 0, 46,
-		71, 0, 0, 55, 0, 0, 0, 64, 0, 0, 0, 54, 71, 0, 0, 242,
-		0, 0, 0, 192, 0, 0, 0, 62, 71, 0, 0, 55, 0, 0, 0, 64,
-		1, 0, 0, 82, 71, 0, 0, 55, 0, 0, 0, 192, 1, 0, 0, 100,
-		71, 0, 0, 204, 2, 0, 0, 64, 2, 0, 0, 109, 71, 0, 0, 10,
-		0, 0, 0, 128, 2, 0, 0, 118, 71, 0, 0, 22, 0, 0, 0, 160,
-		2, 0, 0, 126, 71, 0, 0, 10, 0, 0, 0, 192, 2, 0, 0, 133,
-		71, 0, 0, 2, 0, 0, 0, 224, 2, 0, 0, 145, 71, 0, 0, 237,
-		0, 0, 0, 0, 3, 0, 0, 153, 71, 0, 0, 139, 0, 0, 0, 192,
-		3, 0, 0, 161, 71, 0, 0, 154, 0, 0, 0, 0, 4, 0, 0, 170,
-		71, 0, 0, 154, 0, 0, 0, 64, 4, 0, 0, 177, 71, 0, 0, 166,
-		2, 0, 0, 128, 4, 0, 0, 187, 71, 0, 0, 31, 0, 0, 0, 192,
-		4, 0, 0, 201, 71, 0, 0, 31, 0, 0, 0, 0, 5, 0, 0, 219,
-		71, 0, 0, 213, 2, 0, 0, 64, 5, 0, 0, 226, 71, 0, 0, 215,
-		2, 0, 0, 128, 5, 0, 0, 235, 71, 0, 0, 217, 2, 0, 0, 192,
-		5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 214, 2, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 10, 37, 4, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 216, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 38,
-		4, 0, 0, 0, 0, 0, 0, 3, 0, 0, 5, 32, 0, 0, 0, 240,
-		71, 0, 0, 218, 2, 0, 0, 0, 0, 0, 0, 247, 71, 0, 0, 221,
-		2, 0, 0, 0, 0, 0, 0, 255, 71, 0, 0, 223, 2, 0, 0, 0,
-		0, 0, 0, 3, 72, 0, 0, 3, 0, 0, 4, 32, 0, 0, 0, 118,
-		20, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 62, 27, 0, 0, 219,
-		2, 0, 0, 64, 0, 0, 0, 255, 20, 0, 0, 55, 0, 0, 0, 128,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 220, 2, 0, 0, 17,
-		72, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 31, 72, 0, 0, 1,
-		0, 0, 4, 8, 0, 0, 0, 62, 27, 0, 0, 222, 2, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 39, 4, 0, 0, 0,
-		0, 0, 0, 3, 0, 0, 4, 24, 0, 0, 0, 0, 37, 0, 0, 55,
-		0, 0, 0, 0, 0, 0, 0, 118, 20, 0, 0, 2, 0, 0, 0, 128,
-		0, 0, 0, 46, 72, 0, 0, 10, 0, 0, 0, 160, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 225, 2, 0, 0, 0, 0, 0, 0, 6,
-		0, 0, 13, 126, 2, 0, 0, 0, 0, 0, 0, 139, 0, 0, 0, 0,
-		0, 0, 0, 208, 1, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0,
-		0, 0, 0, 31, 1, 0, 0, 0, 0, 0, 0, 138, 1, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 227,
-		2, 0, 0, 0, 0, 0, 0, 1, 0, 0, 13, 2, 0, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 229,
-		2, 0, 0, 0, 0, 0, 0, 5, 0, 0, 13, 126, 2, 0, 0, 0,
-		0, 0, 0, 206, 1, 0, 0, 0, 0, 0, 0, 139, 0, 0, 0, 0,
-		0, 0, 0, 138, 1, 0, 0, 0, 0, 0, 0, 31, 1, 0, 0, 0,
-		0, 0, 0, 10, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 231,
-		2, 0, 0, 0, 0, 0, 0, 5, 0, 0, 13, 126, 2, 0, 0, 0,
-		0, 0, 0, 139, 0, 0, 0, 0, 0, 0, 0, 138, 1, 0, 0, 0,
-		0, 0, 0, 206, 1, 0, 0, 0, 0, 0, 0, 31, 1, 0, 0, 0,
-		0, 0, 0, 10, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 233,
-		2, 0, 0, 0, 0, 0, 0, 4, 0, 0, 13, 2, 0, 0, 0, 0,
-		0, 0, 0, 139, 0, 0, 0, 0, 0, 0, 0, 30, 0, 0, 0, 0,
-		0, 0, 0, 234, 2, 0, 0, 0, 0, 0, 0, 235, 2, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 211, 2, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 39, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 237,
-		2, 0, 0, 0, 0, 0, 0, 4, 0, 0, 13, 30, 0, 0, 0, 0,
-		0, 0, 0, 139, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0,
-		0, 0, 0, 154, 0, 0, 0, 0, 0, 0, 0, 154, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 239, 2, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 0, 67, 1, 0, 0, 0,
-		0, 0, 0, 139, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 241,
-		2, 0, 0, 0, 0, 0, 0, 6, 0, 0, 13, 126, 2, 0, 0, 0,
-		0, 0, 0, 139, 0, 0, 0, 0, 0, 0, 0, 154, 0, 0, 0, 0,
-		0, 0, 0, 139, 0, 0, 0, 0, 0, 0, 0, 154, 0, 0, 0, 0,
-		0, 0, 0, 31, 1, 0, 0, 0, 0, 0, 0, 10, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 243, 2, 0, 0, 0, 0, 0, 0, 6,
-		0, 0, 13, 154, 0, 0, 0, 0, 0, 0, 0, 139, 0, 0, 0, 0,
-		0, 0, 0, 154, 0, 0, 0, 0, 0, 0, 0, 139, 0, 0, 0, 0,
-		0, 0, 0, 154, 0, 0, 0, 0, 0, 0, 0, 154, 0, 0, 0, 0,
-		0, 0, 0, 10, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 245,
-		2, 0, 0, 0, 0, 0, 0, 2, 0, 0, 13, 2, 0, 0, 0, 0,
-		0, 0, 0, 246, 2, 0, 0, 0, 0, 0, 0, 10, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 247, 2, 0, 0, 55, 72, 0, 0, 6,
-		0, 0, 4, 64, 0, 0, 0, 243, 27, 0, 0, 139, 0, 0, 0, 0,
-		0, 0, 0, 68, 72, 0, 0, 43, 1, 0, 0, 64, 0, 0, 0, 72,
-		72, 0, 0, 248, 2, 0, 0, 128, 0, 0, 0, 85, 72, 0, 0, 8,
-		0, 0, 0, 192, 0, 0, 0, 92, 72, 0, 0, 8, 0, 0, 0, 224,
-		0, 0, 0, 96, 72, 0, 0, 250, 2, 0, 0, 0, 1, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 249, 2, 0, 0, 0, 0, 0, 0, 1,
-		0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 0, 246, 2, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 20, 0, 0, 0, 4,
-		0, 0, 0, 32, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 252,
-		2, 0, 0, 0, 0, 0, 0, 1, 0, 0, 13, 0, 0, 0, 0, 0,
-		0, 0, 0, 145, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 0,
-		4, 0, 0, 0, 0, 0, 0, 4, 0, 0, 5, 8, 0, 0, 0, 100,
-		72, 0, 0, 206, 1, 0, 0, 0, 0, 0, 0, 107, 72, 0, 0, 255,
-		2, 0, 0, 0, 0, 0, 0, 114, 72, 0, 0, 40, 1, 0, 0, 0,
-		0, 0, 0, 121, 72, 0, 0, 10, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 237, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 216, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 1,
-		4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 40, 4, 0, 0, 99,
-		67, 0, 0, 3, 0, 0, 4, 16, 0, 0, 0, 131, 72, 0, 0, 148,
-		0, 0, 0, 0, 0, 0, 0, 139, 72, 0, 0, 216, 1, 0, 0, 32,
-		0, 0, 0, 148, 72, 0, 0, 39, 0, 0, 0, 64, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 5, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 10, 41, 4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 7,
-		3, 0, 0, 0, 0, 0, 0, 6, 0, 0, 13, 126, 2, 0, 0, 0,
-		0, 0, 0, 139, 0, 0, 0, 0, 0, 0, 0, 29, 2, 0, 0, 0,
-		0, 0, 0, 49, 2, 0, 0, 0, 0, 0, 0, 40, 1, 0, 0, 0,
-		0, 0, 0, 154, 0, 0, 0, 0, 0, 0, 0, 31, 1, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 9, 3, 0, 0, 0, 0, 0, 0, 4,
-		0, 0, 13, 2, 0, 0, 0, 0, 0, 0, 0, 139, 0, 0, 0, 0,
-		0, 0, 0, 29, 2, 0, 0, 0, 0, 0, 0, 49, 2, 0, 0, 0,
-		0, 0, 0, 136, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 45,
-		2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 49, 2, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 29, 4, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 14, 3, 0, 0, 0, 0, 0, 0, 1, 0, 0, 13, 0,
-		0, 0, 0, 0, 0, 0, 0, 192, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 16, 3, 0, 0, 0, 0, 0, 0, 1, 0, 0, 13, 2,
-		0, 0, 0, 0, 0, 0, 0, 18, 2, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 18, 3, 0, 0, 0, 0, 0, 0, 2, 0, 0, 13, 0,
-		0, 0, 0, 0, 0, 0, 0, 18, 2, 0, 0, 0, 0, 0, 0, 133,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 20, 3, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 13, 2, 0, 0, 0, 0, 0, 0, 0, 18,
-		2, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 22, 3, 0, 0, 0, 0, 0, 0, 2, 0, 0, 13, 0,
-		0, 0, 0, 0, 0, 0, 0, 18, 2, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 24, 3, 0, 0, 0,
-		0, 0, 0, 1, 0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 0, 18,
-		2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 26, 3, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 0, 192,
-		1, 0, 0, 0, 0, 0, 0, 10, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 28, 3, 0, 0, 0, 0, 0, 0, 1, 0, 0, 13, 2,
-		0, 0, 0, 0, 0, 0, 0, 192, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 30, 3, 0, 0, 0, 0, 0, 0, 2, 0, 0, 13, 0,
-		0, 0, 0, 0, 0, 0, 0, 190, 1, 0, 0, 0, 0, 0, 0, 55,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 81, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 33, 3, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 0, 190, 1, 0, 0, 0,
-		0, 0, 0, 190, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 35,
-		3, 0, 0, 0, 0, 0, 0, 4, 0, 0, 13, 39, 0, 0, 0, 0,
-		0, 0, 0, 18, 2, 0, 0, 0, 0, 0, 0, 235, 2, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 55, 1, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 37, 3, 0, 0, 0, 0, 0, 0, 1,
-		0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 0, 39, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 39, 3, 0, 0, 0, 0, 0, 0, 3,
-		0, 0, 13, 30, 0, 0, 0, 0, 0, 0, 0, 18, 2, 0, 0, 0,
-		0, 0, 0, 40, 3, 0, 0, 0, 0, 0, 0, 31, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 41, 3, 0, 0, 156, 72, 0, 0, 7,
-		0, 0, 4, 56, 0, 0, 0, 175, 72, 0, 0, 18, 2, 0, 0, 0,
-		0, 0, 0, 16, 32, 0, 0, 42, 3, 0, 0, 64, 0, 0, 0, 181,
-		72, 0, 0, 31, 0, 0, 0, 128, 0, 0, 0, 248, 29, 0, 0, 31,
-		0, 0, 0, 192, 0, 0, 0, 188, 72, 0, 0, 57, 0, 0, 0, 0,
-		1, 0, 0, 0, 0, 0, 0, 43, 3, 0, 0, 64, 1, 0, 0, 177,
-		44, 0, 0, 2, 0, 0, 0, 128, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 69, 3, 0, 0, 0, 0, 0, 0, 2, 0, 0, 5, 8,
-		0, 0, 0, 198, 72, 0, 0, 39, 0, 0, 0, 0, 0, 0, 0, 76,
-		33, 0, 0, 31, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 45, 3, 0, 0, 0, 0, 0, 0, 1, 0, 0, 13, 2,
-		0, 0, 0, 0, 0, 0, 0, 56, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 47, 3, 0, 0, 0, 0, 0, 0, 2, 0, 0, 13, 2,
-		0, 0, 0, 0, 0, 0, 0, 18, 2, 0, 0, 0, 0, 0, 0, 57,
-		0, 0, 0, 203, 72, 0, 0, 6, 0, 0, 6, 4, 0, 0, 0, 220,
-		72, 0, 0, 252, 255, 255, 255, 242, 72, 0, 0, 253, 255, 255, 255, 8,
-		73, 0, 0, 254, 255, 255, 255, 31, 73, 0, 0, 255, 255, 255, 255, 52,
-		73, 0, 0, 0, 0, 0, 0, 78, 73, 0, 0, 1, 0, 0, 0, 102,
-		73, 0, 0, 0, 0, 0, 8, 50, 3, 0, 0, 0, 0, 0, 0, 1,
-		0, 0, 4, 8, 0, 0, 0, 27, 44, 0, 0, 194, 1, 0, 0, 0,
-		0, 0, 0, 112, 73, 0, 0, 59, 0, 0, 132, 128, 0, 0, 0, 48,
-		0, 0, 0, 9, 0, 0, 0, 0, 0, 0, 0, 248, 29, 0, 0, 9,
-		0, 0, 0, 32, 0, 0, 0, 128, 73, 0, 0, 58, 0, 0, 0, 64,
-		0, 0, 0, 0, 0, 0, 0, 52, 3, 0, 0, 128, 0, 0, 0, 135,
-		73, 0, 0, 58, 0, 0, 0, 192, 0, 0, 0, 147, 73, 0, 0, 58,
-		0, 0, 0, 0, 1, 0, 0, 159, 73, 0, 0, 58, 0, 0, 0, 64,
-		1, 0, 1, 168, 73, 0, 0, 58, 0, 0, 0, 65, 1, 0, 1, 176,
-		73, 0, 0, 58, 0, 0, 0, 66, 1, 0, 1, 183, 73, 0, 0, 58,
-		0, 0, 0, 67, 1, 0, 1, 193, 73, 0, 0, 58, 0, 0, 0, 68,
-		1, 0, 1, 206, 73, 0, 0, 58, 0, 0, 0, 69, 1, 0, 1, 221,
-		73, 0, 0, 58, 0, 0, 0, 70, 1, 0, 1, 232, 73, 0, 0, 58,
-		0, 0, 0, 71, 1, 0, 1, 74, 25, 0, 0, 58, 0, 0, 0, 72,
-		1, 0, 1, 39, 8, 0, 0, 58, 0, 0, 0, 73, 1, 0, 1, 245,
-		73, 0, 0, 58, 0, 0, 0, 74, 1, 0, 1, 250, 73, 0, 0, 58,
-		0, 0, 0, 75, 1, 0, 1, 7, 74, 0, 0, 58, 0, 0, 0, 76,
-		1, 0, 1, 147, 43, 0, 0, 58, 0, 0, 0, 77, 1, 0, 1, 79,
-		47, 0, 0, 58, 0, 0, 0, 78, 1, 0, 1, 22, 74, 0, 0, 58,
-		0, 0, 0, 79, 1, 0, 2, 33, 74, 0, 0, 58, 0, 0, 0, 81,
-		1, 0, 1, 43, 74, 0, 0, 58, 0, 0, 0, 82, 1, 0, 1, 57,
-		74, 0, 0, 58, 0, 0, 0, 83, 1, 0, 1, 70, 74, 0, 0, 58,
-		0, 0, 0, 84, 1, 0, 1, 84, 74, 0, 0, 58, 0, 0, 0, 85,
-		1, 0, 1, 109, 74, 0, 0, 58, 0, 0, 0, 86, 1, 0, 1, 132,
-		74, 0, 0, 58, 0, 0, 0, 87, 1, 0, 1, 138, 74, 0, 0, 58,
-		0, 0, 0, 88, 1, 0, 1, 148, 74, 0, 0, 58, 0, 0, 0, 89,
-		1, 0, 1, 160, 74, 0, 0, 58, 0, 0, 0, 90, 1, 0, 1, 175,
-		74, 0, 0, 58, 0, 0, 0, 91, 1, 0, 1, 190, 74, 0, 0, 58,
-		0, 0, 0, 92, 1, 0, 1, 201, 74, 0, 0, 58, 0, 0, 0, 93,
-		1, 0, 1, 209, 74, 0, 0, 58, 0, 0, 0, 94, 1, 0, 1, 219,
-		74, 0, 0, 58, 0, 0, 0, 95, 1, 0, 1, 57, 21, 0, 0, 58,
-		0, 0, 0, 96, 1, 0, 1, 230, 74, 0, 0, 58, 0, 0, 0, 97,
-		1, 0, 1, 240, 74, 0, 0, 58, 0, 0, 0, 98, 1, 0, 1, 249,
-		74, 0, 0, 58, 0, 0, 0, 99, 1, 0, 1, 8, 75, 0, 0, 58,
-		0, 0, 0, 100, 1, 0, 1, 23, 75, 0, 0, 58, 0, 0, 0, 101,
-		1, 0, 1, 31, 75, 0, 0, 58, 0, 0, 0, 102, 1, 0, 26, 0,
-		0, 0, 0, 53, 3, 0, 0, 128, 1, 0, 0, 44, 75, 0, 0, 9,
-		0, 0, 0, 160, 1, 0, 0, 0, 0, 0, 0, 54, 3, 0, 0, 192,
-		1, 0, 0, 0, 0, 0, 0, 55, 3, 0, 0, 0, 2, 0, 0, 52,
-		75, 0, 0, 58, 0, 0, 0, 64, 2, 0, 0, 71, 75, 0, 0, 58,
-		0, 0, 0, 128, 2, 0, 0, 88, 75, 0, 0, 9, 0, 0, 0, 192,
-		2, 0, 0, 112, 32, 0, 0, 12, 1, 0, 0, 224, 2, 0, 0, 106,
-		75, 0, 0, 58, 0, 0, 0, 0, 3, 0, 0, 123, 75, 0, 0, 9,
-		0, 0, 0, 64, 3, 0, 0, 137, 75, 0, 0, 49, 0, 0, 0, 96,
-		3, 0, 0, 154, 75, 0, 0, 49, 0, 0, 0, 112, 3, 0, 0, 167,
-		75, 0, 0, 9, 0, 0, 0, 128, 3, 0, 0, 183, 75, 0, 0, 9,
-		0, 0, 0, 160, 3, 0, 0, 196, 75, 0, 0, 58, 0, 0, 0, 192,
-		3, 0, 0, 0, 0, 0, 0, 2, 0, 0, 5, 8, 0, 0, 0, 205,
-		75, 0, 0, 58, 0, 0, 0, 0, 0, 0, 0, 219, 75, 0, 0, 58,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 5, 4,
-		0, 0, 0, 231, 75, 0, 0, 9, 0, 0, 0, 0, 0, 0, 0, 245,
-		75, 0, 0, 9, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 4,
-		0, 0, 5, 8, 0, 0, 0, 6, 76, 0, 0, 58, 0, 0, 0, 0,
-		0, 0, 0, 14, 76, 0, 0, 58, 0, 0, 0, 0, 0, 0, 0, 26,
-		76, 0, 0, 58, 0, 0, 0, 0, 0, 0, 0, 38, 76, 0, 0, 58,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 4, 0, 0, 5, 8,
-		0, 0, 0, 46, 76, 0, 0, 58, 0, 0, 0, 0, 0, 0, 0, 53,
-		76, 0, 0, 58, 0, 0, 0, 0, 0, 0, 0, 65, 76, 0, 0, 58,
-		0, 0, 0, 0, 0, 0, 0, 78, 76, 0, 0, 58, 0, 0, 0, 0,
-		0, 0, 0, 86, 76, 0, 0, 12, 0, 0, 4, 192, 0, 0, 0, 0,
-		0, 0, 0, 57, 3, 0, 0, 0, 0, 0, 0, 100, 76, 0, 0, 50,
-		0, 0, 0, 0, 3, 0, 0, 109, 53, 0, 0, 39, 0, 0, 0, 64,
-		3, 0, 0, 141, 53, 0, 0, 31, 0, 0, 0, 128, 3, 0, 0, 118,
-		20, 0, 0, 2, 0, 0, 0, 192, 3, 0, 0, 107, 76, 0, 0, 49,
-		3, 0, 0, 0, 4, 0, 0, 205, 75, 0, 0, 57, 0, 0, 0, 64,
-		4, 0, 0, 0, 0, 0, 0, 66, 3, 0, 0, 128, 4, 0, 0, 118,
-		76, 0, 0, 57, 0, 0, 0, 0, 5, 0, 0, 133, 76, 0, 0, 57,
-		0, 0, 0, 64, 5, 0, 0, 144, 76, 0, 0, 57, 0, 0, 0, 128,
-		5, 0, 0, 160, 76, 0, 0, 57, 0, 0, 0, 192, 5, 0, 0, 0,
-		0, 0, 0, 6, 0, 0, 5, 96, 0, 0, 0, 0, 0, 0, 0, 58,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 60, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 61, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 62, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 63,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 65, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 10, 0, 0, 4, 96, 0, 0, 0, 128,
-		73, 0, 0, 57, 0, 0, 0, 0, 0, 0, 0, 177, 76, 0, 0, 57,
-		0, 0, 0, 64, 0, 0, 0, 186, 76, 0, 0, 31, 0, 0, 0, 128,
-		0, 0, 0, 198, 76, 0, 0, 31, 0, 0, 0, 192, 0, 0, 0, 209,
-		76, 0, 0, 2, 0, 0, 0, 0, 1, 0, 0, 226, 76, 0, 0, 2,
-		0, 0, 0, 32, 1, 0, 0, 230, 76, 0, 0, 2, 0, 0, 0, 64,
-		1, 0, 0, 186, 0, 0, 0, 2, 0, 0, 0, 96, 1, 0, 0, 239,
-		76, 0, 0, 59, 3, 0, 0, 128, 1, 0, 0, 249, 76, 0, 0, 59,
-		3, 0, 0, 64, 2, 0, 0, 4, 77, 0, 0, 4, 0, 0, 4, 24,
-		0, 0, 0, 128, 73, 0, 0, 57, 0, 0, 0, 0, 0, 0, 0, 24,
-		77, 0, 0, 10, 0, 0, 0, 64, 0, 0, 0, 28, 77, 0, 0, 2,
-		0, 0, 0, 96, 0, 0, 0, 226, 76, 0, 0, 2, 0, 0, 0, 128,
-		0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 4, 64, 0, 0, 0, 78,
-		20, 0, 0, 83, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1,
-		0, 0, 4, 16, 0, 0, 0, 34, 77, 0, 0, 55, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 42,
-		77, 0, 0, 57, 0, 0, 0, 0, 0, 0, 0, 50, 77, 0, 0, 57,
-		0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 4, 40,
-		0, 0, 0, 108, 38, 0, 0, 64, 3, 0, 0, 0, 0, 0, 0, 55,
-		77, 0, 0, 55, 0, 0, 0, 192, 0, 0, 0, 63, 77, 0, 0, 4,
-		0, 0, 4, 24, 0, 0, 0, 82, 77, 0, 0, 31, 0, 0, 0, 0,
-		0, 0, 0, 90, 77, 0, 0, 31, 0, 0, 0, 64, 0, 0, 0, 16,
-		42, 0, 0, 20, 0, 0, 0, 128, 0, 0, 0, 48, 0, 0, 0, 20,
-		0, 0, 0, 136, 0, 0, 0, 0, 0, 0, 0, 5, 0, 0, 4, 24,
-		0, 0, 0, 95, 77, 0, 0, 20, 0, 0, 0, 0, 0, 0, 0, 106,
-		77, 0, 0, 20, 0, 0, 0, 8, 0, 0, 0, 117, 77, 0, 0, 48,
-		0, 0, 0, 16, 0, 0, 0, 125, 77, 0, 0, 57, 0, 0, 0, 64,
-		0, 0, 0, 130, 77, 0, 0, 57, 0, 0, 0, 128, 0, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 5, 16, 0, 0, 0, 0, 0, 0, 0, 67,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 68, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 136,
-		77, 0, 0, 57, 0, 0, 0, 0, 0, 0, 0, 148, 77, 0, 0, 49,
-		3, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 4, 16,
-		0, 0, 0, 160, 77, 0, 0, 57, 0, 0, 0, 0, 0, 0, 0, 173,
-		77, 0, 0, 57, 0, 0, 0, 64, 0, 0, 0, 185, 77, 0, 0, 33,
-		0, 0, 4, 240, 0, 0, 0, 138, 43, 0, 0, 40, 0, 0, 0, 0,
-		0, 0, 0, 247, 21, 0, 0, 109, 0, 0, 0, 64, 0, 0, 0, 197,
-		77, 0, 0, 2, 0, 0, 0, 192, 0, 0, 0, 206, 77, 0, 0, 2,
-		0, 0, 0, 224, 0, 0, 0, 216, 77, 0, 0, 2, 0, 0, 0, 0,
-		1, 0, 0, 82, 32, 0, 0, 42, 0, 0, 0, 32, 1, 0, 0, 76,
-		33, 0, 0, 194, 1, 0, 0, 64, 1, 0, 0, 223, 77, 0, 0, 10,
-		0, 0, 0, 128, 1, 0, 0, 85, 0, 0, 0, 194, 1, 0, 0, 192,
-		1, 0, 0, 181, 72, 0, 0, 194, 1, 0, 0, 0, 2, 0, 0, 228,
-		77, 0, 0, 194, 1, 0, 0, 64, 2, 0, 0, 79, 47, 0, 0, 30,
-		0, 0, 0, 128, 2, 0, 0, 123, 75, 0, 0, 30, 0, 0, 0, 192,
-		2, 0, 0, 233, 77, 0, 0, 148, 0, 0, 0, 0, 3, 0, 0, 11,
-		43, 0, 0, 55, 0, 0, 0, 64, 3, 0, 0, 191, 52, 0, 0, 42,
-		0, 0, 0, 192, 3, 0, 0, 244, 77, 0, 0, 31, 0, 0, 0, 0,
-		4, 0, 0, 0, 78, 0, 0, 110, 1, 0, 0, 64, 4, 0, 0, 10,
-		78, 0, 0, 30, 0, 0, 0, 128, 4, 0, 0, 19, 78, 0, 0, 10,
-		0, 0, 0, 192, 4, 0, 0, 28, 78, 0, 0, 30, 0, 0, 0, 0,
-		5, 0, 0, 39, 78, 0, 0, 31, 0, 0, 0, 64, 5, 0, 0, 49,
-		78, 0, 0, 2, 0, 0, 0, 128, 5, 0, 0, 62, 78, 0, 0, 2,
-		0, 0, 0, 160, 5, 0, 0, 76, 78, 0, 0, 42, 0, 0, 0, 192,
-		5, 0, 0, 91, 78, 0, 0, 31, 0, 0, 0, 0, 6, 0, 0, 99,
-		55, 0, 0, 36, 3, 0, 0, 64, 6, 0, 0, 107, 78, 0, 0, 40,
-		0, 0, 0, 128, 6, 0, 0, 120, 78, 0, 0, 2, 0, 0, 0, 160,
-		6, 0, 0, 136, 78, 0, 0, 235, 2, 0, 0, 192, 6, 0, 0, 146,
-		78, 0, 0, 39, 0, 0, 0, 0, 7, 0, 0, 155, 78, 0, 0, 70,
-		3, 0, 0, 64, 7, 0, 0, 165, 78, 0, 0, 71, 3, 0, 0, 128,
-		7, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 31, 4, 0, 0, 0,
+		0, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 202,
+		1, 0, 0, 252, 27, 0, 0, 3, 0, 0, 4, 8, 4, 0, 0, 17,
+		28, 0, 0, 241, 0, 0, 0, 0, 0, 0, 0, 22, 28, 0, 0, 200,
+		0, 0, 0, 0, 32, 0, 0, 37, 28, 0, 0, 200, 0, 0, 0, 8,
+		32, 0, 0, 46, 28, 0, 0, 1, 0, 0, 4, 0, 4, 0, 0, 158,
+		20, 0, 0, 94, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2,
+		0, 0, 5, 16, 0, 0, 0, 72, 28, 0, 0, 48, 0, 0, 0, 0,
+		0, 0, 0, 82, 28, 0, 0, 166, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 194, 1, 0, 0, 86, 28, 0, 0, 3,
+		0, 0, 4, 16, 0, 0, 0, 96, 28, 0, 0, 245, 0, 0, 0, 0,
+		0, 0, 0, 125, 25, 0, 0, 9, 0, 0, 0, 64, 0, 0, 0, 101,
+		28, 0, 0, 9, 0, 0, 0, 96, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 185, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 209,
+		1, 0, 0, 186, 12, 0, 0, 4, 0, 0, 4, 120, 0, 0, 0, 106,
+		28, 0, 0, 248, 0, 0, 0, 0, 0, 0, 0, 101, 22, 0, 0, 10,
+		0, 0, 0, 0, 3, 0, 0, 158, 22, 0, 0, 39, 0, 0, 0, 64,
+		3, 0, 0, 116, 28, 0, 0, 39, 0, 0, 0, 128, 3, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 39, 0, 0, 0, 4,
-		0, 0, 0, 0, 0, 0, 0, 176, 78, 0, 0, 3, 0, 0, 4, 32,
-		0, 0, 0, 86, 20, 0, 0, 44, 0, 0, 0, 0, 0, 0, 0, 188,
-		21, 0, 0, 73, 3, 0, 0, 128, 0, 0, 0, 185, 78, 0, 0, 76,
-		3, 0, 0, 192, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 74,
-		3, 0, 0, 0, 0, 0, 0, 1, 0, 0, 13, 0, 0, 0, 0, 0,
-		0, 0, 0, 75, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 72,
-		3, 0, 0, 193, 78, 0, 0, 1, 0, 0, 4, 8, 0, 0, 0, 147,
-		43, 0, 0, 50, 0, 0, 0, 0, 0, 0, 0, 201, 78, 0, 0, 3,
-		0, 0, 4, 24, 0, 0, 0, 255, 20, 0, 0, 55, 0, 0, 0, 0,
-		0, 0, 0, 238, 18, 0, 0, 68, 0, 0, 0, 128, 0, 0, 0, 224,
-		78, 0, 0, 10, 0, 0, 0, 160, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 32, 4, 0, 0, 240, 78, 0, 0, 12, 0, 0, 4, 136,
-		0, 0, 0, 254, 78, 0, 0, 80, 3, 0, 0, 0, 0, 0, 0, 251,
-		20, 0, 0, 109, 0, 0, 0, 192, 0, 0, 0, 2, 79, 0, 0, 10,
-		0, 0, 0, 64, 1, 0, 0, 16, 79, 0, 0, 50, 0, 0, 0, 128,
-		1, 0, 0, 29, 79, 0, 0, 31, 3, 0, 0, 192, 1, 0, 0, 10,
-		33, 0, 0, 10, 0, 0, 0, 0, 2, 0, 0, 199, 6, 0, 0, 240,
-		0, 0, 0, 64, 2, 0, 0, 40, 79, 0, 0, 90, 3, 0, 0, 128,
-		2, 0, 0, 68, 27, 0, 0, 196, 0, 0, 0, 192, 2, 0, 0, 214,
-		34, 0, 0, 144, 1, 0, 0, 0, 3, 0, 0, 46, 79, 0, 0, 2,
-		0, 0, 0, 64, 3, 0, 0, 86, 33, 0, 0, 118, 1, 0, 0, 128,
-		3, 0, 0, 254, 78, 0, 0, 3, 0, 0, 4, 24, 0, 0, 0, 53,
-		79, 0, 0, 3, 3, 0, 0, 0, 0, 0, 0, 60, 79, 0, 0, 10,
-		0, 0, 0, 128, 0, 0, 0, 69, 79, 0, 0, 10, 0, 0, 0, 160,
-		0, 0, 0, 78, 79, 0, 0, 26, 0, 0, 4, 216, 32, 0, 0, 89,
-		79, 0, 0, 82, 3, 0, 0, 0, 0, 0, 0, 186, 0, 0, 0, 83,
-		3, 0, 0, 64, 0, 0, 0, 98, 79, 0, 0, 31, 0, 0, 0, 128,
-		0, 0, 0, 248, 29, 0, 0, 10, 0, 0, 0, 192, 0, 0, 0, 110,
-		79, 0, 0, 10, 0, 0, 0, 224, 0, 0, 0, 122, 79, 0, 0, 84,
-		3, 0, 0, 0, 1, 0, 0, 9, 42, 0, 0, 10, 0, 0, 0, 64,
-		1, 0, 0, 138, 79, 0, 0, 10, 0, 0, 0, 96, 1, 0, 0, 150,
-		79, 0, 0, 10, 0, 0, 0, 128, 1, 0, 0, 168, 79, 0, 0, 85,
-		3, 0, 0, 160, 1, 0, 0, 254, 46, 0, 0, 85, 3, 0, 0, 192,
-		1, 0, 0, 171, 79, 0, 0, 216, 1, 0, 0, 224, 1, 0, 0, 138,
-		43, 0, 0, 2, 0, 0, 0, 0, 2, 0, 0, 182, 79, 0, 0, 36,
-		3, 0, 0, 64, 2, 0, 0, 187, 79, 0, 0, 10, 0, 0, 0, 128,
-		2, 0, 0, 193, 79, 0, 0, 10, 0, 0, 0, 160, 2, 0, 0, 199,
-		79, 0, 0, 10, 0, 0, 0, 192, 2, 0, 0, 50, 36, 0, 0, 27,
-		1, 0, 0, 0, 3, 0, 0, 255, 20, 0, 0, 55, 0, 0, 0, 64,
-		3, 0, 0, 115, 65, 0, 0, 30, 2, 0, 0, 192, 3, 0, 0, 212,
-		79, 0, 0, 31, 0, 0, 0, 192, 5, 0, 0, 219, 79, 0, 0, 10,
-		0, 0, 0, 0, 6, 0, 0, 244, 79, 0, 0, 86, 3, 0, 0, 64,
-		6, 0, 0, 255, 79, 0, 0, 10, 0, 0, 0, 128, 6, 0, 0, 10,
-		80, 0, 0, 10, 0, 0, 0, 160, 6, 0, 0, 86, 20, 0, 0, 89,
-		3, 0, 0, 192, 6, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 217,
-		3, 0, 0, 19, 80, 0, 0, 0, 0, 0, 8, 10, 0, 0, 0, 32,
-		80, 0, 0, 3, 0, 0, 4, 8, 0, 0, 0, 49, 80, 0, 0, 8,
-		0, 0, 0, 0, 0, 0, 0, 51, 80, 0, 0, 20, 0, 0, 0, 32,
-		0, 0, 0, 55, 80, 0, 0, 20, 0, 0, 0, 40, 0, 0, 0, 59,
-		80, 0, 0, 1, 0, 0, 4, 4, 0, 0, 0, 16, 36, 0, 0, 10,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 10,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 88, 3, 0, 0, 84,
-		80, 0, 0, 6, 0, 0, 4, 64, 0, 0, 0, 100, 80, 0, 0, 148,
-		0, 0, 0, 0, 0, 0, 0, 110, 80, 0, 0, 31, 0, 0, 0, 64,
-		0, 0, 0, 121, 80, 0, 0, 55, 0, 0, 0, 128, 0, 0, 0, 129,
-		80, 0, 0, 101, 0, 0, 0, 0, 1, 0, 0, 138, 80, 0, 0, 101,
-		0, 0, 0, 64, 1, 0, 0, 152, 80, 0, 0, 55, 0, 0, 0, 128,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 87,
-		3, 0, 0, 4, 0, 0, 0, 0, 4, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 218, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 92,
-		3, 0, 0, 0, 0, 0, 0, 1, 0, 0, 13, 57, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 157, 80, 0, 0, 0, 0, 0, 8, 94,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 95, 3, 0, 0, 0,
-		0, 0, 0, 3, 0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 0, 18,
-		2, 0, 0, 0, 0, 0, 0, 96, 3, 0, 0, 0, 0, 0, 0, 108,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 97, 3, 0, 0, 181,
-		80, 0, 0, 23, 0, 0, 4, 0, 1, 0, 0, 198, 72, 0, 0, 57,
-		0, 0, 0, 0, 0, 0, 0, 198, 80, 0, 0, 98, 3, 0, 0, 64,
-		0, 0, 0, 202, 80, 0, 0, 99, 3, 0, 0, 128, 0, 0, 0, 5,
-		22, 0, 0, 57, 0, 0, 0, 192, 0, 0, 0, 39, 16, 0, 0, 100,
-		3, 0, 0, 0, 1, 0, 0, 211, 80, 0, 0, 57, 0, 0, 0, 64,
-		1, 0, 0, 215, 80, 0, 0, 102, 3, 0, 0, 128, 1, 0, 0, 48,
-		0, 0, 0, 57, 0, 0, 0, 192, 1, 0, 0, 224, 80, 0, 0, 57,
-		0, 0, 0, 0, 2, 0, 0, 227, 80, 0, 0, 104, 3, 0, 0, 64,
-		2, 0, 0, 100, 32, 0, 0, 57, 0, 0, 0, 128, 2, 0, 0, 159,
-		0, 0, 0, 57, 0, 0, 0, 192, 2, 0, 0, 237, 80, 0, 0, 57,
-		0, 0, 0, 0, 3, 0, 0, 247, 80, 0, 0, 105, 3, 0, 0, 64,
-		3, 0, 0, 1, 81, 0, 0, 106, 3, 0, 0, 128, 3, 0, 0, 11,
-		81, 0, 0, 57, 0, 0, 0, 192, 3, 0, 0, 20, 81, 0, 0, 107,
-		3, 0, 0, 0, 4, 0, 0, 30, 81, 0, 0, 107, 3, 0, 0, 128,
-		4, 0, 0, 40, 81, 0, 0, 57, 0, 0, 0, 0, 5, 0, 0, 56,
-		81, 0, 0, 57, 0, 0, 0, 64, 5, 0, 0, 57, 21, 0, 0, 57,
-		0, 0, 0, 128, 5, 0, 0, 66, 81, 0, 0, 57, 0, 0, 0, 192,
-		5, 0, 0, 81, 81, 0, 0, 57, 0, 0, 0, 0, 6, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 33, 4, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 34, 4, 0, 0, 96, 81, 0, 0, 2, 0, 0, 5, 8,
-		0, 0, 0, 152, 80, 0, 0, 58, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 101, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3,
-		0, 0, 4, 8, 0, 0, 0, 115, 81, 0, 0, 9, 0, 0, 0, 0,
-		0, 0, 0, 123, 81, 0, 0, 49, 0, 0, 0, 32, 0, 0, 0, 130,
-		81, 0, 0, 49, 0, 0, 0, 48, 0, 0, 0, 137, 81, 0, 0, 2,
-		0, 0, 5, 8, 0, 0, 0, 53, 19, 0, 0, 58, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 103, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 11, 0, 0, 132, 8, 0, 0, 0, 155, 81, 0, 0, 58,
-		0, 0, 0, 0, 0, 0, 5, 162, 81, 0, 0, 58, 0, 0, 0, 5,
-		0, 0, 14, 170, 81, 0, 0, 58, 0, 0, 0, 19, 0, 0, 5, 180,
-		81, 0, 0, 58, 0, 0, 0, 24, 0, 0, 2, 189, 81, 0, 0, 58,
-		0, 0, 0, 26, 0, 0, 7, 198, 81, 0, 0, 58, 0, 0, 0, 33,
-		0, 0, 4, 210, 81, 0, 0, 58, 0, 0, 0, 37, 0, 0, 1, 221,
-		81, 0, 0, 58, 0, 0, 0, 38, 0, 0, 2, 232, 81, 0, 0, 58,
-		0, 0, 0, 40, 0, 0, 3, 240, 81, 0, 0, 58, 0, 0, 0, 43,
-		0, 0, 3, 249, 81, 0, 0, 58, 0, 0, 0, 46, 0, 0, 18, 0,
-		0, 0, 0, 2, 0, 0, 4, 8, 0, 0, 0, 206, 0, 0, 0, 8,
-		0, 0, 0, 0, 0, 0, 0, 2, 82, 0, 0, 8, 0, 0, 0, 32,
-		0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 4, 8, 0, 0, 0, 6,
-		15, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 6, 82, 0, 0, 8,
-		0, 0, 0, 32, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 35,
-		4, 0, 0, 15, 82, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 25,
-		82, 0, 0, 58, 0, 0, 0, 0, 0, 0, 0, 29, 82, 0, 0, 108,
-		3, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 109,
-		3, 0, 0, 34, 82, 0, 0, 21, 0, 0, 4, 168, 0, 0, 0, 42,
-		82, 0, 0, 31, 0, 0, 0, 0, 0, 0, 0, 46, 82, 0, 0, 31,
-		0, 0, 0, 64, 0, 0, 0, 50, 82, 0, 0, 31, 0, 0, 0, 128,
-		0, 0, 0, 54, 82, 0, 0, 31, 0, 0, 0, 192, 0, 0, 0, 58,
-		82, 0, 0, 31, 0, 0, 0, 0, 1, 0, 0, 61, 82, 0, 0, 31,
-		0, 0, 0, 64, 1, 0, 0, 64, 82, 0, 0, 31, 0, 0, 0, 128,
-		1, 0, 0, 68, 82, 0, 0, 31, 0, 0, 0, 192, 1, 0, 0, 72,
-		82, 0, 0, 31, 0, 0, 0, 0, 2, 0, 0, 75, 82, 0, 0, 31,
-		0, 0, 0, 64, 2, 0, 0, 78, 82, 0, 0, 31, 0, 0, 0, 128,
-		2, 0, 0, 81, 82, 0, 0, 31, 0, 0, 0, 192, 2, 0, 0, 84,
-		82, 0, 0, 31, 0, 0, 0, 0, 3, 0, 0, 87, 82, 0, 0, 31,
-		0, 0, 0, 64, 3, 0, 0, 90, 82, 0, 0, 31, 0, 0, 0, 128,
-		3, 0, 0, 93, 82, 0, 0, 31, 0, 0, 0, 192, 3, 0, 0, 224,
-		80, 0, 0, 31, 0, 0, 0, 0, 4, 0, 0, 101, 82, 0, 0, 31,
-		0, 0, 0, 64, 4, 0, 0, 186, 0, 0, 0, 31, 0, 0, 0, 128,
-		4, 0, 0, 127, 50, 0, 0, 31, 0, 0, 0, 192, 4, 0, 0, 64,
-		21, 0, 0, 31, 0, 0, 0, 0, 5, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 234, 3, 0, 0, 104, 82, 0, 0, 13, 0, 0, 4, 144,
-		0, 0, 0, 255, 20, 0, 0, 55, 0, 0, 0, 0, 0, 0, 0, 121,
-		82, 0, 0, 112, 3, 0, 0, 128, 0, 0, 0, 0, 0, 0, 0, 113,
-		3, 0, 0, 192, 0, 0, 0, 175, 72, 0, 0, 115, 3, 0, 0, 0,
-		1, 0, 0, 127, 82, 0, 0, 40, 1, 0, 0, 128, 2, 0, 0, 227,
-		41, 0, 0, 117, 3, 0, 0, 192, 2, 0, 0, 0, 0, 0, 0, 118,
-		3, 0, 0, 0, 3, 0, 0, 165, 21, 0, 0, 39, 0, 0, 0, 64,
-		3, 0, 0, 186, 0, 0, 0, 2, 0, 0, 0, 128, 3, 0, 0, 137,
-		82, 0, 0, 2, 0, 0, 0, 160, 3, 0, 0, 151, 82, 0, 0, 173,
-		0, 0, 0, 192, 3, 0, 0, 163, 82, 0, 0, 119, 3, 0, 0, 0,
-		4, 0, 0, 174, 82, 0, 0, 120, 3, 0, 0, 64, 4, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 27, 4, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 5, 8, 0, 0, 0, 50, 36, 0, 0, 40, 1, 0, 0, 0,
-		0, 0, 0, 184, 82, 0, 0, 114, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 24, 4, 0, 0, 187, 82, 0, 0, 4,
-		0, 0, 4, 48, 0, 0, 0, 86, 20, 0, 0, 242, 0, 0, 0, 0,
-		0, 0, 0, 255, 20, 0, 0, 55, 0, 0, 0, 128, 0, 0, 0, 48,
-		0, 0, 0, 2, 0, 0, 0, 0, 1, 0, 0, 199, 82, 0, 0, 116,
-		3, 0, 0, 64, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 28,
-		4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 122, 3, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 5, 8, 0, 0, 0, 44, 54, 0, 0, 39,
-		0, 0, 0, 0, 0, 0, 0, 67, 21, 0, 0, 42, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 233, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 121, 3, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 13, 2, 0, 0, 0, 0, 0, 0, 0, 110, 2, 0, 0, 0,
-		0, 0, 0, 18, 2, 0, 0, 205, 82, 0, 0, 2, 0, 0, 4, 16,
-		0, 0, 0, 251, 53, 0, 0, 123, 3, 0, 0, 0, 0, 0, 0, 218,
-		82, 0, 0, 40, 1, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 246, 3, 0, 0, 20, 54, 0, 0, 12, 0, 0, 4, 184,
-		0, 0, 0, 188, 21, 0, 0, 125, 3, 0, 0, 0, 0, 0, 0, 103,
-		15, 0, 0, 128, 3, 0, 0, 64, 0, 0, 0, 186, 0, 0, 0, 31,
-		0, 0, 0, 128, 0, 0, 0, 243, 37, 0, 0, 39, 0, 0, 0, 192,
-		0, 0, 0, 232, 82, 0, 0, 125, 3, 0, 0, 0, 1, 0, 0, 243,
-		82, 0, 0, 131, 3, 0, 0, 64, 1, 0, 0, 254, 82, 0, 0, 134,
-		3, 0, 0, 192, 2, 0, 0, 8, 83, 0, 0, 131, 3, 0, 0, 0,
-		3, 0, 0, 17, 83, 0, 0, 31, 0, 0, 0, 128, 4, 0, 0, 28,
-		83, 0, 0, 31, 0, 0, 0, 192, 4, 0, 0, 255, 20, 0, 0, 55,
-		0, 0, 0, 0, 5, 0, 0, 44, 83, 0, 0, 135, 3, 0, 0, 128,
-		5, 0, 0, 53, 83, 0, 0, 0, 0, 0, 8, 126, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 127, 3, 0, 0, 0, 0, 0, 0, 4,
-		0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 0, 31, 0, 0, 0, 0,
-		0, 0, 0, 31, 0, 0, 0, 0, 0, 0, 0, 128, 3, 0, 0, 0,
-		0, 0, 0, 129, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 124,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 130, 3, 0, 0, 67,
-		83, 0, 0, 1, 0, 0, 4, 168, 0, 0, 0, 29, 82, 0, 0, 109,
-		3, 0, 0, 0, 0, 0, 0, 79, 83, 0, 0, 3, 0, 0, 4, 48,
-		0, 0, 0, 95, 83, 0, 0, 132, 3, 0, 0, 0, 0, 0, 0, 108,
-		83, 0, 0, 132, 3, 0, 0, 64, 0, 0, 0, 120, 83, 0, 0, 152,
-		0, 0, 0, 128, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 133,
-		3, 0, 0, 131, 83, 0, 0, 5, 0, 0, 4, 48, 0, 0, 0, 143,
-		83, 0, 0, 31, 0, 0, 0, 0, 0, 0, 0, 153, 83, 0, 0, 173,
-		0, 0, 0, 64, 0, 0, 0, 73, 30, 0, 0, 31, 0, 0, 0, 128,
-		0, 0, 0, 186, 0, 0, 0, 31, 0, 0, 0, 192, 0, 0, 0, 251,
-		20, 0, 0, 109, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 131, 3, 0, 0, 161, 83, 0, 0, 0, 0, 0, 8, 136,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 137, 3, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 13, 2, 0, 0, 0, 0, 0, 0, 0, 128,
-		3, 0, 0, 0, 0, 0, 0, 138, 3, 0, 0, 179, 83, 0, 0, 3,
-		0, 0, 6, 4, 0, 0, 0, 194, 83, 0, 0, 0, 0, 0, 0, 236,
-		83, 0, 0, 1, 0, 0, 0, 22, 84, 0, 0, 2, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 30, 4, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 3, 0, 0, 0, 0, 18, 2, 0, 0, 4, 0, 0, 0, 4,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 69, 4, 0, 0, 21,
-		51, 0, 0, 7, 0, 0, 4, 128, 16, 0, 0, 230, 76, 0, 0, 10,
-		0, 0, 0, 0, 0, 0, 0, 65, 84, 0, 0, 31, 0, 0, 0, 64,
-		0, 0, 0, 82, 84, 0, 0, 143, 3, 0, 0, 128, 0, 0, 0, 90,
-		84, 0, 0, 143, 3, 0, 0, 192, 0, 0, 0, 75, 35, 0, 0, 163,
-		3, 0, 0, 0, 1, 0, 0, 105, 84, 0, 0, 163, 3, 0, 0, 128,
-		1, 0, 0, 116, 84, 0, 0, 144, 3, 0, 0, 0, 2, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 144, 3, 0, 0, 82, 84, 0, 0, 10,
-		0, 0, 132, 64, 16, 0, 0, 248, 29, 0, 0, 10, 0, 0, 0, 0,
-		0, 0, 0, 126, 84, 0, 0, 10, 0, 0, 0, 32, 0, 0, 0, 136,
-		84, 0, 0, 57, 0, 0, 0, 64, 0, 0, 0, 146, 84, 0, 0, 57,
-		0, 0, 0, 128, 0, 0, 0, 161, 84, 0, 0, 57, 0, 0, 0, 192,
-		0, 0, 0, 165, 84, 0, 0, 10, 0, 0, 0, 0, 1, 0, 1, 175,
-		84, 0, 0, 10, 0, 0, 0, 1, 1, 0, 1, 184, 84, 0, 0, 10,
-		0, 0, 0, 2, 1, 0, 1, 200, 84, 0, 0, 10, 0, 0, 0, 3,
-		1, 0, 1, 29, 82, 0, 0, 145, 3, 0, 0, 0, 2, 0, 0, 207,
-		84, 0, 0, 5, 0, 0, 5, 0, 16, 0, 0, 220, 84, 0, 0, 146,
-		3, 0, 0, 0, 0, 0, 0, 226, 84, 0, 0, 148, 3, 0, 0, 0,
-		0, 0, 0, 233, 84, 0, 0, 156, 3, 0, 0, 0, 0, 0, 0, 238,
-		84, 0, 0, 158, 3, 0, 0, 0, 0, 0, 0, 244, 84, 0, 0, 162,
-		3, 0, 0, 0, 0, 0, 0, 254, 84, 0, 0, 9, 0, 0, 4, 112,
-		0, 0, 0, 10, 85, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 14,
-		85, 0, 0, 8, 0, 0, 0, 32, 0, 0, 0, 18, 85, 0, 0, 8,
-		0, 0, 0, 64, 0, 0, 0, 22, 85, 0, 0, 8, 0, 0, 0, 96,
-		0, 0, 0, 26, 85, 0, 0, 8, 0, 0, 0, 128, 0, 0, 0, 30,
-		85, 0, 0, 8, 0, 0, 0, 160, 0, 0, 0, 34, 85, 0, 0, 8,
-		0, 0, 0, 192, 0, 0, 0, 38, 85, 0, 0, 147, 3, 0, 0, 224,
-		0, 0, 0, 255, 14, 0, 0, 8, 0, 0, 0, 96, 3, 0, 0, 0,
+		0, 0, 0, 12, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
+		0, 0, 0, 247, 0, 0, 0, 4, 0, 0, 0, 32, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 175, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 166, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 177,
+		1, 0, 0, 120, 28, 0, 0, 0, 0, 0, 8, 10, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 200, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 214, 1, 0, 0, 246, 13, 0, 0, 0, 0, 0, 4, 0,
+		0, 0, 0, 126, 28, 0, 0, 4, 0, 0, 4, 40, 0, 0, 0, 137,
+		28, 0, 0, 142, 0, 0, 0, 0, 0, 0, 0, 95, 18, 0, 0, 39,
+		0, 0, 0, 128, 0, 0, 0, 36, 18, 0, 0, 2, 1, 0, 0, 192,
+		0, 0, 0, 229, 0, 0, 0, 8, 0, 0, 0, 0, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 3, 1, 0, 0, 0, 0, 0, 0, 1,
+		0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 0, 4, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 1, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 219, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 150,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 152, 1, 0, 0, 143,
+		28, 0, 0, 1, 0, 0, 4, 8, 0, 0, 0, 142, 20, 0, 0, 54,
+		0, 0, 0, 0, 0, 0, 0, 154, 28, 0, 0, 20, 0, 0, 132, 64,
+		17, 0, 0, 168, 28, 0, 0, 11, 1, 0, 0, 0, 0, 0, 0, 178,
+		28, 0, 0, 39, 0, 0, 0, 192, 0, 0, 0, 181, 28, 0, 0, 37,
+		0, 0, 0, 0, 1, 0, 0, 184, 28, 0, 0, 37, 0, 0, 0, 16,
+		1, 0, 0, 187, 28, 0, 0, 37, 0, 0, 0, 32, 1, 0, 0, 195,
+		28, 0, 0, 37, 0, 0, 0, 48, 1, 0, 0, 203, 28, 0, 0, 39,
+		0, 0, 0, 64, 1, 0, 0, 210, 28, 0, 0, 39, 0, 0, 0, 128,
+		1, 0, 0, 217, 28, 0, 0, 87, 1, 0, 0, 192, 1, 0, 0, 228,
+		28, 0, 0, 39, 0, 0, 0, 192, 2, 0, 0, 240, 28, 0, 0, 39,
+		0, 0, 0, 0, 3, 0, 0, 251, 28, 0, 0, 39, 0, 0, 0, 64,
+		3, 0, 0, 255, 28, 0, 0, 39, 0, 0, 0, 128, 3, 0, 0, 7,
+		29, 0, 0, 39, 0, 0, 0, 192, 3, 0, 0, 18, 29, 0, 0, 88,
+		1, 0, 0, 0, 4, 0, 0, 28, 29, 0, 0, 39, 0, 0, 0, 64,
+		4, 0, 0, 38, 29, 0, 0, 10, 0, 0, 0, 128, 4, 0, 1, 48,
+		29, 0, 0, 10, 0, 0, 0, 129, 4, 0, 1, 67, 29, 0, 0, 8,
+		0, 0, 0, 160, 4, 0, 0, 72, 29, 0, 0, 89, 1, 0, 0, 0,
+		6, 0, 0, 76, 29, 0, 0, 13, 0, 0, 132, 8, 0, 0, 0, 88,
+		29, 0, 0, 56, 0, 0, 0, 0, 0, 0, 0, 95, 29, 0, 0, 56,
+		0, 0, 0, 16, 0, 0, 0, 101, 29, 0, 0, 56, 0, 0, 0, 32,
+		0, 0, 8, 48, 0, 0, 0, 56, 0, 0, 0, 40, 0, 0, 4, 185,
+		20, 0, 0, 56, 0, 0, 0, 44, 0, 0, 1, 107, 29, 0, 0, 56,
+		0, 0, 0, 45, 0, 0, 2, 111, 29, 0, 0, 56, 0, 0, 0, 47,
+		0, 0, 1, 113, 29, 0, 0, 56, 0, 0, 0, 48, 0, 0, 4, 120,
+		29, 0, 0, 56, 0, 0, 0, 52, 0, 0, 1, 124, 29, 0, 0, 56,
+		0, 0, 0, 53, 0, 0, 1, 126, 29, 0, 0, 56, 0, 0, 0, 54,
+		0, 0, 1, 128, 29, 0, 0, 56, 0, 0, 0, 55, 0, 0, 1, 130,
+		29, 0, 0, 56, 0, 0, 0, 56, 0, 0, 8, 0, 0, 0, 0, 0,
+		0, 0, 3, 0, 0, 0, 0, 10, 1, 0, 0, 4, 0, 0, 0, 3,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 13, 1, 0, 0, 136,
+		29, 0, 0, 75, 0, 0, 4, 208, 4, 0, 0, 147, 29, 0, 0, 63,
+		0, 0, 0, 0, 0, 0, 0, 159, 29, 0, 0, 63, 0, 0, 0, 128,
+		0, 0, 0, 172, 29, 0, 0, 63, 0, 0, 0, 0, 1, 0, 0, 183,
+		15, 0, 0, 61, 0, 0, 0, 128, 1, 0, 0, 184, 29, 0, 0, 29,
+		0, 0, 0, 64, 2, 0, 0, 196, 29, 0, 0, 63, 0, 0, 0, 128,
+		2, 0, 0, 210, 29, 0, 0, 142, 0, 0, 0, 0, 3, 0, 0, 222,
+		29, 0, 0, 63, 0, 0, 0, 128, 3, 0, 0, 235, 29, 0, 0, 2,
+		0, 0, 0, 0, 4, 0, 0, 247, 29, 0, 0, 2, 0, 0, 0, 32,
+		4, 0, 0, 2, 30, 0, 0, 2, 0, 0, 0, 64, 4, 0, 0, 245,
+		6, 0, 0, 12, 1, 0, 0, 128, 4, 0, 0, 172, 26, 0, 0, 230,
+		0, 0, 0, 192, 4, 0, 0, 13, 30, 0, 0, 47, 0, 0, 0, 0,
+		5, 0, 0, 50, 18, 0, 0, 14, 1, 0, 0, 64, 5, 0, 0, 25,
+		30, 0, 0, 10, 0, 0, 0, 96, 5, 0, 0, 101, 22, 0, 0, 15,
+		1, 0, 0, 128, 5, 0, 0, 38, 30, 0, 0, 116, 0, 0, 0, 192,
+		5, 0, 0, 50, 30, 0, 0, 29, 0, 0, 0, 0, 6, 0, 0, 69,
+		30, 0, 0, 29, 0, 0, 0, 64, 6, 0, 0, 88, 30, 0, 0, 29,
+		0, 0, 0, 128, 6, 0, 0, 95, 30, 0, 0, 17, 1, 0, 0, 192,
+		6, 0, 0, 100, 30, 0, 0, 56, 0, 0, 0, 192, 10, 0, 0, 112,
+		30, 0, 0, 56, 0, 0, 0, 208, 10, 0, 0, 127, 30, 0, 0, 56,
+		0, 0, 0, 224, 10, 0, 0, 137, 30, 0, 0, 23, 1, 0, 0, 0,
+		11, 0, 0, 83, 22, 0, 0, 228, 0, 0, 0, 0, 17, 0, 0, 93,
+		27, 0, 0, 115, 0, 0, 0, 64, 17, 0, 0, 140, 30, 0, 0, 116,
+		0, 0, 0, 128, 17, 0, 0, 165, 30, 0, 0, 116, 0, 0, 0, 192,
+		17, 0, 0, 190, 30, 0, 0, 226, 0, 0, 0, 0, 18, 0, 0, 202,
+		30, 0, 0, 63, 0, 0, 0, 0, 19, 0, 0, 221, 6, 0, 0, 12,
+		1, 0, 0, 128, 19, 0, 0, 213, 30, 0, 0, 2, 0, 0, 0, 192,
+		19, 0, 0, 28, 15, 0, 0, 2, 0, 0, 0, 224, 19, 0, 0, 219,
+		30, 0, 0, 63, 0, 0, 0, 0, 20, 0, 0, 101, 26, 0, 0, 58,
+		0, 0, 0, 128, 20, 0, 0, 231, 30, 0, 0, 226, 0, 0, 0, 192,
+		20, 0, 0, 242, 30, 0, 0, 50, 0, 0, 0, 192, 21, 0, 0, 39,
+		22, 0, 0, 36, 1, 0, 0, 0, 22, 0, 0, 253, 30, 0, 0, 63,
+		0, 0, 0, 64, 22, 0, 0, 6, 31, 0, 0, 39, 0, 0, 0, 192,
+		22, 0, 0, 18, 31, 0, 0, 2, 0, 0, 0, 0, 23, 0, 0, 30,
+		31, 0, 0, 37, 1, 0, 0, 64, 23, 0, 0, 36, 31, 0, 0, 39,
+		1, 0, 0, 0, 24, 0, 0, 43, 31, 0, 0, 10, 0, 0, 0, 64,
+		24, 0, 0, 58, 31, 0, 0, 10, 0, 0, 0, 96, 24, 0, 0, 71,
+		31, 0, 0, 10, 0, 0, 0, 128, 24, 0, 0, 87, 31, 0, 0, 10,
+		0, 0, 0, 160, 24, 0, 0, 103, 31, 0, 0, 39, 0, 0, 0, 192,
+		24, 0, 0, 116, 31, 0, 0, 40, 1, 0, 0, 0, 25, 0, 0, 128,
+		31, 0, 0, 166, 0, 0, 0, 0, 26, 0, 0, 141, 31, 0, 0, 10,
+		0, 0, 0, 128, 26, 0, 0, 154, 31, 0, 0, 50, 0, 0, 0, 160,
+		26, 0, 0, 166, 31, 0, 0, 45, 1, 0, 0, 192, 26, 0, 0, 179,
+		31, 0, 0, 46, 1, 0, 0, 128, 27, 0, 0, 198, 31, 0, 0, 39,
+		0, 0, 0, 192, 27, 0, 0, 215, 31, 0, 0, 12, 1, 0, 0, 0,
+		28, 0, 0, 225, 31, 0, 0, 47, 1, 0, 0, 64, 28, 0, 0, 169,
+		24, 0, 0, 166, 0, 0, 0, 128, 28, 0, 0, 233, 31, 0, 0, 49,
+		1, 0, 0, 0, 29, 0, 0, 202, 0, 0, 0, 29, 0, 0, 0, 64,
+		29, 0, 0, 236, 31, 0, 0, 116, 0, 0, 0, 128, 29, 0, 0, 249,
+		31, 0, 0, 50, 1, 0, 0, 192, 29, 0, 0, 255, 31, 0, 0, 52,
+		1, 0, 0, 0, 30, 0, 0, 16, 32, 0, 0, 47, 0, 0, 0, 64,
+		30, 0, 0, 41, 32, 0, 0, 52, 1, 0, 0, 128, 30, 0, 0, 63,
+		32, 0, 0, 68, 1, 0, 0, 192, 30, 0, 0, 68, 32, 0, 0, 29,
+		0, 0, 0, 0, 31, 0, 0, 79, 32, 0, 0, 69, 1, 0, 0, 64,
+		31, 0, 0, 87, 25, 0, 0, 70, 1, 0, 0, 128, 31, 0, 0, 88,
+		32, 0, 0, 71, 1, 0, 0, 192, 31, 0, 0, 99, 32, 0, 0, 86,
+		1, 0, 0, 128, 37, 0, 0, 93, 14, 0, 0, 47, 0, 0, 0, 192,
+		37, 0, 0, 104, 32, 0, 0, 63, 0, 0, 0, 0, 38, 0, 0, 112,
+		32, 0, 0, 6, 0, 0, 6, 4, 0, 0, 0, 129, 32, 0, 0, 252,
+		255, 255, 255, 151, 32, 0, 0, 253, 255, 255, 255, 173, 32, 0, 0, 254,
+		255, 255, 255, 196, 32, 0, 0, 255, 255, 255, 255, 217, 32, 0, 0, 0,
+		0, 0, 0, 243, 32, 0, 0, 1, 0, 0, 0, 11, 33, 0, 0, 0,
+		0, 0, 8, 16, 1, 0, 0, 0, 0, 0, 0, 1, 0, 0, 4, 8,
+		0, 0, 0, 244, 27, 0, 0, 232, 0, 0, 0, 0, 0, 0, 0, 21,
+		33, 0, 0, 59, 0, 0, 132, 128, 0, 0, 0, 48, 0, 0, 0, 9,
+		0, 0, 0, 0, 0, 0, 0, 101, 28, 0, 0, 9, 0, 0, 0, 32,
+		0, 0, 0, 37, 33, 0, 0, 30, 0, 0, 0, 64, 0, 0, 0, 0,
+		0, 0, 0, 18, 1, 0, 0, 128, 0, 0, 0, 44, 33, 0, 0, 30,
+		0, 0, 0, 192, 0, 0, 0, 56, 33, 0, 0, 30, 0, 0, 0, 0,
+		1, 0, 0, 68, 33, 0, 0, 30, 0, 0, 0, 64, 1, 0, 1, 77,
+		33, 0, 0, 30, 0, 0, 0, 65, 1, 0, 1, 85, 33, 0, 0, 30,
+		0, 0, 0, 66, 1, 0, 1, 92, 33, 0, 0, 30, 0, 0, 0, 67,
+		1, 0, 1, 102, 33, 0, 0, 30, 0, 0, 0, 68, 1, 0, 1, 115,
+		33, 0, 0, 30, 0, 0, 0, 69, 1, 0, 1, 130, 33, 0, 0, 30,
+		0, 0, 0, 70, 1, 0, 1, 141, 33, 0, 0, 30, 0, 0, 0, 71,
+		1, 0, 1, 154, 33, 0, 0, 30, 0, 0, 0, 72, 1, 0, 1, 61,
+		8, 0, 0, 30, 0, 0, 0, 73, 1, 0, 1, 159, 33, 0, 0, 30,
+		0, 0, 0, 74, 1, 0, 1, 164, 33, 0, 0, 30, 0, 0, 0, 75,
+		1, 0, 1, 177, 33, 0, 0, 30, 0, 0, 0, 76, 1, 0, 1, 102,
+		27, 0, 0, 30, 0, 0, 0, 77, 1, 0, 1, 192, 33, 0, 0, 30,
+		0, 0, 0, 78, 1, 0, 1, 202, 33, 0, 0, 30, 0, 0, 0, 79,
+		1, 0, 2, 213, 33, 0, 0, 30, 0, 0, 0, 81, 1, 0, 1, 223,
+		33, 0, 0, 30, 0, 0, 0, 82, 1, 0, 1, 237, 33, 0, 0, 30,
+		0, 0, 0, 83, 1, 0, 1, 250, 33, 0, 0, 30, 0, 0, 0, 84,
+		1, 0, 1, 8, 34, 0, 0, 30, 0, 0, 0, 85, 1, 0, 1, 33,
+		34, 0, 0, 30, 0, 0, 0, 86, 1, 0, 1, 56, 34, 0, 0, 30,
+		0, 0, 0, 87, 1, 0, 1, 62, 34, 0, 0, 30, 0, 0, 0, 88,
+		1, 0, 1, 72, 34, 0, 0, 30, 0, 0, 0, 89, 1, 0, 1, 84,
+		34, 0, 0, 30, 0, 0, 0, 90, 1, 0, 1, 99, 34, 0, 0, 30,
+		0, 0, 0, 91, 1, 0, 1, 114, 34, 0, 0, 30, 0, 0, 0, 92,
+		1, 0, 1, 125, 34, 0, 0, 30, 0, 0, 0, 93, 1, 0, 1, 133,
+		34, 0, 0, 30, 0, 0, 0, 94, 1, 0, 1, 143, 34, 0, 0, 30,
+		0, 0, 0, 95, 1, 0, 1, 154, 34, 0, 0, 30, 0, 0, 0, 96,
+		1, 0, 1, 161, 34, 0, 0, 30, 0, 0, 0, 97, 1, 0, 1, 171,
+		34, 0, 0, 30, 0, 0, 0, 98, 1, 0, 1, 180, 34, 0, 0, 30,
+		0, 0, 0, 99, 1, 0, 1, 195, 34, 0, 0, 30, 0, 0, 0, 100,
+		1, 0, 1, 210, 34, 0, 0, 30, 0, 0, 0, 101, 1, 0, 1, 218,
+		34, 0, 0, 30, 0, 0, 0, 102, 1, 0, 26, 0, 0, 0, 0, 19,
+		1, 0, 0, 128, 1, 0, 0, 231, 34, 0, 0, 9, 0, 0, 0, 160,
+		1, 0, 0, 0, 0, 0, 0, 20, 1, 0, 0, 192, 1, 0, 0, 0,
+		0, 0, 0, 21, 1, 0, 0, 0, 2, 0, 0, 239, 34, 0, 0, 30,
+		0, 0, 0, 64, 2, 0, 0, 2, 35, 0, 0, 30, 0, 0, 0, 128,
+		2, 0, 0, 19, 35, 0, 0, 9, 0, 0, 0, 192, 2, 0, 0, 170,
+		22, 0, 0, 22, 1, 0, 0, 224, 2, 0, 0, 37, 35, 0, 0, 30,
+		0, 0, 0, 0, 3, 0, 0, 54, 35, 0, 0, 9, 0, 0, 0, 64,
+		3, 0, 0, 68, 35, 0, 0, 57, 0, 0, 0, 96, 3, 0, 0, 85,
+		35, 0, 0, 57, 0, 0, 0, 112, 3, 0, 0, 98, 35, 0, 0, 9,
+		0, 0, 0, 128, 3, 0, 0, 114, 35, 0, 0, 9, 0, 0, 0, 160,
+		3, 0, 0, 127, 35, 0, 0, 30, 0, 0, 0, 192, 3, 0, 0, 0,
+		0, 0, 0, 2, 0, 0, 5, 8, 0, 0, 0, 136, 35, 0, 0, 30,
+		0, 0, 0, 0, 0, 0, 0, 150, 35, 0, 0, 30, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 5, 4, 0, 0, 0, 162,
+		35, 0, 0, 9, 0, 0, 0, 0, 0, 0, 0, 176, 35, 0, 0, 9,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 4, 0, 0, 5, 8,
+		0, 0, 0, 193, 35, 0, 0, 30, 0, 0, 0, 0, 0, 0, 0, 201,
+		35, 0, 0, 30, 0, 0, 0, 0, 0, 0, 0, 213, 35, 0, 0, 30,
+		0, 0, 0, 0, 0, 0, 0, 225, 35, 0, 0, 30, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 4, 0, 0, 5, 8, 0, 0, 0, 233,
+		35, 0, 0, 30, 0, 0, 0, 0, 0, 0, 0, 240, 35, 0, 0, 30,
+		0, 0, 0, 0, 0, 0, 0, 252, 35, 0, 0, 30, 0, 0, 0, 0,
+		0, 0, 0, 9, 36, 0, 0, 30, 0, 0, 0, 0, 0, 0, 0, 17,
+		36, 0, 0, 0, 0, 0, 8, 2, 0, 0, 0, 23, 36, 0, 0, 12,
+		0, 0, 4, 192, 0, 0, 0, 0, 0, 0, 0, 24, 1, 0, 0, 0,
+		0, 0, 0, 37, 36, 0, 0, 58, 0, 0, 0, 0, 3, 0, 0, 166,
+		31, 0, 0, 47, 0, 0, 0, 64, 3, 0, 0, 198, 31, 0, 0, 39,
+		0, 0, 0, 128, 3, 0, 0, 50, 18, 0, 0, 2, 0, 0, 0, 192,
+		3, 0, 0, 44, 36, 0, 0, 15, 1, 0, 0, 0, 4, 0, 0, 136,
+		35, 0, 0, 29, 0, 0, 0, 64, 4, 0, 0, 0, 0, 0, 0, 33,
+		1, 0, 0, 128, 4, 0, 0, 55, 36, 0, 0, 29, 0, 0, 0, 0,
+		5, 0, 0, 70, 36, 0, 0, 29, 0, 0, 0, 64, 5, 0, 0, 81,
+		36, 0, 0, 29, 0, 0, 0, 128, 5, 0, 0, 97, 36, 0, 0, 29,
+		0, 0, 0, 192, 5, 0, 0, 0, 0, 0, 0, 6, 0, 0, 5, 96,
+		0, 0, 0, 0, 0, 0, 0, 25, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 27, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 28,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 29, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 30, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 32, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10,
+		0, 0, 4, 96, 0, 0, 0, 37, 33, 0, 0, 29, 0, 0, 0, 0,
+		0, 0, 0, 114, 36, 0, 0, 29, 0, 0, 0, 64, 0, 0, 0, 123,
+		36, 0, 0, 39, 0, 0, 0, 128, 0, 0, 0, 135, 36, 0, 0, 39,
+		0, 0, 0, 192, 0, 0, 0, 146, 36, 0, 0, 2, 0, 0, 0, 0,
+		1, 0, 0, 163, 36, 0, 0, 2, 0, 0, 0, 32, 1, 0, 0, 167,
+		36, 0, 0, 2, 0, 0, 0, 64, 1, 0, 0, 229, 0, 0, 0, 2,
+		0, 0, 0, 96, 1, 0, 0, 176, 36, 0, 0, 26, 1, 0, 0, 128,
+		1, 0, 0, 186, 36, 0, 0, 26, 1, 0, 0, 64, 2, 0, 0, 197,
+		36, 0, 0, 4, 0, 0, 4, 24, 0, 0, 0, 37, 33, 0, 0, 29,
+		0, 0, 0, 0, 0, 0, 0, 217, 36, 0, 0, 10, 0, 0, 0, 64,
+		0, 0, 0, 221, 36, 0, 0, 2, 0, 0, 0, 96, 0, 0, 0, 163,
+		36, 0, 0, 2, 0, 0, 0, 128, 0, 0, 0, 0, 0, 0, 0, 1,
+		0, 0, 4, 64, 0, 0, 0, 10, 18, 0, 0, 75, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 4, 16, 0, 0, 0, 227,
+		36, 0, 0, 63, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2,
+		0, 0, 4, 16, 0, 0, 0, 235, 36, 0, 0, 29, 0, 0, 0, 0,
+		0, 0, 0, 243, 36, 0, 0, 29, 0, 0, 0, 64, 0, 0, 0, 0,
+		0, 0, 0, 2, 0, 0, 4, 40, 0, 0, 0, 248, 36, 0, 0, 31,
+		1, 0, 0, 0, 0, 0, 0, 253, 36, 0, 0, 63, 0, 0, 0, 192,
+		0, 0, 0, 5, 37, 0, 0, 4, 0, 0, 4, 24, 0, 0, 0, 24,
+		37, 0, 0, 39, 0, 0, 0, 0, 0, 0, 0, 32, 37, 0, 0, 39,
+		0, 0, 0, 64, 0, 0, 0, 132, 25, 0, 0, 20, 0, 0, 0, 128,
+		0, 0, 0, 48, 0, 0, 0, 20, 0, 0, 0, 136, 0, 0, 0, 0,
+		0, 0, 0, 5, 0, 0, 4, 24, 0, 0, 0, 37, 37, 0, 0, 20,
+		0, 0, 0, 0, 0, 0, 0, 48, 37, 0, 0, 20, 0, 0, 0, 8,
+		0, 0, 0, 59, 37, 0, 0, 56, 0, 0, 0, 16, 0, 0, 0, 67,
+		37, 0, 0, 29, 0, 0, 0, 64, 0, 0, 0, 72, 37, 0, 0, 29,
+		0, 0, 0, 128, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 5, 16,
+		0, 0, 0, 0, 0, 0, 0, 34, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 35, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2,
+		0, 0, 4, 16, 0, 0, 0, 78, 37, 0, 0, 29, 0, 0, 0, 0,
+		0, 0, 0, 90, 37, 0, 0, 15, 1, 0, 0, 64, 0, 0, 0, 0,
+		0, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 102, 37, 0, 0, 29,
+		0, 0, 0, 0, 0, 0, 0, 115, 37, 0, 0, 29, 0, 0, 0, 64,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 188, 1, 0, 0, 127,
+		37, 0, 0, 0, 0, 0, 8, 38, 1, 0, 0, 145, 37, 0, 0, 2,
+		0, 0, 4, 24, 0, 0, 0, 85, 23, 0, 0, 202, 0, 0, 0, 0,
+		0, 0, 0, 161, 37, 0, 0, 63, 0, 0, 0, 64, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 161, 1, 0, 0, 166, 37, 0, 0, 3,
+		0, 0, 4, 32, 0, 0, 0, 18, 18, 0, 0, 52, 0, 0, 0, 0,
+		0, 0, 0, 183, 24, 0, 0, 41, 1, 0, 0, 128, 0, 0, 0, 175,
+		37, 0, 0, 44, 1, 0, 0, 192, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 42, 1, 0, 0, 0, 0, 0, 0, 1, 0, 0, 13, 0,
+		0, 0, 0, 0, 0, 0, 0, 43, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 40, 1, 0, 0, 183, 37, 0, 0, 1, 0, 0, 4, 8,
+		0, 0, 0, 102, 27, 0, 0, 58, 0, 0, 0, 0, 0, 0, 0, 191,
+		37, 0, 0, 3, 0, 0, 4, 24, 0, 0, 0, 250, 24, 0, 0, 63,
+		0, 0, 0, 0, 0, 0, 0, 85, 23, 0, 0, 148, 0, 0, 0, 128,
+		0, 0, 0, 214, 37, 0, 0, 10, 0, 0, 0, 160, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 186, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 48, 1, 0, 0, 0, 0, 0, 0, 1, 0, 0, 13, 0,
+		0, 0, 0, 0, 0, 0, 0, 12, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 193, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 51,
+		1, 0, 0, 0, 0, 0, 0, 1, 0, 0, 13, 29, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 230, 37, 0, 0, 0, 0, 0, 8, 53,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 54, 1, 0, 0, 0,
+		0, 0, 0, 3, 0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 0, 12,
+		1, 0, 0, 0, 0, 0, 0, 55, 1, 0, 0, 0, 0, 0, 0, 67,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 56, 1, 0, 0, 254,
+		37, 0, 0, 23, 0, 0, 4, 0, 1, 0, 0, 15, 38, 0, 0, 29,
+		0, 0, 0, 0, 0, 0, 0, 20, 38, 0, 0, 57, 1, 0, 0, 64,
+		0, 0, 0, 24, 38, 0, 0, 58, 1, 0, 0, 128, 0, 0, 0, 33,
+		38, 0, 0, 29, 0, 0, 0, 192, 0, 0, 0, 61, 16, 0, 0, 59,
+		1, 0, 0, 0, 1, 0, 0, 40, 38, 0, 0, 29, 0, 0, 0, 64,
+		1, 0, 0, 44, 38, 0, 0, 61, 1, 0, 0, 128, 1, 0, 0, 48,
+		0, 0, 0, 29, 0, 0, 0, 192, 1, 0, 0, 53, 38, 0, 0, 29,
+		0, 0, 0, 0, 2, 0, 0, 56, 38, 0, 0, 63, 1, 0, 0, 64,
+		2, 0, 0, 158, 22, 0, 0, 29, 0, 0, 0, 128, 2, 0, 0, 202,
+		0, 0, 0, 29, 0, 0, 0, 192, 2, 0, 0, 66, 38, 0, 0, 29,
+		0, 0, 0, 0, 3, 0, 0, 76, 38, 0, 0, 64, 1, 0, 0, 64,
+		3, 0, 0, 86, 38, 0, 0, 65, 1, 0, 0, 128, 3, 0, 0, 96,
+		38, 0, 0, 29, 0, 0, 0, 192, 3, 0, 0, 105, 38, 0, 0, 66,
+		1, 0, 0, 0, 4, 0, 0, 115, 38, 0, 0, 66, 1, 0, 0, 128,
+		4, 0, 0, 125, 38, 0, 0, 29, 0, 0, 0, 0, 5, 0, 0, 141,
+		38, 0, 0, 29, 0, 0, 0, 64, 5, 0, 0, 154, 34, 0, 0, 29,
+		0, 0, 0, 128, 5, 0, 0, 151, 38, 0, 0, 29, 0, 0, 0, 192,
+		5, 0, 0, 166, 38, 0, 0, 29, 0, 0, 0, 0, 6, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 191, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 187, 1, 0, 0, 181, 38, 0, 0, 2, 0, 0, 5, 8,
+		0, 0, 0, 200, 38, 0, 0, 30, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 60, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3,
+		0, 0, 4, 8, 0, 0, 0, 205, 38, 0, 0, 9, 0, 0, 0, 0,
+		0, 0, 0, 213, 38, 0, 0, 57, 0, 0, 0, 32, 0, 0, 0, 220,
+		38, 0, 0, 57, 0, 0, 0, 48, 0, 0, 0, 227, 38, 0, 0, 2,
+		0, 0, 5, 8, 0, 0, 0, 147, 22, 0, 0, 30, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 62, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 11, 0, 0, 132, 8, 0, 0, 0, 245, 38, 0, 0, 30,
+		0, 0, 0, 0, 0, 0, 5, 252, 38, 0, 0, 30, 0, 0, 0, 5,
+		0, 0, 14, 4, 39, 0, 0, 30, 0, 0, 0, 19, 0, 0, 5, 14,
+		39, 0, 0, 30, 0, 0, 0, 24, 0, 0, 2, 23, 39, 0, 0, 30,
+		0, 0, 0, 26, 0, 0, 7, 32, 39, 0, 0, 30, 0, 0, 0, 33,
+		0, 0, 4, 44, 39, 0, 0, 30, 0, 0, 0, 37, 0, 0, 1, 55,
+		39, 0, 0, 30, 0, 0, 0, 38, 0, 0, 2, 66, 39, 0, 0, 30,
+		0, 0, 0, 40, 0, 0, 3, 74, 39, 0, 0, 30, 0, 0, 0, 43,
+		0, 0, 3, 83, 39, 0, 0, 30, 0, 0, 0, 46, 0, 0, 18, 0,
+		0, 0, 0, 2, 0, 0, 4, 8, 0, 0, 0, 249, 0, 0, 0, 8,
+		0, 0, 0, 0, 0, 0, 0, 92, 39, 0, 0, 8, 0, 0, 0, 32,
+		0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 4, 8, 0, 0, 0, 28,
+		15, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 96, 39, 0, 0, 8,
+		0, 0, 0, 32, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 189,
+		1, 0, 0, 105, 39, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 115,
+		39, 0, 0, 30, 0, 0, 0, 0, 0, 0, 0, 119, 39, 0, 0, 67,
+		1, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 78,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 151, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 212, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 160, 1, 0, 0, 88, 32, 0, 0, 12, 0, 0, 4, 184,
+		0, 0, 0, 183, 24, 0, 0, 72, 1, 0, 0, 0, 0, 0, 0, 125,
+		15, 0, 0, 75, 1, 0, 0, 64, 0, 0, 0, 229, 0, 0, 0, 39,
+		0, 0, 0, 128, 0, 0, 0, 124, 39, 0, 0, 47, 0, 0, 0, 192,
+		0, 0, 0, 132, 39, 0, 0, 72, 1, 0, 0, 0, 1, 0, 0, 143,
+		39, 0, 0, 79, 1, 0, 0, 64, 1, 0, 0, 154, 39, 0, 0, 81,
+		1, 0, 0, 192, 2, 0, 0, 164, 39, 0, 0, 79, 1, 0, 0, 0,
+		3, 0, 0, 173, 39, 0, 0, 39, 0, 0, 0, 128, 4, 0, 0, 184,
+		39, 0, 0, 39, 0, 0, 0, 192, 4, 0, 0, 250, 24, 0, 0, 63,
+		0, 0, 0, 0, 5, 0, 0, 200, 39, 0, 0, 82, 1, 0, 0, 128,
+		5, 0, 0, 209, 39, 0, 0, 0, 0, 0, 8, 73, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 74, 1, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 0, 39, 0, 0, 0, 0,
+		0, 0, 0, 39, 0, 0, 0, 0, 0, 0, 0, 75, 1, 0, 0, 0,
+		0, 0, 0, 76, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 71,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 77, 1, 0, 0, 223,
+		39, 0, 0, 1, 0, 0, 4, 168, 0, 0, 0, 119, 39, 0, 0, 78,
+		1, 0, 0, 0, 0, 0, 0, 235, 39, 0, 0, 21, 0, 0, 4, 168,
+		0, 0, 0, 243, 39, 0, 0, 39, 0, 0, 0, 0, 0, 0, 0, 247,
+		39, 0, 0, 39, 0, 0, 0, 64, 0, 0, 0, 251, 39, 0, 0, 39,
+		0, 0, 0, 128, 0, 0, 0, 255, 39, 0, 0, 39, 0, 0, 0, 192,
+		0, 0, 0, 3, 40, 0, 0, 39, 0, 0, 0, 0, 1, 0, 0, 6,
+		40, 0, 0, 39, 0, 0, 0, 64, 1, 0, 0, 9, 40, 0, 0, 39,
+		0, 0, 0, 128, 1, 0, 0, 13, 40, 0, 0, 39, 0, 0, 0, 192,
+		1, 0, 0, 17, 40, 0, 0, 39, 0, 0, 0, 0, 2, 0, 0, 20,
+		40, 0, 0, 39, 0, 0, 0, 64, 2, 0, 0, 23, 40, 0, 0, 39,
+		0, 0, 0, 128, 2, 0, 0, 26, 40, 0, 0, 39, 0, 0, 0, 192,
+		2, 0, 0, 29, 40, 0, 0, 39, 0, 0, 0, 0, 3, 0, 0, 32,
+		40, 0, 0, 39, 0, 0, 0, 64, 3, 0, 0, 35, 40, 0, 0, 39,
+		0, 0, 0, 128, 3, 0, 0, 38, 40, 0, 0, 39, 0, 0, 0, 192,
+		3, 0, 0, 53, 38, 0, 0, 39, 0, 0, 0, 0, 4, 0, 0, 46,
+		40, 0, 0, 39, 0, 0, 0, 64, 4, 0, 0, 229, 0, 0, 0, 39,
+		0, 0, 0, 128, 4, 0, 0, 178, 28, 0, 0, 39, 0, 0, 0, 192,
+		4, 0, 0, 49, 40, 0, 0, 39, 0, 0, 0, 0, 5, 0, 0, 52,
+		40, 0, 0, 3, 0, 0, 4, 48, 0, 0, 0, 68, 40, 0, 0, 80,
+		1, 0, 0, 0, 0, 0, 0, 81, 40, 0, 0, 80, 1, 0, 0, 64,
+		0, 0, 0, 93, 40, 0, 0, 226, 0, 0, 0, 128, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 165, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 79, 1, 0, 0, 104, 40, 0, 0, 0, 0, 0, 8, 83,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 84, 1, 0, 0, 0,
+		0, 0, 0, 2, 0, 0, 13, 2, 0, 0, 0, 0, 0, 0, 0, 75,
+		1, 0, 0, 0, 0, 0, 0, 85, 1, 0, 0, 122, 40, 0, 0, 3,
+		0, 0, 6, 4, 0, 0, 0, 137, 40, 0, 0, 0, 0, 0, 0, 179,
+		40, 0, 0, 1, 0, 0, 0, 221, 40, 0, 0, 2, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 190, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 3, 0, 0, 0, 0, 12, 1, 0, 0, 4, 0, 0, 0, 4,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 169, 1, 0, 0, 72,
+		29, 0, 0, 7, 0, 0, 4, 128, 16, 0, 0, 167, 36, 0, 0, 10,
+		0, 0, 0, 0, 0, 0, 0, 8, 41, 0, 0, 39, 0, 0, 0, 64,
+		0, 0, 0, 25, 41, 0, 0, 90, 1, 0, 0, 128, 0, 0, 0, 33,
+		41, 0, 0, 90, 1, 0, 0, 192, 0, 0, 0, 48, 41, 0, 0, 91,
+		1, 0, 0, 0, 1, 0, 0, 53, 41, 0, 0, 91, 1, 0, 0, 128,
+		1, 0, 0, 64, 41, 0, 0, 92, 1, 0, 0, 0, 2, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 92, 1, 0, 0, 74, 41, 0, 0, 3,
+		0, 0, 4, 16, 0, 0, 0, 89, 41, 0, 0, 29, 0, 0, 0, 0,
+		0, 0, 0, 102, 41, 0, 0, 10, 0, 0, 0, 64, 0, 0, 0, 115,
+		41, 0, 0, 10, 0, 0, 0, 96, 0, 0, 0, 25, 41, 0, 0, 10,
+		0, 0, 132, 64, 16, 0, 0, 101, 28, 0, 0, 10, 0, 0, 0, 0,
+		0, 0, 0, 133, 41, 0, 0, 10, 0, 0, 0, 32, 0, 0, 0, 143,
+		41, 0, 0, 29, 0, 0, 0, 64, 0, 0, 0, 153, 41, 0, 0, 29,
+		0, 0, 0, 128, 0, 0, 0, 168, 41, 0, 0, 29, 0, 0, 0, 192,
+		0, 0, 0, 172, 41, 0, 0, 10, 0, 0, 0, 0, 1, 0, 1, 182,
+		41, 0, 0, 10, 0, 0, 0, 1, 1, 0, 1, 191, 41, 0, 0, 10,
+		0, 0, 0, 2, 1, 0, 1, 207, 41, 0, 0, 10, 0, 0, 0, 3,
+		1, 0, 1, 119, 39, 0, 0, 93, 1, 0, 0, 0, 2, 0, 0, 214,
+		41, 0, 0, 5, 0, 0, 5, 0, 16, 0, 0, 227, 41, 0, 0, 94,
+		1, 0, 0, 0, 0, 0, 0, 233, 41, 0, 0, 96, 1, 0, 0, 0,
+		0, 0, 0, 240, 41, 0, 0, 104, 1, 0, 0, 0, 0, 0, 0, 245,
+		41, 0, 0, 106, 1, 0, 0, 0, 0, 0, 0, 251, 41, 0, 0, 110,
+		1, 0, 0, 0, 0, 0, 0, 5, 42, 0, 0, 9, 0, 0, 4, 112,
+		0, 0, 0, 17, 42, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 21,
+		42, 0, 0, 8, 0, 0, 0, 32, 0, 0, 0, 25, 42, 0, 0, 8,
+		0, 0, 0, 64, 0, 0, 0, 29, 42, 0, 0, 8, 0, 0, 0, 96,
+		0, 0, 0, 33, 42, 0, 0, 8, 0, 0, 0, 128, 0, 0, 0, 37,
+		42, 0, 0, 8, 0, 0, 0, 160, 0, 0, 0, 41, 42, 0, 0, 8,
+		0, 0, 0, 192, 0, 0, 0, 45, 42, 0, 0, 95, 1, 0, 0, 224,
+		0, 0, 0, 21, 15, 0, 0, 8, 0, 0, 0, 96, 3, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 8, 0, 0, 0, 4,
-		0, 0, 0, 20, 0, 0, 0, 47, 85, 0, 0, 11, 0, 0, 4, 0,
-		2, 0, 0, 10, 85, 0, 0, 48, 0, 0, 0, 0, 0, 0, 0, 14,
-		85, 0, 0, 48, 0, 0, 0, 16, 0, 0, 0, 18, 85, 0, 0, 48,
-		0, 0, 0, 32, 0, 0, 0, 60, 85, 0, 0, 48, 0, 0, 0, 48,
-		0, 0, 0, 0, 0, 0, 0, 149, 3, 0, 0, 64, 0, 0, 0, 64,
-		85, 0, 0, 8, 0, 0, 0, 192, 0, 0, 0, 70, 85, 0, 0, 8,
-		0, 0, 0, 224, 0, 0, 0, 38, 85, 0, 0, 152, 3, 0, 0, 0,
-		1, 0, 0, 81, 85, 0, 0, 153, 3, 0, 0, 0, 5, 0, 0, 117,
-		77, 0, 0, 154, 3, 0, 0, 0, 13, 0, 0, 0, 0, 0, 0, 155,
-		3, 0, 0, 128, 14, 0, 0, 0, 0, 0, 0, 2, 0, 0, 5, 16,
-		0, 0, 0, 0, 0, 0, 0, 150, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 151, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 4, 16, 0, 0, 0, 91, 85, 0, 0, 57, 0, 0, 0, 0,
-		0, 0, 0, 95, 85, 0, 0, 57, 0, 0, 0, 64, 0, 0, 0, 0,
-		0, 0, 0, 4, 0, 0, 4, 16, 0, 0, 0, 22, 85, 0, 0, 8,
-		0, 0, 0, 0, 0, 0, 0, 26, 85, 0, 0, 8, 0, 0, 0, 32,
-		0, 0, 0, 30, 85, 0, 0, 8, 0, 0, 0, 64, 0, 0, 0, 34,
-		85, 0, 0, 8, 0, 0, 0, 96, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 20, 0, 0, 0, 54, 42, 0, 0, 11, 0, 0, 4, 0,
+		2, 0, 0, 17, 42, 0, 0, 56, 0, 0, 0, 0, 0, 0, 0, 21,
+		42, 0, 0, 56, 0, 0, 0, 16, 0, 0, 0, 25, 42, 0, 0, 56,
+		0, 0, 0, 32, 0, 0, 0, 67, 42, 0, 0, 56, 0, 0, 0, 48,
+		0, 0, 0, 0, 0, 0, 0, 97, 1, 0, 0, 64, 0, 0, 0, 71,
+		42, 0, 0, 8, 0, 0, 0, 192, 0, 0, 0, 77, 42, 0, 0, 8,
+		0, 0, 0, 224, 0, 0, 0, 45, 42, 0, 0, 100, 1, 0, 0, 0,
+		1, 0, 0, 88, 42, 0, 0, 101, 1, 0, 0, 0, 5, 0, 0, 59,
+		37, 0, 0, 102, 1, 0, 0, 0, 13, 0, 0, 0, 0, 0, 0, 103,
+		1, 0, 0, 128, 14, 0, 0, 0, 0, 0, 0, 2, 0, 0, 5, 16,
+		0, 0, 0, 0, 0, 0, 0, 98, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 99, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2,
+		0, 0, 4, 16, 0, 0, 0, 98, 42, 0, 0, 29, 0, 0, 0, 0,
+		0, 0, 0, 102, 42, 0, 0, 29, 0, 0, 0, 64, 0, 0, 0, 0,
+		0, 0, 0, 4, 0, 0, 4, 16, 0, 0, 0, 29, 42, 0, 0, 8,
+		0, 0, 0, 0, 0, 0, 0, 33, 42, 0, 0, 8, 0, 0, 0, 32,
+		0, 0, 0, 37, 42, 0, 0, 8, 0, 0, 0, 64, 0, 0, 0, 41,
+		42, 0, 0, 8, 0, 0, 0, 96, 0, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 3, 0, 0, 0, 0, 8, 0, 0, 0, 4, 0, 0, 0, 32,
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 8,
 		0, 0, 0, 4, 0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 3, 0, 0, 0, 0, 8, 0, 0, 0, 4, 0, 0, 0, 12,
-		0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 5, 48, 0, 0, 0, 99,
-		85, 0, 0, 154, 3, 0, 0, 0, 0, 0, 0, 108, 85, 0, 0, 154,
-		3, 0, 0, 0, 0, 0, 0, 120, 85, 0, 0, 16, 0, 0, 4, 136,
-		0, 0, 0, 10, 85, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 14,
-		85, 0, 0, 8, 0, 0, 0, 32, 0, 0, 0, 18, 85, 0, 0, 8,
-		0, 0, 0, 64, 0, 0, 0, 22, 85, 0, 0, 8, 0, 0, 0, 96,
-		0, 0, 0, 26, 85, 0, 0, 8, 0, 0, 0, 128, 0, 0, 0, 30,
-		85, 0, 0, 8, 0, 0, 0, 160, 0, 0, 0, 34, 85, 0, 0, 8,
-		0, 0, 0, 192, 0, 0, 0, 38, 85, 0, 0, 147, 3, 0, 0, 224,
-		0, 0, 0, 133, 85, 0, 0, 20, 0, 0, 0, 96, 3, 0, 0, 138,
-		85, 0, 0, 20, 0, 0, 0, 104, 3, 0, 0, 146, 85, 0, 0, 20,
-		0, 0, 0, 112, 3, 0, 0, 156, 85, 0, 0, 20, 0, 0, 0, 120,
-		3, 0, 0, 166, 85, 0, 0, 20, 0, 0, 0, 128, 3, 0, 0, 169,
-		85, 0, 0, 20, 0, 0, 0, 136, 3, 0, 0, 108, 38, 0, 0, 157,
-		3, 0, 0, 192, 3, 0, 0, 176, 85, 0, 0, 8, 0, 0, 0, 0,
-		4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 70, 4, 0, 0, 186,
-		85, 0, 0, 3, 0, 0, 4, 64, 2, 0, 0, 198, 85, 0, 0, 148,
-		3, 0, 0, 0, 0, 0, 0, 211, 40, 0, 0, 159, 3, 0, 0, 0,
-		16, 0, 0, 203, 85, 0, 0, 161, 3, 0, 0, 0, 18, 0, 0, 223,
-		85, 0, 0, 3, 0, 0, 4, 64, 0, 0, 0, 136, 84, 0, 0, 57,
-		0, 0, 0, 0, 0, 0, 0, 237, 85, 0, 0, 57, 0, 0, 0, 64,
-		0, 0, 0, 6, 82, 0, 0, 160, 3, 0, 0, 128, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 57, 0, 0, 0, 4,
+		0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 5, 48, 0, 0, 0, 106,
+		42, 0, 0, 102, 1, 0, 0, 0, 0, 0, 0, 115, 42, 0, 0, 102,
+		1, 0, 0, 0, 0, 0, 0, 127, 42, 0, 0, 16, 0, 0, 4, 136,
+		0, 0, 0, 17, 42, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 21,
+		42, 0, 0, 8, 0, 0, 0, 32, 0, 0, 0, 25, 42, 0, 0, 8,
+		0, 0, 0, 64, 0, 0, 0, 29, 42, 0, 0, 8, 0, 0, 0, 96,
+		0, 0, 0, 33, 42, 0, 0, 8, 0, 0, 0, 128, 0, 0, 0, 37,
+		42, 0, 0, 8, 0, 0, 0, 160, 0, 0, 0, 41, 42, 0, 0, 8,
+		0, 0, 0, 192, 0, 0, 0, 45, 42, 0, 0, 95, 1, 0, 0, 224,
+		0, 0, 0, 140, 42, 0, 0, 20, 0, 0, 0, 96, 3, 0, 0, 145,
+		42, 0, 0, 20, 0, 0, 0, 104, 3, 0, 0, 153, 42, 0, 0, 20,
+		0, 0, 0, 112, 3, 0, 0, 163, 42, 0, 0, 20, 0, 0, 0, 120,
+		3, 0, 0, 173, 42, 0, 0, 20, 0, 0, 0, 128, 3, 0, 0, 176,
+		42, 0, 0, 20, 0, 0, 0, 136, 3, 0, 0, 248, 36, 0, 0, 105,
+		1, 0, 0, 192, 3, 0, 0, 183, 42, 0, 0, 8, 0, 0, 0, 0,
+		4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 176, 1, 0, 0, 193,
+		42, 0, 0, 3, 0, 0, 4, 64, 2, 0, 0, 205, 42, 0, 0, 96,
+		1, 0, 0, 0, 0, 0, 0, 210, 42, 0, 0, 107, 1, 0, 0, 0,
+		16, 0, 0, 217, 42, 0, 0, 109, 1, 0, 0, 0, 18, 0, 0, 237,
+		42, 0, 0, 3, 0, 0, 4, 64, 0, 0, 0, 143, 41, 0, 0, 29,
+		0, 0, 0, 0, 0, 0, 0, 251, 42, 0, 0, 29, 0, 0, 0, 64,
+		0, 0, 0, 96, 39, 0, 0, 108, 1, 0, 0, 128, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 29, 0, 0, 0, 4,
 		0, 0, 0, 6, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
 		0, 0, 0, 20, 0, 0, 0, 4, 0, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 20, 0, 0, 0, 4,
-		0, 0, 0, 0, 16, 0, 0, 246, 85, 0, 0, 3, 0, 0, 4, 16,
-		0, 0, 0, 5, 86, 0, 0, 57, 0, 0, 0, 0, 0, 0, 0, 18,
-		86, 0, 0, 10, 0, 0, 0, 64, 0, 0, 0, 31, 86, 0, 0, 10,
-		0, 0, 0, 96, 0, 0, 0, 88, 8, 0, 0, 9, 0, 0, 4, 72,
-		0, 0, 0, 73, 30, 0, 0, 42, 0, 0, 0, 0, 0, 0, 0, 118,
-		86, 0, 0, 165, 3, 0, 0, 64, 0, 0, 0, 125, 86, 0, 0, 166,
-		3, 0, 0, 128, 0, 0, 0, 132, 86, 0, 0, 167, 3, 0, 0, 192,
-		0, 0, 0, 139, 86, 0, 0, 240, 0, 0, 0, 0, 1, 0, 0, 159,
-		86, 0, 0, 168, 3, 0, 0, 64, 1, 0, 0, 166, 86, 0, 0, 169,
-		3, 0, 0, 128, 1, 0, 0, 174, 86, 0, 0, 169, 3, 0, 0, 192,
-		1, 0, 0, 195, 86, 0, 0, 172, 3, 0, 0, 0, 2, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 201, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 202, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 173,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 210, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 170, 3, 0, 0, 205, 86, 0, 0, 6,
-		0, 0, 4, 88, 0, 0, 0, 68, 27, 0, 0, 196, 0, 0, 0, 0,
-		0, 0, 0, 214, 34, 0, 0, 144, 1, 0, 0, 64, 0, 0, 0, 86,
-		33, 0, 0, 118, 1, 0, 0, 128, 0, 0, 0, 220, 86, 0, 0, 171,
-		3, 0, 0, 64, 1, 0, 0, 228, 86, 0, 0, 208, 1, 0, 0, 64,
-		2, 0, 0, 238, 86, 0, 0, 55, 1, 0, 0, 128, 2, 0, 0, 253,
-		86, 0, 0, 2, 0, 0, 4, 32, 0, 0, 0, 12, 87, 0, 0, 67,
-		2, 0, 0, 0, 0, 0, 0, 22, 87, 0, 0, 67, 2, 0, 0, 128,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 219, 3, 0, 0, 35,
-		87, 0, 0, 11, 0, 0, 4, 120, 0, 0, 0, 86, 33, 0, 0, 118,
-		1, 0, 0, 0, 0, 0, 0, 122, 39, 0, 0, 174, 3, 0, 0, 192,
-		0, 0, 0, 255, 20, 0, 0, 55, 0, 0, 0, 0, 1, 0, 0, 49,
-		87, 0, 0, 148, 0, 0, 0, 128, 1, 0, 0, 68, 27, 0, 0, 196,
-		0, 0, 0, 192, 1, 0, 0, 214, 34, 0, 0, 144, 1, 0, 0, 0,
-		2, 0, 0, 57, 87, 0, 0, 57, 0, 0, 0, 64, 2, 0, 0, 82,
-		32, 0, 0, 237, 0, 0, 0, 128, 2, 0, 0, 175, 72, 0, 0, 57,
-		0, 0, 0, 64, 3, 0, 0, 61, 87, 0, 0, 10, 0, 0, 0, 128,
-		3, 0, 0, 68, 87, 0, 0, 10, 0, 0, 0, 160, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 215, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 3, 0, 0, 0, 0, 33, 0, 0, 0, 4, 0, 0, 0, 13,
-		0, 0, 0, 122, 91, 0, 0, 0, 0, 0, 14, 175, 3, 0, 0, 1,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 178, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 9, 33, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 3, 0, 0, 0, 0, 177, 3, 0, 0, 4, 0, 0, 0, 64,
-		0, 0, 0, 130, 91, 0, 0, 0, 0, 0, 14, 179, 3, 0, 0, 1,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 28,
-		1, 0, 0, 4, 0, 0, 0, 41, 0, 0, 0, 142, 91, 0, 0, 0,
-		0, 0, 14, 181, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 3, 0, 0, 0, 0, 28, 1, 0, 0, 4, 0, 0, 0, 0,
-		4, 0, 0, 160, 91, 0, 0, 0, 0, 0, 14, 183, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 28,
-		1, 0, 0, 4, 0, 0, 0, 72, 0, 0, 0, 175, 91, 0, 0, 0,
-		0, 0, 14, 185, 3, 0, 0, 0, 0, 0, 0, 195, 91, 0, 0, 3,
+		0, 0, 0, 0, 16, 0, 0, 110, 8, 0, 0, 9, 0, 0, 4, 72,
+		0, 0, 0, 101, 22, 0, 0, 50, 0, 0, 0, 0, 0, 0, 0, 73,
+		43, 0, 0, 112, 1, 0, 0, 64, 0, 0, 0, 80, 43, 0, 0, 113,
+		1, 0, 0, 128, 0, 0, 0, 87, 43, 0, 0, 114, 1, 0, 0, 192,
+		0, 0, 0, 94, 43, 0, 0, 49, 1, 0, 0, 0, 1, 0, 0, 114,
+		43, 0, 0, 115, 1, 0, 0, 64, 1, 0, 0, 121, 43, 0, 0, 116,
+		1, 0, 0, 128, 1, 0, 0, 129, 43, 0, 0, 116, 1, 0, 0, 192,
+		1, 0, 0, 150, 43, 0, 0, 117, 1, 0, 0, 0, 2, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 217, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 172, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 118,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 182, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 211, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 155, 1, 0, 0, 164, 43, 0, 0, 11, 0, 0, 4, 120,
+		0, 0, 0, 233, 31, 0, 0, 119, 1, 0, 0, 0, 0, 0, 0, 178,
+		43, 0, 0, 122, 1, 0, 0, 192, 0, 0, 0, 250, 24, 0, 0, 63,
+		0, 0, 0, 0, 1, 0, 0, 183, 43, 0, 0, 202, 0, 0, 0, 128,
+		1, 0, 0, 191, 43, 0, 0, 123, 1, 0, 0, 192, 1, 0, 0, 199,
+		43, 0, 0, 124, 1, 0, 0, 0, 2, 0, 0, 207, 43, 0, 0, 29,
+		0, 0, 0, 64, 2, 0, 0, 136, 22, 0, 0, 37, 1, 0, 0, 128,
+		2, 0, 0, 211, 43, 0, 0, 29, 0, 0, 0, 64, 3, 0, 0, 217,
+		43, 0, 0, 10, 0, 0, 0, 128, 3, 0, 0, 224, 43, 0, 0, 10,
+		0, 0, 0, 160, 3, 0, 0, 239, 43, 0, 0, 4, 0, 0, 4, 24,
+		0, 0, 0, 249, 43, 0, 0, 115, 0, 0, 0, 0, 0, 0, 0, 1,
+		44, 0, 0, 120, 1, 0, 0, 64, 0, 0, 0, 5, 44, 0, 0, 10,
+		0, 0, 0, 128, 0, 0, 0, 101, 22, 0, 0, 48, 0, 0, 0, 160,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 121, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 10, 197, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 180, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 215,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 213, 1, 0, 0, 0,
+		0, 0, 0, 1, 0, 0, 13, 2, 0, 0, 0, 83, 22, 0, 0, 47,
+		0, 0, 0, 26, 49, 0, 0, 1, 0, 0, 12, 125, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 41, 0, 0, 0, 4,
+		0, 0, 0, 13, 0, 0, 0, 233, 49, 0, 0, 0, 0, 0, 14, 127,
+		1, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 130,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 41, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 129, 1, 0, 0, 4,
+		0, 0, 0, 64, 0, 0, 0, 241, 49, 0, 0, 0, 0, 0, 14, 131,
+		1, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 41,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 133,
+		1, 0, 0, 4, 0, 0, 0, 45, 0, 0, 0, 253, 49, 0, 0, 0,
+		0, 0, 14, 134, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 3, 0, 0, 0, 0, 133, 1, 0, 0, 4, 0, 0, 0, 0,
+		4, 0, 0, 15, 50, 0, 0, 0, 0, 0, 14, 136, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 133,
+		1, 0, 0, 4, 0, 0, 0, 72, 0, 0, 0, 30, 50, 0, 0, 0,
+		0, 0, 14, 138, 1, 0, 0, 0, 0, 0, 0, 50, 50, 0, 0, 4,
 		0, 0, 15, 0, 0, 0, 0, 12, 0, 0, 0, 0, 0, 0, 0, 32,
 		0, 0, 0, 18, 0, 0, 0, 0, 0, 0, 0, 16, 0, 0, 0, 25,
-		0, 0, 0, 0, 0, 0, 0, 32, 0, 0, 0, 201, 91, 0, 0, 4,
-		0, 0, 15, 0, 0, 0, 0, 180, 3, 0, 0, 0, 0, 0, 0, 64,
-		0, 0, 0, 182, 3, 0, 0, 64, 0, 0, 0, 41, 0, 0, 0, 184,
-		3, 0, 0, 105, 0, 0, 0, 0, 4, 0, 0, 186, 3, 0, 0, 105,
-		4, 0, 0, 72, 0, 0, 0, 209, 91, 0, 0, 1, 0, 0, 15, 0,
-		0, 0, 0, 176, 3, 0, 0, 0, 0, 0, 0, 13, 0, 0, 0, 217,
-		91, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 238, 91, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 139, 17, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 129, 31, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 252,
-		91, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 5, 92, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 14, 56, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 17, 92, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 186,
-		28, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 26, 68, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 34, 92, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 53, 92, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 67,
-		92, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 81, 92, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 91, 92, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 89, 67, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 97,
-		92, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 105, 92, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 117, 92, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 133, 92, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 140,
-		92, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 144, 92, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 163, 92, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 178, 92, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 193,
-		92, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 202, 92, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 208, 92, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 232, 92, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 247,
-		92, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 254, 92, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 15, 93, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 57, 21, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 23,
-		93, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 35, 93, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 50, 93, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 61, 93, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 78,
-		93, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 92, 93, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 109, 93, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 120, 93, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 132,
-		93, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 153, 93, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 165, 93, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 179, 93, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 194,
-		93, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 203, 93, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 219, 93, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 237, 93, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 143,
-		49, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 195, 49, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 242, 93, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 8, 94, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 18,
-		94, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 29, 94, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 222, 34, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 151, 9, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 38,
-		94, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 49, 94, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 61, 94, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 67, 94, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 79,
-		94, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 101, 94, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 109, 94, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 106, 9, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 134,
-		9, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 123, 94, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 137, 94, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 155, 94, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 168,
-		94, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 200, 8, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 183, 94, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 201, 94, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 216,
-		94, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 44, 8, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 223, 94, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 237, 94, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 250,
-		94, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 8, 95, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 17, 95, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 31, 95, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 50,
-		95, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 67, 95, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 80, 95, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 90, 95, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 100,
-		95, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 110, 95, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 128, 95, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 147, 95, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 156,
-		95, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 168, 95, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 186, 95, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 197, 95, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 208,
-		95, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 225, 95, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 243, 95, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 9, 96, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 26,
-		96, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 38, 96, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 59, 96, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 82, 96, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 98,
-		96, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 116, 96, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 137, 96, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 152, 96, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 173,
-		96, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 197, 96, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 213, 96, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 227, 96, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 252,
-		96, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 6, 97, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 18, 97, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 34, 97, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 47,
-		97, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 62, 97, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 169, 30, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 73, 97, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 86,
-		97, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 253, 30, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 13, 21, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 20, 3, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 113,
-		97, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 122, 97, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 137, 97, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 120, 9, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 162,
-		9, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 153, 97, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 170, 97, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 194, 97, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 204,
-		11, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 30, 12, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 209, 97, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 225, 97, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 242,
-		97, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 254, 97, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 8, 98, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 223, 50, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 20,
-		98, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 0, 105, 110, 116, 0,
-		95, 95, 65, 82, 82, 65, 89, 95, 83, 73, 90, 69, 95, 84, 89, 80,
-		69, 95, 95, 0, 117, 51, 50, 0, 95, 95, 117, 51, 50, 0, 117, 110,
-		115, 105, 103, 110, 101, 100, 32, 105, 110, 116, 0, 116, 121, 112, 101, 0,
-		109, 97, 120, 95, 101, 110, 116, 114, 105, 101, 115, 0, 107, 101, 121, 0,
-		118, 97, 108, 117, 101, 0, 112, 105, 100, 95, 109, 110, 116, 110, 115, 0,
-		101, 118, 101, 110, 116, 115, 0, 117, 56, 0, 95, 95, 117, 56, 0, 117,
-		110, 115, 105, 103, 110, 101, 100, 32, 99, 104, 97, 114, 0, 109, 110, 116,
-		110, 115, 95, 115, 121, 115, 99, 97, 108, 108, 115, 0, 116, 114, 97, 99,
-		101, 95, 101, 118, 101, 110, 116, 95, 114, 97, 119, 95, 115, 121, 115, 95,
-		101, 110, 116, 101, 114, 0, 101, 110, 116, 0, 105, 100, 0, 97, 114, 103,
-		115, 0, 95, 95, 100, 97, 116, 97, 0, 116, 114, 97, 99, 101, 95, 101,
-		110, 116, 114, 121, 0, 102, 108, 97, 103, 115, 0, 112, 114, 101, 101, 109,
-		112, 116, 95, 99, 111, 117, 110, 116, 0, 112, 105, 100, 0, 117, 110, 115,
-		105, 103, 110, 101, 100, 32, 115, 104, 111, 114, 116, 0, 108, 111, 110, 103,
-		0, 117, 110, 115, 105, 103, 110, 101, 100, 32, 108, 111, 110, 103, 0, 99,
-		104, 97, 114, 0, 115, 121, 115, 95, 101, 110, 116, 101, 114, 0, 116, 114,
-		97, 99, 101, 112, 111, 105, 110, 116, 47, 114, 97, 119, 95, 115, 121, 115,
-		99, 97, 108, 108, 115, 47, 115, 121, 115, 95, 101, 110, 116, 101, 114, 0,
-		48, 58, 49, 0, 47, 98, 117, 105, 108, 100, 47, 115, 101, 99, 117, 114,
-		105, 116, 121, 45, 112, 114, 111, 102, 105, 108, 101, 115, 45, 111, 112, 101,
-		114, 97, 116, 111, 114, 47, 105, 110, 116, 101, 114, 110, 97, 108, 47, 112,
-		107, 103, 47, 100, 97, 101, 109, 111, 110, 47, 98, 112, 102, 114, 101, 99,
-		111, 114, 100, 101, 114, 47, 98, 112, 102, 47, 114, 101, 99, 111, 114, 100,
-		101, 114, 46, 98, 112, 102, 46, 99, 0, 32, 32, 32, 32, 117, 51, 50,
-		32, 115, 121, 115, 99, 97, 108, 108, 95, 105, 100, 32, 61, 32, 97, 114,
-		103, 115, 45, 62, 105, 100, 59, 0, 32, 32, 32, 32, 105, 102, 32, 40,
-		115, 121, 115, 99, 97, 108, 108, 95, 105, 100, 32, 60, 32, 48, 32, 124,
-		124, 32, 115, 121, 115, 99, 97, 108, 108, 95, 105, 100, 32, 62, 61, 32,
-		77, 65, 88, 95, 83, 89, 83, 67, 65, 76, 76, 83, 41, 32, 123, 0,
-		32, 32, 32, 32, 117, 51, 50, 32, 112, 105, 100, 32, 61, 32, 98, 112,
-		102, 95, 103, 101, 116, 95, 99, 117, 114, 114, 101, 110, 116, 95, 112, 105,
-		100, 95, 116, 103, 105, 100, 40, 41, 32, 62, 62, 32, 51, 50, 59, 0,
-		32, 32, 32, 32, 115, 116, 114, 117, 99, 116, 32, 116, 97, 115, 107, 95,
-		115, 116, 114, 117, 99, 116, 32, 42, 32, 116, 97, 115, 107, 32, 61, 32,
-		40, 115, 116, 114, 117, 99, 116, 32, 116, 97, 115, 107, 95, 115, 116, 114,
-		117, 99, 116, 32, 42, 41, 98, 112, 102, 95, 103, 101, 116, 95, 99, 117,
-		114, 114, 101, 110, 116, 95, 116, 97, 115, 107, 40, 41, 59, 0, 116, 97,
-		115, 107, 95, 115, 116, 114, 117, 99, 116, 0, 116, 104, 114, 101, 97, 100,
-		95, 105, 110, 102, 111, 0, 95, 95, 115, 116, 97, 116, 101, 0, 115, 116,
-		97, 99, 107, 0, 117, 115, 97, 103, 101, 0, 112, 116, 114, 97, 99, 101,
-		0, 111, 110, 95, 99, 112, 117, 0, 119, 97, 107, 101, 95, 101, 110, 116,
-		114, 121, 0, 119, 97, 107, 101, 101, 95, 102, 108, 105, 112, 115, 0, 119,
-		97, 107, 101, 101, 95, 102, 108, 105, 112, 95, 100, 101, 99, 97, 121, 95,
-		116, 115, 0, 108, 97, 115, 116, 95, 119, 97, 107, 101, 101, 0, 114, 101,
-		99, 101, 110, 116, 95, 117, 115, 101, 100, 95, 99, 112, 117, 0, 119, 97,
-		107, 101, 95, 99, 112, 117, 0, 111, 110, 95, 114, 113, 0, 112, 114, 105,
-		111, 0, 115, 116, 97, 116, 105, 99, 95, 112, 114, 105, 111, 0, 110, 111,
-		114, 109, 97, 108, 95, 112, 114, 105, 111, 0, 114, 116, 95, 112, 114, 105,
-		111, 114, 105, 116, 121, 0, 115, 101, 0, 114, 116, 0, 100, 108, 0, 115,
-		99, 104, 101, 100, 95, 99, 108, 97, 115, 115, 0, 99, 111, 114, 101, 95,
-		110, 111, 100, 101, 0, 99, 111, 114, 101, 95, 99, 111, 111, 107, 105, 101,
-		0, 99, 111, 114, 101, 95, 111, 99, 99, 117, 112, 97, 116, 105, 111, 110,
-		0, 115, 99, 104, 101, 100, 95, 116, 97, 115, 107, 95, 103, 114, 111, 117,
-		112, 0, 115, 116, 97, 116, 115, 0, 112, 114, 101, 101, 109, 112, 116, 95,
-		110, 111, 116, 105, 102, 105, 101, 114, 115, 0, 98, 116, 114, 97, 99, 101,
-		95, 115, 101, 113, 0, 112, 111, 108, 105, 99, 121, 0, 110, 114, 95, 99,
-		112, 117, 115, 95, 97, 108, 108, 111, 119, 101, 100, 0, 99, 112, 117, 115,
-		95, 112, 116, 114, 0, 117, 115, 101, 114, 95, 99, 112, 117, 115, 95, 112,
-		116, 114, 0, 99, 112, 117, 115, 95, 109, 97, 115, 107, 0, 109, 105, 103,
-		114, 97, 116, 105, 111, 110, 95, 112, 101, 110, 100, 105, 110, 103, 0, 109,
-		105, 103, 114, 97, 116, 105, 111, 110, 95, 100, 105, 115, 97, 98, 108, 101,
-		100, 0, 109, 105, 103, 114, 97, 116, 105, 111, 110, 95, 102, 108, 97, 103,
-		115, 0, 114, 99, 117, 95, 114, 101, 97, 100, 95, 108, 111, 99, 107, 95,
-		110, 101, 115, 116, 105, 110, 103, 0, 114, 99, 117, 95, 114, 101, 97, 100,
-		95, 117, 110, 108, 111, 99, 107, 95, 115, 112, 101, 99, 105, 97, 108, 0,
-		114, 99, 117, 95, 110, 111, 100, 101, 95, 101, 110, 116, 114, 121, 0, 114,
-		99, 117, 95, 98, 108, 111, 99, 107, 101, 100, 95, 110, 111, 100, 101, 0,
-		114, 99, 117, 95, 116, 97, 115, 107, 115, 95, 110, 118, 99, 115, 119, 0,
-		114, 99, 117, 95, 116, 97, 115, 107, 115, 95, 104, 111, 108, 100, 111, 117,
-		116, 0, 114, 99, 117, 95, 116, 97, 115, 107, 115, 95, 105, 100, 120, 0,
-		114, 99, 117, 95, 116, 97, 115, 107, 115, 95, 105, 100, 108, 101, 95, 99,
-		112, 117, 0, 114, 99, 117, 95, 116, 97, 115, 107, 115, 95, 104, 111, 108,
-		100, 111, 117, 116, 95, 108, 105, 115, 116, 0, 116, 114, 99, 95, 114, 101,
-		97, 100, 101, 114, 95, 110, 101, 115, 116, 105, 110, 103, 0, 116, 114, 99,
-		95, 105, 112, 105, 95, 116, 111, 95, 99, 112, 117, 0, 116, 114, 99, 95,
-		114, 101, 97, 100, 101, 114, 95, 115, 112, 101, 99, 105, 97, 108, 0, 116,
-		114, 99, 95, 104, 111, 108, 100, 111, 117, 116, 95, 108, 105, 115, 116, 0,
-		116, 114, 99, 95, 98, 108, 107, 100, 95, 110, 111, 100, 101, 0, 116, 114,
-		99, 95, 98, 108, 107, 100, 95, 99, 112, 117, 0, 115, 99, 104, 101, 100,
-		95, 105, 110, 102, 111, 0, 116, 97, 115, 107, 115, 0, 112, 117, 115, 104,
-		97, 98, 108, 101, 95, 116, 97, 115, 107, 115, 0, 112, 117, 115, 104, 97,
-		98, 108, 101, 95, 100, 108, 95, 116, 97, 115, 107, 115, 0, 109, 109, 0,
-		97, 99, 116, 105, 118, 101, 95, 109, 109, 0, 118, 109, 97, 99, 97, 99,
-		104, 101, 0, 114, 115, 115, 95, 115, 116, 97, 116, 0, 101, 120, 105, 116,
-		95, 115, 116, 97, 116, 101, 0, 101, 120, 105, 116, 95, 99, 111, 100, 101,
-		0, 101, 120, 105, 116, 95, 115, 105, 103, 110, 97, 108, 0, 112, 100, 101,
-		97, 116, 104, 95, 115, 105, 103, 110, 97, 108, 0, 106, 111, 98, 99, 116,
-		108, 0, 112, 101, 114, 115, 111, 110, 97, 108, 105, 116, 121, 0, 115, 99,
-		104, 101, 100, 95, 114, 101, 115, 101, 116, 95, 111, 110, 95, 102, 111, 114,
-		107, 0, 115, 99, 104, 101, 100, 95, 99, 111, 110, 116, 114, 105, 98, 117,
-		116, 101, 115, 95, 116, 111, 95, 108, 111, 97, 100, 0, 115, 99, 104, 101,
-		100, 95, 109, 105, 103, 114, 97, 116, 101, 100, 0, 115, 99, 104, 101, 100,
-		95, 112, 115, 105, 95, 119, 97, 107, 101, 95, 114, 101, 113, 117, 101, 117,
-		101, 0, 115, 99, 104, 101, 100, 95, 114, 101, 109, 111, 116, 101, 95, 119,
-		97, 107, 101, 117, 112, 0, 105, 110, 95, 101, 120, 101, 99, 118, 101, 0,
-		105, 110, 95, 105, 111, 119, 97, 105, 116, 0, 114, 101, 115, 116, 111, 114,
-		101, 95, 115, 105, 103, 109, 97, 115, 107, 0, 105, 110, 95, 117, 115, 101,
-		114, 95, 102, 97, 117, 108, 116, 0, 110, 111, 95, 99, 103, 114, 111, 117,
-		112, 95, 109, 105, 103, 114, 97, 116, 105, 111, 110, 0, 102, 114, 111, 122,
-		101, 110, 0, 117, 115, 101, 95, 109, 101, 109, 100, 101, 108, 97, 121, 0,
-		105, 110, 95, 109, 101, 109, 115, 116, 97, 108, 108, 0, 105, 110, 95, 112,
-		97, 103, 101, 95, 111, 119, 110, 101, 114, 0, 105, 110, 95, 101, 118, 101,
-		110, 116, 102, 100, 0, 112, 97, 115, 105, 100, 95, 97, 99, 116, 105, 118,
-		97, 116, 101, 100, 0, 114, 101, 112, 111, 114, 116, 101, 100, 95, 115, 112,
-		108, 105, 116, 95, 108, 111, 99, 107, 0, 97, 116, 111, 109, 105, 99, 95,
-		102, 108, 97, 103, 115, 0, 114, 101, 115, 116, 97, 114, 116, 95, 98, 108,
-		111, 99, 107, 0, 116, 103, 105, 100, 0, 115, 116, 97, 99, 107, 95, 99,
-		97, 110, 97, 114, 121, 0, 114, 101, 97, 108, 95, 112, 97, 114, 101, 110,
-		116, 0, 112, 97, 114, 101, 110, 116, 0, 99, 104, 105, 108, 100, 114, 101,
-		110, 0, 115, 105, 98, 108, 105, 110, 103, 0, 103, 114, 111, 117, 112, 95,
-		108, 101, 97, 100, 101, 114, 0, 112, 116, 114, 97, 99, 101, 100, 0, 112,
-		116, 114, 97, 99, 101, 95, 101, 110, 116, 114, 121, 0, 116, 104, 114, 101,
-		97, 100, 95, 112, 105, 100, 0, 112, 105, 100, 95, 108, 105, 110, 107, 115,
-		0, 116, 104, 114, 101, 97, 100, 95, 103, 114, 111, 117, 112, 0, 116, 104,
-		114, 101, 97, 100, 95, 110, 111, 100, 101, 0, 118, 102, 111, 114, 107, 95,
-		100, 111, 110, 101, 0, 115, 101, 116, 95, 99, 104, 105, 108, 100, 95, 116,
-		105, 100, 0, 99, 108, 101, 97, 114, 95, 99, 104, 105, 108, 100, 95, 116,
-		105, 100, 0, 119, 111, 114, 107, 101, 114, 95, 112, 114, 105, 118, 97, 116,
-		101, 0, 117, 116, 105, 109, 101, 0, 115, 116, 105, 109, 101, 0, 103, 116,
-		105, 109, 101, 0, 112, 114, 101, 118, 95, 99, 112, 117, 116, 105, 109, 101,
-		0, 118, 116, 105, 109, 101, 0, 116, 105, 99, 107, 95, 100, 101, 112, 95,
-		109, 97, 115, 107, 0, 110, 118, 99, 115, 119, 0, 110, 105, 118, 99, 115,
-		119, 0, 115, 116, 97, 114, 116, 95, 116, 105, 109, 101, 0, 115, 116, 97,
-		114, 116, 95, 98, 111, 111, 116, 116, 105, 109, 101, 0, 109, 105, 110, 95,
-		102, 108, 116, 0, 109, 97, 106, 95, 102, 108, 116, 0, 112, 111, 115, 105,
-		120, 95, 99, 112, 117, 116, 105, 109, 101, 114, 115, 0, 112, 111, 115, 105,
-		120, 95, 99, 112, 117, 116, 105, 109, 101, 114, 115, 95, 119, 111, 114, 107,
-		0, 112, 116, 114, 97, 99, 101, 114, 95, 99, 114, 101, 100, 0, 114, 101,
-		97, 108, 95, 99, 114, 101, 100, 0, 99, 114, 101, 100, 0, 99, 97, 99,
-		104, 101, 100, 95, 114, 101, 113, 117, 101, 115, 116, 101, 100, 95, 107, 101,
-		121, 0, 99, 111, 109, 109, 0, 110, 97, 109, 101, 105, 100, 97, 116, 97,
-		0, 115, 121, 115, 118, 115, 101, 109, 0, 115, 121, 115, 118, 115, 104, 109,
-		0, 102, 115, 0, 102, 105, 108, 101, 115, 0, 105, 111, 95, 117, 114, 105,
-		110, 103, 0, 110, 115, 112, 114, 111, 120, 121, 0, 115, 105, 103, 110, 97,
-		108, 0, 115, 105, 103, 104, 97, 110, 100, 0, 98, 108, 111, 99, 107, 101,
-		100, 0, 114, 101, 97, 108, 95, 98, 108, 111, 99, 107, 101, 100, 0, 115,
-		97, 118, 101, 100, 95, 115, 105, 103, 109, 97, 115, 107, 0, 112, 101, 110,
-		100, 105, 110, 103, 0, 115, 97, 115, 95, 115, 115, 95, 115, 112, 0, 115,
-		97, 115, 95, 115, 115, 95, 115, 105, 122, 101, 0, 115, 97, 115, 95, 115,
-		115, 95, 102, 108, 97, 103, 115, 0, 116, 97, 115, 107, 95, 119, 111, 114,
-		107, 115, 0, 97, 117, 100, 105, 116, 95, 99, 111, 110, 116, 101, 120, 116,
-		0, 108, 111, 103, 105, 110, 117, 105, 100, 0, 115, 101, 115, 115, 105, 111,
-		110, 105, 100, 0, 115, 101, 99, 99, 111, 109, 112, 0, 115, 121, 115, 99,
-		97, 108, 108, 95, 100, 105, 115, 112, 97, 116, 99, 104, 0, 112, 97, 114,
-		101, 110, 116, 95, 101, 120, 101, 99, 95, 105, 100, 0, 115, 101, 108, 102,
-		95, 101, 120, 101, 99, 95, 105, 100, 0, 97, 108, 108, 111, 99, 95, 108,
-		111, 99, 107, 0, 112, 105, 95, 108, 111, 99, 107, 0, 119, 97, 107, 101,
-		95, 113, 0, 112, 105, 95, 119, 97, 105, 116, 101, 114, 115, 0, 112, 105,
-		95, 116, 111, 112, 95, 116, 97, 115, 107, 0, 112, 105, 95, 98, 108, 111,
-		99, 107, 101, 100, 95, 111, 110, 0, 106, 111, 117, 114, 110, 97, 108, 95,
-		105, 110, 102, 111, 0, 98, 105, 111, 95, 108, 105, 115, 116, 0, 112, 108,
-		117, 103, 0, 114, 101, 99, 108, 97, 105, 109, 95, 115, 116, 97, 116, 101,
-		0, 98, 97, 99, 107, 105, 110, 103, 95, 100, 101, 118, 95, 105, 110, 102,
-		111, 0, 105, 111, 95, 99, 111, 110, 116, 101, 120, 116, 0, 99, 97, 112,
-		116, 117, 114, 101, 95, 99, 111, 110, 116, 114, 111, 108, 0, 112, 116, 114,
-		97, 99, 101, 95, 109, 101, 115, 115, 97, 103, 101, 0, 108, 97, 115, 116,
-		95, 115, 105, 103, 105, 110, 102, 111, 0, 105, 111, 97, 99, 0, 112, 115,
-		105, 95, 102, 108, 97, 103, 115, 0, 97, 99, 99, 116, 95, 114, 115, 115,
-		95, 109, 101, 109, 49, 0, 97, 99, 99, 116, 95, 118, 109, 95, 109, 101,
-		109, 49, 0, 97, 99, 99, 116, 95, 116, 105, 109, 101, 120, 112, 100, 0,
-		109, 101, 109, 115, 95, 97, 108, 108, 111, 119, 101, 100, 0, 109, 101, 109,
-		115, 95, 97, 108, 108, 111, 119, 101, 100, 95, 115, 101, 113, 0, 99, 112,
-		117, 115, 101, 116, 95, 109, 101, 109, 95, 115, 112, 114, 101, 97, 100, 95,
-		114, 111, 116, 111, 114, 0, 99, 112, 117, 115, 101, 116, 95, 115, 108, 97,
-		98, 95, 115, 112, 114, 101, 97, 100, 95, 114, 111, 116, 111, 114, 0, 99,
-		103, 114, 111, 117, 112, 115, 0, 99, 103, 95, 108, 105, 115, 116, 0, 99,
-		108, 111, 115, 105, 100, 0, 114, 109, 105, 100, 0, 114, 111, 98, 117, 115,
-		116, 95, 108, 105, 115, 116, 0, 99, 111, 109, 112, 97, 116, 95, 114, 111,
-		98, 117, 115, 116, 95, 108, 105, 115, 116, 0, 112, 105, 95, 115, 116, 97,
-		116, 101, 95, 108, 105, 115, 116, 0, 112, 105, 95, 115, 116, 97, 116, 101,
-		95, 99, 97, 99, 104, 101, 0, 102, 117, 116, 101, 120, 95, 101, 120, 105,
-		116, 95, 109, 117, 116, 101, 120, 0, 102, 117, 116, 101, 120, 95, 115, 116,
-		97, 116, 101, 0, 112, 101, 114, 102, 95, 101, 118, 101, 110, 116, 95, 99,
-		116, 120, 112, 0, 112, 101, 114, 102, 95, 101, 118, 101, 110, 116, 95, 109,
-		117, 116, 101, 120, 0, 112, 101, 114, 102, 95, 101, 118, 101, 110, 116, 95,
-		108, 105, 115, 116, 0, 112, 114, 101, 101, 109, 112, 116, 95, 100, 105, 115,
-		97, 98, 108, 101, 95, 105, 112, 0, 109, 101, 109, 112, 111, 108, 105, 99,
-		121, 0, 105, 108, 95, 112, 114, 101, 118, 0, 112, 114, 101, 102, 95, 110,
-		111, 100, 101, 95, 102, 111, 114, 107, 0, 110, 117, 109, 97, 95, 115, 99,
-		97, 110, 95, 115, 101, 113, 0, 110, 117, 109, 97, 95, 115, 99, 97, 110,
-		95, 112, 101, 114, 105, 111, 100, 0, 110, 117, 109, 97, 95, 115, 99, 97,
-		110, 95, 112, 101, 114, 105, 111, 100, 95, 109, 97, 120, 0, 110, 117, 109,
-		97, 95, 112, 114, 101, 102, 101, 114, 114, 101, 100, 95, 110, 105, 100, 0,
-		110, 117, 109, 97, 95, 109, 105, 103, 114, 97, 116, 101, 95, 114, 101, 116,
-		114, 121, 0, 110, 111, 100, 101, 95, 115, 116, 97, 109, 112, 0, 108, 97,
-		115, 116, 95, 116, 97, 115, 107, 95, 110, 117, 109, 97, 95, 112, 108, 97,
-		99, 101, 109, 101, 110, 116, 0, 108, 97, 115, 116, 95, 115, 117, 109, 95,
-		101, 120, 101, 99, 95, 114, 117, 110, 116, 105, 109, 101, 0, 110, 117, 109,
-		97, 95, 119, 111, 114, 107, 0, 110, 117, 109, 97, 95, 103, 114, 111, 117,
-		112, 0, 110, 117, 109, 97, 95, 102, 97, 117, 108, 116, 115, 0, 116, 111,
-		116, 97, 108, 95, 110, 117, 109, 97, 95, 102, 97, 117, 108, 116, 115, 0,
-		110, 117, 109, 97, 95, 102, 97, 117, 108, 116, 115, 95, 108, 111, 99, 97,
-		108, 105, 116, 121, 0, 110, 117, 109, 97, 95, 112, 97, 103, 101, 115, 95,
-		109, 105, 103, 114, 97, 116, 101, 100, 0, 114, 115, 101, 113, 0, 114, 115,
-		101, 113, 95, 115, 105, 103, 0, 114, 115, 101, 113, 95, 101, 118, 101, 110,
-		116, 95, 109, 97, 115, 107, 0, 116, 108, 98, 95, 117, 98, 99, 0, 115,
-		112, 108, 105, 99, 101, 95, 112, 105, 112, 101, 0, 116, 97, 115, 107, 95,
-		102, 114, 97, 103, 0, 100, 101, 108, 97, 121, 115, 0, 110, 114, 95, 100,
-		105, 114, 116, 105, 101, 100, 0, 110, 114, 95, 100, 105, 114, 116, 105, 101,
-		100, 95, 112, 97, 117, 115, 101, 0, 100, 105, 114, 116, 121, 95, 112, 97,
-		117, 115, 101, 100, 95, 119, 104, 101, 110, 0, 108, 97, 116, 101, 110, 99,
-		121, 95, 114, 101, 99, 111, 114, 100, 95, 99, 111, 117, 110, 116, 0, 108,
-		97, 116, 101, 110, 99, 121, 95, 114, 101, 99, 111, 114, 100, 0, 116, 105,
-		109, 101, 114, 95, 115, 108, 97, 99, 107, 95, 110, 115, 0, 100, 101, 102,
-		97, 117, 108, 116, 95, 116, 105, 109, 101, 114, 95, 115, 108, 97, 99, 107,
-		95, 110, 115, 0, 107, 117, 110, 105, 116, 95, 116, 101, 115, 116, 0, 99,
-		117, 114, 114, 95, 114, 101, 116, 95, 115, 116, 97, 99, 107, 0, 99, 117,
-		114, 114, 95, 114, 101, 116, 95, 100, 101, 112, 116, 104, 0, 114, 101, 116,
-		95, 115, 116, 97, 99, 107, 0, 102, 116, 114, 97, 99, 101, 95, 116, 105,
-		109, 101, 115, 116, 97, 109, 112, 0, 116, 114, 97, 99, 101, 95, 111, 118,
-		101, 114, 114, 117, 110, 0, 116, 114, 97, 99, 105, 110, 103, 95, 103, 114,
-		97, 112, 104, 95, 112, 97, 117, 115, 101, 0, 116, 114, 97, 99, 101, 0,
-		116, 114, 97, 99, 101, 95, 114, 101, 99, 117, 114, 115, 105, 111, 110, 0,
-		109, 101, 109, 99, 103, 95, 105, 110, 95, 111, 111, 109, 0, 109, 101, 109,
-		99, 103, 95, 111, 111, 109, 95, 103, 102, 112, 95, 109, 97, 115, 107, 0,
-		109, 101, 109, 99, 103, 95, 111, 111, 109, 95, 111, 114, 100, 101, 114, 0,
-		109, 101, 109, 99, 103, 95, 110, 114, 95, 112, 97, 103, 101, 115, 95, 111,
-		118, 101, 114, 95, 104, 105, 103, 104, 0, 97, 99, 116, 105, 118, 101, 95,
-		109, 101, 109, 99, 103, 0, 116, 104, 114, 111, 116, 116, 108, 101, 95, 113,
-		117, 101, 117, 101, 0, 117, 116, 97, 115, 107, 0, 115, 101, 113, 117, 101,
-		110, 116, 105, 97, 108, 95, 105, 111, 0, 115, 101, 113, 117, 101, 110, 116,
-		105, 97, 108, 95, 105, 111, 95, 97, 118, 103, 0, 107, 109, 97, 112, 95,
-		99, 116, 114, 108, 0, 112, 97, 103, 101, 102, 97, 117, 108, 116, 95, 100,
-		105, 115, 97, 98, 108, 101, 100, 0, 111, 111, 109, 95, 114, 101, 97, 112,
-		101, 114, 95, 108, 105, 115, 116, 0, 111, 111, 109, 95, 114, 101, 97, 112,
-		101, 114, 95, 116, 105, 109, 101, 114, 0, 115, 116, 97, 99, 107, 95, 118,
-		109, 95, 97, 114, 101, 97, 0, 115, 116, 97, 99, 107, 95, 114, 101, 102,
-		99, 111, 117, 110, 116, 0, 112, 97, 116, 99, 104, 95, 115, 116, 97, 116,
-		101, 0, 115, 101, 99, 117, 114, 105, 116, 121, 0, 98, 112, 102, 95, 115,
-		116, 111, 114, 97, 103, 101, 0, 98, 112, 102, 95, 99, 116, 120, 0, 109,
-		99, 101, 95, 118, 97, 100, 100, 114, 0, 109, 99, 101, 95, 107, 102, 108,
-		97, 103, 115, 0, 109, 99, 101, 95, 97, 100, 100, 114, 0, 109, 99, 101,
-		95, 114, 105, 112, 118, 0, 109, 99, 101, 95, 119, 104, 111, 108, 101, 95,
-		112, 97, 103, 101, 0, 95, 95, 109, 99, 101, 95, 114, 101, 115, 101, 114,
-		118, 101, 100, 0, 109, 99, 101, 95, 107, 105, 108, 108, 95, 109, 101, 0,
-		109, 99, 101, 95, 99, 111, 117, 110, 116, 0, 107, 114, 101, 116, 112, 114,
-		111, 98, 101, 95, 105, 110, 115, 116, 97, 110, 99, 101, 115, 0, 114, 101,
-		116, 104, 111, 111, 107, 115, 0, 108, 49, 100, 95, 102, 108, 117, 115, 104,
-		95, 107, 105, 108, 108, 0, 116, 104, 114, 101, 97, 100, 0, 115, 121, 115,
-		99, 97, 108, 108, 95, 119, 111, 114, 107, 0, 115, 116, 97, 116, 117, 115,
-		0, 99, 112, 117, 0, 114, 101, 102, 99, 111, 117, 110, 116, 95, 116, 0,
-		114, 101, 102, 99, 111, 117, 110, 116, 95, 115, 116, 114, 117, 99, 116, 0,
-		114, 101, 102, 115, 0, 97, 116, 111, 109, 105, 99, 95, 116, 0, 99, 111,
-		117, 110, 116, 101, 114, 0, 95, 95, 99, 97, 108, 108, 95, 115, 105, 110,
-		103, 108, 101, 95, 110, 111, 100, 101, 0, 108, 108, 105, 115, 116, 0, 115,
-		114, 99, 0, 100, 115, 116, 0, 108, 108, 105, 115, 116, 95, 110, 111, 100,
-		101, 0, 110, 101, 120, 116, 0, 117, 95, 102, 108, 97, 103, 115, 0, 97,
-		95, 102, 108, 97, 103, 115, 0, 117, 49, 54, 0, 95, 95, 117, 49, 54,
-		0, 115, 99, 104, 101, 100, 95, 101, 110, 116, 105, 116, 121, 0, 108, 111,
-		97, 100, 0, 114, 117, 110, 95, 110, 111, 100, 101, 0, 103, 114, 111, 117,
-		112, 95, 110, 111, 100, 101, 0, 101, 120, 101, 99, 95, 115, 116, 97, 114,
-		116, 0, 115, 117, 109, 95, 101, 120, 101, 99, 95, 114, 117, 110, 116, 105,
-		109, 101, 0, 118, 114, 117, 110, 116, 105, 109, 101, 0, 112, 114, 101, 118,
-		95, 115, 117, 109, 95, 101, 120, 101, 99, 95, 114, 117, 110, 116, 105, 109,
-		101, 0, 110, 114, 95, 109, 105, 103, 114, 97, 116, 105, 111, 110, 115, 0,
-		100, 101, 112, 116, 104, 0, 99, 102, 115, 95, 114, 113, 0, 109, 121, 95,
-		113, 0, 114, 117, 110, 110, 97, 98, 108, 101, 95, 119, 101, 105, 103, 104,
-		116, 0, 97, 118, 103, 0, 108, 111, 97, 100, 95, 119, 101, 105, 103, 104,
-		116, 0, 119, 101, 105, 103, 104, 116, 0, 105, 110, 118, 95, 119, 101, 105,
-		103, 104, 116, 0, 114, 98, 95, 110, 111, 100, 101, 0, 95, 95, 114, 98,
-		95, 112, 97, 114, 101, 110, 116, 95, 99, 111, 108, 111, 114, 0, 114, 98,
-		95, 114, 105, 103, 104, 116, 0, 114, 98, 95, 108, 101, 102, 116, 0, 108,
-		105, 115, 116, 95, 104, 101, 97, 100, 0, 112, 114, 101, 118, 0, 117, 54,
-		52, 0, 95, 95, 117, 54, 52, 0, 117, 110, 115, 105, 103, 110, 101, 100,
-		32, 108, 111, 110, 103, 32, 108, 111, 110, 103, 0, 110, 114, 95, 114, 117,
-		110, 110, 105, 110, 103, 0, 104, 95, 110, 114, 95, 114, 117, 110, 110, 105,
-		110, 103, 0, 105, 100, 108, 101, 95, 110, 114, 95, 114, 117, 110, 110, 105,
-		110, 103, 0, 105, 100, 108, 101, 95, 104, 95, 110, 114, 95, 114, 117, 110,
-		110, 105, 110, 103, 0, 101, 120, 101, 99, 95, 99, 108, 111, 99, 107, 0,
-		109, 105, 110, 95, 118, 114, 117, 110, 116, 105, 109, 101, 0, 102, 111, 114,
-		99, 101, 105, 100, 108, 101, 95, 115, 101, 113, 0, 109, 105, 110, 95, 118,
-		114, 117, 110, 116, 105, 109, 101, 95, 102, 105, 0, 116, 97, 115, 107, 115,
-		95, 116, 105, 109, 101, 108, 105, 110, 101, 0, 99, 117, 114, 114, 0, 108,
-		97, 115, 116, 0, 115, 107, 105, 112, 0, 110, 114, 95, 115, 112, 114, 101,
-		97, 100, 95, 111, 118, 101, 114, 0, 114, 101, 109, 111, 118, 101, 100, 0,
-		116, 103, 95, 108, 111, 97, 100, 95, 97, 118, 103, 95, 99, 111, 110, 116,
-		114, 105, 98, 0, 112, 114, 111, 112, 97, 103, 97, 116, 101, 0, 112, 114,
-		111, 112, 95, 114, 117, 110, 110, 97, 98, 108, 101, 95, 115, 117, 109, 0,
-		104, 95, 108, 111, 97, 100, 0, 108, 97, 115, 116, 95, 104, 95, 108, 111,
-		97, 100, 95, 117, 112, 100, 97, 116, 101, 0, 104, 95, 108, 111, 97, 100,
-		95, 110, 101, 120, 116, 0, 114, 113, 0, 111, 110, 95, 108, 105, 115, 116,
-		0, 108, 101, 97, 102, 95, 99, 102, 115, 95, 114, 113, 95, 108, 105, 115,
-		116, 0, 116, 103, 0, 105, 100, 108, 101, 0, 114, 117, 110, 116, 105, 109,
-		101, 95, 101, 110, 97, 98, 108, 101, 100, 0, 114, 117, 110, 116, 105, 109,
-		101, 95, 114, 101, 109, 97, 105, 110, 105, 110, 103, 0, 116, 104, 114, 111,
-		116, 116, 108, 101, 100, 95, 112, 101, 108, 116, 95, 105, 100, 108, 101, 0,
-		116, 104, 114, 111, 116, 116, 108, 101, 100, 95, 99, 108, 111, 99, 107, 0,
-		116, 104, 114, 111, 116, 116, 108, 101, 100, 95, 99, 108, 111, 99, 107, 95,
-		112, 101, 108, 116, 0, 116, 104, 114, 111, 116, 116, 108, 101, 100, 95, 99,
-		108, 111, 99, 107, 95, 112, 101, 108, 116, 95, 116, 105, 109, 101, 0, 116,
-		104, 114, 111, 116, 116, 108, 101, 100, 0, 116, 104, 114, 111, 116, 116, 108,
-		101, 95, 99, 111, 117, 110, 116, 0, 116, 104, 114, 111, 116, 116, 108, 101,
-		100, 95, 108, 105, 115, 116, 0, 114, 98, 95, 114, 111, 111, 116, 95, 99,
-		97, 99, 104, 101, 100, 0, 114, 98, 95, 114, 111, 111, 116, 0, 114, 98,
-		95, 108, 101, 102, 116, 109, 111, 115, 116, 0, 115, 99, 104, 101, 100, 95,
-		97, 118, 103, 0, 108, 97, 115, 116, 95, 117, 112, 100, 97, 116, 101, 95,
-		116, 105, 109, 101, 0, 108, 111, 97, 100, 95, 115, 117, 109, 0, 114, 117,
-		110, 110, 97, 98, 108, 101, 95, 115, 117, 109, 0, 117, 116, 105, 108, 95,
-		115, 117, 109, 0, 112, 101, 114, 105, 111, 100, 95, 99, 111, 110, 116, 114,
-		105, 98, 0, 108, 111, 97, 100, 95, 97, 118, 103, 0, 114, 117, 110, 110,
-		97, 98, 108, 101, 95, 97, 118, 103, 0, 117, 116, 105, 108, 95, 97, 118,
-		103, 0, 117, 116, 105, 108, 95, 101, 115, 116, 0, 101, 110, 113, 117, 101,
-		117, 101, 100, 0, 101, 119, 109, 97, 0, 108, 111, 99, 107, 0, 110, 114,
+		0, 0, 0, 0, 0, 0, 0, 32, 0, 0, 0, 33, 0, 0, 0, 0,
+		0, 0, 0, 32, 0, 0, 0, 56, 50, 0, 0, 4, 0, 0, 15, 0,
+		0, 0, 0, 132, 1, 0, 0, 0, 0, 0, 0, 64, 0, 0, 0, 135,
+		1, 0, 0, 64, 0, 0, 0, 45, 0, 0, 0, 137, 1, 0, 0, 109,
+		0, 0, 0, 0, 4, 0, 0, 139, 1, 0, 0, 109, 4, 0, 0, 72,
+		0, 0, 0, 64, 50, 0, 0, 1, 0, 0, 15, 0, 0, 0, 0, 128,
+		1, 0, 0, 0, 0, 0, 0, 13, 0, 0, 0, 72, 50, 0, 0, 0,
+		0, 0, 7, 0, 0, 0, 0, 152, 21, 0, 0, 0, 0, 0, 7, 0,
+		0, 0, 0, 90, 50, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 222,
+		8, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 156, 9, 0, 0, 0,
+		0, 0, 7, 0, 0, 0, 0, 128, 9, 0, 0, 0, 0, 0, 7, 0,
+		0, 0, 0, 104, 50, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 113,
+		50, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 131, 50, 0, 0, 0,
+		0, 0, 7, 0, 0, 0, 0, 140, 50, 0, 0, 0, 0, 0, 7, 0,
+		0, 0, 0, 184, 9, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 17,
+		16, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 152, 50, 0, 0, 0,
+		0, 0, 7, 0, 0, 0, 0, 169, 50, 0, 0, 0, 0, 0, 7, 0,
+		0, 0, 0, 193, 50, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 35,
+		8, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 204, 50, 0, 0, 0,
+		0, 0, 7, 0, 0, 0, 0, 212, 50, 0, 0, 0, 0, 0, 7, 0,
+		0, 0, 0, 225, 50, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 239,
+		50, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 244, 50, 0, 0, 0,
+		0, 0, 7, 0, 0, 0, 0, 1, 51, 0, 0, 0, 0, 0, 7, 0,
+		0, 0, 0, 11, 51, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 23,
+		51, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 40, 51, 0, 0, 0,
+		0, 0, 7, 0, 0, 0, 0, 55, 51, 0, 0, 0, 0, 0, 7, 0,
+		0, 0, 0, 18, 29, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 173,
+		9, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 74, 51, 0, 0, 0,
+		0, 0, 7, 0, 0, 0, 0, 88, 51, 0, 0, 0, 0, 0, 7, 0,
+		0, 0, 0, 102, 51, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 65,
+		0, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 117, 51, 0, 0, 0,
+		0, 0, 7, 0, 0, 0, 0, 123, 51, 0, 0, 0, 0, 0, 7, 0,
+		0, 0, 0, 137, 51, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 35,
+		11, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 148, 51, 0, 0, 0,
+		0, 0, 7, 0, 0, 0, 0, 158, 51, 0, 0, 0, 0, 0, 7, 0,
+		0, 0, 0, 66, 8, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 164,
+		51, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 226, 11, 0, 0, 0,
+		0, 0, 7, 0, 0, 0, 0, 168, 51, 0, 0, 0, 0, 0, 7, 0,
+		0, 0, 0, 96, 28, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 183,
+		51, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 206, 51, 0, 0, 0,
+		0, 0, 7, 0, 0, 0, 0, 224, 51, 0, 0, 0, 0, 0, 7, 0,
+		0, 0, 0, 236, 51, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 1,
+		52, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 13, 52, 0, 0, 0,
+		0, 0, 7, 0, 0, 0, 0, 249, 0, 0, 0, 0, 0, 0, 7, 0,
+		0, 0, 0, 29, 52, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 43,
+		52, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 172, 26, 0, 0, 0,
+		0, 0, 7, 0, 0, 0, 0, 59, 52, 0, 0, 0, 0, 0, 7, 0,
+		0, 0, 0, 66, 52, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 85,
+		52, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 142, 9, 0, 0, 0,
+		0, 0, 7, 0, 0, 0, 0, 94, 52, 0, 0, 0, 0, 0, 7, 0,
+		0, 0, 0, 108, 52, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 52,
+		12, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 125, 52, 0, 0, 0,
+		0, 0, 7, 0, 0, 0, 0, 42, 3, 0, 0, 0, 0, 0, 7, 0,
+		0, 0, 0, 141, 52, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 156,
+		52, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 170, 52, 0, 0, 0,
+		0, 0, 7, 0, 0, 0, 0, 185, 52, 0, 0, 0, 0, 0, 7, 0,
+		0, 0, 0, 199, 52, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 215,
+		52, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 226, 52, 0, 0, 0,
+		0, 0, 7, 0, 0, 0, 0, 241, 52, 0, 0, 0, 0, 0, 7, 0,
+		0, 0, 0, 199, 43, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 2,
+		53, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 14, 53, 0, 0, 0,
+		0, 0, 7, 0, 0, 0, 0, 29, 53, 0, 0, 0, 0, 0, 7, 0,
+		0, 0, 0, 45, 53, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 59,
+		53, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 80, 53, 0, 0, 0,
+		0, 0, 7, 0, 0, 0, 0, 0, 105, 110, 116, 0, 95, 95, 65, 82,
+		82, 65, 89, 95, 83, 73, 90, 69, 95, 84, 89, 80, 69, 95, 95, 0,
+		117, 51, 50, 0, 95, 95, 117, 51, 50, 0, 117, 110, 115, 105, 103, 110,
+		101, 100, 32, 105, 110, 116, 0, 116, 121, 112, 101, 0, 109, 97, 120, 95,
+		101, 110, 116, 114, 105, 101, 115, 0, 107, 101, 121, 0, 118, 97, 108, 117,
+		101, 0, 112, 105, 100, 95, 109, 110, 116, 110, 115, 0, 101, 118, 101, 110,
+		116, 115, 0, 117, 56, 0, 95, 95, 117, 56, 0, 117, 110, 115, 105, 103,
+		110, 101, 100, 32, 99, 104, 97, 114, 0, 109, 110, 116, 110, 115, 95, 115,
+		121, 115, 99, 97, 108, 108, 115, 0, 117, 54, 52, 0, 95, 95, 117, 54,
+		52, 0, 117, 110, 115, 105, 103, 110, 101, 100, 32, 108, 111, 110, 103, 32,
+		108, 111, 110, 103, 0, 109, 97, 112, 95, 111, 118, 101, 114, 102, 108, 111,
+		119, 115, 0, 116, 114, 97, 99, 101, 95, 101, 118, 101, 110, 116, 95, 114,
+		97, 119, 95, 115, 121, 115, 95, 101, 110, 116, 101, 114, 0, 101, 110, 116,
+		0, 105, 100, 0, 97, 114, 103, 115, 0, 95, 95, 100, 97, 116, 97, 0,
+		116, 114, 97, 99, 101, 95, 101, 110, 116, 114, 121, 0, 102, 108, 97, 103,
+		115, 0, 112, 114, 101, 101, 109, 112, 116, 95, 99, 111, 117, 110, 116, 0,
+		112, 105, 100, 0, 117, 110, 115, 105, 103, 110, 101, 100, 32, 115, 104, 111,
+		114, 116, 0, 108, 111, 110, 103, 0, 117, 110, 115, 105, 103, 110, 101, 100,
+		32, 108, 111, 110, 103, 0, 99, 104, 97, 114, 0, 115, 121, 115, 95, 101,
+		110, 116, 101, 114, 0, 116, 114, 97, 99, 101, 112, 111, 105, 110, 116, 47,
+		114, 97, 119, 95, 115, 121, 115, 99, 97, 108, 108, 115, 47, 115, 121, 115,
+		95, 101, 110, 116, 101, 114, 0, 48, 58, 49, 0, 47, 114, 111, 111, 116,
+		47, 109, 111, 100, 117, 108, 101, 47, 105, 110, 116, 101, 114, 110, 97, 108,
+		47, 112, 107, 103, 47, 100, 97, 101, 109, 111, 110, 47, 98, 112, 102, 114,
+		101, 99, 111, 114, 100, 101, 114, 47, 98, 112, 102, 47, 114, 101, 99, 111,
+		114, 100, 101, 114, 46, 98, 112, 102, 46, 99, 0, 32, 32, 32, 32, 117,
+		51, 50, 32, 115, 121, 115, 99, 97, 108, 108, 95, 105, 100, 32, 61, 32,
+		97, 114, 103, 115, 45, 62, 105, 100, 59, 0, 32, 32, 32, 32, 105, 102,
+		32, 40, 115, 121, 115, 99, 97, 108, 108, 95, 105, 100, 32, 60, 32, 48,
+		32, 124, 124, 32, 115, 121, 115, 99, 97, 108, 108, 95, 105, 100, 32, 62,
+		61, 32, 77, 65, 88, 95, 83, 89, 83, 67, 65, 76, 76, 83, 41, 32,
+		123, 0, 32, 32, 32, 32, 117, 51, 50, 32, 112, 105, 100, 32, 61, 32,
+		98, 112, 102, 95, 103, 101, 116, 95, 99, 117, 114, 114, 101, 110, 116, 95,
+		112, 105, 100, 95, 116, 103, 105, 100, 40, 41, 32, 62, 62, 32, 51, 50,
+		59, 0, 32, 32, 32, 32, 115, 116, 114, 117, 99, 116, 32, 116, 97, 115,
+		107, 95, 115, 116, 114, 117, 99, 116, 32, 42, 32, 116, 97, 115, 107, 32,
+		61, 32, 40, 115, 116, 114, 117, 99, 116, 32, 116, 97, 115, 107, 95, 115,
+		116, 114, 117, 99, 116, 32, 42, 41, 98, 112, 102, 95, 103, 101, 116, 95,
+		99, 117, 114, 114, 101, 110, 116, 95, 116, 97, 115, 107, 40, 41, 59, 0,
+		116, 97, 115, 107, 95, 115, 116, 114, 117, 99, 116, 0, 116, 104, 114, 101,
+		97, 100, 95, 105, 110, 102, 111, 0, 95, 95, 115, 116, 97, 116, 101, 0,
+		115, 116, 97, 99, 107, 0, 117, 115, 97, 103, 101, 0, 112, 116, 114, 97,
+		99, 101, 0, 111, 110, 95, 99, 112, 117, 0, 119, 97, 107, 101, 95, 101,
+		110, 116, 114, 121, 0, 119, 97, 107, 101, 101, 95, 102, 108, 105, 112, 115,
+		0, 119, 97, 107, 101, 101, 95, 102, 108, 105, 112, 95, 100, 101, 99, 97,
+		121, 95, 116, 115, 0, 108, 97, 115, 116, 95, 119, 97, 107, 101, 101, 0,
+		114, 101, 99, 101, 110, 116, 95, 117, 115, 101, 100, 95, 99, 112, 117, 0,
+		119, 97, 107, 101, 95, 99, 112, 117, 0, 111, 110, 95, 114, 113, 0, 112,
+		114, 105, 111, 0, 115, 116, 97, 116, 105, 99, 95, 112, 114, 105, 111, 0,
+		110, 111, 114, 109, 97, 108, 95, 112, 114, 105, 111, 0, 114, 116, 95, 112,
+		114, 105, 111, 114, 105, 116, 121, 0, 115, 101, 0, 114, 116, 0, 100, 108,
+		0, 115, 99, 104, 101, 100, 95, 99, 108, 97, 115, 115, 0, 99, 111, 114,
+		101, 95, 110, 111, 100, 101, 0, 99, 111, 114, 101, 95, 99, 111, 111, 107,
+		105, 101, 0, 99, 111, 114, 101, 95, 111, 99, 99, 117, 112, 97, 116, 105,
+		111, 110, 0, 115, 99, 104, 101, 100, 95, 116, 97, 115, 107, 95, 103, 114,
+		111, 117, 112, 0, 115, 116, 97, 116, 115, 0, 112, 114, 101, 101, 109, 112,
+		116, 95, 110, 111, 116, 105, 102, 105, 101, 114, 115, 0, 98, 116, 114, 97,
+		99, 101, 95, 115, 101, 113, 0, 112, 111, 108, 105, 99, 121, 0, 110, 114,
+		95, 99, 112, 117, 115, 95, 97, 108, 108, 111, 119, 101, 100, 0, 99, 112,
+		117, 115, 95, 112, 116, 114, 0, 117, 115, 101, 114, 95, 99, 112, 117, 115,
+		95, 112, 116, 114, 0, 99, 112, 117, 115, 95, 109, 97, 115, 107, 0, 109,
+		105, 103, 114, 97, 116, 105, 111, 110, 95, 112, 101, 110, 100, 105, 110, 103,
+		0, 109, 105, 103, 114, 97, 116, 105, 111, 110, 95, 100, 105, 115, 97, 98,
+		108, 101, 100, 0, 109, 105, 103, 114, 97, 116, 105, 111, 110, 95, 102, 108,
+		97, 103, 115, 0, 114, 99, 117, 95, 114, 101, 97, 100, 95, 108, 111, 99,
+		107, 95, 110, 101, 115, 116, 105, 110, 103, 0, 114, 99, 117, 95, 114, 101,
+		97, 100, 95, 117, 110, 108, 111, 99, 107, 95, 115, 112, 101, 99, 105, 97,
+		108, 0, 114, 99, 117, 95, 110, 111, 100, 101, 95, 101, 110, 116, 114, 121,
+		0, 114, 99, 117, 95, 98, 108, 111, 99, 107, 101, 100, 95, 110, 111, 100,
+		101, 0, 114, 99, 117, 95, 116, 97, 115, 107, 115, 95, 110, 118, 99, 115,
+		119, 0, 114, 99, 117, 95, 116, 97, 115, 107, 115, 95, 104, 111, 108, 100,
+		111, 117, 116, 0, 114, 99, 117, 95, 116, 97, 115, 107, 115, 95, 105, 100,
+		120, 0, 114, 99, 117, 95, 116, 97, 115, 107, 115, 95, 105, 100, 108, 101,
+		95, 99, 112, 117, 0, 114, 99, 117, 95, 116, 97, 115, 107, 115, 95, 104,
+		111, 108, 100, 111, 117, 116, 95, 108, 105, 115, 116, 0, 116, 114, 99, 95,
+		114, 101, 97, 100, 101, 114, 95, 110, 101, 115, 116, 105, 110, 103, 0, 116,
+		114, 99, 95, 105, 112, 105, 95, 116, 111, 95, 99, 112, 117, 0, 116, 114,
+		99, 95, 114, 101, 97, 100, 101, 114, 95, 115, 112, 101, 99, 105, 97, 108,
+		0, 116, 114, 99, 95, 104, 111, 108, 100, 111, 117, 116, 95, 108, 105, 115,
+		116, 0, 116, 114, 99, 95, 98, 108, 107, 100, 95, 110, 111, 100, 101, 0,
+		116, 114, 99, 95, 98, 108, 107, 100, 95, 99, 112, 117, 0, 115, 99, 104,
+		101, 100, 95, 105, 110, 102, 111, 0, 116, 97, 115, 107, 115, 0, 112, 117,
+		115, 104, 97, 98, 108, 101, 95, 116, 97, 115, 107, 115, 0, 112, 117, 115,
+		104, 97, 98, 108, 101, 95, 100, 108, 95, 116, 97, 115, 107, 115, 0, 109,
+		109, 0, 97, 99, 116, 105, 118, 101, 95, 109, 109, 0, 118, 109, 97, 99,
+		97, 99, 104, 101, 0, 114, 115, 115, 95, 115, 116, 97, 116, 0, 101, 120,
+		105, 116, 95, 115, 116, 97, 116, 101, 0, 101, 120, 105, 116, 95, 99, 111,
+		100, 101, 0, 101, 120, 105, 116, 95, 115, 105, 103, 110, 97, 108, 0, 112,
+		100, 101, 97, 116, 104, 95, 115, 105, 103, 110, 97, 108, 0, 106, 111, 98,
+		99, 116, 108, 0, 112, 101, 114, 115, 111, 110, 97, 108, 105, 116, 121, 0,
+		115, 99, 104, 101, 100, 95, 114, 101, 115, 101, 116, 95, 111, 110, 95, 102,
+		111, 114, 107, 0, 115, 99, 104, 101, 100, 95, 99, 111, 110, 116, 114, 105,
+		98, 117, 116, 101, 115, 95, 116, 111, 95, 108, 111, 97, 100, 0, 115, 99,
+		104, 101, 100, 95, 109, 105, 103, 114, 97, 116, 101, 100, 0, 115, 99, 104,
+		101, 100, 95, 112, 115, 105, 95, 119, 97, 107, 101, 95, 114, 101, 113, 117,
+		101, 117, 101, 0, 115, 99, 104, 101, 100, 95, 114, 101, 109, 111, 116, 101,
+		95, 119, 97, 107, 101, 117, 112, 0, 105, 110, 95, 101, 120, 101, 99, 118,
+		101, 0, 105, 110, 95, 105, 111, 119, 97, 105, 116, 0, 114, 101, 115, 116,
+		111, 114, 101, 95, 115, 105, 103, 109, 97, 115, 107, 0, 105, 110, 95, 117,
+		115, 101, 114, 95, 102, 97, 117, 108, 116, 0, 110, 111, 95, 99, 103, 114,
+		111, 117, 112, 95, 109, 105, 103, 114, 97, 116, 105, 111, 110, 0, 102, 114,
+		111, 122, 101, 110, 0, 117, 115, 101, 95, 109, 101, 109, 100, 101, 108, 97,
+		121, 0, 105, 110, 95, 109, 101, 109, 115, 116, 97, 108, 108, 0, 105, 110,
+		95, 112, 97, 103, 101, 95, 111, 119, 110, 101, 114, 0, 105, 110, 95, 101,
+		118, 101, 110, 116, 102, 100, 0, 112, 97, 115, 105, 100, 95, 97, 99, 116,
+		105, 118, 97, 116, 101, 100, 0, 114, 101, 112, 111, 114, 116, 101, 100, 95,
+		115, 112, 108, 105, 116, 95, 108, 111, 99, 107, 0, 97, 116, 111, 109, 105,
+		99, 95, 102, 108, 97, 103, 115, 0, 114, 101, 115, 116, 97, 114, 116, 95,
+		98, 108, 111, 99, 107, 0, 116, 103, 105, 100, 0, 115, 116, 97, 99, 107,
+		95, 99, 97, 110, 97, 114, 121, 0, 114, 101, 97, 108, 95, 112, 97, 114,
+		101, 110, 116, 0, 112, 97, 114, 101, 110, 116, 0, 99, 104, 105, 108, 100,
+		114, 101, 110, 0, 115, 105, 98, 108, 105, 110, 103, 0, 103, 114, 111, 117,
+		112, 95, 108, 101, 97, 100, 101, 114, 0, 112, 116, 114, 97, 99, 101, 100,
+		0, 112, 116, 114, 97, 99, 101, 95, 101, 110, 116, 114, 121, 0, 116, 104,
+		114, 101, 97, 100, 95, 112, 105, 100, 0, 112, 105, 100, 95, 108, 105, 110,
+		107, 115, 0, 116, 104, 114, 101, 97, 100, 95, 103, 114, 111, 117, 112, 0,
+		116, 104, 114, 101, 97, 100, 95, 110, 111, 100, 101, 0, 118, 102, 111, 114,
+		107, 95, 100, 111, 110, 101, 0, 115, 101, 116, 95, 99, 104, 105, 108, 100,
+		95, 116, 105, 100, 0, 99, 108, 101, 97, 114, 95, 99, 104, 105, 108, 100,
+		95, 116, 105, 100, 0, 119, 111, 114, 107, 101, 114, 95, 112, 114, 105, 118,
+		97, 116, 101, 0, 117, 116, 105, 109, 101, 0, 115, 116, 105, 109, 101, 0,
+		103, 116, 105, 109, 101, 0, 112, 114, 101, 118, 95, 99, 112, 117, 116, 105,
+		109, 101, 0, 118, 116, 105, 109, 101, 0, 116, 105, 99, 107, 95, 100, 101,
+		112, 95, 109, 97, 115, 107, 0, 110, 118, 99, 115, 119, 0, 110, 105, 118,
+		99, 115, 119, 0, 115, 116, 97, 114, 116, 95, 116, 105, 109, 101, 0, 115,
+		116, 97, 114, 116, 95, 98, 111, 111, 116, 116, 105, 109, 101, 0, 109, 105,
+		110, 95, 102, 108, 116, 0, 109, 97, 106, 95, 102, 108, 116, 0, 112, 111,
+		115, 105, 120, 95, 99, 112, 117, 116, 105, 109, 101, 114, 115, 0, 112, 111,
+		115, 105, 120, 95, 99, 112, 117, 116, 105, 109, 101, 114, 115, 95, 119, 111,
+		114, 107, 0, 112, 116, 114, 97, 99, 101, 114, 95, 99, 114, 101, 100, 0,
+		114, 101, 97, 108, 95, 99, 114, 101, 100, 0, 99, 114, 101, 100, 0, 99,
+		97, 99, 104, 101, 100, 95, 114, 101, 113, 117, 101, 115, 116, 101, 100, 95,
+		107, 101, 121, 0, 99, 111, 109, 109, 0, 110, 97, 109, 101, 105, 100, 97,
+		116, 97, 0, 115, 121, 115, 118, 115, 101, 109, 0, 115, 121, 115, 118, 115,
+		104, 109, 0, 102, 115, 0, 102, 105, 108, 101, 115, 0, 105, 111, 95, 117,
+		114, 105, 110, 103, 0, 110, 115, 112, 114, 111, 120, 121, 0, 115, 105, 103,
+		110, 97, 108, 0, 115, 105, 103, 104, 97, 110, 100, 0, 98, 108, 111, 99,
+		107, 101, 100, 0, 114, 101, 97, 108, 95, 98, 108, 111, 99, 107, 101, 100,
+		0, 115, 97, 118, 101, 100, 95, 115, 105, 103, 109, 97, 115, 107, 0, 112,
+		101, 110, 100, 105, 110, 103, 0, 115, 97, 115, 95, 115, 115, 95, 115, 112,
+		0, 115, 97, 115, 95, 115, 115, 95, 115, 105, 122, 101, 0, 115, 97, 115,
+		95, 115, 115, 95, 102, 108, 97, 103, 115, 0, 116, 97, 115, 107, 95, 119,
+		111, 114, 107, 115, 0, 97, 117, 100, 105, 116, 95, 99, 111, 110, 116, 101,
+		120, 116, 0, 108, 111, 103, 105, 110, 117, 105, 100, 0, 115, 101, 115, 115,
+		105, 111, 110, 105, 100, 0, 115, 101, 99, 99, 111, 109, 112, 0, 115, 121,
+		115, 99, 97, 108, 108, 95, 100, 105, 115, 112, 97, 116, 99, 104, 0, 112,
+		97, 114, 101, 110, 116, 95, 101, 120, 101, 99, 95, 105, 100, 0, 115, 101,
+		108, 102, 95, 101, 120, 101, 99, 95, 105, 100, 0, 97, 108, 108, 111, 99,
+		95, 108, 111, 99, 107, 0, 112, 105, 95, 108, 111, 99, 107, 0, 119, 97,
+		107, 101, 95, 113, 0, 112, 105, 95, 119, 97, 105, 116, 101, 114, 115, 0,
+		112, 105, 95, 116, 111, 112, 95, 116, 97, 115, 107, 0, 112, 105, 95, 98,
+		108, 111, 99, 107, 101, 100, 95, 111, 110, 0, 106, 111, 117, 114, 110, 97,
+		108, 95, 105, 110, 102, 111, 0, 98, 105, 111, 95, 108, 105, 115, 116, 0,
+		112, 108, 117, 103, 0, 114, 101, 99, 108, 97, 105, 109, 95, 115, 116, 97,
+		116, 101, 0, 98, 97, 99, 107, 105, 110, 103, 95, 100, 101, 118, 95, 105,
+		110, 102, 111, 0, 105, 111, 95, 99, 111, 110, 116, 101, 120, 116, 0, 99,
+		97, 112, 116, 117, 114, 101, 95, 99, 111, 110, 116, 114, 111, 108, 0, 112,
+		116, 114, 97, 99, 101, 95, 109, 101, 115, 115, 97, 103, 101, 0, 108, 97,
+		115, 116, 95, 115, 105, 103, 105, 110, 102, 111, 0, 105, 111, 97, 99, 0,
+		112, 115, 105, 95, 102, 108, 97, 103, 115, 0, 97, 99, 99, 116, 95, 114,
+		115, 115, 95, 109, 101, 109, 49, 0, 97, 99, 99, 116, 95, 118, 109, 95,
+		109, 101, 109, 49, 0, 97, 99, 99, 116, 95, 116, 105, 109, 101, 120, 112,
+		100, 0, 109, 101, 109, 115, 95, 97, 108, 108, 111, 119, 101, 100, 0, 109,
+		101, 109, 115, 95, 97, 108, 108, 111, 119, 101, 100, 95, 115, 101, 113, 0,
+		99, 112, 117, 115, 101, 116, 95, 109, 101, 109, 95, 115, 112, 114, 101, 97,
+		100, 95, 114, 111, 116, 111, 114, 0, 99, 112, 117, 115, 101, 116, 95, 115,
+		108, 97, 98, 95, 115, 112, 114, 101, 97, 100, 95, 114, 111, 116, 111, 114,
+		0, 99, 103, 114, 111, 117, 112, 115, 0, 99, 103, 95, 108, 105, 115, 116,
+		0, 99, 108, 111, 115, 105, 100, 0, 114, 109, 105, 100, 0, 114, 111, 98,
+		117, 115, 116, 95, 108, 105, 115, 116, 0, 99, 111, 109, 112, 97, 116, 95,
+		114, 111, 98, 117, 115, 116, 95, 108, 105, 115, 116, 0, 112, 105, 95, 115,
+		116, 97, 116, 101, 95, 108, 105, 115, 116, 0, 112, 105, 95, 115, 116, 97,
+		116, 101, 95, 99, 97, 99, 104, 101, 0, 102, 117, 116, 101, 120, 95, 101,
+		120, 105, 116, 95, 109, 117, 116, 101, 120, 0, 102, 117, 116, 101, 120, 95,
+		115, 116, 97, 116, 101, 0, 112, 101, 114, 102, 95, 101, 118, 101, 110, 116,
+		95, 99, 116, 120, 112, 0, 112, 101, 114, 102, 95, 101, 118, 101, 110, 116,
+		95, 109, 117, 116, 101, 120, 0, 112, 101, 114, 102, 95, 101, 118, 101, 110,
+		116, 95, 108, 105, 115, 116, 0, 112, 114, 101, 101, 109, 112, 116, 95, 100,
+		105, 115, 97, 98, 108, 101, 95, 105, 112, 0, 109, 101, 109, 112, 111, 108,
+		105, 99, 121, 0, 105, 108, 95, 112, 114, 101, 118, 0, 112, 114, 101, 102,
+		95, 110, 111, 100, 101, 95, 102, 111, 114, 107, 0, 110, 117, 109, 97, 95,
+		115, 99, 97, 110, 95, 115, 101, 113, 0, 110, 117, 109, 97, 95, 115, 99,
+		97, 110, 95, 112, 101, 114, 105, 111, 100, 0, 110, 117, 109, 97, 95, 115,
+		99, 97, 110, 95, 112, 101, 114, 105, 111, 100, 95, 109, 97, 120, 0, 110,
+		117, 109, 97, 95, 112, 114, 101, 102, 101, 114, 114, 101, 100, 95, 110, 105,
+		100, 0, 110, 117, 109, 97, 95, 109, 105, 103, 114, 97, 116, 101, 95, 114,
+		101, 116, 114, 121, 0, 110, 111, 100, 101, 95, 115, 116, 97, 109, 112, 0,
+		108, 97, 115, 116, 95, 116, 97, 115, 107, 95, 110, 117, 109, 97, 95, 112,
+		108, 97, 99, 101, 109, 101, 110, 116, 0, 108, 97, 115, 116, 95, 115, 117,
+		109, 95, 101, 120, 101, 99, 95, 114, 117, 110, 116, 105, 109, 101, 0, 110,
+		117, 109, 97, 95, 119, 111, 114, 107, 0, 110, 117, 109, 97, 95, 103, 114,
+		111, 117, 112, 0, 110, 117, 109, 97, 95, 102, 97, 117, 108, 116, 115, 0,
+		116, 111, 116, 97, 108, 95, 110, 117, 109, 97, 95, 102, 97, 117, 108, 116,
+		115, 0, 110, 117, 109, 97, 95, 102, 97, 117, 108, 116, 115, 95, 108, 111,
+		99, 97, 108, 105, 116, 121, 0, 110, 117, 109, 97, 95, 112, 97, 103, 101,
+		115, 95, 109, 105, 103, 114, 97, 116, 101, 100, 0, 114, 115, 101, 113, 0,
+		114, 115, 101, 113, 95, 115, 105, 103, 0, 114, 115, 101, 113, 95, 101, 118,
+		101, 110, 116, 95, 109, 97, 115, 107, 0, 116, 108, 98, 95, 117, 98, 99,
+		0, 115, 112, 108, 105, 99, 101, 95, 112, 105, 112, 101, 0, 116, 97, 115,
+		107, 95, 102, 114, 97, 103, 0, 100, 101, 108, 97, 121, 115, 0, 110, 114,
+		95, 100, 105, 114, 116, 105, 101, 100, 0, 110, 114, 95, 100, 105, 114, 116,
+		105, 101, 100, 95, 112, 97, 117, 115, 101, 0, 100, 105, 114, 116, 121, 95,
+		112, 97, 117, 115, 101, 100, 95, 119, 104, 101, 110, 0, 108, 97, 116, 101,
+		110, 99, 121, 95, 114, 101, 99, 111, 114, 100, 95, 99, 111, 117, 110, 116,
+		0, 108, 97, 116, 101, 110, 99, 121, 95, 114, 101, 99, 111, 114, 100, 0,
+		116, 105, 109, 101, 114, 95, 115, 108, 97, 99, 107, 95, 110, 115, 0, 100,
+		101, 102, 97, 117, 108, 116, 95, 116, 105, 109, 101, 114, 95, 115, 108, 97,
+		99, 107, 95, 110, 115, 0, 107, 117, 110, 105, 116, 95, 116, 101, 115, 116,
+		0, 99, 117, 114, 114, 95, 114, 101, 116, 95, 115, 116, 97, 99, 107, 0,
+		99, 117, 114, 114, 95, 114, 101, 116, 95, 100, 101, 112, 116, 104, 0, 114,
+		101, 116, 95, 115, 116, 97, 99, 107, 0, 102, 116, 114, 97, 99, 101, 95,
+		116, 105, 109, 101, 115, 116, 97, 109, 112, 0, 116, 114, 97, 99, 101, 95,
+		111, 118, 101, 114, 114, 117, 110, 0, 116, 114, 97, 99, 105, 110, 103, 95,
+		103, 114, 97, 112, 104, 95, 112, 97, 117, 115, 101, 0, 116, 114, 97, 99,
+		101, 0, 116, 114, 97, 99, 101, 95, 114, 101, 99, 117, 114, 115, 105, 111,
+		110, 0, 109, 101, 109, 99, 103, 95, 105, 110, 95, 111, 111, 109, 0, 109,
+		101, 109, 99, 103, 95, 111, 111, 109, 95, 103, 102, 112, 95, 109, 97, 115,
+		107, 0, 109, 101, 109, 99, 103, 95, 111, 111, 109, 95, 111, 114, 100, 101,
+		114, 0, 109, 101, 109, 99, 103, 95, 110, 114, 95, 112, 97, 103, 101, 115,
+		95, 111, 118, 101, 114, 95, 104, 105, 103, 104, 0, 97, 99, 116, 105, 118,
+		101, 95, 109, 101, 109, 99, 103, 0, 116, 104, 114, 111, 116, 116, 108, 101,
+		95, 113, 117, 101, 117, 101, 0, 117, 116, 97, 115, 107, 0, 115, 101, 113,
+		117, 101, 110, 116, 105, 97, 108, 95, 105, 111, 0, 115, 101, 113, 117, 101,
+		110, 116, 105, 97, 108, 95, 105, 111, 95, 97, 118, 103, 0, 107, 109, 97,
+		112, 95, 99, 116, 114, 108, 0, 112, 97, 103, 101, 102, 97, 117, 108, 116,
+		95, 100, 105, 115, 97, 98, 108, 101, 100, 0, 111, 111, 109, 95, 114, 101,
+		97, 112, 101, 114, 95, 108, 105, 115, 116, 0, 111, 111, 109, 95, 114, 101,
+		97, 112, 101, 114, 95, 116, 105, 109, 101, 114, 0, 115, 116, 97, 99, 107,
+		95, 118, 109, 95, 97, 114, 101, 97, 0, 115, 116, 97, 99, 107, 95, 114,
+		101, 102, 99, 111, 117, 110, 116, 0, 112, 97, 116, 99, 104, 95, 115, 116,
+		97, 116, 101, 0, 115, 101, 99, 117, 114, 105, 116, 121, 0, 98, 112, 102,
+		95, 115, 116, 111, 114, 97, 103, 101, 0, 98, 112, 102, 95, 99, 116, 120,
+		0, 109, 99, 101, 95, 118, 97, 100, 100, 114, 0, 109, 99, 101, 95, 107,
+		102, 108, 97, 103, 115, 0, 109, 99, 101, 95, 97, 100, 100, 114, 0, 109,
+		99, 101, 95, 114, 105, 112, 118, 0, 109, 99, 101, 95, 119, 104, 111, 108,
+		101, 95, 112, 97, 103, 101, 0, 95, 95, 109, 99, 101, 95, 114, 101, 115,
+		101, 114, 118, 101, 100, 0, 109, 99, 101, 95, 107, 105, 108, 108, 95, 109,
+		101, 0, 109, 99, 101, 95, 99, 111, 117, 110, 116, 0, 107, 114, 101, 116,
+		112, 114, 111, 98, 101, 95, 105, 110, 115, 116, 97, 110, 99, 101, 115, 0,
+		114, 101, 116, 104, 111, 111, 107, 115, 0, 108, 49, 100, 95, 102, 108, 117,
+		115, 104, 95, 107, 105, 108, 108, 0, 116, 104, 114, 101, 97, 100, 0, 115,
+		121, 115, 99, 97, 108, 108, 95, 119, 111, 114, 107, 0, 115, 116, 97, 116,
+		117, 115, 0, 99, 112, 117, 0, 114, 101, 102, 99, 111, 117, 110, 116, 95,
+		116, 0, 114, 101, 102, 99, 111, 117, 110, 116, 95, 115, 116, 114, 117, 99,
+		116, 0, 114, 101, 102, 115, 0, 97, 116, 111, 109, 105, 99, 95, 116, 0,
+		99, 111, 117, 110, 116, 101, 114, 0, 95, 95, 99, 97, 108, 108, 95, 115,
+		105, 110, 103, 108, 101, 95, 110, 111, 100, 101, 0, 108, 108, 105, 115, 116,
+		0, 115, 114, 99, 0, 100, 115, 116, 0, 108, 108, 105, 115, 116, 95, 110,
+		111, 100, 101, 0, 110, 101, 120, 116, 0, 117, 95, 102, 108, 97, 103, 115,
+		0, 97, 95, 102, 108, 97, 103, 115, 0, 117, 49, 54, 0, 95, 95, 117,
+		49, 54, 0, 115, 99, 104, 101, 100, 95, 101, 110, 116, 105, 116, 121, 0,
+		108, 111, 97, 100, 0, 114, 117, 110, 95, 110, 111, 100, 101, 0, 103, 114,
+		111, 117, 112, 95, 110, 111, 100, 101, 0, 101, 120, 101, 99, 95, 115, 116,
+		97, 114, 116, 0, 115, 117, 109, 95, 101, 120, 101, 99, 95, 114, 117, 110,
+		116, 105, 109, 101, 0, 118, 114, 117, 110, 116, 105, 109, 101, 0, 112, 114,
+		101, 118, 95, 115, 117, 109, 95, 101, 120, 101, 99, 95, 114, 117, 110, 116,
+		105, 109, 101, 0, 110, 114, 95, 109, 105, 103, 114, 97, 116, 105, 111, 110,
+		115, 0, 100, 101, 112, 116, 104, 0, 99, 102, 115, 95, 114, 113, 0, 109,
+		121, 95, 113, 0, 114, 117, 110, 110, 97, 98, 108, 101, 95, 119, 101, 105,
+		103, 104, 116, 0, 97, 118, 103, 0, 108, 111, 97, 100, 95, 119, 101, 105,
+		103, 104, 116, 0, 119, 101, 105, 103, 104, 116, 0, 105, 110, 118, 95, 119,
+		101, 105, 103, 104, 116, 0, 114, 98, 95, 110, 111, 100, 101, 0, 95, 95,
+		114, 98, 95, 112, 97, 114, 101, 110, 116, 95, 99, 111, 108, 111, 114, 0,
+		114, 98, 95, 114, 105, 103, 104, 116, 0, 114, 98, 95, 108, 101, 102, 116,
+		0, 108, 105, 115, 116, 95, 104, 101, 97, 100, 0, 112, 114, 101, 118, 0,
+		115, 99, 104, 101, 100, 95, 97, 118, 103, 0, 108, 97, 115, 116, 95, 117,
+		112, 100, 97, 116, 101, 95, 116, 105, 109, 101, 0, 108, 111, 97, 100, 95,
+		115, 117, 109, 0, 114, 117, 110, 110, 97, 98, 108, 101, 95, 115, 117, 109,
+		0, 117, 116, 105, 108, 95, 115, 117, 109, 0, 112, 101, 114, 105, 111, 100,
+		95, 99, 111, 110, 116, 114, 105, 98, 0, 108, 111, 97, 100, 95, 97, 118,
+		103, 0, 114, 117, 110, 110, 97, 98, 108, 101, 95, 97, 118, 103, 0, 117,
+		116, 105, 108, 95, 97, 118, 103, 0, 117, 116, 105, 108, 95, 101, 115, 116,
+		0, 101, 110, 113, 117, 101, 117, 101, 100, 0, 101, 119, 109, 97, 0, 115,
+		99, 104, 101, 100, 95, 114, 116, 95, 101, 110, 116, 105, 116, 121, 0, 114,
+		117, 110, 95, 108, 105, 115, 116, 0, 116, 105, 109, 101, 111, 117, 116, 0,
+		119, 97, 116, 99, 104, 100, 111, 103, 95, 115, 116, 97, 109, 112, 0, 116,
+		105, 109, 101, 95, 115, 108, 105, 99, 101, 0, 111, 110, 95, 108, 105, 115,
+		116, 0, 98, 97, 99, 107, 0, 115, 99, 104, 101, 100, 95, 100, 108, 95,
+		101, 110, 116, 105, 116, 121, 0, 100, 108, 95, 114, 117, 110, 116, 105, 109,
+		101, 0, 100, 108, 95, 100, 101, 97, 100, 108, 105, 110, 101, 0, 100, 108,
+		95, 112, 101, 114, 105, 111, 100, 0, 100, 108, 95, 98, 119, 0, 100, 108,
+		95, 100, 101, 110, 115, 105, 116, 121, 0, 114, 117, 110, 116, 105, 109, 101,
+		0, 100, 101, 97, 100, 108, 105, 110, 101, 0, 100, 108, 95, 116, 104, 114,
+		111, 116, 116, 108, 101, 100, 0, 100, 108, 95, 121, 105, 101, 108, 100, 101,
+		100, 0, 100, 108, 95, 110, 111, 110, 95, 99, 111, 110, 116, 101, 110, 100,
+		105, 110, 103, 0, 100, 108, 95, 111, 118, 101, 114, 114, 117, 110, 0, 100,
+		108, 95, 116, 105, 109, 101, 114, 0, 105, 110, 97, 99, 116, 105, 118, 101,
+		95, 116, 105, 109, 101, 114, 0, 112, 105, 95, 115, 101, 0, 115, 54, 52,
+		0, 95, 95, 115, 54, 52, 0, 108, 111, 110, 103, 32, 108, 111, 110, 103,
+		0, 104, 114, 116, 105, 109, 101, 114, 0, 110, 111, 100, 101, 0, 95, 115,
+		111, 102, 116, 101, 120, 112, 105, 114, 101, 115, 0, 102, 117, 110, 99, 116,
+		105, 111, 110, 0, 98, 97, 115, 101, 0, 115, 116, 97, 116, 101, 0, 105,
+		115, 95, 114, 101, 108, 0, 105, 115, 95, 115, 111, 102, 116, 0, 105, 115,
+		95, 104, 97, 114, 100, 0, 116, 105, 109, 101, 114, 113, 117, 101, 117, 101,
+		95, 110, 111, 100, 101, 0, 101, 120, 112, 105, 114, 101, 115, 0, 107, 116,
+		105, 109, 101, 95, 116, 0, 104, 114, 116, 105, 109, 101, 114, 95, 114, 101,
+		115, 116, 97, 114, 116, 0, 72, 82, 84, 73, 77, 69, 82, 95, 78, 79,
+		82, 69, 83, 84, 65, 82, 84, 0, 72, 82, 84, 73, 77, 69, 82, 95,
+		82, 69, 83, 84, 65, 82, 84, 0, 115, 99, 104, 101, 100, 95, 115, 116,
+		97, 116, 105, 115, 116, 105, 99, 115, 0, 119, 97, 105, 116, 95, 115, 116,
+		97, 114, 116, 0, 119, 97, 105, 116, 95, 109, 97, 120, 0, 119, 97, 105,
+		116, 95, 99, 111, 117, 110, 116, 0, 119, 97, 105, 116, 95, 115, 117, 109,
+		0, 105, 111, 119, 97, 105, 116, 95, 99, 111, 117, 110, 116, 0, 105, 111,
+		119, 97, 105, 116, 95, 115, 117, 109, 0, 115, 108, 101, 101, 112, 95, 115,
+		116, 97, 114, 116, 0, 115, 108, 101, 101, 112, 95, 109, 97, 120, 0, 115,
+		117, 109, 95, 115, 108, 101, 101, 112, 95, 114, 117, 110, 116, 105, 109, 101,
+		0, 98, 108, 111, 99, 107, 95, 115, 116, 97, 114, 116, 0, 98, 108, 111,
+		99, 107, 95, 109, 97, 120, 0, 115, 117, 109, 95, 98, 108, 111, 99, 107,
+		95, 114, 117, 110, 116, 105, 109, 101, 0, 101, 120, 101, 99, 95, 109, 97,
+		120, 0, 115, 108, 105, 99, 101, 95, 109, 97, 120, 0, 110, 114, 95, 109,
+		105, 103, 114, 97, 116, 105, 111, 110, 115, 95, 99, 111, 108, 100, 0, 110,
+		114, 95, 102, 97, 105, 108, 101, 100, 95, 109, 105, 103, 114, 97, 116, 105,
+		111, 110, 115, 95, 97, 102, 102, 105, 110, 101, 0, 110, 114, 95, 102, 97,
+		105, 108, 101, 100, 95, 109, 105, 103, 114, 97, 116, 105, 111, 110, 115, 95,
+		114, 117, 110, 110, 105, 110, 103, 0, 110, 114, 95, 102, 97, 105, 108, 101,
+		100, 95, 109, 105, 103, 114, 97, 116, 105, 111, 110, 115, 95, 104, 111, 116,
+		0, 110, 114, 95, 102, 111, 114, 99, 101, 100, 95, 109, 105, 103, 114, 97,
+		116, 105, 111, 110, 115, 0, 110, 114, 95, 119, 97, 107, 101, 117, 112, 115,
+		0, 110, 114, 95, 119, 97, 107, 101, 117, 112, 115, 95, 115, 121, 110, 99,
+		0, 110, 114, 95, 119, 97, 107, 101, 117, 112, 115, 95, 109, 105, 103, 114,
+		97, 116, 101, 0, 110, 114, 95, 119, 97, 107, 101, 117, 112, 115, 95, 108,
+		111, 99, 97, 108, 0, 110, 114, 95, 119, 97, 107, 101, 117, 112, 115, 95,
+		114, 101, 109, 111, 116, 101, 0, 110, 114, 95, 119, 97, 107, 101, 117, 112,
+		115, 95, 97, 102, 102, 105, 110, 101, 0, 110, 114, 95, 119, 97, 107, 101,
+		117, 112, 115, 95, 97, 102, 102, 105, 110, 101, 95, 97, 116, 116, 101, 109,
+		112, 116, 115, 0, 110, 114, 95, 119, 97, 107, 101, 117, 112, 115, 95, 112,
+		97, 115, 115, 105, 118, 101, 0, 110, 114, 95, 119, 97, 107, 101, 117, 112,
+		115, 95, 105, 100, 108, 101, 0, 99, 111, 114, 101, 95, 102, 111, 114, 99,
+		101, 105, 100, 108, 101, 95, 115, 117, 109, 0, 104, 108, 105, 115, 116, 95,
+		104, 101, 97, 100, 0, 102, 105, 114, 115, 116, 0, 99, 112, 117, 109, 97,
+		115, 107, 95, 116, 0, 99, 112, 117, 109, 97, 115, 107, 0, 98, 105, 116,
+		115, 0, 114, 99, 117, 95, 115, 112, 101, 99, 105, 97, 108, 0, 98, 0,
+		115, 0, 110, 101, 101, 100, 95, 113, 115, 0, 101, 120, 112, 95, 104, 105,
+		110, 116, 0, 110, 101, 101, 100, 95, 109, 98, 0, 112, 99, 111, 117, 110,
+		116, 0, 114, 117, 110, 95, 100, 101, 108, 97, 121, 0, 108, 97, 115, 116,
+		95, 97, 114, 114, 105, 118, 97, 108, 0, 108, 97, 115, 116, 95, 113, 117,
+		101, 117, 101, 100, 0, 112, 108, 105, 115, 116, 95, 110, 111, 100, 101, 0,
+		112, 114, 105, 111, 95, 108, 105, 115, 116, 0, 110, 111, 100, 101, 95, 108,
+		105, 115, 116, 0, 115, 101, 113, 110, 117, 109, 0, 118, 109, 97, 115, 0,
+		118, 109, 95, 97, 114, 101, 97, 95, 115, 116, 114, 117, 99, 116, 0, 118,
+		109, 95, 115, 116, 97, 114, 116, 0, 118, 109, 95, 101, 110, 100, 0, 118,
+		109, 95, 110, 101, 120, 116, 0, 118, 109, 95, 112, 114, 101, 118, 0, 118,
+		109, 95, 114, 98, 0, 114, 98, 95, 115, 117, 98, 116, 114, 101, 101, 95,
+		103, 97, 112, 0, 118, 109, 95, 109, 109, 0, 118, 109, 95, 112, 97, 103,
+		101, 95, 112, 114, 111, 116, 0, 118, 109, 95, 102, 108, 97, 103, 115, 0,
+		97, 110, 111, 110, 95, 118, 109, 97, 95, 99, 104, 97, 105, 110, 0, 97,
+		110, 111, 110, 95, 118, 109, 97, 0, 118, 109, 95, 111, 112, 115, 0, 118,
+		109, 95, 112, 103, 111, 102, 102, 0, 118, 109, 95, 102, 105, 108, 101, 0,
+		118, 109, 95, 112, 114, 105, 118, 97, 116, 101, 95, 100, 97, 116, 97, 0,
+		115, 119, 97, 112, 95, 114, 101, 97, 100, 97, 104, 101, 97, 100, 95, 105,
+		110, 102, 111, 0, 118, 109, 95, 112, 111, 108, 105, 99, 121, 0, 118, 109,
+		95, 117, 115, 101, 114, 102, 97, 117, 108, 116, 102, 100, 95, 99, 116, 120,
+		0, 112, 103, 112, 114, 111, 116, 95, 116, 0, 112, 103, 112, 114, 111, 116,
+		0, 112, 103, 112, 114, 111, 116, 118, 97, 108, 95, 116, 0, 115, 104, 97,
+		114, 101, 100, 0, 97, 110, 111, 110, 95, 110, 97, 109, 101, 0, 114, 98,
+		0, 114, 98, 95, 115, 117, 98, 116, 114, 101, 101, 95, 108, 97, 115, 116,
+		0, 97, 116, 111, 109, 105, 99, 95, 108, 111, 110, 103, 95, 116, 0, 97,
+		116, 111, 109, 105, 99, 54, 52, 95, 116, 0, 99, 116, 120, 0, 116, 97,
+		115, 107, 95, 114, 115, 115, 95, 115, 116, 97, 116, 0, 99, 111, 117, 110,
+		116, 0, 97, 114, 99, 104, 95, 100, 97, 116, 97, 0, 102, 110, 0, 102,
+		117, 116, 101, 120, 0, 110, 97, 110, 111, 115, 108, 101, 101, 112, 0, 112,
+		111, 108, 108, 0, 117, 97, 100, 100, 114, 0, 118, 97, 108, 0, 98, 105,
+		116, 115, 101, 116, 0, 116, 105, 109, 101, 0, 117, 97, 100, 100, 114, 50,
+		0, 99, 108, 111, 99, 107, 105, 100, 0, 99, 108, 111, 99, 107, 105, 100,
+		95, 116, 0, 95, 95, 107, 101, 114, 110, 101, 108, 95, 99, 108, 111, 99,
+		107, 105, 100, 95, 116, 0, 116, 105, 109, 101, 115, 112, 101, 99, 95, 116,
+		121, 112, 101, 0, 84, 84, 95, 78, 79, 78, 69, 0, 84, 84, 95, 78,
+		65, 84, 73, 86, 69, 0, 84, 84, 95, 67, 79, 77, 80, 65, 84, 0,
+		114, 109, 116, 112, 0, 99, 111, 109, 112, 97, 116, 95, 114, 109, 116, 112,
+		0, 117, 102, 100, 115, 0, 110, 102, 100, 115, 0, 104, 97, 115, 95, 116,
+		105, 109, 101, 111, 117, 116, 0, 116, 118, 95, 115, 101, 99, 0, 116, 118,
+		95, 110, 115, 101, 99, 0, 112, 105, 100, 95, 116, 0, 95, 95, 107, 101,
+		114, 110, 101, 108, 95, 112, 105, 100, 95, 116, 0, 104, 108, 105, 115, 116,
+		95, 110, 111, 100, 101, 0, 112, 112, 114, 101, 118, 0, 108, 111, 99, 107,
 		0, 114, 97, 119, 95, 115, 112, 105, 110, 108, 111, 99, 107, 95, 116, 0,
 		114, 97, 119, 95, 115, 112, 105, 110, 108, 111, 99, 107, 0, 114, 97, 119,
 		95, 108, 111, 99, 107, 0, 97, 114, 99, 104, 95, 115, 112, 105, 110, 108,
 		111, 99, 107, 95, 116, 0, 113, 115, 112, 105, 110, 108, 111, 99, 107, 0,
-		118, 97, 108, 0, 108, 111, 99, 107, 101, 100, 0, 108, 111, 99, 107, 101,
-		100, 95, 112, 101, 110, 100, 105, 110, 103, 0, 116, 97, 105, 108, 0, 115,
-		54, 52, 0, 95, 95, 115, 54, 52, 0, 108, 111, 110, 103, 32, 108, 111,
-		110, 103, 0, 115, 99, 104, 101, 100, 95, 114, 116, 95, 101, 110, 116, 105,
-		116, 121, 0, 114, 117, 110, 95, 108, 105, 115, 116, 0, 116, 105, 109, 101,
-		111, 117, 116, 0, 119, 97, 116, 99, 104, 100, 111, 103, 95, 115, 116, 97,
-		109, 112, 0, 116, 105, 109, 101, 95, 115, 108, 105, 99, 101, 0, 98, 97,
-		99, 107, 0, 115, 99, 104, 101, 100, 95, 100, 108, 95, 101, 110, 116, 105,
-		116, 121, 0, 100, 108, 95, 114, 117, 110, 116, 105, 109, 101, 0, 100, 108,
-		95, 100, 101, 97, 100, 108, 105, 110, 101, 0, 100, 108, 95, 112, 101, 114,
-		105, 111, 100, 0, 100, 108, 95, 98, 119, 0, 100, 108, 95, 100, 101, 110,
-		115, 105, 116, 121, 0, 114, 117, 110, 116, 105, 109, 101, 0, 100, 101, 97,
-		100, 108, 105, 110, 101, 0, 100, 108, 95, 116, 104, 114, 111, 116, 116, 108,
-		101, 100, 0, 100, 108, 95, 121, 105, 101, 108, 100, 101, 100, 0, 100, 108,
-		95, 110, 111, 110, 95, 99, 111, 110, 116, 101, 110, 100, 105, 110, 103, 0,
-		100, 108, 95, 111, 118, 101, 114, 114, 117, 110, 0, 100, 108, 95, 116, 105,
-		109, 101, 114, 0, 105, 110, 97, 99, 116, 105, 118, 101, 95, 116, 105, 109,
-		101, 114, 0, 112, 105, 95, 115, 101, 0, 104, 114, 116, 105, 109, 101, 114,
-		0, 110, 111, 100, 101, 0, 95, 115, 111, 102, 116, 101, 120, 112, 105, 114,
-		101, 115, 0, 102, 117, 110, 99, 116, 105, 111, 110, 0, 98, 97, 115, 101,
-		0, 115, 116, 97, 116, 101, 0, 105, 115, 95, 114, 101, 108, 0, 105, 115,
-		95, 115, 111, 102, 116, 0, 105, 115, 95, 104, 97, 114, 100, 0, 116, 105,
-		109, 101, 114, 113, 117, 101, 117, 101, 95, 110, 111, 100, 101, 0, 101, 120,
-		112, 105, 114, 101, 115, 0, 107, 116, 105, 109, 101, 95, 116, 0, 104, 114,
-		116, 105, 109, 101, 114, 95, 114, 101, 115, 116, 97, 114, 116, 0, 72, 82,
-		84, 73, 77, 69, 82, 95, 78, 79, 82, 69, 83, 84, 65, 82, 84, 0,
-		72, 82, 84, 73, 77, 69, 82, 95, 82, 69, 83, 84, 65, 82, 84, 0,
-		116, 97, 115, 107, 95, 103, 114, 111, 117, 112, 0, 99, 115, 115, 0, 115,
-		104, 97, 114, 101, 115, 0, 114, 99, 117, 0, 108, 105, 115, 116, 0, 115,
-		105, 98, 108, 105, 110, 103, 115, 0, 97, 117, 116, 111, 103, 114, 111, 117,
-		112, 0, 99, 102, 115, 95, 98, 97, 110, 100, 119, 105, 100, 116, 104, 0,
-		99, 103, 114, 111, 117, 112, 95, 115, 117, 98, 115, 121, 115, 95, 115, 116,
-		97, 116, 101, 0, 99, 103, 114, 111, 117, 112, 0, 115, 115, 0, 114, 101,
-		102, 99, 110, 116, 0, 114, 115, 116, 97, 116, 95, 99, 115, 115, 95, 110,
-		111, 100, 101, 0, 115, 101, 114, 105, 97, 108, 95, 110, 114, 0, 111, 110,
-		108, 105, 110, 101, 95, 99, 110, 116, 0, 100, 101, 115, 116, 114, 111, 121,
-		95, 119, 111, 114, 107, 0, 100, 101, 115, 116, 114, 111, 121, 95, 114, 119,
-		111, 114, 107, 0, 112, 101, 114, 99, 112, 117, 95, 114, 101, 102, 0, 112,
-		101, 114, 99, 112, 117, 95, 99, 111, 117, 110, 116, 95, 112, 116, 114, 0,
-		100, 97, 116, 97, 0, 119, 111, 114, 107, 95, 115, 116, 114, 117, 99, 116,
-		0, 101, 110, 116, 114, 121, 0, 102, 117, 110, 99, 0, 97, 116, 111, 109,
-		105, 99, 95, 108, 111, 110, 103, 95, 116, 0, 97, 116, 111, 109, 105, 99,
-		54, 52, 95, 116, 0, 119, 111, 114, 107, 95, 102, 117, 110, 99, 95, 116,
-		0, 114, 99, 117, 95, 119, 111, 114, 107, 0, 119, 111, 114, 107, 0, 119,
-		113, 0, 99, 97, 108, 108, 98, 97, 99, 107, 95, 104, 101, 97, 100, 0,
-		112, 101, 114, 105, 111, 100, 0, 113, 117, 111, 116, 97, 0, 98, 117, 114,
-		115, 116, 0, 114, 117, 110, 116, 105, 109, 101, 95, 115, 110, 97, 112, 0,
-		104, 105, 101, 114, 97, 114, 99, 104, 105, 99, 97, 108, 95, 113, 117, 111,
-		116, 97, 0, 112, 101, 114, 105, 111, 100, 95, 97, 99, 116, 105, 118, 101,
-		0, 115, 108, 97, 99, 107, 95, 115, 116, 97, 114, 116, 101, 100, 0, 112,
-		101, 114, 105, 111, 100, 95, 116, 105, 109, 101, 114, 0, 115, 108, 97, 99,
-		107, 95, 116, 105, 109, 101, 114, 0, 116, 104, 114, 111, 116, 116, 108, 101,
-		100, 95, 99, 102, 115, 95, 114, 113, 0, 110, 114, 95, 112, 101, 114, 105,
-		111, 100, 115, 0, 110, 114, 95, 116, 104, 114, 111, 116, 116, 108, 101, 100,
-		0, 110, 114, 95, 98, 117, 114, 115, 116, 0, 116, 104, 114, 111, 116, 116,
-		108, 101, 100, 95, 116, 105, 109, 101, 0, 98, 117, 114, 115, 116, 95, 116,
-		105, 109, 101, 0, 115, 99, 104, 101, 100, 95, 115, 116, 97, 116, 105, 115,
-		116, 105, 99, 115, 0, 119, 97, 105, 116, 95, 115, 116, 97, 114, 116, 0,
-		119, 97, 105, 116, 95, 109, 97, 120, 0, 119, 97, 105, 116, 95, 99, 111,
-		117, 110, 116, 0, 119, 97, 105, 116, 95, 115, 117, 109, 0, 105, 111, 119,
-		97, 105, 116, 95, 99, 111, 117, 110, 116, 0, 105, 111, 119, 97, 105, 116,
-		95, 115, 117, 109, 0, 115, 108, 101, 101, 112, 95, 115, 116, 97, 114, 116,
-		0, 115, 108, 101, 101, 112, 95, 109, 97, 120, 0, 115, 117, 109, 95, 115,
-		108, 101, 101, 112, 95, 114, 117, 110, 116, 105, 109, 101, 0, 98, 108, 111,
-		99, 107, 95, 115, 116, 97, 114, 116, 0, 98, 108, 111, 99, 107, 95, 109,
-		97, 120, 0, 115, 117, 109, 95, 98, 108, 111, 99, 107, 95, 114, 117, 110,
-		116, 105, 109, 101, 0, 101, 120, 101, 99, 95, 109, 97, 120, 0, 115, 108,
-		105, 99, 101, 95, 109, 97, 120, 0, 110, 114, 95, 109, 105, 103, 114, 97,
-		116, 105, 111, 110, 115, 95, 99, 111, 108, 100, 0, 110, 114, 95, 102, 97,
-		105, 108, 101, 100, 95, 109, 105, 103, 114, 97, 116, 105, 111, 110, 115, 95,
-		97, 102, 102, 105, 110, 101, 0, 110, 114, 95, 102, 97, 105, 108, 101, 100,
-		95, 109, 105, 103, 114, 97, 116, 105, 111, 110, 115, 95, 114, 117, 110, 110,
-		105, 110, 103, 0, 110, 114, 95, 102, 97, 105, 108, 101, 100, 95, 109, 105,
-		103, 114, 97, 116, 105, 111, 110, 115, 95, 104, 111, 116, 0, 110, 114, 95,
-		102, 111, 114, 99, 101, 100, 95, 109, 105, 103, 114, 97, 116, 105, 111, 110,
-		115, 0, 110, 114, 95, 119, 97, 107, 101, 117, 112, 115, 0, 110, 114, 95,
-		119, 97, 107, 101, 117, 112, 115, 95, 115, 121, 110, 99, 0, 110, 114, 95,
-		119, 97, 107, 101, 117, 112, 115, 95, 109, 105, 103, 114, 97, 116, 101, 0,
-		110, 114, 95, 119, 97, 107, 101, 117, 112, 115, 95, 108, 111, 99, 97, 108,
-		0, 110, 114, 95, 119, 97, 107, 101, 117, 112, 115, 95, 114, 101, 109, 111,
-		116, 101, 0, 110, 114, 95, 119, 97, 107, 101, 117, 112, 115, 95, 97, 102,
-		102, 105, 110, 101, 0, 110, 114, 95, 119, 97, 107, 101, 117, 112, 115, 95,
-		97, 102, 102, 105, 110, 101, 95, 97, 116, 116, 101, 109, 112, 116, 115, 0,
-		110, 114, 95, 119, 97, 107, 101, 117, 112, 115, 95, 112, 97, 115, 115, 105,
-		118, 101, 0, 110, 114, 95, 119, 97, 107, 101, 117, 112, 115, 95, 105, 100,
-		108, 101, 0, 99, 111, 114, 101, 95, 102, 111, 114, 99, 101, 105, 100, 108,
-		101, 95, 115, 117, 109, 0, 104, 108, 105, 115, 116, 95, 104, 101, 97, 100,
-		0, 102, 105, 114, 115, 116, 0, 99, 112, 117, 109, 97, 115, 107, 95, 116,
-		0, 99, 112, 117, 109, 97, 115, 107, 0, 98, 105, 116, 115, 0, 114, 99,
-		117, 95, 115, 112, 101, 99, 105, 97, 108, 0, 98, 0, 115, 0, 110, 101,
-		101, 100, 95, 113, 115, 0, 101, 120, 112, 95, 104, 105, 110, 116, 0, 110,
-		101, 101, 100, 95, 109, 98, 0, 112, 99, 111, 117, 110, 116, 0, 114, 117,
-		110, 95, 100, 101, 108, 97, 121, 0, 108, 97, 115, 116, 95, 97, 114, 114,
-		105, 118, 97, 108, 0, 108, 97, 115, 116, 95, 113, 117, 101, 117, 101, 100,
-		0, 112, 108, 105, 115, 116, 95, 110, 111, 100, 101, 0, 112, 114, 105, 111,
-		95, 108, 105, 115, 116, 0, 110, 111, 100, 101, 95, 108, 105, 115, 116, 0,
-		109, 109, 95, 115, 116, 114, 117, 99, 116, 0, 99, 112, 117, 95, 98, 105,
-		116, 109, 97, 112, 0, 109, 109, 97, 112, 0, 109, 109, 95, 114, 98, 0,
-		118, 109, 97, 99, 97, 99, 104, 101, 95, 115, 101, 113, 110, 117, 109, 0,
-		103, 101, 116, 95, 117, 110, 109, 97, 112, 112, 101, 100, 95, 97, 114, 101,
-		97, 0, 109, 109, 97, 112, 95, 98, 97, 115, 101, 0, 109, 109, 97, 112,
-		95, 108, 101, 103, 97, 99, 121, 95, 98, 97, 115, 101, 0, 109, 109, 97,
-		112, 95, 99, 111, 109, 112, 97, 116, 95, 98, 97, 115, 101, 0, 109, 109,
-		97, 112, 95, 99, 111, 109, 112, 97, 116, 95, 108, 101, 103, 97, 99, 121,
-		95, 98, 97, 115, 101, 0, 116, 97, 115, 107, 95, 115, 105, 122, 101, 0,
-		104, 105, 103, 104, 101, 115, 116, 95, 118, 109, 95, 101, 110, 100, 0, 112,
-		103, 100, 0, 109, 101, 109, 98, 97, 114, 114, 105, 101, 114, 95, 115, 116,
-		97, 116, 101, 0, 109, 109, 95, 117, 115, 101, 114, 115, 0, 109, 109, 95,
-		99, 111, 117, 110, 116, 0, 112, 103, 116, 97, 98, 108, 101, 115, 95, 98,
-		121, 116, 101, 115, 0, 109, 97, 112, 95, 99, 111, 117, 110, 116, 0, 112,
-		97, 103, 101, 95, 116, 97, 98, 108, 101, 95, 108, 111, 99, 107, 0, 109,
-		109, 97, 112, 95, 108, 111, 99, 107, 0, 109, 109, 108, 105, 115, 116, 0,
-		104, 105, 119, 97, 116, 101, 114, 95, 114, 115, 115, 0, 104, 105, 119, 97,
-		116, 101, 114, 95, 118, 109, 0, 116, 111, 116, 97, 108, 95, 118, 109, 0,
-		108, 111, 99, 107, 101, 100, 95, 118, 109, 0, 112, 105, 110, 110, 101, 100,
-		95, 118, 109, 0, 100, 97, 116, 97, 95, 118, 109, 0, 101, 120, 101, 99,
-		95, 118, 109, 0, 115, 116, 97, 99, 107, 95, 118, 109, 0, 100, 101, 102,
-		95, 102, 108, 97, 103, 115, 0, 119, 114, 105, 116, 101, 95, 112, 114, 111,
-		116, 101, 99, 116, 95, 115, 101, 113, 0, 97, 114, 103, 95, 108, 111, 99,
-		107, 0, 115, 116, 97, 114, 116, 95, 99, 111, 100, 101, 0, 101, 110, 100,
-		95, 99, 111, 100, 101, 0, 115, 116, 97, 114, 116, 95, 100, 97, 116, 97,
-		0, 101, 110, 100, 95, 100, 97, 116, 97, 0, 115, 116, 97, 114, 116, 95,
-		98, 114, 107, 0, 98, 114, 107, 0, 115, 116, 97, 114, 116, 95, 115, 116,
-		97, 99, 107, 0, 97, 114, 103, 95, 115, 116, 97, 114, 116, 0, 97, 114,
-		103, 95, 101, 110, 100, 0, 101, 110, 118, 95, 115, 116, 97, 114, 116, 0,
-		101, 110, 118, 95, 101, 110, 100, 0, 115, 97, 118, 101, 100, 95, 97, 117,
-		120, 118, 0, 98, 105, 110, 102, 109, 116, 0, 99, 111, 110, 116, 101, 120,
-		116, 0, 105, 111, 99, 116, 120, 95, 108, 111, 99, 107, 0, 105, 111, 99,
-		116, 120, 95, 116, 97, 98, 108, 101, 0, 111, 119, 110, 101, 114, 0, 117,
-		115, 101, 114, 95, 110, 115, 0, 101, 120, 101, 95, 102, 105, 108, 101, 0,
-		110, 111, 116, 105, 102, 105, 101, 114, 95, 115, 117, 98, 115, 99, 114, 105,
-		112, 116, 105, 111, 110, 115, 0, 110, 117, 109, 97, 95, 110, 101, 120, 116,
-		95, 115, 99, 97, 110, 0, 110, 117, 109, 97, 95, 115, 99, 97, 110, 95,
-		111, 102, 102, 115, 101, 116, 0, 116, 108, 98, 95, 102, 108, 117, 115, 104,
-		95, 112, 101, 110, 100, 105, 110, 103, 0, 116, 108, 98, 95, 102, 108, 117,
-		115, 104, 95, 98, 97, 116, 99, 104, 101, 100, 0, 117, 112, 114, 111, 98,
-		101, 115, 95, 115, 116, 97, 116, 101, 0, 104, 117, 103, 101, 116, 108, 98,
-		95, 117, 115, 97, 103, 101, 0, 97, 115, 121, 110, 99, 95, 112, 117, 116,
-		95, 119, 111, 114, 107, 0, 112, 97, 115, 105, 100, 0, 107, 115, 109, 95,
-		109, 101, 114, 103, 105, 110, 103, 95, 112, 97, 103, 101, 115, 0, 102, 105,
-		108, 101, 0, 102, 95, 112, 97, 116, 104, 0, 102, 95, 105, 110, 111, 100,
-		101, 0, 102, 95, 111, 112, 0, 102, 95, 108, 111, 99, 107, 0, 102, 95,
-		99, 111, 117, 110, 116, 0, 102, 95, 102, 108, 97, 103, 115, 0, 102, 95,
-		109, 111, 100, 101, 0, 102, 95, 112, 111, 115, 95, 108, 111, 99, 107, 0,
-		102, 95, 112, 111, 115, 0, 102, 95, 111, 119, 110, 101, 114, 0, 102, 95,
-		99, 114, 101, 100, 0, 102, 95, 114, 97, 0, 102, 95, 118, 101, 114, 115,
-		105, 111, 110, 0, 102, 95, 115, 101, 99, 117, 114, 105, 116, 121, 0, 112,
-		114, 105, 118, 97, 116, 101, 95, 100, 97, 116, 97, 0, 102, 95, 101, 112,
-		0, 102, 95, 109, 97, 112, 112, 105, 110, 103, 0, 102, 95, 119, 98, 95,
-		101, 114, 114, 0, 102, 95, 115, 98, 95, 101, 114, 114, 0, 102, 95, 108,
-		108, 105, 115, 116, 0, 102, 95, 114, 99, 117, 104, 101, 97, 100, 0, 102,
-		95, 105, 111, 99, 98, 95, 102, 108, 97, 103, 115, 0, 112, 97, 116, 104,
-		0, 109, 110, 116, 0, 100, 101, 110, 116, 114, 121, 0, 115, 112, 105, 110,
-		108, 111, 99, 107, 95, 116, 0, 115, 112, 105, 110, 108, 111, 99, 107, 0,
-		114, 108, 111, 99, 107, 0, 102, 109, 111, 100, 101, 95, 116, 0, 109, 117,
-		116, 101, 120, 0, 119, 97, 105, 116, 95, 108, 111, 99, 107, 0, 111, 115,
-		113, 0, 119, 97, 105, 116, 95, 108, 105, 115, 116, 0, 111, 112, 116, 105,
-		109, 105, 115, 116, 105, 99, 95, 115, 112, 105, 110, 95, 113, 117, 101, 117,
-		101, 0, 108, 111, 102, 102, 95, 116, 0, 95, 95, 107, 101, 114, 110, 101,
-		108, 95, 108, 111, 102, 102, 95, 116, 0, 102, 111, 119, 110, 95, 115, 116,
-		114, 117, 99, 116, 0, 112, 105, 100, 95, 116, 121, 112, 101, 0, 117, 105,
-		100, 0, 101, 117, 105, 100, 0, 115, 105, 103, 110, 117, 109, 0, 114, 119,
-		108, 111, 99, 107, 95, 116, 0, 97, 114, 99, 104, 95, 114, 119, 108, 111,
-		99, 107, 95, 116, 0, 113, 114, 119, 108, 111, 99, 107, 0, 99, 110, 116,
-		115, 0, 119, 108, 111, 99, 107, 101, 100, 0, 95, 95, 108, 115, 116, 97,
-		116, 101, 0, 80, 73, 68, 84, 89, 80, 69, 95, 80, 73, 68, 0, 80,
-		73, 68, 84, 89, 80, 69, 95, 84, 71, 73, 68, 0, 80, 73, 68, 84,
-		89, 80, 69, 95, 80, 71, 73, 68, 0, 80, 73, 68, 84, 89, 80, 69,
-		95, 83, 73, 68, 0, 80, 73, 68, 84, 89, 80, 69, 95, 77, 65, 88,
-		0, 107, 117, 105, 100, 95, 116, 0, 117, 105, 100, 95, 116, 0, 95, 95,
-		107, 101, 114, 110, 101, 108, 95, 117, 105, 100, 51, 50, 95, 116, 0, 102,
-		105, 108, 101, 95, 114, 97, 95, 115, 116, 97, 116, 101, 0, 115, 116, 97,
-		114, 116, 0, 115, 105, 122, 101, 0, 97, 115, 121, 110, 99, 95, 115, 105,
-		122, 101, 0, 114, 97, 95, 112, 97, 103, 101, 115, 0, 109, 109, 97, 112,
-		95, 109, 105, 115, 115, 0, 112, 114, 101, 118, 95, 112, 111, 115, 0, 101,
-		114, 114, 115, 101, 113, 95, 116, 0, 112, 103, 100, 95, 116, 0, 112, 103,
-		100, 118, 97, 108, 95, 116, 0, 114, 119, 95, 115, 101, 109, 97, 112, 104,
-		111, 114, 101, 0, 99, 111, 117, 110, 116, 0, 115, 101, 113, 99, 111, 117,
-		110, 116, 95, 116, 0, 115, 101, 113, 99, 111, 117, 110, 116, 0, 115, 101,
-		113, 117, 101, 110, 99, 101, 0, 109, 109, 95, 114, 115, 115, 95, 115, 116,
-		97, 116, 0, 109, 109, 95, 99, 111, 110, 116, 101, 120, 116, 95, 116, 0,
-		99, 116, 120, 95, 105, 100, 0, 116, 108, 98, 95, 103, 101, 110, 0, 108,
-		100, 116, 95, 117, 115, 114, 95, 115, 101, 109, 0, 108, 100, 116, 0, 118,
-		100, 115, 111, 0, 118, 100, 115, 111, 95, 105, 109, 97, 103, 101, 0, 112,
-		101, 114, 102, 95, 114, 100, 112, 109, 99, 95, 97, 108, 108, 111, 119, 101,
-		100, 0, 112, 107, 101, 121, 95, 97, 108, 108, 111, 99, 97, 116, 105, 111,
-		110, 95, 109, 97, 112, 0, 101, 120, 101, 99, 117, 116, 101, 95, 111, 110,
-		108, 121, 95, 112, 107, 101, 121, 0, 115, 49, 54, 0, 95, 95, 115, 49,
-		54, 0, 115, 104, 111, 114, 116, 0, 120, 111, 108, 95, 97, 114, 101, 97,
-		0, 115, 101, 113, 110, 117, 109, 0, 118, 109, 97, 115, 0, 118, 109, 95,
-		97, 114, 101, 97, 95, 115, 116, 114, 117, 99, 116, 0, 118, 109, 95, 115,
-		116, 97, 114, 116, 0, 118, 109, 95, 101, 110, 100, 0, 118, 109, 95, 110,
-		101, 120, 116, 0, 118, 109, 95, 112, 114, 101, 118, 0, 118, 109, 95, 114,
-		98, 0, 114, 98, 95, 115, 117, 98, 116, 114, 101, 101, 95, 103, 97, 112,
-		0, 118, 109, 95, 109, 109, 0, 118, 109, 95, 112, 97, 103, 101, 95, 112,
-		114, 111, 116, 0, 118, 109, 95, 102, 108, 97, 103, 115, 0, 97, 110, 111,
-		110, 95, 118, 109, 97, 95, 99, 104, 97, 105, 110, 0, 97, 110, 111, 110,
-		95, 118, 109, 97, 0, 118, 109, 95, 111, 112, 115, 0, 118, 109, 95, 112,
-		103, 111, 102, 102, 0, 118, 109, 95, 102, 105, 108, 101, 0, 118, 109, 95,
-		112, 114, 105, 118, 97, 116, 101, 95, 100, 97, 116, 97, 0, 115, 119, 97,
-		112, 95, 114, 101, 97, 100, 97, 104, 101, 97, 100, 95, 105, 110, 102, 111,
-		0, 118, 109, 95, 112, 111, 108, 105, 99, 121, 0, 118, 109, 95, 117, 115,
-		101, 114, 102, 97, 117, 108, 116, 102, 100, 95, 99, 116, 120, 0, 112, 103,
-		112, 114, 111, 116, 95, 116, 0, 112, 103, 112, 114, 111, 116, 0, 112, 103,
-		112, 114, 111, 116, 118, 97, 108, 95, 116, 0, 115, 104, 97, 114, 101, 100,
-		0, 97, 110, 111, 110, 95, 110, 97, 109, 101, 0, 114, 98, 0, 114, 98,
-		95, 115, 117, 98, 116, 114, 101, 101, 95, 108, 97, 115, 116, 0, 99, 116,
-		120, 0, 116, 97, 115, 107, 95, 114, 115, 115, 95, 115, 116, 97, 116, 0,
-		97, 114, 99, 104, 95, 100, 97, 116, 97, 0, 102, 110, 0, 102, 117, 116,
-		101, 120, 0, 110, 97, 110, 111, 115, 108, 101, 101, 112, 0, 112, 111, 108,
-		108, 0, 117, 97, 100, 100, 114, 0, 98, 105, 116, 115, 101, 116, 0, 116,
-		105, 109, 101, 0, 117, 97, 100, 100, 114, 50, 0, 99, 108, 111, 99, 107,
-		105, 100, 0, 99, 108, 111, 99, 107, 105, 100, 95, 116, 0, 95, 95, 107,
-		101, 114, 110, 101, 108, 95, 99, 108, 111, 99, 107, 105, 100, 95, 116, 0,
-		116, 105, 109, 101, 115, 112, 101, 99, 95, 116, 121, 112, 101, 0, 84, 84,
-		95, 78, 79, 78, 69, 0, 84, 84, 95, 78, 65, 84, 73, 86, 69, 0,
-		84, 84, 95, 67, 79, 77, 80, 65, 84, 0, 114, 109, 116, 112, 0, 99,
-		111, 109, 112, 97, 116, 95, 114, 109, 116, 112, 0, 117, 102, 100, 115, 0,
-		110, 102, 100, 115, 0, 104, 97, 115, 95, 116, 105, 109, 101, 111, 117, 116,
-		0, 116, 118, 95, 115, 101, 99, 0, 116, 118, 95, 110, 115, 101, 99, 0,
-		112, 105, 100, 95, 116, 0, 95, 95, 107, 101, 114, 110, 101, 108, 95, 112,
-		105, 100, 95, 116, 0, 108, 101, 118, 101, 108, 0, 105, 110, 111, 100, 101,
-		115, 0, 119, 97, 105, 116, 95, 112, 105, 100, 102, 100, 0, 110, 117, 109,
-		98, 101, 114, 115, 0, 119, 97, 105, 116, 95, 113, 117, 101, 117, 101, 95,
-		104, 101, 97, 100, 95, 116, 0, 119, 97, 105, 116, 95, 113, 117, 101, 117,
-		101, 95, 104, 101, 97, 100, 0, 104, 101, 97, 100, 0, 117, 112, 105, 100,
-		0, 110, 115, 0, 104, 108, 105, 115, 116, 95, 110, 111, 100, 101, 0, 112,
-		112, 114, 101, 118, 0, 115, 116, 97, 114, 116, 116, 105, 109, 101, 0, 118,
-		116, 105, 109, 101, 95, 115, 116, 97, 116, 101, 0, 86, 84, 73, 77, 69,
-		95, 73, 78, 65, 67, 84, 73, 86, 69, 0, 86, 84, 73, 77, 69, 95,
-		73, 68, 76, 69, 0, 86, 84, 73, 77, 69, 95, 83, 89, 83, 0, 86,
-		84, 73, 77, 69, 95, 85, 83, 69, 82, 0, 86, 84, 73, 77, 69, 95,
-		71, 85, 69, 83, 84, 0, 98, 97, 115, 101, 115, 0, 116, 105, 109, 101,
-		114, 115, 95, 97, 99, 116, 105, 118, 101, 0, 101, 120, 112, 105, 114, 121,
-		95, 97, 99, 116, 105, 118, 101, 0, 112, 111, 115, 105, 120, 95, 99, 112,
-		117, 116, 105, 109, 101, 114, 95, 98, 97, 115, 101, 0, 110, 101, 120, 116,
-		101, 118, 116, 0, 116, 113, 104, 101, 97, 100, 0, 116, 105, 109, 101, 114,
-		113, 117, 101, 117, 101, 95, 104, 101, 97, 100, 0, 115, 99, 104, 101, 100,
-		117, 108, 101, 100, 0, 103, 105, 100, 0, 115, 117, 105, 100, 0, 115, 103,
-		105, 100, 0, 101, 103, 105, 100, 0, 102, 115, 117, 105, 100, 0, 102, 115,
-		103, 105, 100, 0, 115, 101, 99, 117, 114, 101, 98, 105, 116, 115, 0, 99,
-		97, 112, 95, 105, 110, 104, 101, 114, 105, 116, 97, 98, 108, 101, 0, 99,
-		97, 112, 95, 112, 101, 114, 109, 105, 116, 116, 101, 100, 0, 99, 97, 112,
-		95, 101, 102, 102, 101, 99, 116, 105, 118, 101, 0, 99, 97, 112, 95, 98,
-		115, 101, 116, 0, 99, 97, 112, 95, 97, 109, 98, 105, 101, 110, 116, 0,
-		106, 105, 116, 95, 107, 101, 121, 114, 105, 110, 103, 0, 115, 101, 115, 115,
-		105, 111, 110, 95, 107, 101, 121, 114, 105, 110, 103, 0, 112, 114, 111, 99,
-		101, 115, 115, 95, 107, 101, 121, 114, 105, 110, 103, 0, 116, 104, 114, 101,
-		97, 100, 95, 107, 101, 121, 114, 105, 110, 103, 0, 114, 101, 113, 117, 101,
-		115, 116, 95, 107, 101, 121, 95, 97, 117, 116, 104, 0, 117, 115, 101, 114,
-		0, 117, 99, 111, 117, 110, 116, 115, 0, 103, 114, 111, 117, 112, 95, 105,
-		110, 102, 111, 0, 107, 103, 105, 100, 95, 116, 0, 103, 105, 100, 95, 116,
-		0, 95, 95, 107, 101, 114, 110, 101, 108, 95, 103, 105, 100, 51, 50, 95,
-		116, 0, 107, 101, 114, 110, 101, 108, 95, 99, 97, 112, 95, 116, 0, 107,
-		101, 114, 110, 101, 108, 95, 99, 97, 112, 95, 115, 116, 114, 117, 99, 116,
-		0, 99, 97, 112, 0, 115, 101, 114, 105, 97, 108, 0, 119, 97, 116, 99,
-		104, 101, 114, 115, 0, 115, 101, 109, 0, 108, 97, 115, 116, 95, 117, 115,
-		101, 100, 95, 97, 116, 0, 112, 101, 114, 109, 0, 113, 117, 111, 116, 97,
-		108, 101, 110, 0, 100, 97, 116, 97, 108, 101, 110, 0, 114, 101, 115, 116,
-		114, 105, 99, 116, 95, 108, 105, 110, 107, 0, 107, 101, 121, 95, 115, 101,
-		114, 105, 97, 108, 95, 116, 0, 105, 110, 116, 51, 50, 95, 116, 0, 115,
-		51, 50, 0, 95, 95, 115, 51, 50, 0, 103, 114, 97, 118, 101, 121, 97,
-		114, 100, 95, 108, 105, 110, 107, 0, 115, 101, 114, 105, 97, 108, 95, 110,
-		111, 100, 101, 0, 101, 120, 112, 105, 114, 121, 0, 114, 101, 118, 111, 107,
-		101, 100, 95, 97, 116, 0, 116, 105, 109, 101, 54, 52, 95, 116, 0, 107,
-		101, 121, 95, 112, 101, 114, 109, 95, 116, 0, 117, 105, 110, 116, 51, 50,
-		95, 116, 0, 105, 110, 100, 101, 120, 95, 107, 101, 121, 0, 107, 101, 121,
-		114, 105, 110, 103, 95, 105, 110, 100, 101, 120, 95, 107, 101, 121, 0, 104,
-		97, 115, 104, 0, 100, 111, 109, 97, 105, 110, 95, 116, 97, 103, 0, 100,
-		101, 115, 99, 114, 105, 112, 116, 105, 111, 110, 0, 120, 0, 100, 101, 115,
-		99, 95, 108, 101, 110, 0, 100, 101, 115, 99, 0, 108, 101, 110, 95, 100,
-		101, 115, 99, 0, 107, 101, 121, 95, 116, 121, 112, 101, 0, 110, 97, 109,
-		101, 0, 100, 101, 102, 95, 100, 97, 116, 97, 108, 101, 110, 0, 118, 101,
-		116, 95, 100, 101, 115, 99, 114, 105, 112, 116, 105, 111, 110, 0, 112, 114,
-		101, 112, 97, 114, 115, 101, 0, 102, 114, 101, 101, 95, 112, 114, 101, 112,
-		97, 114, 115, 101, 0, 105, 110, 115, 116, 97, 110, 116, 105, 97, 116, 101,
-		0, 117, 112, 100, 97, 116, 101, 0, 109, 97, 116, 99, 104, 95, 112, 114,
-		101, 112, 97, 114, 115, 101, 0, 109, 97, 116, 99, 104, 95, 102, 114, 101,
-		101, 0, 114, 101, 118, 111, 107, 101, 0, 100, 101, 115, 116, 114, 111, 121,
-		0, 100, 101, 115, 99, 114, 105, 98, 101, 0, 114, 101, 97, 100, 0, 114,
-		101, 113, 117, 101, 115, 116, 95, 107, 101, 121, 0, 108, 111, 111, 107, 117,
-		112, 95, 114, 101, 115, 116, 114, 105, 99, 116, 105, 111, 110, 0, 97, 115,
-		121, 109, 95, 113, 117, 101, 114, 121, 0, 97, 115, 121, 109, 95, 101, 100,
-		115, 95, 111, 112, 0, 97, 115, 121, 109, 95, 118, 101, 114, 105, 102, 121,
-		95, 115, 105, 103, 110, 97, 116, 117, 114, 101, 0, 108, 105, 110, 107, 0,
-		108, 111, 99, 107, 95, 99, 108, 97, 115, 115, 0, 115, 105, 122, 101, 95,
-		116, 0, 95, 95, 107, 101, 114, 110, 101, 108, 95, 115, 105, 122, 101, 95,
-		116, 0, 95, 95, 107, 101, 114, 110, 101, 108, 95, 117, 108, 111, 110, 103,
-		95, 116, 0, 107, 101, 121, 95, 112, 114, 101, 112, 97, 114, 115, 101, 100,
-		95, 112, 97, 121, 108, 111, 97, 100, 0, 111, 114, 105, 103, 95, 100, 101,
-		115, 99, 114, 105, 112, 116, 105, 111, 110, 0, 112, 97, 121, 108, 111, 97,
-		100, 0, 107, 101, 121, 95, 112, 97, 121, 108, 111, 97, 100, 0, 114, 99,
-		117, 95, 100, 97, 116, 97, 48, 0, 107, 101, 121, 95, 109, 97, 116, 99,
-		104, 95, 100, 97, 116, 97, 0, 99, 109, 112, 0, 114, 97, 119, 95, 100,
-		97, 116, 97, 0, 112, 114, 101, 112, 97, 114, 115, 101, 100, 0, 108, 111,
-		111, 107, 117, 112, 95, 116, 121, 112, 101, 0, 98, 111, 111, 108, 0, 95,
-		66, 111, 111, 108, 0, 115, 101, 113, 95, 102, 105, 108, 101, 0, 98, 117,
-		102, 0, 102, 114, 111, 109, 0, 112, 97, 100, 95, 117, 110, 116, 105, 108,
-		0, 105, 110, 100, 101, 120, 0, 114, 101, 97, 100, 95, 112, 111, 115, 0,
-		111, 112, 0, 112, 111, 108, 108, 95, 101, 118, 101, 110, 116, 0, 112, 114,
-		105, 118, 97, 116, 101, 0, 114, 101, 113, 117, 101, 115, 116, 95, 107, 101,
-		121, 95, 97, 99, 116, 111, 114, 95, 116, 0, 107, 101, 121, 95, 114, 101,
-		115, 116, 114, 105, 99, 116, 105, 111, 110, 0, 99, 104, 101, 99, 107, 0,
-		107, 101, 121, 116, 121, 112, 101, 0, 107, 101, 121, 95, 114, 101, 115, 116,
-		114, 105, 99, 116, 95, 108, 105, 110, 107, 95, 102, 117, 110, 99, 95, 116,
-		0, 107, 101, 114, 110, 101, 108, 95, 112, 107, 101, 121, 95, 112, 97, 114,
-		97, 109, 115, 0, 101, 110, 99, 111, 100, 105, 110, 103, 0, 104, 97, 115,
-		104, 95, 97, 108, 103, 111, 0, 105, 110, 102, 111, 0, 105, 110, 95, 108,
-		101, 110, 0, 111, 117, 116, 95, 108, 101, 110, 0, 105, 110, 50, 95, 108,
-		101, 110, 0, 107, 101, 114, 110, 101, 108, 95, 112, 107, 101, 121, 95, 111,
-		112, 101, 114, 97, 116, 105, 111, 110, 0, 107, 101, 114, 110, 101, 108, 95,
-		112, 107, 101, 121, 95, 101, 110, 99, 114, 121, 112, 116, 0, 107, 101, 114,
-		110, 101, 108, 95, 112, 107, 101, 121, 95, 100, 101, 99, 114, 121, 112, 116,
-		0, 107, 101, 114, 110, 101, 108, 95, 112, 107, 101, 121, 95, 115, 105, 103,
-		110, 0, 107, 101, 114, 110, 101, 108, 95, 112, 107, 101, 121, 95, 118, 101,
-		114, 105, 102, 121, 0, 107, 101, 114, 110, 101, 108, 95, 112, 107, 101, 121,
-		95, 113, 117, 101, 114, 121, 0, 115, 117, 112, 112, 111, 114, 116, 101, 100,
-		95, 111, 112, 115, 0, 107, 101, 121, 95, 115, 105, 122, 101, 0, 109, 97,
-		120, 95, 100, 97, 116, 97, 95, 115, 105, 122, 101, 0, 109, 97, 120, 95,
-		115, 105, 103, 95, 115, 105, 122, 101, 0, 109, 97, 120, 95, 101, 110, 99,
-		95, 115, 105, 122, 101, 0, 109, 97, 120, 95, 100, 101, 99, 95, 115, 105,
-		122, 101, 0, 108, 111, 99, 107, 95, 99, 108, 97, 115, 115, 95, 107, 101,
-		121, 0, 107, 101, 121, 95, 116, 97, 103, 0, 110, 97, 109, 101, 95, 108,
-		105, 110, 107, 0, 107, 101, 121, 115, 0, 97, 115, 115, 111, 99, 95, 97,
-		114, 114, 97, 121, 0, 114, 111, 111, 116, 0, 110, 114, 95, 108, 101, 97,
-		118, 101, 115, 95, 111, 110, 95, 116, 114, 101, 101, 0, 97, 115, 115, 111,
-		99, 95, 97, 114, 114, 97, 121, 95, 112, 116, 114, 0, 117, 115, 101, 114,
-		95, 110, 97, 109, 101, 115, 112, 97, 99, 101, 0, 117, 105, 100, 95, 109,
-		97, 112, 0, 103, 105, 100, 95, 109, 97, 112, 0, 112, 114, 111, 106, 105,
-		100, 95, 109, 97, 112, 0, 103, 114, 111, 117, 112, 0, 112, 97, 114, 101,
-		110, 116, 95, 99, 111, 117, 108, 100, 95, 115, 101, 116, 102, 99, 97, 112,
-		0, 107, 101, 121, 114, 105, 110, 103, 95, 110, 97, 109, 101, 95, 108, 105,
-		115, 116, 0, 117, 115, 101, 114, 95, 107, 101, 121, 114, 105, 110, 103, 95,
-		114, 101, 103, 105, 115, 116, 101, 114, 0, 107, 101, 121, 114, 105, 110, 103,
-		95, 115, 101, 109, 0, 112, 101, 114, 115, 105, 115, 116, 101, 110, 116, 95,
-		107, 101, 121, 114, 105, 110, 103, 95, 114, 101, 103, 105, 115, 116, 101, 114,
-		0, 115, 101, 116, 0, 115, 121, 115, 99, 116, 108, 115, 0, 117, 99, 111,
-		117, 110, 116, 95, 109, 97, 120, 0, 117, 105, 100, 95, 103, 105, 100, 95,
-		109, 97, 112, 0, 110, 114, 95, 101, 120, 116, 101, 110, 116, 115, 0, 101,
-		120, 116, 101, 110, 116, 0, 117, 105, 100, 95, 103, 105, 100, 95, 101, 120,
-		116, 101, 110, 116, 0, 108, 111, 119, 101, 114, 95, 102, 105, 114, 115, 116,
-		0, 102, 111, 114, 119, 97, 114, 100, 0, 114, 101, 118, 101, 114, 115, 101,
-		0, 110, 115, 95, 99, 111, 109, 109, 111, 110, 0, 115, 116, 97, 115, 104,
-		101, 100, 0, 111, 112, 115, 0, 105, 110, 117, 109, 0, 99, 116, 108, 95,
-		116, 97, 98, 108, 101, 95, 115, 101, 116, 0, 105, 115, 95, 115, 101, 101,
-		110, 0, 100, 105, 114, 0, 99, 116, 108, 95, 100, 105, 114, 0, 104, 101,
-		97, 100, 101, 114, 0, 99, 116, 108, 95, 116, 97, 98, 108, 101, 95, 104,
-		101, 97, 100, 101, 114, 0, 117, 110, 114, 101, 103, 105, 115, 116, 101, 114,
-		105, 110, 103, 0, 99, 116, 108, 95, 116, 97, 98, 108, 101, 95, 97, 114,
-		103, 0, 99, 116, 108, 95, 116, 97, 98, 108, 101, 0, 117, 115, 101, 100,
-		0, 110, 114, 101, 103, 0, 99, 111, 109, 112, 108, 101, 116, 105, 111, 110,
-		0, 100, 111, 110, 101, 0, 119, 97, 105, 116, 0, 115, 119, 97, 105, 116,
-		95, 113, 117, 101, 117, 101, 95, 104, 101, 97, 100, 0, 116, 97, 115, 107,
-		95, 108, 105, 115, 116, 0, 112, 114, 111, 99, 110, 97, 109, 101, 0, 109,
-		97, 120, 108, 101, 110, 0, 109, 111, 100, 101, 0, 99, 104, 105, 108, 100,
-		0, 112, 114, 111, 99, 95, 104, 97, 110, 100, 108, 101, 114, 0, 101, 120,
-		116, 114, 97, 49, 0, 101, 120, 116, 114, 97, 50, 0, 117, 109, 111, 100,
-		101, 95, 116, 0, 117, 99, 111, 117, 110, 116, 0, 110, 111, 110, 95, 114,
-		99, 117, 0, 115, 121, 115, 118, 95, 115, 101, 109, 0, 117, 110, 100, 111,
+		108, 111, 99, 107, 101, 100, 0, 108, 111, 99, 107, 101, 100, 95, 112, 101,
+		110, 100, 105, 110, 103, 0, 116, 97, 105, 108, 0, 115, 101, 113, 99, 111,
+		117, 110, 116, 0, 115, 116, 97, 114, 116, 116, 105, 109, 101, 0, 115, 101,
+		113, 99, 111, 117, 110, 116, 95, 116, 0, 115, 101, 113, 117, 101, 110, 99,
+		101, 0, 118, 116, 105, 109, 101, 95, 115, 116, 97, 116, 101, 0, 86, 84,
+		73, 77, 69, 95, 73, 78, 65, 67, 84, 73, 86, 69, 0, 86, 84, 73,
+		77, 69, 95, 73, 68, 76, 69, 0, 86, 84, 73, 77, 69, 95, 83, 89,
+		83, 0, 86, 84, 73, 77, 69, 95, 85, 83, 69, 82, 0, 86, 84, 73,
+		77, 69, 95, 71, 85, 69, 83, 84, 0, 98, 97, 115, 101, 115, 0, 116,
+		105, 109, 101, 114, 115, 95, 97, 99, 116, 105, 118, 101, 0, 101, 120, 112,
+		105, 114, 121, 95, 97, 99, 116, 105, 118, 101, 0, 112, 111, 115, 105, 120,
+		95, 99, 112, 117, 116, 105, 109, 101, 114, 95, 98, 97, 115, 101, 0, 110,
+		101, 120, 116, 101, 118, 116, 0, 116, 113, 104, 101, 97, 100, 0, 116, 105,
+		109, 101, 114, 113, 117, 101, 117, 101, 95, 104, 101, 97, 100, 0, 114, 98,
+		95, 114, 111, 111, 116, 0, 114, 98, 95, 114, 111, 111, 116, 95, 99, 97,
+		99, 104, 101, 100, 0, 114, 98, 95, 108, 101, 102, 116, 109, 111, 115, 116,
+		0, 119, 111, 114, 107, 0, 115, 99, 104, 101, 100, 117, 108, 101, 100, 0,
+		99, 97, 108, 108, 98, 97, 99, 107, 95, 104, 101, 97, 100, 0, 102, 117,
+		110, 99, 0, 115, 121, 115, 118, 95, 115, 101, 109, 0, 117, 110, 100, 111,
 		95, 108, 105, 115, 116, 0, 115, 121, 115, 118, 95, 115, 104, 109, 0, 115,
 		104, 109, 95, 99, 108, 105, 115, 116, 0, 115, 105, 103, 115, 101, 116, 95,
 		116, 0, 115, 105, 103, 0, 115, 105, 103, 112, 101, 110, 100, 105, 110, 103,
+		0, 108, 105, 115, 116, 0, 115, 105, 122, 101, 95, 116, 0, 95, 95, 107,
+		101, 114, 110, 101, 108, 95, 115, 105, 122, 101, 95, 116, 0, 95, 95, 107,
+		101, 114, 110, 101, 108, 95, 117, 108, 111, 110, 103, 95, 116, 0, 107, 117,
+		105, 100, 95, 116, 0, 117, 105, 100, 95, 116, 0, 95, 95, 107, 101, 114,
+		110, 101, 108, 95, 117, 105, 100, 51, 50, 95, 116, 0, 109, 111, 100, 101,
 		0, 102, 105, 108, 116, 101, 114, 95, 99, 111, 117, 110, 116, 0, 102, 105,
 		108, 116, 101, 114, 0, 115, 121, 115, 99, 97, 108, 108, 95, 117, 115, 101,
 		114, 95, 100, 105, 115, 112, 97, 116, 99, 104, 0, 115, 101, 108, 101, 99,
 		116, 111, 114, 0, 111, 102, 102, 115, 101, 116, 0, 108, 101, 110, 0, 111,
-		110, 95, 100, 105, 115, 112, 97, 116, 99, 104, 0, 119, 97, 107, 101, 95,
-		113, 95, 110, 111, 100, 101, 0, 107, 101, 114, 110, 101, 108, 95, 115, 105,
-		103, 105, 110, 102, 111, 95, 116, 0, 116, 97, 115, 107, 95, 105, 111, 95,
-		97, 99, 99, 111, 117, 110, 116, 105, 110, 103, 0, 114, 99, 104, 97, 114,
-		0, 119, 99, 104, 97, 114, 0, 115, 121, 115, 99, 114, 0, 115, 121, 115,
-		99, 119, 0, 114, 101, 97, 100, 95, 98, 121, 116, 101, 115, 0, 119, 114,
-		105, 116, 101, 95, 98, 121, 116, 101, 115, 0, 99, 97, 110, 99, 101, 108,
-		108, 101, 100, 95, 119, 114, 105, 116, 101, 95, 98, 121, 116, 101, 115, 0,
-		110, 111, 100, 101, 109, 97, 115, 107, 95, 116, 0, 115, 101, 113, 99, 111,
-		117, 110, 116, 95, 115, 112, 105, 110, 108, 111, 99, 107, 95, 116, 0, 115,
-		101, 113, 99, 111, 117, 110, 116, 95, 115, 112, 105, 110, 108, 111, 99, 107,
-		0, 112, 101, 114, 102, 95, 101, 118, 101, 110, 116, 95, 99, 111, 110, 116,
-		101, 120, 116, 0, 112, 109, 117, 0, 97, 99, 116, 105, 118, 101, 95, 99,
-		116, 120, 95, 108, 105, 115, 116, 0, 112, 105, 110, 110, 101, 100, 95, 103,
-		114, 111, 117, 112, 115, 0, 102, 108, 101, 120, 105, 98, 108, 101, 95, 103,
-		114, 111, 117, 112, 115, 0, 101, 118, 101, 110, 116, 95, 108, 105, 115, 116,
-		0, 112, 105, 110, 110, 101, 100, 95, 97, 99, 116, 105, 118, 101, 0, 102,
-		108, 101, 120, 105, 98, 108, 101, 95, 97, 99, 116, 105, 118, 101, 0, 110,
-		114, 95, 101, 118, 101, 110, 116, 115, 0, 110, 114, 95, 97, 99, 116, 105,
-		118, 101, 0, 110, 114, 95, 117, 115, 101, 114, 0, 105, 115, 95, 97, 99,
-		116, 105, 118, 101, 0, 110, 114, 95, 115, 116, 97, 116, 0, 110, 114, 95,
-		102, 114, 101, 113, 0, 114, 111, 116, 97, 116, 101, 95, 100, 105, 115, 97,
-		98, 108, 101, 0, 114, 111, 116, 97, 116, 101, 95, 110, 101, 99, 101, 115,
-		115, 97, 114, 121, 0, 114, 101, 102, 99, 111, 117, 110, 116, 0, 116, 97,
-		115, 107, 0, 116, 105, 109, 101, 115, 116, 97, 109, 112, 0, 116, 105, 109,
-		101, 111, 102, 102, 115, 101, 116, 0, 112, 97, 114, 101, 110, 116, 95, 99,
-		116, 120, 0, 112, 97, 114, 101, 110, 116, 95, 103, 101, 110, 0, 103, 101,
-		110, 101, 114, 97, 116, 105, 111, 110, 0, 112, 105, 110, 95, 99, 111, 117,
-		110, 116, 0, 110, 114, 95, 99, 103, 114, 111, 117, 112, 115, 0, 116, 97,
-		115, 107, 95, 99, 116, 120, 95, 100, 97, 116, 97, 0, 110, 114, 95, 112,
-		101, 110, 100, 105, 110, 103, 0, 112, 101, 114, 102, 95, 101, 118, 101, 110,
-		116, 95, 103, 114, 111, 117, 112, 115, 0, 116, 114, 101, 101, 0, 108, 111,
-		99, 97, 108, 95, 116, 0, 97, 0, 110, 111, 100, 101, 115, 0, 104, 111,
-		109, 101, 95, 110, 111, 100, 101, 0, 119, 0, 99, 112, 117, 115, 101, 116,
-		95, 109, 101, 109, 115, 95, 97, 108, 108, 111, 119, 101, 100, 0, 117, 115,
-		101, 114, 95, 110, 111, 100, 101, 109, 97, 115, 107, 0, 116, 108, 98, 102,
-		108, 117, 115, 104, 95, 117, 110, 109, 97, 112, 95, 98, 97, 116, 99, 104,
-		0, 97, 114, 99, 104, 0, 102, 108, 117, 115, 104, 95, 114, 101, 113, 117,
-		105, 114, 101, 100, 0, 119, 114, 105, 116, 97, 98, 108, 101, 0, 97, 114,
-		99, 104, 95, 116, 108, 98, 102, 108, 117, 115, 104, 95, 117, 110, 109, 97,
-		112, 95, 98, 97, 116, 99, 104, 0, 114, 99, 117, 95, 117, 115, 101, 114,
-		115, 0, 112, 97, 103, 101, 95, 102, 114, 97, 103, 0, 112, 97, 103, 101,
+		110, 95, 100, 105, 115, 112, 97, 116, 99, 104, 0, 98, 111, 111, 108, 0,
+		95, 66, 111, 111, 108, 0, 115, 112, 105, 110, 108, 111, 99, 107, 95, 116,
+		0, 115, 112, 105, 110, 108, 111, 99, 107, 0, 114, 108, 111, 99, 107, 0,
+		119, 97, 107, 101, 95, 113, 95, 110, 111, 100, 101, 0, 107, 101, 114, 110,
+		101, 108, 95, 115, 105, 103, 105, 110, 102, 111, 95, 116, 0, 116, 97, 115,
+		107, 95, 105, 111, 95, 97, 99, 99, 111, 117, 110, 116, 105, 110, 103, 0,
+		114, 99, 104, 97, 114, 0, 119, 99, 104, 97, 114, 0, 115, 121, 115, 99,
+		114, 0, 115, 121, 115, 99, 119, 0, 114, 101, 97, 100, 95, 98, 121, 116,
+		101, 115, 0, 119, 114, 105, 116, 101, 95, 98, 121, 116, 101, 115, 0, 99,
+		97, 110, 99, 101, 108, 108, 101, 100, 95, 119, 114, 105, 116, 101, 95, 98,
+		121, 116, 101, 115, 0, 110, 111, 100, 101, 109, 97, 115, 107, 95, 116, 0,
+		115, 101, 113, 99, 111, 117, 110, 116, 95, 115, 112, 105, 110, 108, 111, 99,
+		107, 95, 116, 0, 115, 101, 113, 99, 111, 117, 110, 116, 95, 115, 112, 105,
+		110, 108, 111, 99, 107, 0, 109, 117, 116, 101, 120, 0, 111, 119, 110, 101,
+		114, 0, 119, 97, 105, 116, 95, 108, 111, 99, 107, 0, 111, 115, 113, 0,
+		119, 97, 105, 116, 95, 108, 105, 115, 116, 0, 111, 112, 116, 105, 109, 105,
+		115, 116, 105, 99, 95, 115, 112, 105, 110, 95, 113, 117, 101, 117, 101, 0,
+		112, 101, 114, 102, 95, 101, 118, 101, 110, 116, 95, 99, 111, 110, 116, 101,
+		120, 116, 0, 112, 109, 117, 0, 97, 99, 116, 105, 118, 101, 95, 99, 116,
+		120, 95, 108, 105, 115, 116, 0, 112, 105, 110, 110, 101, 100, 95, 103, 114,
+		111, 117, 112, 115, 0, 102, 108, 101, 120, 105, 98, 108, 101, 95, 103, 114,
+		111, 117, 112, 115, 0, 101, 118, 101, 110, 116, 95, 108, 105, 115, 116, 0,
+		112, 105, 110, 110, 101, 100, 95, 97, 99, 116, 105, 118, 101, 0, 102, 108,
+		101, 120, 105, 98, 108, 101, 95, 97, 99, 116, 105, 118, 101, 0, 110, 114,
+		95, 101, 118, 101, 110, 116, 115, 0, 110, 114, 95, 97, 99, 116, 105, 118,
+		101, 0, 110, 114, 95, 117, 115, 101, 114, 0, 105, 115, 95, 97, 99, 116,
+		105, 118, 101, 0, 110, 114, 95, 115, 116, 97, 116, 0, 110, 114, 95, 102,
+		114, 101, 113, 0, 114, 111, 116, 97, 116, 101, 95, 100, 105, 115, 97, 98,
+		108, 101, 0, 114, 111, 116, 97, 116, 101, 95, 110, 101, 99, 101, 115, 115,
+		97, 114, 121, 0, 114, 101, 102, 99, 111, 117, 110, 116, 0, 116, 97, 115,
+		107, 0, 116, 105, 109, 101, 115, 116, 97, 109, 112, 0, 116, 105, 109, 101,
+		111, 102, 102, 115, 101, 116, 0, 112, 97, 114, 101, 110, 116, 95, 99, 116,
+		120, 0, 112, 97, 114, 101, 110, 116, 95, 103, 101, 110, 0, 103, 101, 110,
+		101, 114, 97, 116, 105, 111, 110, 0, 112, 105, 110, 95, 99, 111, 117, 110,
+		116, 0, 110, 114, 95, 99, 103, 114, 111, 117, 112, 115, 0, 116, 97, 115,
+		107, 95, 99, 116, 120, 95, 100, 97, 116, 97, 0, 110, 114, 95, 112, 101,
+		110, 100, 105, 110, 103, 0, 112, 101, 114, 102, 95, 101, 118, 101, 110, 116,
+		95, 103, 114, 111, 117, 112, 115, 0, 116, 114, 101, 101, 0, 105, 110, 100,
+		101, 120, 0, 108, 111, 99, 97, 108, 95, 116, 0, 97, 0, 115, 104, 111,
+		114, 116, 0, 116, 108, 98, 102, 108, 117, 115, 104, 95, 117, 110, 109, 97,
+		112, 95, 98, 97, 116, 99, 104, 0, 97, 114, 99, 104, 0, 102, 108, 117,
+		115, 104, 95, 114, 101, 113, 117, 105, 114, 101, 100, 0, 119, 114, 105, 116,
+		97, 98, 108, 101, 0, 97, 114, 99, 104, 95, 116, 108, 98, 102, 108, 117,
+		115, 104, 95, 117, 110, 109, 97, 112, 95, 98, 97, 116, 99, 104, 0, 114,
+		99, 117, 95, 117, 115, 101, 114, 115, 0, 114, 99, 117, 0, 112, 97, 103,
+		101, 95, 102, 114, 97, 103, 0, 112, 97, 103, 101, 0, 115, 105, 122, 101,
 		0, 98, 97, 99, 107, 116, 114, 97, 99, 101, 0, 109, 97, 120, 0, 103,
-		102, 112, 95, 116, 0, 109, 101, 109, 95, 99, 103, 114, 111, 117, 112, 0,
-		109, 101, 109, 111, 114, 121, 0, 107, 109, 101, 109, 0, 116, 99, 112, 109,
-		101, 109, 0, 104, 105, 103, 104, 95, 119, 111, 114, 107, 0, 122, 115, 119,
-		97, 112, 95, 109, 97, 120, 0, 115, 111, 102, 116, 95, 108, 105, 109, 105,
-		116, 0, 118, 109, 112, 114, 101, 115, 115, 117, 114, 101, 0, 111, 111, 109,
-		95, 103, 114, 111, 117, 112, 0, 111, 111, 109, 95, 108, 111, 99, 107, 0,
-		117, 110, 100, 101, 114, 95, 111, 111, 109, 0, 115, 119, 97, 112, 112, 105,
-		110, 101, 115, 115, 0, 111, 111, 109, 95, 107, 105, 108, 108, 95, 100, 105,
-		115, 97, 98, 108, 101, 0, 101, 118, 101, 110, 116, 115, 95, 102, 105, 108,
-		101, 0, 101, 118, 101, 110, 116, 115, 95, 108, 111, 99, 97, 108, 95, 102,
-		105, 108, 101, 0, 115, 119, 97, 112, 95, 101, 118, 101, 110, 116, 115, 95,
-		102, 105, 108, 101, 0, 116, 104, 114, 101, 115, 104, 111, 108, 100, 115, 95,
-		108, 111, 99, 107, 0, 116, 104, 114, 101, 115, 104, 111, 108, 100, 115, 0,
-		109, 101, 109, 115, 119, 95, 116, 104, 114, 101, 115, 104, 111, 108, 100, 115,
-		0, 111, 111, 109, 95, 110, 111, 116, 105, 102, 121, 0, 109, 111, 118, 101,
-		95, 99, 104, 97, 114, 103, 101, 95, 97, 116, 95, 105, 109, 109, 105, 103,
-		114, 97, 116, 101, 0, 109, 111, 118, 101, 95, 108, 111, 99, 107, 0, 109,
-		111, 118, 101, 95, 108, 111, 99, 107, 95, 102, 108, 97, 103, 115, 0, 95,
-		112, 97, 100, 49, 95, 0, 118, 109, 115, 116, 97, 116, 115, 0, 109, 101,
-		109, 111, 114, 121, 95, 101, 118, 101, 110, 116, 115, 0, 109, 101, 109, 111,
-		114, 121, 95, 101, 118, 101, 110, 116, 115, 95, 108, 111, 99, 97, 108, 0,
-		115, 111, 99, 107, 101, 116, 95, 112, 114, 101, 115, 115, 117, 114, 101, 0,
-		116, 99, 112, 109, 101, 109, 95, 97, 99, 116, 105, 118, 101, 0, 116, 99,
-		112, 109, 101, 109, 95, 112, 114, 101, 115, 115, 117, 114, 101, 0, 107, 109,
-		101, 109, 99, 103, 95, 105, 100, 0, 111, 98, 106, 99, 103, 0, 111, 98,
-		106, 99, 103, 95, 108, 105, 115, 116, 0, 95, 112, 97, 100, 50, 95, 0,
-		109, 111, 118, 105, 110, 103, 95, 97, 99, 99, 111, 117, 110, 116, 0, 109,
-		111, 118, 101, 95, 108, 111, 99, 107, 95, 116, 97, 115, 107, 0, 118, 109,
-		115, 116, 97, 116, 115, 95, 112, 101, 114, 99, 112, 117, 0, 99, 103, 119,
-		98, 95, 108, 105, 115, 116, 0, 99, 103, 119, 98, 95, 100, 111, 109, 97,
-		105, 110, 0, 99, 103, 119, 98, 95, 102, 114, 110, 0, 101, 118, 101, 110,
-		116, 95, 108, 105, 115, 116, 95, 108, 111, 99, 107, 0, 100, 101, 102, 101,
-		114, 114, 101, 100, 95, 115, 112, 108, 105, 116, 95, 113, 117, 101, 117, 101,
-		0, 110, 111, 100, 101, 105, 110, 102, 111, 0, 109, 101, 109, 95, 99, 103,
-		114, 111, 117, 112, 95, 105, 100, 0, 114, 101, 102, 0, 112, 97, 103, 101,
-		95, 99, 111, 117, 110, 116, 101, 114, 0, 109, 105, 110, 0, 108, 111, 119,
-		0, 104, 105, 103, 104, 0, 101, 109, 105, 110, 0, 109, 105, 110, 95, 117,
-		115, 97, 103, 101, 0, 99, 104, 105, 108, 100, 114, 101, 110, 95, 109, 105,
-		110, 95, 117, 115, 97, 103, 101, 0, 101, 108, 111, 119, 0, 108, 111, 119,
-		95, 117, 115, 97, 103, 101, 0, 99, 104, 105, 108, 100, 114, 101, 110, 95,
-		108, 111, 119, 95, 117, 115, 97, 103, 101, 0, 119, 97, 116, 101, 114, 109,
-		97, 114, 107, 0, 102, 97, 105, 108, 99, 110, 116, 0, 115, 119, 97, 112,
-		0, 109, 101, 109, 115, 119, 0, 115, 99, 97, 110, 110, 101, 100, 0, 114,
-		101, 99, 108, 97, 105, 109, 101, 100, 0, 116, 114, 101, 101, 95, 115, 99,
-		97, 110, 110, 101, 100, 0, 116, 114, 101, 101, 95, 114, 101, 99, 108, 97,
-		105, 109, 101, 100, 0, 115, 114, 95, 108, 111, 99, 107, 0, 101, 118, 101,
-		110, 116, 115, 95, 108, 111, 99, 107, 0, 99, 103, 114, 111, 117, 112, 95,
-		102, 105, 108, 101, 0, 107, 110, 0, 110, 111, 116, 105, 102, 105, 101, 100,
-		95, 97, 116, 0, 110, 111, 116, 105, 102, 121, 95, 116, 105, 109, 101, 114,
-		0, 116, 105, 109, 101, 114, 95, 108, 105, 115, 116, 0, 109, 101, 109, 95,
-		99, 103, 114, 111, 117, 112, 95, 116, 104, 114, 101, 115, 104, 111, 108, 100,
-		115, 0, 112, 114, 105, 109, 97, 114, 121, 0, 115, 112, 97, 114, 101, 0,
-		109, 101, 109, 95, 99, 103, 114, 111, 117, 112, 95, 116, 104, 114, 101, 115,
-		104, 111, 108, 100, 95, 97, 114, 121, 0, 99, 117, 114, 114, 101, 110, 116,
-		95, 116, 104, 114, 101, 115, 104, 111, 108, 100, 0, 101, 110, 116, 114, 105,
-		101, 115, 0, 109, 101, 109, 95, 99, 103, 114, 111, 117, 112, 95, 116, 104,
-		114, 101, 115, 104, 111, 108, 100, 0, 101, 118, 101, 110, 116, 102, 100, 0,
-		116, 104, 114, 101, 115, 104, 111, 108, 100, 0, 109, 101, 109, 99, 103, 95,
-		112, 97, 100, 100, 105, 110, 103, 0, 109, 101, 109, 99, 103, 95, 118, 109,
-		115, 116, 97, 116, 115, 0, 115, 116, 97, 116, 101, 95, 112, 101, 110, 100,
-		105, 110, 103, 0, 101, 118, 101, 110, 116, 115, 95, 112, 101, 110, 100, 105,
-		110, 103, 0, 119, 98, 95, 100, 111, 109, 97, 105, 110, 0, 99, 111, 109,
-		112, 108, 101, 116, 105, 111, 110, 115, 0, 112, 101, 114, 105, 111, 100, 95,
-		116, 105, 109, 101, 0, 100, 105, 114, 116, 121, 95, 108, 105, 109, 105, 116,
-		95, 116, 115, 116, 97, 109, 112, 0, 100, 105, 114, 116, 121, 95, 108, 105,
-		109, 105, 116, 0, 102, 112, 114, 111, 112, 95, 103, 108, 111, 98, 97, 108,
-		0, 112, 101, 114, 99, 112, 117, 95, 99, 111, 117, 110, 116, 101, 114, 0,
-		99, 111, 117, 110, 116, 101, 114, 115, 0, 109, 101, 109, 99, 103, 95, 99,
-		103, 119, 98, 95, 102, 114, 110, 0, 98, 100, 105, 95, 105, 100, 0, 109,
-		101, 109, 99, 103, 95, 105, 100, 0, 97, 116, 0, 119, 98, 95, 99, 111,
-		109, 112, 108, 101, 116, 105, 111, 110, 0, 99, 110, 116, 0, 119, 97, 105,
-		116, 113, 0, 100, 101, 102, 101, 114, 114, 101, 100, 95, 115, 112, 108, 105,
-		116, 0, 115, 112, 108, 105, 116, 95, 113, 117, 101, 117, 101, 95, 108, 111,
-		99, 107, 0, 115, 112, 108, 105, 116, 95, 113, 117, 101, 117, 101, 0, 115,
-		112, 108, 105, 116, 95, 113, 117, 101, 117, 101, 95, 108, 101, 110, 0, 109,
-		101, 109, 95, 99, 103, 114, 111, 117, 112, 95, 112, 101, 114, 95, 110, 111,
-		100, 101, 0, 108, 114, 117, 118, 101, 99, 0, 108, 114, 117, 118, 101, 99,
-		95, 115, 116, 97, 116, 115, 95, 112, 101, 114, 99, 112, 117, 0, 108, 114,
-		117, 118, 101, 99, 95, 115, 116, 97, 116, 115, 0, 108, 114, 117, 95, 122,
-		111, 110, 101, 95, 115, 105, 122, 101, 0, 105, 116, 101, 114, 0, 115, 104,
-		114, 105, 110, 107, 101, 114, 95, 105, 110, 102, 111, 0, 116, 114, 101, 101,
-		95, 110, 111, 100, 101, 0, 117, 115, 97, 103, 101, 95, 105, 110, 95, 101,
-		120, 99, 101, 115, 115, 0, 111, 110, 95, 116, 114, 101, 101, 0, 109, 101,
-		109, 99, 103, 0, 108, 105, 115, 116, 115, 0, 108, 114, 117, 95, 108, 111,
-		99, 107, 0, 97, 110, 111, 110, 95, 99, 111, 115, 116, 0, 102, 105, 108,
-		101, 95, 99, 111, 115, 116, 0, 110, 111, 110, 114, 101, 115, 105, 100, 101,
-		110, 116, 95, 97, 103, 101, 0, 114, 101, 102, 97, 117, 108, 116, 115, 0,
-		112, 103, 100, 97, 116, 0, 109, 101, 109, 95, 99, 103, 114, 111, 117, 112,
-		95, 114, 101, 99, 108, 97, 105, 109, 95, 105, 116, 101, 114, 0, 112, 111,
-		115, 105, 116, 105, 111, 110, 0, 108, 108, 105, 115, 116, 95, 104, 101, 97,
-		100, 0, 116, 104, 114, 101, 97, 100, 95, 115, 116, 114, 117, 99, 116, 0,
-		116, 108, 115, 95, 97, 114, 114, 97, 121, 0, 115, 112, 0, 101, 115, 0,
-		100, 115, 0, 102, 115, 105, 110, 100, 101, 120, 0, 103, 115, 105, 110, 100,
-		101, 120, 0, 102, 115, 98, 97, 115, 101, 0, 103, 115, 98, 97, 115, 101,
-		0, 112, 116, 114, 97, 99, 101, 95, 98, 112, 115, 0, 118, 105, 114, 116,
-		117, 97, 108, 95, 100, 114, 54, 0, 112, 116, 114, 97, 99, 101, 95, 100,
-		114, 55, 0, 99, 114, 50, 0, 116, 114, 97, 112, 95, 110, 114, 0, 101,
-		114, 114, 111, 114, 95, 99, 111, 100, 101, 0, 105, 111, 95, 98, 105, 116,
-		109, 97, 112, 0, 105, 111, 112, 108, 95, 101, 109, 117, 108, 0, 105, 111,
-		112, 108, 95, 119, 97, 114, 110, 0, 115, 105, 103, 95, 111, 110, 95, 117,
-		97, 99, 99, 101, 115, 115, 95, 101, 114, 114, 0, 112, 107, 114, 117, 0,
-		102, 112, 117, 0, 100, 101, 115, 99, 95, 115, 116, 114, 117, 99, 116, 0,
-		108, 105, 109, 105, 116, 48, 0, 98, 97, 115, 101, 48, 0, 98, 97, 115,
-		101, 49, 0, 100, 112, 108, 0, 112, 0, 108, 105, 109, 105, 116, 49, 0,
-		97, 118, 108, 0, 108, 0, 100, 0, 103, 0, 98, 97, 115, 101, 50, 0,
-		112, 101, 114, 102, 95, 101, 118, 101, 110, 116, 0, 101, 118, 101, 110, 116,
-		95, 101, 110, 116, 114, 121, 0, 115, 105, 98, 108, 105, 110, 103, 95, 108,
-		105, 115, 116, 0, 97, 99, 116, 105, 118, 101, 95, 108, 105, 115, 116, 0,
-		103, 114, 111, 117, 112, 95, 105, 110, 100, 101, 120, 0, 109, 105, 103, 114,
-		97, 116, 101, 95, 101, 110, 116, 114, 121, 0, 104, 108, 105, 115, 116, 95,
-		101, 110, 116, 114, 121, 0, 97, 99, 116, 105, 118, 101, 95, 101, 110, 116,
-		114, 121, 0, 110, 114, 95, 115, 105, 98, 108, 105, 110, 103, 115, 0, 101,
-		118, 101, 110, 116, 95, 99, 97, 112, 115, 0, 103, 114, 111, 117, 112, 95,
-		99, 97, 112, 115, 0, 112, 109, 117, 95, 112, 114, 105, 118, 97, 116, 101,
-		0, 97, 116, 116, 97, 99, 104, 95, 115, 116, 97, 116, 101, 0, 99, 104,
-		105, 108, 100, 95, 99, 111, 117, 110, 116, 0, 116, 111, 116, 97, 108, 95,
-		116, 105, 109, 101, 95, 101, 110, 97, 98, 108, 101, 100, 0, 116, 111, 116,
-		97, 108, 95, 116, 105, 109, 101, 95, 114, 117, 110, 110, 105, 110, 103, 0,
-		116, 115, 116, 97, 109, 112, 0, 97, 116, 116, 114, 0, 104, 101, 97, 100,
-		101, 114, 95, 115, 105, 122, 101, 0, 105, 100, 95, 104, 101, 97, 100, 101,
-		114, 95, 115, 105, 122, 101, 0, 114, 101, 97, 100, 95, 115, 105, 122, 101,
-		0, 104, 119, 0, 99, 104, 105, 108, 100, 95, 116, 111, 116, 97, 108, 95,
-		116, 105, 109, 101, 95, 101, 110, 97, 98, 108, 101, 100, 0, 99, 104, 105,
-		108, 100, 95, 116, 111, 116, 97, 108, 95, 116, 105, 109, 101, 95, 114, 117,
-		110, 110, 105, 110, 103, 0, 99, 104, 105, 108, 100, 95, 109, 117, 116, 101,
-		120, 0, 99, 104, 105, 108, 100, 95, 108, 105, 115, 116, 0, 111, 110, 99,
-		112, 117, 0, 111, 119, 110, 101, 114, 95, 101, 110, 116, 114, 121, 0, 109,
-		109, 97, 112, 95, 109, 117, 116, 101, 120, 0, 109, 109, 97, 112, 95, 99,
-		111, 117, 110, 116, 0, 114, 98, 95, 101, 110, 116, 114, 121, 0, 114, 99,
-		117, 95, 98, 97, 116, 99, 104, 101, 115, 0, 114, 99, 117, 95, 112, 101,
-		110, 100, 105, 110, 103, 0, 102, 97, 115, 121, 110, 99, 0, 112, 101, 110,
-		100, 105, 110, 103, 95, 119, 97, 107, 101, 117, 112, 0, 112, 101, 110, 100,
-		105, 110, 103, 95, 107, 105, 108, 108, 0, 112, 101, 110, 100, 105, 110, 103,
-		95, 100, 105, 115, 97, 98, 108, 101, 0, 112, 101, 110, 100, 105, 110, 103,
-		95, 115, 105, 103, 116, 114, 97, 112, 0, 112, 101, 110, 100, 105, 110, 103,
-		95, 97, 100, 100, 114, 0, 112, 101, 110, 100, 105, 110, 103, 95, 105, 114,
-		113, 0, 112, 101, 110, 100, 105, 110, 103, 95, 116, 97, 115, 107, 0, 112,
-		101, 110, 100, 105, 110, 103, 95, 119, 111, 114, 107, 0, 101, 118, 101, 110,
-		116, 95, 108, 105, 109, 105, 116, 0, 97, 100, 100, 114, 95, 102, 105, 108,
-		116, 101, 114, 115, 0, 97, 100, 100, 114, 95, 102, 105, 108, 116, 101, 114,
-		95, 114, 97, 110, 103, 101, 115, 0, 97, 100, 100, 114, 95, 102, 105, 108,
-		116, 101, 114, 115, 95, 103, 101, 110, 0, 97, 117, 120, 95, 101, 118, 101,
-		110, 116, 0, 108, 111, 115, 116, 95, 115, 97, 109, 112, 108, 101, 115, 0,
+		102, 112, 95, 116, 0, 116, 105, 109, 101, 114, 95, 108, 105, 115, 116, 0,
+		101, 110, 116, 114, 121, 0, 108, 108, 105, 115, 116, 95, 104, 101, 97, 100,
+		0, 116, 104, 114, 101, 97, 100, 95, 115, 116, 114, 117, 99, 116, 0, 116,
+		108, 115, 95, 97, 114, 114, 97, 121, 0, 115, 112, 0, 101, 115, 0, 100,
+		115, 0, 102, 115, 105, 110, 100, 101, 120, 0, 103, 115, 105, 110, 100, 101,
+		120, 0, 102, 115, 98, 97, 115, 101, 0, 103, 115, 98, 97, 115, 101, 0,
+		112, 116, 114, 97, 99, 101, 95, 98, 112, 115, 0, 118, 105, 114, 116, 117,
+		97, 108, 95, 100, 114, 54, 0, 112, 116, 114, 97, 99, 101, 95, 100, 114,
+		55, 0, 99, 114, 50, 0, 116, 114, 97, 112, 95, 110, 114, 0, 101, 114,
+		114, 111, 114, 95, 99, 111, 100, 101, 0, 105, 111, 95, 98, 105, 116, 109,
+		97, 112, 0, 105, 111, 112, 108, 95, 101, 109, 117, 108, 0, 105, 111, 112,
+		108, 95, 119, 97, 114, 110, 0, 115, 105, 103, 95, 111, 110, 95, 117, 97,
+		99, 99, 101, 115, 115, 95, 101, 114, 114, 0, 112, 107, 114, 117, 0, 102,
+		112, 117, 0, 100, 101, 115, 99, 95, 115, 116, 114, 117, 99, 116, 0, 108,
+		105, 109, 105, 116, 48, 0, 98, 97, 115, 101, 48, 0, 98, 97, 115, 101,
+		49, 0, 100, 112, 108, 0, 112, 0, 108, 105, 109, 105, 116, 49, 0, 97,
+		118, 108, 0, 108, 0, 100, 0, 103, 0, 98, 97, 115, 101, 50, 0, 112,
+		101, 114, 102, 95, 101, 118, 101, 110, 116, 0, 101, 118, 101, 110, 116, 95,
+		101, 110, 116, 114, 121, 0, 115, 105, 98, 108, 105, 110, 103, 95, 108, 105,
+		115, 116, 0, 97, 99, 116, 105, 118, 101, 95, 108, 105, 115, 116, 0, 103,
+		114, 111, 117, 112, 95, 105, 110, 100, 101, 120, 0, 109, 105, 103, 114, 97,
+		116, 101, 95, 101, 110, 116, 114, 121, 0, 104, 108, 105, 115, 116, 95, 101,
+		110, 116, 114, 121, 0, 97, 99, 116, 105, 118, 101, 95, 101, 110, 116, 114,
+		121, 0, 110, 114, 95, 115, 105, 98, 108, 105, 110, 103, 115, 0, 101, 118,
+		101, 110, 116, 95, 99, 97, 112, 115, 0, 103, 114, 111, 117, 112, 95, 99,
+		97, 112, 115, 0, 112, 109, 117, 95, 112, 114, 105, 118, 97, 116, 101, 0,
+		97, 116, 116, 97, 99, 104, 95, 115, 116, 97, 116, 101, 0, 99, 104, 105,
+		108, 100, 95, 99, 111, 117, 110, 116, 0, 116, 111, 116, 97, 108, 95, 116,
+		105, 109, 101, 95, 101, 110, 97, 98, 108, 101, 100, 0, 116, 111, 116, 97,
+		108, 95, 116, 105, 109, 101, 95, 114, 117, 110, 110, 105, 110, 103, 0, 116,
+		115, 116, 97, 109, 112, 0, 97, 116, 116, 114, 0, 104, 101, 97, 100, 101,
+		114, 95, 115, 105, 122, 101, 0, 105, 100, 95, 104, 101, 97, 100, 101, 114,
+		95, 115, 105, 122, 101, 0, 114, 101, 97, 100, 95, 115, 105, 122, 101, 0,
+		104, 119, 0, 99, 104, 105, 108, 100, 95, 116, 111, 116, 97, 108, 95, 116,
+		105, 109, 101, 95, 101, 110, 97, 98, 108, 101, 100, 0, 99, 104, 105, 108,
+		100, 95, 116, 111, 116, 97, 108, 95, 116, 105, 109, 101, 95, 114, 117, 110,
+		110, 105, 110, 103, 0, 99, 104, 105, 108, 100, 95, 109, 117, 116, 101, 120,
+		0, 99, 104, 105, 108, 100, 95, 108, 105, 115, 116, 0, 111, 110, 99, 112,
+		117, 0, 111, 119, 110, 101, 114, 95, 101, 110, 116, 114, 121, 0, 109, 109,
+		97, 112, 95, 109, 117, 116, 101, 120, 0, 109, 109, 97, 112, 95, 99, 111,
+		117, 110, 116, 0, 114, 98, 95, 101, 110, 116, 114, 121, 0, 114, 99, 117,
+		95, 98, 97, 116, 99, 104, 101, 115, 0, 114, 99, 117, 95, 112, 101, 110,
+		100, 105, 110, 103, 0, 119, 97, 105, 116, 113, 0, 102, 97, 115, 121, 110,
+		99, 0, 112, 101, 110, 100, 105, 110, 103, 95, 119, 97, 107, 101, 117, 112,
+		0, 112, 101, 110, 100, 105, 110, 103, 95, 107, 105, 108, 108, 0, 112, 101,
+		110, 100, 105, 110, 103, 95, 100, 105, 115, 97, 98, 108, 101, 0, 112, 101,
+		110, 100, 105, 110, 103, 95, 115, 105, 103, 116, 114, 97, 112, 0, 112, 101,
+		110, 100, 105, 110, 103, 95, 97, 100, 100, 114, 0, 112, 101, 110, 100, 105,
+		110, 103, 95, 105, 114, 113, 0, 112, 101, 110, 100, 105, 110, 103, 95, 116,
+		97, 115, 107, 0, 112, 101, 110, 100, 105, 110, 103, 95, 119, 111, 114, 107,
+		0, 101, 118, 101, 110, 116, 95, 108, 105, 109, 105, 116, 0, 97, 100, 100,
+		114, 95, 102, 105, 108, 116, 101, 114, 115, 0, 97, 100, 100, 114, 95, 102,
+		105, 108, 116, 101, 114, 95, 114, 97, 110, 103, 101, 115, 0, 97, 100, 100,
+		114, 95, 102, 105, 108, 116, 101, 114, 115, 95, 103, 101, 110, 0, 97, 117,
+		120, 95, 101, 118, 101, 110, 116, 0, 100, 101, 115, 116, 114, 111, 121, 0,
+		110, 115, 0, 108, 111, 115, 116, 95, 115, 97, 109, 112, 108, 101, 115, 0,
 		99, 108, 111, 99, 107, 0, 111, 118, 101, 114, 102, 108, 111, 119, 95, 104,
 		97, 110, 100, 108, 101, 114, 0, 111, 118, 101, 114, 102, 108, 111, 119, 95,
 		104, 97, 110, 100, 108, 101, 114, 95, 99, 111, 110, 116, 101, 120, 116, 0,
@@ -3672,325 +1879,28 @@ var bpfObjects = map[string][]byte{
 		110, 100, 108, 101, 114, 0, 112, 114, 111, 103, 0, 98, 112, 102, 95, 99,
 		111, 111, 107, 105, 101, 0, 116, 112, 95, 101, 118, 101, 110, 116, 0, 102,
 		116, 114, 97, 99, 101, 95, 111, 112, 115, 0, 99, 103, 114, 112, 0, 115,
-		98, 95, 108, 105, 115, 116, 0, 109, 111, 100, 117, 108, 101, 0, 100, 101,
-		118, 0, 97, 116, 116, 114, 95, 103, 114, 111, 117, 112, 115, 0, 97, 116,
-		116, 114, 95, 117, 112, 100, 97, 116, 101, 0, 99, 97, 112, 97, 98, 105,
-		108, 105, 116, 105, 101, 115, 0, 112, 109, 117, 95, 100, 105, 115, 97, 98,
-		108, 101, 95, 99, 111, 117, 110, 116, 0, 112, 109, 117, 95, 99, 112, 117,
-		95, 99, 111, 110, 116, 101, 120, 116, 0, 101, 120, 99, 108, 117, 115, 105,
-		118, 101, 95, 99, 110, 116, 0, 116, 97, 115, 107, 95, 99, 116, 120, 95,
-		110, 114, 0, 104, 114, 116, 105, 109, 101, 114, 95, 105, 110, 116, 101, 114,
-		118, 97, 108, 95, 109, 115, 0, 110, 114, 95, 97, 100, 100, 114, 95, 102,
-		105, 108, 116, 101, 114, 115, 0, 112, 109, 117, 95, 101, 110, 97, 98, 108,
-		101, 0, 112, 109, 117, 95, 100, 105, 115, 97, 98, 108, 101, 0, 101, 118,
-		101, 110, 116, 95, 105, 110, 105, 116, 0, 101, 118, 101, 110, 116, 95, 109,
-		97, 112, 112, 101, 100, 0, 101, 118, 101, 110, 116, 95, 117, 110, 109, 97,
-		112, 112, 101, 100, 0, 97, 100, 100, 0, 100, 101, 108, 0, 115, 116, 111,
-		112, 0, 115, 116, 97, 114, 116, 95, 116, 120, 110, 0, 99, 111, 109, 109,
-		105, 116, 95, 116, 120, 110, 0, 99, 97, 110, 99, 101, 108, 95, 116, 120,
-		110, 0, 101, 118, 101, 110, 116, 95, 105, 100, 120, 0, 115, 99, 104, 101,
-		100, 95, 116, 97, 115, 107, 0, 116, 97, 115, 107, 95, 99, 116, 120, 95,
-		99, 97, 99, 104, 101, 0, 115, 119, 97, 112, 95, 116, 97, 115, 107, 95,
-		99, 116, 120, 0, 115, 101, 116, 117, 112, 95, 97, 117, 120, 0, 102, 114,
-		101, 101, 95, 97, 117, 120, 0, 115, 110, 97, 112, 115, 104, 111, 116, 95,
-		97, 117, 120, 0, 97, 100, 100, 114, 95, 102, 105, 108, 116, 101, 114, 115,
-		95, 118, 97, 108, 105, 100, 97, 116, 101, 0, 97, 100, 100, 114, 95, 102,
-		105, 108, 116, 101, 114, 115, 95, 115, 121, 110, 99, 0, 97, 117, 120, 95,
-		111, 117, 116, 112, 117, 116, 95, 109, 97, 116, 99, 104, 0, 102, 105, 108,
-		116, 101, 114, 95, 109, 97, 116, 99, 104, 0, 99, 104, 101, 99, 107, 95,
-		112, 101, 114, 105, 111, 100, 0, 97, 116, 116, 114, 105, 98, 117, 116, 101,
-		95, 103, 114, 111, 117, 112, 0, 105, 115, 95, 118, 105, 115, 105, 98, 108,
-		101, 0, 105, 115, 95, 98, 105, 110, 95, 118, 105, 115, 105, 98, 108, 101,
-		0, 97, 116, 116, 114, 115, 0, 98, 105, 110, 95, 97, 116, 116, 114, 115,
-		0, 107, 111, 98, 106, 101, 99, 116, 0, 107, 115, 101, 116, 0, 107, 116,
-		121, 112, 101, 0, 115, 100, 0, 107, 114, 101, 102, 0, 115, 116, 97, 116,
-		101, 95, 105, 110, 105, 116, 105, 97, 108, 105, 122, 101, 100, 0, 115, 116,
-		97, 116, 101, 95, 105, 110, 95, 115, 121, 115, 102, 115, 0, 115, 116, 97,
-		116, 101, 95, 97, 100, 100, 95, 117, 101, 118, 101, 110, 116, 95, 115, 101,
-		110, 116, 0, 115, 116, 97, 116, 101, 95, 114, 101, 109, 111, 118, 101, 95,
-		117, 101, 118, 101, 110, 116, 95, 115, 101, 110, 116, 0, 117, 101, 118, 101,
-		110, 116, 95, 115, 117, 112, 112, 114, 101, 115, 115, 0, 107, 101, 114, 110,
-		102, 115, 95, 110, 111, 100, 101, 0, 97, 99, 116, 105, 118, 101, 0, 112,
-		114, 105, 118, 0, 105, 97, 116, 116, 114, 0, 115, 121, 109, 108, 105, 110,
-		107, 0, 107, 101, 114, 110, 102, 115, 95, 101, 108, 101, 109, 95, 100, 105,
-		114, 0, 115, 117, 98, 100, 105, 114, 115, 0, 114, 101, 118, 0, 107, 101,
-		114, 110, 102, 115, 95, 101, 108, 101, 109, 95, 115, 121, 109, 108, 105, 110,
-		107, 0, 116, 97, 114, 103, 101, 116, 95, 107, 110, 0, 107, 101, 114, 110,
-		102, 115, 95, 101, 108, 101, 109, 95, 97, 116, 116, 114, 0, 111, 112, 101,
-		110, 0, 110, 111, 116, 105, 102, 121, 95, 110, 101, 120, 116, 0, 97, 116,
-		116, 114, 105, 98, 117, 116, 101, 0, 98, 105, 110, 95, 97, 116, 116, 114,
-		105, 98, 117, 116, 101, 0, 119, 114, 105, 116, 101, 0, 97, 100, 100, 114,
-		101, 115, 115, 95, 115, 112, 97, 99, 101, 0, 104, 111, 115, 116, 0, 105,
-		95, 112, 97, 103, 101, 115, 0, 105, 110, 118, 97, 108, 105, 100, 97, 116,
-		101, 95, 108, 111, 99, 107, 0, 103, 102, 112, 95, 109, 97, 115, 107, 0,
-		105, 95, 109, 109, 97, 112, 95, 119, 114, 105, 116, 97, 98, 108, 101, 0,
-		105, 95, 109, 109, 97, 112, 0, 105, 95, 109, 109, 97, 112, 95, 114, 119,
-		115, 101, 109, 0, 110, 114, 112, 97, 103, 101, 115, 0, 119, 114, 105, 116,
-		101, 98, 97, 99, 107, 95, 105, 110, 100, 101, 120, 0, 97, 95, 111, 112,
-		115, 0, 119, 98, 95, 101, 114, 114, 0, 112, 114, 105, 118, 97, 116, 101,
-		95, 108, 111, 99, 107, 0, 112, 114, 105, 118, 97, 116, 101, 95, 108, 105,
-		115, 116, 0, 105, 110, 111, 100, 101, 0, 105, 95, 109, 111, 100, 101, 0,
-		105, 95, 111, 112, 102, 108, 97, 103, 115, 0, 105, 95, 117, 105, 100, 0,
-		105, 95, 103, 105, 100, 0, 105, 95, 102, 108, 97, 103, 115, 0, 105, 95,
-		97, 99, 108, 0, 105, 95, 100, 101, 102, 97, 117, 108, 116, 95, 97, 99,
-		108, 0, 105, 95, 111, 112, 0, 105, 95, 115, 98, 0, 105, 95, 109, 97,
-		112, 112, 105, 110, 103, 0, 105, 95, 115, 101, 99, 117, 114, 105, 116, 121,
-		0, 105, 95, 105, 110, 111, 0, 105, 95, 114, 100, 101, 118, 0, 105, 95,
-		115, 105, 122, 101, 0, 105, 95, 97, 116, 105, 109, 101, 0, 105, 95, 109,
-		116, 105, 109, 101, 0, 105, 95, 99, 116, 105, 109, 101, 0, 105, 95, 108,
-		111, 99, 107, 0, 105, 95, 98, 121, 116, 101, 115, 0, 105, 95, 98, 108,
-		107, 98, 105, 116, 115, 0, 105, 95, 119, 114, 105, 116, 101, 95, 104, 105,
-		110, 116, 0, 105, 95, 98, 108, 111, 99, 107, 115, 0, 105, 95, 115, 116,
-		97, 116, 101, 0, 105, 95, 114, 119, 115, 101, 109, 0, 100, 105, 114, 116,
-		105, 101, 100, 95, 119, 104, 101, 110, 0, 100, 105, 114, 116, 105, 101, 100,
-		95, 116, 105, 109, 101, 95, 119, 104, 101, 110, 0, 105, 95, 104, 97, 115,
-		104, 0, 105, 95, 105, 111, 95, 108, 105, 115, 116, 0, 105, 95, 119, 98,
-		0, 105, 95, 119, 98, 95, 102, 114, 110, 95, 119, 105, 110, 110, 101, 114,
-		0, 105, 95, 119, 98, 95, 102, 114, 110, 95, 97, 118, 103, 95, 116, 105,
-		109, 101, 0, 105, 95, 119, 98, 95, 102, 114, 110, 95, 104, 105, 115, 116,
-		111, 114, 121, 0, 105, 95, 108, 114, 117, 0, 105, 95, 115, 98, 95, 108,
-		105, 115, 116, 0, 105, 95, 119, 98, 95, 108, 105, 115, 116, 0, 105, 95,
-		118, 101, 114, 115, 105, 111, 110, 0, 105, 95, 115, 101, 113, 117, 101, 110,
-		99, 101, 0, 105, 95, 99, 111, 117, 110, 116, 0, 105, 95, 100, 105, 111,
-		95, 99, 111, 117, 110, 116, 0, 105, 95, 119, 114, 105, 116, 101, 99, 111,
-		117, 110, 116, 0, 105, 95, 114, 101, 97, 100, 99, 111, 117, 110, 116, 0,
-		105, 95, 102, 108, 99, 116, 120, 0, 105, 95, 100, 97, 116, 97, 0, 105,
-		95, 100, 101, 118, 105, 99, 101, 115, 0, 105, 95, 103, 101, 110, 101, 114,
-		97, 116, 105, 111, 110, 0, 105, 95, 102, 115, 110, 111, 116, 105, 102, 121,
-		95, 109, 97, 115, 107, 0, 105, 95, 102, 115, 110, 111, 116, 105, 102, 121,
-		95, 109, 97, 114, 107, 115, 0, 105, 95, 99, 114, 121, 112, 116, 95, 105,
-		110, 102, 111, 0, 105, 95, 118, 101, 114, 105, 116, 121, 95, 105, 110, 102,
-		111, 0, 105, 95, 112, 114, 105, 118, 97, 116, 101, 0, 112, 111, 115, 105,
-		120, 95, 97, 99, 108, 0, 97, 95, 114, 101, 102, 99, 111, 117, 110, 116,
-		0, 97, 95, 114, 99, 117, 0, 97, 95, 99, 111, 117, 110, 116, 0, 97,
-		95, 101, 110, 116, 114, 105, 101, 115, 0, 112, 111, 115, 105, 120, 95, 97,
-		99, 108, 95, 101, 110, 116, 114, 121, 0, 101, 95, 116, 97, 103, 0, 101,
-		95, 112, 101, 114, 109, 0, 101, 95, 117, 105, 100, 0, 101, 95, 103, 105,
-		100, 0, 105, 95, 110, 108, 105, 110, 107, 0, 95, 95, 105, 95, 110, 108,
-		105, 110, 107, 0, 100, 101, 118, 95, 116, 0, 95, 95, 107, 101, 114, 110,
-		101, 108, 95, 100, 101, 118, 95, 116, 0, 116, 105, 109, 101, 115, 112, 101,
-		99, 54, 52, 0, 98, 108, 107, 99, 110, 116, 95, 116, 0, 105, 95, 100,
-		101, 110, 116, 114, 121, 0, 105, 95, 114, 99, 117, 0, 105, 95, 102, 111,
-		112, 0, 102, 114, 101, 101, 95, 105, 110, 111, 100, 101, 0, 102, 105, 108,
-		101, 95, 111, 112, 101, 114, 97, 116, 105, 111, 110, 115, 0, 108, 108, 115,
-		101, 101, 107, 0, 114, 101, 97, 100, 95, 105, 116, 101, 114, 0, 119, 114,
-		105, 116, 101, 95, 105, 116, 101, 114, 0, 105, 111, 112, 111, 108, 108, 0,
-		105, 116, 101, 114, 97, 116, 101, 0, 105, 116, 101, 114, 97, 116, 101, 95,
-		115, 104, 97, 114, 101, 100, 0, 117, 110, 108, 111, 99, 107, 101, 100, 95,
-		105, 111, 99, 116, 108, 0, 99, 111, 109, 112, 97, 116, 95, 105, 111, 99,
-		116, 108, 0, 109, 109, 97, 112, 95, 115, 117, 112, 112, 111, 114, 116, 101,
-		100, 95, 102, 108, 97, 103, 115, 0, 102, 108, 117, 115, 104, 0, 114, 101,
-		108, 101, 97, 115, 101, 0, 102, 115, 121, 110, 99, 0, 115, 101, 110, 100,
-		112, 97, 103, 101, 0, 99, 104, 101, 99, 107, 95, 102, 108, 97, 103, 115,
-		0, 102, 108, 111, 99, 107, 0, 115, 112, 108, 105, 99, 101, 95, 119, 114,
-		105, 116, 101, 0, 115, 112, 108, 105, 99, 101, 95, 114, 101, 97, 100, 0,
-		115, 101, 116, 108, 101, 97, 115, 101, 0, 102, 97, 108, 108, 111, 99, 97,
-		116, 101, 0, 115, 104, 111, 119, 95, 102, 100, 105, 110, 102, 111, 0, 99,
-		111, 112, 121, 95, 102, 105, 108, 101, 95, 114, 97, 110, 103, 101, 0, 114,
-		101, 109, 97, 112, 95, 102, 105, 108, 101, 95, 114, 97, 110, 103, 101, 0,
-		102, 97, 100, 118, 105, 115, 101, 0, 117, 114, 105, 110, 103, 95, 99, 109,
-		100, 0, 109, 107, 111, 98, 106, 0, 109, 111, 100, 105, 110, 102, 111, 95,
-		97, 116, 116, 114, 115, 0, 118, 101, 114, 115, 105, 111, 110, 0, 115, 114,
-		99, 118, 101, 114, 115, 105, 111, 110, 0, 104, 111, 108, 100, 101, 114, 115,
-		95, 100, 105, 114, 0, 115, 121, 109, 115, 0, 99, 114, 99, 115, 0, 110,
-		117, 109, 95, 115, 121, 109, 115, 0, 112, 97, 114, 97, 109, 95, 108, 111,
-		99, 107, 0, 107, 112, 0, 110, 117, 109, 95, 107, 112, 0, 110, 117, 109,
-		95, 103, 112, 108, 95, 115, 121, 109, 115, 0, 103, 112, 108, 95, 115, 121,
-		109, 115, 0, 103, 112, 108, 95, 99, 114, 99, 115, 0, 117, 115, 105, 110,
-		103, 95, 103, 112, 108, 111, 110, 108, 121, 95, 115, 121, 109, 98, 111, 108,
-		115, 0, 115, 105, 103, 95, 111, 107, 0, 97, 115, 121, 110, 99, 95, 112,
-		114, 111, 98, 101, 95, 114, 101, 113, 117, 101, 115, 116, 101, 100, 0, 110,
-		117, 109, 95, 101, 120, 101, 110, 116, 114, 105, 101, 115, 0, 101, 120, 116,
-		97, 98, 108, 101, 0, 105, 110, 105, 116, 0, 99, 111, 114, 101, 95, 108,
-		97, 121, 111, 117, 116, 0, 105, 110, 105, 116, 95, 108, 97, 121, 111, 117,
-		116, 0, 116, 97, 105, 110, 116, 115, 0, 110, 117, 109, 95, 98, 117, 103,
-		115, 0, 98, 117, 103, 95, 108, 105, 115, 116, 0, 98, 117, 103, 95, 116,
-		97, 98, 108, 101, 0, 107, 97, 108, 108, 115, 121, 109, 115, 0, 99, 111,
-		114, 101, 95, 107, 97, 108, 108, 115, 121, 109, 115, 0, 115, 101, 99, 116,
-		95, 97, 116, 116, 114, 115, 0, 110, 111, 116, 101, 115, 95, 97, 116, 116,
-		114, 115, 0, 112, 101, 114, 99, 112, 117, 0, 112, 101, 114, 99, 112, 117,
-		95, 115, 105, 122, 101, 0, 110, 111, 105, 110, 115, 116, 114, 95, 116, 101,
-		120, 116, 95, 115, 116, 97, 114, 116, 0, 110, 111, 105, 110, 115, 116, 114,
-		95, 116, 101, 120, 116, 95, 115, 105, 122, 101, 0, 110, 117, 109, 95, 116,
-		114, 97, 99, 101, 112, 111, 105, 110, 116, 115, 0, 116, 114, 97, 99, 101,
-		112, 111, 105, 110, 116, 115, 95, 112, 116, 114, 115, 0, 110, 117, 109, 95,
-		115, 114, 99, 117, 95, 115, 116, 114, 117, 99, 116, 115, 0, 115, 114, 99,
-		117, 95, 115, 116, 114, 117, 99, 116, 95, 112, 116, 114, 115, 0, 110, 117,
-		109, 95, 98, 112, 102, 95, 114, 97, 119, 95, 101, 118, 101, 110, 116, 115,
-		0, 98, 112, 102, 95, 114, 97, 119, 95, 101, 118, 101, 110, 116, 115, 0,
-		98, 116, 102, 95, 100, 97, 116, 97, 95, 115, 105, 122, 101, 0, 98, 116,
-		102, 95, 100, 97, 116, 97, 0, 106, 117, 109, 112, 95, 101, 110, 116, 114,
-		105, 101, 115, 0, 110, 117, 109, 95, 106, 117, 109, 112, 95, 101, 110, 116,
-		114, 105, 101, 115, 0, 110, 117, 109, 95, 116, 114, 97, 99, 101, 95, 98,
-		112, 114, 105, 110, 116, 107, 95, 102, 109, 116, 0, 116, 114, 97, 99, 101,
-		95, 98, 112, 114, 105, 110, 116, 107, 95, 102, 109, 116, 95, 115, 116, 97,
-		114, 116, 0, 116, 114, 97, 99, 101, 95, 101, 118, 101, 110, 116, 115, 0,
-		110, 117, 109, 95, 116, 114, 97, 99, 101, 95, 101, 118, 101, 110, 116, 115,
-		0, 116, 114, 97, 99, 101, 95, 101, 118, 97, 108, 115, 0, 110, 117, 109,
-		95, 116, 114, 97, 99, 101, 95, 101, 118, 97, 108, 115, 0, 110, 117, 109,
-		95, 102, 116, 114, 97, 99, 101, 95, 99, 97, 108, 108, 115, 105, 116, 101,
-		115, 0, 102, 116, 114, 97, 99, 101, 95, 99, 97, 108, 108, 115, 105, 116,
-		101, 115, 0, 107, 112, 114, 111, 98, 101, 115, 95, 116, 101, 120, 116, 95,
-		115, 116, 97, 114, 116, 0, 107, 112, 114, 111, 98, 101, 115, 95, 116, 101,
-		120, 116, 95, 115, 105, 122, 101, 0, 107, 112, 114, 111, 98, 101, 95, 98,
-		108, 97, 99, 107, 108, 105, 115, 116, 0, 110, 117, 109, 95, 107, 112, 114,
-		111, 98, 101, 95, 98, 108, 97, 99, 107, 108, 105, 115, 116, 0, 110, 117,
-		109, 95, 115, 116, 97, 116, 105, 99, 95, 99, 97, 108, 108, 95, 115, 105,
-		116, 101, 115, 0, 115, 116, 97, 116, 105, 99, 95, 99, 97, 108, 108, 95,
-		115, 105, 116, 101, 115, 0, 110, 117, 109, 95, 107, 117, 110, 105, 116, 95,
-		115, 117, 105, 116, 101, 115, 0, 107, 117, 110, 105, 116, 95, 115, 117, 105,
-		116, 101, 115, 0, 107, 108, 112, 0, 107, 108, 112, 95, 97, 108, 105, 118,
-		101, 0, 107, 108, 112, 95, 105, 110, 102, 111, 0, 112, 114, 105, 110, 116,
-		107, 95, 105, 110, 100, 101, 120, 95, 115, 105, 122, 101, 0, 112, 114, 105,
-		110, 116, 107, 95, 105, 110, 100, 101, 120, 95, 115, 116, 97, 114, 116, 0,
-		115, 111, 117, 114, 99, 101, 95, 108, 105, 115, 116, 0, 116, 97, 114, 103,
-		101, 116, 95, 108, 105, 115, 116, 0, 101, 120, 105, 116, 0, 101, 105, 95,
-		102, 117, 110, 99, 115, 0, 110, 117, 109, 95, 101, 105, 95, 102, 117, 110,
-		99, 115, 0, 109, 111, 100, 117, 108, 101, 95, 115, 116, 97, 116, 101, 0,
-		77, 79, 68, 85, 76, 69, 95, 83, 84, 65, 84, 69, 95, 76, 73, 86,
-		69, 0, 77, 79, 68, 85, 76, 69, 95, 83, 84, 65, 84, 69, 95, 67,
-		79, 77, 73, 78, 71, 0, 77, 79, 68, 85, 76, 69, 95, 83, 84, 65,
-		84, 69, 95, 71, 79, 73, 78, 71, 0, 77, 79, 68, 85, 76, 69, 95,
-		83, 84, 65, 84, 69, 95, 85, 78, 70, 79, 82, 77, 69, 68, 0, 109,
-		111, 100, 117, 108, 101, 95, 107, 111, 98, 106, 101, 99, 116, 0, 107, 111,
-		98, 106, 0, 109, 111, 100, 0, 100, 114, 105, 118, 101, 114, 115, 95, 100,
-		105, 114, 0, 109, 112, 0, 107, 111, 98, 106, 95, 99, 111, 109, 112, 108,
-		101, 116, 105, 111, 110, 0, 107, 101, 114, 110, 101, 108, 95, 115, 121, 109,
-		98, 111, 108, 0, 118, 97, 108, 117, 101, 95, 111, 102, 102, 115, 101, 116,
-		0, 110, 97, 109, 101, 95, 111, 102, 102, 115, 101, 116, 0, 110, 97, 109,
-		101, 115, 112, 97, 99, 101, 95, 111, 102, 102, 115, 101, 116, 0, 109, 111,
-		100, 117, 108, 101, 95, 108, 97, 121, 111, 117, 116, 0, 116, 101, 120, 116,
-		95, 115, 105, 122, 101, 0, 114, 111, 95, 115, 105, 122, 101, 0, 114, 111,
-		95, 97, 102, 116, 101, 114, 95, 105, 110, 105, 116, 95, 115, 105, 122, 101,
-		0, 109, 116, 110, 0, 109, 111, 100, 95, 116, 114, 101, 101, 95, 110, 111,
-		100, 101, 0, 108, 97, 116, 99, 104, 95, 116, 114, 101, 101, 95, 110, 111,
-		100, 101, 0, 109, 111, 100, 95, 97, 114, 99, 104, 95, 115, 112, 101, 99,
-		105, 102, 105, 99, 0, 110, 117, 109, 95, 111, 114, 99, 115, 0, 111, 114,
-		99, 95, 117, 110, 119, 105, 110, 100, 95, 105, 112, 0, 111, 114, 99, 95,
-		117, 110, 119, 105, 110, 100, 0, 109, 111, 100, 95, 107, 97, 108, 108, 115,
-		121, 109, 115, 0, 115, 121, 109, 116, 97, 98, 0, 110, 117, 109, 95, 115,
-		121, 109, 116, 97, 98, 0, 115, 116, 114, 116, 97, 98, 0, 116, 121, 112,
-		101, 116, 97, 98, 0, 69, 108, 102, 54, 52, 95, 83, 121, 109, 0, 116,
-		114, 97, 99, 101, 112, 111, 105, 110, 116, 95, 112, 116, 114, 95, 116, 0,
-		115, 115, 105, 122, 101, 95, 116, 0, 95, 95, 107, 101, 114, 110, 101, 108,
-		95, 115, 115, 105, 122, 101, 95, 116, 0, 95, 95, 107, 101, 114, 110, 101,
-		108, 95, 108, 111, 110, 103, 95, 116, 0, 107, 105, 111, 99, 98, 0, 107,
-		105, 95, 102, 105, 108, 112, 0, 107, 105, 95, 112, 111, 115, 0, 107, 105,
-		95, 99, 111, 109, 112, 108, 101, 116, 101, 0, 107, 105, 95, 102, 108, 97,
-		103, 115, 0, 107, 105, 95, 105, 111, 112, 114, 105, 111, 0, 107, 105, 95,
-		119, 97, 105, 116, 113, 0, 105, 111, 118, 95, 105, 116, 101, 114, 0, 105,
-		116, 101, 114, 95, 116, 121, 112, 101, 0, 110, 111, 102, 97, 117, 108, 116,
-		0, 100, 97, 116, 97, 95, 115, 111, 117, 114, 99, 101, 0, 117, 115, 101,
-		114, 95, 98, 97, 99, 107, 101, 100, 0, 105, 111, 118, 95, 111, 102, 102,
-		115, 101, 116, 0, 108, 97, 115, 116, 95, 111, 102, 102, 115, 101, 116, 0,
-		105, 111, 118, 0, 107, 118, 101, 99, 0, 98, 118, 101, 99, 0, 120, 97,
-		114, 114, 97, 121, 0, 112, 105, 112, 101, 0, 117, 98, 117, 102, 0, 112,
-		105, 112, 101, 95, 105, 110, 111, 100, 101, 95, 105, 110, 102, 111, 0, 114,
-		100, 95, 119, 97, 105, 116, 0, 119, 114, 95, 119, 97, 105, 116, 0, 109,
-		97, 120, 95, 117, 115, 97, 103, 101, 0, 114, 105, 110, 103, 95, 115, 105,
-		122, 101, 0, 110, 111, 116, 101, 95, 108, 111, 115, 115, 0, 110, 114, 95,
-		97, 99, 99, 111, 117, 110, 116, 101, 100, 0, 114, 101, 97, 100, 101, 114,
-		115, 0, 119, 114, 105, 116, 101, 114, 115, 0, 114, 95, 99, 111, 117, 110,
-		116, 101, 114, 0, 119, 95, 99, 111, 117, 110, 116, 101, 114, 0, 112, 111,
-		108, 108, 95, 117, 115, 97, 103, 101, 0, 116, 109, 112, 95, 112, 97, 103,
-		101, 0, 102, 97, 115, 121, 110, 99, 95, 114, 101, 97, 100, 101, 114, 115,
-		0, 102, 97, 115, 121, 110, 99, 95, 119, 114, 105, 116, 101, 114, 115, 0,
-		98, 117, 102, 115, 0, 119, 97, 116, 99, 104, 95, 113, 117, 101, 117, 101,
-		0, 95, 114, 101, 102, 99, 111, 117, 110, 116, 0, 109, 101, 109, 99, 103,
-		95, 100, 97, 116, 97, 0, 109, 97, 112, 112, 105, 110, 103, 0, 108, 114,
-		117, 0, 98, 117, 100, 100, 121, 95, 108, 105, 115, 116, 0, 112, 99, 112,
-		95, 108, 105, 115, 116, 0, 95, 95, 102, 105, 108, 108, 101, 114, 0, 109,
-		108, 111, 99, 107, 95, 99, 111, 117, 110, 116, 0, 112, 112, 95, 109, 97,
-		103, 105, 99, 0, 112, 112, 0, 95, 112, 112, 95, 109, 97, 112, 112, 105,
-		110, 103, 95, 112, 97, 100, 0, 100, 109, 97, 95, 97, 100, 100, 114, 0,
-		100, 109, 97, 95, 97, 100, 100, 114, 95, 117, 112, 112, 101, 114, 0, 112,
-		112, 95, 102, 114, 97, 103, 95, 99, 111, 117, 110, 116, 0, 99, 111, 109,
-		112, 111, 117, 110, 100, 95, 104, 101, 97, 100, 0, 99, 111, 109, 112, 111,
-		117, 110, 100, 95, 100, 116, 111, 114, 0, 99, 111, 109, 112, 111, 117, 110,
-		100, 95, 111, 114, 100, 101, 114, 0, 99, 111, 109, 112, 111, 117, 110, 100,
-		95, 109, 97, 112, 99, 111, 117, 110, 116, 0, 99, 111, 109, 112, 111, 117,
-		110, 100, 95, 112, 105, 110, 99, 111, 117, 110, 116, 0, 99, 111, 109, 112,
-		111, 117, 110, 100, 95, 110, 114, 0, 95, 99, 111, 109, 112, 111, 117, 110,
-		100, 95, 112, 97, 100, 95, 49, 0, 95, 99, 111, 109, 112, 111, 117, 110,
-		100, 95, 112, 97, 100, 95, 50, 0, 100, 101, 102, 101, 114, 114, 101, 100,
-		95, 108, 105, 115, 116, 0, 95, 112, 116, 95, 112, 97, 100, 95, 49, 0,
-		112, 109, 100, 95, 104, 117, 103, 101, 95, 112, 116, 101, 0, 95, 112, 116,
-		95, 112, 97, 100, 95, 50, 0, 112, 116, 108, 0, 112, 103, 116, 97, 98,
-		108, 101, 95, 116, 0, 112, 116, 95, 109, 109, 0, 112, 116, 95, 102, 114,
-		97, 103, 95, 114, 101, 102, 99, 111, 117, 110, 116, 0, 112, 103, 109, 97,
-		112, 0, 122, 111, 110, 101, 95, 100, 101, 118, 105, 99, 101, 95, 100, 97,
-		116, 97, 0, 95, 109, 97, 112, 99, 111, 117, 110, 116, 0, 112, 97, 103,
-		101, 95, 116, 121, 112, 101, 0, 102, 97, 115, 121, 110, 99, 95, 115, 116,
-		114, 117, 99, 116, 0, 102, 97, 95, 108, 111, 99, 107, 0, 109, 97, 103,
-		105, 99, 0, 102, 97, 95, 102, 100, 0, 102, 97, 95, 110, 101, 120, 116,
-		0, 102, 97, 95, 102, 105, 108, 101, 0, 102, 97, 95, 114, 99, 117, 0,
-		117, 115, 101, 114, 95, 115, 116, 114, 117, 99, 116, 0, 95, 95, 99, 111,
-		117, 110, 116, 0, 101, 112, 111, 108, 108, 95, 119, 97, 116, 99, 104, 101,
-		115, 0, 117, 110, 105, 120, 95, 105, 110, 102, 108, 105, 103, 104, 116, 0,
-		112, 105, 112, 101, 95, 98, 117, 102, 115, 0, 117, 105, 100, 104, 97, 115,
-		104, 95, 110, 111, 100, 101, 0, 110, 114, 95, 119, 97, 116, 99, 104, 101,
-		115, 0, 114, 97, 116, 101, 108, 105, 109, 105, 116, 0, 114, 97, 116, 101,
-		108, 105, 109, 105, 116, 95, 115, 116, 97, 116, 101, 0, 105, 110, 116, 101,
-		114, 118, 97, 108, 0, 112, 114, 105, 110, 116, 101, 100, 0, 109, 105, 115,
-		115, 101, 100, 0, 98, 101, 103, 105, 110, 0, 110, 114, 95, 115, 101, 103,
-		115, 0, 120, 97, 114, 114, 97, 121, 95, 115, 116, 97, 114, 116, 0, 115,
-		116, 97, 114, 116, 95, 104, 101, 97, 100, 0, 105, 111, 95, 99, 111, 109,
-		112, 95, 98, 97, 116, 99, 104, 0, 114, 101, 113, 95, 108, 105, 115, 116,
-		0, 110, 101, 101, 100, 95, 116, 115, 0, 99, 111, 109, 112, 108, 101, 116,
-		101, 0, 100, 105, 114, 95, 99, 111, 110, 116, 101, 120, 116, 0, 97, 99,
-		116, 111, 114, 0, 112, 111, 115, 0, 102, 105, 108, 108, 100, 105, 114, 95,
-		116, 0, 95, 95, 112, 111, 108, 108, 95, 116, 0, 112, 111, 108, 108, 95,
-		116, 97, 98, 108, 101, 95, 115, 116, 114, 117, 99, 116, 0, 95, 113, 112,
-		114, 111, 99, 0, 95, 107, 101, 121, 0, 112, 111, 108, 108, 95, 113, 117,
-		101, 117, 101, 95, 112, 114, 111, 99, 0, 102, 108, 95, 111, 119, 110, 101,
-		114, 95, 116, 0, 102, 105, 108, 101, 95, 108, 111, 99, 107, 0, 102, 108,
-		95, 98, 108, 111, 99, 107, 101, 114, 0, 102, 108, 95, 108, 105, 115, 116,
-		0, 102, 108, 95, 108, 105, 110, 107, 0, 102, 108, 95, 98, 108, 111, 99,
-		107, 101, 100, 95, 114, 101, 113, 117, 101, 115, 116, 115, 0, 102, 108, 95,
-		98, 108, 111, 99, 107, 101, 100, 95, 109, 101, 109, 98, 101, 114, 0, 102,
-		108, 95, 111, 119, 110, 101, 114, 0, 102, 108, 95, 102, 108, 97, 103, 115,
-		0, 102, 108, 95, 116, 121, 112, 101, 0, 102, 108, 95, 112, 105, 100, 0,
-		102, 108, 95, 108, 105, 110, 107, 95, 99, 112, 117, 0, 102, 108, 95, 119,
-		97, 105, 116, 0, 102, 108, 95, 102, 105, 108, 101, 0, 102, 108, 95, 115,
-		116, 97, 114, 116, 0, 102, 108, 95, 101, 110, 100, 0, 102, 108, 95, 102,
-		97, 115, 121, 110, 99, 0, 102, 108, 95, 98, 114, 101, 97, 107, 95, 116,
-		105, 109, 101, 0, 102, 108, 95, 100, 111, 119, 110, 103, 114, 97, 100, 101,
-		95, 116, 105, 109, 101, 0, 102, 108, 95, 111, 112, 115, 0, 102, 108, 95,
-		108, 109, 111, 112, 115, 0, 102, 108, 95, 117, 0, 110, 102, 115, 95, 102,
-		108, 0, 110, 102, 115, 52, 95, 102, 108, 0, 97, 102, 115, 0, 110, 102,
-		115, 95, 108, 111, 99, 107, 95, 105, 110, 102, 111, 0, 110, 108, 109, 95,
-		108, 111, 99, 107, 111, 119, 110, 101, 114, 0, 110, 102, 115, 52, 95, 108,
-		111, 99, 107, 95, 105, 110, 102, 111, 0, 100, 101, 98, 117, 103, 95, 105,
-		100, 0, 105, 111, 95, 117, 114, 105, 110, 103, 95, 99, 109, 100, 0, 99,
-		109, 100, 0, 116, 97, 115, 107, 95, 119, 111, 114, 107, 95, 99, 98, 0,
-		99, 109, 100, 95, 111, 112, 0, 112, 97, 100, 0, 112, 100, 117, 0, 105,
-		95, 112, 105, 112, 101, 0, 105, 95, 99, 100, 101, 118, 0, 105, 95, 108,
-		105, 110, 107, 0, 105, 95, 100, 105, 114, 95, 115, 101, 113, 0, 120, 97,
-		95, 108, 111, 99, 107, 0, 120, 97, 95, 102, 108, 97, 103, 115, 0, 120,
-		97, 95, 104, 101, 97, 100, 0, 112, 101, 114, 102, 95, 111, 117, 116, 112,
-		117, 116, 95, 104, 97, 110, 100, 108, 101, 0, 101, 118, 101, 110, 116, 0,
-		119, 97, 107, 101, 117, 112, 0, 97, 117, 120, 95, 102, 108, 97, 103, 115,
-		0, 97, 100, 100, 114, 0, 112, 101, 114, 102, 95, 101, 118, 101, 110, 116,
-		95, 115, 116, 97, 116, 101, 0, 80, 69, 82, 70, 95, 69, 86, 69, 78,
-		84, 95, 83, 84, 65, 84, 69, 95, 68, 69, 65, 68, 0, 80, 69, 82,
-		70, 95, 69, 86, 69, 78, 84, 95, 83, 84, 65, 84, 69, 95, 69, 88,
-		73, 84, 0, 80, 69, 82, 70, 95, 69, 86, 69, 78, 84, 95, 83, 84,
-		65, 84, 69, 95, 69, 82, 82, 79, 82, 0, 80, 69, 82, 70, 95, 69,
-		86, 69, 78, 84, 95, 83, 84, 65, 84, 69, 95, 79, 70, 70, 0, 80,
-		69, 82, 70, 95, 69, 86, 69, 78, 84, 95, 83, 84, 65, 84, 69, 95,
-		73, 78, 65, 67, 84, 73, 86, 69, 0, 80, 69, 82, 70, 95, 69, 86,
-		69, 78, 84, 95, 83, 84, 65, 84, 69, 95, 65, 67, 84, 73, 86, 69,
-		0, 108, 111, 99, 97, 108, 54, 52, 95, 116, 0, 112, 101, 114, 102, 95,
-		101, 118, 101, 110, 116, 95, 97, 116, 116, 114, 0, 99, 111, 110, 102, 105,
-		103, 0, 115, 97, 109, 112, 108, 101, 95, 116, 121, 112, 101, 0, 114, 101,
-		97, 100, 95, 102, 111, 114, 109, 97, 116, 0, 100, 105, 115, 97, 98, 108,
-		101, 100, 0, 105, 110, 104, 101, 114, 105, 116, 0, 112, 105, 110, 110, 101,
-		100, 0, 101, 120, 99, 108, 117, 115, 105, 118, 101, 0, 101, 120, 99, 108,
-		117, 100, 101, 95, 117, 115, 101, 114, 0, 101, 120, 99, 108, 117, 100, 101,
-		95, 107, 101, 114, 110, 101, 108, 0, 101, 120, 99, 108, 117, 100, 101, 95,
-		104, 118, 0, 101, 120, 99, 108, 117, 100, 101, 95, 105, 100, 108, 101, 0,
-		102, 114, 101, 113, 0, 105, 110, 104, 101, 114, 105, 116, 95, 115, 116, 97,
-		116, 0, 101, 110, 97, 98, 108, 101, 95, 111, 110, 95, 101, 120, 101, 99,
+		98, 95, 108, 105, 115, 116, 0, 112, 101, 114, 102, 95, 101, 118, 101, 110,
+		116, 95, 115, 116, 97, 116, 101, 0, 80, 69, 82, 70, 95, 69, 86, 69,
+		78, 84, 95, 83, 84, 65, 84, 69, 95, 68, 69, 65, 68, 0, 80, 69,
+		82, 70, 95, 69, 86, 69, 78, 84, 95, 83, 84, 65, 84, 69, 95, 69,
+		88, 73, 84, 0, 80, 69, 82, 70, 95, 69, 86, 69, 78, 84, 95, 83,
+		84, 65, 84, 69, 95, 69, 82, 82, 79, 82, 0, 80, 69, 82, 70, 95,
+		69, 86, 69, 78, 84, 95, 83, 84, 65, 84, 69, 95, 79, 70, 70, 0,
+		80, 69, 82, 70, 95, 69, 86, 69, 78, 84, 95, 83, 84, 65, 84, 69,
+		95, 73, 78, 65, 67, 84, 73, 86, 69, 0, 80, 69, 82, 70, 95, 69,
+		86, 69, 78, 84, 95, 83, 84, 65, 84, 69, 95, 65, 67, 84, 73, 86,
+		69, 0, 108, 111, 99, 97, 108, 54, 52, 95, 116, 0, 112, 101, 114, 102,
+		95, 101, 118, 101, 110, 116, 95, 97, 116, 116, 114, 0, 99, 111, 110, 102,
+		105, 103, 0, 115, 97, 109, 112, 108, 101, 95, 116, 121, 112, 101, 0, 114,
+		101, 97, 100, 95, 102, 111, 114, 109, 97, 116, 0, 100, 105, 115, 97, 98,
+		108, 101, 100, 0, 105, 110, 104, 101, 114, 105, 116, 0, 112, 105, 110, 110,
+		101, 100, 0, 101, 120, 99, 108, 117, 115, 105, 118, 101, 0, 101, 120, 99,
+		108, 117, 100, 101, 95, 117, 115, 101, 114, 0, 101, 120, 99, 108, 117, 100,
+		101, 95, 107, 101, 114, 110, 101, 108, 0, 101, 120, 99, 108, 117, 100, 101,
+		95, 104, 118, 0, 101, 120, 99, 108, 117, 100, 101, 95, 105, 100, 108, 101,
+		0, 109, 109, 97, 112, 0, 102, 114, 101, 113, 0, 105, 110, 104, 101, 114,
+		105, 116, 95, 115, 116, 97, 116, 0, 101, 110, 97, 98, 108, 101, 95, 111,
+		110, 95, 101, 120, 101, 99, 0, 119, 97, 116, 101, 114, 109, 97, 114, 107,
 		0, 112, 114, 101, 99, 105, 115, 101, 95, 105, 112, 0, 109, 109, 97, 112,
 		95, 100, 97, 116, 97, 0, 115, 97, 109, 112, 108, 101, 95, 105, 100, 95,
 		97, 108, 108, 0, 101, 120, 99, 108, 117, 100, 101, 95, 104, 111, 115, 116,
@@ -4004,495 +1914,456 @@ var bpfObjects = map[string][]byte{
 		98, 97, 99, 107, 119, 97, 114, 100, 0, 110, 97, 109, 101, 115, 112, 97,
 		99, 101, 115, 0, 107, 115, 121, 109, 98, 111, 108, 0, 98, 112, 102, 95,
 		101, 118, 101, 110, 116, 0, 97, 117, 120, 95, 111, 117, 116, 112, 117, 116,
-		0, 116, 101, 120, 116, 95, 112, 111, 107, 101, 0, 98, 117, 105, 108, 100,
-		95, 105, 100, 0, 105, 110, 104, 101, 114, 105, 116, 95, 116, 104, 114, 101,
-		97, 100, 0, 114, 101, 109, 111, 118, 101, 95, 111, 110, 95, 101, 120, 101,
-		99, 0, 115, 105, 103, 116, 114, 97, 112, 0, 95, 95, 114, 101, 115, 101,
-		114, 118, 101, 100, 95, 49, 0, 98, 112, 95, 116, 121, 112, 101, 0, 98,
-		114, 97, 110, 99, 104, 95, 115, 97, 109, 112, 108, 101, 95, 116, 121, 112,
-		101, 0, 115, 97, 109, 112, 108, 101, 95, 114, 101, 103, 115, 95, 117, 115,
-		101, 114, 0, 115, 97, 109, 112, 108, 101, 95, 115, 116, 97, 99, 107, 95,
-		117, 115, 101, 114, 0, 115, 97, 109, 112, 108, 101, 95, 114, 101, 103, 115,
-		95, 105, 110, 116, 114, 0, 97, 117, 120, 95, 119, 97, 116, 101, 114, 109,
-		97, 114, 107, 0, 115, 97, 109, 112, 108, 101, 95, 109, 97, 120, 95, 115,
-		116, 97, 99, 107, 0, 95, 95, 114, 101, 115, 101, 114, 118, 101, 100, 95,
-		50, 0, 97, 117, 120, 95, 115, 97, 109, 112, 108, 101, 95, 115, 105, 122,
-		101, 0, 95, 95, 114, 101, 115, 101, 114, 118, 101, 100, 95, 51, 0, 115,
-		105, 103, 95, 100, 97, 116, 97, 0, 115, 97, 109, 112, 108, 101, 95, 112,
-		101, 114, 105, 111, 100, 0, 115, 97, 109, 112, 108, 101, 95, 102, 114, 101,
-		113, 0, 119, 97, 107, 101, 117, 112, 95, 101, 118, 101, 110, 116, 115, 0,
-		119, 97, 107, 101, 117, 112, 95, 119, 97, 116, 101, 114, 109, 97, 114, 107,
-		0, 98, 112, 95, 97, 100, 100, 114, 0, 107, 112, 114, 111, 98, 101, 95,
-		102, 117, 110, 99, 0, 117, 112, 114, 111, 98, 101, 95, 112, 97, 116, 104,
-		0, 99, 111, 110, 102, 105, 103, 49, 0, 98, 112, 95, 108, 101, 110, 0,
-		107, 112, 114, 111, 98, 101, 95, 97, 100, 100, 114, 0, 112, 114, 111, 98,
-		101, 95, 111, 102, 102, 115, 101, 116, 0, 99, 111, 110, 102, 105, 103, 50,
-		0, 104, 119, 95, 112, 101, 114, 102, 95, 101, 118, 101, 110, 116, 0, 116,
-		97, 114, 103, 101, 116, 0, 112, 114, 101, 118, 95, 99, 111, 117, 110, 116,
-		0, 105, 110, 116, 101, 114, 114, 117, 112, 116, 115, 95, 115, 101, 113, 0,
-		105, 110, 116, 101, 114, 114, 117, 112, 116, 115, 0, 102, 114, 101, 113, 95,
-		116, 105, 109, 101, 95, 115, 116, 97, 109, 112, 0, 102, 114, 101, 113, 95,
-		99, 111, 117, 110, 116, 95, 115, 116, 97, 109, 112, 0, 108, 97, 115, 116,
-		95, 116, 97, 103, 0, 99, 111, 110, 102, 105, 103, 95, 98, 97, 115, 101,
-		0, 101, 118, 101, 110, 116, 95, 98, 97, 115, 101, 0, 101, 118, 101, 110,
-		116, 95, 98, 97, 115, 101, 95, 114, 100, 112, 109, 99, 0, 105, 100, 120,
-		0, 108, 97, 115, 116, 95, 99, 112, 117, 0, 101, 120, 116, 114, 97, 95,
-		114, 101, 103, 0, 98, 114, 97, 110, 99, 104, 95, 114, 101, 103, 0, 104,
-		119, 95, 112, 101, 114, 102, 95, 101, 118, 101, 110, 116, 95, 101, 120, 116,
-		114, 97, 0, 114, 101, 103, 0, 97, 108, 108, 111, 99, 0, 116, 112, 95,
-		108, 105, 115, 116, 0, 112, 119, 114, 95, 97, 99, 99, 0, 112, 116, 115,
-		99, 0, 98, 112, 95, 108, 105, 115, 116, 0, 97, 114, 99, 104, 95, 104,
-		119, 95, 98, 114, 101, 97, 107, 112, 111, 105, 110, 116, 0, 97, 100, 100,
-		114, 101, 115, 115, 0, 109, 97, 115, 107, 0, 105, 111, 109, 109, 117, 95,
-		98, 97, 110, 107, 0, 105, 111, 109, 109, 117, 95, 99, 110, 116, 114, 0,
-		112, 97, 100, 100, 105, 110, 103, 0, 99, 111, 110, 102, 0, 99, 111, 110,
-		102, 49, 0, 108, 97, 115, 116, 95, 112, 101, 114, 105, 111, 100, 0, 112,
-		101, 114, 105, 111, 100, 95, 108, 101, 102, 116, 0, 115, 97, 118, 101, 100,
-		95, 109, 101, 116, 114, 105, 99, 0, 115, 97, 118, 101, 100, 95, 115, 108,
-		111, 116, 115, 0, 112, 101, 114, 102, 95, 98, 117, 102, 102, 101, 114, 0,
-		110, 114, 95, 112, 97, 103, 101, 115, 0, 111, 118, 101, 114, 119, 114, 105,
-		116, 101, 0, 112, 97, 117, 115, 101, 100, 0, 110, 101, 115, 116, 0, 108,
-		111, 115, 116, 0, 101, 118, 101, 110, 116, 95, 108, 111, 99, 107, 0, 109,
-		109, 97, 112, 95, 108, 111, 99, 107, 101, 100, 0, 109, 109, 97, 112, 95,
-		117, 115, 101, 114, 0, 97, 117, 120, 95, 104, 101, 97, 100, 0, 97, 117,
-		120, 95, 110, 101, 115, 116, 0, 97, 117, 120, 95, 119, 97, 107, 101, 117,
-		112, 0, 97, 117, 120, 95, 112, 103, 111, 102, 102, 0, 97, 117, 120, 95,
-		110, 114, 95, 112, 97, 103, 101, 115, 0, 97, 117, 120, 95, 111, 118, 101,
-		114, 119, 114, 105, 116, 101, 0, 97, 117, 120, 95, 109, 109, 97, 112, 95,
-		99, 111, 117, 110, 116, 0, 97, 117, 120, 95, 109, 109, 97, 112, 95, 108,
-		111, 99, 107, 101, 100, 0, 97, 117, 120, 95, 114, 101, 102, 99, 111, 117,
-		110, 116, 0, 97, 117, 120, 95, 105, 110, 95, 115, 97, 109, 112, 108, 105,
-		110, 103, 0, 97, 117, 120, 95, 112, 97, 103, 101, 115, 0, 97, 117, 120,
-		95, 112, 114, 105, 118, 0, 117, 115, 101, 114, 95, 112, 97, 103, 101, 0,
-		100, 97, 116, 97, 95, 112, 97, 103, 101, 115, 0, 105, 114, 113, 95, 119,
-		111, 114, 107, 0, 105, 114, 113, 119, 97, 105, 116, 0, 114, 99, 117, 119,
-		97, 105, 116, 0, 112, 101, 114, 102, 95, 97, 100, 100, 114, 95, 102, 105,
-		108, 116, 101, 114, 115, 95, 104, 101, 97, 100, 0, 110, 114, 95, 102, 105,
-		108, 101, 95, 102, 105, 108, 116, 101, 114, 115, 0, 112, 105, 100, 95, 110,
-		97, 109, 101, 115, 112, 97, 99, 101, 0, 105, 100, 114, 0, 112, 105, 100,
-		95, 97, 108, 108, 111, 99, 97, 116, 101, 100, 0, 99, 104, 105, 108, 100,
-		95, 114, 101, 97, 112, 101, 114, 0, 112, 105, 100, 95, 99, 97, 99, 104,
-		101, 112, 0, 98, 97, 99, 99, 116, 0, 114, 101, 98, 111, 111, 116, 0,
-		105, 100, 114, 95, 114, 116, 0, 105, 100, 114, 95, 98, 97, 115, 101, 0,
-		105, 100, 114, 95, 110, 101, 120, 116, 0, 107, 109, 101, 109, 95, 99, 97,
-		99, 104, 101, 0, 99, 112, 117, 95, 115, 108, 97, 98, 0, 109, 105, 110,
-		95, 112, 97, 114, 116, 105, 97, 108, 0, 111, 98, 106, 101, 99, 116, 95,
-		115, 105, 122, 101, 0, 114, 101, 99, 105, 112, 114, 111, 99, 97, 108, 95,
-		115, 105, 122, 101, 0, 99, 112, 117, 95, 112, 97, 114, 116, 105, 97, 108,
-		0, 99, 112, 117, 95, 112, 97, 114, 116, 105, 97, 108, 95, 115, 108, 97,
-		98, 115, 0, 111, 111, 0, 97, 108, 108, 111, 99, 102, 108, 97, 103, 115,
-		0, 99, 116, 111, 114, 0, 105, 110, 117, 115, 101, 0, 97, 108, 105, 103,
-		110, 0, 114, 101, 100, 95, 108, 101, 102, 116, 95, 112, 97, 100, 0, 114,
-		97, 110, 100, 111, 109, 0, 114, 101, 109, 111, 116, 101, 95, 110, 111, 100,
-		101, 95, 100, 101, 102, 114, 97, 103, 95, 114, 97, 116, 105, 111, 0, 114,
-		97, 110, 100, 111, 109, 95, 115, 101, 113, 0, 117, 115, 101, 114, 111, 102,
-		102, 115, 101, 116, 0, 117, 115, 101, 114, 115, 105, 122, 101, 0, 115, 108,
-		97, 98, 95, 102, 108, 97, 103, 115, 95, 116, 0, 114, 101, 99, 105, 112,
-		114, 111, 99, 97, 108, 95, 118, 97, 108, 117, 101, 0, 109, 0, 115, 104,
-		49, 0, 115, 104, 50, 0, 107, 109, 101, 109, 95, 99, 97, 99, 104, 101,
-		95, 111, 114, 100, 101, 114, 95, 111, 98, 106, 101, 99, 116, 115, 0, 107,
-		109, 101, 109, 95, 99, 97, 99, 104, 101, 95, 110, 111, 100, 101, 0, 108,
-		105, 115, 116, 95, 108, 111, 99, 107, 0, 110, 114, 95, 112, 97, 114, 116,
-		105, 97, 108, 0, 112, 97, 114, 116, 105, 97, 108, 0, 110, 114, 95, 115,
-		108, 97, 98, 115, 0, 116, 111, 116, 97, 108, 95, 111, 98, 106, 101, 99,
-		116, 115, 0, 102, 117, 108, 108, 0, 112, 101, 114, 102, 95, 111, 118, 101,
-		114, 102, 108, 111, 119, 95, 104, 97, 110, 100, 108, 101, 114, 95, 116, 0,
-		112, 101, 114, 102, 95, 115, 97, 109, 112, 108, 101, 95, 100, 97, 116, 97,
-		0, 114, 97, 119, 0, 98, 114, 95, 115, 116, 97, 99, 107, 0, 116, 120,
-		110, 0, 100, 97, 116, 97, 95, 115, 114, 99, 0, 105, 112, 0, 116, 105,
-		100, 95, 101, 110, 116, 114, 121, 0, 115, 116, 114, 101, 97, 109, 95, 105,
-		100, 0, 99, 112, 117, 95, 101, 110, 116, 114, 121, 0, 99, 97, 108, 108,
-		99, 104, 97, 105, 110, 0, 97, 117, 120, 95, 115, 105, 122, 101, 0, 114,
-		101, 103, 115, 95, 117, 115, 101, 114, 0, 114, 101, 103, 115, 95, 105, 110,
-		116, 114, 0, 115, 116, 97, 99, 107, 95, 117, 115, 101, 114, 95, 115, 105,
-		122, 101, 0, 112, 104, 121, 115, 95, 97, 100, 100, 114, 0, 100, 97, 116,
-		97, 95, 112, 97, 103, 101, 95, 115, 105, 122, 101, 0, 99, 111, 100, 101,
-		95, 112, 97, 103, 101, 95, 115, 105, 122, 101, 0, 112, 101, 114, 102, 95,
-		115, 97, 109, 112, 108, 101, 95, 119, 101, 105, 103, 104, 116, 0, 118, 97,
-		114, 49, 95, 100, 119, 0, 118, 97, 114, 50, 95, 119, 0, 118, 97, 114,
-		51, 95, 119, 0, 112, 101, 114, 102, 95, 109, 101, 109, 95, 100, 97, 116,
-		97, 95, 115, 114, 99, 0, 109, 101, 109, 95, 111, 112, 0, 109, 101, 109,
-		95, 108, 118, 108, 0, 109, 101, 109, 95, 115, 110, 111, 111, 112, 0, 109,
-		101, 109, 95, 108, 111, 99, 107, 0, 109, 101, 109, 95, 100, 116, 108, 98,
-		0, 109, 101, 109, 95, 108, 118, 108, 95, 110, 117, 109, 0, 109, 101, 109,
-		95, 114, 101, 109, 111, 116, 101, 0, 109, 101, 109, 95, 115, 110, 111, 111,
-		112, 120, 0, 109, 101, 109, 95, 98, 108, 107, 0, 109, 101, 109, 95, 104,
-		111, 112, 115, 0, 109, 101, 109, 95, 114, 115, 118, 100, 0, 116, 105, 100,
-		0, 114, 101, 115, 101, 114, 118, 101, 100, 0, 112, 101, 114, 102, 95, 114,
-		101, 103, 115, 0, 97, 98, 105, 0, 114, 101, 103, 115, 0, 112, 116, 95,
-		114, 101, 103, 115, 0, 114, 49, 53, 0, 114, 49, 52, 0, 114, 49, 51,
-		0, 114, 49, 50, 0, 98, 112, 0, 98, 120, 0, 114, 49, 49, 0, 114,
-		49, 48, 0, 114, 57, 0, 114, 56, 0, 97, 120, 0, 99, 120, 0, 100,
-		120, 0, 115, 105, 0, 100, 105, 0, 111, 114, 105, 103, 95, 97, 120, 0,
-		99, 115, 0, 116, 114, 97, 99, 101, 95, 101, 118, 101, 110, 116, 95, 99,
-		97, 108, 108, 0, 99, 108, 97, 115, 115, 0, 112, 114, 105, 110, 116, 95,
-		102, 109, 116, 0, 112, 101, 114, 102, 95, 114, 101, 102, 99, 111, 117, 110,
-		116, 0, 112, 101, 114, 102, 95, 101, 118, 101, 110, 116, 115, 0, 112, 114,
-		111, 103, 95, 97, 114, 114, 97, 121, 0, 112, 101, 114, 102, 95, 112, 101,
-		114, 109, 0, 116, 112, 0, 116, 114, 97, 99, 101, 95, 101, 118, 101, 110,
-		116, 0, 102, 117, 110, 99, 115, 0, 101, 118, 101, 110, 116, 95, 102, 105,
-		108, 116, 101, 114, 0, 102, 105, 108, 116, 101, 114, 95, 115, 116, 114, 105,
-		110, 103, 0, 115, 97, 118, 101, 100, 95, 102, 117, 110, 99, 0, 108, 111,
-		99, 97, 108, 95, 104, 97, 115, 104, 0, 102, 117, 110, 99, 95, 104, 97,
-		115, 104, 0, 111, 108, 100, 95, 104, 97, 115, 104, 0, 116, 114, 97, 109,
-		112, 111, 108, 105, 110, 101, 0, 116, 114, 97, 109, 112, 111, 108, 105, 110,
-		101, 95, 115, 105, 122, 101, 0, 111, 112, 115, 95, 102, 117, 110, 99, 0,
-		102, 116, 114, 97, 99, 101, 95, 102, 117, 110, 99, 95, 116, 0, 102, 116,
-		114, 97, 99, 101, 95, 114, 101, 103, 115, 0, 102, 116, 114, 97, 99, 101,
-		95, 111, 112, 115, 95, 104, 97, 115, 104, 0, 110, 111, 116, 114, 97, 99,
-		101, 95, 104, 97, 115, 104, 0, 102, 105, 108, 116, 101, 114, 95, 104, 97,
-		115, 104, 0, 114, 101, 103, 101, 120, 95, 108, 111, 99, 107, 0, 102, 116,
-		114, 97, 99, 101, 95, 104, 97, 115, 104, 0, 115, 105, 122, 101, 95, 98,
-		105, 116, 115, 0, 98, 117, 99, 107, 101, 116, 115, 0, 102, 116, 114, 97,
-		99, 101, 95, 111, 112, 115, 95, 102, 117, 110, 99, 95, 116, 0, 102, 116,
-		114, 97, 99, 101, 95, 111, 112, 115, 95, 99, 109, 100, 0, 70, 84, 82,
-		65, 67, 69, 95, 79, 80, 83, 95, 67, 77, 68, 95, 69, 78, 65, 66,
-		76, 69, 95, 83, 72, 65, 82, 69, 95, 73, 80, 77, 79, 68, 73, 70,
-		89, 95, 83, 69, 76, 70, 0, 70, 84, 82, 65, 67, 69, 95, 79, 80,
-		83, 95, 67, 77, 68, 95, 69, 78, 65, 66, 76, 69, 95, 83, 72, 65,
-		82, 69, 95, 73, 80, 77, 79, 68, 73, 70, 89, 95, 80, 69, 69, 82,
-		0, 70, 84, 82, 65, 67, 69, 95, 79, 80, 83, 95, 67, 77, 68, 95,
-		68, 73, 83, 65, 66, 76, 69, 95, 83, 72, 65, 82, 69, 95, 73, 80,
-		77, 79, 68, 73, 70, 89, 95, 80, 69, 69, 82, 0, 97, 118, 120, 53,
-		49, 50, 95, 116, 105, 109, 101, 115, 116, 97, 109, 112, 0, 102, 112, 115,
-		116, 97, 116, 101, 0, 95, 95, 116, 97, 115, 107, 95, 102, 112, 115, 116,
-		97, 116, 101, 0, 103, 117, 101, 115, 116, 95, 112, 101, 114, 109, 0, 95,
-		95, 102, 112, 115, 116, 97, 116, 101, 0, 117, 115, 101, 114, 95, 115, 105,
-		122, 101, 0, 120, 102, 101, 97, 116, 117, 114, 101, 115, 0, 117, 115, 101,
-		114, 95, 120, 102, 101, 97, 116, 117, 114, 101, 115, 0, 120, 102, 100, 0,
-		105, 115, 95, 118, 97, 108, 108, 111, 99, 0, 105, 115, 95, 103, 117, 101,
-		115, 116, 0, 105, 115, 95, 99, 111, 110, 102, 105, 100, 101, 110, 116, 105,
-		97, 108, 0, 105, 110, 95, 117, 115, 101, 0, 102, 112, 114, 101, 103, 115,
-		95, 115, 116, 97, 116, 101, 0, 102, 115, 97, 118, 101, 0, 102, 120, 115,
-		97, 118, 101, 0, 115, 111, 102, 116, 0, 120, 115, 97, 118, 101, 0, 95,
-		95, 112, 97, 100, 100, 105, 110, 103, 0, 102, 114, 101, 103, 115, 95, 115,
-		116, 97, 116, 101, 0, 99, 119, 100, 0, 115, 119, 100, 0, 116, 119, 100,
-		0, 102, 105, 112, 0, 102, 99, 115, 0, 102, 111, 111, 0, 102, 111, 115,
-		0, 115, 116, 95, 115, 112, 97, 99, 101, 0, 102, 120, 114, 101, 103, 115,
-		95, 115, 116, 97, 116, 101, 0, 102, 111, 112, 0, 109, 120, 99, 115, 114,
-		0, 109, 120, 99, 115, 114, 95, 109, 97, 115, 107, 0, 120, 109, 109, 95,
-		115, 112, 97, 99, 101, 0, 114, 105, 112, 0, 114, 100, 112, 0, 112, 97,
-		100, 100, 105, 110, 103, 49, 0, 115, 119, 95, 114, 101, 115, 101, 114, 118,
-		101, 100, 0, 115, 119, 114, 101, 103, 115, 95, 115, 116, 97, 116, 101, 0,
-		102, 116, 111, 112, 0, 99, 104, 97, 110, 103, 101, 100, 0, 108, 111, 111,
-		107, 97, 104, 101, 97, 100, 0, 110, 111, 95, 117, 112, 100, 97, 116, 101,
-		0, 114, 109, 0, 97, 108, 105, 109, 105, 116, 0, 101, 110, 116, 114, 121,
-		95, 101, 105, 112, 0, 120, 114, 101, 103, 115, 95, 115, 116, 97, 116, 101,
-		0, 105, 51, 56, 55, 0, 101, 120, 116, 101, 110, 100, 101, 100, 95, 115,
-		116, 97, 116, 101, 95, 97, 114, 101, 97, 0, 120, 115, 116, 97, 116, 101,
-		95, 104, 101, 97, 100, 101, 114, 0, 120, 99, 111, 109, 112, 95, 98, 118,
+		0, 99, 103, 114, 111, 117, 112, 0, 116, 101, 120, 116, 95, 112, 111, 107,
+		101, 0, 98, 117, 105, 108, 100, 95, 105, 100, 0, 105, 110, 104, 101, 114,
+		105, 116, 95, 116, 104, 114, 101, 97, 100, 0, 114, 101, 109, 111, 118, 101,
+		95, 111, 110, 95, 101, 120, 101, 99, 0, 115, 105, 103, 116, 114, 97, 112,
+		0, 95, 95, 114, 101, 115, 101, 114, 118, 101, 100, 95, 49, 0, 98, 112,
+		95, 116, 121, 112, 101, 0, 98, 114, 97, 110, 99, 104, 95, 115, 97, 109,
+		112, 108, 101, 95, 116, 121, 112, 101, 0, 115, 97, 109, 112, 108, 101, 95,
+		114, 101, 103, 115, 95, 117, 115, 101, 114, 0, 115, 97, 109, 112, 108, 101,
+		95, 115, 116, 97, 99, 107, 95, 117, 115, 101, 114, 0, 115, 97, 109, 112,
+		108, 101, 95, 114, 101, 103, 115, 95, 105, 110, 116, 114, 0, 97, 117, 120,
+		95, 119, 97, 116, 101, 114, 109, 97, 114, 107, 0, 115, 97, 109, 112, 108,
+		101, 95, 109, 97, 120, 95, 115, 116, 97, 99, 107, 0, 95, 95, 114, 101,
+		115, 101, 114, 118, 101, 100, 95, 50, 0, 97, 117, 120, 95, 115, 97, 109,
+		112, 108, 101, 95, 115, 105, 122, 101, 0, 95, 95, 114, 101, 115, 101, 114,
+		118, 101, 100, 95, 51, 0, 115, 105, 103, 95, 100, 97, 116, 97, 0, 115,
+		97, 109, 112, 108, 101, 95, 112, 101, 114, 105, 111, 100, 0, 115, 97, 109,
+		112, 108, 101, 95, 102, 114, 101, 113, 0, 119, 97, 107, 101, 117, 112, 95,
+		101, 118, 101, 110, 116, 115, 0, 119, 97, 107, 101, 117, 112, 95, 119, 97,
+		116, 101, 114, 109, 97, 114, 107, 0, 98, 112, 95, 97, 100, 100, 114, 0,
+		107, 112, 114, 111, 98, 101, 95, 102, 117, 110, 99, 0, 117, 112, 114, 111,
+		98, 101, 95, 112, 97, 116, 104, 0, 99, 111, 110, 102, 105, 103, 49, 0,
+		98, 112, 95, 108, 101, 110, 0, 107, 112, 114, 111, 98, 101, 95, 97, 100,
+		100, 114, 0, 112, 114, 111, 98, 101, 95, 111, 102, 102, 115, 101, 116, 0,
+		99, 111, 110, 102, 105, 103, 50, 0, 95, 95, 115, 51, 50, 0, 104, 119,
+		95, 112, 101, 114, 102, 95, 101, 118, 101, 110, 116, 0, 116, 97, 114, 103,
+		101, 116, 0, 112, 114, 101, 118, 95, 99, 111, 117, 110, 116, 0, 105, 110,
+		116, 101, 114, 114, 117, 112, 116, 115, 95, 115, 101, 113, 0, 105, 110, 116,
+		101, 114, 114, 117, 112, 116, 115, 0, 102, 114, 101, 113, 95, 116, 105, 109,
+		101, 95, 115, 116, 97, 109, 112, 0, 102, 114, 101, 113, 95, 99, 111, 117,
+		110, 116, 95, 115, 116, 97, 109, 112, 0, 108, 97, 115, 116, 95, 116, 97,
+		103, 0, 99, 111, 110, 102, 105, 103, 95, 98, 97, 115, 101, 0, 101, 118,
+		101, 110, 116, 95, 98, 97, 115, 101, 0, 101, 118, 101, 110, 116, 95, 98,
+		97, 115, 101, 95, 114, 100, 112, 109, 99, 0, 105, 100, 120, 0, 108, 97,
+		115, 116, 95, 99, 112, 117, 0, 101, 120, 116, 114, 97, 95, 114, 101, 103,
+		0, 98, 114, 97, 110, 99, 104, 95, 114, 101, 103, 0, 104, 119, 95, 112,
+		101, 114, 102, 95, 101, 118, 101, 110, 116, 95, 101, 120, 116, 114, 97, 0,
+		114, 101, 103, 0, 97, 108, 108, 111, 99, 0, 116, 112, 95, 108, 105, 115,
+		116, 0, 112, 119, 114, 95, 97, 99, 99, 0, 112, 116, 115, 99, 0, 105,
+		110, 102, 111, 0, 98, 112, 95, 108, 105, 115, 116, 0, 97, 114, 99, 104,
+		95, 104, 119, 95, 98, 114, 101, 97, 107, 112, 111, 105, 110, 116, 0, 97,
+		100, 100, 114, 101, 115, 115, 0, 109, 97, 115, 107, 0, 105, 111, 109, 109,
+		117, 95, 98, 97, 110, 107, 0, 105, 111, 109, 109, 117, 95, 99, 110, 116,
+		114, 0, 112, 97, 100, 100, 105, 110, 103, 0, 99, 111, 110, 102, 0, 99,
+		111, 110, 102, 49, 0, 108, 97, 115, 116, 95, 112, 101, 114, 105, 111, 100,
+		0, 112, 101, 114, 105, 111, 100, 95, 108, 101, 102, 116, 0, 115, 97, 118,
+		101, 100, 95, 109, 101, 116, 114, 105, 99, 0, 115, 97, 118, 101, 100, 95,
+		115, 108, 111, 116, 115, 0, 119, 97, 105, 116, 95, 113, 117, 101, 117, 101,
+		95, 104, 101, 97, 100, 95, 116, 0, 119, 97, 105, 116, 95, 113, 117, 101,
+		117, 101, 95, 104, 101, 97, 100, 0, 104, 101, 97, 100, 0, 105, 114, 113,
+		95, 119, 111, 114, 107, 0, 105, 114, 113, 119, 97, 105, 116, 0, 114, 99,
+		117, 119, 97, 105, 116, 0, 112, 101, 114, 102, 95, 97, 100, 100, 114, 95,
+		102, 105, 108, 116, 101, 114, 115, 95, 104, 101, 97, 100, 0, 110, 114, 95,
+		102, 105, 108, 101, 95, 102, 105, 108, 116, 101, 114, 115, 0, 112, 101, 114,
+		102, 95, 111, 118, 101, 114, 102, 108, 111, 119, 95, 104, 97, 110, 100, 108,
+		101, 114, 95, 116, 0, 112, 101, 114, 102, 95, 115, 97, 109, 112, 108, 101,
+		95, 100, 97, 116, 97, 0, 97, 100, 100, 114, 0, 114, 97, 119, 0, 98,
+		114, 95, 115, 116, 97, 99, 107, 0, 112, 101, 114, 105, 111, 100, 0, 116,
+		120, 110, 0, 100, 97, 116, 97, 95, 115, 114, 99, 0, 105, 112, 0, 116,
+		105, 100, 95, 101, 110, 116, 114, 121, 0, 115, 116, 114, 101, 97, 109, 95,
+		105, 100, 0, 99, 112, 117, 95, 101, 110, 116, 114, 121, 0, 99, 97, 108,
+		108, 99, 104, 97, 105, 110, 0, 97, 117, 120, 95, 115, 105, 122, 101, 0,
+		114, 101, 103, 115, 95, 117, 115, 101, 114, 0, 114, 101, 103, 115, 95, 105,
+		110, 116, 114, 0, 115, 116, 97, 99, 107, 95, 117, 115, 101, 114, 95, 115,
+		105, 122, 101, 0, 112, 104, 121, 115, 95, 97, 100, 100, 114, 0, 100, 97,
+		116, 97, 95, 112, 97, 103, 101, 95, 115, 105, 122, 101, 0, 99, 111, 100,
+		101, 95, 112, 97, 103, 101, 95, 115, 105, 122, 101, 0, 112, 101, 114, 102,
+		95, 115, 97, 109, 112, 108, 101, 95, 119, 101, 105, 103, 104, 116, 0, 102,
+		117, 108, 108, 0, 118, 97, 114, 49, 95, 100, 119, 0, 118, 97, 114, 50,
+		95, 119, 0, 118, 97, 114, 51, 95, 119, 0, 112, 101, 114, 102, 95, 109,
+		101, 109, 95, 100, 97, 116, 97, 95, 115, 114, 99, 0, 109, 101, 109, 95,
+		111, 112, 0, 109, 101, 109, 95, 108, 118, 108, 0, 109, 101, 109, 95, 115,
+		110, 111, 111, 112, 0, 109, 101, 109, 95, 108, 111, 99, 107, 0, 109, 101,
+		109, 95, 100, 116, 108, 98, 0, 109, 101, 109, 95, 108, 118, 108, 95, 110,
+		117, 109, 0, 109, 101, 109, 95, 114, 101, 109, 111, 116, 101, 0, 109, 101,
+		109, 95, 115, 110, 111, 111, 112, 120, 0, 109, 101, 109, 95, 98, 108, 107,
+		0, 109, 101, 109, 95, 104, 111, 112, 115, 0, 109, 101, 109, 95, 114, 115,
+		118, 100, 0, 116, 105, 100, 0, 114, 101, 115, 101, 114, 118, 101, 100, 0,
+		112, 101, 114, 102, 95, 114, 101, 103, 115, 0, 97, 98, 105, 0, 114, 101,
+		103, 115, 0, 112, 114, 105, 118, 97, 116, 101, 0, 115, 97, 118, 101, 100,
+		95, 102, 117, 110, 99, 0, 108, 111, 99, 97, 108, 95, 104, 97, 115, 104,
+		0, 102, 117, 110, 99, 95, 104, 97, 115, 104, 0, 111, 108, 100, 95, 104,
+		97, 115, 104, 0, 116, 114, 97, 109, 112, 111, 108, 105, 110, 101, 0, 116,
+		114, 97, 109, 112, 111, 108, 105, 110, 101, 95, 115, 105, 122, 101, 0, 111,
+		112, 115, 95, 102, 117, 110, 99, 0, 102, 116, 114, 97, 99, 101, 95, 102,
+		117, 110, 99, 95, 116, 0, 102, 116, 114, 97, 99, 101, 95, 114, 101, 103,
+		115, 0, 112, 116, 95, 114, 101, 103, 115, 0, 114, 49, 53, 0, 114, 49,
+		52, 0, 114, 49, 51, 0, 114, 49, 50, 0, 98, 112, 0, 98, 120, 0,
+		114, 49, 49, 0, 114, 49, 48, 0, 114, 57, 0, 114, 56, 0, 97, 120,
+		0, 99, 120, 0, 100, 120, 0, 115, 105, 0, 100, 105, 0, 111, 114, 105,
+		103, 95, 97, 120, 0, 99, 115, 0, 115, 115, 0, 102, 116, 114, 97, 99,
+		101, 95, 111, 112, 115, 95, 104, 97, 115, 104, 0, 110, 111, 116, 114, 97,
+		99, 101, 95, 104, 97, 115, 104, 0, 102, 105, 108, 116, 101, 114, 95, 104,
+		97, 115, 104, 0, 114, 101, 103, 101, 120, 95, 108, 111, 99, 107, 0, 102,
+		116, 114, 97, 99, 101, 95, 111, 112, 115, 95, 102, 117, 110, 99, 95, 116,
+		0, 102, 116, 114, 97, 99, 101, 95, 111, 112, 115, 95, 99, 109, 100, 0,
+		70, 84, 82, 65, 67, 69, 95, 79, 80, 83, 95, 67, 77, 68, 95, 69,
+		78, 65, 66, 76, 69, 95, 83, 72, 65, 82, 69, 95, 73, 80, 77, 79,
+		68, 73, 70, 89, 95, 83, 69, 76, 70, 0, 70, 84, 82, 65, 67, 69,
+		95, 79, 80, 83, 95, 67, 77, 68, 95, 69, 78, 65, 66, 76, 69, 95,
+		83, 72, 65, 82, 69, 95, 73, 80, 77, 79, 68, 73, 70, 89, 95, 80,
+		69, 69, 82, 0, 70, 84, 82, 65, 67, 69, 95, 79, 80, 83, 95, 67,
+		77, 68, 95, 68, 73, 83, 65, 66, 76, 69, 95, 83, 72, 65, 82, 69,
+		95, 73, 80, 77, 79, 68, 73, 70, 89, 95, 80, 69, 69, 82, 0, 97,
+		118, 120, 53, 49, 50, 95, 116, 105, 109, 101, 115, 116, 97, 109, 112, 0,
+		102, 112, 115, 116, 97, 116, 101, 0, 95, 95, 116, 97, 115, 107, 95, 102,
+		112, 115, 116, 97, 116, 101, 0, 112, 101, 114, 109, 0, 103, 117, 101, 115,
+		116, 95, 112, 101, 114, 109, 0, 95, 95, 102, 112, 115, 116, 97, 116, 101,
 		0, 102, 112, 117, 95, 115, 116, 97, 116, 101, 95, 112, 101, 114, 109, 0,
 		95, 95, 115, 116, 97, 116, 101, 95, 112, 101, 114, 109, 0, 95, 95, 115,
 		116, 97, 116, 101, 95, 115, 105, 122, 101, 0, 95, 95, 117, 115, 101, 114,
-		95, 115, 116, 97, 116, 101, 95, 115, 105, 122, 101, 0, 48, 58, 49, 50,
-		56, 0, 32, 32, 32, 32, 117, 51, 50, 32, 109, 110, 116, 110, 115, 32,
-		61, 32, 66, 80, 70, 95, 67, 79, 82, 69, 95, 82, 69, 65, 68, 40,
-		116, 97, 115, 107, 44, 32, 110, 115, 112, 114, 111, 120, 121, 44, 32, 109,
-		110, 116, 95, 110, 115, 44, 32, 110, 115, 46, 105, 110, 117, 109, 41, 59,
-		0, 117, 116, 115, 95, 110, 115, 0, 105, 112, 99, 95, 110, 115, 0, 109,
-		110, 116, 95, 110, 115, 0, 112, 105, 100, 95, 110, 115, 95, 102, 111, 114,
-		95, 99, 104, 105, 108, 100, 114, 101, 110, 0, 110, 101, 116, 95, 110, 115,
-		0, 116, 105, 109, 101, 95, 110, 115, 0, 116, 105, 109, 101, 95, 110, 115,
-		95, 102, 111, 114, 95, 99, 104, 105, 108, 100, 114, 101, 110, 0, 99, 103,
-		114, 111, 117, 112, 95, 110, 115, 0, 116, 105, 109, 101, 95, 110, 97, 109,
-		101, 115, 112, 97, 99, 101, 0, 111, 102, 102, 115, 101, 116, 115, 0, 118,
-		118, 97, 114, 95, 112, 97, 103, 101, 0, 102, 114, 111, 122, 101, 110, 95,
-		111, 102, 102, 115, 101, 116, 115, 0, 116, 105, 109, 101, 110, 115, 95, 111,
-		102, 102, 115, 101, 116, 115, 0, 109, 111, 110, 111, 116, 111, 110, 105, 99,
-		0, 98, 111, 111, 116, 116, 105, 109, 101, 0, 48, 58, 51, 0, 109, 110,
-		116, 95, 110, 97, 109, 101, 115, 112, 97, 99, 101, 0, 110, 115, 95, 108,
-		111, 99, 107, 0, 115, 101, 113, 0, 109, 111, 117, 110, 116, 115, 0, 112,
-		101, 110, 100, 105, 110, 103, 95, 109, 111, 117, 110, 116, 115, 0, 48, 58,
-		48, 58, 50, 0, 32, 32, 32, 32, 105, 102, 32, 40, 109, 110, 116, 110,
-		115, 32, 61, 61, 32, 48, 41, 32, 123, 0, 32, 32, 32, 32, 117, 51,
-		50, 32, 104, 111, 115, 116, 80, 105, 100, 32, 61, 32, 49, 59, 0, 32,
-		32, 32, 32, 104, 111, 115, 116, 95, 109, 110, 116, 110, 115, 32, 61, 32,
+		95, 115, 116, 97, 116, 101, 95, 115, 105, 122, 101, 0, 117, 115, 101, 114,
+		95, 115, 105, 122, 101, 0, 120, 102, 101, 97, 116, 117, 114, 101, 115, 0,
+		117, 115, 101, 114, 95, 120, 102, 101, 97, 116, 117, 114, 101, 115, 0, 120,
+		102, 100, 0, 105, 115, 95, 118, 97, 108, 108, 111, 99, 0, 105, 115, 95,
+		103, 117, 101, 115, 116, 0, 105, 115, 95, 99, 111, 110, 102, 105, 100, 101,
+		110, 116, 105, 97, 108, 0, 105, 110, 95, 117, 115, 101, 0, 102, 112, 114,
+		101, 103, 115, 95, 115, 116, 97, 116, 101, 0, 102, 115, 97, 118, 101, 0,
+		102, 120, 115, 97, 118, 101, 0, 115, 111, 102, 116, 0, 120, 115, 97, 118,
+		101, 0, 95, 95, 112, 97, 100, 100, 105, 110, 103, 0, 102, 114, 101, 103,
+		115, 95, 115, 116, 97, 116, 101, 0, 99, 119, 100, 0, 115, 119, 100, 0,
+		116, 119, 100, 0, 102, 105, 112, 0, 102, 99, 115, 0, 102, 111, 111, 0,
+		102, 111, 115, 0, 115, 116, 95, 115, 112, 97, 99, 101, 0, 102, 120, 114,
+		101, 103, 115, 95, 115, 116, 97, 116, 101, 0, 102, 111, 112, 0, 109, 120,
+		99, 115, 114, 0, 109, 120, 99, 115, 114, 95, 109, 97, 115, 107, 0, 120,
+		109, 109, 95, 115, 112, 97, 99, 101, 0, 114, 105, 112, 0, 114, 100, 112,
+		0, 112, 97, 100, 100, 105, 110, 103, 49, 0, 115, 119, 95, 114, 101, 115,
+		101, 114, 118, 101, 100, 0, 115, 119, 114, 101, 103, 115, 95, 115, 116, 97,
+		116, 101, 0, 102, 116, 111, 112, 0, 99, 104, 97, 110, 103, 101, 100, 0,
+		108, 111, 111, 107, 97, 104, 101, 97, 100, 0, 110, 111, 95, 117, 112, 100,
+		97, 116, 101, 0, 114, 109, 0, 97, 108, 105, 109, 105, 116, 0, 101, 110,
+		116, 114, 121, 95, 101, 105, 112, 0, 120, 114, 101, 103, 115, 95, 115, 116,
+		97, 116, 101, 0, 105, 51, 56, 55, 0, 104, 101, 97, 100, 101, 114, 0,
+		101, 120, 116, 101, 110, 100, 101, 100, 95, 115, 116, 97, 116, 101, 95, 97,
+		114, 101, 97, 0, 120, 115, 116, 97, 116, 101, 95, 104, 101, 97, 100, 101,
+		114, 0, 120, 99, 111, 109, 112, 95, 98, 118, 0, 48, 58, 49, 50, 56,
+		0, 32, 32, 32, 32, 117, 51, 50, 32, 109, 110, 116, 110, 115, 32, 61,
+		32, 66, 80, 70, 95, 67, 79, 82, 69, 95, 82, 69, 65, 68, 40, 116,
+		97, 115, 107, 44, 32, 110, 115, 112, 114, 111, 120, 121, 44, 32, 109, 110,
+		116, 95, 110, 115, 44, 32, 110, 115, 46, 105, 110, 117, 109, 41, 59, 0,
+		117, 116, 115, 95, 110, 115, 0, 105, 112, 99, 95, 110, 115, 0, 109, 110,
+		116, 95, 110, 115, 0, 112, 105, 100, 95, 110, 115, 95, 102, 111, 114, 95,
+		99, 104, 105, 108, 100, 114, 101, 110, 0, 110, 101, 116, 95, 110, 115, 0,
+		116, 105, 109, 101, 95, 110, 115, 0, 116, 105, 109, 101, 95, 110, 115, 95,
+		102, 111, 114, 95, 99, 104, 105, 108, 100, 114, 101, 110, 0, 99, 103, 114,
+		111, 117, 112, 95, 110, 115, 0, 48, 58, 51, 0, 109, 110, 116, 95, 110,
+		97, 109, 101, 115, 112, 97, 99, 101, 0, 114, 111, 111, 116, 0, 110, 115,
+		95, 108, 111, 99, 107, 0, 117, 115, 101, 114, 95, 110, 115, 0, 117, 99,
+		111, 117, 110, 116, 115, 0, 115, 101, 113, 0, 101, 118, 101, 110, 116, 0,
+		109, 111, 117, 110, 116, 115, 0, 112, 101, 110, 100, 105, 110, 103, 95, 109,
+		111, 117, 110, 116, 115, 0, 110, 115, 95, 99, 111, 109, 109, 111, 110, 0,
+		115, 116, 97, 115, 104, 101, 100, 0, 111, 112, 115, 0, 105, 110, 117, 109,
+		0, 48, 58, 48, 58, 50, 0, 32, 32, 32, 32, 105, 102, 32, 40, 109,
+		110, 116, 110, 115, 32, 61, 61, 32, 48, 41, 32, 123, 0, 32, 32, 32,
+		32, 117, 51, 50, 32, 104, 111, 115, 116, 80, 105, 100, 32, 61, 32, 49,
+		59, 0, 32, 32, 32, 32, 104, 111, 115, 116, 95, 109, 110, 116, 110, 115,
+		32, 61, 32, 98, 112, 102, 95, 109, 97, 112, 95, 108, 111, 111, 107, 117,
+		112, 95, 101, 108, 101, 109, 40, 38, 112, 105, 100, 95, 109, 110, 116, 110,
+		115, 44, 32, 38, 104, 111, 115, 116, 80, 105, 100, 41, 59, 0, 32, 32,
+		32, 32, 105, 102, 32, 40, 104, 111, 115, 116, 95, 109, 110, 116, 110, 115,
+		32, 33, 61, 32, 78, 85, 76, 76, 32, 38, 38, 32, 42, 104, 111, 115,
+		116, 95, 109, 110, 116, 110, 115, 32, 61, 61, 32, 109, 110, 116, 110, 115,
+		41, 32, 123, 0, 32, 32, 32, 32, 99, 104, 97, 114, 32, 99, 111, 109,
+		109, 91, 77, 65, 88, 95, 67, 79, 77, 77, 95, 76, 69, 78, 93, 32,
+		61, 32, 123, 125, 59, 0, 32, 32, 32, 32, 98, 112, 102, 95, 103, 101,
+		116, 95, 99, 117, 114, 114, 101, 110, 116, 95, 99, 111, 109, 109, 40, 99,
+		111, 109, 109, 44, 32, 115, 105, 122, 101, 111, 102, 40, 99, 111, 109, 109,
+		41, 41, 59, 0, 32, 32, 32, 32, 105, 102, 32, 40, 102, 105, 108, 116,
+		101, 114, 95, 110, 97, 109, 101, 91, 48, 93, 32, 61, 61, 32, 48, 41,
+		32, 123, 0, 32, 32, 32, 32, 32, 32, 32, 32, 105, 102, 32, 40, 99,
+		111, 109, 109, 91, 105, 93, 32, 33, 61, 32, 102, 105, 108, 116, 101, 114,
+		95, 110, 97, 109, 101, 91, 105, 93, 41, 32, 123, 0, 32, 32, 32, 32,
+		32, 32, 32, 32, 105, 102, 32, 40, 99, 111, 109, 109, 91, 105, 93, 32,
+		61, 61, 32, 48, 41, 32, 123, 0, 32, 32, 32, 32, 99, 117, 114, 114,
+		101, 110, 116, 95, 112, 105, 100, 95, 109, 110, 116, 110, 115, 32, 61, 32,
 		98, 112, 102, 95, 109, 97, 112, 95, 108, 111, 111, 107, 117, 112, 95, 101,
 		108, 101, 109, 40, 38, 112, 105, 100, 95, 109, 110, 116, 110, 115, 44, 32,
-		38, 104, 111, 115, 116, 80, 105, 100, 41, 59, 0, 32, 32, 32, 32, 105,
-		102, 32, 40, 104, 111, 115, 116, 95, 109, 110, 116, 110, 115, 32, 33, 61,
-		32, 78, 85, 76, 76, 32, 38, 38, 32, 42, 104, 111, 115, 116, 95, 109,
-		110, 116, 110, 115, 32, 61, 61, 32, 109, 110, 116, 110, 115, 41, 32, 123,
-		0, 32, 32, 32, 32, 99, 104, 97, 114, 32, 99, 111, 109, 109, 91, 77,
-		65, 88, 95, 67, 79, 77, 77, 95, 76, 69, 78, 93, 32, 61, 32, 123,
-		125, 59, 0, 32, 32, 32, 32, 98, 112, 102, 95, 103, 101, 116, 95, 99,
-		117, 114, 114, 101, 110, 116, 95, 99, 111, 109, 109, 40, 99, 111, 109, 109,
-		44, 32, 115, 105, 122, 101, 111, 102, 40, 99, 111, 109, 109, 41, 41, 59,
-		0, 32, 32, 32, 32, 105, 102, 32, 40, 102, 105, 108, 116, 101, 114, 95,
-		110, 97, 109, 101, 91, 48, 93, 32, 61, 61, 32, 48, 41, 32, 123, 0,
-		32, 32, 32, 32, 32, 32, 32, 32, 105, 102, 32, 40, 99, 111, 109, 109,
-		91, 105, 93, 32, 33, 61, 32, 102, 105, 108, 116, 101, 114, 95, 110, 97,
-		109, 101, 91, 105, 93, 41, 32, 123, 0, 32, 32, 32, 32, 32, 32, 32,
-		32, 105, 102, 32, 40, 99, 111, 109, 109, 91, 105, 93, 32, 61, 61, 32,
-		48, 41, 32, 123, 0, 32, 32, 32, 32, 99, 117, 114, 114, 101, 110, 116,
-		95, 112, 105, 100, 95, 109, 110, 116, 110, 115, 32, 61, 32, 98, 112, 102,
-		95, 109, 97, 112, 95, 108, 111, 111, 107, 117, 112, 95, 101, 108, 101, 109,
-		40, 38, 112, 105, 100, 95, 109, 110, 116, 110, 115, 44, 32, 38, 112, 105,
-		100, 41, 59, 0, 32, 32, 32, 32, 105, 102, 32, 40, 99, 117, 114, 114,
-		101, 110, 116, 95, 112, 105, 100, 95, 109, 110, 116, 110, 115, 32, 61, 61,
-		32, 78, 85, 76, 76, 41, 32, 123, 0, 32, 32, 32, 32, 32, 32, 32,
-		32, 32, 32, 32, 32, 98, 112, 102, 95, 114, 105, 110, 103, 98, 117, 102,
-		95, 114, 101, 115, 101, 114, 118, 101, 40, 38, 101, 118, 101, 110, 116, 115,
-		44, 32, 115, 105, 122, 101, 111, 102, 40, 115, 116, 114, 117, 99, 116, 32,
-		101, 118, 101, 110, 116, 95, 116, 41, 44, 32, 48, 41, 59, 0, 32, 32,
-		32, 32, 32, 32, 32, 32, 105, 102, 32, 40, 101, 118, 101, 110, 116, 41,
-		32, 123, 0, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 98,
-		112, 102, 95, 112, 114, 105, 110, 116, 107, 40, 34, 115, 101, 110, 100, 32,
-		101, 118, 101, 110, 116, 32, 112, 105, 100, 58, 32, 37, 117, 44, 32, 109,
-		110, 116, 110, 115, 58, 32, 37, 117, 44, 32, 99, 111, 109, 109, 58, 32,
-		37, 115, 92, 110, 34, 44, 32, 112, 105, 100, 44, 32, 109, 110, 116, 110,
-		115, 44, 0, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 101,
-		118, 101, 110, 116, 45, 62, 112, 105, 100, 32, 61, 32, 112, 105, 100, 59,
-		0, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 101, 118, 101,
-		110, 116, 45, 62, 109, 110, 116, 110, 115, 32, 61, 32, 109, 110, 116, 110,
-		115, 59, 0, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 98,
-		112, 102, 95, 114, 105, 110, 103, 98, 117, 102, 95, 115, 117, 98, 109, 105,
-		116, 40, 101, 118, 101, 110, 116, 44, 32, 48, 41, 59, 0, 32, 32, 32,
-		32, 32, 32, 32, 32, 32, 32, 32, 32, 98, 112, 102, 95, 109, 97, 112,
-		95, 117, 112, 100, 97, 116, 101, 95, 101, 108, 101, 109, 40, 38, 112, 105,
-		100, 95, 109, 110, 116, 110, 115, 44, 32, 38, 112, 105, 100, 44, 32, 38,
-		109, 110, 116, 110, 115, 44, 32, 66, 80, 70, 95, 65, 78, 89, 41, 59,
-		0, 32, 32, 32, 32, 32, 32, 32, 32, 98, 112, 102, 95, 109, 97, 112,
-		95, 108, 111, 111, 107, 117, 112, 95, 101, 108, 101, 109, 40, 38, 109, 110,
-		116, 110, 115, 95, 115, 121, 115, 99, 97, 108, 108, 115, 44, 32, 38, 109,
-		110, 116, 110, 115, 41, 59, 0, 32, 32, 32, 32, 105, 102, 32, 40, 109,
-		110, 116, 110, 115, 95, 115, 121, 115, 99, 97, 108, 108, 95, 118, 97, 108,
-		117, 101, 41, 32, 123, 0, 125, 0, 32, 32, 32, 32, 32, 32, 32, 32,
+		38, 112, 105, 100, 41, 59, 0, 32, 32, 32, 32, 105, 102, 32, 40, 99,
+		117, 114, 114, 101, 110, 116, 95, 112, 105, 100, 95, 109, 110, 116, 110, 115,
+		32, 61, 61, 32, 78, 85, 76, 76, 41, 32, 123, 0, 32, 32, 32, 32,
+		32, 32, 32, 32, 32, 32, 32, 32, 98, 112, 102, 95, 114, 105, 110, 103,
+		98, 117, 102, 95, 114, 101, 115, 101, 114, 118, 101, 40, 38, 101, 118, 101,
+		110, 116, 115, 44, 32, 115, 105, 122, 101, 111, 102, 40, 115, 116, 114, 117,
+		99, 116, 32, 101, 118, 101, 110, 116, 95, 116, 41, 44, 32, 48, 41, 59,
+		0, 32, 32, 32, 32, 32, 32, 32, 32, 105, 102, 32, 40, 101, 118, 101,
+		110, 116, 41, 32, 123, 0, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
+		32, 32, 117, 54, 52, 32, 99, 103, 114, 111, 117, 112, 95, 105, 100, 32,
+		61, 32, 98, 112, 102, 95, 103, 101, 116, 95, 99, 117, 114, 114, 101, 110,
+		116, 95, 99, 103, 114, 111, 117, 112, 95, 105, 100, 40, 41, 59, 0, 32,
+		32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 98, 112, 102, 95, 112,
+		114, 105, 110, 116, 107, 40, 34, 115, 101, 110, 100, 32, 101, 118, 101, 110,
+		116, 32, 112, 105, 100, 58, 32, 37, 117, 44, 32, 109, 110, 116, 110, 115,
+		58, 32, 37, 117, 44, 32, 99, 103, 114, 111, 117, 112, 58, 32, 37, 108,
+		108, 117, 92, 110, 34, 44, 32, 112, 105, 100, 44, 0, 32, 32, 32, 32,
+		32, 32, 32, 32, 32, 32, 32, 32, 101, 118, 101, 110, 116, 45, 62, 112,
+		105, 100, 32, 61, 32, 112, 105, 100, 59, 0, 32, 32, 32, 32, 32, 32,
+		32, 32, 32, 32, 32, 32, 101, 118, 101, 110, 116, 45, 62, 109, 110, 116,
+		110, 115, 32, 61, 32, 109, 110, 116, 110, 115, 59, 0, 32, 32, 32, 32,
+		32, 32, 32, 32, 32, 32, 32, 32, 101, 118, 101, 110, 116, 45, 62, 99,
+		103, 114, 111, 117, 112, 95, 105, 100, 32, 61, 32, 99, 103, 114, 111, 117,
+		112, 95, 105, 100, 59, 0, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
+		32, 32, 98, 112, 102, 95, 114, 105, 110, 103, 98, 117, 102, 95, 115, 117,
+		98, 109, 105, 116, 40, 101, 118, 101, 110, 116, 44, 32, 48, 41, 59, 0,
+		32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 105, 102, 32, 40,
 		98, 112, 102, 95, 109, 97, 112, 95, 117, 112, 100, 97, 116, 101, 95, 101,
-		108, 101, 109, 40, 38, 109, 110, 116, 110, 115, 95, 115, 121, 115, 99, 97,
-		108, 108, 115, 44, 32, 38, 109, 110, 116, 110, 115, 44, 32, 38, 105, 110,
-		105, 116, 44, 32, 66, 80, 70, 95, 65, 78, 89, 41, 59, 0, 32, 32,
-		32, 32, 32, 32, 32, 32, 117, 56, 32, 42, 32, 99, 111, 110, 115, 116,
-		32, 118, 97, 108, 117, 101, 32, 61, 32, 98, 112, 102, 95, 109, 97, 112,
-		95, 108, 111, 111, 107, 117, 112, 95, 101, 108, 101, 109, 40, 38, 109, 110,
-		116, 110, 115, 95, 115, 121, 115, 99, 97, 108, 108, 115, 44, 32, 38, 109,
-		110, 116, 110, 115, 41, 59, 0, 32, 32, 32, 32, 32, 32, 32, 32, 105,
-		102, 32, 40, 33, 118, 97, 108, 117, 101, 41, 32, 123, 0, 32, 32, 32,
-		32, 32, 32, 32, 32, 32, 32, 32, 32, 98, 112, 102, 95, 112, 114, 105,
-		110, 116, 107, 40, 0, 76, 73, 67, 69, 78, 83, 69, 0, 102, 105, 108,
-		116, 101, 114, 95, 110, 97, 109, 101, 0, 115, 121, 115, 95, 101, 110, 116,
-		101, 114, 46, 95, 95, 95, 95, 102, 109, 116, 0, 115, 121, 115, 95, 101,
-		110, 116, 101, 114, 46, 105, 110, 105, 116, 0, 115, 121, 115, 95, 101, 110,
-		116, 101, 114, 46, 95, 95, 95, 95, 102, 109, 116, 46, 49, 0, 46, 109,
-		97, 112, 115, 0, 46, 114, 111, 100, 97, 116, 97, 0, 108, 105, 99, 101,
-		110, 115, 101, 0, 118, 109, 95, 111, 112, 101, 114, 97, 116, 105, 111, 110,
-		115, 95, 115, 116, 114, 117, 99, 116, 0, 97, 110, 111, 110, 95, 118, 109,
-		97, 95, 110, 97, 109, 101, 0, 118, 102, 115, 109, 111, 117, 110, 116, 0,
-		115, 117, 112, 101, 114, 95, 98, 108, 111, 99, 107, 0, 105, 110, 111, 100,
-		101, 95, 111, 112, 101, 114, 97, 116, 105, 111, 110, 115, 0, 112, 114, 111,
-		99, 95, 110, 115, 95, 111, 112, 101, 114, 97, 116, 105, 111, 110, 115, 0,
-		117, 116, 115, 95, 110, 97, 109, 101, 115, 112, 97, 99, 101, 0, 105, 112,
-		99, 95, 110, 97, 109, 101, 115, 112, 97, 99, 101, 0, 107, 111, 98, 106,
-		95, 116, 121, 112, 101, 0, 105, 111, 118, 101, 99, 0, 98, 105, 111, 95,
-		118, 101, 99, 0, 112, 105, 112, 101, 95, 98, 117, 102, 102, 101, 114, 0,
-		119, 97, 105, 116, 95, 112, 97, 103, 101, 95, 113, 117, 101, 117, 101, 0,
-		100, 101, 118, 105, 99, 101, 0, 110, 101, 116, 0, 104, 114, 116, 105, 109,
-		101, 114, 95, 99, 108, 111, 99, 107, 95, 98, 97, 115, 101, 0, 99, 116,
-		108, 95, 116, 97, 98, 108, 101, 95, 112, 111, 108, 108, 0, 99, 116, 108,
-		95, 116, 97, 98, 108, 101, 95, 114, 111, 111, 116, 0, 99, 116, 108, 95,
-		110, 111, 100, 101, 0, 109, 111, 117, 110, 116, 0, 102, 115, 110, 111, 116,
-		105, 102, 121, 95, 109, 97, 114, 107, 95, 99, 111, 110, 110, 101, 99, 116,
-		111, 114, 0, 107, 109, 101, 109, 95, 99, 97, 99, 104, 101, 95, 99, 112,
-		117, 0, 102, 115, 95, 112, 105, 110, 0, 99, 103, 114, 111, 117, 112, 95,
-		110, 97, 109, 101, 115, 112, 97, 99, 101, 0, 99, 115, 115, 95, 115, 101,
-		116, 0, 107, 101, 114, 110, 102, 115, 95, 114, 111, 111, 116, 0, 115, 101,
-		113, 95, 111, 112, 101, 114, 97, 116, 105, 111, 110, 115, 0, 107, 101, 114,
-		110, 102, 115, 95, 111, 112, 115, 0, 107, 101, 114, 110, 102, 115, 95, 111,
-		112, 101, 110, 95, 110, 111, 100, 101, 0, 107, 101, 114, 110, 102, 115, 95,
-		105, 97, 116, 116, 114, 115, 0, 119, 111, 114, 107, 113, 117, 101, 117, 101,
-		95, 115, 116, 114, 117, 99, 116, 0, 111, 98, 106, 95, 99, 103, 114, 111,
-		117, 112, 0, 101, 118, 101, 110, 116, 102, 100, 95, 99, 116, 120, 0, 109,
-		101, 109, 99, 103, 95, 118, 109, 115, 116, 97, 116, 115, 95, 112, 101, 114,
-		99, 112, 117, 0, 112, 103, 108, 105, 115, 116, 95, 100, 97, 116, 97, 0,
-		99, 103, 114, 111, 117, 112, 95, 115, 117, 98, 115, 121, 115, 0, 98, 112,
-		102, 95, 112, 114, 111, 103, 95, 97, 114, 114, 97, 121, 0, 98, 112, 102,
-		95, 112, 114, 111, 103, 0, 112, 101, 114, 99, 112, 117, 95, 114, 101, 102,
-		95, 100, 97, 116, 97, 0, 98, 112, 102, 95, 108, 111, 99, 97, 108, 95,
-		115, 116, 111, 114, 97, 103, 101, 0, 99, 100, 101, 118, 0, 101, 120, 99,
-		101, 112, 116, 105, 111, 110, 95, 116, 97, 98, 108, 101, 95, 101, 110, 116,
-		114, 121, 0, 102, 115, 95, 115, 116, 114, 117, 99, 116, 0, 119, 97, 116,
-		99, 104, 95, 108, 105, 115, 116, 0, 107, 101, 121, 95, 117, 115, 101, 114,
-		0, 112, 114, 111, 103, 95, 101, 110, 116, 114, 121, 0, 107, 117, 110, 105,
-		116, 95, 115, 117, 105, 116, 101, 0, 107, 117, 110, 105, 116, 0, 107, 108,
-		112, 95, 109, 111, 100, 105, 110, 102, 111, 0, 101, 114, 114, 111, 114, 95,
-		105, 110, 106, 101, 99, 116, 105, 111, 110, 95, 101, 110, 116, 114, 121, 0,
-		114, 101, 113, 117, 101, 115, 116, 0, 114, 101, 113, 117, 101, 115, 116, 95,
-		113, 117, 101, 117, 101, 0, 98, 100, 105, 95, 119, 114, 105, 116, 101, 98,
-		97, 99, 107, 0, 102, 105, 108, 101, 95, 108, 111, 99, 107, 95, 99, 111,
-		110, 116, 101, 120, 116, 0, 102, 115, 99, 114, 121, 112, 116, 95, 105, 110,
-		102, 111, 0, 115, 105, 103, 104, 97, 110, 100, 95, 115, 116, 114, 117, 99,
-		116, 0, 95, 95, 107, 101, 114, 110, 101, 108, 95, 116, 105, 109, 101, 115,
-		112, 101, 99, 0, 111, 108, 100, 95, 116, 105, 109, 101, 115, 112, 101, 99,
-		51, 50, 0, 112, 111, 108, 108, 102, 100, 0, 115, 101, 109, 95, 117, 110,
-		100, 111, 95, 108, 105, 115, 116, 0, 102, 105, 108, 101, 115, 95, 115, 116,
-		114, 117, 99, 116, 0, 105, 111, 95, 117, 114, 105, 110, 103, 95, 116, 97,
-		115, 107, 0, 98, 108, 107, 95, 112, 108, 117, 103, 0, 115, 105, 103, 110,
-		97, 108, 95, 115, 116, 114, 117, 99, 116, 0, 109, 111, 100, 117, 108, 101,
-		95, 112, 97, 114, 97, 109, 95, 97, 116, 116, 114, 115, 0, 109, 111, 100,
-		117, 108, 101, 95, 97, 116, 116, 114, 105, 98, 117, 116, 101, 0, 107, 101,
-		114, 110, 101, 108, 95, 112, 97, 114, 97, 109, 0, 111, 114, 99, 95, 101,
-		110, 116, 114, 121, 0, 98, 117, 103, 95, 101, 110, 116, 114, 121, 0, 101,
-		108, 102, 54, 52, 95, 115, 121, 109, 0, 109, 111, 100, 117, 108, 101, 95,
-		115, 101, 99, 116, 95, 97, 116, 116, 114, 115, 0, 109, 111, 100, 117, 108,
-		101, 95, 110, 111, 116, 101, 115, 95, 97, 116, 116, 114, 115, 0, 112, 105,
-		95, 101, 110, 116, 114, 121, 0, 115, 114, 99, 117, 95, 115, 116, 114, 117,
-		99, 116, 0, 98, 112, 102, 95, 114, 97, 119, 95, 101, 118, 101, 110, 116,
-		95, 109, 97, 112, 0, 116, 114, 97, 99, 101, 112, 111, 105, 110, 116, 0,
-		106, 117, 109, 112, 95, 101, 110, 116, 114, 121, 0, 115, 116, 97, 116, 105,
-		99, 95, 99, 97, 108, 108, 95, 115, 105, 116, 101, 0, 116, 114, 97, 99,
-		101, 95, 101, 118, 101, 110, 116, 95, 99, 108, 97, 115, 115, 0, 116, 114,
-		97, 99, 101, 95, 101, 118, 101, 110, 116, 95, 102, 117, 110, 99, 116, 105,
-		111, 110, 115, 0, 112, 101, 114, 102, 95, 99, 112, 117, 95, 99, 111, 110,
-		116, 101, 120, 116, 0, 112, 101, 114, 102, 95, 99, 103, 114, 111, 117, 112,
-		0, 112, 101, 114, 102, 95, 101, 118, 101, 110, 116, 95, 109, 109, 97, 112,
-		95, 112, 97, 103, 101, 0, 112, 101, 114, 102, 95, 97, 100, 100, 114, 95,
-		102, 105, 108, 116, 101, 114, 95, 114, 97, 110, 103, 101, 0, 112, 101, 114,
-		102, 95, 114, 97, 119, 95, 114, 101, 99, 111, 114, 100, 0, 112, 101, 114,
-		102, 95, 98, 114, 97, 110, 99, 104, 95, 115, 116, 97, 99, 107, 0, 112,
-		101, 114, 102, 95, 99, 97, 108, 108, 99, 104, 97, 105, 110, 95, 101, 110,
-		116, 114, 121, 0, 116, 114, 97, 99, 101, 95, 101, 118, 97, 108, 95, 109,
-		97, 112, 0, 102, 105, 108, 101, 95, 108, 111, 99, 107, 95, 111, 112, 101,
-		114, 97, 116, 105, 111, 110, 115, 0, 108, 111, 99, 107, 95, 109, 97, 110,
-		97, 103, 101, 114, 95, 111, 112, 101, 114, 97, 116, 105, 111, 110, 115, 0,
-		110, 102, 115, 52, 95, 108, 111, 99, 107, 95, 115, 116, 97, 116, 101, 0,
-		102, 115, 118, 101, 114, 105, 116, 121, 95, 105, 110, 102, 111, 0, 97, 100,
-		100, 114, 101, 115, 115, 95, 115, 112, 97, 99, 101, 95, 111, 112, 101, 114,
-		97, 116, 105, 111, 110, 115, 0, 112, 97, 103, 101, 95, 112, 111, 111, 108,
-		0, 100, 101, 118, 95, 112, 97, 103, 101, 109, 97, 112, 0, 117, 115, 101,
-		114, 102, 97, 117, 108, 116, 102, 100, 95, 99, 116, 120, 0, 108, 105, 110,
-		117, 120, 95, 98, 105, 110, 102, 109, 116, 0, 107, 101, 114, 110, 101, 108,
-		95, 115, 105, 103, 105, 110, 102, 111, 0, 108, 100, 116, 95, 115, 116, 114,
-		117, 99, 116, 0, 107, 105, 111, 99, 116, 120, 95, 116, 97, 98, 108, 101,
-		0, 109, 109, 117, 95, 110, 111, 116, 105, 102, 105, 101, 114, 95, 115, 117,
-		98, 115, 99, 114, 105, 112, 116, 105, 111, 110, 115, 0, 114, 99, 117, 95,
-		110, 111, 100, 101, 0, 115, 101, 99, 99, 111, 109, 112, 95, 102, 105, 108,
-		116, 101, 114, 0, 114, 116, 95, 109, 117, 116, 101, 120, 95, 119, 97, 105,
-		116, 101, 114, 0, 114, 111, 98, 117, 115, 116, 95, 108, 105, 115, 116, 95,
-		104, 101, 97, 100, 0, 99, 111, 109, 112, 97, 116, 95, 114, 111, 98, 117,
-		115, 116, 95, 108, 105, 115, 116, 95, 104, 101, 97, 100, 0, 102, 117, 116,
-		101, 120, 95, 112, 105, 95, 115, 116, 97, 116, 101, 0, 116, 97, 115, 107,
-		95, 100, 101, 108, 97, 121, 95, 105, 110, 102, 111, 0, 102, 116, 114, 97,
-		99, 101, 95, 114, 101, 116, 95, 115, 116, 97, 99, 107, 0, 117, 112, 114,
-		111, 98, 101, 95, 116, 97, 115, 107, 0, 118, 109, 95, 115, 116, 114, 117,
-		99, 116, 0, 98, 112, 102, 95, 114, 117, 110, 95, 99, 116, 120, 0, 109,
-		97, 116, 104, 95, 101, 109, 117, 95, 105, 110, 102, 111, 0, 0, 0, 159,
-		235, 1, 0, 32, 0, 0, 0, 0, 0, 0, 0, 20, 0, 0, 0, 20,
-		0, 0, 0, 156, 3, 0, 0, 176, 3, 0, 0, 76, 0, 0, 0, 8,
-		0, 0, 0, 3, 1, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 36,
-		0, 0, 0, 16, 0, 0, 0, 3, 1, 0, 0, 57, 0, 0, 0, 0,
-		0, 0, 0, 41, 1, 0, 0, 126, 1, 0, 0, 28, 180, 0, 0, 8,
-		0, 0, 0, 41, 1, 0, 0, 126, 1, 0, 0, 22, 180, 0, 0, 24,
-		0, 0, 0, 41, 1, 0, 0, 157, 1, 0, 0, 24, 184, 0, 0, 32,
-		0, 0, 0, 41, 1, 0, 0, 213, 1, 0, 0, 15, 200, 0, 0, 40,
-		0, 0, 0, 41, 1, 0, 0, 213, 1, 0, 0, 42, 200, 0, 0, 48,
-		0, 0, 0, 41, 1, 0, 0, 213, 1, 0, 0, 9, 200, 0, 0, 56,
-		0, 0, 0, 41, 1, 0, 0, 5, 2, 0, 0, 55, 212, 0, 0, 88,
-		0, 0, 0, 41, 1, 0, 0, 213, 1, 0, 0, 15, 200, 0, 0, 96,
-		0, 0, 0, 41, 1, 0, 0, 55, 86, 0, 0, 17, 216, 0, 0, 208,
-		0, 0, 0, 41, 1, 0, 0, 213, 1, 0, 0, 15, 200, 0, 0, 216,
-		0, 0, 0, 41, 1, 0, 0, 55, 86, 0, 0, 17, 216, 0, 0, 232,
-		0, 0, 0, 41, 1, 0, 0, 55, 86, 0, 0, 17, 216, 0, 0, 240,
-		0, 0, 0, 41, 1, 0, 0, 55, 86, 0, 0, 9, 216, 0, 0, 248,
-		0, 0, 0, 41, 1, 0, 0, 89, 87, 0, 0, 9, 220, 0, 0, 8,
-		1, 0, 0, 41, 1, 0, 0, 111, 87, 0, 0, 9, 240, 0, 0, 24,
-		1, 0, 0, 41, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 32,
-		1, 0, 0, 41, 1, 0, 0, 132, 87, 0, 0, 18, 248, 0, 0, 56,
-		1, 0, 0, 41, 1, 0, 0, 192, 87, 0, 0, 28, 252, 0, 0, 64,
-		1, 0, 0, 41, 1, 0, 0, 192, 87, 0, 0, 31, 252, 0, 0, 72,
-		1, 0, 0, 41, 1, 0, 0, 192, 87, 0, 0, 9, 252, 0, 0, 88,
-		1, 0, 0, 41, 1, 0, 0, 246, 87, 0, 0, 10, 16, 1, 0, 168,
-		1, 0, 0, 41, 1, 0, 0, 24, 88, 0, 0, 5, 20, 1, 0, 184,
-		1, 0, 0, 41, 1, 0, 0, 70, 88, 0, 0, 9, 232, 1, 0, 208,
-		1, 0, 0, 41, 1, 0, 0, 70, 88, 0, 0, 9, 232, 1, 0, 224,
-		1, 0, 0, 41, 1, 0, 0, 101, 88, 0, 0, 24, 4, 2, 0, 0,
-		2, 0, 0, 41, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 8,
-		2, 0, 0, 41, 1, 0, 0, 101, 88, 0, 0, 13, 4, 2, 0, 24,
-		2, 0, 0, 41, 1, 0, 0, 101, 88, 0, 0, 24, 4, 2, 0, 32,
-		2, 0, 0, 41, 1, 0, 0, 101, 88, 0, 0, 13, 4, 2, 0, 40,
-		2, 0, 0, 41, 1, 0, 0, 142, 88, 0, 0, 13, 24, 2, 0, 80,
-		2, 0, 0, 41, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 88,
-		2, 0, 0, 41, 1, 0, 0, 170, 88, 0, 0, 25, 60, 1, 0, 112,
-		2, 0, 0, 41, 1, 0, 0, 233, 88, 0, 0, 9, 64, 1, 0, 120,
-		2, 0, 0, 41, 1, 0, 0, 14, 89, 0, 0, 13, 72, 1, 0, 168,
-		2, 0, 0, 41, 1, 0, 0, 83, 89, 0, 0, 13, 76, 1, 0, 176,
-		2, 0, 0, 41, 1, 0, 0, 104, 89, 0, 0, 13, 80, 1, 0, 192,
-		2, 0, 0, 41, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 200,
-		2, 0, 0, 41, 1, 0, 0, 104, 89, 0, 0, 13, 80, 1, 0, 240,
-		2, 0, 0, 41, 1, 0, 0, 184, 89, 0, 0, 26, 92, 1, 0, 248,
-		2, 0, 0, 41, 1, 0, 0, 184, 89, 0, 0, 24, 92, 1, 0, 0,
-		3, 0, 0, 41, 1, 0, 0, 214, 89, 0, 0, 28, 96, 1, 0, 8,
-		3, 0, 0, 41, 1, 0, 0, 214, 89, 0, 0, 26, 96, 1, 0, 16,
-		3, 0, 0, 41, 1, 0, 0, 248, 89, 0, 0, 13, 100, 1, 0, 48,
-		3, 0, 0, 41, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 72,
-		3, 0, 0, 41, 1, 0, 0, 34, 90, 0, 0, 13, 108, 1, 0, 112,
-		3, 0, 0, 41, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 120,
-		3, 0, 0, 41, 1, 0, 0, 102, 90, 0, 0, 9, 132, 1, 0, 144,
-		3, 0, 0, 41, 1, 0, 0, 156, 90, 0, 0, 9, 136, 1, 0, 152,
-		3, 0, 0, 41, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 176,
-		3, 0, 0, 41, 1, 0, 0, 187, 90, 0, 0, 1, 212, 1, 0, 200,
-		3, 0, 0, 41, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 208,
-		3, 0, 0, 41, 1, 0, 0, 189, 90, 0, 0, 9, 156, 1, 0, 8,
-		4, 0, 0, 41, 1, 0, 0, 3, 91, 0, 0, 28, 160, 1, 0, 40,
-		4, 0, 0, 41, 1, 0, 0, 76, 91, 0, 0, 13, 164, 1, 0, 48,
-		4, 0, 0, 41, 1, 0, 0, 98, 91, 0, 0, 13, 172, 1, 0, 72,
-		4, 0, 0, 41, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 80,
-		4, 0, 0, 41, 1, 0, 0, 98, 91, 0, 0, 13, 172, 1, 0, 16,
-		0, 0, 0, 3, 1, 0, 0, 4, 0, 0, 0, 0, 0, 0, 0, 27,
-		0, 0, 0, 37, 1, 0, 0, 0, 0, 0, 0, 64, 0, 0, 0, 37,
-		0, 0, 0, 49, 86, 0, 0, 0, 0, 0, 0, 128, 0, 0, 0, 164,
-		3, 0, 0, 31, 87, 0, 0, 0, 0, 0, 0, 176, 0, 0, 0, 173,
-		3, 0, 0, 83, 87, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		108, 101, 109, 40, 38, 112, 105, 100, 95, 109, 110, 116, 110, 115, 44, 32,
+		38, 112, 105, 100, 44, 32, 38, 109, 110, 116, 110, 115, 44, 32, 66, 80,
+		70, 95, 65, 78, 89, 41, 32, 33, 61, 32, 48, 41, 32, 123, 0, 32,
+		32, 32, 32, 117, 54, 52, 32, 42, 32, 99, 111, 117, 110, 116, 32, 61,
+		32, 98, 112, 102, 95, 109, 97, 112, 95, 108, 111, 111, 107, 117, 112, 95,
+		101, 108, 101, 109, 40, 38, 109, 97, 112, 95, 111, 118, 101, 114, 102, 108,
+		111, 119, 115, 44, 32, 38, 105, 110, 100, 101, 120, 41, 59, 0, 32, 32,
+		32, 32, 105, 102, 32, 40, 99, 111, 117, 110, 116, 41, 32, 123, 0, 32,
+		32, 32, 32, 32, 32, 32, 32, 95, 95, 115, 121, 110, 99, 95, 102, 101,
+		116, 99, 104, 95, 97, 110, 100, 95, 97, 100, 100, 40, 99, 111, 117, 110,
+		116, 44, 32, 49, 41, 59, 0, 32, 32, 32, 32, 32, 32, 32, 32, 98,
+		112, 102, 95, 109, 97, 112, 95, 108, 111, 111, 107, 117, 112, 95, 101, 108,
+		101, 109, 40, 38, 109, 110, 116, 110, 115, 95, 115, 121, 115, 99, 97, 108,
+		108, 115, 44, 32, 38, 109, 110, 116, 110, 115, 41, 59, 0, 32, 32, 32,
+		32, 105, 102, 32, 40, 109, 110, 116, 110, 115, 95, 115, 121, 115, 99, 97,
+		108, 108, 95, 118, 97, 108, 117, 101, 41, 32, 123, 0, 125, 0, 32, 32,
+		32, 32, 32, 32, 32, 32, 105, 102, 32, 40, 98, 112, 102, 95, 109, 97,
+		112, 95, 117, 112, 100, 97, 116, 101, 95, 101, 108, 101, 109, 40, 38, 109,
+		110, 116, 110, 115, 95, 115, 121, 115, 99, 97, 108, 108, 115, 44, 32, 38,
+		109, 110, 116, 110, 115, 44, 32, 38, 105, 110, 105, 116, 44, 32, 66, 80,
+		70, 95, 65, 78, 89, 41, 32, 33, 61, 0, 32, 32, 32, 32, 32, 32,
+		32, 32, 117, 56, 32, 42, 32, 99, 111, 110, 115, 116, 32, 118, 97, 108,
+		117, 101, 32, 61, 32, 98, 112, 102, 95, 109, 97, 112, 95, 108, 111, 111,
+		107, 117, 112, 95, 101, 108, 101, 109, 40, 38, 109, 110, 116, 110, 115, 95,
+		115, 121, 115, 99, 97, 108, 108, 115, 44, 32, 38, 109, 110, 116, 110, 115,
+		41, 59, 0, 32, 32, 32, 32, 32, 32, 32, 32, 105, 102, 32, 40, 33,
+		118, 97, 108, 117, 101, 41, 32, 123, 0, 32, 32, 32, 32, 32, 32, 32,
+		32, 32, 32, 32, 32, 98, 112, 102, 95, 112, 114, 105, 110, 116, 107, 40,
+		0, 115, 99, 104, 101, 100, 95, 112, 114, 111, 99, 101, 115, 115, 95, 101,
+		120, 105, 116, 0, 116, 114, 97, 99, 101, 112, 111, 105, 110, 116, 47, 115,
+		99, 104, 101, 100, 47, 115, 99, 104, 101, 100, 95, 112, 114, 111, 99, 101,
+		115, 115, 95, 101, 120, 105, 116, 0, 32, 32, 32, 32, 117, 54, 52, 32,
+		112, 105, 100, 95, 116, 103, 105, 100, 32, 61, 32, 98, 112, 102, 95, 103,
+		101, 116, 95, 99, 117, 114, 114, 101, 110, 116, 95, 112, 105, 100, 95, 116,
+		103, 105, 100, 40, 41, 59, 0, 32, 32, 32, 32, 117, 51, 50, 32, 112,
+		105, 100, 32, 61, 32, 112, 105, 100, 95, 116, 103, 105, 100, 32, 62, 62,
+		32, 51, 50, 59, 0, 32, 32, 32, 32, 105, 102, 32, 40, 40, 117, 51,
+		50, 41, 112, 105, 100, 95, 116, 103, 105, 100, 32, 33, 61, 32, 112, 105,
+		100, 41, 32, 123, 0, 32, 32, 32, 32, 98, 112, 102, 95, 109, 97, 112,
+		95, 100, 101, 108, 101, 116, 101, 95, 101, 108, 101, 109, 40, 38, 112, 105,
+		100, 95, 109, 110, 116, 110, 115, 44, 32, 38, 112, 105, 100, 41, 59, 0,
+		76, 73, 67, 69, 78, 83, 69, 0, 102, 105, 108, 116, 101, 114, 95, 110,
+		97, 109, 101, 0, 115, 121, 115, 95, 101, 110, 116, 101, 114, 46, 95, 95,
+		95, 95, 102, 109, 116, 0, 115, 121, 115, 95, 101, 110, 116, 101, 114, 46,
+		105, 110, 105, 116, 0, 115, 121, 115, 95, 101, 110, 116, 101, 114, 46, 95,
+		95, 95, 95, 102, 109, 116, 46, 49, 0, 46, 109, 97, 112, 115, 0, 46,
+		114, 111, 100, 97, 116, 97, 0, 108, 105, 99, 101, 110, 115, 101, 0, 95,
+		95, 107, 101, 114, 110, 101, 108, 95, 116, 105, 109, 101, 115, 112, 101, 99,
+		0, 97, 110, 111, 110, 95, 118, 109, 97, 95, 110, 97, 109, 101, 0, 98,
+		108, 107, 95, 112, 108, 117, 103, 0, 98, 112, 102, 95, 108, 111, 99, 97,
+		108, 95, 115, 116, 111, 114, 97, 103, 101, 0, 98, 112, 102, 95, 112, 114,
+		111, 103, 0, 98, 112, 102, 95, 114, 117, 110, 95, 99, 116, 120, 0, 99,
+		103, 114, 111, 117, 112, 95, 110, 97, 109, 101, 115, 112, 97, 99, 101, 0,
+		99, 111, 109, 112, 97, 116, 95, 114, 111, 98, 117, 115, 116, 95, 108, 105,
+		115, 116, 95, 104, 101, 97, 100, 0, 99, 111, 109, 112, 108, 101, 116, 105,
+		111, 110, 0, 99, 115, 115, 95, 115, 101, 116, 0, 101, 118, 101, 110, 116,
+		95, 102, 105, 108, 116, 101, 114, 0, 102, 97, 115, 121, 110, 99, 95, 115,
+		116, 114, 117, 99, 116, 0, 102, 105, 108, 101, 0, 102, 105, 108, 101, 115,
+		95, 115, 116, 114, 117, 99, 116, 0, 102, 115, 95, 115, 116, 114, 117, 99,
+		116, 0, 102, 116, 114, 97, 99, 101, 95, 104, 97, 115, 104, 0, 102, 116,
+		114, 97, 99, 101, 95, 114, 101, 116, 95, 115, 116, 97, 99, 107, 0, 102,
+		117, 116, 101, 120, 95, 112, 105, 95, 115, 116, 97, 116, 101, 0, 104, 114,
+		116, 105, 109, 101, 114, 95, 99, 108, 111, 99, 107, 95, 98, 97, 115, 101,
+		0, 105, 111, 95, 117, 114, 105, 110, 103, 95, 116, 97, 115, 107, 0, 105,
+		112, 99, 95, 110, 97, 109, 101, 115, 112, 97, 99, 101, 0, 107, 101, 114,
+		110, 101, 108, 95, 115, 105, 103, 105, 110, 102, 111, 0, 107, 117, 110, 105,
+		116, 0, 109, 97, 116, 104, 95, 101, 109, 117, 95, 105, 110, 102, 111, 0,
+		109, 101, 109, 95, 99, 103, 114, 111, 117, 112, 0, 109, 109, 95, 115, 116,
+		114, 117, 99, 116, 0, 109, 111, 117, 110, 116, 0, 110, 101, 116, 0, 111,
+		108, 100, 95, 116, 105, 109, 101, 115, 112, 101, 99, 51, 50, 0, 112, 101,
+		114, 102, 95, 97, 100, 100, 114, 95, 102, 105, 108, 116, 101, 114, 95, 114,
+		97, 110, 103, 101, 0, 112, 101, 114, 102, 95, 98, 114, 97, 110, 99, 104,
+		95, 115, 116, 97, 99, 107, 0, 112, 101, 114, 102, 95, 98, 117, 102, 102,
+		101, 114, 0, 112, 101, 114, 102, 95, 99, 97, 108, 108, 99, 104, 97, 105,
+		110, 95, 101, 110, 116, 114, 121, 0, 112, 101, 114, 102, 95, 99, 103, 114,
+		111, 117, 112, 0, 112, 101, 114, 102, 95, 114, 97, 119, 95, 114, 101, 99,
+		111, 114, 100, 0, 112, 105, 100, 95, 110, 97, 109, 101, 115, 112, 97, 99,
+		101, 0, 112, 105, 112, 101, 95, 105, 110, 111, 100, 101, 95, 105, 110, 102,
+		111, 0, 112, 111, 108, 108, 102, 100, 0, 112, 114, 111, 99, 95, 110, 115,
+		95, 111, 112, 101, 114, 97, 116, 105, 111, 110, 115, 0, 114, 99, 117, 95,
+		110, 111, 100, 101, 0, 114, 101, 113, 117, 101, 115, 116, 95, 113, 117, 101,
+		117, 101, 0, 114, 111, 98, 117, 115, 116, 95, 108, 105, 115, 116, 95, 104,
+		101, 97, 100, 0, 114, 116, 95, 109, 117, 116, 101, 120, 95, 119, 97, 105,
+		116, 101, 114, 0, 115, 101, 99, 99, 111, 109, 112, 95, 102, 105, 108, 116,
+		101, 114, 0, 115, 101, 109, 95, 117, 110, 100, 111, 95, 108, 105, 115, 116,
+		0, 115, 105, 103, 104, 97, 110, 100, 95, 115, 116, 114, 117, 99, 116, 0,
+		115, 105, 103, 110, 97, 108, 95, 115, 116, 114, 117, 99, 116, 0, 116, 97,
+		115, 107, 95, 100, 101, 108, 97, 121, 95, 105, 110, 102, 111, 0, 116, 97,
+		115, 107, 95, 103, 114, 111, 117, 112, 0, 116, 105, 109, 101, 95, 110, 97,
+		109, 101, 115, 112, 97, 99, 101, 0, 116, 114, 97, 99, 101, 95, 101, 118,
+		101, 110, 116, 95, 99, 97, 108, 108, 0, 117, 112, 114, 111, 98, 101, 95,
+		116, 97, 115, 107, 0, 117, 115, 101, 114, 95, 110, 97, 109, 101, 115, 112,
+		97, 99, 101, 0, 117, 115, 101, 114, 102, 97, 117, 108, 116, 102, 100, 95,
+		99, 116, 120, 0, 117, 116, 115, 95, 110, 97, 109, 101, 115, 112, 97, 99,
+		101, 0, 118, 109, 95, 111, 112, 101, 114, 97, 116, 105, 111, 110, 115, 95,
+		115, 116, 114, 117, 99, 116, 0, 118, 109, 95, 115, 116, 114, 117, 99, 116,
+		0, 0, 0, 159, 235, 1, 0, 32, 0, 0, 0, 0, 0, 0, 0, 36,
+		0, 0, 0, 36, 0, 0, 0, 228, 4, 0, 0, 8, 5, 0, 0, 76,
+		0, 0, 0, 8, 0, 0, 0, 46, 1, 0, 0, 1, 0, 0, 0, 0,
+		0, 0, 0, 44, 0, 0, 0, 45, 49, 0, 0, 1, 0, 0, 0, 0,
+		0, 0, 0, 126, 1, 0, 0, 16, 0, 0, 0, 46, 1, 0, 0, 69,
+		0, 0, 0, 0, 0, 0, 0, 84, 1, 0, 0, 148, 1, 0, 0, 28,
+		240, 0, 0, 8, 0, 0, 0, 84, 1, 0, 0, 148, 1, 0, 0, 22,
+		240, 0, 0, 24, 0, 0, 0, 84, 1, 0, 0, 179, 1, 0, 0, 24,
+		244, 0, 0, 32, 0, 0, 0, 84, 1, 0, 0, 235, 1, 0, 0, 15,
+		4, 1, 0, 40, 0, 0, 0, 84, 1, 0, 0, 235, 1, 0, 0, 42,
+		4, 1, 0, 48, 0, 0, 0, 84, 1, 0, 0, 235, 1, 0, 0, 9,
+		4, 1, 0, 56, 0, 0, 0, 84, 1, 0, 0, 27, 2, 0, 0, 55,
+		16, 1, 0, 88, 0, 0, 0, 84, 1, 0, 0, 235, 1, 0, 0, 15,
+		4, 1, 0, 96, 0, 0, 0, 84, 1, 0, 0, 10, 43, 0, 0, 17,
+		20, 1, 0, 152, 0, 0, 0, 84, 1, 0, 0, 235, 1, 0, 0, 15,
+		4, 1, 0, 160, 0, 0, 0, 84, 1, 0, 0, 10, 43, 0, 0, 17,
+		20, 1, 0, 208, 0, 0, 0, 84, 1, 0, 0, 235, 1, 0, 0, 15,
+		4, 1, 0, 216, 0, 0, 0, 84, 1, 0, 0, 10, 43, 0, 0, 17,
+		20, 1, 0, 240, 0, 0, 0, 84, 1, 0, 0, 10, 43, 0, 0, 9,
+		20, 1, 0, 248, 0, 0, 0, 84, 1, 0, 0, 16, 44, 0, 0, 9,
+		24, 1, 0, 8, 1, 0, 0, 84, 1, 0, 0, 38, 44, 0, 0, 9,
+		44, 1, 0, 24, 1, 0, 0, 84, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 32, 1, 0, 0, 84, 1, 0, 0, 59, 44, 0, 0, 18,
+		52, 1, 0, 56, 1, 0, 0, 84, 1, 0, 0, 119, 44, 0, 0, 28,
+		56, 1, 0, 64, 1, 0, 0, 84, 1, 0, 0, 119, 44, 0, 0, 31,
+		56, 1, 0, 72, 1, 0, 0, 84, 1, 0, 0, 119, 44, 0, 0, 9,
+		56, 1, 0, 88, 1, 0, 0, 84, 1, 0, 0, 173, 44, 0, 0, 10,
+		76, 1, 0, 168, 1, 0, 0, 84, 1, 0, 0, 207, 44, 0, 0, 5,
+		80, 1, 0, 184, 1, 0, 0, 84, 1, 0, 0, 253, 44, 0, 0, 9,
+		164, 2, 0, 208, 1, 0, 0, 84, 1, 0, 0, 253, 44, 0, 0, 9,
+		164, 2, 0, 224, 1, 0, 0, 84, 1, 0, 0, 28, 45, 0, 0, 24,
+		192, 2, 0, 0, 2, 0, 0, 84, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 8, 2, 0, 0, 84, 1, 0, 0, 28, 45, 0, 0, 13,
+		192, 2, 0, 24, 2, 0, 0, 84, 1, 0, 0, 28, 45, 0, 0, 24,
+		192, 2, 0, 32, 2, 0, 0, 84, 1, 0, 0, 28, 45, 0, 0, 13,
+		192, 2, 0, 40, 2, 0, 0, 84, 1, 0, 0, 69, 45, 0, 0, 13,
+		212, 2, 0, 80, 2, 0, 0, 84, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 88, 2, 0, 0, 84, 1, 0, 0, 97, 45, 0, 0, 25,
+		124, 1, 0, 112, 2, 0, 0, 84, 1, 0, 0, 160, 45, 0, 0, 9,
+		128, 1, 0, 120, 2, 0, 0, 84, 1, 0, 0, 197, 45, 0, 0, 13,
+		136, 1, 0, 168, 2, 0, 0, 84, 1, 0, 0, 10, 46, 0, 0, 13,
+		140, 1, 0, 176, 2, 0, 0, 84, 1, 0, 0, 31, 46, 0, 0, 29,
+		144, 1, 0, 192, 2, 0, 0, 84, 1, 0, 0, 88, 46, 0, 0, 13,
+		148, 1, 0, 248, 2, 0, 0, 84, 1, 0, 0, 165, 46, 0, 0, 26,
+		160, 1, 0, 0, 3, 0, 0, 84, 1, 0, 0, 165, 46, 0, 0, 24,
+		160, 1, 0, 8, 3, 0, 0, 84, 1, 0, 0, 195, 46, 0, 0, 28,
+		164, 1, 0, 16, 3, 0, 0, 84, 1, 0, 0, 195, 46, 0, 0, 26,
+		164, 1, 0, 24, 3, 0, 0, 84, 1, 0, 0, 229, 46, 0, 0, 30,
+		168, 1, 0, 40, 3, 0, 0, 84, 1, 0, 0, 15, 47, 0, 0, 13,
+		172, 1, 0, 72, 3, 0, 0, 84, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 96, 3, 0, 0, 84, 1, 0, 0, 57, 47, 0, 0, 17,
+		180, 1, 0, 128, 3, 0, 0, 84, 1, 0, 0, 57, 47, 0, 0, 17,
+		180, 1, 0, 152, 3, 0, 0, 84, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 160, 3, 0, 0, 84, 1, 0, 0, 136, 47, 0, 0, 19,
+		128, 2, 0, 184, 3, 0, 0, 84, 1, 0, 0, 199, 47, 0, 0, 9,
+		132, 2, 0, 200, 3, 0, 0, 84, 1, 0, 0, 216, 47, 0, 0, 9,
+		136, 2, 0, 216, 3, 0, 0, 84, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 224, 3, 0, 0, 84, 1, 0, 0, 0, 48, 0, 0, 9,
+		212, 1, 0, 248, 3, 0, 0, 84, 1, 0, 0, 54, 48, 0, 0, 9,
+		216, 1, 0, 0, 4, 0, 0, 84, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 24, 4, 0, 0, 84, 1, 0, 0, 85, 48, 0, 0, 1,
+		52, 2, 0, 48, 4, 0, 0, 84, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 56, 4, 0, 0, 84, 1, 0, 0, 87, 48, 0, 0, 13,
+		236, 1, 0, 104, 4, 0, 0, 84, 1, 0, 0, 87, 48, 0, 0, 13,
+		236, 1, 0, 136, 4, 0, 0, 84, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 144, 4, 0, 0, 84, 1, 0, 0, 136, 47, 0, 0, 19,
+		128, 2, 0, 168, 4, 0, 0, 84, 1, 0, 0, 199, 47, 0, 0, 9,
+		132, 2, 0, 176, 4, 0, 0, 84, 1, 0, 0, 216, 47, 0, 0, 9,
+		136, 2, 0, 200, 4, 0, 0, 84, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 208, 4, 0, 0, 84, 1, 0, 0, 163, 48, 0, 0, 28,
+		0, 2, 0, 232, 4, 0, 0, 84, 1, 0, 0, 236, 48, 0, 0, 13,
+		4, 2, 0, 240, 4, 0, 0, 84, 1, 0, 0, 2, 49, 0, 0, 13,
+		12, 2, 0, 8, 5, 0, 0, 84, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 16, 5, 0, 0, 84, 1, 0, 0, 2, 49, 0, 0, 13,
+		12, 2, 0, 45, 49, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 84,
+		1, 0, 0, 81, 49, 0, 0, 20, 72, 2, 0, 8, 0, 0, 0, 84,
+		1, 0, 0, 128, 49, 0, 0, 24, 76, 2, 0, 24, 0, 0, 0, 84,
+		1, 0, 0, 128, 49, 0, 0, 9, 76, 2, 0, 32, 0, 0, 0, 84,
+		1, 0, 0, 158, 49, 0, 0, 9, 88, 2, 0, 48, 0, 0, 0, 84,
+		1, 0, 0, 158, 49, 0, 0, 9, 88, 2, 0, 64, 0, 0, 0, 84,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 72, 0, 0, 0, 84,
+		1, 0, 0, 190, 49, 0, 0, 5, 104, 2, 0, 96, 0, 0, 0, 84,
+		1, 0, 0, 85, 48, 0, 0, 1, 112, 2, 0, 16, 0, 0, 0, 46,
+		1, 0, 0, 4, 0, 0, 0, 0, 0, 0, 0, 35, 0, 0, 0, 80,
+		1, 0, 0, 0, 0, 0, 0, 64, 0, 0, 0, 45, 0, 0, 0, 4,
+		43, 0, 0, 0, 0, 0, 0, 120, 0, 0, 0, 111, 1, 0, 0, 160,
+		43, 0, 0, 0, 0, 0, 0, 176, 0, 0, 0, 118, 1, 0, 0, 10,
+		44, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0, 3, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 218,
-		0, 0, 0, 0, 0, 3, 0, 176, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 226, 0, 0, 0, 0, 0, 3, 0, 80,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 204,
-		0, 0, 0, 0, 0, 3, 0, 72, 2, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 211, 0, 0, 0, 0, 0, 3, 0, 216,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 249,
-		0, 0, 0, 0, 0, 3, 0, 104, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 20, 0, 0, 0, 1, 0, 6, 0, 64,
-		0, 0, 0, 0, 0, 0, 0, 41, 0, 0, 0, 0, 0, 0, 0, 233,
-		0, 0, 0, 0, 0, 3, 0, 192, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 241, 0, 0, 0, 0, 0, 3, 0, 152,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 38,
-		0, 0, 0, 1, 0, 6, 0, 105, 0, 0, 0, 0, 0, 0, 0, 0,
-		4, 0, 0, 0, 0, 0, 0, 1, 1, 0, 0, 1, 0, 6, 0, 105,
+		0, 0, 0, 3, 0, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 82, 1, 0, 0, 0, 0, 3, 0, 24,
+		4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 32,
+		1, 0, 0, 0, 0, 3, 0, 80, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 2, 1, 0, 0, 0, 0, 3, 0, 72,
+		2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 17,
+		1, 0, 0, 0, 0, 3, 0, 216, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 47, 1, 0, 0, 0, 0, 3, 0, 208,
+		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 20,
+		0, 0, 0, 1, 0, 8, 0, 64, 0, 0, 0, 0, 0, 0, 0, 45,
+		0, 0, 0, 0, 0, 0, 0, 24, 1, 0, 0, 0, 0, 3, 0, 40,
+		4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 39,
+		1, 0, 0, 0, 0, 3, 0, 0, 4, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 78, 0, 0, 0, 1, 0, 8, 0, 109,
+		0, 0, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 0, 0, 0, 9,
+		1, 0, 0, 0, 0, 3, 0, 192, 4, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 62, 1, 0, 0, 1, 0, 8, 0, 109,
 		4, 0, 0, 0, 0, 0, 0, 72, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 3, 0, 6, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 119, 0, 0, 0, 18, 0, 3, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 120, 4, 0, 0, 0, 0, 0, 0, 66,
-		0, 0, 0, 17, 0, 7, 0, 0, 0, 0, 0, 0, 0, 0, 0, 32,
-		0, 0, 0, 0, 0, 0, 0, 151, 0, 0, 0, 17, 0, 6, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 53,
-		0, 0, 0, 17, 0, 7, 0, 32, 0, 0, 0, 0, 0, 0, 0, 16,
-		0, 0, 0, 0, 0, 0, 0, 76, 0, 0, 0, 17, 0, 7, 0, 48,
-		0, 0, 0, 0, 0, 0, 0, 32, 0, 0, 0, 0, 0, 0, 0, 196,
-		0, 0, 0, 17, 0, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 13,
+		0, 0, 0, 3, 0, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 55, 1, 0, 0, 0, 0, 5, 0, 96,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 3, 0, 8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 173, 0, 0, 0, 18, 0, 3, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 56, 5, 0, 0, 0, 0, 0, 0, 120,
+		0, 0, 0, 17, 0, 9, 0, 0, 0, 0, 0, 0, 0, 0, 0, 32,
+		0, 0, 0, 0, 0, 0, 0, 205, 0, 0, 0, 17, 0, 8, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 107,
+		0, 0, 0, 17, 0, 9, 0, 32, 0, 0, 0, 0, 0, 0, 0, 16,
+		0, 0, 0, 0, 0, 0, 0, 93, 0, 0, 0, 17, 0, 9, 0, 80,
+		0, 0, 0, 0, 0, 0, 0, 32, 0, 0, 0, 0, 0, 0, 0, 130,
+		0, 0, 0, 17, 0, 9, 0, 48, 0, 0, 0, 0, 0, 0, 0, 32,
+		0, 0, 0, 0, 0, 0, 0, 59, 0, 0, 0, 18, 0, 5, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 112, 0, 0, 0, 0, 0, 0, 0, 250,
+		0, 0, 0, 17, 0, 7, 0, 0, 0, 0, 0, 0, 0, 0, 0, 13,
 		0, 0, 0, 0, 0, 0, 0, 32, 1, 0, 0, 0, 0, 0, 0, 1,
-		0, 0, 0, 14, 0, 0, 0, 184, 1, 0, 0, 0, 0, 0, 0, 1,
-		0, 0, 0, 15, 0, 0, 0, 224, 1, 0, 0, 0, 0, 0, 0, 1,
-		0, 0, 0, 15, 0, 0, 0, 88, 2, 0, 0, 0, 0, 0, 0, 1,
-		0, 0, 0, 14, 0, 0, 0, 120, 2, 0, 0, 0, 0, 0, 0, 1,
-		0, 0, 0, 16, 0, 0, 0, 200, 2, 0, 0, 0, 0, 0, 0, 1,
-		0, 0, 0, 12, 0, 0, 0, 72, 3, 0, 0, 0, 0, 0, 0, 1,
-		0, 0, 0, 14, 0, 0, 0, 120, 3, 0, 0, 0, 0, 0, 0, 1,
-		0, 0, 0, 17, 0, 0, 0, 208, 3, 0, 0, 0, 0, 0, 0, 1,
-		0, 0, 0, 17, 0, 0, 0, 232, 3, 0, 0, 0, 0, 0, 0, 1,
-		0, 0, 0, 12, 0, 0, 0, 8, 4, 0, 0, 0, 0, 0, 0, 1,
-		0, 0, 0, 17, 0, 0, 0, 80, 4, 0, 0, 0, 0, 0, 0, 1,
-		0, 0, 0, 12, 0, 0, 0, 100, 157, 0, 0, 0, 0, 0, 0, 4,
-		0, 0, 0, 14, 0, 0, 0, 112, 157, 0, 0, 0, 0, 0, 0, 4,
-		0, 0, 0, 16, 0, 0, 0, 124, 157, 0, 0, 0, 0, 0, 0, 4,
-		0, 0, 0, 17, 0, 0, 0, 148, 157, 0, 0, 0, 0, 0, 0, 3,
-		0, 0, 0, 15, 0, 0, 0, 160, 157, 0, 0, 0, 0, 0, 0, 3,
-		0, 0, 0, 12, 0, 0, 0, 172, 157, 0, 0, 0, 0, 0, 0, 3,
-		0, 0, 0, 12, 0, 0, 0, 184, 157, 0, 0, 0, 0, 0, 0, 3,
-		0, 0, 0, 12, 0, 0, 0, 208, 157, 0, 0, 0, 0, 0, 0, 4,
-		0, 0, 0, 18, 0, 0, 0, 44, 0, 0, 0, 0, 0, 0, 0, 4,
-		0, 0, 0, 1, 0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 4,
-		0, 0, 0, 1, 0, 0, 0, 80, 0, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 0, 17, 0, 0, 0, 184, 1, 0, 0, 0, 0, 0, 0, 1,
+		0, 0, 0, 18, 0, 0, 0, 224, 1, 0, 0, 0, 0, 0, 0, 1,
+		0, 0, 0, 18, 0, 0, 0, 88, 2, 0, 0, 0, 0, 0, 0, 1,
+		0, 0, 0, 17, 0, 0, 0, 120, 2, 0, 0, 0, 0, 0, 0, 1,
+		0, 0, 0, 19, 0, 0, 0, 200, 2, 0, 0, 0, 0, 0, 0, 1,
+		0, 0, 0, 15, 0, 0, 0, 96, 3, 0, 0, 0, 0, 0, 0, 1,
+		0, 0, 0, 17, 0, 0, 0, 160, 3, 0, 0, 0, 0, 0, 0, 1,
+		0, 0, 0, 20, 0, 0, 0, 224, 3, 0, 0, 0, 0, 0, 0, 1,
+		0, 0, 0, 21, 0, 0, 0, 56, 4, 0, 0, 0, 0, 0, 0, 1,
+		0, 0, 0, 21, 0, 0, 0, 72, 4, 0, 0, 0, 0, 0, 0, 1,
+		0, 0, 0, 15, 0, 0, 0, 144, 4, 0, 0, 0, 0, 0, 0, 1,
+		0, 0, 0, 20, 0, 0, 0, 208, 4, 0, 0, 0, 0, 0, 0, 1,
+		0, 0, 0, 21, 0, 0, 0, 16, 5, 0, 0, 0, 0, 0, 0, 1,
+		0, 0, 0, 15, 0, 0, 0, 72, 0, 0, 0, 0, 0, 0, 0, 1,
+		0, 0, 0, 17, 0, 0, 0, 124, 68, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 0, 17, 0, 0, 0, 136, 68, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 0, 19, 0, 0, 0, 148, 68, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 0, 21, 0, 0, 0, 160, 68, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 0, 20, 0, 0, 0, 184, 68, 0, 0, 0, 0, 0, 0, 3,
+		0, 0, 0, 18, 0, 0, 0, 196, 68, 0, 0, 0, 0, 0, 0, 3,
+		0, 0, 0, 15, 0, 0, 0, 208, 68, 0, 0, 0, 0, 0, 0, 3,
+		0, 0, 0, 15, 0, 0, 0, 220, 68, 0, 0, 0, 0, 0, 0, 3,
+		0, 0, 0, 15, 0, 0, 0, 244, 68, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 0, 23, 0, 0, 0, 44, 0, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 0, 1, 0, 0, 0, 60, 0, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 0, 13, 0, 0, 0, 80, 0, 0, 0, 0, 0, 0, 0, 4,
 		0, 0, 0, 1, 0, 0, 0, 96, 0, 0, 0, 0, 0, 0, 0, 4,
 		0, 0, 0, 1, 0, 0, 0, 112, 0, 0, 0, 0, 0, 0, 0, 4,
 		0, 0, 0, 1, 0, 0, 0, 128, 0, 0, 0, 0, 0, 0, 0, 4,
@@ -4548,169 +2419,222 @@ var bpfObjects = map[string][]byte{
 		0, 0, 0, 1, 0, 0, 0, 160, 3, 0, 0, 0, 0, 0, 0, 4,
 		0, 0, 0, 1, 0, 0, 0, 176, 3, 0, 0, 0, 0, 0, 0, 4,
 		0, 0, 0, 1, 0, 0, 0, 192, 3, 0, 0, 0, 0, 0, 0, 4,
-		0, 0, 0, 1, 0, 0, 0, 220, 3, 0, 0, 0, 0, 0, 0, 4,
-		0, 0, 0, 1, 0, 0, 0, 236, 3, 0, 0, 0, 0, 0, 0, 4,
-		0, 0, 0, 1, 0, 0, 0, 252, 3, 0, 0, 0, 0, 0, 0, 4,
-		0, 0, 0, 1, 0, 0, 0, 12, 4, 0, 0, 0, 0, 0, 0, 4,
-		0, 0, 0, 1, 0, 0, 0, 23, 28, 25, 24, 26, 8, 27, 11, 12,
-		0, 46, 116, 101, 120, 116, 0, 46, 114, 101, 108, 46, 66, 84, 70, 46,
-		101, 120, 116, 0, 115, 121, 115, 95, 101, 110, 116, 101, 114, 46, 95, 95,
-		95, 95, 102, 109, 116, 0, 115, 121, 115, 95, 101, 110, 116, 101, 114, 46,
-		105, 110, 105, 116, 0, 101, 118, 101, 110, 116, 115, 0, 46, 109, 97, 112,
-		115, 0, 112, 105, 100, 95, 109, 110, 116, 110, 115, 0, 109, 110, 116, 110,
-		115, 95, 115, 121, 115, 99, 97, 108, 108, 115, 0, 46, 114, 101, 108, 116,
-		114, 97, 99, 101, 112, 111, 105, 110, 116, 47, 114, 97, 119, 95, 115, 121,
-		115, 99, 97, 108, 108, 115, 47, 115, 121, 115, 95, 101, 110, 116, 101, 114,
-		0, 46, 108, 108, 118, 109, 95, 97, 100, 100, 114, 115, 105, 103, 0, 108,
-		105, 99, 101, 110, 115, 101, 0, 102, 105, 108, 116, 101, 114, 95, 110, 97,
-		109, 101, 0, 46, 115, 116, 114, 116, 97, 98, 0, 46, 115, 121, 109, 116,
-		97, 98, 0, 46, 114, 111, 100, 97, 116, 97, 0, 46, 114, 101, 108, 46,
-		66, 84, 70, 0, 76, 73, 67, 69, 78, 83, 69, 0, 76, 66, 66, 48,
-		95, 56, 0, 76, 66, 66, 48, 95, 53, 0, 76, 66, 66, 48, 95, 49,
-		53, 0, 76, 66, 66, 48, 95, 52, 0, 76, 66, 66, 48, 95, 49, 51,
-		0, 76, 66, 66, 48, 95, 49, 50, 0, 76, 66, 66, 48, 95, 49, 49,
-		0, 115, 121, 115, 95, 101, 110, 116, 101, 114, 46, 95, 95, 95, 95, 102,
-		109, 116, 46, 49, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 1, 0, 0, 0, 208, 3, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 0, 1, 0, 0, 0, 224, 3, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 0, 1, 0, 0, 0, 240, 3, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 0, 1, 0, 0, 0, 0, 4, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 0, 1, 0, 0, 0, 16, 4, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 0, 1, 0, 0, 0, 32, 4, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 0, 1, 0, 0, 0, 48, 4, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 0, 1, 0, 0, 0, 64, 4, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 0, 1, 0, 0, 0, 80, 4, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 0, 1, 0, 0, 0, 96, 4, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 0, 1, 0, 0, 0, 112, 4, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 0, 1, 0, 0, 0, 128, 4, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 0, 1, 0, 0, 0, 144, 4, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 0, 1, 0, 0, 0, 168, 4, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 0, 13, 0, 0, 0, 184, 4, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 0, 13, 0, 0, 0, 200, 4, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 0, 13, 0, 0, 0, 216, 4, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 0, 13, 0, 0, 0, 232, 4, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 0, 13, 0, 0, 0, 248, 4, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 0, 13, 0, 0, 0, 8, 5, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 0, 13, 0, 0, 0, 24, 5, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 0, 13, 0, 0, 0, 52, 5, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 0, 1, 0, 0, 0, 68, 5, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 0, 1, 0, 0, 0, 84, 5, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 0, 1, 0, 0, 0, 100, 5, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 0, 1, 0, 0, 0, 26, 32, 33, 28, 27, 29, 8, 31, 11,
+		13, 30, 0, 46, 116, 101, 120, 116, 0, 46, 114, 101, 108, 46, 66, 84,
+		70, 46, 101, 120, 116, 0, 115, 121, 115, 95, 101, 110, 116, 101, 114, 46,
+		95, 95, 95, 95, 102, 109, 116, 0, 46, 114, 101, 108, 116, 114, 97, 99,
+		101, 112, 111, 105, 110, 116, 47, 115, 99, 104, 101, 100, 47, 115, 99, 104,
+		101, 100, 95, 112, 114, 111, 99, 101, 115, 115, 95, 101, 120, 105, 116, 0,
+		115, 121, 115, 95, 101, 110, 116, 101, 114, 46, 105, 110, 105, 116, 0, 109,
+		97, 112, 95, 111, 118, 101, 114, 102, 108, 111, 119, 115, 0, 101, 118, 101,
+		110, 116, 115, 0, 46, 109, 97, 112, 115, 0, 112, 105, 100, 95, 109, 110,
+		116, 110, 115, 0, 109, 110, 116, 110, 115, 95, 115, 121, 115, 99, 97, 108,
+		108, 115, 0, 46, 114, 101, 108, 116, 114, 97, 99, 101, 112, 111, 105, 110,
+		116, 47, 114, 97, 119, 95, 115, 121, 115, 99, 97, 108, 108, 115, 47, 115,
+		121, 115, 95, 101, 110, 116, 101, 114, 0, 46, 108, 108, 118, 109, 95, 97,
+		100, 100, 114, 115, 105, 103, 0, 108, 105, 99, 101, 110, 115, 101, 0, 102,
+		105, 108, 116, 101, 114, 95, 110, 97, 109, 101, 0, 46, 115, 116, 114, 116,
+		97, 98, 0, 46, 115, 121, 109, 116, 97, 98, 0, 46, 114, 111, 100, 97,
+		116, 97, 0, 46, 114, 101, 108, 46, 66, 84, 70, 0, 76, 73, 67, 69,
+		78, 83, 69, 0, 76, 66, 66, 48, 95, 56, 0, 76, 66, 66, 48, 95,
+		49, 56, 0, 76, 66, 66, 48, 95, 53, 0, 76, 66, 66, 48, 95, 49,
+		53, 0, 76, 66, 66, 48, 95, 52, 0, 76, 66, 66, 48, 95, 49, 52,
+		0, 76, 66, 66, 48, 95, 49, 51, 0, 76, 66, 66, 49, 95, 50, 0,
+		115, 121, 115, 95, 101, 110, 116, 101, 114, 46, 95, 95, 95, 95, 102, 109,
+		116, 46, 49, 0, 76, 66, 66, 48, 95, 50, 48, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 163, 0, 0, 0, 3, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 65,
-		27, 1, 0, 0, 0, 0, 0, 21, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 1, 0, 0, 0, 6,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 64,
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 95, 0, 0, 0, 1, 0, 0, 0, 6,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 64,
-		0, 0, 0, 0, 0, 0, 0, 120, 4, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 91, 0, 0, 0, 9, 0, 0, 0, 64,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 24,
-		22, 1, 0, 0, 0, 0, 0, 192, 0, 0, 0, 0, 0, 0, 0, 13,
-		0, 0, 0, 3, 0, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 16,
-		0, 0, 0, 0, 0, 0, 0, 143, 0, 0, 0, 1, 0, 0, 0, 3,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 184,
-		4, 0, 0, 0, 0, 0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 179, 0, 0, 0, 1, 0, 0, 0, 2,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 197,
-		4, 0, 0, 0, 0, 0, 0, 177, 4, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 60, 0, 0, 0, 1, 0, 0, 0, 3,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 120,
-		9, 0, 0, 0, 0, 0, 0, 80, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 191, 0, 0, 0, 1, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 200,
-		9, 0, 0, 0, 0, 0, 0, 102, 6, 1, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 187, 0, 0, 0, 9, 0, 0, 0, 64,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 216,
-		22, 1, 0, 0, 0, 0, 0, 128, 0, 0, 0, 0, 0, 0, 0, 13,
-		0, 0, 0, 8, 0, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 16,
-		0, 0, 0, 0, 0, 0, 0, 11, 0, 0, 0, 1, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 48,
-		16, 1, 0, 0, 0, 0, 0, 28, 4, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 7, 0, 0, 0, 9, 0, 0, 0, 64,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 88,
-		23, 1, 0, 0, 0, 0, 0, 224, 3, 0, 0, 0, 0, 0, 0, 13,
-		0, 0, 0, 10, 0, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 16,
-		0, 0, 0, 0, 0, 0, 0, 129, 0, 0, 0, 3, 76, 255, 111, 0,
-		0, 0, 128, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 56,
-		27, 1, 0, 0, 0, 0, 0, 9, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 171, 0, 0, 0, 2, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 80,
-		20, 1, 0, 0, 0, 0, 0, 200, 1, 0, 0, 0, 0, 0, 0, 1,
-		0, 0, 0, 13, 0, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 24,
-		0, 0, 0, 0, 0, 0, 0},
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 217,
+		0, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 131, 151, 0, 0, 0, 0, 0, 0, 90,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1,
+		0, 0, 0, 1, 0, 0, 0, 6, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 149,
+		0, 0, 0, 1, 0, 0, 0, 6, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 56,
+		5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 8,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 145,
+		0, 0, 0, 9, 0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 200, 144, 0, 0, 0, 0, 0, 0, 224,
+		0, 0, 0, 0, 0, 0, 0, 15, 0, 0, 0, 3, 0, 0, 0, 8,
+		0, 0, 0, 0, 0, 0, 0, 16, 0, 0, 0, 0, 0, 0, 0, 42,
+		0, 0, 0, 1, 0, 0, 0, 6, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 120, 5, 0, 0, 0, 0, 0, 0, 112,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 8,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 38,
+		0, 0, 0, 9, 0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 168, 145, 0, 0, 0, 0, 0, 0, 16,
+		0, 0, 0, 0, 0, 0, 0, 15, 0, 0, 0, 5, 0, 0, 0, 8,
+		0, 0, 0, 0, 0, 0, 0, 16, 0, 0, 0, 0, 0, 0, 0, 197,
+		0, 0, 0, 1, 0, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 232, 5, 0, 0, 0, 0, 0, 0, 13,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 233,
+		0, 0, 0, 1, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 245, 5, 0, 0, 0, 0, 0, 0, 181,
+		4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 114,
+		0, 0, 0, 1, 0, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 176, 10, 0, 0, 0, 0, 0, 0, 112,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 8,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 245,
+		0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 32, 11, 0, 0, 0, 0, 0, 0, 242,
+		125, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
+		0, 0, 0, 9, 0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 184, 145, 0, 0, 0, 0, 0, 0, 144,
+		0, 0, 0, 0, 0, 0, 0, 15, 0, 0, 0, 10, 0, 0, 0, 8,
+		0, 0, 0, 0, 0, 0, 0, 16, 0, 0, 0, 0, 0, 0, 0, 11,
+		0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 20, 137, 0, 0, 0, 0, 0, 0, 116,
+		5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7,
+		0, 0, 0, 9, 0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 72, 146, 0, 0, 0, 0, 0, 0, 48,
+		5, 0, 0, 0, 0, 0, 0, 15, 0, 0, 0, 12, 0, 0, 0, 8,
+		0, 0, 0, 0, 0, 0, 0, 16, 0, 0, 0, 0, 0, 0, 0, 183,
+		0, 0, 0, 3, 76, 255, 111, 0, 0, 0, 128, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 120, 151, 0, 0, 0, 0, 0, 0, 11,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 225,
+		0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 136, 142, 0, 0, 0, 0, 0, 0, 64,
+		2, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 16, 0, 0, 0, 8,
+		0, 0, 0, 0, 0, 0, 0, 24, 0, 0, 0, 0, 0, 0, 0},
 	"arm64": {
 		127, 69, 76, 70, 2, 1, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1,
 		0, 247, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 128, 20, 1, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 64, 0, 0, 0, 0, 0, 64, 0, 14, 0, 1, 0, 121,
-		24, 8, 0, 0, 0, 0, 0, 103, 8, 0, 0, 32, 0, 0, 0, 119,
-		8, 0, 0, 32, 0, 0, 0, 37, 8, 114, 0, 255, 3, 0, 0, 133,
+		0, 0, 0, 0, 0, 0, 0, 200, 146, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 64, 0, 0, 0, 0, 0, 64, 0, 16, 0, 1, 0, 121,
+		25, 8, 0, 0, 0, 0, 0, 103, 9, 0, 0, 32, 0, 0, 0, 119,
+		9, 0, 0, 32, 0, 0, 0, 37, 9, 127, 0, 255, 3, 0, 0, 133,
 		0, 0, 0, 14, 0, 0, 0, 119, 0, 0, 0, 32, 0, 0, 0, 99,
 		10, 252, 255, 0, 0, 0, 0, 133, 0, 0, 0, 35, 0, 0, 0, 183,
 		1, 0, 0, 104, 10, 0, 0, 15, 16, 0, 0, 0, 0, 0, 0, 191,
-		166, 0, 0, 0, 0, 0, 0, 7, 6, 0, 0, 176, 255, 255, 255, 191,
-		97, 0, 0, 0, 0, 0, 0, 183, 2, 0, 0, 8, 0, 0, 0, 191,
-		3, 0, 0, 0, 0, 0, 0, 133, 0, 0, 0, 113, 0, 0, 0, 183,
-		1, 0, 0, 24, 0, 0, 0, 121, 163, 176, 255, 0, 0, 0, 0, 15,
-		19, 0, 0, 0, 0, 0, 0, 191, 97, 0, 0, 0, 0, 0, 0, 183,
+		161, 0, 0, 0, 0, 0, 0, 7, 1, 0, 0, 168, 255, 255, 255, 183,
+		2, 0, 0, 8, 0, 0, 0, 191, 3, 0, 0, 0, 0, 0, 0, 133,
+		0, 0, 0, 113, 0, 0, 0, 183, 1, 0, 0, 24, 0, 0, 0, 121,
+		163, 168, 255, 0, 0, 0, 0, 15, 19, 0, 0, 0, 0, 0, 0, 191,
+		161, 0, 0, 0, 0, 0, 0, 7, 1, 0, 0, 240, 255, 255, 255, 183,
 		2, 0, 0, 8, 0, 0, 0, 133, 0, 0, 0, 113, 0, 0, 0, 183,
-		1, 0, 0, 16, 0, 0, 0, 121, 163, 176, 255, 0, 0, 0, 0, 15,
+		1, 0, 0, 16, 0, 0, 0, 121, 163, 240, 255, 0, 0, 0, 0, 15,
 		19, 0, 0, 0, 0, 0, 0, 191, 161, 0, 0, 0, 0, 0, 0, 7,
-		1, 0, 0, 244, 255, 255, 255, 183, 2, 0, 0, 4, 0, 0, 0, 133,
-		0, 0, 0, 113, 0, 0, 0, 97, 166, 244, 255, 0, 0, 0, 0, 99,
-		106, 248, 255, 0, 0, 0, 0, 21, 6, 86, 0, 0, 0, 0, 0, 183,
-		1, 0, 0, 1, 0, 0, 0, 99, 26, 244, 255, 0, 0, 0, 0, 191,
-		162, 0, 0, 0, 0, 0, 0, 7, 2, 0, 0, 244, 255, 255, 255, 24,
+		1, 0, 0, 236, 255, 255, 255, 183, 2, 0, 0, 4, 0, 0, 0, 133,
+		0, 0, 0, 113, 0, 0, 0, 97, 166, 236, 255, 0, 0, 0, 0, 99,
+		106, 248, 255, 0, 0, 0, 0, 21, 6, 99, 0, 0, 0, 0, 0, 183,
+		1, 0, 0, 1, 0, 0, 0, 99, 26, 236, 255, 0, 0, 0, 0, 191,
+		162, 0, 0, 0, 0, 0, 0, 7, 2, 0, 0, 236, 255, 255, 255, 24,
 		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 133,
 		0, 0, 0, 1, 0, 0, 0, 21, 0, 2, 0, 0, 0, 0, 0, 97,
-		1, 0, 0, 0, 0, 0, 0, 29, 97, 76, 0, 0, 0, 0, 0, 183,
-		7, 0, 0, 0, 0, 0, 0, 123, 122, 232, 255, 0, 0, 0, 0, 123,
-		122, 224, 255, 0, 0, 0, 0, 123, 122, 216, 255, 0, 0, 0, 0, 123,
-		122, 208, 255, 0, 0, 0, 0, 123, 122, 200, 255, 0, 0, 0, 0, 123,
-		122, 192, 255, 0, 0, 0, 0, 123, 122, 184, 255, 0, 0, 0, 0, 123,
-		122, 176, 255, 0, 0, 0, 0, 191, 161, 0, 0, 0, 0, 0, 0, 7,
-		1, 0, 0, 176, 255, 255, 255, 183, 2, 0, 0, 64, 0, 0, 0, 133,
+		1, 0, 0, 0, 0, 0, 0, 29, 97, 89, 0, 0, 0, 0, 0, 183,
+		7, 0, 0, 0, 0, 0, 0, 123, 122, 224, 255, 0, 0, 0, 0, 123,
+		122, 216, 255, 0, 0, 0, 0, 123, 122, 208, 255, 0, 0, 0, 0, 123,
+		122, 200, 255, 0, 0, 0, 0, 123, 122, 192, 255, 0, 0, 0, 0, 123,
+		122, 184, 255, 0, 0, 0, 0, 123, 122, 176, 255, 0, 0, 0, 0, 123,
+		122, 168, 255, 0, 0, 0, 0, 191, 161, 0, 0, 0, 0, 0, 0, 7,
+		1, 0, 0, 168, 255, 255, 255, 183, 2, 0, 0, 64, 0, 0, 0, 133,
 		0, 0, 0, 16, 0, 0, 0, 24, 1, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 113, 17, 0, 0, 0, 0, 0, 0, 21,
 		1, 14, 0, 0, 0, 0, 0, 191, 113, 0, 0, 0, 0, 0, 0, 24,
 		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 15,
 		19, 0, 0, 0, 0, 0, 0, 191, 162, 0, 0, 0, 0, 0, 0, 7,
-		2, 0, 0, 176, 255, 255, 255, 15, 18, 0, 0, 0, 0, 0, 0, 113,
+		2, 0, 0, 168, 255, 255, 255, 15, 18, 0, 0, 0, 0, 0, 0, 113,
 		34, 0, 0, 0, 0, 0, 0, 113, 51, 0, 0, 0, 0, 0, 0, 93,
-		50, 49, 0, 0, 0, 0, 0, 21, 2, 3, 0, 0, 0, 0, 0, 191,
+		50, 62, 0, 0, 0, 0, 0, 21, 2, 3, 0, 0, 0, 0, 0, 191,
 		23, 0, 0, 0, 0, 0, 0, 7, 7, 0, 0, 1, 0, 0, 0, 85,
 		1, 242, 255, 63, 0, 0, 0, 191, 162, 0, 0, 0, 0, 0, 0, 7,
 		2, 0, 0, 252, 255, 255, 255, 24, 1, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 133, 0, 0, 0, 1, 0, 0, 0, 85,
-		0, 30, 0, 0, 0, 0, 0, 24, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 183, 2, 0, 0, 8, 0, 0, 0, 183,
+		0, 43, 0, 0, 0, 0, 0, 24, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 183, 2, 0, 0, 16, 0, 0, 0, 183,
 		3, 0, 0, 0, 0, 0, 0, 133, 0, 0, 0, 131, 0, 0, 0, 191,
-		7, 0, 0, 0, 0, 0, 0, 21, 7, 23, 0, 0, 0, 0, 0, 97,
-		163, 252, 255, 0, 0, 0, 0, 191, 165, 0, 0, 0, 0, 0, 0, 7,
-		5, 0, 0, 176, 255, 255, 255, 24, 1, 0, 0, 64, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 183, 2, 0, 0, 41, 0, 0, 0, 191,
-		100, 0, 0, 0, 0, 0, 0, 133, 0, 0, 0, 6, 0, 0, 0, 97,
-		161, 252, 255, 0, 0, 0, 0, 99, 23, 0, 0, 0, 0, 0, 0, 97,
-		161, 248, 255, 0, 0, 0, 0, 99, 23, 4, 0, 0, 0, 0, 0, 191,
-		113, 0, 0, 0, 0, 0, 0, 183, 2, 0, 0, 0, 0, 0, 0, 133,
-		0, 0, 0, 132, 0, 0, 0, 191, 162, 0, 0, 0, 0, 0, 0, 7,
-		2, 0, 0, 252, 255, 255, 255, 191, 163, 0, 0, 0, 0, 0, 0, 7,
-		3, 0, 0, 248, 255, 255, 255, 24, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 183, 4, 0, 0, 0, 0, 0, 0, 133,
-		0, 0, 0, 2, 0, 0, 0, 191, 162, 0, 0, 0, 0, 0, 0, 7,
+		7, 0, 0, 0, 0, 0, 0, 21, 7, 36, 0, 0, 0, 0, 0, 133,
+		0, 0, 0, 80, 0, 0, 0, 191, 8, 0, 0, 0, 0, 0, 0, 97,
+		163, 252, 255, 0, 0, 0, 0, 24, 1, 0, 0, 64, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 183, 2, 0, 0, 45, 0, 0, 0, 191,
+		100, 0, 0, 0, 0, 0, 0, 191, 133, 0, 0, 0, 0, 0, 0, 133,
+		0, 0, 0, 6, 0, 0, 0, 97, 161, 252, 255, 0, 0, 0, 0, 99,
+		23, 0, 0, 0, 0, 0, 0, 97, 161, 248, 255, 0, 0, 0, 0, 99,
+		23, 4, 0, 0, 0, 0, 0, 123, 135, 8, 0, 0, 0, 0, 0, 183,
+		6, 0, 0, 0, 0, 0, 0, 191, 113, 0, 0, 0, 0, 0, 0, 183,
+		2, 0, 0, 0, 0, 0, 0, 133, 0, 0, 0, 132, 0, 0, 0, 191,
+		162, 0, 0, 0, 0, 0, 0, 7, 2, 0, 0, 252, 255, 255, 255, 191,
+		163, 0, 0, 0, 0, 0, 0, 7, 3, 0, 0, 248, 255, 255, 255, 24,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 183,
+		4, 0, 0, 0, 0, 0, 0, 133, 0, 0, 0, 2, 0, 0, 0, 21,
+		0, 9, 0, 0, 0, 0, 0, 99, 106, 240, 255, 0, 0, 0, 0, 191,
+		162, 0, 0, 0, 0, 0, 0, 7, 2, 0, 0, 240, 255, 255, 255, 24,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 133,
+		0, 0, 0, 1, 0, 0, 0, 21, 0, 2, 0, 0, 0, 0, 0, 183,
+		1, 0, 0, 1, 0, 0, 0, 219, 16, 0, 0, 0, 0, 0, 0, 191,
+		162, 0, 0, 0, 0, 0, 0, 7, 2, 0, 0, 248, 255, 255, 255, 24,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 133,
+		0, 0, 0, 1, 0, 0, 0, 21, 0, 5, 0, 0, 0, 0, 0, 15,
+		144, 0, 0, 0, 0, 0, 0, 183, 1, 0, 0, 1, 0, 0, 0, 115,
+		16, 0, 0, 0, 0, 0, 0, 183, 0, 0, 0, 0, 0, 0, 0, 149,
+		0, 0, 0, 0, 0, 0, 0, 191, 162, 0, 0, 0, 0, 0, 0, 7,
 		2, 0, 0, 248, 255, 255, 255, 24, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 133, 0, 0, 0, 1, 0, 0, 0, 21,
-		0, 5, 0, 0, 0, 0, 0, 15, 128, 0, 0, 0, 0, 0, 0, 183,
-		1, 0, 0, 1, 0, 0, 0, 115, 16, 0, 0, 0, 0, 0, 0, 183,
-		0, 0, 0, 0, 0, 0, 0, 149, 0, 0, 0, 0, 0, 0, 0, 191,
-		166, 0, 0, 0, 0, 0, 0, 7, 6, 0, 0, 248, 255, 255, 255, 24,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 191,
-		98, 0, 0, 0, 0, 0, 0, 24, 3, 0, 0, 105, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 24, 3, 0, 0, 109, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 183, 4, 0, 0, 0, 0, 0, 0, 133,
-		0, 0, 0, 2, 0, 0, 0, 24, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 191, 98, 0, 0, 0, 0, 0, 0, 133,
-		0, 0, 0, 1, 0, 0, 0, 85, 0, 237, 255, 0, 0, 0, 0, 97,
+		0, 0, 0, 2, 0, 0, 0, 21, 0, 10, 0, 0, 0, 0, 0, 183,
+		6, 0, 0, 1, 0, 0, 0, 99, 106, 240, 255, 0, 0, 0, 0, 191,
+		162, 0, 0, 0, 0, 0, 0, 7, 2, 0, 0, 240, 255, 255, 255, 24,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 133,
+		0, 0, 0, 1, 0, 0, 0, 21, 0, 237, 255, 0, 0, 0, 0, 219,
+		96, 0, 0, 0, 0, 0, 0, 5, 0, 235, 255, 0, 0, 0, 0, 191,
+		162, 0, 0, 0, 0, 0, 0, 7, 2, 0, 0, 248, 255, 255, 255, 24,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 133,
+		0, 0, 0, 1, 0, 0, 0, 85, 0, 226, 255, 0, 0, 0, 0, 97,
 		164, 248, 255, 0, 0, 0, 0, 97, 163, 252, 255, 0, 0, 0, 0, 191,
-		165, 0, 0, 0, 0, 0, 0, 7, 5, 0, 0, 176, 255, 255, 255, 24,
-		1, 0, 0, 105, 4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 183,
+		165, 0, 0, 0, 0, 0, 0, 7, 5, 0, 0, 168, 255, 255, 255, 24,
+		1, 0, 0, 109, 4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 183,
 		2, 0, 0, 72, 0, 0, 0, 133, 0, 0, 0, 6, 0, 0, 0, 5,
-		0, 231, 255, 0, 0, 0, 0, 68, 117, 97, 108, 32, 66, 83, 68, 47,
+		0, 220, 255, 0, 0, 0, 0, 133, 0, 0, 0, 14, 0, 0, 0, 191,
+		1, 0, 0, 0, 0, 0, 0, 119, 1, 0, 0, 32, 0, 0, 0, 99,
+		26, 252, 255, 0, 0, 0, 0, 103, 0, 0, 0, 32, 0, 0, 0, 119,
+		0, 0, 0, 32, 0, 0, 0, 93, 16, 5, 0, 0, 0, 0, 0, 191,
+		162, 0, 0, 0, 0, 0, 0, 7, 2, 0, 0, 252, 255, 255, 255, 24,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 133,
+		0, 0, 0, 3, 0, 0, 0, 183, 0, 0, 0, 0, 0, 0, 0, 149,
+		0, 0, 0, 0, 0, 0, 0, 68, 117, 97, 108, 32, 66, 83, 68, 47,
 		71, 80, 76, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 115, 101, 110, 100, 32, 101, 118, 101, 110, 116, 32, 112,
 		105, 100, 58, 32, 37, 117, 44, 32, 109, 110, 116, 110, 115, 58, 32, 37,
-		117, 44, 32, 99, 111, 109, 109, 58, 32, 37, 115, 10, 0, 0, 0, 0,
+		117, 44, 32, 99, 103, 114, 111, 117, 112, 58, 32, 37, 108, 108, 117, 10,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -4774,2875 +2698,1389 @@ var bpfObjects = map[string][]byte{
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 108, 111, 111,
-		107, 32, 117, 112, 32, 105, 116, 101, 109, 32, 105, 110, 32, 109, 110, 116,
-		110, 115, 95, 115, 121, 115, 99, 97, 108, 108, 115, 32, 109, 97, 112, 32,
-		102, 97, 105, 108, 101, 100, 32, 112, 105, 100, 58, 32, 37, 117, 44, 32,
-		109, 110, 116, 110, 115, 58, 32, 37, 117, 44, 32, 99, 111, 109, 109, 58,
-		32, 37, 115, 10, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 108, 111, 111, 107, 32, 117, 112, 32, 105, 116, 101, 109, 32, 105, 110,
+		32, 109, 110, 116, 110, 115, 95, 115, 121, 115, 99, 97, 108, 108, 115, 32,
+		109, 97, 112, 32, 102, 97, 105, 108, 101, 100, 32, 112, 105, 100, 58, 32,
+		37, 117, 44, 32, 109, 110, 116, 110, 115, 58, 32, 37, 117, 44, 32, 99,
+		111, 109, 109, 58, 32, 37, 115, 10, 0, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 159, 235, 1, 0, 24, 0, 0, 0, 0,
-		0, 0, 0, 240, 158, 0, 0, 240, 158, 0, 0, 139, 95, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 3, 0, 0, 0, 1, 0, 0, 0, 0,
-		0, 0, 1, 4, 0, 0, 0, 32, 0, 0, 1, 0, 0, 0, 0, 0,
-		0, 0, 3, 0, 0, 0, 0, 2, 0, 0, 0, 4, 0, 0, 0, 1,
-		0, 0, 0, 5, 0, 0, 0, 0, 0, 0, 1, 4, 0, 0, 0, 32,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 6, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 159,
+		235, 1, 0, 24, 0, 0, 0, 0, 0, 0, 0, 60, 68, 0, 0, 60,
+		68, 0, 0, 131, 51, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 3,
+		0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 1, 4, 0, 0, 0, 32,
+		0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 2,
+		0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0, 5, 0, 0, 0, 0,
+		0, 0, 1, 4, 0, 0, 0, 32, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 6, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
+		0, 0, 0, 2, 0, 0, 0, 4, 0, 0, 0, 0, 32, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 8, 0, 0, 0, 25, 0, 0, 0, 0,
+		0, 0, 8, 9, 0, 0, 0, 29, 0, 0, 0, 0, 0, 0, 8, 10,
+		0, 0, 0, 35, 0, 0, 0, 0, 0, 0, 1, 4, 0, 0, 0, 32,
+		0, 0, 0, 0, 0, 0, 0, 4, 0, 0, 4, 32, 0, 0, 0, 48,
+		0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 53, 0, 0, 0, 5,
+		0, 0, 0, 64, 0, 0, 0, 65, 0, 0, 0, 7, 0, 0, 0, 128,
+		0, 0, 0, 69, 0, 0, 0, 7, 0, 0, 0, 192, 0, 0, 0, 75,
+		0, 0, 0, 0, 0, 0, 14, 11, 0, 0, 0, 1, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 14, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 3, 0, 0, 0, 0, 2, 0, 0, 0, 4, 0, 0, 0, 27,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 16, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 2, 0, 0, 0, 4,
-		0, 0, 0, 0, 32, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 8,
-		0, 0, 0, 25, 0, 0, 0, 0, 0, 0, 8, 9, 0, 0, 0, 29,
-		0, 0, 0, 0, 0, 0, 8, 10, 0, 0, 0, 35, 0, 0, 0, 0,
-		0, 0, 1, 4, 0, 0, 0, 32, 0, 0, 0, 0, 0, 0, 0, 4,
-		0, 0, 4, 32, 0, 0, 0, 48, 0, 0, 0, 1, 0, 0, 0, 0,
-		0, 0, 0, 53, 0, 0, 0, 5, 0, 0, 0, 64, 0, 0, 0, 65,
-		0, 0, 0, 7, 0, 0, 0, 128, 0, 0, 0, 69, 0, 0, 0, 7,
-		0, 0, 0, 192, 0, 0, 0, 75, 0, 0, 0, 0, 0, 0, 14, 11,
-		0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 14,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 2,
-		0, 0, 0, 4, 0, 0, 0, 27, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 16, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
-		0, 0, 0, 2, 0, 0, 0, 4, 0, 0, 0, 0, 0, 0, 1, 0,
-		0, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 48, 0, 0, 0, 13,
-		0, 0, 0, 0, 0, 0, 0, 53, 0, 0, 0, 15, 0, 0, 0, 64,
-		0, 0, 0, 85, 0, 0, 0, 0, 0, 0, 14, 17, 0, 0, 0, 1,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 23, 0, 0, 0, 92,
-		0, 0, 0, 0, 0, 0, 8, 21, 0, 0, 0, 95, 0, 0, 0, 0,
-		0, 0, 8, 22, 0, 0, 0, 100, 0, 0, 0, 0, 0, 0, 1, 1,
-		0, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
-		0, 0, 0, 20, 0, 0, 0, 4, 0, 0, 0, 0, 4, 0, 0, 0,
-		0, 0, 0, 4, 0, 0, 4, 32, 0, 0, 0, 48, 0, 0, 0, 1,
-		0, 0, 0, 0, 0, 0, 0, 53, 0, 0, 0, 5, 0, 0, 0, 64,
-		0, 0, 0, 65, 0, 0, 0, 7, 0, 0, 0, 128, 0, 0, 0, 69,
-		0, 0, 0, 19, 0, 0, 0, 192, 0, 0, 0, 114, 0, 0, 0, 0,
-		0, 0, 14, 24, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 27, 0, 0, 0, 129, 0, 0, 0, 4, 0, 0, 4, 64,
-		0, 0, 0, 155, 0, 0, 0, 28, 0, 0, 0, 0, 0, 0, 0, 159,
-		0, 0, 0, 30, 0, 0, 0, 64, 0, 0, 0, 162, 0, 0, 0, 32,
-		0, 0, 0, 128, 0, 0, 0, 167, 0, 0, 0, 34, 0, 0, 0, 0,
-		2, 0, 0, 174, 0, 0, 0, 4, 0, 0, 4, 8, 0, 0, 0, 48,
-		0, 0, 0, 29, 0, 0, 0, 0, 0, 0, 0, 186, 0, 0, 0, 22,
-		0, 0, 0, 16, 0, 0, 0, 192, 0, 0, 0, 22, 0, 0, 0, 24,
-		0, 0, 0, 206, 0, 0, 0, 2, 0, 0, 0, 32, 0, 0, 0, 210,
-		0, 0, 0, 0, 0, 0, 1, 2, 0, 0, 0, 16, 0, 0, 0, 225,
-		0, 0, 0, 0, 0, 0, 1, 8, 0, 0, 0, 64, 0, 0, 1, 230,
-		0, 0, 0, 0, 0, 0, 1, 8, 0, 0, 0, 64, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 31, 0, 0, 0, 4,
-		0, 0, 0, 6, 0, 0, 0, 244, 0, 0, 0, 0, 0, 0, 1, 1,
-		0, 0, 0, 8, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 3, 0,
-		0, 0, 0, 33, 0, 0, 0, 4, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 1, 0, 0, 13, 2, 0, 0, 0, 162, 0, 0, 0, 26,
-		0, 0, 0, 249, 0, 0, 0, 1, 0, 0, 12, 35, 0, 0, 0, 83,
-		2, 0, 0, 240, 0, 0, 132, 0, 34, 0, 0, 95, 2, 0, 0, 38,
-		0, 0, 0, 0, 0, 0, 0, 107, 2, 0, 0, 10, 0, 0, 0, 0,
-		1, 0, 0, 115, 2, 0, 0, 44, 0, 0, 0, 64, 1, 0, 0, 121,
-		2, 0, 0, 45, 0, 0, 0, 128, 1, 0, 0, 186, 0, 0, 0, 10,
-		0, 0, 0, 160, 1, 0, 0, 127, 2, 0, 0, 10, 0, 0, 0, 192,
-		1, 0, 0, 134, 2, 0, 0, 2, 0, 0, 0, 224, 1, 0, 0, 141,
-		2, 0, 0, 49, 0, 0, 0, 0, 2, 0, 0, 152, 2, 0, 0, 10,
-		0, 0, 0, 128, 2, 0, 0, 164, 2, 0, 0, 31, 0, 0, 0, 192,
-		2, 0, 0, 184, 2, 0, 0, 55, 0, 0, 0, 0, 3, 0, 0, 195,
-		2, 0, 0, 2, 0, 0, 0, 64, 3, 0, 0, 211, 2, 0, 0, 2,
-		0, 0, 0, 96, 3, 0, 0, 220, 2, 0, 0, 2, 0, 0, 0, 128,
-		3, 0, 0, 226, 2, 0, 0, 2, 0, 0, 0, 160, 3, 0, 0, 231,
-		2, 0, 0, 2, 0, 0, 0, 192, 3, 0, 0, 243, 2, 0, 0, 2,
-		0, 0, 0, 224, 3, 0, 0, 255, 2, 0, 0, 10, 0, 0, 0, 0,
-		4, 0, 0, 11, 3, 0, 0, 56, 0, 0, 0, 0, 6, 0, 0, 14,
-		3, 0, 0, 82, 0, 0, 0, 0, 14, 0, 0, 17, 3, 0, 0, 84,
-		0, 0, 0, 128, 15, 0, 0, 20, 3, 0, 0, 94, 0, 0, 0, 128,
-		22, 0, 0, 32, 3, 0, 0, 58, 0, 0, 0, 192, 22, 0, 0, 42,
-		3, 0, 0, 31, 0, 0, 0, 128, 23, 0, 0, 54, 3, 0, 0, 10,
-		0, 0, 0, 192, 23, 0, 0, 70, 3, 0, 0, 78, 0, 0, 0, 0,
-		24, 0, 0, 87, 3, 0, 0, 121, 0, 0, 0, 0, 26, 0, 0, 93,
-		3, 0, 0, 122, 0, 0, 0, 0, 34, 0, 0, 111, 3, 0, 0, 10,
-		0, 0, 0, 64, 34, 0, 0, 122, 3, 0, 0, 10, 0, 0, 0, 96,
-		34, 0, 0, 129, 3, 0, 0, 2, 0, 0, 0, 128, 34, 0, 0, 145,
-		3, 0, 0, 124, 0, 0, 0, 192, 34, 0, 0, 154, 3, 0, 0, 127,
-		0, 0, 0, 0, 35, 0, 0, 168, 3, 0, 0, 126, 0, 0, 0, 64,
-		35, 0, 0, 178, 3, 0, 0, 44, 0, 0, 0, 64, 51, 0, 0, 196,
-		3, 0, 0, 29, 0, 0, 0, 128, 51, 0, 0, 215, 3, 0, 0, 29,
-		0, 0, 0, 144, 51, 0, 0, 231, 3, 0, 0, 2, 0, 0, 0, 160,
-		51, 0, 0, 253, 3, 0, 0, 130, 0, 0, 0, 192, 51, 0, 0, 21,
-		4, 0, 0, 60, 0, 0, 0, 0, 52, 0, 0, 36, 4, 0, 0, 132,
-		0, 0, 0, 128, 52, 0, 0, 53, 4, 0, 0, 31, 0, 0, 0, 192,
-		52, 0, 0, 69, 4, 0, 0, 20, 0, 0, 0, 0, 53, 0, 0, 87,
-		4, 0, 0, 20, 0, 0, 0, 8, 53, 0, 0, 101, 4, 0, 0, 2,
-		0, 0, 0, 32, 53, 0, 0, 120, 4, 0, 0, 60, 0, 0, 0, 64,
-		53, 0, 0, 143, 4, 0, 0, 2, 0, 0, 0, 192, 53, 0, 0, 162,
-		4, 0, 0, 2, 0, 0, 0, 224, 53, 0, 0, 177, 4, 0, 0, 130,
-		0, 0, 0, 0, 54, 0, 0, 196, 4, 0, 0, 60, 0, 0, 0, 64,
-		54, 0, 0, 213, 4, 0, 0, 60, 0, 0, 0, 192, 54, 0, 0, 227,
-		4, 0, 0, 2, 0, 0, 0, 64, 55, 0, 0, 240, 4, 0, 0, 133,
-		0, 0, 0, 128, 55, 0, 0, 251, 4, 0, 0, 60, 0, 0, 0, 128,
-		56, 0, 0, 1, 5, 0, 0, 134, 0, 0, 0, 0, 57, 0, 0, 16,
-		5, 0, 0, 58, 0, 0, 0, 64, 58, 0, 0, 34, 5, 0, 0, 135,
-		0, 0, 0, 0, 59, 0, 0, 37, 5, 0, 0, 135, 0, 0, 0, 64,
-		59, 0, 0, 47, 5, 0, 0, 197, 0, 0, 0, 128, 59, 0, 0, 56,
-		5, 0, 0, 212, 0, 0, 0, 192, 60, 0, 0, 65, 5, 0, 0, 2,
-		0, 0, 0, 96, 61, 0, 0, 76, 5, 0, 0, 2, 0, 0, 0, 128,
-		61, 0, 0, 86, 5, 0, 0, 2, 0, 0, 0, 160, 61, 0, 0, 98,
-		5, 0, 0, 2, 0, 0, 0, 192, 61, 0, 0, 112, 5, 0, 0, 31,
-		0, 0, 0, 0, 62, 0, 0, 119, 5, 0, 0, 10, 0, 0, 0, 64,
-		62, 0, 0, 131, 5, 0, 0, 10, 0, 0, 0, 96, 62, 0, 1, 151,
-		5, 0, 0, 10, 0, 0, 0, 97, 62, 0, 1, 177, 5, 0, 0, 10,
-		0, 0, 0, 98, 62, 0, 1, 192, 5, 0, 0, 10, 0, 0, 0, 99,
-		62, 0, 1, 215, 5, 0, 0, 10, 0, 0, 0, 128, 62, 0, 1, 235,
-		5, 0, 0, 10, 0, 0, 0, 129, 62, 0, 1, 245, 5, 0, 0, 10,
-		0, 0, 0, 130, 62, 0, 1, 255, 5, 0, 0, 10, 0, 0, 0, 131,
-		62, 0, 1, 13, 6, 0, 0, 10, 0, 0, 0, 132, 62, 0, 1, 33,
-		6, 0, 0, 10, 0, 0, 0, 133, 62, 0, 1, 40, 6, 0, 0, 10,
-		0, 0, 0, 134, 62, 0, 1, 53, 6, 0, 0, 10, 0, 0, 0, 135,
-		62, 0, 1, 65, 6, 0, 0, 10, 0, 0, 0, 136, 62, 0, 1, 79,
-		6, 0, 0, 10, 0, 0, 0, 137, 62, 0, 1, 90, 6, 0, 0, 10,
-		0, 0, 0, 138, 62, 0, 1, 106, 6, 0, 0, 31, 0, 0, 0, 192,
-		62, 0, 0, 119, 6, 0, 0, 214, 0, 0, 0, 0, 63, 0, 0, 206,
-		0, 0, 0, 229, 0, 0, 0, 192, 64, 0, 0, 133, 6, 0, 0, 229,
-		0, 0, 0, 224, 64, 0, 0, 138, 6, 0, 0, 55, 0, 0, 0, 0,
-		65, 0, 0, 150, 6, 0, 0, 55, 0, 0, 0, 64, 65, 0, 0, 157,
-		6, 0, 0, 60, 0, 0, 0, 128, 65, 0, 0, 166, 6, 0, 0, 60,
-		0, 0, 0, 0, 66, 0, 0, 174, 6, 0, 0, 55, 0, 0, 0, 128,
-		66, 0, 0, 187, 6, 0, 0, 60, 0, 0, 0, 192, 66, 0, 0, 195,
-		6, 0, 0, 60, 0, 0, 0, 64, 67, 0, 0, 208, 6, 0, 0, 166,
-		0, 0, 0, 192, 67, 0, 0, 219, 6, 0, 0, 240, 0, 0, 0, 0,
-		68, 0, 0, 229, 6, 0, 0, 60, 0, 0, 0, 0, 70, 0, 0, 242,
-		6, 0, 0, 60, 0, 0, 0, 128, 70, 0, 0, 254, 6, 0, 0, 241,
-		0, 0, 0, 0, 71, 0, 0, 9, 7, 0, 0, 242, 0, 0, 0, 64,
-		71, 0, 0, 23, 7, 0, 0, 242, 0, 0, 0, 128, 71, 0, 0, 39,
-		7, 0, 0, 44, 0, 0, 0, 192, 71, 0, 0, 54, 7, 0, 0, 39,
-		0, 0, 0, 0, 72, 0, 0, 60, 7, 0, 0, 39, 0, 0, 0, 64,
-		72, 0, 0, 66, 7, 0, 0, 39, 0, 0, 0, 128, 72, 0, 0, 72,
-		7, 0, 0, 243, 0, 0, 0, 192, 72, 0, 0, 85, 7, 0, 0, 244,
-		0, 0, 0, 128, 73, 0, 0, 91, 7, 0, 0, 47, 0, 0, 0, 0,
-		75, 0, 0, 105, 7, 0, 0, 31, 0, 0, 0, 64, 75, 0, 0, 111,
-		7, 0, 0, 31, 0, 0, 0, 128, 75, 0, 0, 118, 7, 0, 0, 39,
-		0, 0, 0, 192, 75, 0, 0, 129, 7, 0, 0, 39, 0, 0, 0, 0,
-		76, 0, 0, 144, 7, 0, 0, 31, 0, 0, 0, 64, 76, 0, 0, 152,
-		7, 0, 0, 31, 0, 0, 0, 128, 76, 0, 0, 160, 7, 0, 0, 246,
-		0, 0, 0, 192, 76, 0, 0, 176, 7, 0, 0, 250, 0, 0, 0, 64,
-		79, 0, 0, 197, 7, 0, 0, 172, 0, 0, 0, 0, 80, 0, 0, 210,
-		7, 0, 0, 172, 0, 0, 0, 64, 80, 0, 0, 220, 7, 0, 0, 172,
-		0, 0, 0, 128, 80, 0, 0, 225, 7, 0, 0, 3, 1, 0, 0, 192,
-		80, 0, 0, 246, 7, 0, 0, 147, 1, 0, 0, 0, 81, 0, 0, 251,
-		7, 0, 0, 148, 1, 0, 0, 128, 81, 0, 0, 5, 8, 0, 0, 149,
-		1, 0, 0, 192, 81, 0, 0, 13, 8, 0, 0, 151, 1, 0, 0, 0,
-		82, 0, 0, 21, 8, 0, 0, 152, 1, 0, 0, 128, 82, 0, 0, 24,
-		8, 0, 0, 153, 1, 0, 0, 192, 82, 0, 0, 30, 8, 0, 0, 154,
-		1, 0, 0, 0, 83, 0, 0, 39, 8, 0, 0, 155, 1, 0, 0, 64,
-		83, 0, 0, 47, 8, 0, 0, 156, 1, 0, 0, 128, 83, 0, 0, 54,
-		8, 0, 0, 157, 1, 0, 0, 192, 83, 0, 0, 62, 8, 0, 0, 158,
-		1, 0, 0, 0, 84, 0, 0, 70, 8, 0, 0, 158, 1, 0, 0, 64,
-		84, 0, 0, 83, 8, 0, 0, 158, 1, 0, 0, 128, 84, 0, 0, 97,
-		8, 0, 0, 161, 1, 0, 0, 192, 84, 0, 0, 105, 8, 0, 0, 31,
-		0, 0, 0, 128, 85, 0, 0, 115, 8, 0, 0, 28, 1, 0, 0, 192,
-		85, 0, 0, 127, 8, 0, 0, 10, 0, 0, 0, 0, 86, 0, 0, 140,
-		8, 0, 0, 112, 0, 0, 0, 64, 86, 0, 0, 151, 8, 0, 0, 162,
-		1, 0, 0, 128, 86, 0, 0, 165, 8, 0, 0, 168, 0, 0, 0, 192,
-		86, 0, 0, 174, 8, 0, 0, 10, 0, 0, 0, 224, 86, 0, 0, 184,
-		8, 0, 0, 163, 1, 0, 0, 0, 87, 0, 0, 192, 8, 0, 0, 165,
-		1, 0, 0, 128, 87, 0, 0, 209, 8, 0, 0, 39, 0, 0, 0, 128,
-		87, 0, 0, 224, 8, 0, 0, 39, 0, 0, 0, 192, 87, 0, 0, 237,
-		8, 0, 0, 150, 0, 0, 0, 0, 88, 0, 0, 248, 8, 0, 0, 70,
-		0, 0, 0, 32, 88, 0, 0, 0, 9, 0, 0, 166, 1, 0, 0, 64,
-		88, 0, 0, 7, 9, 0, 0, 65, 0, 0, 0, 128, 88, 0, 0, 18,
-		9, 0, 0, 55, 0, 0, 0, 0, 89, 0, 0, 30, 9, 0, 0, 168,
-		1, 0, 0, 64, 89, 0, 0, 44, 9, 0, 0, 44, 0, 0, 0, 128,
-		89, 0, 0, 57, 9, 0, 0, 169, 1, 0, 0, 192, 89, 0, 0, 66,
-		9, 0, 0, 170, 1, 0, 0, 0, 90, 0, 0, 71, 9, 0, 0, 171,
-		1, 0, 0, 64, 90, 0, 0, 85, 9, 0, 0, 172, 1, 0, 0, 128,
-		90, 0, 0, 102, 9, 0, 0, 173, 1, 0, 0, 192, 90, 0, 0, 113,
-		9, 0, 0, 174, 1, 0, 0, 0, 91, 0, 0, 129, 9, 0, 0, 31,
-		0, 0, 0, 64, 91, 0, 0, 144, 9, 0, 0, 175, 1, 0, 0, 128,
-		91, 0, 0, 157, 9, 0, 0, 177, 1, 0, 0, 192, 91, 0, 0, 162,
-		9, 0, 0, 10, 0, 0, 0, 128, 93, 0, 0, 172, 9, 0, 0, 39,
-		0, 0, 0, 192, 93, 0, 0, 186, 9, 0, 0, 39, 0, 0, 0, 0,
-		94, 0, 0, 199, 9, 0, 0, 39, 0, 0, 0, 64, 94, 0, 0, 212,
-		9, 0, 0, 178, 1, 0, 0, 128, 94, 0, 0, 225, 9, 0, 0, 181,
-		1, 0, 0, 128, 96, 0, 0, 242, 9, 0, 0, 2, 0, 0, 0, 160,
-		96, 0, 0, 10, 10, 0, 0, 2, 0, 0, 0, 192, 96, 0, 0, 35,
-		10, 0, 0, 183, 1, 0, 0, 0, 97, 0, 0, 43, 10, 0, 0, 60,
-		0, 0, 0, 64, 97, 0, 0, 51, 10, 0, 0, 184, 1, 0, 0, 192,
-		97, 0, 0, 63, 10, 0, 0, 185, 1, 0, 0, 0, 98, 0, 0, 82,
-		10, 0, 0, 60, 0, 0, 0, 64, 98, 0, 0, 96, 10, 0, 0, 186,
-		1, 0, 0, 192, 98, 0, 0, 111, 10, 0, 0, 154, 0, 0, 0, 0,
-		99, 0, 0, 128, 10, 0, 0, 10, 0, 0, 0, 0, 100, 0, 0, 140,
-		10, 0, 0, 193, 1, 0, 0, 64, 100, 0, 0, 156, 10, 0, 0, 154,
-		0, 0, 0, 192, 100, 0, 0, 173, 10, 0, 0, 60, 0, 0, 0, 192,
-		101, 0, 0, 189, 10, 0, 0, 31, 0, 0, 0, 64, 102, 0, 0, 208,
-		10, 0, 0, 208, 0, 0, 0, 128, 102, 0, 0, 218, 10, 0, 0, 16,
-		1, 0, 0, 192, 102, 0, 0, 226, 10, 0, 0, 16, 1, 0, 0, 208,
-		102, 0, 0, 241, 10, 0, 0, 2, 0, 0, 0, 224, 102, 0, 0, 255,
-		10, 0, 0, 10, 0, 0, 0, 0, 103, 0, 0, 16, 11, 0, 0, 10,
-		0, 0, 0, 32, 103, 0, 0, 37, 11, 0, 0, 2, 0, 0, 0, 64,
-		103, 0, 0, 56, 11, 0, 0, 31, 0, 0, 0, 128, 103, 0, 0, 75,
-		11, 0, 0, 39, 0, 0, 0, 192, 103, 0, 0, 86, 11, 0, 0, 39,
-		0, 0, 0, 0, 104, 0, 0, 111, 11, 0, 0, 39, 0, 0, 0, 64,
-		104, 0, 0, 133, 11, 0, 0, 111, 0, 0, 0, 128, 104, 0, 0, 143,
-		11, 0, 0, 196, 1, 0, 0, 0, 105, 0, 0, 154, 11, 0, 0, 197,
-		1, 0, 0, 64, 105, 0, 0, 166, 11, 0, 0, 31, 0, 0, 0, 128,
-		105, 0, 0, 184, 11, 0, 0, 198, 1, 0, 0, 192, 105, 0, 0, 205,
-		11, 0, 0, 31, 0, 0, 0, 128, 106, 0, 0, 225, 11, 0, 0, 199,
-		1, 0, 0, 192, 106, 0, 0, 230, 11, 0, 0, 8, 0, 0, 0, 0,
-		107, 0, 0, 239, 11, 0, 0, 31, 0, 0, 0, 64, 107, 0, 0, 255,
-		11, 0, 0, 200, 1, 0, 0, 128, 107, 0, 0, 0, 0, 0, 0, 201,
-		1, 0, 0, 128, 107, 0, 0, 7, 12, 0, 0, 202, 1, 0, 0, 0,
-		108, 0, 0, 19, 12, 0, 0, 203, 1, 0, 0, 64, 108, 0, 0, 29,
-		12, 0, 0, 205, 1, 0, 0, 192, 108, 0, 0, 36, 12, 0, 0, 2,
-		0, 0, 0, 0, 109, 0, 0, 47, 12, 0, 0, 2, 0, 0, 0, 32,
-		109, 0, 0, 64, 12, 0, 0, 31, 0, 0, 0, 64, 109, 0, 0, 82,
-		12, 0, 0, 2, 0, 0, 0, 128, 109, 0, 0, 103, 12, 0, 0, 208,
-		1, 0, 0, 192, 109, 0, 0, 118, 12, 0, 0, 39, 0, 0, 0, 192,
-		229, 0, 0, 133, 12, 0, 0, 39, 0, 0, 0, 0, 230, 0, 0, 156,
-		12, 0, 0, 209, 1, 0, 0, 64, 230, 0, 0, 167, 12, 0, 0, 2,
-		0, 0, 0, 128, 230, 0, 0, 182, 12, 0, 0, 2, 0, 0, 0, 160,
-		230, 0, 0, 197, 12, 0, 0, 210, 1, 0, 0, 192, 230, 0, 0, 207,
-		12, 0, 0, 41, 0, 0, 0, 0, 231, 0, 0, 224, 12, 0, 0, 47,
-		0, 0, 0, 64, 231, 0, 0, 238, 12, 0, 0, 47, 0, 0, 0, 96,
-		231, 0, 0, 2, 13, 0, 0, 31, 0, 0, 0, 128, 231, 0, 0, 8,
-		13, 0, 0, 31, 0, 0, 0, 192, 231, 0, 0, 24, 13, 0, 0, 211,
-		1, 0, 0, 0, 232, 0, 0, 37, 13, 0, 0, 212, 1, 0, 0, 64,
-		232, 0, 0, 56, 13, 0, 0, 2, 0, 0, 0, 96, 232, 0, 0, 72,
-		13, 0, 0, 10, 0, 0, 0, 128, 232, 0, 0, 97, 13, 0, 0, 211,
-		1, 0, 0, 192, 232, 0, 0, 110, 13, 0, 0, 4, 2, 0, 0, 0,
-		233, 0, 0, 125, 13, 0, 0, 5, 2, 0, 0, 64, 233, 0, 0, 131,
-		13, 0, 0, 10, 0, 0, 0, 128, 233, 0, 0, 145, 13, 0, 0, 10,
-		0, 0, 0, 160, 233, 0, 0, 163, 13, 0, 0, 6, 2, 0, 0, 192,
-		233, 0, 0, 173, 13, 0, 0, 2, 0, 0, 0, 192, 233, 0, 0, 192,
-		13, 0, 0, 55, 0, 0, 0, 0, 234, 0, 0, 208, 13, 0, 0, 221,
-		1, 0, 0, 64, 234, 0, 0, 225, 13, 0, 0, 7, 2, 0, 0, 128,
-		235, 0, 0, 239, 13, 0, 0, 45, 0, 0, 0, 192, 235, 0, 0, 254,
-		13, 0, 0, 44, 0, 0, 0, 0, 236, 0, 0, 7, 14, 0, 0, 8,
-		2, 0, 0, 64, 236, 0, 0, 19, 14, 0, 0, 9, 2, 0, 0, 128,
-		236, 0, 0, 27, 14, 0, 0, 10, 2, 0, 0, 192, 236, 0, 0, 47,
-		14, 0, 0, 11, 2, 0, 0, 0, 237, 0, 0, 95, 2, 0, 0, 4,
-		0, 0, 4, 32, 0, 0, 0, 186, 0, 0, 0, 31, 0, 0, 0, 0,
-		0, 0, 0, 54, 14, 0, 0, 39, 0, 0, 0, 64, 0, 0, 0, 0,
-		0, 0, 0, 42, 0, 0, 0, 128, 0, 0, 0, 60, 14, 0, 0, 8,
-		0, 0, 0, 192, 0, 0, 0, 64, 14, 0, 0, 0, 0, 0, 8, 40,
-		0, 0, 0, 68, 14, 0, 0, 0, 0, 0, 8, 41, 0, 0, 0, 74,
-		14, 0, 0, 0, 0, 0, 1, 8, 0, 0, 0, 64, 0, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 5, 8, 0, 0, 0, 192, 0, 0, 0, 39,
-		0, 0, 0, 0, 0, 0, 0, 93, 14, 0, 0, 43, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 4, 8, 0, 0, 0, 101,
-		14, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 107, 14, 0, 0, 8,
-		0, 0, 0, 32, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 0,
-		0, 0, 0, 120, 14, 0, 0, 0, 0, 0, 8, 46, 0, 0, 0, 131,
-		14, 0, 0, 1, 0, 0, 4, 4, 0, 0, 0, 147, 14, 0, 0, 47,
-		0, 0, 0, 0, 0, 0, 0, 152, 14, 0, 0, 0, 0, 0, 8, 48,
-		0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 4, 4, 0, 0, 0, 161,
-		14, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 169, 14, 0, 0, 4,
-		0, 0, 4, 16, 0, 0, 0, 188, 14, 0, 0, 50, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 52, 0, 0, 0, 64, 0, 0, 0, 194,
-		14, 0, 0, 53, 0, 0, 0, 96, 0, 0, 0, 198, 14, 0, 0, 53,
-		0, 0, 0, 112, 0, 0, 0, 202, 14, 0, 0, 1, 0, 0, 4, 8,
-		0, 0, 0, 213, 14, 0, 0, 51, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 50, 0, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 5, 4, 0, 0, 0, 218, 14, 0, 0, 10, 0, 0, 0, 0,
-		0, 0, 0, 226, 14, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 234,
-		14, 0, 0, 0, 0, 0, 8, 54, 0, 0, 0, 238, 14, 0, 0, 0,
-		0, 0, 8, 29, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 37,
-		0, 0, 0, 244, 14, 0, 0, 15, 0, 0, 4, 0, 1, 0, 0, 1,
-		15, 0, 0, 57, 0, 0, 0, 0, 0, 0, 0, 6, 15, 0, 0, 58,
-		0, 0, 0, 128, 0, 0, 0, 15, 15, 0, 0, 60, 0, 0, 0, 64,
-		1, 0, 0, 220, 2, 0, 0, 10, 0, 0, 0, 192, 1, 0, 0, 26,
-		15, 0, 0, 39, 0, 0, 0, 0, 2, 0, 0, 37, 15, 0, 0, 39,
-		0, 0, 0, 64, 2, 0, 0, 54, 15, 0, 0, 39, 0, 0, 0, 128,
-		2, 0, 0, 63, 15, 0, 0, 39, 0, 0, 0, 192, 2, 0, 0, 85,
-		15, 0, 0, 39, 0, 0, 0, 0, 3, 0, 0, 99, 15, 0, 0, 2,
-		0, 0, 0, 64, 3, 0, 0, 150, 6, 0, 0, 62, 0, 0, 0, 128,
-		3, 0, 0, 105, 15, 0, 0, 63, 0, 0, 0, 192, 3, 0, 0, 112,
-		15, 0, 0, 63, 0, 0, 0, 0, 4, 0, 0, 117, 15, 0, 0, 31,
-		0, 0, 0, 64, 4, 0, 0, 133, 15, 0, 0, 67, 0, 0, 0, 0,
-		6, 0, 0, 137, 15, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 149,
-		15, 0, 0, 31, 0, 0, 0, 0, 0, 0, 0, 156, 15, 0, 0, 8,
-		0, 0, 0, 64, 0, 0, 0, 167, 15, 0, 0, 3, 0, 0, 4, 24,
-		0, 0, 0, 175, 15, 0, 0, 31, 0, 0, 0, 0, 0, 0, 0, 193,
-		15, 0, 0, 59, 0, 0, 0, 64, 0, 0, 0, 202, 15, 0, 0, 59,
-		0, 0, 0, 128, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 58,
-		0, 0, 0, 210, 15, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 213,
-		14, 0, 0, 61, 0, 0, 0, 0, 0, 0, 0, 220, 15, 0, 0, 61,
-		0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 60,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 56, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 64, 0, 0, 0, 105, 15, 0, 0, 37,
-		0, 0, 4, 192, 1, 0, 0, 1, 15, 0, 0, 57, 0, 0, 0, 0,
-		0, 0, 0, 225, 15, 0, 0, 10, 0, 0, 0, 128, 0, 0, 0, 236,
-		15, 0, 0, 10, 0, 0, 0, 160, 0, 0, 0, 249, 15, 0, 0, 10,
-		0, 0, 0, 192, 0, 0, 0, 9, 16, 0, 0, 10, 0, 0, 0, 224,
-		0, 0, 0, 27, 16, 0, 0, 39, 0, 0, 0, 0, 1, 0, 0, 38,
-		16, 0, 0, 39, 0, 0, 0, 64, 1, 0, 0, 51, 16, 0, 0, 10,
-		0, 0, 0, 128, 1, 0, 0, 65, 16, 0, 0, 39, 0, 0, 0, 192,
-		1, 0, 0, 81, 16, 0, 0, 65, 0, 0, 0, 0, 2, 0, 0, 96,
-		16, 0, 0, 62, 0, 0, 0, 128, 2, 0, 0, 213, 14, 0, 0, 62,
-		0, 0, 0, 192, 2, 0, 0, 101, 16, 0, 0, 62, 0, 0, 0, 0,
-		3, 0, 0, 106, 16, 0, 0, 62, 0, 0, 0, 64, 3, 0, 0, 111,
-		16, 0, 0, 10, 0, 0, 0, 128, 3, 0, 0, 133, 15, 0, 0, 67,
-		0, 0, 0, 0, 4, 0, 0, 126, 16, 0, 0, 69, 0, 0, 0, 0,
-		6, 0, 0, 134, 16, 0, 0, 31, 0, 0, 0, 0, 8, 0, 0, 154,
-		16, 0, 0, 30, 0, 0, 0, 64, 8, 0, 0, 164, 16, 0, 0, 30,
-		0, 0, 0, 128, 8, 0, 0, 182, 16, 0, 0, 31, 0, 0, 0, 192,
-		8, 0, 0, 189, 16, 0, 0, 39, 0, 0, 0, 0, 9, 0, 0, 208,
-		16, 0, 0, 62, 0, 0, 0, 64, 9, 0, 0, 220, 16, 0, 0, 77,
-		0, 0, 0, 128, 9, 0, 0, 223, 16, 0, 0, 2, 0, 0, 0, 192,
-		9, 0, 0, 231, 16, 0, 0, 60, 0, 0, 0, 0, 10, 0, 0, 248,
-		16, 0, 0, 78, 0, 0, 0, 128, 10, 0, 0, 251, 16, 0, 0, 2,
-		0, 0, 0, 192, 10, 0, 0, 0, 17, 0, 0, 2, 0, 0, 0, 224,
-		10, 0, 0, 16, 17, 0, 0, 79, 0, 0, 0, 0, 11, 0, 0, 34,
-		17, 0, 0, 39, 0, 0, 0, 64, 11, 0, 0, 54, 17, 0, 0, 39,
-		0, 0, 0, 128, 11, 0, 0, 70, 17, 0, 0, 39, 0, 0, 0, 192,
-		11, 0, 0, 91, 17, 0, 0, 39, 0, 0, 0, 0, 12, 0, 0, 117,
-		17, 0, 0, 2, 0, 0, 0, 64, 12, 0, 0, 127, 17, 0, 0, 2,
-		0, 0, 0, 96, 12, 0, 0, 142, 17, 0, 0, 60, 0, 0, 0, 128,
-		12, 0, 0, 157, 17, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 172,
-		17, 0, 0, 66, 0, 0, 0, 0, 0, 0, 0, 180, 17, 0, 0, 59,
-		0, 0, 0, 64, 0, 0, 0, 172, 17, 0, 0, 1, 0, 0, 4, 8,
-		0, 0, 0, 167, 15, 0, 0, 59, 0, 0, 0, 0, 0, 0, 0, 192,
-		17, 0, 0, 9, 0, 0, 4, 64, 0, 0, 0, 202, 17, 0, 0, 39,
-		0, 0, 0, 0, 0, 0, 0, 219, 17, 0, 0, 39, 0, 0, 0, 64,
-		0, 0, 0, 228, 17, 0, 0, 39, 0, 0, 0, 128, 0, 0, 0, 241,
-		17, 0, 0, 8, 0, 0, 0, 192, 0, 0, 0, 250, 17, 0, 0, 8,
-		0, 0, 0, 224, 0, 0, 0, 9, 18, 0, 0, 31, 0, 0, 0, 0,
-		1, 0, 0, 18, 18, 0, 0, 31, 0, 0, 0, 64, 1, 0, 0, 31,
-		18, 0, 0, 31, 0, 0, 0, 128, 1, 0, 0, 40, 18, 0, 0, 68,
-		0, 0, 0, 192, 1, 0, 0, 40, 18, 0, 0, 2, 0, 0, 4, 8,
-		0, 0, 0, 49, 18, 0, 0, 10, 0, 0, 0, 0, 0, 0, 0, 58,
-		18, 0, 0, 10, 0, 0, 0, 32, 0, 0, 0, 0, 0, 0, 0, 5,
-		0, 0, 4, 64, 0, 0, 0, 63, 18, 0, 0, 70, 0, 0, 0, 0,
-		0, 0, 0, 68, 18, 0, 0, 2, 0, 0, 0, 32, 0, 0, 0, 9,
-		18, 0, 0, 31, 0, 0, 0, 64, 0, 0, 0, 31, 18, 0, 0, 31,
-		0, 0, 0, 128, 0, 0, 0, 18, 18, 0, 0, 31, 0, 0, 0, 192,
-		0, 0, 0, 71, 18, 0, 0, 0, 0, 0, 8, 71, 0, 0, 0, 86,
-		18, 0, 0, 1, 0, 0, 4, 4, 0, 0, 0, 99, 18, 0, 0, 72,
-		0, 0, 0, 0, 0, 0, 0, 108, 18, 0, 0, 0, 0, 0, 8, 73,
-		0, 0, 0, 124, 18, 0, 0, 1, 0, 0, 4, 4, 0, 0, 0, 0,
-		0, 0, 0, 74, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3,
-		0, 0, 5, 4, 0, 0, 0, 134, 18, 0, 0, 47, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 75, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 76, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 4, 2, 0, 0, 0, 138, 18, 0, 0, 20, 0, 0, 0, 0,
-		0, 0, 0, 97, 8, 0, 0, 20, 0, 0, 0, 8, 0, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 4, 4, 0, 0, 0, 145, 18, 0, 0, 53,
-		0, 0, 0, 0, 0, 0, 0, 160, 18, 0, 0, 53, 0, 0, 0, 16,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 178, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 96, 0, 0, 0, 165, 18, 0, 0, 0,
-		0, 0, 8, 80, 0, 0, 0, 169, 18, 0, 0, 0, 0, 0, 8, 81,
-		0, 0, 0, 175, 18, 0, 0, 0, 0, 0, 1, 8, 0, 0, 0, 64,
-		0, 0, 1, 185, 18, 0, 0, 7, 0, 0, 4, 48, 0, 0, 0, 201,
-		18, 0, 0, 60, 0, 0, 0, 0, 0, 0, 0, 210, 18, 0, 0, 31,
-		0, 0, 0, 128, 0, 0, 0, 218, 18, 0, 0, 31, 0, 0, 0, 192,
-		0, 0, 0, 233, 18, 0, 0, 10, 0, 0, 0, 0, 1, 0, 0, 220,
-		2, 0, 0, 29, 0, 0, 0, 32, 1, 0, 0, 223, 16, 0, 0, 29,
-		0, 0, 0, 48, 1, 0, 0, 244, 18, 0, 0, 83, 0, 0, 0, 64,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 82, 0, 0, 0, 249,
-		18, 0, 0, 16, 0, 0, 132, 224, 0, 0, 0, 167, 15, 0, 0, 58,
-		0, 0, 0, 0, 0, 0, 0, 9, 19, 0, 0, 39, 0, 0, 0, 192,
-		0, 0, 0, 20, 19, 0, 0, 39, 0, 0, 0, 0, 1, 0, 0, 32,
-		19, 0, 0, 39, 0, 0, 0, 64, 1, 0, 0, 42, 19, 0, 0, 39,
-		0, 0, 0, 128, 1, 0, 0, 48, 19, 0, 0, 39, 0, 0, 0, 192,
-		1, 0, 0, 59, 19, 0, 0, 79, 0, 0, 0, 0, 2, 0, 0, 67,
-		19, 0, 0, 39, 0, 0, 0, 64, 2, 0, 0, 186, 0, 0, 0, 10,
-		0, 0, 0, 128, 2, 0, 0, 76, 19, 0, 0, 10, 0, 0, 0, 160,
-		2, 0, 1, 89, 19, 0, 0, 10, 0, 0, 0, 161, 2, 0, 1, 100,
-		19, 0, 0, 10, 0, 0, 0, 162, 2, 0, 1, 118, 19, 0, 0, 10,
-		0, 0, 0, 163, 2, 0, 1, 129, 19, 0, 0, 85, 0, 0, 0, 192,
-		2, 0, 0, 138, 19, 0, 0, 85, 0, 0, 0, 192, 4, 0, 0, 153,
-		19, 0, 0, 93, 0, 0, 0, 192, 6, 0, 0, 159, 19, 0, 0, 8,
-		0, 0, 4, 64, 0, 0, 0, 167, 19, 0, 0, 86, 0, 0, 0, 0,
-		0, 0, 0, 172, 19, 0, 0, 87, 0, 0, 0, 0, 1, 0, 0, 185,
-		19, 0, 0, 88, 0, 0, 0, 64, 1, 0, 0, 194, 19, 0, 0, 92,
-		0, 0, 0, 128, 1, 0, 0, 199, 19, 0, 0, 20, 0, 0, 0, 192,
-		1, 0, 0, 205, 19, 0, 0, 20, 0, 0, 0, 200, 1, 0, 0, 212,
-		19, 0, 0, 20, 0, 0, 0, 208, 1, 0, 0, 220, 19, 0, 0, 20,
-		0, 0, 0, 216, 1, 0, 0, 228, 19, 0, 0, 2, 0, 0, 4, 32,
-		0, 0, 0, 167, 19, 0, 0, 58, 0, 0, 0, 0, 0, 0, 0, 244,
-		19, 0, 0, 87, 0, 0, 0, 192, 0, 0, 0, 252, 19, 0, 0, 0,
-		0, 0, 8, 79, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 89,
-		0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 13, 90, 0, 0, 0, 0,
-		0, 0, 0, 91, 0, 0, 0, 4, 20, 0, 0, 2, 0, 0, 6, 4,
-		0, 0, 0, 20, 20, 0, 0, 0, 0, 0, 0, 38, 20, 0, 0, 1,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 85, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 197, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 84, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 95,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 33, 4, 0, 0, 54,
-		20, 0, 0, 13, 0, 0, 4, 128, 2, 0, 0, 65, 20, 0, 0, 97,
-		0, 0, 0, 0, 0, 0, 0, 11, 3, 0, 0, 117, 0, 0, 0, 64,
-		6, 0, 0, 105, 15, 0, 0, 118, 0, 0, 0, 128, 6, 0, 0, 69,
-		20, 0, 0, 31, 0, 0, 0, 192, 6, 0, 0, 251, 16, 0, 0, 2,
-		0, 0, 0, 0, 7, 0, 0, 9, 18, 0, 0, 103, 0, 0, 0, 0,
-		8, 0, 0, 76, 20, 0, 0, 111, 0, 0, 0, 64, 8, 0, 0, 80,
-		20, 0, 0, 60, 0, 0, 0, 192, 8, 0, 0, 150, 6, 0, 0, 78,
-		0, 0, 0, 64, 9, 0, 0, 85, 20, 0, 0, 60, 0, 0, 0, 128,
-		9, 0, 0, 157, 6, 0, 0, 60, 0, 0, 0, 0, 10, 0, 0, 94,
-		20, 0, 0, 119, 0, 0, 0, 128, 10, 0, 0, 104, 20, 0, 0, 120,
-		0, 0, 0, 192, 10, 0, 0, 118, 20, 0, 0, 13, 0, 0, 4, 200,
-		0, 0, 0, 138, 20, 0, 0, 98, 0, 0, 0, 0, 0, 0, 0, 145,
-		20, 0, 0, 99, 0, 0, 0, 64, 0, 0, 0, 148, 20, 0, 0, 100,
-		0, 0, 0, 128, 0, 0, 0, 166, 6, 0, 0, 60, 0, 0, 0, 0,
-		1, 0, 0, 157, 6, 0, 0, 60, 0, 0, 0, 128, 1, 0, 0, 155,
-		20, 0, 0, 60, 0, 0, 0, 0, 2, 0, 0, 159, 0, 0, 0, 2,
-		0, 0, 0, 128, 2, 0, 0, 186, 0, 0, 0, 10, 0, 0, 0, 160,
-		2, 0, 0, 170, 20, 0, 0, 39, 0, 0, 0, 192, 2, 0, 0, 180,
-		20, 0, 0, 47, 0, 0, 0, 0, 3, 0, 0, 191, 20, 0, 0, 102,
-		0, 0, 0, 64, 3, 0, 0, 204, 20, 0, 0, 110, 0, 0, 0, 64,
-		4, 0, 0, 150, 6, 0, 0, 116, 0, 0, 0, 0, 6, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 209, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 219, 3, 0, 0, 218, 20, 0, 0, 2, 0, 0, 4, 16,
-		0, 0, 0, 229, 20, 0, 0, 31, 0, 0, 0, 0, 0, 0, 0, 246,
-		20, 0, 0, 101, 0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 222, 3, 0, 0, 251, 20, 0, 0, 3, 0, 0, 4, 32,
-		0, 0, 0, 246, 20, 0, 0, 103, 0, 0, 0, 0, 0, 0, 0, 7,
-		21, 0, 0, 60, 0, 0, 0, 64, 0, 0, 0, 13, 21, 0, 0, 106,
-		0, 0, 0, 192, 0, 0, 0, 18, 21, 0, 0, 0, 0, 0, 8, 104,
-		0, 0, 0, 32, 21, 0, 0, 0, 0, 0, 8, 105, 0, 0, 0, 0,
-		0, 0, 0, 1, 0, 0, 4, 8, 0, 0, 0, 161, 14, 0, 0, 79,
-		0, 0, 0, 0, 0, 0, 0, 43, 21, 0, 0, 0, 0, 0, 8, 107,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 108, 0, 0, 0, 0,
-		0, 0, 0, 1, 0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 0, 109,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 102, 0, 0, 0, 55,
-		21, 0, 0, 3, 0, 0, 4, 56, 0, 0, 0, 64, 21, 0, 0, 102,
-		0, 0, 0, 0, 0, 0, 0, 76, 20, 0, 0, 111, 0, 0, 0, 0,
-		1, 0, 0, 69, 21, 0, 0, 115, 0, 0, 0, 128, 1, 0, 0, 72,
-		21, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 213, 14, 0, 0, 112,
-		0, 0, 0, 0, 0, 0, 0, 13, 21, 0, 0, 113, 0, 0, 0, 64,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 111, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 114, 0, 0, 0, 0, 0, 0, 0, 1,
-		0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 0, 112, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 214, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 97, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 62,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 63, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 32, 4, 0, 0, 104, 20, 0, 0, 18,
-		0, 0, 4, 240, 0, 0, 0, 63, 18, 0, 0, 70, 0, 0, 0, 0,
-		0, 0, 0, 86, 21, 0, 0, 87, 0, 0, 0, 64, 0, 0, 0, 93,
-		21, 0, 0, 39, 0, 0, 0, 128, 0, 0, 0, 59, 19, 0, 0, 39,
-		0, 0, 0, 192, 0, 0, 0, 99, 21, 0, 0, 39, 0, 0, 0, 0,
-		1, 0, 0, 105, 21, 0, 0, 39, 0, 0, 0, 64, 1, 0, 0, 118,
-		21, 0, 0, 79, 0, 0, 0, 128, 1, 0, 0, 251, 16, 0, 0, 20,
-		0, 0, 0, 192, 1, 0, 0, 137, 21, 0, 0, 20, 0, 0, 0, 200,
-		1, 0, 0, 151, 21, 0, 0, 20, 0, 0, 0, 208, 1, 0, 0, 165,
-		21, 0, 0, 85, 0, 0, 0, 0, 2, 0, 0, 178, 21, 0, 0, 85,
-		0, 0, 0, 0, 4, 0, 0, 190, 21, 0, 0, 60, 0, 0, 0, 0,
-		6, 0, 0, 207, 21, 0, 0, 2, 0, 0, 0, 128, 6, 0, 0, 218,
-		21, 0, 0, 2, 0, 0, 0, 160, 6, 0, 0, 231, 21, 0, 0, 2,
-		0, 0, 0, 192, 6, 0, 0, 240, 21, 0, 0, 39, 0, 0, 0, 0,
-		7, 0, 0, 255, 21, 0, 0, 39, 0, 0, 0, 64, 7, 0, 0, 10,
-		22, 0, 0, 29, 0, 0, 4, 0, 1, 0, 0, 27, 22, 0, 0, 39,
-		0, 0, 0, 0, 0, 0, 0, 38, 22, 0, 0, 39, 0, 0, 0, 64,
-		0, 0, 0, 47, 22, 0, 0, 39, 0, 0, 0, 128, 0, 0, 0, 58,
-		22, 0, 0, 39, 0, 0, 0, 192, 0, 0, 0, 67, 22, 0, 0, 39,
-		0, 0, 0, 0, 1, 0, 0, 80, 22, 0, 0, 39, 0, 0, 0, 64,
-		1, 0, 0, 91, 22, 0, 0, 39, 0, 0, 0, 128, 1, 0, 0, 103,
-		22, 0, 0, 39, 0, 0, 0, 192, 1, 0, 0, 113, 22, 0, 0, 79,
-		0, 0, 0, 0, 2, 0, 0, 131, 22, 0, 0, 39, 0, 0, 0, 64,
-		2, 0, 0, 143, 22, 0, 0, 39, 0, 0, 0, 128, 2, 0, 0, 153,
-		22, 0, 0, 79, 0, 0, 0, 192, 2, 0, 0, 171, 22, 0, 0, 39,
-		0, 0, 0, 0, 3, 0, 0, 180, 22, 0, 0, 39, 0, 0, 0, 64,
-		3, 0, 0, 190, 22, 0, 0, 39, 0, 0, 0, 128, 3, 0, 0, 209,
-		22, 0, 0, 39, 0, 0, 0, 192, 3, 0, 0, 237, 22, 0, 0, 39,
-		0, 0, 0, 0, 4, 0, 0, 10, 23, 0, 0, 39, 0, 0, 0, 64,
-		4, 0, 0, 35, 23, 0, 0, 39, 0, 0, 0, 128, 4, 0, 0, 56,
-		23, 0, 0, 39, 0, 0, 0, 192, 4, 0, 0, 67, 23, 0, 0, 39,
-		0, 0, 0, 0, 5, 0, 0, 83, 23, 0, 0, 39, 0, 0, 0, 64,
-		5, 0, 0, 102, 23, 0, 0, 39, 0, 0, 0, 128, 5, 0, 0, 119,
-		23, 0, 0, 39, 0, 0, 0, 192, 5, 0, 0, 137, 23, 0, 0, 39,
-		0, 0, 0, 0, 6, 0, 0, 155, 23, 0, 0, 39, 0, 0, 0, 64,
-		6, 0, 0, 182, 23, 0, 0, 39, 0, 0, 0, 128, 6, 0, 0, 201,
-		23, 0, 0, 39, 0, 0, 0, 192, 6, 0, 0, 217, 23, 0, 0, 39,
-		0, 0, 0, 0, 7, 0, 0, 236, 23, 0, 0, 1, 0, 0, 4, 8,
-		0, 0, 0, 247, 23, 0, 0, 123, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 238, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 125, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 126,
-		0, 0, 0, 253, 23, 0, 0, 0, 0, 0, 8, 128, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 126, 0, 0, 0, 7, 24, 0, 0, 1,
-		0, 0, 4, 0, 2, 0, 0, 15, 24, 0, 0, 129, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 31,
-		0, 0, 0, 4, 0, 0, 0, 64, 0, 0, 0, 20, 24, 0, 0, 2,
-		0, 0, 5, 4, 0, 0, 0, 32, 24, 0, 0, 131, 0, 0, 0, 0,
-		0, 0, 0, 34, 24, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 4, 0, 0, 4, 4, 0, 0, 0, 62, 8, 0, 0, 20,
-		0, 0, 0, 0, 0, 0, 0, 36, 24, 0, 0, 20, 0, 0, 0, 8,
-		0, 0, 0, 44, 24, 0, 0, 20, 0, 0, 0, 16, 0, 0, 0, 53,
-		24, 0, 0, 20, 0, 0, 0, 24, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 34, 4, 0, 0, 240, 4, 0, 0, 4, 0, 0, 4, 32,
-		0, 0, 0, 61, 24, 0, 0, 31, 0, 0, 0, 0, 0, 0, 0, 68,
-		24, 0, 0, 41, 0, 0, 0, 64, 0, 0, 0, 78, 24, 0, 0, 41,
-		0, 0, 0, 128, 0, 0, 0, 91, 24, 0, 0, 41, 0, 0, 0, 192,
-		0, 0, 0, 103, 24, 0, 0, 3, 0, 0, 4, 40, 0, 0, 0, 226,
-		2, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 114, 24, 0, 0, 60,
-		0, 0, 0, 64, 0, 0, 0, 124, 24, 0, 0, 60, 0, 0, 0, 192,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 136, 0, 0, 0, 134,
-		24, 0, 0, 2, 0, 0, 4, 152, 3, 0, 0, 0, 0, 0, 0, 137,
-		0, 0, 0, 0, 0, 0, 0, 144, 24, 0, 0, 196, 0, 0, 0, 192,
-		28, 0, 0, 0, 0, 0, 0, 59, 0, 0, 4, 152, 3, 0, 0, 155,
-		24, 0, 0, 138, 0, 0, 0, 0, 0, 0, 0, 160, 24, 0, 0, 66,
-		0, 0, 0, 64, 0, 0, 0, 166, 24, 0, 0, 39, 0, 0, 0, 128,
-		0, 0, 0, 182, 24, 0, 0, 139, 0, 0, 0, 192, 0, 0, 0, 200,
-		24, 0, 0, 31, 0, 0, 0, 0, 1, 0, 0, 210, 24, 0, 0, 31,
-		0, 0, 0, 64, 1, 0, 0, 227, 24, 0, 0, 31, 0, 0, 0, 128,
-		1, 0, 0, 237, 24, 0, 0, 31, 0, 0, 0, 192, 1, 0, 0, 252,
-		24, 0, 0, 178, 0, 0, 0, 0, 2, 0, 0, 0, 25, 0, 0, 47,
-		0, 0, 0, 64, 2, 0, 0, 17, 25, 0, 0, 47, 0, 0, 0, 96,
-		2, 0, 0, 26, 25, 0, 0, 47, 0, 0, 0, 128, 2, 0, 0, 35,
-		25, 0, 0, 103, 0, 0, 0, 192, 2, 0, 0, 50, 25, 0, 0, 2,
-		0, 0, 0, 0, 3, 0, 0, 60, 25, 0, 0, 150, 0, 0, 0, 32,
-		3, 0, 0, 76, 25, 0, 0, 182, 0, 0, 0, 64, 3, 0, 0, 86,
-		25, 0, 0, 60, 0, 0, 0, 128, 4, 0, 0, 93, 25, 0, 0, 31,
-		0, 0, 0, 0, 5, 0, 0, 105, 25, 0, 0, 31, 0, 0, 0, 64,
-		5, 0, 0, 116, 25, 0, 0, 31, 0, 0, 0, 128, 5, 0, 0, 125,
-		25, 0, 0, 31, 0, 0, 0, 192, 5, 0, 0, 135, 25, 0, 0, 104,
-		0, 0, 0, 0, 6, 0, 0, 145, 25, 0, 0, 31, 0, 0, 0, 64,
-		6, 0, 0, 153, 25, 0, 0, 31, 0, 0, 0, 128, 6, 0, 0, 161,
-		25, 0, 0, 31, 0, 0, 0, 192, 6, 0, 0, 170, 25, 0, 0, 31,
-		0, 0, 0, 0, 7, 0, 0, 180, 25, 0, 0, 183, 0, 0, 0, 64,
-		7, 0, 0, 198, 25, 0, 0, 150, 0, 0, 0, 96, 7, 0, 0, 207,
-		25, 0, 0, 31, 0, 0, 0, 128, 7, 0, 0, 218, 25, 0, 0, 31,
-		0, 0, 0, 192, 7, 0, 0, 227, 25, 0, 0, 31, 0, 0, 0, 0,
-		8, 0, 0, 238, 25, 0, 0, 31, 0, 0, 0, 64, 8, 0, 0, 247,
-		25, 0, 0, 31, 0, 0, 0, 128, 8, 0, 0, 1, 26, 0, 0, 31,
-		0, 0, 0, 192, 8, 0, 0, 5, 26, 0, 0, 31, 0, 0, 0, 0,
-		9, 0, 0, 17, 26, 0, 0, 31, 0, 0, 0, 64, 9, 0, 0, 27,
-		26, 0, 0, 31, 0, 0, 0, 128, 9, 0, 0, 35, 26, 0, 0, 31,
-		0, 0, 0, 192, 9, 0, 0, 45, 26, 0, 0, 31, 0, 0, 0, 0,
-		10, 0, 0, 53, 26, 0, 0, 185, 0, 0, 0, 64, 10, 0, 0, 56,
-		5, 0, 0, 186, 0, 0, 0, 192, 21, 0, 0, 64, 26, 0, 0, 188,
-		0, 0, 0, 192, 22, 0, 0, 71, 26, 0, 0, 189, 0, 0, 0, 0,
-		23, 0, 0, 186, 0, 0, 0, 31, 0, 0, 0, 64, 24, 0, 0, 79,
-		26, 0, 0, 150, 0, 0, 0, 128, 24, 0, 0, 90, 26, 0, 0, 191,
-		0, 0, 0, 192, 24, 0, 0, 102, 26, 0, 0, 55, 0, 0, 0, 0,
-		25, 0, 0, 108, 26, 0, 0, 192, 0, 0, 0, 64, 25, 0, 0, 116,
-		26, 0, 0, 141, 0, 0, 0, 128, 25, 0, 0, 125, 26, 0, 0, 193,
-		0, 0, 0, 192, 25, 0, 0, 148, 26, 0, 0, 31, 0, 0, 0, 0,
-		26, 0, 0, 163, 26, 0, 0, 31, 0, 0, 0, 64, 26, 0, 0, 241,
-		10, 0, 0, 2, 0, 0, 0, 128, 26, 0, 0, 180, 26, 0, 0, 47,
-		0, 0, 0, 160, 26, 0, 0, 198, 26, 0, 0, 194, 0, 0, 0, 192,
-		26, 0, 0, 212, 26, 0, 0, 103, 0, 0, 0, 0, 27, 0, 0, 226,
-		26, 0, 0, 102, 0, 0, 0, 64, 27, 0, 0, 241, 26, 0, 0, 8,
-		0, 0, 0, 64, 28, 0, 0, 247, 26, 0, 0, 31, 0, 0, 0, 128,
-		28, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 198, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 140, 0, 0, 0, 0, 0, 0, 0, 5,
-		0, 0, 13, 31, 0, 0, 0, 0, 0, 0, 0, 141, 0, 0, 0, 0,
-		0, 0, 0, 31, 0, 0, 0, 0, 0, 0, 0, 31, 0, 0, 0, 0,
-		0, 0, 0, 31, 0, 0, 0, 0, 0, 0, 0, 31, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 142, 0, 0, 0, 9, 27, 0, 0, 20,
-		0, 0, 4, 232, 0, 0, 0, 0, 0, 0, 0, 143, 0, 0, 0, 0,
-		0, 0, 0, 14, 27, 0, 0, 144, 0, 0, 0, 128, 0, 0, 0, 21,
-		27, 0, 0, 147, 0, 0, 0, 0, 1, 0, 0, 29, 27, 0, 0, 148,
-		0, 0, 0, 64, 1, 0, 0, 34, 27, 0, 0, 150, 0, 0, 0, 128,
-		1, 0, 0, 41, 27, 0, 0, 103, 0, 0, 0, 192, 1, 0, 0, 49,
-		27, 0, 0, 10, 0, 0, 0, 0, 2, 0, 0, 57, 27, 0, 0, 153,
-		0, 0, 0, 32, 2, 0, 0, 64, 27, 0, 0, 154, 0, 0, 0, 64,
-		2, 0, 0, 75, 27, 0, 0, 156, 0, 0, 0, 64, 3, 0, 0, 81,
-		27, 0, 0, 158, 0, 0, 0, 128, 3, 0, 0, 89, 27, 0, 0, 172,
-		0, 0, 0, 128, 4, 0, 0, 96, 27, 0, 0, 174, 0, 0, 0, 192,
-		4, 0, 0, 101, 27, 0, 0, 39, 0, 0, 0, 192, 5, 0, 0, 111,
-		27, 0, 0, 44, 0, 0, 0, 0, 6, 0, 0, 122, 27, 0, 0, 44,
-		0, 0, 0, 64, 6, 0, 0, 135, 27, 0, 0, 175, 0, 0, 0, 128,
-		6, 0, 0, 140, 27, 0, 0, 176, 0, 0, 0, 192, 6, 0, 0, 150,
-		27, 0, 0, 177, 0, 0, 0, 0, 7, 0, 0, 159, 27, 0, 0, 177,
-		0, 0, 0, 32, 7, 0, 0, 0, 0, 0, 0, 3, 0, 0, 5, 16,
-		0, 0, 0, 168, 27, 0, 0, 50, 0, 0, 0, 0, 0, 0, 0, 176,
-		27, 0, 0, 111, 0, 0, 0, 0, 0, 0, 0, 186, 27, 0, 0, 10,
-		0, 0, 0, 0, 0, 0, 0, 199, 27, 0, 0, 2, 0, 0, 4, 16,
-		0, 0, 0, 204, 27, 0, 0, 145, 0, 0, 0, 0, 0, 0, 0, 208,
-		27, 0, 0, 146, 0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 180, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 184,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 55, 2, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 149, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 10, 73, 2, 0, 0, 215, 27, 0, 0, 0, 0, 0, 8, 151,
-		0, 0, 0, 226, 27, 0, 0, 1, 0, 0, 4, 4, 0, 0, 0, 0,
-		0, 0, 0, 152, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1,
-		0, 0, 5, 4, 0, 0, 0, 235, 27, 0, 0, 71, 0, 0, 0, 0,
-		0, 0, 0, 241, 27, 0, 0, 0, 0, 0, 8, 10, 0, 0, 0, 249,
-		27, 0, 0, 4, 0, 0, 4, 32, 0, 0, 0, 102, 26, 0, 0, 103,
-		0, 0, 0, 0, 0, 0, 0, 255, 27, 0, 0, 70, 0, 0, 0, 64,
-		0, 0, 0, 9, 28, 0, 0, 155, 0, 0, 0, 96, 0, 0, 0, 13,
-		28, 0, 0, 60, 0, 0, 0, 128, 0, 0, 0, 23, 28, 0, 0, 1,
-		0, 0, 4, 4, 0, 0, 0, 160, 18, 0, 0, 47, 0, 0, 0, 0,
-		0, 0, 0, 45, 28, 0, 0, 0, 0, 0, 8, 157, 0, 0, 0, 52,
-		28, 0, 0, 0, 0, 0, 8, 81, 0, 0, 0, 68, 28, 0, 0, 6,
-		0, 0, 4, 32, 0, 0, 0, 63, 18, 0, 0, 159, 0, 0, 0, 0,
-		0, 0, 0, 206, 0, 0, 0, 166, 0, 0, 0, 64, 0, 0, 0, 80,
-		28, 0, 0, 167, 0, 0, 0, 128, 0, 0, 0, 89, 28, 0, 0, 168,
-		0, 0, 0, 160, 0, 0, 0, 93, 28, 0, 0, 168, 0, 0, 0, 192,
-		0, 0, 0, 98, 28, 0, 0, 2, 0, 0, 0, 224, 0, 0, 0, 105,
-		28, 0, 0, 0, 0, 0, 8, 160, 0, 0, 0, 0, 0, 0, 0, 1,
-		0, 0, 4, 8, 0, 0, 0, 99, 18, 0, 0, 161, 0, 0, 0, 0,
-		0, 0, 0, 114, 28, 0, 0, 0, 0, 0, 8, 162, 0, 0, 0, 128,
-		28, 0, 0, 2, 0, 0, 4, 8, 0, 0, 0, 0, 0, 0, 0, 163,
-		0, 0, 0, 0, 0, 0, 0, 255, 27, 0, 0, 72, 0, 0, 0, 32,
-		0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 5, 4, 0, 0, 0, 136,
-		28, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 164,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 4, 4,
-		0, 0, 0, 141, 28, 0, 0, 20, 0, 0, 0, 0, 0, 0, 0, 149,
-		28, 0, 0, 165, 0, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 3, 0, 0, 0, 0, 20, 0, 0, 0, 4, 0, 0, 0, 3,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 231, 0, 0, 0, 80,
-		28, 0, 0, 5, 0, 0, 6, 4, 0, 0, 0, 158, 28, 0, 0, 0,
-		0, 0, 0, 170, 28, 0, 0, 1, 0, 0, 0, 183, 28, 0, 0, 2,
-		0, 0, 0, 196, 28, 0, 0, 3, 0, 0, 0, 208, 28, 0, 0, 4,
-		0, 0, 0, 220, 28, 0, 0, 0, 0, 0, 8, 169, 0, 0, 0, 0,
-		0, 0, 0, 1, 0, 0, 4, 4, 0, 0, 0, 134, 18, 0, 0, 170,
-		0, 0, 0, 0, 0, 0, 0, 227, 28, 0, 0, 0, 0, 0, 8, 171,
-		0, 0, 0, 233, 28, 0, 0, 0, 0, 0, 8, 10, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 173, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 10, 251, 0, 0, 0, 250, 28, 0, 0, 6, 0, 0, 4, 32,
-		0, 0, 0, 8, 29, 0, 0, 31, 0, 0, 0, 0, 0, 0, 0, 14,
-		29, 0, 0, 10, 0, 0, 0, 64, 0, 0, 0, 19, 29, 0, 0, 10,
-		0, 0, 0, 96, 0, 0, 0, 30, 29, 0, 0, 10, 0, 0, 0, 128,
-		0, 0, 0, 39, 29, 0, 0, 10, 0, 0, 0, 160, 0, 0, 0, 49,
-		29, 0, 0, 156, 0, 0, 0, 192, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 122, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 54,
-		2, 0, 0, 58, 29, 0, 0, 0, 0, 0, 8, 8, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 179, 0, 0, 0, 67, 29, 0, 0, 0,
-		0, 0, 8, 180, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 4, 8,
-		0, 0, 0, 252, 24, 0, 0, 181, 0, 0, 0, 0, 0, 0, 0, 73,
-		29, 0, 0, 0, 0, 0, 8, 39, 0, 0, 0, 82, 29, 0, 0, 5,
-		0, 0, 4, 40, 0, 0, 0, 101, 14, 0, 0, 103, 0, 0, 0, 0,
-		0, 0, 0, 102, 26, 0, 0, 103, 0, 0, 0, 64, 0, 0, 0, 9,
-		28, 0, 0, 155, 0, 0, 0, 128, 0, 0, 0, 255, 27, 0, 0, 70,
-		0, 0, 0, 160, 0, 0, 0, 13, 28, 0, 0, 60, 0, 0, 0, 192,
-		0, 0, 0, 95, 29, 0, 0, 0, 0, 0, 8, 184, 0, 0, 0, 106,
-		29, 0, 0, 1, 0, 0, 4, 4, 0, 0, 0, 115, 29, 0, 0, 10,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
-		0, 0, 0, 31, 0, 0, 0, 4, 0, 0, 0, 46, 0, 0, 0, 124,
-		29, 0, 0, 1, 0, 0, 4, 32, 0, 0, 0, 101, 14, 0, 0, 187,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
-		0, 0, 0, 103, 0, 0, 0, 4, 0, 0, 0, 4, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 27, 4, 0, 0, 136, 29, 0, 0, 0,
-		0, 0, 8, 190, 0, 0, 0, 0, 0, 0, 0, 5, 0, 0, 4, 40,
-		0, 0, 0, 159, 0, 0, 0, 104, 0, 0, 0, 0, 0, 0, 0, 149,
-		29, 0, 0, 44, 0, 0, 0, 64, 0, 0, 0, 157, 29, 0, 0, 45,
-		0, 0, 0, 128, 0, 0, 0, 164, 29, 0, 0, 44, 0, 0, 0, 192,
-		0, 0, 0, 186, 0, 0, 0, 31, 0, 0, 0, 0, 1, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 29, 4, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 108, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 30,
-		4, 0, 0, 198, 26, 0, 0, 1, 0, 0, 4, 8, 0, 0, 0, 169,
-		29, 0, 0, 195, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 31, 4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
-		0, 0, 0, 31, 0, 0, 0, 4, 0, 0, 0, 0, 0, 0, 0, 47,
-		5, 0, 0, 2, 0, 0, 4, 40, 0, 0, 0, 178, 29, 0, 0, 39,
-		0, 0, 0, 0, 0, 0, 0, 185, 29, 0, 0, 211, 0, 0, 0, 64,
-		0, 0, 0, 190, 29, 0, 0, 19, 0, 0, 4, 200, 0, 0, 0, 205,
-		29, 0, 0, 31, 0, 0, 0, 0, 0, 0, 0, 214, 29, 0, 0, 31,
-		0, 0, 0, 64, 0, 0, 0, 221, 29, 0, 0, 138, 0, 0, 0, 128,
-		0, 0, 0, 229, 29, 0, 0, 138, 0, 0, 0, 192, 0, 0, 0, 237,
-		29, 0, 0, 58, 0, 0, 0, 0, 1, 0, 0, 243, 29, 0, 0, 31,
-		0, 0, 0, 192, 1, 0, 0, 2, 30, 0, 0, 135, 0, 0, 0, 0,
-		2, 0, 0, 8, 30, 0, 0, 199, 0, 0, 0, 64, 2, 0, 0, 21,
-		30, 0, 0, 31, 0, 0, 0, 128, 2, 0, 0, 0, 0, 0, 0, 202,
-		0, 0, 0, 192, 2, 0, 0, 30, 30, 0, 0, 60, 0, 0, 0, 192,
-		3, 0, 0, 45, 30, 0, 0, 205, 0, 0, 0, 64, 4, 0, 0, 54,
-		30, 0, 0, 206, 0, 0, 0, 128, 4, 0, 0, 61, 30, 0, 0, 31,
-		0, 0, 0, 192, 4, 0, 0, 70, 30, 0, 0, 141, 0, 0, 0, 0,
-		5, 0, 0, 78, 30, 0, 0, 44, 0, 0, 0, 64, 5, 0, 0, 94,
-		30, 0, 0, 103, 0, 0, 0, 128, 5, 0, 0, 114, 30, 0, 0, 208,
-		0, 0, 0, 192, 5, 0, 0, 124, 30, 0, 0, 209, 0, 0, 0, 0,
-		6, 0, 0, 143, 30, 0, 0, 0, 0, 0, 8, 200, 0, 0, 0, 0,
-		0, 0, 0, 1, 0, 0, 4, 8, 0, 0, 0, 152, 30, 0, 0, 201,
-		0, 0, 0, 0, 0, 0, 0, 159, 30, 0, 0, 0, 0, 0, 8, 39,
-		0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 5, 32, 0, 0, 0, 168,
-		30, 0, 0, 203, 0, 0, 0, 0, 0, 0, 0, 175, 30, 0, 0, 204,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 4, 32,
-		0, 0, 0, 185, 30, 0, 0, 58, 0, 0, 0, 0, 0, 0, 0, 188,
-		30, 0, 0, 31, 0, 0, 0, 192, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 177, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 179,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 207, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 10, 182, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 194, 1, 0, 0, 124, 30, 0, 0, 1, 0, 0, 4, 8,
-		0, 0, 0, 204, 30, 0, 0, 210, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 26, 4, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 3, 0, 0, 0, 0, 138, 0, 0, 0, 4, 0, 0, 0, 4,
-		0, 0, 0, 208, 30, 0, 0, 2, 0, 0, 4, 20, 0, 0, 0, 85,
-		0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 101, 14, 0, 0, 213,
-		0, 0, 0, 32, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
-		0, 0, 0, 2, 0, 0, 0, 4, 0, 0, 0, 4, 0, 0, 0, 119,
-		6, 0, 0, 3, 0, 0, 4, 56, 0, 0, 0, 222, 30, 0, 0, 31,
-		0, 0, 0, 0, 0, 0, 0, 232, 30, 0, 0, 215, 0, 0, 0, 64,
-		0, 0, 0, 0, 0, 0, 0, 218, 0, 0, 0, 128, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 216, 0, 0, 0, 0, 0, 0, 0, 1,
-		0, 0, 13, 30, 0, 0, 0, 0, 0, 0, 0, 217, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 214, 0, 0, 0, 0, 0, 0, 0, 3,
-		0, 0, 5, 40, 0, 0, 0, 235, 30, 0, 0, 219, 0, 0, 0, 0,
-		0, 0, 0, 241, 30, 0, 0, 220, 0, 0, 0, 0, 0, 0, 0, 251,
-		30, 0, 0, 227, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6,
-		0, 0, 4, 40, 0, 0, 0, 0, 31, 0, 0, 7, 0, 0, 0, 0,
-		0, 0, 0, 134, 18, 0, 0, 8, 0, 0, 0, 64, 0, 0, 0, 186,
-		0, 0, 0, 8, 0, 0, 0, 96, 0, 0, 0, 6, 31, 0, 0, 8,
-		0, 0, 0, 128, 0, 0, 0, 13, 31, 0, 0, 39, 0, 0, 0, 192,
-		0, 0, 0, 18, 31, 0, 0, 7, 0, 0, 0, 0, 1, 0, 0, 0,
-		0, 0, 0, 4, 0, 0, 4, 24, 0, 0, 0, 25, 31, 0, 0, 221,
-		0, 0, 0, 0, 0, 0, 0, 48, 0, 0, 0, 223, 0, 0, 0, 32,
-		0, 0, 0, 0, 0, 0, 0, 224, 0, 0, 0, 64, 0, 0, 0, 244,
-		19, 0, 0, 39, 0, 0, 0, 128, 0, 0, 0, 33, 31, 0, 0, 0,
-		0, 0, 8, 222, 0, 0, 0, 43, 31, 0, 0, 0, 0, 0, 8, 2,
-		0, 0, 0, 62, 31, 0, 0, 3, 0, 0, 6, 4, 0, 0, 0, 76,
-		31, 0, 0, 0, 0, 0, 0, 84, 31, 0, 0, 1, 0, 0, 0, 94,
-		31, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 5, 8,
-		0, 0, 0, 104, 31, 0, 0, 225, 0, 0, 0, 0, 0, 0, 0, 109,
-		31, 0, 0, 226, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 36, 4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 37,
-		4, 0, 0, 0, 0, 0, 0, 5, 0, 0, 4, 32, 0, 0, 0, 121,
-		31, 0, 0, 228, 0, 0, 0, 0, 0, 0, 0, 126, 31, 0, 0, 2,
-		0, 0, 0, 64, 0, 0, 0, 131, 31, 0, 0, 2, 0, 0, 0, 96,
-		0, 0, 0, 143, 31, 0, 0, 31, 0, 0, 0, 128, 0, 0, 0, 150,
-		31, 0, 0, 31, 0, 0, 0, 192, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 242, 3, 0, 0, 158, 31, 0, 0, 0, 0, 0, 8, 230,
-		0, 0, 0, 164, 31, 0, 0, 0, 0, 0, 8, 2, 0, 0, 0, 206,
-		0, 0, 0, 8, 0, 0, 4, 112, 0, 0, 0, 101, 14, 0, 0, 45,
-		0, 0, 0, 0, 0, 0, 0, 179, 31, 0, 0, 10, 0, 0, 0, 32,
-		0, 0, 0, 63, 18, 0, 0, 150, 0, 0, 0, 64, 0, 0, 0, 251,
-		4, 0, 0, 232, 0, 0, 0, 128, 0, 0, 0, 185, 31, 0, 0, 122,
-		0, 0, 0, 128, 1, 0, 0, 192, 31, 0, 0, 233, 0, 0, 0, 192,
-		1, 0, 0, 76, 20, 0, 0, 111, 0, 0, 0, 128, 2, 0, 0, 203,
-		31, 0, 0, 237, 0, 0, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 3, 0, 0, 0, 0, 122, 0, 0, 0, 4, 0, 0, 0, 4,
-		0, 0, 0, 211, 31, 0, 0, 0, 0, 0, 8, 234, 0, 0, 0, 229,
-		31, 0, 0, 2, 0, 0, 4, 24, 0, 0, 0, 63, 18, 0, 0, 150,
-		0, 0, 0, 0, 0, 0, 0, 245, 31, 0, 0, 60, 0, 0, 0, 64,
-		0, 0, 0, 250, 31, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 68,
-		18, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 255, 31, 0, 0, 236,
-		0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 80,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 235,
-		0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0, 2, 32, 0, 0, 2,
-		0, 0, 4, 16, 0, 0, 0, 213, 14, 0, 0, 123, 0, 0, 0, 0,
-		0, 0, 0, 13, 32, 0, 0, 239, 0, 0, 0, 64, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 123, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 3, 0, 0, 0, 0, 238, 0, 0, 0, 4, 0, 0, 0, 4,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 127, 1, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 2, 0, 0, 0, 72, 7, 0, 0, 3,
-		0, 0, 4, 24, 0, 0, 0, 54, 7, 0, 0, 39, 0, 0, 0, 0,
-		0, 0, 0, 60, 7, 0, 0, 39, 0, 0, 0, 64, 0, 0, 0, 63,
-		18, 0, 0, 70, 0, 0, 0, 128, 0, 0, 0, 85, 7, 0, 0, 7,
-		0, 0, 4, 48, 0, 0, 0, 106, 29, 0, 0, 183, 0, 0, 0, 0,
-		0, 0, 0, 19, 32, 0, 0, 41, 0, 0, 0, 64, 0, 0, 0, 199,
-		19, 0, 0, 245, 0, 0, 0, 128, 0, 0, 0, 60, 14, 0, 0, 10,
-		0, 0, 0, 160, 0, 0, 0, 54, 7, 0, 0, 39, 0, 0, 0, 192,
-		0, 0, 0, 60, 7, 0, 0, 39, 0, 0, 0, 0, 1, 0, 0, 66,
-		7, 0, 0, 39, 0, 0, 0, 64, 1, 0, 0, 29, 32, 0, 0, 5,
-		0, 0, 6, 4, 0, 0, 0, 41, 32, 0, 0, 0, 0, 0, 0, 56,
-		32, 0, 0, 1, 0, 0, 0, 67, 32, 0, 0, 2, 0, 0, 0, 77,
-		32, 0, 0, 3, 0, 0, 0, 88, 32, 0, 0, 4, 0, 0, 0, 160,
-		7, 0, 0, 3, 0, 0, 4, 80, 0, 0, 0, 100, 32, 0, 0, 249,
-		0, 0, 0, 0, 0, 0, 0, 106, 32, 0, 0, 10, 0, 0, 0, 64,
-		2, 0, 0, 120, 32, 0, 0, 10, 0, 0, 0, 96, 2, 0, 0, 134,
-		32, 0, 0, 2, 0, 0, 4, 24, 0, 0, 0, 154, 32, 0, 0, 39,
-		0, 0, 0, 0, 0, 0, 0, 162, 32, 0, 0, 248, 0, 0, 0, 64,
-		0, 0, 0, 169, 32, 0, 0, 1, 0, 0, 4, 16, 0, 0, 0, 172,
-		17, 0, 0, 65, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 3, 0, 0, 0, 0, 247, 0, 0, 0, 4, 0, 0, 0, 3,
-		0, 0, 0, 176, 7, 0, 0, 2, 0, 0, 4, 24, 0, 0, 0, 64,
-		21, 0, 0, 111, 0, 0, 0, 0, 0, 0, 0, 185, 32, 0, 0, 10,
-		0, 0, 0, 128, 0, 0, 0, 220, 7, 0, 0, 26, 0, 0, 4, 176,
-		0, 0, 0, 121, 2, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 89,
-		28, 0, 0, 168, 0, 0, 0, 32, 0, 0, 0, 195, 32, 0, 0, 252,
-		0, 0, 0, 64, 0, 0, 0, 199, 32, 0, 0, 168, 0, 0, 0, 96,
-		0, 0, 0, 204, 32, 0, 0, 252, 0, 0, 0, 128, 0, 0, 0, 93,
-		28, 0, 0, 168, 0, 0, 0, 160, 0, 0, 0, 209, 32, 0, 0, 252,
-		0, 0, 0, 192, 0, 0, 0, 214, 32, 0, 0, 168, 0, 0, 0, 224,
-		0, 0, 0, 220, 32, 0, 0, 252, 0, 0, 0, 0, 1, 0, 0, 226,
-		32, 0, 0, 10, 0, 0, 0, 32, 1, 0, 0, 237, 32, 0, 0, 0,
-		1, 0, 0, 64, 1, 0, 0, 253, 32, 0, 0, 0, 1, 0, 0, 128,
-		1, 0, 0, 11, 33, 0, 0, 0, 1, 0, 0, 192, 1, 0, 0, 25,
-		33, 0, 0, 0, 1, 0, 0, 0, 2, 0, 0, 34, 33, 0, 0, 0,
-		1, 0, 0, 64, 2, 0, 0, 46, 33, 0, 0, 22, 0, 0, 0, 128,
-		2, 0, 0, 58, 33, 0, 0, 3, 1, 0, 0, 192, 2, 0, 0, 74,
-		33, 0, 0, 3, 1, 0, 0, 0, 3, 0, 0, 90, 33, 0, 0, 3,
-		1, 0, 0, 64, 3, 0, 0, 105, 33, 0, 0, 3, 1, 0, 0, 128,
-		3, 0, 0, 254, 13, 0, 0, 44, 0, 0, 0, 192, 3, 0, 0, 122,
-		33, 0, 0, 107, 1, 0, 0, 0, 4, 0, 0, 108, 26, 0, 0, 192,
-		0, 0, 0, 64, 4, 0, 0, 127, 33, 0, 0, 141, 1, 0, 0, 128,
-		4, 0, 0, 135, 33, 0, 0, 145, 1, 0, 0, 192, 4, 0, 0, 0,
-		0, 0, 0, 146, 1, 0, 0, 0, 5, 0, 0, 146, 33, 0, 0, 0,
-		0, 0, 8, 253, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 4, 4,
-		0, 0, 0, 134, 18, 0, 0, 254, 0, 0, 0, 0, 0, 0, 0, 153,
-		33, 0, 0, 0, 0, 0, 8, 255, 0, 0, 0, 159, 33, 0, 0, 0,
-		0, 0, 8, 10, 0, 0, 0, 176, 33, 0, 0, 0, 0, 0, 8, 1,
-		1, 0, 0, 189, 33, 0, 0, 1, 0, 0, 4, 8, 0, 0, 0, 207,
-		33, 0, 0, 2, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 3, 0, 0, 0, 0, 9, 0, 0, 0, 4, 0, 0, 0, 2,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 4, 1, 0, 0, 65,
-		0, 0, 0, 19, 0, 0, 4, 224, 0, 0, 0, 121, 2, 0, 0, 45,
-		0, 0, 0, 0, 0, 0, 0, 211, 33, 0, 0, 5, 1, 0, 0, 32,
-		0, 0, 0, 0, 0, 0, 0, 9, 1, 0, 0, 64, 0, 0, 0, 218,
-		33, 0, 0, 10, 1, 0, 0, 0, 1, 0, 0, 227, 33, 0, 0, 182,
-		0, 0, 0, 64, 1, 0, 0, 122, 33, 0, 0, 11, 1, 0, 0, 128,
-		2, 0, 0, 254, 13, 0, 0, 44, 0, 0, 0, 192, 2, 0, 0, 0,
-		0, 0, 0, 12, 1, 0, 0, 0, 3, 0, 0, 231, 33, 0, 0, 13,
-		1, 0, 0, 64, 3, 0, 0, 89, 28, 0, 0, 168, 0, 0, 0, 128,
-		3, 0, 0, 195, 32, 0, 0, 252, 0, 0, 0, 160, 3, 0, 0, 244,
-		33, 0, 0, 14, 1, 0, 0, 192, 3, 0, 0, 249, 33, 0, 0, 29,
-		0, 0, 0, 224, 3, 0, 0, 2, 34, 0, 0, 29, 0, 0, 0, 240,
-		3, 0, 0, 199, 19, 0, 0, 16, 1, 0, 0, 0, 4, 0, 0, 186,
-		0, 0, 0, 31, 0, 0, 0, 64, 4, 0, 0, 0, 0, 0, 0, 17,
-		1, 0, 0, 128, 4, 0, 0, 0, 0, 0, 0, 102, 1, 0, 0, 192,
-		5, 0, 0, 10, 34, 0, 0, 77, 1, 0, 0, 192, 6, 0, 0, 24,
-		34, 0, 0, 0, 0, 0, 8, 6, 1, 0, 0, 37, 34, 0, 0, 0,
-		0, 0, 8, 7, 1, 0, 0, 45, 34, 0, 0, 0, 0, 0, 8, 8,
-		1, 0, 0, 49, 34, 0, 0, 0, 0, 0, 8, 2, 0, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 5, 24, 0, 0, 0, 55, 34, 0, 0, 60,
-		0, 0, 0, 0, 0, 0, 0, 70, 34, 0, 0, 58, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 229, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 230, 3, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 5, 8, 0, 0, 0, 82, 34, 0, 0, 13, 1, 0, 0, 0,
-		0, 0, 0, 89, 34, 0, 0, 13, 1, 0, 0, 0, 0, 0, 0, 100,
-		34, 0, 0, 0, 0, 0, 8, 80, 0, 0, 0, 109, 34, 0, 0, 0,
-		0, 0, 8, 15, 1, 0, 0, 120, 34, 0, 0, 0, 0, 0, 8, 8,
-		0, 0, 0, 129, 34, 0, 0, 0, 0, 0, 1, 2, 0, 0, 0, 16,
-		0, 0, 1, 0, 0, 0, 0, 2, 0, 0, 5, 40, 0, 0, 0, 135,
-		34, 0, 0, 18, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 26,
-		1, 0, 0, 0, 0, 0, 0, 145, 34, 0, 0, 5, 0, 0, 4, 40,
-		0, 0, 0, 163, 34, 0, 0, 31, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 19, 1, 0, 0, 64, 0, 0, 0, 48, 0, 0, 0, 22,
-		1, 0, 0, 128, 0, 0, 0, 168, 34, 0, 0, 23, 1, 0, 0, 192,
-		0, 0, 0, 179, 34, 0, 0, 24, 1, 0, 0, 0, 1, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 5, 8, 0, 0, 0, 0, 0, 0, 0, 20,
-		1, 0, 0, 0, 0, 0, 0, 191, 34, 0, 0, 31, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 4, 8, 0, 0, 0, 193,
-		34, 0, 0, 53, 0, 0, 0, 0, 0, 0, 0, 202, 34, 0, 0, 21,
-		1, 0, 0, 16, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
-		0, 0, 0, 33, 0, 0, 0, 4, 0, 0, 0, 6, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 27, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 101, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 25,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 33, 0, 0, 0, 0,
-		0, 0, 0, 5, 0, 0, 4, 40, 0, 0, 0, 163, 34, 0, 0, 31,
-		0, 0, 0, 0, 0, 0, 0, 207, 34, 0, 0, 31, 0, 0, 0, 64,
-		0, 0, 0, 48, 0, 0, 0, 22, 1, 0, 0, 128, 0, 0, 0, 168,
-		34, 0, 0, 23, 1, 0, 0, 192, 0, 0, 0, 179, 34, 0, 0, 37,
-		1, 0, 0, 0, 1, 0, 0, 216, 34, 0, 0, 21, 0, 0, 4, 168,
-		0, 0, 0, 225, 34, 0, 0, 24, 1, 0, 0, 0, 0, 0, 0, 230,
-		34, 0, 0, 28, 1, 0, 0, 64, 0, 0, 0, 186, 0, 0, 0, 10,
-		0, 0, 0, 128, 0, 0, 0, 242, 34, 0, 0, 31, 1, 0, 0, 192,
-		0, 0, 0, 2, 35, 0, 0, 33, 1, 0, 0, 0, 1, 0, 0, 11,
-		35, 0, 0, 42, 1, 0, 0, 64, 1, 0, 0, 25, 35, 0, 0, 44,
-		1, 0, 0, 128, 1, 0, 0, 37, 35, 0, 0, 44, 1, 0, 0, 192,
-		1, 0, 0, 44, 35, 0, 0, 46, 1, 0, 0, 0, 2, 0, 0, 59,
-		35, 0, 0, 58, 1, 0, 0, 64, 2, 0, 0, 70, 35, 0, 0, 60,
-		1, 0, 0, 128, 2, 0, 0, 77, 35, 0, 0, 60, 1, 0, 0, 192,
-		2, 0, 0, 85, 35, 0, 0, 62, 1, 0, 0, 0, 3, 0, 0, 94,
-		35, 0, 0, 70, 1, 0, 0, 64, 3, 0, 0, 99, 35, 0, 0, 72,
-		1, 0, 0, 128, 3, 0, 0, 111, 35, 0, 0, 75, 1, 0, 0, 192,
-		3, 0, 0, 130, 35, 0, 0, 86, 1, 0, 0, 0, 4, 0, 0, 141,
-		35, 0, 0, 95, 1, 0, 0, 64, 4, 0, 0, 153, 35, 0, 0, 98,
-		1, 0, 0, 128, 4, 0, 0, 175, 35, 0, 0, 60, 0, 0, 0, 192,
-		4, 0, 0, 180, 35, 0, 0, 100, 1, 0, 0, 64, 5, 0, 0, 191,
-		35, 0, 0, 0, 0, 0, 8, 29, 1, 0, 0, 198, 35, 0, 0, 0,
-		0, 0, 8, 30, 1, 0, 0, 214, 35, 0, 0, 0, 0, 0, 8, 31,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 32, 1, 0, 0, 0,
-		0, 0, 0, 1, 0, 0, 13, 2, 0, 0, 0, 0, 0, 0, 0, 24,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 34, 1, 0, 0, 0,
-		0, 0, 0, 1, 0, 0, 13, 2, 0, 0, 0, 0, 0, 0, 0, 35,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 36, 1, 0, 0, 231,
-		35, 0, 0, 7, 0, 0, 4, 80, 0, 0, 0, 253, 35, 0, 0, 24,
-		1, 0, 0, 0, 0, 0, 0, 179, 34, 0, 0, 37, 1, 0, 0, 64,
-		0, 0, 0, 14, 36, 0, 0, 38, 1, 0, 0, 128, 0, 0, 0, 246,
-		20, 0, 0, 40, 1, 0, 0, 128, 1, 0, 0, 2, 34, 0, 0, 28,
-		1, 0, 0, 192, 1, 0, 0, 249, 33, 0, 0, 28, 1, 0, 0, 0,
-		2, 0, 0, 82, 34, 0, 0, 13, 1, 0, 0, 64, 2, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 33, 0, 0, 0, 22, 36, 0, 0, 2,
-		0, 0, 5, 32, 0, 0, 0, 34, 36, 0, 0, 44, 0, 0, 0, 0,
-		0, 0, 0, 246, 20, 0, 0, 39, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 44, 0, 0, 0, 4,
-		0, 0, 0, 4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 41,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 43, 1, 0, 0, 0, 0, 0, 0, 1,
-		0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 0, 35, 1, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 45, 1, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 13, 2, 0, 0, 0, 0, 0, 0, 0, 3, 1, 0, 0, 0,
-		0, 0, 0, 35, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 47,
-		1, 0, 0, 0, 0, 0, 0, 1, 0, 0, 13, 2, 0, 0, 0, 0,
-		0, 0, 0, 48, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 49,
-		1, 0, 0, 44, 36, 0, 0, 4, 0, 0, 4, 32, 0, 0, 0, 59,
-		36, 0, 0, 50, 1, 0, 0, 0, 0, 0, 0, 63, 36, 0, 0, 40,
-		1, 0, 0, 64, 0, 0, 0, 72, 36, 0, 0, 44, 0, 0, 0, 128,
-		0, 0, 0, 82, 36, 0, 0, 10, 0, 0, 0, 192, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 51, 1, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 13, 52, 1, 0, 0, 0, 0, 0, 0, 54, 1, 0, 0, 0,
-		0, 0, 0, 56, 1, 0, 0, 94, 36, 0, 0, 0, 0, 0, 8, 53,
-		1, 0, 0, 99, 36, 0, 0, 0, 0, 0, 1, 1, 0, 0, 0, 8,
-		0, 0, 4, 0, 0, 0, 0, 0, 0, 0, 2, 55, 1, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 10, 4, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 57, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 49,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 59, 1, 0, 0, 0,
-		0, 0, 0, 1, 0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 0, 48,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 61, 1, 0, 0, 0,
-		0, 0, 0, 1, 0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 0, 3,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 63, 1, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 0, 54,
-		1, 0, 0, 0, 0, 0, 0, 64, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 65, 1, 0, 0, 105, 36, 0, 0, 12, 0, 0, 4, 120,
-		0, 0, 0, 114, 36, 0, 0, 37, 1, 0, 0, 0, 0, 0, 0, 14,
-		29, 0, 0, 28, 1, 0, 0, 64, 0, 0, 0, 118, 36, 0, 0, 28,
-		1, 0, 0, 128, 0, 0, 0, 101, 14, 0, 0, 28, 1, 0, 0, 192,
-		0, 0, 0, 123, 36, 0, 0, 28, 1, 0, 0, 0, 1, 0, 0, 133,
-		36, 0, 0, 156, 0, 0, 0, 64, 1, 0, 0, 139, 36, 0, 0, 156,
-		0, 0, 0, 128, 1, 0, 0, 63, 18, 0, 0, 154, 0, 0, 0, 192,
-		1, 0, 0, 148, 36, 0, 0, 66, 1, 0, 0, 192, 2, 0, 0, 151,
-		36, 0, 0, 2, 0, 0, 0, 0, 3, 0, 0, 9, 27, 0, 0, 68,
-		1, 0, 0, 64, 3, 0, 0, 162, 36, 0, 0, 44, 0, 0, 0, 128,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 67, 1, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 10, 210, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 69, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 142,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 71, 1, 0, 0, 0,
-		0, 0, 0, 3, 0, 0, 13, 30, 0, 0, 0, 0, 0, 0, 0, 54,
-		1, 0, 0, 0, 0, 0, 0, 37, 1, 0, 0, 0, 0, 0, 0, 28,
-		1, 0, 0, 170, 36, 0, 0, 0, 0, 0, 8, 73, 1, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 74, 1, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 13, 2, 0, 0, 0, 0, 0, 0, 0, 3, 1, 0, 0, 0,
-		0, 0, 0, 44, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 76,
-		1, 0, 0, 0, 0, 0, 0, 1, 0, 0, 13, 77, 1, 0, 0, 0,
-		0, 0, 0, 24, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 78,
-		1, 0, 0, 190, 36, 0, 0, 3, 0, 0, 4, 24, 0, 0, 0, 206,
-		36, 0, 0, 79, 1, 0, 0, 0, 0, 0, 0, 65, 0, 0, 0, 3,
-		1, 0, 0, 64, 0, 0, 0, 212, 36, 0, 0, 22, 1, 0, 0, 128,
-		0, 0, 0, 220, 36, 0, 0, 0, 0, 0, 8, 80, 1, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 81, 1, 0, 0, 0, 0, 0, 0, 4,
-		0, 0, 13, 2, 0, 0, 0, 0, 0, 0, 0, 3, 1, 0, 0, 0,
-		0, 0, 0, 82, 1, 0, 0, 0, 0, 0, 0, 84, 1, 0, 0, 0,
-		0, 0, 0, 3, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 83,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 27, 1, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 85, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 10, 38, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 87,
-		1, 0, 0, 0, 0, 0, 0, 2, 0, 0, 13, 2, 0, 0, 0, 0,
-		0, 0, 0, 88, 1, 0, 0, 0, 0, 0, 0, 93, 1, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 89, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 10, 90, 1, 0, 0, 245, 36, 0, 0, 7, 0, 0, 132, 48,
-		0, 0, 0, 65, 0, 0, 0, 3, 1, 0, 0, 0, 0, 0, 0, 8,
-		37, 0, 0, 24, 1, 0, 0, 64, 0, 0, 0, 17, 37, 0, 0, 24,
-		1, 0, 0, 128, 0, 0, 0, 27, 37, 0, 0, 37, 1, 0, 0, 192,
-		0, 0, 0, 32, 37, 0, 0, 9, 0, 0, 0, 0, 1, 0, 0, 0,
-		0, 0, 0, 91, 1, 0, 0, 32, 1, 0, 0, 148, 36, 0, 0, 92,
-		1, 0, 0, 64, 1, 0, 8, 0, 0, 0, 0, 2, 0, 0, 5, 4,
-		0, 0, 0, 39, 37, 0, 0, 9, 0, 0, 0, 0, 0, 0, 0, 47,
-		37, 0, 0, 9, 0, 0, 0, 0, 0, 0, 0, 55, 37, 0, 0, 4,
-		0, 0, 6, 4, 0, 0, 0, 77, 37, 0, 0, 0, 0, 0, 0, 97,
-		37, 0, 0, 1, 0, 0, 0, 117, 37, 0, 0, 2, 0, 0, 0, 134,
-		37, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 94,
-		1, 0, 0, 153, 37, 0, 0, 6, 0, 0, 4, 16, 0, 0, 0, 171,
-		37, 0, 0, 9, 0, 0, 0, 0, 0, 0, 0, 185, 37, 0, 0, 9,
-		0, 0, 0, 32, 0, 0, 0, 194, 37, 0, 0, 54, 0, 0, 0, 64,
-		0, 0, 0, 208, 37, 0, 0, 54, 0, 0, 0, 80, 0, 0, 0, 221,
-		37, 0, 0, 54, 0, 0, 0, 96, 0, 0, 0, 234, 37, 0, 0, 54,
-		0, 0, 0, 112, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 96,
-		1, 0, 0, 0, 0, 0, 0, 3, 0, 0, 13, 2, 0, 0, 0, 0,
-		0, 0, 0, 97, 1, 0, 0, 0, 0, 0, 0, 40, 1, 0, 0, 0,
-		0, 0, 0, 44, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 90,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 99, 1, 0, 0, 0,
-		0, 0, 0, 3, 0, 0, 13, 2, 0, 0, 0, 0, 0, 0, 0, 97,
-		1, 0, 0, 0, 0, 0, 0, 40, 1, 0, 0, 0, 0, 0, 0, 40,
-		1, 0, 0, 247, 37, 0, 0, 0, 0, 0, 4, 0, 0, 0, 0, 6,
-		38, 0, 0, 3, 0, 0, 4, 24, 0, 0, 0, 76, 20, 0, 0, 111,
-		0, 0, 0, 0, 0, 0, 0, 121, 2, 0, 0, 45, 0, 0, 0, 128,
-		0, 0, 0, 126, 16, 0, 0, 52, 1, 0, 0, 160, 0, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 5, 32, 0, 0, 0, 14, 36, 0, 0, 38,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 103, 1, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 4, 32, 0, 0, 0, 14,
-		38, 0, 0, 60, 0, 0, 0, 0, 0, 0, 0, 24, 38, 0, 0, 104,
-		1, 0, 0, 128, 0, 0, 0, 29, 38, 0, 0, 2, 0, 0, 4, 16,
-		0, 0, 0, 41, 38, 0, 0, 105, 1, 0, 0, 0, 0, 0, 0, 46,
-		38, 0, 0, 31, 0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 106, 1, 0, 0, 64, 38, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 169, 2, 0, 0, 80,
-		38, 0, 0, 19, 0, 0, 4, 112, 2, 0, 0, 95, 38, 0, 0, 109,
-		1, 0, 0, 0, 0, 0, 0, 103, 38, 0, 0, 109, 1, 0, 0, 64,
-		2, 0, 0, 111, 38, 0, 0, 109, 1, 0, 0, 128, 4, 0, 0, 150,
-		6, 0, 0, 192, 0, 0, 0, 192, 6, 0, 0, 179, 31, 0, 0, 2,
-		0, 0, 0, 0, 7, 0, 0, 102, 26, 0, 0, 168, 0, 0, 0, 32,
-		7, 0, 0, 122, 38, 0, 0, 252, 0, 0, 0, 64, 7, 0, 0, 255,
-		31, 0, 0, 115, 1, 0, 0, 128, 7, 0, 0, 186, 0, 0, 0, 31,
-		0, 0, 0, 64, 8, 0, 0, 128, 38, 0, 0, 52, 1, 0, 0, 128,
-		8, 0, 0, 149, 38, 0, 0, 60, 0, 0, 0, 192, 8, 0, 0, 167,
-		38, 0, 0, 3, 1, 0, 0, 64, 9, 0, 0, 189, 38, 0, 0, 182,
-		0, 0, 0, 128, 9, 0, 0, 201, 38, 0, 0, 3, 1, 0, 0, 192,
-		10, 0, 0, 64, 21, 0, 0, 102, 0, 0, 0, 0, 11, 0, 0, 229,
-		38, 0, 0, 118, 1, 0, 0, 0, 12, 0, 0, 233, 38, 0, 0, 140,
-		1, 0, 0, 0, 15, 0, 0, 127, 33, 0, 0, 141, 1, 0, 0, 64,
-		15, 0, 0, 241, 38, 0, 0, 142, 1, 0, 0, 128, 15, 0, 0, 252,
-		38, 0, 0, 2, 0, 0, 4, 72, 0, 0, 0, 8, 39, 0, 0, 8,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 110, 1, 0, 0, 64,
-		0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 5, 64, 0, 0, 0, 19,
-		39, 0, 0, 112, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 113,
-		1, 0, 0, 0, 0, 0, 0, 26, 39, 0, 0, 3, 0, 0, 4, 12,
-		0, 0, 0, 247, 23, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 41,
-		39, 0, 0, 8, 0, 0, 0, 32, 0, 0, 0, 101, 14, 0, 0, 8,
-		0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
-		0, 0, 0, 111, 1, 0, 0, 4, 0, 0, 0, 5, 0, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 53, 39, 0, 0, 114,
-		1, 0, 0, 0, 0, 0, 0, 61, 39, 0, 0, 114, 1, 0, 0, 64,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 111, 1, 0, 0, 69,
-		39, 0, 0, 4, 0, 0, 4, 24, 0, 0, 0, 79, 39, 0, 0, 103,
-		0, 0, 0, 0, 0, 0, 0, 87, 39, 0, 0, 116, 1, 0, 0, 64,
-		0, 0, 0, 91, 39, 0, 0, 10, 0, 0, 0, 128, 0, 0, 0, 101,
-		14, 0, 0, 45, 0, 0, 0, 160, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 117, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 186,
-		3, 0, 0, 96, 39, 0, 0, 2, 0, 0, 4, 96, 0, 0, 0, 110,
-		39, 0, 0, 119, 1, 0, 0, 0, 0, 0, 0, 118, 39, 0, 0, 122,
-		1, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 120,
-		1, 0, 0, 0, 0, 0, 0, 1, 0, 0, 13, 2, 0, 0, 0, 0,
-		0, 0, 0, 121, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 118,
-		1, 0, 0, 122, 39, 0, 0, 2, 0, 0, 4, 88, 0, 0, 0, 130,
-		39, 0, 0, 123, 1, 0, 0, 0, 0, 0, 0, 41, 38, 0, 0, 66,
-		0, 0, 0, 128, 2, 0, 0, 137, 39, 0, 0, 8, 0, 0, 4, 80,
-		0, 0, 0, 0, 0, 0, 0, 124, 1, 0, 0, 0, 0, 0, 0, 154,
-		39, 0, 0, 241, 0, 0, 0, 192, 0, 0, 0, 168, 39, 0, 0, 126,
-		1, 0, 0, 0, 1, 0, 0, 41, 38, 0, 0, 137, 1, 0, 0, 64,
-		1, 0, 0, 229, 38, 0, 0, 121, 1, 0, 0, 128, 1, 0, 0, 150,
-		6, 0, 0, 138, 1, 0, 0, 192, 1, 0, 0, 167, 19, 0, 0, 139,
-		1, 0, 0, 0, 2, 0, 0, 185, 31, 0, 0, 122, 0, 0, 0, 64,
-		2, 0, 0, 0, 0, 0, 0, 2, 0, 0, 5, 24, 0, 0, 0, 0,
-		0, 0, 0, 125, 1, 0, 0, 0, 0, 0, 0, 76, 20, 0, 0, 111,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 4, 0, 0, 4, 24,
-		0, 0, 0, 182, 39, 0, 0, 126, 1, 0, 0, 0, 0, 0, 0, 192,
-		39, 0, 0, 2, 0, 0, 0, 64, 0, 0, 0, 101, 14, 0, 0, 2,
-		0, 0, 0, 96, 0, 0, 0, 197, 39, 0, 0, 2, 0, 0, 0, 128,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 129, 1, 0, 0, 202,
-		39, 0, 0, 2, 0, 0, 4, 32, 0, 0, 0, 213, 39, 0, 0, 10,
-		0, 0, 0, 0, 0, 0, 0, 218, 39, 0, 0, 128, 1, 0, 0, 64,
-		0, 0, 0, 223, 39, 0, 0, 2, 0, 0, 4, 24, 0, 0, 0, 63,
-		18, 0, 0, 70, 0, 0, 0, 0, 0, 0, 0, 240, 39, 0, 0, 60,
-		0, 0, 0, 64, 0, 0, 0, 182, 39, 0, 0, 9, 0, 0, 4, 64,
-		0, 0, 0, 250, 39, 0, 0, 24, 1, 0, 0, 0, 0, 0, 0, 246,
-		20, 0, 0, 44, 0, 0, 0, 64, 0, 0, 0, 3, 40, 0, 0, 2,
-		0, 0, 0, 128, 0, 0, 0, 10, 40, 0, 0, 130, 1, 0, 0, 160,
-		0, 0, 0, 15, 40, 0, 0, 126, 1, 0, 0, 192, 0, 0, 0, 21,
-		40, 0, 0, 131, 1, 0, 0, 0, 1, 0, 0, 251, 30, 0, 0, 136,
-		1, 0, 0, 64, 1, 0, 0, 34, 40, 0, 0, 44, 0, 0, 0, 128,
-		1, 0, 0, 41, 40, 0, 0, 44, 0, 0, 0, 192, 1, 0, 0, 48,
-		40, 0, 0, 0, 0, 0, 8, 29, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 132, 1, 0, 0, 21, 40, 0, 0, 0, 0, 0, 8, 133,
-		1, 0, 0, 0, 0, 0, 0, 5, 0, 0, 13, 2, 0, 0, 0, 0,
-		0, 0, 0, 126, 1, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0,
-		0, 0, 0, 44, 0, 0, 0, 0, 0, 0, 0, 134, 1, 0, 0, 0,
-		0, 0, 0, 135, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 28,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 156, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 200, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 201, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 122,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 202, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 123, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 143, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
-		0, 0, 0, 30, 0, 0, 0, 4, 0, 0, 0, 16, 0, 0, 0, 127,
-		33, 0, 0, 5, 0, 0, 4, 160, 0, 0, 0, 167, 19, 0, 0, 238,
-		0, 0, 0, 0, 0, 0, 0, 255, 31, 0, 0, 192, 0, 0, 0, 128,
-		0, 0, 0, 89, 28, 0, 0, 168, 0, 0, 0, 192, 0, 0, 0, 101,
-		14, 0, 0, 47, 0, 0, 0, 224, 0, 0, 0, 56, 40, 0, 0, 144,
-		1, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
-		0, 0, 0, 103, 0, 0, 0, 4, 0, 0, 0, 16, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 231, 3, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 5, 16, 0, 0, 0, 63, 40, 0, 0, 2, 0, 0, 0, 0,
-		0, 0, 0, 76, 20, 0, 0, 111, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 33, 0, 0, 0, 4,
-		0, 0, 0, 16, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 243,
-		3, 0, 0, 71, 40, 0, 0, 1, 0, 0, 4, 8, 0, 0, 0, 80,
-		40, 0, 0, 150, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 244, 3, 0, 0, 90, 40, 0, 0, 1, 0, 0, 4, 16,
-		0, 0, 0, 99, 40, 0, 0, 60, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 228, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 245, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 246,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 151, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 248, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 241, 3, 0, 0, 109, 40, 0, 0, 0, 0, 0, 8, 159,
-		1, 0, 0, 0, 0, 0, 0, 1, 0, 0, 4, 8, 0, 0, 0, 118,
-		40, 0, 0, 160, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 3, 0, 0, 0, 0, 31, 0, 0, 0, 4, 0, 0, 0, 1,
-		0, 0, 0, 122, 40, 0, 0, 2, 0, 0, 4, 24, 0, 0, 0, 80,
-		20, 0, 0, 60, 0, 0, 0, 0, 0, 0, 0, 47, 8, 0, 0, 158,
-		1, 0, 0, 128, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 35,
-		4, 0, 0, 184, 8, 0, 0, 3, 0, 0, 4, 16, 0, 0, 0, 10,
-		40, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 133, 40, 0, 0, 47,
-		0, 0, 0, 32, 0, 0, 0, 146, 40, 0, 0, 164, 1, 0, 0, 64,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 38, 4, 0, 0, 153,
-		40, 0, 0, 0, 0, 0, 4, 0, 0, 0, 0, 175, 40, 0, 0, 1,
-		0, 0, 4, 8, 0, 0, 0, 213, 14, 0, 0, 167, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 2, 0, 0, 4, 16,
+		0, 0, 0, 48, 0, 0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 53,
+		0, 0, 0, 15, 0, 0, 0, 64, 0, 0, 0, 85, 0, 0, 0, 0,
+		0, 0, 14, 17, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 23, 0, 0, 0, 92, 0, 0, 0, 0, 0, 0, 8, 21,
+		0, 0, 0, 95, 0, 0, 0, 0, 0, 0, 8, 22, 0, 0, 0, 100,
+		0, 0, 0, 0, 0, 0, 1, 1, 0, 0, 0, 8, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 20, 0, 0, 0, 4,
+		0, 0, 0, 0, 4, 0, 0, 0, 0, 0, 0, 4, 0, 0, 4, 32,
+		0, 0, 0, 48, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 53,
+		0, 0, 0, 5, 0, 0, 0, 64, 0, 0, 0, 65, 0, 0, 0, 7,
+		0, 0, 0, 128, 0, 0, 0, 69, 0, 0, 0, 19, 0, 0, 0, 192,
+		0, 0, 0, 114, 0, 0, 0, 0, 0, 0, 14, 24, 0, 0, 0, 1,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 27, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 2, 0, 0, 0, 4,
+		0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 29,
+		0, 0, 0, 129, 0, 0, 0, 0, 0, 0, 8, 30, 0, 0, 0, 133,
+		0, 0, 0, 0, 0, 0, 8, 31, 0, 0, 0, 139, 0, 0, 0, 0,
+		0, 0, 1, 8, 0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 4, 32, 0, 0, 0, 48, 0, 0, 0, 26, 0, 0, 0, 0,
+		0, 0, 0, 53, 0, 0, 0, 26, 0, 0, 0, 64, 0, 0, 0, 65,
+		0, 0, 0, 7, 0, 0, 0, 128, 0, 0, 0, 69, 0, 0, 0, 28,
+		0, 0, 0, 192, 0, 0, 0, 158, 0, 0, 0, 0, 0, 0, 14, 32,
+		0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 35,
+		0, 0, 0, 172, 0, 0, 0, 4, 0, 0, 4, 64, 0, 0, 0, 198,
+		0, 0, 0, 36, 0, 0, 0, 0, 0, 0, 0, 202, 0, 0, 0, 38,
+		0, 0, 0, 64, 0, 0, 0, 205, 0, 0, 0, 40, 0, 0, 0, 128,
+		0, 0, 0, 210, 0, 0, 0, 42, 0, 0, 0, 0, 2, 0, 0, 217,
+		0, 0, 0, 4, 0, 0, 4, 8, 0, 0, 0, 48, 0, 0, 0, 37,
+		0, 0, 0, 0, 0, 0, 0, 229, 0, 0, 0, 22, 0, 0, 0, 16,
+		0, 0, 0, 235, 0, 0, 0, 22, 0, 0, 0, 24, 0, 0, 0, 249,
+		0, 0, 0, 2, 0, 0, 0, 32, 0, 0, 0, 253, 0, 0, 0, 0,
+		0, 0, 1, 2, 0, 0, 0, 16, 0, 0, 0, 12, 1, 0, 0, 0,
+		0, 0, 1, 8, 0, 0, 0, 64, 0, 0, 1, 17, 1, 0, 0, 0,
+		0, 0, 1, 8, 0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 3, 0, 0, 0, 0, 39, 0, 0, 0, 4, 0, 0, 0, 6,
+		0, 0, 0, 31, 1, 0, 0, 0, 0, 0, 1, 1, 0, 0, 0, 8,
+		0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 41,
+		0, 0, 0, 4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1,
+		0, 0, 13, 2, 0, 0, 0, 205, 0, 0, 0, 34, 0, 0, 0, 36,
+		1, 0, 0, 1, 0, 0, 12, 43, 0, 0, 0, 105, 2, 0, 0, 240,
+		0, 0, 132, 0, 34, 0, 0, 117, 2, 0, 0, 46, 0, 0, 0, 0,
+		0, 0, 0, 129, 2, 0, 0, 10, 0, 0, 0, 0, 1, 0, 0, 137,
+		2, 0, 0, 49, 0, 0, 0, 64, 1, 0, 0, 143, 2, 0, 0, 50,
+		0, 0, 0, 128, 1, 0, 0, 229, 0, 0, 0, 10, 0, 0, 0, 160,
+		1, 0, 0, 149, 2, 0, 0, 10, 0, 0, 0, 192, 1, 0, 0, 156,
+		2, 0, 0, 2, 0, 0, 0, 224, 1, 0, 0, 163, 2, 0, 0, 54,
+		0, 0, 0, 0, 2, 0, 0, 174, 2, 0, 0, 10, 0, 0, 0, 128,
+		2, 0, 0, 186, 2, 0, 0, 39, 0, 0, 0, 192, 2, 0, 0, 206,
+		2, 0, 0, 60, 0, 0, 0, 0, 3, 0, 0, 217, 2, 0, 0, 2,
+		0, 0, 0, 64, 3, 0, 0, 233, 2, 0, 0, 2, 0, 0, 0, 96,
+		3, 0, 0, 242, 2, 0, 0, 2, 0, 0, 0, 128, 3, 0, 0, 248,
+		2, 0, 0, 2, 0, 0, 0, 160, 3, 0, 0, 253, 2, 0, 0, 2,
+		0, 0, 0, 192, 3, 0, 0, 9, 3, 0, 0, 2, 0, 0, 0, 224,
+		3, 0, 0, 21, 3, 0, 0, 10, 0, 0, 0, 0, 4, 0, 0, 33,
+		3, 0, 0, 61, 0, 0, 0, 0, 6, 0, 0, 36, 3, 0, 0, 71,
+		0, 0, 0, 0, 14, 0, 0, 39, 3, 0, 0, 73, 0, 0, 0, 128,
+		15, 0, 0, 42, 3, 0, 0, 86, 0, 0, 0, 128, 22, 0, 0, 54,
+		3, 0, 0, 63, 0, 0, 0, 192, 22, 0, 0, 64, 3, 0, 0, 39,
+		0, 0, 0, 128, 23, 0, 0, 76, 3, 0, 0, 10, 0, 0, 0, 192,
+		23, 0, 0, 92, 3, 0, 0, 88, 0, 0, 0, 0, 24, 0, 0, 109,
+		3, 0, 0, 89, 0, 0, 0, 0, 26, 0, 0, 115, 3, 0, 0, 90,
+		0, 0, 0, 0, 34, 0, 0, 133, 3, 0, 0, 10, 0, 0, 0, 64,
+		34, 0, 0, 144, 3, 0, 0, 10, 0, 0, 0, 96, 34, 0, 0, 151,
+		3, 0, 0, 2, 0, 0, 0, 128, 34, 0, 0, 167, 3, 0, 0, 92,
+		0, 0, 0, 192, 34, 0, 0, 176, 3, 0, 0, 95, 0, 0, 0, 0,
+		35, 0, 0, 190, 3, 0, 0, 94, 0, 0, 0, 64, 35, 0, 0, 200,
+		3, 0, 0, 49, 0, 0, 0, 64, 51, 0, 0, 218, 3, 0, 0, 37,
+		0, 0, 0, 128, 51, 0, 0, 237, 3, 0, 0, 37, 0, 0, 0, 144,
+		51, 0, 0, 253, 3, 0, 0, 2, 0, 0, 0, 160, 51, 0, 0, 19,
+		4, 0, 0, 98, 0, 0, 0, 192, 51, 0, 0, 43, 4, 0, 0, 65,
+		0, 0, 0, 0, 52, 0, 0, 58, 4, 0, 0, 100, 0, 0, 0, 128,
+		52, 0, 0, 75, 4, 0, 0, 39, 0, 0, 0, 192, 52, 0, 0, 91,
+		4, 0, 0, 20, 0, 0, 0, 0, 53, 0, 0, 109, 4, 0, 0, 20,
+		0, 0, 0, 8, 53, 0, 0, 123, 4, 0, 0, 2, 0, 0, 0, 32,
+		53, 0, 0, 142, 4, 0, 0, 65, 0, 0, 0, 64, 53, 0, 0, 165,
+		4, 0, 0, 2, 0, 0, 0, 192, 53, 0, 0, 184, 4, 0, 0, 2,
+		0, 0, 0, 224, 53, 0, 0, 199, 4, 0, 0, 98, 0, 0, 0, 0,
+		54, 0, 0, 218, 4, 0, 0, 65, 0, 0, 0, 64, 54, 0, 0, 235,
+		4, 0, 0, 65, 0, 0, 0, 192, 54, 0, 0, 249, 4, 0, 0, 2,
+		0, 0, 0, 64, 55, 0, 0, 6, 5, 0, 0, 101, 0, 0, 0, 128,
+		55, 0, 0, 17, 5, 0, 0, 65, 0, 0, 0, 128, 56, 0, 0, 23,
+		5, 0, 0, 102, 0, 0, 0, 0, 57, 0, 0, 38, 5, 0, 0, 63,
+		0, 0, 0, 64, 58, 0, 0, 56, 5, 0, 0, 103, 0, 0, 0, 0,
+		59, 0, 0, 59, 5, 0, 0, 103, 0, 0, 0, 64, 59, 0, 0, 69,
+		5, 0, 0, 104, 0, 0, 0, 128, 59, 0, 0, 78, 5, 0, 0, 124,
+		0, 0, 0, 192, 60, 0, 0, 87, 5, 0, 0, 2, 0, 0, 0, 96,
+		61, 0, 0, 98, 5, 0, 0, 2, 0, 0, 0, 128, 61, 0, 0, 108,
+		5, 0, 0, 2, 0, 0, 0, 160, 61, 0, 0, 120, 5, 0, 0, 2,
+		0, 0, 0, 192, 61, 0, 0, 134, 5, 0, 0, 39, 0, 0, 0, 0,
+		62, 0, 0, 141, 5, 0, 0, 10, 0, 0, 0, 64, 62, 0, 0, 153,
+		5, 0, 0, 10, 0, 0, 0, 96, 62, 0, 1, 173, 5, 0, 0, 10,
+		0, 0, 0, 97, 62, 0, 1, 199, 5, 0, 0, 10, 0, 0, 0, 98,
+		62, 0, 1, 214, 5, 0, 0, 10, 0, 0, 0, 99, 62, 0, 1, 237,
+		5, 0, 0, 10, 0, 0, 0, 128, 62, 0, 1, 1, 6, 0, 0, 10,
+		0, 0, 0, 129, 62, 0, 1, 11, 6, 0, 0, 10, 0, 0, 0, 130,
+		62, 0, 1, 21, 6, 0, 0, 10, 0, 0, 0, 131, 62, 0, 1, 35,
+		6, 0, 0, 10, 0, 0, 0, 132, 62, 0, 1, 55, 6, 0, 0, 10,
+		0, 0, 0, 133, 62, 0, 1, 62, 6, 0, 0, 10, 0, 0, 0, 134,
+		62, 0, 1, 75, 6, 0, 0, 10, 0, 0, 0, 135, 62, 0, 1, 87,
+		6, 0, 0, 10, 0, 0, 0, 136, 62, 0, 1, 101, 6, 0, 0, 10,
+		0, 0, 0, 137, 62, 0, 1, 112, 6, 0, 0, 10, 0, 0, 0, 138,
+		62, 0, 1, 128, 6, 0, 0, 39, 0, 0, 0, 192, 62, 0, 0, 141,
+		6, 0, 0, 126, 0, 0, 0, 0, 63, 0, 0, 249, 0, 0, 0, 141,
+		0, 0, 0, 192, 64, 0, 0, 155, 6, 0, 0, 141, 0, 0, 0, 224,
+		64, 0, 0, 160, 6, 0, 0, 60, 0, 0, 0, 0, 65, 0, 0, 172,
+		6, 0, 0, 60, 0, 0, 0, 64, 65, 0, 0, 179, 6, 0, 0, 65,
+		0, 0, 0, 128, 65, 0, 0, 188, 6, 0, 0, 65, 0, 0, 0, 0,
+		66, 0, 0, 196, 6, 0, 0, 60, 0, 0, 0, 128, 66, 0, 0, 209,
+		6, 0, 0, 65, 0, 0, 0, 192, 66, 0, 0, 217, 6, 0, 0, 65,
+		0, 0, 0, 64, 67, 0, 0, 230, 6, 0, 0, 143, 0, 0, 0, 192,
+		67, 0, 0, 241, 6, 0, 0, 146, 0, 0, 0, 0, 68, 0, 0, 251,
+		6, 0, 0, 65, 0, 0, 0, 0, 70, 0, 0, 8, 7, 0, 0, 65,
+		0, 0, 0, 128, 70, 0, 0, 20, 7, 0, 0, 147, 0, 0, 0, 0,
+		71, 0, 0, 31, 7, 0, 0, 148, 0, 0, 0, 64, 71, 0, 0, 45,
+		7, 0, 0, 148, 0, 0, 0, 128, 71, 0, 0, 61, 7, 0, 0, 49,
+		0, 0, 0, 192, 71, 0, 0, 76, 7, 0, 0, 29, 0, 0, 0, 0,
+		72, 0, 0, 82, 7, 0, 0, 29, 0, 0, 0, 64, 72, 0, 0, 88,
+		7, 0, 0, 29, 0, 0, 0, 128, 72, 0, 0, 94, 7, 0, 0, 149,
+		0, 0, 0, 192, 72, 0, 0, 107, 7, 0, 0, 157, 0, 0, 0, 128,
+		73, 0, 0, 113, 7, 0, 0, 52, 0, 0, 0, 0, 75, 0, 0, 127,
+		7, 0, 0, 39, 0, 0, 0, 64, 75, 0, 0, 133, 7, 0, 0, 39,
+		0, 0, 0, 128, 75, 0, 0, 140, 7, 0, 0, 29, 0, 0, 0, 192,
+		75, 0, 0, 151, 7, 0, 0, 29, 0, 0, 0, 0, 76, 0, 0, 166,
+		7, 0, 0, 39, 0, 0, 0, 64, 76, 0, 0, 174, 7, 0, 0, 39,
+		0, 0, 0, 128, 76, 0, 0, 182, 7, 0, 0, 161, 0, 0, 0, 192,
+		76, 0, 0, 198, 7, 0, 0, 167, 0, 0, 0, 64, 79, 0, 0, 219,
+		7, 0, 0, 172, 0, 0, 0, 0, 80, 0, 0, 232, 7, 0, 0, 172,
+		0, 0, 0, 64, 80, 0, 0, 242, 7, 0, 0, 172, 0, 0, 0, 128,
+		80, 0, 0, 247, 7, 0, 0, 174, 0, 0, 0, 192, 80, 0, 0, 12,
+		8, 0, 0, 175, 0, 0, 0, 0, 81, 0, 0, 17, 8, 0, 0, 176,
+		0, 0, 0, 128, 81, 0, 0, 27, 8, 0, 0, 177, 0, 0, 0, 192,
+		81, 0, 0, 35, 8, 0, 0, 179, 0, 0, 0, 0, 82, 0, 0, 43,
+		8, 0, 0, 180, 0, 0, 0, 128, 82, 0, 0, 46, 8, 0, 0, 181,
+		0, 0, 0, 192, 82, 0, 0, 52, 8, 0, 0, 182, 0, 0, 0, 0,
+		83, 0, 0, 61, 8, 0, 0, 183, 0, 0, 0, 64, 83, 0, 0, 69,
+		8, 0, 0, 184, 0, 0, 0, 128, 83, 0, 0, 76, 8, 0, 0, 185,
+		0, 0, 0, 192, 83, 0, 0, 84, 8, 0, 0, 186, 0, 0, 0, 0,
+		84, 0, 0, 92, 8, 0, 0, 186, 0, 0, 0, 64, 84, 0, 0, 105,
+		8, 0, 0, 186, 0, 0, 0, 128, 84, 0, 0, 119, 8, 0, 0, 189,
+		0, 0, 0, 192, 84, 0, 0, 127, 8, 0, 0, 39, 0, 0, 0, 128,
+		85, 0, 0, 137, 8, 0, 0, 190, 0, 0, 0, 192, 85, 0, 0, 149,
+		8, 0, 0, 10, 0, 0, 0, 0, 86, 0, 0, 162, 8, 0, 0, 169,
+		0, 0, 0, 64, 86, 0, 0, 173, 8, 0, 0, 193, 0, 0, 0, 128,
+		86, 0, 0, 187, 8, 0, 0, 194, 0, 0, 0, 192, 86, 0, 0, 196,
+		8, 0, 0, 10, 0, 0, 0, 224, 86, 0, 0, 206, 8, 0, 0, 198,
+		0, 0, 0, 0, 87, 0, 0, 214, 8, 0, 0, 200, 0, 0, 0, 128,
+		87, 0, 0, 231, 8, 0, 0, 29, 0, 0, 0, 128, 87, 0, 0, 246,
+		8, 0, 0, 29, 0, 0, 0, 192, 87, 0, 0, 3, 9, 0, 0, 201,
+		0, 0, 0, 0, 88, 0, 0, 14, 9, 0, 0, 150, 0, 0, 0, 32,
+		88, 0, 0, 22, 9, 0, 0, 204, 0, 0, 0, 64, 88, 0, 0, 29,
+		9, 0, 0, 164, 0, 0, 0, 128, 88, 0, 0, 40, 9, 0, 0, 60,
+		0, 0, 0, 0, 89, 0, 0, 52, 9, 0, 0, 206, 0, 0, 0, 64,
+		89, 0, 0, 66, 9, 0, 0, 49, 0, 0, 0, 128, 89, 0, 0, 79,
+		9, 0, 0, 207, 0, 0, 0, 192, 89, 0, 0, 88, 9, 0, 0, 208,
+		0, 0, 0, 0, 90, 0, 0, 93, 9, 0, 0, 209, 0, 0, 0, 64,
+		90, 0, 0, 107, 9, 0, 0, 210, 0, 0, 0, 128, 90, 0, 0, 124,
+		9, 0, 0, 211, 0, 0, 0, 192, 90, 0, 0, 135, 9, 0, 0, 212,
+		0, 0, 0, 0, 91, 0, 0, 151, 9, 0, 0, 39, 0, 0, 0, 64,
+		91, 0, 0, 166, 9, 0, 0, 213, 0, 0, 0, 128, 91, 0, 0, 179,
+		9, 0, 0, 215, 0, 0, 0, 192, 91, 0, 0, 184, 9, 0, 0, 10,
+		0, 0, 0, 128, 93, 0, 0, 194, 9, 0, 0, 29, 0, 0, 0, 192,
+		93, 0, 0, 208, 9, 0, 0, 29, 0, 0, 0, 0, 94, 0, 0, 221,
+		9, 0, 0, 29, 0, 0, 0, 64, 94, 0, 0, 234, 9, 0, 0, 216,
+		0, 0, 0, 128, 94, 0, 0, 247, 9, 0, 0, 219, 0, 0, 0, 128,
+		96, 0, 0, 8, 10, 0, 0, 2, 0, 0, 0, 160, 96, 0, 0, 32,
+		10, 0, 0, 2, 0, 0, 0, 192, 96, 0, 0, 57, 10, 0, 0, 221,
+		0, 0, 0, 0, 97, 0, 0, 65, 10, 0, 0, 65, 0, 0, 0, 64,
+		97, 0, 0, 73, 10, 0, 0, 222, 0, 0, 0, 192, 97, 0, 0, 85,
+		10, 0, 0, 223, 0, 0, 0, 0, 98, 0, 0, 104, 10, 0, 0, 65,
+		0, 0, 0, 64, 98, 0, 0, 118, 10, 0, 0, 224, 0, 0, 0, 192,
+		98, 0, 0, 133, 10, 0, 0, 225, 0, 0, 0, 0, 99, 0, 0, 150,
+		10, 0, 0, 10, 0, 0, 0, 0, 100, 0, 0, 162, 10, 0, 0, 233,
+		0, 0, 0, 64, 100, 0, 0, 178, 10, 0, 0, 225, 0, 0, 0, 192,
+		100, 0, 0, 195, 10, 0, 0, 65, 0, 0, 0, 192, 101, 0, 0, 211,
+		10, 0, 0, 39, 0, 0, 0, 64, 102, 0, 0, 230, 10, 0, 0, 120,
+		0, 0, 0, 128, 102, 0, 0, 240, 10, 0, 0, 234, 0, 0, 0, 192,
+		102, 0, 0, 248, 10, 0, 0, 234, 0, 0, 0, 208, 102, 0, 0, 7,
+		11, 0, 0, 2, 0, 0, 0, 224, 102, 0, 0, 21, 11, 0, 0, 10,
+		0, 0, 0, 0, 103, 0, 0, 38, 11, 0, 0, 10, 0, 0, 0, 32,
+		103, 0, 0, 59, 11, 0, 0, 2, 0, 0, 0, 64, 103, 0, 0, 78,
+		11, 0, 0, 39, 0, 0, 0, 128, 103, 0, 0, 97, 11, 0, 0, 29,
+		0, 0, 0, 192, 103, 0, 0, 108, 11, 0, 0, 29, 0, 0, 0, 0,
+		104, 0, 0, 133, 11, 0, 0, 29, 0, 0, 0, 64, 104, 0, 0, 155,
+		11, 0, 0, 168, 0, 0, 0, 128, 104, 0, 0, 165, 11, 0, 0, 235,
+		0, 0, 0, 0, 105, 0, 0, 176, 11, 0, 0, 236, 0, 0, 0, 64,
+		105, 0, 0, 188, 11, 0, 0, 39, 0, 0, 0, 128, 105, 0, 0, 206,
+		11, 0, 0, 237, 0, 0, 0, 192, 105, 0, 0, 227, 11, 0, 0, 39,
+		0, 0, 0, 128, 106, 0, 0, 247, 11, 0, 0, 238, 0, 0, 0, 192,
+		106, 0, 0, 252, 11, 0, 0, 8, 0, 0, 0, 0, 107, 0, 0, 5,
+		12, 0, 0, 39, 0, 0, 0, 64, 107, 0, 0, 21, 12, 0, 0, 239,
+		0, 0, 0, 128, 107, 0, 0, 0, 0, 0, 0, 240, 0, 0, 0, 128,
+		107, 0, 0, 29, 12, 0, 0, 241, 0, 0, 0, 0, 108, 0, 0, 41,
+		12, 0, 0, 242, 0, 0, 0, 64, 108, 0, 0, 51, 12, 0, 0, 244,
+		0, 0, 0, 192, 108, 0, 0, 58, 12, 0, 0, 2, 0, 0, 0, 0,
+		109, 0, 0, 69, 12, 0, 0, 2, 0, 0, 0, 32, 109, 0, 0, 86,
+		12, 0, 0, 39, 0, 0, 0, 64, 109, 0, 0, 104, 12, 0, 0, 2,
+		0, 0, 0, 128, 109, 0, 0, 125, 12, 0, 0, 247, 0, 0, 0, 192,
+		109, 0, 0, 140, 12, 0, 0, 29, 0, 0, 0, 192, 229, 0, 0, 155,
+		12, 0, 0, 29, 0, 0, 0, 0, 230, 0, 0, 178, 12, 0, 0, 248,
+		0, 0, 0, 64, 230, 0, 0, 189, 12, 0, 0, 2, 0, 0, 0, 128,
+		230, 0, 0, 204, 12, 0, 0, 2, 0, 0, 0, 160, 230, 0, 0, 219,
+		12, 0, 0, 249, 0, 0, 0, 192, 230, 0, 0, 229, 12, 0, 0, 31,
+		0, 0, 0, 0, 231, 0, 0, 246, 12, 0, 0, 52, 0, 0, 0, 64,
+		231, 0, 0, 4, 13, 0, 0, 52, 0, 0, 0, 96, 231, 0, 0, 24,
+		13, 0, 0, 39, 0, 0, 0, 128, 231, 0, 0, 30, 13, 0, 0, 39,
+		0, 0, 0, 192, 231, 0, 0, 46, 13, 0, 0, 250, 0, 0, 0, 0,
+		232, 0, 0, 59, 13, 0, 0, 251, 0, 0, 0, 64, 232, 0, 0, 78,
+		13, 0, 0, 2, 0, 0, 0, 96, 232, 0, 0, 94, 13, 0, 0, 10,
+		0, 0, 0, 128, 232, 0, 0, 119, 13, 0, 0, 250, 0, 0, 0, 192,
+		232, 0, 0, 132, 13, 0, 0, 252, 0, 0, 0, 0, 233, 0, 0, 147,
+		13, 0, 0, 253, 0, 0, 0, 64, 233, 0, 0, 153, 13, 0, 0, 10,
+		0, 0, 0, 128, 233, 0, 0, 167, 13, 0, 0, 10, 0, 0, 0, 160,
+		233, 0, 0, 185, 13, 0, 0, 254, 0, 0, 0, 192, 233, 0, 0, 195,
+		13, 0, 0, 2, 0, 0, 0, 192, 233, 0, 0, 214, 13, 0, 0, 60,
+		0, 0, 0, 0, 234, 0, 0, 230, 13, 0, 0, 255, 0, 0, 0, 64,
+		234, 0, 0, 247, 13, 0, 0, 3, 1, 0, 0, 128, 235, 0, 0, 5,
+		14, 0, 0, 50, 0, 0, 0, 192, 235, 0, 0, 20, 14, 0, 0, 49,
+		0, 0, 0, 0, 236, 0, 0, 29, 14, 0, 0, 4, 1, 0, 0, 64,
+		236, 0, 0, 41, 14, 0, 0, 5, 1, 0, 0, 128, 236, 0, 0, 49,
+		14, 0, 0, 6, 1, 0, 0, 192, 236, 0, 0, 69, 14, 0, 0, 7,
+		1, 0, 0, 0, 237, 0, 0, 117, 2, 0, 0, 4, 0, 0, 4, 32,
+		0, 0, 0, 229, 0, 0, 0, 39, 0, 0, 0, 0, 0, 0, 0, 76,
+		14, 0, 0, 29, 0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 47,
+		0, 0, 0, 128, 0, 0, 0, 82, 14, 0, 0, 8, 0, 0, 0, 192,
+		0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 5, 8, 0, 0, 0, 235,
+		0, 0, 0, 29, 0, 0, 0, 0, 0, 0, 0, 86, 14, 0, 0, 48,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 4, 8,
+		0, 0, 0, 94, 14, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 100,
+		14, 0, 0, 8, 0, 0, 0, 32, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 0, 0, 0, 0, 113, 14, 0, 0, 0, 0, 0, 8, 51,
+		0, 0, 0, 124, 14, 0, 0, 1, 0, 0, 4, 4, 0, 0, 0, 140,
+		14, 0, 0, 52, 0, 0, 0, 0, 0, 0, 0, 145, 14, 0, 0, 0,
+		0, 0, 8, 53, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 4, 4,
+		0, 0, 0, 154, 14, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 162,
+		14, 0, 0, 4, 0, 0, 4, 16, 0, 0, 0, 181, 14, 0, 0, 55,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 57, 0, 0, 0, 64,
+		0, 0, 0, 187, 14, 0, 0, 58, 0, 0, 0, 96, 0, 0, 0, 191,
+		14, 0, 0, 58, 0, 0, 0, 112, 0, 0, 0, 195, 14, 0, 0, 1,
+		0, 0, 4, 8, 0, 0, 0, 206, 14, 0, 0, 56, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 55, 0, 0, 0, 0,
+		0, 0, 0, 2, 0, 0, 5, 4, 0, 0, 0, 211, 14, 0, 0, 10,
+		0, 0, 0, 0, 0, 0, 0, 219, 14, 0, 0, 52, 0, 0, 0, 0,
+		0, 0, 0, 227, 14, 0, 0, 0, 0, 0, 8, 59, 0, 0, 0, 231,
+		14, 0, 0, 0, 0, 0, 8, 37, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 45, 0, 0, 0, 237, 14, 0, 0, 15, 0, 0, 4, 0,
+		1, 0, 0, 250, 14, 0, 0, 62, 0, 0, 0, 0, 0, 0, 0, 255,
+		14, 0, 0, 63, 0, 0, 0, 128, 0, 0, 0, 8, 15, 0, 0, 65,
+		0, 0, 0, 64, 1, 0, 0, 242, 2, 0, 0, 10, 0, 0, 0, 192,
+		1, 0, 0, 19, 15, 0, 0, 29, 0, 0, 0, 0, 2, 0, 0, 30,
+		15, 0, 0, 29, 0, 0, 0, 64, 2, 0, 0, 47, 15, 0, 0, 29,
+		0, 0, 0, 128, 2, 0, 0, 56, 15, 0, 0, 29, 0, 0, 0, 192,
+		2, 0, 0, 78, 15, 0, 0, 29, 0, 0, 0, 0, 3, 0, 0, 92,
+		15, 0, 0, 2, 0, 0, 0, 64, 3, 0, 0, 172, 6, 0, 0, 67,
+		0, 0, 0, 128, 3, 0, 0, 98, 15, 0, 0, 68, 0, 0, 0, 192,
+		3, 0, 0, 105, 15, 0, 0, 68, 0, 0, 0, 0, 4, 0, 0, 110,
+		15, 0, 0, 39, 0, 0, 0, 64, 4, 0, 0, 126, 15, 0, 0, 69,
+		0, 0, 0, 0, 6, 0, 0, 130, 15, 0, 0, 2, 0, 0, 4, 16,
+		0, 0, 0, 142, 15, 0, 0, 39, 0, 0, 0, 0, 0, 0, 0, 149,
+		15, 0, 0, 8, 0, 0, 0, 64, 0, 0, 0, 160, 15, 0, 0, 3,
+		0, 0, 4, 24, 0, 0, 0, 168, 15, 0, 0, 39, 0, 0, 0, 0,
+		0, 0, 0, 186, 15, 0, 0, 64, 0, 0, 0, 64, 0, 0, 0, 195,
+		15, 0, 0, 64, 0, 0, 0, 128, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 63, 0, 0, 0, 203, 15, 0, 0, 2, 0, 0, 4, 16,
+		0, 0, 0, 206, 14, 0, 0, 66, 0, 0, 0, 0, 0, 0, 0, 213,
+		15, 0, 0, 66, 0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 65, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 61,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 146, 1, 0, 0, 218,
+		15, 0, 0, 9, 0, 0, 4, 64, 0, 0, 0, 228, 15, 0, 0, 29,
+		0, 0, 0, 0, 0, 0, 0, 245, 15, 0, 0, 29, 0, 0, 0, 64,
+		0, 0, 0, 254, 15, 0, 0, 29, 0, 0, 0, 128, 0, 0, 0, 11,
+		16, 0, 0, 8, 0, 0, 0, 192, 0, 0, 0, 20, 16, 0, 0, 8,
+		0, 0, 0, 224, 0, 0, 0, 35, 16, 0, 0, 39, 0, 0, 0, 0,
+		1, 0, 0, 44, 16, 0, 0, 39, 0, 0, 0, 64, 1, 0, 0, 57,
+		16, 0, 0, 39, 0, 0, 0, 128, 1, 0, 0, 66, 16, 0, 0, 70,
+		0, 0, 0, 192, 1, 0, 0, 66, 16, 0, 0, 2, 0, 0, 4, 8,
+		0, 0, 0, 75, 16, 0, 0, 10, 0, 0, 0, 0, 0, 0, 0, 84,
+		16, 0, 0, 10, 0, 0, 0, 32, 0, 0, 0, 89, 16, 0, 0, 7,
+		0, 0, 4, 48, 0, 0, 0, 105, 16, 0, 0, 65, 0, 0, 0, 0,
+		0, 0, 0, 114, 16, 0, 0, 39, 0, 0, 0, 128, 0, 0, 0, 122,
+		16, 0, 0, 39, 0, 0, 0, 192, 0, 0, 0, 137, 16, 0, 0, 10,
+		0, 0, 0, 0, 1, 0, 0, 242, 2, 0, 0, 37, 0, 0, 0, 32,
+		1, 0, 0, 148, 16, 0, 0, 37, 0, 0, 0, 48, 1, 0, 0, 156,
+		16, 0, 0, 72, 0, 0, 0, 64, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 71, 0, 0, 0, 161, 16, 0, 0, 16, 0, 0, 132, 224,
+		0, 0, 0, 160, 15, 0, 0, 63, 0, 0, 0, 0, 0, 0, 0, 177,
+		16, 0, 0, 29, 0, 0, 0, 192, 0, 0, 0, 188, 16, 0, 0, 29,
+		0, 0, 0, 0, 1, 0, 0, 200, 16, 0, 0, 29, 0, 0, 0, 64,
+		1, 0, 0, 210, 16, 0, 0, 29, 0, 0, 0, 128, 1, 0, 0, 216,
+		16, 0, 0, 29, 0, 0, 0, 192, 1, 0, 0, 227, 16, 0, 0, 74,
+		0, 0, 0, 0, 2, 0, 0, 235, 16, 0, 0, 29, 0, 0, 0, 64,
+		2, 0, 0, 229, 0, 0, 0, 10, 0, 0, 0, 128, 2, 0, 0, 244,
+		16, 0, 0, 10, 0, 0, 0, 160, 2, 0, 1, 1, 17, 0, 0, 10,
+		0, 0, 0, 161, 2, 0, 1, 12, 17, 0, 0, 10, 0, 0, 0, 162,
+		2, 0, 1, 30, 17, 0, 0, 10, 0, 0, 0, 163, 2, 0, 1, 41,
+		17, 0, 0, 77, 0, 0, 0, 192, 2, 0, 0, 50, 17, 0, 0, 77,
+		0, 0, 0, 192, 4, 0, 0, 65, 17, 0, 0, 85, 0, 0, 0, 192,
+		6, 0, 0, 71, 17, 0, 0, 0, 0, 0, 8, 75, 0, 0, 0, 75,
+		17, 0, 0, 0, 0, 0, 8, 76, 0, 0, 0, 81, 17, 0, 0, 0,
+		0, 0, 1, 8, 0, 0, 0, 64, 0, 0, 1, 91, 17, 0, 0, 8,
+		0, 0, 4, 64, 0, 0, 0, 99, 17, 0, 0, 78, 0, 0, 0, 0,
+		0, 0, 0, 104, 17, 0, 0, 79, 0, 0, 0, 0, 1, 0, 0, 117,
+		17, 0, 0, 80, 0, 0, 0, 64, 1, 0, 0, 126, 17, 0, 0, 84,
+		0, 0, 0, 128, 1, 0, 0, 131, 17, 0, 0, 20, 0, 0, 0, 192,
+		1, 0, 0, 137, 17, 0, 0, 20, 0, 0, 0, 200, 1, 0, 0, 144,
+		17, 0, 0, 20, 0, 0, 0, 208, 1, 0, 0, 152, 17, 0, 0, 20,
+		0, 0, 0, 216, 1, 0, 0, 160, 17, 0, 0, 2, 0, 0, 4, 32,
+		0, 0, 0, 99, 17, 0, 0, 63, 0, 0, 0, 0, 0, 0, 0, 176,
+		17, 0, 0, 79, 0, 0, 0, 192, 0, 0, 0, 184, 17, 0, 0, 0,
+		0, 0, 8, 74, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 81,
+		0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 13, 82, 0, 0, 0, 0,
+		0, 0, 0, 83, 0, 0, 0, 192, 17, 0, 0, 2, 0, 0, 6, 4,
+		0, 0, 0, 208, 17, 0, 0, 0, 0, 0, 0, 226, 17, 0, 0, 1,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 77, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 160, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 73, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 87,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 194, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 200, 1, 0, 0, 242, 17, 0, 0, 29,
+		0, 0, 4, 0, 1, 0, 0, 3, 18, 0, 0, 29, 0, 0, 0, 0,
+		0, 0, 0, 14, 18, 0, 0, 29, 0, 0, 0, 64, 0, 0, 0, 23,
+		18, 0, 0, 29, 0, 0, 0, 128, 0, 0, 0, 34, 18, 0, 0, 29,
+		0, 0, 0, 192, 0, 0, 0, 43, 18, 0, 0, 29, 0, 0, 0, 0,
+		1, 0, 0, 56, 18, 0, 0, 29, 0, 0, 0, 64, 1, 0, 0, 67,
+		18, 0, 0, 29, 0, 0, 0, 128, 1, 0, 0, 79, 18, 0, 0, 29,
+		0, 0, 0, 192, 1, 0, 0, 89, 18, 0, 0, 74, 0, 0, 0, 0,
+		2, 0, 0, 107, 18, 0, 0, 29, 0, 0, 0, 64, 2, 0, 0, 119,
+		18, 0, 0, 29, 0, 0, 0, 128, 2, 0, 0, 129, 18, 0, 0, 74,
+		0, 0, 0, 192, 2, 0, 0, 147, 18, 0, 0, 29, 0, 0, 0, 0,
+		3, 0, 0, 156, 18, 0, 0, 29, 0, 0, 0, 64, 3, 0, 0, 166,
+		18, 0, 0, 29, 0, 0, 0, 128, 3, 0, 0, 185, 18, 0, 0, 29,
+		0, 0, 0, 192, 3, 0, 0, 213, 18, 0, 0, 29, 0, 0, 0, 0,
+		4, 0, 0, 242, 18, 0, 0, 29, 0, 0, 0, 64, 4, 0, 0, 11,
+		19, 0, 0, 29, 0, 0, 0, 128, 4, 0, 0, 32, 19, 0, 0, 29,
+		0, 0, 0, 192, 4, 0, 0, 43, 19, 0, 0, 29, 0, 0, 0, 0,
+		5, 0, 0, 59, 19, 0, 0, 29, 0, 0, 0, 64, 5, 0, 0, 78,
+		19, 0, 0, 29, 0, 0, 0, 128, 5, 0, 0, 95, 19, 0, 0, 29,
+		0, 0, 0, 192, 5, 0, 0, 113, 19, 0, 0, 29, 0, 0, 0, 0,
+		6, 0, 0, 131, 19, 0, 0, 29, 0, 0, 0, 64, 6, 0, 0, 158,
+		19, 0, 0, 29, 0, 0, 0, 128, 6, 0, 0, 177, 19, 0, 0, 29,
+		0, 0, 0, 192, 6, 0, 0, 193, 19, 0, 0, 29, 0, 0, 0, 0,
+		7, 0, 0, 212, 19, 0, 0, 1, 0, 0, 4, 8, 0, 0, 0, 223,
+		19, 0, 0, 91, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 144, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 93,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 94, 0, 0, 0, 229,
+		19, 0, 0, 0, 0, 0, 8, 96, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 94, 0, 0, 0, 239, 19, 0, 0, 1, 0, 0, 4, 0,
+		2, 0, 0, 247, 19, 0, 0, 97, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 39, 0, 0, 0, 4,
+		0, 0, 0, 64, 0, 0, 0, 252, 19, 0, 0, 2, 0, 0, 5, 4,
+		0, 0, 0, 8, 20, 0, 0, 99, 0, 0, 0, 0, 0, 0, 0, 10,
+		20, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 4, 4, 0, 0, 0, 84, 8, 0, 0, 20, 0, 0, 0, 0,
+		0, 0, 0, 12, 20, 0, 0, 20, 0, 0, 0, 8, 0, 0, 0, 20,
+		20, 0, 0, 20, 0, 0, 0, 16, 0, 0, 0, 29, 20, 0, 0, 20,
+		0, 0, 0, 24, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 188,
+		1, 0, 0, 6, 5, 0, 0, 4, 0, 0, 4, 32, 0, 0, 0, 37,
+		20, 0, 0, 39, 0, 0, 0, 0, 0, 0, 0, 44, 20, 0, 0, 31,
+		0, 0, 0, 64, 0, 0, 0, 54, 20, 0, 0, 31, 0, 0, 0, 128,
+		0, 0, 0, 67, 20, 0, 0, 31, 0, 0, 0, 192, 0, 0, 0, 79,
+		20, 0, 0, 3, 0, 0, 4, 40, 0, 0, 0, 248, 2, 0, 0, 2,
+		0, 0, 0, 0, 0, 0, 0, 90, 20, 0, 0, 65, 0, 0, 0, 64,
+		0, 0, 0, 100, 20, 0, 0, 65, 0, 0, 0, 192, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 169, 1, 0, 0, 69, 5, 0, 0, 2,
+		0, 0, 4, 40, 0, 0, 0, 110, 20, 0, 0, 29, 0, 0, 0, 0,
+		0, 0, 0, 117, 20, 0, 0, 123, 0, 0, 0, 64, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 106, 0, 0, 0, 122, 20, 0, 0, 19,
+		0, 0, 4, 200, 0, 0, 0, 137, 20, 0, 0, 39, 0, 0, 0, 0,
+		0, 0, 0, 146, 20, 0, 0, 39, 0, 0, 0, 64, 0, 0, 0, 153,
+		20, 0, 0, 105, 0, 0, 0, 128, 0, 0, 0, 161, 20, 0, 0, 105,
+		0, 0, 0, 192, 0, 0, 0, 169, 20, 0, 0, 63, 0, 0, 0, 0,
+		1, 0, 0, 175, 20, 0, 0, 39, 0, 0, 0, 192, 1, 0, 0, 190,
+		20, 0, 0, 103, 0, 0, 0, 0, 2, 0, 0, 196, 20, 0, 0, 107,
+		0, 0, 0, 64, 2, 0, 0, 209, 20, 0, 0, 39, 0, 0, 0, 128,
+		2, 0, 0, 0, 0, 0, 0, 110, 0, 0, 0, 192, 2, 0, 0, 218,
+		20, 0, 0, 65, 0, 0, 0, 192, 3, 0, 0, 233, 20, 0, 0, 113,
+		0, 0, 0, 64, 4, 0, 0, 242, 20, 0, 0, 114, 0, 0, 0, 128,
+		4, 0, 0, 249, 20, 0, 0, 39, 0, 0, 0, 192, 4, 0, 0, 2,
+		21, 0, 0, 116, 0, 0, 0, 0, 5, 0, 0, 10, 21, 0, 0, 49,
+		0, 0, 0, 64, 5, 0, 0, 26, 21, 0, 0, 117, 0, 0, 0, 128,
+		5, 0, 0, 46, 21, 0, 0, 120, 0, 0, 0, 192, 5, 0, 0, 56,
+		21, 0, 0, 121, 0, 0, 0, 0, 6, 0, 0, 75, 21, 0, 0, 0,
+		0, 0, 8, 108, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 4, 8,
+		0, 0, 0, 84, 21, 0, 0, 109, 0, 0, 0, 0, 0, 0, 0, 91,
+		21, 0, 0, 0, 0, 0, 8, 29, 0, 0, 0, 0, 0, 0, 0, 2,
+		0, 0, 5, 32, 0, 0, 0, 100, 21, 0, 0, 111, 0, 0, 0, 0,
+		0, 0, 0, 107, 21, 0, 0, 112, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 2, 0, 0, 4, 32, 0, 0, 0, 117, 21, 0, 0, 63,
+		0, 0, 0, 0, 0, 0, 0, 120, 21, 0, 0, 39, 0, 0, 0, 192,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 137, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 136, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 115, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 208,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 154, 1, 0, 0, 136,
+		21, 0, 0, 0, 0, 0, 8, 118, 0, 0, 0, 150, 21, 0, 0, 0,
+		0, 0, 8, 119, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 4, 8,
+		0, 0, 0, 154, 14, 0, 0, 74, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 168, 1, 0, 0, 56, 21, 0, 0, 1,
+		0, 0, 4, 8, 0, 0, 0, 161, 21, 0, 0, 122, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 206, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 105, 0, 0, 0, 4,
+		0, 0, 0, 4, 0, 0, 0, 165, 21, 0, 0, 2, 0, 0, 4, 20,
+		0, 0, 0, 85, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 94,
+		14, 0, 0, 125, 0, 0, 0, 32, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 3, 0, 0, 0, 0, 2, 0, 0, 0, 4, 0, 0, 0, 4,
+		0, 0, 0, 141, 6, 0, 0, 3, 0, 0, 4, 56, 0, 0, 0, 179,
+		21, 0, 0, 39, 0, 0, 0, 0, 0, 0, 0, 189, 21, 0, 0, 127,
+		0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 130, 0, 0, 0, 128,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 128, 0, 0, 0, 0,
+		0, 0, 0, 1, 0, 0, 13, 38, 0, 0, 0, 0, 0, 0, 0, 129,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 126, 0, 0, 0, 0,
+		0, 0, 0, 3, 0, 0, 5, 40, 0, 0, 0, 192, 21, 0, 0, 131,
+		0, 0, 0, 0, 0, 0, 0, 198, 21, 0, 0, 132, 0, 0, 0, 0,
+		0, 0, 0, 208, 21, 0, 0, 139, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 6, 0, 0, 4, 40, 0, 0, 0, 213, 21, 0, 0, 7,
+		0, 0, 0, 0, 0, 0, 0, 219, 21, 0, 0, 8, 0, 0, 0, 64,
+		0, 0, 0, 229, 0, 0, 0, 8, 0, 0, 0, 96, 0, 0, 0, 223,
+		21, 0, 0, 8, 0, 0, 0, 128, 0, 0, 0, 230, 21, 0, 0, 29,
+		0, 0, 0, 192, 0, 0, 0, 235, 21, 0, 0, 7, 0, 0, 0, 0,
+		1, 0, 0, 0, 0, 0, 0, 4, 0, 0, 4, 24, 0, 0, 0, 242,
+		21, 0, 0, 133, 0, 0, 0, 0, 0, 0, 0, 48, 0, 0, 0, 135,
+		0, 0, 0, 32, 0, 0, 0, 0, 0, 0, 0, 136, 0, 0, 0, 64,
+		0, 0, 0, 176, 17, 0, 0, 29, 0, 0, 0, 128, 0, 0, 0, 250,
+		21, 0, 0, 0, 0, 0, 8, 134, 0, 0, 0, 4, 22, 0, 0, 0,
+		0, 0, 8, 2, 0, 0, 0, 23, 22, 0, 0, 3, 0, 0, 6, 4,
+		0, 0, 0, 37, 22, 0, 0, 0, 0, 0, 0, 45, 22, 0, 0, 1,
+		0, 0, 0, 55, 22, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 2,
+		0, 0, 5, 8, 0, 0, 0, 65, 22, 0, 0, 137, 0, 0, 0, 0,
+		0, 0, 0, 70, 22, 0, 0, 138, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 135, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 174, 1, 0, 0, 0, 0, 0, 0, 5, 0, 0, 4, 32,
+		0, 0, 0, 82, 22, 0, 0, 140, 0, 0, 0, 0, 0, 0, 0, 87,
+		22, 0, 0, 2, 0, 0, 0, 64, 0, 0, 0, 92, 22, 0, 0, 2,
+		0, 0, 0, 96, 0, 0, 0, 104, 22, 0, 0, 39, 0, 0, 0, 128,
+		0, 0, 0, 111, 22, 0, 0, 39, 0, 0, 0, 192, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 186, 1, 0, 0, 119, 22, 0, 0, 0,
+		0, 0, 8, 142, 0, 0, 0, 125, 22, 0, 0, 0, 0, 0, 8, 2,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 182, 1, 0, 0, 140,
+		22, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 206, 14, 0, 0, 91,
+		0, 0, 0, 0, 0, 0, 0, 151, 22, 0, 0, 145, 0, 0, 0, 64,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 91, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 144, 0, 0, 0, 4,
+		0, 0, 0, 4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 149,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 2, 0, 0, 0, 94,
+		7, 0, 0, 3, 0, 0, 4, 24, 0, 0, 0, 76, 7, 0, 0, 29,
+		0, 0, 0, 0, 0, 0, 0, 82, 7, 0, 0, 29, 0, 0, 0, 64,
+		0, 0, 0, 157, 22, 0, 0, 150, 0, 0, 0, 128, 0, 0, 0, 162,
+		22, 0, 0, 0, 0, 0, 8, 151, 0, 0, 0, 177, 22, 0, 0, 1,
+		0, 0, 4, 4, 0, 0, 0, 190, 22, 0, 0, 152, 0, 0, 0, 0,
+		0, 0, 0, 199, 22, 0, 0, 0, 0, 0, 8, 153, 0, 0, 0, 215,
+		22, 0, 0, 1, 0, 0, 4, 4, 0, 0, 0, 0, 0, 0, 0, 154,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 5, 4,
+		0, 0, 0, 219, 21, 0, 0, 52, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 155, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 156,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 4, 2,
+		0, 0, 0, 225, 22, 0, 0, 20, 0, 0, 0, 0, 0, 0, 0, 119,
+		8, 0, 0, 20, 0, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 2,
+		0, 0, 4, 4, 0, 0, 0, 232, 22, 0, 0, 58, 0, 0, 0, 0,
+		0, 0, 0, 247, 22, 0, 0, 58, 0, 0, 0, 16, 0, 0, 0, 107,
+		7, 0, 0, 7, 0, 0, 4, 48, 0, 0, 0, 252, 22, 0, 0, 158,
+		0, 0, 0, 0, 0, 0, 0, 5, 23, 0, 0, 31, 0, 0, 0, 64,
+		0, 0, 0, 131, 17, 0, 0, 160, 0, 0, 0, 128, 0, 0, 0, 82,
+		14, 0, 0, 10, 0, 0, 0, 160, 0, 0, 0, 76, 7, 0, 0, 29,
+		0, 0, 0, 192, 0, 0, 0, 82, 7, 0, 0, 29, 0, 0, 0, 0,
+		1, 0, 0, 88, 7, 0, 0, 29, 0, 0, 0, 64, 1, 0, 0, 15,
+		23, 0, 0, 0, 0, 0, 8, 159, 0, 0, 0, 252, 22, 0, 0, 1,
+		0, 0, 4, 4, 0, 0, 0, 26, 23, 0, 0, 10, 0, 0, 0, 0,
+		0, 0, 0, 35, 23, 0, 0, 5, 0, 0, 6, 4, 0, 0, 0, 47,
+		23, 0, 0, 0, 0, 0, 0, 62, 23, 0, 0, 1, 0, 0, 0, 73,
+		23, 0, 0, 2, 0, 0, 0, 83, 23, 0, 0, 3, 0, 0, 0, 94,
+		23, 0, 0, 4, 0, 0, 0, 182, 7, 0, 0, 3, 0, 0, 4, 80,
+		0, 0, 0, 106, 23, 0, 0, 166, 0, 0, 0, 0, 0, 0, 0, 112,
+		23, 0, 0, 10, 0, 0, 0, 64, 2, 0, 0, 126, 23, 0, 0, 10,
+		0, 0, 0, 96, 2, 0, 0, 140, 23, 0, 0, 2, 0, 0, 4, 24,
+		0, 0, 0, 160, 23, 0, 0, 29, 0, 0, 0, 0, 0, 0, 0, 168,
+		23, 0, 0, 163, 0, 0, 0, 64, 0, 0, 0, 175, 23, 0, 0, 1,
+		0, 0, 4, 16, 0, 0, 0, 191, 23, 0, 0, 164, 0, 0, 0, 0,
+		0, 0, 0, 199, 23, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 191,
+		23, 0, 0, 165, 0, 0, 0, 0, 0, 0, 0, 214, 23, 0, 0, 64,
+		0, 0, 0, 64, 0, 0, 0, 191, 23, 0, 0, 1, 0, 0, 4, 8,
+		0, 0, 0, 160, 15, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 162, 0, 0, 0, 4,
+		0, 0, 0, 3, 0, 0, 0, 198, 7, 0, 0, 2, 0, 0, 4, 24,
+		0, 0, 0, 226, 23, 0, 0, 168, 0, 0, 0, 0, 0, 0, 0, 231,
+		23, 0, 0, 10, 0, 0, 0, 128, 0, 0, 0, 241, 23, 0, 0, 2,
+		0, 0, 4, 16, 0, 0, 0, 206, 14, 0, 0, 169, 0, 0, 0, 0,
+		0, 0, 0, 255, 23, 0, 0, 170, 0, 0, 0, 64, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 168, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 171, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 13, 0,
+		0, 0, 0, 0, 0, 0, 0, 169, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 173, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 150,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 165, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 41, 0, 0, 0, 4,
+		0, 0, 0, 16, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 171,
+		1, 0, 0, 4, 24, 0, 0, 1, 0, 0, 4, 8, 0, 0, 0, 13,
+		24, 0, 0, 178, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 196, 1, 0, 0, 23, 24, 0, 0, 1, 0, 0, 4, 16,
+		0, 0, 0, 32, 24, 0, 0, 65, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 156, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 155, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 162,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 103, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 198, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 197, 1, 0, 0, 42, 24, 0, 0, 0, 0, 0, 8, 187,
+		0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 4, 8, 0, 0, 0, 51,
+		24, 0, 0, 188, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 3, 0, 0, 0, 0, 39, 0, 0, 0, 4, 0, 0, 0, 1,
+		0, 0, 0, 55, 24, 0, 0, 2, 0, 0, 4, 24, 0, 0, 0, 66,
+		24, 0, 0, 65, 0, 0, 0, 0, 0, 0, 0, 69, 8, 0, 0, 186,
+		0, 0, 0, 128, 0, 0, 0, 71, 24, 0, 0, 0, 0, 0, 8, 191,
+		0, 0, 0, 78, 24, 0, 0, 0, 0, 0, 8, 192, 0, 0, 0, 94,
+		24, 0, 0, 0, 0, 0, 8, 39, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 138, 1, 0, 0, 111, 24, 0, 0, 0, 0, 0, 8, 195,
+		0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 4, 4, 0, 0, 0, 219,
+		21, 0, 0, 196, 0, 0, 0, 0, 0, 0, 0, 118, 24, 0, 0, 0,
+		0, 0, 8, 197, 0, 0, 0, 124, 24, 0, 0, 0, 0, 0, 8, 10,
+		0, 0, 0, 206, 8, 0, 0, 3, 0, 0, 4, 16, 0, 0, 0, 141,
+		24, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 146, 24, 0, 0, 52,
+		0, 0, 0, 32, 0, 0, 0, 159, 24, 0, 0, 199, 0, 0, 0, 64,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 195, 1, 0, 0, 166,
+		24, 0, 0, 0, 0, 0, 4, 0, 0, 0, 0, 188, 24, 0, 0, 0,
+		0, 0, 8, 202, 0, 0, 0, 199, 24, 0, 0, 1, 0, 0, 4, 4,
+		0, 0, 0, 0, 0, 0, 0, 203, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 1, 0, 0, 5, 4, 0, 0, 0, 208, 24, 0, 0, 151,
+		0, 0, 0, 0, 0, 0, 0, 214, 24, 0, 0, 1, 0, 0, 4, 8,
+		0, 0, 0, 206, 14, 0, 0, 205, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 204, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 193, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 140,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 141, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 189, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 139, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 161,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 145, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 214, 0, 0, 0, 226, 24, 0, 0, 0,
+		0, 0, 8, 164, 1, 0, 0, 243, 24, 0, 0, 7, 0, 0, 4, 56,
+		0, 0, 0, 6, 25, 0, 0, 29, 0, 0, 0, 0, 0, 0, 0, 12,
+		25, 0, 0, 29, 0, 0, 0, 64, 0, 0, 0, 18, 25, 0, 0, 29,
+		0, 0, 0, 128, 0, 0, 0, 24, 25, 0, 0, 29, 0, 0, 0, 192,
+		0, 0, 0, 30, 25, 0, 0, 29, 0, 0, 0, 0, 1, 0, 0, 41,
+		25, 0, 0, 29, 0, 0, 0, 64, 1, 0, 0, 53, 25, 0, 0, 29,
+		0, 0, 0, 128, 1, 0, 0, 75, 25, 0, 0, 0, 0, 0, 8, 217,
+		0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 4, 64, 0, 0, 0, 247,
+		19, 0, 0, 218, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 3, 0, 0, 0, 0, 39, 0, 0, 0, 4, 0, 0, 0, 8,
+		0, 0, 0, 86, 25, 0, 0, 0, 0, 0, 8, 220, 0, 0, 0, 106,
+		25, 0, 0, 1, 0, 0, 4, 4, 0, 0, 0, 252, 22, 0, 0, 158,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 151,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 191, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 148, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 159, 1, 0, 0, 124, 25, 0, 0, 4, 0, 0, 4, 32,
+		0, 0, 0, 130, 25, 0, 0, 117, 0, 0, 0, 0, 0, 0, 0, 136,
+		25, 0, 0, 150, 0, 0, 0, 64, 0, 0, 0, 146, 25, 0, 0, 226,
+		0, 0, 0, 96, 0, 0, 0, 150, 25, 0, 0, 65, 0, 0, 0, 128,
+		0, 0, 0, 160, 25, 0, 0, 1, 0, 0, 4, 4, 0, 0, 0, 247,
+		22, 0, 0, 52, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 228, 0, 0, 0, 182, 25, 0, 0, 30, 0, 0, 4, 24,
+		1, 0, 0, 201, 25, 0, 0, 229, 0, 0, 0, 0, 0, 0, 0, 157,
+		22, 0, 0, 150, 0, 0, 0, 64, 0, 0, 0, 124, 25, 0, 0, 225,
+		0, 0, 0, 128, 0, 0, 0, 205, 25, 0, 0, 65, 0, 0, 0, 128,
+		1, 0, 0, 221, 25, 0, 0, 230, 0, 0, 0, 0, 2, 0, 0, 235,
+		25, 0, 0, 230, 0, 0, 0, 128, 2, 0, 0, 251, 25, 0, 0, 65,
+		0, 0, 0, 0, 3, 0, 0, 6, 26, 0, 0, 65, 0, 0, 0, 128,
+		3, 0, 0, 20, 26, 0, 0, 65, 0, 0, 0, 0, 4, 0, 0, 36,
+		26, 0, 0, 2, 0, 0, 0, 128, 4, 0, 0, 46, 26, 0, 0, 2,
+		0, 0, 0, 160, 4, 0, 0, 56, 26, 0, 0, 2, 0, 0, 0, 192,
+		4, 0, 0, 64, 26, 0, 0, 2, 0, 0, 0, 224, 4, 0, 0, 74,
+		26, 0, 0, 2, 0, 0, 0, 0, 5, 0, 0, 82, 26, 0, 0, 2,
+		0, 0, 0, 32, 5, 0, 0, 90, 26, 0, 0, 2, 0, 0, 0, 64,
+		5, 0, 0, 105, 26, 0, 0, 2, 0, 0, 0, 96, 5, 0, 0, 122,
+		26, 0, 0, 50, 0, 0, 0, 128, 5, 0, 0, 131, 26, 0, 0, 60,
+		0, 0, 0, 192, 5, 0, 0, 230, 21, 0, 0, 29, 0, 0, 0, 0,
+		6, 0, 0, 136, 26, 0, 0, 29, 0, 0, 0, 64, 6, 0, 0, 146,
+		26, 0, 0, 29, 0, 0, 0, 128, 6, 0, 0, 157, 26, 0, 0, 227,
+		0, 0, 0, 192, 6, 0, 0, 168, 26, 0, 0, 29, 0, 0, 0, 0,
+		7, 0, 0, 179, 26, 0, 0, 29, 0, 0, 0, 64, 7, 0, 0, 190,
+		26, 0, 0, 2, 0, 0, 0, 128, 7, 0, 0, 200, 26, 0, 0, 2,
+		0, 0, 0, 160, 7, 0, 0, 211, 26, 0, 0, 49, 0, 0, 0, 192,
+		7, 0, 0, 241, 23, 0, 0, 168, 0, 0, 0, 0, 8, 0, 0, 225,
+		26, 0, 0, 231, 0, 0, 0, 128, 8, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 185, 1, 0, 0, 236, 26, 0, 0, 2, 0, 0, 4, 16,
+		0, 0, 0, 254, 26, 0, 0, 165, 0, 0, 0, 0, 0, 0, 0, 3,
+		27, 0, 0, 29, 0, 0, 0, 64, 0, 0, 0, 9, 27, 0, 0, 0,
+		0, 0, 8, 232, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 4, 8,
+		0, 0, 0, 17, 27, 0, 0, 117, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 227, 0, 0, 0, 4,
+		0, 0, 0, 2, 0, 0, 0, 19, 27, 0, 0, 0, 0, 0, 1, 2,
+		0, 0, 0, 16, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 2, 173,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 39, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 39, 0, 0, 0, 4,
+		0, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 192,
+		1, 0, 0, 25, 27, 0, 0, 0, 0, 0, 4, 0, 0, 0, 0, 0,
+		0, 0, 0, 2, 0, 0, 5, 16, 0, 0, 0, 46, 27, 0, 0, 50,
+		0, 0, 0, 0, 0, 0, 0, 56, 27, 0, 0, 168, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 184, 1, 0, 0, 60,
+		27, 0, 0, 3, 0, 0, 4, 16, 0, 0, 0, 70, 27, 0, 0, 243,
+		0, 0, 0, 0, 0, 0, 0, 75, 27, 0, 0, 9, 0, 0, 0, 64,
+		0, 0, 0, 82, 27, 0, 0, 9, 0, 0, 0, 96, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 175, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 199, 1, 0, 0, 125, 12, 0, 0, 4, 0, 0, 4, 120,
+		0, 0, 0, 87, 27, 0, 0, 246, 0, 0, 0, 0, 0, 0, 0, 94,
+		14, 0, 0, 10, 0, 0, 0, 0, 3, 0, 0, 230, 21, 0, 0, 39,
+		0, 0, 0, 64, 3, 0, 0, 97, 27, 0, 0, 39, 0, 0, 0, 128,
+		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 39,
+		0, 0, 0, 4, 0, 0, 0, 12, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 3, 0, 0, 0, 0, 245, 0, 0, 0, 4, 0, 0, 0, 32,
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 166, 1, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 39, 4, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 236, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 247,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 40, 4, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 237, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 232, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 41,
-		4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 176, 1, 0, 0, 187,
-		40, 0, 0, 0, 0, 0, 8, 28, 4, 0, 0, 204, 40, 0, 0, 7,
-		0, 0, 4, 56, 0, 0, 0, 223, 40, 0, 0, 39, 0, 0, 0, 0,
-		0, 0, 0, 229, 40, 0, 0, 39, 0, 0, 0, 64, 0, 0, 0, 235,
-		40, 0, 0, 39, 0, 0, 0, 128, 0, 0, 0, 241, 40, 0, 0, 39,
-		0, 0, 0, 192, 0, 0, 0, 247, 40, 0, 0, 39, 0, 0, 0, 0,
-		1, 0, 0, 2, 41, 0, 0, 39, 0, 0, 0, 64, 1, 0, 0, 14,
-		41, 0, 0, 39, 0, 0, 0, 128, 1, 0, 0, 36, 41, 0, 0, 0,
-		0, 0, 8, 179, 1, 0, 0, 0, 0, 0, 0, 1, 0, 0, 4, 64,
-		0, 0, 0, 15, 24, 0, 0, 180, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 31, 0, 0, 0, 4,
-		0, 0, 0, 8, 0, 0, 0, 47, 41, 0, 0, 0, 0, 0, 8, 182,
-		1, 0, 0, 67, 41, 0, 0, 1, 0, 0, 4, 4, 0, 0, 0, 106,
-		29, 0, 0, 183, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 208, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 42,
-		4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 43, 4, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 44, 4, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 188, 1, 0, 0, 85, 41, 0, 0, 30, 0, 0, 4, 24,
-		1, 0, 0, 104, 41, 0, 0, 189, 1, 0, 0, 0, 0, 0, 0, 63,
-		18, 0, 0, 70, 0, 0, 0, 64, 0, 0, 0, 249, 27, 0, 0, 154,
-		0, 0, 0, 128, 0, 0, 0, 108, 41, 0, 0, 60, 0, 0, 0, 128,
-		1, 0, 0, 124, 41, 0, 0, 190, 1, 0, 0, 0, 2, 0, 0, 138,
-		41, 0, 0, 190, 1, 0, 0, 128, 2, 0, 0, 154, 41, 0, 0, 60,
-		0, 0, 0, 0, 3, 0, 0, 165, 41, 0, 0, 60, 0, 0, 0, 128,
-		3, 0, 0, 179, 41, 0, 0, 60, 0, 0, 0, 0, 4, 0, 0, 195,
-		41, 0, 0, 2, 0, 0, 0, 128, 4, 0, 0, 205, 41, 0, 0, 2,
-		0, 0, 0, 160, 4, 0, 0, 215, 41, 0, 0, 2, 0, 0, 0, 192,
-		4, 0, 0, 223, 41, 0, 0, 2, 0, 0, 0, 224, 4, 0, 0, 233,
-		41, 0, 0, 2, 0, 0, 0, 0, 5, 0, 0, 241, 41, 0, 0, 2,
-		0, 0, 0, 32, 5, 0, 0, 249, 41, 0, 0, 2, 0, 0, 0, 64,
-		5, 0, 0, 8, 42, 0, 0, 2, 0, 0, 0, 96, 5, 0, 0, 25,
-		42, 0, 0, 45, 0, 0, 0, 128, 5, 0, 0, 34, 42, 0, 0, 55,
-		0, 0, 0, 192, 5, 0, 0, 13, 31, 0, 0, 39, 0, 0, 0, 0,
-		6, 0, 0, 39, 42, 0, 0, 39, 0, 0, 0, 64, 6, 0, 0, 49,
-		42, 0, 0, 39, 0, 0, 0, 128, 6, 0, 0, 60, 42, 0, 0, 187,
-		1, 0, 0, 192, 6, 0, 0, 71, 42, 0, 0, 39, 0, 0, 0, 0,
-		7, 0, 0, 82, 42, 0, 0, 39, 0, 0, 0, 64, 7, 0, 0, 93,
-		42, 0, 0, 2, 0, 0, 0, 128, 7, 0, 0, 103, 42, 0, 0, 2,
-		0, 0, 0, 160, 7, 0, 0, 114, 42, 0, 0, 44, 0, 0, 0, 192,
-		7, 0, 0, 72, 21, 0, 0, 111, 0, 0, 0, 0, 8, 0, 0, 128,
-		42, 0, 0, 191, 1, 0, 0, 128, 8, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 21, 2, 0, 0, 139, 42, 0, 0, 2, 0, 0, 4, 16,
-		0, 0, 0, 157, 42, 0, 0, 66, 0, 0, 0, 0, 0, 0, 0, 133,
-		36, 0, 0, 39, 0, 0, 0, 64, 0, 0, 0, 162, 42, 0, 0, 0,
-		0, 0, 8, 192, 1, 0, 0, 0, 0, 0, 0, 1, 0, 0, 4, 8,
-		0, 0, 0, 170, 42, 0, 0, 103, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 187, 1, 0, 0, 4,
-		0, 0, 0, 2, 0, 0, 0, 208, 10, 0, 0, 6, 0, 0, 4, 144,
-		0, 0, 0, 148, 20, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 10,
-		40, 0, 0, 29, 0, 0, 0, 32, 0, 0, 0, 186, 0, 0, 0, 29,
-		0, 0, 0, 48, 0, 0, 0, 172, 42, 0, 0, 178, 1, 0, 0, 64,
-		0, 0, 0, 178, 42, 0, 0, 2, 0, 0, 0, 64, 2, 0, 0, 188,
-		42, 0, 0, 195, 1, 0, 0, 128, 2, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 5, 64, 0, 0, 0, 190, 42, 0, 0, 178, 1, 0, 0, 0,
-		0, 0, 0, 210, 42, 0, 0, 178, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 45, 4, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 31, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
-		0, 0, 0, 31, 0, 0, 0, 4, 0, 0, 0, 3, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 46, 4, 0, 0, 224, 42, 0, 0, 0,
-		0, 0, 4, 0, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 5, 16,
-		0, 0, 0, 245, 42, 0, 0, 45, 0, 0, 0, 0, 0, 0, 0, 76,
-		20, 0, 0, 111, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 149, 2, 0, 0, 255, 42, 0, 0, 3, 0, 0, 4, 16,
-		0, 0, 0, 9, 43, 0, 0, 204, 1, 0, 0, 0, 0, 0, 0, 14,
-		43, 0, 0, 9, 0, 0, 0, 64, 0, 0, 0, 14, 29, 0, 0, 9,
-		0, 0, 0, 96, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 150,
-		2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 47, 4, 0, 0, 103,
-		12, 0, 0, 4, 0, 0, 4, 120, 0, 0, 0, 21, 43, 0, 0, 207,
-		1, 0, 0, 0, 0, 0, 0, 101, 14, 0, 0, 10, 0, 0, 0, 0,
-		3, 0, 0, 13, 31, 0, 0, 31, 0, 0, 0, 64, 3, 0, 0, 31,
-		43, 0, 0, 31, 0, 0, 0, 128, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 3, 0, 0, 0, 0, 31, 0, 0, 0, 4, 0, 0, 0, 12,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 206,
-		1, 0, 0, 4, 0, 0, 0, 32, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 18, 4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 48,
-		4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 213, 1, 0, 0, 35,
-		43, 0, 0, 0, 0, 0, 8, 10, 0, 0, 0, 41, 43, 0, 0, 46,
-		0, 0, 4, 192, 16, 0, 0, 65, 20, 0, 0, 97, 0, 0, 0, 0,
-		0, 0, 0, 159, 0, 0, 0, 214, 1, 0, 0, 64, 6, 0, 0, 52,
-		43, 0, 0, 215, 1, 0, 0, 128, 6, 0, 0, 0, 0, 0, 0, 217,
-		1, 0, 0, 0, 10, 0, 0, 59, 43, 0, 0, 215, 1, 0, 0, 128,
-		13, 0, 0, 64, 43, 0, 0, 215, 1, 0, 0, 0, 17, 0, 0, 71,
-		43, 0, 0, 102, 0, 0, 0, 128, 20, 0, 0, 81, 43, 0, 0, 31,
-		0, 0, 0, 128, 21, 0, 0, 91, 43, 0, 0, 31, 0, 0, 0, 192,
-		21, 0, 0, 102, 43, 0, 0, 218, 1, 0, 0, 0, 22, 0, 0, 113,
-		43, 0, 0, 52, 1, 0, 0, 192, 25, 0, 0, 123, 43, 0, 0, 52,
-		1, 0, 0, 200, 25, 0, 0, 132, 43, 0, 0, 2, 0, 0, 0, 224,
-		25, 0, 0, 142, 43, 0, 0, 2, 0, 0, 0, 0, 26, 0, 0, 153,
-		43, 0, 0, 2, 0, 0, 0, 32, 26, 0, 0, 170, 43, 0, 0, 219,
-		1, 0, 0, 64, 26, 0, 0, 182, 43, 0, 0, 219, 1, 0, 0, 0,
-		28, 0, 0, 200, 43, 0, 0, 219, 1, 0, 0, 192, 29, 0, 0, 217,
-		43, 0, 0, 154, 0, 0, 0, 128, 31, 0, 0, 233, 43, 0, 0, 225,
-		1, 0, 0, 128, 32, 0, 0, 244, 43, 0, 0, 225, 1, 0, 0, 0,
-		33, 0, 0, 5, 44, 0, 0, 60, 0, 0, 0, 128, 33, 0, 0, 16,
-		44, 0, 0, 31, 0, 0, 0, 0, 34, 0, 0, 41, 44, 0, 0, 150,
-		0, 0, 0, 64, 34, 0, 0, 51, 44, 0, 0, 31, 0, 0, 0, 128,
-		34, 0, 0, 67, 44, 0, 0, 231, 1, 0, 0, 0, 36, 0, 0, 74,
-		44, 0, 0, 232, 1, 0, 0, 0, 36, 0, 0, 82, 44, 0, 0, 235,
-		1, 0, 0, 128, 114, 0, 0, 96, 44, 0, 0, 235, 1, 0, 0, 192,
-		116, 0, 0, 116, 44, 0, 0, 31, 0, 0, 0, 0, 119, 0, 0, 132,
-		44, 0, 0, 52, 1, 0, 0, 64, 119, 0, 0, 146, 44, 0, 0, 2,
-		0, 0, 0, 96, 119, 0, 0, 162, 44, 0, 0, 2, 0, 0, 0, 128,
-		119, 0, 0, 172, 44, 0, 0, 236, 1, 0, 0, 192, 119, 0, 0, 178,
-		44, 0, 0, 60, 0, 0, 0, 0, 120, 0, 0, 189, 44, 0, 0, 231,
-		1, 0, 0, 0, 122, 0, 0, 196, 44, 0, 0, 47, 0, 0, 0, 0,
-		122, 0, 0, 211, 44, 0, 0, 55, 0, 0, 0, 64, 122, 0, 0, 226,
-		44, 0, 0, 237, 1, 0, 0, 128, 122, 0, 0, 241, 44, 0, 0, 60,
-		0, 0, 0, 192, 122, 0, 0, 251, 44, 0, 0, 238, 1, 0, 0, 64,
-		123, 0, 0, 7, 45, 0, 0, 245, 1, 0, 0, 0, 127, 0, 0, 154,
-		41, 0, 0, 60, 0, 0, 0, 0, 132, 0, 0, 16, 45, 0, 0, 150,
-		0, 0, 0, 128, 132, 0, 0, 32, 45, 0, 0, 246, 1, 0, 0, 192,
-		132, 0, 0, 53, 45, 0, 0, 3, 2, 0, 0, 192, 133, 0, 0, 62,
-		45, 0, 0, 2, 0, 0, 4, 8, 0, 0, 0, 159, 0, 0, 0, 2,
-		0, 0, 0, 0, 0, 0, 0, 76, 45, 0, 0, 45, 0, 0, 0, 32,
-		0, 0, 0, 80, 45, 0, 0, 14, 0, 0, 4, 112, 0, 0, 0, 121,
-		2, 0, 0, 103, 0, 0, 0, 0, 0, 0, 0, 93, 45, 0, 0, 31,
-		0, 0, 0, 64, 0, 0, 0, 97, 45, 0, 0, 31, 0, 0, 0, 128,
-		0, 0, 0, 101, 45, 0, 0, 31, 0, 0, 0, 192, 0, 0, 0, 31,
-		43, 0, 0, 31, 0, 0, 0, 0, 1, 0, 0, 106, 45, 0, 0, 31,
-		0, 0, 0, 64, 1, 0, 0, 111, 45, 0, 0, 103, 0, 0, 0, 128,
-		1, 0, 0, 121, 45, 0, 0, 103, 0, 0, 0, 192, 1, 0, 0, 140,
-		45, 0, 0, 31, 0, 0, 0, 0, 2, 0, 0, 145, 45, 0, 0, 103,
-		0, 0, 0, 64, 2, 0, 0, 155, 45, 0, 0, 103, 0, 0, 0, 128,
-		2, 0, 0, 174, 45, 0, 0, 31, 0, 0, 0, 192, 2, 0, 0, 184,
-		45, 0, 0, 31, 0, 0, 0, 0, 3, 0, 0, 150, 6, 0, 0, 216,
-		1, 0, 0, 64, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 215,
-		1, 0, 0, 0, 0, 0, 0, 2, 0, 0, 5, 112, 0, 0, 0, 192,
-		45, 0, 0, 215, 1, 0, 0, 0, 0, 0, 0, 197, 45, 0, 0, 215,
-		1, 0, 0, 0, 0, 0, 0, 102, 43, 0, 0, 8, 0, 0, 4, 120,
-		0, 0, 0, 203, 45, 0, 0, 31, 0, 0, 0, 0, 0, 0, 0, 211,
-		45, 0, 0, 31, 0, 0, 0, 64, 0, 0, 0, 221, 45, 0, 0, 31,
-		0, 0, 0, 128, 0, 0, 0, 234, 45, 0, 0, 31, 0, 0, 0, 192,
-		0, 0, 0, 249, 45, 0, 0, 150, 0, 0, 0, 0, 1, 0, 0, 85,
-		0, 0, 0, 60, 0, 0, 0, 64, 1, 0, 0, 1, 46, 0, 0, 154,
-		0, 0, 0, 192, 1, 0, 0, 64, 21, 0, 0, 102, 0, 0, 0, 192,
-		2, 0, 0, 13, 46, 0, 0, 3, 0, 0, 4, 56, 0, 0, 0, 25,
-		46, 0, 0, 220, 1, 0, 0, 0, 0, 0, 0, 28, 46, 0, 0, 31,
-		0, 0, 0, 64, 0, 0, 0, 40, 46, 0, 0, 221, 1, 0, 0, 128,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 35, 2, 0, 0, 53,
-		46, 0, 0, 4, 0, 0, 4, 40, 0, 0, 0, 7, 21, 0, 0, 238,
-		0, 0, 0, 0, 0, 0, 0, 244, 19, 0, 0, 31, 0, 0, 0, 128,
-		0, 0, 0, 185, 19, 0, 0, 222, 1, 0, 0, 192, 0, 0, 0, 186,
-		0, 0, 0, 8, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 223, 1, 0, 0, 0, 0, 0, 0, 1, 0, 0, 13, 0,
-		0, 0, 0, 0, 0, 0, 0, 224, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 221, 1, 0, 0, 64, 46, 0, 0, 2, 0, 0, 4, 16,
-		0, 0, 0, 86, 46, 0, 0, 226, 1, 0, 0, 0, 0, 0, 0, 94,
-		46, 0, 0, 226, 1, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 227, 1, 0, 0, 100, 46, 0, 0, 3, 0, 0, 4, 8,
-		0, 0, 0, 125, 46, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 14,
-		29, 0, 0, 10, 0, 0, 0, 32, 0, 0, 0, 143, 46, 0, 0, 230,
-		1, 0, 0, 64, 0, 0, 0, 151, 46, 0, 0, 2, 0, 0, 4, 16,
-		0, 0, 0, 172, 46, 0, 0, 229, 1, 0, 0, 0, 0, 0, 0, 180,
-		46, 0, 0, 31, 0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 216, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
-		0, 0, 0, 228, 1, 0, 0, 4, 0, 0, 0, 0, 0, 0, 0, 190,
-		46, 0, 0, 1, 0, 0, 4, 0, 0, 0, 0, 191, 34, 0, 0, 34,
-		0, 0, 0, 0, 0, 0, 0, 204, 46, 0, 0, 4, 0, 0, 4, 208,
-		9, 0, 0, 199, 19, 0, 0, 233, 1, 0, 0, 0, 0, 0, 0, 85,
-		0, 0, 0, 234, 1, 0, 0, 0, 12, 0, 0, 218, 46, 0, 0, 233,
-		1, 0, 0, 64, 39, 0, 0, 232, 46, 0, 0, 234, 1, 0, 0, 64,
-		51, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 30,
-		0, 0, 0, 4, 0, 0, 0, 48, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 3, 0, 0, 0, 0, 31, 0, 0, 0, 4, 0, 0, 0, 109,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 103,
-		0, 0, 0, 4, 0, 0, 0, 9, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 215, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 217,
-		3, 0, 0, 247, 46, 0, 0, 6, 0, 0, 4, 120, 0, 0, 0, 63,
-		18, 0, 0, 150, 0, 0, 0, 0, 0, 0, 0, 1, 47, 0, 0, 239,
-		1, 0, 0, 64, 0, 0, 0, 165, 21, 0, 0, 221, 1, 0, 0, 192,
-		1, 0, 0, 13, 47, 0, 0, 31, 0, 0, 0, 0, 3, 0, 0, 25,
-		47, 0, 0, 31, 0, 0, 0, 64, 3, 0, 0, 44, 47, 0, 0, 31,
-		0, 0, 0, 128, 3, 0, 0, 56, 47, 0, 0, 3, 0, 0, 4, 48,
-		0, 0, 0, 85, 0, 0, 0, 240, 1, 0, 0, 0, 0, 0, 0, 86,
-		21, 0, 0, 10, 0, 0, 0, 64, 1, 0, 0, 115, 29, 0, 0, 183,
-		0, 0, 0, 96, 1, 0, 0, 69, 47, 0, 0, 4, 0, 0, 4, 40,
-		0, 0, 0, 63, 18, 0, 0, 70, 0, 0, 0, 0, 0, 0, 0, 101,
-		14, 0, 0, 79, 0, 0, 0, 64, 0, 0, 0, 80, 20, 0, 0, 60,
-		0, 0, 0, 128, 0, 0, 0, 84, 47, 0, 0, 241, 1, 0, 0, 0,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 7, 1, 0, 0, 93,
-		47, 0, 0, 4, 0, 0, 4, 40, 0, 0, 0, 108, 47, 0, 0, 39,
-		0, 0, 0, 0, 0, 0, 0, 115, 47, 0, 0, 2, 0, 0, 0, 64,
-		0, 0, 0, 124, 47, 0, 0, 39, 0, 0, 0, 128, 0, 0, 0, 213,
-		39, 0, 0, 243, 1, 0, 0, 192, 0, 0, 0, 127, 47, 0, 0, 2,
-		0, 0, 4, 16, 0, 0, 0, 141, 47, 0, 0, 47, 0, 0, 0, 0,
-		0, 0, 0, 145, 47, 0, 0, 244, 1, 0, 0, 64, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 233, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 3, 0, 0, 0, 0, 242, 1, 0, 0, 4, 0, 0, 0, 4,
-		0, 0, 0, 151, 47, 0, 0, 3, 0, 0, 4, 32, 0, 0, 0, 166,
-		47, 0, 0, 150, 0, 0, 0, 0, 0, 0, 0, 183, 47, 0, 0, 60,
-		0, 0, 0, 64, 0, 0, 0, 195, 47, 0, 0, 31, 0, 0, 0, 192,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 248, 1, 0, 0, 211,
-		47, 0, 0, 10, 0, 0, 4, 56, 4, 0, 0, 231, 47, 0, 0, 249,
-		1, 0, 0, 0, 0, 0, 0, 238, 47, 0, 0, 253, 1, 0, 0, 128,
-		4, 0, 0, 2, 48, 0, 0, 254, 1, 0, 0, 192, 4, 0, 0, 15,
-		48, 0, 0, 0, 2, 0, 0, 64, 25, 0, 0, 29, 48, 0, 0, 1,
-		2, 0, 0, 128, 31, 0, 0, 34, 48, 0, 0, 2, 2, 0, 0, 0,
-		32, 0, 0, 48, 48, 0, 0, 58, 0, 0, 0, 64, 32, 0, 0, 58,
-		48, 0, 0, 31, 0, 0, 0, 0, 33, 0, 0, 74, 48, 0, 0, 52,
-		1, 0, 0, 64, 33, 0, 0, 82, 48, 0, 0, 211, 1, 0, 0, 128,
-		33, 0, 0, 231, 47, 0, 0, 8, 0, 0, 4, 144, 0, 0, 0, 88,
-		48, 0, 0, 250, 1, 0, 0, 0, 0, 0, 0, 94, 48, 0, 0, 150,
-		0, 0, 0, 128, 2, 0, 0, 103, 48, 0, 0, 31, 0, 0, 0, 192,
-		2, 0, 0, 113, 48, 0, 0, 31, 0, 0, 0, 0, 3, 0, 0, 123,
-		48, 0, 0, 103, 0, 0, 0, 64, 3, 0, 0, 139, 48, 0, 0, 251,
-		1, 0, 0, 128, 3, 0, 0, 186, 0, 0, 0, 31, 0, 0, 0, 0,
-		4, 0, 0, 148, 48, 0, 0, 252, 1, 0, 0, 64, 4, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 60, 0, 0, 0, 4,
-		0, 0, 0, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
-		0, 0, 0, 31, 0, 0, 0, 4, 0, 0, 0, 2, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 218, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 225, 3, 0, 0, 2, 48, 0, 0, 2, 0, 0, 4, 144,
-		2, 0, 0, 199, 19, 0, 0, 255, 1, 0, 0, 0, 0, 0, 0, 218,
-		46, 0, 0, 255, 1, 0, 0, 64, 10, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 3, 0, 0, 0, 0, 30, 0, 0, 0, 4, 0, 0, 0, 41,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 31,
-		0, 0, 0, 4, 0, 0, 0, 25, 0, 0, 0, 154, 48, 0, 0, 2,
-		0, 0, 4, 16, 0, 0, 0, 178, 48, 0, 0, 211, 1, 0, 0, 0,
-		0, 0, 0, 82, 42, 0, 0, 10, 0, 0, 0, 64, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 226, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 3, 0, 0, 0, 0, 247, 1, 0, 0, 4, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 235, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 49, 4, 0, 0, 163, 13, 0, 0, 0,
-		0, 0, 4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 50,
-		4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 223, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 51, 4, 0, 0, 187, 48, 0, 0, 1,
-		0, 0, 4, 8, 0, 0, 0, 247, 23, 0, 0, 51, 0, 0, 0, 0,
-		0, 0, 0, 198, 48, 0, 0, 16, 0, 0, 4, 96, 4, 0, 0, 212,
-		48, 0, 0, 12, 2, 0, 0, 0, 0, 0, 0, 224, 48, 0, 0, 13,
-		2, 0, 0, 128, 3, 0, 0, 227, 48, 0, 0, 10, 0, 0, 0, 128,
-		20, 0, 0, 238, 48, 0, 0, 44, 0, 0, 0, 192, 20, 0, 0, 248,
-		48, 0, 0, 44, 0, 0, 0, 0, 21, 0, 0, 1, 49, 0, 0, 17,
-		2, 0, 0, 64, 21, 0, 0, 4, 49, 0, 0, 17, 2, 0, 0, 128,
-		21, 0, 0, 14, 49, 0, 0, 31, 0, 0, 0, 192, 21, 0, 0, 28,
-		49, 0, 0, 31, 0, 0, 0, 0, 22, 0, 0, 39, 49, 0, 0, 18,
-		2, 0, 0, 64, 22, 0, 0, 45, 49, 0, 0, 148, 3, 0, 0, 192,
-		30, 0, 0, 55, 49, 0, 0, 150, 3, 0, 0, 64, 33, 0, 0, 67,
-		49, 0, 0, 39, 0, 0, 0, 192, 33, 0, 0, 76, 49, 0, 0, 39,
-		0, 0, 0, 0, 34, 0, 0, 87, 49, 0, 0, 39, 0, 0, 0, 64,
-		34, 0, 0, 92, 49, 0, 0, 39, 0, 0, 0, 128, 34, 0, 0, 212,
-		48, 0, 0, 13, 0, 0, 4, 104, 0, 0, 0, 103, 49, 0, 0, 31,
-		0, 0, 0, 0, 0, 0, 0, 107, 49, 0, 0, 31, 0, 0, 0, 64,
-		0, 0, 0, 111, 49, 0, 0, 31, 0, 0, 0, 128, 0, 0, 0, 115,
-		49, 0, 0, 31, 0, 0, 0, 192, 0, 0, 0, 119, 49, 0, 0, 31,
-		0, 0, 0, 0, 1, 0, 0, 123, 49, 0, 0, 31, 0, 0, 0, 64,
-		1, 0, 0, 127, 49, 0, 0, 31, 0, 0, 0, 128, 1, 0, 0, 131,
-		49, 0, 0, 31, 0, 0, 0, 192, 1, 0, 0, 135, 49, 0, 0, 31,
-		0, 0, 0, 0, 2, 0, 0, 139, 49, 0, 0, 31, 0, 0, 0, 64,
-		2, 0, 0, 143, 49, 0, 0, 31, 0, 0, 0, 128, 2, 0, 0, 146,
-		49, 0, 0, 31, 0, 0, 0, 192, 2, 0, 0, 149, 49, 0, 0, 31,
-		0, 0, 0, 0, 3, 0, 0, 0, 0, 0, 0, 3, 0, 0, 4, 32,
-		2, 0, 0, 152, 49, 0, 0, 31, 0, 0, 0, 0, 0, 0, 0, 161,
-		49, 0, 0, 31, 0, 0, 0, 64, 0, 0, 0, 171, 49, 0, 0, 14,
-		2, 0, 0, 128, 0, 0, 0, 184, 49, 0, 0, 4, 0, 0, 4, 16,
-		2, 0, 0, 202, 49, 0, 0, 16, 2, 0, 0, 0, 0, 0, 0, 208,
-		49, 0, 0, 9, 0, 0, 0, 0, 16, 0, 0, 213, 49, 0, 0, 9,
-		0, 0, 0, 32, 16, 0, 0, 218, 49, 0, 0, 2, 1, 0, 0, 64,
-		16, 0, 0, 229, 49, 0, 0, 0, 0, 0, 1, 16, 0, 0, 0, 128,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 15,
-		2, 0, 0, 4, 0, 0, 0, 32, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 158, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 167, 1, 0, 0, 101, 27, 0, 0, 0, 0, 0, 8, 10,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 190, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 204, 1, 0, 0, 185, 13, 0, 0, 0,
+		0, 0, 4, 0, 0, 0, 0, 107, 27, 0, 0, 4, 0, 0, 4, 40,
+		0, 0, 0, 118, 27, 0, 0, 144, 0, 0, 0, 0, 0, 0, 0, 176,
+		17, 0, 0, 39, 0, 0, 0, 128, 0, 0, 0, 117, 17, 0, 0, 0,
+		1, 0, 0, 192, 0, 0, 0, 229, 0, 0, 0, 8, 0, 0, 0, 0,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 1, 1, 0, 0, 0,
+		0, 0, 0, 1, 0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 0, 2,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 255, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 209, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 142, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 144,
+		1, 0, 0, 124, 27, 0, 0, 1, 0, 0, 4, 8, 0, 0, 0, 223,
+		19, 0, 0, 56, 0, 0, 0, 0, 0, 0, 0, 135, 27, 0, 0, 16,
+		0, 0, 4, 96, 4, 0, 0, 149, 27, 0, 0, 8, 1, 0, 0, 0,
+		0, 0, 0, 161, 27, 0, 0, 9, 1, 0, 0, 128, 3, 0, 0, 164,
+		27, 0, 0, 10, 0, 0, 0, 128, 20, 0, 0, 175, 27, 0, 0, 49,
+		0, 0, 0, 192, 20, 0, 0, 185, 27, 0, 0, 49, 0, 0, 0, 0,
+		21, 0, 0, 194, 27, 0, 0, 14, 1, 0, 0, 64, 21, 0, 0, 197,
+		27, 0, 0, 14, 1, 0, 0, 128, 21, 0, 0, 207, 27, 0, 0, 39,
+		0, 0, 0, 192, 21, 0, 0, 221, 27, 0, 0, 39, 0, 0, 0, 0,
+		22, 0, 0, 232, 27, 0, 0, 15, 1, 0, 0, 64, 22, 0, 0, 238,
+		27, 0, 0, 100, 1, 0, 0, 192, 30, 0, 0, 248, 27, 0, 0, 102,
+		1, 0, 0, 64, 33, 0, 0, 4, 28, 0, 0, 29, 0, 0, 0, 192,
+		33, 0, 0, 13, 28, 0, 0, 29, 0, 0, 0, 0, 34, 0, 0, 24,
+		28, 0, 0, 29, 0, 0, 0, 64, 34, 0, 0, 29, 28, 0, 0, 29,
+		0, 0, 0, 128, 34, 0, 0, 149, 27, 0, 0, 13, 0, 0, 4, 104,
+		0, 0, 0, 40, 28, 0, 0, 39, 0, 0, 0, 0, 0, 0, 0, 44,
+		28, 0, 0, 39, 0, 0, 0, 64, 0, 0, 0, 48, 28, 0, 0, 39,
+		0, 0, 0, 128, 0, 0, 0, 52, 28, 0, 0, 39, 0, 0, 0, 192,
+		0, 0, 0, 56, 28, 0, 0, 39, 0, 0, 0, 0, 1, 0, 0, 60,
+		28, 0, 0, 39, 0, 0, 0, 64, 1, 0, 0, 64, 28, 0, 0, 39,
+		0, 0, 0, 128, 1, 0, 0, 68, 28, 0, 0, 39, 0, 0, 0, 192,
+		1, 0, 0, 72, 28, 0, 0, 39, 0, 0, 0, 0, 2, 0, 0, 76,
+		28, 0, 0, 39, 0, 0, 0, 64, 2, 0, 0, 80, 28, 0, 0, 39,
+		0, 0, 0, 128, 2, 0, 0, 83, 28, 0, 0, 39, 0, 0, 0, 192,
+		2, 0, 0, 86, 28, 0, 0, 39, 0, 0, 0, 0, 3, 0, 0, 0,
+		0, 0, 0, 3, 0, 0, 4, 32, 2, 0, 0, 89, 28, 0, 0, 39,
+		0, 0, 0, 0, 0, 0, 0, 98, 28, 0, 0, 39, 0, 0, 0, 64,
+		0, 0, 0, 108, 28, 0, 0, 10, 1, 0, 0, 128, 0, 0, 0, 121,
+		28, 0, 0, 4, 0, 0, 4, 16, 2, 0, 0, 139, 28, 0, 0, 12,
+		1, 0, 0, 0, 0, 0, 0, 145, 28, 0, 0, 9, 0, 0, 0, 0,
+		16, 0, 0, 150, 28, 0, 0, 9, 0, 0, 0, 32, 16, 0, 0, 155,
+		28, 0, 0, 13, 1, 0, 0, 64, 16, 0, 0, 166, 28, 0, 0, 0,
+		0, 0, 1, 16, 0, 0, 0, 128, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 3, 0, 0, 0, 0, 11, 1, 0, 0, 4, 0, 0, 0, 32,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 9,
+		0, 0, 0, 4, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 3, 0, 0, 0, 0, 10, 0, 0, 0, 4, 0, 0, 0, 2,
-		0, 0, 0, 247, 49, 0, 0, 5, 0, 0, 4, 16, 1, 0, 0, 2,
-		50, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 17, 50, 0, 0, 2,
-		0, 0, 0, 32, 0, 0, 0, 30, 50, 0, 0, 2, 0, 0, 0, 64,
-		0, 0, 0, 43, 50, 0, 0, 147, 3, 0, 0, 128, 0, 0, 0, 53,
-		50, 0, 0, 147, 3, 0, 0, 128, 4, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 20, 2, 0, 0, 63, 50, 0, 0, 75, 0, 0, 4, 208,
-		4, 0, 0, 74, 50, 0, 0, 60, 0, 0, 0, 0, 0, 0, 0, 86,
-		50, 0, 0, 60, 0, 0, 0, 128, 0, 0, 0, 99, 50, 0, 0, 60,
-		0, 0, 0, 0, 1, 0, 0, 15, 15, 0, 0, 58, 0, 0, 0, 128,
-		1, 0, 0, 111, 50, 0, 0, 39, 0, 0, 0, 64, 2, 0, 0, 123,
-		50, 0, 0, 60, 0, 0, 0, 128, 2, 0, 0, 137, 50, 0, 0, 238,
-		0, 0, 0, 0, 3, 0, 0, 149, 50, 0, 0, 60, 0, 0, 0, 128,
-		3, 0, 0, 162, 50, 0, 0, 2, 0, 0, 0, 0, 4, 0, 0, 174,
-		50, 0, 0, 2, 0, 0, 0, 32, 4, 0, 0, 185, 50, 0, 0, 2,
-		0, 0, 0, 64, 4, 0, 0, 174, 6, 0, 0, 19, 2, 0, 0, 128,
-		4, 0, 0, 104, 41, 0, 0, 189, 1, 0, 0, 192, 4, 0, 0, 196,
-		50, 0, 0, 44, 0, 0, 0, 0, 5, 0, 0, 199, 19, 0, 0, 48,
-		3, 0, 0, 64, 5, 0, 0, 208, 50, 0, 0, 10, 0, 0, 0, 96,
-		5, 0, 0, 101, 14, 0, 0, 49, 3, 0, 0, 128, 5, 0, 0, 221,
-		50, 0, 0, 104, 0, 0, 0, 192, 5, 0, 0, 233, 50, 0, 0, 39,
-		0, 0, 0, 0, 6, 0, 0, 252, 50, 0, 0, 39, 0, 0, 0, 64,
-		6, 0, 0, 15, 51, 0, 0, 39, 0, 0, 0, 128, 6, 0, 0, 22,
-		51, 0, 0, 51, 3, 0, 0, 192, 6, 0, 0, 27, 51, 0, 0, 53,
-		0, 0, 0, 192, 10, 0, 0, 39, 51, 0, 0, 53, 0, 0, 0, 208,
-		10, 0, 0, 54, 51, 0, 0, 53, 0, 0, 0, 224, 10, 0, 0, 64,
-		51, 0, 0, 56, 3, 0, 0, 0, 11, 0, 0, 204, 30, 0, 0, 187,
-		1, 0, 0, 0, 17, 0, 0, 25, 42, 0, 0, 103, 0, 0, 0, 64,
-		17, 0, 0, 67, 51, 0, 0, 104, 0, 0, 0, 128, 17, 0, 0, 92,
-		51, 0, 0, 104, 0, 0, 0, 192, 17, 0, 0, 117, 51, 0, 0, 154,
-		0, 0, 0, 0, 18, 0, 0, 129, 51, 0, 0, 60, 0, 0, 0, 0,
-		19, 0, 0, 150, 6, 0, 0, 19, 2, 0, 0, 128, 19, 0, 0, 140,
-		51, 0, 0, 2, 0, 0, 0, 192, 19, 0, 0, 60, 14, 0, 0, 2,
-		0, 0, 0, 224, 19, 0, 0, 146, 51, 0, 0, 60, 0, 0, 0, 0,
-		20, 0, 0, 102, 26, 0, 0, 55, 0, 0, 0, 128, 20, 0, 0, 158,
-		51, 0, 0, 154, 0, 0, 0, 192, 20, 0, 0, 169, 51, 0, 0, 47,
-		0, 0, 0, 192, 21, 0, 0, 185, 30, 0, 0, 42, 3, 0, 0, 0,
-		22, 0, 0, 180, 51, 0, 0, 60, 0, 0, 0, 64, 22, 0, 0, 189,
-		51, 0, 0, 31, 0, 0, 0, 192, 22, 0, 0, 201, 51, 0, 0, 2,
-		0, 0, 0, 0, 23, 0, 0, 145, 47, 0, 0, 233, 0, 0, 0, 64,
-		23, 0, 0, 213, 51, 0, 0, 166, 2, 0, 0, 0, 24, 0, 0, 220,
-		51, 0, 0, 10, 0, 0, 0, 64, 24, 0, 0, 235, 51, 0, 0, 10,
-		0, 0, 0, 96, 24, 0, 0, 248, 51, 0, 0, 10, 0, 0, 0, 128,
-		24, 0, 0, 8, 52, 0, 0, 10, 0, 0, 0, 160, 24, 0, 0, 24,
-		52, 0, 0, 31, 0, 0, 0, 192, 24, 0, 0, 37, 52, 0, 0, 73,
-		3, 0, 0, 0, 25, 0, 0, 49, 52, 0, 0, 111, 0, 0, 0, 0,
-		26, 0, 0, 62, 52, 0, 0, 10, 0, 0, 0, 128, 26, 0, 0, 75,
-		52, 0, 0, 47, 0, 0, 0, 160, 26, 0, 0, 87, 52, 0, 0, 78,
-		3, 0, 0, 192, 26, 0, 0, 100, 52, 0, 0, 79, 3, 0, 0, 128,
-		27, 0, 0, 119, 52, 0, 0, 31, 0, 0, 0, 192, 27, 0, 0, 136,
-		52, 0, 0, 19, 2, 0, 0, 0, 28, 0, 0, 77, 35, 0, 0, 23,
-		3, 0, 0, 64, 28, 0, 0, 72, 21, 0, 0, 111, 0, 0, 0, 128,
-		28, 0, 0, 255, 31, 0, 0, 236, 0, 0, 0, 0, 29, 0, 0, 159,
-		0, 0, 0, 39, 0, 0, 0, 64, 29, 0, 0, 146, 52, 0, 0, 104,
-		0, 0, 0, 128, 29, 0, 0, 159, 52, 0, 0, 92, 3, 0, 0, 192,
-		29, 0, 0, 165, 52, 0, 0, 94, 3, 0, 0, 0, 30, 0, 0, 182,
-		52, 0, 0, 44, 0, 0, 0, 64, 30, 0, 0, 207, 52, 0, 0, 94,
-		3, 0, 0, 128, 30, 0, 0, 229, 52, 0, 0, 117, 3, 0, 0, 192,
-		30, 0, 0, 234, 52, 0, 0, 39, 0, 0, 0, 0, 31, 0, 0, 245,
-		52, 0, 0, 112, 2, 0, 0, 64, 31, 0, 0, 146, 40, 0, 0, 124,
-		3, 0, 0, 128, 31, 0, 0, 254, 52, 0, 0, 131, 3, 0, 0, 192,
-		31, 0, 0, 9, 53, 0, 0, 146, 3, 0, 0, 128, 37, 0, 0, 254,
-		13, 0, 0, 44, 0, 0, 0, 192, 37, 0, 0, 14, 53, 0, 0, 60,
-		0, 0, 0, 0, 38, 0, 0, 104, 41, 0, 0, 39, 0, 0, 4, 40,
-		1, 0, 0, 7, 21, 0, 0, 60, 0, 0, 0, 0, 0, 0, 0, 22,
-		53, 0, 0, 22, 2, 0, 0, 128, 0, 0, 0, 29, 53, 0, 0, 23,
-		2, 0, 0, 192, 0, 0, 0, 33, 53, 0, 0, 24, 2, 0, 0, 0,
-		1, 0, 0, 45, 53, 0, 0, 24, 2, 0, 0, 64, 1, 0, 0, 225,
-		34, 0, 0, 24, 1, 0, 0, 128, 1, 0, 0, 48, 0, 0, 0, 2,
-		0, 0, 0, 192, 1, 0, 0, 57, 53, 0, 0, 2, 0, 0, 0, 224,
-		1, 0, 0, 70, 53, 0, 0, 242, 0, 0, 0, 0, 2, 0, 0, 88,
-		53, 0, 0, 12, 3, 0, 0, 64, 2, 0, 0, 104, 53, 0, 0, 47,
-		0, 0, 0, 128, 2, 0, 0, 118, 53, 0, 0, 2, 0, 0, 0, 160,
-		2, 0, 0, 130, 53, 0, 0, 2, 0, 0, 0, 192, 2, 0, 0, 150,
-		53, 0, 0, 10, 0, 0, 0, 224, 2, 0, 0, 166, 53, 0, 0, 13,
-		3, 0, 0, 0, 3, 0, 0, 177, 53, 0, 0, 13, 3, 0, 0, 64,
-		3, 0, 0, 189, 53, 0, 0, 15, 3, 0, 0, 128, 3, 0, 0, 200,
-		53, 0, 0, 17, 3, 0, 0, 192, 3, 0, 0, 213, 53, 0, 0, 17,
-		3, 0, 0, 0, 4, 0, 0, 228, 53, 0, 0, 19, 3, 0, 0, 64,
-		4, 0, 0, 232, 53, 0, 0, 21, 3, 0, 0, 128, 4, 0, 0, 8,
-		29, 0, 0, 21, 3, 0, 0, 192, 4, 0, 0, 236, 53, 0, 0, 21,
-		3, 0, 0, 0, 5, 0, 0, 94, 35, 0, 0, 23, 3, 0, 0, 64,
-		5, 0, 0, 241, 53, 0, 0, 25, 3, 0, 0, 128, 5, 0, 0, 251,
-		53, 0, 0, 27, 3, 0, 0, 192, 5, 0, 0, 6, 54, 0, 0, 13,
-		3, 0, 0, 0, 6, 0, 0, 17, 54, 0, 0, 15, 3, 0, 0, 64,
-		6, 0, 0, 27, 54, 0, 0, 29, 3, 0, 0, 128, 6, 0, 0, 38,
-		54, 0, 0, 31, 3, 0, 0, 192, 6, 0, 0, 53, 54, 0, 0, 32,
-		3, 0, 0, 0, 7, 0, 0, 67, 54, 0, 0, 34, 3, 0, 0, 64,
-		7, 0, 0, 77, 54, 0, 0, 36, 3, 0, 0, 128, 7, 0, 0, 86,
-		54, 0, 0, 38, 3, 0, 0, 192, 7, 0, 0, 99, 54, 0, 0, 44,
-		3, 0, 0, 0, 8, 0, 0, 121, 54, 0, 0, 23, 3, 0, 0, 64,
-		8, 0, 0, 139, 54, 0, 0, 15, 3, 0, 0, 128, 8, 0, 0, 156,
-		54, 0, 0, 15, 3, 0, 0, 192, 8, 0, 0, 169, 54, 0, 0, 46,
-		3, 0, 0, 0, 9, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 74,
-		2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 195, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 25, 2, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 26, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 27,
-		2, 0, 0, 182, 54, 0, 0, 5, 0, 0, 4, 40, 0, 0, 0, 225,
-		34, 0, 0, 24, 1, 0, 0, 0, 0, 0, 0, 198, 54, 0, 0, 28,
-		2, 0, 0, 64, 0, 0, 0, 209, 54, 0, 0, 48, 2, 0, 0, 128,
-		0, 0, 0, 224, 54, 0, 0, 10, 3, 0, 0, 192, 0, 0, 0, 230,
-		54, 0, 0, 11, 3, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 29, 2, 0, 0, 0, 0, 0, 0, 3, 0, 0, 13, 130,
-		1, 0, 0, 0, 0, 0, 0, 30, 2, 0, 0, 0, 0, 0, 0, 46,
-		2, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 31, 2, 0, 0, 240, 54, 0, 0, 12, 0, 0, 132, 64,
-		0, 0, 0, 225, 34, 0, 0, 24, 1, 0, 0, 0, 0, 0, 0, 7,
-		21, 0, 0, 60, 0, 0, 0, 64, 0, 0, 0, 150, 6, 0, 0, 30,
-		2, 0, 0, 192, 0, 0, 0, 248, 54, 0, 0, 32, 2, 0, 0, 0,
-		1, 0, 0, 253, 54, 0, 0, 33, 2, 0, 0, 64, 1, 0, 0, 3,
-		55, 0, 0, 220, 1, 0, 0, 128, 1, 0, 0, 6, 55, 0, 0, 45,
-		2, 0, 0, 192, 1, 0, 0, 11, 55, 0, 0, 10, 0, 0, 0, 224,
-		1, 0, 1, 29, 55, 0, 0, 10, 0, 0, 0, 225, 1, 0, 1, 44,
-		55, 0, 0, 10, 0, 0, 0, 226, 1, 0, 1, 66, 55, 0, 0, 10,
-		0, 0, 0, 227, 1, 0, 1, 91, 55, 0, 0, 10, 0, 0, 0, 228,
-		1, 0, 1, 0, 0, 0, 0, 0, 0, 0, 2, 187, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 34, 2, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 10, 188, 3, 0, 0, 107, 55, 0, 0, 13, 0, 0, 4, 128,
-		0, 0, 0, 101, 14, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 119,
-		55, 0, 0, 47, 0, 0, 0, 32, 0, 0, 0, 150, 6, 0, 0, 220,
-		1, 0, 0, 64, 0, 0, 0, 225, 34, 0, 0, 24, 1, 0, 0, 128,
-		0, 0, 0, 185, 30, 0, 0, 58, 0, 0, 0, 192, 0, 0, 0, 255,
-		31, 0, 0, 40, 1, 0, 0, 128, 1, 0, 0, 163, 34, 0, 0, 10,
-		0, 0, 0, 192, 1, 0, 0, 0, 0, 0, 0, 36, 2, 0, 0, 0,
-		2, 0, 0, 126, 55, 0, 0, 44, 0, 0, 0, 0, 3, 0, 0, 159,
-		0, 0, 0, 39, 0, 0, 0, 64, 3, 0, 0, 186, 0, 0, 0, 29,
-		0, 0, 0, 128, 3, 0, 0, 10, 40, 0, 0, 130, 1, 0, 0, 144,
-		3, 0, 0, 131, 55, 0, 0, 44, 2, 0, 0, 192, 3, 0, 0, 0,
-		0, 0, 0, 3, 0, 0, 5, 32, 0, 0, 0, 118, 39, 0, 0, 37,
-		2, 0, 0, 0, 0, 0, 0, 137, 55, 0, 0, 39, 2, 0, 0, 0,
-		0, 0, 0, 22, 51, 0, 0, 40, 2, 0, 0, 0, 0, 0, 0, 145,
-		55, 0, 0, 4, 0, 0, 4, 32, 0, 0, 0, 161, 55, 0, 0, 31,
-		0, 0, 0, 0, 0, 0, 0, 157, 6, 0, 0, 66, 0, 0, 0, 64,
-		0, 0, 0, 41, 38, 0, 0, 38, 2, 0, 0, 128, 0, 0, 0, 169,
-		55, 0, 0, 31, 0, 0, 0, 192, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 189, 3, 0, 0, 173, 55, 0, 0, 1, 0, 0, 4, 8,
-		0, 0, 0, 193, 55, 0, 0, 220, 1, 0, 0, 0, 0, 0, 0, 203,
-		55, 0, 0, 4, 0, 0, 4, 32, 0, 0, 0, 87, 39, 0, 0, 41,
-		2, 0, 0, 0, 0, 0, 0, 220, 55, 0, 0, 43, 2, 0, 0, 64,
-		0, 0, 0, 14, 29, 0, 0, 156, 0, 0, 0, 128, 0, 0, 0, 225,
-		55, 0, 0, 220, 1, 0, 0, 192, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 42, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 211,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 212, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 213, 3, 0, 0, 6, 55, 0, 0, 1,
-		0, 0, 4, 4, 0, 0, 0, 25, 42, 0, 0, 45, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 47, 2, 0, 0, 237,
-		55, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 225, 34, 0, 0, 24,
-		1, 0, 0, 0, 0, 0, 0, 10, 40, 0, 0, 130, 1, 0, 0, 64,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 49, 2, 0, 0, 0,
-		0, 0, 0, 3, 0, 0, 13, 130, 1, 0, 0, 0, 0, 0, 0, 30,
-		2, 0, 0, 0, 0, 0, 0, 50, 2, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 51, 2, 0, 0, 247,
-		55, 0, 0, 7, 0, 0, 4, 64, 0, 0, 0, 22, 51, 0, 0, 47,
-		2, 0, 0, 0, 0, 0, 0, 14, 29, 0, 0, 28, 1, 0, 0, 128,
-		0, 0, 0, 162, 36, 0, 0, 44, 0, 0, 0, 192, 0, 0, 0, 140,
-		27, 0, 0, 52, 2, 0, 0, 0, 1, 0, 0, 94, 35, 0, 0, 6,
-		3, 0, 0, 64, 1, 0, 0, 5, 56, 0, 0, 6, 3, 0, 0, 128,
-		1, 0, 0, 155, 24, 0, 0, 8, 3, 0, 0, 192, 1, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 53, 2, 0, 0, 0, 0, 0, 0, 1,
-		0, 0, 13, 176, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 11,
-		56, 0, 0, 15, 0, 0, 4, 192, 0, 0, 0, 25, 56, 0, 0, 147,
-		0, 0, 0, 0, 0, 0, 0, 30, 56, 0, 0, 3, 3, 0, 0, 64,
-		0, 0, 0, 38, 56, 0, 0, 182, 0, 0, 0, 192, 0, 0, 0, 54,
-		56, 0, 0, 212, 1, 0, 0, 0, 2, 0, 0, 63, 56, 0, 0, 47,
-		0, 0, 0, 32, 2, 0, 0, 79, 56, 0, 0, 65, 0, 0, 0, 64,
-		2, 0, 0, 86, 56, 0, 0, 182, 0, 0, 0, 192, 2, 0, 0, 99,
-		56, 0, 0, 31, 0, 0, 0, 0, 4, 0, 0, 107, 56, 0, 0, 31,
-		0, 0, 0, 64, 4, 0, 0, 123, 56, 0, 0, 4, 3, 0, 0, 128,
-		4, 0, 0, 186, 0, 0, 0, 31, 0, 0, 0, 192, 4, 0, 0, 129,
-		56, 0, 0, 177, 0, 0, 0, 0, 5, 0, 0, 136, 56, 0, 0, 150,
-		0, 0, 0, 32, 5, 0, 0, 149, 56, 0, 0, 60, 0, 0, 0, 64,
-		5, 0, 0, 122, 27, 0, 0, 44, 0, 0, 0, 192, 5, 0, 0, 162,
-		56, 0, 0, 54, 0, 0, 4, 120, 2, 0, 0, 168, 56, 0, 0, 130,
-		1, 0, 0, 0, 0, 0, 0, 175, 56, 0, 0, 29, 0, 0, 0, 16,
-		0, 0, 0, 185, 56, 0, 0, 168, 0, 0, 0, 32, 0, 0, 0, 191,
-		56, 0, 0, 252, 0, 0, 0, 64, 0, 0, 0, 197, 56, 0, 0, 10,
-		0, 0, 0, 96, 0, 0, 0, 205, 56, 0, 0, 56, 2, 0, 0, 128,
-		0, 0, 0, 211, 56, 0, 0, 56, 2, 0, 0, 192, 0, 0, 0, 225,
-		56, 0, 0, 61, 2, 0, 0, 0, 1, 0, 0, 230, 56, 0, 0, 63,
-		2, 0, 0, 64, 1, 0, 0, 235, 56, 0, 0, 176, 0, 0, 0, 128,
-		1, 0, 0, 245, 56, 0, 0, 44, 0, 0, 0, 192, 1, 0, 0, 0,
-		57, 0, 0, 31, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 64,
-		2, 0, 0, 64, 2, 0, 0, 6, 57, 0, 0, 66, 2, 0, 0, 96,
-		2, 0, 0, 13, 57, 0, 0, 156, 0, 0, 0, 128, 2, 0, 0, 20,
-		57, 0, 0, 68, 2, 0, 0, 192, 2, 0, 0, 28, 57, 0, 0, 68,
-		2, 0, 0, 64, 3, 0, 0, 36, 57, 0, 0, 68, 2, 0, 0, 192,
-		3, 0, 0, 44, 57, 0, 0, 150, 0, 0, 0, 64, 4, 0, 0, 51,
-		57, 0, 0, 29, 0, 0, 0, 96, 4, 0, 0, 59, 57, 0, 0, 20,
-		0, 0, 0, 112, 4, 0, 0, 69, 57, 0, 0, 20, 0, 0, 0, 120,
-		4, 0, 0, 82, 57, 0, 0, 69, 2, 0, 0, 128, 4, 0, 0, 91,
-		57, 0, 0, 31, 0, 0, 0, 192, 4, 0, 0, 99, 57, 0, 0, 182,
-		0, 0, 0, 0, 5, 0, 0, 107, 57, 0, 0, 31, 0, 0, 0, 64,
-		6, 0, 0, 120, 57, 0, 0, 31, 0, 0, 0, 128, 6, 0, 0, 138,
-		57, 0, 0, 238, 0, 0, 0, 192, 6, 0, 0, 145, 57, 0, 0, 60,
-		0, 0, 0, 64, 7, 0, 0, 155, 57, 0, 0, 70, 2, 0, 0, 192,
-		7, 0, 0, 160, 57, 0, 0, 2, 0, 0, 0, 0, 8, 0, 0, 176,
-		57, 0, 0, 53, 0, 0, 0, 32, 8, 0, 0, 194, 57, 0, 0, 53,
-		0, 0, 0, 48, 8, 0, 0, 211, 57, 0, 0, 60, 0, 0, 0, 64,
-		8, 0, 0, 217, 57, 0, 0, 60, 0, 0, 0, 192, 8, 0, 0, 227,
-		57, 0, 0, 60, 0, 0, 0, 64, 9, 0, 0, 0, 0, 0, 0, 71,
-		2, 0, 0, 192, 9, 0, 0, 237, 57, 0, 0, 104, 0, 0, 0, 64,
-		10, 0, 0, 247, 57, 0, 0, 104, 0, 0, 0, 128, 10, 0, 0, 2,
-		58, 0, 0, 47, 0, 0, 0, 192, 10, 0, 0, 10, 58, 0, 0, 47,
-		0, 0, 0, 224, 10, 0, 0, 22, 58, 0, 0, 47, 0, 0, 0, 0,
-		11, 0, 0, 35, 58, 0, 0, 47, 0, 0, 0, 32, 11, 0, 0, 0,
-		0, 0, 0, 72, 2, 0, 0, 64, 11, 0, 0, 47, 58, 0, 0, 253,
-		2, 0, 0, 128, 11, 0, 0, 55, 58, 0, 0, 54, 2, 0, 0, 192,
-		11, 0, 0, 62, 58, 0, 0, 60, 0, 0, 0, 192, 17, 0, 0, 0,
-		0, 0, 0, 254, 2, 0, 0, 64, 18, 0, 0, 72, 58, 0, 0, 9,
-		0, 0, 0, 128, 18, 0, 0, 85, 58, 0, 0, 9, 0, 0, 0, 160,
-		18, 0, 0, 101, 58, 0, 0, 0, 3, 0, 0, 192, 18, 0, 0, 118,
-		58, 0, 0, 1, 3, 0, 0, 0, 19, 0, 0, 131, 58, 0, 0, 2,
-		3, 0, 0, 64, 19, 0, 0, 145, 58, 0, 0, 44, 0, 0, 0, 128,
-		19, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 57, 2, 0, 0, 155,
-		58, 0, 0, 4, 0, 0, 4, 32, 0, 0, 0, 165, 58, 0, 0, 45,
-		0, 0, 0, 0, 0, 0, 0, 176, 58, 0, 0, 111, 0, 0, 0, 64,
-		0, 0, 0, 182, 58, 0, 0, 10, 0, 0, 0, 192, 0, 0, 0, 190,
-		58, 0, 0, 60, 2, 0, 0, 224, 0, 0, 0, 200, 58, 0, 0, 3,
-		0, 0, 4, 8, 0, 0, 0, 216, 58, 0, 0, 16, 1, 0, 0, 0,
-		0, 0, 0, 222, 58, 0, 0, 29, 0, 0, 0, 16, 0, 0, 0, 0,
-		0, 0, 0, 59, 2, 0, 0, 32, 0, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 5, 4, 0, 0, 0, 229, 58, 0, 0, 168, 0, 0, 0, 0,
-		0, 0, 0, 235, 58, 0, 0, 252, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 58, 2, 0, 0, 4,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 62,
-		2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 183, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 181, 3, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 5, 4, 0, 0, 0, 241, 58, 0, 0, 65, 2, 0, 0, 0,
-		0, 0, 0, 249, 58, 0, 0, 10, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 10, 10, 0, 0, 0, 3, 59, 0, 0, 0,
-		0, 0, 8, 67, 2, 0, 0, 9, 59, 0, 0, 0, 0, 0, 8, 8,
-		0, 0, 0, 24, 59, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 143,
-		31, 0, 0, 13, 1, 0, 0, 0, 0, 0, 0, 150, 31, 0, 0, 30,
-		0, 0, 0, 64, 0, 0, 0, 35, 59, 0, 0, 0, 0, 0, 8, 39,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 238, 3, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 5, 16, 0, 0, 0, 44, 59, 0, 0, 122,
-		0, 0, 0, 0, 0, 0, 0, 53, 59, 0, 0, 111, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 5, 8, 0, 0, 0, 59,
-		59, 0, 0, 148, 0, 0, 0, 0, 0, 0, 0, 65, 59, 0, 0, 251,
-		2, 0, 0, 0, 0, 0, 0, 76, 59, 0, 0, 33, 0, 0, 4, 8,
-		1, 0, 0, 102, 26, 0, 0, 22, 2, 0, 0, 0, 0, 0, 0, 92,
-		59, 0, 0, 122, 2, 0, 0, 64, 0, 0, 0, 94, 35, 0, 0, 124,
-		2, 0, 0, 128, 0, 0, 0, 5, 56, 0, 0, 129, 2, 0, 0, 192,
-		0, 0, 0, 99, 59, 0, 0, 131, 2, 0, 0, 0, 1, 0, 0, 109,
-		59, 0, 0, 131, 2, 0, 0, 64, 1, 0, 0, 120, 59, 0, 0, 174,
-		2, 0, 0, 128, 1, 0, 0, 127, 59, 0, 0, 181, 2, 0, 0, 192,
-		1, 0, 0, 135, 59, 0, 0, 181, 2, 0, 0, 0, 2, 0, 0, 251,
-		30, 0, 0, 188, 2, 0, 0, 64, 2, 0, 0, 150, 59, 0, 0, 196,
-		2, 0, 0, 128, 2, 0, 0, 165, 59, 0, 0, 196, 2, 0, 0, 192,
-		2, 0, 0, 155, 24, 0, 0, 198, 2, 0, 0, 0, 3, 0, 0, 178,
-		59, 0, 0, 31, 0, 0, 0, 64, 3, 0, 0, 220, 55, 0, 0, 200,
-		2, 0, 0, 128, 3, 0, 0, 199, 59, 0, 0, 202, 2, 0, 0, 192,
-		3, 0, 0, 205, 59, 0, 0, 200, 2, 0, 0, 0, 4, 0, 0, 213,
-		59, 0, 0, 205, 2, 0, 0, 64, 4, 0, 0, 213, 51, 0, 0, 207,
-		2, 0, 0, 128, 4, 0, 0, 63, 18, 0, 0, 209, 2, 0, 0, 192,
-		4, 0, 0, 219, 59, 0, 0, 224, 2, 0, 0, 0, 5, 0, 0, 182,
-		24, 0, 0, 139, 0, 0, 0, 64, 5, 0, 0, 228, 59, 0, 0, 226,
-		2, 0, 0, 128, 5, 0, 0, 240, 59, 0, 0, 209, 2, 0, 0, 192,
-		5, 0, 0, 246, 59, 0, 0, 228, 2, 0, 0, 0, 6, 0, 0, 3,
-		60, 0, 0, 230, 2, 0, 0, 64, 6, 0, 0, 15, 60, 0, 0, 232,
-		2, 0, 0, 128, 6, 0, 0, 24, 60, 0, 0, 236, 2, 0, 0, 192,
-		6, 0, 0, 34, 60, 0, 0, 238, 2, 0, 0, 0, 7, 0, 0, 46,
-		60, 0, 0, 240, 2, 0, 0, 64, 7, 0, 0, 62, 60, 0, 0, 242,
-		2, 0, 0, 128, 7, 0, 0, 79, 60, 0, 0, 205, 2, 0, 0, 192,
-		7, 0, 0, 87, 60, 0, 0, 244, 2, 0, 0, 0, 8, 0, 0, 22,
-		53, 0, 0, 71, 0, 0, 4, 128, 3, 0, 0, 199, 19, 0, 0, 75,
-		2, 0, 0, 0, 0, 0, 0, 80, 20, 0, 0, 60, 0, 0, 0, 64,
-		0, 0, 0, 225, 34, 0, 0, 76, 2, 0, 0, 192, 0, 0, 0, 97,
-		60, 0, 0, 77, 2, 0, 0, 128, 2, 0, 0, 103, 60, 0, 0, 79,
-		2, 0, 0, 128, 5, 0, 0, 117, 60, 0, 0, 24, 1, 0, 0, 192,
-		5, 0, 0, 125, 60, 0, 0, 24, 1, 0, 0, 0, 6, 0, 0, 136,
-		60, 0, 0, 30, 2, 0, 0, 64, 6, 0, 0, 148, 60, 0, 0, 80,
-		2, 0, 0, 128, 6, 0, 0, 153, 60, 0, 0, 82, 2, 0, 0, 192,
-		6, 0, 0, 158, 60, 0, 0, 10, 0, 0, 0, 0, 7, 0, 0, 167,
-		60, 0, 0, 154, 0, 0, 0, 64, 7, 0, 0, 178, 60, 0, 0, 84,
-		2, 0, 0, 64, 8, 0, 0, 181, 60, 0, 0, 10, 0, 0, 0, 128,
-		8, 0, 0, 188, 60, 0, 0, 10, 0, 0, 0, 160, 8, 0, 0, 201,
-		60, 0, 0, 80, 2, 0, 0, 192, 8, 0, 0, 210, 60, 0, 0, 82,
-		2, 0, 0, 0, 9, 0, 0, 219, 60, 0, 0, 52, 1, 0, 0, 64,
-		9, 0, 0, 241, 60, 0, 0, 52, 1, 0, 0, 72, 9, 0, 0, 248,
-		60, 0, 0, 52, 1, 0, 0, 80, 9, 0, 0, 14, 61, 0, 0, 10,
-		0, 0, 0, 96, 9, 0, 0, 28, 61, 0, 0, 86, 2, 0, 0, 128,
-		9, 0, 0, 36, 61, 0, 0, 87, 2, 0, 0, 192, 9, 0, 0, 41,
-		61, 0, 0, 89, 2, 0, 0, 0, 10, 0, 0, 53, 61, 0, 0, 89,
-		2, 0, 0, 128, 12, 0, 0, 65, 61, 0, 0, 93, 2, 0, 0, 0,
-		15, 0, 0, 70, 61, 0, 0, 31, 0, 0, 0, 0, 16, 0, 0, 77,
-		61, 0, 0, 10, 0, 0, 0, 64, 16, 0, 0, 86, 61, 0, 0, 60,
-		0, 0, 0, 128, 16, 0, 0, 95, 61, 0, 0, 96, 2, 0, 0, 0,
-		17, 0, 0, 105, 61, 0, 0, 97, 2, 0, 0, 64, 17, 0, 0, 114,
-		61, 0, 0, 98, 2, 0, 0, 128, 17, 0, 0, 128, 61, 0, 0, 101,
-		2, 0, 0, 128, 18, 0, 0, 139, 61, 0, 0, 102, 2, 0, 0, 192,
-		18, 0, 0, 162, 0, 0, 0, 37, 1, 0, 0, 0, 19, 0, 0, 151,
-		61, 0, 0, 44, 0, 0, 0, 64, 19, 0, 0, 158, 61, 0, 0, 10,
-		0, 0, 0, 128, 19, 0, 0, 170, 61, 0, 0, 44, 0, 0, 0, 192,
-		19, 0, 0, 189, 61, 0, 0, 10, 0, 0, 0, 0, 20, 0, 0, 207,
-		61, 0, 0, 10, 0, 0, 0, 32, 20, 0, 0, 223, 61, 0, 0, 103,
-		2, 0, 0, 64, 20, 0, 0, 240, 61, 0, 0, 10, 0, 0, 0, 128,
-		20, 0, 0, 1, 62, 0, 0, 106, 2, 0, 0, 192, 20, 0, 0, 18,
-		62, 0, 0, 10, 0, 0, 0, 0, 21, 0, 0, 37, 62, 0, 0, 108,
-		2, 0, 0, 64, 21, 0, 0, 52, 62, 0, 0, 10, 0, 0, 0, 128,
-		21, 0, 0, 66, 62, 0, 0, 44, 0, 0, 0, 192, 21, 0, 0, 75,
-		62, 0, 0, 109, 2, 0, 0, 0, 22, 0, 0, 88, 62, 0, 0, 10,
-		0, 0, 0, 64, 22, 0, 0, 105, 62, 0, 0, 10, 0, 0, 0, 96,
-		22, 0, 0, 127, 62, 0, 0, 110, 2, 0, 0, 128, 22, 0, 0, 151,
-		62, 0, 0, 111, 2, 0, 0, 192, 22, 0, 0, 164, 62, 0, 0, 10,
-		0, 0, 0, 0, 23, 0, 0, 181, 62, 0, 0, 113, 2, 0, 0, 64,
-		23, 0, 0, 193, 62, 0, 0, 10, 0, 0, 0, 128, 23, 0, 0, 209,
-		62, 0, 0, 10, 0, 0, 0, 160, 23, 0, 0, 230, 62, 0, 0, 197,
-		1, 0, 0, 192, 23, 0, 0, 247, 62, 0, 0, 44, 0, 0, 0, 0,
-		24, 0, 0, 10, 63, 0, 0, 10, 0, 0, 0, 64, 24, 0, 0, 28,
-		63, 0, 0, 197, 1, 0, 0, 128, 24, 0, 0, 45, 63, 0, 0, 10,
-		0, 0, 0, 192, 24, 0, 0, 66, 63, 0, 0, 2, 0, 0, 0, 224,
-		24, 0, 0, 83, 63, 0, 0, 115, 2, 0, 0, 0, 25, 0, 0, 96,
-		63, 0, 0, 10, 0, 0, 0, 64, 25, 0, 0, 114, 63, 0, 0, 117,
-		2, 0, 0, 128, 25, 0, 0, 133, 63, 0, 0, 60, 0, 0, 0, 192,
-		25, 0, 0, 145, 63, 0, 0, 60, 0, 0, 0, 64, 26, 0, 0, 157,
-		63, 0, 0, 119, 2, 0, 0, 192, 26, 0, 0, 148, 20, 0, 0, 47,
-		0, 0, 0, 0, 27, 0, 0, 162, 63, 0, 0, 121, 2, 0, 0, 64,
-		27, 0, 0, 171, 63, 0, 0, 10, 0, 0, 0, 128, 27, 0, 0, 184,
-		63, 0, 0, 4, 0, 0, 6, 4, 0, 0, 0, 197, 63, 0, 0, 0,
-		0, 0, 0, 215, 63, 0, 0, 1, 0, 0, 0, 235, 63, 0, 0, 2,
-		0, 0, 0, 254, 63, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 3, 0, 0, 0, 0, 33, 0, 0, 0, 4, 0, 0, 0, 56,
-		0, 0, 0, 20, 64, 0, 0, 5, 0, 0, 4, 96, 0, 0, 0, 35,
-		64, 0, 0, 31, 2, 0, 0, 0, 0, 0, 0, 40, 64, 0, 0, 22,
-		2, 0, 0, 0, 2, 0, 0, 44, 64, 0, 0, 30, 2, 0, 0, 64,
-		2, 0, 0, 56, 64, 0, 0, 78, 2, 0, 0, 128, 2, 0, 0, 59,
-		64, 0, 0, 241, 0, 0, 0, 192, 2, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 249, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 250,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 81, 2, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 10, 85, 2, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 83, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 7,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 251, 3, 0, 0, 75,
-		64, 0, 0, 3, 0, 0, 4, 12, 0, 0, 0, 89, 64, 0, 0, 2,
-		0, 0, 0, 0, 0, 0, 0, 102, 64, 0, 0, 2, 0, 0, 0, 32,
-		0, 0, 0, 114, 64, 0, 0, 2, 0, 0, 0, 64, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 227, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 88, 2, 0, 0, 0, 0, 0, 0, 1, 0, 0, 13, 2,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 131, 64, 0, 0, 6,
-		0, 0, 4, 80, 0, 0, 0, 194, 19, 0, 0, 44, 0, 0, 0, 0,
-		0, 0, 0, 14, 29, 0, 0, 10, 0, 0, 0, 64, 0, 0, 0, 145,
-		64, 0, 0, 10, 0, 0, 0, 96, 0, 0, 0, 155, 64, 0, 0, 10,
-		0, 0, 0, 128, 0, 0, 0, 163, 64, 0, 0, 10, 0, 0, 0, 160,
-		0, 0, 0, 182, 64, 0, 0, 90, 2, 0, 0, 192, 0, 0, 0, 186,
-		64, 0, 0, 2, 0, 0, 4, 56, 0, 0, 0, 40, 64, 0, 0, 22,
-		2, 0, 0, 0, 0, 0, 0, 167, 19, 0, 0, 91, 2, 0, 0, 64,
-		0, 0, 0, 200, 64, 0, 0, 1, 0, 0, 4, 48, 0, 0, 0, 167,
-		19, 0, 0, 92, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 3, 0, 0, 0, 0, 58, 0, 0, 0, 4, 0, 0, 0, 2,
-		0, 0, 0, 216, 64, 0, 0, 3, 0, 0, 4, 32, 0, 0, 0, 234,
-		64, 0, 0, 94, 2, 0, 0, 0, 0, 0, 0, 36, 61, 0, 0, 94,
-		2, 0, 0, 96, 0, 0, 0, 239, 64, 0, 0, 95, 2, 0, 0, 192,
-		0, 0, 0, 2, 65, 0, 0, 3, 0, 0, 4, 12, 0, 0, 0, 14,
-		65, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 24, 65, 0, 0, 2,
-		0, 0, 0, 32, 0, 0, 0, 40, 65, 0, 0, 2, 0, 0, 0, 64,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 252, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 253, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 98, 2, 0, 0, 56, 65, 0, 0, 4, 0, 0, 4, 32,
-		0, 0, 0, 69, 65, 0, 0, 99, 2, 0, 0, 0, 0, 0, 0, 76,
-		65, 0, 0, 10, 0, 0, 0, 64, 0, 0, 0, 87, 65, 0, 0, 37,
-		1, 0, 0, 128, 0, 0, 0, 94, 65, 0, 0, 37, 1, 0, 0, 192,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 100, 2, 0, 0, 102,
-		65, 0, 0, 0, 0, 0, 8, 254, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 255, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 0,
-		4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 104, 2, 0, 0, 112,
-		65, 0, 0, 0, 0, 0, 8, 105, 2, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 10, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 107,
-		2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 2, 4, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 3, 4, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 5, 4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 24,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 112, 2, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 118, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 114, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 16,
-		4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 116, 2, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 17, 4, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 118, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 233,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 120, 2, 0, 0, 0,
-		0, 0, 0, 1, 0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 1, 4, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 123, 2, 0, 0, 0, 0, 0, 0, 3,
-		0, 0, 13, 156, 0, 0, 0, 0, 0, 0, 0, 141, 0, 0, 0, 0,
-		0, 0, 0, 156, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 125, 2, 0, 0, 0, 0, 0, 0, 4,
-		0, 0, 13, 126, 2, 0, 0, 0, 0, 0, 0, 141, 0, 0, 0, 0,
-		0, 0, 0, 37, 1, 0, 0, 0, 0, 0, 0, 28, 1, 0, 0, 0,
-		0, 0, 0, 135, 1, 0, 0, 129, 65, 0, 0, 0, 0, 0, 8, 127,
-		2, 0, 0, 137, 65, 0, 0, 0, 0, 0, 8, 128, 2, 0, 0, 154,
-		65, 0, 0, 0, 0, 0, 8, 30, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 130, 2, 0, 0, 0, 0, 0, 0, 4, 0, 0, 13, 126,
-		2, 0, 0, 0, 0, 0, 0, 141, 0, 0, 0, 0, 0, 0, 0, 24,
-		1, 0, 0, 0, 0, 0, 0, 28, 1, 0, 0, 0, 0, 0, 0, 135,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 132, 2, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 13, 126, 2, 0, 0, 0, 0, 0, 0, 133,
-		2, 0, 0, 0, 0, 0, 0, 138, 2, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 134, 2, 0, 0, 170, 65, 0, 0, 7, 0, 0, 4, 48,
-		0, 0, 0, 176, 65, 0, 0, 141, 0, 0, 0, 0, 0, 0, 0, 184,
-		65, 0, 0, 156, 0, 0, 0, 64, 0, 0, 0, 191, 65, 0, 0, 135,
-		2, 0, 0, 128, 0, 0, 0, 162, 36, 0, 0, 44, 0, 0, 0, 192,
-		0, 0, 0, 203, 65, 0, 0, 2, 0, 0, 0, 0, 1, 0, 0, 212,
-		65, 0, 0, 53, 0, 0, 0, 32, 1, 0, 0, 222, 65, 0, 0, 137,
-		2, 0, 0, 64, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 136,
-		2, 0, 0, 0, 0, 0, 0, 2, 0, 0, 13, 0, 0, 0, 0, 0,
-		0, 0, 0, 133, 2, 0, 0, 0, 0, 0, 0, 30, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 194, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 139, 2, 0, 0, 231, 65, 0, 0, 8, 0, 0, 4, 40,
-		0, 0, 0, 240, 65, 0, 0, 20, 0, 0, 0, 0, 0, 0, 0, 250,
-		65, 0, 0, 52, 1, 0, 0, 8, 0, 0, 0, 2, 66, 0, 0, 52,
-		1, 0, 0, 16, 0, 0, 0, 14, 66, 0, 0, 52, 1, 0, 0, 24,
-		0, 0, 0, 0, 0, 0, 0, 140, 2, 0, 0, 64, 0, 0, 0, 101,
-		14, 0, 0, 28, 1, 0, 0, 128, 0, 0, 0, 0, 0, 0, 0, 141,
-		2, 0, 0, 192, 0, 0, 0, 0, 0, 0, 0, 172, 2, 0, 0, 0,
-		1, 0, 0, 0, 0, 0, 0, 2, 0, 0, 5, 8, 0, 0, 0, 26,
-		66, 0, 0, 28, 1, 0, 0, 0, 0, 0, 0, 37, 66, 0, 0, 2,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6, 0, 0, 5, 8,
-		0, 0, 0, 49, 66, 0, 0, 142, 2, 0, 0, 0, 0, 0, 0, 53,
-		66, 0, 0, 144, 2, 0, 0, 0, 0, 0, 0, 58, 66, 0, 0, 146,
-		2, 0, 0, 0, 0, 0, 0, 63, 66, 0, 0, 148, 2, 0, 0, 0,
-		0, 0, 0, 70, 66, 0, 0, 202, 1, 0, 0, 0, 0, 0, 0, 75,
-		66, 0, 0, 44, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 143, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 190,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 145, 2, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 10, 191, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 147, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 192,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 3, 3, 0, 0, 80,
-		66, 0, 0, 21, 0, 0, 4, 176, 0, 0, 0, 249, 27, 0, 0, 154,
-		0, 0, 0, 0, 0, 0, 0, 96, 66, 0, 0, 233, 0, 0, 0, 0,
-		1, 0, 0, 104, 66, 0, 0, 233, 0, 0, 0, 192, 1, 0, 0, 245,
-		31, 0, 0, 10, 0, 0, 0, 128, 2, 0, 0, 160, 18, 0, 0, 10,
-		0, 0, 0, 160, 2, 0, 0, 112, 66, 0, 0, 10, 0, 0, 0, 192,
-		2, 0, 0, 122, 66, 0, 0, 10, 0, 0, 0, 224, 2, 0, 0, 132,
-		66, 0, 0, 52, 1, 0, 0, 0, 3, 0, 0, 142, 66, 0, 0, 10,
-		0, 0, 0, 32, 3, 0, 0, 155, 66, 0, 0, 10, 0, 0, 0, 64,
-		3, 0, 0, 163, 66, 0, 0, 10, 0, 0, 0, 96, 3, 0, 0, 24,
-		8, 0, 0, 10, 0, 0, 0, 128, 3, 0, 0, 171, 66, 0, 0, 10,
-		0, 0, 0, 160, 3, 0, 0, 181, 66, 0, 0, 10, 0, 0, 0, 192,
-		3, 0, 0, 191, 66, 0, 0, 52, 1, 0, 0, 224, 3, 0, 0, 202,
-		66, 0, 0, 204, 1, 0, 0, 0, 4, 0, 0, 211, 66, 0, 0, 166,
-		2, 0, 0, 64, 4, 0, 0, 226, 66, 0, 0, 166, 2, 0, 0, 128,
-		4, 0, 0, 241, 66, 0, 0, 168, 2, 0, 0, 192, 4, 0, 0, 122,
-		33, 0, 0, 107, 1, 0, 0, 0, 5, 0, 0, 246, 66, 0, 0, 171,
-		2, 0, 0, 64, 5, 0, 0, 9, 43, 0, 0, 5, 0, 0, 4, 64,
-		0, 0, 0, 186, 0, 0, 0, 31, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 151, 2, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 165,
-		2, 0, 0, 128, 1, 0, 0, 2, 67, 0, 0, 47, 0, 0, 0, 160,
-		1, 0, 0, 12, 67, 0, 0, 31, 0, 0, 0, 192, 1, 0, 0, 0,
-		0, 0, 0, 7, 0, 0, 5, 40, 0, 0, 0, 0, 0, 0, 0, 152,
-		2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 155, 2, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 158, 2, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 159, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 160,
-		2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 163, 2, 0, 0, 0,
-		0, 0, 0, 72, 21, 0, 0, 111, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 4, 0, 0, 4, 40, 0, 0, 0, 0, 0, 0, 0, 153,
-		2, 0, 0, 0, 0, 0, 0, 23, 67, 0, 0, 176, 0, 0, 0, 128,
-		0, 0, 0, 133, 36, 0, 0, 31, 0, 0, 0, 192, 0, 0, 0, 162,
-		36, 0, 0, 31, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 4,
-		0, 0, 5, 16, 0, 0, 0, 31, 67, 0, 0, 60, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 154, 2, 0, 0, 0, 0, 0, 0, 35,
-		67, 0, 0, 60, 0, 0, 0, 0, 0, 0, 0, 46, 67, 0, 0, 60,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 4, 16,
-		0, 0, 0, 55, 67, 0, 0, 44, 0, 0, 0, 0, 0, 0, 0, 64,
-		67, 0, 0, 10, 0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 5,
-		0, 0, 4, 40, 0, 0, 0, 76, 67, 0, 0, 31, 0, 0, 0, 0,
-		0, 0, 0, 85, 67, 0, 0, 156, 2, 0, 0, 64, 0, 0, 0, 88,
-		67, 0, 0, 31, 0, 0, 0, 128, 0, 0, 0, 104, 67, 0, 0, 31,
-		0, 0, 0, 192, 0, 0, 0, 0, 0, 0, 0, 157, 2, 0, 0, 0,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 24, 4, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 5, 8, 0, 0, 0, 113, 67, 0, 0, 31,
-		0, 0, 0, 0, 0, 0, 0, 128, 67, 0, 0, 103, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 6, 0, 0, 4, 24, 0, 0, 0, 142,
-		67, 0, 0, 31, 0, 0, 0, 0, 0, 0, 0, 156, 67, 0, 0, 22,
-		0, 0, 0, 64, 0, 0, 0, 170, 67, 0, 0, 22, 0, 0, 0, 72,
-		0, 0, 0, 185, 67, 0, 0, 47, 0, 0, 0, 96, 0, 0, 0, 203,
-		67, 0, 0, 47, 0, 0, 0, 128, 0, 0, 0, 221, 67, 0, 0, 10,
-		0, 0, 0, 160, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 4, 32,
-		0, 0, 0, 233, 67, 0, 0, 31, 0, 0, 0, 0, 0, 0, 0, 249,
-		67, 0, 0, 31, 0, 0, 0, 64, 0, 0, 0, 9, 68, 0, 0, 60,
-		0, 0, 0, 128, 0, 0, 0, 0, 0, 0, 0, 5, 0, 0, 4, 40,
-		0, 0, 0, 23, 68, 0, 0, 31, 0, 0, 0, 0, 0, 0, 0, 33,
-		68, 0, 0, 161, 2, 0, 0, 64, 0, 0, 0, 46, 68, 0, 0, 31,
-		0, 0, 0, 128, 0, 0, 0, 0, 0, 0, 0, 162, 2, 0, 0, 192,
-		0, 0, 0, 56, 68, 0, 0, 150, 0, 0, 0, 0, 1, 0, 0, 60,
-		68, 0, 0, 0, 0, 0, 8, 204, 1, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 5, 8, 0, 0, 0, 70, 68, 0, 0, 135, 0, 0, 0, 0,
-		0, 0, 0, 76, 68, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 93, 68, 0, 0, 164,
-		2, 0, 0, 0, 0, 0, 0, 99, 68, 0, 0, 44, 0, 0, 0, 64,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 25, 4, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 5, 4, 0, 0, 0, 116, 68, 0, 0, 47,
-		0, 0, 0, 0, 0, 0, 0, 126, 68, 0, 0, 10, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 167, 2, 0, 0, 136,
-		68, 0, 0, 6, 0, 0, 4, 48, 0, 0, 0, 150, 68, 0, 0, 159,
-		0, 0, 0, 0, 0, 0, 0, 158, 68, 0, 0, 2, 0, 0, 0, 64,
-		0, 0, 0, 164, 68, 0, 0, 2, 0, 0, 0, 96, 0, 0, 0, 170,
-		68, 0, 0, 166, 2, 0, 0, 128, 0, 0, 0, 178, 68, 0, 0, 141,
-		0, 0, 0, 192, 0, 0, 0, 186, 68, 0, 0, 111, 0, 0, 0, 0,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 193, 3, 0, 0, 193,
-		68, 0, 0, 9, 0, 0, 4, 144, 0, 0, 0, 205, 68, 0, 0, 45,
-		0, 0, 0, 0, 0, 0, 0, 213, 68, 0, 0, 240, 1, 0, 0, 64,
-		0, 0, 0, 227, 68, 0, 0, 31, 0, 0, 0, 128, 1, 0, 0, 241,
-		68, 0, 0, 103, 0, 0, 0, 192, 1, 0, 0, 251, 68, 0, 0, 238,
-		0, 0, 0, 0, 2, 0, 0, 89, 28, 0, 0, 168, 0, 0, 0, 128,
-		2, 0, 0, 125, 25, 0, 0, 103, 0, 0, 0, 192, 2, 0, 0, 8,
-		69, 0, 0, 47, 0, 0, 0, 0, 3, 0, 0, 19, 69, 0, 0, 170,
-		2, 0, 0, 64, 3, 0, 0, 29, 69, 0, 0, 7, 0, 0, 4, 40,
-		0, 0, 0, 63, 18, 0, 0, 70, 0, 0, 0, 0, 0, 0, 0, 45,
-		69, 0, 0, 2, 0, 0, 0, 32, 0, 0, 0, 99, 21, 0, 0, 2,
-		0, 0, 0, 64, 0, 0, 0, 54, 69, 0, 0, 2, 0, 0, 0, 96,
-		0, 0, 0, 62, 69, 0, 0, 2, 0, 0, 0, 128, 0, 0, 0, 69,
-		69, 0, 0, 31, 0, 0, 0, 192, 0, 0, 0, 186, 0, 0, 0, 31,
-		0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 185,
-		3, 0, 0, 0, 0, 0, 0, 3, 0, 0, 5, 8, 0, 0, 0, 75,
-		69, 0, 0, 31, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 173,
-		2, 0, 0, 0, 0, 0, 0, 83, 69, 0, 0, 156, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 4, 8, 0, 0, 0, 245,
-		31, 0, 0, 10, 0, 0, 0, 0, 0, 0, 0, 96, 69, 0, 0, 10,
-		0, 0, 0, 32, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 175,
-		2, 0, 0, 0, 0, 0, 0, 3, 0, 0, 13, 2, 0, 0, 0, 0,
-		0, 0, 0, 133, 2, 0, 0, 0, 0, 0, 0, 176, 2, 0, 0, 0,
-		0, 0, 0, 10, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 177,
-		2, 0, 0, 107, 69, 0, 0, 3, 0, 0, 4, 24, 0, 0, 0, 121,
-		69, 0, 0, 178, 2, 0, 0, 0, 0, 0, 0, 130, 69, 0, 0, 52,
-		1, 0, 0, 64, 0, 0, 0, 138, 69, 0, 0, 179, 2, 0, 0, 128,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 234, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 180, 2, 0, 0, 0, 0, 0, 0, 1,
-		0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 0, 176, 2, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 182, 2, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 13, 2, 0, 0, 0, 0, 0, 0, 0, 141, 0, 0, 0, 0,
-		0, 0, 0, 183, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 184,
-		2, 0, 0, 147, 69, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 159,
-		69, 0, 0, 185, 2, 0, 0, 0, 0, 0, 0, 165, 69, 0, 0, 156,
-		0, 0, 0, 64, 0, 0, 0, 169, 69, 0, 0, 0, 0, 0, 8, 186,
-		2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 187, 2, 0, 0, 0,
-		0, 0, 0, 6, 0, 0, 13, 2, 0, 0, 0, 0, 0, 0, 0, 183,
-		2, 0, 0, 0, 0, 0, 0, 24, 1, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 0, 0, 0, 0, 0, 156, 0, 0, 0, 0, 0, 0, 0, 39,
-		0, 0, 0, 0, 0, 0, 0, 10, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 189, 2, 0, 0, 0, 0, 0, 0, 2, 0, 0, 13, 190,
-		2, 0, 0, 0, 0, 0, 0, 141, 0, 0, 0, 0, 0, 0, 0, 191,
-		2, 0, 0, 179, 69, 0, 0, 0, 0, 0, 8, 10, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 192, 2, 0, 0, 188, 69, 0, 0, 2,
-		0, 0, 4, 16, 0, 0, 0, 206, 69, 0, 0, 193, 2, 0, 0, 0,
-		0, 0, 0, 213, 69, 0, 0, 190, 2, 0, 0, 64, 0, 0, 0, 218,
-		69, 0, 0, 0, 0, 0, 8, 194, 2, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 195, 2, 0, 0, 0, 0, 0, 0, 3, 0, 0, 13, 0,
-		0, 0, 0, 0, 0, 0, 0, 141, 0, 0, 0, 0, 0, 0, 0, 244,
-		1, 0, 0, 0, 0, 0, 0, 191, 2, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 197, 2, 0, 0, 0, 0, 0, 0, 3, 0, 0, 13, 30,
-		0, 0, 0, 0, 0, 0, 0, 141, 0, 0, 0, 0, 0, 0, 0, 10,
-		0, 0, 0, 0, 0, 0, 0, 31, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 199, 2, 0, 0, 0, 0, 0, 0, 2, 0, 0, 13, 2,
-		0, 0, 0, 0, 0, 0, 0, 141, 0, 0, 0, 0, 0, 0, 0, 138,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 201, 2, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 13, 2, 0, 0, 0, 0, 0, 0, 0, 147,
-		0, 0, 0, 0, 0, 0, 0, 141, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 203, 2, 0, 0, 0, 0, 0, 0, 2, 0, 0, 13, 2,
-		0, 0, 0, 0, 0, 0, 0, 141, 0, 0, 0, 0, 0, 0, 0, 204,
-		2, 0, 0, 234, 69, 0, 0, 0, 0, 0, 8, 44, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 206, 2, 0, 0, 0, 0, 0, 0, 4,
-		0, 0, 13, 2, 0, 0, 0, 0, 0, 0, 0, 141, 0, 0, 0, 0,
-		0, 0, 0, 156, 0, 0, 0, 0, 0, 0, 0, 156, 0, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 208,
-		2, 0, 0, 0, 0, 0, 0, 3, 0, 0, 13, 2, 0, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 141, 0, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 210,
-		2, 0, 0, 0, 0, 0, 0, 3, 0, 0, 13, 2, 0, 0, 0, 0,
-		0, 0, 0, 141, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0,
-		0, 0, 0, 211, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 212,
-		2, 0, 0, 245, 69, 0, 0, 20, 0, 0, 4, 216, 0, 0, 0, 255,
-		69, 0, 0, 211, 2, 0, 0, 0, 0, 0, 0, 10, 70, 0, 0, 60,
-		0, 0, 0, 64, 0, 0, 0, 18, 70, 0, 0, 238, 0, 0, 0, 192,
-		0, 0, 0, 26, 70, 0, 0, 60, 0, 0, 0, 64, 1, 0, 0, 46,
-		70, 0, 0, 60, 0, 0, 0, 192, 1, 0, 0, 64, 70, 0, 0, 204,
-		2, 0, 0, 64, 2, 0, 0, 73, 70, 0, 0, 10, 0, 0, 0, 128,
-		2, 0, 0, 82, 70, 0, 0, 22, 0, 0, 0, 160, 2, 0, 0, 90,
-		70, 0, 0, 10, 0, 0, 0, 192, 2, 0, 0, 97, 70, 0, 0, 2,
-		0, 0, 0, 224, 2, 0, 0, 109, 70, 0, 0, 233, 0, 0, 0, 0,
-		3, 0, 0, 117, 70, 0, 0, 141, 0, 0, 0, 192, 3, 0, 0, 125,
-		70, 0, 0, 156, 0, 0, 0, 0, 4, 0, 0, 134, 70, 0, 0, 156,
-		0, 0, 0, 64, 4, 0, 0, 141, 70, 0, 0, 166, 2, 0, 0, 128,
-		4, 0, 0, 151, 70, 0, 0, 31, 0, 0, 0, 192, 4, 0, 0, 165,
-		70, 0, 0, 31, 0, 0, 0, 0, 5, 0, 0, 183, 70, 0, 0, 213,
-		2, 0, 0, 64, 5, 0, 0, 190, 70, 0, 0, 215, 2, 0, 0, 128,
-		5, 0, 0, 199, 70, 0, 0, 217, 2, 0, 0, 192, 5, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 214, 2, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 10, 19, 4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 216,
-		2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 20, 4, 0, 0, 0,
-		0, 0, 0, 3, 0, 0, 5, 32, 0, 0, 0, 204, 70, 0, 0, 218,
-		2, 0, 0, 0, 0, 0, 0, 211, 70, 0, 0, 221, 2, 0, 0, 0,
-		0, 0, 0, 219, 70, 0, 0, 223, 2, 0, 0, 0, 0, 0, 0, 223,
-		70, 0, 0, 3, 0, 0, 4, 32, 0, 0, 0, 199, 19, 0, 0, 8,
-		0, 0, 0, 0, 0, 0, 0, 102, 26, 0, 0, 219, 2, 0, 0, 64,
-		0, 0, 0, 80, 20, 0, 0, 60, 0, 0, 0, 128, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 220, 2, 0, 0, 237, 70, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 251, 70, 0, 0, 1, 0, 0, 4, 8,
-		0, 0, 0, 102, 26, 0, 0, 222, 2, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 21, 4, 0, 0, 0, 0, 0, 0, 3,
-		0, 0, 4, 24, 0, 0, 0, 175, 35, 0, 0, 60, 0, 0, 0, 0,
-		0, 0, 0, 199, 19, 0, 0, 2, 0, 0, 0, 128, 0, 0, 0, 10,
-		71, 0, 0, 10, 0, 0, 0, 160, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 225, 2, 0, 0, 0, 0, 0, 0, 6, 0, 0, 13, 126,
-		2, 0, 0, 0, 0, 0, 0, 141, 0, 0, 0, 0, 0, 0, 0, 204,
-		1, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 28,
-		1, 0, 0, 0, 0, 0, 0, 135, 1, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 227, 2, 0, 0, 0,
-		0, 0, 0, 1, 0, 0, 13, 2, 0, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 229, 2, 0, 0, 0,
-		0, 0, 0, 5, 0, 0, 13, 126, 2, 0, 0, 0, 0, 0, 0, 202,
-		1, 0, 0, 0, 0, 0, 0, 141, 0, 0, 0, 0, 0, 0, 0, 135,
-		1, 0, 0, 0, 0, 0, 0, 28, 1, 0, 0, 0, 0, 0, 0, 10,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 231, 2, 0, 0, 0,
-		0, 0, 0, 5, 0, 0, 13, 126, 2, 0, 0, 0, 0, 0, 0, 141,
-		0, 0, 0, 0, 0, 0, 0, 135, 1, 0, 0, 0, 0, 0, 0, 202,
-		1, 0, 0, 0, 0, 0, 0, 28, 1, 0, 0, 0, 0, 0, 0, 10,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 233, 2, 0, 0, 0,
-		0, 0, 0, 4, 0, 0, 13, 2, 0, 0, 0, 0, 0, 0, 0, 141,
-		0, 0, 0, 0, 0, 0, 0, 30, 0, 0, 0, 0, 0, 0, 0, 234,
-		2, 0, 0, 0, 0, 0, 0, 235, 2, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 211, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 44,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 237, 2, 0, 0, 0,
-		0, 0, 0, 4, 0, 0, 13, 30, 0, 0, 0, 0, 0, 0, 0, 141,
-		0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 156,
-		0, 0, 0, 0, 0, 0, 0, 156, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 239, 2, 0, 0, 0, 0, 0, 0, 2, 0, 0, 13, 0,
-		0, 0, 0, 0, 0, 0, 0, 64, 1, 0, 0, 0, 0, 0, 0, 141,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 241, 2, 0, 0, 0,
-		0, 0, 0, 6, 0, 0, 13, 126, 2, 0, 0, 0, 0, 0, 0, 141,
-		0, 0, 0, 0, 0, 0, 0, 156, 0, 0, 0, 0, 0, 0, 0, 141,
-		0, 0, 0, 0, 0, 0, 0, 156, 0, 0, 0, 0, 0, 0, 0, 28,
-		1, 0, 0, 0, 0, 0, 0, 10, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 243, 2, 0, 0, 0, 0, 0, 0, 6, 0, 0, 13, 156,
-		0, 0, 0, 0, 0, 0, 0, 141, 0, 0, 0, 0, 0, 0, 0, 156,
-		0, 0, 0, 0, 0, 0, 0, 141, 0, 0, 0, 0, 0, 0, 0, 156,
-		0, 0, 0, 0, 0, 0, 0, 156, 0, 0, 0, 0, 0, 0, 0, 10,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 245, 2, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 13, 2, 0, 0, 0, 0, 0, 0, 0, 246,
-		2, 0, 0, 0, 0, 0, 0, 10, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 247, 2, 0, 0, 19, 71, 0, 0, 6, 0, 0, 4, 64,
-		0, 0, 0, 9, 27, 0, 0, 141, 0, 0, 0, 0, 0, 0, 0, 32,
-		71, 0, 0, 40, 1, 0, 0, 64, 0, 0, 0, 36, 71, 0, 0, 248,
-		2, 0, 0, 128, 0, 0, 0, 49, 71, 0, 0, 8, 0, 0, 0, 192,
-		0, 0, 0, 56, 71, 0, 0, 8, 0, 0, 0, 224, 0, 0, 0, 60,
-		71, 0, 0, 250, 2, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 249, 2, 0, 0, 0, 0, 0, 0, 1, 0, 0, 13, 0,
-		0, 0, 0, 0, 0, 0, 0, 246, 2, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 3, 0, 0, 0, 0, 20, 0, 0, 0, 4, 0, 0, 0, 32,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 252, 2, 0, 0, 0,
-		0, 0, 0, 1, 0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 0, 147,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 239, 3, 0, 0, 0,
-		0, 0, 0, 4, 0, 0, 5, 8, 0, 0, 0, 64, 71, 0, 0, 202,
-		1, 0, 0, 0, 0, 0, 0, 71, 71, 0, 0, 255, 2, 0, 0, 0,
-		0, 0, 0, 78, 71, 0, 0, 37, 1, 0, 0, 0, 0, 0, 0, 85,
-		71, 0, 0, 10, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 224, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 204,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 240, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 22, 4, 0, 0, 63, 66, 0, 0, 3,
-		0, 0, 4, 16, 0, 0, 0, 95, 71, 0, 0, 150, 0, 0, 0, 0,
-		0, 0, 0, 103, 71, 0, 0, 212, 1, 0, 0, 32, 0, 0, 0, 112,
-		71, 0, 0, 44, 0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 5, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 23,
-		4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 7, 3, 0, 0, 0,
-		0, 0, 0, 6, 0, 0, 13, 126, 2, 0, 0, 0, 0, 0, 0, 141,
-		0, 0, 0, 0, 0, 0, 0, 30, 2, 0, 0, 0, 0, 0, 0, 50,
-		2, 0, 0, 0, 0, 0, 0, 37, 1, 0, 0, 0, 0, 0, 0, 156,
-		0, 0, 0, 0, 0, 0, 0, 28, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 9, 3, 0, 0, 0, 0, 0, 0, 4, 0, 0, 13, 2,
-		0, 0, 0, 0, 0, 0, 0, 141, 0, 0, 0, 0, 0, 0, 0, 30,
-		2, 0, 0, 0, 0, 0, 0, 50, 2, 0, 0, 0, 0, 0, 0, 138,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 46, 2, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 50, 2, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 9, 4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 14,
-		3, 0, 0, 0, 0, 0, 0, 1, 0, 0, 13, 0, 0, 0, 0, 0,
-		0, 0, 0, 189, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 16,
-		3, 0, 0, 0, 0, 0, 0, 1, 0, 0, 13, 2, 0, 0, 0, 0,
-		0, 0, 0, 19, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 18,
-		3, 0, 0, 0, 0, 0, 0, 2, 0, 0, 13, 0, 0, 0, 0, 0,
-		0, 0, 0, 19, 2, 0, 0, 0, 0, 0, 0, 135, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 20, 3, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 13, 2, 0, 0, 0, 0, 0, 0, 0, 19, 2, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 22,
-		3, 0, 0, 0, 0, 0, 0, 2, 0, 0, 13, 0, 0, 0, 0, 0,
-		0, 0, 0, 19, 2, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 24, 3, 0, 0, 0, 0, 0, 0, 1,
-		0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 0, 19, 2, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 26, 3, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 0, 189, 1, 0, 0, 0,
-		0, 0, 0, 10, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 28,
-		3, 0, 0, 0, 0, 0, 0, 1, 0, 0, 13, 2, 0, 0, 0, 0,
-		0, 0, 0, 189, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 30,
-		3, 0, 0, 0, 0, 0, 0, 2, 0, 0, 13, 0, 0, 0, 0, 0,
-		0, 0, 0, 187, 1, 0, 0, 0, 0, 0, 0, 52, 1, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 82, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 33, 3, 0, 0, 0, 0, 0, 0, 2, 0, 0, 13, 0,
-		0, 0, 0, 0, 0, 0, 0, 187, 1, 0, 0, 0, 0, 0, 0, 187,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 35, 3, 0, 0, 0,
-		0, 0, 0, 4, 0, 0, 13, 44, 0, 0, 0, 0, 0, 0, 0, 19,
-		2, 0, 0, 0, 0, 0, 0, 235, 2, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 0, 0, 0, 0, 0, 52, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 37, 3, 0, 0, 0, 0, 0, 0, 1, 0, 0, 13, 0,
-		0, 0, 0, 0, 0, 0, 0, 44, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 39, 3, 0, 0, 0, 0, 0, 0, 3, 0, 0, 13, 30,
-		0, 0, 0, 0, 0, 0, 0, 19, 2, 0, 0, 0, 0, 0, 0, 40,
-		3, 0, 0, 0, 0, 0, 0, 31, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 41, 3, 0, 0, 120, 71, 0, 0, 7, 0, 0, 4, 56,
-		0, 0, 0, 139, 71, 0, 0, 19, 2, 0, 0, 0, 0, 0, 0, 185,
-		30, 0, 0, 42, 3, 0, 0, 64, 0, 0, 0, 145, 71, 0, 0, 31,
-		0, 0, 0, 128, 0, 0, 0, 14, 29, 0, 0, 31, 0, 0, 0, 192,
-		0, 0, 0, 152, 71, 0, 0, 39, 0, 0, 0, 0, 1, 0, 0, 0,
-		0, 0, 0, 43, 3, 0, 0, 64, 1, 0, 0, 9, 43, 0, 0, 2,
-		0, 0, 0, 128, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 70,
-		3, 0, 0, 0, 0, 0, 0, 2, 0, 0, 5, 8, 0, 0, 0, 162,
-		71, 0, 0, 44, 0, 0, 0, 0, 0, 0, 0, 245, 31, 0, 0, 31,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 45,
-		3, 0, 0, 0, 0, 0, 0, 1, 0, 0, 13, 2, 0, 0, 0, 0,
-		0, 0, 0, 61, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 47,
-		3, 0, 0, 0, 0, 0, 0, 2, 0, 0, 13, 2, 0, 0, 0, 0,
-		0, 0, 0, 19, 2, 0, 0, 0, 0, 0, 0, 39, 0, 0, 0, 167,
-		71, 0, 0, 6, 0, 0, 6, 4, 0, 0, 0, 184, 71, 0, 0, 252,
-		255, 255, 255, 206, 71, 0, 0, 253, 255, 255, 255, 228, 71, 0, 0, 254,
-		255, 255, 255, 251, 71, 0, 0, 255, 255, 255, 255, 16, 72, 0, 0, 0,
-		0, 0, 0, 42, 72, 0, 0, 1, 0, 0, 0, 66, 72, 0, 0, 0,
-		0, 0, 8, 50, 3, 0, 0, 0, 0, 0, 0, 1, 0, 0, 4, 8,
-		0, 0, 0, 170, 42, 0, 0, 191, 1, 0, 0, 0, 0, 0, 0, 76,
-		72, 0, 0, 59, 0, 0, 132, 128, 0, 0, 0, 48, 0, 0, 0, 9,
-		0, 0, 0, 0, 0, 0, 0, 14, 29, 0, 0, 9, 0, 0, 0, 32,
-		0, 0, 0, 92, 72, 0, 0, 40, 0, 0, 0, 64, 0, 0, 0, 0,
-		0, 0, 0, 52, 3, 0, 0, 128, 0, 0, 0, 99, 72, 0, 0, 40,
-		0, 0, 0, 192, 0, 0, 0, 111, 72, 0, 0, 40, 0, 0, 0, 0,
-		1, 0, 0, 123, 72, 0, 0, 40, 0, 0, 0, 64, 1, 0, 1, 132,
-		72, 0, 0, 40, 0, 0, 0, 65, 1, 0, 1, 157, 29, 0, 0, 40,
-		0, 0, 0, 66, 1, 0, 1, 140, 72, 0, 0, 40, 0, 0, 0, 67,
-		1, 0, 1, 150, 72, 0, 0, 40, 0, 0, 0, 68, 1, 0, 1, 163,
-		72, 0, 0, 40, 0, 0, 0, 69, 1, 0, 1, 178, 72, 0, 0, 40,
-		0, 0, 0, 70, 1, 0, 1, 189, 72, 0, 0, 40, 0, 0, 0, 71,
-		1, 0, 1, 155, 24, 0, 0, 40, 0, 0, 0, 72, 1, 0, 1, 246,
-		7, 0, 0, 40, 0, 0, 0, 73, 1, 0, 1, 202, 72, 0, 0, 40,
-		0, 0, 0, 74, 1, 0, 1, 207, 72, 0, 0, 40, 0, 0, 0, 75,
-		1, 0, 1, 220, 72, 0, 0, 40, 0, 0, 0, 76, 1, 0, 1, 34,
-		42, 0, 0, 40, 0, 0, 0, 77, 1, 0, 1, 174, 45, 0, 0, 40,
-		0, 0, 0, 78, 1, 0, 1, 235, 72, 0, 0, 40, 0, 0, 0, 79,
-		1, 0, 2, 246, 72, 0, 0, 40, 0, 0, 0, 81, 1, 0, 1, 0,
-		73, 0, 0, 40, 0, 0, 0, 82, 1, 0, 1, 14, 73, 0, 0, 40,
-		0, 0, 0, 83, 1, 0, 1, 27, 73, 0, 0, 40, 0, 0, 0, 84,
-		1, 0, 1, 41, 73, 0, 0, 40, 0, 0, 0, 85, 1, 0, 1, 66,
-		73, 0, 0, 40, 0, 0, 0, 86, 1, 0, 1, 89, 73, 0, 0, 40,
-		0, 0, 0, 87, 1, 0, 1, 95, 73, 0, 0, 40, 0, 0, 0, 88,
-		1, 0, 1, 105, 73, 0, 0, 40, 0, 0, 0, 89, 1, 0, 1, 117,
-		73, 0, 0, 40, 0, 0, 0, 90, 1, 0, 1, 132, 73, 0, 0, 40,
-		0, 0, 0, 91, 1, 0, 1, 147, 73, 0, 0, 40, 0, 0, 0, 92,
-		1, 0, 1, 158, 73, 0, 0, 40, 0, 0, 0, 93, 1, 0, 1, 166,
-		73, 0, 0, 40, 0, 0, 0, 94, 1, 0, 1, 176, 73, 0, 0, 40,
-		0, 0, 0, 95, 1, 0, 1, 138, 20, 0, 0, 40, 0, 0, 0, 96,
-		1, 0, 1, 187, 73, 0, 0, 40, 0, 0, 0, 97, 1, 0, 1, 197,
-		73, 0, 0, 40, 0, 0, 0, 98, 1, 0, 1, 206, 73, 0, 0, 40,
-		0, 0, 0, 99, 1, 0, 1, 221, 73, 0, 0, 40, 0, 0, 0, 100,
-		1, 0, 1, 236, 73, 0, 0, 40, 0, 0, 0, 101, 1, 0, 1, 244,
-		73, 0, 0, 40, 0, 0, 0, 102, 1, 0, 26, 0, 0, 0, 0, 53,
-		3, 0, 0, 128, 1, 0, 0, 1, 74, 0, 0, 9, 0, 0, 0, 160,
-		1, 0, 0, 0, 0, 0, 0, 54, 3, 0, 0, 192, 1, 0, 0, 0,
-		0, 0, 0, 55, 3, 0, 0, 0, 2, 0, 0, 9, 74, 0, 0, 40,
-		0, 0, 0, 64, 2, 0, 0, 28, 74, 0, 0, 40, 0, 0, 0, 128,
-		2, 0, 0, 45, 74, 0, 0, 9, 0, 0, 0, 192, 2, 0, 0, 25,
-		31, 0, 0, 8, 1, 0, 0, 224, 2, 0, 0, 63, 74, 0, 0, 40,
-		0, 0, 0, 0, 3, 0, 0, 80, 74, 0, 0, 9, 0, 0, 0, 64,
-		3, 0, 0, 94, 74, 0, 0, 54, 0, 0, 0, 96, 3, 0, 0, 111,
-		74, 0, 0, 54, 0, 0, 0, 112, 3, 0, 0, 124, 74, 0, 0, 9,
-		0, 0, 0, 128, 3, 0, 0, 140, 74, 0, 0, 9, 0, 0, 0, 160,
-		3, 0, 0, 153, 74, 0, 0, 40, 0, 0, 0, 192, 3, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 5, 8, 0, 0, 0, 162, 74, 0, 0, 40,
-		0, 0, 0, 0, 0, 0, 0, 176, 74, 0, 0, 40, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 5, 4, 0, 0, 0, 188,
-		74, 0, 0, 9, 0, 0, 0, 0, 0, 0, 0, 202, 74, 0, 0, 9,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 4, 0, 0, 5, 8,
-		0, 0, 0, 219, 74, 0, 0, 40, 0, 0, 0, 0, 0, 0, 0, 227,
-		74, 0, 0, 40, 0, 0, 0, 0, 0, 0, 0, 239, 74, 0, 0, 40,
-		0, 0, 0, 0, 0, 0, 0, 251, 74, 0, 0, 40, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 4, 0, 0, 5, 8, 0, 0, 0, 3,
-		75, 0, 0, 40, 0, 0, 0, 0, 0, 0, 0, 10, 75, 0, 0, 40,
-		0, 0, 0, 0, 0, 0, 0, 22, 75, 0, 0, 40, 0, 0, 0, 0,
-		0, 0, 0, 35, 75, 0, 0, 40, 0, 0, 0, 0, 0, 0, 0, 43,
-		75, 0, 0, 12, 0, 0, 4, 192, 0, 0, 0, 0, 0, 0, 0, 57,
-		3, 0, 0, 0, 0, 0, 0, 57, 75, 0, 0, 55, 0, 0, 0, 0,
-		3, 0, 0, 87, 52, 0, 0, 44, 0, 0, 0, 64, 3, 0, 0, 119,
-		52, 0, 0, 31, 0, 0, 0, 128, 3, 0, 0, 199, 19, 0, 0, 2,
-		0, 0, 0, 192, 3, 0, 0, 64, 75, 0, 0, 49, 3, 0, 0, 0,
-		4, 0, 0, 162, 74, 0, 0, 39, 0, 0, 0, 64, 4, 0, 0, 0,
-		0, 0, 0, 67, 3, 0, 0, 128, 4, 0, 0, 75, 75, 0, 0, 39,
-		0, 0, 0, 0, 5, 0, 0, 90, 75, 0, 0, 39, 0, 0, 0, 64,
-		5, 0, 0, 101, 75, 0, 0, 39, 0, 0, 0, 128, 5, 0, 0, 117,
-		75, 0, 0, 39, 0, 0, 0, 192, 5, 0, 0, 0, 0, 0, 0, 6,
-		0, 0, 5, 96, 0, 0, 0, 0, 0, 0, 0, 58, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 60, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 61, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 62,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 63, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 66, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 10, 0, 0, 4, 96, 0, 0, 0, 92, 72, 0, 0, 39,
-		0, 0, 0, 0, 0, 0, 0, 134, 75, 0, 0, 39, 0, 0, 0, 64,
-		0, 0, 0, 143, 75, 0, 0, 31, 0, 0, 0, 128, 0, 0, 0, 155,
-		75, 0, 0, 31, 0, 0, 0, 192, 0, 0, 0, 166, 75, 0, 0, 2,
-		0, 0, 0, 0, 1, 0, 0, 183, 75, 0, 0, 2, 0, 0, 0, 32,
-		1, 0, 0, 187, 75, 0, 0, 2, 0, 0, 0, 64, 1, 0, 0, 186,
-		0, 0, 0, 2, 0, 0, 0, 96, 1, 0, 0, 196, 75, 0, 0, 59,
-		3, 0, 0, 128, 1, 0, 0, 206, 75, 0, 0, 59, 3, 0, 0, 64,
-		2, 0, 0, 217, 75, 0, 0, 4, 0, 0, 4, 24, 0, 0, 0, 92,
-		72, 0, 0, 39, 0, 0, 0, 0, 0, 0, 0, 237, 75, 0, 0, 10,
-		0, 0, 0, 64, 0, 0, 0, 241, 75, 0, 0, 2, 0, 0, 0, 96,
-		0, 0, 0, 183, 75, 0, 0, 2, 0, 0, 0, 128, 0, 0, 0, 0,
-		0, 0, 0, 1, 0, 0, 4, 64, 0, 0, 0, 159, 19, 0, 0, 85,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 4, 16,
-		0, 0, 0, 247, 75, 0, 0, 60, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 255, 75, 0, 0, 39,
-		0, 0, 0, 0, 0, 0, 0, 7, 76, 0, 0, 39, 0, 0, 0, 64,
-		0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 4, 40, 0, 0, 0, 27,
-		37, 0, 0, 64, 3, 0, 0, 0, 0, 0, 0, 12, 76, 0, 0, 60,
-		0, 0, 0, 192, 0, 0, 0, 20, 76, 0, 0, 3, 0, 0, 4, 24,
-		0, 0, 0, 39, 76, 0, 0, 39, 0, 0, 0, 0, 0, 0, 0, 47,
-		76, 0, 0, 39, 0, 0, 0, 64, 0, 0, 0, 55, 76, 0, 0, 65,
-		3, 0, 0, 128, 0, 0, 0, 60, 76, 0, 0, 5, 0, 0, 132, 4,
-		0, 0, 0, 218, 49, 0, 0, 8, 0, 0, 0, 0, 0, 0, 19, 84,
-		76, 0, 0, 8, 0, 0, 0, 19, 0, 0, 8, 48, 0, 0, 0, 8,
-		0, 0, 0, 27, 0, 0, 2, 88, 76, 0, 0, 8, 0, 0, 0, 29,
-		0, 0, 2, 98, 76, 0, 0, 8, 0, 0, 0, 31, 0, 0, 1, 0,
-		0, 0, 0, 5, 0, 0, 4, 24, 0, 0, 0, 106, 76, 0, 0, 20,
-		0, 0, 0, 0, 0, 0, 0, 117, 76, 0, 0, 20, 0, 0, 0, 8,
-		0, 0, 0, 128, 76, 0, 0, 53, 0, 0, 0, 16, 0, 0, 0, 136,
-		76, 0, 0, 39, 0, 0, 0, 64, 0, 0, 0, 141, 76, 0, 0, 39,
-		0, 0, 0, 128, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 5, 16,
-		0, 0, 0, 0, 0, 0, 0, 68, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 69, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 4, 16, 0, 0, 0, 147, 76, 0, 0, 39, 0, 0, 0, 0,
-		0, 0, 0, 159, 76, 0, 0, 49, 3, 0, 0, 64, 0, 0, 0, 0,
-		0, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 171, 76, 0, 0, 39,
-		0, 0, 0, 0, 0, 0, 0, 184, 76, 0, 0, 39, 0, 0, 0, 64,
-		0, 0, 0, 196, 76, 0, 0, 33, 0, 0, 4, 240, 0, 0, 0, 25,
-		42, 0, 0, 45, 0, 0, 0, 0, 0, 0, 0, 72, 21, 0, 0, 111,
-		0, 0, 0, 64, 0, 0, 0, 208, 76, 0, 0, 2, 0, 0, 0, 192,
-		0, 0, 0, 217, 76, 0, 0, 2, 0, 0, 0, 224, 0, 0, 0, 227,
-		76, 0, 0, 2, 0, 0, 0, 0, 1, 0, 0, 251, 30, 0, 0, 47,
-		0, 0, 0, 32, 1, 0, 0, 245, 31, 0, 0, 191, 1, 0, 0, 64,
-		1, 0, 0, 234, 76, 0, 0, 10, 0, 0, 0, 128, 1, 0, 0, 85,
-		0, 0, 0, 191, 1, 0, 0, 192, 1, 0, 0, 145, 71, 0, 0, 191,
-		1, 0, 0, 0, 2, 0, 0, 239, 76, 0, 0, 191, 1, 0, 0, 64,
-		2, 0, 0, 174, 45, 0, 0, 30, 0, 0, 0, 128, 2, 0, 0, 80,
-		74, 0, 0, 30, 0, 0, 0, 192, 2, 0, 0, 244, 76, 0, 0, 150,
-		0, 0, 0, 0, 3, 0, 0, 154, 41, 0, 0, 60, 0, 0, 0, 64,
-		3, 0, 0, 169, 51, 0, 0, 47, 0, 0, 0, 192, 3, 0, 0, 255,
-		76, 0, 0, 31, 0, 0, 0, 0, 4, 0, 0, 11, 77, 0, 0, 107,
-		1, 0, 0, 64, 4, 0, 0, 21, 77, 0, 0, 30, 0, 0, 0, 128,
-		4, 0, 0, 30, 77, 0, 0, 10, 0, 0, 0, 192, 4, 0, 0, 39,
-		77, 0, 0, 30, 0, 0, 0, 0, 5, 0, 0, 50, 77, 0, 0, 31,
-		0, 0, 0, 64, 5, 0, 0, 60, 77, 0, 0, 2, 0, 0, 0, 128,
-		5, 0, 0, 73, 77, 0, 0, 2, 0, 0, 0, 160, 5, 0, 0, 87,
-		77, 0, 0, 47, 0, 0, 0, 192, 5, 0, 0, 102, 77, 0, 0, 31,
-		0, 0, 0, 0, 6, 0, 0, 77, 54, 0, 0, 36, 3, 0, 0, 64,
-		6, 0, 0, 118, 77, 0, 0, 45, 0, 0, 0, 128, 6, 0, 0, 131,
-		77, 0, 0, 2, 0, 0, 0, 160, 6, 0, 0, 147, 77, 0, 0, 235,
-		2, 0, 0, 192, 6, 0, 0, 157, 77, 0, 0, 44, 0, 0, 0, 0,
-		7, 0, 0, 166, 77, 0, 0, 71, 3, 0, 0, 64, 7, 0, 0, 176,
-		77, 0, 0, 72, 3, 0, 0, 128, 7, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 11, 4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
-		0, 0, 0, 44, 0, 0, 0, 4, 0, 0, 0, 0, 0, 0, 0, 187,
-		77, 0, 0, 3, 0, 0, 4, 32, 0, 0, 0, 167, 19, 0, 0, 49,
-		0, 0, 0, 0, 0, 0, 0, 13, 21, 0, 0, 74, 3, 0, 0, 128,
-		0, 0, 0, 196, 77, 0, 0, 77, 3, 0, 0, 192, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 75, 3, 0, 0, 0, 0, 0, 0, 1,
-		0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 0, 76, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 73, 3, 0, 0, 204, 77, 0, 0, 1,
-		0, 0, 4, 8, 0, 0, 0, 34, 42, 0, 0, 55, 0, 0, 0, 0,
-		0, 0, 0, 212, 77, 0, 0, 3, 0, 0, 4, 24, 0, 0, 0, 80,
-		20, 0, 0, 60, 0, 0, 0, 0, 0, 0, 0, 63, 18, 0, 0, 70,
-		0, 0, 0, 128, 0, 0, 0, 235, 77, 0, 0, 10, 0, 0, 0, 160,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 12, 4, 0, 0, 251,
-		77, 0, 0, 12, 0, 0, 4, 136, 0, 0, 0, 9, 78, 0, 0, 81,
-		3, 0, 0, 0, 0, 0, 0, 76, 20, 0, 0, 111, 0, 0, 0, 192,
-		0, 0, 0, 13, 78, 0, 0, 10, 0, 0, 0, 64, 1, 0, 0, 27,
-		78, 0, 0, 55, 0, 0, 0, 128, 1, 0, 0, 40, 78, 0, 0, 31,
-		3, 0, 0, 192, 1, 0, 0, 179, 31, 0, 0, 10, 0, 0, 0, 0,
-		2, 0, 0, 150, 6, 0, 0, 236, 0, 0, 0, 64, 2, 0, 0, 51,
-		78, 0, 0, 91, 3, 0, 0, 128, 2, 0, 0, 108, 26, 0, 0, 192,
-		0, 0, 0, 192, 2, 0, 0, 127, 33, 0, 0, 141, 1, 0, 0, 0,
-		3, 0, 0, 57, 78, 0, 0, 2, 0, 0, 0, 64, 3, 0, 0, 255,
-		31, 0, 0, 115, 1, 0, 0, 128, 3, 0, 0, 9, 78, 0, 0, 3,
-		0, 0, 4, 24, 0, 0, 0, 64, 78, 0, 0, 3, 3, 0, 0, 0,
-		0, 0, 0, 71, 78, 0, 0, 10, 0, 0, 0, 128, 0, 0, 0, 80,
-		78, 0, 0, 10, 0, 0, 0, 160, 0, 0, 0, 89, 78, 0, 0, 26,
-		0, 0, 4, 216, 16, 0, 0, 100, 78, 0, 0, 83, 3, 0, 0, 0,
-		0, 0, 0, 186, 0, 0, 0, 84, 3, 0, 0, 64, 0, 0, 0, 109,
-		78, 0, 0, 31, 0, 0, 0, 128, 0, 0, 0, 14, 29, 0, 0, 10,
-		0, 0, 0, 192, 0, 0, 0, 121, 78, 0, 0, 10, 0, 0, 0, 224,
-		0, 0, 0, 133, 78, 0, 0, 85, 3, 0, 0, 0, 1, 0, 0, 14,
-		43, 0, 0, 10, 0, 0, 0, 64, 1, 0, 0, 149, 78, 0, 0, 10,
-		0, 0, 0, 96, 1, 0, 0, 161, 78, 0, 0, 10, 0, 0, 0, 128,
-		1, 0, 0, 179, 78, 0, 0, 86, 3, 0, 0, 160, 1, 0, 0, 93,
-		45, 0, 0, 86, 3, 0, 0, 192, 1, 0, 0, 182, 78, 0, 0, 212,
-		1, 0, 0, 224, 1, 0, 0, 25, 42, 0, 0, 2, 0, 0, 0, 0,
-		2, 0, 0, 193, 78, 0, 0, 36, 3, 0, 0, 64, 2, 0, 0, 198,
-		78, 0, 0, 10, 0, 0, 0, 128, 2, 0, 0, 204, 78, 0, 0, 10,
-		0, 0, 0, 160, 2, 0, 0, 210, 78, 0, 0, 10, 0, 0, 0, 192,
-		2, 0, 0, 225, 34, 0, 0, 24, 1, 0, 0, 0, 3, 0, 0, 80,
-		20, 0, 0, 60, 0, 0, 0, 64, 3, 0, 0, 35, 64, 0, 0, 31,
-		2, 0, 0, 192, 3, 0, 0, 223, 78, 0, 0, 31, 0, 0, 0, 192,
-		5, 0, 0, 230, 78, 0, 0, 10, 0, 0, 0, 0, 6, 0, 0, 255,
-		78, 0, 0, 87, 3, 0, 0, 64, 6, 0, 0, 10, 79, 0, 0, 10,
-		0, 0, 0, 128, 6, 0, 0, 21, 79, 0, 0, 10, 0, 0, 0, 160,
-		6, 0, 0, 167, 19, 0, 0, 90, 3, 0, 0, 192, 6, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 205, 3, 0, 0, 30, 79, 0, 0, 0,
-		0, 0, 8, 10, 0, 0, 0, 43, 79, 0, 0, 3, 0, 0, 4, 8,
-		0, 0, 0, 60, 79, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 62,
-		79, 0, 0, 20, 0, 0, 0, 32, 0, 0, 0, 66, 79, 0, 0, 20,
-		0, 0, 0, 40, 0, 0, 0, 70, 79, 0, 0, 1, 0, 0, 4, 4,
-		0, 0, 0, 191, 34, 0, 0, 10, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 10, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 89, 3, 0, 0, 95, 79, 0, 0, 6, 0, 0, 4, 64,
-		0, 0, 0, 111, 79, 0, 0, 150, 0, 0, 0, 0, 0, 0, 0, 121,
-		79, 0, 0, 31, 0, 0, 0, 64, 0, 0, 0, 132, 79, 0, 0, 60,
-		0, 0, 0, 128, 0, 0, 0, 140, 79, 0, 0, 103, 0, 0, 0, 0,
-		1, 0, 0, 149, 79, 0, 0, 103, 0, 0, 0, 64, 1, 0, 0, 163,
-		79, 0, 0, 60, 0, 0, 0, 128, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 3, 0, 0, 0, 0, 88, 3, 0, 0, 4, 0, 0, 0, 0,
-		2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 206, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 93, 3, 0, 0, 0, 0, 0, 0, 1,
-		0, 0, 13, 39, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 168,
-		79, 0, 0, 0, 0, 0, 8, 95, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 96, 3, 0, 0, 0, 0, 0, 0, 3, 0, 0, 13, 0,
-		0, 0, 0, 0, 0, 0, 0, 19, 2, 0, 0, 0, 0, 0, 0, 97,
-		3, 0, 0, 0, 0, 0, 0, 109, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 98, 3, 0, 0, 192, 79, 0, 0, 23, 0, 0, 4, 0,
-		1, 0, 0, 162, 71, 0, 0, 39, 0, 0, 0, 0, 0, 0, 0, 209,
-		79, 0, 0, 99, 3, 0, 0, 64, 0, 0, 0, 213, 79, 0, 0, 100,
-		3, 0, 0, 128, 0, 0, 0, 86, 21, 0, 0, 39, 0, 0, 0, 192,
-		0, 0, 0, 149, 15, 0, 0, 101, 3, 0, 0, 0, 1, 0, 0, 222,
-		79, 0, 0, 39, 0, 0, 0, 64, 1, 0, 0, 226, 79, 0, 0, 103,
-		3, 0, 0, 128, 1, 0, 0, 48, 0, 0, 0, 39, 0, 0, 0, 192,
-		1, 0, 0, 235, 79, 0, 0, 39, 0, 0, 0, 0, 2, 0, 0, 238,
-		79, 0, 0, 105, 3, 0, 0, 64, 2, 0, 0, 13, 31, 0, 0, 39,
-		0, 0, 0, 128, 2, 0, 0, 159, 0, 0, 0, 39, 0, 0, 0, 192,
-		2, 0, 0, 248, 79, 0, 0, 39, 0, 0, 0, 0, 3, 0, 0, 2,
-		80, 0, 0, 106, 3, 0, 0, 64, 3, 0, 0, 12, 80, 0, 0, 107,
-		3, 0, 0, 128, 3, 0, 0, 22, 80, 0, 0, 39, 0, 0, 0, 192,
-		3, 0, 0, 31, 80, 0, 0, 108, 3, 0, 0, 0, 4, 0, 0, 41,
-		80, 0, 0, 108, 3, 0, 0, 128, 4, 0, 0, 51, 80, 0, 0, 39,
-		0, 0, 0, 0, 5, 0, 0, 67, 80, 0, 0, 39, 0, 0, 0, 64,
-		5, 0, 0, 138, 20, 0, 0, 39, 0, 0, 0, 128, 5, 0, 0, 77,
-		80, 0, 0, 39, 0, 0, 0, 192, 5, 0, 0, 92, 80, 0, 0, 39,
-		0, 0, 0, 0, 6, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 13,
-		4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 14, 4, 0, 0, 107,
-		80, 0, 0, 2, 0, 0, 5, 8, 0, 0, 0, 163, 79, 0, 0, 40,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 102, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 4, 8, 0, 0, 0, 126,
-		80, 0, 0, 9, 0, 0, 0, 0, 0, 0, 0, 134, 80, 0, 0, 54,
-		0, 0, 0, 32, 0, 0, 0, 141, 80, 0, 0, 54, 0, 0, 0, 48,
-		0, 0, 0, 148, 80, 0, 0, 2, 0, 0, 5, 8, 0, 0, 0, 134,
-		18, 0, 0, 40, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 104,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 11, 0, 0, 132, 8,
-		0, 0, 0, 166, 80, 0, 0, 40, 0, 0, 0, 0, 0, 0, 5, 173,
-		80, 0, 0, 40, 0, 0, 0, 5, 0, 0, 14, 181, 80, 0, 0, 40,
-		0, 0, 0, 19, 0, 0, 5, 191, 80, 0, 0, 40, 0, 0, 0, 24,
-		0, 0, 2, 200, 80, 0, 0, 40, 0, 0, 0, 26, 0, 0, 7, 209,
-		80, 0, 0, 40, 0, 0, 0, 33, 0, 0, 4, 221, 80, 0, 0, 40,
-		0, 0, 0, 37, 0, 0, 1, 232, 80, 0, 0, 40, 0, 0, 0, 38,
-		0, 0, 2, 243, 80, 0, 0, 40, 0, 0, 0, 40, 0, 0, 3, 251,
-		80, 0, 0, 40, 0, 0, 0, 43, 0, 0, 3, 4, 81, 0, 0, 40,
-		0, 0, 0, 46, 0, 0, 18, 0, 0, 0, 0, 2, 0, 0, 4, 8,
-		0, 0, 0, 206, 0, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 13,
-		81, 0, 0, 8, 0, 0, 0, 32, 0, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 4, 8, 0, 0, 0, 60, 14, 0, 0, 8, 0, 0, 0, 0,
-		0, 0, 0, 17, 81, 0, 0, 8, 0, 0, 0, 32, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 15, 4, 0, 0, 26, 81, 0, 0, 2,
-		0, 0, 4, 16, 0, 0, 0, 36, 81, 0, 0, 40, 0, 0, 0, 0,
-		0, 0, 0, 40, 81, 0, 0, 109, 3, 0, 0, 64, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 110, 3, 0, 0, 45, 81, 0, 0, 9,
-		0, 0, 4, 80, 1, 0, 0, 0, 0, 0, 0, 111, 3, 0, 0, 0,
-		0, 0, 0, 53, 81, 0, 0, 39, 0, 0, 0, 128, 8, 0, 0, 61,
-		81, 0, 0, 7, 1, 0, 0, 192, 8, 0, 0, 71, 81, 0, 0, 8,
-		0, 0, 0, 224, 8, 0, 0, 79, 81, 0, 0, 39, 0, 0, 0, 0,
-		9, 0, 0, 90, 81, 0, 0, 39, 0, 0, 0, 64, 9, 0, 0, 99,
-		81, 0, 0, 116, 3, 0, 0, 128, 9, 0, 0, 110, 81, 0, 0, 39,
-		0, 0, 0, 0, 10, 0, 0, 127, 81, 0, 0, 39, 0, 0, 0, 64,
-		10, 0, 0, 0, 0, 0, 0, 2, 0, 0, 5, 16, 1, 0, 0, 136,
-		81, 0, 0, 112, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 114,
-		3, 0, 0, 0, 0, 0, 0, 146, 81, 0, 0, 4, 0, 0, 4, 16,
-		1, 0, 0, 40, 81, 0, 0, 113, 3, 0, 0, 0, 0, 0, 0, 146,
-		49, 0, 0, 40, 0, 0, 0, 192, 7, 0, 0, 149, 49, 0, 0, 40,
-		0, 0, 0, 0, 8, 0, 0, 159, 81, 0, 0, 40, 0, 0, 0, 64,
-		8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 40,
+		0, 0, 0, 184, 28, 0, 0, 5, 0, 0, 4, 16, 1, 0, 0, 195,
+		28, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 210, 28, 0, 0, 2,
+		0, 0, 0, 32, 0, 0, 0, 223, 28, 0, 0, 2, 0, 0, 0, 64,
+		0, 0, 0, 236, 28, 0, 0, 99, 1, 0, 0, 128, 0, 0, 0, 246,
+		28, 0, 0, 99, 1, 0, 0, 128, 4, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 17, 1, 0, 0, 0, 29, 0, 0, 75, 0, 0, 4, 208,
+		4, 0, 0, 11, 29, 0, 0, 65, 0, 0, 0, 0, 0, 0, 0, 23,
+		29, 0, 0, 65, 0, 0, 0, 128, 0, 0, 0, 36, 29, 0, 0, 65,
+		0, 0, 0, 0, 1, 0, 0, 8, 15, 0, 0, 63, 0, 0, 0, 128,
+		1, 0, 0, 48, 29, 0, 0, 29, 0, 0, 0, 64, 2, 0, 0, 60,
+		29, 0, 0, 65, 0, 0, 0, 128, 2, 0, 0, 74, 29, 0, 0, 144,
+		0, 0, 0, 0, 3, 0, 0, 86, 29, 0, 0, 65, 0, 0, 0, 128,
+		3, 0, 0, 99, 29, 0, 0, 2, 0, 0, 0, 0, 4, 0, 0, 111,
+		29, 0, 0, 2, 0, 0, 0, 32, 4, 0, 0, 122, 29, 0, 0, 2,
+		0, 0, 0, 64, 4, 0, 0, 196, 6, 0, 0, 16, 1, 0, 0, 128,
+		4, 0, 0, 201, 25, 0, 0, 229, 0, 0, 0, 192, 4, 0, 0, 133,
+		29, 0, 0, 49, 0, 0, 0, 0, 5, 0, 0, 131, 17, 0, 0, 18,
+		1, 0, 0, 64, 5, 0, 0, 145, 29, 0, 0, 10, 0, 0, 0, 96,
+		5, 0, 0, 94, 14, 0, 0, 19, 1, 0, 0, 128, 5, 0, 0, 158,
+		29, 0, 0, 118, 0, 0, 0, 192, 5, 0, 0, 170, 29, 0, 0, 29,
+		0, 0, 0, 0, 6, 0, 0, 189, 29, 0, 0, 29, 0, 0, 0, 64,
+		6, 0, 0, 208, 29, 0, 0, 29, 0, 0, 0, 128, 6, 0, 0, 215,
+		29, 0, 0, 21, 1, 0, 0, 192, 6, 0, 0, 220, 29, 0, 0, 58,
+		0, 0, 0, 192, 10, 0, 0, 232, 29, 0, 0, 58, 0, 0, 0, 208,
+		10, 0, 0, 247, 29, 0, 0, 58, 0, 0, 0, 224, 10, 0, 0, 1,
+		30, 0, 0, 27, 1, 0, 0, 0, 11, 0, 0, 161, 21, 0, 0, 227,
+		0, 0, 0, 0, 17, 0, 0, 122, 26, 0, 0, 117, 0, 0, 0, 64,
+		17, 0, 0, 4, 30, 0, 0, 118, 0, 0, 0, 128, 17, 0, 0, 29,
+		30, 0, 0, 118, 0, 0, 0, 192, 17, 0, 0, 54, 30, 0, 0, 225,
+		0, 0, 0, 0, 18, 0, 0, 66, 30, 0, 0, 65, 0, 0, 0, 0,
+		19, 0, 0, 172, 6, 0, 0, 16, 1, 0, 0, 128, 19, 0, 0, 77,
+		30, 0, 0, 2, 0, 0, 0, 192, 19, 0, 0, 82, 14, 0, 0, 2,
+		0, 0, 0, 224, 19, 0, 0, 83, 30, 0, 0, 65, 0, 0, 0, 0,
+		20, 0, 0, 130, 25, 0, 0, 60, 0, 0, 0, 128, 20, 0, 0, 95,
+		30, 0, 0, 225, 0, 0, 0, 192, 20, 0, 0, 106, 30, 0, 0, 52,
+		0, 0, 0, 192, 21, 0, 0, 117, 21, 0, 0, 41, 1, 0, 0, 0,
+		22, 0, 0, 117, 30, 0, 0, 65, 0, 0, 0, 64, 22, 0, 0, 126,
+		30, 0, 0, 39, 0, 0, 0, 192, 22, 0, 0, 138, 30, 0, 0, 2,
+		0, 0, 0, 0, 23, 0, 0, 150, 30, 0, 0, 42, 1, 0, 0, 64,
+		23, 0, 0, 156, 30, 0, 0, 44, 1, 0, 0, 0, 24, 0, 0, 163,
+		30, 0, 0, 10, 0, 0, 0, 64, 24, 0, 0, 178, 30, 0, 0, 10,
+		0, 0, 0, 96, 24, 0, 0, 191, 30, 0, 0, 10, 0, 0, 0, 128,
+		24, 0, 0, 207, 30, 0, 0, 10, 0, 0, 0, 160, 24, 0, 0, 223,
+		30, 0, 0, 39, 0, 0, 0, 192, 24, 0, 0, 236, 30, 0, 0, 45,
+		1, 0, 0, 0, 25, 0, 0, 248, 30, 0, 0, 168, 0, 0, 0, 0,
+		26, 0, 0, 5, 31, 0, 0, 10, 0, 0, 0, 128, 26, 0, 0, 18,
+		31, 0, 0, 52, 0, 0, 0, 160, 26, 0, 0, 30, 31, 0, 0, 50,
+		1, 0, 0, 192, 26, 0, 0, 43, 31, 0, 0, 51, 1, 0, 0, 128,
+		27, 0, 0, 62, 31, 0, 0, 39, 0, 0, 0, 192, 27, 0, 0, 79,
+		31, 0, 0, 16, 1, 0, 0, 0, 28, 0, 0, 89, 31, 0, 0, 52,
+		1, 0, 0, 64, 28, 0, 0, 241, 23, 0, 0, 168, 0, 0, 0, 128,
+		28, 0, 0, 97, 31, 0, 0, 54, 1, 0, 0, 0, 29, 0, 0, 202,
+		0, 0, 0, 29, 0, 0, 0, 64, 29, 0, 0, 100, 31, 0, 0, 118,
+		0, 0, 0, 128, 29, 0, 0, 113, 31, 0, 0, 55, 1, 0, 0, 192,
+		29, 0, 0, 119, 31, 0, 0, 57, 1, 0, 0, 0, 30, 0, 0, 136,
+		31, 0, 0, 49, 0, 0, 0, 64, 30, 0, 0, 161, 31, 0, 0, 57,
+		1, 0, 0, 128, 30, 0, 0, 183, 31, 0, 0, 73, 1, 0, 0, 192,
+		30, 0, 0, 188, 31, 0, 0, 29, 0, 0, 0, 0, 31, 0, 0, 199,
+		31, 0, 0, 74, 1, 0, 0, 64, 31, 0, 0, 159, 24, 0, 0, 75,
+		1, 0, 0, 128, 31, 0, 0, 208, 31, 0, 0, 76, 1, 0, 0, 192,
+		31, 0, 0, 219, 31, 0, 0, 98, 1, 0, 0, 128, 37, 0, 0, 20,
+		14, 0, 0, 49, 0, 0, 0, 192, 37, 0, 0, 224, 31, 0, 0, 65,
+		0, 0, 0, 0, 38, 0, 0, 232, 31, 0, 0, 6, 0, 0, 6, 4,
+		0, 0, 0, 249, 31, 0, 0, 252, 255, 255, 255, 15, 32, 0, 0, 253,
+		255, 255, 255, 37, 32, 0, 0, 254, 255, 255, 255, 60, 32, 0, 0, 255,
+		255, 255, 255, 81, 32, 0, 0, 0, 0, 0, 0, 107, 32, 0, 0, 1,
+		0, 0, 0, 131, 32, 0, 0, 0, 0, 0, 8, 20, 1, 0, 0, 0,
+		0, 0, 0, 1, 0, 0, 4, 8, 0, 0, 0, 17, 27, 0, 0, 231,
+		0, 0, 0, 0, 0, 0, 0, 141, 32, 0, 0, 59, 0, 0, 132, 128,
+		0, 0, 0, 48, 0, 0, 0, 9, 0, 0, 0, 0, 0, 0, 0, 82,
+		27, 0, 0, 9, 0, 0, 0, 32, 0, 0, 0, 157, 32, 0, 0, 30,
+		0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 22, 1, 0, 0, 128,
+		0, 0, 0, 164, 32, 0, 0, 30, 0, 0, 0, 192, 0, 0, 0, 176,
+		32, 0, 0, 30, 0, 0, 0, 0, 1, 0, 0, 188, 32, 0, 0, 30,
+		0, 0, 0, 64, 1, 0, 1, 197, 32, 0, 0, 30, 0, 0, 0, 65,
+		1, 0, 1, 205, 32, 0, 0, 30, 0, 0, 0, 66, 1, 0, 1, 212,
+		32, 0, 0, 30, 0, 0, 0, 67, 1, 0, 1, 222, 32, 0, 0, 30,
+		0, 0, 0, 68, 1, 0, 1, 235, 32, 0, 0, 30, 0, 0, 0, 69,
+		1, 0, 1, 250, 32, 0, 0, 30, 0, 0, 0, 70, 1, 0, 1, 5,
+		33, 0, 0, 30, 0, 0, 0, 71, 1, 0, 1, 18, 33, 0, 0, 30,
+		0, 0, 0, 72, 1, 0, 1, 12, 8, 0, 0, 30, 0, 0, 0, 73,
+		1, 0, 1, 23, 33, 0, 0, 30, 0, 0, 0, 74, 1, 0, 1, 28,
+		33, 0, 0, 30, 0, 0, 0, 75, 1, 0, 1, 41, 33, 0, 0, 30,
+		0, 0, 0, 76, 1, 0, 1, 131, 26, 0, 0, 30, 0, 0, 0, 77,
+		1, 0, 1, 56, 33, 0, 0, 30, 0, 0, 0, 78, 1, 0, 1, 66,
+		33, 0, 0, 30, 0, 0, 0, 79, 1, 0, 2, 77, 33, 0, 0, 30,
+		0, 0, 0, 81, 1, 0, 1, 87, 33, 0, 0, 30, 0, 0, 0, 82,
+		1, 0, 1, 101, 33, 0, 0, 30, 0, 0, 0, 83, 1, 0, 1, 114,
+		33, 0, 0, 30, 0, 0, 0, 84, 1, 0, 1, 128, 33, 0, 0, 30,
+		0, 0, 0, 85, 1, 0, 1, 153, 33, 0, 0, 30, 0, 0, 0, 86,
+		1, 0, 1, 176, 33, 0, 0, 30, 0, 0, 0, 87, 1, 0, 1, 182,
+		33, 0, 0, 30, 0, 0, 0, 88, 1, 0, 1, 192, 33, 0, 0, 30,
+		0, 0, 0, 89, 1, 0, 1, 204, 33, 0, 0, 30, 0, 0, 0, 90,
+		1, 0, 1, 219, 33, 0, 0, 30, 0, 0, 0, 91, 1, 0, 1, 234,
+		33, 0, 0, 30, 0, 0, 0, 92, 1, 0, 1, 245, 33, 0, 0, 30,
+		0, 0, 0, 93, 1, 0, 1, 253, 33, 0, 0, 30, 0, 0, 0, 94,
+		1, 0, 1, 7, 34, 0, 0, 30, 0, 0, 0, 95, 1, 0, 1, 18,
+		34, 0, 0, 30, 0, 0, 0, 96, 1, 0, 1, 25, 34, 0, 0, 30,
+		0, 0, 0, 97, 1, 0, 1, 35, 34, 0, 0, 30, 0, 0, 0, 98,
+		1, 0, 1, 44, 34, 0, 0, 30, 0, 0, 0, 99, 1, 0, 1, 59,
+		34, 0, 0, 30, 0, 0, 0, 100, 1, 0, 1, 74, 34, 0, 0, 30,
+		0, 0, 0, 101, 1, 0, 1, 82, 34, 0, 0, 30, 0, 0, 0, 102,
+		1, 0, 26, 0, 0, 0, 0, 23, 1, 0, 0, 128, 1, 0, 0, 95,
+		34, 0, 0, 9, 0, 0, 0, 160, 1, 0, 0, 0, 0, 0, 0, 24,
+		1, 0, 0, 192, 1, 0, 0, 0, 0, 0, 0, 25, 1, 0, 0, 0,
+		2, 0, 0, 103, 34, 0, 0, 30, 0, 0, 0, 64, 2, 0, 0, 122,
+		34, 0, 0, 30, 0, 0, 0, 128, 2, 0, 0, 139, 34, 0, 0, 9,
+		0, 0, 0, 192, 2, 0, 0, 242, 21, 0, 0, 26, 1, 0, 0, 224,
+		2, 0, 0, 157, 34, 0, 0, 30, 0, 0, 0, 0, 3, 0, 0, 174,
+		34, 0, 0, 9, 0, 0, 0, 64, 3, 0, 0, 188, 34, 0, 0, 59,
+		0, 0, 0, 96, 3, 0, 0, 205, 34, 0, 0, 59, 0, 0, 0, 112,
+		3, 0, 0, 218, 34, 0, 0, 9, 0, 0, 0, 128, 3, 0, 0, 234,
+		34, 0, 0, 9, 0, 0, 0, 160, 3, 0, 0, 247, 34, 0, 0, 30,
+		0, 0, 0, 192, 3, 0, 0, 0, 0, 0, 0, 2, 0, 0, 5, 8,
+		0, 0, 0, 0, 35, 0, 0, 30, 0, 0, 0, 0, 0, 0, 0, 14,
+		35, 0, 0, 30, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2,
+		0, 0, 5, 4, 0, 0, 0, 26, 35, 0, 0, 9, 0, 0, 0, 0,
+		0, 0, 0, 40, 35, 0, 0, 9, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 4, 0, 0, 5, 8, 0, 0, 0, 57, 35, 0, 0, 30,
+		0, 0, 0, 0, 0, 0, 0, 65, 35, 0, 0, 30, 0, 0, 0, 0,
+		0, 0, 0, 77, 35, 0, 0, 30, 0, 0, 0, 0, 0, 0, 0, 89,
+		35, 0, 0, 30, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 5, 8, 0, 0, 0, 97, 35, 0, 0, 30, 0, 0, 0, 0,
+		0, 0, 0, 104, 35, 0, 0, 30, 0, 0, 0, 0, 0, 0, 0, 116,
+		35, 0, 0, 30, 0, 0, 0, 0, 0, 0, 0, 129, 35, 0, 0, 30,
+		0, 0, 0, 0, 0, 0, 0, 137, 35, 0, 0, 0, 0, 0, 8, 2,
+		0, 0, 0, 143, 35, 0, 0, 12, 0, 0, 4, 192, 0, 0, 0, 0,
+		0, 0, 0, 28, 1, 0, 0, 0, 0, 0, 0, 157, 35, 0, 0, 60,
+		0, 0, 0, 0, 3, 0, 0, 30, 31, 0, 0, 49, 0, 0, 0, 64,
+		3, 0, 0, 62, 31, 0, 0, 39, 0, 0, 0, 128, 3, 0, 0, 131,
+		17, 0, 0, 2, 0, 0, 0, 192, 3, 0, 0, 164, 35, 0, 0, 19,
+		1, 0, 0, 0, 4, 0, 0, 0, 35, 0, 0, 29, 0, 0, 0, 64,
+		4, 0, 0, 0, 0, 0, 0, 38, 1, 0, 0, 128, 4, 0, 0, 175,
+		35, 0, 0, 29, 0, 0, 0, 0, 5, 0, 0, 190, 35, 0, 0, 29,
+		0, 0, 0, 64, 5, 0, 0, 201, 35, 0, 0, 29, 0, 0, 0, 128,
+		5, 0, 0, 217, 35, 0, 0, 29, 0, 0, 0, 192, 5, 0, 0, 0,
+		0, 0, 0, 6, 0, 0, 5, 96, 0, 0, 0, 0, 0, 0, 0, 29,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 31, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 32, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 33, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 34,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 37, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 10, 0, 0, 4, 96, 0, 0, 0, 157,
+		32, 0, 0, 29, 0, 0, 0, 0, 0, 0, 0, 234, 35, 0, 0, 29,
+		0, 0, 0, 64, 0, 0, 0, 243, 35, 0, 0, 39, 0, 0, 0, 128,
+		0, 0, 0, 255, 35, 0, 0, 39, 0, 0, 0, 192, 0, 0, 0, 10,
+		36, 0, 0, 2, 0, 0, 0, 0, 1, 0, 0, 27, 36, 0, 0, 2,
+		0, 0, 0, 32, 1, 0, 0, 31, 36, 0, 0, 2, 0, 0, 0, 64,
+		1, 0, 0, 229, 0, 0, 0, 2, 0, 0, 0, 96, 1, 0, 0, 40,
+		36, 0, 0, 30, 1, 0, 0, 128, 1, 0, 0, 50, 36, 0, 0, 30,
+		1, 0, 0, 64, 2, 0, 0, 61, 36, 0, 0, 4, 0, 0, 4, 24,
+		0, 0, 0, 157, 32, 0, 0, 29, 0, 0, 0, 0, 0, 0, 0, 81,
+		36, 0, 0, 10, 0, 0, 0, 64, 0, 0, 0, 85, 36, 0, 0, 2,
+		0, 0, 0, 96, 0, 0, 0, 27, 36, 0, 0, 2, 0, 0, 0, 128,
+		0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 4, 64, 0, 0, 0, 91,
+		17, 0, 0, 77, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1,
+		0, 0, 4, 16, 0, 0, 0, 91, 36, 0, 0, 65, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 99,
+		36, 0, 0, 29, 0, 0, 0, 0, 0, 0, 0, 107, 36, 0, 0, 29,
+		0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 4, 40,
+		0, 0, 0, 112, 36, 0, 0, 35, 1, 0, 0, 0, 0, 0, 0, 117,
+		36, 0, 0, 65, 0, 0, 0, 192, 0, 0, 0, 125, 36, 0, 0, 3,
+		0, 0, 4, 24, 0, 0, 0, 144, 36, 0, 0, 29, 0, 0, 0, 0,
+		0, 0, 0, 152, 36, 0, 0, 29, 0, 0, 0, 64, 0, 0, 0, 160,
+		36, 0, 0, 36, 1, 0, 0, 128, 0, 0, 0, 165, 36, 0, 0, 5,
+		0, 0, 132, 4, 0, 0, 0, 155, 28, 0, 0, 8, 0, 0, 0, 0,
+		0, 0, 19, 189, 36, 0, 0, 8, 0, 0, 0, 19, 0, 0, 8, 48,
+		0, 0, 0, 8, 0, 0, 0, 27, 0, 0, 2, 193, 36, 0, 0, 8,
+		0, 0, 0, 29, 0, 0, 2, 203, 36, 0, 0, 8, 0, 0, 0, 31,
+		0, 0, 1, 0, 0, 0, 0, 5, 0, 0, 4, 24, 0, 0, 0, 211,
+		36, 0, 0, 20, 0, 0, 0, 0, 0, 0, 0, 222, 36, 0, 0, 20,
+		0, 0, 0, 8, 0, 0, 0, 233, 36, 0, 0, 58, 0, 0, 0, 16,
+		0, 0, 0, 241, 36, 0, 0, 29, 0, 0, 0, 64, 0, 0, 0, 246,
+		36, 0, 0, 29, 0, 0, 0, 128, 0, 0, 0, 0, 0, 0, 0, 2,
+		0, 0, 5, 16, 0, 0, 0, 0, 0, 0, 0, 39, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 40, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 252, 36, 0, 0, 29,
+		0, 0, 0, 0, 0, 0, 0, 8, 37, 0, 0, 19, 1, 0, 0, 64,
+		0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 20,
+		37, 0, 0, 29, 0, 0, 0, 0, 0, 0, 0, 33, 37, 0, 0, 29,
+		0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 178,
+		1, 0, 0, 45, 37, 0, 0, 0, 0, 0, 8, 43, 1, 0, 0, 63,
+		37, 0, 0, 2, 0, 0, 4, 24, 0, 0, 0, 157, 22, 0, 0, 201,
+		0, 0, 0, 0, 0, 0, 0, 79, 37, 0, 0, 65, 0, 0, 0, 64,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 153, 1, 0, 0, 84,
+		37, 0, 0, 3, 0, 0, 4, 32, 0, 0, 0, 99, 17, 0, 0, 54,
+		0, 0, 0, 0, 0, 0, 0, 255, 23, 0, 0, 46, 1, 0, 0, 128,
+		0, 0, 0, 93, 37, 0, 0, 49, 1, 0, 0, 192, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 47, 1, 0, 0, 0, 0, 0, 0, 1,
+		0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 0, 48, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 45, 1, 0, 0, 101, 37, 0, 0, 1,
+		0, 0, 4, 8, 0, 0, 0, 131, 26, 0, 0, 60, 0, 0, 0, 0,
+		0, 0, 0, 109, 37, 0, 0, 3, 0, 0, 4, 24, 0, 0, 0, 66,
+		24, 0, 0, 65, 0, 0, 0, 0, 0, 0, 0, 157, 22, 0, 0, 150,
+		0, 0, 0, 128, 0, 0, 0, 132, 37, 0, 0, 10, 0, 0, 0, 160,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 176, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 53, 1, 0, 0, 0, 0, 0, 0, 1,
+		0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 0, 16, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 183, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 56, 1, 0, 0, 0, 0, 0, 0, 1, 0, 0, 13, 29,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 148, 37, 0, 0, 0,
+		0, 0, 8, 58, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 59,
+		1, 0, 0, 0, 0, 0, 0, 3, 0, 0, 13, 0, 0, 0, 0, 0,
+		0, 0, 0, 16, 1, 0, 0, 0, 0, 0, 0, 60, 1, 0, 0, 0,
+		0, 0, 0, 72, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 61,
+		1, 0, 0, 172, 37, 0, 0, 23, 0, 0, 4, 0, 1, 0, 0, 189,
+		37, 0, 0, 29, 0, 0, 0, 0, 0, 0, 0, 194, 37, 0, 0, 62,
+		1, 0, 0, 64, 0, 0, 0, 198, 37, 0, 0, 63, 1, 0, 0, 128,
+		0, 0, 0, 207, 37, 0, 0, 29, 0, 0, 0, 192, 0, 0, 0, 142,
+		15, 0, 0, 64, 1, 0, 0, 0, 1, 0, 0, 214, 37, 0, 0, 29,
+		0, 0, 0, 64, 1, 0, 0, 218, 37, 0, 0, 66, 1, 0, 0, 128,
+		1, 0, 0, 48, 0, 0, 0, 29, 0, 0, 0, 192, 1, 0, 0, 227,
+		37, 0, 0, 29, 0, 0, 0, 0, 2, 0, 0, 230, 37, 0, 0, 68,
+		1, 0, 0, 64, 2, 0, 0, 230, 21, 0, 0, 29, 0, 0, 0, 128,
+		2, 0, 0, 202, 0, 0, 0, 29, 0, 0, 0, 192, 2, 0, 0, 240,
+		37, 0, 0, 29, 0, 0, 0, 0, 3, 0, 0, 250, 37, 0, 0, 69,
+		1, 0, 0, 64, 3, 0, 0, 4, 38, 0, 0, 70, 1, 0, 0, 128,
+		3, 0, 0, 14, 38, 0, 0, 29, 0, 0, 0, 192, 3, 0, 0, 23,
+		38, 0, 0, 71, 1, 0, 0, 0, 4, 0, 0, 33, 38, 0, 0, 71,
+		1, 0, 0, 128, 4, 0, 0, 43, 38, 0, 0, 29, 0, 0, 0, 0,
+		5, 0, 0, 59, 38, 0, 0, 29, 0, 0, 0, 64, 5, 0, 0, 18,
+		34, 0, 0, 29, 0, 0, 0, 128, 5, 0, 0, 69, 38, 0, 0, 29,
+		0, 0, 0, 192, 5, 0, 0, 84, 38, 0, 0, 29, 0, 0, 0, 0,
+		6, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 181, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 177, 1, 0, 0, 99, 38, 0, 0, 2,
+		0, 0, 5, 8, 0, 0, 0, 118, 38, 0, 0, 30, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 65, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 3, 0, 0, 4, 8, 0, 0, 0, 123, 38, 0, 0, 9,
+		0, 0, 0, 0, 0, 0, 0, 131, 38, 0, 0, 59, 0, 0, 0, 32,
+		0, 0, 0, 138, 38, 0, 0, 59, 0, 0, 0, 48, 0, 0, 0, 145,
+		38, 0, 0, 2, 0, 0, 5, 8, 0, 0, 0, 219, 21, 0, 0, 30,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 67, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 11, 0, 0, 132, 8, 0, 0, 0, 163,
+		38, 0, 0, 30, 0, 0, 0, 0, 0, 0, 5, 170, 38, 0, 0, 30,
+		0, 0, 0, 5, 0, 0, 14, 178, 38, 0, 0, 30, 0, 0, 0, 19,
+		0, 0, 5, 188, 38, 0, 0, 30, 0, 0, 0, 24, 0, 0, 2, 197,
+		38, 0, 0, 30, 0, 0, 0, 26, 0, 0, 7, 206, 38, 0, 0, 30,
+		0, 0, 0, 33, 0, 0, 4, 218, 38, 0, 0, 30, 0, 0, 0, 37,
+		0, 0, 1, 229, 38, 0, 0, 30, 0, 0, 0, 38, 0, 0, 2, 240,
+		38, 0, 0, 30, 0, 0, 0, 40, 0, 0, 3, 248, 38, 0, 0, 30,
+		0, 0, 0, 43, 0, 0, 3, 1, 39, 0, 0, 30, 0, 0, 0, 46,
+		0, 0, 18, 0, 0, 0, 0, 2, 0, 0, 4, 8, 0, 0, 0, 249,
+		0, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 10, 39, 0, 0, 8,
+		0, 0, 0, 32, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 4, 8,
+		0, 0, 0, 82, 14, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 14,
+		39, 0, 0, 8, 0, 0, 0, 32, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 179, 1, 0, 0, 23, 39, 0, 0, 2, 0, 0, 4, 16,
+		0, 0, 0, 33, 39, 0, 0, 30, 0, 0, 0, 0, 0, 0, 0, 37,
+		39, 0, 0, 72, 1, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 83, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 143,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 202, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 152, 1, 0, 0, 208, 31, 0, 0, 12,
+		0, 0, 4, 184, 0, 0, 0, 255, 23, 0, 0, 77, 1, 0, 0, 0,
+		0, 0, 0, 206, 14, 0, 0, 80, 1, 0, 0, 64, 0, 0, 0, 229,
+		0, 0, 0, 39, 0, 0, 0, 128, 0, 0, 0, 42, 39, 0, 0, 49,
+		0, 0, 0, 192, 0, 0, 0, 50, 39, 0, 0, 77, 1, 0, 0, 0,
+		1, 0, 0, 61, 39, 0, 0, 91, 1, 0, 0, 64, 1, 0, 0, 72,
+		39, 0, 0, 93, 1, 0, 0, 192, 2, 0, 0, 82, 39, 0, 0, 91,
+		1, 0, 0, 0, 3, 0, 0, 91, 39, 0, 0, 39, 0, 0, 0, 128,
+		4, 0, 0, 102, 39, 0, 0, 39, 0, 0, 0, 192, 4, 0, 0, 66,
+		24, 0, 0, 65, 0, 0, 0, 0, 5, 0, 0, 118, 39, 0, 0, 94,
+		1, 0, 0, 128, 5, 0, 0, 127, 39, 0, 0, 0, 0, 0, 8, 78,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 79, 1, 0, 0, 0,
+		0, 0, 0, 4, 0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 0, 39,
+		0, 0, 0, 0, 0, 0, 0, 39, 0, 0, 0, 0, 0, 0, 0, 80,
+		1, 0, 0, 0, 0, 0, 0, 81, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 76, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 82,
+		1, 0, 0, 141, 39, 0, 0, 1, 0, 0, 4, 80, 1, 0, 0, 37,
+		39, 0, 0, 83, 1, 0, 0, 0, 0, 0, 0, 153, 39, 0, 0, 9,
+		0, 0, 4, 80, 1, 0, 0, 0, 0, 0, 0, 84, 1, 0, 0, 0,
+		0, 0, 0, 161, 39, 0, 0, 29, 0, 0, 0, 128, 8, 0, 0, 169,
+		39, 0, 0, 89, 1, 0, 0, 192, 8, 0, 0, 179, 39, 0, 0, 8,
+		0, 0, 0, 224, 8, 0, 0, 187, 39, 0, 0, 29, 0, 0, 0, 0,
+		9, 0, 0, 198, 39, 0, 0, 29, 0, 0, 0, 64, 9, 0, 0, 207,
+		39, 0, 0, 90, 1, 0, 0, 128, 9, 0, 0, 218, 39, 0, 0, 29,
+		0, 0, 0, 0, 10, 0, 0, 235, 39, 0, 0, 29, 0, 0, 0, 64,
+		10, 0, 0, 0, 0, 0, 0, 2, 0, 0, 5, 16, 1, 0, 0, 244,
+		39, 0, 0, 85, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 87,
+		1, 0, 0, 0, 0, 0, 0, 254, 39, 0, 0, 4, 0, 0, 4, 16,
+		1, 0, 0, 37, 39, 0, 0, 86, 1, 0, 0, 0, 0, 0, 0, 83,
+		28, 0, 0, 30, 0, 0, 0, 192, 7, 0, 0, 86, 28, 0, 0, 30,
+		0, 0, 0, 0, 8, 0, 0, 11, 40, 0, 0, 30, 0, 0, 0, 64,
+		8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 30,
 		0, 0, 0, 4, 0, 0, 0, 31, 0, 0, 0, 0, 0, 0, 0, 4,
-		0, 0, 4, 16, 1, 0, 0, 40, 81, 0, 0, 115, 3, 0, 0, 0,
-		0, 0, 0, 146, 49, 0, 0, 39, 0, 0, 0, 192, 7, 0, 0, 149,
-		49, 0, 0, 39, 0, 0, 0, 0, 8, 0, 0, 159, 81, 0, 0, 39,
+		0, 0, 4, 16, 1, 0, 0, 37, 39, 0, 0, 88, 1, 0, 0, 0,
+		0, 0, 0, 83, 28, 0, 0, 29, 0, 0, 0, 192, 7, 0, 0, 86,
+		28, 0, 0, 29, 0, 0, 0, 0, 8, 0, 0, 11, 40, 0, 0, 29,
 		0, 0, 0, 64, 8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
-		0, 0, 0, 39, 0, 0, 0, 4, 0, 0, 0, 31, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 39, 0, 0, 0, 4,
-		0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 221,
-		3, 0, 0, 166, 81, 0, 0, 13, 0, 0, 4, 144, 0, 0, 0, 80,
-		20, 0, 0, 60, 0, 0, 0, 0, 0, 0, 0, 183, 81, 0, 0, 119,
-		3, 0, 0, 128, 0, 0, 0, 0, 0, 0, 0, 120, 3, 0, 0, 192,
-		0, 0, 0, 139, 71, 0, 0, 122, 3, 0, 0, 0, 1, 0, 0, 189,
-		81, 0, 0, 37, 1, 0, 0, 128, 2, 0, 0, 146, 40, 0, 0, 124,
-		3, 0, 0, 192, 2, 0, 0, 0, 0, 0, 0, 125, 3, 0, 0, 0,
-		3, 0, 0, 246, 20, 0, 0, 44, 0, 0, 0, 64, 3, 0, 0, 186,
-		0, 0, 0, 2, 0, 0, 0, 128, 3, 0, 0, 199, 81, 0, 0, 2,
-		0, 0, 0, 160, 3, 0, 0, 213, 81, 0, 0, 175, 0, 0, 0, 192,
-		3, 0, 0, 225, 81, 0, 0, 126, 3, 0, 0, 0, 4, 0, 0, 236,
-		81, 0, 0, 127, 3, 0, 0, 64, 4, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 6, 4, 0, 0, 0, 0, 0, 0, 2, 0, 0, 5, 8,
-		0, 0, 0, 225, 34, 0, 0, 37, 1, 0, 0, 0, 0, 0, 0, 246,
-		81, 0, 0, 121, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 4, 4, 0, 0, 249, 81, 0, 0, 4, 0, 0, 4, 48,
-		0, 0, 0, 167, 19, 0, 0, 238, 0, 0, 0, 0, 0, 0, 0, 80,
-		20, 0, 0, 60, 0, 0, 0, 128, 0, 0, 0, 48, 0, 0, 0, 2,
-		0, 0, 0, 0, 1, 0, 0, 5, 82, 0, 0, 123, 3, 0, 0, 64,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 7, 4, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 129, 3, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 5, 8, 0, 0, 0, 22, 53, 0, 0, 44, 0, 0, 0, 0,
-		0, 0, 0, 148, 20, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 220, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 128, 3, 0, 0, 0, 0, 0, 0, 2, 0, 0, 13, 2,
-		0, 0, 0, 0, 0, 0, 0, 112, 2, 0, 0, 0, 0, 0, 0, 19,
-		2, 0, 0, 11, 82, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 229,
-		52, 0, 0, 130, 3, 0, 0, 0, 0, 0, 0, 24, 82, 0, 0, 37,
-		1, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 8,
-		4, 0, 0, 254, 52, 0, 0, 12, 0, 0, 4, 184, 0, 0, 0, 13,
-		21, 0, 0, 132, 3, 0, 0, 0, 0, 0, 0, 213, 14, 0, 0, 135,
-		3, 0, 0, 64, 0, 0, 0, 186, 0, 0, 0, 31, 0, 0, 0, 128,
-		0, 0, 0, 162, 36, 0, 0, 44, 0, 0, 0, 192, 0, 0, 0, 38,
-		82, 0, 0, 132, 3, 0, 0, 0, 1, 0, 0, 49, 82, 0, 0, 138,
-		3, 0, 0, 64, 1, 0, 0, 60, 82, 0, 0, 141, 3, 0, 0, 192,
-		2, 0, 0, 70, 82, 0, 0, 138, 3, 0, 0, 0, 3, 0, 0, 79,
-		82, 0, 0, 31, 0, 0, 0, 128, 4, 0, 0, 90, 82, 0, 0, 31,
-		0, 0, 0, 192, 4, 0, 0, 80, 20, 0, 0, 60, 0, 0, 0, 0,
-		5, 0, 0, 106, 82, 0, 0, 142, 3, 0, 0, 128, 5, 0, 0, 115,
-		82, 0, 0, 0, 0, 0, 8, 133, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 134, 3, 0, 0, 0, 0, 0, 0, 4, 0, 0, 13, 0,
-		0, 0, 0, 0, 0, 0, 0, 31, 0, 0, 0, 0, 0, 0, 0, 31,
-		0, 0, 0, 0, 0, 0, 0, 135, 3, 0, 0, 0, 0, 0, 0, 136,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 131, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 137, 3, 0, 0, 129, 82, 0, 0, 1,
-		0, 0, 4, 80, 1, 0, 0, 40, 81, 0, 0, 110, 3, 0, 0, 0,
-		0, 0, 0, 141, 82, 0, 0, 3, 0, 0, 4, 48, 0, 0, 0, 157,
-		82, 0, 0, 139, 3, 0, 0, 0, 0, 0, 0, 170, 82, 0, 0, 139,
-		3, 0, 0, 64, 0, 0, 0, 182, 82, 0, 0, 154, 0, 0, 0, 128,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 140, 3, 0, 0, 193,
-		82, 0, 0, 5, 0, 0, 4, 48, 0, 0, 0, 205, 82, 0, 0, 31,
-		0, 0, 0, 0, 0, 0, 0, 215, 82, 0, 0, 175, 0, 0, 0, 64,
-		0, 0, 0, 101, 14, 0, 0, 31, 0, 0, 0, 128, 0, 0, 0, 186,
-		0, 0, 0, 31, 0, 0, 0, 192, 0, 0, 0, 76, 20, 0, 0, 111,
-		0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 138,
-		3, 0, 0, 223, 82, 0, 0, 0, 0, 0, 8, 143, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 144, 3, 0, 0, 0, 0, 0, 0, 2,
-		0, 0, 13, 2, 0, 0, 0, 0, 0, 0, 0, 135, 3, 0, 0, 0,
-		0, 0, 0, 145, 3, 0, 0, 241, 82, 0, 0, 3, 0, 0, 6, 4,
-		0, 0, 0, 0, 83, 0, 0, 0, 0, 0, 0, 42, 83, 0, 0, 1,
-		0, 0, 0, 84, 83, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 10, 4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
-		0, 0, 0, 19, 2, 0, 0, 4, 0, 0, 0, 16, 0, 0, 0, 127,
-		83, 0, 0, 5, 0, 0, 4, 80, 0, 0, 0, 145, 83, 0, 0, 149,
-		3, 0, 0, 0, 0, 0, 0, 150, 83, 0, 0, 149, 3, 0, 0, 128,
-		0, 0, 0, 155, 83, 0, 0, 149, 3, 0, 0, 0, 1, 0, 0, 160,
-		83, 0, 0, 149, 3, 0, 0, 128, 1, 0, 0, 165, 83, 0, 0, 149,
-		3, 0, 0, 0, 2, 0, 0, 170, 83, 0, 0, 2, 0, 0, 4, 16,
-		0, 0, 0, 182, 83, 0, 0, 31, 0, 0, 0, 0, 0, 0, 0, 185,
-		83, 0, 0, 31, 0, 0, 0, 64, 0, 0, 0, 188, 83, 0, 0, 1,
-		0, 0, 4, 16, 0, 0, 0, 145, 83, 0, 0, 149, 3, 0, 0, 0,
-		0, 0, 0, 39, 8, 0, 0, 9, 0, 0, 4, 72, 0, 0, 0, 101,
-		14, 0, 0, 47, 0, 0, 0, 0, 0, 0, 0, 21, 84, 0, 0, 152,
-		3, 0, 0, 64, 0, 0, 0, 28, 84, 0, 0, 153, 3, 0, 0, 128,
-		0, 0, 0, 35, 84, 0, 0, 154, 3, 0, 0, 192, 0, 0, 0, 42,
-		84, 0, 0, 236, 0, 0, 0, 0, 1, 0, 0, 62, 84, 0, 0, 155,
-		3, 0, 0, 64, 1, 0, 0, 69, 84, 0, 0, 156, 3, 0, 0, 128,
-		1, 0, 0, 77, 84, 0, 0, 156, 3, 0, 0, 192, 1, 0, 0, 98,
-		84, 0, 0, 159, 3, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 198, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 199,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 160, 3, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 196, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 157, 3, 0, 0, 108, 84, 0, 0, 6, 0, 0, 4, 88,
-		0, 0, 0, 108, 26, 0, 0, 192, 0, 0, 0, 0, 0, 0, 0, 127,
-		33, 0, 0, 141, 1, 0, 0, 64, 0, 0, 0, 255, 31, 0, 0, 115,
-		1, 0, 0, 128, 0, 0, 0, 123, 84, 0, 0, 158, 3, 0, 0, 64,
-		1, 0, 0, 131, 84, 0, 0, 204, 1, 0, 0, 64, 2, 0, 0, 141,
-		84, 0, 0, 52, 1, 0, 0, 128, 2, 0, 0, 156, 84, 0, 0, 2,
-		0, 0, 4, 32, 0, 0, 0, 171, 84, 0, 0, 68, 2, 0, 0, 0,
-		0, 0, 0, 181, 84, 0, 0, 68, 2, 0, 0, 128, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2, 207, 3, 0, 0, 194, 84, 0, 0, 11,
-		0, 0, 4, 120, 0, 0, 0, 255, 31, 0, 0, 115, 1, 0, 0, 0,
-		0, 0, 0, 41, 38, 0, 0, 161, 3, 0, 0, 192, 0, 0, 0, 80,
-		20, 0, 0, 60, 0, 0, 0, 0, 1, 0, 0, 208, 84, 0, 0, 150,
-		0, 0, 0, 128, 1, 0, 0, 108, 26, 0, 0, 192, 0, 0, 0, 192,
-		1, 0, 0, 127, 33, 0, 0, 141, 1, 0, 0, 0, 2, 0, 0, 216,
-		84, 0, 0, 39, 0, 0, 0, 64, 2, 0, 0, 251, 30, 0, 0, 233,
-		0, 0, 0, 128, 2, 0, 0, 139, 71, 0, 0, 39, 0, 0, 0, 64,
-		3, 0, 0, 220, 84, 0, 0, 10, 0, 0, 0, 128, 3, 0, 0, 227,
-		84, 0, 0, 10, 0, 0, 0, 160, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2, 203, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
-		0, 0, 0, 33, 0, 0, 0, 4, 0, 0, 0, 13, 0, 0, 0, 25,
-		89, 0, 0, 0, 0, 0, 14, 162, 3, 0, 0, 1, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 10, 165, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 9, 33, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
-		0, 0, 0, 164, 3, 0, 0, 4, 0, 0, 0, 64, 0, 0, 0, 33,
-		89, 0, 0, 0, 0, 0, 14, 166, 3, 0, 0, 1, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 25, 1, 0, 0, 4,
-		0, 0, 0, 41, 0, 0, 0, 45, 89, 0, 0, 0, 0, 0, 14, 168,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
-		0, 0, 0, 25, 1, 0, 0, 4, 0, 0, 0, 0, 4, 0, 0, 63,
-		89, 0, 0, 0, 0, 0, 14, 170, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 25, 1, 0, 0, 4,
-		0, 0, 0, 72, 0, 0, 0, 78, 89, 0, 0, 0, 0, 0, 14, 172,
-		3, 0, 0, 0, 0, 0, 0, 98, 89, 0, 0, 3, 0, 0, 15, 0,
-		0, 0, 0, 12, 0, 0, 0, 0, 0, 0, 0, 32, 0, 0, 0, 18,
-		0, 0, 0, 0, 0, 0, 0, 16, 0, 0, 0, 25, 0, 0, 0, 0,
-		0, 0, 0, 32, 0, 0, 0, 104, 89, 0, 0, 4, 0, 0, 15, 0,
-		0, 0, 0, 167, 3, 0, 0, 0, 0, 0, 0, 64, 0, 0, 0, 169,
-		3, 0, 0, 64, 0, 0, 0, 41, 0, 0, 0, 171, 3, 0, 0, 105,
-		0, 0, 0, 0, 4, 0, 0, 173, 3, 0, 0, 105, 4, 0, 0, 72,
-		0, 0, 0, 112, 89, 0, 0, 1, 0, 0, 15, 0, 0, 0, 0, 163,
-		3, 0, 0, 0, 0, 0, 0, 13, 0, 0, 0, 120, 89, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 220, 16, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 45, 30, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 134,
-		89, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 143, 89, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 155, 89, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 176, 89, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 208,
-		27, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 246, 66, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 193, 89, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 248, 54, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 212,
-		89, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 222, 89, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 234, 89, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 53, 66, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 240,
-		89, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 248, 89, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 4, 90, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 20, 90, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 27,
-		90, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 31, 90, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 50, 90, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 64, 90, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 78,
-		90, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 93, 90, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 108, 90, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 117, 90, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 123,
-		90, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 147, 90, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 162, 90, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 169, 90, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 186,
-		90, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 138, 20, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 194, 90, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 209, 90, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 220,
-		90, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 237, 90, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 251, 90, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 12, 91, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 23,
-		91, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 35, 91, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 56, 91, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 68, 91, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 82,
-		91, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 97, 91, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 106, 91, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 122, 91, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 140,
-		91, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 238, 47, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 34, 48, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 145, 91, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 167,
-		91, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 177, 91, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 188, 91, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 135, 33, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 102,
-		9, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 197, 91, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 206, 91, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 214, 91, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 57,
-		9, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 85, 9, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 228, 91, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 242, 91, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 4,
-		92, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 17, 92, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 32, 92, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 251, 7, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 39,
-		92, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 53, 92, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 66, 92, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 80, 92, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 89,
-		92, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 103, 92, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 122, 92, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 139, 92, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 152,
-		92, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 162, 92, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 172, 92, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 182, 92, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 200,
-		92, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 219, 92, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 241, 92, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 253, 92, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 15,
-		93, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 26, 93, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 37, 93, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 55, 93, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 77,
-		93, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 88, 93, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 105, 93, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 117, 93, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 138,
-		93, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 161, 93, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 177, 93, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 195, 93, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 216,
-		93, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 231, 93, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 243, 93, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 249, 93, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 14,
-		94, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 38, 94, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 54, 94, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 68, 94, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 93,
-		94, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 103, 94, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 115, 94, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 131, 94, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 144,
-		94, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 159, 94, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 172, 94, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 169, 29, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 94,
-		20, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 20, 3, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 199, 94, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 151, 8, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 208,
-		94, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 226, 94, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 241, 94, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 0, 95, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 71,
-		9, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 113, 9, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 16, 95, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 33, 95, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 57,
-		95, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 143, 11, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 225, 11, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 72, 95, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 88,
-		95, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 105, 95, 0, 0, 0,
-		0, 0, 7, 0, 0, 0, 0, 117, 95, 0, 0, 0, 0, 0, 7, 0,
-		0, 0, 0, 127, 95, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 0,
-		105, 110, 116, 0, 95, 95, 65, 82, 82, 65, 89, 95, 83, 73, 90, 69,
-		95, 84, 89, 80, 69, 95, 95, 0, 117, 51, 50, 0, 95, 95, 117, 51,
-		50, 0, 117, 110, 115, 105, 103, 110, 101, 100, 32, 105, 110, 116, 0, 116,
-		121, 112, 101, 0, 109, 97, 120, 95, 101, 110, 116, 114, 105, 101, 115, 0,
-		107, 101, 121, 0, 118, 97, 108, 117, 101, 0, 112, 105, 100, 95, 109, 110,
-		116, 110, 115, 0, 101, 118, 101, 110, 116, 115, 0, 117, 56, 0, 95, 95,
-		117, 56, 0, 117, 110, 115, 105, 103, 110, 101, 100, 32, 99, 104, 97, 114,
-		0, 109, 110, 116, 110, 115, 95, 115, 121, 115, 99, 97, 108, 108, 115, 0,
-		116, 114, 97, 99, 101, 95, 101, 118, 101, 110, 116, 95, 114, 97, 119, 95,
-		115, 121, 115, 95, 101, 110, 116, 101, 114, 0, 101, 110, 116, 0, 105, 100,
-		0, 97, 114, 103, 115, 0, 95, 95, 100, 97, 116, 97, 0, 116, 114, 97,
-		99, 101, 95, 101, 110, 116, 114, 121, 0, 102, 108, 97, 103, 115, 0, 112,
-		114, 101, 101, 109, 112, 116, 95, 99, 111, 117, 110, 116, 0, 112, 105, 100,
-		0, 117, 110, 115, 105, 103, 110, 101, 100, 32, 115, 104, 111, 114, 116, 0,
-		108, 111, 110, 103, 0, 117, 110, 115, 105, 103, 110, 101, 100, 32, 108, 111,
-		110, 103, 0, 99, 104, 97, 114, 0, 115, 121, 115, 95, 101, 110, 116, 101,
-		114, 0, 116, 114, 97, 99, 101, 112, 111, 105, 110, 116, 47, 114, 97, 119,
-		95, 115, 121, 115, 99, 97, 108, 108, 115, 47, 115, 121, 115, 95, 101, 110,
-		116, 101, 114, 0, 48, 58, 49, 0, 47, 98, 117, 105, 108, 100, 47, 115,
-		101, 99, 117, 114, 105, 116, 121, 45, 112, 114, 111, 102, 105, 108, 101, 115,
-		45, 111, 112, 101, 114, 97, 116, 111, 114, 47, 105, 110, 116, 101, 114, 110,
-		97, 108, 47, 112, 107, 103, 47, 100, 97, 101, 109, 111, 110, 47, 98, 112,
-		102, 114, 101, 99, 111, 114, 100, 101, 114, 47, 98, 112, 102, 47, 114, 101,
-		99, 111, 114, 100, 101, 114, 46, 98, 112, 102, 46, 99, 0, 32, 32, 32,
-		32, 117, 51, 50, 32, 115, 121, 115, 99, 97, 108, 108, 95, 105, 100, 32,
-		61, 32, 97, 114, 103, 115, 45, 62, 105, 100, 59, 0, 32, 32, 32, 32,
-		105, 102, 32, 40, 115, 121, 115, 99, 97, 108, 108, 95, 105, 100, 32, 60,
-		32, 48, 32, 124, 124, 32, 115, 121, 115, 99, 97, 108, 108, 95, 105, 100,
-		32, 62, 61, 32, 77, 65, 88, 95, 83, 89, 83, 67, 65, 76, 76, 83,
-		41, 32, 123, 0, 32, 32, 32, 32, 117, 51, 50, 32, 112, 105, 100, 32,
-		61, 32, 98, 112, 102, 95, 103, 101, 116, 95, 99, 117, 114, 114, 101, 110,
-		116, 95, 112, 105, 100, 95, 116, 103, 105, 100, 40, 41, 32, 62, 62, 32,
-		51, 50, 59, 0, 32, 32, 32, 32, 115, 116, 114, 117, 99, 116, 32, 116,
-		97, 115, 107, 95, 115, 116, 114, 117, 99, 116, 32, 42, 32, 116, 97, 115,
-		107, 32, 61, 32, 40, 115, 116, 114, 117, 99, 116, 32, 116, 97, 115, 107,
-		95, 115, 116, 114, 117, 99, 116, 32, 42, 41, 98, 112, 102, 95, 103, 101,
-		116, 95, 99, 117, 114, 114, 101, 110, 116, 95, 116, 97, 115, 107, 40, 41,
-		59, 0, 116, 97, 115, 107, 95, 115, 116, 114, 117, 99, 116, 0, 116, 104,
-		114, 101, 97, 100, 95, 105, 110, 102, 111, 0, 95, 95, 115, 116, 97, 116,
-		101, 0, 115, 116, 97, 99, 107, 0, 117, 115, 97, 103, 101, 0, 112, 116,
-		114, 97, 99, 101, 0, 111, 110, 95, 99, 112, 117, 0, 119, 97, 107, 101,
-		95, 101, 110, 116, 114, 121, 0, 119, 97, 107, 101, 101, 95, 102, 108, 105,
-		112, 115, 0, 119, 97, 107, 101, 101, 95, 102, 108, 105, 112, 95, 100, 101,
-		99, 97, 121, 95, 116, 115, 0, 108, 97, 115, 116, 95, 119, 97, 107, 101,
-		101, 0, 114, 101, 99, 101, 110, 116, 95, 117, 115, 101, 100, 95, 99, 112,
-		117, 0, 119, 97, 107, 101, 95, 99, 112, 117, 0, 111, 110, 95, 114, 113,
-		0, 112, 114, 105, 111, 0, 115, 116, 97, 116, 105, 99, 95, 112, 114, 105,
-		111, 0, 110, 111, 114, 109, 97, 108, 95, 112, 114, 105, 111, 0, 114, 116,
-		95, 112, 114, 105, 111, 114, 105, 116, 121, 0, 115, 101, 0, 114, 116, 0,
-		100, 108, 0, 115, 99, 104, 101, 100, 95, 99, 108, 97, 115, 115, 0, 99,
-		111, 114, 101, 95, 110, 111, 100, 101, 0, 99, 111, 114, 101, 95, 99, 111,
-		111, 107, 105, 101, 0, 99, 111, 114, 101, 95, 111, 99, 99, 117, 112, 97,
-		116, 105, 111, 110, 0, 115, 99, 104, 101, 100, 95, 116, 97, 115, 107, 95,
-		103, 114, 111, 117, 112, 0, 115, 116, 97, 116, 115, 0, 112, 114, 101, 101,
-		109, 112, 116, 95, 110, 111, 116, 105, 102, 105, 101, 114, 115, 0, 98, 116,
-		114, 97, 99, 101, 95, 115, 101, 113, 0, 112, 111, 108, 105, 99, 121, 0,
-		110, 114, 95, 99, 112, 117, 115, 95, 97, 108, 108, 111, 119, 101, 100, 0,
-		99, 112, 117, 115, 95, 112, 116, 114, 0, 117, 115, 101, 114, 95, 99, 112,
-		117, 115, 95, 112, 116, 114, 0, 99, 112, 117, 115, 95, 109, 97, 115, 107,
-		0, 109, 105, 103, 114, 97, 116, 105, 111, 110, 95, 112, 101, 110, 100, 105,
-		110, 103, 0, 109, 105, 103, 114, 97, 116, 105, 111, 110, 95, 100, 105, 115,
-		97, 98, 108, 101, 100, 0, 109, 105, 103, 114, 97, 116, 105, 111, 110, 95,
-		102, 108, 97, 103, 115, 0, 114, 99, 117, 95, 114, 101, 97, 100, 95, 108,
-		111, 99, 107, 95, 110, 101, 115, 116, 105, 110, 103, 0, 114, 99, 117, 95,
-		114, 101, 97, 100, 95, 117, 110, 108, 111, 99, 107, 95, 115, 112, 101, 99,
-		105, 97, 108, 0, 114, 99, 117, 95, 110, 111, 100, 101, 95, 101, 110, 116,
-		114, 121, 0, 114, 99, 117, 95, 98, 108, 111, 99, 107, 101, 100, 95, 110,
-		111, 100, 101, 0, 114, 99, 117, 95, 116, 97, 115, 107, 115, 95, 110, 118,
-		99, 115, 119, 0, 114, 99, 117, 95, 116, 97, 115, 107, 115, 95, 104, 111,
-		108, 100, 111, 117, 116, 0, 114, 99, 117, 95, 116, 97, 115, 107, 115, 95,
-		105, 100, 120, 0, 114, 99, 117, 95, 116, 97, 115, 107, 115, 95, 105, 100,
-		108, 101, 95, 99, 112, 117, 0, 114, 99, 117, 95, 116, 97, 115, 107, 115,
+		0, 0, 0, 29, 0, 0, 0, 4, 0, 0, 0, 31, 0, 0, 0, 18,
+		40, 0, 0, 0, 0, 0, 8, 26, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 3, 0, 0, 0, 0, 29, 0, 0, 0, 4, 0, 0, 0, 2,
+		0, 0, 0, 22, 40, 0, 0, 3, 0, 0, 4, 48, 0, 0, 0, 38,
+		40, 0, 0, 92, 1, 0, 0, 0, 0, 0, 0, 51, 40, 0, 0, 92,
+		1, 0, 0, 64, 0, 0, 0, 63, 40, 0, 0, 225, 0, 0, 0, 128,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 157, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 91, 1, 0, 0, 74, 40, 0, 0, 0,
+		0, 0, 8, 95, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 96,
+		1, 0, 0, 0, 0, 0, 0, 2, 0, 0, 13, 2, 0, 0, 0, 0,
+		0, 0, 0, 80, 1, 0, 0, 0, 0, 0, 0, 97, 1, 0, 0, 92,
+		40, 0, 0, 3, 0, 0, 6, 4, 0, 0, 0, 107, 40, 0, 0, 0,
+		0, 0, 0, 149, 40, 0, 0, 1, 0, 0, 0, 191, 40, 0, 0, 2,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 180, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 16, 1, 0, 0, 4,
+		0, 0, 0, 16, 0, 0, 0, 234, 40, 0, 0, 5, 0, 0, 4, 80,
+		0, 0, 0, 252, 40, 0, 0, 101, 1, 0, 0, 0, 0, 0, 0, 1,
+		41, 0, 0, 101, 1, 0, 0, 128, 0, 0, 0, 6, 41, 0, 0, 101,
+		1, 0, 0, 0, 1, 0, 0, 11, 41, 0, 0, 101, 1, 0, 0, 128,
+		1, 0, 0, 16, 41, 0, 0, 101, 1, 0, 0, 0, 2, 0, 0, 21,
+		41, 0, 0, 2, 0, 0, 4, 16, 0, 0, 0, 33, 41, 0, 0, 39,
+		0, 0, 0, 0, 0, 0, 0, 36, 41, 0, 0, 39, 0, 0, 0, 64,
+		0, 0, 0, 39, 41, 0, 0, 1, 0, 0, 4, 16, 0, 0, 0, 252,
+		40, 0, 0, 101, 1, 0, 0, 0, 0, 0, 0, 61, 8, 0, 0, 9,
+		0, 0, 4, 72, 0, 0, 0, 94, 14, 0, 0, 52, 0, 0, 0, 0,
+		0, 0, 0, 128, 41, 0, 0, 104, 1, 0, 0, 64, 0, 0, 0, 135,
+		41, 0, 0, 105, 1, 0, 0, 128, 0, 0, 0, 142, 41, 0, 0, 106,
+		1, 0, 0, 192, 0, 0, 0, 149, 41, 0, 0, 54, 1, 0, 0, 0,
+		1, 0, 0, 169, 41, 0, 0, 107, 1, 0, 0, 64, 1, 0, 0, 176,
+		41, 0, 0, 108, 1, 0, 0, 128, 1, 0, 0, 184, 41, 0, 0, 108,
+		1, 0, 0, 192, 1, 0, 0, 205, 41, 0, 0, 109, 1, 0, 0, 0,
+		2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 207, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 163, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 110, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 172,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 201, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 147, 1, 0, 0, 219, 41, 0, 0, 11,
+		0, 0, 4, 120, 0, 0, 0, 97, 31, 0, 0, 111, 1, 0, 0, 0,
+		0, 0, 0, 233, 41, 0, 0, 114, 1, 0, 0, 192, 0, 0, 0, 66,
+		24, 0, 0, 65, 0, 0, 0, 0, 1, 0, 0, 238, 41, 0, 0, 201,
+		0, 0, 0, 128, 1, 0, 0, 246, 41, 0, 0, 115, 1, 0, 0, 192,
+		1, 0, 0, 254, 41, 0, 0, 116, 1, 0, 0, 0, 2, 0, 0, 6,
+		42, 0, 0, 29, 0, 0, 0, 64, 2, 0, 0, 208, 21, 0, 0, 42,
+		1, 0, 0, 128, 2, 0, 0, 10, 42, 0, 0, 29, 0, 0, 0, 64,
+		3, 0, 0, 16, 42, 0, 0, 10, 0, 0, 0, 128, 3, 0, 0, 23,
+		42, 0, 0, 10, 0, 0, 0, 160, 3, 0, 0, 38, 42, 0, 0, 4,
+		0, 0, 4, 24, 0, 0, 0, 48, 42, 0, 0, 117, 0, 0, 0, 0,
+		0, 0, 0, 56, 42, 0, 0, 112, 1, 0, 0, 64, 0, 0, 0, 60,
+		42, 0, 0, 10, 0, 0, 0, 128, 0, 0, 0, 94, 14, 0, 0, 50,
+		0, 0, 0, 160, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 113,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 10, 187, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 2, 170, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 2, 205, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 203,
+		1, 0, 0, 0, 0, 0, 0, 1, 0, 0, 13, 2, 0, 0, 0, 161,
+		21, 0, 0, 49, 0, 0, 0, 81, 47, 0, 0, 1, 0, 0, 12, 117,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 41,
+		0, 0, 0, 4, 0, 0, 0, 13, 0, 0, 0, 32, 48, 0, 0, 0,
+		0, 0, 14, 119, 1, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 10, 122, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 41,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 121,
+		1, 0, 0, 4, 0, 0, 0, 64, 0, 0, 0, 40, 48, 0, 0, 0,
+		0, 0, 14, 123, 1, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 10, 41, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
+		0, 0, 0, 125, 1, 0, 0, 4, 0, 0, 0, 45, 0, 0, 0, 52,
+		48, 0, 0, 0, 0, 0, 14, 126, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 125, 1, 0, 0, 4,
+		0, 0, 0, 0, 4, 0, 0, 70, 48, 0, 0, 0, 0, 0, 14, 128,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
+		0, 0, 0, 125, 1, 0, 0, 4, 0, 0, 0, 72, 0, 0, 0, 85,
+		48, 0, 0, 0, 0, 0, 14, 130, 1, 0, 0, 0, 0, 0, 0, 105,
+		48, 0, 0, 4, 0, 0, 15, 0, 0, 0, 0, 12, 0, 0, 0, 0,
+		0, 0, 0, 32, 0, 0, 0, 18, 0, 0, 0, 0, 0, 0, 0, 16,
+		0, 0, 0, 25, 0, 0, 0, 0, 0, 0, 0, 32, 0, 0, 0, 33,
+		0, 0, 0, 0, 0, 0, 0, 32, 0, 0, 0, 111, 48, 0, 0, 4,
+		0, 0, 15, 0, 0, 0, 0, 124, 1, 0, 0, 0, 0, 0, 0, 64,
+		0, 0, 0, 127, 1, 0, 0, 64, 0, 0, 0, 45, 0, 0, 0, 129,
+		1, 0, 0, 109, 0, 0, 0, 0, 4, 0, 0, 131, 1, 0, 0, 109,
+		4, 0, 0, 72, 0, 0, 0, 119, 48, 0, 0, 1, 0, 0, 15, 0,
+		0, 0, 0, 120, 1, 0, 0, 0, 0, 0, 0, 13, 0, 0, 0, 127,
+		48, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 233, 20, 0, 0, 0,
+		0, 0, 7, 0, 0, 0, 0, 145, 48, 0, 0, 0, 0, 0, 7, 0,
+		0, 0, 0, 173, 8, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 107,
+		9, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 79, 9, 0, 0, 0,
+		0, 0, 7, 0, 0, 0, 0, 159, 48, 0, 0, 0, 0, 0, 7, 0,
+		0, 0, 0, 168, 48, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 186,
+		48, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 195, 48, 0, 0, 0,
+		0, 0, 7, 0, 0, 0, 0, 135, 9, 0, 0, 0, 0, 0, 7, 0,
+		0, 0, 0, 98, 15, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 207,
+		48, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 224, 48, 0, 0, 0,
+		0, 0, 7, 0, 0, 0, 0, 248, 48, 0, 0, 0, 0, 0, 7, 0,
+		0, 0, 0, 242, 7, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 3,
+		49, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 11, 49, 0, 0, 0,
+		0, 0, 7, 0, 0, 0, 0, 24, 49, 0, 0, 0, 0, 0, 7, 0,
+		0, 0, 0, 38, 49, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 43,
+		49, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 56, 49, 0, 0, 0,
+		0, 0, 7, 0, 0, 0, 0, 66, 49, 0, 0, 0, 0, 0, 7, 0,
+		0, 0, 0, 78, 49, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 95,
+		49, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 110, 49, 0, 0, 0,
+		0, 0, 7, 0, 0, 0, 0, 124, 9, 0, 0, 0, 0, 0, 7, 0,
+		0, 0, 0, 129, 49, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 143,
+		49, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 157, 49, 0, 0, 0,
+		0, 0, 7, 0, 0, 0, 0, 65, 0, 0, 0, 0, 0, 0, 7, 0,
+		0, 0, 0, 172, 49, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 178,
+		49, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 230, 10, 0, 0, 0,
+		0, 0, 7, 0, 0, 0, 0, 189, 49, 0, 0, 0, 0, 0, 7, 0,
+		0, 0, 0, 199, 49, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 17,
+		8, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 205, 49, 0, 0, 0,
+		0, 0, 7, 0, 0, 0, 0, 165, 11, 0, 0, 0, 0, 0, 7, 0,
+		0, 0, 0, 209, 49, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 70,
+		27, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 224, 49, 0, 0, 0,
+		0, 0, 7, 0, 0, 0, 0, 247, 49, 0, 0, 0, 0, 0, 7, 0,
+		0, 0, 0, 9, 50, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 21,
+		50, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 42, 50, 0, 0, 0,
+		0, 0, 7, 0, 0, 0, 0, 54, 50, 0, 0, 0, 0, 0, 7, 0,
+		0, 0, 0, 249, 0, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 70,
+		50, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 84, 50, 0, 0, 0,
+		0, 0, 7, 0, 0, 0, 0, 201, 25, 0, 0, 0, 0, 0, 7, 0,
+		0, 0, 0, 100, 50, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 107,
+		50, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 126, 50, 0, 0, 0,
+		0, 0, 7, 0, 0, 0, 0, 93, 9, 0, 0, 0, 0, 0, 7, 0,
+		0, 0, 0, 135, 50, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 149,
+		50, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 247, 11, 0, 0, 0,
+		0, 0, 7, 0, 0, 0, 0, 166, 50, 0, 0, 0, 0, 0, 7, 0,
+		0, 0, 0, 42, 3, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 182,
+		50, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 197, 50, 0, 0, 0,
+		0, 0, 7, 0, 0, 0, 0, 211, 50, 0, 0, 0, 0, 0, 7, 0,
+		0, 0, 0, 226, 50, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 240,
+		50, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 0, 51, 0, 0, 0,
+		0, 0, 7, 0, 0, 0, 0, 11, 51, 0, 0, 0, 0, 0, 7, 0,
+		0, 0, 0, 26, 51, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 254,
+		41, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 43, 51, 0, 0, 0,
+		0, 0, 7, 0, 0, 0, 0, 55, 51, 0, 0, 0, 0, 0, 7, 0,
+		0, 0, 0, 70, 51, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 86,
+		51, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 100, 51, 0, 0, 0,
+		0, 0, 7, 0, 0, 0, 0, 121, 51, 0, 0, 0, 0, 0, 7, 0,
+		0, 0, 0, 0, 105, 110, 116, 0, 95, 95, 65, 82, 82, 65, 89, 95,
+		83, 73, 90, 69, 95, 84, 89, 80, 69, 95, 95, 0, 117, 51, 50, 0,
+		95, 95, 117, 51, 50, 0, 117, 110, 115, 105, 103, 110, 101, 100, 32, 105,
+		110, 116, 0, 116, 121, 112, 101, 0, 109, 97, 120, 95, 101, 110, 116, 114,
+		105, 101, 115, 0, 107, 101, 121, 0, 118, 97, 108, 117, 101, 0, 112, 105,
+		100, 95, 109, 110, 116, 110, 115, 0, 101, 118, 101, 110, 116, 115, 0, 117,
+		56, 0, 95, 95, 117, 56, 0, 117, 110, 115, 105, 103, 110, 101, 100, 32,
+		99, 104, 97, 114, 0, 109, 110, 116, 110, 115, 95, 115, 121, 115, 99, 97,
+		108, 108, 115, 0, 117, 54, 52, 0, 95, 95, 117, 54, 52, 0, 117, 110,
+		115, 105, 103, 110, 101, 100, 32, 108, 111, 110, 103, 32, 108, 111, 110, 103,
+		0, 109, 97, 112, 95, 111, 118, 101, 114, 102, 108, 111, 119, 115, 0, 116,
+		114, 97, 99, 101, 95, 101, 118, 101, 110, 116, 95, 114, 97, 119, 95, 115,
+		121, 115, 95, 101, 110, 116, 101, 114, 0, 101, 110, 116, 0, 105, 100, 0,
+		97, 114, 103, 115, 0, 95, 95, 100, 97, 116, 97, 0, 116, 114, 97, 99,
+		101, 95, 101, 110, 116, 114, 121, 0, 102, 108, 97, 103, 115, 0, 112, 114,
+		101, 101, 109, 112, 116, 95, 99, 111, 117, 110, 116, 0, 112, 105, 100, 0,
+		117, 110, 115, 105, 103, 110, 101, 100, 32, 115, 104, 111, 114, 116, 0, 108,
+		111, 110, 103, 0, 117, 110, 115, 105, 103, 110, 101, 100, 32, 108, 111, 110,
+		103, 0, 99, 104, 97, 114, 0, 115, 121, 115, 95, 101, 110, 116, 101, 114,
+		0, 116, 114, 97, 99, 101, 112, 111, 105, 110, 116, 47, 114, 97, 119, 95,
+		115, 121, 115, 99, 97, 108, 108, 115, 47, 115, 121, 115, 95, 101, 110, 116,
+		101, 114, 0, 48, 58, 49, 0, 47, 114, 111, 111, 116, 47, 109, 111, 100,
+		117, 108, 101, 47, 105, 110, 116, 101, 114, 110, 97, 108, 47, 112, 107, 103,
+		47, 100, 97, 101, 109, 111, 110, 47, 98, 112, 102, 114, 101, 99, 111, 114,
+		100, 101, 114, 47, 98, 112, 102, 47, 114, 101, 99, 111, 114, 100, 101, 114,
+		46, 98, 112, 102, 46, 99, 0, 32, 32, 32, 32, 117, 51, 50, 32, 115,
+		121, 115, 99, 97, 108, 108, 95, 105, 100, 32, 61, 32, 97, 114, 103, 115,
+		45, 62, 105, 100, 59, 0, 32, 32, 32, 32, 105, 102, 32, 40, 115, 121,
+		115, 99, 97, 108, 108, 95, 105, 100, 32, 60, 32, 48, 32, 124, 124, 32,
+		115, 121, 115, 99, 97, 108, 108, 95, 105, 100, 32, 62, 61, 32, 77, 65,
+		88, 95, 83, 89, 83, 67, 65, 76, 76, 83, 41, 32, 123, 0, 32, 32,
+		32, 32, 117, 51, 50, 32, 112, 105, 100, 32, 61, 32, 98, 112, 102, 95,
+		103, 101, 116, 95, 99, 117, 114, 114, 101, 110, 116, 95, 112, 105, 100, 95,
+		116, 103, 105, 100, 40, 41, 32, 62, 62, 32, 51, 50, 59, 0, 32, 32,
+		32, 32, 115, 116, 114, 117, 99, 116, 32, 116, 97, 115, 107, 95, 115, 116,
+		114, 117, 99, 116, 32, 42, 32, 116, 97, 115, 107, 32, 61, 32, 40, 115,
+		116, 114, 117, 99, 116, 32, 116, 97, 115, 107, 95, 115, 116, 114, 117, 99,
+		116, 32, 42, 41, 98, 112, 102, 95, 103, 101, 116, 95, 99, 117, 114, 114,
+		101, 110, 116, 95, 116, 97, 115, 107, 40, 41, 59, 0, 116, 97, 115, 107,
+		95, 115, 116, 114, 117, 99, 116, 0, 116, 104, 114, 101, 97, 100, 95, 105,
+		110, 102, 111, 0, 95, 95, 115, 116, 97, 116, 101, 0, 115, 116, 97, 99,
+		107, 0, 117, 115, 97, 103, 101, 0, 112, 116, 114, 97, 99, 101, 0, 111,
+		110, 95, 99, 112, 117, 0, 119, 97, 107, 101, 95, 101, 110, 116, 114, 121,
+		0, 119, 97, 107, 101, 101, 95, 102, 108, 105, 112, 115, 0, 119, 97, 107,
+		101, 101, 95, 102, 108, 105, 112, 95, 100, 101, 99, 97, 121, 95, 116, 115,
+		0, 108, 97, 115, 116, 95, 119, 97, 107, 101, 101, 0, 114, 101, 99, 101,
+		110, 116, 95, 117, 115, 101, 100, 95, 99, 112, 117, 0, 119, 97, 107, 101,
+		95, 99, 112, 117, 0, 111, 110, 95, 114, 113, 0, 112, 114, 105, 111, 0,
+		115, 116, 97, 116, 105, 99, 95, 112, 114, 105, 111, 0, 110, 111, 114, 109,
+		97, 108, 95, 112, 114, 105, 111, 0, 114, 116, 95, 112, 114, 105, 111, 114,
+		105, 116, 121, 0, 115, 101, 0, 114, 116, 0, 100, 108, 0, 115, 99, 104,
+		101, 100, 95, 99, 108, 97, 115, 115, 0, 99, 111, 114, 101, 95, 110, 111,
+		100, 101, 0, 99, 111, 114, 101, 95, 99, 111, 111, 107, 105, 101, 0, 99,
+		111, 114, 101, 95, 111, 99, 99, 117, 112, 97, 116, 105, 111, 110, 0, 115,
+		99, 104, 101, 100, 95, 116, 97, 115, 107, 95, 103, 114, 111, 117, 112, 0,
+		115, 116, 97, 116, 115, 0, 112, 114, 101, 101, 109, 112, 116, 95, 110, 111,
+		116, 105, 102, 105, 101, 114, 115, 0, 98, 116, 114, 97, 99, 101, 95, 115,
+		101, 113, 0, 112, 111, 108, 105, 99, 121, 0, 110, 114, 95, 99, 112, 117,
+		115, 95, 97, 108, 108, 111, 119, 101, 100, 0, 99, 112, 117, 115, 95, 112,
+		116, 114, 0, 117, 115, 101, 114, 95, 99, 112, 117, 115, 95, 112, 116, 114,
+		0, 99, 112, 117, 115, 95, 109, 97, 115, 107, 0, 109, 105, 103, 114, 97,
+		116, 105, 111, 110, 95, 112, 101, 110, 100, 105, 110, 103, 0, 109, 105, 103,
+		114, 97, 116, 105, 111, 110, 95, 100, 105, 115, 97, 98, 108, 101, 100, 0,
+		109, 105, 103, 114, 97, 116, 105, 111, 110, 95, 102, 108, 97, 103, 115, 0,
+		114, 99, 117, 95, 114, 101, 97, 100, 95, 108, 111, 99, 107, 95, 110, 101,
+		115, 116, 105, 110, 103, 0, 114, 99, 117, 95, 114, 101, 97, 100, 95, 117,
+		110, 108, 111, 99, 107, 95, 115, 112, 101, 99, 105, 97, 108, 0, 114, 99,
+		117, 95, 110, 111, 100, 101, 95, 101, 110, 116, 114, 121, 0, 114, 99, 117,
+		95, 98, 108, 111, 99, 107, 101, 100, 95, 110, 111, 100, 101, 0, 114, 99,
+		117, 95, 116, 97, 115, 107, 115, 95, 110, 118, 99, 115, 119, 0, 114, 99,
+		117, 95, 116, 97, 115, 107, 115, 95, 104, 111, 108, 100, 111, 117, 116, 0,
+		114, 99, 117, 95, 116, 97, 115, 107, 115, 95, 105, 100, 120, 0, 114, 99,
+		117, 95, 116, 97, 115, 107, 115, 95, 105, 100, 108, 101, 95, 99, 112, 117,
+		0, 114, 99, 117, 95, 116, 97, 115, 107, 115, 95, 104, 111, 108, 100, 111,
+		117, 116, 95, 108, 105, 115, 116, 0, 116, 114, 99, 95, 114, 101, 97, 100,
+		101, 114, 95, 110, 101, 115, 116, 105, 110, 103, 0, 116, 114, 99, 95, 105,
+		112, 105, 95, 116, 111, 95, 99, 112, 117, 0, 116, 114, 99, 95, 114, 101,
+		97, 100, 101, 114, 95, 115, 112, 101, 99, 105, 97, 108, 0, 116, 114, 99,
 		95, 104, 111, 108, 100, 111, 117, 116, 95, 108, 105, 115, 116, 0, 116, 114,
-		99, 95, 114, 101, 97, 100, 101, 114, 95, 110, 101, 115, 116, 105, 110, 103,
-		0, 116, 114, 99, 95, 105, 112, 105, 95, 116, 111, 95, 99, 112, 117, 0,
-		116, 114, 99, 95, 114, 101, 97, 100, 101, 114, 95, 115, 112, 101, 99, 105,
-		97, 108, 0, 116, 114, 99, 95, 104, 111, 108, 100, 111, 117, 116, 95, 108,
-		105, 115, 116, 0, 116, 114, 99, 95, 98, 108, 107, 100, 95, 110, 111, 100,
-		101, 0, 116, 114, 99, 95, 98, 108, 107, 100, 95, 99, 112, 117, 0, 115,
-		99, 104, 101, 100, 95, 105, 110, 102, 111, 0, 116, 97, 115, 107, 115, 0,
-		112, 117, 115, 104, 97, 98, 108, 101, 95, 116, 97, 115, 107, 115, 0, 112,
-		117, 115, 104, 97, 98, 108, 101, 95, 100, 108, 95, 116, 97, 115, 107, 115,
-		0, 109, 109, 0, 97, 99, 116, 105, 118, 101, 95, 109, 109, 0, 118, 109,
-		97, 99, 97, 99, 104, 101, 0, 114, 115, 115, 95, 115, 116, 97, 116, 0,
-		101, 120, 105, 116, 95, 115, 116, 97, 116, 101, 0, 101, 120, 105, 116, 95,
-		99, 111, 100, 101, 0, 101, 120, 105, 116, 95, 115, 105, 103, 110, 97, 108,
-		0, 112, 100, 101, 97, 116, 104, 95, 115, 105, 103, 110, 97, 108, 0, 106,
-		111, 98, 99, 116, 108, 0, 112, 101, 114, 115, 111, 110, 97, 108, 105, 116,
-		121, 0, 115, 99, 104, 101, 100, 95, 114, 101, 115, 101, 116, 95, 111, 110,
-		95, 102, 111, 114, 107, 0, 115, 99, 104, 101, 100, 95, 99, 111, 110, 116,
-		114, 105, 98, 117, 116, 101, 115, 95, 116, 111, 95, 108, 111, 97, 100, 0,
-		115, 99, 104, 101, 100, 95, 109, 105, 103, 114, 97, 116, 101, 100, 0, 115,
-		99, 104, 101, 100, 95, 112, 115, 105, 95, 119, 97, 107, 101, 95, 114, 101,
-		113, 117, 101, 117, 101, 0, 115, 99, 104, 101, 100, 95, 114, 101, 109, 111,
-		116, 101, 95, 119, 97, 107, 101, 117, 112, 0, 105, 110, 95, 101, 120, 101,
-		99, 118, 101, 0, 105, 110, 95, 105, 111, 119, 97, 105, 116, 0, 105, 110,
-		95, 117, 115, 101, 114, 95, 102, 97, 117, 108, 116, 0, 110, 111, 95, 99,
-		103, 114, 111, 117, 112, 95, 109, 105, 103, 114, 97, 116, 105, 111, 110, 0,
-		102, 114, 111, 122, 101, 110, 0, 117, 115, 101, 95, 109, 101, 109, 100, 101,
-		108, 97, 121, 0, 105, 110, 95, 109, 101, 109, 115, 116, 97, 108, 108, 0,
-		105, 110, 95, 112, 97, 103, 101, 95, 111, 119, 110, 101, 114, 0, 105, 110,
-		95, 101, 118, 101, 110, 116, 102, 100, 0, 112, 97, 115, 105, 100, 95, 97,
-		99, 116, 105, 118, 97, 116, 101, 100, 0, 97, 116, 111, 109, 105, 99, 95,
-		102, 108, 97, 103, 115, 0, 114, 101, 115, 116, 97, 114, 116, 95, 98, 108,
-		111, 99, 107, 0, 116, 103, 105, 100, 0, 114, 101, 97, 108, 95, 112, 97,
-		114, 101, 110, 116, 0, 112, 97, 114, 101, 110, 116, 0, 99, 104, 105, 108,
-		100, 114, 101, 110, 0, 115, 105, 98, 108, 105, 110, 103, 0, 103, 114, 111,
-		117, 112, 95, 108, 101, 97, 100, 101, 114, 0, 112, 116, 114, 97, 99, 101,
-		100, 0, 112, 116, 114, 97, 99, 101, 95, 101, 110, 116, 114, 121, 0, 116,
-		104, 114, 101, 97, 100, 95, 112, 105, 100, 0, 112, 105, 100, 95, 108, 105,
-		110, 107, 115, 0, 116, 104, 114, 101, 97, 100, 95, 103, 114, 111, 117, 112,
-		0, 116, 104, 114, 101, 97, 100, 95, 110, 111, 100, 101, 0, 118, 102, 111,
-		114, 107, 95, 100, 111, 110, 101, 0, 115, 101, 116, 95, 99, 104, 105, 108,
-		100, 95, 116, 105, 100, 0, 99, 108, 101, 97, 114, 95, 99, 104, 105, 108,
-		100, 95, 116, 105, 100, 0, 119, 111, 114, 107, 101, 114, 95, 112, 114, 105,
-		118, 97, 116, 101, 0, 117, 116, 105, 109, 101, 0, 115, 116, 105, 109, 101,
-		0, 103, 116, 105, 109, 101, 0, 112, 114, 101, 118, 95, 99, 112, 117, 116,
-		105, 109, 101, 0, 118, 116, 105, 109, 101, 0, 116, 105, 99, 107, 95, 100,
-		101, 112, 95, 109, 97, 115, 107, 0, 110, 118, 99, 115, 119, 0, 110, 105,
-		118, 99, 115, 119, 0, 115, 116, 97, 114, 116, 95, 116, 105, 109, 101, 0,
-		115, 116, 97, 114, 116, 95, 98, 111, 111, 116, 116, 105, 109, 101, 0, 109,
-		105, 110, 95, 102, 108, 116, 0, 109, 97, 106, 95, 102, 108, 116, 0, 112,
-		111, 115, 105, 120, 95, 99, 112, 117, 116, 105, 109, 101, 114, 115, 0, 112,
-		111, 115, 105, 120, 95, 99, 112, 117, 116, 105, 109, 101, 114, 115, 95, 119,
-		111, 114, 107, 0, 112, 116, 114, 97, 99, 101, 114, 95, 99, 114, 101, 100,
-		0, 114, 101, 97, 108, 95, 99, 114, 101, 100, 0, 99, 114, 101, 100, 0,
-		99, 97, 99, 104, 101, 100, 95, 114, 101, 113, 117, 101, 115, 116, 101, 100,
-		95, 107, 101, 121, 0, 99, 111, 109, 109, 0, 110, 97, 109, 101, 105, 100,
-		97, 116, 97, 0, 115, 121, 115, 118, 115, 101, 109, 0, 115, 121, 115, 118,
-		115, 104, 109, 0, 102, 115, 0, 102, 105, 108, 101, 115, 0, 105, 111, 95,
-		117, 114, 105, 110, 103, 0, 110, 115, 112, 114, 111, 120, 121, 0, 115, 105,
-		103, 110, 97, 108, 0, 115, 105, 103, 104, 97, 110, 100, 0, 98, 108, 111,
-		99, 107, 101, 100, 0, 114, 101, 97, 108, 95, 98, 108, 111, 99, 107, 101,
-		100, 0, 115, 97, 118, 101, 100, 95, 115, 105, 103, 109, 97, 115, 107, 0,
-		112, 101, 110, 100, 105, 110, 103, 0, 115, 97, 115, 95, 115, 115, 95, 115,
-		112, 0, 115, 97, 115, 95, 115, 115, 95, 115, 105, 122, 101, 0, 115, 97,
-		115, 95, 115, 115, 95, 102, 108, 97, 103, 115, 0, 116, 97, 115, 107, 95,
-		119, 111, 114, 107, 115, 0, 97, 117, 100, 105, 116, 95, 99, 111, 110, 116,
-		101, 120, 116, 0, 108, 111, 103, 105, 110, 117, 105, 100, 0, 115, 101, 115,
-		115, 105, 111, 110, 105, 100, 0, 115, 101, 99, 99, 111, 109, 112, 0, 115,
-		121, 115, 99, 97, 108, 108, 95, 100, 105, 115, 112, 97, 116, 99, 104, 0,
-		112, 97, 114, 101, 110, 116, 95, 101, 120, 101, 99, 95, 105, 100, 0, 115,
-		101, 108, 102, 95, 101, 120, 101, 99, 95, 105, 100, 0, 97, 108, 108, 111,
-		99, 95, 108, 111, 99, 107, 0, 112, 105, 95, 108, 111, 99, 107, 0, 119,
-		97, 107, 101, 95, 113, 0, 112, 105, 95, 119, 97, 105, 116, 101, 114, 115,
-		0, 112, 105, 95, 116, 111, 112, 95, 116, 97, 115, 107, 0, 112, 105, 95,
-		98, 108, 111, 99, 107, 101, 100, 95, 111, 110, 0, 106, 111, 117, 114, 110,
-		97, 108, 95, 105, 110, 102, 111, 0, 98, 105, 111, 95, 108, 105, 115, 116,
-		0, 112, 108, 117, 103, 0, 114, 101, 99, 108, 97, 105, 109, 95, 115, 116,
-		97, 116, 101, 0, 98, 97, 99, 107, 105, 110, 103, 95, 100, 101, 118, 95,
-		105, 110, 102, 111, 0, 105, 111, 95, 99, 111, 110, 116, 101, 120, 116, 0,
-		99, 97, 112, 116, 117, 114, 101, 95, 99, 111, 110, 116, 114, 111, 108, 0,
-		112, 116, 114, 97, 99, 101, 95, 109, 101, 115, 115, 97, 103, 101, 0, 108,
-		97, 115, 116, 95, 115, 105, 103, 105, 110, 102, 111, 0, 105, 111, 97, 99,
-		0, 112, 115, 105, 95, 102, 108, 97, 103, 115, 0, 97, 99, 99, 116, 95,
-		114, 115, 115, 95, 109, 101, 109, 49, 0, 97, 99, 99, 116, 95, 118, 109,
-		95, 109, 101, 109, 49, 0, 97, 99, 99, 116, 95, 116, 105, 109, 101, 120,
-		112, 100, 0, 109, 101, 109, 115, 95, 97, 108, 108, 111, 119, 101, 100, 0,
-		109, 101, 109, 115, 95, 97, 108, 108, 111, 119, 101, 100, 95, 115, 101, 113,
-		0, 99, 112, 117, 115, 101, 116, 95, 109, 101, 109, 95, 115, 112, 114, 101,
-		97, 100, 95, 114, 111, 116, 111, 114, 0, 99, 112, 117, 115, 101, 116, 95,
-		115, 108, 97, 98, 95, 115, 112, 114, 101, 97, 100, 95, 114, 111, 116, 111,
-		114, 0, 99, 103, 114, 111, 117, 112, 115, 0, 99, 103, 95, 108, 105, 115,
-		116, 0, 114, 111, 98, 117, 115, 116, 95, 108, 105, 115, 116, 0, 99, 111,
-		109, 112, 97, 116, 95, 114, 111, 98, 117, 115, 116, 95, 108, 105, 115, 116,
-		0, 112, 105, 95, 115, 116, 97, 116, 101, 95, 108, 105, 115, 116, 0, 112,
-		105, 95, 115, 116, 97, 116, 101, 95, 99, 97, 99, 104, 101, 0, 102, 117,
-		116, 101, 120, 95, 101, 120, 105, 116, 95, 109, 117, 116, 101, 120, 0, 102,
-		117, 116, 101, 120, 95, 115, 116, 97, 116, 101, 0, 112, 101, 114, 102, 95,
-		101, 118, 101, 110, 116, 95, 99, 116, 120, 112, 0, 112, 101, 114, 102, 95,
-		101, 118, 101, 110, 116, 95, 109, 117, 116, 101, 120, 0, 112, 101, 114, 102,
-		95, 101, 118, 101, 110, 116, 95, 108, 105, 115, 116, 0, 112, 114, 101, 101,
-		109, 112, 116, 95, 100, 105, 115, 97, 98, 108, 101, 95, 105, 112, 0, 109,
-		101, 109, 112, 111, 108, 105, 99, 121, 0, 105, 108, 95, 112, 114, 101, 118,
-		0, 112, 114, 101, 102, 95, 110, 111, 100, 101, 95, 102, 111, 114, 107, 0,
-		110, 117, 109, 97, 95, 115, 99, 97, 110, 95, 115, 101, 113, 0, 110, 117,
-		109, 97, 95, 115, 99, 97, 110, 95, 112, 101, 114, 105, 111, 100, 0, 110,
-		117, 109, 97, 95, 115, 99, 97, 110, 95, 112, 101, 114, 105, 111, 100, 95,
-		109, 97, 120, 0, 110, 117, 109, 97, 95, 112, 114, 101, 102, 101, 114, 114,
-		101, 100, 95, 110, 105, 100, 0, 110, 117, 109, 97, 95, 109, 105, 103, 114,
-		97, 116, 101, 95, 114, 101, 116, 114, 121, 0, 110, 111, 100, 101, 95, 115,
-		116, 97, 109, 112, 0, 108, 97, 115, 116, 95, 116, 97, 115, 107, 95, 110,
-		117, 109, 97, 95, 112, 108, 97, 99, 101, 109, 101, 110, 116, 0, 108, 97,
-		115, 116, 95, 115, 117, 109, 95, 101, 120, 101, 99, 95, 114, 117, 110, 116,
-		105, 109, 101, 0, 110, 117, 109, 97, 95, 119, 111, 114, 107, 0, 110, 117,
-		109, 97, 95, 103, 114, 111, 117, 112, 0, 110, 117, 109, 97, 95, 102, 97,
-		117, 108, 116, 115, 0, 116, 111, 116, 97, 108, 95, 110, 117, 109, 97, 95,
-		102, 97, 117, 108, 116, 115, 0, 110, 117, 109, 97, 95, 102, 97, 117, 108,
-		116, 115, 95, 108, 111, 99, 97, 108, 105, 116, 121, 0, 110, 117, 109, 97,
-		95, 112, 97, 103, 101, 115, 95, 109, 105, 103, 114, 97, 116, 101, 100, 0,
-		114, 115, 101, 113, 0, 114, 115, 101, 113, 95, 115, 105, 103, 0, 114, 115,
-		101, 113, 95, 101, 118, 101, 110, 116, 95, 109, 97, 115, 107, 0, 116, 108,
-		98, 95, 117, 98, 99, 0, 115, 112, 108, 105, 99, 101, 95, 112, 105, 112,
-		101, 0, 116, 97, 115, 107, 95, 102, 114, 97, 103, 0, 100, 101, 108, 97,
-		121, 115, 0, 110, 114, 95, 100, 105, 114, 116, 105, 101, 100, 0, 110, 114,
-		95, 100, 105, 114, 116, 105, 101, 100, 95, 112, 97, 117, 115, 101, 0, 100,
-		105, 114, 116, 121, 95, 112, 97, 117, 115, 101, 100, 95, 119, 104, 101, 110,
-		0, 108, 97, 116, 101, 110, 99, 121, 95, 114, 101, 99, 111, 114, 100, 95,
-		99, 111, 117, 110, 116, 0, 108, 97, 116, 101, 110, 99, 121, 95, 114, 101,
-		99, 111, 114, 100, 0, 116, 105, 109, 101, 114, 95, 115, 108, 97, 99, 107,
-		95, 110, 115, 0, 100, 101, 102, 97, 117, 108, 116, 95, 116, 105, 109, 101,
-		114, 95, 115, 108, 97, 99, 107, 95, 110, 115, 0, 107, 117, 110, 105, 116,
-		95, 116, 101, 115, 116, 0, 99, 117, 114, 114, 95, 114, 101, 116, 95, 115,
-		116, 97, 99, 107, 0, 99, 117, 114, 114, 95, 114, 101, 116, 95, 100, 101,
-		112, 116, 104, 0, 114, 101, 116, 95, 115, 116, 97, 99, 107, 0, 102, 116,
-		114, 97, 99, 101, 95, 116, 105, 109, 101, 115, 116, 97, 109, 112, 0, 116,
-		114, 97, 99, 101, 95, 111, 118, 101, 114, 114, 117, 110, 0, 116, 114, 97,
-		99, 105, 110, 103, 95, 103, 114, 97, 112, 104, 95, 112, 97, 117, 115, 101,
-		0, 116, 114, 97, 99, 101, 0, 116, 114, 97, 99, 101, 95, 114, 101, 99,
-		117, 114, 115, 105, 111, 110, 0, 109, 101, 109, 99, 103, 95, 105, 110, 95,
-		111, 111, 109, 0, 109, 101, 109, 99, 103, 95, 111, 111, 109, 95, 103, 102,
-		112, 95, 109, 97, 115, 107, 0, 109, 101, 109, 99, 103, 95, 111, 111, 109,
-		95, 111, 114, 100, 101, 114, 0, 109, 101, 109, 99, 103, 95, 110, 114, 95,
-		112, 97, 103, 101, 115, 95, 111, 118, 101, 114, 95, 104, 105, 103, 104, 0,
-		97, 99, 116, 105, 118, 101, 95, 109, 101, 109, 99, 103, 0, 116, 104, 114,
-		111, 116, 116, 108, 101, 95, 113, 117, 101, 117, 101, 0, 117, 116, 97, 115,
-		107, 0, 115, 101, 113, 117, 101, 110, 116, 105, 97, 108, 95, 105, 111, 0,
-		115, 101, 113, 117, 101, 110, 116, 105, 97, 108, 95, 105, 111, 95, 97, 118,
-		103, 0, 107, 109, 97, 112, 95, 99, 116, 114, 108, 0, 112, 97, 103, 101,
-		102, 97, 117, 108, 116, 95, 100, 105, 115, 97, 98, 108, 101, 100, 0, 111,
-		111, 109, 95, 114, 101, 97, 112, 101, 114, 95, 108, 105, 115, 116, 0, 111,
-		111, 109, 95, 114, 101, 97, 112, 101, 114, 95, 116, 105, 109, 101, 114, 0,
-		115, 116, 97, 99, 107, 95, 118, 109, 95, 97, 114, 101, 97, 0, 115, 116,
-		97, 99, 107, 95, 114, 101, 102, 99, 111, 117, 110, 116, 0, 115, 101, 99,
-		117, 114, 105, 116, 121, 0, 98, 112, 102, 95, 115, 116, 111, 114, 97, 103,
-		101, 0, 98, 112, 102, 95, 99, 116, 120, 0, 107, 114, 101, 116, 112, 114,
-		111, 98, 101, 95, 105, 110, 115, 116, 97, 110, 99, 101, 115, 0, 116, 104,
-		114, 101, 97, 100, 0, 116, 116, 98, 114, 48, 0, 99, 112, 117, 0, 117,
-		54, 52, 0, 95, 95, 117, 54, 52, 0, 117, 110, 115, 105, 103, 110, 101,
-		100, 32, 108, 111, 110, 103, 32, 108, 111, 110, 103, 0, 112, 114, 101, 101,
-		109, 112, 116, 0, 99, 111, 117, 110, 116, 0, 110, 101, 101, 100, 95, 114,
-		101, 115, 99, 104, 101, 100, 0, 114, 101, 102, 99, 111, 117, 110, 116, 95,
-		116, 0, 114, 101, 102, 99, 111, 117, 110, 116, 95, 115, 116, 114, 117, 99,
-		116, 0, 114, 101, 102, 115, 0, 97, 116, 111, 109, 105, 99, 95, 116, 0,
-		99, 111, 117, 110, 116, 101, 114, 0, 95, 95, 99, 97, 108, 108, 95, 115,
-		105, 110, 103, 108, 101, 95, 110, 111, 100, 101, 0, 108, 108, 105, 115, 116,
-		0, 115, 114, 99, 0, 100, 115, 116, 0, 108, 108, 105, 115, 116, 95, 110,
-		111, 100, 101, 0, 110, 101, 120, 116, 0, 117, 95, 102, 108, 97, 103, 115,
-		0, 97, 95, 102, 108, 97, 103, 115, 0, 117, 49, 54, 0, 95, 95, 117,
-		49, 54, 0, 115, 99, 104, 101, 100, 95, 101, 110, 116, 105, 116, 121, 0,
-		108, 111, 97, 100, 0, 114, 117, 110, 95, 110, 111, 100, 101, 0, 103, 114,
-		111, 117, 112, 95, 110, 111, 100, 101, 0, 101, 120, 101, 99, 95, 115, 116,
-		97, 114, 116, 0, 115, 117, 109, 95, 101, 120, 101, 99, 95, 114, 117, 110,
-		116, 105, 109, 101, 0, 118, 114, 117, 110, 116, 105, 109, 101, 0, 112, 114,
-		101, 118, 95, 115, 117, 109, 95, 101, 120, 101, 99, 95, 114, 117, 110, 116,
-		105, 109, 101, 0, 110, 114, 95, 109, 105, 103, 114, 97, 116, 105, 111, 110,
-		115, 0, 100, 101, 112, 116, 104, 0, 99, 102, 115, 95, 114, 113, 0, 109,
-		121, 95, 113, 0, 114, 117, 110, 110, 97, 98, 108, 101, 95, 119, 101, 105,
-		103, 104, 116, 0, 97, 118, 103, 0, 108, 111, 97, 100, 95, 119, 101, 105,
-		103, 104, 116, 0, 119, 101, 105, 103, 104, 116, 0, 105, 110, 118, 95, 119,
-		101, 105, 103, 104, 116, 0, 114, 98, 95, 110, 111, 100, 101, 0, 95, 95,
-		114, 98, 95, 112, 97, 114, 101, 110, 116, 95, 99, 111, 108, 111, 114, 0,
-		114, 98, 95, 114, 105, 103, 104, 116, 0, 114, 98, 95, 108, 101, 102, 116,
-		0, 108, 105, 115, 116, 95, 104, 101, 97, 100, 0, 112, 114, 101, 118, 0,
-		110, 114, 95, 114, 117, 110, 110, 105, 110, 103, 0, 104, 95, 110, 114, 95,
-		114, 117, 110, 110, 105, 110, 103, 0, 105, 100, 108, 101, 95, 110, 114, 95,
-		114, 117, 110, 110, 105, 110, 103, 0, 105, 100, 108, 101, 95, 104, 95, 110,
-		114, 95, 114, 117, 110, 110, 105, 110, 103, 0, 101, 120, 101, 99, 95, 99,
-		108, 111, 99, 107, 0, 109, 105, 110, 95, 118, 114, 117, 110, 116, 105, 109,
-		101, 0, 102, 111, 114, 99, 101, 105, 100, 108, 101, 95, 115, 101, 113, 0,
-		109, 105, 110, 95, 118, 114, 117, 110, 116, 105, 109, 101, 95, 102, 105, 0,
-		116, 97, 115, 107, 115, 95, 116, 105, 109, 101, 108, 105, 110, 101, 0, 99,
-		117, 114, 114, 0, 108, 97, 115, 116, 0, 115, 107, 105, 112, 0, 110, 114,
-		95, 115, 112, 114, 101, 97, 100, 95, 111, 118, 101, 114, 0, 114, 101, 109,
-		111, 118, 101, 100, 0, 116, 103, 95, 108, 111, 97, 100, 95, 97, 118, 103,
-		95, 99, 111, 110, 116, 114, 105, 98, 0, 112, 114, 111, 112, 97, 103, 97,
-		116, 101, 0, 112, 114, 111, 112, 95, 114, 117, 110, 110, 97, 98, 108, 101,
-		95, 115, 117, 109, 0, 104, 95, 108, 111, 97, 100, 0, 108, 97, 115, 116,
-		95, 104, 95, 108, 111, 97, 100, 95, 117, 112, 100, 97, 116, 101, 0, 104,
-		95, 108, 111, 97, 100, 95, 110, 101, 120, 116, 0, 114, 113, 0, 111, 110,
-		95, 108, 105, 115, 116, 0, 108, 101, 97, 102, 95, 99, 102, 115, 95, 114,
-		113, 95, 108, 105, 115, 116, 0, 116, 103, 0, 105, 100, 108, 101, 0, 114,
-		117, 110, 116, 105, 109, 101, 95, 101, 110, 97, 98, 108, 101, 100, 0, 114,
-		117, 110, 116, 105, 109, 101, 95, 114, 101, 109, 97, 105, 110, 105, 110, 103,
-		0, 116, 104, 114, 111, 116, 116, 108, 101, 100, 95, 112, 101, 108, 116, 95,
-		105, 100, 108, 101, 0, 116, 104, 114, 111, 116, 116, 108, 101, 100, 95, 99,
-		108, 111, 99, 107, 0, 116, 104, 114, 111, 116, 116, 108, 101, 100, 95, 99,
-		108, 111, 99, 107, 95, 112, 101, 108, 116, 0, 116, 104, 114, 111, 116, 116,
-		108, 101, 100, 95, 99, 108, 111, 99, 107, 95, 112, 101, 108, 116, 95, 116,
-		105, 109, 101, 0, 116, 104, 114, 111, 116, 116, 108, 101, 100, 0, 116, 104,
-		114, 111, 116, 116, 108, 101, 95, 99, 111, 117, 110, 116, 0, 116, 104, 114,
-		111, 116, 116, 108, 101, 100, 95, 108, 105, 115, 116, 0, 114, 98, 95, 114,
-		111, 111, 116, 95, 99, 97, 99, 104, 101, 100, 0, 114, 98, 95, 114, 111,
-		111, 116, 0, 114, 98, 95, 108, 101, 102, 116, 109, 111, 115, 116, 0, 115,
-		99, 104, 101, 100, 95, 97, 118, 103, 0, 108, 97, 115, 116, 95, 117, 112,
-		100, 97, 116, 101, 95, 116, 105, 109, 101, 0, 108, 111, 97, 100, 95, 115,
-		117, 109, 0, 114, 117, 110, 110, 97, 98, 108, 101, 95, 115, 117, 109, 0,
-		117, 116, 105, 108, 95, 115, 117, 109, 0, 112, 101, 114, 105, 111, 100, 95,
-		99, 111, 110, 116, 114, 105, 98, 0, 108, 111, 97, 100, 95, 97, 118, 103,
-		0, 114, 117, 110, 110, 97, 98, 108, 101, 95, 97, 118, 103, 0, 117, 116,
-		105, 108, 95, 97, 118, 103, 0, 117, 116, 105, 108, 95, 101, 115, 116, 0,
-		101, 110, 113, 117, 101, 117, 101, 100, 0, 101, 119, 109, 97, 0, 108, 111,
-		99, 107, 0, 110, 114, 0, 114, 97, 119, 95, 115, 112, 105, 110, 108, 111,
-		99, 107, 95, 116, 0, 114, 97, 119, 95, 115, 112, 105, 110, 108, 111, 99,
-		107, 0, 114, 97, 119, 95, 108, 111, 99, 107, 0, 97, 114, 99, 104, 95,
-		115, 112, 105, 110, 108, 111, 99, 107, 95, 116, 0, 113, 115, 112, 105, 110,
-		108, 111, 99, 107, 0, 118, 97, 108, 0, 108, 111, 99, 107, 101, 100, 0,
-		108, 111, 99, 107, 101, 100, 95, 112, 101, 110, 100, 105, 110, 103, 0, 116,
-		97, 105, 108, 0, 115, 54, 52, 0, 95, 95, 115, 54, 52, 0, 108, 111,
-		110, 103, 32, 108, 111, 110, 103, 0, 115, 99, 104, 101, 100, 95, 114, 116,
-		95, 101, 110, 116, 105, 116, 121, 0, 114, 117, 110, 95, 108, 105, 115, 116,
-		0, 116, 105, 109, 101, 111, 117, 116, 0, 119, 97, 116, 99, 104, 100, 111,
-		103, 95, 115, 116, 97, 109, 112, 0, 116, 105, 109, 101, 95, 115, 108, 105,
-		99, 101, 0, 98, 97, 99, 107, 0, 115, 99, 104, 101, 100, 95, 100, 108,
-		95, 101, 110, 116, 105, 116, 121, 0, 100, 108, 95, 114, 117, 110, 116, 105,
-		109, 101, 0, 100, 108, 95, 100, 101, 97, 100, 108, 105, 110, 101, 0, 100,
-		108, 95, 112, 101, 114, 105, 111, 100, 0, 100, 108, 95, 98, 119, 0, 100,
-		108, 95, 100, 101, 110, 115, 105, 116, 121, 0, 114, 117, 110, 116, 105, 109,
-		101, 0, 100, 101, 97, 100, 108, 105, 110, 101, 0, 100, 108, 95, 116, 104,
-		114, 111, 116, 116, 108, 101, 100, 0, 100, 108, 95, 121, 105, 101, 108, 100,
-		101, 100, 0, 100, 108, 95, 110, 111, 110, 95, 99, 111, 110, 116, 101, 110,
-		100, 105, 110, 103, 0, 100, 108, 95, 111, 118, 101, 114, 114, 117, 110, 0,
-		100, 108, 95, 116, 105, 109, 101, 114, 0, 105, 110, 97, 99, 116, 105, 118,
-		101, 95, 116, 105, 109, 101, 114, 0, 112, 105, 95, 115, 101, 0, 104, 114,
+		99, 95, 98, 108, 107, 100, 95, 110, 111, 100, 101, 0, 116, 114, 99, 95,
+		98, 108, 107, 100, 95, 99, 112, 117, 0, 115, 99, 104, 101, 100, 95, 105,
+		110, 102, 111, 0, 116, 97, 115, 107, 115, 0, 112, 117, 115, 104, 97, 98,
+		108, 101, 95, 116, 97, 115, 107, 115, 0, 112, 117, 115, 104, 97, 98, 108,
+		101, 95, 100, 108, 95, 116, 97, 115, 107, 115, 0, 109, 109, 0, 97, 99,
+		116, 105, 118, 101, 95, 109, 109, 0, 118, 109, 97, 99, 97, 99, 104, 101,
+		0, 114, 115, 115, 95, 115, 116, 97, 116, 0, 101, 120, 105, 116, 95, 115,
+		116, 97, 116, 101, 0, 101, 120, 105, 116, 95, 99, 111, 100, 101, 0, 101,
+		120, 105, 116, 95, 115, 105, 103, 110, 97, 108, 0, 112, 100, 101, 97, 116,
+		104, 95, 115, 105, 103, 110, 97, 108, 0, 106, 111, 98, 99, 116, 108, 0,
+		112, 101, 114, 115, 111, 110, 97, 108, 105, 116, 121, 0, 115, 99, 104, 101,
+		100, 95, 114, 101, 115, 101, 116, 95, 111, 110, 95, 102, 111, 114, 107, 0,
+		115, 99, 104, 101, 100, 95, 99, 111, 110, 116, 114, 105, 98, 117, 116, 101,
+		115, 95, 116, 111, 95, 108, 111, 97, 100, 0, 115, 99, 104, 101, 100, 95,
+		109, 105, 103, 114, 97, 116, 101, 100, 0, 115, 99, 104, 101, 100, 95, 112,
+		115, 105, 95, 119, 97, 107, 101, 95, 114, 101, 113, 117, 101, 117, 101, 0,
+		115, 99, 104, 101, 100, 95, 114, 101, 109, 111, 116, 101, 95, 119, 97, 107,
+		101, 117, 112, 0, 105, 110, 95, 101, 120, 101, 99, 118, 101, 0, 105, 110,
+		95, 105, 111, 119, 97, 105, 116, 0, 105, 110, 95, 117, 115, 101, 114, 95,
+		102, 97, 117, 108, 116, 0, 110, 111, 95, 99, 103, 114, 111, 117, 112, 95,
+		109, 105, 103, 114, 97, 116, 105, 111, 110, 0, 102, 114, 111, 122, 101, 110,
+		0, 117, 115, 101, 95, 109, 101, 109, 100, 101, 108, 97, 121, 0, 105, 110,
+		95, 109, 101, 109, 115, 116, 97, 108, 108, 0, 105, 110, 95, 112, 97, 103,
+		101, 95, 111, 119, 110, 101, 114, 0, 105, 110, 95, 101, 118, 101, 110, 116,
+		102, 100, 0, 112, 97, 115, 105, 100, 95, 97, 99, 116, 105, 118, 97, 116,
+		101, 100, 0, 97, 116, 111, 109, 105, 99, 95, 102, 108, 97, 103, 115, 0,
+		114, 101, 115, 116, 97, 114, 116, 95, 98, 108, 111, 99, 107, 0, 116, 103,
+		105, 100, 0, 114, 101, 97, 108, 95, 112, 97, 114, 101, 110, 116, 0, 112,
+		97, 114, 101, 110, 116, 0, 99, 104, 105, 108, 100, 114, 101, 110, 0, 115,
+		105, 98, 108, 105, 110, 103, 0, 103, 114, 111, 117, 112, 95, 108, 101, 97,
+		100, 101, 114, 0, 112, 116, 114, 97, 99, 101, 100, 0, 112, 116, 114, 97,
+		99, 101, 95, 101, 110, 116, 114, 121, 0, 116, 104, 114, 101, 97, 100, 95,
+		112, 105, 100, 0, 112, 105, 100, 95, 108, 105, 110, 107, 115, 0, 116, 104,
+		114, 101, 97, 100, 95, 103, 114, 111, 117, 112, 0, 116, 104, 114, 101, 97,
+		100, 95, 110, 111, 100, 101, 0, 118, 102, 111, 114, 107, 95, 100, 111, 110,
+		101, 0, 115, 101, 116, 95, 99, 104, 105, 108, 100, 95, 116, 105, 100, 0,
+		99, 108, 101, 97, 114, 95, 99, 104, 105, 108, 100, 95, 116, 105, 100, 0,
+		119, 111, 114, 107, 101, 114, 95, 112, 114, 105, 118, 97, 116, 101, 0, 117,
+		116, 105, 109, 101, 0, 115, 116, 105, 109, 101, 0, 103, 116, 105, 109, 101,
+		0, 112, 114, 101, 118, 95, 99, 112, 117, 116, 105, 109, 101, 0, 118, 116,
+		105, 109, 101, 0, 116, 105, 99, 107, 95, 100, 101, 112, 95, 109, 97, 115,
+		107, 0, 110, 118, 99, 115, 119, 0, 110, 105, 118, 99, 115, 119, 0, 115,
+		116, 97, 114, 116, 95, 116, 105, 109, 101, 0, 115, 116, 97, 114, 116, 95,
+		98, 111, 111, 116, 116, 105, 109, 101, 0, 109, 105, 110, 95, 102, 108, 116,
+		0, 109, 97, 106, 95, 102, 108, 116, 0, 112, 111, 115, 105, 120, 95, 99,
+		112, 117, 116, 105, 109, 101, 114, 115, 0, 112, 111, 115, 105, 120, 95, 99,
+		112, 117, 116, 105, 109, 101, 114, 115, 95, 119, 111, 114, 107, 0, 112, 116,
+		114, 97, 99, 101, 114, 95, 99, 114, 101, 100, 0, 114, 101, 97, 108, 95,
+		99, 114, 101, 100, 0, 99, 114, 101, 100, 0, 99, 97, 99, 104, 101, 100,
+		95, 114, 101, 113, 117, 101, 115, 116, 101, 100, 95, 107, 101, 121, 0, 99,
+		111, 109, 109, 0, 110, 97, 109, 101, 105, 100, 97, 116, 97, 0, 115, 121,
+		115, 118, 115, 101, 109, 0, 115, 121, 115, 118, 115, 104, 109, 0, 102, 115,
+		0, 102, 105, 108, 101, 115, 0, 105, 111, 95, 117, 114, 105, 110, 103, 0,
+		110, 115, 112, 114, 111, 120, 121, 0, 115, 105, 103, 110, 97, 108, 0, 115,
+		105, 103, 104, 97, 110, 100, 0, 98, 108, 111, 99, 107, 101, 100, 0, 114,
+		101, 97, 108, 95, 98, 108, 111, 99, 107, 101, 100, 0, 115, 97, 118, 101,
+		100, 95, 115, 105, 103, 109, 97, 115, 107, 0, 112, 101, 110, 100, 105, 110,
+		103, 0, 115, 97, 115, 95, 115, 115, 95, 115, 112, 0, 115, 97, 115, 95,
+		115, 115, 95, 115, 105, 122, 101, 0, 115, 97, 115, 95, 115, 115, 95, 102,
+		108, 97, 103, 115, 0, 116, 97, 115, 107, 95, 119, 111, 114, 107, 115, 0,
+		97, 117, 100, 105, 116, 95, 99, 111, 110, 116, 101, 120, 116, 0, 108, 111,
+		103, 105, 110, 117, 105, 100, 0, 115, 101, 115, 115, 105, 111, 110, 105, 100,
+		0, 115, 101, 99, 99, 111, 109, 112, 0, 115, 121, 115, 99, 97, 108, 108,
+		95, 100, 105, 115, 112, 97, 116, 99, 104, 0, 112, 97, 114, 101, 110, 116,
+		95, 101, 120, 101, 99, 95, 105, 100, 0, 115, 101, 108, 102, 95, 101, 120,
+		101, 99, 95, 105, 100, 0, 97, 108, 108, 111, 99, 95, 108, 111, 99, 107,
+		0, 112, 105, 95, 108, 111, 99, 107, 0, 119, 97, 107, 101, 95, 113, 0,
+		112, 105, 95, 119, 97, 105, 116, 101, 114, 115, 0, 112, 105, 95, 116, 111,
+		112, 95, 116, 97, 115, 107, 0, 112, 105, 95, 98, 108, 111, 99, 107, 101,
+		100, 95, 111, 110, 0, 106, 111, 117, 114, 110, 97, 108, 95, 105, 110, 102,
+		111, 0, 98, 105, 111, 95, 108, 105, 115, 116, 0, 112, 108, 117, 103, 0,
+		114, 101, 99, 108, 97, 105, 109, 95, 115, 116, 97, 116, 101, 0, 98, 97,
+		99, 107, 105, 110, 103, 95, 100, 101, 118, 95, 105, 110, 102, 111, 0, 105,
+		111, 95, 99, 111, 110, 116, 101, 120, 116, 0, 99, 97, 112, 116, 117, 114,
+		101, 95, 99, 111, 110, 116, 114, 111, 108, 0, 112, 116, 114, 97, 99, 101,
+		95, 109, 101, 115, 115, 97, 103, 101, 0, 108, 97, 115, 116, 95, 115, 105,
+		103, 105, 110, 102, 111, 0, 105, 111, 97, 99, 0, 112, 115, 105, 95, 102,
+		108, 97, 103, 115, 0, 97, 99, 99, 116, 95, 114, 115, 115, 95, 109, 101,
+		109, 49, 0, 97, 99, 99, 116, 95, 118, 109, 95, 109, 101, 109, 49, 0,
+		97, 99, 99, 116, 95, 116, 105, 109, 101, 120, 112, 100, 0, 109, 101, 109,
+		115, 95, 97, 108, 108, 111, 119, 101, 100, 0, 109, 101, 109, 115, 95, 97,
+		108, 108, 111, 119, 101, 100, 95, 115, 101, 113, 0, 99, 112, 117, 115, 101,
+		116, 95, 109, 101, 109, 95, 115, 112, 114, 101, 97, 100, 95, 114, 111, 116,
+		111, 114, 0, 99, 112, 117, 115, 101, 116, 95, 115, 108, 97, 98, 95, 115,
+		112, 114, 101, 97, 100, 95, 114, 111, 116, 111, 114, 0, 99, 103, 114, 111,
+		117, 112, 115, 0, 99, 103, 95, 108, 105, 115, 116, 0, 114, 111, 98, 117,
+		115, 116, 95, 108, 105, 115, 116, 0, 99, 111, 109, 112, 97, 116, 95, 114,
+		111, 98, 117, 115, 116, 95, 108, 105, 115, 116, 0, 112, 105, 95, 115, 116,
+		97, 116, 101, 95, 108, 105, 115, 116, 0, 112, 105, 95, 115, 116, 97, 116,
+		101, 95, 99, 97, 99, 104, 101, 0, 102, 117, 116, 101, 120, 95, 101, 120,
+		105, 116, 95, 109, 117, 116, 101, 120, 0, 102, 117, 116, 101, 120, 95, 115,
+		116, 97, 116, 101, 0, 112, 101, 114, 102, 95, 101, 118, 101, 110, 116, 95,
+		99, 116, 120, 112, 0, 112, 101, 114, 102, 95, 101, 118, 101, 110, 116, 95,
+		109, 117, 116, 101, 120, 0, 112, 101, 114, 102, 95, 101, 118, 101, 110, 116,
+		95, 108, 105, 115, 116, 0, 112, 114, 101, 101, 109, 112, 116, 95, 100, 105,
+		115, 97, 98, 108, 101, 95, 105, 112, 0, 109, 101, 109, 112, 111, 108, 105,
+		99, 121, 0, 105, 108, 95, 112, 114, 101, 118, 0, 112, 114, 101, 102, 95,
+		110, 111, 100, 101, 95, 102, 111, 114, 107, 0, 110, 117, 109, 97, 95, 115,
+		99, 97, 110, 95, 115, 101, 113, 0, 110, 117, 109, 97, 95, 115, 99, 97,
+		110, 95, 112, 101, 114, 105, 111, 100, 0, 110, 117, 109, 97, 95, 115, 99,
+		97, 110, 95, 112, 101, 114, 105, 111, 100, 95, 109, 97, 120, 0, 110, 117,
+		109, 97, 95, 112, 114, 101, 102, 101, 114, 114, 101, 100, 95, 110, 105, 100,
+		0, 110, 117, 109, 97, 95, 109, 105, 103, 114, 97, 116, 101, 95, 114, 101,
+		116, 114, 121, 0, 110, 111, 100, 101, 95, 115, 116, 97, 109, 112, 0, 108,
+		97, 115, 116, 95, 116, 97, 115, 107, 95, 110, 117, 109, 97, 95, 112, 108,
+		97, 99, 101, 109, 101, 110, 116, 0, 108, 97, 115, 116, 95, 115, 117, 109,
+		95, 101, 120, 101, 99, 95, 114, 117, 110, 116, 105, 109, 101, 0, 110, 117,
+		109, 97, 95, 119, 111, 114, 107, 0, 110, 117, 109, 97, 95, 103, 114, 111,
+		117, 112, 0, 110, 117, 109, 97, 95, 102, 97, 117, 108, 116, 115, 0, 116,
+		111, 116, 97, 108, 95, 110, 117, 109, 97, 95, 102, 97, 117, 108, 116, 115,
+		0, 110, 117, 109, 97, 95, 102, 97, 117, 108, 116, 115, 95, 108, 111, 99,
+		97, 108, 105, 116, 121, 0, 110, 117, 109, 97, 95, 112, 97, 103, 101, 115,
+		95, 109, 105, 103, 114, 97, 116, 101, 100, 0, 114, 115, 101, 113, 0, 114,
+		115, 101, 113, 95, 115, 105, 103, 0, 114, 115, 101, 113, 95, 101, 118, 101,
+		110, 116, 95, 109, 97, 115, 107, 0, 116, 108, 98, 95, 117, 98, 99, 0,
+		115, 112, 108, 105, 99, 101, 95, 112, 105, 112, 101, 0, 116, 97, 115, 107,
+		95, 102, 114, 97, 103, 0, 100, 101, 108, 97, 121, 115, 0, 110, 114, 95,
+		100, 105, 114, 116, 105, 101, 100, 0, 110, 114, 95, 100, 105, 114, 116, 105,
+		101, 100, 95, 112, 97, 117, 115, 101, 0, 100, 105, 114, 116, 121, 95, 112,
+		97, 117, 115, 101, 100, 95, 119, 104, 101, 110, 0, 108, 97, 116, 101, 110,
+		99, 121, 95, 114, 101, 99, 111, 114, 100, 95, 99, 111, 117, 110, 116, 0,
+		108, 97, 116, 101, 110, 99, 121, 95, 114, 101, 99, 111, 114, 100, 0, 116,
+		105, 109, 101, 114, 95, 115, 108, 97, 99, 107, 95, 110, 115, 0, 100, 101,
+		102, 97, 117, 108, 116, 95, 116, 105, 109, 101, 114, 95, 115, 108, 97, 99,
+		107, 95, 110, 115, 0, 107, 117, 110, 105, 116, 95, 116, 101, 115, 116, 0,
+		99, 117, 114, 114, 95, 114, 101, 116, 95, 115, 116, 97, 99, 107, 0, 99,
+		117, 114, 114, 95, 114, 101, 116, 95, 100, 101, 112, 116, 104, 0, 114, 101,
+		116, 95, 115, 116, 97, 99, 107, 0, 102, 116, 114, 97, 99, 101, 95, 116,
+		105, 109, 101, 115, 116, 97, 109, 112, 0, 116, 114, 97, 99, 101, 95, 111,
+		118, 101, 114, 114, 117, 110, 0, 116, 114, 97, 99, 105, 110, 103, 95, 103,
+		114, 97, 112, 104, 95, 112, 97, 117, 115, 101, 0, 116, 114, 97, 99, 101,
+		0, 116, 114, 97, 99, 101, 95, 114, 101, 99, 117, 114, 115, 105, 111, 110,
+		0, 109, 101, 109, 99, 103, 95, 105, 110, 95, 111, 111, 109, 0, 109, 101,
+		109, 99, 103, 95, 111, 111, 109, 95, 103, 102, 112, 95, 109, 97, 115, 107,
+		0, 109, 101, 109, 99, 103, 95, 111, 111, 109, 95, 111, 114, 100, 101, 114,
+		0, 109, 101, 109, 99, 103, 95, 110, 114, 95, 112, 97, 103, 101, 115, 95,
+		111, 118, 101, 114, 95, 104, 105, 103, 104, 0, 97, 99, 116, 105, 118, 101,
+		95, 109, 101, 109, 99, 103, 0, 116, 104, 114, 111, 116, 116, 108, 101, 95,
+		113, 117, 101, 117, 101, 0, 117, 116, 97, 115, 107, 0, 115, 101, 113, 117,
+		101, 110, 116, 105, 97, 108, 95, 105, 111, 0, 115, 101, 113, 117, 101, 110,
+		116, 105, 97, 108, 95, 105, 111, 95, 97, 118, 103, 0, 107, 109, 97, 112,
+		95, 99, 116, 114, 108, 0, 112, 97, 103, 101, 102, 97, 117, 108, 116, 95,
+		100, 105, 115, 97, 98, 108, 101, 100, 0, 111, 111, 109, 95, 114, 101, 97,
+		112, 101, 114, 95, 108, 105, 115, 116, 0, 111, 111, 109, 95, 114, 101, 97,
+		112, 101, 114, 95, 116, 105, 109, 101, 114, 0, 115, 116, 97, 99, 107, 95,
+		118, 109, 95, 97, 114, 101, 97, 0, 115, 116, 97, 99, 107, 95, 114, 101,
+		102, 99, 111, 117, 110, 116, 0, 115, 101, 99, 117, 114, 105, 116, 121, 0,
+		98, 112, 102, 95, 115, 116, 111, 114, 97, 103, 101, 0, 98, 112, 102, 95,
+		99, 116, 120, 0, 107, 114, 101, 116, 112, 114, 111, 98, 101, 95, 105, 110,
+		115, 116, 97, 110, 99, 101, 115, 0, 116, 104, 114, 101, 97, 100, 0, 116,
+		116, 98, 114, 48, 0, 99, 112, 117, 0, 112, 114, 101, 101, 109, 112, 116,
+		0, 99, 111, 117, 110, 116, 0, 110, 101, 101, 100, 95, 114, 101, 115, 99,
+		104, 101, 100, 0, 114, 101, 102, 99, 111, 117, 110, 116, 95, 116, 0, 114,
+		101, 102, 99, 111, 117, 110, 116, 95, 115, 116, 114, 117, 99, 116, 0, 114,
+		101, 102, 115, 0, 97, 116, 111, 109, 105, 99, 95, 116, 0, 99, 111, 117,
+		110, 116, 101, 114, 0, 95, 95, 99, 97, 108, 108, 95, 115, 105, 110, 103,
+		108, 101, 95, 110, 111, 100, 101, 0, 108, 108, 105, 115, 116, 0, 115, 114,
+		99, 0, 100, 115, 116, 0, 108, 108, 105, 115, 116, 95, 110, 111, 100, 101,
+		0, 110, 101, 120, 116, 0, 117, 95, 102, 108, 97, 103, 115, 0, 97, 95,
+		102, 108, 97, 103, 115, 0, 117, 49, 54, 0, 95, 95, 117, 49, 54, 0,
+		115, 99, 104, 101, 100, 95, 101, 110, 116, 105, 116, 121, 0, 108, 111, 97,
+		100, 0, 114, 117, 110, 95, 110, 111, 100, 101, 0, 103, 114, 111, 117, 112,
+		95, 110, 111, 100, 101, 0, 101, 120, 101, 99, 95, 115, 116, 97, 114, 116,
+		0, 115, 117, 109, 95, 101, 120, 101, 99, 95, 114, 117, 110, 116, 105, 109,
+		101, 0, 118, 114, 117, 110, 116, 105, 109, 101, 0, 112, 114, 101, 118, 95,
+		115, 117, 109, 95, 101, 120, 101, 99, 95, 114, 117, 110, 116, 105, 109, 101,
+		0, 110, 114, 95, 109, 105, 103, 114, 97, 116, 105, 111, 110, 115, 0, 100,
+		101, 112, 116, 104, 0, 99, 102, 115, 95, 114, 113, 0, 109, 121, 95, 113,
+		0, 114, 117, 110, 110, 97, 98, 108, 101, 95, 119, 101, 105, 103, 104, 116,
+		0, 97, 118, 103, 0, 108, 111, 97, 100, 95, 119, 101, 105, 103, 104, 116,
+		0, 119, 101, 105, 103, 104, 116, 0, 105, 110, 118, 95, 119, 101, 105, 103,
+		104, 116, 0, 114, 98, 95, 110, 111, 100, 101, 0, 95, 95, 114, 98, 95,
+		112, 97, 114, 101, 110, 116, 95, 99, 111, 108, 111, 114, 0, 114, 98, 95,
+		114, 105, 103, 104, 116, 0, 114, 98, 95, 108, 101, 102, 116, 0, 108, 105,
+		115, 116, 95, 104, 101, 97, 100, 0, 112, 114, 101, 118, 0, 115, 99, 104,
+		101, 100, 95, 97, 118, 103, 0, 108, 97, 115, 116, 95, 117, 112, 100, 97,
+		116, 101, 95, 116, 105, 109, 101, 0, 108, 111, 97, 100, 95, 115, 117, 109,
+		0, 114, 117, 110, 110, 97, 98, 108, 101, 95, 115, 117, 109, 0, 117, 116,
+		105, 108, 95, 115, 117, 109, 0, 112, 101, 114, 105, 111, 100, 95, 99, 111,
+		110, 116, 114, 105, 98, 0, 108, 111, 97, 100, 95, 97, 118, 103, 0, 114,
+		117, 110, 110, 97, 98, 108, 101, 95, 97, 118, 103, 0, 117, 116, 105, 108,
+		95, 97, 118, 103, 0, 117, 116, 105, 108, 95, 101, 115, 116, 0, 101, 110,
+		113, 117, 101, 117, 101, 100, 0, 101, 119, 109, 97, 0, 115, 99, 104, 101,
+		100, 95, 114, 116, 95, 101, 110, 116, 105, 116, 121, 0, 114, 117, 110, 95,
+		108, 105, 115, 116, 0, 116, 105, 109, 101, 111, 117, 116, 0, 119, 97, 116,
+		99, 104, 100, 111, 103, 95, 115, 116, 97, 109, 112, 0, 116, 105, 109, 101,
+		95, 115, 108, 105, 99, 101, 0, 111, 110, 95, 108, 105, 115, 116, 0, 98,
+		97, 99, 107, 0, 115, 99, 104, 101, 100, 95, 100, 108, 95, 101, 110, 116,
+		105, 116, 121, 0, 100, 108, 95, 114, 117, 110, 116, 105, 109, 101, 0, 100,
+		108, 95, 100, 101, 97, 100, 108, 105, 110, 101, 0, 100, 108, 95, 112, 101,
+		114, 105, 111, 100, 0, 100, 108, 95, 98, 119, 0, 100, 108, 95, 100, 101,
+		110, 115, 105, 116, 121, 0, 114, 117, 110, 116, 105, 109, 101, 0, 100, 101,
+		97, 100, 108, 105, 110, 101, 0, 100, 108, 95, 116, 104, 114, 111, 116, 116,
+		108, 101, 100, 0, 100, 108, 95, 121, 105, 101, 108, 100, 101, 100, 0, 100,
+		108, 95, 110, 111, 110, 95, 99, 111, 110, 116, 101, 110, 100, 105, 110, 103,
+		0, 100, 108, 95, 111, 118, 101, 114, 114, 117, 110, 0, 100, 108, 95, 116,
+		105, 109, 101, 114, 0, 105, 110, 97, 99, 116, 105, 118, 101, 95, 116, 105,
+		109, 101, 114, 0, 112, 105, 95, 115, 101, 0, 115, 54, 52, 0, 95, 95,
+		115, 54, 52, 0, 108, 111, 110, 103, 32, 108, 111, 110, 103, 0, 104, 114,
 		116, 105, 109, 101, 114, 0, 110, 111, 100, 101, 0, 95, 115, 111, 102, 116,
 		101, 120, 112, 105, 114, 101, 115, 0, 102, 117, 110, 99, 116, 105, 111, 110,
 		0, 98, 97, 115, 101, 0, 115, 116, 97, 116, 101, 0, 105, 115, 95, 114,
@@ -7652,158 +4090,46 @@ var bpfObjects = map[string][]byte{
 		95, 116, 0, 104, 114, 116, 105, 109, 101, 114, 95, 114, 101, 115, 116, 97,
 		114, 116, 0, 72, 82, 84, 73, 77, 69, 82, 95, 78, 79, 82, 69, 83,
 		84, 65, 82, 84, 0, 72, 82, 84, 73, 77, 69, 82, 95, 82, 69, 83,
-		84, 65, 82, 84, 0, 116, 97, 115, 107, 95, 103, 114, 111, 117, 112, 0,
-		99, 115, 115, 0, 115, 104, 97, 114, 101, 115, 0, 114, 99, 117, 0, 108,
-		105, 115, 116, 0, 115, 105, 98, 108, 105, 110, 103, 115, 0, 97, 117, 116,
-		111, 103, 114, 111, 117, 112, 0, 99, 102, 115, 95, 98, 97, 110, 100, 119,
-		105, 100, 116, 104, 0, 99, 103, 114, 111, 117, 112, 95, 115, 117, 98, 115,
-		121, 115, 95, 115, 116, 97, 116, 101, 0, 99, 103, 114, 111, 117, 112, 0,
-		115, 115, 0, 114, 101, 102, 99, 110, 116, 0, 114, 115, 116, 97, 116, 95,
-		99, 115, 115, 95, 110, 111, 100, 101, 0, 115, 101, 114, 105, 97, 108, 95,
-		110, 114, 0, 111, 110, 108, 105, 110, 101, 95, 99, 110, 116, 0, 100, 101,
-		115, 116, 114, 111, 121, 95, 119, 111, 114, 107, 0, 100, 101, 115, 116, 114,
-		111, 121, 95, 114, 119, 111, 114, 107, 0, 112, 101, 114, 99, 112, 117, 95,
-		114, 101, 102, 0, 112, 101, 114, 99, 112, 117, 95, 99, 111, 117, 110, 116,
-		95, 112, 116, 114, 0, 100, 97, 116, 97, 0, 119, 111, 114, 107, 95, 115,
-		116, 114, 117, 99, 116, 0, 101, 110, 116, 114, 121, 0, 102, 117, 110, 99,
-		0, 97, 116, 111, 109, 105, 99, 95, 108, 111, 110, 103, 95, 116, 0, 97,
-		116, 111, 109, 105, 99, 54, 52, 95, 116, 0, 119, 111, 114, 107, 95, 102,
-		117, 110, 99, 95, 116, 0, 114, 99, 117, 95, 119, 111, 114, 107, 0, 119,
-		111, 114, 107, 0, 119, 113, 0, 99, 97, 108, 108, 98, 97, 99, 107, 95,
-		104, 101, 97, 100, 0, 112, 101, 114, 105, 111, 100, 0, 113, 117, 111, 116,
-		97, 0, 98, 117, 114, 115, 116, 0, 114, 117, 110, 116, 105, 109, 101, 95,
-		115, 110, 97, 112, 0, 104, 105, 101, 114, 97, 114, 99, 104, 105, 99, 97,
-		108, 95, 113, 117, 111, 116, 97, 0, 112, 101, 114, 105, 111, 100, 95, 97,
-		99, 116, 105, 118, 101, 0, 115, 108, 97, 99, 107, 95, 115, 116, 97, 114,
-		116, 101, 100, 0, 112, 101, 114, 105, 111, 100, 95, 116, 105, 109, 101, 114,
-		0, 115, 108, 97, 99, 107, 95, 116, 105, 109, 101, 114, 0, 116, 104, 114,
-		111, 116, 116, 108, 101, 100, 95, 99, 102, 115, 95, 114, 113, 0, 110, 114,
-		95, 112, 101, 114, 105, 111, 100, 115, 0, 110, 114, 95, 116, 104, 114, 111,
-		116, 116, 108, 101, 100, 0, 110, 114, 95, 98, 117, 114, 115, 116, 0, 116,
-		104, 114, 111, 116, 116, 108, 101, 100, 95, 116, 105, 109, 101, 0, 98, 117,
-		114, 115, 116, 95, 116, 105, 109, 101, 0, 115, 99, 104, 101, 100, 95, 115,
-		116, 97, 116, 105, 115, 116, 105, 99, 115, 0, 119, 97, 105, 116, 95, 115,
-		116, 97, 114, 116, 0, 119, 97, 105, 116, 95, 109, 97, 120, 0, 119, 97,
-		105, 116, 95, 99, 111, 117, 110, 116, 0, 119, 97, 105, 116, 95, 115, 117,
-		109, 0, 105, 111, 119, 97, 105, 116, 95, 99, 111, 117, 110, 116, 0, 105,
-		111, 119, 97, 105, 116, 95, 115, 117, 109, 0, 115, 108, 101, 101, 112, 95,
-		115, 116, 97, 114, 116, 0, 115, 108, 101, 101, 112, 95, 109, 97, 120, 0,
-		115, 117, 109, 95, 115, 108, 101, 101, 112, 95, 114, 117, 110, 116, 105, 109,
-		101, 0, 98, 108, 111, 99, 107, 95, 115, 116, 97, 114, 116, 0, 98, 108,
-		111, 99, 107, 95, 109, 97, 120, 0, 115, 117, 109, 95, 98, 108, 111, 99,
-		107, 95, 114, 117, 110, 116, 105, 109, 101, 0, 101, 120, 101, 99, 95, 109,
-		97, 120, 0, 115, 108, 105, 99, 101, 95, 109, 97, 120, 0, 110, 114, 95,
-		109, 105, 103, 114, 97, 116, 105, 111, 110, 115, 95, 99, 111, 108, 100, 0,
-		110, 114, 95, 102, 97, 105, 108, 101, 100, 95, 109, 105, 103, 114, 97, 116,
-		105, 111, 110, 115, 95, 97, 102, 102, 105, 110, 101, 0, 110, 114, 95, 102,
+		84, 65, 82, 84, 0, 115, 99, 104, 101, 100, 95, 115, 116, 97, 116, 105,
+		115, 116, 105, 99, 115, 0, 119, 97, 105, 116, 95, 115, 116, 97, 114, 116,
+		0, 119, 97, 105, 116, 95, 109, 97, 120, 0, 119, 97, 105, 116, 95, 99,
+		111, 117, 110, 116, 0, 119, 97, 105, 116, 95, 115, 117, 109, 0, 105, 111,
+		119, 97, 105, 116, 95, 99, 111, 117, 110, 116, 0, 105, 111, 119, 97, 105,
+		116, 95, 115, 117, 109, 0, 115, 108, 101, 101, 112, 95, 115, 116, 97, 114,
+		116, 0, 115, 108, 101, 101, 112, 95, 109, 97, 120, 0, 115, 117, 109, 95,
+		115, 108, 101, 101, 112, 95, 114, 117, 110, 116, 105, 109, 101, 0, 98, 108,
+		111, 99, 107, 95, 115, 116, 97, 114, 116, 0, 98, 108, 111, 99, 107, 95,
+		109, 97, 120, 0, 115, 117, 109, 95, 98, 108, 111, 99, 107, 95, 114, 117,
+		110, 116, 105, 109, 101, 0, 101, 120, 101, 99, 95, 109, 97, 120, 0, 115,
+		108, 105, 99, 101, 95, 109, 97, 120, 0, 110, 114, 95, 109, 105, 103, 114,
+		97, 116, 105, 111, 110, 115, 95, 99, 111, 108, 100, 0, 110, 114, 95, 102,
 		97, 105, 108, 101, 100, 95, 109, 105, 103, 114, 97, 116, 105, 111, 110, 115,
-		95, 114, 117, 110, 110, 105, 110, 103, 0, 110, 114, 95, 102, 97, 105, 108,
-		101, 100, 95, 109, 105, 103, 114, 97, 116, 105, 111, 110, 115, 95, 104, 111,
-		116, 0, 110, 114, 95, 102, 111, 114, 99, 101, 100, 95, 109, 105, 103, 114,
-		97, 116, 105, 111, 110, 115, 0, 110, 114, 95, 119, 97, 107, 101, 117, 112,
-		115, 0, 110, 114, 95, 119, 97, 107, 101, 117, 112, 115, 95, 115, 121, 110,
-		99, 0, 110, 114, 95, 119, 97, 107, 101, 117, 112, 115, 95, 109, 105, 103,
-		114, 97, 116, 101, 0, 110, 114, 95, 119, 97, 107, 101, 117, 112, 115, 95,
-		108, 111, 99, 97, 108, 0, 110, 114, 95, 119, 97, 107, 101, 117, 112, 115,
-		95, 114, 101, 109, 111, 116, 101, 0, 110, 114, 95, 119, 97, 107, 101, 117,
-		112, 115, 95, 97, 102, 102, 105, 110, 101, 0, 110, 114, 95, 119, 97, 107,
-		101, 117, 112, 115, 95, 97, 102, 102, 105, 110, 101, 95, 97, 116, 116, 101,
-		109, 112, 116, 115, 0, 110, 114, 95, 119, 97, 107, 101, 117, 112, 115, 95,
-		112, 97, 115, 115, 105, 118, 101, 0, 110, 114, 95, 119, 97, 107, 101, 117,
-		112, 115, 95, 105, 100, 108, 101, 0, 99, 111, 114, 101, 95, 102, 111, 114,
-		99, 101, 105, 100, 108, 101, 95, 115, 117, 109, 0, 104, 108, 105, 115, 116,
-		95, 104, 101, 97, 100, 0, 102, 105, 114, 115, 116, 0, 99, 112, 117, 109,
-		97, 115, 107, 95, 116, 0, 99, 112, 117, 109, 97, 115, 107, 0, 98, 105,
-		116, 115, 0, 114, 99, 117, 95, 115, 112, 101, 99, 105, 97, 108, 0, 98,
-		0, 115, 0, 110, 101, 101, 100, 95, 113, 115, 0, 101, 120, 112, 95, 104,
-		105, 110, 116, 0, 110, 101, 101, 100, 95, 109, 98, 0, 112, 99, 111, 117,
-		110, 116, 0, 114, 117, 110, 95, 100, 101, 108, 97, 121, 0, 108, 97, 115,
-		116, 95, 97, 114, 114, 105, 118, 97, 108, 0, 108, 97, 115, 116, 95, 113,
-		117, 101, 117, 101, 100, 0, 112, 108, 105, 115, 116, 95, 110, 111, 100, 101,
-		0, 112, 114, 105, 111, 95, 108, 105, 115, 116, 0, 110, 111, 100, 101, 95,
-		108, 105, 115, 116, 0, 109, 109, 95, 115, 116, 114, 117, 99, 116, 0, 99,
-		112, 117, 95, 98, 105, 116, 109, 97, 112, 0, 109, 109, 97, 112, 0, 109,
-		109, 95, 114, 98, 0, 118, 109, 97, 99, 97, 99, 104, 101, 95, 115, 101,
-		113, 110, 117, 109, 0, 103, 101, 116, 95, 117, 110, 109, 97, 112, 112, 101,
-		100, 95, 97, 114, 101, 97, 0, 109, 109, 97, 112, 95, 98, 97, 115, 101,
-		0, 109, 109, 97, 112, 95, 108, 101, 103, 97, 99, 121, 95, 98, 97, 115,
-		101, 0, 116, 97, 115, 107, 95, 115, 105, 122, 101, 0, 104, 105, 103, 104,
-		101, 115, 116, 95, 118, 109, 95, 101, 110, 100, 0, 112, 103, 100, 0, 109,
-		101, 109, 98, 97, 114, 114, 105, 101, 114, 95, 115, 116, 97, 116, 101, 0,
-		109, 109, 95, 117, 115, 101, 114, 115, 0, 109, 109, 95, 99, 111, 117, 110,
-		116, 0, 112, 103, 116, 97, 98, 108, 101, 115, 95, 98, 121, 116, 101, 115,
-		0, 109, 97, 112, 95, 99, 111, 117, 110, 116, 0, 112, 97, 103, 101, 95,
-		116, 97, 98, 108, 101, 95, 108, 111, 99, 107, 0, 109, 109, 97, 112, 95,
-		108, 111, 99, 107, 0, 109, 109, 108, 105, 115, 116, 0, 104, 105, 119, 97,
-		116, 101, 114, 95, 114, 115, 115, 0, 104, 105, 119, 97, 116, 101, 114, 95,
-		118, 109, 0, 116, 111, 116, 97, 108, 95, 118, 109, 0, 108, 111, 99, 107,
-		101, 100, 95, 118, 109, 0, 112, 105, 110, 110, 101, 100, 95, 118, 109, 0,
-		100, 97, 116, 97, 95, 118, 109, 0, 101, 120, 101, 99, 95, 118, 109, 0,
-		115, 116, 97, 99, 107, 95, 118, 109, 0, 100, 101, 102, 95, 102, 108, 97,
-		103, 115, 0, 119, 114, 105, 116, 101, 95, 112, 114, 111, 116, 101, 99, 116,
-		95, 115, 101, 113, 0, 97, 114, 103, 95, 108, 111, 99, 107, 0, 115, 116,
-		97, 114, 116, 95, 99, 111, 100, 101, 0, 101, 110, 100, 95, 99, 111, 100,
-		101, 0, 115, 116, 97, 114, 116, 95, 100, 97, 116, 97, 0, 101, 110, 100,
-		95, 100, 97, 116, 97, 0, 115, 116, 97, 114, 116, 95, 98, 114, 107, 0,
-		98, 114, 107, 0, 115, 116, 97, 114, 116, 95, 115, 116, 97, 99, 107, 0,
-		97, 114, 103, 95, 115, 116, 97, 114, 116, 0, 97, 114, 103, 95, 101, 110,
-		100, 0, 101, 110, 118, 95, 115, 116, 97, 114, 116, 0, 101, 110, 118, 95,
-		101, 110, 100, 0, 115, 97, 118, 101, 100, 95, 97, 117, 120, 118, 0, 98,
-		105, 110, 102, 109, 116, 0, 99, 111, 110, 116, 101, 120, 116, 0, 105, 111,
-		99, 116, 120, 95, 108, 111, 99, 107, 0, 105, 111, 99, 116, 120, 95, 116,
-		97, 98, 108, 101, 0, 111, 119, 110, 101, 114, 0, 117, 115, 101, 114, 95,
-		110, 115, 0, 101, 120, 101, 95, 102, 105, 108, 101, 0, 110, 111, 116, 105,
-		102, 105, 101, 114, 95, 115, 117, 98, 115, 99, 114, 105, 112, 116, 105, 111,
-		110, 115, 0, 110, 117, 109, 97, 95, 110, 101, 120, 116, 95, 115, 99, 97,
-		110, 0, 110, 117, 109, 97, 95, 115, 99, 97, 110, 95, 111, 102, 102, 115,
-		101, 116, 0, 116, 108, 98, 95, 102, 108, 117, 115, 104, 95, 112, 101, 110,
-		100, 105, 110, 103, 0, 117, 112, 114, 111, 98, 101, 115, 95, 115, 116, 97,
-		116, 101, 0, 104, 117, 103, 101, 116, 108, 98, 95, 117, 115, 97, 103, 101,
-		0, 97, 115, 121, 110, 99, 95, 112, 117, 116, 95, 119, 111, 114, 107, 0,
-		112, 97, 115, 105, 100, 0, 107, 115, 109, 95, 109, 101, 114, 103, 105, 110,
-		103, 95, 112, 97, 103, 101, 115, 0, 102, 105, 108, 101, 0, 102, 95, 112,
-		97, 116, 104, 0, 102, 95, 105, 110, 111, 100, 101, 0, 102, 95, 111, 112,
-		0, 102, 95, 108, 111, 99, 107, 0, 102, 95, 99, 111, 117, 110, 116, 0,
-		102, 95, 102, 108, 97, 103, 115, 0, 102, 95, 109, 111, 100, 101, 0, 102,
-		95, 112, 111, 115, 95, 108, 111, 99, 107, 0, 102, 95, 112, 111, 115, 0,
-		102, 95, 111, 119, 110, 101, 114, 0, 102, 95, 99, 114, 101, 100, 0, 102,
-		95, 114, 97, 0, 102, 95, 118, 101, 114, 115, 105, 111, 110, 0, 102, 95,
-		115, 101, 99, 117, 114, 105, 116, 121, 0, 112, 114, 105, 118, 97, 116, 101,
-		95, 100, 97, 116, 97, 0, 102, 95, 101, 112, 0, 102, 95, 109, 97, 112,
-		112, 105, 110, 103, 0, 102, 95, 119, 98, 95, 101, 114, 114, 0, 102, 95,
-		115, 98, 95, 101, 114, 114, 0, 102, 95, 108, 108, 105, 115, 116, 0, 102,
-		95, 114, 99, 117, 104, 101, 97, 100, 0, 102, 95, 105, 111, 99, 98, 95,
-		102, 108, 97, 103, 115, 0, 112, 97, 116, 104, 0, 109, 110, 116, 0, 100,
-		101, 110, 116, 114, 121, 0, 115, 112, 105, 110, 108, 111, 99, 107, 95, 116,
-		0, 115, 112, 105, 110, 108, 111, 99, 107, 0, 114, 108, 111, 99, 107, 0,
-		102, 109, 111, 100, 101, 95, 116, 0, 109, 117, 116, 101, 120, 0, 119, 97,
-		105, 116, 95, 108, 111, 99, 107, 0, 111, 115, 113, 0, 119, 97, 105, 116,
-		95, 108, 105, 115, 116, 0, 111, 112, 116, 105, 109, 105, 115, 116, 105, 99,
-		95, 115, 112, 105, 110, 95, 113, 117, 101, 117, 101, 0, 108, 111, 102, 102,
-		95, 116, 0, 95, 95, 107, 101, 114, 110, 101, 108, 95, 108, 111, 102, 102,
-		95, 116, 0, 102, 111, 119, 110, 95, 115, 116, 114, 117, 99, 116, 0, 112,
-		105, 100, 95, 116, 121, 112, 101, 0, 117, 105, 100, 0, 101, 117, 105, 100,
-		0, 115, 105, 103, 110, 117, 109, 0, 114, 119, 108, 111, 99, 107, 95, 116,
-		0, 97, 114, 99, 104, 95, 114, 119, 108, 111, 99, 107, 95, 116, 0, 113,
-		114, 119, 108, 111, 99, 107, 0, 99, 110, 116, 115, 0, 119, 108, 111, 99,
-		107, 101, 100, 0, 95, 95, 108, 115, 116, 97, 116, 101, 0, 80, 73, 68,
-		84, 89, 80, 69, 95, 80, 73, 68, 0, 80, 73, 68, 84, 89, 80, 69,
-		95, 84, 71, 73, 68, 0, 80, 73, 68, 84, 89, 80, 69, 95, 80, 71,
-		73, 68, 0, 80, 73, 68, 84, 89, 80, 69, 95, 83, 73, 68, 0, 80,
-		73, 68, 84, 89, 80, 69, 95, 77, 65, 88, 0, 107, 117, 105, 100, 95,
-		116, 0, 117, 105, 100, 95, 116, 0, 95, 95, 107, 101, 114, 110, 101, 108,
-		95, 117, 105, 100, 51, 50, 95, 116, 0, 102, 105, 108, 101, 95, 114, 97,
-		95, 115, 116, 97, 116, 101, 0, 115, 116, 97, 114, 116, 0, 115, 105, 122,
-		101, 0, 97, 115, 121, 110, 99, 95, 115, 105, 122, 101, 0, 114, 97, 95,
-		112, 97, 103, 101, 115, 0, 109, 109, 97, 112, 95, 109, 105, 115, 115, 0,
-		112, 114, 101, 118, 95, 112, 111, 115, 0, 101, 114, 114, 115, 101, 113, 95,
-		116, 0, 112, 103, 100, 95, 116, 0, 112, 103, 100, 118, 97, 108, 95, 116,
-		0, 114, 119, 95, 115, 101, 109, 97, 112, 104, 111, 114, 101, 0, 115, 101,
-		113, 99, 111, 117, 110, 116, 95, 116, 0, 115, 101, 113, 99, 111, 117, 110,
-		116, 0, 115, 101, 113, 117, 101, 110, 99, 101, 0, 109, 109, 95, 114, 115,
-		115, 95, 115, 116, 97, 116, 0, 109, 109, 95, 99, 111, 110, 116, 101, 120,
-		116, 95, 116, 0, 115, 105, 103, 112, 97, 103, 101, 0, 112, 105, 110, 110,
-		101, 100, 0, 118, 100, 115, 111, 0, 120, 111, 108, 95, 97, 114, 101, 97,
+		95, 97, 102, 102, 105, 110, 101, 0, 110, 114, 95, 102, 97, 105, 108, 101,
+		100, 95, 109, 105, 103, 114, 97, 116, 105, 111, 110, 115, 95, 114, 117, 110,
+		110, 105, 110, 103, 0, 110, 114, 95, 102, 97, 105, 108, 101, 100, 95, 109,
+		105, 103, 114, 97, 116, 105, 111, 110, 115, 95, 104, 111, 116, 0, 110, 114,
+		95, 102, 111, 114, 99, 101, 100, 95, 109, 105, 103, 114, 97, 116, 105, 111,
+		110, 115, 0, 110, 114, 95, 119, 97, 107, 101, 117, 112, 115, 0, 110, 114,
+		95, 119, 97, 107, 101, 117, 112, 115, 95, 115, 121, 110, 99, 0, 110, 114,
+		95, 119, 97, 107, 101, 117, 112, 115, 95, 109, 105, 103, 114, 97, 116, 101,
+		0, 110, 114, 95, 119, 97, 107, 101, 117, 112, 115, 95, 108, 111, 99, 97,
+		108, 0, 110, 114, 95, 119, 97, 107, 101, 117, 112, 115, 95, 114, 101, 109,
+		111, 116, 101, 0, 110, 114, 95, 119, 97, 107, 101, 117, 112, 115, 95, 97,
+		102, 102, 105, 110, 101, 0, 110, 114, 95, 119, 97, 107, 101, 117, 112, 115,
+		95, 97, 102, 102, 105, 110, 101, 95, 97, 116, 116, 101, 109, 112, 116, 115,
+		0, 110, 114, 95, 119, 97, 107, 101, 117, 112, 115, 95, 112, 97, 115, 115,
+		105, 118, 101, 0, 110, 114, 95, 119, 97, 107, 101, 117, 112, 115, 95, 105,
+		100, 108, 101, 0, 99, 111, 114, 101, 95, 102, 111, 114, 99, 101, 105, 100,
+		108, 101, 95, 115, 117, 109, 0, 104, 108, 105, 115, 116, 95, 104, 101, 97,
+		100, 0, 102, 105, 114, 115, 116, 0, 99, 112, 117, 109, 97, 115, 107, 95,
+		116, 0, 99, 112, 117, 109, 97, 115, 107, 0, 98, 105, 116, 115, 0, 114,
+		99, 117, 95, 115, 112, 101, 99, 105, 97, 108, 0, 98, 0, 115, 0, 110,
+		101, 101, 100, 95, 113, 115, 0, 101, 120, 112, 95, 104, 105, 110, 116, 0,
+		110, 101, 101, 100, 95, 109, 98, 0, 112, 99, 111, 117, 110, 116, 0, 114,
+		117, 110, 95, 100, 101, 108, 97, 121, 0, 108, 97, 115, 116, 95, 97, 114,
+		114, 105, 118, 97, 108, 0, 108, 97, 115, 116, 95, 113, 117, 101, 117, 101,
+		100, 0, 112, 108, 105, 115, 116, 95, 110, 111, 100, 101, 0, 112, 114, 105,
+		111, 95, 108, 105, 115, 116, 0, 110, 111, 100, 101, 95, 108, 105, 115, 116,
 		0, 115, 101, 113, 110, 117, 109, 0, 118, 109, 97, 115, 0, 118, 109, 95,
 		97, 114, 101, 97, 95, 115, 116, 114, 117, 99, 116, 0, 118, 109, 95, 115,
 		116, 97, 114, 116, 0, 118, 109, 95, 101, 110, 100, 0, 118, 109, 95, 110,
@@ -7821,813 +4147,296 @@ var bpfObjects = map[string][]byte{
 		112, 114, 111, 116, 95, 116, 0, 112, 103, 112, 114, 111, 116, 0, 112, 116,
 		101, 118, 97, 108, 95, 116, 0, 115, 104, 97, 114, 101, 100, 0, 97, 110,
 		111, 110, 95, 110, 97, 109, 101, 0, 114, 98, 0, 114, 98, 95, 115, 117,
-		98, 116, 114, 101, 101, 95, 108, 97, 115, 116, 0, 99, 116, 120, 0, 116,
-		97, 115, 107, 95, 114, 115, 115, 95, 115, 116, 97, 116, 0, 97, 114, 99,
-		104, 95, 100, 97, 116, 97, 0, 102, 110, 0, 102, 117, 116, 101, 120, 0,
-		110, 97, 110, 111, 115, 108, 101, 101, 112, 0, 112, 111, 108, 108, 0, 117,
-		97, 100, 100, 114, 0, 98, 105, 116, 115, 101, 116, 0, 116, 105, 109, 101,
-		0, 117, 97, 100, 100, 114, 50, 0, 99, 108, 111, 99, 107, 105, 100, 0,
-		99, 108, 111, 99, 107, 105, 100, 95, 116, 0, 95, 95, 107, 101, 114, 110,
-		101, 108, 95, 99, 108, 111, 99, 107, 105, 100, 95, 116, 0, 116, 105, 109,
-		101, 115, 112, 101, 99, 95, 116, 121, 112, 101, 0, 84, 84, 95, 78, 79,
-		78, 69, 0, 84, 84, 95, 78, 65, 84, 73, 86, 69, 0, 84, 84, 95,
-		67, 79, 77, 80, 65, 84, 0, 114, 109, 116, 112, 0, 99, 111, 109, 112,
-		97, 116, 95, 114, 109, 116, 112, 0, 117, 102, 100, 115, 0, 110, 102, 100,
-		115, 0, 104, 97, 115, 95, 116, 105, 109, 101, 111, 117, 116, 0, 116, 118,
-		95, 115, 101, 99, 0, 116, 118, 95, 110, 115, 101, 99, 0, 112, 105, 100,
-		95, 116, 0, 95, 95, 107, 101, 114, 110, 101, 108, 95, 112, 105, 100, 95,
-		116, 0, 108, 101, 118, 101, 108, 0, 105, 110, 111, 100, 101, 115, 0, 119,
-		97, 105, 116, 95, 112, 105, 100, 102, 100, 0, 110, 117, 109, 98, 101, 114,
-		115, 0, 119, 97, 105, 116, 95, 113, 117, 101, 117, 101, 95, 104, 101, 97,
-		100, 95, 116, 0, 119, 97, 105, 116, 95, 113, 117, 101, 117, 101, 95, 104,
-		101, 97, 100, 0, 104, 101, 97, 100, 0, 117, 112, 105, 100, 0, 110, 115,
-		0, 104, 108, 105, 115, 116, 95, 110, 111, 100, 101, 0, 112, 112, 114, 101,
-		118, 0, 115, 116, 97, 114, 116, 116, 105, 109, 101, 0, 118, 116, 105, 109,
-		101, 95, 115, 116, 97, 116, 101, 0, 86, 84, 73, 77, 69, 95, 73, 78,
-		65, 67, 84, 73, 86, 69, 0, 86, 84, 73, 77, 69, 95, 73, 68, 76,
-		69, 0, 86, 84, 73, 77, 69, 95, 83, 89, 83, 0, 86, 84, 73, 77,
-		69, 95, 85, 83, 69, 82, 0, 86, 84, 73, 77, 69, 95, 71, 85, 69,
-		83, 84, 0, 98, 97, 115, 101, 115, 0, 116, 105, 109, 101, 114, 115, 95,
-		97, 99, 116, 105, 118, 101, 0, 101, 120, 112, 105, 114, 121, 95, 97, 99,
-		116, 105, 118, 101, 0, 112, 111, 115, 105, 120, 95, 99, 112, 117, 116, 105,
-		109, 101, 114, 95, 98, 97, 115, 101, 0, 110, 101, 120, 116, 101, 118, 116,
-		0, 116, 113, 104, 101, 97, 100, 0, 116, 105, 109, 101, 114, 113, 117, 101,
-		117, 101, 95, 104, 101, 97, 100, 0, 115, 99, 104, 101, 100, 117, 108, 101,
-		100, 0, 103, 105, 100, 0, 115, 117, 105, 100, 0, 115, 103, 105, 100, 0,
-		101, 103, 105, 100, 0, 102, 115, 117, 105, 100, 0, 102, 115, 103, 105, 100,
-		0, 115, 101, 99, 117, 114, 101, 98, 105, 116, 115, 0, 99, 97, 112, 95,
-		105, 110, 104, 101, 114, 105, 116, 97, 98, 108, 101, 0, 99, 97, 112, 95,
-		112, 101, 114, 109, 105, 116, 116, 101, 100, 0, 99, 97, 112, 95, 101, 102,
-		102, 101, 99, 116, 105, 118, 101, 0, 99, 97, 112, 95, 98, 115, 101, 116,
-		0, 99, 97, 112, 95, 97, 109, 98, 105, 101, 110, 116, 0, 106, 105, 116,
-		95, 107, 101, 121, 114, 105, 110, 103, 0, 115, 101, 115, 115, 105, 111, 110,
-		95, 107, 101, 121, 114, 105, 110, 103, 0, 112, 114, 111, 99, 101, 115, 115,
-		95, 107, 101, 121, 114, 105, 110, 103, 0, 116, 104, 114, 101, 97, 100, 95,
-		107, 101, 121, 114, 105, 110, 103, 0, 114, 101, 113, 117, 101, 115, 116, 95,
-		107, 101, 121, 95, 97, 117, 116, 104, 0, 117, 115, 101, 114, 0, 117, 99,
-		111, 117, 110, 116, 115, 0, 103, 114, 111, 117, 112, 95, 105, 110, 102, 111,
-		0, 107, 103, 105, 100, 95, 116, 0, 103, 105, 100, 95, 116, 0, 95, 95,
-		107, 101, 114, 110, 101, 108, 95, 103, 105, 100, 51, 50, 95, 116, 0, 107,
-		101, 114, 110, 101, 108, 95, 99, 97, 112, 95, 116, 0, 107, 101, 114, 110,
-		101, 108, 95, 99, 97, 112, 95, 115, 116, 114, 117, 99, 116, 0, 99, 97,
-		112, 0, 115, 101, 114, 105, 97, 108, 0, 119, 97, 116, 99, 104, 101, 114,
-		115, 0, 115, 101, 109, 0, 108, 97, 115, 116, 95, 117, 115, 101, 100, 95,
-		97, 116, 0, 112, 101, 114, 109, 0, 113, 117, 111, 116, 97, 108, 101, 110,
-		0, 100, 97, 116, 97, 108, 101, 110, 0, 114, 101, 115, 116, 114, 105, 99,
-		116, 95, 108, 105, 110, 107, 0, 107, 101, 121, 95, 115, 101, 114, 105, 97,
-		108, 95, 116, 0, 105, 110, 116, 51, 50, 95, 116, 0, 115, 51, 50, 0,
-		95, 95, 115, 51, 50, 0, 103, 114, 97, 118, 101, 121, 97, 114, 100, 95,
-		108, 105, 110, 107, 0, 115, 101, 114, 105, 97, 108, 95, 110, 111, 100, 101,
-		0, 101, 120, 112, 105, 114, 121, 0, 114, 101, 118, 111, 107, 101, 100, 95,
-		97, 116, 0, 116, 105, 109, 101, 54, 52, 95, 116, 0, 107, 101, 121, 95,
-		112, 101, 114, 109, 95, 116, 0, 117, 105, 110, 116, 51, 50, 95, 116, 0,
-		115, 104, 111, 114, 116, 0, 105, 110, 100, 101, 120, 95, 107, 101, 121, 0,
-		107, 101, 121, 114, 105, 110, 103, 95, 105, 110, 100, 101, 120, 95, 107, 101,
-		121, 0, 104, 97, 115, 104, 0, 100, 111, 109, 97, 105, 110, 95, 116, 97,
-		103, 0, 100, 101, 115, 99, 114, 105, 112, 116, 105, 111, 110, 0, 120, 0,
-		100, 101, 115, 99, 95, 108, 101, 110, 0, 100, 101, 115, 99, 0, 108, 101,
-		110, 95, 100, 101, 115, 99, 0, 107, 101, 121, 95, 116, 121, 112, 101, 0,
-		110, 97, 109, 101, 0, 100, 101, 102, 95, 100, 97, 116, 97, 108, 101, 110,
-		0, 118, 101, 116, 95, 100, 101, 115, 99, 114, 105, 112, 116, 105, 111, 110,
-		0, 112, 114, 101, 112, 97, 114, 115, 101, 0, 102, 114, 101, 101, 95, 112,
-		114, 101, 112, 97, 114, 115, 101, 0, 105, 110, 115, 116, 97, 110, 116, 105,
-		97, 116, 101, 0, 117, 112, 100, 97, 116, 101, 0, 109, 97, 116, 99, 104,
-		95, 112, 114, 101, 112, 97, 114, 115, 101, 0, 109, 97, 116, 99, 104, 95,
-		102, 114, 101, 101, 0, 114, 101, 118, 111, 107, 101, 0, 100, 101, 115, 116,
-		114, 111, 121, 0, 100, 101, 115, 99, 114, 105, 98, 101, 0, 114, 101, 97,
-		100, 0, 114, 101, 113, 117, 101, 115, 116, 95, 107, 101, 121, 0, 108, 111,
-		111, 107, 117, 112, 95, 114, 101, 115, 116, 114, 105, 99, 116, 105, 111, 110,
-		0, 97, 115, 121, 109, 95, 113, 117, 101, 114, 121, 0, 97, 115, 121, 109,
-		95, 101, 100, 115, 95, 111, 112, 0, 97, 115, 121, 109, 95, 118, 101, 114,
-		105, 102, 121, 95, 115, 105, 103, 110, 97, 116, 117, 114, 101, 0, 108, 105,
-		110, 107, 0, 108, 111, 99, 107, 95, 99, 108, 97, 115, 115, 0, 115, 105,
-		122, 101, 95, 116, 0, 95, 95, 107, 101, 114, 110, 101, 108, 95, 115, 105,
-		122, 101, 95, 116, 0, 95, 95, 107, 101, 114, 110, 101, 108, 95, 117, 108,
-		111, 110, 103, 95, 116, 0, 107, 101, 121, 95, 112, 114, 101, 112, 97, 114,
-		115, 101, 100, 95, 112, 97, 121, 108, 111, 97, 100, 0, 111, 114, 105, 103,
-		95, 100, 101, 115, 99, 114, 105, 112, 116, 105, 111, 110, 0, 112, 97, 121,
-		108, 111, 97, 100, 0, 107, 101, 121, 95, 112, 97, 121, 108, 111, 97, 100,
-		0, 114, 99, 117, 95, 100, 97, 116, 97, 48, 0, 107, 101, 121, 95, 109,
-		97, 116, 99, 104, 95, 100, 97, 116, 97, 0, 99, 109, 112, 0, 114, 97,
-		119, 95, 100, 97, 116, 97, 0, 112, 114, 101, 112, 97, 114, 115, 101, 100,
-		0, 108, 111, 111, 107, 117, 112, 95, 116, 121, 112, 101, 0, 98, 111, 111,
-		108, 0, 95, 66, 111, 111, 108, 0, 115, 101, 113, 95, 102, 105, 108, 101,
-		0, 98, 117, 102, 0, 102, 114, 111, 109, 0, 112, 97, 100, 95, 117, 110,
-		116, 105, 108, 0, 105, 110, 100, 101, 120, 0, 114, 101, 97, 100, 95, 112,
-		111, 115, 0, 111, 112, 0, 112, 111, 108, 108, 95, 101, 118, 101, 110, 116,
-		0, 112, 114, 105, 118, 97, 116, 101, 0, 114, 101, 113, 117, 101, 115, 116,
-		95, 107, 101, 121, 95, 97, 99, 116, 111, 114, 95, 116, 0, 107, 101, 121,
-		95, 114, 101, 115, 116, 114, 105, 99, 116, 105, 111, 110, 0, 99, 104, 101,
-		99, 107, 0, 107, 101, 121, 116, 121, 112, 101, 0, 107, 101, 121, 95, 114,
-		101, 115, 116, 114, 105, 99, 116, 95, 108, 105, 110, 107, 95, 102, 117, 110,
-		99, 95, 116, 0, 107, 101, 114, 110, 101, 108, 95, 112, 107, 101, 121, 95,
-		112, 97, 114, 97, 109, 115, 0, 101, 110, 99, 111, 100, 105, 110, 103, 0,
-		104, 97, 115, 104, 95, 97, 108, 103, 111, 0, 105, 110, 102, 111, 0, 105,
-		110, 95, 108, 101, 110, 0, 111, 117, 116, 95, 108, 101, 110, 0, 105, 110,
-		50, 95, 108, 101, 110, 0, 107, 101, 114, 110, 101, 108, 95, 112, 107, 101,
-		121, 95, 111, 112, 101, 114, 97, 116, 105, 111, 110, 0, 107, 101, 114, 110,
-		101, 108, 95, 112, 107, 101, 121, 95, 101, 110, 99, 114, 121, 112, 116, 0,
-		107, 101, 114, 110, 101, 108, 95, 112, 107, 101, 121, 95, 100, 101, 99, 114,
-		121, 112, 116, 0, 107, 101, 114, 110, 101, 108, 95, 112, 107, 101, 121, 95,
-		115, 105, 103, 110, 0, 107, 101, 114, 110, 101, 108, 95, 112, 107, 101, 121,
-		95, 118, 101, 114, 105, 102, 121, 0, 107, 101, 114, 110, 101, 108, 95, 112,
-		107, 101, 121, 95, 113, 117, 101, 114, 121, 0, 115, 117, 112, 112, 111, 114,
-		116, 101, 100, 95, 111, 112, 115, 0, 107, 101, 121, 95, 115, 105, 122, 101,
-		0, 109, 97, 120, 95, 100, 97, 116, 97, 95, 115, 105, 122, 101, 0, 109,
-		97, 120, 95, 115, 105, 103, 95, 115, 105, 122, 101, 0, 109, 97, 120, 95,
-		101, 110, 99, 95, 115, 105, 122, 101, 0, 109, 97, 120, 95, 100, 101, 99,
-		95, 115, 105, 122, 101, 0, 108, 111, 99, 107, 95, 99, 108, 97, 115, 115,
-		95, 107, 101, 121, 0, 107, 101, 121, 95, 116, 97, 103, 0, 110, 97, 109,
-		101, 95, 108, 105, 110, 107, 0, 107, 101, 121, 115, 0, 97, 115, 115, 111,
-		99, 95, 97, 114, 114, 97, 121, 0, 114, 111, 111, 116, 0, 110, 114, 95,
-		108, 101, 97, 118, 101, 115, 95, 111, 110, 95, 116, 114, 101, 101, 0, 97,
-		115, 115, 111, 99, 95, 97, 114, 114, 97, 121, 95, 112, 116, 114, 0, 117,
-		115, 101, 114, 95, 110, 97, 109, 101, 115, 112, 97, 99, 101, 0, 117, 105,
-		100, 95, 109, 97, 112, 0, 103, 105, 100, 95, 109, 97, 112, 0, 112, 114,
-		111, 106, 105, 100, 95, 109, 97, 112, 0, 103, 114, 111, 117, 112, 0, 112,
-		97, 114, 101, 110, 116, 95, 99, 111, 117, 108, 100, 95, 115, 101, 116, 102,
-		99, 97, 112, 0, 107, 101, 121, 114, 105, 110, 103, 95, 110, 97, 109, 101,
-		95, 108, 105, 115, 116, 0, 117, 115, 101, 114, 95, 107, 101, 121, 114, 105,
-		110, 103, 95, 114, 101, 103, 105, 115, 116, 101, 114, 0, 107, 101, 121, 114,
-		105, 110, 103, 95, 115, 101, 109, 0, 112, 101, 114, 115, 105, 115, 116, 101,
-		110, 116, 95, 107, 101, 121, 114, 105, 110, 103, 95, 114, 101, 103, 105, 115,
-		116, 101, 114, 0, 115, 101, 116, 0, 115, 121, 115, 99, 116, 108, 115, 0,
-		117, 99, 111, 117, 110, 116, 95, 109, 97, 120, 0, 117, 105, 100, 95, 103,
-		105, 100, 95, 109, 97, 112, 0, 110, 114, 95, 101, 120, 116, 101, 110, 116,
-		115, 0, 101, 120, 116, 101, 110, 116, 0, 117, 105, 100, 95, 103, 105, 100,
-		95, 101, 120, 116, 101, 110, 116, 0, 108, 111, 119, 101, 114, 95, 102, 105,
-		114, 115, 116, 0, 102, 111, 114, 119, 97, 114, 100, 0, 114, 101, 118, 101,
-		114, 115, 101, 0, 110, 115, 95, 99, 111, 109, 109, 111, 110, 0, 115, 116,
-		97, 115, 104, 101, 100, 0, 111, 112, 115, 0, 105, 110, 117, 109, 0, 99,
-		116, 108, 95, 116, 97, 98, 108, 101, 95, 115, 101, 116, 0, 105, 115, 95,
-		115, 101, 101, 110, 0, 100, 105, 114, 0, 99, 116, 108, 95, 100, 105, 114,
-		0, 104, 101, 97, 100, 101, 114, 0, 99, 116, 108, 95, 116, 97, 98, 108,
-		101, 95, 104, 101, 97, 100, 101, 114, 0, 117, 110, 114, 101, 103, 105, 115,
-		116, 101, 114, 105, 110, 103, 0, 99, 116, 108, 95, 116, 97, 98, 108, 101,
-		95, 97, 114, 103, 0, 99, 116, 108, 95, 116, 97, 98, 108, 101, 0, 117,
-		115, 101, 100, 0, 110, 114, 101, 103, 0, 99, 111, 109, 112, 108, 101, 116,
-		105, 111, 110, 0, 100, 111, 110, 101, 0, 119, 97, 105, 116, 0, 115, 119,
-		97, 105, 116, 95, 113, 117, 101, 117, 101, 95, 104, 101, 97, 100, 0, 116,
-		97, 115, 107, 95, 108, 105, 115, 116, 0, 112, 114, 111, 99, 110, 97, 109,
-		101, 0, 109, 97, 120, 108, 101, 110, 0, 109, 111, 100, 101, 0, 99, 104,
-		105, 108, 100, 0, 112, 114, 111, 99, 95, 104, 97, 110, 100, 108, 101, 114,
-		0, 101, 120, 116, 114, 97, 49, 0, 101, 120, 116, 114, 97, 50, 0, 117,
-		109, 111, 100, 101, 95, 116, 0, 117, 99, 111, 117, 110, 116, 0, 110, 111,
-		110, 95, 114, 99, 117, 0, 115, 121, 115, 118, 95, 115, 101, 109, 0, 117,
-		110, 100, 111, 95, 108, 105, 115, 116, 0, 115, 121, 115, 118, 95, 115, 104,
-		109, 0, 115, 104, 109, 95, 99, 108, 105, 115, 116, 0, 115, 105, 103, 115,
-		101, 116, 95, 116, 0, 115, 105, 103, 0, 115, 105, 103, 112, 101, 110, 100,
-		105, 110, 103, 0, 102, 105, 108, 116, 101, 114, 95, 99, 111, 117, 110, 116,
-		0, 102, 105, 108, 116, 101, 114, 0, 115, 121, 115, 99, 97, 108, 108, 95,
-		117, 115, 101, 114, 95, 100, 105, 115, 112, 97, 116, 99, 104, 0, 119, 97,
-		107, 101, 95, 113, 95, 110, 111, 100, 101, 0, 107, 101, 114, 110, 101, 108,
-		95, 115, 105, 103, 105, 110, 102, 111, 95, 116, 0, 116, 97, 115, 107, 95,
-		105, 111, 95, 97, 99, 99, 111, 117, 110, 116, 105, 110, 103, 0, 114, 99,
-		104, 97, 114, 0, 119, 99, 104, 97, 114, 0, 115, 121, 115, 99, 114, 0,
-		115, 121, 115, 99, 119, 0, 114, 101, 97, 100, 95, 98, 121, 116, 101, 115,
-		0, 119, 114, 105, 116, 101, 95, 98, 121, 116, 101, 115, 0, 99, 97, 110,
-		99, 101, 108, 108, 101, 100, 95, 119, 114, 105, 116, 101, 95, 98, 121, 116,
-		101, 115, 0, 110, 111, 100, 101, 109, 97, 115, 107, 95, 116, 0, 115, 101,
-		113, 99, 111, 117, 110, 116, 95, 115, 112, 105, 110, 108, 111, 99, 107, 95,
-		116, 0, 115, 101, 113, 99, 111, 117, 110, 116, 95, 115, 112, 105, 110, 108,
-		111, 99, 107, 0, 112, 101, 114, 102, 95, 101, 118, 101, 110, 116, 95, 99,
-		111, 110, 116, 101, 120, 116, 0, 112, 109, 117, 0, 97, 99, 116, 105, 118,
-		101, 95, 99, 116, 120, 95, 108, 105, 115, 116, 0, 112, 105, 110, 110, 101,
-		100, 95, 103, 114, 111, 117, 112, 115, 0, 102, 108, 101, 120, 105, 98, 108,
-		101, 95, 103, 114, 111, 117, 112, 115, 0, 101, 118, 101, 110, 116, 95, 108,
-		105, 115, 116, 0, 112, 105, 110, 110, 101, 100, 95, 97, 99, 116, 105, 118,
-		101, 0, 102, 108, 101, 120, 105, 98, 108, 101, 95, 97, 99, 116, 105, 118,
-		101, 0, 110, 114, 95, 101, 118, 101, 110, 116, 115, 0, 110, 114, 95, 97,
-		99, 116, 105, 118, 101, 0, 110, 114, 95, 117, 115, 101, 114, 0, 105, 115,
-		95, 97, 99, 116, 105, 118, 101, 0, 110, 114, 95, 115, 116, 97, 116, 0,
-		110, 114, 95, 102, 114, 101, 113, 0, 114, 111, 116, 97, 116, 101, 95, 100,
-		105, 115, 97, 98, 108, 101, 0, 114, 111, 116, 97, 116, 101, 95, 110, 101,
-		99, 101, 115, 115, 97, 114, 121, 0, 114, 101, 102, 99, 111, 117, 110, 116,
-		0, 116, 97, 115, 107, 0, 116, 105, 109, 101, 115, 116, 97, 109, 112, 0,
-		116, 105, 109, 101, 111, 102, 102, 115, 101, 116, 0, 112, 97, 114, 101, 110,
-		116, 95, 99, 116, 120, 0, 112, 97, 114, 101, 110, 116, 95, 103, 101, 110,
-		0, 103, 101, 110, 101, 114, 97, 116, 105, 111, 110, 0, 112, 105, 110, 95,
-		99, 111, 117, 110, 116, 0, 110, 114, 95, 99, 103, 114, 111, 117, 112, 115,
-		0, 116, 97, 115, 107, 95, 99, 116, 120, 95, 100, 97, 116, 97, 0, 110,
-		114, 95, 112, 101, 110, 100, 105, 110, 103, 0, 112, 101, 114, 102, 95, 101,
-		118, 101, 110, 116, 95, 103, 114, 111, 117, 112, 115, 0, 116, 114, 101, 101,
-		0, 108, 111, 99, 97, 108, 95, 116, 0, 97, 0, 110, 111, 100, 101, 115,
-		0, 104, 111, 109, 101, 95, 110, 111, 100, 101, 0, 119, 0, 99, 112, 117,
-		115, 101, 116, 95, 109, 101, 109, 115, 95, 97, 108, 108, 111, 119, 101, 100,
-		0, 117, 115, 101, 114, 95, 110, 111, 100, 101, 109, 97, 115, 107, 0, 116,
-		108, 98, 102, 108, 117, 115, 104, 95, 117, 110, 109, 97, 112, 95, 98, 97,
-		116, 99, 104, 0, 114, 99, 117, 95, 117, 115, 101, 114, 115, 0, 112, 97,
-		103, 101, 95, 102, 114, 97, 103, 0, 112, 97, 103, 101, 0, 111, 102, 102,
-		115, 101, 116, 0, 98, 97, 99, 107, 116, 114, 97, 99, 101, 0, 109, 97,
-		120, 0, 103, 102, 112, 95, 116, 0, 109, 101, 109, 95, 99, 103, 114, 111,
-		117, 112, 0, 109, 101, 109, 111, 114, 121, 0, 107, 109, 101, 109, 0, 116,
-		99, 112, 109, 101, 109, 0, 104, 105, 103, 104, 95, 119, 111, 114, 107, 0,
-		122, 115, 119, 97, 112, 95, 109, 97, 120, 0, 115, 111, 102, 116, 95, 108,
-		105, 109, 105, 116, 0, 118, 109, 112, 114, 101, 115, 115, 117, 114, 101, 0,
-		111, 111, 109, 95, 103, 114, 111, 117, 112, 0, 111, 111, 109, 95, 108, 111,
-		99, 107, 0, 117, 110, 100, 101, 114, 95, 111, 111, 109, 0, 115, 119, 97,
-		112, 112, 105, 110, 101, 115, 115, 0, 111, 111, 109, 95, 107, 105, 108, 108,
-		95, 100, 105, 115, 97, 98, 108, 101, 0, 101, 118, 101, 110, 116, 115, 95,
-		102, 105, 108, 101, 0, 101, 118, 101, 110, 116, 115, 95, 108, 111, 99, 97,
-		108, 95, 102, 105, 108, 101, 0, 115, 119, 97, 112, 95, 101, 118, 101, 110,
-		116, 115, 95, 102, 105, 108, 101, 0, 116, 104, 114, 101, 115, 104, 111, 108,
-		100, 115, 95, 108, 111, 99, 107, 0, 116, 104, 114, 101, 115, 104, 111, 108,
-		100, 115, 0, 109, 101, 109, 115, 119, 95, 116, 104, 114, 101, 115, 104, 111,
-		108, 100, 115, 0, 111, 111, 109, 95, 110, 111, 116, 105, 102, 121, 0, 109,
-		111, 118, 101, 95, 99, 104, 97, 114, 103, 101, 95, 97, 116, 95, 105, 109,
-		109, 105, 103, 114, 97, 116, 101, 0, 109, 111, 118, 101, 95, 108, 111, 99,
-		107, 0, 109, 111, 118, 101, 95, 108, 111, 99, 107, 95, 102, 108, 97, 103,
-		115, 0, 95, 112, 97, 100, 49, 95, 0, 118, 109, 115, 116, 97, 116, 115,
-		0, 109, 101, 109, 111, 114, 121, 95, 101, 118, 101, 110, 116, 115, 0, 109,
-		101, 109, 111, 114, 121, 95, 101, 118, 101, 110, 116, 115, 95, 108, 111, 99,
-		97, 108, 0, 115, 111, 99, 107, 101, 116, 95, 112, 114, 101, 115, 115, 117,
-		114, 101, 0, 116, 99, 112, 109, 101, 109, 95, 97, 99, 116, 105, 118, 101,
-		0, 116, 99, 112, 109, 101, 109, 95, 112, 114, 101, 115, 115, 117, 114, 101,
-		0, 107, 109, 101, 109, 99, 103, 95, 105, 100, 0, 111, 98, 106, 99, 103,
-		0, 111, 98, 106, 99, 103, 95, 108, 105, 115, 116, 0, 95, 112, 97, 100,
-		50, 95, 0, 109, 111, 118, 105, 110, 103, 95, 97, 99, 99, 111, 117, 110,
-		116, 0, 109, 111, 118, 101, 95, 108, 111, 99, 107, 95, 116, 97, 115, 107,
-		0, 118, 109, 115, 116, 97, 116, 115, 95, 112, 101, 114, 99, 112, 117, 0,
-		99, 103, 119, 98, 95, 108, 105, 115, 116, 0, 99, 103, 119, 98, 95, 100,
-		111, 109, 97, 105, 110, 0, 99, 103, 119, 98, 95, 102, 114, 110, 0, 101,
-		118, 101, 110, 116, 95, 108, 105, 115, 116, 95, 108, 111, 99, 107, 0, 100,
-		101, 102, 101, 114, 114, 101, 100, 95, 115, 112, 108, 105, 116, 95, 113, 117,
-		101, 117, 101, 0, 110, 111, 100, 101, 105, 110, 102, 111, 0, 109, 101, 109,
-		95, 99, 103, 114, 111, 117, 112, 95, 105, 100, 0, 114, 101, 102, 0, 112,
-		97, 103, 101, 95, 99, 111, 117, 110, 116, 101, 114, 0, 109, 105, 110, 0,
-		108, 111, 119, 0, 104, 105, 103, 104, 0, 101, 109, 105, 110, 0, 109, 105,
-		110, 95, 117, 115, 97, 103, 101, 0, 99, 104, 105, 108, 100, 114, 101, 110,
-		95, 109, 105, 110, 95, 117, 115, 97, 103, 101, 0, 101, 108, 111, 119, 0,
-		108, 111, 119, 95, 117, 115, 97, 103, 101, 0, 99, 104, 105, 108, 100, 114,
-		101, 110, 95, 108, 111, 119, 95, 117, 115, 97, 103, 101, 0, 119, 97, 116,
-		101, 114, 109, 97, 114, 107, 0, 102, 97, 105, 108, 99, 110, 116, 0, 115,
-		119, 97, 112, 0, 109, 101, 109, 115, 119, 0, 115, 99, 97, 110, 110, 101,
-		100, 0, 114, 101, 99, 108, 97, 105, 109, 101, 100, 0, 116, 114, 101, 101,
-		95, 115, 99, 97, 110, 110, 101, 100, 0, 116, 114, 101, 101, 95, 114, 101,
-		99, 108, 97, 105, 109, 101, 100, 0, 115, 114, 95, 108, 111, 99, 107, 0,
-		101, 118, 101, 110, 116, 115, 95, 108, 111, 99, 107, 0, 99, 103, 114, 111,
-		117, 112, 95, 102, 105, 108, 101, 0, 107, 110, 0, 110, 111, 116, 105, 102,
-		105, 101, 100, 95, 97, 116, 0, 110, 111, 116, 105, 102, 121, 95, 116, 105,
-		109, 101, 114, 0, 116, 105, 109, 101, 114, 95, 108, 105, 115, 116, 0, 109,
-		101, 109, 95, 99, 103, 114, 111, 117, 112, 95, 116, 104, 114, 101, 115, 104,
-		111, 108, 100, 115, 0, 112, 114, 105, 109, 97, 114, 121, 0, 115, 112, 97,
-		114, 101, 0, 109, 101, 109, 95, 99, 103, 114, 111, 117, 112, 95, 116, 104,
-		114, 101, 115, 104, 111, 108, 100, 95, 97, 114, 121, 0, 99, 117, 114, 114,
-		101, 110, 116, 95, 116, 104, 114, 101, 115, 104, 111, 108, 100, 0, 101, 110,
-		116, 114, 105, 101, 115, 0, 109, 101, 109, 95, 99, 103, 114, 111, 117, 112,
-		95, 116, 104, 114, 101, 115, 104, 111, 108, 100, 0, 101, 118, 101, 110, 116,
-		102, 100, 0, 116, 104, 114, 101, 115, 104, 111, 108, 100, 0, 109, 101, 109,
-		99, 103, 95, 112, 97, 100, 100, 105, 110, 103, 0, 109, 101, 109, 99, 103,
-		95, 118, 109, 115, 116, 97, 116, 115, 0, 115, 116, 97, 116, 101, 95, 112,
-		101, 110, 100, 105, 110, 103, 0, 101, 118, 101, 110, 116, 115, 95, 112, 101,
-		110, 100, 105, 110, 103, 0, 119, 98, 95, 100, 111, 109, 97, 105, 110, 0,
-		99, 111, 109, 112, 108, 101, 116, 105, 111, 110, 115, 0, 112, 101, 114, 105,
-		111, 100, 95, 116, 105, 109, 101, 0, 100, 105, 114, 116, 121, 95, 108, 105,
-		109, 105, 116, 95, 116, 115, 116, 97, 109, 112, 0, 100, 105, 114, 116, 121,
-		95, 108, 105, 109, 105, 116, 0, 102, 112, 114, 111, 112, 95, 103, 108, 111,
-		98, 97, 108, 0, 112, 101, 114, 99, 112, 117, 95, 99, 111, 117, 110, 116,
-		101, 114, 0, 99, 111, 117, 110, 116, 101, 114, 115, 0, 109, 101, 109, 99,
-		103, 95, 99, 103, 119, 98, 95, 102, 114, 110, 0, 98, 100, 105, 95, 105,
-		100, 0, 109, 101, 109, 99, 103, 95, 105, 100, 0, 97, 116, 0, 119, 98,
-		95, 99, 111, 109, 112, 108, 101, 116, 105, 111, 110, 0, 99, 110, 116, 0,
-		119, 97, 105, 116, 113, 0, 100, 101, 102, 101, 114, 114, 101, 100, 95, 115,
-		112, 108, 105, 116, 0, 115, 112, 108, 105, 116, 95, 113, 117, 101, 117, 101,
-		95, 108, 111, 99, 107, 0, 115, 112, 108, 105, 116, 95, 113, 117, 101, 117,
-		101, 0, 115, 112, 108, 105, 116, 95, 113, 117, 101, 117, 101, 95, 108, 101,
-		110, 0, 109, 101, 109, 95, 99, 103, 114, 111, 117, 112, 95, 112, 101, 114,
-		95, 110, 111, 100, 101, 0, 108, 114, 117, 118, 101, 99, 0, 108, 114, 117,
-		118, 101, 99, 95, 115, 116, 97, 116, 115, 95, 112, 101, 114, 99, 112, 117,
-		0, 108, 114, 117, 118, 101, 99, 95, 115, 116, 97, 116, 115, 0, 108, 114,
-		117, 95, 122, 111, 110, 101, 95, 115, 105, 122, 101, 0, 105, 116, 101, 114,
-		0, 115, 104, 114, 105, 110, 107, 101, 114, 95, 105, 110, 102, 111, 0, 116,
-		114, 101, 101, 95, 110, 111, 100, 101, 0, 117, 115, 97, 103, 101, 95, 105,
-		110, 95, 101, 120, 99, 101, 115, 115, 0, 111, 110, 95, 116, 114, 101, 101,
-		0, 109, 101, 109, 99, 103, 0, 108, 105, 115, 116, 115, 0, 108, 114, 117,
-		95, 108, 111, 99, 107, 0, 97, 110, 111, 110, 95, 99, 111, 115, 116, 0,
-		102, 105, 108, 101, 95, 99, 111, 115, 116, 0, 110, 111, 110, 114, 101, 115,
-		105, 100, 101, 110, 116, 95, 97, 103, 101, 0, 114, 101, 102, 97, 117, 108,
-		116, 115, 0, 112, 103, 100, 97, 116, 0, 109, 101, 109, 95, 99, 103, 114,
-		111, 117, 112, 95, 114, 101, 99, 108, 97, 105, 109, 95, 105, 116, 101, 114,
-		0, 112, 111, 115, 105, 116, 105, 111, 110, 0, 108, 108, 105, 115, 116, 95,
-		104, 101, 97, 100, 0, 116, 104, 114, 101, 97, 100, 95, 115, 116, 114, 117,
-		99, 116, 0, 99, 112, 117, 95, 99, 111, 110, 116, 101, 120, 116, 0, 117,
-		119, 0, 102, 112, 115, 105, 109, 100, 95, 99, 112, 117, 0, 115, 118, 101,
-		95, 115, 116, 97, 116, 101, 0, 122, 97, 95, 115, 116, 97, 116, 101, 0,
-		118, 108, 0, 118, 108, 95, 111, 110, 101, 120, 101, 99, 0, 102, 97, 117,
-		108, 116, 95, 97, 100, 100, 114, 101, 115, 115, 0, 102, 97, 117, 108, 116,
-		95, 99, 111, 100, 101, 0, 100, 101, 98, 117, 103, 0, 107, 101, 121, 115,
-		95, 117, 115, 101, 114, 0, 107, 101, 121, 115, 95, 107, 101, 114, 110, 101,
-		108, 0, 109, 116, 101, 95, 99, 116, 114, 108, 0, 115, 99, 116, 108, 114,
-		95, 117, 115, 101, 114, 0, 115, 118, 99, 114, 0, 116, 112, 105, 100, 114,
-		50, 95, 101, 108, 48, 0, 120, 49, 57, 0, 120, 50, 48, 0, 120, 50,
-		49, 0, 120, 50, 50, 0, 120, 50, 51, 0, 120, 50, 52, 0, 120, 50,
-		53, 0, 120, 50, 54, 0, 120, 50, 55, 0, 120, 50, 56, 0, 102, 112,
-		0, 115, 112, 0, 112, 99, 0, 116, 112, 95, 118, 97, 108, 117, 101, 0,
-		116, 112, 50, 95, 118, 97, 108, 117, 101, 0, 102, 112, 115, 105, 109, 100,
-		95, 115, 116, 97, 116, 101, 0, 117, 115, 101, 114, 95, 102, 112, 115, 105,
-		109, 100, 95, 115, 116, 97, 116, 101, 0, 118, 114, 101, 103, 115, 0, 102,
-		112, 115, 114, 0, 102, 112, 99, 114, 0, 95, 95, 114, 101, 115, 101, 114,
-		118, 101, 100, 0, 117, 110, 115, 105, 103, 110, 101, 100, 32, 95, 95, 105,
-		110, 116, 49, 50, 56, 0, 100, 101, 98, 117, 103, 95, 105, 110, 102, 111,
-		0, 115, 117, 115, 112, 101, 110, 100, 101, 100, 95, 115, 116, 101, 112, 0,
-		98, 112, 115, 95, 100, 105, 115, 97, 98, 108, 101, 100, 0, 119, 112, 115,
-		95, 100, 105, 115, 97, 98, 108, 101, 100, 0, 104, 98, 112, 95, 98, 114,
-		101, 97, 107, 0, 104, 98, 112, 95, 119, 97, 116, 99, 104, 0, 112, 101,
-		114, 102, 95, 101, 118, 101, 110, 116, 0, 101, 118, 101, 110, 116, 95, 101,
-		110, 116, 114, 121, 0, 115, 105, 98, 108, 105, 110, 103, 95, 108, 105, 115,
-		116, 0, 97, 99, 116, 105, 118, 101, 95, 108, 105, 115, 116, 0, 103, 114,
-		111, 117, 112, 95, 105, 110, 100, 101, 120, 0, 109, 105, 103, 114, 97, 116,
-		101, 95, 101, 110, 116, 114, 121, 0, 104, 108, 105, 115, 116, 95, 101, 110,
-		116, 114, 121, 0, 97, 99, 116, 105, 118, 101, 95, 101, 110, 116, 114, 121,
-		0, 110, 114, 95, 115, 105, 98, 108, 105, 110, 103, 115, 0, 101, 118, 101,
-		110, 116, 95, 99, 97, 112, 115, 0, 103, 114, 111, 117, 112, 95, 99, 97,
-		112, 115, 0, 112, 109, 117, 95, 112, 114, 105, 118, 97, 116, 101, 0, 97,
-		116, 116, 97, 99, 104, 95, 115, 116, 97, 116, 101, 0, 99, 104, 105, 108,
-		100, 95, 99, 111, 117, 110, 116, 0, 116, 111, 116, 97, 108, 95, 116, 105,
-		109, 101, 95, 101, 110, 97, 98, 108, 101, 100, 0, 116, 111, 116, 97, 108,
-		95, 116, 105, 109, 101, 95, 114, 117, 110, 110, 105, 110, 103, 0, 116, 115,
-		116, 97, 109, 112, 0, 97, 116, 116, 114, 0, 104, 101, 97, 100, 101, 114,
-		95, 115, 105, 122, 101, 0, 105, 100, 95, 104, 101, 97, 100, 101, 114, 95,
-		115, 105, 122, 101, 0, 114, 101, 97, 100, 95, 115, 105, 122, 101, 0, 104,
-		119, 0, 99, 104, 105, 108, 100, 95, 116, 111, 116, 97, 108, 95, 116, 105,
-		109, 101, 95, 101, 110, 97, 98, 108, 101, 100, 0, 99, 104, 105, 108, 100,
-		95, 116, 111, 116, 97, 108, 95, 116, 105, 109, 101, 95, 114, 117, 110, 110,
-		105, 110, 103, 0, 99, 104, 105, 108, 100, 95, 109, 117, 116, 101, 120, 0,
-		99, 104, 105, 108, 100, 95, 108, 105, 115, 116, 0, 111, 110, 99, 112, 117,
-		0, 111, 119, 110, 101, 114, 95, 101, 110, 116, 114, 121, 0, 109, 109, 97,
-		112, 95, 109, 117, 116, 101, 120, 0, 109, 109, 97, 112, 95, 99, 111, 117,
-		110, 116, 0, 114, 98, 95, 101, 110, 116, 114, 121, 0, 114, 99, 117, 95,
-		98, 97, 116, 99, 104, 101, 115, 0, 114, 99, 117, 95, 112, 101, 110, 100,
-		105, 110, 103, 0, 102, 97, 115, 121, 110, 99, 0, 112, 101, 110, 100, 105,
-		110, 103, 95, 119, 97, 107, 101, 117, 112, 0, 112, 101, 110, 100, 105, 110,
-		103, 95, 107, 105, 108, 108, 0, 112, 101, 110, 100, 105, 110, 103, 95, 100,
-		105, 115, 97, 98, 108, 101, 0, 112, 101, 110, 100, 105, 110, 103, 95, 115,
-		105, 103, 116, 114, 97, 112, 0, 112, 101, 110, 100, 105, 110, 103, 95, 97,
-		100, 100, 114, 0, 112, 101, 110, 100, 105, 110, 103, 95, 105, 114, 113, 0,
-		112, 101, 110, 100, 105, 110, 103, 95, 116, 97, 115, 107, 0, 112, 101, 110,
-		100, 105, 110, 103, 95, 119, 111, 114, 107, 0, 101, 118, 101, 110, 116, 95,
-		108, 105, 109, 105, 116, 0, 97, 100, 100, 114, 95, 102, 105, 108, 116, 101,
-		114, 115, 0, 97, 100, 100, 114, 95, 102, 105, 108, 116, 101, 114, 95, 114,
-		97, 110, 103, 101, 115, 0, 97, 100, 100, 114, 95, 102, 105, 108, 116, 101,
-		114, 115, 95, 103, 101, 110, 0, 97, 117, 120, 95, 101, 118, 101, 110, 116,
-		0, 108, 111, 115, 116, 95, 115, 97, 109, 112, 108, 101, 115, 0, 99, 108,
-		111, 99, 107, 0, 111, 118, 101, 114, 102, 108, 111, 119, 95, 104, 97, 110,
-		100, 108, 101, 114, 0, 111, 118, 101, 114, 102, 108, 111, 119, 95, 104, 97,
-		110, 100, 108, 101, 114, 95, 99, 111, 110, 116, 101, 120, 116, 0, 111, 114,
-		105, 103, 95, 111, 118, 101, 114, 102, 108, 111, 119, 95, 104, 97, 110, 100,
-		108, 101, 114, 0, 112, 114, 111, 103, 0, 98, 112, 102, 95, 99, 111, 111,
-		107, 105, 101, 0, 116, 112, 95, 101, 118, 101, 110, 116, 0, 102, 116, 114,
-		97, 99, 101, 95, 111, 112, 115, 0, 99, 103, 114, 112, 0, 115, 98, 95,
-		108, 105, 115, 116, 0, 109, 111, 100, 117, 108, 101, 0, 100, 101, 118, 0,
-		97, 116, 116, 114, 95, 103, 114, 111, 117, 112, 115, 0, 97, 116, 116, 114,
-		95, 117, 112, 100, 97, 116, 101, 0, 99, 97, 112, 97, 98, 105, 108, 105,
-		116, 105, 101, 115, 0, 112, 109, 117, 95, 100, 105, 115, 97, 98, 108, 101,
-		95, 99, 111, 117, 110, 116, 0, 112, 109, 117, 95, 99, 112, 117, 95, 99,
-		111, 110, 116, 101, 120, 116, 0, 101, 120, 99, 108, 117, 115, 105, 118, 101,
-		95, 99, 110, 116, 0, 116, 97, 115, 107, 95, 99, 116, 120, 95, 110, 114,
-		0, 104, 114, 116, 105, 109, 101, 114, 95, 105, 110, 116, 101, 114, 118, 97,
-		108, 95, 109, 115, 0, 110, 114, 95, 97, 100, 100, 114, 95, 102, 105, 108,
-		116, 101, 114, 115, 0, 112, 109, 117, 95, 101, 110, 97, 98, 108, 101, 0,
-		112, 109, 117, 95, 100, 105, 115, 97, 98, 108, 101, 0, 101, 118, 101, 110,
-		116, 95, 105, 110, 105, 116, 0, 101, 118, 101, 110, 116, 95, 109, 97, 112,
-		112, 101, 100, 0, 101, 118, 101, 110, 116, 95, 117, 110, 109, 97, 112, 112,
-		101, 100, 0, 97, 100, 100, 0, 100, 101, 108, 0, 115, 116, 111, 112, 0,
-		115, 116, 97, 114, 116, 95, 116, 120, 110, 0, 99, 111, 109, 109, 105, 116,
-		95, 116, 120, 110, 0, 99, 97, 110, 99, 101, 108, 95, 116, 120, 110, 0,
-		101, 118, 101, 110, 116, 95, 105, 100, 120, 0, 115, 99, 104, 101, 100, 95,
-		116, 97, 115, 107, 0, 116, 97, 115, 107, 95, 99, 116, 120, 95, 99, 97,
-		99, 104, 101, 0, 115, 119, 97, 112, 95, 116, 97, 115, 107, 95, 99, 116,
-		120, 0, 115, 101, 116, 117, 112, 95, 97, 117, 120, 0, 102, 114, 101, 101,
-		95, 97, 117, 120, 0, 115, 110, 97, 112, 115, 104, 111, 116, 95, 97, 117,
-		120, 0, 97, 100, 100, 114, 95, 102, 105, 108, 116, 101, 114, 115, 95, 118,
-		97, 108, 105, 100, 97, 116, 101, 0, 97, 100, 100, 114, 95, 102, 105, 108,
-		116, 101, 114, 115, 95, 115, 121, 110, 99, 0, 97, 117, 120, 95, 111, 117,
-		116, 112, 117, 116, 95, 109, 97, 116, 99, 104, 0, 102, 105, 108, 116, 101,
-		114, 95, 109, 97, 116, 99, 104, 0, 99, 104, 101, 99, 107, 95, 112, 101,
-		114, 105, 111, 100, 0, 97, 116, 116, 114, 105, 98, 117, 116, 101, 95, 103,
-		114, 111, 117, 112, 0, 105, 115, 95, 118, 105, 115, 105, 98, 108, 101, 0,
-		105, 115, 95, 98, 105, 110, 95, 118, 105, 115, 105, 98, 108, 101, 0, 97,
-		116, 116, 114, 115, 0, 98, 105, 110, 95, 97, 116, 116, 114, 115, 0, 107,
-		111, 98, 106, 101, 99, 116, 0, 107, 115, 101, 116, 0, 107, 116, 121, 112,
-		101, 0, 115, 100, 0, 107, 114, 101, 102, 0, 115, 116, 97, 116, 101, 95,
-		105, 110, 105, 116, 105, 97, 108, 105, 122, 101, 100, 0, 115, 116, 97, 116,
-		101, 95, 105, 110, 95, 115, 121, 115, 102, 115, 0, 115, 116, 97, 116, 101,
-		95, 97, 100, 100, 95, 117, 101, 118, 101, 110, 116, 95, 115, 101, 110, 116,
-		0, 115, 116, 97, 116, 101, 95, 114, 101, 109, 111, 118, 101, 95, 117, 101,
-		118, 101, 110, 116, 95, 115, 101, 110, 116, 0, 117, 101, 118, 101, 110, 116,
-		95, 115, 117, 112, 112, 114, 101, 115, 115, 0, 107, 101, 114, 110, 102, 115,
-		95, 110, 111, 100, 101, 0, 97, 99, 116, 105, 118, 101, 0, 112, 114, 105,
-		118, 0, 105, 97, 116, 116, 114, 0, 115, 121, 109, 108, 105, 110, 107, 0,
-		107, 101, 114, 110, 102, 115, 95, 101, 108, 101, 109, 95, 100, 105, 114, 0,
-		115, 117, 98, 100, 105, 114, 115, 0, 114, 101, 118, 0, 107, 101, 114, 110,
-		102, 115, 95, 101, 108, 101, 109, 95, 115, 121, 109, 108, 105, 110, 107, 0,
-		116, 97, 114, 103, 101, 116, 95, 107, 110, 0, 107, 101, 114, 110, 102, 115,
-		95, 101, 108, 101, 109, 95, 97, 116, 116, 114, 0, 111, 112, 101, 110, 0,
-		110, 111, 116, 105, 102, 121, 95, 110, 101, 120, 116, 0, 97, 116, 116, 114,
-		105, 98, 117, 116, 101, 0, 98, 105, 110, 95, 97, 116, 116, 114, 105, 98,
-		117, 116, 101, 0, 119, 114, 105, 116, 101, 0, 97, 100, 100, 114, 101, 115,
-		115, 95, 115, 112, 97, 99, 101, 0, 104, 111, 115, 116, 0, 105, 95, 112,
-		97, 103, 101, 115, 0, 105, 110, 118, 97, 108, 105, 100, 97, 116, 101, 95,
-		108, 111, 99, 107, 0, 103, 102, 112, 95, 109, 97, 115, 107, 0, 105, 95,
-		109, 109, 97, 112, 95, 119, 114, 105, 116, 97, 98, 108, 101, 0, 105, 95,
-		109, 109, 97, 112, 0, 105, 95, 109, 109, 97, 112, 95, 114, 119, 115, 101,
-		109, 0, 110, 114, 112, 97, 103, 101, 115, 0, 119, 114, 105, 116, 101, 98,
-		97, 99, 107, 95, 105, 110, 100, 101, 120, 0, 97, 95, 111, 112, 115, 0,
-		119, 98, 95, 101, 114, 114, 0, 112, 114, 105, 118, 97, 116, 101, 95, 108,
-		111, 99, 107, 0, 112, 114, 105, 118, 97, 116, 101, 95, 108, 105, 115, 116,
-		0, 105, 110, 111, 100, 101, 0, 105, 95, 109, 111, 100, 101, 0, 105, 95,
-		111, 112, 102, 108, 97, 103, 115, 0, 105, 95, 117, 105, 100, 0, 105, 95,
-		103, 105, 100, 0, 105, 95, 102, 108, 97, 103, 115, 0, 105, 95, 97, 99,
-		108, 0, 105, 95, 100, 101, 102, 97, 117, 108, 116, 95, 97, 99, 108, 0,
-		105, 95, 111, 112, 0, 105, 95, 115, 98, 0, 105, 95, 109, 97, 112, 112,
-		105, 110, 103, 0, 105, 95, 115, 101, 99, 117, 114, 105, 116, 121, 0, 105,
-		95, 105, 110, 111, 0, 105, 95, 114, 100, 101, 118, 0, 105, 95, 115, 105,
-		122, 101, 0, 105, 95, 97, 116, 105, 109, 101, 0, 105, 95, 109, 116, 105,
-		109, 101, 0, 105, 95, 99, 116, 105, 109, 101, 0, 105, 95, 108, 111, 99,
-		107, 0, 105, 95, 98, 121, 116, 101, 115, 0, 105, 95, 98, 108, 107, 98,
-		105, 116, 115, 0, 105, 95, 119, 114, 105, 116, 101, 95, 104, 105, 110, 116,
-		0, 105, 95, 98, 108, 111, 99, 107, 115, 0, 105, 95, 115, 116, 97, 116,
-		101, 0, 105, 95, 114, 119, 115, 101, 109, 0, 100, 105, 114, 116, 105, 101,
-		100, 95, 119, 104, 101, 110, 0, 100, 105, 114, 116, 105, 101, 100, 95, 116,
-		105, 109, 101, 95, 119, 104, 101, 110, 0, 105, 95, 104, 97, 115, 104, 0,
-		105, 95, 105, 111, 95, 108, 105, 115, 116, 0, 105, 95, 119, 98, 0, 105,
-		95, 119, 98, 95, 102, 114, 110, 95, 119, 105, 110, 110, 101, 114, 0, 105,
-		95, 119, 98, 95, 102, 114, 110, 95, 97, 118, 103, 95, 116, 105, 109, 101,
-		0, 105, 95, 119, 98, 95, 102, 114, 110, 95, 104, 105, 115, 116, 111, 114,
-		121, 0, 105, 95, 108, 114, 117, 0, 105, 95, 115, 98, 95, 108, 105, 115,
-		116, 0, 105, 95, 119, 98, 95, 108, 105, 115, 116, 0, 105, 95, 118, 101,
-		114, 115, 105, 111, 110, 0, 105, 95, 115, 101, 113, 117, 101, 110, 99, 101,
-		0, 105, 95, 99, 111, 117, 110, 116, 0, 105, 95, 100, 105, 111, 95, 99,
-		111, 117, 110, 116, 0, 105, 95, 119, 114, 105, 116, 101, 99, 111, 117, 110,
-		116, 0, 105, 95, 114, 101, 97, 100, 99, 111, 117, 110, 116, 0, 105, 95,
-		102, 108, 99, 116, 120, 0, 105, 95, 100, 97, 116, 97, 0, 105, 95, 100,
-		101, 118, 105, 99, 101, 115, 0, 105, 95, 103, 101, 110, 101, 114, 97, 116,
-		105, 111, 110, 0, 105, 95, 102, 115, 110, 111, 116, 105, 102, 121, 95, 109,
-		97, 115, 107, 0, 105, 95, 102, 115, 110, 111, 116, 105, 102, 121, 95, 109,
-		97, 114, 107, 115, 0, 105, 95, 99, 114, 121, 112, 116, 95, 105, 110, 102,
-		111, 0, 105, 95, 118, 101, 114, 105, 116, 121, 95, 105, 110, 102, 111, 0,
-		105, 95, 112, 114, 105, 118, 97, 116, 101, 0, 112, 111, 115, 105, 120, 95,
-		97, 99, 108, 0, 97, 95, 114, 101, 102, 99, 111, 117, 110, 116, 0, 97,
-		95, 114, 99, 117, 0, 97, 95, 99, 111, 117, 110, 116, 0, 97, 95, 101,
-		110, 116, 114, 105, 101, 115, 0, 112, 111, 115, 105, 120, 95, 97, 99, 108,
-		95, 101, 110, 116, 114, 121, 0, 101, 95, 116, 97, 103, 0, 101, 95, 112,
-		101, 114, 109, 0, 101, 95, 117, 105, 100, 0, 101, 95, 103, 105, 100, 0,
-		105, 95, 110, 108, 105, 110, 107, 0, 95, 95, 105, 95, 110, 108, 105, 110,
-		107, 0, 100, 101, 118, 95, 116, 0, 95, 95, 107, 101, 114, 110, 101, 108,
-		95, 100, 101, 118, 95, 116, 0, 116, 105, 109, 101, 115, 112, 101, 99, 54,
-		52, 0, 98, 108, 107, 99, 110, 116, 95, 116, 0, 105, 95, 100, 101, 110,
-		116, 114, 121, 0, 105, 95, 114, 99, 117, 0, 105, 95, 102, 111, 112, 0,
-		102, 114, 101, 101, 95, 105, 110, 111, 100, 101, 0, 102, 105, 108, 101, 95,
-		111, 112, 101, 114, 97, 116, 105, 111, 110, 115, 0, 108, 108, 115, 101, 101,
-		107, 0, 114, 101, 97, 100, 95, 105, 116, 101, 114, 0, 119, 114, 105, 116,
-		101, 95, 105, 116, 101, 114, 0, 105, 111, 112, 111, 108, 108, 0, 105, 116,
-		101, 114, 97, 116, 101, 0, 105, 116, 101, 114, 97, 116, 101, 95, 115, 104,
-		97, 114, 101, 100, 0, 117, 110, 108, 111, 99, 107, 101, 100, 95, 105, 111,
-		99, 116, 108, 0, 99, 111, 109, 112, 97, 116, 95, 105, 111, 99, 116, 108,
-		0, 109, 109, 97, 112, 95, 115, 117, 112, 112, 111, 114, 116, 101, 100, 95,
-		102, 108, 97, 103, 115, 0, 102, 108, 117, 115, 104, 0, 114, 101, 108, 101,
-		97, 115, 101, 0, 102, 115, 121, 110, 99, 0, 115, 101, 110, 100, 112, 97,
-		103, 101, 0, 99, 104, 101, 99, 107, 95, 102, 108, 97, 103, 115, 0, 102,
-		108, 111, 99, 107, 0, 115, 112, 108, 105, 99, 101, 95, 119, 114, 105, 116,
-		101, 0, 115, 112, 108, 105, 99, 101, 95, 114, 101, 97, 100, 0, 115, 101,
-		116, 108, 101, 97, 115, 101, 0, 102, 97, 108, 108, 111, 99, 97, 116, 101,
-		0, 115, 104, 111, 119, 95, 102, 100, 105, 110, 102, 111, 0, 99, 111, 112,
-		121, 95, 102, 105, 108, 101, 95, 114, 97, 110, 103, 101, 0, 114, 101, 109,
-		97, 112, 95, 102, 105, 108, 101, 95, 114, 97, 110, 103, 101, 0, 102, 97,
-		100, 118, 105, 115, 101, 0, 117, 114, 105, 110, 103, 95, 99, 109, 100, 0,
-		109, 107, 111, 98, 106, 0, 109, 111, 100, 105, 110, 102, 111, 95, 97, 116,
-		116, 114, 115, 0, 118, 101, 114, 115, 105, 111, 110, 0, 115, 114, 99, 118,
-		101, 114, 115, 105, 111, 110, 0, 104, 111, 108, 100, 101, 114, 115, 95, 100,
-		105, 114, 0, 115, 121, 109, 115, 0, 99, 114, 99, 115, 0, 110, 117, 109,
-		95, 115, 121, 109, 115, 0, 112, 97, 114, 97, 109, 95, 108, 111, 99, 107,
-		0, 107, 112, 0, 110, 117, 109, 95, 107, 112, 0, 110, 117, 109, 95, 103,
-		112, 108, 95, 115, 121, 109, 115, 0, 103, 112, 108, 95, 115, 121, 109, 115,
-		0, 103, 112, 108, 95, 99, 114, 99, 115, 0, 117, 115, 105, 110, 103, 95,
-		103, 112, 108, 111, 110, 108, 121, 95, 115, 121, 109, 98, 111, 108, 115, 0,
-		115, 105, 103, 95, 111, 107, 0, 97, 115, 121, 110, 99, 95, 112, 114, 111,
-		98, 101, 95, 114, 101, 113, 117, 101, 115, 116, 101, 100, 0, 110, 117, 109,
-		95, 101, 120, 101, 110, 116, 114, 105, 101, 115, 0, 101, 120, 116, 97, 98,
-		108, 101, 0, 105, 110, 105, 116, 0, 99, 111, 114, 101, 95, 108, 97, 121,
-		111, 117, 116, 0, 105, 110, 105, 116, 95, 108, 97, 121, 111, 117, 116, 0,
-		97, 114, 99, 104, 0, 116, 97, 105, 110, 116, 115, 0, 110, 117, 109, 95,
-		98, 117, 103, 115, 0, 98, 117, 103, 95, 108, 105, 115, 116, 0, 98, 117,
-		103, 95, 116, 97, 98, 108, 101, 0, 107, 97, 108, 108, 115, 121, 109, 115,
-		0, 99, 111, 114, 101, 95, 107, 97, 108, 108, 115, 121, 109, 115, 0, 115,
-		101, 99, 116, 95, 97, 116, 116, 114, 115, 0, 110, 111, 116, 101, 115, 95,
-		97, 116, 116, 114, 115, 0, 112, 101, 114, 99, 112, 117, 0, 112, 101, 114,
-		99, 112, 117, 95, 115, 105, 122, 101, 0, 110, 111, 105, 110, 115, 116, 114,
-		95, 116, 101, 120, 116, 95, 115, 116, 97, 114, 116, 0, 110, 111, 105, 110,
-		115, 116, 114, 95, 116, 101, 120, 116, 95, 115, 105, 122, 101, 0, 110, 117,
-		109, 95, 116, 114, 97, 99, 101, 112, 111, 105, 110, 116, 115, 0, 116, 114,
-		97, 99, 101, 112, 111, 105, 110, 116, 115, 95, 112, 116, 114, 115, 0, 110,
-		117, 109, 95, 115, 114, 99, 117, 95, 115, 116, 114, 117, 99, 116, 115, 0,
-		115, 114, 99, 117, 95, 115, 116, 114, 117, 99, 116, 95, 112, 116, 114, 115,
-		0, 110, 117, 109, 95, 98, 112, 102, 95, 114, 97, 119, 95, 101, 118, 101,
-		110, 116, 115, 0, 98, 112, 102, 95, 114, 97, 119, 95, 101, 118, 101, 110,
-		116, 115, 0, 98, 116, 102, 95, 100, 97, 116, 97, 95, 115, 105, 122, 101,
-		0, 98, 116, 102, 95, 100, 97, 116, 97, 0, 106, 117, 109, 112, 95, 101,
-		110, 116, 114, 105, 101, 115, 0, 110, 117, 109, 95, 106, 117, 109, 112, 95,
-		101, 110, 116, 114, 105, 101, 115, 0, 110, 117, 109, 95, 116, 114, 97, 99,
-		101, 95, 98, 112, 114, 105, 110, 116, 107, 95, 102, 109, 116, 0, 116, 114,
-		97, 99, 101, 95, 98, 112, 114, 105, 110, 116, 107, 95, 102, 109, 116, 95,
-		115, 116, 97, 114, 116, 0, 116, 114, 97, 99, 101, 95, 101, 118, 101, 110,
-		116, 115, 0, 110, 117, 109, 95, 116, 114, 97, 99, 101, 95, 101, 118, 101,
-		110, 116, 115, 0, 116, 114, 97, 99, 101, 95, 101, 118, 97, 108, 115, 0,
-		110, 117, 109, 95, 116, 114, 97, 99, 101, 95, 101, 118, 97, 108, 115, 0,
-		110, 117, 109, 95, 102, 116, 114, 97, 99, 101, 95, 99, 97, 108, 108, 115,
-		105, 116, 101, 115, 0, 102, 116, 114, 97, 99, 101, 95, 99, 97, 108, 108,
-		115, 105, 116, 101, 115, 0, 107, 112, 114, 111, 98, 101, 115, 95, 116, 101,
-		120, 116, 95, 115, 116, 97, 114, 116, 0, 107, 112, 114, 111, 98, 101, 115,
-		95, 116, 101, 120, 116, 95, 115, 105, 122, 101, 0, 107, 112, 114, 111, 98,
-		101, 95, 98, 108, 97, 99, 107, 108, 105, 115, 116, 0, 110, 117, 109, 95,
-		107, 112, 114, 111, 98, 101, 95, 98, 108, 97, 99, 107, 108, 105, 115, 116,
-		0, 110, 117, 109, 95, 107, 117, 110, 105, 116, 95, 115, 117, 105, 116, 101,
-		115, 0, 107, 117, 110, 105, 116, 95, 115, 117, 105, 116, 101, 115, 0, 112,
-		114, 105, 110, 116, 107, 95, 105, 110, 100, 101, 120, 95, 115, 105, 122, 101,
-		0, 112, 114, 105, 110, 116, 107, 95, 105, 110, 100, 101, 120, 95, 115, 116,
-		97, 114, 116, 0, 115, 111, 117, 114, 99, 101, 95, 108, 105, 115, 116, 0,
-		116, 97, 114, 103, 101, 116, 95, 108, 105, 115, 116, 0, 101, 120, 105, 116,
-		0, 101, 105, 95, 102, 117, 110, 99, 115, 0, 110, 117, 109, 95, 101, 105,
-		95, 102, 117, 110, 99, 115, 0, 109, 111, 100, 117, 108, 101, 95, 115, 116,
-		97, 116, 101, 0, 77, 79, 68, 85, 76, 69, 95, 83, 84, 65, 84, 69,
-		95, 76, 73, 86, 69, 0, 77, 79, 68, 85, 76, 69, 95, 83, 84, 65,
-		84, 69, 95, 67, 79, 77, 73, 78, 71, 0, 77, 79, 68, 85, 76, 69,
-		95, 83, 84, 65, 84, 69, 95, 71, 79, 73, 78, 71, 0, 77, 79, 68,
-		85, 76, 69, 95, 83, 84, 65, 84, 69, 95, 85, 78, 70, 79, 82, 77,
-		69, 68, 0, 109, 111, 100, 117, 108, 101, 95, 107, 111, 98, 106, 101, 99,
-		116, 0, 107, 111, 98, 106, 0, 109, 111, 100, 0, 100, 114, 105, 118, 101,
-		114, 115, 95, 100, 105, 114, 0, 109, 112, 0, 107, 111, 98, 106, 95, 99,
-		111, 109, 112, 108, 101, 116, 105, 111, 110, 0, 107, 101, 114, 110, 101, 108,
-		95, 115, 121, 109, 98, 111, 108, 0, 118, 97, 108, 117, 101, 95, 111, 102,
-		102, 115, 101, 116, 0, 110, 97, 109, 101, 95, 111, 102, 102, 115, 101, 116,
-		0, 110, 97, 109, 101, 115, 112, 97, 99, 101, 95, 111, 102, 102, 115, 101,
-		116, 0, 109, 111, 100, 117, 108, 101, 95, 108, 97, 121, 111, 117, 116, 0,
-		116, 101, 120, 116, 95, 115, 105, 122, 101, 0, 114, 111, 95, 115, 105, 122,
-		101, 0, 114, 111, 95, 97, 102, 116, 101, 114, 95, 105, 110, 105, 116, 95,
-		115, 105, 122, 101, 0, 109, 116, 110, 0, 109, 111, 100, 95, 116, 114, 101,
-		101, 95, 110, 111, 100, 101, 0, 108, 97, 116, 99, 104, 95, 116, 114, 101,
-		101, 95, 110, 111, 100, 101, 0, 109, 111, 100, 95, 97, 114, 99, 104, 95,
-		115, 112, 101, 99, 105, 102, 105, 99, 0, 99, 111, 114, 101, 0, 102, 116,
-		114, 97, 99, 101, 95, 116, 114, 97, 109, 112, 111, 108, 105, 110, 101, 115,
-		0, 109, 111, 100, 95, 112, 108, 116, 95, 115, 101, 99, 0, 112, 108, 116,
-		95, 115, 104, 110, 100, 120, 0, 112, 108, 116, 95, 110, 117, 109, 95, 101,
-		110, 116, 114, 105, 101, 115, 0, 112, 108, 116, 95, 109, 97, 120, 95, 101,
-		110, 116, 114, 105, 101, 115, 0, 109, 111, 100, 95, 107, 97, 108, 108, 115,
-		121, 109, 115, 0, 115, 121, 109, 116, 97, 98, 0, 110, 117, 109, 95, 115,
-		121, 109, 116, 97, 98, 0, 115, 116, 114, 116, 97, 98, 0, 116, 121, 112,
-		101, 116, 97, 98, 0, 69, 108, 102, 54, 52, 95, 83, 121, 109, 0, 116,
-		114, 97, 99, 101, 112, 111, 105, 110, 116, 95, 112, 116, 114, 95, 116, 0,
-		115, 115, 105, 122, 101, 95, 116, 0, 95, 95, 107, 101, 114, 110, 101, 108,
-		95, 115, 115, 105, 122, 101, 95, 116, 0, 95, 95, 107, 101, 114, 110, 101,
-		108, 95, 108, 111, 110, 103, 95, 116, 0, 107, 105, 111, 99, 98, 0, 107,
-		105, 95, 102, 105, 108, 112, 0, 107, 105, 95, 112, 111, 115, 0, 107, 105,
-		95, 99, 111, 109, 112, 108, 101, 116, 101, 0, 107, 105, 95, 102, 108, 97,
-		103, 115, 0, 107, 105, 95, 105, 111, 112, 114, 105, 111, 0, 107, 105, 95,
-		119, 97, 105, 116, 113, 0, 105, 111, 118, 95, 105, 116, 101, 114, 0, 105,
-		116, 101, 114, 95, 116, 121, 112, 101, 0, 110, 111, 102, 97, 117, 108, 116,
-		0, 100, 97, 116, 97, 95, 115, 111, 117, 114, 99, 101, 0, 117, 115, 101,
-		114, 95, 98, 97, 99, 107, 101, 100, 0, 105, 111, 118, 95, 111, 102, 102,
-		115, 101, 116, 0, 108, 97, 115, 116, 95, 111, 102, 102, 115, 101, 116, 0,
-		105, 111, 118, 0, 107, 118, 101, 99, 0, 98, 118, 101, 99, 0, 120, 97,
-		114, 114, 97, 121, 0, 112, 105, 112, 101, 0, 117, 98, 117, 102, 0, 112,
-		105, 112, 101, 95, 105, 110, 111, 100, 101, 95, 105, 110, 102, 111, 0, 114,
-		100, 95, 119, 97, 105, 116, 0, 119, 114, 95, 119, 97, 105, 116, 0, 109,
-		97, 120, 95, 117, 115, 97, 103, 101, 0, 114, 105, 110, 103, 95, 115, 105,
-		122, 101, 0, 110, 111, 116, 101, 95, 108, 111, 115, 115, 0, 110, 114, 95,
-		97, 99, 99, 111, 117, 110, 116, 101, 100, 0, 114, 101, 97, 100, 101, 114,
-		115, 0, 119, 114, 105, 116, 101, 114, 115, 0, 114, 95, 99, 111, 117, 110,
-		116, 101, 114, 0, 119, 95, 99, 111, 117, 110, 116, 101, 114, 0, 112, 111,
-		108, 108, 95, 117, 115, 97, 103, 101, 0, 116, 109, 112, 95, 112, 97, 103,
-		101, 0, 102, 97, 115, 121, 110, 99, 95, 114, 101, 97, 100, 101, 114, 115,
-		0, 102, 97, 115, 121, 110, 99, 95, 119, 114, 105, 116, 101, 114, 115, 0,
-		98, 117, 102, 115, 0, 119, 97, 116, 99, 104, 95, 113, 117, 101, 117, 101,
-		0, 95, 114, 101, 102, 99, 111, 117, 110, 116, 0, 109, 101, 109, 99, 103,
-		95, 100, 97, 116, 97, 0, 109, 97, 112, 112, 105, 110, 103, 0, 108, 114,
-		117, 0, 98, 117, 100, 100, 121, 95, 108, 105, 115, 116, 0, 112, 99, 112,
-		95, 108, 105, 115, 116, 0, 95, 95, 102, 105, 108, 108, 101, 114, 0, 109,
-		108, 111, 99, 107, 95, 99, 111, 117, 110, 116, 0, 112, 112, 95, 109, 97,
-		103, 105, 99, 0, 112, 112, 0, 95, 112, 112, 95, 109, 97, 112, 112, 105,
-		110, 103, 95, 112, 97, 100, 0, 100, 109, 97, 95, 97, 100, 100, 114, 0,
-		100, 109, 97, 95, 97, 100, 100, 114, 95, 117, 112, 112, 101, 114, 0, 112,
-		112, 95, 102, 114, 97, 103, 95, 99, 111, 117, 110, 116, 0, 99, 111, 109,
-		112, 111, 117, 110, 100, 95, 104, 101, 97, 100, 0, 99, 111, 109, 112, 111,
-		117, 110, 100, 95, 100, 116, 111, 114, 0, 99, 111, 109, 112, 111, 117, 110,
-		100, 95, 111, 114, 100, 101, 114, 0, 99, 111, 109, 112, 111, 117, 110, 100,
-		95, 109, 97, 112, 99, 111, 117, 110, 116, 0, 99, 111, 109, 112, 111, 117,
-		110, 100, 95, 112, 105, 110, 99, 111, 117, 110, 116, 0, 99, 111, 109, 112,
-		111, 117, 110, 100, 95, 110, 114, 0, 95, 99, 111, 109, 112, 111, 117, 110,
-		100, 95, 112, 97, 100, 95, 49, 0, 95, 99, 111, 109, 112, 111, 117, 110,
-		100, 95, 112, 97, 100, 95, 50, 0, 100, 101, 102, 101, 114, 114, 101, 100,
-		95, 108, 105, 115, 116, 0, 95, 112, 116, 95, 112, 97, 100, 95, 49, 0,
-		112, 109, 100, 95, 104, 117, 103, 101, 95, 112, 116, 101, 0, 95, 112, 116,
-		95, 112, 97, 100, 95, 50, 0, 112, 116, 108, 0, 112, 103, 116, 97, 98,
-		108, 101, 95, 116, 0, 112, 116, 95, 109, 109, 0, 112, 116, 95, 102, 114,
-		97, 103, 95, 114, 101, 102, 99, 111, 117, 110, 116, 0, 112, 103, 109, 97,
-		112, 0, 122, 111, 110, 101, 95, 100, 101, 118, 105, 99, 101, 95, 100, 97,
-		116, 97, 0, 95, 109, 97, 112, 99, 111, 117, 110, 116, 0, 112, 97, 103,
-		101, 95, 116, 121, 112, 101, 0, 102, 97, 115, 121, 110, 99, 95, 115, 116,
-		114, 117, 99, 116, 0, 102, 97, 95, 108, 111, 99, 107, 0, 109, 97, 103,
-		105, 99, 0, 102, 97, 95, 102, 100, 0, 102, 97, 95, 110, 101, 120, 116,
-		0, 102, 97, 95, 102, 105, 108, 101, 0, 102, 97, 95, 114, 99, 117, 0,
-		117, 115, 101, 114, 95, 115, 116, 114, 117, 99, 116, 0, 95, 95, 99, 111,
-		117, 110, 116, 0, 101, 112, 111, 108, 108, 95, 119, 97, 116, 99, 104, 101,
-		115, 0, 117, 110, 105, 120, 95, 105, 110, 102, 108, 105, 103, 104, 116, 0,
-		112, 105, 112, 101, 95, 98, 117, 102, 115, 0, 117, 105, 100, 104, 97, 115,
-		104, 95, 110, 111, 100, 101, 0, 110, 114, 95, 119, 97, 116, 99, 104, 101,
-		115, 0, 114, 97, 116, 101, 108, 105, 109, 105, 116, 0, 114, 97, 116, 101,
-		108, 105, 109, 105, 116, 95, 115, 116, 97, 116, 101, 0, 105, 110, 116, 101,
-		114, 118, 97, 108, 0, 112, 114, 105, 110, 116, 101, 100, 0, 109, 105, 115,
-		115, 101, 100, 0, 98, 101, 103, 105, 110, 0, 110, 114, 95, 115, 101, 103,
-		115, 0, 120, 97, 114, 114, 97, 121, 95, 115, 116, 97, 114, 116, 0, 115,
-		116, 97, 114, 116, 95, 104, 101, 97, 100, 0, 105, 111, 95, 99, 111, 109,
-		112, 95, 98, 97, 116, 99, 104, 0, 114, 101, 113, 95, 108, 105, 115, 116,
-		0, 110, 101, 101, 100, 95, 116, 115, 0, 99, 111, 109, 112, 108, 101, 116,
-		101, 0, 100, 105, 114, 95, 99, 111, 110, 116, 101, 120, 116, 0, 97, 99,
-		116, 111, 114, 0, 112, 111, 115, 0, 102, 105, 108, 108, 100, 105, 114, 95,
-		116, 0, 95, 95, 112, 111, 108, 108, 95, 116, 0, 112, 111, 108, 108, 95,
-		116, 97, 98, 108, 101, 95, 115, 116, 114, 117, 99, 116, 0, 95, 113, 112,
-		114, 111, 99, 0, 95, 107, 101, 121, 0, 112, 111, 108, 108, 95, 113, 117,
-		101, 117, 101, 95, 112, 114, 111, 99, 0, 102, 108, 95, 111, 119, 110, 101,
-		114, 95, 116, 0, 102, 105, 108, 101, 95, 108, 111, 99, 107, 0, 102, 108,
-		95, 98, 108, 111, 99, 107, 101, 114, 0, 102, 108, 95, 108, 105, 115, 116,
-		0, 102, 108, 95, 108, 105, 110, 107, 0, 102, 108, 95, 98, 108, 111, 99,
-		107, 101, 100, 95, 114, 101, 113, 117, 101, 115, 116, 115, 0, 102, 108, 95,
-		98, 108, 111, 99, 107, 101, 100, 95, 109, 101, 109, 98, 101, 114, 0, 102,
-		108, 95, 111, 119, 110, 101, 114, 0, 102, 108, 95, 102, 108, 97, 103, 115,
-		0, 102, 108, 95, 116, 121, 112, 101, 0, 102, 108, 95, 112, 105, 100, 0,
-		102, 108, 95, 108, 105, 110, 107, 95, 99, 112, 117, 0, 102, 108, 95, 119,
-		97, 105, 116, 0, 102, 108, 95, 102, 105, 108, 101, 0, 102, 108, 95, 115,
-		116, 97, 114, 116, 0, 102, 108, 95, 101, 110, 100, 0, 102, 108, 95, 102,
-		97, 115, 121, 110, 99, 0, 102, 108, 95, 98, 114, 101, 97, 107, 95, 116,
-		105, 109, 101, 0, 102, 108, 95, 100, 111, 119, 110, 103, 114, 97, 100, 101,
-		95, 116, 105, 109, 101, 0, 102, 108, 95, 111, 112, 115, 0, 102, 108, 95,
-		108, 109, 111, 112, 115, 0, 102, 108, 95, 117, 0, 110, 102, 115, 95, 102,
-		108, 0, 110, 102, 115, 52, 95, 102, 108, 0, 97, 102, 115, 0, 110, 102,
-		115, 95, 108, 111, 99, 107, 95, 105, 110, 102, 111, 0, 110, 108, 109, 95,
-		108, 111, 99, 107, 111, 119, 110, 101, 114, 0, 110, 102, 115, 52, 95, 108,
-		111, 99, 107, 95, 105, 110, 102, 111, 0, 100, 101, 98, 117, 103, 95, 105,
-		100, 0, 105, 111, 95, 117, 114, 105, 110, 103, 95, 99, 109, 100, 0, 99,
-		109, 100, 0, 116, 97, 115, 107, 95, 119, 111, 114, 107, 95, 99, 98, 0,
-		99, 109, 100, 95, 111, 112, 0, 112, 97, 100, 0, 112, 100, 117, 0, 105,
-		95, 112, 105, 112, 101, 0, 105, 95, 99, 100, 101, 118, 0, 105, 95, 108,
-		105, 110, 107, 0, 105, 95, 100, 105, 114, 95, 115, 101, 113, 0, 120, 97,
-		95, 108, 111, 99, 107, 0, 120, 97, 95, 102, 108, 97, 103, 115, 0, 120,
-		97, 95, 104, 101, 97, 100, 0, 112, 101, 114, 102, 95, 111, 117, 116, 112,
-		117, 116, 95, 104, 97, 110, 100, 108, 101, 0, 101, 118, 101, 110, 116, 0,
-		119, 97, 107, 101, 117, 112, 0, 97, 117, 120, 95, 102, 108, 97, 103, 115,
-		0, 97, 100, 100, 114, 0, 112, 101, 114, 102, 95, 101, 118, 101, 110, 116,
-		95, 115, 116, 97, 116, 101, 0, 80, 69, 82, 70, 95, 69, 86, 69, 78,
-		84, 95, 83, 84, 65, 84, 69, 95, 68, 69, 65, 68, 0, 80, 69, 82,
-		70, 95, 69, 86, 69, 78, 84, 95, 83, 84, 65, 84, 69, 95, 69, 88,
-		73, 84, 0, 80, 69, 82, 70, 95, 69, 86, 69, 78, 84, 95, 83, 84,
-		65, 84, 69, 95, 69, 82, 82, 79, 82, 0, 80, 69, 82, 70, 95, 69,
-		86, 69, 78, 84, 95, 83, 84, 65, 84, 69, 95, 79, 70, 70, 0, 80,
+		98, 116, 114, 101, 101, 95, 108, 97, 115, 116, 0, 97, 116, 111, 109, 105,
+		99, 95, 108, 111, 110, 103, 95, 116, 0, 97, 116, 111, 109, 105, 99, 54,
+		52, 95, 116, 0, 99, 116, 120, 0, 116, 97, 115, 107, 95, 114, 115, 115,
+		95, 115, 116, 97, 116, 0, 97, 114, 99, 104, 95, 100, 97, 116, 97, 0,
+		102, 110, 0, 102, 117, 116, 101, 120, 0, 110, 97, 110, 111, 115, 108, 101,
+		101, 112, 0, 112, 111, 108, 108, 0, 117, 97, 100, 100, 114, 0, 118, 97,
+		108, 0, 98, 105, 116, 115, 101, 116, 0, 116, 105, 109, 101, 0, 117, 97,
+		100, 100, 114, 50, 0, 99, 108, 111, 99, 107, 105, 100, 0, 99, 108, 111,
+		99, 107, 105, 100, 95, 116, 0, 95, 95, 107, 101, 114, 110, 101, 108, 95,
+		99, 108, 111, 99, 107, 105, 100, 95, 116, 0, 116, 105, 109, 101, 115, 112,
+		101, 99, 95, 116, 121, 112, 101, 0, 84, 84, 95, 78, 79, 78, 69, 0,
+		84, 84, 95, 78, 65, 84, 73, 86, 69, 0, 84, 84, 95, 67, 79, 77,
+		80, 65, 84, 0, 114, 109, 116, 112, 0, 99, 111, 109, 112, 97, 116, 95,
+		114, 109, 116, 112, 0, 117, 102, 100, 115, 0, 110, 102, 100, 115, 0, 104,
+		97, 115, 95, 116, 105, 109, 101, 111, 117, 116, 0, 116, 118, 95, 115, 101,
+		99, 0, 116, 118, 95, 110, 115, 101, 99, 0, 112, 105, 100, 95, 116, 0,
+		95, 95, 107, 101, 114, 110, 101, 108, 95, 112, 105, 100, 95, 116, 0, 104,
+		108, 105, 115, 116, 95, 110, 111, 100, 101, 0, 112, 112, 114, 101, 118, 0,
+		108, 111, 99, 107, 0, 114, 97, 119, 95, 115, 112, 105, 110, 108, 111, 99,
+		107, 95, 116, 0, 114, 97, 119, 95, 115, 112, 105, 110, 108, 111, 99, 107,
+		0, 114, 97, 119, 95, 108, 111, 99, 107, 0, 97, 114, 99, 104, 95, 115,
+		112, 105, 110, 108, 111, 99, 107, 95, 116, 0, 113, 115, 112, 105, 110, 108,
+		111, 99, 107, 0, 108, 111, 99, 107, 101, 100, 0, 108, 111, 99, 107, 101,
+		100, 95, 112, 101, 110, 100, 105, 110, 103, 0, 116, 97, 105, 108, 0, 115,
+		101, 113, 99, 111, 117, 110, 116, 0, 115, 116, 97, 114, 116, 116, 105, 109,
+		101, 0, 115, 101, 113, 99, 111, 117, 110, 116, 95, 116, 0, 115, 101, 113,
+		117, 101, 110, 99, 101, 0, 118, 116, 105, 109, 101, 95, 115, 116, 97, 116,
+		101, 0, 86, 84, 73, 77, 69, 95, 73, 78, 65, 67, 84, 73, 86, 69,
+		0, 86, 84, 73, 77, 69, 95, 73, 68, 76, 69, 0, 86, 84, 73, 77,
+		69, 95, 83, 89, 83, 0, 86, 84, 73, 77, 69, 95, 85, 83, 69, 82,
+		0, 86, 84, 73, 77, 69, 95, 71, 85, 69, 83, 84, 0, 98, 97, 115,
+		101, 115, 0, 116, 105, 109, 101, 114, 115, 95, 97, 99, 116, 105, 118, 101,
+		0, 101, 120, 112, 105, 114, 121, 95, 97, 99, 116, 105, 118, 101, 0, 112,
+		111, 115, 105, 120, 95, 99, 112, 117, 116, 105, 109, 101, 114, 95, 98, 97,
+		115, 101, 0, 110, 101, 120, 116, 101, 118, 116, 0, 116, 113, 104, 101, 97,
+		100, 0, 116, 105, 109, 101, 114, 113, 117, 101, 117, 101, 95, 104, 101, 97,
+		100, 0, 114, 98, 95, 114, 111, 111, 116, 0, 114, 98, 95, 114, 111, 111,
+		116, 95, 99, 97, 99, 104, 101, 100, 0, 114, 98, 95, 108, 101, 102, 116,
+		109, 111, 115, 116, 0, 119, 111, 114, 107, 0, 115, 99, 104, 101, 100, 117,
+		108, 101, 100, 0, 99, 97, 108, 108, 98, 97, 99, 107, 95, 104, 101, 97,
+		100, 0, 102, 117, 110, 99, 0, 115, 121, 115, 118, 95, 115, 101, 109, 0,
+		117, 110, 100, 111, 95, 108, 105, 115, 116, 0, 115, 121, 115, 118, 95, 115,
+		104, 109, 0, 115, 104, 109, 95, 99, 108, 105, 115, 116, 0, 115, 105, 103,
+		115, 101, 116, 95, 116, 0, 115, 105, 103, 0, 115, 105, 103, 112, 101, 110,
+		100, 105, 110, 103, 0, 108, 105, 115, 116, 0, 115, 105, 122, 101, 95, 116,
+		0, 95, 95, 107, 101, 114, 110, 101, 108, 95, 115, 105, 122, 101, 95, 116,
+		0, 95, 95, 107, 101, 114, 110, 101, 108, 95, 117, 108, 111, 110, 103, 95,
+		116, 0, 107, 117, 105, 100, 95, 116, 0, 117, 105, 100, 95, 116, 0, 95,
+		95, 107, 101, 114, 110, 101, 108, 95, 117, 105, 100, 51, 50, 95, 116, 0,
+		109, 111, 100, 101, 0, 102, 105, 108, 116, 101, 114, 95, 99, 111, 117, 110,
+		116, 0, 102, 105, 108, 116, 101, 114, 0, 115, 121, 115, 99, 97, 108, 108,
+		95, 117, 115, 101, 114, 95, 100, 105, 115, 112, 97, 116, 99, 104, 0, 115,
+		112, 105, 110, 108, 111, 99, 107, 95, 116, 0, 115, 112, 105, 110, 108, 111,
+		99, 107, 0, 114, 108, 111, 99, 107, 0, 119, 97, 107, 101, 95, 113, 95,
+		110, 111, 100, 101, 0, 107, 101, 114, 110, 101, 108, 95, 115, 105, 103, 105,
+		110, 102, 111, 95, 116, 0, 116, 97, 115, 107, 95, 105, 111, 95, 97, 99,
+		99, 111, 117, 110, 116, 105, 110, 103, 0, 114, 99, 104, 97, 114, 0, 119,
+		99, 104, 97, 114, 0, 115, 121, 115, 99, 114, 0, 115, 121, 115, 99, 119,
+		0, 114, 101, 97, 100, 95, 98, 121, 116, 101, 115, 0, 119, 114, 105, 116,
+		101, 95, 98, 121, 116, 101, 115, 0, 99, 97, 110, 99, 101, 108, 108, 101,
+		100, 95, 119, 114, 105, 116, 101, 95, 98, 121, 116, 101, 115, 0, 110, 111,
+		100, 101, 109, 97, 115, 107, 95, 116, 0, 115, 101, 113, 99, 111, 117, 110,
+		116, 95, 115, 112, 105, 110, 108, 111, 99, 107, 95, 116, 0, 115, 101, 113,
+		99, 111, 117, 110, 116, 95, 115, 112, 105, 110, 108, 111, 99, 107, 0, 109,
+		117, 116, 101, 120, 0, 111, 119, 110, 101, 114, 0, 119, 97, 105, 116, 95,
+		108, 111, 99, 107, 0, 111, 115, 113, 0, 119, 97, 105, 116, 95, 108, 105,
+		115, 116, 0, 111, 112, 116, 105, 109, 105, 115, 116, 105, 99, 95, 115, 112,
+		105, 110, 95, 113, 117, 101, 117, 101, 0, 112, 101, 114, 102, 95, 101, 118,
+		101, 110, 116, 95, 99, 111, 110, 116, 101, 120, 116, 0, 112, 109, 117, 0,
+		97, 99, 116, 105, 118, 101, 95, 99, 116, 120, 95, 108, 105, 115, 116, 0,
+		112, 105, 110, 110, 101, 100, 95, 103, 114, 111, 117, 112, 115, 0, 102, 108,
+		101, 120, 105, 98, 108, 101, 95, 103, 114, 111, 117, 112, 115, 0, 101, 118,
+		101, 110, 116, 95, 108, 105, 115, 116, 0, 112, 105, 110, 110, 101, 100, 95,
+		97, 99, 116, 105, 118, 101, 0, 102, 108, 101, 120, 105, 98, 108, 101, 95,
+		97, 99, 116, 105, 118, 101, 0, 110, 114, 95, 101, 118, 101, 110, 116, 115,
+		0, 110, 114, 95, 97, 99, 116, 105, 118, 101, 0, 110, 114, 95, 117, 115,
+		101, 114, 0, 105, 115, 95, 97, 99, 116, 105, 118, 101, 0, 110, 114, 95,
+		115, 116, 97, 116, 0, 110, 114, 95, 102, 114, 101, 113, 0, 114, 111, 116,
+		97, 116, 101, 95, 100, 105, 115, 97, 98, 108, 101, 0, 114, 111, 116, 97,
+		116, 101, 95, 110, 101, 99, 101, 115, 115, 97, 114, 121, 0, 114, 101, 102,
+		99, 111, 117, 110, 116, 0, 116, 97, 115, 107, 0, 116, 105, 109, 101, 115,
+		116, 97, 109, 112, 0, 116, 105, 109, 101, 111, 102, 102, 115, 101, 116, 0,
+		112, 97, 114, 101, 110, 116, 95, 99, 116, 120, 0, 112, 97, 114, 101, 110,
+		116, 95, 103, 101, 110, 0, 103, 101, 110, 101, 114, 97, 116, 105, 111, 110,
+		0, 112, 105, 110, 95, 99, 111, 117, 110, 116, 0, 110, 114, 95, 99, 103,
+		114, 111, 117, 112, 115, 0, 116, 97, 115, 107, 95, 99, 116, 120, 95, 100,
+		97, 116, 97, 0, 110, 114, 95, 112, 101, 110, 100, 105, 110, 103, 0, 112,
+		101, 114, 102, 95, 101, 118, 101, 110, 116, 95, 103, 114, 111, 117, 112, 115,
+		0, 116, 114, 101, 101, 0, 105, 110, 100, 101, 120, 0, 108, 111, 99, 97,
+		108, 95, 116, 0, 97, 0, 115, 104, 111, 114, 116, 0, 116, 108, 98, 102,
+		108, 117, 115, 104, 95, 117, 110, 109, 97, 112, 95, 98, 97, 116, 99, 104,
+		0, 114, 99, 117, 95, 117, 115, 101, 114, 115, 0, 114, 99, 117, 0, 112,
+		97, 103, 101, 95, 102, 114, 97, 103, 0, 112, 97, 103, 101, 0, 111, 102,
+		102, 115, 101, 116, 0, 115, 105, 122, 101, 0, 98, 97, 99, 107, 116, 114,
+		97, 99, 101, 0, 109, 97, 120, 0, 103, 102, 112, 95, 116, 0, 116, 105,
+		109, 101, 114, 95, 108, 105, 115, 116, 0, 101, 110, 116, 114, 121, 0, 108,
+		108, 105, 115, 116, 95, 104, 101, 97, 100, 0, 116, 104, 114, 101, 97, 100,
+		95, 115, 116, 114, 117, 99, 116, 0, 99, 112, 117, 95, 99, 111, 110, 116,
+		101, 120, 116, 0, 117, 119, 0, 102, 112, 115, 105, 109, 100, 95, 99, 112,
+		117, 0, 115, 118, 101, 95, 115, 116, 97, 116, 101, 0, 122, 97, 95, 115,
+		116, 97, 116, 101, 0, 118, 108, 0, 118, 108, 95, 111, 110, 101, 120, 101,
+		99, 0, 102, 97, 117, 108, 116, 95, 97, 100, 100, 114, 101, 115, 115, 0,
+		102, 97, 117, 108, 116, 95, 99, 111, 100, 101, 0, 100, 101, 98, 117, 103,
+		0, 107, 101, 121, 115, 95, 117, 115, 101, 114, 0, 107, 101, 121, 115, 95,
+		107, 101, 114, 110, 101, 108, 0, 109, 116, 101, 95, 99, 116, 114, 108, 0,
+		115, 99, 116, 108, 114, 95, 117, 115, 101, 114, 0, 115, 118, 99, 114, 0,
+		116, 112, 105, 100, 114, 50, 95, 101, 108, 48, 0, 120, 49, 57, 0, 120,
+		50, 48, 0, 120, 50, 49, 0, 120, 50, 50, 0, 120, 50, 51, 0, 120,
+		50, 52, 0, 120, 50, 53, 0, 120, 50, 54, 0, 120, 50, 55, 0, 120,
+		50, 56, 0, 102, 112, 0, 115, 112, 0, 112, 99, 0, 116, 112, 95, 118,
+		97, 108, 117, 101, 0, 116, 112, 50, 95, 118, 97, 108, 117, 101, 0, 102,
+		112, 115, 105, 109, 100, 95, 115, 116, 97, 116, 101, 0, 117, 115, 101, 114,
+		95, 102, 112, 115, 105, 109, 100, 95, 115, 116, 97, 116, 101, 0, 118, 114,
+		101, 103, 115, 0, 102, 112, 115, 114, 0, 102, 112, 99, 114, 0, 95, 95,
+		114, 101, 115, 101, 114, 118, 101, 100, 0, 117, 110, 115, 105, 103, 110, 101,
+		100, 32, 95, 95, 105, 110, 116, 49, 50, 56, 0, 100, 101, 98, 117, 103,
+		95, 105, 110, 102, 111, 0, 115, 117, 115, 112, 101, 110, 100, 101, 100, 95,
+		115, 116, 101, 112, 0, 98, 112, 115, 95, 100, 105, 115, 97, 98, 108, 101,
+		100, 0, 119, 112, 115, 95, 100, 105, 115, 97, 98, 108, 101, 100, 0, 104,
+		98, 112, 95, 98, 114, 101, 97, 107, 0, 104, 98, 112, 95, 119, 97, 116,
+		99, 104, 0, 112, 101, 114, 102, 95, 101, 118, 101, 110, 116, 0, 101, 118,
+		101, 110, 116, 95, 101, 110, 116, 114, 121, 0, 115, 105, 98, 108, 105, 110,
+		103, 95, 108, 105, 115, 116, 0, 97, 99, 116, 105, 118, 101, 95, 108, 105,
+		115, 116, 0, 103, 114, 111, 117, 112, 95, 105, 110, 100, 101, 120, 0, 109,
+		105, 103, 114, 97, 116, 101, 95, 101, 110, 116, 114, 121, 0, 104, 108, 105,
+		115, 116, 95, 101, 110, 116, 114, 121, 0, 97, 99, 116, 105, 118, 101, 95,
+		101, 110, 116, 114, 121, 0, 110, 114, 95, 115, 105, 98, 108, 105, 110, 103,
+		115, 0, 101, 118, 101, 110, 116, 95, 99, 97, 112, 115, 0, 103, 114, 111,
+		117, 112, 95, 99, 97, 112, 115, 0, 112, 109, 117, 95, 112, 114, 105, 118,
+		97, 116, 101, 0, 97, 116, 116, 97, 99, 104, 95, 115, 116, 97, 116, 101,
+		0, 99, 104, 105, 108, 100, 95, 99, 111, 117, 110, 116, 0, 116, 111, 116,
+		97, 108, 95, 116, 105, 109, 101, 95, 101, 110, 97, 98, 108, 101, 100, 0,
+		116, 111, 116, 97, 108, 95, 116, 105, 109, 101, 95, 114, 117, 110, 110, 105,
+		110, 103, 0, 116, 115, 116, 97, 109, 112, 0, 97, 116, 116, 114, 0, 104,
+		101, 97, 100, 101, 114, 95, 115, 105, 122, 101, 0, 105, 100, 95, 104, 101,
+		97, 100, 101, 114, 95, 115, 105, 122, 101, 0, 114, 101, 97, 100, 95, 115,
+		105, 122, 101, 0, 104, 119, 0, 99, 104, 105, 108, 100, 95, 116, 111, 116,
+		97, 108, 95, 116, 105, 109, 101, 95, 101, 110, 97, 98, 108, 101, 100, 0,
+		99, 104, 105, 108, 100, 95, 116, 111, 116, 97, 108, 95, 116, 105, 109, 101,
+		95, 114, 117, 110, 110, 105, 110, 103, 0, 99, 104, 105, 108, 100, 95, 109,
+		117, 116, 101, 120, 0, 99, 104, 105, 108, 100, 95, 108, 105, 115, 116, 0,
+		111, 110, 99, 112, 117, 0, 111, 119, 110, 101, 114, 95, 101, 110, 116, 114,
+		121, 0, 109, 109, 97, 112, 95, 109, 117, 116, 101, 120, 0, 109, 109, 97,
+		112, 95, 99, 111, 117, 110, 116, 0, 114, 98, 95, 101, 110, 116, 114, 121,
+		0, 114, 99, 117, 95, 98, 97, 116, 99, 104, 101, 115, 0, 114, 99, 117,
+		95, 112, 101, 110, 100, 105, 110, 103, 0, 119, 97, 105, 116, 113, 0, 102,
+		97, 115, 121, 110, 99, 0, 112, 101, 110, 100, 105, 110, 103, 95, 119, 97,
+		107, 101, 117, 112, 0, 112, 101, 110, 100, 105, 110, 103, 95, 107, 105, 108,
+		108, 0, 112, 101, 110, 100, 105, 110, 103, 95, 100, 105, 115, 97, 98, 108,
+		101, 0, 112, 101, 110, 100, 105, 110, 103, 95, 115, 105, 103, 116, 114, 97,
+		112, 0, 112, 101, 110, 100, 105, 110, 103, 95, 97, 100, 100, 114, 0, 112,
+		101, 110, 100, 105, 110, 103, 95, 105, 114, 113, 0, 112, 101, 110, 100, 105,
+		110, 103, 95, 116, 97, 115, 107, 0, 112, 101, 110, 100, 105, 110, 103, 95,
+		119, 111, 114, 107, 0, 101, 118, 101, 110, 116, 95, 108, 105, 109, 105, 116,
+		0, 97, 100, 100, 114, 95, 102, 105, 108, 116, 101, 114, 115, 0, 97, 100,
+		100, 114, 95, 102, 105, 108, 116, 101, 114, 95, 114, 97, 110, 103, 101, 115,
+		0, 97, 100, 100, 114, 95, 102, 105, 108, 116, 101, 114, 115, 95, 103, 101,
+		110, 0, 97, 117, 120, 95, 101, 118, 101, 110, 116, 0, 100, 101, 115, 116,
+		114, 111, 121, 0, 110, 115, 0, 108, 111, 115, 116, 95, 115, 97, 109, 112,
+		108, 101, 115, 0, 99, 108, 111, 99, 107, 0, 111, 118, 101, 114, 102, 108,
+		111, 119, 95, 104, 97, 110, 100, 108, 101, 114, 0, 111, 118, 101, 114, 102,
+		108, 111, 119, 95, 104, 97, 110, 100, 108, 101, 114, 95, 99, 111, 110, 116,
+		101, 120, 116, 0, 111, 114, 105, 103, 95, 111, 118, 101, 114, 102, 108, 111,
+		119, 95, 104, 97, 110, 100, 108, 101, 114, 0, 112, 114, 111, 103, 0, 98,
+		112, 102, 95, 99, 111, 111, 107, 105, 101, 0, 116, 112, 95, 101, 118, 101,
+		110, 116, 0, 102, 116, 114, 97, 99, 101, 95, 111, 112, 115, 0, 99, 103,
+		114, 112, 0, 115, 98, 95, 108, 105, 115, 116, 0, 112, 101, 114, 102, 95,
+		101, 118, 101, 110, 116, 95, 115, 116, 97, 116, 101, 0, 80, 69, 82, 70,
+		95, 69, 86, 69, 78, 84, 95, 83, 84, 65, 84, 69, 95, 68, 69, 65,
+		68, 0, 80, 69, 82, 70, 95, 69, 86, 69, 78, 84, 95, 83, 84, 65,
+		84, 69, 95, 69, 88, 73, 84, 0, 80, 69, 82, 70, 95, 69, 86, 69,
+		78, 84, 95, 83, 84, 65, 84, 69, 95, 69, 82, 82, 79, 82, 0, 80,
 		69, 82, 70, 95, 69, 86, 69, 78, 84, 95, 83, 84, 65, 84, 69, 95,
-		73, 78, 65, 67, 84, 73, 86, 69, 0, 80, 69, 82, 70, 95, 69, 86,
-		69, 78, 84, 95, 83, 84, 65, 84, 69, 95, 65, 67, 84, 73, 86, 69,
-		0, 108, 111, 99, 97, 108, 54, 52, 95, 116, 0, 112, 101, 114, 102, 95,
-		101, 118, 101, 110, 116, 95, 97, 116, 116, 114, 0, 99, 111, 110, 102, 105,
-		103, 0, 115, 97, 109, 112, 108, 101, 95, 116, 121, 112, 101, 0, 114, 101,
-		97, 100, 95, 102, 111, 114, 109, 97, 116, 0, 100, 105, 115, 97, 98, 108,
-		101, 100, 0, 105, 110, 104, 101, 114, 105, 116, 0, 101, 120, 99, 108, 117,
-		115, 105, 118, 101, 0, 101, 120, 99, 108, 117, 100, 101, 95, 117, 115, 101,
-		114, 0, 101, 120, 99, 108, 117, 100, 101, 95, 107, 101, 114, 110, 101, 108,
-		0, 101, 120, 99, 108, 117, 100, 101, 95, 104, 118, 0, 101, 120, 99, 108,
-		117, 100, 101, 95, 105, 100, 108, 101, 0, 102, 114, 101, 113, 0, 105, 110,
-		104, 101, 114, 105, 116, 95, 115, 116, 97, 116, 0, 101, 110, 97, 98, 108,
-		101, 95, 111, 110, 95, 101, 120, 101, 99, 0, 112, 114, 101, 99, 105, 115,
-		101, 95, 105, 112, 0, 109, 109, 97, 112, 95, 100, 97, 116, 97, 0, 115,
-		97, 109, 112, 108, 101, 95, 105, 100, 95, 97, 108, 108, 0, 101, 120, 99,
-		108, 117, 100, 101, 95, 104, 111, 115, 116, 0, 101, 120, 99, 108, 117, 100,
-		101, 95, 103, 117, 101, 115, 116, 0, 101, 120, 99, 108, 117, 100, 101, 95,
-		99, 97, 108, 108, 99, 104, 97, 105, 110, 95, 107, 101, 114, 110, 101, 108,
-		0, 101, 120, 99, 108, 117, 100, 101, 95, 99, 97, 108, 108, 99, 104, 97,
-		105, 110, 95, 117, 115, 101, 114, 0, 109, 109, 97, 112, 50, 0, 99, 111,
-		109, 109, 95, 101, 120, 101, 99, 0, 117, 115, 101, 95, 99, 108, 111, 99,
-		107, 105, 100, 0, 99, 111, 110, 116, 101, 120, 116, 95, 115, 119, 105, 116,
-		99, 104, 0, 119, 114, 105, 116, 101, 95, 98, 97, 99, 107, 119, 97, 114,
-		100, 0, 110, 97, 109, 101, 115, 112, 97, 99, 101, 115, 0, 107, 115, 121,
-		109, 98, 111, 108, 0, 98, 112, 102, 95, 101, 118, 101, 110, 116, 0, 97,
-		117, 120, 95, 111, 117, 116, 112, 117, 116, 0, 116, 101, 120, 116, 95, 112,
-		111, 107, 101, 0, 98, 117, 105, 108, 100, 95, 105, 100, 0, 105, 110, 104,
-		101, 114, 105, 116, 95, 116, 104, 114, 101, 97, 100, 0, 114, 101, 109, 111,
-		118, 101, 95, 111, 110, 95, 101, 120, 101, 99, 0, 115, 105, 103, 116, 114,
-		97, 112, 0, 95, 95, 114, 101, 115, 101, 114, 118, 101, 100, 95, 49, 0,
-		98, 112, 95, 116, 121, 112, 101, 0, 98, 114, 97, 110, 99, 104, 95, 115,
-		97, 109, 112, 108, 101, 95, 116, 121, 112, 101, 0, 115, 97, 109, 112, 108,
-		101, 95, 114, 101, 103, 115, 95, 117, 115, 101, 114, 0, 115, 97, 109, 112,
-		108, 101, 95, 115, 116, 97, 99, 107, 95, 117, 115, 101, 114, 0, 115, 97,
-		109, 112, 108, 101, 95, 114, 101, 103, 115, 95, 105, 110, 116, 114, 0, 97,
-		117, 120, 95, 119, 97, 116, 101, 114, 109, 97, 114, 107, 0, 115, 97, 109,
-		112, 108, 101, 95, 109, 97, 120, 95, 115, 116, 97, 99, 107, 0, 95, 95,
-		114, 101, 115, 101, 114, 118, 101, 100, 95, 50, 0, 97, 117, 120, 95, 115,
-		97, 109, 112, 108, 101, 95, 115, 105, 122, 101, 0, 95, 95, 114, 101, 115,
-		101, 114, 118, 101, 100, 95, 51, 0, 115, 105, 103, 95, 100, 97, 116, 97,
-		0, 115, 97, 109, 112, 108, 101, 95, 112, 101, 114, 105, 111, 100, 0, 115,
-		97, 109, 112, 108, 101, 95, 102, 114, 101, 113, 0, 119, 97, 107, 101, 117,
-		112, 95, 101, 118, 101, 110, 116, 115, 0, 119, 97, 107, 101, 117, 112, 95,
-		119, 97, 116, 101, 114, 109, 97, 114, 107, 0, 98, 112, 95, 97, 100, 100,
-		114, 0, 107, 112, 114, 111, 98, 101, 95, 102, 117, 110, 99, 0, 117, 112,
-		114, 111, 98, 101, 95, 112, 97, 116, 104, 0, 99, 111, 110, 102, 105, 103,
-		49, 0, 98, 112, 95, 108, 101, 110, 0, 107, 112, 114, 111, 98, 101, 95,
-		97, 100, 100, 114, 0, 112, 114, 111, 98, 101, 95, 111, 102, 102, 115, 101,
-		116, 0, 99, 111, 110, 102, 105, 103, 50, 0, 104, 119, 95, 112, 101, 114,
-		102, 95, 101, 118, 101, 110, 116, 0, 116, 97, 114, 103, 101, 116, 0, 112,
-		114, 101, 118, 95, 99, 111, 117, 110, 116, 0, 105, 110, 116, 101, 114, 114,
-		117, 112, 116, 115, 95, 115, 101, 113, 0, 105, 110, 116, 101, 114, 114, 117,
-		112, 116, 115, 0, 102, 114, 101, 113, 95, 116, 105, 109, 101, 95, 115, 116,
-		97, 109, 112, 0, 102, 114, 101, 113, 95, 99, 111, 117, 110, 116, 95, 115,
-		116, 97, 109, 112, 0, 108, 97, 115, 116, 95, 116, 97, 103, 0, 99, 111,
-		110, 102, 105, 103, 95, 98, 97, 115, 101, 0, 101, 118, 101, 110, 116, 95,
-		98, 97, 115, 101, 0, 101, 118, 101, 110, 116, 95, 98, 97, 115, 101, 95,
-		114, 100, 112, 109, 99, 0, 105, 100, 120, 0, 108, 97, 115, 116, 95, 99,
-		112, 117, 0, 101, 120, 116, 114, 97, 95, 114, 101, 103, 0, 98, 114, 97,
-		110, 99, 104, 95, 114, 101, 103, 0, 104, 119, 95, 112, 101, 114, 102, 95,
-		101, 118, 101, 110, 116, 95, 101, 120, 116, 114, 97, 0, 114, 101, 103, 0,
-		97, 108, 108, 111, 99, 0, 116, 112, 95, 108, 105, 115, 116, 0, 112, 119,
-		114, 95, 97, 99, 99, 0, 112, 116, 115, 99, 0, 98, 112, 95, 108, 105,
-		115, 116, 0, 97, 114, 99, 104, 95, 104, 119, 95, 98, 114, 101, 97, 107,
-		112, 111, 105, 110, 116, 0, 97, 100, 100, 114, 101, 115, 115, 0, 116, 114,
-		105, 103, 103, 101, 114, 0, 99, 116, 114, 108, 0, 97, 114, 99, 104, 95,
-		104, 119, 95, 98, 114, 101, 97, 107, 112, 111, 105, 110, 116, 95, 99, 116,
-		114, 108, 0, 108, 101, 110, 0, 112, 114, 105, 118, 105, 108, 101, 103, 101,
-		0, 101, 110, 97, 98, 108, 101, 100, 0, 105, 111, 109, 109, 117, 95, 98,
-		97, 110, 107, 0, 105, 111, 109, 109, 117, 95, 99, 110, 116, 114, 0, 112,
-		97, 100, 100, 105, 110, 103, 0, 99, 111, 110, 102, 0, 99, 111, 110, 102,
-		49, 0, 108, 97, 115, 116, 95, 112, 101, 114, 105, 111, 100, 0, 112, 101,
-		114, 105, 111, 100, 95, 108, 101, 102, 116, 0, 115, 97, 118, 101, 100, 95,
-		109, 101, 116, 114, 105, 99, 0, 115, 97, 118, 101, 100, 95, 115, 108, 111,
-		116, 115, 0, 112, 101, 114, 102, 95, 98, 117, 102, 102, 101, 114, 0, 110,
-		114, 95, 112, 97, 103, 101, 115, 0, 111, 118, 101, 114, 119, 114, 105, 116,
-		101, 0, 112, 97, 117, 115, 101, 100, 0, 110, 101, 115, 116, 0, 108, 111,
-		115, 116, 0, 101, 118, 101, 110, 116, 95, 108, 111, 99, 107, 0, 109, 109,
-		97, 112, 95, 108, 111, 99, 107, 101, 100, 0, 109, 109, 97, 112, 95, 117,
-		115, 101, 114, 0, 97, 117, 120, 95, 104, 101, 97, 100, 0, 97, 117, 120,
-		95, 110, 101, 115, 116, 0, 97, 117, 120, 95, 119, 97, 107, 101, 117, 112,
-		0, 97, 117, 120, 95, 112, 103, 111, 102, 102, 0, 97, 117, 120, 95, 110,
-		114, 95, 112, 97, 103, 101, 115, 0, 97, 117, 120, 95, 111, 118, 101, 114,
-		119, 114, 105, 116, 101, 0, 97, 117, 120, 95, 109, 109, 97, 112, 95, 99,
-		111, 117, 110, 116, 0, 97, 117, 120, 95, 109, 109, 97, 112, 95, 108, 111,
-		99, 107, 101, 100, 0, 97, 117, 120, 95, 114, 101, 102, 99, 111, 117, 110,
-		116, 0, 97, 117, 120, 95, 105, 110, 95, 115, 97, 109, 112, 108, 105, 110,
-		103, 0, 97, 117, 120, 95, 112, 97, 103, 101, 115, 0, 97, 117, 120, 95,
-		112, 114, 105, 118, 0, 117, 115, 101, 114, 95, 112, 97, 103, 101, 0, 100,
-		97, 116, 97, 95, 112, 97, 103, 101, 115, 0, 105, 114, 113, 95, 119, 111,
-		114, 107, 0, 105, 114, 113, 119, 97, 105, 116, 0, 114, 99, 117, 119, 97,
-		105, 116, 0, 112, 101, 114, 102, 95, 97, 100, 100, 114, 95, 102, 105, 108,
-		116, 101, 114, 115, 95, 104, 101, 97, 100, 0, 110, 114, 95, 102, 105, 108,
-		101, 95, 102, 105, 108, 116, 101, 114, 115, 0, 112, 105, 100, 95, 110, 97,
-		109, 101, 115, 112, 97, 99, 101, 0, 105, 100, 114, 0, 112, 105, 100, 95,
-		97, 108, 108, 111, 99, 97, 116, 101, 100, 0, 99, 104, 105, 108, 100, 95,
-		114, 101, 97, 112, 101, 114, 0, 112, 105, 100, 95, 99, 97, 99, 104, 101,
-		112, 0, 98, 97, 99, 99, 116, 0, 114, 101, 98, 111, 111, 116, 0, 105,
-		100, 114, 95, 114, 116, 0, 105, 100, 114, 95, 98, 97, 115, 101, 0, 105,
-		100, 114, 95, 110, 101, 120, 116, 0, 107, 109, 101, 109, 95, 99, 97, 99,
-		104, 101, 0, 99, 112, 117, 95, 115, 108, 97, 98, 0, 109, 105, 110, 95,
-		112, 97, 114, 116, 105, 97, 108, 0, 111, 98, 106, 101, 99, 116, 95, 115,
-		105, 122, 101, 0, 114, 101, 99, 105, 112, 114, 111, 99, 97, 108, 95, 115,
-		105, 122, 101, 0, 99, 112, 117, 95, 112, 97, 114, 116, 105, 97, 108, 0,
-		99, 112, 117, 95, 112, 97, 114, 116, 105, 97, 108, 95, 115, 108, 97, 98,
-		115, 0, 111, 111, 0, 97, 108, 108, 111, 99, 102, 108, 97, 103, 115, 0,
-		99, 116, 111, 114, 0, 105, 110, 117, 115, 101, 0, 97, 108, 105, 103, 110,
-		0, 114, 101, 100, 95, 108, 101, 102, 116, 95, 112, 97, 100, 0, 114, 97,
-		110, 100, 111, 109, 0, 114, 101, 109, 111, 116, 101, 95, 110, 111, 100, 101,
-		95, 100, 101, 102, 114, 97, 103, 95, 114, 97, 116, 105, 111, 0, 114, 97,
-		110, 100, 111, 109, 95, 115, 101, 113, 0, 117, 115, 101, 114, 111, 102, 102,
-		115, 101, 116, 0, 117, 115, 101, 114, 115, 105, 122, 101, 0, 115, 108, 97,
-		98, 95, 102, 108, 97, 103, 115, 95, 116, 0, 114, 101, 99, 105, 112, 114,
-		111, 99, 97, 108, 95, 118, 97, 108, 117, 101, 0, 109, 0, 115, 104, 49,
-		0, 115, 104, 50, 0, 107, 109, 101, 109, 95, 99, 97, 99, 104, 101, 95,
-		111, 114, 100, 101, 114, 95, 111, 98, 106, 101, 99, 116, 115, 0, 107, 109,
-		101, 109, 95, 99, 97, 99, 104, 101, 95, 110, 111, 100, 101, 0, 108, 105,
-		115, 116, 95, 108, 111, 99, 107, 0, 110, 114, 95, 112, 97, 114, 116, 105,
-		97, 108, 0, 112, 97, 114, 116, 105, 97, 108, 0, 110, 114, 95, 115, 108,
-		97, 98, 115, 0, 116, 111, 116, 97, 108, 95, 111, 98, 106, 101, 99, 116,
-		115, 0, 102, 117, 108, 108, 0, 112, 101, 114, 102, 95, 111, 118, 101, 114,
+		79, 70, 70, 0, 80, 69, 82, 70, 95, 69, 86, 69, 78, 84, 95, 83,
+		84, 65, 84, 69, 95, 73, 78, 65, 67, 84, 73, 86, 69, 0, 80, 69,
+		82, 70, 95, 69, 86, 69, 78, 84, 95, 83, 84, 65, 84, 69, 95, 65,
+		67, 84, 73, 86, 69, 0, 108, 111, 99, 97, 108, 54, 52, 95, 116, 0,
+		112, 101, 114, 102, 95, 101, 118, 101, 110, 116, 95, 97, 116, 116, 114, 0,
+		99, 111, 110, 102, 105, 103, 0, 115, 97, 109, 112, 108, 101, 95, 116, 121,
+		112, 101, 0, 114, 101, 97, 100, 95, 102, 111, 114, 109, 97, 116, 0, 100,
+		105, 115, 97, 98, 108, 101, 100, 0, 105, 110, 104, 101, 114, 105, 116, 0,
+		112, 105, 110, 110, 101, 100, 0, 101, 120, 99, 108, 117, 115, 105, 118, 101,
+		0, 101, 120, 99, 108, 117, 100, 101, 95, 117, 115, 101, 114, 0, 101, 120,
+		99, 108, 117, 100, 101, 95, 107, 101, 114, 110, 101, 108, 0, 101, 120, 99,
+		108, 117, 100, 101, 95, 104, 118, 0, 101, 120, 99, 108, 117, 100, 101, 95,
+		105, 100, 108, 101, 0, 109, 109, 97, 112, 0, 102, 114, 101, 113, 0, 105,
+		110, 104, 101, 114, 105, 116, 95, 115, 116, 97, 116, 0, 101, 110, 97, 98,
+		108, 101, 95, 111, 110, 95, 101, 120, 101, 99, 0, 119, 97, 116, 101, 114,
+		109, 97, 114, 107, 0, 112, 114, 101, 99, 105, 115, 101, 95, 105, 112, 0,
+		109, 109, 97, 112, 95, 100, 97, 116, 97, 0, 115, 97, 109, 112, 108, 101,
+		95, 105, 100, 95, 97, 108, 108, 0, 101, 120, 99, 108, 117, 100, 101, 95,
+		104, 111, 115, 116, 0, 101, 120, 99, 108, 117, 100, 101, 95, 103, 117, 101,
+		115, 116, 0, 101, 120, 99, 108, 117, 100, 101, 95, 99, 97, 108, 108, 99,
+		104, 97, 105, 110, 95, 107, 101, 114, 110, 101, 108, 0, 101, 120, 99, 108,
+		117, 100, 101, 95, 99, 97, 108, 108, 99, 104, 97, 105, 110, 95, 117, 115,
+		101, 114, 0, 109, 109, 97, 112, 50, 0, 99, 111, 109, 109, 95, 101, 120,
+		101, 99, 0, 117, 115, 101, 95, 99, 108, 111, 99, 107, 105, 100, 0, 99,
+		111, 110, 116, 101, 120, 116, 95, 115, 119, 105, 116, 99, 104, 0, 119, 114,
+		105, 116, 101, 95, 98, 97, 99, 107, 119, 97, 114, 100, 0, 110, 97, 109,
+		101, 115, 112, 97, 99, 101, 115, 0, 107, 115, 121, 109, 98, 111, 108, 0,
+		98, 112, 102, 95, 101, 118, 101, 110, 116, 0, 97, 117, 120, 95, 111, 117,
+		116, 112, 117, 116, 0, 99, 103, 114, 111, 117, 112, 0, 116, 101, 120, 116,
+		95, 112, 111, 107, 101, 0, 98, 117, 105, 108, 100, 95, 105, 100, 0, 105,
+		110, 104, 101, 114, 105, 116, 95, 116, 104, 114, 101, 97, 100, 0, 114, 101,
+		109, 111, 118, 101, 95, 111, 110, 95, 101, 120, 101, 99, 0, 115, 105, 103,
+		116, 114, 97, 112, 0, 95, 95, 114, 101, 115, 101, 114, 118, 101, 100, 95,
+		49, 0, 98, 112, 95, 116, 121, 112, 101, 0, 98, 114, 97, 110, 99, 104,
+		95, 115, 97, 109, 112, 108, 101, 95, 116, 121, 112, 101, 0, 115, 97, 109,
+		112, 108, 101, 95, 114, 101, 103, 115, 95, 117, 115, 101, 114, 0, 115, 97,
+		109, 112, 108, 101, 95, 115, 116, 97, 99, 107, 95, 117, 115, 101, 114, 0,
+		115, 97, 109, 112, 108, 101, 95, 114, 101, 103, 115, 95, 105, 110, 116, 114,
+		0, 97, 117, 120, 95, 119, 97, 116, 101, 114, 109, 97, 114, 107, 0, 115,
+		97, 109, 112, 108, 101, 95, 109, 97, 120, 95, 115, 116, 97, 99, 107, 0,
+		95, 95, 114, 101, 115, 101, 114, 118, 101, 100, 95, 50, 0, 97, 117, 120,
+		95, 115, 97, 109, 112, 108, 101, 95, 115, 105, 122, 101, 0, 95, 95, 114,
+		101, 115, 101, 114, 118, 101, 100, 95, 51, 0, 115, 105, 103, 95, 100, 97,
+		116, 97, 0, 115, 97, 109, 112, 108, 101, 95, 112, 101, 114, 105, 111, 100,
+		0, 115, 97, 109, 112, 108, 101, 95, 102, 114, 101, 113, 0, 119, 97, 107,
+		101, 117, 112, 95, 101, 118, 101, 110, 116, 115, 0, 119, 97, 107, 101, 117,
+		112, 95, 119, 97, 116, 101, 114, 109, 97, 114, 107, 0, 98, 112, 95, 97,
+		100, 100, 114, 0, 107, 112, 114, 111, 98, 101, 95, 102, 117, 110, 99, 0,
+		117, 112, 114, 111, 98, 101, 95, 112, 97, 116, 104, 0, 99, 111, 110, 102,
+		105, 103, 49, 0, 98, 112, 95, 108, 101, 110, 0, 107, 112, 114, 111, 98,
+		101, 95, 97, 100, 100, 114, 0, 112, 114, 111, 98, 101, 95, 111, 102, 102,
+		115, 101, 116, 0, 99, 111, 110, 102, 105, 103, 50, 0, 95, 95, 115, 51,
+		50, 0, 104, 119, 95, 112, 101, 114, 102, 95, 101, 118, 101, 110, 116, 0,
+		116, 97, 114, 103, 101, 116, 0, 112, 114, 101, 118, 95, 99, 111, 117, 110,
+		116, 0, 105, 110, 116, 101, 114, 114, 117, 112, 116, 115, 95, 115, 101, 113,
+		0, 105, 110, 116, 101, 114, 114, 117, 112, 116, 115, 0, 102, 114, 101, 113,
+		95, 116, 105, 109, 101, 95, 115, 116, 97, 109, 112, 0, 102, 114, 101, 113,
+		95, 99, 111, 117, 110, 116, 95, 115, 116, 97, 109, 112, 0, 108, 97, 115,
+		116, 95, 116, 97, 103, 0, 99, 111, 110, 102, 105, 103, 95, 98, 97, 115,
+		101, 0, 101, 118, 101, 110, 116, 95, 98, 97, 115, 101, 0, 101, 118, 101,
+		110, 116, 95, 98, 97, 115, 101, 95, 114, 100, 112, 109, 99, 0, 105, 100,
+		120, 0, 108, 97, 115, 116, 95, 99, 112, 117, 0, 101, 120, 116, 114, 97,
+		95, 114, 101, 103, 0, 98, 114, 97, 110, 99, 104, 95, 114, 101, 103, 0,
+		104, 119, 95, 112, 101, 114, 102, 95, 101, 118, 101, 110, 116, 95, 101, 120,
+		116, 114, 97, 0, 114, 101, 103, 0, 97, 108, 108, 111, 99, 0, 116, 112,
+		95, 108, 105, 115, 116, 0, 112, 119, 114, 95, 97, 99, 99, 0, 112, 116,
+		115, 99, 0, 105, 110, 102, 111, 0, 98, 112, 95, 108, 105, 115, 116, 0,
+		97, 114, 99, 104, 95, 104, 119, 95, 98, 114, 101, 97, 107, 112, 111, 105,
+		110, 116, 0, 97, 100, 100, 114, 101, 115, 115, 0, 116, 114, 105, 103, 103,
+		101, 114, 0, 99, 116, 114, 108, 0, 97, 114, 99, 104, 95, 104, 119, 95,
+		98, 114, 101, 97, 107, 112, 111, 105, 110, 116, 95, 99, 116, 114, 108, 0,
+		108, 101, 110, 0, 112, 114, 105, 118, 105, 108, 101, 103, 101, 0, 101, 110,
+		97, 98, 108, 101, 100, 0, 105, 111, 109, 109, 117, 95, 98, 97, 110, 107,
+		0, 105, 111, 109, 109, 117, 95, 99, 110, 116, 114, 0, 112, 97, 100, 100,
+		105, 110, 103, 0, 99, 111, 110, 102, 0, 99, 111, 110, 102, 49, 0, 108,
+		97, 115, 116, 95, 112, 101, 114, 105, 111, 100, 0, 112, 101, 114, 105, 111,
+		100, 95, 108, 101, 102, 116, 0, 115, 97, 118, 101, 100, 95, 109, 101, 116,
+		114, 105, 99, 0, 115, 97, 118, 101, 100, 95, 115, 108, 111, 116, 115, 0,
+		119, 97, 105, 116, 95, 113, 117, 101, 117, 101, 95, 104, 101, 97, 100, 95,
+		116, 0, 119, 97, 105, 116, 95, 113, 117, 101, 117, 101, 95, 104, 101, 97,
+		100, 0, 104, 101, 97, 100, 0, 105, 114, 113, 95, 119, 111, 114, 107, 0,
+		105, 114, 113, 119, 97, 105, 116, 0, 114, 99, 117, 119, 97, 105, 116, 0,
+		112, 101, 114, 102, 95, 97, 100, 100, 114, 95, 102, 105, 108, 116, 101, 114,
+		115, 95, 104, 101, 97, 100, 0, 110, 114, 95, 102, 105, 108, 101, 95, 102,
+		105, 108, 116, 101, 114, 115, 0, 112, 101, 114, 102, 95, 111, 118, 101, 114,
 		102, 108, 111, 119, 95, 104, 97, 110, 100, 108, 101, 114, 95, 116, 0, 112,
 		101, 114, 102, 95, 115, 97, 109, 112, 108, 101, 95, 100, 97, 116, 97, 0,
-		114, 97, 119, 0, 98, 114, 95, 115, 116, 97, 99, 107, 0, 116, 120, 110,
-		0, 100, 97, 116, 97, 95, 115, 114, 99, 0, 105, 112, 0, 116, 105, 100,
-		95, 101, 110, 116, 114, 121, 0, 115, 116, 114, 101, 97, 109, 95, 105, 100,
-		0, 99, 112, 117, 95, 101, 110, 116, 114, 121, 0, 99, 97, 108, 108, 99,
-		104, 97, 105, 110, 0, 97, 117, 120, 95, 115, 105, 122, 101, 0, 114, 101,
-		103, 115, 95, 117, 115, 101, 114, 0, 114, 101, 103, 115, 95, 105, 110, 116,
-		114, 0, 115, 116, 97, 99, 107, 95, 117, 115, 101, 114, 95, 115, 105, 122,
-		101, 0, 112, 104, 121, 115, 95, 97, 100, 100, 114, 0, 100, 97, 116, 97,
-		95, 112, 97, 103, 101, 95, 115, 105, 122, 101, 0, 99, 111, 100, 101, 95,
-		112, 97, 103, 101, 95, 115, 105, 122, 101, 0, 112, 101, 114, 102, 95, 115,
-		97, 109, 112, 108, 101, 95, 119, 101, 105, 103, 104, 116, 0, 118, 97, 114,
-		49, 95, 100, 119, 0, 118, 97, 114, 50, 95, 119, 0, 118, 97, 114, 51,
-		95, 119, 0, 112, 101, 114, 102, 95, 109, 101, 109, 95, 100, 97, 116, 97,
-		95, 115, 114, 99, 0, 109, 101, 109, 95, 111, 112, 0, 109, 101, 109, 95,
-		108, 118, 108, 0, 109, 101, 109, 95, 115, 110, 111, 111, 112, 0, 109, 101,
-		109, 95, 108, 111, 99, 107, 0, 109, 101, 109, 95, 100, 116, 108, 98, 0,
-		109, 101, 109, 95, 108, 118, 108, 95, 110, 117, 109, 0, 109, 101, 109, 95,
-		114, 101, 109, 111, 116, 101, 0, 109, 101, 109, 95, 115, 110, 111, 111, 112,
-		120, 0, 109, 101, 109, 95, 98, 108, 107, 0, 109, 101, 109, 95, 104, 111,
-		112, 115, 0, 109, 101, 109, 95, 114, 115, 118, 100, 0, 116, 105, 100, 0,
-		114, 101, 115, 101, 114, 118, 101, 100, 0, 112, 101, 114, 102, 95, 114, 101,
-		103, 115, 0, 97, 98, 105, 0, 114, 101, 103, 115, 0, 112, 116, 95, 114,
+		97, 100, 100, 114, 0, 114, 97, 119, 0, 98, 114, 95, 115, 116, 97, 99,
+		107, 0, 112, 101, 114, 105, 111, 100, 0, 116, 120, 110, 0, 100, 97, 116,
+		97, 95, 115, 114, 99, 0, 105, 112, 0, 116, 105, 100, 95, 101, 110, 116,
+		114, 121, 0, 115, 116, 114, 101, 97, 109, 95, 105, 100, 0, 99, 112, 117,
+		95, 101, 110, 116, 114, 121, 0, 99, 97, 108, 108, 99, 104, 97, 105, 110,
+		0, 97, 117, 120, 95, 115, 105, 122, 101, 0, 114, 101, 103, 115, 95, 117,
+		115, 101, 114, 0, 114, 101, 103, 115, 95, 105, 110, 116, 114, 0, 115, 116,
+		97, 99, 107, 95, 117, 115, 101, 114, 95, 115, 105, 122, 101, 0, 112, 104,
+		121, 115, 95, 97, 100, 100, 114, 0, 100, 97, 116, 97, 95, 112, 97, 103,
+		101, 95, 115, 105, 122, 101, 0, 99, 111, 100, 101, 95, 112, 97, 103, 101,
+		95, 115, 105, 122, 101, 0, 112, 101, 114, 102, 95, 115, 97, 109, 112, 108,
+		101, 95, 119, 101, 105, 103, 104, 116, 0, 102, 117, 108, 108, 0, 118, 97,
+		114, 49, 95, 100, 119, 0, 118, 97, 114, 50, 95, 119, 0, 118, 97, 114,
+		51, 95, 119, 0, 112, 101, 114, 102, 95, 109, 101, 109, 95, 100, 97, 116,
+		97, 95, 115, 114, 99, 0, 109, 101, 109, 95, 111, 112, 0, 109, 101, 109,
+		95, 108, 118, 108, 0, 109, 101, 109, 95, 115, 110, 111, 111, 112, 0, 109,
+		101, 109, 95, 108, 111, 99, 107, 0, 109, 101, 109, 95, 100, 116, 108, 98,
+		0, 109, 101, 109, 95, 108, 118, 108, 95, 110, 117, 109, 0, 109, 101, 109,
+		95, 114, 101, 109, 111, 116, 101, 0, 109, 101, 109, 95, 115, 110, 111, 111,
+		112, 120, 0, 109, 101, 109, 95, 98, 108, 107, 0, 109, 101, 109, 95, 104,
+		111, 112, 115, 0, 109, 101, 109, 95, 114, 115, 118, 100, 0, 116, 105, 100,
+		0, 114, 101, 115, 101, 114, 118, 101, 100, 0, 112, 101, 114, 102, 95, 114,
+		101, 103, 115, 0, 97, 98, 105, 0, 114, 101, 103, 115, 0, 112, 114, 105,
+		118, 97, 116, 101, 0, 115, 97, 118, 101, 100, 95, 102, 117, 110, 99, 0,
+		108, 111, 99, 97, 108, 95, 104, 97, 115, 104, 0, 102, 117, 110, 99, 95,
+		104, 97, 115, 104, 0, 111, 108, 100, 95, 104, 97, 115, 104, 0, 116, 114,
+		97, 109, 112, 111, 108, 105, 110, 101, 0, 116, 114, 97, 109, 112, 111, 108,
+		105, 110, 101, 95, 115, 105, 122, 101, 0, 111, 112, 115, 95, 102, 117, 110,
+		99, 0, 102, 116, 114, 97, 99, 101, 95, 102, 117, 110, 99, 95, 116, 0,
+		102, 116, 114, 97, 99, 101, 95, 114, 101, 103, 115, 0, 112, 116, 95, 114,
 		101, 103, 115, 0, 111, 114, 105, 103, 95, 120, 48, 0, 115, 121, 115, 99,
 		97, 108, 108, 110, 111, 0, 117, 110, 117, 115, 101, 100, 50, 0, 115, 100,
 		101, 105, 95, 116, 116, 98, 114, 49, 0, 112, 109, 114, 95, 115, 97, 118,
@@ -8635,345 +4444,339 @@ var bpfObjects = map[string][]byte{
 		107, 100, 101, 112, 95, 104, 97, 114, 100, 105, 114, 113, 115, 0, 101, 120,
 		105, 116, 95, 114, 99, 117, 0, 117, 115, 101, 114, 95, 114, 101, 103, 115,
 		0, 117, 115, 101, 114, 95, 112, 116, 95, 114, 101, 103, 115, 0, 112, 115,
-		116, 97, 116, 101, 0, 116, 114, 97, 99, 101, 95, 101, 118, 101, 110, 116,
-		95, 99, 97, 108, 108, 0, 99, 108, 97, 115, 115, 0, 112, 114, 105, 110,
-		116, 95, 102, 109, 116, 0, 112, 101, 114, 102, 95, 114, 101, 102, 99, 111,
-		117, 110, 116, 0, 112, 101, 114, 102, 95, 101, 118, 101, 110, 116, 115, 0,
-		112, 114, 111, 103, 95, 97, 114, 114, 97, 121, 0, 112, 101, 114, 102, 95,
-		112, 101, 114, 109, 0, 116, 112, 0, 116, 114, 97, 99, 101, 95, 101, 118,
-		101, 110, 116, 0, 102, 117, 110, 99, 115, 0, 101, 118, 101, 110, 116, 95,
-		102, 105, 108, 116, 101, 114, 0, 102, 105, 108, 116, 101, 114, 95, 115, 116,
-		114, 105, 110, 103, 0, 115, 97, 118, 101, 100, 95, 102, 117, 110, 99, 0,
-		108, 111, 99, 97, 108, 95, 104, 97, 115, 104, 0, 102, 117, 110, 99, 95,
-		104, 97, 115, 104, 0, 111, 108, 100, 95, 104, 97, 115, 104, 0, 116, 114,
-		97, 109, 112, 111, 108, 105, 110, 101, 0, 116, 114, 97, 109, 112, 111, 108,
-		105, 110, 101, 95, 115, 105, 122, 101, 0, 111, 112, 115, 95, 102, 117, 110,
-		99, 0, 102, 116, 114, 97, 99, 101, 95, 102, 117, 110, 99, 95, 116, 0,
-		102, 116, 114, 97, 99, 101, 95, 114, 101, 103, 115, 0, 102, 116, 114, 97,
-		99, 101, 95, 111, 112, 115, 95, 104, 97, 115, 104, 0, 110, 111, 116, 114,
-		97, 99, 101, 95, 104, 97, 115, 104, 0, 102, 105, 108, 116, 101, 114, 95,
-		104, 97, 115, 104, 0, 114, 101, 103, 101, 120, 95, 108, 111, 99, 107, 0,
-		102, 116, 114, 97, 99, 101, 95, 104, 97, 115, 104, 0, 115, 105, 122, 101,
-		95, 98, 105, 116, 115, 0, 98, 117, 99, 107, 101, 116, 115, 0, 102, 116,
-		114, 97, 99, 101, 95, 111, 112, 115, 95, 102, 117, 110, 99, 95, 116, 0,
-		102, 116, 114, 97, 99, 101, 95, 111, 112, 115, 95, 99, 109, 100, 0, 70,
-		84, 82, 65, 67, 69, 95, 79, 80, 83, 95, 67, 77, 68, 95, 69, 78,
-		65, 66, 76, 69, 95, 83, 72, 65, 82, 69, 95, 73, 80, 77, 79, 68,
-		73, 70, 89, 95, 83, 69, 76, 70, 0, 70, 84, 82, 65, 67, 69, 95,
-		79, 80, 83, 95, 67, 77, 68, 95, 69, 78, 65, 66, 76, 69, 95, 83,
-		72, 65, 82, 69, 95, 73, 80, 77, 79, 68, 73, 70, 89, 95, 80, 69,
-		69, 82, 0, 70, 84, 82, 65, 67, 69, 95, 79, 80, 83, 95, 67, 77,
-		68, 95, 68, 73, 83, 65, 66, 76, 69, 95, 83, 72, 65, 82, 69, 95,
-		73, 80, 77, 79, 68, 73, 70, 89, 95, 80, 69, 69, 82, 0, 112, 116,
-		114, 97, 117, 116, 104, 95, 107, 101, 121, 115, 95, 117, 115, 101, 114, 0,
-		97, 112, 105, 97, 0, 97, 112, 105, 98, 0, 97, 112, 100, 97, 0, 97,
-		112, 100, 98, 0, 97, 112, 103, 97, 0, 112, 116, 114, 97, 117, 116, 104,
-		95, 107, 101, 121, 0, 108, 111, 0, 104, 105, 0, 112, 116, 114, 97, 117,
-		116, 104, 95, 107, 101, 121, 115, 95, 107, 101, 114, 110, 101, 108, 0, 48,
-		58, 49, 50, 53, 0, 32, 32, 32, 32, 117, 51, 50, 32, 109, 110, 116,
-		110, 115, 32, 61, 32, 66, 80, 70, 95, 67, 79, 82, 69, 95, 82, 69,
-		65, 68, 40, 116, 97, 115, 107, 44, 32, 110, 115, 112, 114, 111, 120, 121,
-		44, 32, 109, 110, 116, 95, 110, 115, 44, 32, 110, 115, 46, 105, 110, 117,
-		109, 41, 59, 0, 117, 116, 115, 95, 110, 115, 0, 105, 112, 99, 95, 110,
-		115, 0, 109, 110, 116, 95, 110, 115, 0, 112, 105, 100, 95, 110, 115, 95,
-		102, 111, 114, 95, 99, 104, 105, 108, 100, 114, 101, 110, 0, 110, 101, 116,
-		95, 110, 115, 0, 116, 105, 109, 101, 95, 110, 115, 0, 116, 105, 109, 101,
-		95, 110, 115, 95, 102, 111, 114, 95, 99, 104, 105, 108, 100, 114, 101, 110,
-		0, 99, 103, 114, 111, 117, 112, 95, 110, 115, 0, 116, 105, 109, 101, 95,
-		110, 97, 109, 101, 115, 112, 97, 99, 101, 0, 111, 102, 102, 115, 101, 116,
-		115, 0, 118, 118, 97, 114, 95, 112, 97, 103, 101, 0, 102, 114, 111, 122,
-		101, 110, 95, 111, 102, 102, 115, 101, 116, 115, 0, 116, 105, 109, 101, 110,
-		115, 95, 111, 102, 102, 115, 101, 116, 115, 0, 109, 111, 110, 111, 116, 111,
-		110, 105, 99, 0, 98, 111, 111, 116, 116, 105, 109, 101, 0, 48, 58, 51,
-		0, 109, 110, 116, 95, 110, 97, 109, 101, 115, 112, 97, 99, 101, 0, 110,
-		115, 95, 108, 111, 99, 107, 0, 115, 101, 113, 0, 109, 111, 117, 110, 116,
-		115, 0, 112, 101, 110, 100, 105, 110, 103, 95, 109, 111, 117, 110, 116, 115,
-		0, 48, 58, 48, 58, 50, 0, 32, 32, 32, 32, 105, 102, 32, 40, 109,
-		110, 116, 110, 115, 32, 61, 61, 32, 48, 41, 32, 123, 0, 32, 32, 32,
-		32, 117, 51, 50, 32, 104, 111, 115, 116, 80, 105, 100, 32, 61, 32, 49,
-		59, 0, 32, 32, 32, 32, 104, 111, 115, 116, 95, 109, 110, 116, 110, 115,
+		116, 97, 116, 101, 0, 115, 51, 50, 0, 102, 116, 114, 97, 99, 101, 95,
+		111, 112, 115, 95, 104, 97, 115, 104, 0, 110, 111, 116, 114, 97, 99, 101,
+		95, 104, 97, 115, 104, 0, 102, 105, 108, 116, 101, 114, 95, 104, 97, 115,
+		104, 0, 114, 101, 103, 101, 120, 95, 108, 111, 99, 107, 0, 102, 116, 114,
+		97, 99, 101, 95, 111, 112, 115, 95, 102, 117, 110, 99, 95, 116, 0, 102,
+		116, 114, 97, 99, 101, 95, 111, 112, 115, 95, 99, 109, 100, 0, 70, 84,
+		82, 65, 67, 69, 95, 79, 80, 83, 95, 67, 77, 68, 95, 69, 78, 65,
+		66, 76, 69, 95, 83, 72, 65, 82, 69, 95, 73, 80, 77, 79, 68, 73,
+		70, 89, 95, 83, 69, 76, 70, 0, 70, 84, 82, 65, 67, 69, 95, 79,
+		80, 83, 95, 67, 77, 68, 95, 69, 78, 65, 66, 76, 69, 95, 83, 72,
+		65, 82, 69, 95, 73, 80, 77, 79, 68, 73, 70, 89, 95, 80, 69, 69,
+		82, 0, 70, 84, 82, 65, 67, 69, 95, 79, 80, 83, 95, 67, 77, 68,
+		95, 68, 73, 83, 65, 66, 76, 69, 95, 83, 72, 65, 82, 69, 95, 73,
+		80, 77, 79, 68, 73, 70, 89, 95, 80, 69, 69, 82, 0, 112, 116, 114,
+		97, 117, 116, 104, 95, 107, 101, 121, 115, 95, 117, 115, 101, 114, 0, 97,
+		112, 105, 97, 0, 97, 112, 105, 98, 0, 97, 112, 100, 97, 0, 97, 112,
+		100, 98, 0, 97, 112, 103, 97, 0, 112, 116, 114, 97, 117, 116, 104, 95,
+		107, 101, 121, 0, 108, 111, 0, 104, 105, 0, 112, 116, 114, 97, 117, 116,
+		104, 95, 107, 101, 121, 115, 95, 107, 101, 114, 110, 101, 108, 0, 48, 58,
+		49, 50, 53, 0, 32, 32, 32, 32, 117, 51, 50, 32, 109, 110, 116, 110,
+		115, 32, 61, 32, 66, 80, 70, 95, 67, 79, 82, 69, 95, 82, 69, 65,
+		68, 40, 116, 97, 115, 107, 44, 32, 110, 115, 112, 114, 111, 120, 121, 44,
+		32, 109, 110, 116, 95, 110, 115, 44, 32, 110, 115, 46, 105, 110, 117, 109,
+		41, 59, 0, 117, 116, 115, 95, 110, 115, 0, 105, 112, 99, 95, 110, 115,
+		0, 109, 110, 116, 95, 110, 115, 0, 112, 105, 100, 95, 110, 115, 95, 102,
+		111, 114, 95, 99, 104, 105, 108, 100, 114, 101, 110, 0, 110, 101, 116, 95,
+		110, 115, 0, 116, 105, 109, 101, 95, 110, 115, 0, 116, 105, 109, 101, 95,
+		110, 115, 95, 102, 111, 114, 95, 99, 104, 105, 108, 100, 114, 101, 110, 0,
+		99, 103, 114, 111, 117, 112, 95, 110, 115, 0, 48, 58, 51, 0, 109, 110,
+		116, 95, 110, 97, 109, 101, 115, 112, 97, 99, 101, 0, 114, 111, 111, 116,
+		0, 110, 115, 95, 108, 111, 99, 107, 0, 117, 115, 101, 114, 95, 110, 115,
+		0, 117, 99, 111, 117, 110, 116, 115, 0, 115, 101, 113, 0, 101, 118, 101,
+		110, 116, 0, 109, 111, 117, 110, 116, 115, 0, 112, 101, 110, 100, 105, 110,
+		103, 95, 109, 111, 117, 110, 116, 115, 0, 110, 115, 95, 99, 111, 109, 109,
+		111, 110, 0, 115, 116, 97, 115, 104, 101, 100, 0, 111, 112, 115, 0, 105,
+		110, 117, 109, 0, 48, 58, 48, 58, 50, 0, 32, 32, 32, 32, 105, 102,
+		32, 40, 109, 110, 116, 110, 115, 32, 61, 61, 32, 48, 41, 32, 123, 0,
+		32, 32, 32, 32, 117, 51, 50, 32, 104, 111, 115, 116, 80, 105, 100, 32,
+		61, 32, 49, 59, 0, 32, 32, 32, 32, 104, 111, 115, 116, 95, 109, 110,
+		116, 110, 115, 32, 61, 32, 98, 112, 102, 95, 109, 97, 112, 95, 108, 111,
+		111, 107, 117, 112, 95, 101, 108, 101, 109, 40, 38, 112, 105, 100, 95, 109,
+		110, 116, 110, 115, 44, 32, 38, 104, 111, 115, 116, 80, 105, 100, 41, 59,
+		0, 32, 32, 32, 32, 105, 102, 32, 40, 104, 111, 115, 116, 95, 109, 110,
+		116, 110, 115, 32, 33, 61, 32, 78, 85, 76, 76, 32, 38, 38, 32, 42,
+		104, 111, 115, 116, 95, 109, 110, 116, 110, 115, 32, 61, 61, 32, 109, 110,
+		116, 110, 115, 41, 32, 123, 0, 32, 32, 32, 32, 99, 104, 97, 114, 32,
+		99, 111, 109, 109, 91, 77, 65, 88, 95, 67, 79, 77, 77, 95, 76, 69,
+		78, 93, 32, 61, 32, 123, 125, 59, 0, 32, 32, 32, 32, 98, 112, 102,
+		95, 103, 101, 116, 95, 99, 117, 114, 114, 101, 110, 116, 95, 99, 111, 109,
+		109, 40, 99, 111, 109, 109, 44, 32, 115, 105, 122, 101, 111, 102, 40, 99,
+		111, 109, 109, 41, 41, 59, 0, 32, 32, 32, 32, 105, 102, 32, 40, 102,
+		105, 108, 116, 101, 114, 95, 110, 97, 109, 101, 91, 48, 93, 32, 61, 61,
+		32, 48, 41, 32, 123, 0, 32, 32, 32, 32, 32, 32, 32, 32, 105, 102,
+		32, 40, 99, 111, 109, 109, 91, 105, 93, 32, 33, 61, 32, 102, 105, 108,
+		116, 101, 114, 95, 110, 97, 109, 101, 91, 105, 93, 41, 32, 123, 0, 32,
+		32, 32, 32, 32, 32, 32, 32, 105, 102, 32, 40, 99, 111, 109, 109, 91,
+		105, 93, 32, 61, 61, 32, 48, 41, 32, 123, 0, 32, 32, 32, 32, 99,
+		117, 114, 114, 101, 110, 116, 95, 112, 105, 100, 95, 109, 110, 116, 110, 115,
 		32, 61, 32, 98, 112, 102, 95, 109, 97, 112, 95, 108, 111, 111, 107, 117,
 		112, 95, 101, 108, 101, 109, 40, 38, 112, 105, 100, 95, 109, 110, 116, 110,
-		115, 44, 32, 38, 104, 111, 115, 116, 80, 105, 100, 41, 59, 0, 32, 32,
-		32, 32, 105, 102, 32, 40, 104, 111, 115, 116, 95, 109, 110, 116, 110, 115,
-		32, 33, 61, 32, 78, 85, 76, 76, 32, 38, 38, 32, 42, 104, 111, 115,
-		116, 95, 109, 110, 116, 110, 115, 32, 61, 61, 32, 109, 110, 116, 110, 115,
-		41, 32, 123, 0, 32, 32, 32, 32, 99, 104, 97, 114, 32, 99, 111, 109,
-		109, 91, 77, 65, 88, 95, 67, 79, 77, 77, 95, 76, 69, 78, 93, 32,
-		61, 32, 123, 125, 59, 0, 32, 32, 32, 32, 98, 112, 102, 95, 103, 101,
-		116, 95, 99, 117, 114, 114, 101, 110, 116, 95, 99, 111, 109, 109, 40, 99,
-		111, 109, 109, 44, 32, 115, 105, 122, 101, 111, 102, 40, 99, 111, 109, 109,
-		41, 41, 59, 0, 32, 32, 32, 32, 105, 102, 32, 40, 102, 105, 108, 116,
-		101, 114, 95, 110, 97, 109, 101, 91, 48, 93, 32, 61, 61, 32, 48, 41,
-		32, 123, 0, 32, 32, 32, 32, 32, 32, 32, 32, 105, 102, 32, 40, 99,
-		111, 109, 109, 91, 105, 93, 32, 33, 61, 32, 102, 105, 108, 116, 101, 114,
-		95, 110, 97, 109, 101, 91, 105, 93, 41, 32, 123, 0, 32, 32, 32, 32,
-		32, 32, 32, 32, 105, 102, 32, 40, 99, 111, 109, 109, 91, 105, 93, 32,
-		61, 61, 32, 48, 41, 32, 123, 0, 32, 32, 32, 32, 99, 117, 114, 114,
-		101, 110, 116, 95, 112, 105, 100, 95, 109, 110, 116, 110, 115, 32, 61, 32,
-		98, 112, 102, 95, 109, 97, 112, 95, 108, 111, 111, 107, 117, 112, 95, 101,
-		108, 101, 109, 40, 38, 112, 105, 100, 95, 109, 110, 116, 110, 115, 44, 32,
-		38, 112, 105, 100, 41, 59, 0, 32, 32, 32, 32, 105, 102, 32, 40, 99,
-		117, 114, 114, 101, 110, 116, 95, 112, 105, 100, 95, 109, 110, 116, 110, 115,
-		32, 61, 61, 32, 78, 85, 76, 76, 41, 32, 123, 0, 32, 32, 32, 32,
-		32, 32, 32, 32, 32, 32, 32, 32, 98, 112, 102, 95, 114, 105, 110, 103,
-		98, 117, 102, 95, 114, 101, 115, 101, 114, 118, 101, 40, 38, 101, 118, 101,
-		110, 116, 115, 44, 32, 115, 105, 122, 101, 111, 102, 40, 115, 116, 114, 117,
-		99, 116, 32, 101, 118, 101, 110, 116, 95, 116, 41, 44, 32, 48, 41, 59,
-		0, 32, 32, 32, 32, 32, 32, 32, 32, 105, 102, 32, 40, 101, 118, 101,
-		110, 116, 41, 32, 123, 0, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-		32, 32, 98, 112, 102, 95, 112, 114, 105, 110, 116, 107, 40, 34, 115, 101,
-		110, 100, 32, 101, 118, 101, 110, 116, 32, 112, 105, 100, 58, 32, 37, 117,
-		44, 32, 109, 110, 116, 110, 115, 58, 32, 37, 117, 44, 32, 99, 111, 109,
-		109, 58, 32, 37, 115, 92, 110, 34, 44, 32, 112, 105, 100, 44, 32, 109,
-		110, 116, 110, 115, 44, 0, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-		32, 32, 101, 118, 101, 110, 116, 45, 62, 112, 105, 100, 32, 61, 32, 112,
-		105, 100, 59, 0, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-		101, 118, 101, 110, 116, 45, 62, 109, 110, 116, 110, 115, 32, 61, 32, 109,
-		110, 116, 110, 115, 59, 0, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
-		32, 32, 98, 112, 102, 95, 114, 105, 110, 103, 98, 117, 102, 95, 115, 117,
-		98, 109, 105, 116, 40, 101, 118, 101, 110, 116, 44, 32, 48, 41, 59, 0,
-		32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 98, 112, 102, 95,
-		109, 97, 112, 95, 117, 112, 100, 97, 116, 101, 95, 101, 108, 101, 109, 40,
+		115, 44, 32, 38, 112, 105, 100, 41, 59, 0, 32, 32, 32, 32, 105, 102,
+		32, 40, 99, 117, 114, 114, 101, 110, 116, 95, 112, 105, 100, 95, 109, 110,
+		116, 110, 115, 32, 61, 61, 32, 78, 85, 76, 76, 41, 32, 123, 0, 32,
+		32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 98, 112, 102, 95, 114,
+		105, 110, 103, 98, 117, 102, 95, 114, 101, 115, 101, 114, 118, 101, 40, 38,
+		101, 118, 101, 110, 116, 115, 44, 32, 115, 105, 122, 101, 111, 102, 40, 115,
+		116, 114, 117, 99, 116, 32, 101, 118, 101, 110, 116, 95, 116, 41, 44, 32,
+		48, 41, 59, 0, 32, 32, 32, 32, 32, 32, 32, 32, 105, 102, 32, 40,
+		101, 118, 101, 110, 116, 41, 32, 123, 0, 32, 32, 32, 32, 32, 32, 32,
+		32, 32, 32, 32, 32, 117, 54, 52, 32, 99, 103, 114, 111, 117, 112, 95,
+		105, 100, 32, 61, 32, 98, 112, 102, 95, 103, 101, 116, 95, 99, 117, 114,
+		114, 101, 110, 116, 95, 99, 103, 114, 111, 117, 112, 95, 105, 100, 40, 41,
+		59, 0, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 98, 112,
+		102, 95, 112, 114, 105, 110, 116, 107, 40, 34, 115, 101, 110, 100, 32, 101,
+		118, 101, 110, 116, 32, 112, 105, 100, 58, 32, 37, 117, 44, 32, 109, 110,
+		116, 110, 115, 58, 32, 37, 117, 44, 32, 99, 103, 114, 111, 117, 112, 58,
+		32, 37, 108, 108, 117, 92, 110, 34, 44, 32, 112, 105, 100, 44, 0, 32,
+		32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 101, 118, 101, 110, 116,
+		45, 62, 112, 105, 100, 32, 61, 32, 112, 105, 100, 59, 0, 32, 32, 32,
+		32, 32, 32, 32, 32, 32, 32, 32, 32, 101, 118, 101, 110, 116, 45, 62,
+		109, 110, 116, 110, 115, 32, 61, 32, 109, 110, 116, 110, 115, 59, 0, 32,
+		32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 101, 118, 101, 110, 116,
+		45, 62, 99, 103, 114, 111, 117, 112, 95, 105, 100, 32, 61, 32, 99, 103,
+		114, 111, 117, 112, 95, 105, 100, 59, 0, 32, 32, 32, 32, 32, 32, 32,
+		32, 32, 32, 32, 32, 98, 112, 102, 95, 114, 105, 110, 103, 98, 117, 102,
+		95, 115, 117, 98, 109, 105, 116, 40, 101, 118, 101, 110, 116, 44, 32, 48,
+		41, 59, 0, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 105,
+		102, 32, 40, 98, 112, 102, 95, 109, 97, 112, 95, 117, 112, 100, 97, 116,
+		101, 95, 101, 108, 101, 109, 40, 38, 112, 105, 100, 95, 109, 110, 116, 110,
+		115, 44, 32, 38, 112, 105, 100, 44, 32, 38, 109, 110, 116, 110, 115, 44,
+		32, 66, 80, 70, 95, 65, 78, 89, 41, 32, 33, 61, 32, 48, 41, 32,
+		123, 0, 32, 32, 32, 32, 117, 54, 52, 32, 42, 32, 99, 111, 117, 110,
+		116, 32, 61, 32, 98, 112, 102, 95, 109, 97, 112, 95, 108, 111, 111, 107,
+		117, 112, 95, 101, 108, 101, 109, 40, 38, 109, 97, 112, 95, 111, 118, 101,
+		114, 102, 108, 111, 119, 115, 44, 32, 38, 105, 110, 100, 101, 120, 41, 59,
+		0, 32, 32, 32, 32, 105, 102, 32, 40, 99, 111, 117, 110, 116, 41, 32,
+		123, 0, 32, 32, 32, 32, 32, 32, 32, 32, 95, 95, 115, 121, 110, 99,
+		95, 102, 101, 116, 99, 104, 95, 97, 110, 100, 95, 97, 100, 100, 40, 99,
+		111, 117, 110, 116, 44, 32, 49, 41, 59, 0, 32, 32, 32, 32, 32, 32,
+		32, 32, 98, 112, 102, 95, 109, 97, 112, 95, 108, 111, 111, 107, 117, 112,
+		95, 101, 108, 101, 109, 40, 38, 109, 110, 116, 110, 115, 95, 115, 121, 115,
+		99, 97, 108, 108, 115, 44, 32, 38, 109, 110, 116, 110, 115, 41, 59, 0,
+		32, 32, 32, 32, 105, 102, 32, 40, 109, 110, 116, 110, 115, 95, 115, 121,
+		115, 99, 97, 108, 108, 95, 118, 97, 108, 117, 101, 41, 32, 123, 0, 125,
+		0, 32, 32, 32, 32, 32, 32, 32, 32, 105, 102, 32, 40, 98, 112, 102,
+		95, 109, 97, 112, 95, 117, 112, 100, 97, 116, 101, 95, 101, 108, 101, 109,
+		40, 38, 109, 110, 116, 110, 115, 95, 115, 121, 115, 99, 97, 108, 108, 115,
+		44, 32, 38, 109, 110, 116, 110, 115, 44, 32, 38, 105, 110, 105, 116, 44,
+		32, 66, 80, 70, 95, 65, 78, 89, 41, 32, 33, 61, 0, 32, 32, 32,
+		32, 32, 32, 32, 32, 117, 56, 32, 42, 32, 99, 111, 110, 115, 116, 32,
+		118, 97, 108, 117, 101, 32, 61, 32, 98, 112, 102, 95, 109, 97, 112, 95,
+		108, 111, 111, 107, 117, 112, 95, 101, 108, 101, 109, 40, 38, 109, 110, 116,
+		110, 115, 95, 115, 121, 115, 99, 97, 108, 108, 115, 44, 32, 38, 109, 110,
+		116, 110, 115, 41, 59, 0, 32, 32, 32, 32, 32, 32, 32, 32, 105, 102,
+		32, 40, 33, 118, 97, 108, 117, 101, 41, 32, 123, 0, 32, 32, 32, 32,
+		32, 32, 32, 32, 32, 32, 32, 32, 98, 112, 102, 95, 112, 114, 105, 110,
+		116, 107, 40, 0, 115, 99, 104, 101, 100, 95, 112, 114, 111, 99, 101, 115,
+		115, 95, 101, 120, 105, 116, 0, 116, 114, 97, 99, 101, 112, 111, 105, 110,
+		116, 47, 115, 99, 104, 101, 100, 47, 115, 99, 104, 101, 100, 95, 112, 114,
+		111, 99, 101, 115, 115, 95, 101, 120, 105, 116, 0, 32, 32, 32, 32, 117,
+		54, 52, 32, 112, 105, 100, 95, 116, 103, 105, 100, 32, 61, 32, 98, 112,
+		102, 95, 103, 101, 116, 95, 99, 117, 114, 114, 101, 110, 116, 95, 112, 105,
+		100, 95, 116, 103, 105, 100, 40, 41, 59, 0, 32, 32, 32, 32, 117, 51,
+		50, 32, 112, 105, 100, 32, 61, 32, 112, 105, 100, 95, 116, 103, 105, 100,
+		32, 62, 62, 32, 51, 50, 59, 0, 32, 32, 32, 32, 105, 102, 32, 40,
+		40, 117, 51, 50, 41, 112, 105, 100, 95, 116, 103, 105, 100, 32, 33, 61,
+		32, 112, 105, 100, 41, 32, 123, 0, 32, 32, 32, 32, 98, 112, 102, 95,
+		109, 97, 112, 95, 100, 101, 108, 101, 116, 101, 95, 101, 108, 101, 109, 40,
 		38, 112, 105, 100, 95, 109, 110, 116, 110, 115, 44, 32, 38, 112, 105, 100,
-		44, 32, 38, 109, 110, 116, 110, 115, 44, 32, 66, 80, 70, 95, 65, 78,
-		89, 41, 59, 0, 32, 32, 32, 32, 32, 32, 32, 32, 98, 112, 102, 95,
-		109, 97, 112, 95, 108, 111, 111, 107, 117, 112, 95, 101, 108, 101, 109, 40,
-		38, 109, 110, 116, 110, 115, 95, 115, 121, 115, 99, 97, 108, 108, 115, 44,
-		32, 38, 109, 110, 116, 110, 115, 41, 59, 0, 32, 32, 32, 32, 105, 102,
-		32, 40, 109, 110, 116, 110, 115, 95, 115, 121, 115, 99, 97, 108, 108, 95,
-		118, 97, 108, 117, 101, 41, 32, 123, 0, 125, 0, 32, 32, 32, 32, 32,
-		32, 32, 32, 98, 112, 102, 95, 109, 97, 112, 95, 117, 112, 100, 97, 116,
-		101, 95, 101, 108, 101, 109, 40, 38, 109, 110, 116, 110, 115, 95, 115, 121,
-		115, 99, 97, 108, 108, 115, 44, 32, 38, 109, 110, 116, 110, 115, 44, 32,
-		38, 105, 110, 105, 116, 44, 32, 66, 80, 70, 95, 65, 78, 89, 41, 59,
-		0, 32, 32, 32, 32, 32, 32, 32, 32, 117, 56, 32, 42, 32, 99, 111,
-		110, 115, 116, 32, 118, 97, 108, 117, 101, 32, 61, 32, 98, 112, 102, 95,
-		109, 97, 112, 95, 108, 111, 111, 107, 117, 112, 95, 101, 108, 101, 109, 40,
-		38, 109, 110, 116, 110, 115, 95, 115, 121, 115, 99, 97, 108, 108, 115, 44,
-		32, 38, 109, 110, 116, 110, 115, 41, 59, 0, 32, 32, 32, 32, 32, 32,
-		32, 32, 105, 102, 32, 40, 33, 118, 97, 108, 117, 101, 41, 32, 123, 0,
-		32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 98, 112, 102, 95,
-		112, 114, 105, 110, 116, 107, 40, 0, 76, 73, 67, 69, 78, 83, 69, 0,
-		102, 105, 108, 116, 101, 114, 95, 110, 97, 109, 101, 0, 115, 121, 115, 95,
-		101, 110, 116, 101, 114, 46, 95, 95, 95, 95, 102, 109, 116, 0, 115, 121,
-		115, 95, 101, 110, 116, 101, 114, 46, 105, 110, 105, 116, 0, 115, 121, 115,
-		95, 101, 110, 116, 101, 114, 46, 95, 95, 95, 95, 102, 109, 116, 46, 49,
-		0, 46, 109, 97, 112, 115, 0, 46, 114, 111, 100, 97, 116, 97, 0, 108,
-		105, 99, 101, 110, 115, 101, 0, 97, 110, 111, 110, 95, 118, 109, 97, 95,
-		110, 97, 109, 101, 0, 118, 102, 115, 109, 111, 117, 110, 116, 0, 115, 117,
-		112, 101, 114, 95, 98, 108, 111, 99, 107, 0, 118, 109, 95, 111, 112, 101,
-		114, 97, 116, 105, 111, 110, 115, 95, 115, 116, 114, 117, 99, 116, 0, 105,
-		110, 111, 100, 101, 95, 111, 112, 101, 114, 97, 116, 105, 111, 110, 115, 0,
-		112, 114, 111, 99, 95, 110, 115, 95, 111, 112, 101, 114, 97, 116, 105, 111,
-		110, 115, 0, 107, 111, 98, 106, 95, 116, 121, 112, 101, 0, 107, 101, 114,
-		110, 102, 115, 95, 114, 111, 111, 116, 0, 105, 111, 118, 101, 99, 0, 98,
-		105, 111, 95, 118, 101, 99, 0, 112, 105, 112, 101, 95, 98, 117, 102, 102,
-		101, 114, 0, 119, 97, 105, 116, 95, 112, 97, 103, 101, 95, 113, 117, 101,
-		117, 101, 0, 100, 101, 118, 105, 99, 101, 0, 110, 101, 116, 0, 104, 114,
-		116, 105, 109, 101, 114, 95, 99, 108, 111, 99, 107, 95, 98, 97, 115, 101,
-		0, 117, 116, 115, 95, 110, 97, 109, 101, 115, 112, 97, 99, 101, 0, 105,
-		112, 99, 95, 110, 97, 109, 101, 115, 112, 97, 99, 101, 0, 99, 116, 108,
-		95, 116, 97, 98, 108, 101, 95, 112, 111, 108, 108, 0, 99, 116, 108, 95,
-		116, 97, 98, 108, 101, 95, 114, 111, 111, 116, 0, 99, 116, 108, 95, 110,
-		111, 100, 101, 0, 109, 111, 117, 110, 116, 0, 102, 115, 110, 111, 116, 105,
-		102, 121, 95, 109, 97, 114, 107, 95, 99, 111, 110, 110, 101, 99, 116, 111,
-		114, 0, 107, 109, 101, 109, 95, 99, 97, 99, 104, 101, 95, 99, 112, 117,
-		0, 102, 115, 95, 112, 105, 110, 0, 99, 103, 114, 111, 117, 112, 95, 110,
-		97, 109, 101, 115, 112, 97, 99, 101, 0, 99, 115, 115, 95, 115, 101, 116,
-		0, 115, 101, 113, 95, 111, 112, 101, 114, 97, 116, 105, 111, 110, 115, 0,
-		107, 101, 114, 110, 102, 115, 95, 111, 112, 115, 0, 107, 101, 114, 110, 102,
-		115, 95, 111, 112, 101, 110, 95, 110, 111, 100, 101, 0, 107, 101, 114, 110,
-		102, 115, 95, 105, 97, 116, 116, 114, 115, 0, 119, 111, 114, 107, 113, 117,
-		101, 117, 101, 95, 115, 116, 114, 117, 99, 116, 0, 111, 98, 106, 95, 99,
-		103, 114, 111, 117, 112, 0, 101, 118, 101, 110, 116, 102, 100, 95, 99, 116,
-		120, 0, 109, 101, 109, 99, 103, 95, 118, 109, 115, 116, 97, 116, 115, 95,
-		112, 101, 114, 99, 112, 117, 0, 112, 103, 108, 105, 115, 116, 95, 100, 97,
-		116, 97, 0, 99, 103, 114, 111, 117, 112, 95, 115, 117, 98, 115, 121, 115,
-		0, 98, 112, 102, 95, 112, 114, 111, 103, 95, 97, 114, 114, 97, 121, 0,
-		98, 112, 102, 95, 112, 114, 111, 103, 0, 112, 101, 114, 99, 112, 117, 95,
-		114, 101, 102, 95, 100, 97, 116, 97, 0, 98, 112, 102, 95, 108, 111, 99,
-		97, 108, 95, 115, 116, 111, 114, 97, 103, 101, 0, 99, 100, 101, 118, 0,
-		101, 120, 99, 101, 112, 116, 105, 111, 110, 95, 116, 97, 98, 108, 101, 95,
-		101, 110, 116, 114, 121, 0, 102, 115, 95, 115, 116, 114, 117, 99, 116, 0,
-		119, 97, 116, 99, 104, 95, 108, 105, 115, 116, 0, 107, 101, 121, 95, 117,
-		115, 101, 114, 0, 112, 105, 95, 101, 110, 116, 114, 121, 0, 114, 101, 113,
-		117, 101, 115, 116, 0, 114, 101, 113, 117, 101, 115, 116, 95, 113, 117, 101,
-		117, 101, 0, 98, 100, 105, 95, 119, 114, 105, 116, 101, 98, 97, 99, 107,
-		0, 102, 105, 108, 101, 95, 108, 111, 99, 107, 95, 99, 111, 110, 116, 101,
-		120, 116, 0, 102, 115, 99, 114, 121, 112, 116, 95, 105, 110, 102, 111, 0,
-		115, 105, 103, 104, 97, 110, 100, 95, 115, 116, 114, 117, 99, 116, 0, 112,
-		111, 108, 108, 102, 100, 0, 115, 101, 109, 95, 117, 110, 100, 111, 95, 108,
-		105, 115, 116, 0, 102, 105, 108, 101, 115, 95, 115, 116, 114, 117, 99, 116,
-		0, 105, 111, 95, 117, 114, 105, 110, 103, 95, 116, 97, 115, 107, 0, 98,
-		108, 107, 95, 112, 108, 117, 103, 0, 115, 105, 103, 110, 97, 108, 95, 115,
-		116, 114, 117, 99, 116, 0, 109, 111, 100, 117, 108, 101, 95, 112, 97, 114,
-		97, 109, 95, 97, 116, 116, 114, 115, 0, 109, 111, 100, 117, 108, 101, 95,
-		97, 116, 116, 114, 105, 98, 117, 116, 101, 0, 107, 101, 114, 110, 101, 108,
-		95, 112, 97, 114, 97, 109, 0, 112, 108, 116, 95, 101, 110, 116, 114, 121,
-		0, 98, 117, 103, 95, 101, 110, 116, 114, 121, 0, 101, 108, 102, 54, 52,
-		95, 115, 121, 109, 0, 109, 111, 100, 117, 108, 101, 95, 115, 101, 99, 116,
-		95, 97, 116, 116, 114, 115, 0, 109, 111, 100, 117, 108, 101, 95, 110, 111,
-		116, 101, 115, 95, 97, 116, 116, 114, 115, 0, 101, 114, 114, 111, 114, 95,
-		105, 110, 106, 101, 99, 116, 105, 111, 110, 95, 101, 110, 116, 114, 121, 0,
-		115, 114, 99, 117, 95, 115, 116, 114, 117, 99, 116, 0, 98, 112, 102, 95,
-		114, 97, 119, 95, 101, 118, 101, 110, 116, 95, 109, 97, 112, 0, 116, 114,
-		97, 99, 101, 112, 111, 105, 110, 116, 0, 106, 117, 109, 112, 95, 101, 110,
-		116, 114, 121, 0, 116, 114, 97, 99, 101, 95, 101, 118, 101, 110, 116, 95,
-		99, 108, 97, 115, 115, 0, 116, 114, 97, 99, 101, 95, 101, 118, 101, 110,
-		116, 95, 102, 117, 110, 99, 116, 105, 111, 110, 115, 0, 112, 114, 111, 103,
-		95, 101, 110, 116, 114, 121, 0, 112, 101, 114, 102, 95, 99, 112, 117, 95,
-		99, 111, 110, 116, 101, 120, 116, 0, 112, 101, 114, 102, 95, 99, 103, 114,
-		111, 117, 112, 0, 112, 101, 114, 102, 95, 101, 118, 101, 110, 116, 95, 109,
-		109, 97, 112, 95, 112, 97, 103, 101, 0, 112, 101, 114, 102, 95, 97, 100,
-		100, 114, 95, 102, 105, 108, 116, 101, 114, 95, 114, 97, 110, 103, 101, 0,
-		112, 101, 114, 102, 95, 114, 97, 119, 95, 114, 101, 99, 111, 114, 100, 0,
-		112, 101, 114, 102, 95, 98, 114, 97, 110, 99, 104, 95, 115, 116, 97, 99,
-		107, 0, 112, 101, 114, 102, 95, 99, 97, 108, 108, 99, 104, 97, 105, 110,
-		95, 101, 110, 116, 114, 121, 0, 116, 114, 97, 99, 101, 95, 101, 118, 97,
-		108, 95, 109, 97, 112, 0, 107, 117, 110, 105, 116, 95, 115, 117, 105, 116,
-		101, 0, 107, 117, 110, 105, 116, 0, 102, 105, 108, 101, 95, 108, 111, 99,
-		107, 95, 111, 112, 101, 114, 97, 116, 105, 111, 110, 115, 0, 108, 111, 99,
-		107, 95, 109, 97, 110, 97, 103, 101, 114, 95, 111, 112, 101, 114, 97, 116,
-		105, 111, 110, 115, 0, 110, 102, 115, 52, 95, 108, 111, 99, 107, 95, 115,
-		116, 97, 116, 101, 0, 102, 115, 118, 101, 114, 105, 116, 121, 95, 105, 110,
-		102, 111, 0, 97, 100, 100, 114, 101, 115, 115, 95, 115, 112, 97, 99, 101,
-		95, 111, 112, 101, 114, 97, 116, 105, 111, 110, 115, 0, 112, 97, 103, 101,
-		95, 112, 111, 111, 108, 0, 100, 101, 118, 95, 112, 97, 103, 101, 109, 97,
-		112, 0, 117, 115, 101, 114, 102, 97, 117, 108, 116, 102, 100, 95, 99, 116,
-		120, 0, 108, 105, 110, 117, 120, 95, 98, 105, 110, 102, 109, 116, 0, 107,
-		101, 114, 110, 101, 108, 95, 115, 105, 103, 105, 110, 102, 111, 0, 107, 105,
-		111, 99, 116, 120, 95, 116, 97, 98, 108, 101, 0, 109, 109, 117, 95, 110,
-		111, 116, 105, 102, 105, 101, 114, 95, 115, 117, 98, 115, 99, 114, 105, 112,
-		116, 105, 111, 110, 115, 0, 114, 99, 117, 95, 110, 111, 100, 101, 0, 95,
-		95, 107, 101, 114, 110, 101, 108, 95, 116, 105, 109, 101, 115, 112, 101, 99,
-		0, 111, 108, 100, 95, 116, 105, 109, 101, 115, 112, 101, 99, 51, 50, 0,
-		115, 101, 99, 99, 111, 109, 112, 95, 102, 105, 108, 116, 101, 114, 0, 114,
-		116, 95, 109, 117, 116, 101, 120, 95, 119, 97, 105, 116, 101, 114, 0, 114,
-		111, 98, 117, 115, 116, 95, 108, 105, 115, 116, 95, 104, 101, 97, 100, 0,
-		99, 111, 109, 112, 97, 116, 95, 114, 111, 98, 117, 115, 116, 95, 108, 105,
-		115, 116, 95, 104, 101, 97, 100, 0, 102, 117, 116, 101, 120, 95, 112, 105,
-		95, 115, 116, 97, 116, 101, 0, 116, 97, 115, 107, 95, 100, 101, 108, 97,
-		121, 95, 105, 110, 102, 111, 0, 102, 116, 114, 97, 99, 101, 95, 114, 101,
-		116, 95, 115, 116, 97, 99, 107, 0, 117, 112, 114, 111, 98, 101, 95, 116,
-		97, 115, 107, 0, 118, 109, 95, 115, 116, 114, 117, 99, 116, 0, 98, 112,
-		102, 95, 114, 117, 110, 95, 99, 116, 120, 0, 0, 159, 235, 1, 0, 32,
-		0, 0, 0, 0, 0, 0, 0, 20, 0, 0, 0, 20, 0, 0, 0, 156,
-		3, 0, 0, 176, 3, 0, 0, 76, 0, 0, 0, 8, 0, 0, 0, 3,
-		1, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 36, 0, 0, 0, 16,
-		0, 0, 0, 3, 1, 0, 0, 57, 0, 0, 0, 0, 0, 0, 0, 41,
-		1, 0, 0, 126, 1, 0, 0, 28, 180, 0, 0, 8, 0, 0, 0, 41,
-		1, 0, 0, 126, 1, 0, 0, 22, 180, 0, 0, 24, 0, 0, 0, 41,
-		1, 0, 0, 157, 1, 0, 0, 24, 184, 0, 0, 32, 0, 0, 0, 41,
-		1, 0, 0, 213, 1, 0, 0, 15, 200, 0, 0, 40, 0, 0, 0, 41,
-		1, 0, 0, 213, 1, 0, 0, 42, 200, 0, 0, 48, 0, 0, 0, 41,
-		1, 0, 0, 213, 1, 0, 0, 9, 200, 0, 0, 56, 0, 0, 0, 41,
-		1, 0, 0, 5, 2, 0, 0, 55, 212, 0, 0, 88, 0, 0, 0, 41,
-		1, 0, 0, 213, 1, 0, 0, 15, 200, 0, 0, 96, 0, 0, 0, 41,
-		1, 0, 0, 214, 83, 0, 0, 17, 216, 0, 0, 208, 0, 0, 0, 41,
-		1, 0, 0, 213, 1, 0, 0, 15, 200, 0, 0, 216, 0, 0, 0, 41,
-		1, 0, 0, 214, 83, 0, 0, 17, 216, 0, 0, 232, 0, 0, 0, 41,
-		1, 0, 0, 214, 83, 0, 0, 17, 216, 0, 0, 240, 0, 0, 0, 41,
-		1, 0, 0, 214, 83, 0, 0, 9, 216, 0, 0, 248, 0, 0, 0, 41,
-		1, 0, 0, 248, 84, 0, 0, 9, 220, 0, 0, 8, 1, 0, 0, 41,
-		1, 0, 0, 14, 85, 0, 0, 9, 240, 0, 0, 24, 1, 0, 0, 41,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 32, 1, 0, 0, 41,
-		1, 0, 0, 35, 85, 0, 0, 18, 248, 0, 0, 56, 1, 0, 0, 41,
-		1, 0, 0, 95, 85, 0, 0, 28, 252, 0, 0, 64, 1, 0, 0, 41,
-		1, 0, 0, 95, 85, 0, 0, 31, 252, 0, 0, 72, 1, 0, 0, 41,
-		1, 0, 0, 95, 85, 0, 0, 9, 252, 0, 0, 88, 1, 0, 0, 41,
-		1, 0, 0, 149, 85, 0, 0, 10, 16, 1, 0, 168, 1, 0, 0, 41,
-		1, 0, 0, 183, 85, 0, 0, 5, 20, 1, 0, 184, 1, 0, 0, 41,
-		1, 0, 0, 229, 85, 0, 0, 9, 232, 1, 0, 208, 1, 0, 0, 41,
-		1, 0, 0, 229, 85, 0, 0, 9, 232, 1, 0, 224, 1, 0, 0, 41,
-		1, 0, 0, 4, 86, 0, 0, 24, 4, 2, 0, 0, 2, 0, 0, 41,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 8, 2, 0, 0, 41,
-		1, 0, 0, 4, 86, 0, 0, 13, 4, 2, 0, 24, 2, 0, 0, 41,
-		1, 0, 0, 4, 86, 0, 0, 24, 4, 2, 0, 32, 2, 0, 0, 41,
-		1, 0, 0, 4, 86, 0, 0, 13, 4, 2, 0, 40, 2, 0, 0, 41,
-		1, 0, 0, 45, 86, 0, 0, 13, 24, 2, 0, 80, 2, 0, 0, 41,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 88, 2, 0, 0, 41,
-		1, 0, 0, 73, 86, 0, 0, 25, 60, 1, 0, 112, 2, 0, 0, 41,
-		1, 0, 0, 136, 86, 0, 0, 9, 64, 1, 0, 120, 2, 0, 0, 41,
-		1, 0, 0, 173, 86, 0, 0, 13, 72, 1, 0, 168, 2, 0, 0, 41,
-		1, 0, 0, 242, 86, 0, 0, 13, 76, 1, 0, 176, 2, 0, 0, 41,
-		1, 0, 0, 7, 87, 0, 0, 13, 80, 1, 0, 192, 2, 0, 0, 41,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 200, 2, 0, 0, 41,
-		1, 0, 0, 7, 87, 0, 0, 13, 80, 1, 0, 240, 2, 0, 0, 41,
-		1, 0, 0, 87, 87, 0, 0, 26, 92, 1, 0, 248, 2, 0, 0, 41,
-		1, 0, 0, 87, 87, 0, 0, 24, 92, 1, 0, 0, 3, 0, 0, 41,
-		1, 0, 0, 117, 87, 0, 0, 28, 96, 1, 0, 8, 3, 0, 0, 41,
-		1, 0, 0, 117, 87, 0, 0, 26, 96, 1, 0, 16, 3, 0, 0, 41,
-		1, 0, 0, 151, 87, 0, 0, 13, 100, 1, 0, 48, 3, 0, 0, 41,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 72, 3, 0, 0, 41,
-		1, 0, 0, 193, 87, 0, 0, 13, 108, 1, 0, 112, 3, 0, 0, 41,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 120, 3, 0, 0, 41,
-		1, 0, 0, 5, 88, 0, 0, 9, 132, 1, 0, 144, 3, 0, 0, 41,
-		1, 0, 0, 59, 88, 0, 0, 9, 136, 1, 0, 152, 3, 0, 0, 41,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 176, 3, 0, 0, 41,
-		1, 0, 0, 90, 88, 0, 0, 1, 212, 1, 0, 200, 3, 0, 0, 41,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 208, 3, 0, 0, 41,
-		1, 0, 0, 92, 88, 0, 0, 9, 156, 1, 0, 8, 4, 0, 0, 41,
-		1, 0, 0, 162, 88, 0, 0, 28, 160, 1, 0, 40, 4, 0, 0, 41,
-		1, 0, 0, 235, 88, 0, 0, 13, 164, 1, 0, 48, 4, 0, 0, 41,
-		1, 0, 0, 1, 89, 0, 0, 13, 172, 1, 0, 72, 4, 0, 0, 41,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 80, 4, 0, 0, 41,
-		1, 0, 0, 1, 89, 0, 0, 13, 172, 1, 0, 16, 0, 0, 0, 3,
-		1, 0, 0, 4, 0, 0, 0, 0, 0, 0, 0, 27, 0, 0, 0, 37,
-		1, 0, 0, 0, 0, 0, 0, 64, 0, 0, 0, 37, 0, 0, 0, 208,
-		83, 0, 0, 0, 0, 0, 0, 128, 0, 0, 0, 151, 3, 0, 0, 190,
-		84, 0, 0, 0, 0, 0, 0, 176, 0, 0, 0, 160, 3, 0, 0, 242,
-		84, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		41, 59, 0, 76, 73, 67, 69, 78, 83, 69, 0, 102, 105, 108, 116, 101,
+		114, 95, 110, 97, 109, 101, 0, 115, 121, 115, 95, 101, 110, 116, 101, 114,
+		46, 95, 95, 95, 95, 102, 109, 116, 0, 115, 121, 115, 95, 101, 110, 116,
+		101, 114, 46, 105, 110, 105, 116, 0, 115, 121, 115, 95, 101, 110, 116, 101,
+		114, 46, 95, 95, 95, 95, 102, 109, 116, 46, 49, 0, 46, 109, 97, 112,
+		115, 0, 46, 114, 111, 100, 97, 116, 97, 0, 108, 105, 99, 101, 110, 115,
+		101, 0, 95, 95, 107, 101, 114, 110, 101, 108, 95, 116, 105, 109, 101, 115,
+		112, 101, 99, 0, 97, 110, 111, 110, 95, 118, 109, 97, 95, 110, 97, 109,
+		101, 0, 98, 108, 107, 95, 112, 108, 117, 103, 0, 98, 112, 102, 95, 108,
+		111, 99, 97, 108, 95, 115, 116, 111, 114, 97, 103, 101, 0, 98, 112, 102,
+		95, 112, 114, 111, 103, 0, 98, 112, 102, 95, 114, 117, 110, 95, 99, 116,
+		120, 0, 99, 103, 114, 111, 117, 112, 95, 110, 97, 109, 101, 115, 112, 97,
+		99, 101, 0, 99, 111, 109, 112, 97, 116, 95, 114, 111, 98, 117, 115, 116,
+		95, 108, 105, 115, 116, 95, 104, 101, 97, 100, 0, 99, 111, 109, 112, 108,
+		101, 116, 105, 111, 110, 0, 99, 115, 115, 95, 115, 101, 116, 0, 101, 118,
+		101, 110, 116, 95, 102, 105, 108, 116, 101, 114, 0, 102, 97, 115, 121, 110,
+		99, 95, 115, 116, 114, 117, 99, 116, 0, 102, 105, 108, 101, 0, 102, 105,
+		108, 101, 115, 95, 115, 116, 114, 117, 99, 116, 0, 102, 115, 95, 115, 116,
+		114, 117, 99, 116, 0, 102, 116, 114, 97, 99, 101, 95, 104, 97, 115, 104,
+		0, 102, 116, 114, 97, 99, 101, 95, 114, 101, 116, 95, 115, 116, 97, 99,
+		107, 0, 102, 117, 116, 101, 120, 95, 112, 105, 95, 115, 116, 97, 116, 101,
+		0, 104, 114, 116, 105, 109, 101, 114, 95, 99, 108, 111, 99, 107, 95, 98,
+		97, 115, 101, 0, 105, 111, 95, 117, 114, 105, 110, 103, 95, 116, 97, 115,
+		107, 0, 105, 112, 99, 95, 110, 97, 109, 101, 115, 112, 97, 99, 101, 0,
+		107, 101, 114, 110, 101, 108, 95, 115, 105, 103, 105, 110, 102, 111, 0, 107,
+		117, 110, 105, 116, 0, 109, 101, 109, 95, 99, 103, 114, 111, 117, 112, 0,
+		109, 109, 95, 115, 116, 114, 117, 99, 116, 0, 109, 111, 117, 110, 116, 0,
+		110, 101, 116, 0, 111, 108, 100, 95, 116, 105, 109, 101, 115, 112, 101, 99,
+		51, 50, 0, 112, 101, 114, 102, 95, 97, 100, 100, 114, 95, 102, 105, 108,
+		116, 101, 114, 95, 114, 97, 110, 103, 101, 0, 112, 101, 114, 102, 95, 98,
+		114, 97, 110, 99, 104, 95, 115, 116, 97, 99, 107, 0, 112, 101, 114, 102,
+		95, 98, 117, 102, 102, 101, 114, 0, 112, 101, 114, 102, 95, 99, 97, 108,
+		108, 99, 104, 97, 105, 110, 95, 101, 110, 116, 114, 121, 0, 112, 101, 114,
+		102, 95, 99, 103, 114, 111, 117, 112, 0, 112, 101, 114, 102, 95, 114, 97,
+		119, 95, 114, 101, 99, 111, 114, 100, 0, 112, 105, 100, 95, 110, 97, 109,
+		101, 115, 112, 97, 99, 101, 0, 112, 105, 112, 101, 95, 105, 110, 111, 100,
+		101, 95, 105, 110, 102, 111, 0, 112, 111, 108, 108, 102, 100, 0, 112, 114,
+		111, 99, 95, 110, 115, 95, 111, 112, 101, 114, 97, 116, 105, 111, 110, 115,
+		0, 114, 99, 117, 95, 110, 111, 100, 101, 0, 114, 101, 113, 117, 101, 115,
+		116, 95, 113, 117, 101, 117, 101, 0, 114, 111, 98, 117, 115, 116, 95, 108,
+		105, 115, 116, 95, 104, 101, 97, 100, 0, 114, 116, 95, 109, 117, 116, 101,
+		120, 95, 119, 97, 105, 116, 101, 114, 0, 115, 101, 99, 99, 111, 109, 112,
+		95, 102, 105, 108, 116, 101, 114, 0, 115, 101, 109, 95, 117, 110, 100, 111,
+		95, 108, 105, 115, 116, 0, 115, 105, 103, 104, 97, 110, 100, 95, 115, 116,
+		114, 117, 99, 116, 0, 115, 105, 103, 110, 97, 108, 95, 115, 116, 114, 117,
+		99, 116, 0, 116, 97, 115, 107, 95, 100, 101, 108, 97, 121, 95, 105, 110,
+		102, 111, 0, 116, 97, 115, 107, 95, 103, 114, 111, 117, 112, 0, 116, 105,
+		109, 101, 95, 110, 97, 109, 101, 115, 112, 97, 99, 101, 0, 116, 114, 97,
+		99, 101, 95, 101, 118, 101, 110, 116, 95, 99, 97, 108, 108, 0, 117, 112,
+		114, 111, 98, 101, 95, 116, 97, 115, 107, 0, 117, 115, 101, 114, 95, 110,
+		97, 109, 101, 115, 112, 97, 99, 101, 0, 117, 115, 101, 114, 102, 97, 117,
+		108, 116, 102, 100, 95, 99, 116, 120, 0, 117, 116, 115, 95, 110, 97, 109,
+		101, 115, 112, 97, 99, 101, 0, 118, 109, 95, 111, 112, 101, 114, 97, 116,
+		105, 111, 110, 115, 95, 115, 116, 114, 117, 99, 116, 0, 118, 109, 95, 115,
+		116, 114, 117, 99, 116, 0, 0, 159, 235, 1, 0, 32, 0, 0, 0, 0,
+		0, 0, 0, 36, 0, 0, 0, 36, 0, 0, 0, 228, 4, 0, 0, 8,
+		5, 0, 0, 76, 0, 0, 0, 8, 0, 0, 0, 46, 1, 0, 0, 1,
+		0, 0, 0, 0, 0, 0, 0, 44, 0, 0, 0, 100, 47, 0, 0, 1,
+		0, 0, 0, 0, 0, 0, 0, 118, 1, 0, 0, 16, 0, 0, 0, 46,
+		1, 0, 0, 69, 0, 0, 0, 0, 0, 0, 0, 84, 1, 0, 0, 148,
+		1, 0, 0, 28, 240, 0, 0, 8, 0, 0, 0, 84, 1, 0, 0, 148,
+		1, 0, 0, 22, 240, 0, 0, 24, 0, 0, 0, 84, 1, 0, 0, 179,
+		1, 0, 0, 24, 244, 0, 0, 32, 0, 0, 0, 84, 1, 0, 0, 235,
+		1, 0, 0, 15, 4, 1, 0, 40, 0, 0, 0, 84, 1, 0, 0, 235,
+		1, 0, 0, 42, 4, 1, 0, 48, 0, 0, 0, 84, 1, 0, 0, 235,
+		1, 0, 0, 9, 4, 1, 0, 56, 0, 0, 0, 84, 1, 0, 0, 27,
+		2, 0, 0, 55, 16, 1, 0, 88, 0, 0, 0, 84, 1, 0, 0, 235,
+		1, 0, 0, 15, 4, 1, 0, 96, 0, 0, 0, 84, 1, 0, 0, 65,
+		41, 0, 0, 17, 20, 1, 0, 152, 0, 0, 0, 84, 1, 0, 0, 235,
+		1, 0, 0, 15, 4, 1, 0, 160, 0, 0, 0, 84, 1, 0, 0, 65,
+		41, 0, 0, 17, 20, 1, 0, 208, 0, 0, 0, 84, 1, 0, 0, 235,
+		1, 0, 0, 15, 4, 1, 0, 216, 0, 0, 0, 84, 1, 0, 0, 65,
+		41, 0, 0, 17, 20, 1, 0, 240, 0, 0, 0, 84, 1, 0, 0, 65,
+		41, 0, 0, 9, 20, 1, 0, 248, 0, 0, 0, 84, 1, 0, 0, 71,
+		42, 0, 0, 9, 24, 1, 0, 8, 1, 0, 0, 84, 1, 0, 0, 93,
+		42, 0, 0, 9, 44, 1, 0, 24, 1, 0, 0, 84, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 32, 1, 0, 0, 84, 1, 0, 0, 114,
+		42, 0, 0, 18, 52, 1, 0, 56, 1, 0, 0, 84, 1, 0, 0, 174,
+		42, 0, 0, 28, 56, 1, 0, 64, 1, 0, 0, 84, 1, 0, 0, 174,
+		42, 0, 0, 31, 56, 1, 0, 72, 1, 0, 0, 84, 1, 0, 0, 174,
+		42, 0, 0, 9, 56, 1, 0, 88, 1, 0, 0, 84, 1, 0, 0, 228,
+		42, 0, 0, 10, 76, 1, 0, 168, 1, 0, 0, 84, 1, 0, 0, 6,
+		43, 0, 0, 5, 80, 1, 0, 184, 1, 0, 0, 84, 1, 0, 0, 52,
+		43, 0, 0, 9, 164, 2, 0, 208, 1, 0, 0, 84, 1, 0, 0, 52,
+		43, 0, 0, 9, 164, 2, 0, 224, 1, 0, 0, 84, 1, 0, 0, 83,
+		43, 0, 0, 24, 192, 2, 0, 0, 2, 0, 0, 84, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 8, 2, 0, 0, 84, 1, 0, 0, 83,
+		43, 0, 0, 13, 192, 2, 0, 24, 2, 0, 0, 84, 1, 0, 0, 83,
+		43, 0, 0, 24, 192, 2, 0, 32, 2, 0, 0, 84, 1, 0, 0, 83,
+		43, 0, 0, 13, 192, 2, 0, 40, 2, 0, 0, 84, 1, 0, 0, 124,
+		43, 0, 0, 13, 212, 2, 0, 80, 2, 0, 0, 84, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 88, 2, 0, 0, 84, 1, 0, 0, 152,
+		43, 0, 0, 25, 124, 1, 0, 112, 2, 0, 0, 84, 1, 0, 0, 215,
+		43, 0, 0, 9, 128, 1, 0, 120, 2, 0, 0, 84, 1, 0, 0, 252,
+		43, 0, 0, 13, 136, 1, 0, 168, 2, 0, 0, 84, 1, 0, 0, 65,
+		44, 0, 0, 13, 140, 1, 0, 176, 2, 0, 0, 84, 1, 0, 0, 86,
+		44, 0, 0, 29, 144, 1, 0, 192, 2, 0, 0, 84, 1, 0, 0, 143,
+		44, 0, 0, 13, 148, 1, 0, 248, 2, 0, 0, 84, 1, 0, 0, 220,
+		44, 0, 0, 26, 160, 1, 0, 0, 3, 0, 0, 84, 1, 0, 0, 220,
+		44, 0, 0, 24, 160, 1, 0, 8, 3, 0, 0, 84, 1, 0, 0, 250,
+		44, 0, 0, 28, 164, 1, 0, 16, 3, 0, 0, 84, 1, 0, 0, 250,
+		44, 0, 0, 26, 164, 1, 0, 24, 3, 0, 0, 84, 1, 0, 0, 28,
+		45, 0, 0, 30, 168, 1, 0, 40, 3, 0, 0, 84, 1, 0, 0, 70,
+		45, 0, 0, 13, 172, 1, 0, 72, 3, 0, 0, 84, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 96, 3, 0, 0, 84, 1, 0, 0, 112,
+		45, 0, 0, 17, 180, 1, 0, 128, 3, 0, 0, 84, 1, 0, 0, 112,
+		45, 0, 0, 17, 180, 1, 0, 152, 3, 0, 0, 84, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 160, 3, 0, 0, 84, 1, 0, 0, 191,
+		45, 0, 0, 19, 128, 2, 0, 184, 3, 0, 0, 84, 1, 0, 0, 254,
+		45, 0, 0, 9, 132, 2, 0, 200, 3, 0, 0, 84, 1, 0, 0, 15,
+		46, 0, 0, 9, 136, 2, 0, 216, 3, 0, 0, 84, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 224, 3, 0, 0, 84, 1, 0, 0, 55,
+		46, 0, 0, 9, 212, 1, 0, 248, 3, 0, 0, 84, 1, 0, 0, 109,
+		46, 0, 0, 9, 216, 1, 0, 0, 4, 0, 0, 84, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 24, 4, 0, 0, 84, 1, 0, 0, 140,
+		46, 0, 0, 1, 52, 2, 0, 48, 4, 0, 0, 84, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 56, 4, 0, 0, 84, 1, 0, 0, 142,
+		46, 0, 0, 13, 236, 1, 0, 104, 4, 0, 0, 84, 1, 0, 0, 142,
+		46, 0, 0, 13, 236, 1, 0, 136, 4, 0, 0, 84, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 144, 4, 0, 0, 84, 1, 0, 0, 191,
+		45, 0, 0, 19, 128, 2, 0, 168, 4, 0, 0, 84, 1, 0, 0, 254,
+		45, 0, 0, 9, 132, 2, 0, 176, 4, 0, 0, 84, 1, 0, 0, 15,
+		46, 0, 0, 9, 136, 2, 0, 200, 4, 0, 0, 84, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 208, 4, 0, 0, 84, 1, 0, 0, 218,
+		46, 0, 0, 28, 0, 2, 0, 232, 4, 0, 0, 84, 1, 0, 0, 35,
+		47, 0, 0, 13, 4, 2, 0, 240, 4, 0, 0, 84, 1, 0, 0, 57,
+		47, 0, 0, 13, 12, 2, 0, 8, 5, 0, 0, 84, 1, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 16, 5, 0, 0, 84, 1, 0, 0, 57,
+		47, 0, 0, 13, 12, 2, 0, 100, 47, 0, 0, 8, 0, 0, 0, 0,
+		0, 0, 0, 84, 1, 0, 0, 136, 47, 0, 0, 20, 72, 2, 0, 8,
+		0, 0, 0, 84, 1, 0, 0, 183, 47, 0, 0, 24, 76, 2, 0, 24,
+		0, 0, 0, 84, 1, 0, 0, 183, 47, 0, 0, 9, 76, 2, 0, 32,
+		0, 0, 0, 84, 1, 0, 0, 213, 47, 0, 0, 9, 88, 2, 0, 48,
+		0, 0, 0, 84, 1, 0, 0, 213, 47, 0, 0, 9, 88, 2, 0, 64,
+		0, 0, 0, 84, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 72,
+		0, 0, 0, 84, 1, 0, 0, 245, 47, 0, 0, 5, 104, 2, 0, 96,
+		0, 0, 0, 84, 1, 0, 0, 140, 46, 0, 0, 1, 112, 2, 0, 16,
+		0, 0, 0, 46, 1, 0, 0, 4, 0, 0, 0, 0, 0, 0, 0, 35,
+		0, 0, 0, 80, 1, 0, 0, 0, 0, 0, 0, 64, 0, 0, 0, 45,
+		0, 0, 0, 59, 41, 0, 0, 0, 0, 0, 0, 120, 0, 0, 0, 103,
+		1, 0, 0, 215, 41, 0, 0, 0, 0, 0, 0, 176, 0, 0, 0, 110,
+		1, 0, 0, 65, 42, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 3, 0, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 218, 0, 0, 0, 0, 0, 3, 0, 176,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 226,
-		0, 0, 0, 0, 0, 3, 0, 80, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 204, 0, 0, 0, 0, 0, 3, 0, 72,
-		2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 211,
-		0, 0, 0, 0, 0, 3, 0, 216, 1, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 249, 0, 0, 0, 0, 0, 3, 0, 104,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 20,
-		0, 0, 0, 1, 0, 6, 0, 64, 0, 0, 0, 0, 0, 0, 0, 41,
-		0, 0, 0, 0, 0, 0, 0, 233, 0, 0, 0, 0, 0, 3, 0, 192,
-		3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
-		0, 0, 0, 0, 0, 3, 0, 152, 3, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 38, 0, 0, 0, 1, 0, 6, 0, 105,
-		0, 0, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 0, 0, 0, 1,
-		1, 0, 0, 1, 0, 6, 0, 105, 4, 0, 0, 0, 0, 0, 0, 72,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0, 6, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 119,
-		0, 0, 0, 18, 0, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 120,
-		4, 0, 0, 0, 0, 0, 0, 66, 0, 0, 0, 17, 0, 7, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 32, 0, 0, 0, 0, 0, 0, 0, 151,
-		0, 0, 0, 17, 0, 6, 0, 0, 0, 0, 0, 0, 0, 0, 0, 64,
-		0, 0, 0, 0, 0, 0, 0, 53, 0, 0, 0, 17, 0, 7, 0, 32,
-		0, 0, 0, 0, 0, 0, 0, 16, 0, 0, 0, 0, 0, 0, 0, 76,
-		0, 0, 0, 17, 0, 7, 0, 48, 0, 0, 0, 0, 0, 0, 0, 32,
-		0, 0, 0, 0, 0, 0, 0, 196, 0, 0, 0, 17, 0, 5, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0, 3, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 82,
+		1, 0, 0, 0, 0, 3, 0, 24, 4, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 32, 1, 0, 0, 0, 0, 3, 0, 80,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2,
+		1, 0, 0, 0, 0, 3, 0, 72, 2, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 17, 1, 0, 0, 0, 0, 3, 0, 216,
+		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 47,
+		1, 0, 0, 0, 0, 3, 0, 208, 3, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 20, 0, 0, 0, 1, 0, 8, 0, 64,
+		0, 0, 0, 0, 0, 0, 0, 45, 0, 0, 0, 0, 0, 0, 0, 24,
+		1, 0, 0, 0, 0, 3, 0, 40, 4, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 39, 1, 0, 0, 0, 0, 3, 0, 0,
+		4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 78,
+		0, 0, 0, 1, 0, 8, 0, 109, 0, 0, 0, 0, 0, 0, 0, 0,
+		4, 0, 0, 0, 0, 0, 0, 9, 1, 0, 0, 0, 0, 3, 0, 192,
+		4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 62,
+		1, 0, 0, 1, 0, 8, 0, 109, 4, 0, 0, 0, 0, 0, 0, 72,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0, 5, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 55,
+		1, 0, 0, 0, 0, 5, 0, 96, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0, 8, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 173,
+		0, 0, 0, 18, 0, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 56,
+		5, 0, 0, 0, 0, 0, 0, 120, 0, 0, 0, 17, 0, 9, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 32, 0, 0, 0, 0, 0, 0, 0, 205,
+		0, 0, 0, 17, 0, 8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 64,
+		0, 0, 0, 0, 0, 0, 0, 107, 0, 0, 0, 17, 0, 9, 0, 32,
+		0, 0, 0, 0, 0, 0, 0, 16, 0, 0, 0, 0, 0, 0, 0, 93,
+		0, 0, 0, 17, 0, 9, 0, 80, 0, 0, 0, 0, 0, 0, 0, 32,
+		0, 0, 0, 0, 0, 0, 0, 130, 0, 0, 0, 17, 0, 9, 0, 48,
+		0, 0, 0, 0, 0, 0, 0, 32, 0, 0, 0, 0, 0, 0, 0, 59,
+		0, 0, 0, 18, 0, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 112,
+		0, 0, 0, 0, 0, 0, 0, 250, 0, 0, 0, 17, 0, 7, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 32,
-		1, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 14, 0, 0, 0, 184,
-		1, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 15, 0, 0, 0, 224,
-		1, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 15, 0, 0, 0, 88,
-		2, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 14, 0, 0, 0, 120,
-		2, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 16, 0, 0, 0, 200,
-		2, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 12, 0, 0, 0, 72,
-		3, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 14, 0, 0, 0, 120,
-		3, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 17, 0, 0, 0, 208,
-		3, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 17, 0, 0, 0, 232,
-		3, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 12, 0, 0, 0, 8,
-		4, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 17, 0, 0, 0, 80,
-		4, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 12, 0, 0, 0, 112,
-		152, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 14, 0, 0, 0, 124,
-		152, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 16, 0, 0, 0, 136,
-		152, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 17, 0, 0, 0, 160,
-		152, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 15, 0, 0, 0, 172,
-		152, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 12, 0, 0, 0, 184,
-		152, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 12, 0, 0, 0, 196,
-		152, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 12, 0, 0, 0, 220,
-		152, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 18, 0, 0, 0, 44,
-		0, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0, 64,
-		0, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0, 80,
+		1, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 17, 0, 0, 0, 184,
+		1, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 18, 0, 0, 0, 224,
+		1, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 18, 0, 0, 0, 88,
+		2, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 17, 0, 0, 0, 120,
+		2, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 19, 0, 0, 0, 200,
+		2, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 15, 0, 0, 0, 96,
+		3, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 17, 0, 0, 0, 160,
+		3, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 20, 0, 0, 0, 224,
+		3, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 21, 0, 0, 0, 56,
+		4, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 21, 0, 0, 0, 72,
+		4, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 15, 0, 0, 0, 144,
+		4, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 20, 0, 0, 0, 208,
+		4, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 21, 0, 0, 0, 16,
+		5, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 15, 0, 0, 0, 72,
+		0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 17, 0, 0, 0, 80,
+		64, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 17, 0, 0, 0, 92,
+		64, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 19, 0, 0, 0, 104,
+		64, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 21, 0, 0, 0, 116,
+		64, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 20, 0, 0, 0, 140,
+		64, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 18, 0, 0, 0, 152,
+		64, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 15, 0, 0, 0, 164,
+		64, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 15, 0, 0, 0, 176,
+		64, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 15, 0, 0, 0, 200,
+		64, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 23, 0, 0, 0, 44,
+		0, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0, 60,
+		0, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 13, 0, 0, 0, 80,
 		0, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0, 96,
 		0, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0, 112,
 		0, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0, 128,
@@ -9029,85 +4832,119 @@ var bpfObjects = map[string][]byte{
 		3, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0, 160,
 		3, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0, 176,
 		3, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0, 192,
-		3, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0, 220,
-		3, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0, 236,
-		3, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0, 252,
-		3, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0, 12,
-		4, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0, 23,
-		28, 25, 24, 26, 8, 27, 11, 12, 0, 46, 116, 101, 120, 116, 0, 46,
-		114, 101, 108, 46, 66, 84, 70, 46, 101, 120, 116, 0, 115, 121, 115, 95,
-		101, 110, 116, 101, 114, 46, 95, 95, 95, 95, 102, 109, 116, 0, 115, 121,
-		115, 95, 101, 110, 116, 101, 114, 46, 105, 110, 105, 116, 0, 101, 118, 101,
-		110, 116, 115, 0, 46, 109, 97, 112, 115, 0, 112, 105, 100, 95, 109, 110,
-		116, 110, 115, 0, 109, 110, 116, 110, 115, 95, 115, 121, 115, 99, 97, 108,
-		108, 115, 0, 46, 114, 101, 108, 116, 114, 97, 99, 101, 112, 111, 105, 110,
-		116, 47, 114, 97, 119, 95, 115, 121, 115, 99, 97, 108, 108, 115, 47, 115,
-		121, 115, 95, 101, 110, 116, 101, 114, 0, 46, 108, 108, 118, 109, 95, 97,
-		100, 100, 114, 115, 105, 103, 0, 108, 105, 99, 101, 110, 115, 101, 0, 102,
-		105, 108, 116, 101, 114, 95, 110, 97, 109, 101, 0, 46, 115, 116, 114, 116,
-		97, 98, 0, 46, 115, 121, 109, 116, 97, 98, 0, 46, 114, 111, 100, 97,
-		116, 97, 0, 46, 114, 101, 108, 46, 66, 84, 70, 0, 76, 73, 67, 69,
-		78, 83, 69, 0, 76, 66, 66, 48, 95, 56, 0, 76, 66, 66, 48, 95,
+		3, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0, 208,
+		3, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0, 224,
+		3, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0, 240,
+		3, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0, 0,
+		4, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0, 16,
+		4, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0, 32,
+		4, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0, 48,
+		4, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0, 64,
+		4, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0, 80,
+		4, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0, 96,
+		4, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0, 112,
+		4, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0, 128,
+		4, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0, 144,
+		4, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0, 168,
+		4, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 13, 0, 0, 0, 184,
+		4, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 13, 0, 0, 0, 200,
+		4, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 13, 0, 0, 0, 216,
+		4, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 13, 0, 0, 0, 232,
+		4, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 13, 0, 0, 0, 248,
+		4, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 13, 0, 0, 0, 8,
+		5, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 13, 0, 0, 0, 24,
+		5, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 13, 0, 0, 0, 52,
+		5, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0, 68,
+		5, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0, 84,
+		5, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0, 100,
+		5, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0, 26,
+		32, 33, 28, 27, 29, 8, 31, 11, 13, 30, 0, 46, 116, 101, 120, 116,
+		0, 46, 114, 101, 108, 46, 66, 84, 70, 46, 101, 120, 116, 0, 115, 121,
+		115, 95, 101, 110, 116, 101, 114, 46, 95, 95, 95, 95, 102, 109, 116, 0,
+		46, 114, 101, 108, 116, 114, 97, 99, 101, 112, 111, 105, 110, 116, 47, 115,
+		99, 104, 101, 100, 47, 115, 99, 104, 101, 100, 95, 112, 114, 111, 99, 101,
+		115, 115, 95, 101, 120, 105, 116, 0, 115, 121, 115, 95, 101, 110, 116, 101,
+		114, 46, 105, 110, 105, 116, 0, 109, 97, 112, 95, 111, 118, 101, 114, 102,
+		108, 111, 119, 115, 0, 101, 118, 101, 110, 116, 115, 0, 46, 109, 97, 112,
+		115, 0, 112, 105, 100, 95, 109, 110, 116, 110, 115, 0, 109, 110, 116, 110,
+		115, 95, 115, 121, 115, 99, 97, 108, 108, 115, 0, 46, 114, 101, 108, 116,
+		114, 97, 99, 101, 112, 111, 105, 110, 116, 47, 114, 97, 119, 95, 115, 121,
+		115, 99, 97, 108, 108, 115, 47, 115, 121, 115, 95, 101, 110, 116, 101, 114,
+		0, 46, 108, 108, 118, 109, 95, 97, 100, 100, 114, 115, 105, 103, 0, 108,
+		105, 99, 101, 110, 115, 101, 0, 102, 105, 108, 116, 101, 114, 95, 110, 97,
+		109, 101, 0, 46, 115, 116, 114, 116, 97, 98, 0, 46, 115, 121, 109, 116,
+		97, 98, 0, 46, 114, 111, 100, 97, 116, 97, 0, 46, 114, 101, 108, 46,
+		66, 84, 70, 0, 76, 73, 67, 69, 78, 83, 69, 0, 76, 66, 66, 48,
+		95, 56, 0, 76, 66, 66, 48, 95, 49, 56, 0, 76, 66, 66, 48, 95,
 		53, 0, 76, 66, 66, 48, 95, 49, 53, 0, 76, 66, 66, 48, 95, 52,
-		0, 76, 66, 66, 48, 95, 49, 51, 0, 76, 66, 66, 48, 95, 49, 50,
-		0, 76, 66, 66, 48, 95, 49, 49, 0, 115, 121, 115, 95, 101, 110, 116,
-		101, 114, 46, 95, 95, 95, 95, 102, 109, 116, 46, 49, 0, 0, 0, 0,
+		0, 76, 66, 66, 48, 95, 49, 52, 0, 76, 66, 66, 48, 95, 49, 51,
+		0, 76, 66, 66, 49, 95, 50, 0, 115, 121, 115, 95, 101, 110, 116, 101,
+		114, 46, 95, 95, 95, 95, 102, 109, 116, 46, 49, 0, 76, 66, 66, 48,
+		95, 50, 48, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 163,
-		0, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 105, 19, 1, 0, 0, 0, 0, 0, 21,
-		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1,
-		0, 0, 0, 1, 0, 0, 0, 6, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 4,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 95,
-		0, 0, 0, 1, 0, 0, 0, 6, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 120,
-		4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 8,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 91,
-		0, 0, 0, 9, 0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 64, 14, 1, 0, 0, 0, 0, 0, 192,
-		0, 0, 0, 0, 0, 0, 0, 13, 0, 0, 0, 3, 0, 0, 0, 8,
-		0, 0, 0, 0, 0, 0, 0, 16, 0, 0, 0, 0, 0, 0, 0, 143,
-		0, 0, 0, 1, 0, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 184, 4, 0, 0, 0, 0, 0, 0, 13,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 179,
-		0, 0, 0, 1, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 197, 4, 0, 0, 0, 0, 0, 0, 177,
-		4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 60,
-		0, 0, 0, 1, 0, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 120, 9, 0, 0, 0, 0, 0, 0, 80,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 8,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 191,
-		0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 200, 9, 0, 0, 0, 0, 0, 0, 147,
-		254, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 4,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 187,
-		0, 0, 0, 9, 0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 15, 1, 0, 0, 0, 0, 0, 128,
-		0, 0, 0, 0, 0, 0, 0, 13, 0, 0, 0, 8, 0, 0, 0, 8,
-		0, 0, 0, 0, 0, 0, 0, 16, 0, 0, 0, 0, 0, 0, 0, 11,
-		0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 92, 8, 1, 0, 0, 0, 0, 0, 28,
-		4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 4,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7,
-		0, 0, 0, 9, 0, 0, 0, 64, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 128, 15, 1, 0, 0, 0, 0, 0, 224,
-		3, 0, 0, 0, 0, 0, 0, 13, 0, 0, 0, 10, 0, 0, 0, 8,
-		0, 0, 0, 0, 0, 0, 0, 16, 0, 0, 0, 0, 0, 0, 0, 129,
-		0, 0, 0, 3, 76, 255, 111, 0, 0, 0, 128, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 96, 19, 1, 0, 0, 0, 0, 0, 9,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 171,
-		0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 120, 12, 1, 0, 0, 0, 0, 0, 200,
-		1, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 13, 0, 0, 0, 8,
-		0, 0, 0, 0, 0, 0, 0, 24, 0, 0, 0, 0, 0, 0, 0},
+		0, 0, 0, 0, 0, 0, 0, 217, 0, 0, 0, 3, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 107,
+		145, 0, 0, 0, 0, 0, 0, 90, 1, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 1, 0, 0, 0, 6,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 64,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 149, 0, 0, 0, 1, 0, 0, 0, 6,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 64,
+		0, 0, 0, 0, 0, 0, 0, 56, 5, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 145, 0, 0, 0, 9, 0, 0, 0, 64,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 176,
+		138, 0, 0, 0, 0, 0, 0, 224, 0, 0, 0, 0, 0, 0, 0, 15,
+		0, 0, 0, 3, 0, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 16,
+		0, 0, 0, 0, 0, 0, 0, 42, 0, 0, 0, 1, 0, 0, 0, 6,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 120,
+		5, 0, 0, 0, 0, 0, 0, 112, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 38, 0, 0, 0, 9, 0, 0, 0, 64,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 144,
+		139, 0, 0, 0, 0, 0, 0, 16, 0, 0, 0, 0, 0, 0, 0, 15,
+		0, 0, 0, 5, 0, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 16,
+		0, 0, 0, 0, 0, 0, 0, 197, 0, 0, 0, 1, 0, 0, 0, 3,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 232,
+		5, 0, 0, 0, 0, 0, 0, 13, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 233, 0, 0, 0, 1, 0, 0, 0, 2,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 245,
+		5, 0, 0, 0, 0, 0, 0, 181, 4, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 114, 0, 0, 0, 1, 0, 0, 0, 3,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 176,
+		10, 0, 0, 0, 0, 0, 0, 112, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 245, 0, 0, 0, 1, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 32,
+		11, 0, 0, 0, 0, 0, 0, 215, 119, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 241, 0, 0, 0, 9, 0, 0, 0, 64,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 160,
+		139, 0, 0, 0, 0, 0, 0, 144, 0, 0, 0, 0, 0, 0, 0, 15,
+		0, 0, 0, 10, 0, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 16,
+		0, 0, 0, 0, 0, 0, 0, 11, 0, 0, 0, 1, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 248,
+		130, 0, 0, 0, 0, 0, 0, 116, 5, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 7, 0, 0, 0, 9, 0, 0, 0, 64,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 48,
+		140, 0, 0, 0, 0, 0, 0, 48, 5, 0, 0, 0, 0, 0, 0, 15,
+		0, 0, 0, 12, 0, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 16,
+		0, 0, 0, 0, 0, 0, 0, 183, 0, 0, 0, 3, 76, 255, 111, 0,
+		0, 0, 128, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 96,
+		145, 0, 0, 0, 0, 0, 0, 11, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 225, 0, 0, 0, 2, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 112,
+		136, 0, 0, 0, 0, 0, 0, 64, 2, 0, 0, 0, 0, 0, 0, 1,
+		0, 0, 0, 16, 0, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 24,
+		0, 0, 0, 0, 0, 0, 0},
 }
 
 var btfJSON = `{
@@ -21,6 +21,7 @@ package bpfrecorder
 
 import (
 	"context"
+	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"net"
@@ -60,6 +61,8 @@ type impl interface {
 	GetProgram(*bpf.Module, string) (*bpf.BPFProg, error)
 	AttachTracepoint(*bpf.BPFProg, string, string) (*bpf.BPFLink, error)
 	GetMap(*bpf.Module, string) (*bpf.BPFMap, error)
+	ResizeMap(*bpf.Module, string, uint32) error
+	MapKeys(*bpf.BPFMap) ([]uint32, error)
 	InitRingBuf(*bpf.Module, string, chan []byte) (*bpf.RingBuffer, error)
 	Stat(string) (os.FileInfo, error)
 	Unmarshal([]byte, interface{}) error
@@ -68,6 +71,7 @@ type impl interface {
 	TempFile(string, string) (*os.File, error)
 	Write(*os.File, []byte) (int, error)
 	ContainerIDForPID(*ttlcache.Cache[string, string], int) (string, error)
+	ContainerIDsForCgroups(string) (map[uint64]string, error)
 	GetValue(*bpf.BPFMap, uint32) ([]byte, error)
 	GetValue64(*bpf.BPFMap, uint64) ([]byte, error)
 	UpdateValue(*bpf.BPFMap, uint32, []byte) error
@@ -82,6 +86,7 @@ type impl interface {
 	PollRingBuffer(*bpf.RingBuffer, int)
 	GoArch() string
 	Readlink(string) (string, error)
+	ReadDir(string) ([]os.DirEntry, error)
 	ParseUint(string) (uint32, error)
 	DialMetrics() (*grpc.ClientConn, context.CancelFunc, error)
 	BpfIncClient(client apimetrics.MetricsClient) (apimetrics.Metrics_BpfIncClient, error)
@@ -132,6 +137,26 @@ func (d *defaultImpl) GetMap(module *bpf.Module, mapName string) (*bpf.BPFMap, e
 	return module.GetMap(mapName)
 }
 
+func (d *defaultImpl) ResizeMap(module *bpf.Module, mapName string, maxEntries uint32) error {
+	m, err := module.GetMap(mapName)
+	if err != nil {
+		return err
+	}
+	return m.Resize(maxEntries)
+}
+
+func (d *defaultImpl) MapKeys(m *bpf.BPFMap) ([]uint32, error) {
+	if m == nil {
+		return nil, errors.New("provided bpf map is nil")
+	}
+	keys := []uint32{}
+	it := m.Iterator()
+	for it.Next() {
+		keys = append(keys, binary.LittleEndian.Uint32(it.Key()))
+	}
+	return keys, it.Err()
+}
+
 func (d *defaultImpl) InitRingBuf(module *bpf.Module, mapName string, eventsChan chan []byte) (*bpf.RingBuffer, error) {
 	return module.InitRingBuf(mapName, eventsChan)
 }
@@ -164,6 +189,10 @@ func (d *defaultImpl) ContainerIDForPID(cache *ttlcache.Cache[string, string], p
 	return util.ContainerIDForPID(cache, pid)
 }
 
+func (d *defaultImpl) ContainerIDsForCgroups(root string) (map[uint64]string, error) {
+	return util.ContainerIDsForCgroups(root)
+}
+
 func (d *defaultImpl) GetValue(m *bpf.BPFMap, key uint32) ([]byte, error) {
 	if m == nil {
 		return nil, errors.New("provided bpf map is nil")
@@ -242,6 +271,10 @@ func (d *defaultImpl) Readlink(name string) (string, error) {
 	return os.Readlink(name)
 }
 
+func (d *defaultImpl) ReadDir(name string) ([]os.DirEntry, error) {
+	return os.ReadDir(name)
+}
+
 func (d *defaultImpl) ParseUint(s string) (uint32, error) {
 	value, err := strconv.ParseUint(s, 10, 32)
 	return uint32(value), err
@@ -0,0 +1,717 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+)
+
+var cacheLog = logf.Log.WithName("namespace-scoped-cache")
+
+// NewNamespaceScopedCache returns a cache.NewCacheFunc which restricts the
+// namespaced objects to the namespaces in the scope of the operator. Every
+// namespace in scope gets its own cache, which is started and stopped when
+// the namespace labels or the namespace selector of the SPOD instance change.
+// The DefaultNamespaces of the cache options restrict the scope further. A
+// nil newCache defaults to cache.New.
+func NewNamespaceScopedCache(newCache cache.NewCacheFunc) cache.NewCacheFunc {
+	if newCache == nil {
+		newCache = cache.New
+	}
+
+	return func(cfg *rest.Config, opts cache.Options) (cache.Cache, error) {
+		restrict := opts.DefaultNamespaces
+		opts.DefaultNamespaces = nil
+
+		clusterCache, err := newCache(cfg, namespaceCacheOptions(opts, corev1.NamespaceAll))
+		if err != nil {
+			return nil, fmt.Errorf("create cluster scoped cache: %w", err)
+		}
+
+		return &namespaceScopedCache{
+			config:       cfg,
+			opts:         opts,
+			newCache:     newCache,
+			restrict:     restrict,
+			clusterCache: clusterCache,
+			namespaces:   map[string]*namespaceCache{},
+			informers:    map[schema.GroupVersionKind]*namespacedInformer{},
+			synced:       make(chan struct{}),
+		}, nil
+	}
+}
+
+// namespacesInScope returns the namespaces whose objects get cached. The
+// empty namespace stands for all namespaces.
+func namespacesInScope(
+	sel *metav1.LabelSelector, namespaces []corev1.Namespace, restrict map[string]cache.Config,
+) (map[string]bool, error) {
+	operatorNamespace := config.GetOperatorNamespace()
+
+	if sel == nil {
+		if len(restrict) == 0 {
+			return map[string]bool{corev1.NamespaceAll: true}, nil
+		}
+
+		inScope := map[string]bool{operatorNamespace: true}
+		for ns := range restrict {
+			inScope[ns] = true
+		}
+		return inScope, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(sel)
+	if err != nil {
+		return nil, fmt.Errorf("parsing namespace selector: %w", err)
+	}
+
+	inScope := map[string]bool{operatorNamespace: true}
+	for i := range namespaces {
+		ns := &namespaces[i]
+		if _, ok := restrict[ns.Name]; len(restrict) > 0 && !ok {
+			continue
+		}
+		if selector.Matches(labels.Set(ns.Labels)) {
+			inScope[ns.Name] = true
+		}
+	}
+
+	return inScope, nil
+}
+
+// namespaceCacheOptions returns the cache options for a single namespace.
+func namespaceCacheOptions(opts cache.Options, namespace string) cache.Options {
+	if namespace != corev1.NamespaceAll {
+		opts.DefaultNamespaces = map[string]cache.Config{namespace: {}}
+	}
+
+	// The cache defaults the options in place, which must not leak into the
+	// caches of the other namespaces.
+	if opts.ByObject != nil {
+		byObject := make(map[client.Object]cache.ByObject, len(opts.ByObject))
+		for obj, cfg := range opts.ByObject {
+			byObject[obj] = cfg
+		}
+		opts.ByObject = byObject
+	}
+
+	return opts
+}
+
+type namespaceCache struct {
+	cache.Cache
+	cancel context.CancelFunc
+}
+
+type fieldIndex struct {
+	obj          client.Object
+	field        string
+	extractValue client.IndexerFunc
+}
+
+type namespaceScopedCache struct {
+	config       *rest.Config
+	opts         cache.Options
+	newCache     cache.NewCacheFunc
+	restrict     map[string]cache.Config
+	clusterCache cache.Cache
+
+	mu         sync.RWMutex
+	started    bool
+	namespaces map[string]*namespaceCache
+	informers  map[schema.GroupVersionKind]*namespacedInformer
+	indexes    []fieldIndex
+	synced     chan struct{}
+}
+
+var _ cache.Cache = &namespaceScopedCache{}
+
+func (c *namespaceScopedCache) isNamespaced(obj runtime.Object) (bool, error) {
+	return apiutil.IsObjectNamespaced(obj, c.opts.Scheme, c.opts.Mapper)
+}
+
+// cacheFor returns the cache of the namespace, if it is in scope.
+func (c *namespaceScopedCache) cacheFor(namespace string) (cache.Cache, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if nc, ok := c.namespaces[namespace]; ok {
+		return nc, true
+	}
+	if nc, ok := c.namespaces[corev1.NamespaceAll]; ok {
+		return nc, true
+	}
+	return nil, false
+}
+
+func (c *namespaceScopedCache) caches() []cache.Cache {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	caches := make([]cache.Cache, 0, len(c.namespaces))
+	for _, nc := range c.namespaces {
+		caches = append(caches, nc)
+	}
+	return caches
+}
+
+// Get retrieves an object from the cache of its namespace. Objects of
+// namespaces which are not in scope are not found.
+func (c *namespaceScopedCache) Get(
+	ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption,
+) error {
+	namespaced, err := c.isNamespaced(obj)
+	if err != nil {
+		return err
+	}
+	if !namespaced {
+		return c.clusterCache.Get(ctx, key, obj, opts...)
+	}
+
+	nc, ok := c.cacheFor(key.Namespace)
+	if !ok {
+		gvk, err := apiutil.GVKForObject(obj, c.opts.Scheme)
+		if err != nil {
+			return err
+		}
+		mapping, err := c.opts.Mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		if err != nil {
+			return err
+		}
+		return kerrors.NewNotFound(mapping.Resource.GroupResource(), key.Name)
+	}
+	return nc.Get(ctx, key, obj, opts...)
+}
+
+// List retrieves the objects of all namespaces in scope, or of the requested
+// namespace if it is in scope.
+func (c *namespaceScopedCache) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	listOpts := client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+
+	namespaced, err := c.isNamespaced(list)
+	if err != nil {
+		return err
+	}
+	if !namespaced {
+		return c.clusterCache.List(ctx, list, opts...)
+	}
+
+	if listOpts.Namespace != corev1.NamespaceAll {
+		nc, ok := c.cacheFor(listOpts.Namespace)
+		if !ok {
+			return meta.SetList(list, nil)
+		}
+		return nc.List(ctx, list, opts...)
+	}
+
+	listAccessor, err := meta.ListAccessor(list)
+	if err != nil {
+		return err
+	}
+
+	allItems := []runtime.Object{}
+	limitSet := listOpts.Limit > 0
+	var resourceVersion string
+	for _, nc := range c.caches() {
+		listObj, ok := list.DeepCopyObject().(client.ObjectList)
+		if !ok {
+			return fmt.Errorf("object: %T must be a list type", list)
+		}
+		if err := nc.List(ctx, listObj, &listOpts); err != nil {
+			return err
+		}
+		items, err := meta.ExtractList(listObj)
+		if err != nil {
+			return err
+		}
+		accessor, err := meta.ListAccessor(listObj)
+		if err != nil {
+			return fmt.Errorf("object: %T must be a list type", list)
+		}
+		allItems = append(allItems, items...)
+		resourceVersion = accessor.GetResourceVersion()
+
+		if limitSet {
+			listOpts.Limit -= int64(len(items))
+			if listOpts.Limit == 0 {
+				break
+			}
+		}
+	}
+	listAccessor.SetResourceVersion(resourceVersion)
+
+	return meta.SetList(list, allItems)
+}
+
+// GetInformer returns an informer spanning the caches of all namespaces in
+// scope, including the ones entering the scope later on.
+func (c *namespaceScopedCache) GetInformer(
+	ctx context.Context, obj client.Object, opts ...cache.InformerGetOption,
+) (cache.Informer, error) {
+	gvk, err := apiutil.GVKForObject(obj, c.opts.Scheme)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetInformerForKind(ctx, gvk, opts...)
+}
+
+// GetInformerForKind is similar to GetInformer, except that it takes a
+// group-version-kind.
+func (c *namespaceScopedCache) GetInformerForKind(
+	ctx context.Context, gvk schema.GroupVersionKind, opts ...cache.InformerGetOption,
+) (cache.Informer, error) {
+	namespaced, err := apiutil.IsGVKNamespaced(gvk, c.opts.Mapper)
+	if err != nil {
+		return nil, err
+	}
+	if !namespaced {
+		return c.clusterCache.GetInformerForKind(ctx, gvk, opts...)
+	}
+
+	informer, started, err := c.namespacedInformer(ctx, gvk)
+	if err != nil {
+		return nil, err
+	}
+
+	getOpts := cache.InformerGetOptions{}
+	for _, opt := range opts {
+		opt(&getOpts)
+	}
+	if started && (getOpts.BlockUntilSynced == nil || *getOpts.BlockUntilSynced) {
+		if !toolscache.WaitForCacheSync(ctx.Done(), informer.HasSynced) {
+			return nil, errors.New("failed waiting for informer to sync")
+		}
+	}
+
+	return informer, nil
+}
+
+func (c *namespaceScopedCache) namespacedInformer(
+	ctx context.Context, gvk schema.GroupVersionKind,
+) (informer *namespacedInformer, started bool, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if informer, ok := c.informers[gvk]; ok {
+		return informer, c.started, nil
+	}
+
+	informer = &namespacedInformer{
+		informers:     map[string]cache.Informer{},
+		registrations: map[*namespacedRegistration]bool{},
+	}
+	for ns, nc := range c.namespaces {
+		i, err := nc.GetInformerForKind(ctx, gvk, cache.BlockUntilSynced(false))
+		if err != nil {
+			return nil, false, err
+		}
+		if err := informer.add(ns, i); err != nil {
+			return nil, false, err
+		}
+	}
+	c.informers[gvk] = informer
+
+	return informer, c.started, nil
+}
+
+// IndexField adds the index to the caches of all namespaces in scope,
+// including the ones entering the scope later on.
+func (c *namespaceScopedCache) IndexField(
+	ctx context.Context, obj client.Object, field string, extractValue client.IndexerFunc,
+) error {
+	namespaced, err := c.isNamespaced(obj)
+	if err != nil {
+		return err
+	}
+	if !namespaced {
+		return c.clusterCache.IndexField(ctx, obj, field, extractValue)
+	}
+
+	c.mu.Lock()
+	c.indexes = append(c.indexes, fieldIndex{obj: obj, field: field, extractValue: extractValue})
+	caches := make([]cache.Cache, 0, len(c.namespaces))
+	for _, nc := range c.namespaces {
+		caches = append(caches, nc)
+	}
+	c.mu.Unlock()
+
+	for _, nc := range caches {
+		if err := nc.IndexField(ctx, obj, field, extractValue); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Start runs the cluster scoped cache and the caches of the namespaces in
+// scope, and follows the scope until the context is closed.
+func (c *namespaceScopedCache) Start(ctx context.Context) error {
+	go func() {
+		if err := c.clusterCache.Start(ctx); err != nil {
+			cacheLog.Error(err, "Cluster scoped cache failed to start")
+		}
+	}()
+
+	changed := make(chan struct{}, 1)
+	handler := toolscache.ResourceEventHandlerFuncs{
+		AddFunc:    func(interface{}) { notifyScopeChange(changed) },
+		UpdateFunc: func(interface{}, interface{}) { notifyScopeChange(changed) },
+		DeleteFunc: func(interface{}) { notifyScopeChange(changed) },
+	}
+
+	nsInformer, err := c.clusterCache.GetInformer(ctx, &corev1.Namespace{}, cache.BlockUntilSynced(false))
+	if err != nil {
+		return fmt.Errorf("get namespace informer: %w", err)
+	}
+	if _, err := nsInformer.AddEventHandler(handler); err != nil {
+		return fmt.Errorf("add namespace event handler: %w", err)
+	}
+
+	// The scope is read from the cluster scoped cache, which watches the
+	// SPOD regardless of the namespaces in scope.
+	spodInformer, err := c.clusterCache.GetInformer(
+		ctx, &spodv1alpha1.SecurityProfilesOperatorDaemon{}, cache.BlockUntilSynced(false),
+	)
+	if err != nil {
+		return fmt.Errorf("get SPOD informer: %w", err)
+	}
+	if _, err := spodInformer.AddEventHandler(handler); err != nil {
+		return fmt.Errorf("add SPOD event handler: %w", err)
+	}
+
+	if !c.clusterCache.WaitForCacheSync(ctx) {
+		return errors.New("cluster scoped cache did not sync")
+	}
+
+	if err := c.updateScope(ctx); err != nil {
+		return fmt.Errorf("initialize namespace scope: %w", err)
+	}
+
+	c.mu.Lock()
+	c.started = true
+	c.mu.Unlock()
+	close(c.synced)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-changed:
+			if err := c.updateScope(ctx); err != nil {
+				cacheLog.Error(err, "Cannot update namespace scope")
+			}
+		}
+	}
+}
+
+func notifyScopeChange(changed chan<- struct{}) {
+	select {
+	case changed <- struct{}{}:
+	default:
+	}
+}
+
+// updateScope starts the caches of the namespaces entering the scope and
+// stops the ones of the namespaces leaving it.
+func (c *namespaceScopedCache) updateScope(ctx context.Context) error {
+	var sel *metav1.LabelSelector
+	spod := &spodv1alpha1.SecurityProfilesOperatorDaemon{}
+	err := c.clusterCache.Get(ctx, client.ObjectKey{
+		Name:      GetSPODName(),
+		Namespace: config.GetOperatorNamespace(),
+	}, spod)
+	if err != nil && !kerrors.IsNotFound(err) {
+		return fmt.Errorf("getting SPOD: %w", err)
+	}
+	if err == nil {
+		sel = spod.Spec.NamespaceSelector
+	}
+
+	namespaces := &corev1.NamespaceList{}
+	if sel != nil {
+		if err := c.clusterCache.List(ctx, namespaces); err != nil {
+			return fmt.Errorf("listing namespaces: %w", err)
+		}
+	}
+
+	inScope, err := namespacesInScope(sel, namespaces.Items, c.restrict)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Start the new caches first to not lose objects when switching from
+	// all namespaces to selected ones.
+	for ns := range inScope {
+		if _, ok := c.namespaces[ns]; ok {
+			continue
+		}
+		if err := c.startNamespace(ctx, ns); err != nil {
+			return fmt.Errorf("start cache for namespace %q: %w", ns, err)
+		}
+		cacheLog.Info("Watching namespace", "namespace", ns)
+	}
+
+	for ns, nc := range c.namespaces {
+		if inScope[ns] {
+			continue
+		}
+		nc.cancel()
+		delete(c.namespaces, ns)
+		for _, informer := range c.informers {
+			informer.remove(ns)
+		}
+		cacheLog.Info("Stopped watching namespace", "namespace", ns)
+	}
+
+	return nil
+}
+
+// startNamespace creates and starts the cache of a namespace with the
+// informers and indexes of the already existing caches. Requires c.mu.
+func (c *namespaceScopedCache) startNamespace(ctx context.Context, namespace string) error {
+	nc, err := c.newCache(c.config, namespaceCacheOptions(c.opts, namespace))
+	if err != nil {
+		return err
+	}
+
+	for _, index := range c.indexes {
+		if err := nc.IndexField(ctx, index.obj, index.field, index.extractValue); err != nil {
+			return err
+		}
+	}
+
+	for gvk, informer := range c.informers {
+		i, err := nc.GetInformerForKind(ctx, gvk, cache.BlockUntilSynced(false))
+		if err != nil {
+			return err
+		}
+		if err := informer.add(namespace, i); err != nil {
+			return err
+		}
+	}
+
+	nsCtx, cancel := context.WithCancel(ctx)
+	go func() {
+		if err := nc.Start(nsCtx); err != nil {
+			cacheLog.Error(err, "Namespace cache failed to start", "namespace", namespace)
+		}
+	}()
+	c.namespaces[namespace] = &namespaceCache{Cache: nc, cancel: cancel}
+
+	return nil
+}
+
+// WaitForCacheSync waits until the namespace scope is known and all caches
+// in scope are synced.
+func (c *namespaceScopedCache) WaitForCacheSync(ctx context.Context) bool {
+	select {
+	case <-c.synced:
+	case <-ctx.Done():
+		return false
+	}
+
+	synced := c.clusterCache.WaitForCacheSync(ctx)
+	for _, nc := range c.caches() {
+		if !nc.WaitForCacheSync(ctx) {
+			synced = false
+		}
+	}
+	return synced
+}
+
+// namespacedInformer distributes the event handlers and indexers to the
+// informers of the namespaces in scope.
+type namespacedInformer struct {
+	mu            sync.Mutex
+	informers     map[string]cache.Informer
+	indexers      []toolscache.Indexers
+	registrations map[*namespacedRegistration]bool
+}
+
+var _ cache.Informer = &namespacedInformer{}
+
+type namespacedRegistration struct {
+	informer     *namespacedInformer
+	handler      toolscache.ResourceEventHandler
+	resyncPeriod *time.Duration
+	handles      map[string]toolscache.ResourceEventHandlerRegistration
+}
+
+func (i *namespacedInformer) add(namespace string, informer cache.Informer) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	for _, indexers := range i.indexers {
+		if err := informer.AddIndexers(indexers); err != nil {
+			return err
+		}
+	}
+	for reg := range i.registrations {
+		handle, err := reg.addTo(informer)
+		if err != nil {
+			return err
+		}
+		reg.handles[namespace] = handle
+	}
+	i.informers[namespace] = informer
+
+	return nil
+}
+
+func (i *namespacedInformer) remove(namespace string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	delete(i.informers, namespace)
+	for reg := range i.registrations {
+		delete(reg.handles, namespace)
+	}
+}
+
+func (i *namespacedInformer) addEventHandler(
+	handler toolscache.ResourceEventHandler, resyncPeriod *time.Duration,
+) (toolscache.ResourceEventHandlerRegistration, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	reg := &namespacedRegistration{
+		informer:     i,
+		handler:      handler,
+		resyncPeriod: resyncPeriod,
+		handles:      make(map[string]toolscache.ResourceEventHandlerRegistration, len(i.informers)),
+	}
+	for ns, informer := range i.informers {
+		handle, err := reg.addTo(informer)
+		if err != nil {
+			return nil, err
+		}
+		reg.handles[ns] = handle
+	}
+	i.registrations[reg] = true
+
+	return reg, nil
+}
+
+// AddEventHandler adds the handler to the informers of all namespaces.
+func (i *namespacedInformer) AddEventHandler(
+	handler toolscache.ResourceEventHandler,
+) (toolscache.ResourceEventHandlerRegistration, error) {
+	return i.addEventHandler(handler, nil)
+}
+
+// AddEventHandlerWithResyncPeriod adds the handler with a resync period to
+// the informers of all namespaces.
+func (i *namespacedInformer) AddEventHandlerWithResyncPeriod(
+	handler toolscache.ResourceEventHandler, resyncPeriod time.Duration,
+) (toolscache.ResourceEventHandlerRegistration, error) {
+	return i.addEventHandler(handler, &resyncPeriod)
+}
+
+// RemoveEventHandler removes a previously added event handler given by its
+// registration handle.
+func (i *namespacedInformer) RemoveEventHandler(handle toolscache.ResourceEventHandlerRegistration) error {
+	reg, ok := handle.(*namespacedRegistration)
+	if !ok {
+		return errors.New("registration is not a registration returned by the namespace scoped informer")
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	for ns, h := range reg.handles {
+		if err := i.informers[ns].RemoveEventHandler(h); err != nil {
+			return err
+		}
+	}
+	delete(i.registrations, reg)
+
+	return nil
+}
+
+// AddIndexers adds the indexers to the informers of all namespaces.
+func (i *namespacedInformer) AddIndexers(indexers toolscache.Indexers) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	for _, informer := range i.informers {
+		if err := informer.AddIndexers(indexers); err != nil {
+			return err
+		}
+	}
+	i.indexers = append(i.indexers, indexers)
+
+	return nil
+}
+
+// HasSynced returns true if the informers of all namespaces have synced.
+func (i *namespacedInformer) HasSynced() bool {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	for _, informer := range i.informers {
+		if !informer.HasSynced() {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *namespacedRegistration) addTo(informer cache.Informer) (toolscache.ResourceEventHandlerRegistration, error) {
+	if r.resyncPeriod != nil {
+		return informer.AddEventHandlerWithResyncPeriod(r.handler, *r.resyncPeriod)
+	}
+	return informer.AddEventHandler(r.handler)
+}
+
+// HasSynced returns true if the handler has been called for the initial
+// state of the informers of all namespaces.
+func (r *namespacedRegistration) HasSynced() bool {
+	r.informer.mu.Lock()
+	defer r.informer.mu.Unlock()
+
+	for _, handle := range r.handles {
+		if !handle.HasSynced() {
+			return false
+		}
+	}
+	return true
+}
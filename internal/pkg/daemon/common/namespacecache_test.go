@@ -0,0 +1,102 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+)
+
+//nolint:paralleltest // cannot set environment variables in parallel tests
+func TestNamespacesInScope(t *testing.T) {
+	const operatorNamespace = "security-profiles-operator"
+	t.Setenv(config.OperatorNamespaceEnvKey, operatorNamespace)
+
+	namespaces := []corev1.Namespace{
+		{ObjectMeta: metav1.ObjectMeta{Name: operatorNamespace}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "a", Labels: map[string]string{"tenant": "a"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "b", Labels: map[string]string{"tenant": "b"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "c", Labels: map[string]string{"tenant": "a"}}},
+	}
+	tenantSelector := &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "a"}}
+
+	for _, tc := range []struct {
+		name     string
+		sel      *metav1.LabelSelector
+		restrict map[string]cache.Config
+		want     map[string]bool
+	}{
+		{
+			name: "all namespaces",
+			want: map[string]bool{"": true},
+		},
+		{
+			name:     "restricted namespaces",
+			restrict: map[string]cache.Config{"b": {}},
+			want:     map[string]bool{operatorNamespace: true, "b": true},
+		},
+		{
+			name: "selected namespaces",
+			sel:  tenantSelector,
+			want: map[string]bool{operatorNamespace: true, "a": true, "c": true},
+		},
+		{
+			name:     "selected and restricted namespaces",
+			sel:      tenantSelector,
+			restrict: map[string]cache.Config{"a": {}, "b": {}},
+			want:     map[string]bool{operatorNamespace: true, "a": true},
+		},
+		{
+			name: "no selected namespaces",
+			sel:  &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "d"}},
+			want: map[string]bool{operatorNamespace: true},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			inScope, err := namespacesInScope(tc.sel, namespaces, tc.restrict)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, inScope)
+		})
+	}
+}
+
+func TestNamespaceCacheOptions(t *testing.T) {
+	t.Parallel()
+
+	pod := &corev1.Pod{}
+	opts := cache.Options{ByObject: map[client.Object]cache.ByObject{pod: {}}}
+
+	nsOpts := namespaceCacheOptions(opts, "ns")
+	require.Equal(t, map[string]cache.Config{"ns": {}}, nsOpts.DefaultNamespaces)
+
+	// the defaulting of a namespace cache does not leak into the others
+	byObject := nsOpts.ByObject[pod]
+	byObject.Namespaces = nsOpts.DefaultNamespaces
+	nsOpts.ByObject[pod] = byObject
+	require.Nil(t, opts.ByObject[pod].Namespaces)
+
+	allOpts := namespaceCacheOptions(opts, corev1.NamespaceAll)
+	require.Nil(t, allOpts.DefaultNamespaces)
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+)
+
+// InNamespaceScope returns true if the operator manages the objects of the
+// provided namespace, which is the case if the SPOD instance has no namespace
+// selector or if the namespace labels match it. Cluster scoped objects and
+// the operator namespace are always in scope.
+func InNamespaceScope(ctx context.Context, cli client.Client, namespace string) (bool, error) {
+	if namespace == "" || namespace == config.GetOperatorNamespace() {
+		return true, nil
+	}
+
+	spod, err := GetSPOD(ctx, cli)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, fmt.Errorf("getting SPOD: %w", err)
+	}
+
+	return NamespaceMatches(ctx, cli, spod.Spec.NamespaceSelector, namespace)
+}
+
+// NamespaceMatches returns true if the labels of the namespace match the
+// selector. A nil selector matches all namespaces.
+func NamespaceMatches(
+	ctx context.Context, cli client.Client, sel *metav1.LabelSelector, namespace string,
+) (bool, error) {
+	if sel == nil {
+		return true, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(sel)
+	if err != nil {
+		return false, fmt.Errorf("parsing namespace selector: %w", err)
+	}
+
+	ns := &corev1.Namespace{}
+	if err := cli.Get(ctx, types.NamespacedName{Name: namespace}, ns); err != nil {
+		if kerrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("getting namespace %s: %w", namespace, err)
+	}
+
+	return selector.Matches(labels.Set(ns.GetLabels())), nil
+}
+
+// NamespaceScopePredicate filters the events of objects whose namespace is
+// not in the scope of the operator. Deletions always pass, to not block the
+// removal of finalizers when a namespace leaves the scope.
+func NamespaceScopePredicate(ctx context.Context, cli client.Client) predicate.Predicate {
+	inScope := func(obj client.Object) bool {
+		if obj.GetDeletionTimestamp() != nil {
+			return true
+		}
+		ok, err := InNamespaceScope(ctx, cli, obj.GetNamespace())
+		// Let the reconciler retry on errors
+		return err != nil || ok
+	}
+
+	return predicate.Funcs{
+		CreateFunc:  func(e event.CreateEvent) bool { return inScope(e.Object) },
+		DeleteFunc:  func(event.DeleteEvent) bool { return true },
+		UpdateFunc:  func(e event.UpdateEvent) bool { return inScope(e.ObjectNew) },
+		GenericFunc: func(e event.GenericEvent) bool { return inScope(e.Object) },
+	}
+}
+
+// WatchNamespaceScope restricts the controller to the objects in the scope of
+// the operator. Objects get reconciled when their namespace enters the scope,
+// because the namespace scoped cache starts watching the namespace then.
+func WatchNamespaceScope(ctx context.Context, b *builder.Builder, cli client.Client) *builder.Builder {
+	return b.WithEventFilter(NamespaceScopePredicate(ctx, cli))
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+)
+
+//nolint:paralleltest // cannot set environment variables in parallel tests
+func TestInNamespaceScope(t *testing.T) {
+	const operatorNamespace = "security-profiles-operator"
+	t.Setenv(config.OperatorNamespaceEnvKey, operatorNamespace)
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, spodv1alpha1.AddToScheme(scheme))
+
+	namespace := func(name string, labels map[string]string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+	}
+	newSPOD := func(sel *metav1.LabelSelector) *spodv1alpha1.SecurityProfilesOperatorDaemon {
+		return &spodv1alpha1.SecurityProfilesOperatorDaemon{
+			ObjectMeta: metav1.ObjectMeta{Name: config.SPOdName, Namespace: operatorNamespace},
+			Spec:       spodv1alpha1.SPODSpec{NamespaceSelector: sel},
+		}
+	}
+	tenantSelector := &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "a"}}
+
+	for _, tc := range []struct {
+		name      string
+		objs      []client.Object
+		namespace string
+		want      bool
+	}{
+		{
+			name:      "no SPOD",
+			objs:      []client.Object{namespace("ns", nil)},
+			namespace: "ns",
+			want:      true,
+		},
+		{
+			name:      "no selector",
+			objs:      []client.Object{newSPOD(nil), namespace("ns", nil)},
+			namespace: "ns",
+			want:      true,
+		},
+		{
+			name:      "matching namespace",
+			objs:      []client.Object{newSPOD(tenantSelector), namespace("ns", map[string]string{"tenant": "a"})},
+			namespace: "ns",
+			want:      true,
+		},
+		{
+			name:      "not matching namespace",
+			objs:      []client.Object{newSPOD(tenantSelector), namespace("ns", map[string]string{"tenant": "b"})},
+			namespace: "ns",
+			want:      false,
+		},
+		{
+			name:      "missing namespace",
+			objs:      []client.Object{newSPOD(tenantSelector)},
+			namespace: "ns",
+			want:      false,
+		},
+		{
+			name:      "cluster scoped",
+			objs:      []client.Object{newSPOD(tenantSelector)},
+			namespace: "",
+			want:      true,
+		},
+		{
+			name:      "operator namespace",
+			objs:      []client.Object{newSPOD(tenantSelector), namespace(operatorNamespace, nil)},
+			namespace: operatorNamespace,
+			want:      true,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tc.objs...).Build()
+
+			inScope, err := InNamespaceScope(context.Background(), cli, tc.namespace)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, inScope)
+		})
+	}
+}
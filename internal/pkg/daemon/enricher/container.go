@@ -141,11 +141,12 @@ func (e *Enricher) populateCacheEntryForContainer(
 				recordProfile = pod.Annotations[config.SelinuxProfileRecordLogsAnnotationKey+containerName]
 			}
 			info := &types.ContainerInfo{
-				PodName:       pod.Name,
-				ContainerName: containerStatus.Name,
-				Namespace:     pod.Namespace,
-				ContainerID:   rawContainerID,
-				RecordProfile: recordProfile,
+				PodName:        pod.Name,
+				ContainerName:  containerStatus.Name,
+				Namespace:      pod.Namespace,
+				ContainerID:    rawContainerID,
+				RecordProfile:  recordProfile,
+				SeccompProfile: seccompProfileForContainer(pod, containerName),
 			}
 
 			// Update the cache
@@ -156,6 +157,35 @@ func (e *Enricher) populateCacheEntryForContainer(
 	})
 }
 
+// seccompProfileForContainer returns the localhost seccomp profile of the
+// provided container. A profile set on the container level takes precedence
+// over the pod level one.
+func seccompProfileForContainer(pod *v1.Pod, containerName string) string {
+	localhostProfile := func(sp *v1.SeccompProfile) string {
+		if sp == nil || sp.Type != v1.SeccompProfileTypeLocalhost || sp.LocalhostProfile == nil {
+			return ""
+		}
+		return *sp.LocalhostProfile
+	}
+
+	//nolint:gocritic // This is what we expect and want
+	containers := append(pod.Spec.InitContainers, pod.Spec.Containers...)
+	for i := range containers {
+		if containers[i].Name != containerName || containers[i].SecurityContext == nil {
+			continue
+		}
+		if profile := localhostProfile(containers[i].SecurityContext.SeccompProfile); profile != "" {
+			return profile
+		}
+	}
+
+	if pod.Spec.SecurityContext != nil {
+		return localhostProfile(pod.Spec.SecurityContext.SeccompProfile)
+	}
+
+	return ""
+}
+
 func (e *Enricher) handleContainerIDEmpty(podName, containerName string, containerStatus *v1.ContainerStatus) error {
 	if containerStatus.State.Waiting != nil &&
 		(containerStatus.State.Waiting.Reason == "ContainerCreating" ||
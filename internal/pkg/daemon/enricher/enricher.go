@@ -31,7 +31,9 @@ import (
 	"github.com/jellydator/ttlcache/v3"
 	"github.com/nxadm/tail"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
@@ -64,6 +66,9 @@ type Enricher struct {
 	infoCache        *ttlcache.Cache[string, *types.ContainerInfo]
 	syscalls         sync.Map
 	avcs             sync.Map
+	violations       sync.Map
+	lastViolations   sync.Map
+	usage            sync.Map
 	auditLineCache   *ttlcache.Cache[string, []*types.AuditLine]
 	clientset        kubernetes.Interface
 }
@@ -81,8 +86,11 @@ func New(logger logr.Logger) *Enricher {
 			ttlcache.WithTTL[string, *types.ContainerInfo](defaultCacheTimeout),
 			ttlcache.WithCapacity[string, *types.ContainerInfo](maxCacheItems),
 		),
-		syscalls: sync.Map{},
-		avcs:     sync.Map{},
+		syscalls:       sync.Map{},
+		avcs:           sync.Map{},
+		violations:     sync.Map{},
+		lastViolations: sync.Map{},
+		usage:          sync.Map{},
 		auditLineCache: ttlcache.New(
 			ttlcache.WithTTL[string, []*types.AuditLine](defaultCacheTimeout),
 			ttlcache.WithCapacity[string, []*types.AuditLine](maxCacheItems),
@@ -423,6 +431,24 @@ func (e *Enricher) dispatchSelinuxLine(
 				stringSet.Insert(string(jsonBytes))
 			}
 		}
+		return
+	}
+
+	if namespace, name, ok := selinuxProfileName(auditLine.Scontext, info.Namespace); ok {
+		for _, perm := range strings.Split(auditLine.Perm, " ") {
+			avc := &apienricher.AvcResponse_SelinuxAvc{
+				Perm:     perm,
+				Scontext: auditLine.Scontext,
+				Tcontext: auditLine.Tcontext,
+				Tclass:   auditLine.Tclass,
+			}
+			jsonBytes, err := protojson.Marshal(avc)
+			if err != nil {
+				e.logger.Error(err, "marshall protobuf")
+				continue
+			}
+			e.addViolation(types.AuditTypeSelinux, namespace, name, string(jsonBytes))
+		}
 	}
 }
 
@@ -477,6 +503,11 @@ func (e *Enricher) dispatchSeccompLine(
 		if ok {
 			stringSet.Insert(syscallName)
 		}
+		return
+	}
+
+	if namespace, name, ok := operatorSeccompProfile(info.SeccompProfile); ok {
+		e.addViolation(types.AuditTypeSeccomp, namespace, name, syscallName)
 	}
 }
 
@@ -505,6 +536,29 @@ func (e *Enricher) dispatchApparmorLine(
 	}
 
 	e.logger.Info("audit", values...)
+
+	// Profiles in complain mode log the operations they would deny as
+	// allowed, which are tracked as violations to refine the profile.
+	if auditLine.Apparmor != apparmorDenied && auditLine.Apparmor != apparmorAllowed {
+		return
+	}
+
+	denial := &apienricher.ViolationsResponse_ApparmorDenial{
+		Operation:  auditLine.Operation,
+		Name:       auditLine.Name,
+		DeniedMask: extraInfoValue(auditLine.ExtraInfo, "denied_mask"),
+		Complain:   auditLine.Apparmor == apparmorAllowed,
+	}
+	jsonBytes, err := protojson.Marshal(denial)
+	if err != nil {
+		e.logger.Error(err, "marshall protobuf")
+		return
+	}
+	// AppArmor profiles are loaded by name only, so the denial is tracked for
+	// the namespaced profile as well as a cluster scoped profile of that name.
+	name := apparmorProfileName(auditLine.Profile)
+	e.addViolation(types.AuditTypeApparmor, info.Namespace, name, string(jsonBytes))
+	e.addViolation(types.AuditTypeApparmor, "", name, string(jsonBytes))
 }
 
 // LogFilePath returns either the path to the audit logs or falls back to
@@ -516,3 +570,29 @@ func LogFilePath() string {
 	}
 	return filePath
 }
+
+// LastViolation returns the time of the most recent violation of the profile
+// recorded by the local log enricher, or the zero time if there was none.
+func LastViolation(ctx context.Context, kind, namespace, name string) (time.Time, error) {
+	conn, cancel, err := Dial()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("connecting to local GRPC server: %w", err)
+	}
+	defer cancel()
+
+	res, err := apienricher.NewEnricherClient(conn).Violations(ctx, &apienricher.ViolationsRequest{
+		Kind:      kind,
+		Namespace: namespace,
+		Name:      name,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return time.Time{}, nil
+		}
+		return time.Time{}, fmt.Errorf("retrieving violations: %w", err)
+	}
+	if res.GetLastViolation() == 0 {
+		return time.Time{}, nil
+	}
+	return time.Unix(res.GetLastViolation(), 0), nil
+}
@@ -28,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	api "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
 )
 
 const (
@@ -35,6 +36,9 @@ const (
 	ErrorNoSyscalls = "no syscalls recorded for profile"
 	// ErrorNoAvcs is returned when no AVCs are recorded for a profile.
 	ErrorNoAvcs = "no avcs recorded for profile"
+	// ErrorNoViolations is returned when no violations are recorded for an
+	// installed profile.
+	ErrorNoViolations = "no violations recorded for profile"
 )
 
 // Syscalls returns the syscalls for a provided profile.
@@ -99,3 +103,93 @@ func (e *Enricher) ResetAvcs(
 	e.avcs.Delete(r.GetProfile())
 	return &api.EmptyResponse{}, nil
 }
+
+// Violations returns the violations recorded for an installed profile.
+func (e *Enricher) Violations(
+	_ context.Context, r *api.ViolationsRequest,
+) (*api.ViolationsResponse, error) {
+	key := violationKey(r.GetKind(), r.GetNamespace(), r.GetName())
+	res := &api.ViolationsResponse{GoArch: runtime.GOARCH}
+
+	lastViolation, ok := e.lastViolations.Load(key)
+	if !ok {
+		st := status.New(codes.NotFound, ErrorNoViolations)
+		return nil, st.Err()
+	}
+	if res.LastViolation, ok = lastViolation.(int64); !ok {
+		return nil, errors.New("last violation is no timestamp")
+	}
+
+	violations, ok := e.violations.Load(key)
+	if !ok {
+		// The violations got reset since the last one occurred
+		return res, nil
+	}
+	stringSet, ok := violations.(sets.Set[string])
+	if !ok {
+		return nil, errors.New("violations are no string set")
+	}
+
+	for _, value := range stringSet.UnsortedList() {
+		if err := appendViolation(res, r.GetKind(), value); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+// ResetViolations removes the violations for an installed profile.
+func (e *Enricher) ResetViolations(
+	_ context.Context, r *api.ViolationsRequest,
+) (*api.EmptyResponse, error) {
+	e.violations.Delete(violationKey(r.GetKind(), r.GetNamespace(), r.GetName()))
+	return &api.EmptyResponse{}, nil
+}
+
+// Usage returns the operations observed for an installed profile since the
+// requested time, which are not affected by resetting the violations.
+func (e *Enricher) Usage(
+	_ context.Context, r *api.UsageRequest,
+) (*api.ViolationsResponse, error) {
+	key := violationKey(r.GetKind(), r.GetNamespace(), r.GetName())
+	res := &api.ViolationsResponse{GoArch: runtime.GOARCH}
+
+	if lastViolation, ok := e.lastViolations.Load(key); ok {
+		if res.LastViolation, ok = lastViolation.(int64); !ok {
+			return nil, errors.New("last violation is no timestamp")
+		}
+	}
+
+	for _, value := range e.usageSince(key, r.GetSince()) {
+		if err := appendViolation(res, r.GetKind(), value); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+// appendViolation decodes the recorded value of a violation depending on the
+// kind of the profile and adds it to the response.
+func appendViolation(res *api.ViolationsResponse, kind, value string) error {
+	switch kind {
+	case types.AuditTypeSeccomp:
+		res.Syscalls = append(res.Syscalls, value)
+	case types.AuditTypeSelinux:
+		avc := &api.AvcResponse_SelinuxAvc{}
+		if err := protojson.Unmarshal([]byte(value), avc); err != nil {
+			return fmt.Errorf("unmarshall JSON: %w", err)
+		}
+		res.Avc = append(res.Avc, avc)
+	case types.AuditTypeApparmor:
+		denial := &api.ViolationsResponse_ApparmorDenial{}
+		if err := protojson.Unmarshal([]byte(value), denial); err != nil {
+			return fmt.Errorf("unmarshall JSON: %w", err)
+		}
+		res.Apparmor = append(res.Apparmor, denial)
+	default:
+		return fmt.Errorf("unknown violation kind %s", kind)
+	}
+	return nil
+}
@@ -57,4 +57,7 @@ type ContainerInfo struct {
 	Namespace     string
 	ContainerID   string
 	RecordProfile string
+	// SeccompProfile is the localhost seccomp profile the container runs
+	// with, for example "operator/default/profile.json".
+	SeccompProfile string
 }
@@ -0,0 +1,174 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enricher
+
+import (
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+)
+
+const (
+	apparmorDenied  = "DENIED"
+	apparmorAllowed = "ALLOWED"
+
+	seContextRequiredParts = 3
+	selinuxProcessSuffix   = ".process"
+	seccompProfileParts    = 3
+	clusterSeccompParts    = 2
+	seccompProfileExt      = ".json"
+
+	// usageRetention is the time for which the operations observed for an
+	// installed profile are kept for the impact analysis of profile updates.
+	usageRetention = 7 * 24 * time.Hour
+)
+
+// violationKey returns the key under which the violations of an installed
+// profile are tracked.
+func violationKey(kind, namespace, name string) string {
+	return kind + "/" + namespace + "/" + name
+}
+
+// addViolation records a violation of an installed profile. The value is
+// either a syscall name or a JSON serialized protobuf message, depending on
+// the kind of the profile.
+func (e *Enricher) addViolation(kind, namespace, name, value string) {
+	key := violationKey(kind, namespace, name)
+	v, _ := e.violations.LoadOrStore(key, sets.New[string]())
+	stringSet, ok := v.(sets.Set[string])
+	if ok {
+		stringSet.Insert(value)
+	}
+	now := time.Now()
+	e.lastViolations.Store(key, now.Unix())
+	e.addUsage(key, value, now)
+}
+
+// usageSet contains the unix timestamps when the values were last observed
+// for an installed profile. Other than the violations, it does not get reset
+// but expires after the usageRetention.
+type usageSet struct {
+	sync.Mutex
+	lastSeen map[string]int64
+}
+
+// addUsage records the observation of a value and removes the expired ones.
+func (e *Enricher) addUsage(key, value string, now time.Time) {
+	v, _ := e.usage.LoadOrStore(key, &usageSet{lastSeen: map[string]int64{}})
+	set, ok := v.(*usageSet)
+	if !ok {
+		return
+	}
+
+	set.Lock()
+	defer set.Unlock()
+	set.lastSeen[value] = now.Unix()
+	expired := now.Add(-usageRetention).Unix()
+	for value, lastSeen := range set.lastSeen {
+		if lastSeen < expired {
+			delete(set.lastSeen, value)
+		}
+	}
+}
+
+// usageSince returns the values observed for an installed profile since the
+// provided unix timestamp.
+func (e *Enricher) usageSince(key string, since int64) []string {
+	v, ok := e.usage.Load(key)
+	if !ok {
+		return nil
+	}
+	set, ok := v.(*usageSet)
+	if !ok {
+		return nil
+	}
+
+	set.Lock()
+	defer set.Unlock()
+	values := []string{}
+	for value, lastSeen := range set.lastSeen {
+		if lastSeen >= since {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// operatorSeccompProfile returns the namespace and name of a seccomp profile
+// managed by the operator from its localhost profile path, which has the
+// format "operator/<namespace>/<name>.json". Cluster scoped profiles have the
+// format "operator/<name>.json" and an empty namespace.
+func operatorSeccompProfile(localhostProfile string) (namespace, name string, ok bool) {
+	parts := strings.Split(filepath.Clean(localhostProfile), string(filepath.Separator))
+	if parts[0] != config.OperatorProfilesFolder {
+		return "", "", false
+	}
+	switch len(parts) {
+	case seccompProfileParts:
+		return parts[1], strings.TrimSuffix(parts[2], seccompProfileExt), true
+	case clusterSeccompParts:
+		return "", strings.TrimSuffix(parts[1], seccompProfileExt), true
+	default:
+		return "", "", false
+	}
+}
+
+// selinuxProfileName returns the namespace and name of the SelinuxProfile in
+// the provided namespace which corresponds to the SELinux source context, for
+// example "system_u:system_r:profile_namespace.process:s0". The namespace is
+// empty for ClusterSelinuxProfiles, which use contexts like
+// "system_u:system_r:profile_.process:s0".
+func selinuxProfileName(scontext, namespace string) (profileNamespace, name string, ok bool) {
+	elems := strings.Split(scontext, ":")
+	if len(elems) < seContextRequiredParts {
+		return "", "", false
+	}
+
+	for _, ns := range []string{namespace, ""} {
+		suffix := "_" + ns + selinuxProcessSuffix
+		if !strings.HasSuffix(elems[2], suffix) {
+			continue
+		}
+		name := strings.TrimSuffix(elems[2], suffix)
+		return ns, name, name != ""
+	}
+	return "", "", false
+}
+
+// apparmorProfileName strips the hat from an AppArmor profile name.
+func apparmorProfileName(profile string) string {
+	name, _, _ := strings.Cut(profile, "//")
+	return name
+}
+
+// extraInfoValue returns the value of the key from the extra information of
+// an audit line, for example "x" for key "denied_mask" in
+// "requested_mask='x' denied_mask='x' fsuid=65534 ouid=0".
+func extraInfoValue(extraInfo, key string) string {
+	for _, field := range strings.Fields(extraInfo) {
+		k, v, found := strings.Cut(field, "=")
+		if found && k == key {
+			return strings.Trim(v, "'")
+		}
+	}
+	return ""
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enricher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+
+	api "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
+)
+
+func Test_operatorSeccompProfile(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		profile, namespace, name string
+		ok                       bool
+	}{
+		{"operator/default/profile.json", "default", "profile", true},
+		{"operator/default/profile", "default", "profile", true},
+		{"operator/profile.json", "", "profile", true},
+		{"operator/default/profile/other.json", "", "", false},
+		{"other/default/profile.json", "", "", false},
+		{"", "", "", false},
+	} {
+		namespace, name, ok := operatorSeccompProfile(tc.profile)
+		require.Equal(t, tc.ok, ok, tc.profile)
+		require.Equal(t, tc.namespace, namespace, tc.profile)
+		require.Equal(t, tc.name, name, tc.profile)
+	}
+}
+
+func Test_selinuxProfileName(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		scontext, namespace, name string
+		ok                        bool
+	}{
+		{"system_u:system_r:profile_default.process:s0:c1,c2", "default", "profile", true},
+		{"system_u:system_r:profile_.process:s0", "", "profile", true},
+		{"system_u:system_r:profile_other.process:s0", "", "", false},
+		{"system_u:system_r:container_t:s0", "", "", false},
+		{"system_u:system_r:_default.process:s0", "default", "", false},
+		{"invalid", "", "", false},
+	} {
+		namespace, name, ok := selinuxProfileName(tc.scontext, "default")
+		require.Equal(t, tc.ok, ok, tc.scontext)
+		require.Equal(t, tc.namespace, namespace, tc.scontext)
+		require.Equal(t, tc.name, name, tc.scontext)
+	}
+}
+
+func Test_extraInfoValue(t *testing.T) {
+	t.Parallel()
+	extraInfo := "requested_mask='rw' denied_mask='w' fsuid=65534 ouid=0"
+	require.Equal(t, "w", extraInfoValue(extraInfo, "denied_mask"))
+	require.Equal(t, "65534", extraInfoValue(extraInfo, "fsuid"))
+	require.Empty(t, extraInfoValue(extraInfo, "target"))
+	require.Equal(t, "profile", apparmorProfileName("profile//hat"))
+}
+
+func TestUsage(t *testing.T) {
+	t.Parallel()
+
+	sut := &Enricher{}
+	key := violationKey(types.AuditTypeSeccomp, "default", "profile")
+	now := time.Now()
+	sut.addUsage(key, "expired", now.Add(-usageRetention-time.Hour))
+	sut.addUsage(key, "old", now.Add(-2*time.Hour))
+	sut.addViolation(types.AuditTypeSeccomp, "default", "profile", "read")
+
+	// usage is kept when the violations get reset
+	_, err := sut.ResetViolations(context.Background(), &api.ViolationsRequest{
+		Kind: types.AuditTypeSeccomp, Namespace: "default", Name: "profile",
+	})
+	require.NoError(t, err)
+
+	res, err := sut.Usage(context.Background(), &api.UsageRequest{
+		Kind: types.AuditTypeSeccomp, Namespace: "default", Name: "profile",
+		Since: now.Add(-time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"read"}, res.GetSyscalls())
+
+	res, err = sut.Usage(context.Background(), &api.UsageRequest{
+		Kind: types.AuditTypeSeccomp, Namespace: "default", Name: "profile",
+	})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"read", "old"}, res.GetSyscalls())
+
+	res, err = sut.Usage(context.Background(), &api.UsageRequest{
+		Kind: types.AuditTypeSeccomp, Namespace: "default", Name: "other",
+	})
+	require.NoError(t, err)
+	require.Empty(t, res.GetSyscalls())
+}
+
+func TestApparmorComplainViolations(t *testing.T) {
+	t.Parallel()
+
+	sut := New(logr.Discard())
+	info := &types.ContainerInfo{Namespace: "default"}
+	for _, apparmor := range []string{"ALLOWED", "DENIED", "AUDIT"} {
+		sut.dispatchApparmorLine("node", &types.AuditLine{
+			AuditType: types.AuditTypeApparmor,
+			Apparmor:  apparmor,
+			Operation: "open",
+			Profile:   "profile",
+			Name:      "/etc/" + apparmor,
+			ExtraInfo: "requested_mask='r' denied_mask='r' fsuid=0 ouid=0",
+		}, info)
+	}
+
+	res, err := sut.Violations(context.Background(), &api.ViolationsRequest{
+		Kind: types.AuditTypeApparmor, Namespace: "default", Name: "profile",
+	})
+	require.NoError(t, err)
+	require.Len(t, res.GetApparmor(), 2)
+	complain := map[string]bool{}
+	for _, denial := range res.GetApparmor() {
+		complain[denial.GetName()] = denial.GetComplain()
+	}
+	require.Equal(t, map[string]bool{"/etc/ALLOWED": true, "/etc/DENIED": false}, complain)
+}
@@ -129,6 +129,15 @@ func (m *Metrics) BpfInc(stream api.Metrics_BpfIncServer) error {
 			return fmt.Errorf("record bpf metrics: %w", err)
 		}
 
+		if r.GetMapOverflowReq() != nil {
+			m.AddBpfMapOverflow(
+				r.GetNode(),
+				r.GetMapOverflowReq().GetMap(),
+				r.GetMapOverflowReq().GetCount(),
+			)
+			continue
+		}
+
 		m.IncSeccompProfileBpf(
 			r.GetNode(),
 			r.GetProfile(),
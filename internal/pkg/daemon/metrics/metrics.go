@@ -43,6 +43,8 @@ const (
 	metricNameSelinuxProfileAudit  = "selinux_profile_audit_total"
 	metricNameAppArmorProfileAudit = "apparmor_profile_audit_total"
 	metricNameSeccompProfileBpf    = "seccomp_profile_bpf_total"
+	metricNameSeccompNotify        = "seccomp_notify_total"
+	metricNameBpfMapOverflow       = "bpf_map_overflow_total"
 	metricNameSeccompProfileError  = "seccomp_profile_error_total"
 	metricNameSelinuxProfileError  = "selinux_profile_error_total"
 	metricNameAppArmorProfileError = "apparmor_profile_error_total"
@@ -64,6 +66,8 @@ const (
 	metricsLabelScontext       = "scontext"
 	metricsLabelTcontext       = "tcontext"
 	metricsLabelMountNamespace = "mount_namespace"
+	metricsLabelMap            = "map"
+	metricsLabelAction         = "action"
 
 	// HandlerPath is the default path for serving metrics.
 	HandlerPath = "/metrics-spod"
@@ -77,6 +81,8 @@ type Metrics struct {
 	metricSeccompProfile       *prometheus.CounterVec
 	metricSeccompProfileAudit  *prometheus.CounterVec
 	metricSeccompProfileBpf    *prometheus.CounterVec
+	metricSeccompNotify        *prometheus.CounterVec
+	metricBpfMapOverflow       *prometheus.CounterVec
 	metricSeccompProfileError  *prometheus.CounterVec
 	metricSelinuxProfile       *prometheus.CounterVec
 	metricSelinuxProfileAudit  *prometheus.CounterVec
@@ -126,6 +132,31 @@ func New() *Metrics {
 				metricsLabelProfile,
 			},
 		),
+		metricSeccompNotify: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:      metricNameSeccompNotify,
+				Namespace: metricNamespace,
+				Help:      "Counter about seccomp notifications, requires the seccomp notifier to be enabled.",
+			},
+			[]string{
+				metricsLabelNode,
+				metricsLabelNamespace,
+				metricsLabelContainer,
+				metricsLabelSyscall,
+				metricsLabelAction,
+			},
+		),
+		metricBpfMapOverflow: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:      metricNameBpfMapOverflow,
+				Namespace: metricNamespace,
+				Help:      "Counter about failed bpf map inserts because the map is full, requires the bpf recorder to be enabled.",
+			},
+			[]string{
+				metricsLabelNode,
+				metricsLabelMap,
+			},
+		),
 		metricSeccompProfileError: prometheus.NewCounterVec(
 			prometheus.CounterOpts{
 				Name:      metricNameSeccompProfileError,
@@ -206,6 +237,8 @@ func (m *Metrics) Register() error {
 		metricNameSeccompProfile:       m.metricSeccompProfile,
 		metricNameSeccompProfileAudit:  m.metricSeccompProfileAudit,
 		metricNameSeccompProfileBpf:    m.metricSeccompProfileBpf,
+		metricNameSeccompNotify:        m.metricSeccompNotify,
+		metricNameBpfMapOverflow:       m.metricBpfMapOverflow,
 		metricNameSeccompProfileError:  m.metricSeccompProfileError,
 		metricNameSelinuxProfile:       m.metricSelinuxProfile,
 		metricNameSelinuxProfileAudit:  m.metricSelinuxProfileAudit,
@@ -261,6 +294,22 @@ func (m *Metrics) IncSeccompProfileBpf(
 	).Inc()
 }
 
+// IncSeccompNotify increments the seccomp notification counter for the
+// provided labels.
+func (m *Metrics) IncSeccompNotify(
+	node, namespace, container, syscall, action string,
+) {
+	m.metricSeccompNotify.WithLabelValues(
+		node, namespace, container, syscall, action,
+	).Inc()
+}
+
+// AddBpfMapOverflow adds the number of failed inserts into the provided bpf
+// map to the bpf map overflow counter.
+func (m *Metrics) AddBpfMapOverflow(node, bpfMap string, count uint64) {
+	m.metricBpfMapOverflow.WithLabelValues(node, bpfMap).Add(float64(count))
+}
+
 // IncSeccompProfileError increments the seccomp profile error counter for the
 // provided reason.
 func (m *Metrics) IncSeccompProfileError(reason string) {
@@ -221,3 +221,66 @@ func TestSeccompProfileBpf(t *testing.T) {
 		tc.then(sut)
 	}
 }
+
+func TestBpfMapOverflow(t *testing.T) {
+	t.Parallel()
+
+	const (
+		node   = "node"
+		bpfMap = "pid_mntns"
+	)
+
+	getMetricValue := func(col prometheus.Collector) int {
+		c := make(chan prometheus.Metric, 1)
+		col.Collect(c)
+		m := dto.Metric{}
+		err := (<-c).Write(&m)
+		require.Nil(t, err)
+		return int(*m.Counter.Value)
+	}
+
+	sut := New()
+	sut.impl = &metricsfakes.FakeImpl{}
+
+	sut.AddBpfMapOverflow(node, bpfMap, 2)
+	sut.AddBpfMapOverflow(node, bpfMap, 3)
+
+	ctr, err := sut.metricBpfMapOverflow.GetMetricWithLabelValues(node, bpfMap)
+	require.Nil(t, err)
+	require.Equal(t, 5, getMetricValue(ctr))
+}
+
+func TestSeccompNotify(t *testing.T) {
+	t.Parallel()
+
+	const (
+		node      = "node"
+		namespace = "namespace"
+		container = "container"
+		syscall   = "mkdir"
+	)
+
+	getMetricValue := func(col prometheus.Collector) int {
+		c := make(chan prometheus.Metric, 1)
+		col.Collect(c)
+		m := dto.Metric{}
+		err := (<-c).Write(&m)
+		require.Nil(t, err)
+		return int(*m.Counter.Value)
+	}
+
+	sut := New()
+	sut.impl = &metricsfakes.FakeImpl{}
+
+	sut.IncSeccompNotify(node, namespace, container, syscall, "allow")
+	sut.IncSeccompNotify(node, namespace, container, syscall, "allow")
+	sut.IncSeccompNotify(node, namespace, container, syscall, "deny")
+
+	ctr, err := sut.metricSeccompNotify.GetMetricWithLabelValues(node, namespace, container, syscall, "allow")
+	require.Nil(t, err)
+	require.Equal(t, 2, getMetricValue(ctr))
+
+	ctr, err = sut.metricSeccompNotify.GetMetricWithLabelValues(node, namespace, container, syscall, "deny")
+	require.Nil(t, err)
+	require.Equal(t, 1, getMetricValue(ctr))
+}
@@ -0,0 +1,582 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profilepatcher
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/containers/common/pkg/seccomp"
+	"github.com/go-logr/logr"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	bpfrecorderapi "sigs.k8s.io/security-profiles-operator/api/grpc/bpfrecorder"
+	enricherapi "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
+	profilebaseapi "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	profilebindingapi "sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	statusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
+	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/nodestatus"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/translator"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+const (
+	// defaultImpactWindow is the default duration for which the usage of
+	// removed operations gets checked.
+	defaultImpactWindow = 24 * time.Hour
+
+	// maxImpactWindow is the maximum duration for which the log enricher
+	// keeps the observed operations.
+	maxImpactWindow = 7 * 24 * time.Hour
+
+	// proposedDeletion is the proposed spec which analyzes the deletion of
+	// the profile.
+	proposedDeletion = "null"
+
+	// maxBaseProfileLevel is the maximum depth of resolved base profiles.
+	maxBaseProfileLevel = 15
+
+	// clusterProfileBindingKind prefixes the names of the cluster profile
+	// bindings in the impact report.
+	clusterProfileBindingKind = "ClusterProfileBinding"
+
+	reasonCannotAnalyzeImpact string = "CannotAnalyzeImpact"
+)
+
+// errUnsupportedBaseProfile is returned for base profiles which can not be
+// resolved by the impact analysis, like the ones pulled from OCI registries.
+var errUnsupportedBaseProfile = errors.New("unsupported base profile")
+
+// ImpactReport is the result of analyzing a proposed profile spec.
+type ImpactReport struct {
+	// ProposedSpec is the SHA256 digest of the analyzed proposed spec.
+	ProposedSpec string `json:"proposedSpec"`
+	// Window is the duration for which the usage has been checked.
+	Window string `json:"window"`
+	// Removed are the operations allowed by the current but not by the
+	// proposed spec.
+	Removed []string `json:"removed,omitempty"`
+	// Added are the operations allowed by the proposed but not by the
+	// current spec.
+	Added []string `json:"added,omitempty"`
+	// RecentlyUsed are the removed operations which have been observed
+	// within the window, or recorded by the bpf recorder.
+	RecentlyUsed []string `json:"recentlyUsed,omitempty"`
+	// Recorded are the removed operations which the bpf recorder recorded
+	// in the running containers using the profile. They cross-check the
+	// operations observed in the audit log, which only contains allowed
+	// operations if they get logged.
+	Recorded []string `json:"recorded,omitempty"`
+	// Nodes are the nodes whose observed operations are part of the report.
+	Nodes []string `json:"nodes,omitempty"`
+	// Workloads are the workloads currently using the profile.
+	Workloads []profilebaseapi.WorkloadReference `json:"workloads,omitempty"`
+	// ProfileBindings are the profile bindings and cluster profile bindings
+	// referencing the profile.
+	ProfileBindings []string `json:"profileBindings,omitempty"`
+}
+
+// impactHandler is implemented by the patch handlers of the profile kinds
+// which support the impact analysis of proposed specs.
+type impactHandler interface {
+	// bindingKind returns the profile binding kind referencing the profile.
+	bindingKind(profilebaseapi.SecurityProfileBase) profilebindingapi.ProfileBindingKind
+	// proposed returns a copy of the profile with the proposed spec.
+	proposed(profilebaseapi.SecurityProfileBase, []byte) (profilebaseapi.SecurityProfileBase, error)
+	// allowed returns the operations allowed by the profile, including the
+	// ones inherited from its base profiles.
+	allowed(context.Context, getFunc, profilebaseapi.SecurityProfileBase) ([]string, error)
+	// observed returns the operations contained in the enricher response.
+	observed(profilebaseapi.SecurityProfileBase, *enricherapi.ViolationsResponse, logr.Logger) ([]string, error)
+	// localhostProfile returns the localhost profile under which the bpf
+	// recorder finds the containers using the profile, or an empty string
+	// if the bpf recorder does not record the operations of the kind.
+	localhostProfile(profilebaseapi.SecurityProfileBase) (string, error)
+}
+
+// getFunc retrieves an object from the cluster.
+type getFunc func(context.Context, client.ObjectKey, client.Object) error
+
+// impactObservers are the daemon features observing the operations used
+// by the workloads.
+type impactObservers struct {
+	logEnricher bool
+	bpfRecorder bool
+}
+
+// ImpactWindow returns the duration for which the usage of removed
+// operations should be checked.
+func ImpactWindow(obj profilebaseapi.SecurityProfileBase) (time.Duration, error) {
+	value, ok := obj.GetAnnotations()[profilebaseapi.ImpactWindowAnnotation]
+	if !ok || value == "" {
+		return defaultImpactWindow, nil
+	}
+
+	window, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("parsing impact window: %w", err)
+	}
+	if window <= 0 || window > maxImpactWindow {
+		return 0, fmt.Errorf("impact window %s must be between 0 and %s", window, maxImpactWindow)
+	}
+	return window, nil
+}
+
+// ProposedSpecDigest returns the SHA256 digest of the proposed spec of the
+// profile, which identifies the impact reports analyzing it.
+func ProposedSpecDigest(obj profilebaseapi.SecurityProfileBase) string {
+	digest := sha256.Sum256([]byte(obj.GetAnnotations()[profilebaseapi.ProposedSpecAnnotation]))
+	return hex.EncodeToString(digest[:])
+}
+
+// analyzeImpact updates the impact report of the proposed spec with the
+// operations observed on the local node. The report is stored in the node
+// status of the profile, from where the manager merges the reports of all
+// nodes into the profile annotation.
+func (r *PatchReconciler) analyzeImpact(
+	ctx context.Context,
+	logger logr.Logger,
+	handler impactHandler,
+	profile profilebaseapi.SecurityProfileBase,
+	observers impactObservers,
+) error {
+	nodeStatus := &statusv1alpha1.SecurityProfileNodeStatus{}
+	key := nodestatus.NamespacedName(profile, os.Getenv(config.NodeNameEnvKey))
+	if err := r.ClientGet(ctx, r.client, key, nodeStatus); err != nil {
+		if kerrors.IsNotFound(err) {
+			// The profile is not installed on this node
+			return nil
+		}
+		return fmt.Errorf("getting node status: %w", err)
+	}
+
+	proposedSpec := profile.GetAnnotations()[profilebaseapi.ProposedSpecAnnotation]
+
+	window, err := ImpactWindow(profile)
+	if err != nil {
+		r.record.Event(profile, util.EventTypeWarning, reasonCannotAnalyzeImpact, err.Error())
+		return nil
+	}
+
+	report := &ImpactReport{
+		ProposedSpec: ProposedSpecDigest(profile),
+		Window:       window.String(),
+	}
+
+	get := func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+		return r.ClientGet(ctx, r.client, key, obj)
+	}
+
+	allowed, err := handler.allowed(ctx, get, profile)
+	if errors.Is(err, errUnsupportedBaseProfile) {
+		r.record.Event(profile, util.EventTypeWarning, reasonCannotAnalyzeImpact, err.Error())
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	current := sets.New(allowed...)
+	proposed := sets.New[string]()
+	if proposedSpec != proposedDeletion {
+		proposedProfile, err := handler.proposed(profile, []byte(proposedSpec))
+		if err != nil {
+			r.record.Event(profile, util.EventTypeWarning, reasonCannotAnalyzeImpact, err.Error())
+			return nil
+		}
+		allowed, err := handler.allowed(ctx, get, proposedProfile)
+		if errors.Is(err, errUnsupportedBaseProfile) {
+			r.record.Event(profile, util.EventTypeWarning, reasonCannotAnalyzeImpact, err.Error())
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		proposed.Insert(allowed...)
+	}
+	removed := current.Difference(proposed)
+	report.Removed = sets.List(removed)
+	report.Added = sets.List(proposed.Difference(current))
+
+	if wsu, ok := profile.(profilebaseapi.WorkloadsStatusUser); ok {
+		report.Workloads = wsu.GetWorkloadsStatus().Workloads
+	}
+
+	report.ProfileBindings, err = r.profileBindings(ctx, handler.bindingKind(profile), profile)
+	if err != nil {
+		return err
+	}
+
+	observed := false
+	used := sets.New[string]()
+	if observers.logEnricher {
+		enricherUsed, err := r.recentlyUsed(ctx, logger, handler, profile, window)
+		if err != nil {
+			return err
+		}
+		used.Insert(enricherUsed...)
+		observed = true
+	}
+	if observers.bpfRecorder {
+		recorded, ok, err := r.recorded(ctx, logger, handler, profile)
+		if err != nil {
+			return err
+		}
+		if ok {
+			report.Recorded = sets.List(removed.Intersection(sets.New(recorded...)))
+			used.Insert(recorded...)
+			observed = true
+		}
+	}
+	if observed {
+		report.RecentlyUsed = sets.List(removed.Intersection(used))
+		report.Nodes = []string{os.Getenv(config.NodeNameEnvKey)}
+	}
+
+	data, err := json.Marshal(report)
+	if err != nil {
+		return fmt.Errorf("marshal impact report: %w", err)
+	}
+
+	if nodeStatus.ImpactReport == string(data) {
+		return nil
+	}
+	nodeStatus.ImpactReport = string(data)
+	if err := r.ClientUpdate(ctx, r.client, nodeStatus); err != nil {
+		return fmt.Errorf("updating impact report of node: %w", err)
+	}
+
+	logger.Info("Updated impact report of node for proposed spec")
+	return nil
+}
+
+// profileBindings returns the names of the profile bindings and cluster
+// profile bindings referencing the profile. Cluster profile bindings are
+// prefixed with their kind.
+func (r *PatchReconciler) profileBindings(
+	ctx context.Context, kind profilebindingapi.ProfileBindingKind, profile profilebaseapi.SecurityProfileBase,
+) ([]string, error) {
+	var res []string
+
+	bindings := &profilebindingapi.ProfileBindingList{}
+	if err := r.ClientList(ctx, r.client, bindings, client.InNamespace(profile.GetNamespace())); err != nil {
+		return nil, fmt.Errorf("listing profile bindings: %w", err)
+	}
+	for i := range bindings.Items {
+		ref := bindings.Items[i].Spec.ProfileRef
+		if ref.Kind == kind && ref.Name == profile.GetName() {
+			res = append(res, bindings.Items[i].GetName())
+		}
+	}
+
+	clusterBindings := &profilebindingapi.ClusterProfileBindingList{}
+	if err := r.ClientList(ctx, r.client, clusterBindings); err != nil {
+		return nil, fmt.Errorf("listing cluster profile bindings: %w", err)
+	}
+	for i := range clusterBindings.Items {
+		ref := clusterBindings.Items[i].Spec.ProfileRef
+		if ref.Kind == kind && ref.Name == profile.GetName() {
+			res = append(res, clusterProfileBindingKind+"/"+clusterBindings.Items[i].GetName())
+		}
+	}
+	return res, nil
+}
+
+// recentlyUsed returns the operations observed by the log enricher of the
+// local node within the window.
+func (r *PatchReconciler) recentlyUsed(
+	ctx context.Context,
+	logger logr.Logger,
+	handler impactHandler,
+	profile profilebaseapi.SecurityProfileBase,
+	window time.Duration,
+) ([]string, error) {
+	name, err := r.handler.violationsName(profile)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, cancel, err := r.DialEnricher()
+	if err != nil {
+		return nil, fmt.Errorf("connecting to local GRPC server: %w", err)
+	}
+	defer cancel()
+
+	response, err := r.Usage(ctx, enricherapi.NewEnricherClient(conn), &enricherapi.UsageRequest{
+		Kind:      r.handler.auditType(),
+		Namespace: profile.GetNamespace(),
+		Name:      name,
+		Since:     time.Now().Add(-window).Unix(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("retrieving usage: %w", err)
+	}
+
+	used, err := handler.observed(profile, response, logger)
+	if err != nil {
+		return nil, fmt.Errorf("decoding usage: %w", err)
+	}
+	return used, nil
+}
+
+// recorded returns the operations which the bpf recorder of the local node
+// recorded for the running containers using the profile. The bpf recorder
+// only runs while profiles get recorded, so its data is optional and ok is
+// false if none is available.
+func (r *PatchReconciler) recorded(
+	ctx context.Context,
+	logger logr.Logger,
+	handler impactHandler,
+	profile profilebaseapi.SecurityProfileBase,
+) (syscalls []string, ok bool, err error) {
+	localhostProfile, err := handler.localhostProfile(profile)
+	if err != nil || localhostProfile == "" {
+		return nil, false, err
+	}
+
+	conn, cancel, err := r.DialBpfRecorder()
+	if err != nil {
+		logger.V(config.VerboseLevel).Info("Unable to connect to bpf recorder", "err", err.Error())
+		return nil, false, nil
+	}
+	defer cancel()
+
+	response, err := r.SyscallsForLocalhostProfile(ctx, bpfrecorderapi.NewBpfRecorderClient(conn), &bpfrecorderapi.ProfileRequest{
+		Name: localhostProfile,
+	})
+	if err != nil {
+		logger.V(config.VerboseLevel).Info("No syscalls recorded by bpf recorder", "err", err.Error())
+		return nil, false, nil
+	}
+	return response.GetSyscalls(), true, nil
+}
+
+// ParseImpactReport decodes a JSON encoded impact report. An empty report
+// gets decoded to nil.
+func ParseImpactReport(data string) (*ImpactReport, error) {
+	if data == "" {
+		return nil, nil
+	}
+
+	report := &ImpactReport{}
+	if err := json.Unmarshal([]byte(data), report); err != nil {
+		return nil, fmt.Errorf("unmarshal impact report: %w", err)
+	}
+	return report, nil
+}
+
+// MergeImpactReports adds the observations of the other report to the
+// existing one, as long as both analyze the same proposed spec and window.
+// Otherwise the other report replaces the existing one.
+func MergeImpactReports(existing, other *ImpactReport) *ImpactReport {
+	if existing == nil ||
+		existing.ProposedSpec != other.ProposedSpec ||
+		existing.Window != other.Window {
+		return other
+	}
+
+	merged := *other
+	merged.RecentlyUsed = sets.List(sets.New(existing.RecentlyUsed...).Insert(other.RecentlyUsed...))
+	merged.Recorded = sets.List(sets.New(existing.Recorded...).Insert(other.Recorded...))
+	merged.Nodes = sets.List(sets.New(existing.Nodes...).Insert(other.Nodes...))
+	return &merged
+}
+
+func (seccompHandler) bindingKind(obj profilebaseapi.SecurityProfileBase) profilebindingapi.ProfileBindingKind {
+	if obj.GetNamespace() == "" {
+		return profilebindingapi.ProfileBindingKindClusterSeccompProfile
+	}
+	return profilebindingapi.ProfileBindingKindSeccompProfile
+}
+
+func (seccompHandler) proposed(
+	obj profilebaseapi.SecurityProfileBase, spec []byte,
+) (profilebaseapi.SecurityProfileBase, error) {
+	sp, err := seccompProfileOf(obj)
+	if err != nil {
+		return nil, err
+	}
+	proposed := sp.DeepCopy()
+	proposed.Spec = seccompprofileapi.SeccompProfileSpec{}
+	if err := json.Unmarshal(spec, &proposed.Spec); err != nil {
+		return nil, fmt.Errorf("unmarshal proposed spec: %w", err)
+	}
+	return proposed, nil
+}
+
+// allowed returns the syscalls which are allowed or logged, as well as the
+// default action of the profile. The syscalls of the base profiles get
+// merged the same way the seccomp profile controller does.
+func (seccompHandler) allowed(
+	ctx context.Context, get getFunc, obj profilebaseapi.SecurityProfileBase,
+) ([]string, error) {
+	sp, err := seccompProfileOf(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	syscalls, err := resolveSeccompSyscalls(ctx, get, sp, sp.Spec.Syscalls, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []string{"defaultAction " + string(sp.Spec.DefaultAction)}
+	for _, s := range syscalls {
+		if s.Action == seccomp.ActAllow || s.Action == seccomp.ActLog {
+			res = append(res, s.Names...)
+		}
+	}
+	return res, nil
+}
+
+// resolveSeccompSyscalls recursively merges the syscalls of the base
+// profiles into the input syscalls.
+func resolveSeccompSyscalls(
+	ctx context.Context,
+	get getFunc,
+	sp seccompprofileapi.SeccompProfileObject,
+	inputSyscalls []*seccompprofileapi.Syscall,
+	level uint8,
+) ([]*seccompprofileapi.Syscall, error) {
+	if level >= maxBaseProfileLevel {
+		return nil, fmt.Errorf(
+			"max recursion level of %d is reached for resolving base profiles",
+			maxBaseProfileLevel,
+		)
+	}
+
+	baseProfileName := sp.GetSpec().BaseProfileName
+	if baseProfileName == "" {
+		return inputSyscalls, nil
+	}
+	if strings.HasPrefix(baseProfileName, config.OCIProfilePrefix) {
+		return nil, fmt.Errorf("%w: %s", errUnsupportedBaseProfile, baseProfileName)
+	}
+
+	var baseProfile seccompprofileapi.SeccompProfileObject
+	var key client.ObjectKey
+	if clusterProfileName, ok := strings.CutPrefix(
+		baseProfileName, seccompprofileapi.ClusterSeccompProfileKind+"/",
+	); ok {
+		baseProfile = &seccompprofileapi.ClusterSeccompProfile{}
+		key = util.NamespacedName(clusterProfileName, "")
+	} else {
+		baseProfile = &seccompprofileapi.SeccompProfile{}
+		key = util.NamespacedName(baseProfileName, sp.GetNamespace())
+	}
+	if err := get(ctx, key, baseProfile); err != nil {
+		return nil, fmt.Errorf("getting base profile %s: %w", baseProfileName, err)
+	}
+
+	syscalls, err := util.UnionSyscalls(baseProfile.GetSpec().Syscalls, inputSyscalls)
+	if err != nil {
+		return nil, fmt.Errorf("union syscalls: %w", err)
+	}
+	return resolveSeccompSyscalls(ctx, get, baseProfile, syscalls, level+1)
+}
+
+func (seccompHandler) observed(
+	_ profilebaseapi.SecurityProfileBase, res *enricherapi.ViolationsResponse, _ logr.Logger,
+) ([]string, error) {
+	return res.GetSyscalls(), nil
+}
+
+func (seccompHandler) localhostProfile(obj profilebaseapi.SecurityProfileBase) (string, error) {
+	sp, err := seccompProfileOf(obj)
+	if err != nil {
+		return "", err
+	}
+	return sp.Status.LocalhostProfile, nil
+}
+
+func (selinuxHandler) bindingKind(obj profilebaseapi.SecurityProfileBase) profilebindingapi.ProfileBindingKind {
+	if obj.GetNamespace() == "" {
+		return profilebindingapi.ProfileBindingKindClusterSelinuxProfile
+	}
+	return profilebindingapi.ProfileBindingKindSelinuxProfile
+}
+
+func (selinuxHandler) proposed(
+	obj profilebaseapi.SecurityProfileBase, spec []byte,
+) (profilebaseapi.SecurityProfileBase, error) {
+	sp, err := selinuxProfileOf(obj)
+	if err != nil {
+		return nil, err
+	}
+	proposed := sp.DeepCopy()
+	proposed.Spec = selxv1alpha2.SelinuxProfileSpec{}
+	if err := json.Unmarshal(spec, &proposed.Spec); err != nil {
+		return nil, fmt.Errorf("unmarshal proposed spec: %w", err)
+	}
+	return proposed, nil
+}
+
+// allowed returns the permissions of the allow policy in the form
+// "<label> <class> <permission>".
+func (selinuxHandler) allowed(
+	_ context.Context, _ getFunc, obj profilebaseapi.SecurityProfileBase,
+) ([]string, error) {
+	sp, err := selinuxProfileOf(obj)
+	if err != nil {
+		return nil, err
+	}
+	return flattenAllow(sp.Spec.Allow), nil
+}
+
+func (selinuxHandler) observed(
+	obj profilebaseapi.SecurityProfileBase, res *enricherapi.ViolationsResponse, log logr.Logger,
+) ([]string, error) {
+	sp, err := selinuxProfileOf(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	allow, err := translator.Avcs2Allow(res.GetAvc(), sp.GetPolicyUsage(), log)
+	if err != nil {
+		return nil, fmt.Errorf("translate AVCs: %w", err)
+	}
+	return flattenAllow(allow), nil
+}
+
+// localhostProfile returns an empty string, since the bpf recorder only
+// records syscalls.
+func (selinuxHandler) localhostProfile(profilebaseapi.SecurityProfileBase) (string, error) {
+	return "", nil
+}
+
+func flattenAllow(allow selxv1alpha2.Allow) []string {
+	res := []string{}
+	for label, classes := range allow {
+		for class, perms := range classes {
+			for _, perm := range perms {
+				res = append(res, fmt.Sprintf("%s %s %s", label, class, perm))
+			}
+		}
+	}
+	return res
+}
@@ -0,0 +1,350 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profilepatcher
+
+import (
+	"context"
+	"testing"
+
+	"github.com/containers/common/pkg/seccomp"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	bpfrecorderapi "sigs.k8s.io/security-profiles-operator/api/grpc/bpfrecorder"
+	enricherapi "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
+	profilebaseapi "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	profilebindingapi "sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	statusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
+	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	spodapi "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/profilepatcher/profilepatcherfakes"
+)
+
+func TestReconcileImpact(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name        string
+		annotations map[string]string
+		enricher    bool
+		usage       []string
+		recorder    bool
+		recorded    []string
+		recorderErr error
+		assert      func(*profilepatcherfakes.FakeImpl, *ImpactReport)
+	}{
+		{
+			name: "tightened profile",
+			annotations: map[string]string{
+				profilebaseapi.ProposedSpecAnnotation: `{"defaultAction":"SCMP_ACT_ERRNO",` +
+					`"syscalls":[{"names":["write"],"action":"SCMP_ACT_ALLOW"}]}`,
+			},
+			enricher: true,
+			usage:    []string{"read", "close"},
+			assert: func(mock *profilepatcherfakes.FakeImpl, report *ImpactReport) {
+				assert.Equal(t, []string{"defaultAction ", "read"}, report.Removed)
+				assert.Equal(t, []string{"defaultAction SCMP_ACT_ERRNO", "write"}, report.Added)
+				assert.Equal(t, []string{"read"}, report.RecentlyUsed)
+				assert.Equal(t, []string{"binding"}, report.ProfileBindings)
+				assert.Equal(t, "24h0m0s", report.Window)
+
+				_, _, req := mock.UsageArgsForCall(0)
+				assert.Equal(t, "profile", req.GetName())
+				assert.NotZero(t, req.GetSince())
+			},
+		},
+		{
+			name: "recorded by bpf recorder",
+			annotations: map[string]string{
+				profilebaseapi.ProposedSpecAnnotation: `{"defaultAction":"SCMP_ACT_ERRNO",` +
+					`"syscalls":[{"names":["write"],"action":"SCMP_ACT_ALLOW"}]}`,
+			},
+			enricher: true,
+			recorder: true,
+			recorded: []string{"read", "write"},
+			assert: func(mock *profilepatcherfakes.FakeImpl, report *ImpactReport) {
+				assert.Equal(t, []string{"read"}, report.RecentlyUsed)
+				assert.Equal(t, []string{"read"}, report.Recorded)
+				assert.NotEmpty(t, report.Nodes)
+
+				_, _, req := mock.SyscallsForLocalhostProfileArgsForCall(0)
+				assert.Equal(t, "operator/default/profile.json", req.GetName())
+			},
+		},
+		{
+			name: "bpf recorder without log enricher",
+			annotations: map[string]string{
+				profilebaseapi.ProposedSpecAnnotation: "null",
+			},
+			recorder: true,
+			recorded: []string{"read"},
+			assert: func(mock *profilepatcherfakes.FakeImpl, report *ImpactReport) {
+				assert.Equal(t, []string{"read"}, report.RecentlyUsed)
+				assert.Equal(t, []string{"read"}, report.Recorded)
+				assert.Zero(t, mock.UsageCallCount())
+			},
+		},
+		{
+			name: "bpf recorder not running",
+			annotations: map[string]string{
+				profilebaseapi.ProposedSpecAnnotation: "null",
+			},
+			recorder:    true,
+			recorderErr: errTest,
+			assert: func(mock *profilepatcherfakes.FakeImpl, report *ImpactReport) {
+				assert.Equal(t, []string{"defaultAction ", "read"}, report.Removed)
+				assert.Empty(t, report.RecentlyUsed)
+				assert.Empty(t, report.Recorded)
+				assert.Empty(t, report.Nodes)
+				assert.Equal(t, 1, mock.SyscallsForLocalhostProfileCallCount())
+			},
+		},
+		{
+			name: "deletion without log enricher",
+			annotations: map[string]string{
+				profilebaseapi.ProposedSpecAnnotation: "null",
+				profilebaseapi.ImpactWindowAnnotation: "1h",
+			},
+			assert: func(mock *profilepatcherfakes.FakeImpl, report *ImpactReport) {
+				assert.Equal(t, []string{"defaultAction ", "read"}, report.Removed)
+				assert.Empty(t, report.RecentlyUsed)
+				assert.Empty(t, report.Nodes)
+				assert.Equal(t, "1h0m0s", report.Window)
+				assert.Zero(t, mock.UsageCallCount())
+			},
+		},
+		{
+			name: "invalid window",
+			annotations: map[string]string{
+				profilebaseapi.ProposedSpecAnnotation: "null",
+				profilebaseapi.ImpactWindowAnnotation: "720h",
+			},
+			assert: func(mock *profilepatcherfakes.FakeImpl, report *ImpactReport) {
+				assert.Nil(t, report)
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &profilepatcherfakes.FakeImpl{}
+			mock.DialEnricherReturns(nil, func() {}, nil)
+			mock.DialBpfRecorderReturns(nil, func() {}, nil)
+			mock.SyscallsForLocalhostProfileReturns(
+				&bpfrecorderapi.SyscallsResponse{Syscalls: tc.recorded}, tc.recorderErr,
+			)
+			mock.ClientGetCalls(seccompProfileWithAnnotations(tc.annotations))
+			mock.ClientListCalls(listBindings)
+			mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+				Spec: spodapi.SPODSpec{EnableLogEnricher: tc.enricher, EnableBpfRecorder: tc.recorder},
+			}, nil)
+			mock.UsageReturns(&enricherapi.ViolationsResponse{Syscalls: tc.usage}, nil)
+			mock.ViolationsReturns(&enricherapi.ViolationsResponse{}, nil)
+
+			sut := &PatchReconciler{
+				impl:    mock,
+				log:     logr.Discard(),
+				record:  record.NewFakeRecorder(10),
+				handler: seccompHandler{},
+			}
+			_, err := sut.Reconcile(context.Background(), reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: "default", Name: "profile"},
+			})
+			require.NoError(t, err)
+
+			var report *ImpactReport
+			if mock.ClientUpdateCallCount() > 0 {
+				_, _, obj := mock.ClientUpdateArgsForCall(0)
+				nodeStatus, ok := obj.(*statusv1alpha1.SecurityProfileNodeStatus)
+				require.True(t, ok)
+				report, err = ParseImpactReport(nodeStatus.ImpactReport)
+				require.NoError(t, err)
+			}
+			tc.assert(mock, report)
+		})
+	}
+}
+
+// listBindings lists profile bindings and cluster profile bindings
+// referencing profiles named "profile".
+func listBindings(_ context.Context, _ client.Client, list client.ObjectList, _ ...client.ListOption) error {
+	switch bindings := list.(type) {
+	case *profilebindingapi.ProfileBindingList:
+		for name, kind := range map[string]profilebindingapi.ProfileBindingKind{
+			"binding":       profilebindingapi.ProfileBindingKindSeccompProfile,
+			"other-binding": profilebindingapi.ProfileBindingKindSelinuxProfile,
+		} {
+			b := profilebindingapi.ProfileBinding{}
+			b.SetName(name)
+			b.Spec.ProfileRef = profilebindingapi.ProfileRef{Kind: kind, Name: "profile"}
+			bindings.Items = append(bindings.Items, b)
+		}
+	case *profilebindingapi.ClusterProfileBindingList:
+		b := profilebindingapi.ClusterProfileBinding{}
+		b.SetName("cluster-binding")
+		b.Spec.ProfileRef = profilebindingapi.ClusterProfileRef{
+			Kind: profilebindingapi.ProfileBindingKindClusterSeccompProfile, Name: "profile",
+		}
+		bindings.Items = append(bindings.Items, b)
+	default:
+		return errTest
+	}
+	return nil
+}
+
+func TestProfileBindings(t *testing.T) {
+	t.Parallel()
+
+	mock := &profilepatcherfakes.FakeImpl{}
+	mock.ClientListCalls(listBindings)
+	sut := &PatchReconciler{impl: mock}
+
+	sp := &seccompprofileapi.SeccompProfile{}
+	sp.SetName("profile")
+	sp.SetNamespace("default")
+	bindings, err := sut.profileBindings(context.Background(), seccompHandler{}.bindingKind(sp), sp)
+	require.NoError(t, err)
+	require.Equal(t, []string{"binding"}, bindings)
+
+	csp := &seccompprofileapi.ClusterSeccompProfile{}
+	csp.SetName("profile")
+	bindings, err = sut.profileBindings(context.Background(), seccompHandler{}.bindingKind(csp), csp)
+	require.NoError(t, err)
+	require.Equal(t, []string{"ClusterProfileBinding/cluster-binding"}, bindings)
+}
+
+func TestSeccompAllowed(t *testing.T) {
+	t.Parallel()
+
+	get := func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+		sp, ok := obj.(seccompprofileapi.SeccompProfileObject)
+		if !ok {
+			return errTest
+		}
+		switch key.Name {
+		case "base":
+			sp.GetSpec().BaseProfileName = seccompprofileapi.ClusterSeccompProfileKind + "/cluster-base"
+			sp.GetSpec().Syscalls = []*seccompprofileapi.Syscall{
+				{Names: []string{"write"}, Action: seccomp.ActAllow},
+			}
+		case "cluster-base":
+			if key.Namespace != "" {
+				return errTest
+			}
+			sp.GetSpec().Syscalls = []*seccompprofileapi.Syscall{
+				{Names: []string{"close"}, Action: seccomp.ActLog},
+				{Names: []string{"mount"}, Action: seccomp.ActErrno},
+			}
+		default:
+			return errTest
+		}
+		return nil
+	}
+
+	for _, tc := range []struct {
+		name     string
+		base     string
+		want     []string
+		wantErr  error
+		anyError bool
+	}{
+		{
+			name: "without base profile",
+			want: []string{"defaultAction SCMP_ACT_ERRNO", "read"},
+		},
+		{
+			name: "with base profiles",
+			base: "base",
+			want: []string{"defaultAction SCMP_ACT_ERRNO", "read", "write", "close"},
+		},
+		{
+			name:     "missing base profile",
+			base:     "missing",
+			anyError: true,
+		},
+		{
+			name:    "OCI base profile",
+			base:    "oci://registry/profile:latest",
+			wantErr: errUnsupportedBaseProfile,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sp := &seccompprofileapi.SeccompProfile{}
+			sp.SetNamespace("default")
+			sp.Spec.DefaultAction = seccomp.ActErrno
+			sp.Spec.BaseProfileName = tc.base
+			sp.Spec.Syscalls = []*seccompprofileapi.Syscall{{Names: []string{"read"}, Action: seccomp.ActAllow}}
+
+			allowed, err := seccompHandler{}.allowed(context.Background(), get, sp)
+			switch {
+			case tc.wantErr != nil:
+				require.ErrorIs(t, err, tc.wantErr)
+			case tc.anyError:
+				require.Error(t, err)
+			default:
+				require.NoError(t, err)
+				require.ElementsMatch(t, tc.want, allowed)
+			}
+		})
+	}
+}
+
+func TestMergeImpactReports(t *testing.T) {
+	t.Parallel()
+
+	local := &ImpactReport{ProposedSpec: "a", Window: "1h", RecentlyUsed: []string{"read"}, Nodes: []string{"node1"}}
+	require.Equal(t, local, MergeImpactReports(nil, local))
+
+	merged := MergeImpactReports(&ImpactReport{
+		ProposedSpec: "a", Window: "1h", RecentlyUsed: []string{"write"}, Nodes: []string{"node2"},
+	}, local)
+	require.Equal(t, []string{"read", "write"}, merged.RecentlyUsed)
+	require.Equal(t, []string{"node1", "node2"}, merged.Nodes)
+
+	// reports of other proposed specs get replaced
+	require.Equal(t, local, MergeImpactReports(&ImpactReport{
+		ProposedSpec: "b", Window: "1h", RecentlyUsed: []string{"write"},
+	}, local))
+}
+
+func TestSelinuxAllowed(t *testing.T) {
+	t.Parallel()
+
+	sp := &selxv1alpha2.SelinuxProfile{}
+	proposed, err := selinuxHandler{}.proposed(sp, []byte(`{"allow":{"@self":{"tcp_socket":["listen","bind"]}}}`))
+	require.NoError(t, err)
+	allowed, err := selinuxHandler{}.allowed(context.Background(), nil, proposed)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"@self tcp_socket listen", "@self tcp_socket bind"}, allowed)
+
+	allowed, err = selinuxHandler{}.allowed(context.Background(), nil, sp)
+	require.NoError(t, err)
+	require.Empty(t, allowed)
+
+	_, err = selinuxHandler{}.allowed(context.Background(), nil, &seccompprofileapi.SeccompProfile{})
+	require.ErrorIs(t, err, errUnexpectedProfile)
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profilepatcher
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	bpfrecorderapi "sigs.k8s.io/security-profiles-operator/api/grpc/bpfrecorder"
+	enricherapi "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
+	spodapi "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/bpfrecorder"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/common"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher"
+)
+
+type defaultImpl struct{}
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate -header ../../../../hack/boilerplate/boilerplate.generatego.txt
+//counterfeiter:generate . impl
+type impl interface {
+	NewControllerManagedBy(manager.Manager, string, client.Object, reconcile.Reconciler) error
+	ManagerGetClient(manager.Manager) client.Client
+	ManagerGetEventRecorderFor(manager.Manager, string) record.EventRecorder
+	ClientGet(context.Context, client.Client, client.ObjectKey, client.Object) error
+	ClientUpdate(context.Context, client.Client, client.Object) error
+	ClientList(context.Context, client.Client, client.ObjectList, ...client.ListOption) error
+	GetSPOD(context.Context, client.Client) (*spodapi.SecurityProfilesOperatorDaemon, error)
+	DialEnricher() (*grpc.ClientConn, context.CancelFunc, error)
+	Violations(
+		context.Context, enricherapi.EnricherClient, *enricherapi.ViolationsRequest,
+	) (*enricherapi.ViolationsResponse, error)
+	ResetViolations(
+		context.Context, enricherapi.EnricherClient, *enricherapi.ViolationsRequest,
+	) error
+	Usage(
+		context.Context, enricherapi.EnricherClient, *enricherapi.UsageRequest,
+	) (*enricherapi.ViolationsResponse, error)
+	DialBpfRecorder() (*grpc.ClientConn, context.CancelFunc, error)
+	SyscallsForLocalhostProfile(
+		context.Context, bpfrecorderapi.BpfRecorderClient, *bpfrecorderapi.ProfileRequest,
+	) (*bpfrecorderapi.SyscallsResponse, error)
+}
+
+func (*defaultImpl) NewControllerManagedBy(
+	m manager.Manager, name string, obj client.Object, r reconcile.Reconciler,
+) error {
+	return ctrl.NewControllerManagedBy(m).
+		Named(name).
+		For(obj).
+		Complete(r)
+}
+
+func (*defaultImpl) ManagerGetClient(m manager.Manager) client.Client {
+	return m.GetClient()
+}
+
+func (*defaultImpl) ManagerGetEventRecorderFor(
+	m manager.Manager, name string,
+) record.EventRecorder {
+	return m.GetEventRecorderFor(name)
+}
+
+func (*defaultImpl) ClientGet(
+	ctx context.Context, c client.Client, key client.ObjectKey, obj client.Object,
+) error {
+	return c.Get(ctx, key, obj)
+}
+
+func (*defaultImpl) ClientUpdate(
+	ctx context.Context, c client.Client, obj client.Object,
+) error {
+	return c.Update(ctx, obj)
+}
+
+func (*defaultImpl) ClientList(
+	ctx context.Context, c client.Client, list client.ObjectList, opts ...client.ListOption,
+) error {
+	return c.List(ctx, list, opts...)
+}
+
+func (*defaultImpl) GetSPOD(
+	ctx context.Context, c client.Client,
+) (*spodapi.SecurityProfilesOperatorDaemon, error) {
+	return common.GetSPOD(ctx, c)
+}
+
+func (*defaultImpl) DialEnricher() (*grpc.ClientConn, context.CancelFunc, error) {
+	return enricher.Dial()
+}
+
+func (*defaultImpl) Violations(
+	ctx context.Context, c enricherapi.EnricherClient, in *enricherapi.ViolationsRequest,
+) (*enricherapi.ViolationsResponse, error) {
+	return c.Violations(ctx, in)
+}
+
+func (*defaultImpl) ResetViolations(
+	ctx context.Context, c enricherapi.EnricherClient, in *enricherapi.ViolationsRequest,
+) error {
+	_, err := c.ResetViolations(ctx, in)
+	return err
+}
+
+func (*defaultImpl) Usage(
+	ctx context.Context, c enricherapi.EnricherClient, in *enricherapi.UsageRequest,
+) (*enricherapi.ViolationsResponse, error) {
+	return c.Usage(ctx, in)
+}
+
+func (*defaultImpl) DialBpfRecorder() (*grpc.ClientConn, context.CancelFunc, error) {
+	return bpfrecorder.Dial()
+}
+
+func (*defaultImpl) SyscallsForLocalhostProfile(
+	ctx context.Context, c bpfrecorderapi.BpfRecorderClient, in *bpfrecorderapi.ProfileRequest,
+) (*bpfrecorderapi.SyscallsResponse, error) {
+	return c.SyscallsForLocalhostProfile(ctx, in)
+}
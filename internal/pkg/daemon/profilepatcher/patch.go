@@ -0,0 +1,488 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profilepatcher
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/containers/common/pkg/seccomp"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	enricherapi "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
+	profilebaseapi "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/translator"
+)
+
+var (
+	errNoPolicyEnd       = errors.New("unable to find the end of the AppArmor profile policy")
+	errUnexpectedProfile = errors.New("unexpected profile type")
+)
+
+// Patch is an addition to the spec of a profile which would allow the
+// operations observed as violations while the profile was in use.
+type Patch struct {
+	// Syscalls to be added to a SeccompProfile.
+	Syscalls []*seccompprofileapi.Syscall `json:"syscalls,omitempty"`
+	// Allow entries to be added to a SelinuxProfile.
+	Allow selxv1alpha2.Allow `json:"allow,omitempty"`
+	// Rules to be added to the policy of an AppArmorProfile.
+	Rules []string `json:"rules,omitempty"`
+}
+
+// IsEmpty returns true if the patch would not change any profile.
+func (p *Patch) IsEmpty() bool {
+	return p == nil || (len(p.Syscalls) == 0 && len(p.Allow) == 0 && len(p.Rules) == 0)
+}
+
+// ParsePatch decodes a JSON encoded patch. An empty string is an empty
+// patch.
+func ParsePatch(data string) (*Patch, error) {
+	patch := &Patch{}
+	if data == "" {
+		return patch, nil
+	}
+	if err := json.Unmarshal([]byte(data), patch); err != nil {
+		return nil, fmt.Errorf("unmarshal patch: %w", err)
+	}
+	return patch, nil
+}
+
+// EncodePatch returns the JSON encoding of the patch, or an empty string if
+// the patch is empty.
+func EncodePatch(patch *Patch) (string, error) {
+	if patch.IsEmpty() {
+		return "", nil
+	}
+	data, err := json.Marshal(patch)
+	if err != nil {
+		return "", fmt.Errorf("marshal patch: %w", err)
+	}
+	return string(data), nil
+}
+
+// SuggestedPatch returns the patch stored in the annotations of the profile.
+func SuggestedPatch(obj profilebaseapi.SecurityProfileBase) (*Patch, error) {
+	patch, err := ParsePatch(obj.GetAnnotations()[profilebaseapi.SuggestedPatchAnnotation])
+	if err != nil {
+		return nil, fmt.Errorf("parsing suggested patch: %w", err)
+	}
+	return patch, nil
+}
+
+// IsApproved returns true if the suggested patch of the profile should be
+// merged into its spec.
+func IsApproved(obj profilebaseapi.SecurityProfileBase) bool {
+	approved, err := strconv.ParseBool(obj.GetAnnotations()[profilebaseapi.ApprovePatchAnnotation])
+	return err == nil && approved
+}
+
+// Pending returns the part of the patch which is not yet allowed by the
+// profile.
+func Pending(obj profilebaseapi.SecurityProfileBase, patch *Patch) (*Patch, error) {
+	handler, err := handlerFor(obj)
+	if err != nil {
+		return nil, err
+	}
+	return handler.pending(obj, patch)
+}
+
+// Apply merges the patch into the spec of the profile.
+func Apply(obj profilebaseapi.SecurityProfileBase, patch *Patch) error {
+	handler, err := handlerFor(obj)
+	if err != nil {
+		return err
+	}
+	return handler.apply(obj, patch)
+}
+
+// handlerFor returns the patch handler of the profile kind.
+func handlerFor(obj profilebaseapi.SecurityProfileBase) (patchHandler, error) {
+	switch obj.(type) {
+	case *seccompprofileapi.SeccompProfile:
+		return seccompHandler{}, nil
+	case *selxv1alpha2.SelinuxProfile:
+		return selinuxHandler{}, nil
+	case *apparmorprofileapi.AppArmorProfile:
+		return apparmorHandler{}, nil
+	default:
+		return nil, fmt.Errorf("%w: %T", errUnexpectedProfile, obj)
+	}
+}
+
+// MergePatches returns the union of both patches.
+func MergePatches(a, b *Patch) *Patch {
+	return &Patch{
+		Syscalls: addSyscalls(addSyscalls(nil, a.Syscalls), b.Syscalls),
+		Allow:    addAllow(addAllow(nil, a.Allow), b.Allow),
+		Rules:    sets.List(sets.New(a.Rules...).Insert(b.Rules...)),
+	}
+}
+
+// addSyscalls adds the syscall names to the entries of the same action,
+// which do neither restrict arguments nor set an errno return code.
+func addSyscalls(syscalls, add []*seccompprofileapi.Syscall) []*seccompprofileapi.Syscall {
+	for _, a := range add {
+		var target *seccompprofileapi.Syscall
+		for _, s := range syscalls {
+			if s.Action == a.Action && len(s.Args) == 0 && s.ErrnoRet == 0 {
+				target = s
+				break
+			}
+		}
+
+		if target == nil || len(a.Args) != 0 || a.ErrnoRet != 0 {
+			syscalls = append(syscalls, a.DeepCopy())
+			continue
+		}
+
+		existing := sets.New(target.Names...)
+		for _, name := range a.Names {
+			if !existing.Has(name) {
+				existing.Insert(name)
+				target.Names = append(target.Names, name)
+			}
+		}
+	}
+	return syscalls
+}
+
+// addAllow adds the permissions to the allow policy.
+func addAllow(allow, add selxv1alpha2.Allow) selxv1alpha2.Allow {
+	if len(add) == 0 {
+		return allow
+	}
+	if allow == nil {
+		allow = make(selxv1alpha2.Allow)
+	}
+
+	for label, classes := range add {
+		if _, ok := allow[label]; !ok {
+			allow[label] = make(map[selxv1alpha2.ObjectClassKey]selxv1alpha2.PermissionSet)
+		}
+		for class, perms := range classes {
+			allow[label][class] = sets.List(sets.New(allow[label][class]...).Insert(perms...))
+		}
+	}
+	return allow
+}
+
+// subtractAllow returns the permissions of allow which are not part of
+// existing.
+func subtractAllow(allow, existing selxv1alpha2.Allow) selxv1alpha2.Allow {
+	res := make(selxv1alpha2.Allow)
+	for label, classes := range allow {
+		for class, perms := range classes {
+			missing := sets.List(sets.New(perms...).Difference(sets.New(existing[label][class]...)))
+			if len(missing) == 0 {
+				continue
+			}
+			if _, ok := res[label]; !ok {
+				res[label] = make(map[selxv1alpha2.ObjectClassKey]selxv1alpha2.PermissionSet)
+			}
+			res[label][class] = missing
+		}
+	}
+	return res
+}
+
+// patchHandler abstracts the profile kinds which can be patched.
+type patchHandler interface {
+	// auditType returns the enricher audit type of the profile kind.
+	auditType() string
+	// newProfile returns an empty profile of the handled kind.
+	newProfile() profilebaseapi.SecurityProfileBase
+	// violationsName returns the name under which the enricher tracks the
+	// violations of the profile.
+	violationsName(profilebaseapi.SecurityProfileBase) (string, error)
+	// suggest returns the patch allowing the violations which are not
+	// already allowed by the profile.
+	suggest(profilebaseapi.SecurityProfileBase, *enricherapi.ViolationsResponse, logr.Logger) (*Patch, error)
+	// pending returns the part of the patch which is not already allowed by
+	// the profile.
+	pending(profilebaseapi.SecurityProfileBase, *Patch) (*Patch, error)
+	// apply merges the patch into the profile spec.
+	apply(profilebaseapi.SecurityProfileBase, *Patch) error
+}
+
+func seccompProfileOf(obj profilebaseapi.SecurityProfileBase) (*seccompprofileapi.SeccompProfile, error) {
+	sp, ok := obj.(*seccompprofileapi.SeccompProfile)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", errUnexpectedProfile, obj)
+	}
+	return sp, nil
+}
+
+func selinuxProfileOf(obj profilebaseapi.SecurityProfileBase) (*selxv1alpha2.SelinuxProfile, error) {
+	sp, ok := obj.(*selxv1alpha2.SelinuxProfile)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", errUnexpectedProfile, obj)
+	}
+	return sp, nil
+}
+
+func apparmorProfileOf(obj profilebaseapi.SecurityProfileBase) (*apparmorprofileapi.AppArmorProfile, error) {
+	ap, ok := obj.(*apparmorprofileapi.AppArmorProfile)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", errUnexpectedProfile, obj)
+	}
+	return ap, nil
+}
+
+type seccompHandler struct{}
+
+func (seccompHandler) auditType() string {
+	return types.AuditTypeSeccomp
+}
+
+func (seccompHandler) newProfile() profilebaseapi.SecurityProfileBase {
+	return &seccompprofileapi.SeccompProfile{}
+}
+
+func (seccompHandler) violationsName(obj profilebaseapi.SecurityProfileBase) (string, error) {
+	sp, err := seccompProfileOf(obj)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(sp.GetProfileFile(), seccompprofileapi.ExtJSON), nil
+}
+
+func (h seccompHandler) suggest(
+	obj profilebaseapi.SecurityProfileBase, res *enricherapi.ViolationsResponse, _ logr.Logger,
+) (*Patch, error) {
+	syscalls := sets.List(sets.New(res.GetSyscalls()...))
+	if len(syscalls) == 0 {
+		return &Patch{}, nil
+	}
+
+	return h.pending(obj, &Patch{
+		Syscalls: []*seccompprofileapi.Syscall{{
+			Names:  syscalls,
+			Action: seccomp.ActAllow,
+		}},
+	})
+}
+
+// pending removes the syscall names which are already allowed without
+// restricting their arguments.
+func (seccompHandler) pending(obj profilebaseapi.SecurityProfileBase, patch *Patch) (*Patch, error) {
+	sp, err := seccompProfileOf(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := sets.New[string]()
+	for _, s := range sp.Spec.Syscalls {
+		if s.Action == seccomp.ActAllow && len(s.Args) == 0 {
+			allowed.Insert(s.Names...)
+		}
+	}
+
+	res := &Patch{}
+	for _, s := range patch.Syscalls {
+		pending := s.DeepCopy()
+		if s.Action == seccomp.ActAllow {
+			pending.Names = []string{}
+			for _, name := range s.Names {
+				if !allowed.Has(name) {
+					pending.Names = append(pending.Names, name)
+				}
+			}
+		}
+		if len(pending.Names) > 0 {
+			res.Syscalls = append(res.Syscalls, pending)
+		}
+	}
+	return res, nil
+}
+
+func (seccompHandler) apply(obj profilebaseapi.SecurityProfileBase, patch *Patch) error {
+	sp, err := seccompProfileOf(obj)
+	if err != nil {
+		return err
+	}
+	sp.Spec.Syscalls = addSyscalls(sp.Spec.Syscalls, patch.Syscalls)
+	return nil
+}
+
+type selinuxHandler struct{}
+
+func (selinuxHandler) auditType() string {
+	return types.AuditTypeSelinux
+}
+
+func (selinuxHandler) newProfile() profilebaseapi.SecurityProfileBase {
+	return &selxv1alpha2.SelinuxProfile{}
+}
+
+func (selinuxHandler) violationsName(obj profilebaseapi.SecurityProfileBase) (string, error) {
+	return obj.GetName(), nil
+}
+
+func (h selinuxHandler) suggest(
+	obj profilebaseapi.SecurityProfileBase, res *enricherapi.ViolationsResponse, log logr.Logger,
+) (*Patch, error) {
+	sp, err := selinuxProfileOf(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	allow, err := translator.Avcs2Allow(res.GetAvc(), sp.GetPolicyUsage(), log)
+	if err != nil {
+		return nil, fmt.Errorf("translate AVCs: %w", err)
+	}
+
+	return h.pending(obj, &Patch{Allow: allow})
+}
+
+func (selinuxHandler) pending(obj profilebaseapi.SecurityProfileBase, patch *Patch) (*Patch, error) {
+	sp, err := selinuxProfileOf(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	missing := subtractAllow(patch.Allow, sp.Spec.Allow)
+	if len(missing) == 0 {
+		return &Patch{}, nil
+	}
+	return &Patch{Allow: missing}, nil
+}
+
+func (selinuxHandler) apply(obj profilebaseapi.SecurityProfileBase, patch *Patch) error {
+	sp, err := selinuxProfileOf(obj)
+	if err != nil {
+		return err
+	}
+	sp.Spec.Allow = addAllow(sp.Spec.Allow, patch.Allow)
+	return nil
+}
+
+type apparmorHandler struct{}
+
+func (apparmorHandler) auditType() string {
+	return types.AuditTypeApparmor
+}
+
+func (apparmorHandler) newProfile() profilebaseapi.SecurityProfileBase {
+	return &apparmorprofileapi.AppArmorProfile{}
+}
+
+func (apparmorHandler) violationsName(obj profilebaseapi.SecurityProfileBase) (string, error) {
+	return obj.GetName(), nil
+}
+
+func (h apparmorHandler) suggest(
+	obj profilebaseapi.SecurityProfileBase, res *enricherapi.ViolationsResponse, _ logr.Logger,
+) (*Patch, error) {
+	rules := sets.New[string]()
+	for _, denial := range res.GetApparmor() {
+		if rule := apparmorRule(denial); rule != "" {
+			rules.Insert(rule)
+		}
+	}
+	return h.pending(obj, &Patch{Rules: sets.List(rules)})
+}
+
+func (apparmorHandler) pending(obj profilebaseapi.SecurityProfileBase, patch *Patch) (*Patch, error) {
+	ap, err := apparmorProfileOf(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Patch{}
+	for _, rule := range patch.Rules {
+		if !strings.Contains(ap.Spec.Policy, rule) {
+			res.Rules = append(res.Rules, rule)
+		}
+	}
+	return res, nil
+}
+
+func (apparmorHandler) apply(obj profilebaseapi.SecurityProfileBase, patch *Patch) error {
+	ap, err := apparmorProfileOf(obj)
+	if err != nil {
+		return err
+	}
+	if len(patch.Rules) == 0 {
+		return nil
+	}
+
+	// The rules are added to the end of the outermost profile.
+	end := strings.LastIndex(ap.Spec.Policy, "}")
+	if end < 0 {
+		return errNoPolicyEnd
+	}
+
+	var sb strings.Builder
+	sb.WriteString(ap.Spec.Policy[:end])
+	if !strings.HasSuffix(ap.Spec.Policy[:end], "\n") {
+		sb.WriteString("\n")
+	}
+	for _, rule := range patch.Rules {
+		sb.WriteString("  ")
+		sb.WriteString(rule)
+		sb.WriteString("\n")
+	}
+	sb.WriteString(ap.Spec.Policy[end:])
+	ap.Spec.Policy = sb.String()
+	return nil
+}
+
+// apparmorPermissions maps the characters of an AppArmor denied mask to the
+// file permissions of a profile rule.
+var apparmorPermissions = []struct {
+	mask, perm string
+}{
+	{"r", "r"},
+	{"wcd", "w"},
+	{"a", "a"},
+	{"x", "ix"},
+	{"m", "m"},
+	{"k", "k"},
+	{"l", "l"},
+}
+
+// apparmorRule returns the file rule which allows the denied operation, or
+// an empty string if the denial cannot be expressed as a file rule.
+func apparmorRule(denial *enricherapi.ViolationsResponse_ApparmorDenial) string {
+	name := denial.GetName()
+	if !strings.HasPrefix(name, "/") {
+		return ""
+	}
+
+	perms := []string{}
+	for _, p := range apparmorPermissions {
+		if strings.ContainsAny(denial.GetDeniedMask(), p.mask) {
+			perms = append(perms, p.perm)
+		}
+	}
+	if len(perms) == 0 {
+		return ""
+	}
+	if strings.ContainsAny(name, " \t") {
+		name = strconv.Quote(name)
+	}
+	return fmt.Sprintf("%s %s,", name, strings.Join(perms, ""))
+}
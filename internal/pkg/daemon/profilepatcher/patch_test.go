@@ -0,0 +1,208 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profilepatcher
+
+import (
+	"testing"
+
+	"github.com/containers/common/pkg/seccomp"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	enricherapi "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+)
+
+func TestSelinuxSuggest(t *testing.T) {
+	t.Parallel()
+
+	sp := &selxv1alpha2.SelinuxProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "profile", Namespace: "default"},
+		Spec: selxv1alpha2.SelinuxProfileSpec{
+			Allow: selxv1alpha2.Allow{
+				"var_log_t": {"file": {"read"}},
+			},
+		},
+	}
+	res := &enricherapi.ViolationsResponse{
+		Avc: []*enricherapi.AvcResponse_SelinuxAvc{
+			{
+				Perm:     "read",
+				Scontext: "system_u:system_r:profile_default.process:s0",
+				Tcontext: "system_u:object_r:var_log_t:s0",
+				Tclass:   "file",
+			},
+			{
+				Perm:     "open",
+				Scontext: "system_u:system_r:profile_default.process:s0",
+				Tcontext: "system_u:object_r:var_log_t:s0",
+				Tclass:   "file",
+			},
+			{
+				Perm:     "sigchld",
+				Scontext: "system_u:system_r:profile_default.process:s0",
+				Tcontext: "system_u:system_r:profile_default.process:s0",
+				Tclass:   "process",
+			},
+		},
+	}
+
+	patch, err := selinuxHandler{}.suggest(sp, res, logr.Discard())
+	require.NoError(t, err)
+	assert.Equal(t, selxv1alpha2.Allow{
+		"var_log_t":            {"file": {"open"}},
+		selxv1alpha2.AllowSelf: {"process": {"sigchld"}},
+	}, patch.Allow)
+
+	require.NoError(t, selinuxHandler{}.apply(sp, patch))
+	assert.Equal(t, selxv1alpha2.PermissionSet{"open", "read"}, sp.Spec.Allow["var_log_t"]["file"])
+	assert.Equal(t, selxv1alpha2.PermissionSet{"sigchld"}, sp.Spec.Allow[selxv1alpha2.AllowSelf]["process"])
+}
+
+func TestAppArmorSuggestAndApply(t *testing.T) {
+	t.Parallel()
+
+	ap := &apparmorprofileapi.AppArmorProfile{
+		Spec: apparmorprofileapi.AppArmorProfileSpec{
+			Policy: "profile test flags=(attach_disconnected) {\n  /etc/hosts r,\n}\n",
+		},
+	}
+	res := &enricherapi.ViolationsResponse{
+		Apparmor: []*enricherapi.ViolationsResponse_ApparmorDenial{
+			{Operation: "open", Name: "/etc/hosts", DeniedMask: "r"},
+			{Operation: "open", Name: "/var/log/app.log", DeniedMask: "wc"},
+			{Operation: "exec", Name: "/usr/bin/my app", DeniedMask: "x"},
+			{Operation: "create", Name: "", DeniedMask: "c"},
+			{Operation: "capable", Name: "net_admin"},
+		},
+	}
+
+	patch, err := apparmorHandler{}.suggest(ap, res, logr.Discard())
+	require.NoError(t, err)
+	assert.Equal(t, []string{`"/usr/bin/my app" ix,`, "/var/log/app.log w,"}, patch.Rules)
+
+	require.NoError(t, apparmorHandler{}.apply(ap, patch))
+	assert.Equal(t,
+		"profile test flags=(attach_disconnected) {\n  /etc/hosts r,\n"+
+			"  \"/usr/bin/my app\" ix,\n  /var/log/app.log w,\n}\n",
+		ap.Spec.Policy,
+	)
+
+	ap.Spec.Policy = "invalid"
+	require.ErrorIs(t, apparmorHandler{}.apply(ap, patch), errNoPolicyEnd)
+}
+
+func TestMergePatches(t *testing.T) {
+	t.Parallel()
+
+	a := &Patch{
+		Allow: selxv1alpha2.Allow{"var_log_t": {"file": {"read"}}},
+		Rules: []string{"/etc/hosts r,"},
+	}
+	b := &Patch{
+		Allow: selxv1alpha2.Allow{"var_log_t": {"file": {"open", "read"}}},
+		Rules: []string{"/etc/hosts r,", "/tmp/ rw,"},
+	}
+
+	res := MergePatches(a, b)
+	assert.Equal(t, selxv1alpha2.Allow{"var_log_t": {"file": {"open", "read"}}}, res.Allow)
+	assert.Equal(t, []string{"/etc/hosts r,", "/tmp/ rw,"}, res.Rules)
+	assert.Empty(t, res.Syscalls)
+	assert.Equal(t, selxv1alpha2.PermissionSet{"read"}, a.Allow["var_log_t"]["file"])
+	assert.True(t, (&Patch{}).IsEmpty())
+	assert.False(t, res.IsEmpty())
+}
+
+func TestPending(t *testing.T) {
+	t.Parallel()
+
+	sp := &seccompprofileapi.SeccompProfile{
+		Spec: seccompprofileapi.SeccompProfileSpec{
+			Syscalls: []*seccompprofileapi.Syscall{
+				{Names: []string{"read"}, Action: seccomp.ActAllow},
+				{
+					Names:  []string{"ioctl"},
+					Action: seccomp.ActAllow,
+					Args:   []*seccompprofileapi.Arg{{Index: 1, Value: 1, Op: seccomp.OpEqualTo}},
+				},
+			},
+		},
+	}
+	patch, err := Pending(sp, &Patch{
+		Syscalls: []*seccompprofileapi.Syscall{
+			{Names: []string{"read", "ioctl", "write"}, Action: seccomp.ActAllow},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, patch.Syscalls, 1)
+	assert.Equal(t, []string{"ioctl", "write"}, patch.Syscalls[0].Names)
+
+	patch, err = Pending(sp, &Patch{
+		Syscalls: []*seccompprofileapi.Syscall{
+			{Names: []string{"read"}, Action: seccomp.ActAllow},
+		},
+	})
+	require.NoError(t, err)
+	assert.True(t, patch.IsEmpty())
+
+	selx := &selxv1alpha2.SelinuxProfile{
+		Spec: selxv1alpha2.SelinuxProfileSpec{
+			Allow: selxv1alpha2.Allow{"var_log_t": {"file": {"read"}}},
+		},
+	}
+	patch, err = Pending(selx, &Patch{
+		Allow: selxv1alpha2.Allow{"var_log_t": {"file": {"open", "read"}}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, selxv1alpha2.Allow{"var_log_t": {"file": {"open"}}}, patch.Allow)
+
+	ap := &apparmorprofileapi.AppArmorProfile{
+		Spec: apparmorprofileapi.AppArmorProfileSpec{
+			Policy: "profile test {\n  /etc/hosts r,\n}\n",
+		},
+	}
+	patch, err = Pending(ap, &Patch{Rules: []string{"/etc/hosts r,", "/tmp/ rw,"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/tmp/ rw,"}, patch.Rules)
+}
+
+func TestParseAndEncodePatch(t *testing.T) {
+	t.Parallel()
+
+	patch, err := ParsePatch("")
+	require.NoError(t, err)
+	assert.True(t, patch.IsEmpty())
+
+	data, err := EncodePatch(patch)
+	require.NoError(t, err)
+	assert.Empty(t, data)
+
+	patch, err = ParsePatch(`{"rules":["/tmp/ rw,"]}`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/tmp/ rw,"}, patch.Rules)
+
+	data, err = EncodePatch(patch)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"rules":["/tmp/ rw,"]}`, data)
+
+	_, err = ParsePatch("invalid")
+	require.Error(t, err)
+}
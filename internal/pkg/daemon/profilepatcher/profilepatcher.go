@@ -0,0 +1,279 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profilepatcher
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	enricherapi "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
+	profilebaseapi "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	statusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
+	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/controller"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/nodestatus"
+)
+
+const (
+	// violationsPollInterval is the interval in which the log enricher gets
+	// queried for new violations of a profile.
+	violationsPollInterval = 1 * time.Minute
+)
+
+// blank assignment to verify that PatchReconciler implements `reconcile.Reconciler`.
+var _ reconcile.Reconciler = &PatchReconciler{}
+
+// PatchReconciler suggests additions to installed profiles based on the
+// violations observed by the log enricher of the local node.
+type PatchReconciler struct {
+	impl
+	client         client.Client
+	log            logr.Logger
+	record         record.EventRecorder
+	controllerName string
+	schemeBuilder  *scheme.Builder
+	handler        patchHandler
+}
+
+// NewSeccompController returns a new controller instance for SeccompProfiles.
+func NewSeccompController() controller.Controller {
+	return &PatchReconciler{
+		impl:           &defaultImpl{},
+		controllerName: "seccomppatcher",
+		schemeBuilder:  seccompprofileapi.SchemeBuilder,
+		handler:        seccompHandler{},
+	}
+}
+
+// NewSelinuxController returns a new controller instance for SelinuxProfiles.
+func NewSelinuxController() controller.Controller {
+	return &PatchReconciler{
+		impl:           &defaultImpl{},
+		controllerName: "selinuxpatcher",
+		schemeBuilder:  selxv1alpha2.SchemeBuilder,
+		handler:        selinuxHandler{},
+	}
+}
+
+// NewAppArmorController returns a new controller instance for AppArmorProfiles.
+func NewAppArmorController() controller.Controller {
+	return &PatchReconciler{
+		impl:           &defaultImpl{},
+		controllerName: "apparmorpatcher",
+		schemeBuilder:  apparmorprofileapi.SchemeBuilder,
+		handler:        apparmorHandler{},
+	}
+}
+
+// Name returns the name of the controller.
+func (r *PatchReconciler) Name() string {
+	return r.controllerName + "-spod"
+}
+
+// SchemeBuilder returns the API scheme of the controller.
+func (r *PatchReconciler) SchemeBuilder() *scheme.Builder {
+	return r.schemeBuilder
+}
+
+// Setup is the initialization of the controller.
+func (r *PatchReconciler) Setup(
+	_ context.Context,
+	mgr ctrl.Manager,
+	_ *metrics.Metrics,
+) error {
+	r.log = ctrl.Log.WithName(r.Name())
+	r.client = r.ManagerGetClient(mgr)
+	r.record = r.ManagerGetEventRecorderFor(mgr, r.controllerName)
+
+	return r.NewControllerManagedBy(mgr, r.controllerName, r.handler.newProfile(), r)
+}
+
+// Healthz is the liveness probe endpoint of the controller.
+func (r *PatchReconciler) Healthz(*http.Request) error {
+	return nil
+}
+
+//nolint:lll // required for kubebuilder
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=clusterseccompprofiles,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=selinuxprofiles,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=apparmorprofiles,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profilebindings,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=clusterprofilebindings,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=securityprofilenodestatuses,verbs=get;update
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+
+// Reconcile updates the patch suggested by the local node with the observed
+// violations. Proposed specs get analyzed for their impact on the workloads
+// using the profile.
+func (r *PatchReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	logger := r.log.WithValues("profile", req.Name, "namespace", req.Namespace)
+
+	profile := r.handler.newProfile()
+	if err := r.ClientGet(ctx, r.client, req.NamespacedName, profile); err != nil {
+		if kerrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("getting profile: %w", err)
+	}
+
+	if !profile.GetDeletionTimestamp().IsZero() || !profilebaseapi.IsReconcilable(profile) {
+		return reconcile.Result{}, nil
+	}
+
+	observers, err := r.observers(ctx)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if handler, ok := r.handler.(impactHandler); ok {
+		if _, proposed := profile.GetAnnotations()[profilebaseapi.ProposedSpecAnnotation]; proposed {
+			if err := r.analyzeImpact(ctx, logger, handler, profile, observers); err != nil {
+				return reconcile.Result{}, err
+			}
+		}
+	}
+
+	if !observers.logEnricher {
+		return reconcile.Result{}, nil
+	}
+
+	if err := r.suggestPatch(ctx, logger, profile); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: violationsPollInterval}, nil
+}
+
+// observers returns whether the log enricher and the bpf recorder are
+// enabled, either by their environment variables or in the SPOD config.
+func (r *PatchReconciler) observers(ctx context.Context) (impactObservers, error) {
+	enableLogEnricherEnv, err := strconv.ParseBool(os.Getenv(config.EnableLogEnricherEnvKey))
+	logEnricher := err == nil && enableLogEnricherEnv
+	enableBpfRecorderEnv, err := strconv.ParseBool(os.Getenv(config.EnableBpfRecorderEnvKey))
+	bpfRecorder := err == nil && enableBpfRecorderEnv
+	if logEnricher && bpfRecorder {
+		return impactObservers{logEnricher: true, bpfRecorder: true}, nil
+	}
+
+	spod, err := r.GetSPOD(ctx, r.client)
+	if err != nil {
+		return impactObservers{}, fmt.Errorf("getting SPOD config: %w", err)
+	}
+	return impactObservers{
+		logEnricher: logEnricher || spod.Spec.EnableLogEnricher,
+		bpfRecorder: bpfRecorder || spod.Spec.EnableBpfRecorder,
+	}, nil
+}
+
+// suggestPatch updates the patch suggested by the local node with the
+// violations observed since the last poll. The patch is stored in the node
+// status of the profile, from where the manager aggregates the patches of
+// all nodes into the profile annotation.
+func (r *PatchReconciler) suggestPatch(
+	ctx context.Context, logger logr.Logger, profile profilebaseapi.SecurityProfileBase,
+) error {
+	nodeStatus := &statusv1alpha1.SecurityProfileNodeStatus{}
+	key := nodestatus.NamespacedName(profile, os.Getenv(config.NodeNameEnvKey))
+	if err := r.ClientGet(ctx, r.client, key, nodeStatus); err != nil {
+		if kerrors.IsNotFound(err) {
+			// The profile is not installed on this node
+			return nil
+		}
+		return fmt.Errorf("getting node status: %w", err)
+	}
+
+	violationsName, err := r.handler.violationsName(profile)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := r.DialEnricher()
+	if err != nil {
+		return fmt.Errorf("connecting to local GRPC server: %w", err)
+	}
+	defer cancel()
+	enricherClient := enricherapi.NewEnricherClient(conn)
+
+	request := &enricherapi.ViolationsRequest{
+		Kind:      r.handler.auditType(),
+		Namespace: profile.GetNamespace(),
+		Name:      violationsName,
+	}
+	response, err := r.Violations(ctx, enricherClient, request)
+	if status.Code(err) == codes.NotFound {
+		response = &enricherapi.ViolationsResponse{}
+	} else if err != nil {
+		return fmt.Errorf("retrieving violations: %w", err)
+	}
+
+	patch, err := r.handler.suggest(profile, response, logger)
+	if err != nil {
+		return fmt.Errorf("building patch: %w", err)
+	}
+
+	existing, err := ParsePatch(nodeStatus.SuggestedPatch)
+	if err != nil {
+		return fmt.Errorf("parsing suggested patch of node: %w", err)
+	}
+
+	// Drop the parts which got merged into the profile in the meantime
+	pending, err := r.handler.pending(profile, MergePatches(existing, patch))
+	if err != nil {
+		return fmt.Errorf("building patch: %w", err)
+	}
+
+	data, err := EncodePatch(pending)
+	if err != nil {
+		return err
+	}
+
+	if nodeStatus.SuggestedPatch != data {
+		nodeStatus.SuggestedPatch = data
+		if err := r.ClientUpdate(ctx, r.client, nodeStatus); err != nil {
+			return fmt.Errorf("updating suggested patch of node: %w", err)
+		}
+		logger.Info("Updated suggested patch of node from observed violations")
+	}
+
+	if len(response.GetSyscalls()) == 0 && len(response.GetAvc()) == 0 && len(response.GetApparmor()) == 0 {
+		return nil
+	}
+	if err := r.ResetViolations(ctx, enricherClient, request); err != nil {
+		return fmt.Errorf("resetting violations: %w", err)
+	}
+
+	return nil
+}
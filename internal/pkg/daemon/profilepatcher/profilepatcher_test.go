@@ -0,0 +1,330 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profilepatcher
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/containers/common/pkg/seccomp"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	enricherapi "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
+	profilebaseapi "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	statusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
+	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	spodapi "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/profilepatcher/profilepatcherfakes"
+)
+
+var errTest = errors.New("error")
+
+func TestName(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "seccomppatcher-spod", NewSeccompController().Name())
+	assert.Equal(t, "selinuxpatcher-spod", NewSelinuxController().Name())
+	assert.Equal(t, "apparmorpatcher-spod", NewAppArmorController().Name())
+}
+
+func TestSchemeBuilder(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, seccompprofileapi.SchemeBuilder, NewSeccompController().SchemeBuilder())
+	assert.Equal(t, selxv1alpha2.SchemeBuilder, NewSelinuxController().SchemeBuilder())
+	assert.Equal(t, apparmorprofileapi.SchemeBuilder, NewAppArmorController().SchemeBuilder())
+}
+
+func TestSetup(t *testing.T) {
+	t.Parallel()
+
+	mock := &profilepatcherfakes.FakeImpl{}
+	mock.NewControllerManagedByReturns(errTest)
+	sut := &PatchReconciler{impl: mock, controllerName: "test", handler: seccompHandler{}}
+
+	err := sut.Setup(context.Background(), nil, nil)
+	require.ErrorIs(t, err, errTest)
+	_, _, obj, _ := mock.NewControllerManagedByArgsForCall(0)
+	assert.IsType(t, &seccompprofileapi.SeccompProfile{}, obj)
+}
+
+// seccompProfileWithAnnotations returns a getter for a seccomp profile with
+// the provided annotations and its node status without a suggested patch.
+func seccompProfileWithAnnotations(annotations map[string]string) func(
+	context.Context, client.Client, client.ObjectKey, client.Object,
+) error {
+	return seccompProfileWith(annotations, "")
+}
+
+// seccompProfileWithNodePatch returns a getter for a seccomp profile and its
+// node status with the provided suggested patch.
+func seccompProfileWithNodePatch(nodePatch string) func(
+	context.Context, client.Client, client.ObjectKey, client.Object,
+) error {
+	return seccompProfileWith(nil, nodePatch)
+}
+
+func seccompProfileWith(annotations map[string]string, nodePatch string) func(
+	context.Context, client.Client, client.ObjectKey, client.Object,
+) error {
+	return func(_ context.Context, _ client.Client, key client.ObjectKey, obj client.Object) error {
+		switch o := obj.(type) {
+		case *seccompprofileapi.SeccompProfile:
+			o.SetName(key.Name)
+			o.SetNamespace(key.Namespace)
+			o.SetAnnotations(annotations)
+			o.Status.LocalhostProfile = "operator/" + key.Namespace + "/" + key.Name + ".json"
+			o.Spec.Syscalls = []*seccompprofileapi.Syscall{{
+				Names:  []string{"read"},
+				Action: seccomp.ActAllow,
+			}}
+		case *statusv1alpha1.SecurityProfileNodeStatus:
+			o.SetName(key.Name)
+			o.SetNamespace(key.Namespace)
+			o.SuggestedPatch = nodePatch
+		default:
+			return errTest
+		}
+		return nil
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	t.Parallel()
+
+	enricherEnabled := &spodapi.SecurityProfilesOperatorDaemon{
+		Spec: spodapi.SPODSpec{EnableLogEnricher: true},
+	}
+
+	for _, tc := range []struct {
+		name    string
+		prepare func(*profilepatcherfakes.FakeImpl)
+		assert  func(*profilepatcherfakes.FakeImpl, reconcile.Result, error)
+	}{
+		{
+			name: "profile not found",
+			prepare: func(mock *profilepatcherfakes.FakeImpl) {
+				mock.ClientGetReturns(kerrors.NewNotFound(schema.GroupResource{}, ""))
+			},
+			assert: func(mock *profilepatcherfakes.FakeImpl, res reconcile.Result, err error) {
+				require.NoError(t, err)
+				assert.Zero(t, res.RequeueAfter)
+				assert.Zero(t, mock.DialEnricherCallCount())
+			},
+		},
+		{
+			name: "get profile fails",
+			prepare: func(mock *profilepatcherfakes.FakeImpl) {
+				mock.ClientGetReturns(errTest)
+			},
+			assert: func(mock *profilepatcherfakes.FakeImpl, res reconcile.Result, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "log enricher not enabled",
+			prepare: func(mock *profilepatcherfakes.FakeImpl) {
+				mock.ClientGetCalls(seccompProfileWithNodePatch(""))
+				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{}, nil)
+			},
+			assert: func(mock *profilepatcherfakes.FakeImpl, res reconcile.Result, err error) {
+				require.NoError(t, err)
+				assert.Zero(t, res.RequeueAfter)
+				assert.Zero(t, mock.DialEnricherCallCount())
+			},
+		},
+		{
+			name: "profile not installed on node",
+			prepare: func(mock *profilepatcherfakes.FakeImpl) {
+				get := seccompProfileWithNodePatch("")
+				mock.ClientGetCalls(func(
+					ctx context.Context, c client.Client, key client.ObjectKey, obj client.Object,
+				) error {
+					if _, ok := obj.(*statusv1alpha1.SecurityProfileNodeStatus); ok {
+						return kerrors.NewNotFound(schema.GroupResource{}, key.Name)
+					}
+					return get(ctx, c, key, obj)
+				})
+				mock.GetSPODReturns(enricherEnabled, nil)
+			},
+			assert: func(mock *profilepatcherfakes.FakeImpl, res reconcile.Result, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, violationsPollInterval, res.RequeueAfter)
+				assert.Zero(t, mock.DialEnricherCallCount())
+			},
+		},
+		{
+			name: "no violations",
+			prepare: func(mock *profilepatcherfakes.FakeImpl) {
+				mock.ClientGetCalls(seccompProfileWithNodePatch(""))
+				mock.GetSPODReturns(enricherEnabled, nil)
+				mock.ViolationsReturns(nil, status.Error(codes.NotFound, "not found"))
+			},
+			assert: func(mock *profilepatcherfakes.FakeImpl, res reconcile.Result, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, violationsPollInterval, res.RequeueAfter)
+				assert.Zero(t, mock.ClientUpdateCallCount())
+				assert.Zero(t, mock.ResetViolationsCallCount())
+			},
+		},
+		{
+			name: "no violations and node patch got merged",
+			prepare: func(mock *profilepatcherfakes.FakeImpl) {
+				mock.ClientGetCalls(seccompProfileWithNodePatch(
+					`{"syscalls":[{"names":["read"],"action":"SCMP_ACT_ALLOW"}]}`,
+				))
+				mock.GetSPODReturns(enricherEnabled, nil)
+				mock.ViolationsReturns(nil, status.Error(codes.NotFound, "not found"))
+			},
+			assert: func(mock *profilepatcherfakes.FakeImpl, res reconcile.Result, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, mock.ClientUpdateCallCount())
+				_, _, obj := mock.ClientUpdateArgsForCall(0)
+				nodeStatus, ok := obj.(*statusv1alpha1.SecurityProfileNodeStatus)
+				require.True(t, ok)
+				assert.Empty(t, nodeStatus.SuggestedPatch)
+				assert.Zero(t, mock.ResetViolationsCallCount())
+			},
+		},
+		{
+			name: "violations fail",
+			prepare: func(mock *profilepatcherfakes.FakeImpl) {
+				mock.ClientGetCalls(seccompProfileWithNodePatch(""))
+				mock.GetSPODReturns(enricherEnabled, nil)
+				mock.ViolationsReturns(nil, errTest)
+			},
+			assert: func(mock *profilepatcherfakes.FakeImpl, res reconcile.Result, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+		{
+			name: "violations suggested",
+			prepare: func(mock *profilepatcherfakes.FakeImpl) {
+				mock.ClientGetCalls(seccompProfileWithNodePatch(
+					`{"syscalls":[{"names":["open"],"action":"SCMP_ACT_ALLOW"}]}`,
+				))
+				mock.GetSPODReturns(enricherEnabled, nil)
+				mock.ViolationsReturns(&enricherapi.ViolationsResponse{
+					Syscalls: []string{"read", "write"},
+				}, nil)
+			},
+			assert: func(mock *profilepatcherfakes.FakeImpl, res reconcile.Result, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, violationsPollInterval, res.RequeueAfter)
+
+				_, req := mockViolationsRequest(mock)
+				assert.Equal(t, "seccomp", req.GetKind())
+				assert.Equal(t, "default", req.GetNamespace())
+				assert.Equal(t, "profile", req.GetName())
+
+				require.Equal(t, 1, mock.ClientUpdateCallCount())
+				_, _, obj := mock.ClientUpdateArgsForCall(0)
+				nodeStatus, ok := obj.(*statusv1alpha1.SecurityProfileNodeStatus)
+				require.True(t, ok)
+				assert.JSONEq(t,
+					`{"syscalls":[{"names":["open","write"],"action":"SCMP_ACT_ALLOW"}]}`,
+					nodeStatus.SuggestedPatch,
+				)
+				assert.Empty(t, nodeStatus.GetAnnotations())
+				assert.Equal(t, 1, mock.ResetViolationsCallCount())
+			},
+		},
+		{
+			name: "violations already allowed",
+			prepare: func(mock *profilepatcherfakes.FakeImpl) {
+				mock.ClientGetCalls(seccompProfileWithNodePatch(""))
+				mock.GetSPODReturns(enricherEnabled, nil)
+				mock.ViolationsReturns(&enricherapi.ViolationsResponse{
+					Syscalls: []string{"read"},
+				}, nil)
+			},
+			assert: func(mock *profilepatcherfakes.FakeImpl, res reconcile.Result, err error) {
+				require.NoError(t, err)
+				assert.Zero(t, mock.ClientUpdateCallCount())
+				assert.Equal(t, 1, mock.ResetViolationsCallCount())
+			},
+		},
+		{
+			name: "update suggested patch fails",
+			prepare: func(mock *profilepatcherfakes.FakeImpl) {
+				mock.ClientGetCalls(seccompProfileWithNodePatch(""))
+				mock.GetSPODReturns(enricherEnabled, nil)
+				mock.ViolationsReturns(&enricherapi.ViolationsResponse{
+					Syscalls: []string{"write"},
+				}, nil)
+				mock.ClientUpdateReturns(errTest)
+			},
+			assert: func(mock *profilepatcherfakes.FakeImpl, res reconcile.Result, err error) {
+				require.ErrorIs(t, err, errTest)
+				assert.Zero(t, mock.ResetViolationsCallCount())
+			},
+		},
+		{
+			name: "approved patch is left to the manager",
+			prepare: func(mock *profilepatcherfakes.FakeImpl) {
+				mock.ClientGetCalls(seccompProfileWithAnnotations(map[string]string{
+					profilebaseapi.SuggestedPatchAnnotation: `{"syscalls":[{"names":["open"],"action":"SCMP_ACT_ALLOW"}]}`,
+					profilebaseapi.ApprovePatchAnnotation:   "true",
+				}))
+				mock.GetSPODReturns(enricherEnabled, nil)
+				mock.ViolationsReturns(nil, status.Error(codes.NotFound, "not found"))
+			},
+			assert: func(mock *profilepatcherfakes.FakeImpl, res reconcile.Result, err error) {
+				require.NoError(t, err)
+				assert.Zero(t, mock.ClientUpdateCallCount())
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &profilepatcherfakes.FakeImpl{}
+			mock.DialEnricherReturns(nil, func() {}, nil)
+			tc.prepare(mock)
+
+			sut := &PatchReconciler{
+				impl:    mock,
+				log:     logr.Discard(),
+				record:  record.NewFakeRecorder(10),
+				handler: seccompHandler{},
+			}
+			res, err := sut.Reconcile(context.Background(), reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: "default", Name: "profile"},
+			})
+			tc.assert(mock, res, err)
+		})
+	}
+}
+
+func mockViolationsRequest(
+	mock *profilepatcherfakes.FakeImpl,
+) (enricherapi.EnricherClient, *enricherapi.ViolationsRequest) {
+	_, c, req := mock.ViolationsArgsForCall(0)
+	return c, req
+}
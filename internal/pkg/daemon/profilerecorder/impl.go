@@ -56,8 +56,8 @@ type impl interface {
 	GetPod(context.Context, client.Client, client.ObjectKey) (*corev1.Pod, error)
 	GetSPOD(context.Context, client.Client) (*spodapi.SecurityProfilesOperatorDaemon, error)
 	DialBpfRecorder() (*grpc.ClientConn, context.CancelFunc, error)
-	StartBpfRecorder(context.Context, bpfrecorderapi.BpfRecorderClient) error
-	StopBpfRecorder(context.Context, bpfrecorderapi.BpfRecorderClient) error
+	StartBpfRecorder(context.Context, bpfrecorderapi.BpfRecorderClient, *bpfrecorderapi.StartRequest) error
+	StopBpfRecorder(context.Context, bpfrecorderapi.BpfRecorderClient, *bpfrecorderapi.StopRequest) error
 	SyscallsForProfile(
 		context.Context,
 		bpfrecorderapi.BpfRecorderClient,
@@ -141,16 +141,16 @@ func (*defaultImpl) DialBpfRecorder() (*grpc.ClientConn, context.CancelFunc, err
 }
 
 func (*defaultImpl) StartBpfRecorder(
-	ctx context.Context, c bpfrecorderapi.BpfRecorderClient,
+	ctx context.Context, c bpfrecorderapi.BpfRecorderClient, req *bpfrecorderapi.StartRequest,
 ) error {
-	_, err := c.Start(ctx, &bpfrecorderapi.EmptyRequest{})
+	_, err := c.Start(ctx, req)
 	return err
 }
 
 func (*defaultImpl) StopBpfRecorder(
-	ctx context.Context, c bpfrecorderapi.BpfRecorderClient,
+	ctx context.Context, c bpfrecorderapi.BpfRecorderClient, req *bpfrecorderapi.StopRequest,
 ) error {
-	_, err := c.Stop(ctx, &bpfrecorderapi.EmptyRequest{})
+	_, err := c.Stop(ctx, req)
 	return err
 }
 
@@ -22,7 +22,6 @@ import (
 	"fmt"
 	"net/http"
 	"os"
-	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -37,7 +36,6 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -58,6 +56,7 @@ import (
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/bpfrecorder"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/translator"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/webhooks/utils"
 )
@@ -72,8 +71,6 @@ const (
 	reasonProfileCreated        string = "ProfileCreated"
 	reasonProfileCreationFailed string = "CannotCreateProfile"
 	reasonAnnotationParsing     string = "AnnotationParsing"
-
-	seContextRequiredParts = 3
 )
 
 var errNameNotValid = errors.New("recording name is not valid DNS1123 subdomain, check profileRecording events")
@@ -103,6 +100,15 @@ type podToWatch struct {
 	baseName types.NamespacedName
 	recorder profilerecording1alpha1.ProfileRecorder
 	profiles []profileToCollect
+	// recording identifies the recording of the pod within the bpf recorder.
+	recording string
+	uid       types.UID
+	// collected are the containers whose profiles got already collected
+	// because they terminated before the pod finished.
+	collected map[string]bool
+	// ephemeralStarts is the number of additional start requests sent to the
+	// bpf recorder for ephemeral containers, which need to be stopped as well.
+	ephemeralStarts int
 }
 
 // Name returns the name of the controller.
@@ -256,8 +262,9 @@ func (r *RecorderReconciler) Reconcile(ctx context.Context, req reconcile.Reques
 		}
 
 		var (
-			profiles []profileToCollect
-			recorder profilerecording1alpha1.ProfileRecorder
+			profiles  []profileToCollect
+			recorder  profilerecording1alpha1.ProfileRecorder
+			recording string
 		)
 
 		//nolint:gocritic // should be intentionally no switch
@@ -265,7 +272,8 @@ func (r *RecorderReconciler) Reconcile(ctx context.Context, req reconcile.Reques
 			profiles = logProfiles
 			recorder = profilerecording1alpha1.ProfileRecorderLogs
 		} else if len(bpfProfiles) > 0 {
-			if err := r.startBpfRecorder(ctx); err != nil {
+			recording = bpfRecordingName(req.Namespace, bpfProfiles)
+			if err := r.startBpfRecorder(ctx, recording, bpfProfiles); err != nil {
 				logger.Error(err, "unable to start bpf recorder")
 				return reconcile.Result{}, err
 			}
@@ -289,25 +297,98 @@ func (r *RecorderReconciler) Reconcile(ctx context.Context, req reconcile.Reques
 
 		r.podsToWatch.Store(
 			req.NamespacedName.String(),
-			podToWatch{baseName, recorder, profiles},
+			podToWatch{baseName, recorder, profiles, recording, pod.UID, map[string]bool{}, 0},
 		)
 		r.record.Event(pod, util.EventTypeNormal, reasonProfileRecording, "Recording profiles")
 	}
 
-	if pod.Status.Phase == corev1.PodSucceeded {
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
 		collErr := r.collectProfile(ctx, req.NamespacedName)
 		if errors.Is(collErr, errNameNotValid) {
 			logger.Error(collErr, "cannot collect profile")
 			// not reconcilable, no need to requeue
 			return reconcile.Result{}, nil
 		} else if collErr != nil {
-			return reconcile.Result{}, fmt.Errorf("collect profile for succeeded pod: %w", err)
+			return reconcile.Result{}, fmt.Errorf("collect profile for finished pod: %w", collErr)
+		}
+		return reconcile.Result{}, nil
+	}
+
+	if pod.Status.Phase == corev1.PodRunning {
+		if err := r.recordEphemeralContainers(ctx, pod, req.NamespacedName); err != nil {
+			return reconcile.Result{}, fmt.Errorf("record ephemeral containers: %w", err)
+		}
+
+		collErr := r.collectTerminatedContainers(ctx, pod, req.NamespacedName)
+		if errors.Is(collErr, errNameNotValid) {
+			logger.Error(collErr, "cannot collect profile")
+			return reconcile.Result{}, nil
+		} else if collErr != nil {
+			return reconcile.Result{}, fmt.Errorf("collect profile for terminated containers: %w", collErr)
+		}
+
+		requeueAfter, collErr := r.collectClosedWindow(ctx, req.NamespacedName)
+		if errors.Is(collErr, errNameNotValid) {
+			logger.Error(collErr, "cannot collect profile")
+			return reconcile.Result{}, nil
+		} else if collErr != nil {
+			return reconcile.Result{}, fmt.Errorf("collect profile for closed recording window: %w", collErr)
 		}
+		return reconcile.Result{RequeueAfter: requeueAfter}, nil
 	}
 
 	return reconcile.Result{}, nil
 }
 
+// collectClosedWindow collects the profiles of a running pod once the time
+// window of its recording closed. It returns the duration after which the
+// window closes if it is still open.
+func (r *RecorderReconciler) collectClosedWindow(
+	ctx context.Context, podName types.NamespacedName,
+) (time.Duration, error) {
+	value, ok := r.podsToWatch.Load(podName.String())
+	if !ok {
+		return 0, nil
+	}
+
+	podToWatch, ok := value.(podToWatch)
+	if !ok {
+		return 0, errors.New("type assert pod to watch")
+	}
+
+	if len(podToWatch.profiles) == 0 {
+		return 0, nil
+	}
+
+	parsed, err := parseProfileAnnotation(podToWatch.profiles[0].name)
+	if err != nil {
+		return 0, fmt.Errorf("parse profile annotation: %w", err)
+	}
+
+	recording, err := r.GetRecording(ctx, r.client, types.NamespacedName{
+		Name:      parsed.profileName,
+		Namespace: podName.Namespace,
+	})
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("get recording: %w", err)
+	}
+
+	_, end := recording.RecordingWindow()
+	if end.IsZero() {
+		return 0, nil
+	}
+
+	if remaining := time.Until(end); remaining > 0 {
+		return remaining, nil
+	}
+
+	r.log.Info("Recording window closed, collecting profiles", "pod", podName.String())
+	return 0, r.collectProfile(ctx, podName)
+}
+
 func (r *RecorderReconciler) getBpfRecorderClient(
 	ctx context.Context,
 ) (bpfrecorderapi.BpfRecorderClient, context.CancelFunc, error) {
@@ -336,20 +417,27 @@ func (r *RecorderReconciler) getBpfRecorderClient(
 	return bpfRecorderClient, cancel, nil
 }
 
-func (r *RecorderReconciler) startBpfRecorder(ctx context.Context) error {
+func (r *RecorderReconciler) startBpfRecorder(
+	ctx context.Context, recording string, profiles []profileToCollect,
+) error {
 	recorderClient, cancel, err := r.getBpfRecorderClient(ctx)
 	if err != nil {
 		return fmt.Errorf("get bpf recorder client: %w", err)
 	}
 	defer cancel()
 
+	req := &bpfrecorderapi.StartRequest{Recording: recording}
+	for _, profile := range profiles {
+		req.Profiles = append(req.Profiles, profile.name)
+	}
+
 	ctx, cancel = context.WithTimeout(ctx, reconcileTimeout)
 	defer cancel()
-	r.log.Info("Starting BPF recorder on node")
-	return r.StartBpfRecorder(ctx, recorderClient)
+	r.log.Info("Starting BPF recorder on node", "recording", recording)
+	return r.StartBpfRecorder(ctx, recorderClient, req)
 }
 
-func (r *RecorderReconciler) stopBpfRecorder(ctx context.Context) error {
+func (r *RecorderReconciler) stopBpfRecorder(ctx context.Context, recording string) error {
 	recorderClient, cancel1, err := r.getBpfRecorderClient(ctx)
 	if err != nil {
 		return fmt.Errorf("get bpf recorder client: %w", err)
@@ -358,8 +446,19 @@ func (r *RecorderReconciler) stopBpfRecorder(ctx context.Context) error {
 
 	ctx, cancel2 := context.WithTimeout(ctx, reconcileTimeout)
 	defer cancel2()
-	r.log.Info("Stopping BPF recorder on node")
-	return r.StopBpfRecorder(ctx, recorderClient)
+	r.log.Info("Stopping BPF recorder on node", "recording", recording)
+	return r.StopBpfRecorder(ctx, recorderClient, &bpfrecorderapi.StopRequest{Recording: recording})
+}
+
+// bpfRecordingName returns the name of the recording the bpf recorder tracks
+// the profiles of a pod for, which is the namespaced name of the
+// ProfileRecording.
+func bpfRecordingName(namespace string, profiles []profileToCollect) string {
+	name := profiles[0].name
+	if parsed, err := parseProfileAnnotation(name); err == nil {
+		name = parsed.profileName
+	}
+	return types.NamespacedName{Namespace: namespace, Name: name}.String()
 }
 
 func (r *RecorderReconciler) collectProfile(
@@ -377,36 +476,239 @@ func (r *RecorderReconciler) collectProfile(
 		return errors.New("type assert pod to watch")
 	}
 
+	profiles := []profileToCollect{}
+	for _, prf := range podToWatch.profiles {
+		if !podToWatch.collected[prf.cntName()] {
+			profiles = append(profiles, prf)
+		}
+	}
+
+	if err := r.collectProfiles(ctx, &podToWatch, podName, profiles); err != nil {
+		return err
+	}
+
+	if podToWatch.recorder == profilerecording1alpha1.ProfileRecorderBpf {
+		for i := 0; i <= podToWatch.ephemeralStarts; i++ {
+			if err := r.stopBpfRecorder(ctx, podToWatch.recording); err != nil {
+				r.log.Error(err, "Unable to stop bpf recorder")
+				return fmt.Errorf("stop bpf recorder: %w", err)
+			}
+		}
+	}
+
+	r.podsToWatch.Delete(n)
+	return nil
+}
+
+// recordEphemeralContainers adds the profiles of the ephemeral containers,
+// which got added to the running pod, to its recording in the bpf recorder.
+// Ephemeral containers cannot be annotated, which is why their profiles get
+// derived from the other containers. This is only supported by the bpf
+// recorder, because the log based recorder requires the ephemeral containers
+// to run with a logging seccomp or SELinux profile.
+func (r *RecorderReconciler) recordEphemeralContainers(
+	ctx context.Context, pod *corev1.Pod, podName types.NamespacedName,
+) error {
+	n := podName.String()
+
+	value, ok := r.podsToWatch.Load(n)
+	if !ok {
+		return nil
+	}
+
+	podToWatch, ok := value.(podToWatch)
+	if !ok {
+		return errors.New("type assert pod to watch")
+	}
+
+	if podToWatch.recorder != profilerecording1alpha1.ProfileRecorderBpf {
+		return nil
+	}
+
+	added := []profileToCollect{}
+	for i := range pod.Spec.EphemeralContainers {
+		name := pod.Spec.EphemeralContainers[i].Name
+		if podToWatch.hasContainer(name) {
+			continue
+		}
+		value, ok := util.EphemeralRecordingAnnotation(
+			pod.Annotations, config.SeccompProfileRecordBpfAnnotationKey, name,
+		)
+		if !ok {
+			continue
+		}
+		added = append(added, profileToCollect{
+			kind: profilerecording1alpha1.ProfileRecordingKindSeccompProfile,
+			name: value,
+		})
+	}
+	if len(added) == 0 {
+		return nil
+	}
+
+	if err := r.startBpfRecorder(ctx, podToWatch.recording, added); err != nil {
+		return fmt.Errorf("start bpf recorder: %w", err)
+	}
+
+	podToWatch.profiles = append(podToWatch.profiles, added...)
+	podToWatch.ephemeralStarts++
+	r.podsToWatch.Store(n, podToWatch)
+	return nil
+}
+
+// collectTerminatedContainers collects the profiles of the containers which
+// terminated and will not be restarted, like init containers, ephemeral
+// containers or the containers of a Job, without waiting for the pod to
+// finish.
+func (r *RecorderReconciler) collectTerminatedContainers(
+	ctx context.Context, pod *corev1.Pod, podName types.NamespacedName,
+) error {
+	n := podName.String()
+
+	value, ok := r.podsToWatch.Load(n)
+	if !ok {
+		return nil
+	}
+
+	podToWatch, ok := value.(podToWatch)
+	if !ok {
+		return errors.New("type assert pod to watch")
+	}
+
+	terminated := terminatedContainers(pod)
+	if len(terminated) == 0 {
+		return nil
+	}
+
+	profiles := []profileToCollect{}
+	for _, prf := range podToWatch.profiles {
+		cntName := prf.cntName()
+		if terminated[cntName] && !podToWatch.collected[cntName] {
+			profiles = append(profiles, prf)
+		}
+	}
+	if len(profiles) == 0 {
+		return nil
+	}
+
+	if err := r.collectProfiles(ctx, &podToWatch, podName, profiles); err != nil {
+		return err
+	}
+
+	for _, prf := range profiles {
+		podToWatch.collected[prf.cntName()] = true
+	}
+	r.podsToWatch.Store(n, podToWatch)
+	return nil
+}
+
+// collectProfiles collects the provided profiles of the pod.
+func (r *RecorderReconciler) collectProfiles(
+	ctx context.Context, pod *podToWatch, podName types.NamespacedName, profiles []profileToCollect,
+) error {
+	if len(profiles) == 0 {
+		return nil
+	}
+
 	replicaSuffix := ""
-	if podToWatch.baseName.Name != podName.Name && strings.HasPrefix(podName.Name, podToWatch.baseName.Name) {
+	if pod.baseName.Name != podName.Name && strings.HasPrefix(podName.Name, pod.baseName.Name) {
 		// this is a replica, we need to strip the suffix from the pod name
-		replicaSuffix = strings.TrimPrefix(podName.Name, podToWatch.baseName.Name)
+		replicaSuffix = strings.TrimPrefix(podName.Name, pod.baseName.Name)
 	}
 
-	if podToWatch.recorder == profilerecording1alpha1.ProfileRecorderLogs {
+	if pod.recorder == profilerecording1alpha1.ProfileRecorderLogs {
 		if err := r.collectLogProfiles(
-			ctx, replicaSuffix, podName, podToWatch.profiles,
+			ctx, replicaSuffix, podName, pod.uid, profiles,
 		); err != nil {
 			return fmt.Errorf("collect log profile: %w", err)
 		}
 	}
 
-	if podToWatch.recorder == profilerecording1alpha1.ProfileRecorderBpf {
+	if pod.recorder == profilerecording1alpha1.ProfileRecorderBpf {
 		if err := r.collectBpfProfiles(
-			ctx, replicaSuffix, podName, podToWatch.profiles,
+			ctx, replicaSuffix, podName, pod.uid, profiles,
 		); err != nil {
 			return fmt.Errorf("collect bpf profile: %w", err)
 		}
 	}
 
-	r.podsToWatch.Delete(n)
 	return nil
 }
 
+// hasContainer returns true if a profile of the container gets recorded.
+func (p *podToWatch) hasContainer(name string) bool {
+	for _, prf := range p.profiles {
+		if prf.cntName() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// cntName returns the container name of the profile annotation, or an empty
+// string if the annotation is invalid.
+func (p *profileToCollect) cntName() string {
+	parsed, err := parseProfileAnnotation(p.name)
+	if err != nil {
+		return ""
+	}
+	return parsed.cntName
+}
+
+// terminatedContainers returns the names of the containers which terminated
+// and will not be restarted anymore.
+func terminatedContainers(pod *corev1.Pod) map[string]bool {
+	res := map[string]bool{}
+
+	sidecars := map[string]bool{}
+	for i := range pod.Spec.InitContainers {
+		ctr := &pod.Spec.InitContainers[i]
+		if ctr.RestartPolicy != nil && *ctr.RestartPolicy == corev1.ContainerRestartPolicyAlways {
+			sidecars[ctr.Name] = true
+		}
+	}
+
+	for i := range pod.Status.InitContainerStatuses {
+		status := &pod.Status.InitContainerStatuses[i]
+		// Init containers only run again on failure, sidecars get restarted.
+		if !sidecars[status.Name] && status.State.Terminated != nil &&
+			(status.State.Terminated.ExitCode == 0 || pod.Spec.RestartPolicy == corev1.RestartPolicyNever) {
+			res[status.Name] = true
+		}
+	}
+
+	for i := range pod.Status.ContainerStatuses {
+		status := &pod.Status.ContainerStatuses[i]
+		if status.State.Terminated == nil {
+			continue
+		}
+		switch pod.Spec.RestartPolicy {
+		case corev1.RestartPolicyNever:
+			res[status.Name] = true
+		case corev1.RestartPolicyOnFailure:
+			if status.State.Terminated.ExitCode == 0 {
+				res[status.Name] = true
+			}
+		case corev1.RestartPolicyAlways:
+		}
+	}
+
+	// Ephemeral containers are never restarted.
+	for i := range pod.Status.EphemeralContainerStatuses {
+		status := &pod.Status.EphemeralContainerStatuses[i]
+		if status.State.Terminated != nil {
+			res[status.Name] = true
+		}
+	}
+
+	return res
+}
+
 func (r *RecorderReconciler) collectLogProfiles(
 	ctx context.Context,
 	replicaSuffix string,
 	podName types.NamespacedName,
+	podUID types.UID,
 	profiles []profileToCollect,
 ) error {
 	r.log.Info("Checking if enricher is enabled")
@@ -446,9 +748,13 @@ func (r *RecorderReconciler) collectLogProfiles(
 
 		switch prf.kind {
 		case profilerecording1alpha1.ProfileRecordingKindSeccompProfile:
-			err = r.collectLogSeccompProfile(ctx, enricherClient, parsedProfileAnnotation, profileNamespacedName, prf.name)
+			err = r.collectLogSeccompProfile(
+				ctx, enricherClient, parsedProfileAnnotation, profileNamespacedName, podUID, prf.name,
+			)
 		case profilerecording1alpha1.ProfileRecordingKindSelinuxProfile:
-			err = r.collectLogSelinuxProfile(ctx, enricherClient, parsedProfileAnnotation, profileNamespacedName, prf.name)
+			err = r.collectLogSelinuxProfile(
+				ctx, enricherClient, parsedProfileAnnotation, profileNamespacedName, podUID, prf.name,
+			)
 		default:
 			err = fmt.Errorf("unrecognized kind %s", prf.kind)
 		}
@@ -466,6 +772,7 @@ func (r *RecorderReconciler) collectLogSeccompProfile(
 	enricherClient enricherapi.EnricherClient,
 	parsedProfileName *parsedAnnotation,
 	profileNamespacedName types.NamespacedName,
+	podUID types.UID,
 	profileID string,
 ) error {
 	labels, err := profileLabels(
@@ -473,7 +780,8 @@ func (r *RecorderReconciler) collectLogSeccompProfile(
 		r,
 		parsedProfileName.profileName,
 		parsedProfileName.cntName,
-		profileNamespacedName.Namespace)
+		profileNamespacedName.Namespace,
+		podUID)
 	if err != nil {
 		return fmt.Errorf("creating profile labels: %w", err)
 	}
@@ -560,6 +868,7 @@ func (r *RecorderReconciler) collectLogSelinuxProfile(
 	enricherClient enricherapi.EnricherClient,
 	parsedProfileName *parsedAnnotation,
 	profileNamespacedName types.NamespacedName,
+	podUID types.UID,
 	profileID string,
 ) error {
 	labels, err := profileLabels(
@@ -567,7 +876,8 @@ func (r *RecorderReconciler) collectLogSelinuxProfile(
 		r,
 		parsedProfileName.profileName,
 		parsedProfileName.cntName,
-		profileNamespacedName.Namespace)
+		profileNamespacedName.Namespace,
+		podUID)
 	if err != nil {
 		return fmt.Errorf("creating profile labels: %w", err)
 	}
@@ -613,7 +923,7 @@ func (r *RecorderReconciler) collectLogSelinuxProfile(
 		Spec: selinuxProfileSpec,
 	}
 
-	selinuxProfileSpec.Allow, err = r.formatSelinuxProfile(profile, response)
+	selinuxProfileSpec.Allow, err = r.formatSelinuxProfile(response)
 	if err != nil {
 		r.log.Error(err, "Cannot format selinuxprofile")
 		r.record.Event(profile, util.EventTypeWarning, reasonProfileCreationFailed, err.Error())
@@ -652,27 +962,18 @@ func (r *RecorderReconciler) collectLogSelinuxProfile(
 }
 
 func (r *RecorderReconciler) formatSelinuxProfile(
-	selinuxprofile *selxv1alpha2.SelinuxProfile,
 	avcResponse *enricherapi.AvcResponse,
 ) (selxv1alpha2.Allow, error) {
-	seBuilder := newSeProfileBuilder(selinuxprofile.GetPolicyUsage(), r.log)
-
-	if err := seBuilder.AddAvcList(avcResponse.GetAvc()); err != nil {
-		return nil, fmt.Errorf("consuming AVCs: %w", err)
-	}
-
-	sePol, err := seBuilder.Format()
-	if err != nil {
-		return nil, fmt.Errorf("building policy: %w", err)
-	}
-
-	return sePol, nil
+	// The recorded workload runs in the recording profile, which is always
+	// rewritten to reference the profile itself.
+	return translator.Avcs2Allow(avcResponse.GetAvc(), "", r.log)
 }
 
 func (r *RecorderReconciler) collectBpfProfiles(
 	ctx context.Context,
 	replicaSuffix string,
 	podName types.NamespacedName,
+	podUID types.UID,
 	profiles []profileToCollect,
 ) error {
 	recorderClient, cancel, err := r.getBpfRecorderClient(ctx)
@@ -696,7 +997,8 @@ func (r *RecorderReconciler) collectBpfProfiles(
 			r,
 			parsedProfileName.profileName,
 			parsedProfileName.cntName,
-			profileNamespacedName.Namespace)
+			profileNamespacedName.Namespace,
+			podUID)
 		if err != nil {
 			return fmt.Errorf("creating profile labels: %w", err)
 		}
@@ -771,10 +1073,6 @@ func (r *RecorderReconciler) collectBpfProfiles(
 		r.record.Event(profile, util.EventTypeNormal, reasonProfileCreated, "seccomp profile created")
 	}
 
-	if err := r.stopBpfRecorder(ctx); err != nil {
-		r.log.Error(err, "Unable to stop bpf recorder")
-		return fmt.Errorf("stop bpf recorder: %w", err)
-	}
 	return nil
 }
 
@@ -869,109 +1167,6 @@ func parseBpfAnnotations(annotations map[string]string) (res []profileToCollect,
 	return res, nil
 }
 
-type seProfileBuilder struct {
-	permMap       map[string]sets.Set[string]
-	usageCtx      string
-	policyBuilder selxv1alpha2.Allow
-	log           logr.Logger
-	// used to optimize sorting
-	keys []string
-}
-
-func newSeProfileBuilder(usageCtx string, log logr.Logger) *seProfileBuilder {
-	return &seProfileBuilder{
-		permMap:       make(map[string]sets.Set[string]),
-		usageCtx:      usageCtx,
-		policyBuilder: make(selxv1alpha2.Allow),
-		log:           log,
-		keys:          make([]string, 0),
-	}
-}
-
-func (sb *seProfileBuilder) AddAvcList(avcs []*enricherapi.AvcResponse_SelinuxAvc) error {
-	for _, avc := range avcs {
-		sb.log.Info("Received an AVC response",
-			"perm", avc.Perm, "tclass",
-			avc.Tclass, "scontext", avc.Scontext,
-			"tcontext", avc.Tcontext)
-
-		if err := sb.addAvc(avc); err != nil {
-			return fmt.Errorf("adding AVC: %w", err)
-		}
-	}
-
-	return nil
-}
-
-func (sb *seProfileBuilder) addAvc(avc *enricherapi.AvcResponse_SelinuxAvc) error {
-	ctxType, err := ctxt2type(avc.Tcontext)
-	if err != nil {
-		return fmt.Errorf("converting context to type: %w", err)
-	}
-
-	key := avc.Tclass + " " + ctxType
-	sb.keys = append(sb.keys, key)
-
-	perms, ok := sb.permMap[key]
-	if ok {
-		perms.Insert(avc.Perm)
-	} else {
-		sb.permMap[key] = sets.New(avc.Perm)
-	}
-	return nil
-}
-
-func (sb *seProfileBuilder) Format() (selxv1alpha2.Allow, error) {
-	sort.Strings(sb.keys)
-	for _, key := range sb.keys {
-		val := sb.permMap[key]
-		if err := sb.writeLineFromKeyVal(key, val); err != nil {
-			return nil, fmt.Errorf("writing policy line from key-value pair: %w", err)
-		}
-	}
-
-	return sb.policyBuilder, nil
-}
-
-func (sb *seProfileBuilder) writeLineFromKeyVal(key string, val sets.Set[string]) error {
-	tclass, setype := sb.targetClassCtx(key)
-	if tclass == "" || setype == "" {
-		return errors.New("empty context or class")
-	}
-
-	// If we haven't parsed the type, ensure we have space for it
-	_, haveType := sb.policyBuilder[selxv1alpha2.LabelKey(setype)]
-	if !haveType {
-		sb.policyBuilder[selxv1alpha2.LabelKey(setype)] = make(map[selxv1alpha2.ObjectClassKey]selxv1alpha2.PermissionSet)
-	}
-
-	typePerms := sb.policyBuilder[selxv1alpha2.LabelKey(setype)]
-	l := val.UnsortedList()
-	sort.Strings(l)
-	typePerms[selxv1alpha2.ObjectClassKey(tclass)] = selxv1alpha2.PermissionSet(l)
-	return nil
-}
-
-func (sb *seProfileBuilder) targetClassCtx(key string) (tclass, tcontext string) {
-	splitkey := strings.Split(key, " ")
-	tclass = splitkey[0]
-	tcontext = splitkey[1]
-	if tcontext == config.SelinuxPermissiveProfile {
-		// rewrite the context to reference itself.
-		// We replace this when writing the policy.
-		tcontext = selxv1alpha2.AllowSelf
-	}
-	return
-}
-
-func ctxt2type(ctx string) (string, error) {
-	elems := strings.Split(ctx, ":")
-	if len(elems) < seContextRequiredParts {
-		return "", errors.New("malformed SELinux context")
-	}
-	return elems[2], nil
-}
-
 func (r *RecorderReconciler) goArchToSeccompArch(goarch string) (seccompprofileapi.Arch, error) {
 	seccompArch, err := r.GoArchToSeccompArch(goarch)
 	if err != nil {
@@ -994,18 +1189,11 @@ func profilePartial(
 		return false, err
 	}
 
-	var profilePartial bool
-	switch recorder.Spec.MergeStrategy {
-	case profilerecording1alpha1.ProfileMergeNone:
-		profilePartial = false
-	case profilerecording1alpha1.ProfileMergeContainers:
-		profilePartial = true
-	}
-	return profilePartial, nil
+	return recorder.Spec.MergeStrategy.IsMerging(), nil
 }
 
 func profileLabels(
-	ctx context.Context, r *RecorderReconciler, recordingName, cntName, namespace string,
+	ctx context.Context, r *RecorderReconciler, recordingName, cntName, namespace string, podUID types.UID,
 ) (map[string]string, error) {
 	errs := validation.IsDNS1123Label(recordingName)
 	if len(errs) > 0 {
@@ -1016,6 +1204,9 @@ func profileLabels(
 		profilerecording1alpha1.ProfileToRecordingLabel: recordingName,
 		profilerecording1alpha1.ProfileToContainerLabel: cntName,
 	}
+	if podUID != "" {
+		labels[profilerecording1alpha1.ProfileToPodUIDLabel] = string(podUID)
+	}
 
 	partial, err := profilePartial(ctx, r, recordingName, namespace)
 	if err != nil {
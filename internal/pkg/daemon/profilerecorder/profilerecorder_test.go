@@ -1204,6 +1204,176 @@ func TestReconcile(t *testing.T) {
 	}
 }
 
+func TestReconcileBpfRecording(t *testing.T) {
+	t.Parallel()
+
+	testRequest := reconcile.Request{
+		NamespacedName: types.NamespacedName{
+			Namespace: "namespace",
+			Name:      "name",
+		},
+	}
+	profileA := fmt.Sprintf("recording_a_4bbwm_%d", time.Now().Unix())
+	profileB := fmt.Sprintf("recording_b_4bbwm_%d", time.Now().Unix())
+
+	mock := &profilerecorderfakes.FakeImpl{}
+	sut := &RecorderReconciler{
+		impl:   mock,
+		log:    logr.Discard(),
+		record: record.NewFakeRecorder(10),
+	}
+	pod := &corev1.Pod{
+		Status: corev1.PodStatus{Phase: corev1.PodPending},
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				config.SeccompProfileRecordBpfAnnotationKey + "a": profileA,
+				config.SeccompProfileRecordBpfAnnotationKey + "b": profileB,
+			},
+		},
+	}
+	mock.GetPodReturns(pod, nil)
+	mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+		Spec: spodapi.SPODSpec{EnableBpfRecorder: true},
+	}, nil)
+	mock.DialBpfRecorderReturns(nil, func() {}, nil)
+	mock.SyscallsForProfileReturns(nil, bpfrecorder.ErrNotFound)
+
+	_, err := sut.Reconcile(context.Background(), testRequest)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, mock.StartBpfRecorderCallCount())
+	_, _, startReq := mock.StartBpfRecorderArgsForCall(0)
+	assert.Equal(t, "namespace/recording", startReq.Recording)
+	assert.ElementsMatch(t, []string{profileA, profileB}, startReq.Profiles)
+
+	pod.Status.Phase = corev1.PodSucceeded
+	_, err = sut.Reconcile(context.Background(), testRequest)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, mock.StopBpfRecorderCallCount())
+	_, _, stopReq := mock.StopBpfRecorderArgsForCall(0)
+	assert.Equal(t, "namespace/recording", stopReq.Recording)
+}
+
+func TestReconcileBpfRecordingEphemeralContainer(t *testing.T) {
+	t.Parallel()
+
+	testRequest := reconcile.Request{
+		NamespacedName: types.NamespacedName{
+			Namespace: "namespace",
+			Name:      "name",
+		},
+	}
+	now := time.Now().Unix()
+	profileA := fmt.Sprintf("recording_a_4bbwm_%d", now)
+	profileDebug := fmt.Sprintf("recording_debug_4bbwm_%d", now)
+
+	mock := &profilerecorderfakes.FakeImpl{}
+	sut := &RecorderReconciler{
+		impl:   mock,
+		log:    logr.Discard(),
+		record: record.NewFakeRecorder(10),
+	}
+	pod := &corev1.Pod{
+		Status: corev1.PodStatus{Phase: corev1.PodPending},
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				config.SeccompProfileRecordBpfAnnotationKey + "a": profileA,
+			},
+		},
+	}
+	mock.GetPodReturns(pod, nil)
+	mock.GetRecordingReturns(&recordingapi.ProfileRecording{}, nil)
+	mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+		Spec: spodapi.SPODSpec{EnableBpfRecorder: true},
+	}, nil)
+	mock.DialBpfRecorderReturns(nil, func() {}, nil)
+	mock.SyscallsForProfileReturns(nil, bpfrecorder.ErrNotFound)
+
+	_, err := sut.Reconcile(context.Background(), testRequest)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, mock.StartBpfRecorderCallCount())
+
+	// An ephemeral container got added to the running pod
+	pod.Status.Phase = corev1.PodRunning
+	pod.Spec.EphemeralContainers = []corev1.EphemeralContainer{{
+		EphemeralContainerCommon: corev1.EphemeralContainerCommon{Name: "debug"},
+	}}
+	_, err = sut.Reconcile(context.Background(), testRequest)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, mock.StartBpfRecorderCallCount())
+	_, _, startReq := mock.StartBpfRecorderArgsForCall(1)
+	assert.Equal(t, "namespace/recording", startReq.Recording)
+	assert.Equal(t, []string{profileDebug}, startReq.Profiles)
+
+	// The container is only added once
+	_, err = sut.Reconcile(context.Background(), testRequest)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, mock.StartBpfRecorderCallCount())
+
+	// Every start request gets stopped
+	pod.Status.Phase = corev1.PodSucceeded
+	_, err = sut.Reconcile(context.Background(), testRequest)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, mock.StopBpfRecorderCallCount())
+}
+
+func TestReconcileRecordingWindow(t *testing.T) {
+	t.Parallel()
+
+	testRequest := reconcile.Request{
+		NamespacedName: types.NamespacedName{
+			Namespace: "namespace",
+			Name:      "name",
+		},
+	}
+	profileName := fmt.Sprintf("recording_a_4bbwm_%d", time.Now().Unix())
+
+	mock := &profilerecorderfakes.FakeImpl{}
+	sut := &RecorderReconciler{
+		impl:   mock,
+		log:    logr.Discard(),
+		record: record.NewFakeRecorder(10),
+	}
+	pod := &corev1.Pod{
+		Status: corev1.PodStatus{Phase: corev1.PodPending},
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				config.SeccompProfileRecordBpfAnnotationKey + "a": profileName,
+			},
+		},
+	}
+	recording := &recordingapi.ProfileRecording{
+		Spec: recordingapi.ProfileRecordingSpec{
+			Deadline: &metav1.Time{Time: time.Now().Add(time.Hour)},
+		},
+	}
+	mock.GetPodReturns(pod, nil)
+	mock.GetRecordingReturns(recording, nil)
+	mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+		Spec: spodapi.SPODSpec{EnableBpfRecorder: true},
+	}, nil)
+	mock.DialBpfRecorderReturns(nil, func() {}, nil)
+	mock.SyscallsForProfileReturns(nil, bpfrecorder.ErrNotFound)
+
+	_, err := sut.Reconcile(context.Background(), testRequest)
+	assert.Nil(t, err)
+
+	// The window is still open
+	pod.Status.Phase = corev1.PodRunning
+	res, err := sut.Reconcile(context.Background(), testRequest)
+	assert.Nil(t, err)
+	assert.Greater(t, res.RequeueAfter, 59*time.Minute)
+	assert.Zero(t, mock.StopBpfRecorderCallCount())
+
+	// The window closed while the pod is still running
+	recording.Spec.Deadline = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+	res, err = sut.Reconcile(context.Background(), testRequest)
+	assert.Nil(t, err)
+	assert.Zero(t, res.RequeueAfter)
+	assert.Equal(t, 1, mock.StopBpfRecorderCallCount())
+	_, ok := sut.podsToWatch.Load(testRequest.NamespacedName.String())
+	assert.False(t, ok)
+}
+
 func TestIsPodOnLocalNode(t *testing.T) {
 	t.Parallel()
 
@@ -1315,3 +1485,57 @@ func TestIsPodWithTraceAnnotation(t *testing.T) {
 		tc.assert(res)
 	}
 }
+
+func TestTerminatedContainers(t *testing.T) {
+	t.Parallel()
+
+	always := corev1.ContainerRestartPolicyAlways
+	terminated := func(exitCode int32) corev1.ContainerState {
+		return corev1.ContainerState{
+			Terminated: &corev1.ContainerStateTerminated{ExitCode: exitCode},
+		}
+	}
+	running := corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}
+
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			RestartPolicy: corev1.RestartPolicyOnFailure,
+			InitContainers: []corev1.Container{
+				{Name: "init"},
+				{Name: "init-failed"},
+				{Name: "sidecar", RestartPolicy: &always},
+			},
+		},
+		Status: corev1.PodStatus{
+			InitContainerStatuses: []corev1.ContainerStatus{
+				{Name: "init", State: terminated(0)},
+				{Name: "init-failed", State: terminated(1)},
+				{Name: "sidecar", State: terminated(0)},
+			},
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "succeeded", State: terminated(0)},
+				{Name: "failed", State: terminated(1)},
+				{Name: "running", State: running},
+			},
+			EphemeralContainerStatuses: []corev1.ContainerStatus{
+				{Name: "debug", State: terminated(137)},
+				{Name: "debug-running", State: running},
+			},
+		},
+	}
+
+	assert.Equal(t, map[string]bool{
+		"init":      true,
+		"succeeded": true,
+		"debug":     true,
+	}, terminatedContainers(pod))
+
+	pod.Spec.RestartPolicy = corev1.RestartPolicyNever
+	assert.Equal(t, map[string]bool{
+		"init":        true,
+		"init-failed": true,
+		"succeeded":   true,
+		"failed":      true,
+		"debug":       true,
+	}, terminatedContainers(pod))
+}
@@ -245,11 +245,12 @@ type FakeImpl struct {
 	resetSyscallsReturnsOnCall map[int]struct {
 		result1 error
 	}
-	StartBpfRecorderStub        func(context.Context, api_bpfrecorder.BpfRecorderClient) error
+	StartBpfRecorderStub        func(context.Context, api_bpfrecorder.BpfRecorderClient, *api_bpfrecorder.StartRequest) error
 	startBpfRecorderMutex       sync.RWMutex
 	startBpfRecorderArgsForCall []struct {
 		arg1 context.Context
 		arg2 api_bpfrecorder.BpfRecorderClient
+		arg3 *api_bpfrecorder.StartRequest
 	}
 	startBpfRecorderReturns struct {
 		result1 error
@@ -257,11 +258,12 @@ type FakeImpl struct {
 	startBpfRecorderReturnsOnCall map[int]struct {
 		result1 error
 	}
-	StopBpfRecorderStub        func(context.Context, api_bpfrecorder.BpfRecorderClient) error
+	StopBpfRecorderStub        func(context.Context, api_bpfrecorder.BpfRecorderClient, *api_bpfrecorder.StopRequest) error
 	stopBpfRecorderMutex       sync.RWMutex
 	stopBpfRecorderArgsForCall []struct {
 		arg1 context.Context
 		arg2 api_bpfrecorder.BpfRecorderClient
+		arg3 *api_bpfrecorder.StopRequest
 	}
 	stopBpfRecorderReturns struct {
 		result1 error
@@ -1257,19 +1259,20 @@ func (fake *FakeImpl) ResetSyscallsReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeImpl) StartBpfRecorder(arg1 context.Context, arg2 api_bpfrecorder.BpfRecorderClient) error {
+func (fake *FakeImpl) StartBpfRecorder(arg1 context.Context, arg2 api_bpfrecorder.BpfRecorderClient, arg3 *api_bpfrecorder.StartRequest) error {
 	fake.startBpfRecorderMutex.Lock()
 	ret, specificReturn := fake.startBpfRecorderReturnsOnCall[len(fake.startBpfRecorderArgsForCall)]
 	fake.startBpfRecorderArgsForCall = append(fake.startBpfRecorderArgsForCall, struct {
 		arg1 context.Context
 		arg2 api_bpfrecorder.BpfRecorderClient
-	}{arg1, arg2})
+		arg3 *api_bpfrecorder.StartRequest
+	}{arg1, arg2, arg3})
 	stub := fake.StartBpfRecorderStub
 	fakeReturns := fake.startBpfRecorderReturns
-	fake.recordInvocation("StartBpfRecorder", []interface{}{arg1, arg2})
+	fake.recordInvocation("StartBpfRecorder", []interface{}{arg1, arg2, arg3})
 	fake.startBpfRecorderMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
@@ -1283,17 +1286,17 @@ func (fake *FakeImpl) StartBpfRecorderCallCount() int {
 	return len(fake.startBpfRecorderArgsForCall)
 }
 
-func (fake *FakeImpl) StartBpfRecorderCalls(stub func(context.Context, api_bpfrecorder.BpfRecorderClient) error) {
+func (fake *FakeImpl) StartBpfRecorderCalls(stub func(context.Context, api_bpfrecorder.BpfRecorderClient, *api_bpfrecorder.StartRequest) error) {
 	fake.startBpfRecorderMutex.Lock()
 	defer fake.startBpfRecorderMutex.Unlock()
 	fake.StartBpfRecorderStub = stub
 }
 
-func (fake *FakeImpl) StartBpfRecorderArgsForCall(i int) (context.Context, api_bpfrecorder.BpfRecorderClient) {
+func (fake *FakeImpl) StartBpfRecorderArgsForCall(i int) (context.Context, api_bpfrecorder.BpfRecorderClient, *api_bpfrecorder.StartRequest) {
 	fake.startBpfRecorderMutex.RLock()
 	defer fake.startBpfRecorderMutex.RUnlock()
 	argsForCall := fake.startBpfRecorderArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeImpl) StartBpfRecorderReturns(result1 error) {
@@ -1319,19 +1322,20 @@ func (fake *FakeImpl) StartBpfRecorderReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeImpl) StopBpfRecorder(arg1 context.Context, arg2 api_bpfrecorder.BpfRecorderClient) error {
+func (fake *FakeImpl) StopBpfRecorder(arg1 context.Context, arg2 api_bpfrecorder.BpfRecorderClient, arg3 *api_bpfrecorder.StopRequest) error {
 	fake.stopBpfRecorderMutex.Lock()
 	ret, specificReturn := fake.stopBpfRecorderReturnsOnCall[len(fake.stopBpfRecorderArgsForCall)]
 	fake.stopBpfRecorderArgsForCall = append(fake.stopBpfRecorderArgsForCall, struct {
 		arg1 context.Context
 		arg2 api_bpfrecorder.BpfRecorderClient
-	}{arg1, arg2})
+		arg3 *api_bpfrecorder.StopRequest
+	}{arg1, arg2, arg3})
 	stub := fake.StopBpfRecorderStub
 	fakeReturns := fake.stopBpfRecorderReturns
-	fake.recordInvocation("StopBpfRecorder", []interface{}{arg1, arg2})
+	fake.recordInvocation("StopBpfRecorder", []interface{}{arg1, arg2, arg3})
 	fake.stopBpfRecorderMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
@@ -1345,17 +1349,17 @@ func (fake *FakeImpl) StopBpfRecorderCallCount() int {
 	return len(fake.stopBpfRecorderArgsForCall)
 }
 
-func (fake *FakeImpl) StopBpfRecorderCalls(stub func(context.Context, api_bpfrecorder.BpfRecorderClient) error) {
+func (fake *FakeImpl) StopBpfRecorderCalls(stub func(context.Context, api_bpfrecorder.BpfRecorderClient, *api_bpfrecorder.StopRequest) error) {
 	fake.stopBpfRecorderMutex.Lock()
 	defer fake.stopBpfRecorderMutex.Unlock()
 	fake.StopBpfRecorderStub = stub
 }
 
-func (fake *FakeImpl) StopBpfRecorderArgsForCall(i int) (context.Context, api_bpfrecorder.BpfRecorderClient) {
+func (fake *FakeImpl) StopBpfRecorderArgsForCall(i int) (context.Context, api_bpfrecorder.BpfRecorderClient, *api_bpfrecorder.StopRequest) {
 	fake.stopBpfRecorderMutex.RLock()
 	defer fake.stopBpfRecorderMutex.RUnlock()
 	argsForCall := fake.stopBpfRecorderArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeImpl) StopBpfRecorderReturns(result1 error) {
@@ -0,0 +1,50 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package seccompnotifier
+
+import (
+	"syscall"
+
+	seccomp "github.com/seccomp/libseccomp-golang"
+)
+
+type defaultImpl struct{}
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate -header ../../../../hack/boilerplate/boilerplate.generatego.txt
+//counterfeiter:generate . impl
+type impl interface {
+	NotifReceive(fd seccomp.ScmpFd) (*seccomp.ScmpNotifReq, error)
+	NotifRespond(fd seccomp.ScmpFd, resp *seccomp.ScmpNotifResp) error
+	SyscallName(sc seccomp.ScmpSyscall, arch seccomp.ScmpArch) (string, error)
+	CloseFd(fd int) error
+}
+
+func (*defaultImpl) NotifReceive(fd seccomp.ScmpFd) (*seccomp.ScmpNotifReq, error) {
+	return seccomp.NotifReceive(fd)
+}
+
+func (*defaultImpl) NotifRespond(fd seccomp.ScmpFd, resp *seccomp.ScmpNotifResp) error {
+	return seccomp.NotifRespond(fd, resp)
+}
+
+func (*defaultImpl) SyscallName(sc seccomp.ScmpSyscall, arch seccomp.ScmpArch) (string, error) {
+	return sc.GetNameByArch(arch)
+}
+
+func (*defaultImpl) CloseFd(fd int) error {
+	return syscall.Close(fd)
+}
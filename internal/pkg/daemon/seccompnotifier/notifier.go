@@ -0,0 +1,288 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package seccompnotifier implements the seccomp notify agent of the daemon,
+// which handles the syscalls of the seccomp profiles with the
+// SCMP_ACT_NOTIFY action.
+package seccompnotifier
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"syscall"
+
+	"github.com/go-logr/logr"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	seccomp "github.com/seccomp/libseccomp-golang"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
+)
+
+const (
+	// maxFds is the maximum number of file descriptors accepted from a
+	// container runtime.
+	maxFds = 16
+)
+
+// The annotations of the container runtimes identifying the container.
+var (
+	namespaceAnnotations = []string{"io.kubernetes.pod.namespace", "io.kubernetes.cri.sandbox-namespace"}
+	podAnnotations       = []string{"io.kubernetes.pod.name", "io.kubernetes.cri.sandbox-name"}
+	containerAnnotations = []string{"io.kubernetes.container.name", "io.kubernetes.cri.container-name"}
+)
+
+// Notifier is the seccomp notify agent. The container runtimes pass the
+// seccomp notify file descriptor of a container to its socket, which is the
+// listener path of the seccomp profiles. The notifier records every notified
+// syscall and allows or denies it depending on the policy of the profile,
+// which is its listener metadata.
+type Notifier struct {
+	impl       impl
+	log        logr.Logger
+	metrics    *metrics.Metrics
+	socketPath string
+	nodeName   string
+}
+
+// New returns a new Notifier listening on the default socket path.
+func New(logger logr.Logger, met *metrics.Metrics) *Notifier {
+	return &Notifier{
+		impl:       &defaultImpl{},
+		log:        logger,
+		metrics:    met,
+		socketPath: config.SeccompNotifierSocketPath(),
+		nodeName:   os.Getenv(config.NodeNameEnvKey),
+	}
+}
+
+// container is a container whose syscalls get notified.
+type container struct {
+	namespace string
+	pod       string
+	name      string
+	id        string
+	policy    Policy
+}
+
+// NeedLeaderElection implements the LeaderElectionRunnable interface of the
+// controller manager, since the notifier has to run on every node.
+func (n *Notifier) NeedLeaderElection() bool {
+	return false
+}
+
+// Start listens on the socket until the context is done.
+func (n *Notifier) Start(ctx context.Context) error {
+	if err := os.Remove(n.socketPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("removing stale socket: %w", err)
+	}
+
+	listener, err := net.ListenUnix("unix", &net.UnixAddr{Name: n.socketPath, Net: "unix"})
+	if err != nil {
+		return fmt.Errorf("listening on %s: %w", n.socketPath, err)
+	}
+	go func() {
+		<-ctx.Done()
+		listener.Close()
+	}()
+
+	n.log.Info("Listening for seccomp notifications", "socket", n.socketPath)
+	for {
+		conn, err := listener.AcceptUnix()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("accepting connection: %w", err)
+		}
+
+		go func() {
+			if err := n.handleConn(conn); err != nil {
+				n.log.Error(err, "Cannot handle seccomp notify connection")
+			}
+		}()
+	}
+}
+
+// handleConn receives the process state and the seccomp notify file
+// descriptor from a container runtime and starts handling the
+// notifications.
+func (n *Notifier) handleConn(conn *net.UnixConn) error {
+	defer conn.Close()
+
+	state, fds, err := n.receiveState(conn)
+	if err != nil {
+		return err
+	}
+
+	seccompFd := -1
+	for i, fd := range fds {
+		if i < len(state.Fds) && state.Fds[i] == specs.SeccompFdName && seccompFd == -1 {
+			seccompFd = fd
+			continue
+		}
+		if err := n.impl.CloseFd(fd); err != nil {
+			n.log.Error(err, "Cannot close unused file descriptor")
+		}
+	}
+	if seccompFd == -1 {
+		return errors.New("no seccomp notify file descriptor received")
+	}
+
+	c := containerOf(state)
+	policy, err := ParsePolicy(state.Metadata)
+	if err != nil {
+		n.log.Error(err, "Using the fallback policy", "containerID", c.id)
+		policy = FallbackPolicy
+	}
+	c.policy = policy
+
+	n.log.Info(
+		"Handling seccomp notifications",
+		"namespace", c.namespace, "pod", c.pod, "container", c.name,
+		"containerID", c.id, "action", c.policy.Action,
+	)
+	go n.handleNotifications(seccomp.ScmpFd(seccompFd), c)
+	return nil
+}
+
+// receiveState reads the container process state, which the runtimes send
+// in a single message together with the file descriptors before closing the
+// connection.
+func (n *Notifier) receiveState(conn *net.UnixConn) (*specs.ContainerProcessState, []int, error) {
+	buf := make([]byte, os.Getpagesize())
+	oob := make([]byte, syscall.CmsgSpace(maxFds*4)) //nolint:gomnd // size of a file descriptor
+	num, oobn, _, _, err := conn.ReadMsgUnix(buf, oob)
+	if err != nil {
+		return nil, nil, fmt.Errorf("receiving process state: %w", err)
+	}
+
+	fds, err := parseFds(oob[:oobn])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rest, err := io.ReadAll(conn)
+	if err != nil {
+		n.closeFds(fds)
+		return nil, nil, fmt.Errorf("receiving process state: %w", err)
+	}
+
+	state := &specs.ContainerProcessState{}
+	if err := json.Unmarshal(append(buf[:num], rest...), state); err != nil {
+		n.closeFds(fds)
+		return nil, nil, fmt.Errorf("decoding process state: %w", err)
+	}
+	return state, fds, nil
+}
+
+func parseFds(oob []byte) ([]int, error) {
+	msgs, err := syscall.ParseSocketControlMessage(oob)
+	if err != nil {
+		return nil, fmt.Errorf("parsing control message: %w", err)
+	}
+
+	fds := []int{}
+	for i := range msgs {
+		rights, err := syscall.ParseUnixRights(&msgs[i])
+		if err != nil {
+			continue
+		}
+		fds = append(fds, rights...)
+	}
+	return fds, nil
+}
+
+func (n *Notifier) closeFds(fds []int) {
+	for _, fd := range fds {
+		if err := n.impl.CloseFd(fd); err != nil {
+			n.log.Error(err, "Cannot close file descriptor")
+		}
+	}
+}
+
+func containerOf(state *specs.ContainerProcessState) *container {
+	annotation := func(keys []string) string {
+		for _, key := range keys {
+			if value, ok := state.State.Annotations[key]; ok {
+				return value
+			}
+		}
+		return ""
+	}
+
+	return &container{
+		namespace: annotation(namespaceAnnotations),
+		pod:       annotation(podAnnotations),
+		name:      annotation(containerAnnotations),
+		id:        state.State.ID,
+	}
+}
+
+// handleNotifications handles the notifications of a container until all of
+// its processes exited, which makes receiving fail.
+func (n *Notifier) handleNotifications(fd seccomp.ScmpFd, c *container) {
+	defer func() {
+		if err := n.impl.CloseFd(int(fd)); err != nil {
+			n.log.Error(err, "Cannot close seccomp notify file descriptor")
+		}
+	}()
+
+	for {
+		req, err := n.impl.NotifReceive(fd)
+		if err != nil {
+			n.log.V(config.VerboseLevel).Info(
+				"Stopped handling seccomp notifications", "containerID", c.id, "reason", err.Error(),
+			)
+			return
+		}
+
+		if err := n.handleNotification(fd, c, req); err != nil {
+			n.log.Error(err, "Cannot respond to seccomp notification", "containerID", c.id)
+		}
+	}
+}
+
+func (n *Notifier) handleNotification(fd seccomp.ScmpFd, c *container, req *seccomp.ScmpNotifReq) error {
+	name, err := n.impl.SyscallName(req.Data.Syscall, req.Data.Arch)
+	if err != nil {
+		name = fmt.Sprint(req.Data.Syscall)
+	}
+
+	n.log.V(config.VerboseLevel).Info(
+		"Seccomp notification",
+		"namespace", c.namespace, "pod", c.pod, "container", c.name,
+		"pid", req.Pid, "syscall", name, "action", c.policy.Action,
+	)
+	n.metrics.IncSeccompNotify(n.nodeName, c.namespace, c.name, name, string(c.policy.Action))
+
+	resp := &seccomp.ScmpNotifResp{ID: req.ID}
+	if c.policy.Action == ActionDeny {
+		resp.Error = -int32(c.policy.Errno)
+	} else {
+		resp.Flags = seccomp.NotifRespFlagContinue
+	}
+
+	if err := n.impl.NotifRespond(fd, resp); err != nil {
+		return fmt.Errorf("responding to notification of %s: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package seccompnotifier
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	seccomp "github.com/seccomp/libseccomp-golang"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/seccompnotifier/seccompnotifierfakes"
+)
+
+func TestNotifier(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		metadata string
+		assert   func(*seccomp.ScmpNotifResp)
+	}{
+		{
+			name: "allow",
+			assert: func(resp *seccomp.ScmpNotifResp) {
+				require.Equal(t, seccomp.NotifRespFlagContinue, resp.Flags)
+				require.Zero(t, resp.Error)
+			},
+		},
+		{
+			name:     "deny",
+			metadata: "action=deny,errno=EACCES",
+			assert: func(resp *seccomp.ScmpNotifResp) {
+				require.Zero(t, resp.Flags)
+				require.Equal(t, -int32(syscall.EACCES), resp.Error)
+			},
+		},
+		{
+			name:     "invalid policy",
+			metadata: "action=kill",
+			assert: func(resp *seccomp.ScmpNotifResp) {
+				require.Zero(t, resp.Flags)
+				require.Equal(t, -int32(syscall.EPERM), resp.Error)
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &seccompnotifierfakes.FakeImpl{}
+			mock.NotifReceiveReturnsOnCall(0, &seccomp.ScmpNotifReq{
+				ID: 42, Pid: 1234, Data: seccomp.ScmpNotifData{Syscall: 83},
+			}, nil)
+			mock.NotifReceiveReturnsOnCall(1, nil, errors.New("no such process"))
+			mock.SyscallNameReturns("mkdir", nil)
+			mock.CloseFdCalls(func(fd int) error { return syscall.Close(fd) })
+
+			sut := New(log.Log, metrics.New())
+			sut.impl = mock
+			sut.socketPath = filepath.Join(t.TempDir(), "notify.sock")
+			sut.nodeName = "node"
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			errCh := make(chan error)
+			go func() { errCh <- sut.Start(ctx) }()
+
+			sendState(t, sut.socketPath, tc.metadata)
+
+			require.Eventually(t, func() bool {
+				return mock.CloseFdCallCount() == 2
+			}, 5*time.Second, 10*time.Millisecond)
+
+			require.Equal(t, 1, mock.NotifRespondCallCount())
+			_, resp := mock.NotifRespondArgsForCall(0)
+			require.Equal(t, uint64(42), resp.ID)
+			tc.assert(resp)
+
+			cancel()
+			require.NoError(t, <-errCh)
+		})
+	}
+}
+
+// sendState sends the process state together with a seccomp and an unused
+// file descriptor, like the container runtimes do.
+func sendState(t *testing.T, socketPath, metadata string) {
+	t.Helper()
+
+	var conn *net.UnixConn
+	require.Eventually(t, func() bool {
+		var err error
+		conn, err = net.DialUnix("unix", nil, &net.UnixAddr{Name: socketPath, Net: "unix"})
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	defer conn.Close()
+
+	state, err := json.Marshal(&specs.ContainerProcessState{
+		Version:  specs.Version,
+		Fds:      []string{"other", specs.SeccompFdName},
+		Pid:      1234,
+		Metadata: metadata,
+		State: specs.State{
+			ID: "container-id",
+			Annotations: map[string]string{
+				"io.kubernetes.pod.namespace":  "namespace",
+				"io.kubernetes.pod.name":       "pod",
+				"io.kubernetes.container.name": "container",
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+	defer r.Close()
+	defer w.Close()
+
+	_, _, err = conn.WriteMsgUnix(state, syscall.UnixRights(int(r.Fd()), int(w.Fd())), nil)
+	require.NoError(t, err)
+}
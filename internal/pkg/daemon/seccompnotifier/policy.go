@@ -0,0 +1,129 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package seccompnotifier
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// Action is the action taken for a notified syscall.
+type Action string
+
+const (
+	// ActionAllow lets the syscall continue after recording it.
+	ActionAllow Action = "allow"
+
+	// ActionDeny fails the syscall with the errno of the policy after
+	// recording it.
+	ActionDeny Action = "deny"
+)
+
+const (
+	metadataKeyAction = "action"
+	metadataKeyErrno  = "errno"
+)
+
+// errnos are the names of the errors which can be used in a policy, besides
+// their numbers.
+var errnos = map[string]syscall.Errno{
+	"EACCES":     syscall.EACCES,
+	"EAGAIN":     syscall.EAGAIN,
+	"EBUSY":      syscall.EBUSY,
+	"EEXIST":     syscall.EEXIST,
+	"EINVAL":     syscall.EINVAL,
+	"ENOENT":     syscall.ENOENT,
+	"ENOMEM":     syscall.ENOMEM,
+	"ENOSYS":     syscall.ENOSYS,
+	"ENOTSUP":    syscall.ENOTSUP,
+	"EOPNOTSUPP": syscall.EOPNOTSUPP,
+	"EPERM":      syscall.EPERM,
+	"EROFS":      syscall.EROFS,
+}
+
+var errInvalidPolicy = errors.New("invalid seccomp notifier policy")
+
+// Policy decides how the notified syscalls of a container get handled.
+type Policy struct {
+	Action Action
+	// Errno is the error returned for denied syscalls.
+	Errno syscall.Errno
+}
+
+// DefaultPolicy allows all notified syscalls, which only records them.
+var DefaultPolicy = Policy{Action: ActionAllow}
+
+// FallbackPolicy denies all notified syscalls with EPERM. It gets used for
+// containers with invalid listener metadata, because those may have been
+// meant to deny syscalls.
+var FallbackPolicy = Policy{Action: ActionDeny, Errno: syscall.EPERM}
+
+// ParsePolicy parses the listener metadata of a seccomp profile, which is a
+// comma separated list of key=value pairs, for example
+// "action=deny,errno=EPERM". Empty metadata results in the DefaultPolicy,
+// while denied syscalls fail with EPERM unless an errno is set.
+func ParsePolicy(metadata string) (Policy, error) {
+	policy := DefaultPolicy
+	metadata = strings.TrimSpace(metadata)
+	if metadata == "" {
+		return policy, nil
+	}
+
+	for _, pair := range strings.Split(metadata, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok {
+			return Policy{}, fmt.Errorf("%w: missing value of %q", errInvalidPolicy, pair)
+		}
+
+		switch key {
+		case metadataKeyAction:
+			switch Action(value) {
+			case ActionAllow, ActionDeny:
+				policy.Action = Action(value)
+			default:
+				return Policy{}, fmt.Errorf("%w: unknown action %q", errInvalidPolicy, value)
+			}
+		case metadataKeyErrno:
+			errno, err := parseErrno(value)
+			if err != nil {
+				return Policy{}, err
+			}
+			policy.Errno = errno
+		default:
+			return Policy{}, fmt.Errorf("%w: unknown key %q", errInvalidPolicy, key)
+		}
+	}
+
+	if policy.Action == ActionDeny && policy.Errno == 0 {
+		policy.Errno = syscall.EPERM
+	}
+	return policy, nil
+}
+
+func parseErrno(value string) (syscall.Errno, error) {
+	if errno, ok := errnos[value]; ok {
+		return errno, nil
+	}
+	num, err := strconv.ParseUint(value, 10, 16)
+	if err != nil || num == 0 {
+		return 0, fmt.Errorf("%w: unknown errno %q", errInvalidPolicy, value)
+	}
+	return syscall.Errno(num), nil
+}
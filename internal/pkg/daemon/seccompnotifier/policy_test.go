@@ -0,0 +1,53 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package seccompnotifier
+
+import (
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePolicy(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		metadata string
+		want     Policy
+		wantErr  bool
+	}{
+		{metadata: "", want: DefaultPolicy},
+		{metadata: "action=allow", want: Policy{Action: ActionAllow}},
+		{metadata: "action=deny", want: Policy{Action: ActionDeny, Errno: syscall.EPERM}},
+		{metadata: "action=deny, errno=EACCES", want: Policy{Action: ActionDeny, Errno: syscall.EACCES}},
+		{metadata: "errno=38,action=deny", want: Policy{Action: ActionDeny, Errno: syscall.ENOSYS}},
+		{metadata: "action=kill", wantErr: true},
+		{metadata: "action=deny,errno=EFOO", wantErr: true},
+		{metadata: "action=deny,errno=0", wantErr: true},
+		{metadata: "deny", wantErr: true},
+		{metadata: "mode=deny", wantErr: true},
+	} {
+		policy, err := ParsePolicy(tc.metadata)
+		if tc.wantErr {
+			require.ErrorIs(t, err, errInvalidPolicy, tc.metadata)
+			continue
+		}
+		require.NoError(t, err, tc.metadata)
+		require.Equal(t, tc.want, policy, tc.metadata)
+	}
+}
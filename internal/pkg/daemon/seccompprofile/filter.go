@@ -0,0 +1,323 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package seccompprofile
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"syscall"
+
+	"github.com/containers/common/pkg/seccomp"
+	libseccomp "github.com/seccomp/libseccomp-golang"
+
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	statusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
+)
+
+const (
+	archPrefix = "SCMP_ARCH_"
+
+	// bpfInstructionSize is the size of a single struct sock_filter.
+	bpfInstructionSize = 8
+)
+
+var (
+	errUnknownAction   = errors.New("unknown seccomp action")
+	errUnknownOperator = errors.New("unknown seccomp operator")
+)
+
+// compileFilter generates the BPF filter of the profile with libseccomp, the
+// same way the container runtimes do it when starting a workload.
+func compileFilter(spec *seccompprofileapi.SeccompProfileSpec) (*statusv1alpha1.SeccompFilterStatus, error) {
+	native, err := libseccomp.GetNativeArch()
+	if err != nil {
+		return nil, fmt.Errorf("getting native architecture: %w", err)
+	}
+
+	archs := spec.Architectures
+	if len(archs) == 0 {
+		archs = []seccompprofileapi.Arch{fromScmpArch(native)}
+	}
+
+	scmpArchs := []libseccomp.ScmpArch{}
+	status := &statusv1alpha1.SeccompFilterStatus{}
+	for _, arch := range archs {
+		archStatus := statusv1alpha1.SeccompArchFilterStatus{Arch: string(arch)}
+		scmpArch, err := toScmpArch(arch, native)
+		if err == nil {
+			var instructions int
+			_, instructions, err = buildFilter(spec, native, scmpArch)
+			archStatus.Instructions = int32(instructions)
+		}
+		if err != nil {
+			archStatus.Error = err.Error()
+		} else {
+			archStatus.Valid = true
+			scmpArchs = append(scmpArchs, scmpArch)
+		}
+		status.Architectures = append(status.Architectures, archStatus)
+	}
+
+	if len(scmpArchs) == 0 {
+		return status, nil
+	}
+	rules, instructions, err := buildFilter(spec, native, scmpArchs...)
+	if err != nil {
+		return nil, err
+	}
+	status.Rules = int32(rules)
+	status.Instructions = int32(instructions)
+
+	return status, nil
+}
+
+// buildFilter compiles the profile into a filter for the provided
+// architectures and returns the number of rules and BPF instructions.
+func buildFilter(
+	spec *seccompprofileapi.SeccompProfileSpec, native libseccomp.ScmpArch, archs ...libseccomp.ScmpArch,
+) (rules, instructions int, err error) {
+	defaultAction, err := toScmpAction(spec.DefaultAction, 0)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	filter, err := libseccomp.NewFilter(defaultAction)
+	if err != nil {
+		return 0, 0, fmt.Errorf("creating filter: %w", err)
+	}
+	defer filter.Release()
+
+	nativeWanted := false
+	for _, arch := range archs {
+		if arch == native {
+			nativeWanted = true
+			continue
+		}
+		if err := filter.AddArch(arch); err != nil {
+			return 0, 0, fmt.Errorf("adding architecture %s: %w", arch, err)
+		}
+	}
+	if !nativeWanted {
+		if err := filter.RemoveArch(native); err != nil {
+			return 0, 0, fmt.Errorf("removing native architecture %s: %w", native, err)
+		}
+	}
+
+	for _, call := range spec.Syscalls {
+		if call == nil {
+			continue
+		}
+		action, err := toScmpAction(call.Action, call.ErrnoRet)
+		if err != nil {
+			return 0, 0, err
+		}
+		if action == defaultAction {
+			// libseccomp refuses rules matching the default action
+			continue
+		}
+
+		conditions, err := toScmpConditions(call.Args)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		for _, name := range call.Names {
+			// Like the container runtimes, ignore syscalls unknown to the
+			// node instead of failing.
+			nr, err := libseccomp.GetSyscallFromName(name)
+			if err != nil {
+				continue
+			}
+			added, err := addRule(filter, nr, action, conditions)
+			if err != nil {
+				return 0, 0, fmt.Errorf("adding rule for syscall %s: %w", name, err)
+			}
+			rules += added
+		}
+	}
+
+	instructions, err = countInstructions(filter)
+	if err != nil {
+		return 0, 0, err
+	}
+	return rules, instructions, nil
+}
+
+// addRule adds the rule for a syscall to the filter. libseccomp does not
+// support multiple conditions on the same argument within one rule, which
+// is why those get added as a separate rule per condition.
+func addRule(
+	filter *libseccomp.ScmpFilter,
+	nr libseccomp.ScmpSyscall,
+	action libseccomp.ScmpAction,
+	conditions []libseccomp.ScmpCondition,
+) (int, error) {
+	if len(conditions) == 0 {
+		return 1, filter.AddRule(nr, action)
+	}
+
+	args := map[uint]bool{}
+	for _, condition := range conditions {
+		if args[condition.Argument] {
+			for _, condition := range conditions {
+				if err := filter.AddRuleConditional(
+					nr, action, []libseccomp.ScmpCondition{condition},
+				); err != nil {
+					return 0, err
+				}
+			}
+			return len(conditions), nil
+		}
+		args[condition.Argument] = true
+	}
+
+	return 1, filter.AddRuleConditional(nr, action, conditions)
+}
+
+// countInstructions exports the BPF program of the filter and returns its
+// number of instructions.
+func countInstructions(filter *libseccomp.ScmpFilter) (int, error) {
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		return 0, fmt.Errorf("creating pipe: %w", err)
+	}
+	defer reader.Close()
+
+	type result struct {
+		size int64
+		err  error
+	}
+	done := make(chan result, 1)
+	go func() {
+		size, err := io.Copy(io.Discard, reader)
+		done <- result{size, err}
+	}()
+
+	exportErr := filter.ExportBPF(writer)
+	writer.Close()
+	res := <-done
+	if exportErr != nil {
+		return 0, fmt.Errorf("exporting BPF program: %w", exportErr)
+	}
+	if res.err != nil {
+		return 0, fmt.Errorf("reading BPF program: %w", res.err)
+	}
+
+	return int(res.size / bpfInstructionSize), nil
+}
+
+func toScmpAction(action seccomp.Action, errnoRet uint) (libseccomp.ScmpAction, error) {
+	switch action {
+	case seccomp.ActKill, seccomp.ActKillThread:
+		return libseccomp.ActKillThread, nil
+	case seccomp.ActKillProcess:
+		return libseccomp.ActKillProcess, nil
+	case seccomp.ActTrap:
+		return libseccomp.ActTrap, nil
+	case seccomp.ActErrno:
+		if errnoRet == 0 {
+			errnoRet = uint(syscall.EPERM)
+		}
+		return libseccomp.ActErrno.SetReturnCode(int16(errnoRet)), nil
+	case seccomp.ActTrace:
+		if errnoRet == 0 {
+			errnoRet = uint(syscall.EPERM)
+		}
+		return libseccomp.ActTrace.SetReturnCode(int16(errnoRet)), nil
+	case seccomp.ActAllow:
+		return libseccomp.ActAllow, nil
+	case seccomp.ActLog:
+		return libseccomp.ActLog, nil
+	case seccomp.ActNotify:
+		return libseccomp.ActNotify, nil
+	default:
+		return libseccomp.ActInvalid, fmt.Errorf("%w: %s", errUnknownAction, action)
+	}
+}
+
+func toScmpConditions(args []*seccompprofileapi.Arg) ([]libseccomp.ScmpCondition, error) {
+	conditions := []libseccomp.ScmpCondition{}
+	for _, arg := range args {
+		if arg == nil {
+			continue
+		}
+		op, err := toScmpCompareOp(arg.Op)
+		if err != nil {
+			return nil, err
+		}
+		values := []uint64{arg.Value}
+		if op == libseccomp.CompareMaskedEqual {
+			values = append(values, arg.ValueTwo)
+		}
+		condition, err := libseccomp.MakeCondition(arg.Index, op, values...)
+		if err != nil {
+			return nil, fmt.Errorf("creating condition for argument %d: %w", arg.Index, err)
+		}
+		conditions = append(conditions, condition)
+	}
+	return conditions, nil
+}
+
+func toScmpCompareOp(op seccomp.Operator) (libseccomp.ScmpCompareOp, error) {
+	switch op {
+	case seccomp.OpNotEqual:
+		return libseccomp.CompareNotEqual, nil
+	case seccomp.OpLessThan:
+		return libseccomp.CompareLess, nil
+	case seccomp.OpLessEqual:
+		return libseccomp.CompareLessOrEqual, nil
+	case seccomp.OpEqualTo:
+		return libseccomp.CompareEqual, nil
+	case seccomp.OpGreaterEqual:
+		return libseccomp.CompareGreaterEqual, nil
+	case seccomp.OpGreaterThan:
+		return libseccomp.CompareGreater, nil
+	case seccomp.OpMaskedEqual:
+		return libseccomp.CompareMaskedEqual, nil
+	default:
+		return libseccomp.CompareInvalid, fmt.Errorf("%w: %s", errUnknownOperator, op)
+	}
+}
+
+// toScmpArch converts an architecture of a profile into the libseccomp one.
+func toScmpArch(arch seccompprofileapi.Arch, native libseccomp.ScmpArch) (libseccomp.ScmpArch, error) {
+	name := strings.ToLower(strings.TrimPrefix(string(arch), archPrefix))
+	if name == "native" {
+		return native, nil
+	}
+	scmpArch, err := libseccomp.GetArchFromString(name)
+	if err != nil {
+		return libseccomp.ArchInvalid, fmt.Errorf("converting architecture %s: %w", arch, err)
+	}
+	return scmpArch, nil
+}
+
+// fromScmpArch converts a libseccomp architecture into the one used by
+// profiles.
+func fromScmpArch(arch libseccomp.ScmpArch) seccompprofileapi.Arch {
+	switch arch {
+	case libseccomp.ArchAMD64:
+		return archPrefix + "X86_64"
+	case libseccomp.ArchARM64:
+		return archPrefix + "AARCH64"
+	default:
+		return seccompprofileapi.Arch(archPrefix + strings.ToUpper(arch.String()))
+	}
+}
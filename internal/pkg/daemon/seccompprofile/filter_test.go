@@ -0,0 +1,98 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package seccompprofile
+
+import (
+	"syscall"
+	"testing"
+
+	"github.com/containers/common/pkg/seccomp"
+	libseccomp "github.com/seccomp/libseccomp-golang"
+	"github.com/stretchr/testify/require"
+
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+)
+
+func TestToScmpAction(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		action   seccomp.Action
+		errnoRet uint
+		want     libseccomp.ScmpAction
+		wantErr  bool
+	}{
+		{action: seccomp.ActKill, want: libseccomp.ActKillThread},
+		{action: seccomp.ActKillThread, want: libseccomp.ActKillThread},
+		{action: seccomp.ActKillProcess, want: libseccomp.ActKillProcess},
+		{action: seccomp.ActTrap, want: libseccomp.ActTrap},
+		{action: seccomp.ActErrno, want: libseccomp.ActErrno.SetReturnCode(int16(syscall.EPERM))},
+		{
+			action:   seccomp.ActErrno,
+			errnoRet: uint(syscall.ENOSYS),
+			want:     libseccomp.ActErrno.SetReturnCode(int16(syscall.ENOSYS)),
+		},
+		{action: seccomp.ActTrace, want: libseccomp.ActTrace.SetReturnCode(int16(syscall.EPERM))},
+		{action: seccomp.ActAllow, want: libseccomp.ActAllow},
+		{action: seccomp.ActLog, want: libseccomp.ActLog},
+		{action: seccomp.ActNotify, want: libseccomp.ActNotify},
+		{action: "SCMP_ACT_WRONG", want: libseccomp.ActInvalid, wantErr: true},
+	} {
+		got, err := toScmpAction(tc.action, tc.errnoRet)
+		if tc.wantErr {
+			require.ErrorIs(t, err, errUnknownAction)
+		} else {
+			require.NoError(t, err)
+		}
+		require.Equal(t, tc.want, got, tc.action)
+	}
+}
+
+func TestToScmpCompareOp(t *testing.T) {
+	t.Parallel()
+
+	for op, want := range map[seccomp.Operator]libseccomp.ScmpCompareOp{
+		seccomp.OpNotEqual:     libseccomp.CompareNotEqual,
+		seccomp.OpLessThan:     libseccomp.CompareLess,
+		seccomp.OpLessEqual:    libseccomp.CompareLessOrEqual,
+		seccomp.OpEqualTo:      libseccomp.CompareEqual,
+		seccomp.OpGreaterEqual: libseccomp.CompareGreaterEqual,
+		seccomp.OpGreaterThan:  libseccomp.CompareGreater,
+		seccomp.OpMaskedEqual:  libseccomp.CompareMaskedEqual,
+	} {
+		got, err := toScmpCompareOp(op)
+		require.NoError(t, err)
+		require.Equal(t, want, got, op)
+	}
+
+	_, err := toScmpCompareOp("SCMP_CMP_WRONG")
+	require.ErrorIs(t, err, errUnknownOperator)
+}
+
+func TestFromScmpArch(t *testing.T) {
+	t.Parallel()
+
+	for arch, want := range map[libseccomp.ScmpArch]seccompprofileapi.Arch{
+		libseccomp.ArchAMD64:   "SCMP_ARCH_X86_64",
+		libseccomp.ArchARM64:   "SCMP_ARCH_AARCH64",
+		libseccomp.ArchX86:     "SCMP_ARCH_X86",
+		libseccomp.ArchPPC64LE: "SCMP_ARCH_PPC64LE",
+		libseccomp.ArchS390X:   "SCMP_ARCH_S390X",
+	} {
+		require.Equal(t, want, fromScmpArch(arch))
+	}
+}
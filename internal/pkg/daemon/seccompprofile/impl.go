@@ -26,6 +26,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	statusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
 	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/artifact"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/common"
@@ -43,9 +44,13 @@ type impl interface {
 	ClientGetProfile(
 		context.Context, client.Client, client.ObjectKey, ...client.GetOption,
 	) (*seccompprofileapi.SeccompProfile, error)
+	ClientGetClusterProfile(
+		context.Context, client.Client, client.ObjectKey, ...client.GetOption,
+	) (*seccompprofileapi.ClusterSeccompProfile, error)
 	IncSeccompProfileError(*metrics.Metrics, string)
 	RecordEvent(record.EventRecorder, runtime.Object, string, string, string)
 	GetSPOD(context.Context, client.Client) (*spodv1alpha1.SecurityProfilesOperatorDaemon, error)
+	CompileFilter(*seccompprofileapi.SeccompProfileSpec) (*statusv1alpha1.SeccompFilterStatus, error)
 }
 
 func (*defaultImpl) Pull(
@@ -74,6 +79,14 @@ func (*defaultImpl) ClientGetProfile(
 	return profile, err
 }
 
+func (*defaultImpl) ClientGetClusterProfile(
+	ctx context.Context, c client.Client, key client.ObjectKey, opts ...client.GetOption,
+) (*seccompprofileapi.ClusterSeccompProfile, error) {
+	profile := &seccompprofileapi.ClusterSeccompProfile{}
+	err := c.Get(ctx, key, profile, opts...)
+	return profile, err
+}
+
 func (*defaultImpl) IncSeccompProfileError(m *metrics.Metrics, reason string) {
 	m.IncSeccompProfileError(reason)
 }
@@ -89,3 +102,9 @@ func (*defaultImpl) GetSPOD(
 ) (*spodv1alpha1.SecurityProfilesOperatorDaemon, error) {
 	return common.GetSPOD(ctx, cli)
 }
+
+func (*defaultImpl) CompileFilter(
+	spec *seccompprofileapi.SeccompProfileSpec,
+) (*statusv1alpha1.SeccompFilterStatus, error) {
+	return compileFilter(spec)
+}
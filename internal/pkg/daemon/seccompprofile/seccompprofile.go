@@ -51,7 +51,11 @@ import (
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/artifact"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/controller"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/common"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher"
+	enrichertypes "sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/seccompnotifier"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/nodestatus"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
 )
@@ -85,6 +89,13 @@ const (
 	reasonCannotUpdateStatus    string = "CannotUpdateNodeStatus"
 	reasonProfileNotAllowed     string = "ProfileNotAllowed"
 	reasonSavedProfile          string = "SavedSeccompProfile"
+	reasonInvalidFilter         string = "InvalidSeccompFilter"
+	reasonFilterTooLarge        string = "SeccompFilterExceedsThreshold"
+	reasonInvalidNotifierPolicy string = "InvalidSeccompNotifierPolicy"
+
+	// defaultFilterThreshold is the maximum number of instructions of a BPF
+	// program accepted by the kernel (BPF_MAXINSNS).
+	defaultFilterThreshold uint32 = 4096
 
 	defaultCacheTimeout time.Duration = 24 * time.Hour
 	maxCacheItems       uint64        = 1000
@@ -92,8 +103,19 @@ const (
 
 // NewController returns a new empty controller instance.
 func NewController() controller.Controller {
+	return newReconciler(false)
+}
+
+// NewClusterController returns a new empty controller instance for cluster
+// scoped seccomp profiles.
+func NewClusterController() controller.Controller {
+	return newReconciler(true)
+}
+
+func newReconciler(cluster bool) *Reconciler {
 	return &Reconciler{
-		impl: &defaultImpl{},
+		impl:    &defaultImpl{},
+		cluster: cluster,
 		baseProfiles: ttlcache.New(
 			ttlcache.WithTTL[string, *seccompprofileapi.SeccompProfile](defaultCacheTimeout),
 			ttlcache.WithCapacity[string, *seccompprofileapi.SeccompProfile](maxCacheItems),
@@ -112,13 +134,50 @@ type Reconciler struct {
 	save         saver
 	metrics      *metrics.Metrics
 	baseProfiles *ttlcache.Cache[string, *seccompprofileapi.SeccompProfile]
+	cluster      bool
 }
 
 // Name returns the name of the controller.
 func (r *Reconciler) Name() string {
+	if r.cluster {
+		return "cluster-seccomp-spod"
+	}
 	return "seccomp-spod"
 }
 
+// newProfile returns an empty profile of the kind reconciled by the
+// controller.
+func (r *Reconciler) newProfile() seccompprofileapi.SeccompProfileObject {
+	if r.cluster {
+		return &seccompprofileapi.ClusterSeccompProfile{}
+	}
+	return &seccompprofileapi.SeccompProfile{}
+}
+
+// listProfiles returns all profiles of the kind reconciled by the controller.
+func (r *Reconciler) listProfiles(ctx context.Context) ([]seccompprofileapi.SeccompProfileObject, error) {
+	profiles := []seccompprofileapi.SeccompProfileObject{}
+	if r.cluster {
+		list := &seccompprofileapi.ClusterSeccompProfileList{}
+		if err := r.client.List(ctx, list); err != nil {
+			return nil, fmt.Errorf("listing cluster seccomp profiles: %w", err)
+		}
+		for i := range list.Items {
+			profiles = append(profiles, &list.Items[i])
+		}
+		return profiles, nil
+	}
+
+	list := &seccompprofileapi.SeccompProfileList{}
+	if err := r.client.List(ctx, list); err != nil {
+		return nil, fmt.Errorf("listing seccomp profiles: %w", err)
+	}
+	for i := range list.Items {
+		profiles = append(profiles, &list.Items[i])
+	}
+	return profiles, nil
+}
+
 // SchemeBuilder returns the API scheme of the controller.
 func (r *Reconciler) SchemeBuilder() *scheme.Builder {
 	return seccompprofileapi.SchemeBuilder
@@ -163,7 +222,7 @@ func (AllowedSyscallsChangedPredicate) Update(e event.UpdateEvent) bool {
 
 // Setup adds a controller that reconciles seccomp profiles.
 func (r *Reconciler) Setup(
-	_ context.Context,
+	ctx context.Context,
 	mgr ctrl.Manager,
 	met *metrics.Metrics,
 ) error {
@@ -173,10 +232,26 @@ func (r *Reconciler) Setup(
 	r.save = saveProfileOnDisk
 	r.metrics = met
 
+	name := "profile"
+	if r.cluster {
+		name = "clusterprofile"
+	}
+
+	b := ctrl.NewControllerManagedBy(mgr)
+	if !r.cluster {
+		b = common.WatchNamespaceScope(ctx, b, r.client)
+	}
+	b = nodestatus.WatchNodeLabels(b, r.client, func() client.ObjectList {
+		if r.cluster {
+			return &seccompprofileapi.ClusterSeccompProfileList{}
+		}
+		return &seccompprofileapi.SeccompProfileList{}
+	})
+
 	// Register the regular reconciler to manage SeccompProfiles
-	return ctrl.NewControllerManagedBy(mgr).
-		Named("profile").
-		For(&seccompprofileapi.SeccompProfile{}).
+	return b.
+		Named(name).
+		For(r.newProfile()).
 		Watches(
 			&spodapi.SecurityProfilesOperatorDaemon{},
 			handler.EnqueueRequestsFromMapFunc(r.handleAllowedSyscallsChanged),
@@ -198,15 +273,14 @@ func (r *Reconciler) handleAllowedSyscallsChanged(ctx context.Context, obj clien
 	ctx, cancel := context.WithTimeout(ctx, reconcileTimeout)
 	defer cancel()
 
-	seccompProfileList := &seccompprofileapi.SeccompProfileList{}
-	if err := r.client.List(ctx, seccompProfileList, &client.ListOptions{}); err != nil {
+	seccompProfiles, err := r.listProfiles(ctx)
+	if err != nil {
 		r.log.Error(err, "cannot list seccomp profiles in the cluster")
 		return []reconcile.Request{}
 	}
 
 	reconcileRequests := []reconcile.Request{}
-	for i := range seccompProfileList.Items {
-		sp := &seccompProfileList.Items[i]
+	for _, sp := range seccompProfiles {
 		if err := allowProfile(sp, spod.Spec.AllowedSyscalls, spod.Spec.AllowedSeccompActions); err != nil {
 			r.log.Info(fmt.Sprintf("deleting not allowed seccomp profile %s/%s",
 				sp.GetNamespace(), sp.GetName()))
@@ -254,11 +328,15 @@ func (r *Reconciler) checkSeccomp() error {
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles/finalizers,verbs=delete;get;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=clusterseccompprofiles,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=clusterseccompprofiles/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=clusterseccompprofiles/finalizers,verbs=delete;get;update;patch
 
 //nolint:lll // required for kubebuilder
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=securityprofilenodestatuses,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=securityprofilesoperatordaemons,verbs=get;list;watch
 // +kubebuilder:rbac:groups=core,resources=nodes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch
 // +kubebuilder:rbac:groups=core,resources=events,verbs=create;get;patch;update
 
 // OpenShift ... This is ignored in other distros
@@ -280,7 +358,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		return reconcile.Result{}, nil
 	}
 
-	seccompProfile := &seccompprofileapi.SeccompProfile{}
+	seccompProfile := r.newProfile()
 	if err := r.client.Get(ctx, req.NamespacedName, seccompProfile); err != nil {
 		// Expected to find a SeccompProfile, return an error and requeue
 		if util.IgnoreNotFound(err) == nil {
@@ -293,10 +371,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 }
 
 func (r *Reconciler) mergeBaseProfile(
-	ctx context.Context, sp *seccompprofileapi.SeccompProfile, l logr.Logger,
-) (*seccompprofileapi.SeccompProfile, error) {
+	ctx context.Context, sp seccompprofileapi.SeccompProfileObject, l logr.Logger,
+) (seccompprofileapi.SeccompProfileObject, error) {
 	// Recursively resolve the syscalls
-	finalSyscalls, err := r.resolveSyscallsForProfile(ctx, sp, sp.Spec.Syscalls, l, 0)
+	finalSyscalls, err := r.resolveSyscallsForProfile(ctx, sp, sp.GetSpec().Syscalls, l, 0)
 	if err != nil {
 		return nil, fmt.Errorf("resolve syscalls: %w", err)
 	}
@@ -309,21 +387,23 @@ func (r *Reconciler) mergeBaseProfile(
 	jsonSyscalls := string(scBytes)
 
 	const key = "syscalls"
-	if sp.Annotations[key] != jsonSyscalls {
-		l.Info("Updating syscall annotations", "profile", sp.Name)
+	if sp.GetAnnotations()[key] != jsonSyscalls {
+		l.Info("Updating syscall annotations", "profile", sp.GetName())
 
-		if sp.Annotations == nil {
-			sp.Annotations = make(map[string]string)
+		annotations := sp.GetAnnotations()
+		if annotations == nil {
+			annotations = make(map[string]string)
 		}
 
-		sp.Annotations[key] = jsonSyscalls
+		annotations[key] = jsonSyscalls
+		sp.SetAnnotations(annotations)
 
 		if err := r.client.Update(ctx, sp); err != nil {
 			return nil, fmt.Errorf("update seccomp profile annotations: %w", err)
 		}
 	}
 
-	sp.Spec.Syscalls = finalSyscalls
+	sp.GetSpec().Syscalls = finalSyscalls
 	return sp, nil
 }
 
@@ -332,7 +412,7 @@ func (r *Reconciler) mergeBaseProfile(
 // from OCI artifacts.
 func (r *Reconciler) resolveSyscallsForProfile(
 	ctx context.Context,
-	sp *seccompprofileapi.SeccompProfile,
+	sp seccompprofileapi.SeccompProfileObject,
 	inputSyscalls []*seccompprofileapi.Syscall,
 	l logr.Logger,
 	level uint8,
@@ -345,14 +425,14 @@ func (r *Reconciler) resolveSyscallsForProfile(
 		)
 	}
 
-	baseProfileName := sp.Spec.BaseProfileName
+	baseProfileName := sp.GetSpec().BaseProfileName
 	if baseProfileName == "" {
 		// No base profile at all
 		return inputSyscalls, nil
 	}
 
 	l.Info("Resolving syscalls for profile", "recursion", level)
-	var baseProfile *seccompprofileapi.SeccompProfile
+	var baseProfile seccompprofileapi.SeccompProfileObject
 
 	if strings.HasPrefix(baseProfileName, config.OCIProfilePrefix) {
 		// Pull remote base profile from an OCI artifact registry
@@ -388,19 +468,30 @@ func (r *Reconciler) resolveSyscallsForProfile(
 			if resType != artifact.PullResultTypeSeccompProfile {
 				return nil, fmt.Errorf("pull result type %s is not a seccomp profile", resType)
 			}
-			baseProfile = r.PullResultSeccompProfile(res)
-			r.baseProfiles.Set(from, baseProfile, ttlcache.DefaultTTL)
+			pulledProfile := r.PullResultSeccompProfile(res)
+			r.baseProfiles.Set(from, pulledProfile, ttlcache.DefaultTTL)
+			baseProfile = pulledProfile
 
 			l.Info(
 				"Set remote base seccomp profile",
-				"baseProfile", baseProfile.Name,
+				"baseProfile", baseProfile.GetName(),
 			)
 		}
 	} else {
 		// Local base profile
-		profile, err := r.ClientGetProfile(
-			ctx, r.client, util.NamespacedName(baseProfileName, sp.GetNamespace()),
-		)
+		var profile seccompprofileapi.SeccompProfileObject
+		var err error
+		if clusterProfileName, ok := strings.CutPrefix(
+			baseProfileName, seccompprofileapi.ClusterSeccompProfileKind+"/",
+		); ok {
+			profile, err = r.ClientGetClusterProfile(
+				ctx, r.client, util.NamespacedName(clusterProfileName, ""),
+			)
+		} else {
+			profile, err = r.ClientGetProfile(
+				ctx, r.client, util.NamespacedName(baseProfileName, sp.GetNamespace()),
+			)
+		}
 		if err != nil {
 			l.Error(err, "cannot retrieve base profile "+baseProfileName)
 			r.IncSeccompProfileError(r.metrics, reasonInvalidSeccompProfile)
@@ -412,12 +503,12 @@ func (r *Reconciler) resolveSyscallsForProfile(
 
 		l.Info(
 			"Set remote base seccomp profile",
-			"baseProfile", baseProfile.Name,
-			"seccompProfile", sp.Name,
+			"baseProfile", baseProfile.GetName(),
+			"seccompProfile", sp.GetName(),
 		)
 	}
 
-	newSyscalls, err := util.UnionSyscalls(baseProfile.Spec.Syscalls, inputSyscalls)
+	newSyscalls, err := util.UnionSyscalls(baseProfile.GetSpec().Syscalls, inputSyscalls)
 	if err != nil {
 		return nil, fmt.Errorf("union syscalls: %w", err)
 	}
@@ -426,12 +517,12 @@ func (r *Reconciler) resolveSyscallsForProfile(
 }
 
 func (r *Reconciler) reconcileSeccompProfile(
-	ctx context.Context, sp *seccompprofileapi.SeccompProfile, l logr.Logger,
+	ctx context.Context, sp seccompprofileapi.SeccompProfileObject, l logr.Logger,
 ) (reconcile.Result, error) {
 	if sp == nil {
 		return reconcile.Result{}, errors.New(errSeccompProfileNil)
 	}
-	profileName := sp.Name
+	profileName := sp.GetName()
 
 	nodeStatus, err := nodestatus.NewForProfile(sp, r.client)
 	if err != nil {
@@ -442,6 +533,14 @@ func (r *Reconciler) reconcileSeccompProfile(
 		return r.reconcileDeletion(ctx, sp, nodeStatus)
 	}
 
+	selected, err := nodeStatus.NodeSelected(ctx)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("checking node selector: %w", err)
+	}
+	if !selected {
+		return r.reconcileDeselected(ctx, sp, nodeStatus, l)
+	}
+
 	l.Info("Merge possible base profile")
 	outputProfile, err := r.mergeBaseProfile(ctx, sp, l)
 	if err != nil {
@@ -458,7 +557,7 @@ func (r *Reconciler) reconcileSeccompProfile(
 	}
 
 	l.Info("Got profile content")
-	profileContent, err := json.Marshal(outputProfile.Spec)
+	profileContent, err := json.Marshal(outputProfile.GetSpec())
 	if err != nil {
 		l.Error(err, "cannot validate profile "+profileName)
 		r.metrics.IncSeccompProfileError(reasonInvalidSeccompProfile)
@@ -489,12 +588,40 @@ func (r *Reconciler) reconcileSeccompProfile(
 		return reconcile.Result{}, nil
 	}
 
+	l.Info("Validate listener metadata")
+	valid, err := r.checkListenerMetadata(ctx, sp, outputProfile, nodeStatus, l)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("cannot update node status: %w", err)
+	}
+	if !valid {
+		return reconcile.Result{}, nil
+	}
+
+	l.Info("Compile profile")
+	if err := r.checkFilter(ctx, sp, outputProfile, nodeStatus, l); err != nil {
+		l.Error(err, "cannot update filter status")
+		r.metrics.IncSeccompProfileError(reasonCannotUpdateStatus)
+		return reconcile.Result{}, fmt.Errorf("updating filter status of SeccompProfile: %w", err)
+	}
+
+	allowed, requeueAfter, err := nodeStatus.RolloutAllowed(ctx)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("checking profile rollout: %w", err)
+	}
+	if !allowed {
+		l.Info("Waiting for the profile update to be rolled out to the canary nodes")
+		return reconcile.Result{RequeueAfter: requeueAfter}, nil
+	}
+
 	l.Info("Saving profile to disk")
 	updated, err := r.save(profilePath, profileContent)
 	if err != nil {
 		l.Error(err, "cannot save profile into disk")
 		r.metrics.IncSeccompProfileError(reasonCannotSaveProfile)
 		r.record.Event(sp, util.EventTypeWarning, reasonCannotSaveProfile, err.Error())
+		if statusErr := nodeStatus.SetNodeStatusError(ctx, reasonCannotSaveProfile, err.Error()); statusErr != nil {
+			l.Error(statusErr, "cannot update node status")
+		}
 		return reconcile.Result{}, fmt.Errorf("cannot save profile into disk: %w", err)
 	}
 	if updated {
@@ -505,19 +632,19 @@ func (r *Reconciler) reconcileSeccompProfile(
 	}
 
 	l.Info("Checking node status")
-	isAlreadyInstalled, getErr := nodeStatus.Matches(ctx, statusv1alpha1.ProfileStateInstalled)
+	isAlreadyInstalled, getErr := nodeStatus.IsInstalled(ctx)
 	if getErr != nil {
 		l.Error(getErr, "couldn't get current status")
 		return reconcile.Result{}, fmt.Errorf("getting status for installed SeccompProfile: %w", getErr)
 	}
 
-	if isAlreadyInstalled {
+	if isAlreadyInstalled && !updated {
 		l.Info("Already in the expected Installed state")
-		return reconcile.Result{}, nil
+		return r.checkCanary(ctx, sp, nodeStatus, l)
 	}
 
 	l.Info("Set node status to installed")
-	if err := nodeStatus.SetNodeStatus(ctx, statusv1alpha1.ProfileStateInstalled); err != nil {
+	if err := nodeStatus.SetNodeStatusInstalled(ctx, profileContent); err != nil {
 		l.Error(err, "cannot update node status")
 		r.metrics.IncSeccompProfileError(reasonCannotUpdateStatus)
 		r.record.Event(sp, util.EventTypeWarning, reasonCannotUpdateStatus, err.Error())
@@ -529,12 +656,30 @@ func (r *Reconciler) reconcileSeccompProfile(
 		"resource version", sp.GetResourceVersion(),
 		"name", sp.GetName(),
 	)
-	return reconcile.Result{}, nil
+	return r.checkCanary(ctx, sp, nodeStatus, l)
+}
+
+// checkCanary halts the staged rollout of the profile if violations of it
+// got observed on this canary node.
+func (r *Reconciler) checkCanary(
+	ctx context.Context,
+	sp seccompprofileapi.SeccompProfileObject,
+	nsc *nodestatus.StatusClient,
+	l logr.Logger,
+) (reconcile.Result, error) {
+	return nsc.ReconcileCanary(ctx, r.record, l, func(ctx context.Context) (time.Time, error) {
+		return enricher.LastViolation(
+			ctx,
+			enrichertypes.AuditTypeSeccomp,
+			sp.GetNamespace(),
+			strings.TrimSuffix(sp.GetProfileFile(), seccompprofileapi.ExtJSON),
+		)
+	}, r.metrics.IncSeccompProfileError)
 }
 
 func (r *Reconciler) reconcileDeletion(
 	ctx context.Context,
-	sp *seccompprofileapi.SeccompProfile,
+	sp seccompprofileapi.SeccompProfileObject,
 	nsc *nodestatus.StatusClient,
 ) (reconcile.Result, error) {
 	hasStatus, err := nsc.Exists(ctx)
@@ -584,7 +729,28 @@ func (r *Reconciler) reconcileDeletion(
 	return ctrl.Result{}, nil
 }
 
-func (r *Reconciler) handleDeletion(sp *seccompprofileapi.SeccompProfile) error {
+// reconcileDeselected removes the profile from the node if it got installed
+// before the node selector of the profile stopped matching the node.
+func (r *Reconciler) reconcileDeselected(
+	ctx context.Context,
+	sp seccompprofileapi.SeccompProfileObject,
+	nsc *nodestatus.StatusClient,
+	l logr.Logger,
+) (reconcile.Result, error) {
+	exists, err := nsc.Exists(ctx)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("checking if node status exists: %w", err)
+	}
+	if !exists {
+		l.Info("Profile does not select this node, skipping")
+		return reconcile.Result{}, nil
+	}
+
+	l.Info("Profile does not select this node anymore, removing it")
+	return r.reconcileDeletion(ctx, sp, nsc)
+}
+
+func (r *Reconciler) handleDeletion(sp seccompprofileapi.SeccompProfileObject) error {
 	profilePath := sp.GetProfilePath()
 	err := os.Remove(profilePath)
 	if os.IsNotExist(err) {
@@ -598,7 +764,7 @@ func (r *Reconciler) handleDeletion(sp *seccompprofileapi.SeccompProfile) error
 	return nil
 }
 
-func (r *Reconciler) validateProfile(ctx context.Context, profile *seccompprofileapi.SeccompProfile) error {
+func (r *Reconciler) validateProfile(ctx context.Context, profile seccompprofileapi.SeccompProfileObject) error {
 	spod, err := r.GetSPOD(ctx, r.client)
 	if err != nil {
 		return fmt.Errorf("retrieving the SPOD configuration: %w", err)
@@ -609,6 +775,79 @@ func (r *Reconciler) validateProfile(ctx context.Context, profile *seccompprofil
 	return nil
 }
 
+// checkListenerMetadata validates the listener metadata of profiles using the
+// seccomp notifier and marks the profile as failed on this node if the
+// metadata is not a valid notifier policy.
+func (r *Reconciler) checkListenerMetadata(
+	ctx context.Context,
+	sp, profile seccompprofileapi.SeccompProfileObject,
+	nodeStatus *nodestatus.StatusClient,
+	l logr.Logger,
+) (bool, error) {
+	spec := profile.GetSpec()
+	if spec.ListenerPath == "" {
+		return true, nil
+	}
+	if _, err := seccompnotifier.ParsePolicy(spec.ListenerMetadata); err != nil {
+		l.Error(err, "invalid listener metadata")
+		r.IncSeccompProfileError(r.metrics, reasonInvalidNotifierPolicy)
+		r.RecordEvent(r.record, sp, util.EventTypeWarning, reasonInvalidNotifierPolicy, err.Error())
+		return false, nodeStatus.SetNodeStatusError(ctx, reasonInvalidNotifierPolicy, err.Error())
+	}
+	return true, nil
+}
+
+// checkFilter compiles the profile into a BPF filter and reports the filter
+// of this node in its node status. Profiles exceeding the filter threshold of
+// the SPOD or failing to compile for one of their architectures get flagged,
+// but are still saved to disk.
+func (r *Reconciler) checkFilter(
+	ctx context.Context,
+	sp, profile seccompprofileapi.SeccompProfileObject,
+	nodeStatus *nodestatus.StatusClient,
+	l logr.Logger,
+) error {
+	spod, err := r.GetSPOD(ctx, r.client)
+	if err != nil {
+		return fmt.Errorf("retrieving the SPOD configuration: %w", err)
+	}
+	threshold := defaultFilterThreshold
+	if spod.Spec.SeccompFilterThreshold != nil {
+		threshold = *spod.Spec.SeccompFilterThreshold
+	}
+
+	filter, err := r.CompileFilter(profile.GetSpec())
+	if err != nil {
+		l.Error(err, "cannot compile profile")
+		r.IncSeccompProfileError(r.metrics, reasonInvalidFilter)
+		r.RecordEvent(r.record, sp, util.EventTypeWarning, reasonInvalidFilter, err.Error())
+		return nodeStatus.SetSeccompFilter(ctx, nil)
+	}
+
+	for _, arch := range filter.Architectures {
+		if arch.Valid {
+			continue
+		}
+		msg := fmt.Sprintf("Profile does not compile for architecture %s: %s", arch.Arch, arch.Error)
+		l.Info(msg)
+		r.IncSeccompProfileError(r.metrics, reasonInvalidFilter)
+		r.RecordEvent(r.record, sp, util.EventTypeWarning, reasonInvalidFilter, msg)
+	}
+
+	if uint32(filter.Instructions) > threshold {
+		filter.ExceedsThreshold = true
+		msg := fmt.Sprintf(
+			"Filter of profile has %d instructions, which exceeds the threshold of %d",
+			filter.Instructions, threshold,
+		)
+		l.Info(msg)
+		r.IncSeccompProfileError(r.metrics, reasonFilterTooLarge)
+		r.RecordEvent(r.record, sp, util.EventTypeWarning, reasonFilterTooLarge, msg)
+	}
+
+	return nodeStatus.SetSeccompFilter(ctx, filter)
+}
+
 func saveProfileOnDisk(fileName string, content []byte) (updated bool, err error) {
 	if err := os.MkdirAll(path.Dir(fileName), dirPermissionMode); err != nil {
 		return false, fmt.Errorf("%s: %w", errCreatingOperatorDir, err)
@@ -627,10 +866,10 @@ func saveProfileOnDisk(fileName string, content []byte) (updated bool, err error
 }
 
 func allowProfile(
-	profile *seccompprofileapi.SeccompProfile, allowedSyscalls []string, allowedActions []seccomp.Action,
+	profile seccompprofileapi.SeccompProfileObject, allowedSyscalls []string, allowedActions []seccomp.Action,
 ) error {
 	syscalls := map[seccomp.Action]map[string]bool{}
-	for _, call := range profile.Spec.Syscalls {
+	for _, call := range profile.GetSpec().Syscalls {
 		if _, ok := syscalls[call.Action]; !ok {
 			syscalls[call.Action] = map[string]bool{}
 		}
@@ -655,7 +894,7 @@ func allowProfile(
 				}
 			}
 		}
-		if profile.Spec.DefaultAction == action && len(allowedSyscalls) > 0 {
+		if profile.GetSpec().DefaultAction == action && len(allowedSyscalls) > 0 {
 			return fmt.Errorf(errForbiddenProfile)
 		}
 	}
@@ -29,19 +29,24 @@ import (
 	"github.com/stretchr/testify/require"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	statusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
 	spodapi "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/artifact"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/seccompprofile/seccompprofilefakes"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/nodestatus"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
 )
 
@@ -643,6 +648,36 @@ func TestResolveSyscallsForProfile(t *testing.T) {
 				require.Equal(t, "first", syscalls[2].Names[0])
 			},
 		},
+		{
+			name: "success cluster base profile",
+			prepare: func(mock *seccompprofilefakes.FakeImpl) *seccompprofileapi.SeccompProfile {
+				mock.ClientGetClusterProfileReturns(
+					&seccompprofileapi.ClusterSeccompProfile{
+						Spec: seccompprofileapi.SeccompProfileSpec{
+							Syscalls: []*seccompprofileapi.Syscall{
+								{Names: []string{"second"}},
+							},
+						},
+					}, nil,
+				)
+
+				return &seccompprofileapi.SeccompProfile{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+					Spec: seccompprofileapi.SeccompProfileSpec{
+						BaseProfileName: seccompprofileapi.ClusterSeccompProfileKind + "/baseline",
+						Syscalls: []*seccompprofileapi.Syscall{
+							{Names: []string{"first"}},
+						},
+					},
+				}
+			},
+			assert: func(syscalls []*seccompprofileapi.Syscall, err error) {
+				require.NoError(t, err)
+				require.Len(t, syscalls, 2)
+				require.Equal(t, "second", syscalls[0].Names[0])
+				require.Equal(t, "first", syscalls[1].Names[0])
+			},
+		},
 		{
 			name: "success two remote base profiles",
 			prepare: func(mock *seccompprofilefakes.FakeImpl) *seccompprofileapi.SeccompProfile {
@@ -773,3 +808,229 @@ func TestResolveSyscallsForProfile(t *testing.T) {
 		})
 	}
 }
+
+//nolint:paralleltest // cannot set environment variables in parallel tests
+func TestCheckFilter(t *testing.T) {
+	t.Setenv(config.NodeNameEnvKey, "node")
+
+	errTest := errors.New("test")
+	threshold := uint32(40)
+	valid := func(instructions int32) *statusv1alpha1.SeccompFilterStatus {
+		return &statusv1alpha1.SeccompFilterStatus{
+			Rules:        10,
+			Instructions: instructions,
+			Architectures: []statusv1alpha1.SeccompArchFilterStatus{{
+				Arch:         "SCMP_ARCH_X86_64",
+				Valid:        true,
+				Instructions: instructions,
+			}},
+		}
+	}
+
+	for _, tc := range []struct {
+		name    string
+		prepare func(*seccompprofilefakes.FakeImpl, *statusv1alpha1.SecurityProfileNodeStatus)
+		assert  func(*seccompprofilefakes.FakeImpl, *statusv1alpha1.SecurityProfileNodeStatus, error)
+	}{
+		{
+			name: "Success",
+			prepare: func(mock *seccompprofilefakes.FakeImpl, _ *statusv1alpha1.SecurityProfileNodeStatus) {
+				mock.CompileFilterReturns(valid(50), nil)
+			},
+			assert: func(mock *seccompprofilefakes.FakeImpl, status *statusv1alpha1.SecurityProfileNodeStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, valid(50), status.SeccompFilter)
+				require.Equal(t, statusv1alpha1.ProfileStatePending, status.Status)
+				require.Zero(t, mock.RecordEventCallCount())
+			},
+		},
+		{
+			name: "SuccessExceedsDefaultThreshold",
+			prepare: func(mock *seccompprofilefakes.FakeImpl, _ *statusv1alpha1.SecurityProfileNodeStatus) {
+				mock.CompileFilterReturns(valid(5000), nil)
+			},
+			assert: func(mock *seccompprofilefakes.FakeImpl, status *statusv1alpha1.SecurityProfileNodeStatus, err error) {
+				require.NoError(t, err)
+				require.True(t, status.SeccompFilter.ExceedsThreshold)
+				require.Equal(t, 1, mock.RecordEventCallCount())
+				_, _, _, reason, _ := mock.RecordEventArgsForCall(0)
+				require.Equal(t, reasonFilterTooLarge, reason)
+				_, reason = mock.IncSeccompProfileErrorArgsForCall(0)
+				require.Equal(t, reasonFilterTooLarge, reason)
+			},
+		},
+		{
+			name: "SuccessExceedsConfiguredThreshold",
+			prepare: func(mock *seccompprofilefakes.FakeImpl, _ *statusv1alpha1.SecurityProfileNodeStatus) {
+				mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{
+					Spec: spodapi.SPODSpec{SeccompFilterThreshold: &threshold},
+				}, nil)
+				mock.CompileFilterReturns(valid(50), nil)
+			},
+			assert: func(mock *seccompprofilefakes.FakeImpl, status *statusv1alpha1.SecurityProfileNodeStatus, err error) {
+				require.NoError(t, err)
+				require.True(t, status.SeccompFilter.ExceedsThreshold)
+				require.Equal(t, 1, mock.RecordEventCallCount())
+			},
+		},
+		{
+			name: "SuccessInvalidArchitecture",
+			prepare: func(mock *seccompprofilefakes.FakeImpl, _ *statusv1alpha1.SecurityProfileNodeStatus) {
+				filter := valid(50)
+				filter.Architectures = append(filter.Architectures, statusv1alpha1.SeccompArchFilterStatus{
+					Arch:  "SCMP_ARCH_RISCV64",
+					Error: "unsupported",
+				})
+				mock.CompileFilterReturns(filter, nil)
+			},
+			assert: func(mock *seccompprofilefakes.FakeImpl, status *statusv1alpha1.SecurityProfileNodeStatus, err error) {
+				require.NoError(t, err)
+				require.Len(t, status.SeccompFilter.Architectures, 2)
+				require.Equal(t, 1, mock.RecordEventCallCount())
+				_, _, _, reason, msg := mock.RecordEventArgsForCall(0)
+				require.Equal(t, reasonInvalidFilter, reason)
+				require.Contains(t, msg, "SCMP_ARCH_RISCV64")
+			},
+		},
+		{
+			name: "SuccessCompileErrorRemovesFilter",
+			prepare: func(mock *seccompprofilefakes.FakeImpl, status *statusv1alpha1.SecurityProfileNodeStatus) {
+				status.SeccompFilter = valid(50)
+				mock.CompileFilterReturns(nil, errTest)
+			},
+			assert: func(mock *seccompprofilefakes.FakeImpl, status *statusv1alpha1.SecurityProfileNodeStatus, err error) {
+				require.NoError(t, err)
+				require.Nil(t, status.SeccompFilter)
+				_, _, _, reason, _ := mock.RecordEventArgsForCall(0)
+				require.Equal(t, reasonInvalidFilter, reason)
+			},
+		},
+		{
+			name: "FailureGetSPOD",
+			prepare: func(mock *seccompprofilefakes.FakeImpl, _ *statusv1alpha1.SecurityProfileNodeStatus) {
+				mock.GetSPODReturns(nil, errTest)
+			},
+			assert: func(mock *seccompprofilefakes.FakeImpl, _ *statusv1alpha1.SecurityProfileNodeStatus, err error) {
+				require.ErrorIs(t, err, errTest)
+				require.Zero(t, mock.CompileFilterCallCount())
+			},
+		},
+	} {
+		prepare := tc.prepare
+		assert := tc.assert
+
+		t.Run(tc.name, func(t *testing.T) {
+			sp := &seccompprofileapi.SeccompProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "profile", Namespace: "default"},
+				Spec:       seccompprofileapi.SeccompProfileSpec{DefaultAction: seccomp.ActErrno},
+			}
+			status := &statusv1alpha1.SecurityProfileNodeStatus{
+				ObjectMeta: metav1.ObjectMeta{Name: "profile-node", Namespace: "default"},
+				NodeName:   "node",
+				Status:     statusv1alpha1.ProfileStatePending,
+			}
+			mock := &seccompprofilefakes.FakeImpl{}
+			mock.GetSPODReturns(&spodapi.SecurityProfilesOperatorDaemon{}, nil)
+			prepare(mock, status)
+
+			scheme := runtime.NewScheme()
+			require.NoError(t, seccompprofileapi.AddToScheme(scheme))
+			require.NoError(t, statusv1alpha1.AddToScheme(scheme))
+			cli := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(sp, status).
+				Build()
+
+			sut, ok := NewController().(*Reconciler)
+			require.True(t, ok)
+			sut.impl = mock
+			sut.client = cli
+
+			nodeStatus, err := nodestatus.NewForProfile(sp, cli)
+			require.NoError(t, err)
+
+			err = sut.checkFilter(context.Background(), sp, sp, nodeStatus, logr.Discard())
+
+			got := &statusv1alpha1.SecurityProfileNodeStatus{}
+			require.NoError(t, cli.Get(context.Background(), client.ObjectKeyFromObject(status), got))
+			assert(mock, got, err)
+		})
+	}
+}
+
+func TestCheckListenerMetadata(t *testing.T) {
+	t.Setenv(config.NodeNameEnvKey, "node")
+
+	for _, tc := range []struct {
+		name         string
+		listenerPath string
+		metadata     string
+		wantValid    bool
+	}{
+		{name: "WithoutListener", metadata: "action=kill", wantValid: true},
+		{name: "ValidMetadata", listenerPath: "/run/notify.sock", metadata: "action=deny,errno=EACCES", wantValid: true},
+		{name: "EmptyMetadata", listenerPath: "/run/notify.sock", wantValid: true},
+		{name: "InvalidMetadata", listenerPath: "/run/notify.sock", metadata: "action=kill"},
+	} {
+		listenerPath := tc.listenerPath
+		metadata := tc.metadata
+		wantValid := tc.wantValid
+
+		t.Run(tc.name, func(t *testing.T) {
+			sp := &seccompprofileapi.SeccompProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "profile", Namespace: "default"},
+				Spec: seccompprofileapi.SeccompProfileSpec{
+					DefaultAction:    seccomp.ActErrno,
+					ListenerPath:     listenerPath,
+					ListenerMetadata: metadata,
+				},
+			}
+			status := &statusv1alpha1.SecurityProfileNodeStatus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "profile-node",
+					Namespace: "default",
+					Labels: map[string]string{
+						statusv1alpha1.StatusStateLabel: string(statusv1alpha1.ProfileStatePending),
+					},
+				},
+				NodeName: "node",
+				Status:   statusv1alpha1.ProfileStatePending,
+			}
+			mock := &seccompprofilefakes.FakeImpl{}
+
+			scheme := runtime.NewScheme()
+			require.NoError(t, seccompprofileapi.AddToScheme(scheme))
+			require.NoError(t, statusv1alpha1.AddToScheme(scheme))
+			cli := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(sp, status).
+				Build()
+
+			sut, ok := NewController().(*Reconciler)
+			require.True(t, ok)
+			sut.impl = mock
+			sut.client = cli
+
+			nodeStatus, err := nodestatus.NewForProfile(sp, cli)
+			require.NoError(t, err)
+
+			valid, err := sut.checkListenerMetadata(context.Background(), sp, sp, nodeStatus, logr.Discard())
+			require.NoError(t, err)
+			require.Equal(t, wantValid, valid)
+
+			got := &statusv1alpha1.SecurityProfileNodeStatus{}
+			require.NoError(t, cli.Get(context.Background(), client.ObjectKeyFromObject(status), got))
+			if wantValid {
+				require.Equal(t, statusv1alpha1.ProfileStatePending, got.Status)
+				require.Zero(t, mock.RecordEventCallCount())
+				return
+			}
+			require.Equal(t, statusv1alpha1.ProfileStateError, got.Status)
+			require.Equal(t, 1, mock.RecordEventCallCount())
+			_, _, _, reason, _ := mock.RecordEventArgsForCall(0)
+			require.Equal(t, reasonInvalidNotifierPolicy, reason)
+			_, reason = mock.IncSeccompProfileErrorArgsForCall(0)
+			require.Equal(t, reasonInvalidNotifierPolicy, reason)
+		})
+	}
+}
@@ -28,12 +28,29 @@ import (
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
-	"sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
+	v1alpha1a "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/artifact"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
 )
 
 type FakeImpl struct {
+	ClientGetClusterProfileStub        func(context.Context, client.Client, types.NamespacedName, ...client.GetOption) (*v1beta1.ClusterSeccompProfile, error)
+	clientGetClusterProfileMutex       sync.RWMutex
+	clientGetClusterProfileArgsForCall []struct {
+		arg1 context.Context
+		arg2 client.Client
+		arg3 types.NamespacedName
+		arg4 []client.GetOption
+	}
+	clientGetClusterProfileReturns struct {
+		result1 *v1beta1.ClusterSeccompProfile
+		result2 error
+	}
+	clientGetClusterProfileReturnsOnCall map[int]struct {
+		result1 *v1beta1.ClusterSeccompProfile
+		result2 error
+	}
 	ClientGetProfileStub        func(context.Context, client.Client, types.NamespacedName, ...client.GetOption) (*v1beta1.SeccompProfile, error)
 	clientGetProfileMutex       sync.RWMutex
 	clientGetProfileArgsForCall []struct {
@@ -50,18 +67,31 @@ type FakeImpl struct {
 		result1 *v1beta1.SeccompProfile
 		result2 error
 	}
-	GetSPODStub        func(context.Context, client.Client) (*v1alpha1.SecurityProfilesOperatorDaemon, error)
+	CompileFilterStub        func(*v1beta1.SeccompProfileSpec) (*v1alpha1.SeccompFilterStatus, error)
+	compileFilterMutex       sync.RWMutex
+	compileFilterArgsForCall []struct {
+		arg1 *v1beta1.SeccompProfileSpec
+	}
+	compileFilterReturns struct {
+		result1 *v1alpha1.SeccompFilterStatus
+		result2 error
+	}
+	compileFilterReturnsOnCall map[int]struct {
+		result1 *v1alpha1.SeccompFilterStatus
+		result2 error
+	}
+	GetSPODStub        func(context.Context, client.Client) (*v1alpha1a.SecurityProfilesOperatorDaemon, error)
 	getSPODMutex       sync.RWMutex
 	getSPODArgsForCall []struct {
 		arg1 context.Context
 		arg2 client.Client
 	}
 	getSPODReturns struct {
-		result1 *v1alpha1.SecurityProfilesOperatorDaemon
+		result1 *v1alpha1a.SecurityProfilesOperatorDaemon
 		result2 error
 	}
 	getSPODReturnsOnCall map[int]struct {
-		result1 *v1alpha1.SecurityProfilesOperatorDaemon
+		result1 *v1alpha1a.SecurityProfilesOperatorDaemon
 		result2 error
 	}
 	IncSeccompProfileErrorStub        func(*metrics.Metrics, string)
@@ -124,6 +154,73 @@ type FakeImpl struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeImpl) ClientGetClusterProfile(arg1 context.Context, arg2 client.Client, arg3 types.NamespacedName, arg4 ...client.GetOption) (*v1beta1.ClusterSeccompProfile, error) {
+	fake.clientGetClusterProfileMutex.Lock()
+	ret, specificReturn := fake.clientGetClusterProfileReturnsOnCall[len(fake.clientGetClusterProfileArgsForCall)]
+	fake.clientGetClusterProfileArgsForCall = append(fake.clientGetClusterProfileArgsForCall, struct {
+		arg1 context.Context
+		arg2 client.Client
+		arg3 types.NamespacedName
+		arg4 []client.GetOption
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ClientGetClusterProfileStub
+	fakeReturns := fake.clientGetClusterProfileReturns
+	fake.recordInvocation("ClientGetClusterProfile", []interface{}{arg1, arg2, arg3, arg4})
+	fake.clientGetClusterProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) ClientGetClusterProfileCallCount() int {
+	fake.clientGetClusterProfileMutex.RLock()
+	defer fake.clientGetClusterProfileMutex.RUnlock()
+	return len(fake.clientGetClusterProfileArgsForCall)
+}
+
+func (fake *FakeImpl) ClientGetClusterProfileCalls(stub func(context.Context, client.Client, types.NamespacedName, ...client.GetOption) (*v1beta1.ClusterSeccompProfile, error)) {
+	fake.clientGetClusterProfileMutex.Lock()
+	defer fake.clientGetClusterProfileMutex.Unlock()
+	fake.ClientGetClusterProfileStub = stub
+}
+
+func (fake *FakeImpl) ClientGetClusterProfileArgsForCall(i int) (context.Context, client.Client, types.NamespacedName, []client.GetOption) {
+	fake.clientGetClusterProfileMutex.RLock()
+	defer fake.clientGetClusterProfileMutex.RUnlock()
+	argsForCall := fake.clientGetClusterProfileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeImpl) ClientGetClusterProfileReturns(result1 *v1beta1.ClusterSeccompProfile, result2 error) {
+	fake.clientGetClusterProfileMutex.Lock()
+	defer fake.clientGetClusterProfileMutex.Unlock()
+	fake.ClientGetClusterProfileStub = nil
+	fake.clientGetClusterProfileReturns = struct {
+		result1 *v1beta1.ClusterSeccompProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) ClientGetClusterProfileReturnsOnCall(i int, result1 *v1beta1.ClusterSeccompProfile, result2 error) {
+	fake.clientGetClusterProfileMutex.Lock()
+	defer fake.clientGetClusterProfileMutex.Unlock()
+	fake.ClientGetClusterProfileStub = nil
+	if fake.clientGetClusterProfileReturnsOnCall == nil {
+		fake.clientGetClusterProfileReturnsOnCall = make(map[int]struct {
+			result1 *v1beta1.ClusterSeccompProfile
+			result2 error
+		})
+	}
+	fake.clientGetClusterProfileReturnsOnCall[i] = struct {
+		result1 *v1beta1.ClusterSeccompProfile
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeImpl) ClientGetProfile(arg1 context.Context, arg2 client.Client, arg3 types.NamespacedName, arg4 ...client.GetOption) (*v1beta1.SeccompProfile, error) {
 	fake.clientGetProfileMutex.Lock()
 	ret, specificReturn := fake.clientGetProfileReturnsOnCall[len(fake.clientGetProfileArgsForCall)]
@@ -191,7 +288,71 @@ func (fake *FakeImpl) ClientGetProfileReturnsOnCall(i int, result1 *v1beta1.Secc
 	}{result1, result2}
 }
 
-func (fake *FakeImpl) GetSPOD(arg1 context.Context, arg2 client.Client) (*v1alpha1.SecurityProfilesOperatorDaemon, error) {
+func (fake *FakeImpl) CompileFilter(arg1 *v1beta1.SeccompProfileSpec) (*v1alpha1.SeccompFilterStatus, error) {
+	fake.compileFilterMutex.Lock()
+	ret, specificReturn := fake.compileFilterReturnsOnCall[len(fake.compileFilterArgsForCall)]
+	fake.compileFilterArgsForCall = append(fake.compileFilterArgsForCall, struct {
+		arg1 *v1beta1.SeccompProfileSpec
+	}{arg1})
+	stub := fake.CompileFilterStub
+	fakeReturns := fake.compileFilterReturns
+	fake.recordInvocation("CompileFilter", []interface{}{arg1})
+	fake.compileFilterMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) CompileFilterCallCount() int {
+	fake.compileFilterMutex.RLock()
+	defer fake.compileFilterMutex.RUnlock()
+	return len(fake.compileFilterArgsForCall)
+}
+
+func (fake *FakeImpl) CompileFilterCalls(stub func(*v1beta1.SeccompProfileSpec) (*v1alpha1.SeccompFilterStatus, error)) {
+	fake.compileFilterMutex.Lock()
+	defer fake.compileFilterMutex.Unlock()
+	fake.CompileFilterStub = stub
+}
+
+func (fake *FakeImpl) CompileFilterArgsForCall(i int) *v1beta1.SeccompProfileSpec {
+	fake.compileFilterMutex.RLock()
+	defer fake.compileFilterMutex.RUnlock()
+	argsForCall := fake.compileFilterArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) CompileFilterReturns(result1 *v1alpha1.SeccompFilterStatus, result2 error) {
+	fake.compileFilterMutex.Lock()
+	defer fake.compileFilterMutex.Unlock()
+	fake.CompileFilterStub = nil
+	fake.compileFilterReturns = struct {
+		result1 *v1alpha1.SeccompFilterStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) CompileFilterReturnsOnCall(i int, result1 *v1alpha1.SeccompFilterStatus, result2 error) {
+	fake.compileFilterMutex.Lock()
+	defer fake.compileFilterMutex.Unlock()
+	fake.CompileFilterStub = nil
+	if fake.compileFilterReturnsOnCall == nil {
+		fake.compileFilterReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.SeccompFilterStatus
+			result2 error
+		})
+	}
+	fake.compileFilterReturnsOnCall[i] = struct {
+		result1 *v1alpha1.SeccompFilterStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetSPOD(arg1 context.Context, arg2 client.Client) (*v1alpha1a.SecurityProfilesOperatorDaemon, error) {
 	fake.getSPODMutex.Lock()
 	ret, specificReturn := fake.getSPODReturnsOnCall[len(fake.getSPODArgsForCall)]
 	fake.getSPODArgsForCall = append(fake.getSPODArgsForCall, struct {
@@ -217,7 +378,7 @@ func (fake *FakeImpl) GetSPODCallCount() int {
 	return len(fake.getSPODArgsForCall)
 }
 
-func (fake *FakeImpl) GetSPODCalls(stub func(context.Context, client.Client) (*v1alpha1.SecurityProfilesOperatorDaemon, error)) {
+func (fake *FakeImpl) GetSPODCalls(stub func(context.Context, client.Client) (*v1alpha1a.SecurityProfilesOperatorDaemon, error)) {
 	fake.getSPODMutex.Lock()
 	defer fake.getSPODMutex.Unlock()
 	fake.GetSPODStub = stub
@@ -230,28 +391,28 @@ func (fake *FakeImpl) GetSPODArgsForCall(i int) (context.Context, client.Client)
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeImpl) GetSPODReturns(result1 *v1alpha1.SecurityProfilesOperatorDaemon, result2 error) {
+func (fake *FakeImpl) GetSPODReturns(result1 *v1alpha1a.SecurityProfilesOperatorDaemon, result2 error) {
 	fake.getSPODMutex.Lock()
 	defer fake.getSPODMutex.Unlock()
 	fake.GetSPODStub = nil
 	fake.getSPODReturns = struct {
-		result1 *v1alpha1.SecurityProfilesOperatorDaemon
+		result1 *v1alpha1a.SecurityProfilesOperatorDaemon
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeImpl) GetSPODReturnsOnCall(i int, result1 *v1alpha1.SecurityProfilesOperatorDaemon, result2 error) {
+func (fake *FakeImpl) GetSPODReturnsOnCall(i int, result1 *v1alpha1a.SecurityProfilesOperatorDaemon, result2 error) {
 	fake.getSPODMutex.Lock()
 	defer fake.getSPODMutex.Unlock()
 	fake.GetSPODStub = nil
 	if fake.getSPODReturnsOnCall == nil {
 		fake.getSPODReturnsOnCall = make(map[int]struct {
-			result1 *v1alpha1.SecurityProfilesOperatorDaemon
+			result1 *v1alpha1a.SecurityProfilesOperatorDaemon
 			result2 error
 		})
 	}
 	fake.getSPODReturnsOnCall[i] = struct {
-		result1 *v1alpha1.SecurityProfilesOperatorDaemon
+		result1 *v1alpha1a.SecurityProfilesOperatorDaemon
 		result2 error
 	}{result1, result2}
 }
@@ -520,8 +681,12 @@ func (fake *FakeImpl) RecordEventArgsForCall(i int) (record.EventRecorder, runti
 func (fake *FakeImpl) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.clientGetClusterProfileMutex.RLock()
+	defer fake.clientGetClusterProfileMutex.RUnlock()
 	fake.clientGetProfileMutex.RLock()
 	defer fake.clientGetProfileMutex.RUnlock()
+	fake.compileFilterMutex.RLock()
+	defer fake.compileFilterMutex.RUnlock()
 	fake.getSPODMutex.RLock()
 	defer fake.getSPODMutex.RUnlock()
 	fake.incSeccompProfileErrorMutex.RLock()
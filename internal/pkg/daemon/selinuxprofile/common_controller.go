@@ -17,66 +17,58 @@ limitations under the License.
 package selinuxprofile
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
-	"net"
 	"net/http"
 	"os"
-	"path"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	statusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
 	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher"
+	enrichertypes "sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/enricher/types"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
-	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/spod/bindata"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/nodestatus"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
 )
 
-const (
-	selinuxdSockAddr        = "http://unix"
-	selinuxdPoliciesBaseURL = selinuxdSockAddr + "/policies/"
-	selinuxdReadyURL        = selinuxdSockAddr + "/ready"
-	selinuxdSocketTimeout   = 5 * time.Second
-
-	selinuxdReadyKey = "ready"
-)
-
-type sePolStatusType string
-
-const (
-	installedStatus sePolStatusType = "Installed"
-	failedStatus    sePolStatusType = "Failed"
-)
+// policyEventsBufferSize is the number of policy status changes buffered
+// until the controller processes them.
+const policyEventsBufferSize = 1024
 
-type sePolStatus struct {
-	Msg    string          `json:"msg"`
-	Status sePolStatusType `json:"status"`
-}
+// policyStatusResyncPeriod is the period after which a policy which is being
+// installed or removed gets checked again, in case the status change pushed
+// by the backend got lost.
+const policyStatusResyncPeriod = time.Minute
 
 const (
-	reasonCannotContactSelinuxd    string = "CannotContactSelinuxd"
+	reasonCannotContactBackend     string = "CannotContactSelinuxBackend"
 	reasonCannotRemovePolicy       string = "CannotRemoveSelinuxPolicy"
 	reasonCannotInstallPolicy      string = "CannotSaveSelinuxPolicy"
 	reasonCannotWritePolicyFile    string = "CannotWritePolicyFile"
 	reasonCannotGetPolicyStatus    string = "CannotGetPolicyStatus"
 	reasonCannotUpdatePolicyStatus string = "CannotUpdatePolicyStatus"
 	reasonInstalledPolicy          string = "SavedSelinuxPolicy"
+	reasonPolicyBackend            string = "SelinuxPolicyBackend"
 )
 
 // blank assignment to verify that ReconcileSelinux implements `reconcile.Reconciler`.
@@ -97,11 +89,18 @@ type ReconcileSelinux struct {
 	controllerName    string
 	objectHandlerInit SelinuxObjectHandlerInit
 	ctrlBuilder       controllerBuilder
+	backend           PolicyBackend
+	// recordBackend records the backend on the node of the daemon. Only one
+	// of the controllers sharing the backend does so.
+	recordBackend bool
+	// events are the status changes pushed by the backend for the policies
+	// of the profiles.
+	events chan event.GenericEvent
 }
 
 // Setup adds a controller that reconciles selinux profiles.
 func (r *ReconcileSelinux) Setup(
-	_ context.Context,
+	ctx context.Context,
 	mgr ctrl.Manager,
 	met *metrics.Metrics,
 ) error {
@@ -110,8 +109,61 @@ func (r *ReconcileSelinux) Setup(
 	r.scheme = mgr.GetScheme()
 	r.record = mgr.GetEventRecorderFor(r.controllerName)
 	r.metrics = met
+	r.events = make(chan event.GenericEvent, policyEventsBufferSize)
+	r.backend.Watch(r.policyChanged)
+	if r.recordBackend {
+		r.recordPolicyBackend()
+	}
+
+	return r.ctrlBuilder(
+		ctx,
+		ctrl.NewControllerManagedBy(mgr).WatchesRawSource(
+			&source.Channel{Source: r.events}, &handler.EnqueueRequestForObject{},
+		),
+		r.client, r,
+	)
+}
+
+// recordPolicyBackend records the backend installing the policies on the
+// node of the daemon, which differs from the configured one if the daemon
+// fell back to selinuxd.
+func (r *ReconcileSelinux) recordPolicyBackend() {
+	nodeName := os.Getenv(config.NodeNameEnvKey)
+	r.log.Info("Installing SELinux policies", "backend", r.backend.Name(), "node", nodeName)
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: nodeName}}
+	r.record.Eventf(node, util.EventTypeNormal, reasonPolicyBackend,
+		"Installing SELinux policies with %s", r.backend.Name())
+}
+
+// policyChanged enqueues the profile of a policy whose status changed in
+// the backend. The profile is derived from the policy name, so that the
+// status changes of policies installed before a restart of the daemon are
+// not lost either. The backend is blocked while the events are pending,
+// because dropping them would leave the profile in progress.
+func (r *ReconcileSelinux) policyChanged(name string) {
+	key, ok := policyKey(name)
+	if !ok {
+		return
+	}
+	if _, err := r.objectHandlerInit(context.Background(), r.client, key); err != nil {
+		// The policy does not belong to a profile of this controller
+		return
+	}
+
+	r.events <- event.GenericEvent{Object: &metav1.PartialObjectMetadata{
+		ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+	}}
+}
 
-	return r.ctrlBuilder(ctrl.NewControllerManagedBy(mgr), r)
+// policyKey returns the key of the profile of a policy. The policy names
+// join the name and the namespace of the profile with an underscore, which
+// is valid in neither of them.
+func policyKey(policyName string) (types.NamespacedName, bool) {
+	name, namespace, found := strings.Cut(policyName, "_")
+	if !found || name == "" {
+		return types.NamespacedName{}, false
+	}
+	return types.NamespacedName{Name: name, Namespace: namespace}, true
 }
 
 // Name returns the name of the controller.
@@ -126,7 +178,7 @@ func (r *ReconcileSelinux) SchemeBuilder() *scheme.Builder {
 
 // Healthz is the liveness probe endpoint of the controller.
 func (r *ReconcileSelinux) Healthz(*http.Request) error {
-	ready, err := isSelinuxdReady(context.TODO())
+	ready, err := r.backend.Ready(context.TODO())
 	if err != nil {
 		return fmt.Errorf("getting health status: %w", err)
 	}
@@ -146,6 +198,11 @@ func (r *ReconcileSelinux) Healthz(*http.Request) error {
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=rawselinuxprofiles/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=rawselinuxprofiles/finalizers,verbs=delete;get;update;patch
 
+//nolint:lll // required for kubebuilder
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=clusterselinuxprofiles,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=clusterselinuxprofiles/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=clusterselinuxprofiles/finalizers,verbs=delete;get;update;patch
+
 // Reconcile reads that state of the cluster for a SelinuxProfile object and makes changes based on the state read
 // and what is in the `SelinuxProfile.Spec`.
 func (r *ReconcileSelinux) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
@@ -173,6 +230,23 @@ func (r *ReconcileSelinux) Reconcile(ctx context.Context, request reconcile.Requ
 			return reconcile.Result{}, fmt.Errorf("checking if node status exists: %w", existErr)
 		}
 
+		selected, err := nodeStatus.NodeSelected(ctx)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("checking node selector: %w", err)
+		}
+
+		if !selected && !exists {
+			reqLogger.Info("Profile does not select this node, skipping")
+			return reconcile.Result{}, nil
+		}
+
+		if !selected {
+			// The profile got installed before the node selector of the
+			// profile stopped matching the node, remove it like a deleted one.
+			reqLogger.Info("Profile does not select this node anymore, removing it")
+			return r.reconcileRemoval(ctx, instance, nodeStatus, reqLogger)
+		}
+
 		if !exists {
 			if err := nodeStatus.Create(ctx); err != nil {
 				return reconcile.Result{}, fmt.Errorf("cannot ensure node status: %w", err)
@@ -182,6 +256,18 @@ func (r *ReconcileSelinux) Reconcile(ctx context.Context, request reconcile.Requ
 		return r.reconcilePolicy(ctx, instance, oh, nodeStatus, reqLogger)
 	}
 
+	return r.reconcileRemoval(ctx, instance, nodeStatus, reqLogger)
+}
+
+// reconcileRemoval removes the policy from the node, either because the
+// profile got deleted or because its node selector does not match the node
+// anymore.
+func (r *ReconcileSelinux) reconcileRemoval(
+	ctx context.Context,
+	instance selxv1alpha2.SelinuxProfileObject,
+	nodeStatus *nodestatus.StatusClient,
+	reqLogger logr.Logger,
+) (reconcile.Result, error) {
 	if err := nodeStatus.SetNodeStatus(ctx, statusv1alpha1.ProfileStateTerminating); err != nil {
 		reqLogger.Error(err, "cannot update SELinux profile status")
 		r.metrics.IncSelinuxProfileError(reasonCannotUpdatePolicyStatus)
@@ -200,15 +286,15 @@ func (r *ReconcileSelinux) Reconcile(ctx context.Context, request reconcile.Requ
 		return reconcile.Result{}, nil
 	}
 
-	res, err := r.reconcileDeletePolicy(ctx, instance, nodeStatus, reqLogger)
+	removed, res, err := r.reconcileDeletePolicy(ctx, instance, nodeStatus, reqLogger)
 	if err != nil {
 		reqLogger.Error(err, "cannot delete policy or requeue")
 		r.metrics.IncSelinuxProfileError(reasonCannotRemovePolicy)
 		r.record.Event(instance, util.EventTypeWarning, reasonCannotRemovePolicy, err.Error())
 		return res, err
-	} else if res.Requeue {
-		reqLogger.Info("Re-queueing delete request to make sure the policy is gone")
-		return res, err
+	} else if !removed {
+		reqLogger.Info("Waiting for the policy to be removed")
+		return res, nil
 	}
 
 	if err := nodeStatus.Remove(ctx, r.client); err != nil {
@@ -228,20 +314,20 @@ func (r *ReconcileSelinux) reconcilePolicy(
 	nodeStatus *nodestatus.StatusClient,
 	l logr.Logger,
 ) (reconcile.Result, error) {
-	selinuxdReady, err := isSelinuxdReady(ctx)
+	backendReady, err := r.backend.Ready(ctx)
 	if err != nil {
-		r.metrics.IncSelinuxProfileError(reasonCannotContactSelinuxd)
-		r.record.Event(sp, util.EventTypeWarning, reasonCannotContactSelinuxd, err.Error())
-		return reconcile.Result{}, fmt.Errorf("contacting selinuxd: %w", err)
+		r.metrics.IncSelinuxProfileError(reasonCannotContactBackend)
+		r.record.Event(sp, util.EventTypeWarning, reasonCannotContactBackend, err.Error())
+		return reconcile.Result{}, fmt.Errorf("contacting %s: %w", r.backend.Name(), err)
 	}
-	if !selinuxdReady {
-		l.Info("selinuxd not yet up, requeue")
-		r.record.Event(sp, util.EventTypeWarning, reasonCannotContactSelinuxd, err.Error())
+	if !backendReady {
+		l.Info("SELinux policy backend not yet ready, requeue", "backend", r.backend.Name())
+		r.record.Event(sp, util.EventTypeWarning, reasonCannotContactBackend, r.backend.Name()+" is not yet ready")
 		return reconcile.Result{Requeue: true}, nil
 	}
 
 	if valErr := oh.Validate(); valErr != nil {
-		if err := nodeStatus.SetNodeStatus(ctx, statusv1alpha1.ProfileStateError); err != nil {
+		if err := nodeStatus.SetNodeStatusError(ctx, reasonCannotInstallPolicy, valErr.Error()); err != nil {
 			r.metrics.IncSelinuxProfileError(reasonCannotUpdatePolicyStatus)
 			r.record.Event(sp, util.EventTypeWarning, reasonCannotUpdatePolicyStatus, err.Error())
 			return reconcile.Result{}, fmt.Errorf("setting node status to error: %w", err)
@@ -257,23 +343,33 @@ func (r *ReconcileSelinux) reconcilePolicy(
 		return reconcile.Result{}, nil
 	}
 
-	err = r.reconcilePolicyFile(sp, oh, l)
+	allowed, requeueAfter, err := nodeStatus.RolloutAllowed(ctx)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("checking profile rollout: %w", err)
+	}
+	if !allowed {
+		l.Info("Waiting for the profile update to be rolled out to the canary nodes")
+		return reconcile.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	policyContent, err := r.reconcilePolicyInstall(ctx, sp, oh)
 	if err != nil {
 		r.metrics.IncSelinuxProfileError(reasonCannotWritePolicyFile)
 		r.record.Event(sp, util.EventTypeWarning, reasonCannotWritePolicyFile, err.Error())
-		return reconcile.Result{}, fmt.Errorf("creating policy file: %w", err)
+		return reconcile.Result{}, fmt.Errorf("installing policy: %w", err)
 	}
 
 	l.Info("Checking if policy deployed", "policyName", sp.GetName())
-	polStatus, err := getPolicyStatus(ctx, sp)
+	polStatus, err := r.backend.PolicyStatus(ctx, sp.GetPolicyName())
 
 	if errors.Is(err, errPolicyNotFound) {
+		// The backend pushes the status change once the policy got installed
 		if err := nodeStatus.SetNodeStatus(ctx, statusv1alpha1.ProfileStateInProgress); err != nil {
 			r.metrics.IncSelinuxProfileError(reasonCannotUpdatePolicyStatus)
 			r.record.Event(sp, util.EventTypeWarning, reasonCannotUpdatePolicyStatus, err.Error())
 			return reconcile.Result{}, fmt.Errorf("setting node status to in progress: %w", err)
 		}
-		return reconcile.Result{Requeue: true}, nil
+		return reconcile.Result{RequeueAfter: policyStatusResyncPeriod}, nil
 	}
 
 	if err != nil {
@@ -299,201 +395,107 @@ func (r *ReconcileSelinux) reconcilePolicy(
 
 	l.Info("Policy deployed", "status", polState)
 
-	if err := nodeStatus.SetNodeStatus(ctx, polState); err != nil {
+	switch polState {
+	case statusv1alpha1.ProfileStateInstalled:
+		err = nodeStatus.SetNodeStatusInstalled(ctx, policyContent)
+	case statusv1alpha1.ProfileStateError:
+		err = nodeStatus.SetNodeStatusError(ctx, reasonCannotInstallPolicy, polStatus.Msg)
+	default:
+		err = nodeStatus.SetNodeStatus(ctx, polState)
+	}
+	if err != nil {
 		r.metrics.IncSelinuxProfileError(reasonCannotUpdatePolicyStatus)
 		r.record.Event(sp, util.EventTypeWarning, reasonCannotUpdatePolicyStatus, err.Error())
 		return reconcile.Result{}, fmt.Errorf("setting profile status: %w", err)
 	}
 
+	if polState == statusv1alpha1.ProfileStateInstalled {
+		return r.checkCanary(ctx, sp, nodeStatus, l)
+	}
 	return reconcile.Result{}, nil
 }
 
-func (r *ReconcileSelinux) reconcilePolicyFile(
+// checkCanary halts the staged rollout of the profile if violations of it
+// got observed on this canary node.
+func (r *ReconcileSelinux) checkCanary(
+	ctx context.Context,
+	sp selxv1alpha2.SelinuxProfileObject,
+	nsc *nodestatus.StatusClient,
+	l logr.Logger,
+) (reconcile.Result, error) {
+	return nsc.ReconcileCanary(ctx, r.record, l, func(ctx context.Context) (time.Time, error) {
+		return enricher.LastViolation(ctx, enrichertypes.AuditTypeSelinux, sp.GetNamespace(), sp.GetName())
+	}, r.metrics.IncSelinuxProfileError)
+}
+
+// reconcilePolicyInstall hands the CIL policy of the profile over to the
+// backend and returns it.
+func (r *ReconcileSelinux) reconcilePolicyInstall(
+	ctx context.Context,
 	sp selxv1alpha2.SelinuxProfileObject,
 	oh SelinuxObjectHandler,
-	l logr.Logger,
-) error {
-	policyPath := path.Join(bindata.SelinuxDropDirectory, sp.GetPolicyName()+".cil")
+) ([]byte, error) {
 	cil, parseErr := oh.GetCILPolicy()
 	if parseErr != nil {
-		return fmt.Errorf("generating CIL: %w", parseErr)
+		return nil, fmt.Errorf("generating CIL: %w", parseErr)
 	}
 	policyContent := []byte(cil)
 
-	if err := writeFileIfDiffers(policyPath, policyContent, l); err != nil {
-		return fmt.Errorf("writing policy file: %w", err)
+	if err := r.backend.InstallPolicy(ctx, sp.GetPolicyName(), policyContent); err != nil {
+		return nil, fmt.Errorf("installing policy with %s: %w", r.backend.Name(), err)
 	}
 
-	return nil
+	return policyContent, nil
 }
 
+// reconcileDeletePolicy removes the policy of the profile. It returns true
+// once the backend removed the policy.
 func (r *ReconcileSelinux) reconcileDeletePolicy(
 	ctx context.Context,
 	sp selxv1alpha2.SelinuxProfileObject,
 	nodeStatus *nodestatus.StatusClient,
 	l logr.Logger,
-) (reconcile.Result, error) {
-	selinuxdReady, err := isSelinuxdReady(ctx)
+) (bool, reconcile.Result, error) {
+	backendReady, err := r.backend.Ready(ctx)
 	if err != nil {
-		return reconcile.Result{}, fmt.Errorf("contacting selinuxd: %w", err)
+		return false, reconcile.Result{}, fmt.Errorf("contacting %s: %w", r.backend.Name(), err)
 	}
-	if !selinuxdReady {
-		l.Info("selinuxd not yet up, requeue")
-		return reconcile.Result{Requeue: true}, nil
+	if !backendReady {
+		l.Info("SELinux policy backend not yet ready, requeue", "backend", r.backend.Name())
+		return false, reconcile.Result{Requeue: true}, nil
 	}
 
-	res, err := r.reconcileDeletePolicyFile(sp, l)
-	if res.Requeue || err != nil {
-		return res, err
+	l.Info("Removing policy", "policyName", sp.GetPolicyName())
+	if err := r.backend.RemovePolicy(ctx, sp.GetPolicyName()); err != nil {
+		return false, reconcile.Result{Requeue: true}, fmt.Errorf("removing policy: %w", err)
 	}
 
 	l.Info("Checking if policy is removed", "policyName", sp.GetName())
-	polStatus, err := getPolicyStatus(ctx, sp)
+	polStatus, err := r.backend.PolicyStatus(ctx, sp.GetPolicyName())
 
 	if errors.Is(err, errPolicyNotFound) {
-		return reconcile.Result{}, nil
+		r.metrics.IncSelinuxProfileDelete()
+		l.Info("Policy removed")
+		return true, reconcile.Result{}, nil
 	}
 
 	if err != nil {
 		r.metrics.IncSelinuxProfileError(reasonCannotGetPolicyStatus)
-		return reconcile.Result{}, fmt.Errorf("looking up policy status: %w", err)
+		return false, reconcile.Result{}, fmt.Errorf("looking up policy status: %w", err)
 	}
 
-	switch polStatus.Status {
-	case installedStatus:
-		l.Info("Policy still installed, requeue")
-		return reconcile.Result{Requeue: true}, nil
-	case failedStatus:
-		if err := nodeStatus.SetNodeStatus(ctx, statusv1alpha1.ProfileStateError); err != nil {
+	if polStatus.Status == failedStatus {
+		if err := nodeStatus.SetNodeStatusError(ctx, reasonCannotRemovePolicy, polStatus.Msg); err != nil {
 			r.metrics.IncSelinuxProfileError(reasonCannotRemovePolicy)
-			return reconcile.Result{}, fmt.Errorf("updating SELinux policy with installation: %w", err)
+			return false, reconcile.Result{}, fmt.Errorf("updating SELinux policy with installation: %w", err)
 		}
 
 		evstr := fmt.Sprintf("Failed to save profile to disk on %s: %s", os.Getenv(config.NodeNameEnvKey), polStatus.Msg)
 		r.record.Event(sp, util.EventTypeWarning, reasonCannotInstallPolicy, evstr)
-		return reconcile.Result{}, nil
-	}
-
-	r.metrics.IncSelinuxProfileDelete()
-	l.Info("Policy removed")
-	return reconcile.Result{}, nil
-}
-
-func (r *ReconcileSelinux) reconcileDeletePolicyFile(sp selxv1alpha2.SelinuxProfileObject,
-	l logr.Logger,
-) (reconcile.Result, error) {
-	policyPath := path.Join(bindata.SelinuxDropDirectory, sp.GetPolicyName()+".cil")
-
-	l.Info("Removing policy file", "policyPath", policyPath)
-	err := os.Remove(policyPath)
-	if err == nil {
-		// Reconcile again to make sure the file is gone
-		return reconcile.Result{Requeue: true}, nil
+		return false, reconcile.Result{RequeueAfter: policyStatusResyncPeriod}, nil
 	}
 
-	var osPathErr *os.PathError
-	if errors.As(err, &osPathErr) {
-		if errors.Is(osPathErr.Err, os.ErrNotExist) {
-			// The file is gone, stop requeuing
-			return reconcile.Result{}, nil
-		}
-	}
-
-	// Retry on a generic error
-	return reconcile.Result{Requeue: true}, fmt.Errorf("error removing policy file: %w", err)
-}
-
-func getPolicyStatus(
-	ctx context.Context,
-	sp selxv1alpha2.SelinuxProfileObject,
-) (*sePolStatus, error) {
-	polURL := selinuxdPoliciesBaseURL + sp.GetPolicyName()
-	response, err := selinuxdGetRequest(ctx, polURL)
-	if err != nil {
-		return nil, fmt.Errorf("failed to send a request to selinuxd: %w", err)
-	}
-	defer response.Body.Close()
-
-	if response.StatusCode == http.StatusNotFound {
-		return nil, errPolicyNotFound
-	} else if response.StatusCode != http.StatusOK {
-		return nil, errors.New("unexpected HTTP error code " + fmt.Sprint(response.StatusCode))
-	}
-
-	var status sePolStatus
-	err = json.NewDecoder(response.Body).Decode(&status)
-	if err != nil {
-		return nil, fmt.Errorf("failed to decode response from selinuxd: %w", err)
-	}
-
-	switch status.Status {
-	case installedStatus, failedStatus:
-		return &status, nil
-	}
-
-	return nil, errors.New("invalid sePolStatus value")
-}
-
-func isSelinuxdReady(ctx context.Context) (bool, error) {
-	response, err := selinuxdGetRequest(ctx, selinuxdReadyURL)
-	if err != nil {
-		return false, fmt.Errorf("failed to send a request to selinuxd: %w", err)
-	}
-	defer response.Body.Close()
-
-	var status map[string]bool
-	err = json.NewDecoder(response.Body).Decode(&status)
-	if err != nil {
-		return false, fmt.Errorf("failed to decode response from selinuxd: %w", err)
-	}
-
-	return status[selinuxdReadyKey], nil
-}
-
-func selinuxdGetRequest(ctx context.Context, url string) (*http.Response, error) {
-	httpc := http.Client{
-		Transport: &http.Transport{
-			DialContext: func(_ context.Context, _, _ string) (net.Conn, error) {
-				return net.Dial("unix", bindata.SelinuxdSocketPath)
-			},
-		},
-	}
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create a request to selinuxd: %w", err)
-	}
-
-	return httpc.Do(req)
-}
-
-// writeFileIfDiffers checks if the content of file at filePath are the same as the byte array
-// contents, if not, overwrites the file at filePath.
-//
-// Reopening the same file may seem wasteful and even look like a TOCTOU issue, but the policy
-// drop dir is private to this pod, but mostly just calling a single write is much easier codepath
-// than mucking around with seeks and truncates to account for all the corner cases.
-func writeFileIfDiffers(filePath string, contents []byte, l logr.Logger) error {
-	const filePermissions = 0o600
-	file, err := os.OpenFile(filePath, os.O_RDONLY, filePermissions)
-	if os.IsNotExist(err) {
-		file.Close()
-		return os.WriteFile(filePath, contents, filePermissions)
-	} else if err != nil {
-		return fmt.Errorf("could not open for reading: %w"+filePath, err)
-	}
-	defer file.Close()
-
-	existing, err := io.ReadAll(file)
-	if err != nil {
-		return fmt.Errorf("reading file : %w"+filePath, err)
-	}
-
-	if bytes.Equal(existing, contents) {
-		return nil
-	}
-
-	l.Info("Writing to policy file", "policyPath", filePath)
-
-	return os.WriteFile(filePath, contents, filePermissions)
+	// The backend pushes the status change once the policy got removed
+	l.Info("Policy still installed, waiting for the backend")
+	return false, reconcile.Result{RequeueAfter: policyStatusResyncPeriod}, nil
 }
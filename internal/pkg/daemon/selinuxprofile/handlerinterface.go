@@ -27,7 +27,7 @@ import (
 	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
 )
 
-type controllerBuilder func(*ctrl.Builder, reconcile.Reconciler) error
+type controllerBuilder func(context.Context, *ctrl.Builder, client.Client, reconcile.Reconciler) error
 
 type SelinuxObjectHandler interface {
 	Init(context.Context, client.Client, types.NamespacedName) error
@@ -0,0 +1,112 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package selinuxprofile
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"sync"
+
+	"github.com/go-logr/logr"
+
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+)
+
+type sePolStatusType string
+
+const (
+	installedStatus sePolStatusType = "Installed"
+	failedStatus    sePolStatusType = "Failed"
+)
+
+type sePolStatus struct {
+	Msg    string          `json:"msg"`
+	Status sePolStatusType `json:"status"`
+}
+
+// PolicyBackend installs the CIL policies of the SELinux profiles on the
+// node.
+type PolicyBackend interface {
+	// Name returns the name of the backend.
+	Name() string
+
+	// Ready returns true if the backend is able to manage policies.
+	Ready(ctx context.Context) (bool, error)
+
+	// InstallPolicy installs or updates the policy with the given name. The
+	// installation may complete asynchronously, in which case the status
+	// change is pushed to the watchers.
+	InstallPolicy(ctx context.Context, name string, cil []byte) error
+
+	// RemovePolicy removes the policy with the given name. The removal may
+	// complete asynchronously, in which case the status change is pushed to
+	// the watchers.
+	RemovePolicy(ctx context.Context, name string) error
+
+	// PolicyStatus returns the status of the policy with the given name, or
+	// errPolicyNotFound if it is not installed.
+	PolicyStatus(ctx context.Context, name string) (*sePolStatus, error)
+
+	// Watch registers a function which gets called with the name of a policy
+	// whenever its status changed.
+	Watch(fn func(name string))
+}
+
+// NewPolicyBackend returns the policy backend with the given name. The
+// semodule backend falls back to selinuxd if the daemon image does not ship
+// semodule.
+func NewPolicyBackend(name spodv1alpha1.SelinuxBackend, logger logr.Logger) (PolicyBackend, error) {
+	return newPolicyBackend(name, logger, exec.LookPath)
+}
+
+func newPolicyBackend(
+	name spodv1alpha1.SelinuxBackend, logger logr.Logger, lookPath func(string) (string, error),
+) (PolicyBackend, error) {
+	switch name {
+	case spodv1alpha1.SelinuxBackendSelinuxd, "":
+		return newSelinuxdBackend(logger), nil
+	case spodv1alpha1.SelinuxBackendSemodule:
+		if _, err := lookPath(semoduleCmd); err != nil {
+			logger.Info("The daemon image does not ship semodule, falling back to selinuxd", "error", err.Error())
+			return newSelinuxdBackend(logger), nil
+		}
+		return newSemoduleBackend(logger), nil
+	}
+	return nil, fmt.Errorf("unknown SELinux policy backend: %s", name)
+}
+
+// policyWatchers notifies the watchers of a policy backend about status
+// changes.
+type policyWatchers struct {
+	sync.RWMutex
+	fns []func(string)
+}
+
+func (w *policyWatchers) Watch(fn func(name string)) {
+	w.Lock()
+	defer w.Unlock()
+	w.fns = append(w.fns, fn)
+}
+
+func (w *policyWatchers) notify(name string) {
+	w.RLock()
+	defer w.RUnlock()
+	for _, fn := range w.fns {
+		fn(name)
+	}
+}
@@ -0,0 +1,394 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package selinuxprofile
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+)
+
+const notifyTimeout = 10 * time.Second
+
+// fakePolicyBackend is an in-memory PolicyBackend, which completes the
+// installations and removals when the test sets the status of the policy.
+type fakePolicyBackend struct {
+	policyWatchers
+	mu       sync.Mutex
+	ready    bool
+	policies map[string][]byte
+	statuses map[string]*sePolStatus
+}
+
+func newFakePolicyBackend() *fakePolicyBackend {
+	return &fakePolicyBackend{
+		ready:    true,
+		policies: map[string][]byte{},
+		statuses: map[string]*sePolStatus{},
+	}
+}
+
+func (f *fakePolicyBackend) Name() string {
+	return "fake"
+}
+
+func (f *fakePolicyBackend) Ready(context.Context) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.ready, nil
+}
+
+func (f *fakePolicyBackend) InstallPolicy(_ context.Context, name string, cil []byte) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.policies[name] = cil
+	return nil
+}
+
+func (f *fakePolicyBackend) RemovePolicy(_ context.Context, name string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.policies, name)
+	return nil
+}
+
+func (f *fakePolicyBackend) PolicyStatus(_ context.Context, name string) (*sePolStatus, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	status, ok := f.statuses[name]
+	if !ok {
+		return nil, errPolicyNotFound
+	}
+	return status, nil
+}
+
+// setStatus sets the status of the policy, removing it if nil, and notifies
+// the watchers.
+func (f *fakePolicyBackend) setStatus(name string, status *sePolStatus) {
+	f.mu.Lock()
+	if status == nil {
+		delete(f.statuses, name)
+	} else {
+		f.statuses[name] = status
+	}
+	f.mu.Unlock()
+	f.notify(name)
+}
+
+// notifications returns a channel receiving the names of the policies
+// the backend notifies about.
+func notifications(backend PolicyBackend) <-chan string {
+	ch := make(chan string, 10)
+	backend.Watch(func(name string) { ch <- name })
+	return ch
+}
+
+func waitForNotification(t *testing.T, ch <-chan string, name string) {
+	t.Helper()
+	select {
+	case got := <-ch:
+		require.Equal(t, name, got)
+	case <-time.After(notifyTimeout):
+		t.Fatalf("no status change of policy %s", name)
+	}
+}
+
+func TestNewPolicyBackend(t *testing.T) {
+	t.Parallel()
+
+	found := func(file string) (string, error) { return "/usr/sbin/" + file, nil }
+	notFound := func(string) (string, error) { return "", exec.ErrNotFound }
+
+	for _, tc := range []struct {
+		name     spodv1alpha1.SelinuxBackend
+		lookPath func(string) (string, error)
+		expected string
+	}{
+		{"", found, "selinuxd"},
+		{spodv1alpha1.SelinuxBackendSelinuxd, found, "selinuxd"},
+		{spodv1alpha1.SelinuxBackendSemodule, found, "semodule"},
+		{spodv1alpha1.SelinuxBackendSemodule, notFound, "selinuxd"},
+	} {
+		backend, err := newPolicyBackend(tc.name, logr.Discard(), tc.lookPath)
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, backend.Name())
+	}
+
+	_, err := NewPolicyBackend("unknown", logr.Discard())
+	require.Error(t, err)
+}
+
+func TestPolicyChanged(t *testing.T) {
+	t.Parallel()
+
+	schemeInstance := runtime.NewScheme()
+	require.NoError(t, selxv1alpha2.AddToScheme(schemeInstance))
+	cli := fake.NewClientBuilder().WithScheme(schemeInstance).WithObjects(
+		&selxv1alpha2.SelinuxProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "profile", Namespace: "ns"},
+		},
+	).Build()
+
+	backend := newFakePolicyBackend()
+	r := &ReconcileSelinux{
+		log:               logr.Discard(),
+		client:            cli,
+		backend:           backend,
+		objectHandlerInit: newSelinuxProfileHandler,
+		events:            make(chan event.GenericEvent, 1),
+	}
+	backend.Watch(r.policyChanged)
+
+	// Policies of unknown profiles are ignored
+	backend.setStatus("unknown_ns", &sePolStatus{Status: installedStatus})
+	backend.setStatus("invalid", &sePolStatus{Status: installedStatus})
+	require.Empty(t, r.events)
+
+	// The profile does not need to be reconciled before, like after a
+	// restart of the daemon
+	backend.setStatus("profile_ns", &sePolStatus{Status: installedStatus})
+	require.Len(t, r.events, 1)
+
+	// Status changes block the backend instead of getting dropped
+	done := make(chan struct{})
+	go func() {
+		backend.setStatus("profile_ns", &sePolStatus{Status: failedStatus})
+		close(done)
+	}()
+	for i := 0; i < 2; i++ {
+		select {
+		case ev := <-r.events:
+			require.Equal(t, "profile", ev.Object.GetName())
+			require.Equal(t, "ns", ev.Object.GetNamespace())
+		case <-time.After(notifyTimeout):
+			require.Fail(t, "policy status change got dropped")
+		}
+	}
+	<-done
+}
+
+func TestPolicyKey(t *testing.T) {
+	t.Parallel()
+
+	for policyName, expected := range map[string]*types.NamespacedName{
+		"profile_ns": {Name: "profile", Namespace: "ns"},
+		"profile_":   {Name: "profile"},
+		"profile":    nil,
+		"_ns":        nil,
+	} {
+		key, ok := policyKey(policyName)
+		if expected == nil {
+			require.False(t, ok, policyName)
+			continue
+		}
+		require.True(t, ok, policyName)
+		require.Equal(t, *expected, key)
+	}
+}
+
+func TestSemoduleBackend(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	commands := []string{}
+	backend := newSemoduleBackend(logr.Discard())
+	backend.workDir = t.TempDir()
+	backend.run = func(_ context.Context, dir, name string, args ...string) ([]byte, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		commands = append(commands, name+" "+strings.Join(args, " "))
+
+		switch {
+		case args[0] == "--list-modules=standard":
+			return []byte("existing_ns\nchanged_ns\ncontainer\n"), nil
+		case args[0] == "--cil":
+			// The policies installed before the start get extracted into
+			// the working directory
+			module := strings.TrimPrefix(args[1], "--extract=")
+			return nil, os.WriteFile(filepath.Join(dir, module+".cil"), []byte("(block "+module+")"), 0o600)
+		case strings.HasSuffix(args[0], "broken_ns.cil"):
+			return []byte("syntax error"), errors.New("exit status 1")
+		case strings.HasPrefix(args[0], "--install="):
+			// The policy file has to exist while semodule runs
+			_, err := os.Stat(strings.TrimPrefix(args[0], "--install="))
+			return nil, err
+		}
+		return nil, nil
+	}
+	ch := notifications(backend)
+	ctx := context.Background()
+
+	ready, err := backend.Ready(ctx)
+	require.NoError(t, err)
+	require.True(t, ready)
+	status, err := backend.PolicyStatus(ctx, "existing_ns")
+	require.NoError(t, err)
+	require.Equal(t, installedStatus, status.Status)
+
+	// Installation
+	require.NoError(t, backend.InstallPolicy(ctx, "profile_ns", []byte("(block profile_ns)")))
+	_, err = backend.PolicyStatus(ctx, "profile_ns")
+	require.ErrorIs(t, err, errPolicyNotFound)
+	waitForNotification(t, ch, "profile_ns")
+	status, err = backend.PolicyStatus(ctx, "profile_ns")
+	require.NoError(t, err)
+	require.Equal(t, installedStatus, status.Status)
+
+	// Installing the same policy again is a no-op
+	require.NoError(t, backend.InstallPolicy(ctx, "profile_ns", []byte("(block profile_ns)")))
+	_, err = backend.PolicyStatus(ctx, "profile_ns")
+	require.NoError(t, err)
+
+	// Policies installed before the start only get reinstalled if they
+	// changed
+	require.NoError(t, backend.InstallPolicy(ctx, "existing_ns", []byte("(block existing_ns)")))
+	_, err = backend.PolicyStatus(ctx, "existing_ns")
+	require.NoError(t, err)
+	require.NoError(t, backend.InstallPolicy(ctx, "changed_ns", []byte("(block changed_ns (type t))")))
+	waitForNotification(t, ch, "changed_ns")
+	status, err = backend.PolicyStatus(ctx, "changed_ns")
+	require.NoError(t, err)
+	require.Equal(t, installedStatus, status.Status)
+
+	// Failed installation
+	require.NoError(t, backend.InstallPolicy(ctx, "broken_ns", []byte("(block broken_ns")))
+	waitForNotification(t, ch, "broken_ns")
+	status, err = backend.PolicyStatus(ctx, "broken_ns")
+	require.NoError(t, err)
+	require.Equal(t, failedStatus, status.Status)
+	require.Contains(t, status.Msg, "syntax error")
+
+	// Removal of the policy and of a policy installed before the start
+	require.NoError(t, backend.RemovePolicy(ctx, "profile_ns"))
+	waitForNotification(t, ch, "profile_ns")
+	require.NoError(t, backend.RemovePolicy(ctx, "existing_ns"))
+	waitForNotification(t, ch, "existing_ns")
+	for _, name := range []string{"profile_ns", "existing_ns"} {
+		_, err = backend.PolicyStatus(ctx, name)
+		require.ErrorIs(t, err, errPolicyNotFound)
+	}
+
+	// Removing an unknown policy is a no-op
+	require.NoError(t, backend.RemovePolicy(ctx, "unknown_ns"))
+
+	files, err := os.ReadDir(backend.workDir)
+	require.NoError(t, err)
+	require.Empty(t, files)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, []string{
+		"semodule --list-modules=standard",
+		"semodule --install=" + filepath.Join(backend.workDir, "profile_ns.cil"),
+		"semodule --cil --extract=existing_ns",
+		"semodule --cil --extract=changed_ns",
+		"semodule --install=" + filepath.Join(backend.workDir, "changed_ns.cil"),
+		"semodule --install=" + filepath.Join(backend.workDir, "broken_ns.cil"),
+		"semodule --remove=profile_ns",
+		"semodule --remove=existing_ns",
+	}, commands)
+}
+
+// fakeSelinuxd serves the status of the policies of the drop directory.
+// Like selinuxd, it installs and removes the policies asynchronously, which
+// becomes visible with the next status query.
+func fakeSelinuxd(t *testing.T, dropDir string) string {
+	t.Helper()
+
+	socketPath := filepath.Join(t.TempDir(), "selinuxd.sock")
+	listener, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ready", func(w http.ResponseWriter, _ *http.Request) {
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]bool{selinuxdReadyKey: true}))
+	})
+	var mu sync.Mutex
+	installed := map[string]bool{}
+	mux.HandleFunc("/policies/", func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimPrefix(r.URL.Path, "/policies/")
+		_, err := os.Stat(filepath.Join(dropDir, name+".cil"))
+
+		mu.Lock()
+		wasInstalled := installed[name]
+		installed[name] = err == nil
+		mu.Unlock()
+
+		if !wasInstalled {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(sePolStatus{Status: installedStatus}))
+	})
+
+	server := &http.Server{Handler: mux, ReadHeaderTimeout: time.Second}
+	go server.Serve(listener) //nolint:errcheck // closed by the cleanup
+	t.Cleanup(func() { server.Close() })
+
+	return socketPath
+}
+
+func TestSelinuxdBackend(t *testing.T) {
+	t.Parallel()
+
+	backend := newSelinuxdBackend(logr.Discard())
+	backend.dropDir = t.TempDir()
+	backend.socketPath = fakeSelinuxd(t, backend.dropDir)
+	ch := notifications(backend)
+	ctx := context.Background()
+
+	ready, err := backend.Ready(ctx)
+	require.NoError(t, err)
+	require.True(t, ready)
+
+	require.NoError(t, backend.InstallPolicy(ctx, "profile_ns", []byte("(block profile_ns)")))
+	waitForNotification(t, ch, "profile_ns")
+	status, err := backend.PolicyStatus(ctx, "profile_ns")
+	require.NoError(t, err)
+	require.Equal(t, installedStatus, status.Status)
+
+	require.NoError(t, backend.RemovePolicy(ctx, "profile_ns"))
+	waitForNotification(t, ch, "profile_ns")
+	_, err = backend.PolicyStatus(ctx, "profile_ns")
+	require.ErrorIs(t, err, errPolicyNotFound)
+
+	// Removing a policy which is not installed does not poll
+	require.NoError(t, backend.RemovePolicy(ctx, "unknown_ns"))
+	backend.mu.Lock()
+	defer backend.mu.Unlock()
+	require.Empty(t, backend.pending)
+}
@@ -30,6 +30,8 @@ import (
 
 	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/controller"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/common"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/nodestatus"
 )
 
 // The underscore is not a valid character in a pod, so we can
@@ -38,17 +40,25 @@ const profileWrapper = `(block {{.Name}}_{{.Namespace}}
     {{.Policy}}
 )`
 
-// NewController returns a new empty controller instance.
-func NewRawController() controller.Controller {
+// NewRawController returns a new empty controller instance for raw SELinux
+// profiles.
+func NewRawController(backend PolicyBackend) controller.Controller {
 	return &ReconcileSelinux{
+		backend:           backend,
 		controllerName:    "rawselinuxprofile",
 		objectHandlerInit: newRawSelinuxProfileHandler,
 		ctrlBuilder:       rawSelinuxProfileControllerBuild,
 	}
 }
 
-func rawSelinuxProfileControllerBuild(b *ctrl.Builder, r reconcile.Reconciler) error {
-	return b.Named("rawselinuxprofile").
+func rawSelinuxProfileControllerBuild(
+	ctx context.Context, b *ctrl.Builder, cli client.Client, r reconcile.Reconciler,
+) error {
+	b = nodestatus.WatchNodeLabels(b, cli, func() client.ObjectList {
+		return &selxv1alpha2.RawSelinuxProfileList{}
+	})
+	return common.WatchNamespaceScope(ctx, b, cli).
+		Named("rawselinuxprofile").
 		For(&selxv1alpha2.RawSelinuxProfile{}).
 		Complete(r)
 }
@@ -0,0 +1,275 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package selinuxprofile
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/spod/bindata"
+)
+
+const (
+	selinuxdSockAddr        = "http://unix"
+	selinuxdPoliciesBaseURL = selinuxdSockAddr + "/policies/"
+	selinuxdReadyURL        = selinuxdSockAddr + "/ready"
+
+	selinuxdReadyKey = "ready"
+
+	// selinuxdPollInterval is the interval in which the status of the
+	// policies pending in selinuxd gets checked.
+	selinuxdPollInterval = time.Second
+
+	// selinuxdPendingTimeout is the time after which the watchers get
+	// notified about a pending policy even if its status did not change,
+	// for example because selinuxd reports the same status after updating
+	// a policy.
+	selinuxdPendingTimeout = 30 * time.Second
+)
+
+// pendingPolicy is a policy whose status is expected to change.
+type pendingPolicy struct {
+	status *sePolStatus
+	since  time.Time
+}
+
+// selinuxdBackend installs the policies by writing them into the drop
+// directory of selinuxd. Since selinuxd does not provide status events, the
+// backend polls the status of the pending policies and pushes their changes
+// to the watchers.
+type selinuxdBackend struct {
+	policyWatchers
+	log        logr.Logger
+	dropDir    string
+	socketPath string
+
+	mu      sync.Mutex
+	pending map[string]*pendingPolicy
+	polling bool
+}
+
+func newSelinuxdBackend(logger logr.Logger) *selinuxdBackend {
+	return &selinuxdBackend{
+		log:        logger,
+		dropDir:    bindata.SelinuxDropDirectory,
+		socketPath: bindata.SelinuxdSocketPath,
+		pending:    map[string]*pendingPolicy{},
+	}
+}
+
+func (b *selinuxdBackend) Name() string {
+	return string(spodv1alpha1.SelinuxBackendSelinuxd)
+}
+
+func (b *selinuxdBackend) Ready(ctx context.Context) (bool, error) {
+	response, err := b.get(ctx, selinuxdReadyURL)
+	if err != nil {
+		return false, fmt.Errorf("failed to send a request to selinuxd: %w", err)
+	}
+	defer response.Body.Close()
+
+	var status map[string]bool
+	err = json.NewDecoder(response.Body).Decode(&status)
+	if err != nil {
+		return false, fmt.Errorf("failed to decode response from selinuxd: %w", err)
+	}
+
+	return status[selinuxdReadyKey], nil
+}
+
+func (b *selinuxdBackend) InstallPolicy(ctx context.Context, name string, cil []byte) error {
+	policyPath := path.Join(b.dropDir, name+".cil")
+	changed, err := writeFileIfDiffers(policyPath, cil, b.log)
+	if err != nil {
+		return fmt.Errorf("writing policy file: %w", err)
+	}
+
+	status, err := b.PolicyStatus(ctx, name)
+	if errors.Is(err, errPolicyNotFound) || (changed && err == nil) {
+		b.watchPending(name, status)
+	}
+	return nil
+}
+
+func (b *selinuxdBackend) RemovePolicy(ctx context.Context, name string) error {
+	policyPath := path.Join(b.dropDir, name+".cil")
+
+	b.log.Info("Removing policy file", "policyPath", policyPath)
+	if err := os.Remove(policyPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("removing policy file: %w", err)
+	}
+
+	if status, err := b.PolicyStatus(ctx, name); err == nil {
+		b.watchPending(name, status)
+	}
+	return nil
+}
+
+func (b *selinuxdBackend) PolicyStatus(ctx context.Context, name string) (*sePolStatus, error) {
+	response, err := b.get(ctx, selinuxdPoliciesBaseURL+name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send a request to selinuxd: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusNotFound {
+		return nil, errPolicyNotFound
+	} else if response.StatusCode != http.StatusOK {
+		return nil, errors.New("unexpected HTTP error code " + fmt.Sprint(response.StatusCode))
+	}
+
+	var status sePolStatus
+	err = json.NewDecoder(response.Body).Decode(&status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode response from selinuxd: %w", err)
+	}
+
+	switch status.Status {
+	case installedStatus, failedStatus:
+		return &status, nil
+	}
+
+	return nil, errors.New("invalid sePolStatus value")
+}
+
+// watchPending starts polling the status of the policy until it differs
+// from the given one, which is nil if the policy is not yet known.
+func (b *selinuxdBackend) watchPending(name string, status *sePolStatus) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.pending[name] = &pendingPolicy{status: status, since: time.Now()}
+	if !b.polling {
+		b.polling = true
+		go b.poll()
+	}
+}
+
+// poll checks the status of the pending policies until none is left.
+func (b *selinuxdBackend) poll() {
+	ticker := time.NewTicker(selinuxdPollInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		b.mu.Lock()
+		pending := make(map[string]*pendingPolicy, len(b.pending))
+		for name, p := range b.pending {
+			pending[name] = p
+		}
+		b.mu.Unlock()
+
+		changed := []string{}
+		for name, p := range pending {
+			status, err := b.PolicyStatus(context.Background(), name)
+			if err != nil && !errors.Is(err, errPolicyNotFound) {
+				b.log.Error(err, "Cannot get policy status", "policyName", name)
+				continue
+			}
+			if statusChanged(p.status, status) || time.Since(p.since) > selinuxdPendingTimeout {
+				changed = append(changed, name)
+			}
+		}
+
+		b.mu.Lock()
+		for _, name := range changed {
+			// The policy might have been updated again in the meantime
+			if b.pending[name] == pending[name] {
+				delete(b.pending, name)
+			}
+		}
+		done := len(b.pending) == 0
+		if done {
+			b.polling = false
+		}
+		b.mu.Unlock()
+
+		for _, name := range changed {
+			b.notify(name)
+		}
+		if done {
+			return
+		}
+	}
+}
+
+func statusChanged(previous, current *sePolStatus) bool {
+	if previous == nil || current == nil {
+		return previous != current
+	}
+	return *previous != *current
+}
+
+func (b *selinuxdBackend) get(ctx context.Context, url string) (*http.Response, error) {
+	httpc := http.Client{
+		Transport: &http.Transport{
+			DialContext: func(_ context.Context, _, _ string) (net.Conn, error) {
+				return net.Dial("unix", b.socketPath)
+			},
+		},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a request to selinuxd: %w", err)
+	}
+
+	return httpc.Do(req)
+}
+
+// writeFileIfDiffers checks if the content of file at filePath are the same as the byte array
+// contents, if not, overwrites the file at filePath. It returns true if the file got written.
+//
+// Reopening the same file may seem wasteful and even look like a TOCTOU issue, but the policy
+// drop dir is private to this pod, but mostly just calling a single write is much easier codepath
+// than mucking around with seeks and truncates to account for all the corner cases.
+func writeFileIfDiffers(filePath string, contents []byte, l logr.Logger) (bool, error) {
+	const filePermissions = 0o600
+	file, err := os.OpenFile(filePath, os.O_RDONLY, filePermissions)
+	if os.IsNotExist(err) {
+		file.Close()
+		return true, os.WriteFile(filePath, contents, filePermissions)
+	} else if err != nil {
+		return false, fmt.Errorf("could not open for reading: %w"+filePath, err)
+	}
+	defer file.Close()
+
+	existing, err := io.ReadAll(file)
+	if err != nil {
+		return false, fmt.Errorf("reading file : %w"+filePath, err)
+	}
+
+	if bytes.Equal(existing, contents) {
+		return false, nil
+	}
+
+	l.Info("Writing to policy file", "policyPath", filePath)
+
+	return true, os.WriteFile(filePath, contents, filePermissions)
+}
@@ -21,9 +21,11 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strings"
 
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -31,6 +33,7 @@ import (
 	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/controller"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/common"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/nodestatus"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/translator"
 )
 
@@ -40,32 +43,82 @@ var (
 	ErrInvalidPermission       = errors.New("invalid permission")
 	ErrSystemInheritNotAllowed = errors.New("system profile not allowed")
 	ErrUnknownKindForEntry     = errors.New("unknown inherit kind for entry")
+	ErrNamespacedInherit       = errors.New("cluster scoped profiles cannot inherit namespaced profiles")
+	ErrInvalidTypeDefinition   = errors.New("invalid type definition")
+	ErrInvalidFileContext      = errors.New("invalid file context")
+	ErrInvalidPortContext      = errors.New("invalid port context")
+	ErrInvalidTypeTransition   = errors.New("invalid type transition")
+	ErrInvalidTypeAttribute    = errors.New("invalid type attribute")
+	ErrNamespacedContexts      = errors.New("file and port contexts are only allowed in cluster scoped profiles")
+	ErrNamespacedAttributes    = errors.New("type attributes are only allowed in cluster scoped profiles")
+)
+
+const maxPort = 65535
+
+var (
+	fileContextTypes = sets.New("any", "file", "dir", "symlink", "pipe", "socket", "char", "block")
+	portProtocols    = sets.New("tcp", "udp", "sctp", "dccp")
 )
 
 // NewController returns a new empty controller instance.
-func NewController() controller.Controller {
+func NewController(backend PolicyBackend) controller.Controller {
 	return &ReconcileSelinux{
+		backend:           backend,
+		recordBackend:     true,
 		controllerName:    "selinuxprofile",
 		objectHandlerInit: newSelinuxProfileHandler,
 		ctrlBuilder:       selinuxProfileControllerBuild,
 	}
 }
 
-func selinuxProfileControllerBuild(b *ctrl.Builder, r reconcile.Reconciler) error {
-	return b.Named("selinuxprofile").
+func selinuxProfileControllerBuild(
+	ctx context.Context, b *ctrl.Builder, cli client.Client, r reconcile.Reconciler,
+) error {
+	b = nodestatus.WatchNodeLabels(b, cli, func() client.ObjectList {
+		return &selxv1alpha2.SelinuxProfileList{}
+	})
+	return common.WatchNamespaceScope(ctx, b, cli).
+		Named("selinuxprofile").
 		For(&selxv1alpha2.SelinuxProfile{}).
 		Complete(r)
 }
 
+// NewClusterController returns a new empty controller instance for cluster
+// scoped SELinux profiles.
+func NewClusterController(backend PolicyBackend) controller.Controller {
+	return &ReconcileSelinux{
+		backend:           backend,
+		controllerName:    "clusterselinuxprofile",
+		objectHandlerInit: newClusterSelinuxProfileHandler,
+		ctrlBuilder:       clusterSelinuxProfileControllerBuild,
+	}
+}
+
+func clusterSelinuxProfileControllerBuild(
+	_ context.Context, b *ctrl.Builder, cli client.Client, r reconcile.Reconciler,
+) error {
+	b = nodestatus.WatchNodeLabels(b, cli, func() client.ObjectList {
+		return &selxv1alpha2.ClusterSelinuxProfileList{}
+	})
+	return b.Named("clusterselinuxprofile").
+		For(&selxv1alpha2.ClusterSelinuxProfile{}).
+		Complete(r)
+}
+
 var _ SelinuxObjectHandler = &selinuxProfileHandler{}
 
 type selinuxProfileHandler struct {
+	// sp is the profile to be translated into a policy. It is a copy of
+	// the cluster scoped profile if cluster is set.
 	sp                *selxv1alpha2.SelinuxProfile
+	obj               selxv1alpha2.SelinuxProfileObject
+	cluster           bool
 	cli               client.Client
 	systemInherits    []string
 	objInherits       []selxv1alpha2.SelinuxProfileObject
 	labelRegex        *regexp.Regexp
 	objClassPermRegex *regexp.Regexp
+	typeNameRegex     *regexp.Regexp
 }
 
 func (sph *selinuxProfileHandler) Init(
@@ -78,8 +131,18 @@ func (sph *selinuxProfileHandler) Init(
 		sph.cli = cli
 	}
 	// initiate the SelinuxProfile object
-	if err := sph.cli.Get(ctx, key, sph.sp); err != nil {
-		return err
+	if sph.cluster {
+		csp := &selxv1alpha2.ClusterSelinuxProfile{}
+		if err := sph.cli.Get(ctx, key, csp); err != nil {
+			return err
+		}
+		sph.obj = csp
+		sph.sp = csp.ToSelinuxProfile()
+	} else {
+		if err := sph.cli.Get(ctx, key, sph.sp); err != nil {
+			return err
+		}
+		sph.obj = sph.sp
 	}
 
 	// Matches alpha numerical names in upper and lower-case, as well as
@@ -93,11 +156,16 @@ func (sph *selinuxProfileHandler) Init(
 	// Must be at least one character.
 	// The characters must match from beginning to end of the string
 	sph.objClassPermRegex = regexp.MustCompile(`^[a-zA-Z0-9.\-_]+$`)
+
+	// Matches the names of the types declared by the profile, which must
+	// start with a lower-case letter followed by lower-case alpha numerical
+	// characters and underscores.
+	sph.typeNameRegex = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
 	return nil
 }
 
 func (sph *selinuxProfileHandler) GetProfileObject() selxv1alpha2.SelinuxProfileObject {
-	return sph.sp
+	return sph.obj
 }
 
 func (sph *selinuxProfileHandler) Validate() error {
@@ -123,9 +191,103 @@ func (sph *selinuxProfileHandler) Validate() error {
 			}
 		}
 	}
+
+	return sph.validateTypes()
+}
+
+// validateTypes validates the declared types and the labeling rules,
+// transitions and attributes referencing them.
+func (sph *selinuxProfileHandler) validateTypes() error {
+	spec := &sph.sp.Spec
+
+	// File and port contexts label objects of the whole node, which must
+	// not be possible for the users of a namespace.
+	if !sph.cluster && (len(spec.FileContexts) > 0 || len(spec.PortContexts) > 0) {
+		return fmt.Errorf("profile %s: %w", sph.sp.GetName(), ErrNamespacedContexts)
+	}
+	// Attributes of the system policy grant the permissions of all their
+	// types, like unconfined_domain_type, which would allow the users of a
+	// namespace to escalate their privileges.
+	if !sph.cluster && len(spec.TypeAttributes) > 0 {
+		return fmt.Errorf("profile %s: %w", sph.sp.GetName(), ErrNamespacedAttributes)
+	}
+
+	declared := map[string]selxv1alpha2.TypeKind{}
+	for _, t := range spec.Types {
+		if !sph.typeNameRegex.MatchString(t.Name) || t.Name == "process" {
+			return fmt.Errorf("'%s' is not a valid type name: %w", t.Name, ErrInvalidTypeDefinition)
+		}
+		if _, ok := declared[t.Name]; ok {
+			return fmt.Errorf("type '%s' declared twice: %w", t.Name, ErrInvalidTypeDefinition)
+		}
+		switch t.Kind {
+		case selxv1alpha2.TypeKindFile, selxv1alpha2.TypeKindPort, selxv1alpha2.TypeKindProcess, "":
+		default:
+			return fmt.Errorf("'%s' is not a valid kind of type %s: %w", t.Kind, t.Name, ErrInvalidTypeDefinition)
+		}
+		declared[t.Name] = t.Kind
+	}
+
+	for _, fc := range spec.FileContexts {
+		if fc.Path == "" || strings.ContainsAny(fc.Path, "\"\n") {
+			return fmt.Errorf("'%s' is not a valid path: %w", fc.Path, ErrInvalidFileContext)
+		}
+		if fc.FileType != "" && !fileContextTypes.Has(fc.FileType) {
+			return fmt.Errorf("'%s' is not a valid file type: %w", fc.FileType, ErrInvalidFileContext)
+		}
+		if err := sph.validateTypeReference(fc.Type); err != nil {
+			return fmt.Errorf("path %s: %w: %w", fc.Path, ErrInvalidFileContext, err)
+		}
+	}
+
+	for _, pc := range spec.PortContexts {
+		if !portProtocols.Has(pc.Protocol) {
+			return fmt.Errorf("'%s' is not a valid protocol: %w", pc.Protocol, ErrInvalidPortContext)
+		}
+		if pc.Port < 1 || pc.Port > maxPort || pc.EndPort < 0 || pc.EndPort > maxPort ||
+			(pc.EndPort != 0 && pc.EndPort < pc.Port) {
+			return fmt.Errorf("%d-%d is not a valid port range: %w", pc.Port, pc.EndPort, ErrInvalidPortContext)
+		}
+		if err := sph.validateTypeReference(pc.Type); err != nil {
+			return fmt.Errorf("port %d: %w: %w", pc.Port, ErrInvalidPortContext, err)
+		}
+	}
+
+	for _, tt := range spec.TypeTransitions {
+		if kind, ok := declared[tt.Target]; !ok || kind != selxv1alpha2.TypeKindProcess {
+			return fmt.Errorf("target '%s' is not a process type of the profile: %w", tt.Target, ErrInvalidTypeTransition)
+		}
+		if err := sph.validateTypeReference(tt.Entrypoint); err != nil {
+			return fmt.Errorf("entrypoint of %s: %w: %w", tt.Target, ErrInvalidTypeTransition, err)
+		}
+	}
+
+	for _, attr := range spec.TypeAttributes {
+		if !sph.typeNameRegex.MatchString(attr.Name) {
+			return fmt.Errorf("'%s' is not a valid attribute name: %w", attr.Name, ErrInvalidTypeAttribute)
+		}
+		if len(attr.Types) == 0 {
+			return fmt.Errorf("attribute %s has no types: %w", attr.Name, ErrInvalidTypeAttribute)
+		}
+		for _, t := range attr.Types {
+			if _, ok := declared[t]; !ok && t != selxv1alpha2.AllowSelf {
+				return fmt.Errorf("'%s' is not a type of the profile: %w", t, ErrInvalidTypeAttribute)
+			}
+		}
+	}
+
 	return nil
 }
 
+// validateTypeReference validates a type used as a label, which can be a
+// type of the profile or of the system policy.
+func (sph *selinuxProfileHandler) validateTypeReference(t string) error {
+	if t == selxv1alpha2.AllowSelf {
+		return fmt.Errorf("'%s' cannot be used as label: %w", t, ErrInvalidLabelKey)
+	}
+	return sph.validateLabelKey(selxv1alpha2.LabelKey(t))
+}
+
 func (sph *selinuxProfileHandler) validateAndTrackInherit(
 	ancestorRef selxv1alpha2.PolicyRef,
 	namespace string,
@@ -135,7 +297,12 @@ func (sph *selinuxProfileHandler) validateAndTrackInherit(
 	case selxv1alpha2.SystemPolicyKind, "":
 		return sph.handleInheritSystemPolicy(ancestorRef)
 	case "SelinuxPolicy":
+		if sph.cluster {
+			return fmt.Errorf("%s/%s: %w", ancestorRef.Kind, ancestorRef.Name, ErrNamespacedInherit)
+		}
 		return sph.handleInheritSPOPolicy(ancestorRef, namespace)
+	case selxv1alpha2.ClusterSelinuxProfileKind:
+		return sph.handleInheritClusterPolicy(ancestorRef)
 	}
 	return fmt.Errorf("%s/%s: %w", ancestorRef.Kind, ancestorRef.Name, ErrUnknownKindForEntry)
 }
@@ -185,6 +352,24 @@ func (sph *selinuxProfileHandler) handleInheritSPOPolicy(
 	return nil
 }
 
+func (sph *selinuxProfileHandler) handleInheritClusterPolicy(
+	ancestorRef selxv1alpha2.PolicyRef,
+) error {
+	ancestor := &selxv1alpha2.ClusterSelinuxProfile{}
+	key := types.NamespacedName{Name: ancestorRef.Name}
+	if err := sph.cli.Get(context.Background(), key, ancestor); err != nil {
+		if kerrors.IsNotFound(err) {
+			return fmt.Errorf("couldn't find inherit reference %s/%s: %w",
+				ancestorRef.Kind, ancestorRef.Name, err)
+		}
+		return fmt.Errorf("getting inherit reference %s/%s: %w",
+			ancestorRef.Kind, ancestorRef.Name, err)
+	}
+
+	sph.objInherits = append(sph.objInherits, ancestor)
+	return nil
+}
+
 func (sph *selinuxProfileHandler) handleInheritSystemPolicy(
 	ancestorRef selxv1alpha2.PolicyRef,
 ) error {
@@ -228,3 +413,19 @@ func newSelinuxProfileHandler(
 	err := oh.Init(ctx, cli, key)
 	return oh, err
 }
+
+func newClusterSelinuxProfileHandler(
+	ctx context.Context,
+	cli client.Client,
+	key types.NamespacedName,
+) (SelinuxObjectHandler, error) {
+	oh := &selinuxProfileHandler{
+		sp:             &selxv1alpha2.SelinuxProfile{},
+		cluster:        true,
+		systemInherits: make([]string, 0),
+		objInherits:    make([]selxv1alpha2.SelinuxProfileObject, 0),
+	}
+
+	err := oh.Init(ctx, cli, key)
+	return oh, err
+}
@@ -22,7 +22,9 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -359,6 +361,104 @@ func Test_selinuxProfileHandler(t *testing.T) {
 				"didn't match expected characters: invalid permission",
 			},
 		},
+		{
+			name: "Test validate types and labeling rules",
+			profile: &selxv1alpha2.SelinuxProfile{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "bar",
+				},
+				Spec: selxv1alpha2.SelinuxProfileSpec{
+					Types: []selxv1alpha2.TypeDefinition{
+						{Name: "data"},
+						{Name: "worker", Kind: selxv1alpha2.TypeKindProcess},
+						{Name: "app_port", Kind: selxv1alpha2.TypeKindPort},
+					},
+					TypeTransitions: []selxv1alpha2.TypeTransition{
+						{Entrypoint: "bin_t", Target: "worker"},
+					},
+				},
+			},
+		},
+		{
+			name: "Test validate file contexts of namespaced profile",
+			profile: &selxv1alpha2.SelinuxProfile{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "bar",
+				},
+				Spec: selxv1alpha2.SelinuxProfileSpec{
+					Types: []selxv1alpha2.TypeDefinition{{Name: "data"}},
+					FileContexts: []selxv1alpha2.FileContext{
+						{Path: "/var/lib/app(/.*)?", Type: "data"},
+					},
+				},
+			},
+			wantValidateErr: true,
+			wantErrMatches: []string{
+				"file and port contexts are only allowed in cluster scoped profiles",
+			},
+		},
+		{
+			name: "Test validate port contexts of namespaced profile",
+			profile: &selxv1alpha2.SelinuxProfile{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "bar",
+				},
+				Spec: selxv1alpha2.SelinuxProfileSpec{
+					Types: []selxv1alpha2.TypeDefinition{{Name: "app_port", Kind: selxv1alpha2.TypeKindPort}},
+					PortContexts: []selxv1alpha2.PortContext{
+						{Protocol: "tcp", Port: 9000, Type: "app_port"},
+					},
+				},
+			},
+			wantValidateErr: true,
+			wantErrMatches: []string{
+				"file and port contexts are only allowed in cluster scoped profiles",
+			},
+		},
+		{
+			name: "Test validate system attribute of namespaced profile",
+			profile: &selxv1alpha2.SelinuxProfile{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "bar",
+				},
+				Spec: selxv1alpha2.SelinuxProfileSpec{
+					TypeAttributes: []selxv1alpha2.TypeAttribute{
+						{Name: "unconfined_domain_type", Types: []string{"@self"}},
+					},
+				},
+			},
+			wantValidateErr: true,
+			wantErrMatches: []string{
+				"type attributes are only allowed in cluster scoped profiles",
+			},
+		},
+		{
+			name: "Test validate transition to file type",
+			profile: &selxv1alpha2.SelinuxProfile{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "bar",
+				},
+				Spec: selxv1alpha2.SelinuxProfileSpec{
+					Types: []selxv1alpha2.TypeDefinition{
+						{Name: "data"},
+						{Name: "worker", Kind: selxv1alpha2.TypeKindProcess},
+						{Name: "app_port", Kind: selxv1alpha2.TypeKindPort},
+					},
+					TypeTransitions: []selxv1alpha2.TypeTransition{
+						{Entrypoint: "bin_t", Target: "data"},
+					},
+				},
+			},
+			wantValidateErr: true,
+			wantErrMatches: []string{
+				"is not a process type of the profile: invalid type transition",
+			},
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -405,3 +505,126 @@ func Test_selinuxProfileHandler(t *testing.T) {
 		})
 	}
 }
+
+func Test_clusterSelinuxProfileHandler(t *testing.T) {
+	t.Parallel()
+
+	schemeInstance := runtime.NewScheme()
+	require.NoError(t, selxv1alpha2.AddToScheme(schemeInstance))
+
+	newProfile := func(name string, inherit ...selxv1alpha2.PolicyRef) *selxv1alpha2.ClusterSelinuxProfile {
+		return &selxv1alpha2.ClusterSelinuxProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: selxv1alpha2.SelinuxProfileSpec{
+				Inherit: inherit,
+				Allow: selxv1alpha2.Allow{
+					"http_port_t": {"tcp_socket": []string{"name_bind"}},
+				},
+			},
+		}
+	}
+
+	cli := fake.NewClientBuilder().WithScheme(schemeInstance).WithObjects(
+		newProfile("base"),
+		newProfile("web", selxv1alpha2.PolicyRef{Kind: selxv1alpha2.ClusterSelinuxProfileKind, Name: "base"}),
+		newProfile("invalid", selxv1alpha2.PolicyRef{Kind: "SelinuxPolicy", Name: "base"}),
+	).Build()
+
+	sph, err := newClusterSelinuxProfileHandler(context.TODO(), cli, types.NamespacedName{Name: "web"})
+	require.NoError(t, err)
+	require.Equal(t, "web_", sph.GetProfileObject().GetPolicyName())
+	require.NoError(t, sph.Validate())
+	policy, err := sph.GetCILPolicy()
+	require.NoError(t, err)
+	require.Contains(t, policy, "(block web_\n")
+	require.Contains(t, policy, "(blockinherit base_.process)")
+
+	sph, err = newClusterSelinuxProfileHandler(context.TODO(), cli, types.NamespacedName{Name: "invalid"})
+	require.NoError(t, err)
+	require.ErrorIs(t, sph.Validate(), ErrNamespacedInherit)
+}
+
+func Test_clusterSelinuxProfileHandlerContexts(t *testing.T) {
+	t.Parallel()
+
+	schemeInstance := runtime.NewScheme()
+	require.NoError(t, selxv1alpha2.AddToScheme(schemeInstance))
+
+	for _, tc := range []struct {
+		name           string
+		fileContexts   []selxv1alpha2.FileContext
+		portContexts   []selxv1alpha2.PortContext
+		typeAttributes []selxv1alpha2.TypeAttribute
+		wantErr        error
+	}{
+		{
+			name: "valid contexts",
+			fileContexts: []selxv1alpha2.FileContext{
+				{Path: "/var/lib/app(/.*)?", Type: "data"},
+				{Path: "/usr/bin/worker", FileType: "file", Type: "bin_t"},
+			},
+			portContexts: []selxv1alpha2.PortContext{
+				{Protocol: "tcp", Port: 9000, EndPort: 9010, Type: "app_port"},
+			},
+			typeAttributes: []selxv1alpha2.TypeAttribute{
+				{Name: "container_domain", Types: []string{"@self", "data"}},
+			},
+		},
+		{
+			name: "attribute with unknown type",
+			typeAttributes: []selxv1alpha2.TypeAttribute{
+				{Name: "container_domain", Types: []string{"unknown"}},
+			},
+			wantErr: ErrInvalidTypeAttribute,
+		},
+		{
+			name: "injection through file context path",
+			fileContexts: []selxv1alpha2.FileContext{
+				{Path: "/data\" any (system_u object_r shadow_t ((s0) (s0))))", Type: "data"},
+			},
+			wantErr: ErrInvalidFileContext,
+		},
+		{
+			name: "invalid port range",
+			portContexts: []selxv1alpha2.PortContext{
+				{Protocol: "tcp", Port: 9010, EndPort: 9000, Type: "app_port"},
+			},
+			wantErr: ErrInvalidPortContext,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			cli := fake.NewClientBuilder().WithScheme(schemeInstance).WithObjects(
+				&selxv1alpha2.ClusterSelinuxProfile{
+					ObjectMeta: metav1.ObjectMeta{Name: "app"},
+					Spec: selxv1alpha2.SelinuxProfileSpec{
+						Types: []selxv1alpha2.TypeDefinition{
+							{Name: "data"},
+							{Name: "app_port", Kind: selxv1alpha2.TypeKindPort},
+						},
+						FileContexts:   tc.fileContexts,
+						PortContexts:   tc.portContexts,
+						TypeAttributes: tc.typeAttributes,
+					},
+				},
+			).Build()
+
+			sph, err := newClusterSelinuxProfileHandler(context.TODO(), cli, types.NamespacedName{Name: "app"})
+			require.NoError(t, err)
+			err = sph.Validate()
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			policy, err := sph.GetCILPolicy()
+			require.NoError(t, err)
+			require.Contains(t, policy, "(filecon \"/usr/bin/worker\" file ")
+			require.Contains(t, policy, "(portcon tcp (9000 9010) ")
+			require.Contains(t, policy, "(typeattributeset container_domain (process data))")
+		})
+	}
+}
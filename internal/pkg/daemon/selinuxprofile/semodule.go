@@ -0,0 +1,274 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package selinuxprofile
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"k8s.io/client-go/util/workqueue"
+
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/manager/spod/bindata"
+)
+
+const semoduleCmd = "semodule"
+
+// semoduleBackend installs the policies directly into the policy store of
+// the node by using semodule, which wraps libsemanage. The policies get
+// installed one after another by a single worker, which pushes the status
+// changes to the watchers once semodule finished.
+type semoduleBackend struct {
+	policyWatchers
+	log logr.Logger
+	// workDir holds the policy files while semodule runs. It must not be
+	// the drop directory of selinuxd, which keeps running as the fallback
+	// of daemons without semodule.
+	workDir string
+	run     func(ctx context.Context, dir, name string, args ...string) ([]byte, error)
+	queue   workqueue.Interface
+
+	mu sync.Mutex
+	// desired is the policy to be installed, or nil if it has to be removed
+	desired map[string][]byte
+	// applied are the policies of the last installation attempts, which do
+	// not get retried until the policy changes. The policies installed before
+	// the daemon started get extracted on first use.
+	applied  map[string][]byte
+	statuses map[string]*sePolStatus
+	listed   bool
+	started  bool
+}
+
+func newSemoduleBackend(logger logr.Logger) *semoduleBackend {
+	return &semoduleBackend{
+		log:      logger,
+		workDir:  bindata.TempDirectory,
+		run:      runCommand,
+		queue:    workqueue.NewNamed("semodule"),
+		desired:  map[string][]byte{},
+		applied:  map[string][]byte{},
+		statuses: map[string]*sePolStatus{},
+	}
+}
+
+func runCommand(ctx context.Context, dir, name string, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Dir = dir
+	return cmd.CombinedOutput()
+}
+
+func (b *semoduleBackend) Name() string {
+	return string(spodv1alpha1.SelinuxBackendSemodule)
+}
+
+// Ready lists the installed modules once to be able to remove the policies
+// installed before the daemon started.
+func (b *semoduleBackend) Ready(ctx context.Context) (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.listed {
+		return true, nil
+	}
+
+	out, err := b.run(ctx, "", semoduleCmd, "--list-modules=standard")
+	if err != nil {
+		return false, fmt.Errorf("listing SELinux modules: %w: %s", err, out)
+	}
+	for _, line := range strings.Split(string(out), "\n") {
+		if fields := strings.Fields(line); len(fields) > 0 {
+			b.statuses[fields[0]] = &sePolStatus{Status: installedStatus}
+		}
+	}
+	b.listed = true
+
+	return true, nil
+}
+
+func (b *semoduleBackend) InstallPolicy(ctx context.Context, name string, cil []byte) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if desired, ok := b.desired[name]; ok && bytes.Equal(desired, cil) {
+		return nil
+	}
+	if _, pending := b.desired[name]; !pending {
+		b.loadApplied(ctx, name)
+		if bytes.Equal(b.applied[name], cil) {
+			return nil
+		}
+	}
+
+	// The policy is in progress until semodule finished
+	delete(b.statuses, name)
+	b.desired[name] = cil
+	b.enqueue(name)
+	return nil
+}
+
+func (b *semoduleBackend) RemovePolicy(_ context.Context, name string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.statuses[name]; !ok {
+		if _, pending := b.desired[name]; !pending {
+			return nil
+		}
+	}
+
+	b.desired[name] = nil
+	b.enqueue(name)
+	return nil
+}
+
+func (b *semoduleBackend) PolicyStatus(_ context.Context, name string) (*sePolStatus, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	status, ok := b.statuses[name]
+	if !ok {
+		return nil, errPolicyNotFound
+	}
+	res := *status
+	return &res, nil
+}
+
+// loadApplied extracts the policy if it got installed before the daemon
+// started, so that it does not get reinstalled if it did not change. It has
+// to be called with the lock held.
+func (b *semoduleBackend) loadApplied(ctx context.Context, name string) {
+	if _, ok := b.applied[name]; ok {
+		return
+	}
+	if status, ok := b.statuses[name]; !ok || status.Status != installedStatus {
+		return
+	}
+
+	cil, err := b.extract(ctx, name)
+	if err != nil {
+		b.log.Error(err, "Cannot extract installed policy, reinstalling it", "policyName", name)
+		return
+	}
+	b.applied[name] = cil
+}
+
+// extract returns the CIL of an installed policy, which semodule writes into
+// its working directory.
+func (b *semoduleBackend) extract(ctx context.Context, name string) ([]byte, error) {
+	dir, err := os.MkdirTemp(b.workDir, "extract-")
+	if err != nil {
+		return nil, fmt.Errorf("creating extraction directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	if out, err := b.run(ctx, dir, semoduleCmd, "--cil", "--extract="+name); err != nil {
+		return nil, fmt.Errorf("extracting policy: %w: %s", err, out)
+	}
+	cil, err := os.ReadFile(path.Join(dir, name+".cil"))
+	if err != nil {
+		return nil, fmt.Errorf("reading extracted policy: %w", err)
+	}
+	return cil, nil
+}
+
+// enqueue queues the policy for the worker, which gets started on first use.
+// It has to be called with the lock held.
+func (b *semoduleBackend) enqueue(name string) {
+	if !b.started {
+		b.started = true
+		go b.work()
+	}
+	b.queue.Add(name)
+}
+
+func (b *semoduleBackend) work() {
+	for {
+		item, shutdown := b.queue.Get()
+		if shutdown {
+			return
+		}
+
+		name, ok := item.(string)
+		if ok {
+			b.apply(name)
+			b.notify(name)
+		}
+		b.queue.Done(item)
+	}
+}
+
+// apply installs or removes the policy, depending on its desired state.
+func (b *semoduleBackend) apply(name string) {
+	b.mu.Lock()
+	cil, ok := b.desired[name]
+	delete(b.desired, name)
+	b.mu.Unlock()
+	if !ok {
+		return
+	}
+
+	ctx := context.Background()
+	if cil == nil {
+		b.log.Info("Removing policy", "policyName", name)
+		out, err := b.run(ctx, "", semoduleCmd, "--remove="+name)
+
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if err != nil {
+			b.statuses[name] = &sePolStatus{
+				Status: failedStatus,
+				Msg:    fmt.Sprintf("removing policy: %v: %s", err, strings.TrimSpace(string(out))),
+			}
+			return
+		}
+		delete(b.statuses, name)
+		delete(b.applied, name)
+		return
+	}
+
+	b.log.Info("Installing policy", "policyName", name)
+	status := &sePolStatus{Status: installedStatus}
+	policyPath := path.Join(b.workDir, name+".cil")
+	const filePermissions = 0o600
+	if err := os.WriteFile(policyPath, cil, filePermissions); err != nil {
+		status = &sePolStatus{Status: failedStatus, Msg: fmt.Sprintf("writing policy file: %v", err)}
+	} else {
+		out, err := b.run(ctx, "", semoduleCmd, "--install="+policyPath)
+		if err != nil {
+			status = &sePolStatus{
+				Status: failedStatus,
+				Msg:    fmt.Sprintf("installing policy: %v: %s", err, strings.TrimSpace(string(out))),
+			}
+		}
+		if err := os.Remove(policyPath); err != nil {
+			b.log.Error(err, "Cannot remove policy file", "policyPath", policyPath)
+		}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.statuses[name] = status
+	b.applied[name] = cil
+}
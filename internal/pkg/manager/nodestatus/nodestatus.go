@@ -21,6 +21,8 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -48,6 +50,10 @@ import (
 const (
 	reconcileTimeout = 1 * time.Minute
 	dsWait           = 30 * time.Second
+
+	// maxFailedNodesInCondition is the maximum number of failed nodes whose
+	// details are part of the NodesUpToDate condition message.
+	maxFailedNodesInCondition = 3
 )
 
 var (
@@ -90,17 +96,24 @@ func (r *StatusReconciler) Healthz(*http.Request) error {
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=rawselinuxprofiles,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=rawselinuxprofiles/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=rawselinuxprofiles/finalizers,verbs=delete;get;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=clusterselinuxprofiles,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=clusterselinuxprofiles/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=clusterselinuxprofiles/finalizers,verbs=delete;get;update;patch
 
 // Security Profiles Operator RBAC permissions to manage SeccompProfile
 //nolint:lll // required for kubebuilder
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles/finalizers,verbs=delete;get;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=clusterseccompprofiles,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=clusterseccompprofiles/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=clusterseccompprofiles/finalizers,verbs=delete;get;update;patch
 
 // Security Profiles Operator RBAC permissions to manage Node Statuses
 //nolint:lll // required for kubebuilder
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=securityprofilenodestatuses,verbs=get;list;watch;delete
 // +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
 
 // Reconcile reconciles a NodeStatus.
 func (r *StatusReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
@@ -167,8 +180,32 @@ func (r *StatusReconciler) Reconcile(ctx context.Context, req reconcile.Request)
 	}
 
 	// make sure we have all the statuses already
-	hasStatuses := len(nodeStatusList.Items)
 	wantsStatuses := spodDS.Status.DesiredNumberScheduled
+	if nodeSelectorOf(prof) != nil {
+		selectedNodes, err := r.selectedNodes(ctx, spodDS, prof)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("cannot get the selected nodes: %w", err)
+		}
+		wantsStatuses = int32(len(selectedNodes))
+
+		var unselected *statusv1alpha1.SecurityProfileNodeStatusList
+		nodeStatusList, unselected = splitStatusesByNode(nodeStatusList, selectedNodes)
+
+		// The statuses of unselected nodes get removed by the daemon of the
+		// node, except if the node got removed from the cluster.
+		nodeName, err := r.removeStatusForDeletedNode(ctx, unselected, lprof)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("cannot remove extra statuses: %w", err)
+		}
+		if nodeName != "" {
+			logger.Info("Removing finalizer from profile", "profile", prof.GetName(), "node", nodeName)
+			if err := util.RemoveFinalizer(ctx, r.client, prof, util.GetFinalizerNodeString(nodeName)); err != nil {
+				return reconcile.Result{}, fmt.Errorf("cannot remove finalizer from profile: %w", err)
+			}
+			return reconcile.Result{Requeue: true}, nil
+		}
+	}
+	hasStatuses := len(nodeStatusList.Items)
 	if wantsStatuses > int32(hasStatuses) {
 		logger.Info("Not updating policy: not all statuses are ready",
 			"has", hasStatuses, "wants", wantsStatuses)
@@ -197,7 +234,90 @@ func (r *StatusReconciler) Reconcile(ctx context.Context, req reconcile.Request)
 	}
 	logger.V(config.VerboseLevel).Info("Setting the status to", "Status", lowestCommonState)
 
-	return r.reconcileStatus(ctx, prof, lowestCommonState, lprof)
+	prof = prof.DeepCopyToStatusBaseIf()
+	if sp, ok := prof.(seccompprofileapi.SeccompProfileObject); ok {
+		sp.GetStatus().Filter = largestSeccompFilter(nodeStatusList.Items)
+	}
+
+	return r.reconcileStatus(
+		ctx, prof, lowestCommonState, lprof,
+		nodesUpToDate(prof.GetGeneration(), nodeStatusList.Items),
+	)
+}
+
+// nodesUpToDate summarizes the node statuses of a profile into a condition,
+// which is true if all nodes installed the current generation of the profile
+// with the same content.
+func nodesUpToDate(generation int64, statuses []statusv1alpha1.SecurityProfileNodeStatus) metav1.Condition {
+	stale := 0
+	failed := []string{}
+	digests := map[string]bool{}
+	for i := range statuses {
+		status := &statuses[i]
+		if status.Status == statusv1alpha1.ProfileStateError {
+			detail := status.NodeName
+			if status.Reason != "" {
+				detail += " (" + status.Reason + ")"
+			}
+			if status.Message != "" {
+				detail += ": " + status.Message
+			}
+			failed = append(failed, detail)
+		}
+		if status.ObservedGeneration != generation {
+			stale++
+		} else if status.Status == statusv1alpha1.ProfileStateInstalled && status.ContentDigest != "" {
+			digests[status.ContentDigest] = true
+		}
+	}
+	sort.Strings(failed)
+
+	condition := metav1.Condition{
+		Type:               spodv1alpha1.TypeNodesUpToDate,
+		Status:             metav1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+	}
+	staleMsg := fmt.Sprintf("%d/%d nodes on stale generation", stale, len(statuses))
+
+	switch {
+	case len(failed) > 0:
+		condition.Reason = spodv1alpha1.ReasonNodeErrors
+		details := failed
+		if len(details) > maxFailedNodesInCondition {
+			details = details[:maxFailedNodesInCondition]
+		}
+		condition.Message = fmt.Sprintf("%d/%d nodes failed: %s", len(failed), len(statuses), strings.Join(details, "; "))
+		if stale > 0 {
+			condition.Message += ", " + staleMsg
+		}
+	case stale > 0:
+		condition.Reason = spodv1alpha1.ReasonStaleGeneration
+		condition.Message = staleMsg
+	case len(digests) > 1:
+		condition.Reason = spodv1alpha1.ReasonContentMismatch
+		condition.Message = fmt.Sprintf("Nodes run %d different versions of the profile content", len(digests))
+	default:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = spodv1alpha1.ReasonUpToDate
+		condition.Message = fmt.Sprintf("%d/%d nodes on generation %d", len(statuses), len(statuses), generation)
+	}
+
+	return condition
+}
+
+// largestSeccompFilter returns the seccomp filter with the most instructions
+// reported by the node statuses, or nil if no node reported a filter.
+func largestSeccompFilter(
+	statuses []statusv1alpha1.SecurityProfileNodeStatus,
+) *statusv1alpha1.SeccompFilterStatus {
+	var largest *statusv1alpha1.SeccompFilterStatus
+	for i := range statuses {
+		filter := statuses[i].SeccompFilter
+		if filter != nil && (largest == nil || filter.Instructions > largest.Instructions) {
+			largest = filter
+		}
+	}
+	return largest.DeepCopy()
 }
 
 // removeStatusForDeletedNode removes the status for a node that has been deleted.
@@ -221,6 +341,111 @@ func (r *StatusReconciler) removeStatusForDeletedNode(ctx context.Context,
 	return "", nil
 }
 
+// nodeSelectorOf returns the node selector of the profile, or nil if the
+// profile is installed on all nodes.
+func nodeSelectorOf(prof pbv1alpha1.StatusBaseUser) *metav1.LabelSelector {
+	base, ok := prof.(pbv1alpha1.SecurityProfileBase)
+	if !ok {
+		return nil
+	}
+	return base.GetNodeSelector()
+}
+
+// enqueueNodeSelectingStatuses enqueues one node status of every profile with
+// a node selector, because the nodes selected by them may have changed.
+func (r *StatusReconciler) enqueueNodeSelectingStatuses(ctx context.Context, _ client.Object) []reconcile.Request {
+	statuses := &statusv1alpha1.SecurityProfileNodeStatusList{}
+	if err := r.client.List(ctx, statuses); err != nil {
+		r.log.Error(err, "Cannot list node statuses")
+		return nil
+	}
+
+	seen := map[types.NamespacedName]bool{}
+	var requests []reconcile.Request
+	for i := range statuses.Items {
+		status := &statuses.Items[i]
+		key := util.NamespacedName(status.Labels[statusv1alpha1.StatusToProfLabel], status.Namespace)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		prof, err := r.getProfileFromStatus(ctx, status)
+		if err != nil || nodeSelectorOf(prof) == nil {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(status)})
+	}
+	return requests
+}
+
+// selectedNodes returns the names of the nodes running the SPOd which are
+// selected by the profile.
+func (r *StatusReconciler) selectedNodes(
+	ctx context.Context, ds *appsv1.DaemonSet, prof pbv1alpha1.StatusBaseUser,
+) (map[string]bool, error) {
+	base, ok := prof.(pbv1alpha1.SecurityProfileBase)
+	if !ok {
+		return nil, fmt.Errorf("profile %s does not support node selection", prof.GetName())
+	}
+
+	podSelector, err := metav1.LabelSelectorAsSelector(ds.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("parsing DS selector: %w", err)
+	}
+
+	pods := &v1.PodList{}
+	if err := r.client.List(ctx, pods, &client.ListOptions{
+		LabelSelector: podSelector,
+		Namespace:     ds.Namespace,
+	}); err != nil {
+		return nil, fmt.Errorf("cannot list SPOd pods: %w", err)
+	}
+
+	selected := map[string]bool{}
+	for i := range pods.Items {
+		nodeName := pods.Items[i].Spec.NodeName
+		if nodeName == "" || selected[nodeName] {
+			continue
+		}
+
+		node := &v1.Node{}
+		if err := r.client.Get(ctx, types.NamespacedName{Name: nodeName}, node); err != nil {
+			if util.IgnoreNotFound(err) == nil {
+				continue
+			}
+			return nil, fmt.Errorf("cannot get node: %w", err)
+		}
+
+		matches, err := pbv1alpha1.SelectsNode(base, node.Labels)
+		if err != nil {
+			return nil, fmt.Errorf("matching node selector: %w", err)
+		}
+		if matches {
+			selected[nodeName] = true
+		}
+	}
+
+	return selected, nil
+}
+
+// splitStatusesByNode splits the statuses into the ones of the selected nodes
+// and the remaining ones.
+func splitStatusesByNode(
+	statuses *statusv1alpha1.SecurityProfileNodeStatusList, selectedNodes map[string]bool,
+) (selected, unselected *statusv1alpha1.SecurityProfileNodeStatusList) {
+	selected = &statusv1alpha1.SecurityProfileNodeStatusList{}
+	unselected = &statusv1alpha1.SecurityProfileNodeStatusList{}
+	for i := range statuses.Items {
+		if selectedNodes[statuses.Items[i].NodeName] {
+			selected.Items = append(selected.Items, statuses.Items[i])
+		} else {
+			unselected.Items = append(unselected.Items, statuses.Items[i])
+		}
+	}
+	return selected, unselected
+}
+
 func (r *StatusReconciler) getDS(ctx context.Context, namespace string, l logr.Logger) (*appsv1.DaemonSet, error) {
 	dsSelect := labels.NewSelector()
 	spodDSFilter, err := labels.NewRequirement("spod", selection.Exists, []string{})
@@ -266,6 +491,12 @@ func (r *StatusReconciler) getProfileFromStatus(
 		prof = &seccompprofileapi.SeccompProfile{}
 	case "SelinuxProfile":
 		prof = &selxv1alpha2.SelinuxProfile{}
+	case seccompprofileapi.ClusterSeccompProfileKind:
+		prof = &seccompprofileapi.ClusterSeccompProfile{}
+		key.Namespace = ""
+	case selxv1alpha2.ClusterSelinuxProfileKind:
+		prof = &selxv1alpha2.ClusterSelinuxProfile{}
+		key.Namespace = ""
 	default:
 		return nil, fmt.Errorf("getting owner profile: %w", ErrUnknownOwnerKind)
 	}
@@ -280,6 +511,7 @@ func (r *StatusReconciler) reconcileStatus(
 	prof pbv1alpha1.StatusBaseUser,
 	state statusv1alpha1.ProfileState,
 	l logr.Logger,
+	conditions ...metav1.Condition,
 ) (reconcile.Result, error) {
 	pCopy := prof.DeepCopyToStatusBaseIf()
 
@@ -310,6 +542,7 @@ func (r *StatusReconciler) reconcileStatus(
 		outStatus.Status = statusv1alpha1.ProfileStateDisabled
 		outStatus.SetConditions(spodv1alpha1.Unavailable())
 	}
+	outStatus.SetConditions(conditions...)
 
 	l.V(config.VerboseLevel).Info("Updating status")
 	if updateErr := r.client.Status().Update(ctx, pCopy); updateErr != nil {
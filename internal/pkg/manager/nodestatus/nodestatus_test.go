@@ -0,0 +1,196 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodestatus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	statusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+)
+
+func TestNodesUpToDate(t *testing.T) {
+	t.Parallel()
+
+	installed := func(node string, generation int64, digest string) statusv1alpha1.SecurityProfileNodeStatus {
+		return statusv1alpha1.SecurityProfileNodeStatus{
+			NodeName:           node,
+			Status:             statusv1alpha1.ProfileStateInstalled,
+			ObservedGeneration: generation,
+			ContentDigest:      digest,
+		}
+	}
+
+	for _, tc := range []struct {
+		name        string
+		statuses    []statusv1alpha1.SecurityProfileNodeStatus
+		wantStatus  metav1.ConditionStatus
+		wantReason  string
+		wantMessage string
+	}{
+		{
+			name: "up to date",
+			statuses: []statusv1alpha1.SecurityProfileNodeStatus{
+				installed("a", 2, "sha256:1"), installed("b", 2, "sha256:1"),
+			},
+			wantStatus:  metav1.ConditionTrue,
+			wantReason:  spodv1alpha1.ReasonUpToDate,
+			wantMessage: "2/2 nodes on generation 2",
+		},
+		{
+			name: "stale generation",
+			statuses: []statusv1alpha1.SecurityProfileNodeStatus{
+				installed("a", 2, "sha256:1"), installed("b", 1, "sha256:0"), installed("c", 1, "sha256:0"),
+			},
+			wantStatus:  metav1.ConditionFalse,
+			wantReason:  spodv1alpha1.ReasonStaleGeneration,
+			wantMessage: "2/3 nodes on stale generation",
+		},
+		{
+			name: "content mismatch",
+			statuses: []statusv1alpha1.SecurityProfileNodeStatus{
+				installed("a", 2, "sha256:1"), installed("b", 2, "sha256:2"),
+			},
+			wantStatus:  metav1.ConditionFalse,
+			wantReason:  spodv1alpha1.ReasonContentMismatch,
+			wantMessage: "Nodes run 2 different versions of the profile content",
+		},
+		{
+			name: "node errors",
+			statuses: []statusv1alpha1.SecurityProfileNodeStatus{
+				installed("a", 1, "sha256:0"),
+				{
+					NodeName:           "b",
+					Status:             statusv1alpha1.ProfileStateError,
+					ObservedGeneration: 2,
+					Reason:             "CannotSaveProfile",
+					Message:            "disk full",
+				},
+			},
+			wantStatus:  metav1.ConditionFalse,
+			wantReason:  spodv1alpha1.ReasonNodeErrors,
+			wantMessage: "1/2 nodes failed: b (CannotSaveProfile): disk full, 1/2 nodes on stale generation",
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			condition := nodesUpToDate(2, tc.statuses)
+			require.Equal(t, spodv1alpha1.TypeNodesUpToDate, condition.Type)
+			require.Equal(t, tc.wantStatus, condition.Status)
+			require.Equal(t, tc.wantReason, condition.Reason)
+			require.Equal(t, tc.wantMessage, condition.Message)
+		})
+	}
+}
+
+func TestLargestSeccompFilter(t *testing.T) {
+	t.Parallel()
+
+	withFilter := func(node string, instructions int32) statusv1alpha1.SecurityProfileNodeStatus {
+		return statusv1alpha1.SecurityProfileNodeStatus{
+			NodeName: node,
+			SeccompFilter: &statusv1alpha1.SeccompFilterStatus{
+				Rules:            10,
+				Instructions:     instructions,
+				ExceedsThreshold: instructions > 100,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		name     string
+		statuses []statusv1alpha1.SecurityProfileNodeStatus
+		want     *statusv1alpha1.SeccompFilterStatus
+	}{
+		{
+			name:     "no filters",
+			statuses: []statusv1alpha1.SecurityProfileNodeStatus{{NodeName: "a"}},
+		},
+		{
+			name: "largest filter",
+			statuses: []statusv1alpha1.SecurityProfileNodeStatus{
+				withFilter("a", 50), {NodeName: "b"}, withFilter("c", 200), withFilter("d", 80),
+			},
+			want: withFilter("c", 200).SeccompFilter,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.want, largestSeccompFilter(tc.statuses))
+		})
+	}
+}
+
+func TestEnqueueNodeSelectingStatuses(t *testing.T) {
+	t.Parallel()
+
+	profile := func(name string, nodeSelector *metav1.LabelSelector) *seccompprofileapi.SeccompProfile {
+		sp := &seccompprofileapi.SeccompProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		}
+		sp.Spec.NodeSelector = nodeSelector
+		return sp
+	}
+	status := func(profile, node string) *statusv1alpha1.SecurityProfileNodeStatus {
+		controller := true
+		return &statusv1alpha1.SecurityProfileNodeStatus{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      profile + "-" + node,
+				Namespace: "default",
+				Labels:    map[string]string{statusv1alpha1.StatusToProfLabel: profile},
+				OwnerReferences: []metav1.OwnerReference{{
+					Kind:       "SeccompProfile",
+					Name:       profile,
+					Controller: &controller,
+				}},
+			},
+			NodeName: node,
+		}
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, seccompprofileapi.AddToScheme(scheme))
+	require.NoError(t, statusv1alpha1.AddToScheme(scheme))
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		profile("selecting", &metav1.LabelSelector{MatchLabels: map[string]string{"role": "worker"}}),
+		profile("all", nil),
+		status("selecting", "a"),
+		status("selecting", "b"),
+		status("all", "a"),
+		status("deleted", "a"),
+	).Build()
+
+	sut := &StatusReconciler{client: cli, log: logr.Discard()}
+	requests := sut.enqueueNodeSelectingStatuses(context.Background(), nil)
+	require.Equal(t, []reconcile.Request{{
+		NamespacedName: client.ObjectKeyFromObject(status("selecting", "a")),
+	}}, requests)
+}
@@ -19,7 +19,11 @@ package nodestatus
 import (
 	"context"
 
+	corev1 "k8s.io/api/core/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	secprofnodestatusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
@@ -35,9 +39,15 @@ func (r *StatusReconciler) Setup(
 	r.log = ctrl.Log.WithName(r.Name())
 	r.record = mgr.GetEventRecorderFor(r.Name())
 
-	// Register a special reconciler for status events
+	// Register a special reconciler for status events, which also updates
+	// the profiles selecting nodes when the node labels change
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(r.Name()).
 		For(&secprofnodestatusv1alpha1.SecurityProfileNodeStatus{}).
+		Watches(
+			&corev1.Node{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueNodeSelectingStatuses),
+			builder.WithPredicates(predicate.LabelChangedPredicate{}),
+		).
 		Complete(r)
 }
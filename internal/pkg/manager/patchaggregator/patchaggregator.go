@@ -0,0 +1,321 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package patchaggregator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	profilebaseapi "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	statusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
+	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/controller"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/profilepatcher"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/nodestatus"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+const (
+	reasonPatchSuggested   string = "PatchSuggested"
+	reasonPatchApplied     string = "PatchApplied"
+	reasonCannotApplyPatch string = "CannotApplyPatch"
+	reasonImpactDetected   string = "ImpactDetected"
+)
+
+// AggregateReconciler merges the patches suggested by the daemons of all
+// nodes into the suggested patch annotation of a profile, and merges the
+// suggested patch into the profile spec once it got approved. It also merges
+// the impact reports of the nodes into the impact report annotation. It is
+// the only writer of both annotations, the daemons store the patches and
+// impact reports of their node in the node status of the profile.
+type AggregateReconciler struct {
+	client         client.Client
+	log            logr.Logger
+	record         record.EventRecorder
+	controllerName string
+	schemeBuilder  *scheme.Builder
+	newProfile     func() profilebaseapi.SecurityProfileBase
+}
+
+// NewSeccompController returns a new controller instance for SeccompProfiles.
+func NewSeccompController() controller.Controller {
+	return &AggregateReconciler{
+		controllerName: "seccomppatchaggregator",
+		schemeBuilder:  seccompprofileapi.SchemeBuilder,
+		newProfile: func() profilebaseapi.SecurityProfileBase {
+			return &seccompprofileapi.SeccompProfile{}
+		},
+	}
+}
+
+// NewSelinuxController returns a new controller instance for SelinuxProfiles.
+func NewSelinuxController() controller.Controller {
+	return &AggregateReconciler{
+		controllerName: "selinuxpatchaggregator",
+		schemeBuilder:  selxv1alpha2.SchemeBuilder,
+		newProfile: func() profilebaseapi.SecurityProfileBase {
+			return &selxv1alpha2.SelinuxProfile{}
+		},
+	}
+}
+
+// NewAppArmorController returns a new controller instance for AppArmorProfiles.
+func NewAppArmorController() controller.Controller {
+	return &AggregateReconciler{
+		controllerName: "apparmorpatchaggregator",
+		schemeBuilder:  apparmorprofileapi.SchemeBuilder,
+		newProfile: func() profilebaseapi.SecurityProfileBase {
+			return &apparmorprofileapi.AppArmorProfile{}
+		},
+	}
+}
+
+// Name returns the name of the controller.
+func (r *AggregateReconciler) Name() string {
+	return r.controllerName
+}
+
+// SchemeBuilder returns the API scheme of the controller.
+func (r *AggregateReconciler) SchemeBuilder() *scheme.Builder {
+	return r.schemeBuilder
+}
+
+// Healthz is the liveness probe endpoint of the controller.
+func (r *AggregateReconciler) Healthz(*http.Request) error {
+	return nil
+}
+
+//nolint:lll // required for kubebuilder
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=selinuxprofiles,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=apparmorprofiles,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=securityprofilenodestatuses,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+
+// Reconcile merges an approved patch into the profile spec, or otherwise
+// updates the suggested patch and the impact report from the node statuses
+// of the profile.
+func (r *AggregateReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	logger := r.log.WithValues("profile", req.Name, "namespace", req.Namespace)
+
+	profile := r.newProfile()
+	if err := r.client.Get(ctx, req.NamespacedName, profile); err != nil {
+		return reconcile.Result{}, util.IgnoreNotFound(err)
+	}
+
+	if !profile.GetDeletionTimestamp().IsZero() {
+		return reconcile.Result{}, nil
+	}
+
+	if profilepatcher.IsApproved(profile) {
+		return reconcile.Result{}, r.applyPatch(ctx, logger, profile)
+	}
+
+	statuses, err := r.nodeStatuses(ctx, profile)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := r.aggregatePatches(ctx, logger, profile, statuses); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if _, proposed := profile.GetAnnotations()[profilebaseapi.ProposedSpecAnnotation]; proposed {
+		return reconcile.Result{}, r.aggregateImpactReports(ctx, logger, profile, statuses)
+	}
+	return reconcile.Result{}, nil
+}
+
+// nodeStatuses returns the node statuses of the profile ordered by node, to
+// merge the nodes in a stable order and not reorder the annotations.
+func (r *AggregateReconciler) nodeStatuses(
+	ctx context.Context, profile profilebaseapi.SecurityProfileBase,
+) ([]*statusv1alpha1.SecurityProfileNodeStatus, error) {
+	list := &statusv1alpha1.SecurityProfileNodeStatusList{}
+	if err := r.client.List(ctx, list, client.InNamespace(nodestatus.StatusNamespace(profile))); err != nil {
+		return nil, fmt.Errorf("listing node statuses: %w", err)
+	}
+
+	statuses := []*statusv1alpha1.SecurityProfileNodeStatus{}
+	for i := range list.Items {
+		if metav1.IsControlledBy(&list.Items[i], profile) {
+			statuses = append(statuses, &list.Items[i])
+		}
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].NodeName < statuses[j].NodeName
+	})
+	return statuses, nil
+}
+
+// aggregatePatches sets the suggested patch annotation to the union of the
+// patches suggested by the nodes, without the parts already allowed by the
+// profile.
+func (r *AggregateReconciler) aggregatePatches(
+	ctx context.Context,
+	logger logr.Logger,
+	profile profilebaseapi.SecurityProfileBase,
+	statuses []*statusv1alpha1.SecurityProfileNodeStatus,
+) error {
+	patch := &profilepatcher.Patch{}
+	for _, status := range statuses {
+		if status.SuggestedPatch == "" {
+			continue
+		}
+		nodePatch, err := profilepatcher.ParsePatch(status.SuggestedPatch)
+		if err != nil {
+			logger.Error(err, "Ignoring invalid suggested patch of node", "node", status.NodeName)
+			continue
+		}
+		patch = profilepatcher.MergePatches(patch, nodePatch)
+	}
+
+	pending, err := profilepatcher.Pending(profile, patch)
+	if err != nil {
+		return fmt.Errorf("building suggested patch: %w", err)
+	}
+	data, err := profilepatcher.EncodePatch(pending)
+	if err != nil {
+		return err
+	}
+
+	annotations := profile.GetAnnotations()
+	if annotations[profilebaseapi.SuggestedPatchAnnotation] == data {
+		return nil
+	}
+	if data == "" {
+		delete(annotations, profilebaseapi.SuggestedPatchAnnotation)
+	} else {
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[profilebaseapi.SuggestedPatchAnnotation] = data
+	}
+	profile.SetAnnotations(annotations)
+
+	if err := r.client.Update(ctx, profile); err != nil {
+		return fmt.Errorf("updating suggested patch: %w", err)
+	}
+
+	if data != "" {
+		logger.Info("Updated suggested patch from observed violations")
+		r.record.Event(
+			profile, util.EventTypeNormal, reasonPatchSuggested,
+			"Suggested patch updated from observed violations",
+		)
+	}
+	return nil
+}
+
+// aggregateImpactReports sets the impact report annotation to the merged
+// reports of the nodes which analyzed the current proposed spec and window.
+func (r *AggregateReconciler) aggregateImpactReports(
+	ctx context.Context,
+	logger logr.Logger,
+	profile profilebaseapi.SecurityProfileBase,
+	statuses []*statusv1alpha1.SecurityProfileNodeStatus,
+) error {
+	window, err := profilepatcher.ImpactWindow(profile)
+	if err != nil {
+		// The daemons record the invalid window on the profile
+		return nil
+	}
+	proposedSpec := profilepatcher.ProposedSpecDigest(profile)
+
+	var report *profilepatcher.ImpactReport
+	for _, status := range statuses {
+		nodeReport, err := profilepatcher.ParseImpactReport(status.ImpactReport)
+		if err != nil {
+			logger.Error(err, "Ignoring invalid impact report of node", "node", status.NodeName)
+			continue
+		}
+		if nodeReport == nil || nodeReport.ProposedSpec != proposedSpec || nodeReport.Window != window.String() {
+			continue
+		}
+		report = profilepatcher.MergeImpactReports(report, nodeReport)
+	}
+	if report == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(report)
+	if err != nil {
+		return fmt.Errorf("marshal impact report: %w", err)
+	}
+
+	annotations := profile.GetAnnotations()
+	if annotations[profilebaseapi.ImpactReportAnnotation] == string(data) {
+		return nil
+	}
+	annotations[profilebaseapi.ImpactReportAnnotation] = string(data)
+	profile.SetAnnotations(annotations)
+
+	if err := r.client.Update(ctx, profile); err != nil {
+		return fmt.Errorf("updating impact report: %w", err)
+	}
+
+	logger.Info("Updated impact report of proposed spec")
+	if len(report.RecentlyUsed) > 0 {
+		r.record.Eventf(
+			profile, util.EventTypeWarning, reasonImpactDetected,
+			"Proposed spec removes operations used within the last %s: %v", report.Window, report.RecentlyUsed,
+		)
+	}
+	return nil
+}
+
+// applyPatch merges the suggested patch annotation, as approved, into the
+// profile spec.
+func (r *AggregateReconciler) applyPatch(
+	ctx context.Context, logger logr.Logger, profile profilebaseapi.SecurityProfileBase,
+) error {
+	patch, err := profilepatcher.SuggestedPatch(profile)
+	if err != nil {
+		r.record.Event(profile, util.EventTypeWarning, reasonCannotApplyPatch, err.Error())
+		return err
+	}
+
+	if err := profilepatcher.Apply(profile, patch); err != nil {
+		r.record.Event(profile, util.EventTypeWarning, reasonCannotApplyPatch, err.Error())
+		return fmt.Errorf("applying patch: %w", err)
+	}
+
+	annotations := profile.GetAnnotations()
+	delete(annotations, profilebaseapi.SuggestedPatchAnnotation)
+	delete(annotations, profilebaseapi.ApprovePatchAnnotation)
+	profile.SetAnnotations(annotations)
+
+	if err := r.client.Update(ctx, profile); err != nil {
+		return fmt.Errorf("updating profile: %w", err)
+	}
+
+	logger.Info("Applied approved patch")
+	r.record.Event(profile, util.EventTypeNormal, reasonPatchApplied, "Approved patch merged into the profile")
+	return nil
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package patchaggregator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/containers/common/pkg/seccomp"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	profilebaseapi "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	statusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/profilepatcher"
+)
+
+func seccompProfile(annotations map[string]string) *seccompprofileapi.SeccompProfile {
+	return &seccompprofileapi.SeccompProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "profile",
+			Namespace:   "default",
+			UID:         "profile-uid",
+			Annotations: annotations,
+		},
+		Spec: seccompprofileapi.SeccompProfileSpec{
+			DefaultAction: seccomp.ActErrno,
+			Syscalls: []*seccompprofileapi.Syscall{{
+				Names:  []string{"read"},
+				Action: seccomp.ActAllow,
+			}},
+		},
+	}
+}
+
+func nodeStatus(
+	profile *seccompprofileapi.SeccompProfile, node, patch string,
+) *statusv1alpha1.SecurityProfileNodeStatus {
+	return &statusv1alpha1.SecurityProfileNodeStatus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      profile.GetName() + "-" + node,
+			Namespace: profile.GetNamespace(),
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(profile, seccompprofileapi.GroupVersion.WithKind("SeccompProfile")),
+			},
+		},
+		NodeName:       node,
+		SuggestedPatch: patch,
+	}
+}
+
+func reconcileProfile(
+	t *testing.T, objs ...client.Object,
+) (*seccompprofileapi.SeccompProfile, *record.FakeRecorder) {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, seccompprofileapi.AddToScheme(scheme))
+	require.NoError(t, statusv1alpha1.AddToScheme(scheme))
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+
+	recorder := record.NewFakeRecorder(10)
+	sut, ok := NewSeccompController().(*AggregateReconciler)
+	require.True(t, ok)
+	sut.client = cli
+	sut.log = logr.Discard()
+	sut.record = recorder
+
+	key := client.ObjectKey{Namespace: "default", Name: "profile"}
+	_, err := sut.Reconcile(context.Background(), reconcile.Request{NamespacedName: key})
+	require.NoError(t, err)
+
+	profile := &seccompprofileapi.SeccompProfile{}
+	require.NoError(t, cli.Get(context.Background(), key, profile))
+	return profile, recorder
+}
+
+func TestAggregatePatches(t *testing.T) {
+	t.Parallel()
+
+	profile := seccompProfile(nil)
+	other := seccompProfile(nil)
+	other.SetName("other")
+	other.SetUID("other-uid")
+
+	res, recorder := reconcileProfile(t,
+		profile,
+		nodeStatus(profile, "node-b", `{"syscalls":[{"names":["write"],"action":"SCMP_ACT_ALLOW"}]}`),
+		nodeStatus(profile, "node-a", `{"syscalls":[{"names":["open","read"],"action":"SCMP_ACT_ALLOW"}]}`),
+		nodeStatus(profile, "node-c", "invalid"),
+		nodeStatus(other, "node-a", `{"syscalls":[{"names":["mmap"],"action":"SCMP_ACT_ALLOW"}]}`),
+	)
+
+	assert.JSONEq(t,
+		`{"syscalls":[{"names":["open","write"],"action":"SCMP_ACT_ALLOW"}]}`,
+		res.GetAnnotations()[profilebaseapi.SuggestedPatchAnnotation],
+	)
+	assert.Len(t, recorder.Events, 1)
+}
+
+func TestAggregatePatchesRemovesAllowed(t *testing.T) {
+	t.Parallel()
+
+	profile := seccompProfile(map[string]string{
+		profilebaseapi.SuggestedPatchAnnotation: `{"syscalls":[{"names":["read"],"action":"SCMP_ACT_ALLOW"}]}`,
+	})
+
+	res, recorder := reconcileProfile(t,
+		profile,
+		nodeStatus(profile, "node-a", `{"syscalls":[{"names":["read"],"action":"SCMP_ACT_ALLOW"}]}`),
+	)
+
+	assert.NotContains(t, res.GetAnnotations(), profilebaseapi.SuggestedPatchAnnotation)
+	assert.Empty(t, recorder.Events)
+}
+
+func TestAggregateImpactReports(t *testing.T) {
+	t.Parallel()
+
+	profile := seccompProfile(map[string]string{
+		profilebaseapi.ProposedSpecAnnotation: "null",
+	})
+	proposedSpec := profilepatcher.ProposedSpecDigest(profile)
+
+	withReport := func(
+		status *statusv1alpha1.SecurityProfileNodeStatus, report string,
+	) *statusv1alpha1.SecurityProfileNodeStatus {
+		status.ImpactReport = report
+		return status
+	}
+
+	res, recorder := reconcileProfile(t,
+		profile,
+		withReport(nodeStatus(profile, "node-b", ""),
+			`{"proposedSpec":"`+proposedSpec+`","window":"24h0m0s","removed":["read"],`+
+				`"recentlyUsed":["read"],"nodes":["node-b"]}`),
+		withReport(nodeStatus(profile, "node-a", ""),
+			`{"proposedSpec":"`+proposedSpec+`","window":"24h0m0s","removed":["read"],"nodes":["node-a"]}`),
+		withReport(nodeStatus(profile, "node-c", ""),
+			`{"proposedSpec":"outdated","window":"24h0m0s","removed":["read"],`+
+				`"recentlyUsed":["write"],"nodes":["node-c"]}`),
+		withReport(nodeStatus(profile, "node-d", ""), "invalid"),
+	)
+
+	report, err := profilepatcher.ParseImpactReport(res.GetAnnotations()[profilebaseapi.ImpactReportAnnotation])
+	require.NoError(t, err)
+	require.NotNil(t, report)
+	assert.Equal(t, proposedSpec, report.ProposedSpec)
+	assert.Equal(t, []string{"read"}, report.Removed)
+	assert.Equal(t, []string{"read"}, report.RecentlyUsed)
+	assert.Equal(t, []string{"node-a", "node-b"}, report.Nodes)
+	require.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events, reasonImpactDetected)
+}
+
+func TestAggregateImpactReportsWithoutProposedSpec(t *testing.T) {
+	t.Parallel()
+
+	profile := seccompProfile(nil)
+	status := nodeStatus(profile, "node-a", "")
+	status.ImpactReport = `{"proposedSpec":"outdated","window":"24h0m0s","recentlyUsed":["read"]}`
+
+	res, recorder := reconcileProfile(t, profile, status)
+
+	assert.NotContains(t, res.GetAnnotations(), profilebaseapi.ImpactReportAnnotation)
+	assert.Empty(t, recorder.Events)
+}
+
+func TestApplyApprovedPatch(t *testing.T) {
+	t.Parallel()
+
+	profile := seccompProfile(map[string]string{
+		profilebaseapi.SuggestedPatchAnnotation: `{"syscalls":[{"names":["write"],"action":"SCMP_ACT_ALLOW"}]}`,
+		profilebaseapi.ApprovePatchAnnotation:   "true",
+	})
+
+	res, recorder := reconcileProfile(t, profile)
+
+	assert.NotContains(t, res.GetAnnotations(), profilebaseapi.SuggestedPatchAnnotation)
+	assert.NotContains(t, res.GetAnnotations(), profilebaseapi.ApprovePatchAnnotation)
+	require.Len(t, res.Spec.Syscalls, 1)
+	assert.ElementsMatch(t, []string{"read", "write"}, res.Spec.Syscalls[0].Names)
+	assert.Len(t, recorder.Events, 1)
+}
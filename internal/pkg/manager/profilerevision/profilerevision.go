@@ -0,0 +1,403 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profilerevision
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+
+	profilebaseapi "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/controller"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+const (
+	errGetProfile      = "cannot get profile"
+	errListRevisions   = "cannot list profile revisions"
+	errUnknownRevision = "unknown revision"
+
+	// hashLength is the number of hex characters of the spec hash used in
+	// the name of a revision.
+	hashLength = 10
+
+	reasonRolledBack     string = "RolledBack"
+	reasonCannotRollback string = "CannotRollback"
+)
+
+// RevisionReconciler stores the spec of a profile as ControllerRevision
+// whenever it changes and restores previous revisions on request.
+type RevisionReconciler struct {
+	client         client.Client
+	reader         client.Reader
+	log            logr.Logger
+	record         record.EventRecorder
+	controllerName string
+	schemeBuilder  *scheme.Builder
+	handler        revisionHandler
+}
+
+// revisionHandler abstracts the profile kinds which keep a revision history.
+type revisionHandler struct {
+	// kind of the profile, used as revision label.
+	kind string
+	// newProfile returns an empty profile of the handled kind.
+	newProfile func() profilebaseapi.RevisionStatusUser
+	// spec returns the spec of the profile.
+	spec func(profilebaseapi.RevisionStatusUser) any
+	// setSpec replaces the spec of the profile with the JSON encoded one.
+	setSpec func(profilebaseapi.RevisionStatusUser, []byte) error
+}
+
+// NewSeccompController returns a new controller instance for SeccompProfiles.
+func NewSeccompController() controller.Controller {
+	return &RevisionReconciler{
+		controllerName: "seccomprevision",
+		schemeBuilder:  seccompprofileapi.SchemeBuilder,
+		handler: revisionHandler{
+			kind: "SeccompProfile",
+			newProfile: func() profilebaseapi.RevisionStatusUser {
+				return &seccompprofileapi.SeccompProfile{}
+			},
+			spec: func(obj profilebaseapi.RevisionStatusUser) any {
+				sp, _ := obj.(*seccompprofileapi.SeccompProfile)
+				return &sp.Spec
+			},
+			setSpec: func(obj profilebaseapi.RevisionStatusUser, data []byte) error {
+				sp, _ := obj.(*seccompprofileapi.SeccompProfile)
+				spec := seccompprofileapi.SeccompProfileSpec{}
+				if err := json.Unmarshal(data, &spec); err != nil {
+					return fmt.Errorf("unmarshal seccomp profile spec: %w", err)
+				}
+				sp.Spec = spec
+				return nil
+			},
+		},
+	}
+}
+
+// NewSelinuxController returns a new controller instance for SelinuxProfiles.
+func NewSelinuxController() controller.Controller {
+	return &RevisionReconciler{
+		controllerName: "selinuxrevision",
+		schemeBuilder:  selxv1alpha2.SchemeBuilder,
+		handler: revisionHandler{
+			kind: "SelinuxProfile",
+			newProfile: func() profilebaseapi.RevisionStatusUser {
+				return &selxv1alpha2.SelinuxProfile{}
+			},
+			spec: func(obj profilebaseapi.RevisionStatusUser) any {
+				sp, _ := obj.(*selxv1alpha2.SelinuxProfile)
+				return &sp.Spec
+			},
+			setSpec: func(obj profilebaseapi.RevisionStatusUser, data []byte) error {
+				sp, _ := obj.(*selxv1alpha2.SelinuxProfile)
+				spec := selxv1alpha2.SelinuxProfileSpec{}
+				if err := json.Unmarshal(data, &spec); err != nil {
+					return fmt.Errorf("unmarshal selinux profile spec: %w", err)
+				}
+				sp.Spec = spec
+				return nil
+			},
+		},
+	}
+}
+
+// Name returns the name of the controller.
+func (r *RevisionReconciler) Name() string {
+	return r.controllerName
+}
+
+// SchemeBuilder returns the API scheme of the controller.
+func (r *RevisionReconciler) SchemeBuilder() *scheme.Builder {
+	return r.schemeBuilder
+}
+
+// Healthz is the liveness probe endpoint of the controller.
+func (r *RevisionReconciler) Healthz(*http.Request) error {
+	return nil
+}
+
+//nolint:lll // required for kubebuilder
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles/status,verbs=get;update
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=selinuxprofiles,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=selinuxprofiles/status,verbs=get;update
+// +kubebuilder:rbac:groups=apps,resources=controllerrevisions,verbs=get;list;create;update;delete
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+
+// Reconcile records the spec of the profile as revision, or restores the
+// spec of the revision requested by the rollback annotation.
+func (r *RevisionReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	logger := r.log.WithValues("profile", req.Name, "namespace", req.Namespace)
+
+	profile := r.handler.newProfile()
+	if err := r.client.Get(ctx, req.NamespacedName, profile); err != nil {
+		if util.IgnoreNotFound(err) == nil {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("%s: %w", errGetProfile, err)
+	}
+
+	if !profile.GetDeletionTimestamp().IsZero() {
+		// The revisions get garbage collected through their owner reference
+		return reconcile.Result{}, nil
+	}
+
+	revisions, err := r.listRevisions(ctx, profile)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	data, err := json.Marshal(r.handler.spec(profile))
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("marshal profile spec: %w", err)
+	}
+	name := revisionName(r.handler.kind, profile.GetName(), data)
+
+	if target, ok := profile.GetAnnotations()[profilebaseapi.RollbackAnnotation]; ok {
+		return reconcile.Result{}, r.rollback(ctx, logger, profile, revisions, name, target)
+	}
+
+	active, revisions, err := r.activateRevision(ctx, profile, revisions, name, data)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := r.pruneRevisions(ctx, revisions); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, r.updateStatus(ctx, profile, active)
+}
+
+// listRevisions returns the revisions of the profile sorted by their number.
+func (r *RevisionReconciler) listRevisions(
+	ctx context.Context, profile profilebaseapi.RevisionStatusUser,
+) ([]appsv1.ControllerRevision, error) {
+	list := &appsv1.ControllerRevisionList{}
+	if err := r.reader.List(ctx, list,
+		client.InNamespace(profile.GetNamespace()),
+		client.MatchingLabels{
+			profilebaseapi.RevisionProfileKindLabel: r.handler.kind,
+			profilebaseapi.RevisionProfileNameLabel: profile.GetName(),
+		},
+	); err != nil {
+		return nil, fmt.Errorf("%s: %w", errListRevisions, err)
+	}
+
+	revisions := list.Items
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].Revision < revisions[j].Revision
+	})
+	return revisions, nil
+}
+
+// activateRevision makes the revision of the current spec the latest one,
+// either by creating it or by renumbering an existing one.
+func (r *RevisionReconciler) activateRevision(
+	ctx context.Context,
+	profile profilebaseapi.RevisionStatusUser,
+	revisions []appsv1.ControllerRevision,
+	name string,
+	data []byte,
+) (*appsv1.ControllerRevision, []appsv1.ControllerRevision, error) {
+	latest := int64(0)
+	if len(revisions) > 0 {
+		latest = revisions[len(revisions)-1].Revision
+	}
+
+	for i := range revisions {
+		if revisions[i].GetName() != name {
+			continue
+		}
+
+		active := revisions[i].DeepCopy()
+		if active.Revision == latest {
+			return active, revisions, nil
+		}
+
+		active.Revision = latest + 1
+		if err := r.client.Update(ctx, active); err != nil {
+			return nil, nil, fmt.Errorf("updating revision %s: %w", name, err)
+		}
+		revisions = append(append(revisions[:i:i], revisions[i+1:]...), *active)
+		return active, revisions, nil
+	}
+
+	active := &appsv1.ControllerRevision{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: profile.GetNamespace(),
+			Labels: map[string]string{
+				profilebaseapi.RevisionProfileKindLabel: r.handler.kind,
+				profilebaseapi.RevisionProfileNameLabel: profile.GetName(),
+			},
+		},
+		Data:     runtime.RawExtension{Raw: data},
+		Revision: latest + 1,
+	}
+	if err := controllerutil.SetControllerReference(profile, active, r.client.Scheme()); err != nil {
+		return nil, nil, fmt.Errorf("setting revision owner: %w", err)
+	}
+	if err := r.client.Create(ctx, active); err != nil {
+		return nil, nil, fmt.Errorf("creating revision %s: %w", name, err)
+	}
+
+	return active, append(revisions, *active), nil
+}
+
+// pruneRevisions removes the oldest revisions exceeding the history limit.
+// The active revision is always the latest one and therefore kept.
+func (r *RevisionReconciler) pruneRevisions(
+	ctx context.Context, revisions []appsv1.ControllerRevision,
+) error {
+	for i := 0; i < len(revisions)-profilebaseapi.MaxProfileRevisions; i++ {
+		if err := r.client.Delete(ctx, &revisions[i]); util.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("deleting revision %s: %w", revisions[i].GetName(), err)
+		}
+	}
+	return nil
+}
+
+func (r *RevisionReconciler) updateStatus(
+	ctx context.Context,
+	profile profilebaseapi.RevisionStatusUser,
+	active *appsv1.ControllerRevision,
+) error {
+	status := profilebaseapi.RevisionStatus{
+		ActiveRevision:     active.Revision,
+		ActiveRevisionName: active.GetName(),
+	}
+
+	if err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		if *profile.GetRevisionStatus() == status {
+			return nil
+		}
+		*profile.GetRevisionStatus() = status
+		updateErr := r.client.Status().Update(ctx, profile)
+		if updateErr == nil {
+			return nil
+		}
+		if err := r.client.Get(ctx, client.ObjectKeyFromObject(profile), profile); err != nil {
+			return fmt.Errorf("%s: %w", errGetProfile, err)
+		}
+		return updateErr
+	}); err != nil {
+		return fmt.Errorf("updating revision status: %w", err)
+	}
+
+	return nil
+}
+
+// rollback restores the spec of the target revision and removes the
+// rollback annotation. The spec update makes the daemons reconcile the
+// profile on all nodes, after which the restored revision becomes the
+// latest one.
+func (r *RevisionReconciler) rollback(
+	ctx context.Context,
+	logger logr.Logger,
+	profile profilebaseapi.RevisionStatusUser,
+	revisions []appsv1.ControllerRevision,
+	activeName, target string,
+) error {
+	annotations := profile.GetAnnotations()
+	delete(annotations, profilebaseapi.RollbackAnnotation)
+	profile.SetAnnotations(annotations)
+
+	revision, err := targetRevision(revisions, activeName, target)
+	if err == nil {
+		err = r.handler.setSpec(profile, revision.Data.Raw)
+	}
+	if err != nil {
+		logger.Error(err, "Cannot roll back profile", "target", target)
+		r.record.Event(profile, util.EventTypeWarning, reasonCannotRollback, err.Error())
+		if err := r.client.Update(ctx, profile); err != nil {
+			return fmt.Errorf("removing rollback annotation: %w", err)
+		}
+		return nil
+	}
+
+	if err := r.client.Update(ctx, profile); err != nil {
+		return fmt.Errorf("rolling back profile: %w", err)
+	}
+
+	logger.Info("Rolled back profile", "revision", revision.Revision)
+	r.record.Eventf(profile, util.EventTypeNormal, reasonRolledBack, "Rolled back to revision %d", revision.Revision)
+	return nil
+}
+
+// targetRevision returns the revision with the requested number, or the
+// latest revision which does not match the current spec for "previous".
+func targetRevision(
+	revisions []appsv1.ControllerRevision, activeName, target string,
+) (*appsv1.ControllerRevision, error) {
+	if target == profilebaseapi.RollbackPrevious {
+		for i := len(revisions) - 1; i >= 0; i-- {
+			if revisions[i].GetName() != activeName {
+				return &revisions[i], nil
+			}
+		}
+		return nil, fmt.Errorf("%s: no previous revision", errUnknownRevision)
+	}
+
+	number, err := strconv.ParseInt(target, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%s %q: %w", errUnknownRevision, target, err)
+	}
+	for i := range revisions {
+		if revisions[i].Revision == number {
+			return &revisions[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%s %d", errUnknownRevision, number)
+}
+
+// revisionName returns the name of the revision containing the spec. The
+// name is prefixed with the profile kind, because profiles of different
+// kinds can share their name within a namespace.
+func revisionName(kind, profileName string, spec []byte) string {
+	hash := sha256.Sum256(spec)
+	suffix := hex.EncodeToString(hash[:])[:hashLength]
+
+	// Ensure that the name stays a valid DNS subdomain
+	prefix := strings.ToLower(kind) + "-" + profileName
+	const maxPrefixLength = 253 - hashLength - 1
+	if len(prefix) > maxPrefixLength {
+		prefix = prefix[:maxPrefixLength]
+	}
+	return prefix + "-" + suffix
+}
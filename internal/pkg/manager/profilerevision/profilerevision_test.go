@@ -0,0 +1,230 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profilerevision
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/containers/common/pkg/seccomp"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	profilebaseapi "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+)
+
+func TestReconcileRevisions(t *testing.T) {
+	t.Parallel()
+
+	setSyscalls := func(names ...string) func(*seccompprofileapi.SeccompProfile) {
+		return func(sp *seccompprofileapi.SeccompProfile) {
+			sp.Spec.Syscalls[0].Names = names
+		}
+	}
+	rollback := func(revision string) func(*seccompprofileapi.SeccompProfile) {
+		return func(sp *seccompprofileapi.SeccompProfile) {
+			sp.SetAnnotations(map[string]string{profilebaseapi.RollbackAnnotation: revision})
+		}
+	}
+
+	pruneUpdates := []func(*seccompprofileapi.SeccompProfile){}
+	for i := 1; i <= profilebaseapi.MaxProfileRevisions+2; i++ {
+		pruneUpdates = append(pruneUpdates, setSyscalls(fmt.Sprintf("syscall%d", i)))
+	}
+	// only the latest revisions of the initial spec and its updates are kept
+	pruneLatest := int64(len(pruneUpdates) + 1)
+	pruneRevisions := []int64{}
+	for i := pruneLatest - profilebaseapi.MaxProfileRevisions + 1; i <= pruneLatest; i++ {
+		pruneRevisions = append(pruneRevisions, i)
+	}
+
+	for _, tc := range []struct {
+		name          string
+		updates       []func(*seccompprofileapi.SeccompProfile)
+		wantSyscalls  []string
+		wantActive    int64
+		wantRevisions []int64
+		// wantFirst expects the name of the first revision, which only
+		// depends on the spec
+		wantFirst bool
+	}{
+		{
+			name:          "first revision",
+			wantSyscalls:  []string{"read"},
+			wantActive:    1,
+			wantRevisions: []int64{1},
+			wantFirst:     true,
+		},
+		{
+			name:          "unchanged spec",
+			updates:       []func(*seccompprofileapi.SeccompProfile){setSyscalls("read")},
+			wantSyscalls:  []string{"read"},
+			wantActive:    1,
+			wantRevisions: []int64{1},
+			wantFirst:     true,
+		},
+		{
+			name:          "changed spec",
+			updates:       []func(*seccompprofileapi.SeccompProfile){setSyscalls("read", "write")},
+			wantSyscalls:  []string{"read", "write"},
+			wantActive:    2,
+			wantRevisions: []int64{1, 2},
+		},
+		{
+			name: "roll back to the previous revision",
+			updates: []func(*seccompprofileapi.SeccompProfile){
+				setSyscalls("read", "write"),
+				rollback(profilebaseapi.RollbackPrevious),
+			},
+			// the restored revision becomes the latest one
+			wantSyscalls:  []string{"read"},
+			wantActive:    3,
+			wantRevisions: []int64{2, 3},
+			wantFirst:     true,
+		},
+		{
+			name: "roll back to an explicit revision",
+			updates: []func(*seccompprofileapi.SeccompProfile){
+				setSyscalls("read", "write"),
+				setSyscalls("read", "write", "close"),
+				rollback("2"),
+			},
+			wantSyscalls:  []string{"read", "write"},
+			wantActive:    4,
+			wantRevisions: []int64{1, 3, 4},
+		},
+		{
+			name: "roll back to an unknown revision",
+			updates: []func(*seccompprofileapi.SeccompProfile){
+				setSyscalls("read", "write"),
+				rollback("7"),
+			},
+			wantSyscalls:  []string{"read", "write"},
+			wantActive:    2,
+			wantRevisions: []int64{1, 2},
+		},
+		{
+			name:          "pruned revisions",
+			updates:       pruneUpdates,
+			wantSyscalls:  []string{fmt.Sprintf("syscall%d", profilebaseapi.MaxProfileRevisions+2)},
+			wantActive:    pruneLatest,
+			wantRevisions: pruneRevisions,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			scheme := runtime.NewScheme()
+			require.NoError(t, clientgoscheme.AddToScheme(scheme))
+			require.NoError(t, seccompprofileapi.AddToScheme(scheme))
+
+			profile := &seccompprofileapi.SeccompProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "profile", Namespace: "default"},
+				Spec: seccompprofileapi.SeccompProfileSpec{
+					DefaultAction: seccomp.ActErrno,
+					Syscalls: []*seccompprofileapi.Syscall{{
+						Names:  []string{"read"},
+						Action: seccomp.ActAllow,
+					}},
+				},
+			}
+			cli := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(profile).
+				WithStatusSubresource(profile).
+				Build()
+
+			sut, ok := NewSeccompController().(*RevisionReconciler)
+			require.True(t, ok)
+			sut.client = cli
+			sut.reader = cli
+			sut.log = logr.Discard()
+			sut.record = record.NewFakeRecorder(10)
+
+			// A rollback restores the spec in a first reconcile and records
+			// the restored revision in a second one.
+			key := client.ObjectKey{Namespace: "default", Name: "profile"}
+			reconcileProfile := func() {
+				for i := 0; i < 2; i++ {
+					_, err := sut.Reconcile(context.Background(), reconcile.Request{NamespacedName: key})
+					require.NoError(t, err)
+				}
+				require.NoError(t, cli.Get(context.Background(), key, profile))
+			}
+
+			reconcileProfile()
+			firstRevision := profile.Status.ActiveRevisionName
+			require.True(t, strings.HasPrefix(firstRevision, "seccompprofile-profile-"))
+			for _, update := range tc.updates {
+				update(profile)
+				require.NoError(t, cli.Update(context.Background(), profile))
+				reconcileProfile()
+			}
+
+			require.NotContains(t, profile.GetAnnotations(), profilebaseapi.RollbackAnnotation)
+			require.Equal(t, tc.wantSyscalls, profile.Spec.Syscalls[0].Names)
+			require.Equal(t, tc.wantActive, profile.Status.ActiveRevision)
+			if tc.wantFirst {
+				require.Equal(t, firstRevision, profile.Status.ActiveRevisionName)
+			} else {
+				require.NotEqual(t, firstRevision, profile.Status.ActiveRevisionName)
+			}
+
+			list := &appsv1.ControllerRevisionList{}
+			require.NoError(t, cli.List(context.Background(), list))
+			revisions := []int64{}
+			for i := range list.Items {
+				require.Equal(t, "SeccompProfile", list.Items[i].Labels[profilebaseapi.RevisionProfileKindLabel])
+				require.Equal(t, "profile", list.Items[i].Labels[profilebaseapi.RevisionProfileNameLabel])
+				require.Len(t, list.Items[i].OwnerReferences, 1)
+				revisions = append(revisions, list.Items[i].Revision)
+			}
+			require.ElementsMatch(t, tc.wantRevisions, revisions)
+		})
+	}
+}
+
+func TestRevisionName(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t,
+		revisionName("SeccompProfile", "profile", []byte("a")),
+		revisionName("SeccompProfile", "profile", []byte("a")),
+	)
+	require.NotEqual(t,
+		revisionName("SeccompProfile", "profile", []byte("a")),
+		revisionName("SeccompProfile", "profile", []byte("b")),
+	)
+	require.NotEqual(t,
+		revisionName("SeccompProfile", "profile", []byte("a")),
+		revisionName("SelinuxProfile", "profile", []byte("a")),
+	)
+	require.True(t, strings.HasPrefix(revisionName("SeccompProfile", "profile", []byte("a")), "seccompprofile-profile-"))
+	require.Len(t, revisionName("SeccompProfile", strings.Repeat("a", 253), []byte("a")), 253)
+}
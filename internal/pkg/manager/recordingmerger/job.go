@@ -0,0 +1,461 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recordingmerger
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	profilerecording1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
+	seccompprofile "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+)
+
+const (
+	// jobRequeueInterval is the interval in which the completion of a Job
+	// recording gets checked again.
+	jobRequeueInterval = 30 * time.Second
+
+	// profileCollectionTimeout is the time after which a finished pod is
+	// considered collected, even if not all of its profiles showed up.
+	// This avoids waiting forever for containers which never ran, for
+	// example because an init container failed.
+	profileCollectionTimeout = 5 * time.Minute
+)
+
+// recordingAnnotationPrefixes are the annotation key prefixes used to mark
+// the containers of a pod for recording.
+var recordingAnnotationPrefixes = []string{
+	config.SeccompProfileRecordLogsAnnotationKey,
+	config.SeccompProfileRecordBpfAnnotationKey,
+	config.SelinuxProfileRecordLogsAnnotationKey,
+}
+
+// reconcileJob tracks the completion of a recording scoped to a Job or
+// CronJob. The recording completes once the Job finished and the profiles
+// of all of its pods got collected. A CronJob recording completes once its
+// recording window closed and all Jobs started within it finished.
+func (r *PolicyMergeReconciler) reconcileJob(
+	ctx context.Context,
+	profileRecording *profilerecording1alpha1.ProfileRecording,
+) (time.Duration, error) {
+	completed := meta.FindStatusCondition(
+		profileRecording.Status.Conditions, profilerecording1alpha1.TypeCompleted,
+	)
+	if completed != nil && completed.Status == metav1.ConditionTrue {
+		return 0, nil
+	}
+
+	ref := profileRecording.Spec.Job
+	if ref.Kind == profilerecording1alpha1.JobKindCronJob {
+		// A CronJob keeps starting Jobs, so none of them ends the recording.
+		_, end := profileRecording.RecordingWindow()
+		if end.IsZero() {
+			return 0, r.setCompleted(
+				ctx, profileRecording, metav1.ConditionFalse, profilerecording1alpha1.ReasonWindowOpen,
+				fmt.Sprintf("Recording %s %s until the recording is deleted, "+
+					"set a duration or deadline to complete it", ref.Kind, ref.Name),
+			)
+		}
+		if remaining := time.Until(end); remaining > 0 {
+			return remaining, r.setCompleted(
+				ctx, profileRecording, metav1.ConditionFalse, profilerecording1alpha1.ReasonWindowOpen,
+				fmt.Sprintf("Recording %s %s until %s", ref.Kind, ref.Name, end.UTC().Format(time.RFC3339)),
+			)
+		}
+	}
+
+	jobs, err := r.recordedJobs(ctx, profileRecording)
+	if err != nil {
+		return 0, err
+	}
+
+	// Jobs may get deleted after they finished, for example by their TTL,
+	// so the recording keeps track of them in its status.
+	tracked := trackJobs(profileRecording.Status.Jobs, jobs)
+	if err := r.setRecordedJobs(ctx, profileRecording, tracked); err != nil {
+		return 0, err
+	}
+
+	finished := map[types.UID]bool{}
+	for i := range tracked {
+		if tracked[i].Finished {
+			finished[tracked[i].UID] = true
+		}
+	}
+
+	if len(tracked) == 0 || len(finished) < len(tracked) {
+		return jobRequeueInterval, r.setCompleted(
+			ctx, profileRecording, metav1.ConditionFalse, profilerecording1alpha1.ReasonJobRunning,
+			fmt.Sprintf("Waiting for %s %s to finish", ref.Kind, ref.Name),
+		)
+	}
+
+	pending, err := r.pendingJobPods(ctx, profileRecording, finished)
+	if err != nil {
+		return 0, err
+	}
+
+	if pending > 0 {
+		return jobRequeueInterval, r.setCompleted(
+			ctx, profileRecording, metav1.ConditionFalse, profilerecording1alpha1.ReasonCollectingProfiles,
+			fmt.Sprintf("Waiting for the profiles of %d pod(s) to be collected", pending),
+		)
+	}
+
+	if profileRecording.Spec.MergeStrategy.IsMerging() {
+		if err := r.mergeProfiles(ctx, profileRecording); err != nil {
+			return 0, fmt.Errorf("%s: %w", errMergingRec, err)
+		}
+	}
+
+	return 0, r.setCompleted(
+		ctx, profileRecording, metav1.ConditionTrue, profilerecording1alpha1.ReasonJobFinished,
+		fmt.Sprintf("%s %s finished and all profiles got collected", ref.Kind, ref.Name),
+	)
+}
+
+// recordedJobs returns the Jobs referenced by the recording. For CronJobs
+// these are the Jobs owned by the CronJob, which got started before the end
+// of the recording window.
+func (r *PolicyMergeReconciler) recordedJobs(
+	ctx context.Context,
+	profileRecording *profilerecording1alpha1.ProfileRecording,
+) ([]batchv1.Job, error) {
+	ref := profileRecording.Spec.Job
+
+	switch ref.Kind {
+	case profilerecording1alpha1.JobKindJob:
+		job := &batchv1.Job{}
+		if err := r.client.Get(ctx, types.NamespacedName{
+			Namespace: profileRecording.Namespace,
+			Name:      ref.Name,
+		}, job); err != nil {
+			if client.IgnoreNotFound(err) == nil {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("getting job %s: %w", ref.Name, err)
+		}
+		return []batchv1.Job{*job}, nil
+
+	case profilerecording1alpha1.JobKindCronJob:
+		jobs := &batchv1.JobList{}
+		if err := r.client.List(ctx, jobs, client.InNamespace(profileRecording.Namespace)); err != nil {
+			return nil, fmt.Errorf("listing jobs: %w", err)
+		}
+
+		_, end := profileRecording.RecordingWindow()
+		res := []batchv1.Job{}
+		for i := range jobs.Items {
+			job := &jobs.Items[i]
+			owner := metav1.GetControllerOf(job)
+			if owner == nil || owner.Kind != string(ref.Kind) || owner.Name != ref.Name {
+				continue
+			}
+			// The pods of Jobs started after the window are not recorded.
+			if !end.IsZero() && !job.CreationTimestamp.Time.Before(end) {
+				continue
+			}
+			res = append(res, *job)
+		}
+		return res, nil
+	}
+
+	return nil, fmt.Errorf("unsupported job kind: %s", ref.Kind)
+}
+
+// trackJobs merges the existing Jobs of a recording into the Jobs tracked in
+// its status. Tracked Jobs which do not exist anymore are finished, because
+// they cannot run anymore once deleted.
+func trackJobs(
+	tracked []profilerecording1alpha1.RecordedJob, jobs []batchv1.Job,
+) []profilerecording1alpha1.RecordedJob {
+	res := make([]profilerecording1alpha1.RecordedJob, 0, len(jobs)+len(tracked))
+	existing := map[types.UID]bool{}
+	for i := range jobs {
+		existing[jobs[i].UID] = true
+		res = append(res, profilerecording1alpha1.RecordedJob{
+			Name:     jobs[i].Name,
+			UID:      jobs[i].UID,
+			Finished: jobFinished(&jobs[i]),
+		})
+	}
+	for _, job := range tracked {
+		if !existing[job.UID] {
+			job.Finished = true
+			res = append(res, job)
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Name != res[j].Name {
+			return res[i].Name < res[j].Name
+		}
+		return res[i].UID < res[j].UID
+	})
+	return res
+}
+
+// setRecordedJobs updates the Jobs tracked in the status of the recording.
+func (r *PolicyMergeReconciler) setRecordedJobs(
+	ctx context.Context,
+	profileRecording *profilerecording1alpha1.ProfileRecording,
+	jobs []profilerecording1alpha1.RecordedJob,
+) error {
+	if err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		recording := &profilerecording1alpha1.ProfileRecording{}
+		if err := r.client.Get(ctx, client.ObjectKeyFromObject(profileRecording), recording); err != nil {
+			return fmt.Errorf("%s: %w", errGetRecording, err)
+		}
+
+		if equality.Semantic.DeepEqual(jobs, recording.Status.Jobs) {
+			return nil
+		}
+
+		recording.Status.Jobs = jobs
+		return r.client.Status().Update(ctx, recording)
+	}); err != nil {
+		return fmt.Errorf("updating recorded jobs: %w", err)
+	}
+
+	return nil
+}
+
+// jobFinished returns true if the job either completed or failed.
+func jobFinished(job *batchv1.Job) bool {
+	for _, c := range job.Status.Conditions {
+		if (c.Type == batchv1.JobComplete || c.Type == batchv1.JobFailed) &&
+			c.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// pendingJobPods returns the number of recorded pods of the finished jobs,
+// which either still run or whose profiles are not yet collected.
+func (r *PolicyMergeReconciler) pendingJobPods(
+	ctx context.Context,
+	profileRecording *profilerecording1alpha1.ProfileRecording,
+	finishedJobs map[types.UID]bool,
+) (int, error) {
+	pods, err := r.selectedPods(ctx, profileRecording)
+	if err != nil {
+		return 0, err
+	}
+
+	pending := 0
+	for i := range pods {
+		pod := &pods[i]
+
+		owner := metav1.GetControllerOf(pod)
+		if owner == nil || !finishedJobs[owner.UID] {
+			continue
+		}
+
+		if pod.Status.Phase != corev1.PodSucceeded && pod.Status.Phase != corev1.PodFailed {
+			pending++
+			continue
+		}
+
+		collected, err := r.podCollected(ctx, profileRecording, pod)
+		if err != nil {
+			return 0, err
+		}
+		if !collected {
+			pending++
+		}
+	}
+
+	return pending, nil
+}
+
+// podCollected returns true if all profiles of the pod recorded by the
+// recording got collected, or if the pod finished too long ago to still
+// expect them.
+func (r *PolicyMergeReconciler) podCollected(
+	ctx context.Context,
+	profileRecording *profilerecording1alpha1.ProfileRecording,
+	pod *corev1.Pod,
+) (bool, error) {
+	expected := expectedProfiles(pod, profileRecording.Name)
+	if expected == 0 || podFinishedBefore(pod, profileCollectionTimeout) {
+		return true, nil
+	}
+
+	found, err := r.collectedProfiles(ctx, profileRecording, pod.UID)
+	if err != nil {
+		return false, err
+	}
+	return found >= expected, nil
+}
+
+// selectedPods returns the pods selected by the pod selector of the
+// recording.
+func (r *PolicyMergeReconciler) selectedPods(
+	ctx context.Context,
+	profileRecording *profilerecording1alpha1.ProfileRecording,
+) ([]corev1.Pod, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&profileRecording.Spec.PodSelector)
+	if err != nil {
+		return nil, fmt.Errorf("parsing pod selector: %w", err)
+	}
+
+	pods := &corev1.PodList{}
+	if err := r.client.List(
+		ctx,
+		pods,
+		client.InNamespace(profileRecording.Namespace),
+		client.MatchingLabelsSelector{Selector: selector},
+	); err != nil {
+		return nil, fmt.Errorf("listing pods: %w", err)
+	}
+
+	return pods.Items, nil
+}
+
+// expectedProfiles returns the number of containers of the pod which are
+// recorded by the recording.
+func expectedProfiles(pod *corev1.Pod, recordingName string) int {
+	res := 0
+	for key, value := range pod.Annotations {
+		for _, prefix := range recordingAnnotationPrefixes {
+			if strings.HasPrefix(key, prefix) && strings.HasPrefix(value, recordingName+"_") {
+				res++
+				break
+			}
+		}
+	}
+	return res
+}
+
+// podFinishedBefore returns true if all containers of the pod terminated
+// longer than the provided duration ago.
+func podFinishedBefore(pod *corev1.Pod, d time.Duration) bool {
+	var finishedAt time.Time
+	for i := range pod.Status.ContainerStatuses {
+		terminated := pod.Status.ContainerStatuses[i].State.Terminated
+		if terminated == nil {
+			continue
+		}
+		if terminated.FinishedAt.After(finishedAt) {
+			finishedAt = terminated.FinishedAt.Time
+		}
+	}
+	return !finishedAt.IsZero() && time.Since(finishedAt) > d
+}
+
+// collectedProfiles returns the number of profiles recorded for the pod.
+func (r *PolicyMergeReconciler) collectedProfiles(
+	ctx context.Context,
+	profileRecording *profilerecording1alpha1.ProfileRecording,
+	podUID types.UID,
+) (int, error) {
+	list, err := profileList(profileRecording.Spec.Kind)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := r.client.List(
+		ctx,
+		list,
+		client.InNamespace(profileRecording.Namespace),
+		client.MatchingLabels{
+			profilerecording1alpha1.ProfileToRecordingLabel: profileRecording.Name,
+			profilerecording1alpha1.ProfileToPodUIDLabel:    string(podUID),
+		}); err != nil {
+		return 0, fmt.Errorf("listing profiles of pod %s: %w", podUID, err)
+	}
+
+	return meta.LenList(list), nil
+}
+
+// profileList returns an empty list of the profiles of the recorded kind.
+func profileList(kind profilerecording1alpha1.ProfileRecordingKind) (client.ObjectList, error) {
+	switch kind {
+	case profilerecording1alpha1.ProfileRecordingKindSeccompProfile:
+		return &seccompprofile.SeccompProfileList{}, nil
+	case profilerecording1alpha1.ProfileRecordingKindSelinuxProfile:
+		return &selinuxprofileapi.SelinuxProfileList{}, nil
+	}
+	return nil, fmt.Errorf("%s: %s", errCannotMergeKind, kind)
+}
+
+// setCompleted updates the completed condition of the recording.
+func (r *PolicyMergeReconciler) setCompleted(
+	ctx context.Context,
+	profileRecording *profilerecording1alpha1.ProfileRecording,
+	status metav1.ConditionStatus,
+	reason, message string,
+) error {
+	return r.setCompletedWithSummary(ctx, profileRecording, status, reason, message, nil)
+}
+
+// setCompletedWithSummary updates the completed condition of the recording
+// and sets the summary if provided.
+func (r *PolicyMergeReconciler) setCompletedWithSummary(
+	ctx context.Context,
+	profileRecording *profilerecording1alpha1.ProfileRecording,
+	status metav1.ConditionStatus,
+	reason, message string,
+	summary *profilerecording1alpha1.RecordingSummary,
+) error {
+	condition := metav1.Condition{
+		Type:               profilerecording1alpha1.TypeCompleted,
+		Status:             status,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reason,
+		Message:            message,
+	}
+
+	if err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		recording := &profilerecording1alpha1.ProfileRecording{}
+		if err := r.client.Get(ctx, client.ObjectKeyFromObject(profileRecording), recording); err != nil {
+			return fmt.Errorf("%s: %w", errGetRecording, err)
+		}
+
+		existing := meta.FindStatusCondition(recording.Status.Conditions, condition.Type)
+		if existing != nil && existing.Status == condition.Status &&
+			existing.Reason == condition.Reason && existing.Message == condition.Message &&
+			(summary == nil || equality.Semantic.DeepEqual(summary, recording.Status.Summary)) {
+			return nil
+		}
+
+		recording.Status.SetConditions(condition)
+		if summary != nil {
+			recording.Status.Summary = summary
+		}
+		return r.client.Status().Update(ctx, recording)
+	}); err != nil {
+		return fmt.Errorf("updating recording status: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,371 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recordingmerger
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	profilebase "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	profilerecording1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
+	seccompprofile "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+)
+
+func TestReconcileJobRecording(t *testing.T) {
+	t.Parallel()
+
+	const (
+		namespace = "default"
+		recName   = "rec"
+		jobUID    = "job-uid"
+		podUID    = "pod-uid"
+	)
+
+	newRecording := func() *profilerecording1alpha1.ProfileRecording {
+		return &profilerecording1alpha1.ProfileRecording{
+			ObjectMeta: metav1.ObjectMeta{Name: recName, Namespace: namespace},
+			Spec: profilerecording1alpha1.ProfileRecordingSpec{
+				Kind:          profilerecording1alpha1.ProfileRecordingKindSeccompProfile,
+				Recorder:      profilerecording1alpha1.ProfileRecorderBpf,
+				MergeStrategy: profilerecording1alpha1.ProfileMergeContainers,
+				PodSelector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "job"},
+				},
+				Job: &profilerecording1alpha1.JobReference{
+					Kind: profilerecording1alpha1.JobKindJob,
+					Name: "job",
+				},
+			},
+		}
+	}
+
+	newJob := func(finished bool) *batchv1.Job {
+		job := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: "job", Namespace: namespace, UID: jobUID},
+		}
+		if finished {
+			job.Status.Conditions = []batchv1.JobCondition{{
+				Type:   batchv1.JobComplete,
+				Status: corev1.ConditionTrue,
+			}}
+		}
+		return job
+	}
+
+	newCronJobRecording := func(deadline time.Time) func(*profilerecording1alpha1.ProfileRecording) {
+		return func(rec *profilerecording1alpha1.ProfileRecording) {
+			rec.Spec.Job.Kind = profilerecording1alpha1.JobKindCronJob
+			rec.Spec.Job.Name = "cron"
+			if !deadline.IsZero() {
+				rec.Spec.Deadline = &metav1.Time{Time: deadline}
+			}
+		}
+	}
+
+	newCronJobJob := func(name string, uid types.UID, finished bool) *batchv1.Job {
+		isController := true
+		job := newJob(finished)
+		job.Name = name
+		job.UID = uid
+		job.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: "batch/v1",
+			Kind:       "CronJob",
+			Name:       "cron",
+			UID:        "cron-uid",
+			Controller: &isController,
+		}}
+		return job
+	}
+
+	newPod := func(phase corev1.PodPhase) *corev1.Pod {
+		isController := true
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "job-abcde",
+				Namespace: namespace,
+				UID:       podUID,
+				Labels:    map[string]string{"app": "job"},
+				Annotations: map[string]string{
+					config.SeccompProfileRecordBpfAnnotationKey + "init": recName + "_init_abcde_1",
+					config.SeccompProfileRecordBpfAnnotationKey + "main": recName + "_main_abcde_1",
+				},
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: "batch/v1",
+					Kind:       "Job",
+					Name:       "job",
+					UID:        jobUID,
+					Controller: &isController,
+				}},
+			},
+			Status: corev1.PodStatus{Phase: phase},
+		}
+	}
+
+	newProfile := func(cntName string) *seccompprofile.SeccompProfile {
+		return &seccompprofile.SeccompProfile{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "job-" + cntName,
+				Namespace: namespace,
+				Labels: map[string]string{
+					profilerecording1alpha1.ProfileToRecordingLabel: recName,
+					profilerecording1alpha1.ProfileToContainerLabel: cntName,
+					profilerecording1alpha1.ProfileToPodUIDLabel:    podUID,
+					profilebase.ProfilePartialLabel:                 "true",
+				},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		name           string
+		recording      func(*profilerecording1alpha1.ProfileRecording)
+		objs           []client.Object
+		expectedReason string
+		expectedStatus metav1.ConditionStatus
+		expectRequeue  bool
+		expectMerged   bool
+	}{
+		{
+			name:           "job running",
+			objs:           []client.Object{newJob(false), newPod(corev1.PodRunning)},
+			expectedReason: profilerecording1alpha1.ReasonJobRunning,
+			expectedStatus: metav1.ConditionFalse,
+			expectRequeue:  true,
+		},
+		{
+			name: "profiles not yet collected",
+			objs: []client.Object{
+				newJob(true), newPod(corev1.PodSucceeded), newProfile("init"),
+			},
+			expectedReason: profilerecording1alpha1.ReasonCollectingProfiles,
+			expectedStatus: metav1.ConditionFalse,
+			expectRequeue:  true,
+		},
+		{
+			name: "completed",
+			objs: []client.Object{
+				newJob(true), newPod(corev1.PodFailed), newProfile("init"), newProfile("main"),
+			},
+			expectedReason: profilerecording1alpha1.ReasonJobFinished,
+			expectedStatus: metav1.ConditionTrue,
+			expectMerged:   true,
+		},
+		{
+			name:           "job not yet created",
+			objs:           []client.Object{},
+			expectedReason: profilerecording1alpha1.ReasonJobRunning,
+			expectedStatus: metav1.ConditionFalse,
+			expectRequeue:  true,
+		},
+		{
+			name: "job deleted after completing",
+			recording: func(rec *profilerecording1alpha1.ProfileRecording) {
+				rec.Status.Jobs = []profilerecording1alpha1.RecordedJob{{Name: "job", UID: jobUID}}
+			},
+			objs: []client.Object{
+				newPod(corev1.PodSucceeded), newProfile("init"), newProfile("main"),
+			},
+			expectedReason: profilerecording1alpha1.ReasonJobFinished,
+			expectedStatus: metav1.ConditionTrue,
+			expectMerged:   true,
+		},
+		{
+			name:      "cronjob without recording window",
+			recording: newCronJobRecording(time.Time{}),
+			objs: []client.Object{
+				newCronJobJob("job", jobUID, true), newPod(corev1.PodSucceeded),
+				newProfile("init"), newProfile("main"),
+			},
+			expectedReason: profilerecording1alpha1.ReasonWindowOpen,
+			expectedStatus: metav1.ConditionFalse,
+		},
+		{
+			name:      "cronjob recording window open",
+			recording: newCronJobRecording(time.Now().Add(time.Hour)),
+			objs: []client.Object{
+				newCronJobJob("job", jobUID, true), newPod(corev1.PodSucceeded),
+				newProfile("init"), newProfile("main"),
+			},
+			expectedReason: profilerecording1alpha1.ReasonWindowOpen,
+			expectedStatus: metav1.ConditionFalse,
+			expectRequeue:  true,
+		},
+		{
+			name:      "cronjob recording window closed with running job",
+			recording: newCronJobRecording(time.Now().Add(-time.Minute)),
+			objs: []client.Object{
+				newCronJobJob("job", jobUID, true), newCronJobJob("job-2", "job-2-uid", false),
+				newPod(corev1.PodSucceeded), newProfile("init"), newProfile("main"),
+			},
+			expectedReason: profilerecording1alpha1.ReasonJobRunning,
+			expectedStatus: metav1.ConditionFalse,
+			expectRequeue:  true,
+		},
+		{
+			name:      "cronjob completed",
+			recording: newCronJobRecording(time.Now().Add(-time.Minute)),
+			objs: []client.Object{
+				newCronJobJob("job", jobUID, true), newPod(corev1.PodSucceeded),
+				newProfile("init"), newProfile("main"),
+			},
+			expectedReason: profilerecording1alpha1.ReasonJobFinished,
+			expectedStatus: metav1.ConditionTrue,
+			expectMerged:   true,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			scheme := runtime.NewScheme()
+			require.NoError(t, clientgoscheme.AddToScheme(scheme))
+			require.NoError(t, profilerecording1alpha1.AddToScheme(scheme))
+			require.NoError(t, seccompprofile.AddToScheme(scheme))
+
+			recording := newRecording()
+			if tc.recording != nil {
+				tc.recording(recording)
+			}
+			cli := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(append(tc.objs, recording)...).
+				WithStatusSubresource(recording).
+				Build()
+
+			sut := &PolicyMergeReconciler{
+				client: cli,
+				log:    logr.Discard(),
+				record: record.NewFakeRecorder(10),
+			}
+
+			res, err := sut.Reconcile(context.Background(), reconcile.Request{
+				NamespacedName: client.ObjectKeyFromObject(recording),
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.expectRequeue, res.RequeueAfter > 0)
+
+			require.NoError(t, cli.Get(context.Background(), client.ObjectKeyFromObject(recording), recording))
+			cond := meta.FindStatusCondition(recording.Status.Conditions, profilerecording1alpha1.TypeCompleted)
+			require.NotNil(t, cond)
+			require.Equal(t, tc.expectedStatus, cond.Status)
+			require.Equal(t, tc.expectedReason, cond.Reason)
+
+			merged := &seccompprofile.SeccompProfile{}
+			err = cli.Get(context.Background(), client.ObjectKey{
+				Namespace: namespace, Name: recName + "-main",
+			}, merged)
+			if tc.expectMerged {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestExpectedProfiles(t *testing.T) {
+	t.Parallel()
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				config.SeccompProfileRecordLogsAnnotationKey + "a": "rec_a_abcde_1",
+				config.SelinuxProfileRecordLogsAnnotationKey + "b": "rec_b_abcde_1",
+				config.SeccompProfileRecordBpfAnnotationKey + "c":  "other_c_abcde_1",
+				"unrelated": "rec_d",
+			},
+		},
+	}
+	require.Equal(t, 2, expectedProfiles(pod, "rec"))
+}
+
+func TestTrackJobs(t *testing.T) {
+	t.Parallel()
+
+	job := func(name string, uid types.UID, finished bool) batchv1.Job {
+		res := batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: name, UID: uid}}
+		if finished {
+			res.Status.Conditions = []batchv1.JobCondition{{
+				Type:   batchv1.JobFailed,
+				Status: corev1.ConditionTrue,
+			}}
+		}
+		return res
+	}
+
+	for _, tc := range []struct {
+		name    string
+		tracked []profilerecording1alpha1.RecordedJob
+		jobs    []batchv1.Job
+		want    []profilerecording1alpha1.RecordedJob
+	}{
+		{
+			name: "new jobs",
+			jobs: []batchv1.Job{job("b", "b-uid", false), job("a", "a-uid", true)},
+			want: []profilerecording1alpha1.RecordedJob{
+				{Name: "a", UID: "a-uid", Finished: true},
+				{Name: "b", UID: "b-uid"},
+			},
+		},
+		{
+			name: "finished job",
+			tracked: []profilerecording1alpha1.RecordedJob{
+				{Name: "a", UID: "a-uid"},
+			},
+			jobs: []batchv1.Job{job("a", "a-uid", true)},
+			want: []profilerecording1alpha1.RecordedJob{
+				{Name: "a", UID: "a-uid", Finished: true},
+			},
+		},
+		{
+			name: "deleted jobs",
+			tracked: []profilerecording1alpha1.RecordedJob{
+				{Name: "a", UID: "a-uid", Finished: true},
+				{Name: "a", UID: "old-a-uid"},
+			},
+			jobs: []batchv1.Job{job("a", "a-uid", true), job("b", "b-uid", false)},
+			want: []profilerecording1alpha1.RecordedJob{
+				{Name: "a", UID: "a-uid", Finished: true},
+				{Name: "a", UID: "old-a-uid", Finished: true},
+				{Name: "b", UID: "b-uid"},
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.want, trackJobs(tc.tracked, tc.jobs))
+		})
+	}
+}
@@ -19,10 +19,12 @@ package recordingmerger
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	profilebase "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
@@ -42,7 +44,13 @@ func mergedObjectMeta(profileName, recordingName, namespace string) *metav1.Obje
 	}
 }
 
-func mergedProfileName(recordingName string, prf metav1.Object) string {
+func mergedProfileName(
+	recordingName string, strategy profilerecording1alpha1.ProfileMergeStrategy, prf metav1.Object,
+) string {
+	if strategy == profilerecording1alpha1.ProfileMergePods {
+		return recordingName
+	}
+
 	suffix := prf.GetLabels()[profilerecording1alpha1.ProfileToContainerLabel]
 	if suffix == "" {
 		suffix = prf.GetName()
@@ -71,6 +79,57 @@ func mergeProfiles(profiles []mergeableProfile) (mergeableProfile, error) {
 	return base, nil
 }
 
+// intersectProfiles merges the profiles of all replicas, but keeps only the
+// entries which have been observed in at least minReplicas of them. All
+// replicas have to contain an entry if minReplicas is not set or exceeds the
+// number of replicas.
+func intersectProfiles(profiles []mergeableProfile, minReplicas int) (mergeableProfile, error) {
+	replicas := map[string][]mergeableProfile{}
+	for _, prf := range profiles {
+		id := replicaID(prf)
+		replicas[id] = append(replicas[id], prf)
+	}
+
+	counts := map[string]int{}
+	merged := make([]mergeableProfile, 0, len(replicas))
+	for _, replicaProfiles := range replicas {
+		replica, err := mergeProfiles(replicaProfiles)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range sets.List(sets.New(replica.entries()...)) {
+			counts[entry]++
+		}
+		merged = append(merged, replica)
+	}
+
+	if minReplicas <= 0 || minReplicas > len(replicas) {
+		minReplicas = len(replicas)
+	}
+	keep := sets.New[string]()
+	for entry, count := range counts {
+		if count >= minReplicas {
+			keep.Insert(entry)
+		}
+	}
+
+	res, err := mergeProfiles(merged)
+	if err != nil {
+		return nil, err
+	}
+	res.retain(keep)
+	return res, nil
+}
+
+// replicaID returns the identifier of the pod a partial profile has been
+// recorded from.
+func replicaID(prf client.Object) string {
+	if uid := prf.GetLabels()[profilerecording1alpha1.ProfileToPodUIDLabel]; uid != "" {
+		return uid
+	}
+	return prf.GetName()
+}
+
 type perContainerMergeableProfiles map[string][]mergeableProfile
 
 func listPartialProfiles(
@@ -107,6 +166,10 @@ func listPartialProfiles(
 			// todo: log
 			return nil
 		}
+		if recording.Spec.MergeStrategy == profilerecording1alpha1.ProfileMergePods {
+			// All containers get merged into the same profile
+			containerID = ""
+		}
 		partialProfiles[containerID] = append(partialProfiles[containerID], partialPrf)
 		return nil
 	}); err != nil {
@@ -156,6 +219,10 @@ type mergeableProfile interface {
 
 	merge(profile mergeableProfile) error
 	getProfile() client.Object
+	// entries returns the syscalls or permissions allowed by the profile.
+	entries() []string
+	// retain removes all entries which are not part of the provided set.
+	retain(sets.Set[string])
 }
 
 type mergeableSeccompProfile struct {
@@ -180,6 +247,40 @@ func (sp *mergeableSeccompProfile) getProfile() client.Object {
 	return &sp.SeccompProfile
 }
 
+func (sp *mergeableSeccompProfile) entries() []string {
+	res := []string{}
+	for _, s := range sp.Spec.Syscalls {
+		for _, name := range s.Names {
+			res = append(res, seccompEntry(s, name))
+		}
+	}
+	return res
+}
+
+func (sp *mergeableSeccompProfile) retain(keep sets.Set[string]) {
+	syscalls := []*seccompprofile.Syscall{}
+	seen := sets.New[string]()
+	for _, s := range sp.Spec.Syscalls {
+		names := []string{}
+		for _, name := range s.Names {
+			entry := seccompEntry(s, name)
+			if keep.Has(entry) && !seen.Has(entry) {
+				seen.Insert(entry)
+				names = append(names, name)
+			}
+		}
+		if len(names) > 0 {
+			s.Names = names
+			syscalls = append(syscalls, s)
+		}
+	}
+	sp.Spec.Syscalls = syscalls
+}
+
+func seccompEntry(s *seccompprofile.Syscall, name string) string {
+	return fmt.Sprintf("%s %s", s.Action, name)
+}
+
 type MergeableSelinuxProfile struct {
 	selinuxprofileapi.SelinuxProfile
 }
@@ -188,6 +289,40 @@ func (sp *MergeableSelinuxProfile) getProfile() client.Object {
 	return &sp.SelinuxProfile
 }
 
+func (sp *MergeableSelinuxProfile) entries() []string {
+	res := []string{}
+	for label, permMap := range sp.Spec.Allow {
+		for objClass, perms := range permMap {
+			for _, perm := range perms {
+				res = append(res, strings.Join([]string{string(label), string(objClass), perm}, " "))
+			}
+		}
+	}
+	return res
+}
+
+func (sp *MergeableSelinuxProfile) retain(keep sets.Set[string]) {
+	allow := selinuxprofileapi.Allow{}
+	for label, permMap := range sp.Spec.Allow {
+		for objClass, perms := range permMap {
+			kept := selinuxprofileapi.PermissionSet{}
+			for _, perm := range perms {
+				if keep.Has(strings.Join([]string{string(label), string(objClass), perm}, " ")) {
+					kept = append(kept, perm)
+				}
+			}
+			if len(kept) == 0 {
+				continue
+			}
+			if _, ok := allow[label]; !ok {
+				allow[label] = make(map[selinuxprofileapi.ObjectClassKey]selinuxprofileapi.PermissionSet)
+			}
+			allow[label][objClass] = kept
+		}
+	}
+	sp.Spec.Allow = allow
+}
+
 func (sp *MergeableSelinuxProfile) merge(other mergeableProfile) error {
 	// TODO(jhrozek): should we be defensive about checking if other attributes match as well? (e.g. inherit)
 	otherSP, ok := other.(*MergeableSelinuxProfile)
@@ -23,7 +23,9 @@ import (
 	"github.com/containers/common/pkg/seccomp"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 
+	profilerecording1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
 	seccompprofile "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
 	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
 )
@@ -194,3 +196,67 @@ func TestMergeProfiles(t *testing.T) {
 		})
 	}
 }
+
+func TestIntersectProfiles(t *testing.T) {
+	t.Parallel()
+
+	partial := func(name, podUID string, syscalls ...string) mergeableProfile {
+		return &mergeableSeccompProfile{SeccompProfile: seccompprofile.SeccompProfile{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{profilerecording1alpha1.ProfileToPodUIDLabel: podUID},
+			},
+			Spec: seccompprofile.SeccompProfileSpec{
+				Syscalls: []*seccompprofile.Syscall{{Names: syscalls, Action: seccomp.ActAllow}},
+			},
+		}}
+	}
+	partials := func() []mergeableProfile {
+		return []mergeableProfile{
+			partial("a-1", "a", "read", "write"),
+			// a second partial profile of the same replica is counted once
+			partial("a-2", "a", "read", "mknod"),
+			partial("b", "b", "read", "write"),
+			partial("c", "c", "read", "close"),
+		}
+	}
+
+	for _, tc := range []struct {
+		minReplicas int
+		want        []string
+	}{
+		{minReplicas: 0, want: []string{"read"}},
+		{minReplicas: 2, want: []string{"read", "write"}},
+		{minReplicas: 1, want: []string{"close", "mknod", "read", "write"}},
+		{minReplicas: 5, want: []string{"read"}},
+	} {
+		merged, err := intersectProfiles(partials(), tc.minReplicas)
+		require.NoError(t, err)
+		got := []string{}
+		for _, s := range ifaceAsSortedSeccompProfile(merged).Spec.Syscalls {
+			got = append(got, s.Names...)
+		}
+		sort.Strings(got)
+		require.Equal(t, tc.want, got, tc.minReplicas)
+	}
+}
+
+func TestSelinuxRetain(t *testing.T) {
+	t.Parallel()
+
+	sp := &MergeableSelinuxProfile{SelinuxProfile: selinuxprofileapi.SelinuxProfile{
+		Spec: selinuxprofileapi.SelinuxProfileSpec{
+			Allow: selinuxprofileapi.Allow{
+				"@self": {"tcp_socket": {"listen", "bind"}},
+				"var_t": {"file": {"read"}},
+			},
+		},
+	}}
+	require.ElementsMatch(t,
+		[]string{"@self tcp_socket listen", "@self tcp_socket bind", "var_t file read"},
+		sp.entries(),
+	)
+
+	sp.retain(sets.New("@self tcp_socket bind"))
+	require.Equal(t, selinuxprofileapi.Allow{"@self": {"tcp_socket": {"bind"}}}, sp.Spec.Allow)
+}
@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -82,8 +83,13 @@ func (r *PolicyMergeReconciler) Healthz(*http.Request) error {
 //nolint:lll // required for kubebuilder
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profilerecordings,verbs=get;list;watch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profilerecordings/finalizers,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profilerecordings/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles,verbs=get;list;watch;create;update;patch;delete;deletecollection
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=selinuxprofiles,verbs=get;list;watch;create;update;patch;delete;deletecollection
+// +kubebuilder:rbac:groups=batch,resources=jobs;cronjobs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=securityprofilesoperatordaemons,verbs=get;list;watch
 
 // Reconcile reconciles a NodeStatus.
 func (r *PolicyMergeReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
@@ -104,12 +110,35 @@ func (r *PolicyMergeReconciler) Reconcile(ctx context.Context, req reconcile.Req
 	if !profileRecording.GetDeletionTimestamp().IsZero() { // object is being deleted
 		logger.Info("Is being deleted, will check if there are policies to be merged")
 
+		if meta.IsStatusConditionTrue(
+			profileRecording.Status.Conditions, profilerecording1alpha1.TypeCompleted,
+		) {
+			// The profiles got already merged when the recording completed
+			return reconcile.Result{}, nil
+		}
+
 		if err := r.mergeProfiles(ctx, profileRecording); err != nil {
 			return reconcile.Result{}, fmt.Errorf("%s: %w", errMergingRec, err)
 		}
 		return reconcile.Result{}, nil
 	}
 
+	if profileRecording.Spec.Job != nil {
+		requeueAfter, err := r.reconcileJob(ctx, profileRecording)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("reconciling job recording: %w", err)
+		}
+		return reconcile.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	if _, end := profileRecording.RecordingWindow(); !end.IsZero() {
+		requeueAfter, err := r.reconcileWindow(ctx, profileRecording)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("reconciling recording window: %w", err)
+		}
+		return reconcile.Result{RequeueAfter: requeueAfter}, nil
+	}
+
 	// We don't really care until the recording is being deleted
 	return reconcile.Result{}, nil
 }
@@ -158,7 +187,12 @@ func (r *PolicyMergeReconciler) mergeTypedProfiles(
 	for cntName, cntPartialProfiles := range partialProfiles {
 		r.log.Info("Merging profiles for container", "container", cntName)
 
-		mergedProfile, err := mergeProfiles(cntPartialProfiles)
+		var mergedProfile mergeableProfile
+		if profileRecording.Spec.MergeStrategy == profilerecording1alpha1.ProfileMergeIntersection {
+			mergedProfile, err = intersectProfiles(cntPartialProfiles, int(profileRecording.Spec.MinReplicas))
+		} else {
+			mergedProfile, err = mergeProfiles(cntPartialProfiles)
+		}
 		if err != nil {
 			return fmt.Errorf("cannot merge partial profiles: %w", err)
 		}
@@ -169,7 +203,9 @@ func (r *PolicyMergeReconciler) mergeTypedProfiles(
 			return nil
 		}
 
-		mergedRecordingName := mergedProfileName(profileRecording.Name, cntPartialProfiles[0])
+		mergedRecordingName := mergedProfileName(
+			profileRecording.Name, profileRecording.Spec.MergeStrategy, cntPartialProfiles[0],
+		)
 		res, err := createUpdateMergedProfile(ctx, r.client, profileRecording, mergedRecordingName, mergedProfile)
 		if err != nil {
 			r.record.Event(profileRecording, util.EventTypeWarning, reasonCannotCreateUpdate, err.Error())
@@ -220,6 +256,14 @@ func createUpdateSeccompProfile(
 	mergedSp.Spec = *mergedSpec
 	return controllerutil.CreateOrUpdate(ctx, cl, mergedSp,
 		func() error {
+			if profileRecording.Spec.MergeWithExisting && mergedSp.GetResourceVersion() != "" {
+				existing := &mergeableSeccompProfile{SeccompProfile: *mergedSp}
+				if err := existing.merge(mergedProfiles); err != nil {
+					return fmt.Errorf("merging with existing profile: %w", err)
+				}
+				mergedSp.Spec.Syscalls = existing.Spec.Syscalls
+				return nil
+			}
 			mergedSp.Spec = *mergedSpec
 			return nil
 		},
@@ -258,6 +302,17 @@ func createUpdateSelinuxProfile(
 	mergedSp.Spec = *mergedSpec
 	return controllerutil.CreateOrUpdate(ctx, cl, mergedSp,
 		func() error {
+			if profileRecording.Spec.MergeWithExisting && mergedSp.GetResourceVersion() != "" {
+				existing := &MergeableSelinuxProfile{SelinuxProfile: *mergedSp}
+				if existing.Spec.Allow == nil {
+					existing.Spec.Allow = selinuxprofileapi.Allow{}
+				}
+				if err := existing.merge(mergedProfiles); err != nil {
+					return fmt.Errorf("merging with existing profile: %w", err)
+				}
+				mergedSp.Spec.Allow = existing.Spec.Allow
+				return nil
+			}
 			mergedSp.Spec = *mergedSpec
 			return nil
 		},
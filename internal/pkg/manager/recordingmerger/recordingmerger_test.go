@@ -0,0 +1,170 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recordingmerger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/containers/common/pkg/seccomp"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	profilebase "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	profilerecording1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
+	seccompprofile "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+)
+
+func TestMergeStrategies(t *testing.T) {
+	t.Parallel()
+
+	const (
+		namespace = "default"
+		recName   = "rec"
+	)
+
+	partial := func(name, container, podUID string, syscalls ...string) client.Object {
+		return &seccompprofile.SeccompProfile{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				Labels: map[string]string{
+					profilerecording1alpha1.ProfileToRecordingLabel: recName,
+					profilerecording1alpha1.ProfileToContainerLabel: container,
+					profilerecording1alpha1.ProfileToPodUIDLabel:    podUID,
+					profilebase.ProfilePartialLabel:                 "true",
+				},
+			},
+			Spec: seccompprofile.SeccompProfileSpec{
+				DefaultAction: seccomp.ActErrno,
+				Syscalls:      []*seccompprofile.Syscall{{Names: syscalls, Action: seccomp.ActAllow}},
+			},
+		}
+	}
+	partials := []client.Object{
+		partial("rec-app-1", "app", "pod1", "read", "mknod"),
+		partial("rec-app-2", "app", "pod2", "read", "write"),
+		partial("rec-sidecar-1", "sidecar", "pod1", "close"),
+	}
+
+	for _, tc := range []struct {
+		name     string
+		strategy profilerecording1alpha1.ProfileMergeStrategy
+		existing []client.Object
+		merge    bool
+		want     map[string][]string
+	}{
+		{
+			name:     "containers",
+			strategy: profilerecording1alpha1.ProfileMergeContainers,
+			want: map[string][]string{
+				"rec-app":     {"mknod", "read", "write"},
+				"rec-sidecar": {"close"},
+			},
+		},
+		{
+			name:     "pods",
+			strategy: profilerecording1alpha1.ProfileMergePods,
+			want: map[string][]string{
+				"rec": {"close", "mknod", "read", "write"},
+			},
+		},
+		{
+			name:     "intersection",
+			strategy: profilerecording1alpha1.ProfileMergeIntersection,
+			want: map[string][]string{
+				"rec-app":     {"read"},
+				"rec-sidecar": {"close"},
+			},
+		},
+		{
+			name:     "replace existing",
+			strategy: profilerecording1alpha1.ProfileMergePods,
+			existing: []client.Object{&seccompprofile.SeccompProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: recName, Namespace: namespace},
+				Spec: seccompprofile.SeccompProfileSpec{
+					Syscalls: []*seccompprofile.Syscall{{Names: []string{"open"}, Action: seccomp.ActAllow}},
+				},
+			}},
+			want: map[string][]string{
+				"rec": {"close", "mknod", "read", "write"},
+			},
+		},
+		{
+			name:     "merge with existing",
+			strategy: profilerecording1alpha1.ProfileMergePods,
+			merge:    true,
+			existing: []client.Object{&seccompprofile.SeccompProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: recName, Namespace: namespace},
+				Spec: seccompprofile.SeccompProfileSpec{
+					Syscalls: []*seccompprofile.Syscall{{Names: []string{"open", "read"}, Action: seccomp.ActAllow}},
+				},
+			}},
+			want: map[string][]string{
+				"rec": {"close", "mknod", "open", "read", "write"},
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			scheme := runtime.NewScheme()
+			require.NoError(t, clientgoscheme.AddToScheme(scheme))
+			require.NoError(t, seccompprofile.AddToScheme(scheme))
+			require.NoError(t, profilerecording1alpha1.AddToScheme(scheme))
+
+			objs := []client.Object{}
+			for _, obj := range append(partials, tc.existing...) {
+				objs = append(objs, obj.DeepCopyObject().(client.Object))
+			}
+			cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+			sut := &PolicyMergeReconciler{client: cli, log: logr.Discard(), record: record.NewFakeRecorder(10)}
+
+			recording := &profilerecording1alpha1.ProfileRecording{
+				ObjectMeta: metav1.ObjectMeta{Name: recName, Namespace: namespace},
+				Spec: profilerecording1alpha1.ProfileRecordingSpec{
+					Kind:              profilerecording1alpha1.ProfileRecordingKindSeccompProfile,
+					MergeStrategy:     tc.strategy,
+					MergeWithExisting: tc.merge,
+				},
+			}
+			require.NoError(t, sut.mergeProfiles(context.Background(), recording))
+
+			list := &seccompprofile.SeccompProfileList{}
+			require.NoError(t, cli.List(context.Background(), list))
+			got := map[string][]string{}
+			for i := range list.Items {
+				require.False(t, profilebase.IsPartial(&list.Items[i]))
+				// the union of partial profiles does not deduplicate syscalls
+				names := sets.New[string]()
+				for _, s := range list.Items[i].Spec.Syscalls {
+					names.Insert(s.Names...)
+				}
+				got[list.Items[i].GetName()] = sets.List(names)
+			}
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
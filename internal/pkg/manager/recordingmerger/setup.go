@@ -22,12 +22,13 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	profilerecording1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/common"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
 )
 
 // Setup adds a controller that reconciles any profilerecordings.
 func (r *PolicyMergeReconciler) Setup(
-	_ context.Context,
+	ctx context.Context,
 	mgr ctrl.Manager,
 	_ *metrics.Metrics,
 ) error {
@@ -36,7 +37,9 @@ func (r *PolicyMergeReconciler) Setup(
 	r.record = mgr.GetEventRecorderFor(r.Name())
 
 	// Register a special reconciler for status events
-	return ctrl.NewControllerManagedBy(mgr).
+	b := common.WatchNamespaceScope(ctx, ctrl.NewControllerManagedBy(mgr), r.client)
+
+	return b.
 		Named(r.Name()).
 		For(&profilerecording1alpha1.ProfileRecording{}).
 		Complete(r)
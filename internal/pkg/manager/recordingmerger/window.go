@@ -0,0 +1,199 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recordingmerger
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	profilebasev1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	profilerecording1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
+	seccompprofile "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+)
+
+// windowRequeueInterval is the interval in which the collection of the
+// profiles of a recording with a closed time window gets checked again.
+const windowRequeueInterval = 30 * time.Second
+
+// reconcileWindow tracks the time window of a recording. Once the window
+// closed and the profiles of all recorded pods got collected, the profiles
+// get merged and the recording completes with a summary.
+func (r *PolicyMergeReconciler) reconcileWindow(
+	ctx context.Context,
+	profileRecording *profilerecording1alpha1.ProfileRecording,
+) (time.Duration, error) {
+	if meta.IsStatusConditionTrue(
+		profileRecording.Status.Conditions, profilerecording1alpha1.TypeCompleted,
+	) {
+		return 0, nil
+	}
+
+	start, end := profileRecording.RecordingWindow()
+	now := time.Now()
+
+	if now.Before(start) {
+		return start.Sub(now), r.setCompleted(
+			ctx, profileRecording, metav1.ConditionFalse, profilerecording1alpha1.ReasonWindowNotStarted,
+			fmt.Sprintf("Recording starts at %s", start.UTC().Format(time.RFC3339)),
+		)
+	}
+
+	if now.Before(end) {
+		return end.Sub(now), r.setCompleted(
+			ctx, profileRecording, metav1.ConditionFalse, profilerecording1alpha1.ReasonWindowOpen,
+			fmt.Sprintf("Recording until %s", end.UTC().Format(time.RFC3339)),
+		)
+	}
+
+	// Stop waiting for profiles which did not show up at all, for example
+	// because the node of the pod is gone.
+	if now.Before(end.Add(profileCollectionTimeout)) {
+		pending, err := r.pendingWindowPods(ctx, profileRecording)
+		if err != nil {
+			return 0, err
+		}
+
+		if pending > 0 {
+			return windowRequeueInterval, r.setCompleted(
+				ctx, profileRecording, metav1.ConditionFalse, profilerecording1alpha1.ReasonCollectingProfiles,
+				fmt.Sprintf("Waiting for the profiles of %d pod(s) to be collected", pending),
+			)
+		}
+	}
+
+	summary, err := r.recordingSummary(ctx, profileRecording)
+	if err != nil {
+		return 0, err
+	}
+
+	if profileRecording.Spec.MergeStrategy.IsMerging() {
+		if err := r.mergeProfiles(ctx, profileRecording); err != nil {
+			return 0, fmt.Errorf("%s: %w", errMergingRec, err)
+		}
+	}
+
+	profiles, err := r.recordedProfiles(ctx, profileRecording)
+	if err != nil {
+		return 0, err
+	}
+	for _, prf := range profiles {
+		summary.Profiles = append(summary.Profiles, prf.GetName())
+	}
+	sort.Strings(summary.Profiles)
+
+	return 0, r.setCompletedWithSummary(
+		ctx, profileRecording, metav1.ConditionTrue, profilerecording1alpha1.ReasonWindowClosed,
+		fmt.Sprintf("Recording window closed at %s and all profiles got collected",
+			end.UTC().Format(time.RFC3339)),
+		summary,
+	)
+}
+
+// pendingWindowPods returns the number of recorded pods whose profiles are
+// not yet collected.
+func (r *PolicyMergeReconciler) pendingWindowPods(
+	ctx context.Context,
+	profileRecording *profilerecording1alpha1.ProfileRecording,
+) (int, error) {
+	pods, err := r.selectedPods(ctx, profileRecording)
+	if err != nil {
+		return 0, err
+	}
+
+	pending := 0
+	for i := range pods {
+		collected, err := r.podCollected(ctx, profileRecording, &pods[i])
+		if err != nil {
+			return 0, err
+		}
+		if !collected {
+			pending++
+		}
+	}
+
+	return pending, nil
+}
+
+// recordedProfiles returns the profiles produced by the recording.
+func (r *PolicyMergeReconciler) recordedProfiles(
+	ctx context.Context,
+	profileRecording *profilerecording1alpha1.ProfileRecording,
+) ([]metav1.Object, error) {
+	list, err := profileList(profileRecording.Spec.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	profiles, err := profilebasev1alpha1.ListProfilesByRecording(
+		ctx, r.client, profileRecording.Name, profileRecording.Namespace, list,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing recorded profiles: %w", err)
+	}
+
+	return profiles, nil
+}
+
+// recordingSummary counts the recorded pods and the distinct syscalls or
+// permissions of the profiles produced by the recording. It has to be
+// called before merging, because the merged profiles lose the pod labels.
+func (r *PolicyMergeReconciler) recordingSummary(
+	ctx context.Context,
+	profileRecording *profilerecording1alpha1.ProfileRecording,
+) (*profilerecording1alpha1.RecordingSummary, error) {
+	profiles, err := r.recordedProfiles(ctx, profileRecording)
+	if err != nil {
+		return nil, err
+	}
+
+	pods := sets.New[string]()
+	syscalls := sets.New[string]()
+	permissions := sets.New[string]()
+	for _, prf := range profiles {
+		if uid := prf.GetLabels()[profilerecording1alpha1.ProfileToPodUIDLabel]; uid != "" {
+			pods.Insert(uid)
+		}
+
+		switch p := prf.(type) {
+		case *seccompprofile.SeccompProfile:
+			for _, syscall := range p.Spec.Syscalls {
+				syscalls.Insert(syscall.Names...)
+			}
+		case *selinuxprofileapi.SelinuxProfile:
+			for label, classes := range p.Spec.Allow {
+				for class, perms := range classes {
+					for _, perm := range perms {
+						permissions.Insert(fmt.Sprintf("%s:%s:%s", label, class, perm))
+					}
+				}
+			}
+		}
+	}
+
+	return &profilerecording1alpha1.RecordingSummary{
+		PodsObserved: int32(pods.Len()),
+		Syscalls:     int32(syscalls.Len()),
+		Permissions:  int32(permissions.Len()),
+	}, nil
+}
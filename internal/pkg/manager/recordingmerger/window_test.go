@@ -0,0 +1,221 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recordingmerger
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	profilebase "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	profilerecording1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilerecording/v1alpha1"
+	seccompprofile "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+)
+
+func TestReconcileWindowRecording(t *testing.T) {
+	t.Parallel()
+
+	const (
+		namespace = "default"
+		recName   = "rec"
+	)
+
+	newRecording := func(schedule, deadline time.Time) *profilerecording1alpha1.ProfileRecording {
+		return &profilerecording1alpha1.ProfileRecording{
+			ObjectMeta: metav1.ObjectMeta{Name: recName, Namespace: namespace},
+			Spec: profilerecording1alpha1.ProfileRecordingSpec{
+				Kind:          profilerecording1alpha1.ProfileRecordingKindSeccompProfile,
+				Recorder:      profilerecording1alpha1.ProfileRecorderBpf,
+				MergeStrategy: profilerecording1alpha1.ProfileMergeContainers,
+				PodSelector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "web"},
+				},
+				Schedule: &metav1.Time{Time: schedule},
+				Duration: &metav1.Duration{Duration: time.Hour},
+				Deadline: &metav1.Time{Time: deadline},
+			},
+		}
+	}
+
+	newPod := func(uid string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "web-" + uid,
+				Namespace: namespace,
+				UID:       types.UID("uid-" + uid),
+				Labels:    map[string]string{"app": "web"},
+				Annotations: map[string]string{
+					config.SeccompProfileRecordBpfAnnotationKey + "main": recName + "_main_abcde_1",
+				},
+			},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+	}
+
+	newProfile := func(uid string, syscalls ...string) *seccompprofile.SeccompProfile {
+		return &seccompprofile.SeccompProfile{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      recName + "-main-" + uid,
+				Namespace: namespace,
+				Labels: map[string]string{
+					profilerecording1alpha1.ProfileToRecordingLabel: recName,
+					profilerecording1alpha1.ProfileToContainerLabel: "main",
+					profilerecording1alpha1.ProfileToPodUIDLabel:    "uid-" + uid,
+					profilebase.ProfilePartialLabel:                 "true",
+				},
+			},
+			Spec: seccompprofile.SeccompProfileSpec{
+				Syscalls: []*seccompprofile.Syscall{{
+					Names:  syscalls,
+					Action: "SCMP_ACT_ALLOW",
+				}},
+			},
+		}
+	}
+
+	now := time.Now()
+	for _, tc := range []struct {
+		name            string
+		recording       *profilerecording1alpha1.ProfileRecording
+		objs            []client.Object
+		expectedReason  string
+		expectedStatus  metav1.ConditionStatus
+		expectRequeue   bool
+		maxRequeue      time.Duration
+		expectedSummary *profilerecording1alpha1.RecordingSummary
+	}{
+		{
+			name:           "not started",
+			recording:      newRecording(now.Add(time.Hour), now.Add(24*time.Hour)),
+			expectedReason: profilerecording1alpha1.ReasonWindowNotStarted,
+			expectedStatus: metav1.ConditionFalse,
+			expectRequeue:  true,
+			maxRequeue:     time.Hour,
+		},
+		{
+			name:           "window open",
+			recording:      newRecording(now.Add(-time.Minute), now.Add(24*time.Hour)),
+			objs:           []client.Object{newPod("a")},
+			expectedReason: profilerecording1alpha1.ReasonWindowOpen,
+			expectedStatus: metav1.ConditionFalse,
+			expectRequeue:  true,
+		},
+		{
+			name:           "profiles not yet collected",
+			recording:      newRecording(now.Add(-time.Hour), now.Add(-time.Minute)),
+			objs:           []client.Object{newPod("a"), newPod("b"), newProfile("a", "read")},
+			expectedReason: profilerecording1alpha1.ReasonCollectingProfiles,
+			expectedStatus: metav1.ConditionFalse,
+			expectRequeue:  true,
+		},
+		{
+			name:      "completed",
+			recording: newRecording(now.Add(-2*time.Hour), now.Add(24*time.Hour)),
+			objs: []client.Object{
+				newPod("a"), newPod("b"),
+				newProfile("a", "read", "write"), newProfile("b", "read", "close"),
+			},
+			expectedReason: profilerecording1alpha1.ReasonWindowClosed,
+			expectedStatus: metav1.ConditionTrue,
+			expectedSummary: &profilerecording1alpha1.RecordingSummary{
+				Profiles:     []string{recName + "-main"},
+				PodsObserved: 2,
+				Syscalls:     3,
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			scheme := runtime.NewScheme()
+			require.NoError(t, clientgoscheme.AddToScheme(scheme))
+			require.NoError(t, profilerecording1alpha1.AddToScheme(scheme))
+			require.NoError(t, seccompprofile.AddToScheme(scheme))
+
+			recording := tc.recording
+			cli := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(append(tc.objs, recording)...).
+				WithStatusSubresource(recording).
+				Build()
+
+			sut := &PolicyMergeReconciler{
+				client: cli,
+				log:    logr.Discard(),
+				record: record.NewFakeRecorder(10),
+			}
+
+			res, err := sut.Reconcile(context.Background(), reconcile.Request{
+				NamespacedName: client.ObjectKeyFromObject(recording),
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.expectRequeue, res.RequeueAfter > 0)
+			if tc.maxRequeue > 0 {
+				require.LessOrEqual(t, res.RequeueAfter, tc.maxRequeue)
+			}
+
+			require.NoError(t, cli.Get(context.Background(), client.ObjectKeyFromObject(recording), recording))
+			cond := meta.FindStatusCondition(recording.Status.Conditions, profilerecording1alpha1.TypeCompleted)
+			require.NotNil(t, cond)
+			require.Equal(t, tc.expectedStatus, cond.Status)
+			require.Equal(t, tc.expectedReason, cond.Reason)
+			require.Equal(t, tc.expectedSummary, recording.Status.Summary)
+		})
+	}
+}
+
+func TestRecordingWindow(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	recording := &profilerecording1alpha1.ProfileRecording{
+		ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.Time{Time: created}},
+	}
+
+	start, end := recording.RecordingWindow()
+	require.Equal(t, created, start)
+	require.True(t, end.IsZero())
+	require.True(t, recording.IsActiveAt(created.Add(24*time.Hour)))
+
+	recording.Spec.Duration = &metav1.Duration{Duration: time.Hour}
+	_, end = recording.RecordingWindow()
+	require.Equal(t, created.Add(time.Hour), end)
+
+	recording.Spec.Deadline = &metav1.Time{Time: created.Add(30 * time.Minute)}
+	_, end = recording.RecordingWindow()
+	require.Equal(t, created.Add(30*time.Minute), end)
+
+	recording.Spec.Schedule = &metav1.Time{Time: created.Add(10 * time.Minute)}
+	require.False(t, recording.IsActiveAt(created.Add(5*time.Minute)))
+	require.True(t, recording.IsActiveAt(created.Add(15*time.Minute)))
+	require.False(t, recording.IsActiveAt(created.Add(30*time.Minute)))
+}
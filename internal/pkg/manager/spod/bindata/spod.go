@@ -57,6 +57,7 @@ const (
 	SelinuxdDBPath                             = SelinuxdPrivateDir + "/selinuxd.db"
 	MetricsImage                               = "gcr.io/kubebuilder/kube-rbac-proxy:v0.14.1"
 	sysKernelDebugPath                         = "/sys/kernel/debug"
+	sysFsCgroupPath                            = "/sys/fs/cgroup"
 	InitContainerIDNonRootenabler              = 0
 	InitContainerIDSelinuxSharedPoliciesCopier = 1
 	ContainerIDDaemon                          = 0
@@ -550,6 +551,11 @@ semodule -i /opt/spo-profiles/selinuxrecording.cil
 								MountPath: sysKernelDebugPath,
 								ReadOnly:  true,
 							},
+							{
+								Name:      "host-sys-fs-cgroup-volume",
+								MountPath: sysFsCgroupPath,
+								ReadOnly:  true,
+							},
 							{
 								Name:      "host-etc-osrelease-volume",
 								MountPath: etcOSReleasePath,
@@ -754,6 +760,15 @@ semodule -i /opt/spo-profiles/selinuxrecording.cil
 							},
 						},
 					},
+					{
+						Name: "host-sys-fs-cgroup-volume",
+						VolumeSource: corev1.VolumeSource{
+							HostPath: &corev1.HostPathVolumeSource{
+								Path: sysFsCgroupPath,
+								Type: &hostPathDirectory,
+							},
+						},
+					},
 					{
 						Name: "host-etc-osrelease-volume",
 						VolumeSource: corev1.VolumeSource{
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sort"
 
 	"github.com/go-logr/logr"
 	admissionregv1 "k8s.io/api/admissionregistration/v1"
@@ -85,6 +86,10 @@ const (
 	containerPort      = 9443
 	serviceName        = "webhook-service"
 	webhookServerCert  = "webhook-server-cert"
+
+	// operatorNamespaceWebhookPrefix is the name prefix of the webhooks
+	// matching the operator namespace if the SPOD restricts the namespaces.
+	operatorNamespaceWebhookPrefix = "operator-namespace."
 )
 
 type Webhook struct {
@@ -98,6 +103,7 @@ func GetWebhook(
 	log logr.Logger,
 	namespace string,
 	webhookOpts []spodv1alpha1.WebhookOptions,
+	namespaceSelector *metav1.LabelSelector,
 	image string,
 	pullPolicy corev1.PullPolicy,
 	caInjectType CAInjectType,
@@ -144,6 +150,7 @@ func GetWebhook(
 
 	// then apply the user-specified opts
 	applyWebhookOptions(cfg, webhookOpts)
+	applyNamespaceSelector(cfg, namespaceSelector, namespace)
 
 	return &Webhook{
 		log:        log,
@@ -199,6 +206,72 @@ func applyWebhookOptions(cfg *admissionregv1.MutatingWebhookConfiguration, opts
 	}
 }
 
+// applyNamespaceSelector restricts all webhooks additionally to the namespaces
+// selected by the SPOD instance. The operator namespace is always in scope,
+// which cannot be expressed by a single selector, so every webhook gets a
+// companion webhook matching only the operator namespace.
+func applyNamespaceSelector(
+	cfg *admissionregv1.MutatingWebhookConfiguration, sel *metav1.LabelSelector, operatorNamespace string,
+) {
+	if sel == nil {
+		return
+	}
+
+	// Match labels are converted to expressions to keep the selectors ANDed
+	// even if both define the same label key.
+	keys := make([]string, 0, len(sel.MatchLabels))
+	for key := range sel.MatchLabels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	requirements := make([]metav1.LabelSelectorRequirement, 0, len(keys)+len(sel.MatchExpressions)+1)
+	for _, key := range keys {
+		requirements = append(requirements, metav1.LabelSelectorRequirement{
+			Key:      key,
+			Operator: metav1.LabelSelectorOpIn,
+			Values:   []string{sel.MatchLabels[key]},
+		})
+	}
+	requirements = append(requirements, sel.MatchExpressions...)
+	requirements = append(requirements, metav1.LabelSelectorRequirement{
+		Key:      corev1.LabelMetadataName,
+		Operator: metav1.LabelSelectorOpNotIn,
+		Values:   []string{operatorNamespace},
+	})
+
+	hooks := make([]admissionregv1.MutatingWebhook, 0, 2*len(cfg.Webhooks))
+	for i := range cfg.Webhooks {
+		hook := cfg.Webhooks[i].DeepCopy()
+		operatorHook := cfg.Webhooks[i].DeepCopy()
+
+		hook.NamespaceSelector = withRequirements(hook.NamespaceSelector, requirements...)
+		operatorHook.Name = operatorNamespaceWebhookPrefix + operatorHook.Name
+		operatorHook.NamespaceSelector = withRequirements(operatorHook.NamespaceSelector,
+			metav1.LabelSelectorRequirement{
+				Key:      corev1.LabelMetadataName,
+				Operator: metav1.LabelSelectorOpIn,
+				Values:   []string{operatorNamespace},
+			})
+
+		hooks = append(hooks, *hook, *operatorHook)
+	}
+	cfg.Webhooks = hooks
+}
+
+func withRequirements(
+	sel *metav1.LabelSelector, requirements ...metav1.LabelSelectorRequirement,
+) *metav1.LabelSelector {
+	selector := &metav1.LabelSelector{}
+	if sel != nil {
+		selector = sel.DeepCopy()
+	}
+	for _, req := range requirements {
+		selector.MatchExpressions = append(selector.MatchExpressions, *req.DeepCopy())
+	}
+	return selector
+}
+
 func (w *Webhook) NeedsUpdate(ctx context.Context, c client.Client) (bool, error) {
 	existingWebHook := admissionregv1.MutatingWebhookConfiguration{}
 
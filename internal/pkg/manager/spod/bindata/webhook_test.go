@@ -0,0 +1,118 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bindata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func TestApplyNamespaceSelector(t *testing.T) {
+	t.Parallel()
+
+	const operatorNamespace = "security-profiles-operator"
+
+	cfg := webhookConfig.DeepCopy()
+	applyNamespaceSelector(cfg, nil, operatorNamespace)
+	require.Equal(t, webhookConfig, cfg)
+
+	applyNamespaceSelector(cfg, &metav1.LabelSelector{
+		MatchLabels: map[string]string{"tenant": "a"},
+		MatchExpressions: []metav1.LabelSelectorRequirement{{
+			Key:      "env",
+			Operator: metav1.LabelSelectorOpNotIn,
+			Values:   []string{"dev"},
+		}},
+	}, operatorNamespace)
+	require.Len(t, cfg.Webhooks, 2*len(webhookConfig.Webhooks))
+
+	for _, tc := range []struct {
+		name   string
+		labels map[string]string
+		want   bool
+	}{
+		{
+			name:   "matching namespace",
+			labels: map[string]string{EnableBindingLabel: "", EnableRecordingLabel: "", "tenant": "a"},
+			want:   true,
+		},
+		{
+			name:   "not matching namespace",
+			labels: map[string]string{EnableBindingLabel: "", EnableRecordingLabel: "", "tenant": "b"},
+			want:   false,
+		},
+		{
+			name:   "not enabled namespace",
+			labels: map[string]string{"tenant": "a"},
+			want:   false,
+		},
+		{
+			name:   "excluded namespace",
+			labels: map[string]string{EnableBindingLabel: "", EnableRecordingLabel: "", "tenant": "a", "env": "dev"},
+			want:   false,
+		},
+		{
+			name: "operator namespace",
+			labels: map[string]string{
+				EnableBindingLabel: "", EnableRecordingLabel: "", corev1.LabelMetadataName: operatorNamespace,
+			},
+			want: true,
+		},
+		{
+			name: "operator namespace matching the selector",
+			labels: map[string]string{
+				EnableBindingLabel: "", EnableRecordingLabel: "", "tenant": "a",
+				corev1.LabelMetadataName: operatorNamespace,
+			},
+			want: true,
+		},
+		{
+			name:   "operator namespace not enabled",
+			labels: map[string]string{corev1.LabelMetadataName: operatorNamespace},
+			want:   false,
+		},
+	} {
+		// every webhook is called at most once per namespace
+		for _, original := range webhookConfig.Webhooks {
+			matches := 0
+			for i := range cfg.Webhooks {
+				hook := &cfg.Webhooks[i]
+				if hook.Name != original.Name && hook.Name != operatorNamespaceWebhookPrefix+original.Name {
+					continue
+				}
+				selector, err := metav1.LabelSelectorAsSelector(hook.NamespaceSelector)
+				require.NoError(t, err)
+				if selector.Matches(labels.Set(tc.labels)) {
+					matches++
+				}
+			}
+			want := 0
+			if tc.want {
+				want = 1
+			}
+			require.Equal(t, want, matches, tc.name)
+		}
+	}
+
+	// the default configuration is not modified
+	require.Len(t, webhookConfig.Webhooks, 2)
+	require.Len(t, webhookConfig.Webhooks[0].NamespaceSelector.MatchExpressions, 1)
+}
@@ -22,6 +22,7 @@ import (
 	"net/http"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -178,8 +179,8 @@ func (r *ReconcileSPOd) Reconcile(ctx context.Context, req reconcile.Request) (r
 	}
 	configuredSPOd := r.getConfiguredSPOd(spod, image, pullPolicy, caInjectType)
 
-	webhook := bindata.GetWebhook(r.log, r.namespace, spod.Spec.WebhookOpts, image,
-		pullPolicy, caInjectType, spod.Spec.Tolerations, spod.Spec.ImagePullSecrets)
+	webhook := bindata.GetWebhook(r.log, r.namespace, spod.Spec.WebhookOpts, spod.Spec.NamespaceSelector,
+		image, pullPolicy, caInjectType, spod.Spec.Tolerations, spod.Spec.ImagePullSecrets)
 	metricsService := bindata.GetMetricsService(r.namespace, caInjectType)
 	serviceMonitor := bindata.ServiceMonitor(caInjectType)
 
@@ -514,13 +515,22 @@ func (r *ReconcileSPOd) getConfiguredSPOd(
 		templateSpec.InitContainers = append(
 			templateSpec.InitContainers,
 			r.baseSPOd.Spec.Template.Spec.InitContainers[bindata.InitContainerIDSelinuxSharedPoliciesCopier])
-		templateSpec.Containers = append(
-			templateSpec.Containers,
-			r.baseSPOd.Spec.Template.Spec.Containers[bindata.ContainerIDSelinuxd])
 
 		templateSpec.Containers[bindata.ContainerIDDaemon].Args = append(
 			templateSpec.Containers[bindata.ContainerIDDaemon].Args,
 			"--with-selinux=true")
+
+		if cfg.Spec.SelinuxOpts.Backend == spodv1alpha1.SelinuxBackendSemodule {
+			configureSemoduleBackend(
+				&templateSpec.Containers[bindata.ContainerIDDaemon],
+				&r.baseSPOd.Spec.Template.Spec.Containers[bindata.ContainerIDSelinuxd],
+			)
+		}
+		// selinuxd keeps running with the semodule backend as well, since
+		// daemons whose image does not ship semodule fall back to it
+		templateSpec.Containers = append(
+			templateSpec.Containers,
+			r.baseSPOd.Spec.Template.Spec.Containers[bindata.ContainerIDSelinuxd])
 	}
 
 	// Custom host proc volume
@@ -567,6 +577,8 @@ func (r *ReconcileSPOd) getConfiguredSPOd(
 			ctr.VolumeMounts = append(ctr.VolumeMounts, mount)
 		}
 
+		ctr.Env = append(ctr.Env, bpfRecorderMapSizeEnv(cfg.Spec.BpfRecorderOpts)...)
+
 		templateSpec.Containers = append(templateSpec.Containers, ctr)
 		// pass the bpf recorder env var to the daemon as the profile recorder is otherwise disabled
 		addEnvVar(templateSpec, config.EnableBpfRecorderEnvKey)
@@ -600,6 +612,12 @@ func (r *ReconcileSPOd) getConfiguredSPOd(
 		templateSpec.HostPID = true
 	}
 
+	if cfg.Spec.EnableSeccompNotifier {
+		templateSpec.Containers[bindata.ContainerIDDaemon].Args = append(
+			templateSpec.Containers[bindata.ContainerIDDaemon].Args,
+			"--with-seccomp-notifier=true")
+	}
+
 	// Enable memory optimization for spod controller
 	if cfg.Spec.EnableMemoryOptimization {
 		templateSpec.Containers[bindata.ContainerIDDaemon].Args = append(
@@ -681,6 +699,29 @@ func isBpfRecorderEnabled(cfg *spodv1alpha1.SecurityProfilesOperatorDaemon) bool
 	return cfg.Spec.EnableBpfRecorder || enableBpfRecorderEnv
 }
 
+// bpfRecorderMapSizeEnv returns the environment variables configuring the
+// BPF map sizes of the bpf recorder.
+func bpfRecorderMapSizeEnv(opts *spodv1alpha1.BpfRecorderOptions) []corev1.EnvVar {
+	env := []corev1.EnvVar{}
+	if opts == nil {
+		return env
+	}
+
+	if opts.MaxMountNamespaces != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  config.BpfRecorderMaxMountNamespacesEnvKey,
+			Value: fmt.Sprint(*opts.MaxMountNamespaces),
+		})
+	}
+	if opts.MaxProcesses != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  config.BpfRecorderMaxProcessesEnvKey,
+			Value: fmt.Sprint(*opts.MaxProcesses),
+		})
+	}
+	return env
+}
+
 func addEnvVar(templateSpec *corev1.PodSpec, envVarKey string) {
 	envValue, err := strconv.ParseBool(os.Getenv(envVarKey))
 	if err != nil {
@@ -755,3 +796,31 @@ func spodNeedsUpdate(configured, found *appsv1.DaemonSet) bool {
 		len(configured.Spec.Template.Spec.Containers) != len(found.Spec.Template.Spec.Containers) ||
 		!apiequality.Semantic.DeepDerivative(configured.Spec.Template, found.Spec.Template))
 }
+
+// configureSemoduleBackend lets the daemon install the SELinux policies with
+// semodule instead of handing them over to selinuxd, which requires the
+// privileges and the SELinux host mounts of selinuxd.
+func configureSemoduleBackend(daemon, selinuxd *corev1.Container) {
+	daemon.Args = append(daemon.Args, "--selinux-backend="+string(spodv1alpha1.SelinuxBackendSemodule))
+
+	mounts := append([]corev1.VolumeMount{}, daemon.VolumeMounts...)
+	for _, mount := range selinuxd.VolumeMounts {
+		if strings.HasPrefix(mount.Name, "host-") {
+			mounts = append(mounts, mount)
+		}
+	}
+	daemon.VolumeMounts = mounts
+
+	var userRoot int64
+	sc := daemon.SecurityContext.DeepCopy()
+	sc.RunAsUser = &userRoot
+	sc.RunAsGroup = &userRoot
+	sc.Capabilities = &corev1.Capabilities{
+		Drop: []corev1.Capability{"ALL"},
+		Add:  selinuxd.SecurityContext.Capabilities.Add,
+	}
+	// semodule runs the policy compiler, which is not covered by the
+	// seccomp profile of the daemon
+	sc.SeccompProfile = &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault}
+	daemon.SecurityContext = sc
+}
@@ -27,6 +27,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
 	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
 	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/metrics"
@@ -65,31 +66,53 @@ func (r *PodReconciler) Setup(
 		return fmt.Errorf("creating pod index: %w", err)
 	}
 
-	// Index SeccompProfiles with active pods
+	// Index Pods using apparmor profiles
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &corev1.Pod{}, aaOwnerKey, func(rawObj client.Object) []string {
+		pod, ok := rawObj.(*corev1.Pod)
+		if !ok {
+			return []string{}
+		}
+		return getAppArmorProfilesFromPod(pod)
+	}); err != nil {
+		return fmt.Errorf("creating pod index: %w", err)
+	}
+
+	// Index SeccompProfiles by the namespaces of their workloads
 	if err := mgr.GetFieldIndexer().IndexField(
-		ctx, &seccompprofileapi.SeccompProfile{}, linkedPodsKey, func(rawObj client.Object) []string {
+		ctx, &seccompprofileapi.SeccompProfile{}, workloadNamespacesKey, func(rawObj client.Object) []string {
 			sp, ok := rawObj.(*seccompprofileapi.SeccompProfile)
 			if !ok {
 				return []string{}
 			}
-
-			return sp.Status.ActiveWorkloads
+			return workloadNamespaces(&sp.Status.WorkloadsStatus, sp.Status.ActiveWorkloads)
 		}); err != nil {
 		return fmt.Errorf("creating seccomp profile index: %w", err)
 	}
 
-	// Index SelinuxProfile with active pods
+	// Index SelinuxProfiles by the namespaces of their workloads
 	if err := mgr.GetFieldIndexer().IndexField(
-		ctx, &selinuxprofileapi.SelinuxProfile{}, linkedPodsKey, func(rawObj client.Object) []string {
+		ctx, &selinuxprofileapi.SelinuxProfile{}, workloadNamespacesKey, func(rawObj client.Object) []string {
 			sp, ok := rawObj.(*selinuxprofileapi.SelinuxProfile)
 			if !ok {
 				return []string{}
 			}
-			return sp.Status.ActiveWorkloads
+			return workloadNamespaces(&sp.Status.WorkloadsStatus, sp.Status.ActiveWorkloads)
 		}); err != nil {
 		return fmt.Errorf("creating selinux profile index: %w", err)
 	}
 
+	// Index AppArmorProfiles by the namespaces of their workloads
+	if err := mgr.GetFieldIndexer().IndexField(
+		ctx, &apparmorprofileapi.AppArmorProfile{}, workloadNamespacesKey, func(rawObj client.Object) []string {
+			sp, ok := rawObj.(*apparmorprofileapi.AppArmorProfile)
+			if !ok {
+				return []string{}
+			}
+			return workloadNamespaces(&sp.Status.WorkloadsStatus, nil)
+		}); err != nil {
+		return fmt.Errorf("creating apparmor profile index: %w", err)
+	}
+
 	// Register a special reconciler for pod events
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
@@ -121,6 +144,15 @@ func hasSelinuxProfile(r *PodReconciler, obj runtime.Object) bool {
 	return len(getSelinuxProfilesFromPod(context.TODO(), r, pod)) > 0
 }
 
+func hasAppArmorProfile(obj runtime.Object) bool {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return false
+	}
+
+	return len(getAppArmorProfilesFromPod(pod)) > 0
+}
+
 func (r *PodReconciler) hasValidProfile(obj runtime.Object) bool {
-	return hasSeccompProfile(obj) || hasSelinuxProfile(r, obj)
+	return hasSeccompProfile(obj) || hasSelinuxProfile(r, obj) || hasAppArmorProfile(obj)
 }
@@ -20,17 +20,26 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"reflect"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/scheme"
 
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	profilebase "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
 	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
 	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/controller"
@@ -38,12 +47,20 @@ import (
 )
 
 const (
-	spOwnerKey        = ".metadata.seccompProfileOwner"
-	seOwnerKey        = ".metadata.selinuxProfileOwner"
-	linkedPodsKey     = ".metadata.activeWorkloads"
-	StatusToProfLabel = "spo.x-k8s.io/profile-id"
-	reconcileTimeout  = 1 * time.Minute
-	pathParts         = 3
+	spOwnerKey            = ".metadata.seccompProfileOwner"
+	seOwnerKey            = ".metadata.selinuxProfileOwner"
+	aaOwnerKey            = ".metadata.apparmorProfileOwner"
+	workloadNamespacesKey = ".status.workloadNamespaces"
+	StatusToProfLabel     = "spo.x-k8s.io/profile-id"
+	reconcileTimeout      = 1 * time.Minute
+	pathParts             = 3
+
+	// allNamespaces is indexed for profiles whose workload list got
+	// truncated, which have to be updated on every pod deletion.
+	allNamespaces = "*"
+
+	// kindPod is used as workload kind for pods without a controller.
+	kindPod = "Pod"
 )
 
 // NewController returns a new empty controller instance.
@@ -51,7 +68,8 @@ func NewController() controller.Controller {
 	return &PodReconciler{}
 }
 
-// A PodReconciler monitors pod changes and links them to SeccompProfiles.
+// A PodReconciler monitors pod changes and links their workloads to the
+// SeccompProfiles, SelinuxProfiles and AppArmorProfiles they are using.
 type PodReconciler struct {
 	client client.Client
 	log    logr.Logger
@@ -75,16 +93,19 @@ func (r *PodReconciler) Healthz(*http.Request) error {
 
 // Namespace scoped
 // +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups=apps,resources=replicasets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=apparmorprofiles,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=apparmorprofiles/status,verbs=get;update;patch
 
-// Reconcile reacts to pod events and updates SeccompProfiles or SelinuxProfiles if in use or no longer in use by a pod.
+// Reconcile reacts to pod events and updates the workloads of the profiles
+// which are in use or no longer in use by a pod.
 func (r *PodReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
 	logger := r.log.WithValues("pod", req.Name, "namespace", req.Namespace)
 
 	ctx, cancel := context.WithTimeout(ctx, reconcileTimeout)
 	defer cancel()
 
-	podID := req.Namespace + "/" + req.Name
-
 	pod := &corev1.Pod{}
 	var err error
 	//nolint:gocritic // It's intended to ignore the not found error
@@ -92,33 +113,14 @@ func (r *PodReconciler) Reconcile(ctx context.Context, req reconcile.Request) (r
 		logger.Error(err, "could not get pod")
 		return reconcile.Result{}, fmt.Errorf("looking up pod in pod reconciler: %w", err)
 	}
-	if errors.IsNotFound(err) { // this is a pod deletion, so update all seccomp/selinux profiles that were using it
-		seccompProfiles := &seccompprofileapi.SeccompProfileList{}
-		selinuxProfiles := &selinuxprofileapi.SelinuxProfileList{}
-
-		if err = r.client.List(ctx, seccompProfiles, client.MatchingFields{linkedPodsKey: podID}); err != nil {
-			return reconcile.Result{}, fmt.Errorf("listing SeccompProfiles for deleted pod: %w", err)
-		}
-
-		if err = r.client.List(ctx, selinuxProfiles, client.MatchingFields{linkedPodsKey: podID}); err != nil {
-			return reconcile.Result{}, fmt.Errorf("listing SelinuxProfiles for deleted pod: %w", err)
-		}
-
-		for i := range seccompProfiles.Items {
-			if err = r.updatePodReferencesForSeccomp(ctx, &seccompProfiles.Items[i]); err != nil {
-				return reconcile.Result{}, fmt.Errorf("updating SeccompProfile for deleted pod: %w", err)
-			}
-		}
-
-		for j := range selinuxProfiles.Items {
-			if err = r.updatePodReferencesForSelinux(ctx, &selinuxProfiles.Items[j]); err != nil {
-				return reconcile.Result{}, fmt.Errorf("updating SelinuxProfile for deleted pod: %w", err)
-			}
+	if errors.IsNotFound(err) { // this is a pod deletion, so update all profiles that might have used it
+		if err := r.updateProfilesForDeletedPod(ctx, req.Namespace); err != nil {
+			return reconcile.Result{}, fmt.Errorf("updating profiles for deleted pod: %w", err)
 		}
 		return reconcile.Result{}, nil
 	}
 
-	// pod is being created or updated so ensure it is linked to a seccomp/selinux profile
+	// pod is being created or updated so ensure it is linked to a seccomp profile
 	for _, profileIndex := range getSeccompProfilesFromPod(pod) {
 		profileElements := strings.Split(profileIndex, "/")
 		if len(profileElements) != pathParts {
@@ -131,9 +133,9 @@ func (r *PodReconciler) Reconcile(ctx context.Context, req reconcile.Request) (r
 			logger.Error(err, "could not get seccomp profile for pod")
 			return reconcile.Result{}, fmt.Errorf("looking up SeccompProfile for new or updated pod: %w", err)
 		}
-		if err := r.updatePodReferencesForSeccomp(ctx, seccompProfile); err != nil {
+		if err := r.updateWorkloads(ctx, seccompProfile); err != nil {
 			logger.Error(err, "could not update seccomp profile for pod")
-			return reconcile.Result{}, fmt.Errorf("updating SeccompProfile pod references for new or updated pod: %w", err)
+			return reconcile.Result{}, fmt.Errorf("updating SeccompProfile workloads for new or updated pod: %w", err)
 		}
 	}
 
@@ -147,52 +149,105 @@ func (r *PodReconciler) Reconcile(ctx context.Context, req reconcile.Request) (r
 			logger.Error(err, "could not get selinux profile for pod")
 			return reconcile.Result{}, fmt.Errorf("looking up SelinuxProfile for new or updated pod: %w", err)
 		}
-		if err := r.updatePodReferencesForSelinux(ctx, selinuxProfile); err != nil {
+		if err := r.updateWorkloads(ctx, selinuxProfile); err != nil {
 			logger.Error(err, "could not update selinux profile for pod")
-			return reconcile.Result{}, fmt.Errorf("updating SelinuxProfile pod references for new or updated pod: %w", err)
+			return reconcile.Result{}, fmt.Errorf("updating SelinuxProfile workloads for new or updated pod: %w", err)
+		}
+	}
+
+	// pod is being created or updated so ensure it is linked to an apparmor profile
+	for _, profileIndex := range getAppArmorProfilesFromPod(pod) {
+		appArmorProfile := &apparmorprofileapi.AppArmorProfile{}
+		if err := r.client.Get(
+			ctx, util.NamespacedName(strings.TrimPrefix(profileIndex, pod.GetNamespace()+"/"), pod.GetNamespace()),
+			appArmorProfile,
+		); err != nil {
+			if errors.IsNotFound(err) {
+				// The profile is not managed by the operator
+				continue
+			}
+			logger.Error(err, "could not get apparmor profile for pod")
+			return reconcile.Result{}, fmt.Errorf("looking up AppArmorProfile for new or updated pod: %w", err)
+		}
+		if err := r.updateWorkloads(ctx, appArmorProfile); err != nil {
+			logger.Error(err, "could not update apparmor profile for pod")
+			return reconcile.Result{}, fmt.Errorf("updating AppArmorProfile workloads for new or updated pod: %w", err)
 		}
 	}
 	return reconcile.Result{}, nil
 }
 
-// updatePodReferencesForSeccomp updates a SeccompProfile with the identifiers of pods using it and ensures
-// it has a finalizer indicating it is in use to prevent it from being deleted.
-func (r *PodReconciler) updatePodReferencesForSeccomp(ctx context.Context, sp *seccompprofileapi.SeccompProfile) error {
+// updateProfilesForDeletedPod updates all profiles which list workloads in
+// the namespace of a deleted pod, as well as the ones whose workload list got
+// truncated.
+func (r *PodReconciler) updateProfilesForDeletedPod(ctx context.Context, namespace string) error {
+	for _, newList := range []func() client.ObjectList{
+		func() client.ObjectList { return &seccompprofileapi.SeccompProfileList{} },
+		func() client.ObjectList { return &selinuxprofileapi.SelinuxProfileList{} },
+		func() client.ObjectList { return &apparmorprofileapi.AppArmorProfileList{} },
+	} {
+		updated := map[types.UID]bool{}
+		for _, key := range []string{namespace, allNamespaces} {
+			list := newList()
+			if err := r.client.List(ctx, list, client.MatchingFields{workloadNamespacesKey: key}); err != nil {
+				return fmt.Errorf("listing profiles: %w", err)
+			}
+			if err := meta.EachListItem(list, func(obj runtime.Object) error {
+				profile, ok := obj.(profilebase.WorkloadsStatusUser)
+				if !ok || updated[profile.GetUID()] {
+					return nil
+				}
+				updated[profile.GetUID()] = true
+				return r.updateWorkloads(ctx, profile)
+			}); err != nil {
+				return fmt.Errorf("updating profile: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// updateWorkloads updates a profile with the workloads of the pods using it
+// and ensures it has a finalizer indicating it is in use to prevent it from
+// being deleted.
+func (r *PodReconciler) updateWorkloads(ctx context.Context, profile profilebase.WorkloadsStatusUser) error {
+	field, reference := podIndexForProfile(profile)
 	linkedPods := &corev1.PodList{}
-	profileReference := fmt.Sprintf("operator/%s/%s.json", sp.GetNamespace(), sp.GetName())
-	err := r.client.List(ctx, linkedPods, client.MatchingFields{spOwnerKey: profileReference})
+	err := r.client.List(ctx, linkedPods, client.MatchingFields{field: reference})
 	if util.IgnoreNotFound(err) != nil {
-		return fmt.Errorf("listing pods to update seccompProfile: %w", err)
-	}
-	podList := make([]string, len(linkedPods.Items))
-	for i := range linkedPods.Items {
-		pod := linkedPods.Items[i]
-		podList[i] = pod.ObjectMeta.Namespace + "/" + pod.ObjectMeta.Name
+		return fmt.Errorf("listing pods to update profile: %w", err)
 	}
+
+	workloads := r.workloadsForPods(ctx, linkedPods.Items)
+	pods := activeWorkloads(linkedPods.Items)
 	if err := util.Retry(func() error {
-		sp.Status.ActiveWorkloads = podList
+		changed := setActiveWorkloads(profile, pods)
+		if reflect.DeepEqual(*profile.GetWorkloadsStatus(), workloads) && !changed {
+			return nil
+		}
+		*profile.GetWorkloadsStatus() = workloads
 
-		updateErr := r.client.Status().Update(ctx, sp)
+		updateErr := r.client.Status().Update(ctx, profile)
 		if updateErr != nil {
-			if err := r.client.Get(ctx, util.NamespacedName(sp.GetName(), sp.GetNamespace()), sp); err != nil {
+			if err := r.client.Get(ctx, util.NamespacedName(profile.GetName(), profile.GetNamespace()), profile); err != nil {
 				return fmt.Errorf("retrieving profile: %w", err)
 			}
 			return fmt.Errorf("updating profile: %w", updateErr)
 		}
-
 		return nil
 	}, util.IsNotFoundOrConflict); err != nil {
-		return fmt.Errorf("updating SeccompProfile status: %w", err)
+		return fmt.Errorf("updating profile status: %w", err)
 	}
+
 	if len(linkedPods.Items) > 0 {
 		if err := util.Retry(func() error {
-			return util.AddFinalizer(ctx, r.client, sp, util.HasActivePodsFinalizerString)
+			return util.AddFinalizer(ctx, r.client, profile, util.HasActivePodsFinalizerString)
 		}, util.IsNotFoundOrConflict); err != nil {
 			return fmt.Errorf("adding finalizer: %w", err)
 		}
 	} else {
 		if err := util.Retry(func() error {
-			return util.RemoveFinalizer(ctx, r.client, sp, util.HasActivePodsFinalizerString)
+			return util.RemoveFinalizer(ctx, r.client, profile, util.HasActivePodsFinalizerString)
 		}, util.IsNotFoundOrConflict); err != nil {
 			return fmt.Errorf("removing finalizer: %w", err)
 		}
@@ -200,47 +255,157 @@ func (r *PodReconciler) updatePodReferencesForSeccomp(ctx context.Context, sp *s
 	return nil
 }
 
-// updatePodReferencesForSelinux updates a SelinuxProfile with the identifiers of pods using it and ensures
-// it has a finalizer indicating it is in use to prevent it from being deleted.
-func (r *PodReconciler) updatePodReferencesForSelinux(ctx context.Context, se *selinuxprofileapi.SelinuxProfile) error {
-	linkedPods := &corev1.PodList{}
-	profileReference := se.GetPolicyUsage()
-	err := r.client.List(ctx, linkedPods, client.MatchingFields{seOwnerKey: profileReference})
-	if util.IgnoreNotFound(err) != nil {
-		return fmt.Errorf("listing pods to update selinuxProfile: %w", err)
+// podIndexForProfile returns the pod index field and value to find the pods
+// using a profile.
+func podIndexForProfile(profile profilebase.WorkloadsStatusUser) (field, reference string) {
+	switch p := profile.(type) {
+	case *seccompprofileapi.SeccompProfile:
+		return spOwnerKey, fmt.Sprintf("operator/%s/%s.json", p.GetNamespace(), p.GetName())
+	case *selinuxprofileapi.SelinuxProfile:
+		return seOwnerKey, p.GetPolicyUsage()
+	default:
+		return aaOwnerKey, profile.GetNamespace() + "/" + profile.GetName()
 	}
-	podList := make([]string, len(linkedPods.Items))
-	for i := range linkedPods.Items {
-		pod := linkedPods.Items[i]
-		podList[i] = pod.ObjectMeta.Namespace + "/" + pod.ObjectMeta.Name
+}
+
+// activeWorkloads returns the identifiers of the pods for the deprecated
+// activeWorkloads status field. Like the workloads, the list is sorted and
+// limited to MaxStatusWorkloads to bound the size of the status.
+func activeWorkloads(pods []corev1.Pod) []string {
+	if len(pods) == 0 {
+		return nil
 	}
-	if err := util.Retry(func() error {
-		se.Status.ActiveWorkloads = podList
-		updateErr := r.client.Status().Update(ctx, se)
-		if updateErr != nil {
-			if err := r.client.Get(ctx, util.NamespacedName(se.GetName(), se.GetNamespace()), se); err != nil {
-				return fmt.Errorf("retrieving profile: %w", err)
-			}
-			return fmt.Errorf("updating profile: %w", updateErr)
+	podList := make([]string, len(pods))
+	for i := range pods {
+		podList[i] = pods[i].GetNamespace() + "/" + pods[i].GetName()
+	}
+	sort.Strings(podList)
+	if len(podList) > profilebase.MaxStatusWorkloads {
+		podList = podList[:profilebase.MaxStatusWorkloads]
+	}
+	return podList
+}
+
+// setActiveWorkloads sets the deprecated activeWorkloads status field of the
+// profile kinds having it, and returns true if it changed.
+func setActiveWorkloads(profile profilebase.WorkloadsStatusUser, pods []string) bool {
+	var status *[]string
+	switch p := profile.(type) {
+	case *seccompprofileapi.SeccompProfile:
+		status = &p.Status.ActiveWorkloads
+	case *selinuxprofileapi.SelinuxProfile:
+		status = &p.Status.ActiveWorkloads
+	default:
+		return false
+	}
+	if len(*status) == 0 && len(pods) == 0 || reflect.DeepEqual(*status, pods) {
+		return false
+	}
+	*status = pods
+	return true
+}
+
+// workloadNamespaces returns the namespaces of the workloads using a profile
+// for indexing. Profiles with a truncated workload list are indexed for all
+// namespaces.
+func workloadNamespaces(status *profilebase.WorkloadsStatus, activeWorkloads []string) []string {
+	namespaces := []string{}
+	for i := range status.Workloads {
+		if !util.Contains(namespaces, status.Workloads[i].Namespace) {
+			namespaces = append(namespaces, status.Workloads[i].Namespace)
 		}
-		return nil
-	}, util.IsNotFoundOrConflict); err != nil {
-		return fmt.Errorf("updating SelinuxProfile status: %w", err)
 	}
-	if len(linkedPods.Items) > 0 {
-		if err := util.Retry(func() error {
-			return util.AddFinalizer(ctx, r.client, se, util.HasActivePodsFinalizerString)
-		}, util.IsNotFoundOrConflict); err != nil {
-			return fmt.Errorf("adding finalizer: %w", err)
+	// Pods listed by previous versions of the operator
+	for _, pod := range activeWorkloads {
+		if ns, _, ok := strings.Cut(pod, "/"); ok && !util.Contains(namespaces, ns) {
+			namespaces = append(namespaces, ns)
 		}
-	} else {
-		if err := util.Retry(func() error {
-			return util.RemoveFinalizer(ctx, r.client, se, util.HasActivePodsFinalizerString)
-		}, util.IsNotFoundOrConflict); err != nil {
-			return fmt.Errorf("removing finalizer: %w", err)
+	}
+	if int(status.WorkloadCount) > len(status.Workloads) {
+		namespaces = append(namespaces, allNamespaces)
+	}
+	return namespaces
+}
+
+type workloadKey struct {
+	kind, namespace, name string
+}
+
+// workloadsForPods resolves the workloads of the pods and returns them as
+// bounded workloads status.
+func (r *PodReconciler) workloadsForPods(ctx context.Context, pods []corev1.Pod) profilebase.WorkloadsStatus {
+	replicas := map[workloadKey]int32{}
+	resolved := map[types.UID]workloadKey{}
+	for i := range pods {
+		replicas[r.resolveWorkload(ctx, &pods[i], resolved)]++
+	}
+	return summarizeWorkloads(replicas)
+}
+
+// resolveWorkload returns the workload owning the pod. ReplicaSets and Jobs
+// are resolved to their owning Deployment or CronJob. The resolved owners
+// are cached by their UID.
+func (r *PodReconciler) resolveWorkload(
+	ctx context.Context, pod *corev1.Pod, resolved map[types.UID]workloadKey,
+) workloadKey {
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil {
+		return workloadKey{kind: kindPod, namespace: pod.GetNamespace(), name: pod.GetName()}
+	}
+	if key, ok := resolved[owner.UID]; ok {
+		return key
+	}
+
+	key := workloadKey{kind: owner.Kind, namespace: pod.GetNamespace(), name: owner.Name}
+	if owner.Kind == "ReplicaSet" || owner.Kind == "Job" {
+		obj := &metav1.PartialObjectMetadata{}
+		obj.SetGroupVersionKind(schema.FromAPIVersionAndKind(owner.APIVersion, owner.Kind))
+		if err := r.client.Get(ctx, util.NamespacedName(owner.Name, pod.GetNamespace()), obj); err != nil {
+			r.log.V(1).Info("Unable to resolve owner of pod", "pod", pod.GetName(), "owner", owner.Name, "error", err)
+		} else if ownerOwner := metav1.GetControllerOf(obj); ownerOwner != nil {
+			key = workloadKey{kind: ownerOwner.Kind, namespace: pod.GetNamespace(), name: ownerOwner.Name}
 		}
 	}
-	return nil
+
+	resolved[owner.UID] = key
+	return key
+}
+
+// summarizeWorkloads sorts the workloads by namespace, kind and name and
+// limits them to MaxStatusWorkloads.
+func summarizeWorkloads(replicas map[workloadKey]int32) profilebase.WorkloadsStatus {
+	status := profilebase.WorkloadsStatus{WorkloadCount: int32(len(replicas))}
+	if len(replicas) == 0 {
+		return status
+	}
+
+	keys := make([]workloadKey, 0, len(replicas))
+	for key := range replicas {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].namespace != keys[j].namespace {
+			return keys[i].namespace < keys[j].namespace
+		}
+		if keys[i].kind != keys[j].kind {
+			return keys[i].kind < keys[j].kind
+		}
+		return keys[i].name < keys[j].name
+	})
+	if len(keys) > profilebase.MaxStatusWorkloads {
+		keys = keys[:profilebase.MaxStatusWorkloads]
+	}
+
+	status.Workloads = make([]profilebase.WorkloadReference, 0, len(keys))
+	for _, key := range keys {
+		status.Workloads = append(status.Workloads, profilebase.WorkloadReference{
+			Kind:      key.kind,
+			Namespace: key.namespace,
+			Name:      key.name,
+			Replicas:  replicas[key],
+		})
+	}
+	return status
 }
 
 // getSeccompProfilesFromPod returns a slice of strings representing seccomp profiles required by the pod.
@@ -348,3 +513,22 @@ func isOperatorSelinuxType(ctx context.Context, r *PodReconciler, se *corev1.SEL
 	}
 	return false
 }
+
+// getAppArmorProfilesFromPod returns a slice of strings representing the
+// apparmor profiles required by the pod, in the format "namespace/name".
+// The profiles are referenced by the container annotations.
+func getAppArmorProfilesFromPod(pod *corev1.Pod) []string {
+	profiles := []string{}
+	for key, value := range pod.GetAnnotations() {
+		if !strings.HasPrefix(key, corev1.AppArmorBetaContainerAnnotationKeyPrefix) ||
+			!strings.HasPrefix(value, corev1.AppArmorBetaProfileNamePrefix) {
+			continue
+		}
+		profileString := pod.GetNamespace() + "/" + strings.TrimPrefix(value, corev1.AppArmorBetaProfileNamePrefix)
+		if !util.Contains(profiles, profileString) {
+			profiles = append(profiles, profileString)
+		}
+	}
+	sort.Strings(profiles)
+	return profiles
+}
@@ -17,11 +17,25 @@ limitations under the License.
 package workloadannotator
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
+	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	apparmorprofileapi "sigs.k8s.io/security-profiles-operator/api/apparmorprofile/v1alpha1"
+	profilebase "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
 )
 
 func TestGetSeccompProfilesFromPod(t *testing.T) {
@@ -238,3 +252,148 @@ func TestGetSeccompProfilesFromPod(t *testing.T) {
 		})
 	}
 }
+
+func TestGetAppArmorProfilesFromPod(t *testing.T) {
+	t.Parallel()
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Annotations: map[string]string{
+				corev1.AppArmorBetaContainerAnnotationKeyPrefix + "a": "localhost/test",
+				corev1.AppArmorBetaContainerAnnotationKeyPrefix + "b": "localhost/test",
+				corev1.AppArmorBetaContainerAnnotationKeyPrefix + "c": "localhost/other",
+				corev1.AppArmorBetaContainerAnnotationKeyPrefix + "d": corev1.AppArmorBetaProfileRuntimeDefault,
+				"unrelated": "localhost/unrelated",
+			},
+		},
+	}
+	require.Equal(t, []string{"default/other", "default/test"}, getAppArmorProfilesFromPod(pod))
+}
+
+func TestResolveWorkload(t *testing.T) {
+	t.Parallel()
+
+	isController := true
+	ownerRef := func(apiVersion, kind, name string) []metav1.OwnerReference {
+		return []metav1.OwnerReference{{
+			APIVersion: apiVersion,
+			Kind:       kind,
+			Name:       name,
+			UID:        types.UID(kind + "/" + name),
+			Controller: &isController,
+		}}
+	}
+	newPod := func(name string, owners []metav1.OwnerReference) *corev1.Pod {
+		return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Name: name, Namespace: "default", OwnerReferences: owners,
+		}}
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{
+			Name: "web-5d8f", Namespace: "default", OwnerReferences: ownerRef("apps/v1", "Deployment", "web"),
+		}},
+		&batchv1.Job{ObjectMeta: metav1.ObjectMeta{
+			Name: "backup-2811", Namespace: "default", OwnerReferences: ownerRef("batch/v1", "CronJob", "backup"),
+		}},
+	).Build()
+	r := &PodReconciler{client: cli, log: logr.Discard()}
+
+	for _, tc := range []struct {
+		pod  *corev1.Pod
+		want workloadKey
+	}{
+		{
+			pod:  newPod("web-5d8f-abc", ownerRef("apps/v1", "ReplicaSet", "web-5d8f")),
+			want: workloadKey{kind: "Deployment", namespace: "default", name: "web"},
+		},
+		{
+			pod:  newPod("backup-2811-xyz", ownerRef("batch/v1", "Job", "backup-2811")),
+			want: workloadKey{kind: "CronJob", namespace: "default", name: "backup"},
+		},
+		{
+			pod:  newPod("db-0", ownerRef("apps/v1", "StatefulSet", "db")),
+			want: workloadKey{kind: "StatefulSet", namespace: "default", name: "db"},
+		},
+		{
+			pod:  newPod("orphan-1a2b", ownerRef("apps/v1", "ReplicaSet", "orphan")),
+			want: workloadKey{kind: "ReplicaSet", namespace: "default", name: "orphan"},
+		},
+		{
+			pod:  newPod("standalone", nil),
+			want: workloadKey{kind: kindPod, namespace: "default", name: "standalone"},
+		},
+	} {
+		require.Equal(t, tc.want, r.resolveWorkload(context.Background(), tc.pod, map[types.UID]workloadKey{}))
+	}
+}
+
+func TestSummarizeWorkloads(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, profilebase.WorkloadsStatus{}, summarizeWorkloads(map[workloadKey]int32{}))
+
+	status := summarizeWorkloads(map[workloadKey]int32{
+		{kind: "StatefulSet", namespace: "b", name: "db"}: 3,
+		{kind: "Deployment", namespace: "b", name: "web"}: 2,
+		{kind: "Pod", namespace: "a", name: "debug"}:      1,
+	})
+	require.Equal(t, profilebase.WorkloadsStatus{
+		WorkloadCount: 3,
+		Workloads: []profilebase.WorkloadReference{
+			{Kind: "Pod", Namespace: "a", Name: "debug", Replicas: 1},
+			{Kind: "Deployment", Namespace: "b", Name: "web", Replicas: 2},
+			{Kind: "StatefulSet", Namespace: "b", Name: "db", Replicas: 3},
+		},
+	}, status)
+	require.Equal(t, []string{"a", "b"}, workloadNamespaces(&status, nil))
+
+	many := map[workloadKey]int32{}
+	for i := 0; i < profilebase.MaxStatusWorkloads+10; i++ {
+		many[workloadKey{kind: "Deployment", namespace: fmt.Sprintf("ns-%03d", i), name: "web"}] = 1
+	}
+	status = summarizeWorkloads(many)
+	require.Len(t, status.Workloads, profilebase.MaxStatusWorkloads)
+	require.EqualValues(t, profilebase.MaxStatusWorkloads+10, status.WorkloadCount)
+	require.Equal(t, "ns-000", status.Workloads[0].Namespace)
+	require.Contains(t, workloadNamespaces(&status, nil), allNamespaces)
+}
+
+func TestSetActiveWorkloads(t *testing.T) {
+	t.Parallel()
+
+	pods := activeWorkloads([]corev1.Pod{
+		{ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "a"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "web-2", Namespace: "a"}},
+	})
+	require.Equal(t, []string{"a/web-1", "a/web-2"}, pods)
+	require.Nil(t, activeWorkloads(nil))
+
+	many := []corev1.Pod{}
+	for i := 0; i < profilebase.MaxStatusWorkloads+10; i++ {
+		many = append(many, corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Name: fmt.Sprintf("web-%03d", profilebase.MaxStatusWorkloads+10-i), Namespace: "a",
+		}})
+	}
+	capped := activeWorkloads(many)
+	require.Len(t, capped, profilebase.MaxStatusWorkloads)
+	require.Equal(t, "a/web-001", capped[0])
+
+	sp := &seccompprofileapi.SeccompProfile{}
+	require.True(t, setActiveWorkloads(sp, pods))
+	require.Equal(t, pods, sp.Status.ActiveWorkloads)
+	require.False(t, setActiveWorkloads(sp, pods))
+	require.True(t, setActiveWorkloads(sp, nil))
+	require.Empty(t, sp.Status.ActiveWorkloads)
+	require.False(t, setActiveWorkloads(sp, nil))
+
+	se := &selinuxprofileapi.SelinuxProfile{}
+	require.True(t, setActiveWorkloads(se, pods))
+	require.Equal(t, pods, se.Status.ActiveWorkloads)
+
+	// AppArmor profiles never had the field
+	require.False(t, setActiveWorkloads(&apparmorprofileapi.AppArmorProfile{}, pods))
+}
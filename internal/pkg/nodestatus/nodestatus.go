@@ -18,10 +18,14 @@ package nodestatus
 
 import (
 	"context"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"os"
+	"reflect"
+	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -60,11 +64,33 @@ func NewForProfile(pol profilebase.SecurityProfileBase, c client.Client) (*Statu
 }
 
 func (nsf *StatusClient) perNodeStatusName() string {
-	return nsf.pol.GetName() + "-" + nsf.nodeName
+	return nsf.perNodeStatusNamespacedName().Name
 }
 
 func (nsf *StatusClient) perNodeStatusNamespacedName() types.NamespacedName {
-	return util.NamespacedName(nsf.perNodeStatusName(), nsf.pol.GetNamespace())
+	return NamespacedName(nsf.pol, nsf.nodeName)
+}
+
+// NamespacedName returns the name of the status of the profile on the node.
+// The names of the node statuses of cluster scoped profiles are prefixed by
+// their kind, to not collide with the ones of namespaced profiles with the
+// same name in the operator namespace.
+func NamespacedName(pol client.Object, nodeName string) types.NamespacedName {
+	name := pol.GetName()
+	if pol.GetNamespace() == "" {
+		name = strings.ToLower(util.KindBasedDNSLengthName(pol))
+	}
+	return util.NamespacedName(name+"-"+nodeName, StatusNamespace(pol))
+}
+
+// StatusNamespace returns the namespace of the node statuses of a profile.
+// The node statuses of cluster scoped profiles are stored in the operator
+// namespace.
+func StatusNamespace(pol metav1.Object) string {
+	if pol.GetNamespace() == "" {
+		return config.GetOperatorNamespace()
+	}
+	return pol.GetNamespace()
 }
 
 func (nsf *StatusClient) Create(ctx context.Context) error {
@@ -114,7 +140,7 @@ func (nsf *StatusClient) statusObj(
 	return &secprofnodestatusv1alpha1.SecurityProfileNodeStatus{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      nsf.perNodeStatusName(),
-			Namespace: nsf.pol.GetNamespace(),
+			Namespace: StatusNamespace(nsf.pol),
 			Labels: map[string]string{
 				secprofnodestatusv1alpha1.StatusToProfLabel: util.KindBasedDNSLengthName(nsf.pol),
 				secprofnodestatusv1alpha1.StatusToNodeLabel: nsf.nodeName,
@@ -195,6 +221,25 @@ func (nsf *StatusClient) Exists(ctx context.Context) (bool, error) {
 	return s || f, err
 }
 
+// NodeSelected returns true if the profile should be installed on the local
+// node.
+func (nsf *StatusClient) NodeSelected(ctx context.Context) (bool, error) {
+	if nsf.pol.GetNodeSelector() == nil {
+		return true, nil
+	}
+
+	node := &corev1.Node{}
+	if err := nsf.client.Get(ctx, types.NamespacedName{Name: nsf.nodeName}, node); err != nil {
+		return false, fmt.Errorf("getting node %s: %w", nsf.nodeName, err)
+	}
+
+	selected, err := profilebase.SelectsNode(nsf.pol, node.Labels)
+	if err != nil {
+		return false, fmt.Errorf("matching node selector of %s: %w", nsf.pol.GetName(), err)
+	}
+	return selected, nil
+}
+
 func (nsf *StatusClient) finalizerExists() bool {
 	return controllerutil.ContainsFinalizer(nsf.pol, nsf.finalizerString)
 }
@@ -211,9 +256,85 @@ func (nsf *StatusClient) nodeStatusExists(ctx context.Context) (bool, error) {
 	return true, nil
 }
 
+// SetNodeStatus sets the state of the node status and clears the reason and
+// message of a previous state.
 func (nsf *StatusClient) SetNodeStatus(
 	ctx context.Context,
 	polState secprofnodestatusv1alpha1.ProfileState,
+) error {
+	return nsf.setNodeStatus(ctx, polState, func(*secprofnodestatusv1alpha1.SecurityProfileNodeStatus) {})
+}
+
+// SetNodeStatusInstalled sets the node status to installed and records the
+// digest of the installed profile content.
+func (nsf *StatusClient) SetNodeStatusInstalled(ctx context.Context, content []byte) error {
+	return nsf.setNodeStatus(
+		ctx,
+		secprofnodestatusv1alpha1.ProfileStateInstalled,
+		func(status *secprofnodestatusv1alpha1.SecurityProfileNodeStatus) {
+			status.ContentDigest = contentDigest(content)
+		},
+	)
+}
+
+// SetNodeStatusError sets the node status to error and records the reason and
+// message of the failure.
+func (nsf *StatusClient) SetNodeStatusError(ctx context.Context, reason, message string) error {
+	return nsf.setNodeStatus(
+		ctx,
+		secprofnodestatusv1alpha1.ProfileStateError,
+		func(status *secprofnodestatusv1alpha1.SecurityProfileNodeStatus) {
+			status.Reason = reason
+			status.Message = message
+		},
+	)
+}
+
+// SetSeccompFilter records the BPF filter compiled from the seccomp profile
+// on the node, or removes it if filter is nil. The state of the node status
+// remains unchanged.
+func (nsf *StatusClient) SetSeccompFilter(
+	ctx context.Context, filter *secprofnodestatusv1alpha1.SeccompFilterStatus,
+) error {
+	return nsf.updateNodeStatus(ctx, func(status *secprofnodestatusv1alpha1.SecurityProfileNodeStatus) {
+		status.SeccompFilter = filter
+	})
+}
+
+// SetLoadedMode records the mode in which the AppArmor profile is loaded on
+// the node. The state of the node status remains unchanged.
+func (nsf *StatusClient) SetLoadedMode(ctx context.Context, mode string) error {
+	return nsf.updateNodeStatus(ctx, func(status *secprofnodestatusv1alpha1.SecurityProfileNodeStatus) {
+		status.LoadedMode = mode
+	})
+}
+
+// updateNodeStatus applies the mutation to the node status and updates it
+// if it changed.
+func (nsf *StatusClient) updateNodeStatus(
+	ctx context.Context, mutate func(*secprofnodestatusv1alpha1.SecurityProfileNodeStatus),
+) error {
+	status := &secprofnodestatusv1alpha1.SecurityProfileNodeStatus{}
+	if err := nsf.client.Get(ctx, nsf.perNodeStatusNamespacedName(), status); err != nil {
+		return fmt.Errorf("retrieving the current status: %w", err)
+	}
+
+	updated := status.DeepCopy()
+	mutate(updated)
+	if reflect.DeepEqual(status, updated) {
+		return nil
+	}
+	if err := nsf.client.Update(ctx, updated); err != nil {
+		return fmt.Errorf("updating node status: %w", err)
+	}
+
+	return nil
+}
+
+func (nsf *StatusClient) setNodeStatus(
+	ctx context.Context,
+	polState secprofnodestatusv1alpha1.ProfileState,
+	mutate func(*secprofnodestatusv1alpha1.SecurityProfileNodeStatus),
 ) error {
 	status := secprofnodestatusv1alpha1.SecurityProfileNodeStatus{}
 	err := nsf.client.Get(ctx, nsf.perNodeStatusNamespacedName(), &status)
@@ -224,7 +345,14 @@ func (nsf *StatusClient) SetNodeStatus(
 		return fmt.Errorf("retrieving the current status: %w", err)
 	}
 
+	if status.Status != polState || status.ObservedGeneration != nsf.pol.GetGeneration() {
+		status.LastTransitionTime = metav1.Now()
+	}
 	status.Status = polState
+	status.ObservedGeneration = nsf.pol.GetGeneration()
+	status.Reason = ""
+	status.Message = ""
+	mutate(&status)
 	status.Labels[secprofnodestatusv1alpha1.StatusStateLabel] = string(polState)
 	if err := nsf.client.Update(ctx, &status); err != nil {
 		return fmt.Errorf("updating node status: %w", err)
@@ -233,6 +361,12 @@ func (nsf *StatusClient) SetNodeStatus(
 	return nil
 }
 
+// contentDigest returns the digest of the profile content as recorded in the
+// node status.
+func contentDigest(content []byte) string {
+	return fmt.Sprintf("sha256:%x", sha256.Sum256(content))
+}
+
 func (nsf *StatusClient) Matches(
 	ctx context.Context, polState secprofnodestatusv1alpha1.ProfileState,
 ) (bool, error) {
@@ -247,6 +381,19 @@ func (nsf *StatusClient) Matches(
 	return status.Status == polState, nil
 }
 
+// IsInstalled returns true if the profile is installed on the node in its
+// current generation. A status of a previous generation needs to be updated
+// even if the installed content did not change, because a staged rollout
+// waits for the canary nodes to observe the current generation.
+func (nsf *StatusClient) IsInstalled(ctx context.Context) (bool, error) {
+	status := secprofnodestatusv1alpha1.SecurityProfileNodeStatus{}
+	if err := nsf.client.Get(ctx, nsf.perNodeStatusNamespacedName(), &status); err != nil {
+		return false, fmt.Errorf("getting node status: %w", err)
+	}
+	return status.Status == secprofnodestatusv1alpha1.ProfileStateInstalled &&
+		status.ObservedGeneration == nsf.pol.GetGeneration(), nil
+}
+
 func getFinalizerString(pol profilebase.SecurityProfileBase, nodeName string) string {
 	if pol.IsPartial() {
 		return partialProfileFinalizer
@@ -17,13 +17,20 @@ limitations under the License.
 package nodestatus
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	profilebase "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
 	seccompprofile "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	secprofnodestatusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
 )
 
@@ -89,3 +96,194 @@ func partialSeccompProfile() *seccompprofile.SeccompProfile {
 		},
 	}
 }
+
+//nolint:paralleltest // cannot set environment variables in parallel tests
+func TestNodeSelected(t *testing.T) {
+	const nodeName = "node"
+	t.Setenv(config.NodeNameEnvKey, nodeName)
+
+	cli := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(&corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   nodeName,
+			Labels: map[string]string{"pool": "apparmor"},
+		},
+	}).Build()
+
+	for _, tc := range []struct {
+		name         string
+		nodeSelector *metav1.LabelSelector
+		want         bool
+	}{
+		{
+			name: "no selector",
+			want: true,
+		},
+		{
+			name: "matching selector",
+			nodeSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"pool": "apparmor"},
+			},
+			want: true,
+		},
+		{
+			name: "not matching selector",
+			nodeSelector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{
+					Key:      "pool",
+					Operator: metav1.LabelSelectorOpIn,
+					Values:   []string{"selinux"},
+				}},
+			},
+			want: false,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			sp := regularSeccompProfile()
+			sp.Spec.NodeSelector = tc.nodeSelector
+
+			sc, err := NewForProfile(sp, cli)
+			require.NoError(t, err)
+
+			selected, err := sc.NodeSelected(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, tc.want, selected)
+		})
+	}
+}
+
+//nolint:paralleltest // cannot set environment variables in parallel tests
+func TestSetNodeStatusDetails(t *testing.T) {
+	const nodeName = "node"
+	t.Setenv(config.NodeNameEnvKey, nodeName)
+
+	sp := regularSeccompProfile()
+	sp.Generation = 3
+
+	s := runtime.NewScheme()
+	require.NoError(t, secprofnodestatusv1alpha1.AddToScheme(s))
+	cli := fake.NewClientBuilder().WithScheme(s).WithObjects(&secprofnodestatusv1alpha1.SecurityProfileNodeStatus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      sp.GetName() + "-" + nodeName,
+			Namespace: sp.GetNamespace(),
+			Labels: map[string]string{
+				secprofnodestatusv1alpha1.StatusStateLabel: string(secprofnodestatusv1alpha1.ProfileStatePending),
+			},
+		},
+		NodeName: nodeName,
+		Status:   secprofnodestatusv1alpha1.ProfileStatePending,
+	}).Build()
+
+	sc, err := NewForProfile(sp, cli)
+	require.NoError(t, err)
+
+	get := func() *secprofnodestatusv1alpha1.SecurityProfileNodeStatus {
+		status := &secprofnodestatusv1alpha1.SecurityProfileNodeStatus{}
+		require.NoError(t, cli.Get(context.Background(), sc.perNodeStatusNamespacedName(), status))
+		return status
+	}
+
+	require.NoError(t, sc.SetNodeStatusError(context.Background(), "CannotSaveProfile", "disk full"))
+	status := get()
+	require.Equal(t, secprofnodestatusv1alpha1.ProfileStateError, status.Status)
+	require.Equal(t, int64(3), status.ObservedGeneration)
+	require.Equal(t, "CannotSaveProfile", status.Reason)
+	require.Equal(t, "disk full", status.Message)
+	require.False(t, status.LastTransitionTime.IsZero())
+
+	require.NoError(t, sc.SetNodeStatusInstalled(context.Background(), []byte("{}")))
+	status = get()
+	require.Equal(t, secprofnodestatusv1alpha1.ProfileStateInstalled, status.Status)
+	require.Empty(t, status.Reason)
+	require.Empty(t, status.Message)
+	require.Equal(t,
+		"sha256:44136fa355b3678a1146ad16f7e8649e94fb4fc21fe77e8310c060f61caaff8a",
+		status.ContentDigest,
+	)
+
+	installed, err := sc.Matches(context.Background(), secprofnodestatusv1alpha1.ProfileStateInstalled)
+	require.NoError(t, err)
+	require.True(t, installed)
+
+	installed, err = sc.IsInstalled(context.Background())
+	require.NoError(t, err)
+	require.True(t, installed)
+
+	// a new generation of the profile has to be installed again
+	sp.Generation = 4
+	installed, err = sc.Matches(context.Background(), secprofnodestatusv1alpha1.ProfileStateInstalled)
+	require.NoError(t, err)
+	require.True(t, installed)
+
+	installed, err = sc.IsInstalled(context.Background())
+	require.NoError(t, err)
+	require.False(t, installed)
+
+	// the details of the profile on the node do not change its state
+	filter := &secprofnodestatusv1alpha1.SeccompFilterStatus{Rules: 1, Instructions: 12}
+	require.NoError(t, sc.SetSeccompFilter(context.Background(), filter))
+	require.NoError(t, sc.SetLoadedMode(context.Background(), "complain"))
+	status = get()
+	require.Equal(t, secprofnodestatusv1alpha1.ProfileStateInstalled, status.Status)
+	require.Equal(t, int64(3), status.ObservedGeneration)
+	require.Equal(t, filter, status.SeccompFilter)
+	require.Equal(t, "complain", status.LoadedMode)
+
+	require.NoError(t, sc.SetSeccompFilter(context.Background(), nil))
+	require.Nil(t, get().SeccompFilter)
+}
+
+//nolint:paralleltest // cannot set environment variables in parallel tests
+func TestStatusNamespace(t *testing.T) {
+	t.Setenv(config.OperatorNamespaceEnvKey, "security-profiles-operator")
+
+	require.Equal(t, "test-namespace", StatusNamespace(regularSeccompProfile()))
+	require.Equal(t, "security-profiles-operator", StatusNamespace(&seccompprofile.ClusterSeccompProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+	}))
+}
+
+//nolint:paralleltest // cannot set environment variables in parallel tests
+func TestNodeStatusNameCollision(t *testing.T) {
+	const (
+		nodeName          = "node"
+		operatorNamespace = "security-profiles-operator"
+	)
+	t.Setenv(config.NodeNameEnvKey, nodeName)
+	t.Setenv(config.OperatorNamespaceEnvKey, operatorNamespace)
+
+	namespaced := &seccompprofile.SeccompProfile{
+		TypeMeta:   metav1.TypeMeta{Kind: "SeccompProfile", APIVersion: seccompprofile.GroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{Name: "profile", Namespace: operatorNamespace},
+	}
+	cluster := &seccompprofile.ClusterSeccompProfile{
+		TypeMeta:   metav1.TypeMeta{Kind: "ClusterSeccompProfile", APIVersion: seccompprofile.GroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+	}
+
+	namespacedKey := NamespacedName(namespaced, nodeName)
+	clusterKey := NamespacedName(cluster, nodeName)
+	require.Equal(t, operatorNamespace, clusterKey.Namespace)
+	require.Equal(t, "profile-node", namespacedKey.Name)
+	require.Equal(t, "clusterseccompprofile-profile-node", clusterKey.Name)
+
+	s := runtime.NewScheme()
+	require.NoError(t, seccompprofile.AddToScheme(s))
+	require.NoError(t, secprofnodestatusv1alpha1.AddToScheme(s))
+	cli := fake.NewClientBuilder().WithScheme(s).WithObjects(namespaced, cluster).Build()
+
+	for _, pol := range []profilebase.SecurityProfileBase{namespaced, cluster} {
+		sc, err := NewForProfile(pol, cli)
+		require.NoError(t, err)
+		require.NoError(t, sc.Create(context.Background()))
+	}
+
+	statuses := &secprofnodestatusv1alpha1.SecurityProfileNodeStatusList{}
+	require.NoError(t, cli.List(context.Background(), statuses))
+	require.Len(t, statuses.Items, 2)
+	for _, key := range []types.NamespacedName{namespacedKey, clusterKey} {
+		status := &secprofnodestatusv1alpha1.SecurityProfileNodeStatus{}
+		require.NoError(t, cli.Get(context.Background(), key, status))
+		require.Len(t, status.OwnerReferences, 1)
+	}
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodestatus
+
+import (
+	"context"
+	"os"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	profilebase "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+// WatchNodeLabels reconciles the profiles with a node selector when the labels
+// of the local node change, because the node may have to install or remove
+// them.
+func WatchNodeLabels(b *builder.Builder, cli client.Client, newList func() client.ObjectList) *builder.Builder {
+	nodeName := os.Getenv(config.NodeNameEnvKey)
+	return b.Watches(
+		&corev1.Node{},
+		handler.EnqueueRequestsFromMapFunc(EnqueueNodeSelectingProfiles(cli, newList)),
+		builder.WithPredicates(
+			predicate.NewPredicateFuncs(func(obj client.Object) bool {
+				return obj.GetName() == nodeName
+			}),
+			predicate.LabelChangedPredicate{},
+		),
+	)
+}
+
+// EnqueueNodeSelectingProfiles returns a map function which enqueues all
+// profiles of the list having a node selector.
+func EnqueueNodeSelectingProfiles(cli client.Client, newList func() client.ObjectList) handler.MapFunc {
+	return func(ctx context.Context, _ client.Object) []reconcile.Request {
+		list := newList()
+		if err := cli.List(ctx, list); err != nil {
+			return nil
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return nil
+		}
+
+		var requests []reconcile.Request
+		for _, item := range items {
+			profile, ok := item.(profilebase.SecurityProfileBase)
+			if !ok || profile.GetNodeSelector() == nil {
+				continue
+			}
+			requests = append(requests, reconcile.Request{
+				NamespacedName: util.NamespacedName(profile.GetName(), profile.GetNamespace()),
+			})
+		}
+		return requests
+	}
+}
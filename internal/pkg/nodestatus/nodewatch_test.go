@@ -0,0 +1,57 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodestatus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	seccompprofile "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+func TestEnqueueNodeSelectingProfiles(t *testing.T) {
+	t.Parallel()
+
+	selecting := &seccompprofile.SeccompProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "selecting", Namespace: "default"},
+	}
+	selecting.Spec.NodeSelector = &metav1.LabelSelector{
+		MatchLabels: map[string]string{"pool": "seccomp"},
+	}
+	all := &seccompprofile.SeccompProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "all", Namespace: "default"},
+	}
+
+	s := runtime.NewScheme()
+	require.NoError(t, seccompprofile.AddToScheme(s))
+	cli := fake.NewClientBuilder().WithScheme(s).WithObjects(selecting, all).Build()
+
+	mapFunc := EnqueueNodeSelectingProfiles(cli, func() client.ObjectList {
+		return &seccompprofile.SeccompProfileList{}
+	})
+	require.Equal(t, []reconcile.Request{{
+		NamespacedName: util.NamespacedName("selecting", "default"),
+	}}, mapFunc(context.Background(), nil))
+}
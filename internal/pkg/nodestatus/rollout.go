@@ -0,0 +1,275 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodestatus
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"time"
+
+	"github.com/go-logr/logr"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	secprofnodestatusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/daemon/common"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+// rolloutCheckInterval is the interval in which the progress of a staged
+// rollout gets checked.
+const rolloutCheckInterval = 1 * time.Minute
+
+// LastViolationFunc returns the time of the most recent violation of the
+// profile observed on the local node, or the zero time if there was none.
+type LastViolationFunc func(context.Context) (time.Time, error)
+
+// RolloutAllowed returns true if the current generation of the profile can be
+// installed on the local node. Profile updates get installed on the canary
+// nodes first and on the remaining nodes once the canary nodes installed the
+// update without errors for the soak duration of the rollout strategy. If the
+// update cannot be installed yet, the returned duration is the time to wait
+// before checking again, or zero if the rollout is halted.
+func (nsf *StatusClient) RolloutAllowed(ctx context.Context) (bool, time.Duration, error) {
+	own := &secprofnodestatusv1alpha1.SecurityProfileNodeStatus{}
+	if err := nsf.client.Get(ctx, nsf.perNodeStatusNamespacedName(), own); err != nil {
+		if kerrors.IsNotFound(err) {
+			return true, 0, nil
+		}
+		return false, 0, fmt.Errorf("getting node status: %w", err)
+	}
+
+	generation := nsf.pol.GetGeneration()
+	if own.ObservedGeneration == 0 {
+		// the profile was never installed on this node
+		return true, 0, nil
+	}
+
+	strategy, err := nsf.rolloutStrategy(ctx)
+	if err != nil {
+		return false, 0, err
+	}
+	if strategy == nil {
+		return true, 0, nil
+	}
+
+	statuses, err := nsf.listStatuses(ctx)
+	if err != nil {
+		return false, 0, err
+	}
+	canaries := canaryNodes(statuses, string(nsf.pol.GetUID()), strategy.Canary)
+
+	if own.ObservedGeneration == generation {
+		// A canary which observed violations keeps its status until the
+		// profile gets updated, to halt the rollout.
+		halted := canaries[nsf.nodeName] &&
+			own.Status == secprofnodestatusv1alpha1.ProfileStateError &&
+			own.Reason == secprofnodestatusv1alpha1.ReasonRolloutHalted
+		return !halted, 0, nil
+	}
+
+	if canaries[nsf.nodeName] {
+		return true, 0, nil
+	}
+
+	var soakStart time.Time
+	for i := range statuses {
+		status := &statuses[i]
+		if !canaries[status.NodeName] {
+			continue
+		}
+		if status.ObservedGeneration != generation {
+			// canary did not install the update yet
+			return false, rolloutCheckInterval, nil
+		}
+		switch status.Status {
+		case secprofnodestatusv1alpha1.ProfileStateError:
+			// the rollout continues once the profile gets updated
+			return false, 0, nil
+		case secprofnodestatusv1alpha1.ProfileStateInstalled:
+		default:
+			return false, rolloutCheckInterval, nil
+		}
+		if status.LastTransitionTime.After(soakStart) {
+			soakStart = status.LastTransitionTime.Time
+		}
+	}
+
+	if remaining := strategy.SoakDuration.Duration - time.Since(soakStart); remaining > 0 {
+		return false, remaining, nil
+	}
+	return true, 0, nil
+}
+
+// CheckCanary sets the status of the local node to error if it is a canary
+// node of a staged rollout and the log enricher observed violations of the
+// profile during the soak duration. It returns true if the status got set to
+// error, otherwise the time to wait before checking again, which is zero once
+// the soak duration passed.
+func (nsf *StatusClient) CheckCanary(
+	ctx context.Context, lastViolation LastViolationFunc,
+) (bool, time.Duration, error) {
+	own := &secprofnodestatusv1alpha1.SecurityProfileNodeStatus{}
+	if err := nsf.client.Get(ctx, nsf.perNodeStatusNamespacedName(), own); err != nil {
+		return false, 0, fmt.Errorf("getting node status: %w", err)
+	}
+	if own.Status != secprofnodestatusv1alpha1.ProfileStateInstalled ||
+		own.ObservedGeneration != nsf.pol.GetGeneration() {
+		return false, 0, nil
+	}
+
+	spod, err := nsf.spod(ctx)
+	if err != nil || spod == nil || spod.Spec.ProfileRollout == nil || !spod.Spec.EnableLogEnricher {
+		return false, 0, err
+	}
+	strategy := spod.Spec.ProfileRollout
+
+	remaining := strategy.SoakDuration.Duration - time.Since(own.LastTransitionTime.Time)
+	if remaining <= 0 {
+		return false, 0, nil
+	}
+
+	statuses, err := nsf.listStatuses(ctx)
+	if err != nil {
+		return false, 0, err
+	}
+	if !canaryNodes(statuses, string(nsf.pol.GetUID()), strategy.Canary)[nsf.nodeName] {
+		return false, 0, nil
+	}
+
+	last, err := lastViolation(ctx)
+	if err != nil {
+		return false, 0, fmt.Errorf("getting last violation: %w", err)
+	}
+	// The transition time of the status is only accurate to the second
+	if !last.Before(own.LastTransitionTime.Time.Truncate(time.Second)) {
+		if err := nsf.SetNodeStatusError(
+			ctx,
+			secprofnodestatusv1alpha1.ReasonRolloutHalted,
+			fmt.Sprintf("Observed violations of the profile at %s", last.UTC().Format(time.RFC3339)),
+		); err != nil {
+			return false, 0, err
+		}
+		return true, 0, nil
+	}
+
+	if remaining > rolloutCheckInterval {
+		remaining = rolloutCheckInterval
+	}
+	return false, remaining, nil
+}
+
+// ReconcileCanary checks the local node with CheckCanary and records a warning
+// event on the profile if its rollout got halted. The reason of the halted
+// rollout gets passed to incError to be counted by the metrics of the profile
+// kind. It returns the result to requeue the profile with.
+func (nsf *StatusClient) ReconcileCanary(
+	ctx context.Context,
+	recorder record.EventRecorder,
+	l logr.Logger,
+	lastViolation LastViolationFunc,
+	incError func(reason string),
+) (reconcile.Result, error) {
+	halted, requeueAfter, err := nsf.CheckCanary(ctx, lastViolation)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("checking canary node: %w", err)
+	}
+
+	if halted {
+		evstr := fmt.Sprintf("Halted rollout because of violations on canary node %s", nsf.nodeName)
+		l.Info(evstr)
+		incError(secprofnodestatusv1alpha1.ReasonRolloutHalted)
+		recorder.Event(nsf.pol, util.EventTypeWarning, secprofnodestatusv1alpha1.ReasonRolloutHalted, evstr)
+	}
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// rolloutStrategy returns the staged rollout strategy configured in the SPOD,
+// or nil if profile updates get installed on all nodes at once.
+func (nsf *StatusClient) rolloutStrategy(ctx context.Context) (*spodv1alpha1.ProfileRolloutStrategy, error) {
+	spod, err := nsf.spod(ctx)
+	if err != nil || spod == nil {
+		return nil, err
+	}
+	return spod.Spec.ProfileRollout, nil
+}
+
+func (nsf *StatusClient) spod(ctx context.Context) (*spodv1alpha1.SecurityProfilesOperatorDaemon, error) {
+	spod, err := common.GetSPOD(ctx, nsf.client)
+	if kerrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("getting SPOD: %w", err)
+	}
+	return spod, nil
+}
+
+func (nsf *StatusClient) listStatuses(ctx context.Context) ([]secprofnodestatusv1alpha1.SecurityProfileNodeStatus, error) {
+	list := &secprofnodestatusv1alpha1.SecurityProfileNodeStatusList{}
+	if err := nsf.client.List(
+		ctx,
+		list,
+		client.InNamespace(StatusNamespace(nsf.pol)),
+		client.MatchingLabels{
+			secprofnodestatusv1alpha1.StatusToProfLabel: util.KindBasedDNSLengthName(nsf.pol),
+		},
+	); err != nil {
+		return nil, fmt.Errorf("listing node statuses: %w", err)
+	}
+	return list.Items, nil
+}
+
+// canaryNodes returns the nodes which install profile updates first. The
+// nodes get ordered by a hash of the node and profile, so that the canary
+// nodes differ between the profiles.
+func canaryNodes(
+	statuses []secprofnodestatusv1alpha1.SecurityProfileNodeStatus, profileUID string, canary intstr.IntOrString,
+) map[string]bool {
+	nodes := make([]string, 0, len(statuses))
+	for i := range statuses {
+		nodes = append(nodes, statuses[i].NodeName)
+	}
+
+	hash := func(node string) uint64 {
+		h := fnv.New64a()
+		h.Write([]byte(profileUID + "/" + node))
+		return h.Sum64()
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return hash(nodes[i]) < hash(nodes[j])
+	})
+
+	count, err := intstr.GetScaledValueFromIntOrPercent(&canary, len(nodes), true)
+	if err != nil || count < 1 {
+		count = 1
+	}
+	if count > len(nodes) {
+		count = len(nodes)
+	}
+
+	res := make(map[string]bool, count)
+	for _, node := range nodes[:count] {
+		res[node] = true
+	}
+	return res
+}
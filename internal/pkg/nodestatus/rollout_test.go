@@ -0,0 +1,233 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodestatus
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	seccompprofile "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
+	secprofnodestatusv1alpha1 "sigs.k8s.io/security-profiles-operator/api/secprofnodestatus/v1alpha1"
+	spodv1alpha1 "sigs.k8s.io/security-profiles-operator/api/spod/v1alpha1"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/util"
+)
+
+func TestCanaryNodes(t *testing.T) {
+	t.Parallel()
+
+	statuses := []secprofnodestatusv1alpha1.SecurityProfileNodeStatus{}
+	for _, node := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"} {
+		statuses = append(statuses, secprofnodestatusv1alpha1.SecurityProfileNodeStatus{NodeName: node})
+	}
+
+	for _, tc := range []struct {
+		canary intstr.IntOrString
+		want   int
+	}{
+		{canary: intstr.FromInt(0), want: 1},
+		{canary: intstr.FromInt(3), want: 3},
+		{canary: intstr.FromInt(20), want: 10},
+		{canary: intstr.FromString("25%"), want: 3},
+		{canary: intstr.FromString("100%"), want: 10},
+	} {
+		require.Len(t, canaryNodes(statuses, "uid", tc.canary), tc.want, tc.canary.String())
+	}
+
+	// the canary nodes are stable, but differ between the profiles
+	require.Equal(t,
+		canaryNodes(statuses, "uid", intstr.FromInt(3)),
+		canaryNodes(statuses, "uid", intstr.FromInt(3)),
+	)
+	require.NotEqual(t,
+		canaryNodes(statuses, "uid", intstr.FromInt(3)),
+		canaryNodes(statuses, "other", intstr.FromInt(3)),
+	)
+}
+
+//nolint:paralleltest // cannot set environment variables in parallel tests
+func TestRolloutAllowed(t *testing.T) {
+	const operatorNamespace = "security-profiles-operator"
+	t.Setenv(config.OperatorNamespaceEnvKey, operatorNamespace)
+
+	nodes := []string{"node-a", "node-b", "node-c"}
+
+	newProfile := func() *seccompprofile.SeccompProfile {
+		sp := regularSeccompProfile()
+		sp.UID = "uid"
+		sp.Generation = 2
+		return sp
+	}
+
+	newStatus := func(
+		node string, generation int64, state secprofnodestatusv1alpha1.ProfileState, transition time.Time,
+	) *secprofnodestatusv1alpha1.SecurityProfileNodeStatus {
+		sp := newProfile()
+		return &secprofnodestatusv1alpha1.SecurityProfileNodeStatus{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      sp.GetName() + "-" + node,
+				Namespace: sp.GetNamespace(),
+				Labels: map[string]string{
+					secprofnodestatusv1alpha1.StatusToProfLabel: util.KindBasedDNSLengthName(sp),
+				},
+			},
+			NodeName:           node,
+			Status:             state,
+			ObservedGeneration: generation,
+			LastTransitionTime: metav1.NewTime(transition),
+		}
+	}
+
+	rollout := &spodv1alpha1.ProfileRolloutStrategy{
+		Canary:       intstr.FromInt(1),
+		SoakDuration: metav1.Duration{Duration: 10 * time.Minute},
+	}
+
+	// Determine the canary node of the profile
+	all := []secprofnodestatusv1alpha1.SecurityProfileNodeStatus{}
+	for _, node := range nodes {
+		all = append(all, *newStatus(node, 1, secprofnodestatusv1alpha1.ProfileStateInstalled, time.Now()))
+	}
+	canaries := canaryNodes(all, "uid", rollout.Canary)
+	var canary, other string
+	for _, node := range nodes {
+		if canaries[node] {
+			canary = node
+		} else {
+			other = node
+		}
+	}
+
+	for _, tc := range []struct {
+		name          string
+		node          string
+		rollout       *spodv1alpha1.ProfileRolloutStrategy
+		ownGeneration int64
+		canaryState   secprofnodestatusv1alpha1.ProfileState
+		canaryGen     int64
+		canarySince   time.Duration
+		wantAllowed   bool
+		wantRequeue   bool
+	}{
+		{
+			name:          "no rollout strategy",
+			node:          other,
+			ownGeneration: 1,
+			canaryState:   secprofnodestatusv1alpha1.ProfileStateInstalled,
+			canaryGen:     1,
+			wantAllowed:   true,
+		},
+		{
+			name:        "first installation",
+			node:        other,
+			rollout:     rollout,
+			canaryState: secprofnodestatusv1alpha1.ProfileStateInstalled,
+			canaryGen:   1,
+			wantAllowed: true,
+		},
+		{
+			name:          "canary node",
+			node:          canary,
+			rollout:       rollout,
+			ownGeneration: 1,
+			canaryState:   secprofnodestatusv1alpha1.ProfileStateInstalled,
+			canaryGen:     1,
+			wantAllowed:   true,
+		},
+		{
+			name:          "canary pending",
+			node:          other,
+			rollout:       rollout,
+			ownGeneration: 1,
+			canaryState:   secprofnodestatusv1alpha1.ProfileStateInstalled,
+			canaryGen:     1,
+			wantRequeue:   true,
+		},
+		{
+			name:          "canary soaking",
+			node:          other,
+			rollout:       rollout,
+			ownGeneration: 1,
+			canaryState:   secprofnodestatusv1alpha1.ProfileStateInstalled,
+			canaryGen:     2,
+			canarySince:   time.Minute,
+			wantRequeue:   true,
+		},
+		{
+			name:          "canary soaked",
+			node:          other,
+			rollout:       rollout,
+			ownGeneration: 1,
+			canaryState:   secprofnodestatusv1alpha1.ProfileStateInstalled,
+			canaryGen:     2,
+			canarySince:   time.Hour,
+			wantAllowed:   true,
+		},
+		{
+			name:          "canary failed",
+			node:          other,
+			rollout:       rollout,
+			ownGeneration: 1,
+			canaryState:   secprofnodestatusv1alpha1.ProfileStateError,
+			canaryGen:     2,
+			canarySince:   time.Hour,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv(config.NodeNameEnvKey, tc.node)
+
+			scheme := runtime.NewScheme()
+			require.NoError(t, clientgoscheme.AddToScheme(scheme))
+			require.NoError(t, spodv1alpha1.AddToScheme(scheme))
+			require.NoError(t, secprofnodestatusv1alpha1.AddToScheme(scheme))
+
+			objs := []client.Object{&spodv1alpha1.SecurityProfilesOperatorDaemon{
+				ObjectMeta: metav1.ObjectMeta{Name: config.SPOdName, Namespace: operatorNamespace},
+				Spec:       spodv1alpha1.SPODSpec{ProfileRollout: tc.rollout},
+			}}
+			for _, node := range nodes {
+				switch node {
+				case canary:
+					objs = append(objs, newStatus(node, tc.canaryGen, tc.canaryState, time.Now().Add(-tc.canarySince)))
+				case tc.node:
+					objs = append(objs, newStatus(node, tc.ownGeneration, secprofnodestatusv1alpha1.ProfileStateInstalled, time.Now()))
+				default:
+					objs = append(objs, newStatus(node, 1, secprofnodestatusv1alpha1.ProfileStateInstalled, time.Now()))
+				}
+			}
+			cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+
+			sc, err := NewForProfile(newProfile(), cli)
+			require.NoError(t, err)
+
+			allowed, requeueAfter, err := sc.RolloutAllowed(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, tc.wantAllowed, allowed)
+			require.Equal(t, tc.wantRequeue, requeueAfter > 0)
+		})
+	}
+}
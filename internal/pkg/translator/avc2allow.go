@@ -0,0 +1,161 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package translator
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	enricherapi "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
+	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
+)
+
+const seContextRequiredParts = 3
+
+// Avcs2Allow converts a list of AVC denials into the allow policy of a
+// SelinuxProfile. Target types matching the recording profile are rewritten to
+// reference the profile itself. A workload which already runs confined by its
+// profile reports its own process type instead, so a non-empty selfType is
+// rewritten to reference the profile itself as well.
+func Avcs2Allow(
+	avcs []*enricherapi.AvcResponse_SelinuxAvc,
+	selfType string,
+	log logr.Logger,
+) (selxv1alpha2.Allow, error) {
+	seBuilder := newSeProfileBuilder(selfType, log)
+
+	if err := seBuilder.AddAvcList(avcs); err != nil {
+		return nil, fmt.Errorf("consuming AVCs: %w", err)
+	}
+
+	sePol, err := seBuilder.Format()
+	if err != nil {
+		return nil, fmt.Errorf("building policy: %w", err)
+	}
+
+	return sePol, nil
+}
+
+type seProfileBuilder struct {
+	permMap       map[string]sets.Set[string]
+	selfType      string
+	policyBuilder selxv1alpha2.Allow
+	log           logr.Logger
+	// used to optimize sorting
+	keys []string
+}
+
+func newSeProfileBuilder(selfType string, log logr.Logger) *seProfileBuilder {
+	return &seProfileBuilder{
+		permMap:       make(map[string]sets.Set[string]),
+		selfType:      selfType,
+		policyBuilder: make(selxv1alpha2.Allow),
+		log:           log,
+		keys:          make([]string, 0),
+	}
+}
+
+func (sb *seProfileBuilder) AddAvcList(avcs []*enricherapi.AvcResponse_SelinuxAvc) error {
+	for _, avc := range avcs {
+		sb.log.V(config.VerboseLevel).Info("Received an AVC response",
+			"perm", avc.Perm, "tclass",
+			avc.Tclass, "scontext", avc.Scontext,
+			"tcontext", avc.Tcontext)
+
+		if err := sb.addAvc(avc); err != nil {
+			return fmt.Errorf("adding AVC: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (sb *seProfileBuilder) addAvc(avc *enricherapi.AvcResponse_SelinuxAvc) error {
+	ctxType, err := ctxt2type(avc.Tcontext)
+	if err != nil {
+		return fmt.Errorf("converting context to type: %w", err)
+	}
+
+	key := avc.Tclass + " " + ctxType
+	sb.keys = append(sb.keys, key)
+
+	perms, ok := sb.permMap[key]
+	if ok {
+		perms.Insert(avc.Perm)
+	} else {
+		sb.permMap[key] = sets.New(avc.Perm)
+	}
+	return nil
+}
+
+func (sb *seProfileBuilder) Format() (selxv1alpha2.Allow, error) {
+	sort.Strings(sb.keys)
+	for _, key := range sb.keys {
+		val := sb.permMap[key]
+		if err := sb.writeLineFromKeyVal(key, val); err != nil {
+			return nil, fmt.Errorf("writing policy line from key-value pair: %w", err)
+		}
+	}
+
+	return sb.policyBuilder, nil
+}
+
+func (sb *seProfileBuilder) writeLineFromKeyVal(key string, val sets.Set[string]) error {
+	tclass, setype := sb.targetClassCtx(key)
+	if tclass == "" || setype == "" {
+		return errors.New("empty context or class")
+	}
+
+	// If we haven't parsed the type, ensure we have space for it
+	_, haveType := sb.policyBuilder[selxv1alpha2.LabelKey(setype)]
+	if !haveType {
+		sb.policyBuilder[selxv1alpha2.LabelKey(setype)] = make(map[selxv1alpha2.ObjectClassKey]selxv1alpha2.PermissionSet)
+	}
+
+	// Several target types may be rewritten to the profile itself, so merge
+	// with the permissions already written for the class.
+	typePerms := sb.policyBuilder[selxv1alpha2.LabelKey(setype)]
+	perms := sets.New(typePerms[selxv1alpha2.ObjectClassKey(tclass)]...).Union(val)
+	typePerms[selxv1alpha2.ObjectClassKey(tclass)] = selxv1alpha2.PermissionSet(sets.List(perms))
+	return nil
+}
+
+func (sb *seProfileBuilder) targetClassCtx(key string) (tclass, tcontext string) {
+	splitkey := strings.Split(key, " ")
+	tclass = splitkey[0]
+	tcontext = splitkey[1]
+	if tcontext == config.SelinuxPermissiveProfile || (sb.selfType != "" && tcontext == sb.selfType) {
+		// rewrite the context to reference itself.
+		// We replace this when writing the policy.
+		tcontext = selxv1alpha2.AllowSelf
+	}
+	return
+}
+
+func ctxt2type(ctx string) (string, error) {
+	elems := strings.Split(ctx, ":")
+	if len(elems) < seContextRequiredParts {
+		return "", errors.New("malformed SELinux context")
+	}
+	return elems[2], nil
+}
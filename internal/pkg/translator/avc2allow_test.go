@@ -0,0 +1,84 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package translator
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+
+	enricherapi "sigs.k8s.io/security-profiles-operator/api/grpc/enricher"
+	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+)
+
+func TestAvcs2Allow(t *testing.T) {
+	t.Parallel()
+
+	avcs := []*enricherapi.AvcResponse_SelinuxAvc{
+		{
+			Perm:     "read",
+			Scontext: "system_u:system_r:selinuxrecording.process:s0",
+			Tcontext: "system_u:object_r:var_log_t:s0",
+			Tclass:   "file",
+		},
+		{
+			Perm:     "sigchld",
+			Scontext: "system_u:system_r:selinuxrecording.process:s0",
+			Tcontext: "system_u:system_r:selinuxrecording.process:s0",
+			Tclass:   "process",
+		},
+		{
+			Perm:     "signal",
+			Scontext: "system_u:system_r:profile_default.process:s0",
+			Tcontext: "system_u:system_r:profile_default.process:s0",
+			Tclass:   "process",
+		},
+	}
+
+	for _, tc := range []struct {
+		name     string
+		selfType string
+		want     selxv1alpha2.Allow
+	}{
+		{
+			name: "recording profile only",
+			want: selxv1alpha2.Allow{
+				"var_log_t":               {"file": {"read"}},
+				selxv1alpha2.AllowSelf:    {"process": {"sigchld"}},
+				"profile_default.process": {"process": {"signal"}},
+			},
+		},
+		{
+			name:     "self type of a confined workload",
+			selfType: "profile_default.process",
+			want: selxv1alpha2.Allow{
+				"var_log_t":            {"file": {"read"}},
+				selxv1alpha2.AllowSelf: {"process": {"sigchld", "signal"}},
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			allow, err := Avcs2Allow(avcs, tc.selfType, logr.Discard())
+			require.NoError(t, err)
+			require.Equal(t, tc.want, allow)
+		})
+	}
+}
@@ -0,0 +1,585 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package translator
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+)
+
+const (
+	processType = "process"
+	objectRole  = "object_r"
+	systemRole  = "system_r"
+)
+
+var (
+	// ErrNoBlock is returned if a CIL policy does not contain a block.
+	ErrNoBlock = errors.New("policy does not contain a block")
+	// ErrUnbalanced is returned if the parentheses of a CIL policy are not
+	// balanced.
+	ErrUnbalanced = errors.New("unbalanced parentheses")
+)
+
+// UnsupportedStatement is a statement of an imported policy which cannot be
+// represented by a SelinuxProfile.
+type UnsupportedStatement struct {
+	// Line is the line of the statement in the imported policy.
+	Line int
+	// Statement is the text of the statement.
+	Statement string
+	// Reason why the statement cannot be represented.
+	Reason string
+}
+
+func (u UnsupportedStatement) String() string {
+	return fmt.Sprintf("line %d: %s: %s", u.Line, u.Statement, u.Reason)
+}
+
+// sexpr is a node of a parsed CIL policy. Atoms have no children.
+type sexpr struct {
+	atom     string
+	quoted   bool
+	children []*sexpr
+	line     int
+}
+
+func (s *sexpr) isList() bool {
+	return s.atom == "" && !s.quoted
+}
+
+func (s *sexpr) String() string {
+	if s.quoted {
+		return strconv.Quote(s.atom)
+	}
+	if !s.isList() {
+		return s.atom
+	}
+	parts := make([]string, 0, len(s.children))
+	for _, c := range s.children {
+		parts = append(parts, c.String())
+	}
+	return "(" + strings.Join(parts, " ") + ")"
+}
+
+// atoms returns the atoms of a list, or false if it contains lists.
+func (s *sexpr) atoms() ([]string, bool) {
+	if !s.isList() {
+		return nil, false
+	}
+	res := make([]string, 0, len(s.children))
+	for _, c := range s.children {
+		if c.isList() || c.quoted {
+			return nil, false
+		}
+		res = append(res, c.atom)
+	}
+	return res, true
+}
+
+// parseCIL parses the S-expressions of a CIL policy.
+func parseCIL(policy string) ([]*sexpr, error) {
+	root := &sexpr{}
+	stack := []*sexpr{root}
+	line := 1
+
+	for i := 0; i < len(policy); i++ {
+		c := policy[i]
+		top := stack[len(stack)-1]
+
+		switch {
+		case c == '\n':
+			line++
+		case c == ';':
+			for i < len(policy) && policy[i] != '\n' {
+				i++
+			}
+			i--
+		case c == '(':
+			list := &sexpr{line: line}
+			top.children = append(top.children, list)
+			stack = append(stack, list)
+		case c == ')':
+			if len(stack) == 1 {
+				return nil, fmt.Errorf("line %d: %w", line, ErrUnbalanced)
+			}
+			stack = stack[:len(stack)-1]
+		case c == '"':
+			end := strings.IndexByte(policy[i+1:], '"')
+			if end < 0 {
+				return nil, fmt.Errorf("line %d: unterminated string", line)
+			}
+			top.children = append(top.children, &sexpr{atom: policy[i+1 : i+1+end], quoted: true, line: line})
+			i += end + 1
+		case c == ' ' || c == '\t' || c == '\r':
+		default:
+			start := i
+			for i < len(policy) && !strings.ContainsRune(" \t\r\n();\"", rune(policy[i])) {
+				i++
+			}
+			top.children = append(top.children, &sexpr{atom: policy[start:i], line: line})
+			i--
+		}
+	}
+
+	if len(stack) != 1 {
+		return nil, fmt.Errorf("line %d: %w", line, ErrUnbalanced)
+	}
+	return root.children, nil
+}
+
+// profileImporter collects the structured representation of an imported
+// policy.
+type profileImporter struct {
+	spec        selxv1alpha2.SelinuxProfileSpec
+	unsupported []UnsupportedStatement
+	// selfTypes are the names referencing the process of the profile.
+	selfTypes map[string]bool
+	// types are the declared types, attributes are the attributes of the
+	// types and roles their roles.
+	types      []string
+	attributes map[string][]string
+	roles      map[string]string
+	// allows are the allow rules which are not implied by a transition.
+	allows []importedAllow
+}
+
+type importedAllow struct {
+	source, target, class string
+	perms                 []string
+	line                  int
+	statement             string
+}
+
+func newProfileImporter(selfTypes ...string) *profileImporter {
+	imp := &profileImporter{
+		selfTypes:  map[string]bool{},
+		attributes: map[string][]string{},
+		roles:      map[string]string{},
+	}
+	for _, t := range selfTypes {
+		imp.selfTypes[t] = true
+	}
+	return imp
+}
+
+func (imp *profileImporter) unsupportedStatement(line int, statement, reason string) {
+	imp.unsupported = append(imp.unsupported, UnsupportedStatement{
+		Line:      line,
+		Statement: statement,
+		Reason:    reason,
+	})
+}
+
+// CIL2Object converts a CIL policy consisting of a single block into a
+// SelinuxProfile. It is the inverse of Object2CIL. The statements which
+// cannot be represented by the profile are returned and not part of the
+// profile. The name of the profile is derived from the name of the block.
+func CIL2Object(policy string) (*selxv1alpha2.SelinuxProfile, []UnsupportedStatement, error) {
+	exprs, err := parseCIL(policy)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing CIL: %w", err)
+	}
+
+	var block *sexpr
+	unsupported := []UnsupportedStatement{}
+	for _, expr := range exprs {
+		if block == nil && expr.isList() && len(expr.children) >= 2 &&
+			expr.children[0].atom == "block" && !expr.children[1].isList() {
+			block = expr
+			continue
+		}
+		unsupported = append(unsupported, UnsupportedStatement{
+			Line:      expr.line,
+			Statement: expr.String(),
+			Reason:    "statement outside of the profile block",
+		})
+	}
+	if block == nil {
+		return nil, nil, ErrNoBlock
+	}
+
+	blockName := block.children[1].atom
+	imp := newProfileImporter(processType, blockName+"."+processType)
+	imp.unsupported = unsupported
+	for _, stmt := range block.children[2:] {
+		imp.addCILStatement(stmt)
+	}
+
+	return imp.profile(strings.ReplaceAll(blockName, "_", "-")), imp.unsupported, nil
+}
+
+func (imp *profileImporter) addCILStatement(stmt *sexpr) {
+	line, text := stmt.line, stmt.String()
+	if !stmt.isList() || len(stmt.children) == 0 || stmt.children[0].isList() {
+		imp.unsupportedStatement(line, text, "not a statement")
+		return
+	}
+
+	args := stmt.children[1:]
+	switch keyword := stmt.children[0].atom; keyword {
+	case "blockinherit":
+		if len(args) != 1 || args[0].isList() {
+			imp.unsupportedStatement(line, text, "unsupported blockinherit")
+			return
+		}
+		imp.spec.Inherit = append(imp.spec.Inherit, selxv1alpha2.PolicyRef{
+			Kind: selxv1alpha2.SystemPolicyKind,
+			Name: args[0].atom,
+		})
+
+	case "typepermissive":
+		if len(args) != 1 || !imp.selfTypes[args[0].atom] {
+			imp.unsupportedStatement(line, text, "only the process can be permissive")
+			return
+		}
+		imp.spec.Permissive = true
+
+	case "type":
+		if len(args) != 1 || args[0].isList() {
+			imp.unsupportedStatement(line, text, "unsupported type declaration")
+			return
+		}
+		imp.types = append(imp.types, args[0].atom)
+
+	case "roletype":
+		if len(args) != 2 || args[0].isList() || args[1].isList() {
+			imp.unsupportedStatement(line, text, "unsupported role")
+			return
+		}
+		if !imp.addRole(args[0].atom, args[1].atom) {
+			imp.unsupportedStatement(line, text, "only object_r and system_r roles of declared types are supported")
+		}
+
+	case "typeattributeset":
+		types, ok := listAtoms(args, 1)
+		if !ok || len(args) != 2 || args[0].isList() {
+			imp.unsupportedStatement(line, text, "unsupported attribute expression")
+			return
+		}
+		imp.addAttribute(line, text, args[0].atom, types)
+
+	case "allow":
+		imp.addCILAllow(stmt)
+
+	case "typetransition":
+		atoms, ok := listAtoms(args, -1)
+		if !ok || len(atoms) != 4 || !imp.selfTypes[atoms[0]] || atoms[2] != processType {
+			imp.unsupportedStatement(line, text, "only process transitions from the process are supported")
+			return
+		}
+		imp.spec.TypeTransitions = append(imp.spec.TypeTransitions, selxv1alpha2.TypeTransition{
+			Entrypoint: atoms[1],
+			Target:     atoms[3],
+		})
+
+	case "filecon":
+		if len(args) != 3 || !args[0].quoted || args[1].isList() {
+			imp.unsupportedStatement(line, text, "unsupported file context")
+			return
+		}
+		t, ok := objectContextType(args[2])
+		if !ok {
+			imp.unsupportedStatement(line, text, "only system_u:object_r:<type>:s0 contexts are supported")
+			return
+		}
+		imp.spec.FileContexts = append(imp.spec.FileContexts, selxv1alpha2.FileContext{
+			Path:     args[0].atom,
+			FileType: args[1].atom,
+			Type:     t,
+		})
+
+	case "portcon":
+		imp.addCILPortcon(stmt)
+
+	default:
+		imp.unsupportedStatement(line, text, fmt.Sprintf("%s statements are not supported", keyword))
+	}
+}
+
+func (imp *profileImporter) addCILAllow(stmt *sexpr) {
+	line, text := stmt.line, stmt.String()
+	args := stmt.children[1:]
+	const allowArgs = 3
+	if len(args) != allowArgs || args[0].isList() || args[1].isList() ||
+		!args[2].isList() || len(args[2].children) != 2 || args[2].children[0].isList() {
+		imp.unsupportedStatement(line, text, "unsupported allow rule")
+		return
+	}
+	perms, ok := args[2].children[1].atoms()
+	if !ok {
+		imp.unsupportedStatement(line, text, "unsupported permission expression")
+		return
+	}
+	imp.allows = append(imp.allows, importedAllow{
+		source:    args[0].atom,
+		target:    args[1].atom,
+		class:     args[2].children[0].atom,
+		perms:     perms,
+		line:      line,
+		statement: text,
+	})
+}
+
+func (imp *profileImporter) addCILPortcon(stmt *sexpr) {
+	line, text := stmt.line, stmt.String()
+	args := stmt.children[1:]
+	const portconArgs = 3
+	if len(args) != portconArgs || args[0].isList() {
+		imp.unsupportedStatement(line, text, "unsupported port context")
+		return
+	}
+
+	pc := selxv1alpha2.PortContext{Protocol: args[0].atom}
+	ports := []string{args[1].atom}
+	if args[1].isList() {
+		var ok bool
+		if ports, ok = args[1].atoms(); !ok || len(ports) != 2 {
+			imp.unsupportedStatement(line, text, "unsupported port range")
+			return
+		}
+	}
+	for i, p := range ports {
+		port, err := strconv.ParseInt(p, 10, 32)
+		if err != nil {
+			imp.unsupportedStatement(line, text, fmt.Sprintf("invalid port %s", p))
+			return
+		}
+		if i == 0 {
+			pc.Port = int32(port)
+		} else {
+			pc.EndPort = int32(port)
+		}
+	}
+
+	t, ok := objectContextType(args[2])
+	if !ok {
+		imp.unsupportedStatement(line, text, "only system_u:object_r:<type>:s0 contexts are supported")
+		return
+	}
+	pc.Type = t
+	imp.spec.PortContexts = append(imp.spec.PortContexts, pc)
+}
+
+// listAtoms returns the atoms of args, expanding the list at index
+// listIndex. A negative index does not expand any list.
+func listAtoms(args []*sexpr, listIndex int) ([]string, bool) {
+	res := []string{}
+	for i, arg := range args {
+		if i == listIndex && arg.isList() {
+			atoms, ok := arg.atoms()
+			if !ok {
+				return nil, false
+			}
+			if listIndex == len(args)-1 {
+				return atoms, true
+			}
+			res = append(res, atoms...)
+			continue
+		}
+		if arg.isList() || arg.quoted {
+			return nil, false
+		}
+		res = append(res, arg.atom)
+	}
+	if listIndex >= 0 {
+		return res[listIndex:], true
+	}
+	return res, true
+}
+
+// objectContextType returns the type of a (system_u object_r <type>
+// ((s0) (s0))) context.
+func objectContextType(ctx *sexpr) (string, bool) {
+	const contextParts = 4
+	if !ctx.isList() || len(ctx.children) != contextParts {
+		return "", false
+	}
+	if ctx.children[0].atom != "system_u" || ctx.children[1].atom != objectRole || ctx.children[2].isList() {
+		return "", false
+	}
+	if ctx.children[3].String() != "((s0) (s0))" {
+		return "", false
+	}
+	return ctx.children[2].atom, true
+}
+
+func (imp *profileImporter) addRole(role, t string) bool {
+	if role != objectRole && role != systemRole {
+		return false
+	}
+	if imp.selfTypes[t] {
+		return role == systemRole
+	}
+	for _, declared := range imp.types {
+		if declared == t {
+			imp.roles[t] = role
+			return true
+		}
+	}
+	return false
+}
+
+func (imp *profileImporter) addAttribute(line int, text, attribute string, types []string) {
+	kindAttribute := false
+	for kind, attr := range typeAttributes {
+		if attr != attribute {
+			continue
+		}
+		kindAttribute = true
+		for _, t := range types {
+			if imp.isDeclared(t) {
+				imp.attributes[t] = append(imp.attributes[t], attribute)
+				continue
+			}
+			if imp.selfTypes[t] && kind == selxv1alpha2.TypeKindProcess {
+				// The process is a domain already
+				continue
+			}
+			imp.unsupportedStatement(line, text, fmt.Sprintf("%s is not a type of the profile", t))
+			return
+		}
+	}
+	if kindAttribute {
+		return
+	}
+
+	attr := selxv1alpha2.TypeAttribute{Name: attribute}
+	for _, t := range types {
+		switch {
+		case imp.selfTypes[t]:
+			attr.Types = append(attr.Types, selxv1alpha2.AllowSelf)
+		case imp.isDeclared(t):
+			attr.Types = append(attr.Types, t)
+		default:
+			imp.unsupportedStatement(line, text, fmt.Sprintf("%s is not a type of the profile", t))
+			return
+		}
+	}
+	imp.spec.TypeAttributes = append(imp.spec.TypeAttributes, attr)
+}
+
+func (imp *profileImporter) isDeclared(t string) bool {
+	for _, declared := range imp.types {
+		if declared == t {
+			return true
+		}
+	}
+	return false
+}
+
+// profile builds the profile from the collected statements.
+func (imp *profileImporter) profile(name string) *selxv1alpha2.SelinuxProfile {
+	for _, t := range imp.types {
+		kind := selxv1alpha2.TypeKindFile
+		for _, attr := range imp.attributes[t] {
+			for k, a := range typeAttributes {
+				if a == attr {
+					kind = k
+				}
+			}
+		}
+		if imp.roles[t] == systemRole {
+			kind = selxv1alpha2.TypeKindProcess
+		}
+		imp.spec.Types = append(imp.spec.Types, selxv1alpha2.TypeDefinition{Name: t, Kind: kind})
+	}
+
+	imp.spec.Allow = selxv1alpha2.Allow{}
+	for _, allow := range imp.allows {
+		if imp.impliedByTransition(allow) {
+			continue
+		}
+		if !imp.selfTypes[allow.source] {
+			imp.unsupportedStatement(allow.line, allow.statement, "only rules with the process as source are supported")
+			continue
+		}
+
+		target := allow.target
+		if imp.selfTypes[target] || target == "self" {
+			target = selxv1alpha2.AllowSelf
+		}
+		classes, ok := imp.spec.Allow[selxv1alpha2.LabelKey(target)]
+		if !ok {
+			classes = map[selxv1alpha2.ObjectClassKey]selxv1alpha2.PermissionSet{}
+			imp.spec.Allow[selxv1alpha2.LabelKey(target)] = classes
+		}
+		classes[selxv1alpha2.ObjectClassKey(allow.class)] = append(
+			classes[selxv1alpha2.ObjectClassKey(allow.class)], allow.perms...,
+		)
+	}
+	if len(imp.spec.Allow) == 0 {
+		imp.spec.Allow = nil
+	}
+	sort.SliceStable(imp.unsupported, func(i, j int) bool {
+		return imp.unsupported[i].Line < imp.unsupported[j].Line
+	})
+
+	return &selxv1alpha2.SelinuxProfile{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "SelinuxProfile",
+			APIVersion: selxv1alpha2.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       imp.spec,
+	}
+}
+
+// impliedByTransition returns true if the allow rule is granted by one of
+// the type transitions anyway.
+func (imp *profileImporter) impliedByTransition(allow importedAllow) bool {
+	for _, tt := range imp.spec.TypeTransitions {
+		var implied []string
+		switch {
+		case imp.selfTypes[allow.source] && allow.target == tt.Entrypoint && allow.class == "file":
+			implied = []string{"execute", "getattr", "open", "read"}
+		case imp.selfTypes[allow.source] && allow.target == tt.Target && allow.class == processType:
+			implied = []string{"transition"}
+		case allow.source == tt.Target && allow.target == tt.Entrypoint && allow.class == "file":
+			implied = []string{"entrypoint", "execute", "getattr", "map", "open", "read"}
+		default:
+			continue
+		}
+		if isSubset(allow.perms, implied) {
+			return true
+		}
+	}
+	return false
+}
+
+func isSubset(items, set []string) bool {
+	for _, item := range items {
+		found := false
+		for _, s := range set {
+			if s == item {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
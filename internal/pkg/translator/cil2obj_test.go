@@ -0,0 +1,154 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package translator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+)
+
+func TestCIL2ObjectRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	profile := &selxv1alpha2.SelinuxProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "bar"},
+		Spec: selxv1alpha2.SelinuxProfileSpec{
+			Inherit: []selxv1alpha2.PolicyRef{
+				{Kind: selxv1alpha2.SystemPolicyKind, Name: "container"},
+				{Kind: selxv1alpha2.SystemPolicyKind, Name: "net_container"},
+			},
+			Permissive: true,
+			Types: []selxv1alpha2.TypeDefinition{
+				{Name: "data", Kind: selxv1alpha2.TypeKindFile},
+				{Name: "worker", Kind: selxv1alpha2.TypeKindProcess},
+				{Name: "app_port", Kind: selxv1alpha2.TypeKindPort},
+			},
+			TypeAttributes: []selxv1alpha2.TypeAttribute{
+				{Name: "container_domain", Types: []string{selxv1alpha2.AllowSelf, "worker"}},
+			},
+			Allow: selxv1alpha2.Allow{
+				"data":                 {"file": {"open", "read"}},
+				"http_port_t":          {"tcp_socket": {"name_bind"}},
+				selxv1alpha2.AllowSelf: {"capability": {"chown"}},
+			},
+			TypeTransitions: []selxv1alpha2.TypeTransition{
+				{Entrypoint: "bin_t", Target: "worker"},
+			},
+			FileContexts: []selxv1alpha2.FileContext{
+				{Path: "/var/lib/app(/.*)?", FileType: "any", Type: "data"},
+				{Path: "/usr/bin/worker", FileType: "file", Type: "bin_t"},
+			},
+			PortContexts: []selxv1alpha2.PortContext{
+				{Protocol: "tcp", Port: 9000, Type: "app_port"},
+				{Protocol: "udp", Port: 9000, EndPort: 9010, Type: "app_port"},
+			},
+		},
+	}
+
+	cil := Object2CIL([]string{"net_container"}, nil, profile)
+
+	imported, unsupported, err := CIL2Object(cil)
+	require.NoError(t, err)
+	require.Empty(t, unsupported)
+	require.Equal(t, "foo-bar", imported.GetName())
+	require.Equal(t, profile.Spec, imported.Spec)
+}
+
+func TestCIL2Object(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name                string
+		policy              string
+		wantErr             error
+		assert              func(*selxv1alpha2.SelinuxProfile)
+		expectedUnsupported []UnsupportedStatement
+	}{
+		{
+			name: "unsupported statements",
+			policy: `; imported from the node
+(block app
+    (blockinherit container)
+    (allow process var_log_t (dir (open read)))
+    (allow process process (process (signal)))
+    (allow container_runtime_t process (process (signal)))
+    (dontaudit process var_t (dir (search)))
+    (filecon "/data" any (system_u object_r data_t ((s0) (s0 c1))))
+)
+(typepermissive container_t)
+`,
+			assert: func(sp *selxv1alpha2.SelinuxProfile) {
+				require.Equal(t, "app", sp.GetName())
+				require.Equal(t, selxv1alpha2.Allow{
+					"var_log_t":            {"dir": {"open", "read"}},
+					selxv1alpha2.AllowSelf: {"process": {"signal"}},
+				}, sp.Spec.Allow)
+				require.Empty(t, sp.Spec.FileContexts)
+			},
+			expectedUnsupported: []UnsupportedStatement{
+				{
+					Line:      6,
+					Statement: "(allow container_runtime_t process (process (signal)))",
+					Reason:    "only rules with the process as source are supported",
+				},
+				{
+					Line:      7,
+					Statement: "(dontaudit process var_t (dir (search)))",
+					Reason:    "dontaudit statements are not supported",
+				},
+				{
+					Line:      8,
+					Statement: `(filecon "/data" any (system_u object_r data_t ((s0) (s0 c1))))`,
+					Reason:    "only system_u:object_r:<type>:s0 contexts are supported",
+				},
+				{
+					Line:      10,
+					Statement: "(typepermissive container_t)",
+					Reason:    "statement outside of the profile block",
+				},
+			},
+		},
+		{
+			name:    "no block",
+			policy:  "(allow container_t var_t (dir (open)))",
+			wantErr: ErrNoBlock,
+		},
+		{
+			name:    "unbalanced",
+			policy:  "(block app (allow process var_t (dir (open)))",
+			wantErr: ErrUnbalanced,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sp, unsupported, err := CIL2Object(tc.policy)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			tc.assert(sp)
+			require.Equal(t, tc.expectedUnsupported, unsupported)
+		})
+	}
+}
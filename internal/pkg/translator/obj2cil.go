@@ -59,16 +59,98 @@ func Object2CIL(
 		cilbuilder.WriteString("\n")
 	}
 
+	for _, t := range sp.Spec.Types {
+		cilbuilder.WriteString(getCILTypeLines(t))
+	}
+	for _, attr := range sp.Spec.TypeAttributes {
+		cilbuilder.WriteString(getCILTypeAttributeLine(attr))
+	}
+
 	for _, ttype := range selxv1alpha2.SortLabelKeys(sp.Spec.Allow) {
 		for _, tclass := range selxv1alpha2.SortObjectClassKeys(sp.Spec.Allow[ttype]) {
 			cilbuilder.WriteString(getCILAllowLine(sp, ttype, tclass, sp.Spec.Allow[ttype][tclass]))
 		}
 	}
 
+	for _, tt := range sp.Spec.TypeTransitions {
+		cilbuilder.WriteString(getCILTypeTransitionLines(tt))
+	}
+	for _, fc := range sp.Spec.FileContexts {
+		cilbuilder.WriteString(getCILFileconLine(fc))
+	}
+	for _, pc := range sp.Spec.PortContexts {
+		cilbuilder.WriteString(getCILPortconLine(pc))
+	}
+
 	cilbuilder.WriteString(getCILEnd())
 	return cilbuilder.String()
 }
 
+// typeRoles and typeAttributes are the role and attribute of the types
+// declared by a profile, depending on their kind.
+var (
+	typeRoles = map[selxv1alpha2.TypeKind]string{
+		selxv1alpha2.TypeKindFile:    "object_r",
+		selxv1alpha2.TypeKindPort:    "object_r",
+		selxv1alpha2.TypeKindProcess: "system_r",
+	}
+	typeAttributes = map[selxv1alpha2.TypeKind]string{
+		selxv1alpha2.TypeKindFile:    "file_type",
+		selxv1alpha2.TypeKindPort:    "port_type",
+		selxv1alpha2.TypeKindProcess: "domain",
+	}
+)
+
+func getCILTypeLines(t selxv1alpha2.TypeDefinition) string {
+	kind := t.Kind
+	if kind == "" {
+		kind = selxv1alpha2.TypeKindFile
+	}
+	return fmt.Sprintf("(type %s)\n(roletype %s %s)\n(typeattributeset %s (%s))\n",
+		t.Name, typeRoles[kind], t.Name, typeAttributes[kind], t.Name)
+}
+
+func getCILTypeAttributeLine(attr selxv1alpha2.TypeAttribute) string {
+	types := make([]string, 0, len(attr.Types))
+	for _, t := range attr.Types {
+		if t == selxv1alpha2.AllowSelf {
+			t = "process"
+		}
+		types = append(types, t)
+	}
+	return fmt.Sprintf("(typeattributeset %s (%s))\n", attr.Name, strings.Join(types, " "))
+}
+
+// getCILTypeTransitionLines renders the transition and the permissions
+// required to execute the entrypoint and to enter the target domain.
+func getCILTypeTransitionLines(tt selxv1alpha2.TypeTransition) string {
+	return fmt.Sprintf("(typetransition process %[1]s process %[2]s)\n"+
+		"(allow process %[1]s (file (execute getattr open read)))\n"+
+		"(allow process %[2]s (process (transition)))\n"+
+		"(allow %[2]s %[1]s (file (entrypoint execute getattr map open read)))\n",
+		tt.Entrypoint, tt.Target)
+}
+
+func getCILFileconLine(fc selxv1alpha2.FileContext) string {
+	fileType := fc.FileType
+	if fileType == "" {
+		fileType = "any"
+	}
+	return fmt.Sprintf("(filecon \"%s\" %s %s)\n", fc.Path, fileType, getCILObjectContext(fc.Type))
+}
+
+func getCILPortconLine(pc selxv1alpha2.PortContext) string {
+	port := fmt.Sprintf("%d", pc.Port)
+	if pc.EndPort != 0 && pc.EndPort != pc.Port {
+		port = fmt.Sprintf("(%d %d)", pc.Port, pc.EndPort)
+	}
+	return fmt.Sprintf("(portcon %s %s %s)\n", pc.Protocol, port, getCILObjectContext(pc.Type))
+}
+
+func getCILObjectContext(t string) string {
+	return fmt.Sprintf("(system_u object_r %s ((s0) (s0)))", t)
+}
+
 func getCILStart(sp *selxv1alpha2.SelinuxProfile) string {
 	return fmt.Sprintf("(block %s_%s\n", sp.GetName(), sp.GetNamespace())
 }
@@ -301,6 +301,55 @@ func TestObject2CIL(t *testing.T) {
 				"net_container",
 			},
 		},
+		{
+			name: "Test translation of types and labeling rules",
+			profile: &selxv1alpha2.SelinuxProfile{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "bar",
+				},
+				Spec: selxv1alpha2.SelinuxProfileSpec{
+					Types: []selxv1alpha2.TypeDefinition{
+						{Name: "data"},
+						{Name: "worker", Kind: selxv1alpha2.TypeKindProcess},
+						{Name: "app_port", Kind: selxv1alpha2.TypeKindPort},
+					},
+					Allow: selxv1alpha2.Allow{
+						"data": {
+							"file": []string{"read"},
+						},
+					},
+					FileContexts: []selxv1alpha2.FileContext{
+						{Path: "/var/lib/app(/.*)?", Type: "data"},
+						{Path: "/usr/bin/worker", FileType: "file", Type: "bin_t"},
+					},
+					PortContexts: []selxv1alpha2.PortContext{
+						{Protocol: "tcp", Port: 9000, Type: "app_port"},
+						{Protocol: "udp", Port: 9000, EndPort: 9010, Type: "app_port"},
+					},
+					TypeTransitions: []selxv1alpha2.TypeTransition{
+						{Entrypoint: "bin_t", Target: "worker"},
+					},
+					TypeAttributes: []selxv1alpha2.TypeAttribute{
+						{Name: "container_domain", Types: []string{"@self", "worker"}},
+					},
+				},
+			},
+			wantMatches: []string{
+				"\\(type data\\)\n\\(roletype object_r data\\)\n\\(typeattributeset file_type \\(data\\)\\)\n",
+				"\\(roletype system_r worker\\)\n\\(typeattributeset domain \\(worker\\)\\)\n",
+				"\\(typeattributeset port_type \\(app_port\\)\\)\n",
+				"\\(typeattributeset container_domain \\(process worker\\)\\)\n",
+				"\\(allow process data \\( file \\( read \\)\\)\\)\n",
+				"\\(typetransition process bin_t process worker\\)\n",
+				"\\(allow process worker \\(process \\(transition\\)\\)\\)\n",
+				"\\(allow worker bin_t \\(file \\(entrypoint .*\\)\\)\\)\n",
+				"\\(filecon \"/var/lib/app\\(/\\.\\*\\)\\?\" any \\(system_u object_r data \\(\\(s0\\) \\(s0\\)\\)\\)\\)\n",
+				"\\(filecon \"/usr/bin/worker\" file \\(system_u object_r bin_t ",
+				"\\(portcon tcp 9000 \\(system_u object_r app_port ",
+				"\\(portcon udp \\(9000 9010\\) \\(system_u object_r app_port ",
+			},
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
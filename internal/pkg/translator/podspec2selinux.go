@@ -0,0 +1,357 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package translator
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+)
+
+// The policy templates picked when generating a profile from a pod spec.
+// They are shipped by udica and have to be allowed in the
+// selinuxOptions.allowedSystemProfiles of the SPOD.
+const (
+	TemplateNetContainer    = "net_container"
+	TemplateHomeContainer   = "home_container"
+	TemplateLogContainer    = "log_container"
+	TemplateTTYContainer    = "tty_container"
+	TemplateConfigContainer = "config_container"
+	TemplateXContainer      = "x_container"
+	TemplateVirtContainer   = "virt_container"
+)
+
+const (
+	classDir      = "dir"
+	classFile     = "file"
+	classLnkFile  = "lnk_file"
+	classSockFile = "sock_file"
+	classFifoFile = "fifo_file"
+
+	classCapability  = "capability"
+	classCapability2 = "capability2"
+	capabilityAll    = "ALL"
+
+	permNameBind = "name_bind"
+
+	// defaultFileType is the type of host paths without a known label.
+	defaultFileType = "default_t"
+
+	reservedPortType   = "reserved_port_t"
+	unreservedPortType = "unreserved_port_t"
+	maxReservedPort    = 1023
+)
+
+// templatePaths are host paths which are covered by a policy template
+// instead of explicit allow rules.
+var templatePaths = []struct {
+	path     string
+	template string
+}{
+	{"/home", TemplateHomeContainer},
+	{"/var/log", TemplateLogContainer},
+	{"/etc", TemplateConfigContainer},
+	{"/tmp/.X11-unix", TemplateXContainer},
+	{"/dev/kvm", TemplateVirtContainer},
+}
+
+// defaultFileContexts are the types of well known host paths from the
+// default targeted policy. The longest matching prefix wins.
+var defaultFileContexts = map[string]string{
+	"/":                   "root_t",
+	"/dev":                "device_t",
+	"/mnt":                "mnt_t",
+	"/opt":                "usr_t",
+	"/proc":               "proc_t",
+	"/run":                "var_run_t",
+	"/srv":                "var_t",
+	"/sys":                "sysfs_t",
+	"/tmp":                "tmp_t",
+	"/usr":                "usr_t",
+	"/var":                "var_t",
+	"/var/cache":          "var_t",
+	"/var/lib":            "var_lib_t",
+	"/var/lib/kubelet":    "container_var_lib_t",
+	"/var/lib/docker":     "container_var_lib_t",
+	"/var/lib/containers": "container_var_lib_t",
+	"/var/run":            "var_run_t",
+	"/var/spool":          "var_spool_t",
+	"/var/tmp":            "tmp_t",
+}
+
+// knownPorts are the types of well known ports from the default targeted
+// policy.
+var knownPorts = map[int32]string{
+	22:    "ssh_port_t",
+	25:    "smtp_port_t",
+	53:    "dns_port_t",
+	80:    "http_port_t",
+	389:   "ldap_port_t",
+	443:   "http_port_t",
+	2379:  "etcd_port_t",
+	3306:  "mysqld_port_t",
+	5432:  "postgresql_port_t",
+	6379:  "redis_port_t",
+	8080:  "http_cache_port_t",
+	8443:  "http_port_t",
+	9090:  "websm_port_t",
+	11211: "memcache_port_t",
+	27017: "mongod_port_t",
+}
+
+// capability2 contains the capabilities which belong to the capability2
+// object class because their number does not fit into the capability class.
+var capability2 = sets.New(
+	"mac_override", "mac_admin", "syslog", "wake_alarm", "block_suspend",
+	"audit_read", "perfmon", "bpf", "checkpoint_restore",
+)
+
+// allCapabilities are the capabilities granted by adding "ALL".
+var allCapabilities = []string{
+	"chown", "dac_override", "dac_read_search", "fowner", "fsetid", "kill",
+	"setgid", "setuid", "setpcap", "linux_immutable", "net_bind_service",
+	"net_broadcast", "net_admin", "net_raw", "ipc_lock", "ipc_owner",
+	"sys_module", "sys_rawio", "sys_chroot", "sys_ptrace", "sys_pacct",
+	"sys_admin", "sys_boot", "sys_nice", "sys_resource", "sys_time",
+	"sys_tty_config", "mknod", "lease", "audit_write", "audit_control",
+	"setfcap", "mac_override", "mac_admin", "syslog", "wake_alarm",
+	"block_suspend", "audit_read", "perfmon", "bpf", "checkpoint_restore",
+}
+
+var (
+	readWritePerms = map[string][]string{
+		classDir: {
+			"add_name", "create", "getattr", "ioctl", "lock", "open", "read",
+			"remove_name", "rmdir", "search", "setattr", "write",
+		},
+		classFile: {
+			"append", "create", "getattr", "ioctl", "lock", "map", "open",
+			"read", "rename", "setattr", "unlink", "write",
+		},
+		classFifoFile: {
+			"append", "getattr", "ioctl", "lock", "open", "read", "write",
+		},
+		classLnkFile: {
+			"append", "create", "getattr", "ioctl", "lock", "read", "rename",
+			"setattr", "unlink", "write",
+		},
+		classSockFile: {
+			"append", "getattr", "open", "read", "write",
+		},
+	}
+	readOnlyPerms = map[string][]string{
+		classDir:      {"getattr", "ioctl", "lock", "open", "read", "search"},
+		classFile:     {"getattr", "ioctl", "lock", "open", "read"},
+		classFifoFile: {"getattr", "open", "read"},
+		classLnkFile:  {"getattr", "read"},
+		classSockFile: {"getattr", "open", "read"},
+	}
+)
+
+// PodSpec2Selinux derives a starting SelinuxProfileSpec from a pod spec, the
+// same way udica does for a single container. Host path mounts are mapped to
+// file permissions, container ports to name_bind permissions and added
+// capabilities to capability permissions. The policy templates get picked
+// from the mounts, ports and terminal settings. Only the provided containers
+// are taken into account, or all containers if none is provided.
+// fileContexts overrides the type of host paths whose label differs from
+// the default targeted policy.
+func PodSpec2Selinux(
+	spec *corev1.PodSpec,
+	containers []string,
+	fileContexts map[string]string,
+) selxv1alpha2.SelinuxProfileSpec {
+	builder := &podSpecBuilder{
+		allow:        selxv1alpha2.Allow{},
+		templates:    sets.New[string](),
+		fileContexts: fileContexts,
+	}
+
+	if spec.HostNetwork {
+		builder.templates.Insert(TemplateNetContainer)
+	}
+
+	hostPaths := map[string]*corev1.HostPathVolumeSource{}
+	for i := range spec.Volumes {
+		if spec.Volumes[i].HostPath != nil {
+			hostPaths[spec.Volumes[i].Name] = spec.Volumes[i].HostPath
+		}
+	}
+
+	selected := sets.New(containers...)
+	allContainers := append(
+		append([]corev1.Container{}, spec.InitContainers...), spec.Containers...,
+	)
+	for i := range allContainers {
+		ctr := &allContainers[i]
+		if selected.Len() > 0 && !selected.Has(ctr.Name) {
+			continue
+		}
+		builder.addContainer(ctr, hostPaths)
+	}
+
+	res := selxv1alpha2.SelinuxProfileSpec{
+		Inherit: []selxv1alpha2.PolicyRef{{
+			Kind: "System",
+			Name: systemContainerInherit,
+		}},
+		Allow: builder.allow,
+	}
+
+	templates := builder.templates.UnsortedList()
+	sort.Strings(templates)
+	for _, template := range templates {
+		res.Inherit = append(res.Inherit, selxv1alpha2.PolicyRef{
+			Kind: "System",
+			Name: template,
+		})
+	}
+
+	return res
+}
+
+type podSpecBuilder struct {
+	allow        selxv1alpha2.Allow
+	templates    sets.Set[string]
+	fileContexts map[string]string
+}
+
+func (b *podSpecBuilder) addContainer(
+	ctr *corev1.Container, hostPaths map[string]*corev1.HostPathVolumeSource,
+) {
+	if ctr.TTY || ctr.Stdin {
+		b.templates.Insert(TemplateTTYContainer)
+	}
+
+	for _, mount := range ctr.VolumeMounts {
+		hostPath, ok := hostPaths[mount.Name]
+		if !ok {
+			continue
+		}
+		b.addHostPath(filepath.Clean(hostPath.Path), mount.ReadOnly)
+	}
+
+	for _, port := range ctr.Ports {
+		b.templates.Insert(TemplateNetContainer)
+		b.addPort(port)
+	}
+
+	if ctr.SecurityContext != nil && ctr.SecurityContext.Capabilities != nil {
+		for _, capability := range ctr.SecurityContext.Capabilities.Add {
+			b.addCapability(string(capability))
+		}
+	}
+}
+
+func (b *podSpecBuilder) addHostPath(path string, readOnly bool) {
+	for _, tp := range templatePaths {
+		if pathHasPrefix(path, tp.path) {
+			b.templates.Insert(tp.template)
+			return
+		}
+	}
+
+	perms := readWritePerms
+	if readOnly {
+		perms = readOnlyPerms
+	}
+
+	fileType := b.fileType(path)
+	for class, classPerms := range perms {
+		b.addPerms(fileType, class, classPerms...)
+	}
+}
+
+// fileType returns the type of the longest matching path prefix, preferring
+// the user provided contexts over the default ones.
+func (b *podSpecBuilder) fileType(path string) string {
+	for _, contexts := range []map[string]string{b.fileContexts, defaultFileContexts} {
+		match := ""
+		for prefix := range contexts {
+			if pathHasPrefix(path, prefix) && len(prefix) > len(match) {
+				match = prefix
+			}
+		}
+		if match != "" {
+			return contexts[match]
+		}
+	}
+	return defaultFileType
+}
+
+func (b *podSpecBuilder) addPort(port corev1.ContainerPort) {
+	class := "tcp_socket"
+	switch port.Protocol {
+	case corev1.ProtocolUDP:
+		class = "udp_socket"
+	case corev1.ProtocolSCTP:
+		class = "sctp_socket"
+	case corev1.ProtocolTCP:
+	}
+
+	portType, ok := knownPorts[port.ContainerPort]
+	if !ok {
+		portType = unreservedPortType
+		if port.ContainerPort <= maxReservedPort {
+			portType = reservedPortType
+		}
+	}
+
+	b.addPerms(portType, class, permNameBind)
+}
+
+func (b *podSpecBuilder) addCapability(capability string) {
+	capability = strings.TrimPrefix(strings.ToUpper(capability), "CAP_")
+	caps := []string{strings.ToLower(capability)}
+	if capability == capabilityAll {
+		caps = allCapabilities
+	}
+
+	for _, c := range caps {
+		class := classCapability
+		if capability2.Has(c) {
+			class = classCapability2
+		}
+		b.addPerms(selxv1alpha2.AllowSelf, class, c)
+	}
+}
+
+func (b *podSpecBuilder) addPerms(label, class string, perms ...string) {
+	classes, ok := b.allow[selxv1alpha2.LabelKey(label)]
+	if !ok {
+		classes = map[selxv1alpha2.ObjectClassKey]selxv1alpha2.PermissionSet{}
+		b.allow[selxv1alpha2.LabelKey(label)] = classes
+	}
+
+	merged := sets.New(classes[selxv1alpha2.ObjectClassKey(class)]...).Insert(perms...)
+	sortedPerms := merged.UnsortedList()
+	sort.Strings(sortedPerms)
+	classes[selxv1alpha2.ObjectClassKey(class)] = sortedPerms
+}
+
+// pathHasPrefix returns true if path is prefix or located below it.
+func pathHasPrefix(path, prefix string) bool {
+	if prefix == "/" || path == prefix {
+		return true
+	}
+	return strings.HasPrefix(path, strings.TrimSuffix(prefix, "/")+"/")
+}
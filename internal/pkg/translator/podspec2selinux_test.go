@@ -0,0 +1,185 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package translator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+)
+
+func TestPodSpec2Selinux(t *testing.T) {
+	t.Parallel()
+
+	hostPath := func(name, path string) corev1.Volume {
+		return corev1.Volume{
+			Name: name,
+			VolumeSource: corev1.VolumeSource{
+				HostPath: &corev1.HostPathVolumeSource{Path: path},
+			},
+		}
+	}
+
+	inherits := func(names ...string) []selxv1alpha2.PolicyRef {
+		res := []selxv1alpha2.PolicyRef{}
+		for _, name := range names {
+			res = append(res, selxv1alpha2.PolicyRef{Kind: "System", Name: name})
+		}
+		return res
+	}
+
+	for _, tc := range []struct {
+		name         string
+		spec         *corev1.PodSpec
+		containers   []string
+		fileContexts map[string]string
+		assert       func(selxv1alpha2.SelinuxProfileSpec)
+	}{
+		{
+			name: "plain container",
+			spec: &corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "app"}},
+			},
+			assert: func(spec selxv1alpha2.SelinuxProfileSpec) {
+				require.Equal(t, inherits("container"), spec.Inherit)
+				require.Empty(t, spec.Allow)
+			},
+		},
+		{
+			name: "host path mounts",
+			spec: &corev1.PodSpec{
+				Volumes: []corev1.Volume{
+					hostPath("data", "/srv/data/"),
+					hostPath("lib", "/var/lib/app"),
+					hostPath("logs", "/var/log/app"),
+					{Name: "scratch", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+				},
+				Containers: []corev1.Container{{
+					Name: "app",
+					VolumeMounts: []corev1.VolumeMount{
+						{Name: "data", MountPath: "/data"},
+						{Name: "lib", MountPath: "/lib", ReadOnly: true},
+						{Name: "logs", MountPath: "/logs"},
+						{Name: "scratch", MountPath: "/scratch"},
+					},
+				}},
+			},
+			assert: func(spec selxv1alpha2.SelinuxProfileSpec) {
+				require.Equal(t, inherits("container", TemplateLogContainer), spec.Inherit)
+				require.Len(t, spec.Allow, 2)
+				require.Contains(t, spec.Allow["var_t"][classDir], "add_name")
+				require.Contains(t, spec.Allow["var_t"][classFile], "write")
+				require.Equal(t, selxv1alpha2.PermissionSet(readOnlyPerms[classFile]), spec.Allow["var_lib_t"][classFile])
+			},
+		},
+		{
+			name: "file context override",
+			spec: &corev1.PodSpec{
+				Volumes: []corev1.Volume{hostPath("data", "/data/app")},
+				Containers: []corev1.Container{{
+					Name:         "app",
+					VolumeMounts: []corev1.VolumeMount{{Name: "data", MountPath: "/data", ReadOnly: true}},
+				}},
+			},
+			fileContexts: map[string]string{"/data": "container_file_t"},
+			assert: func(spec selxv1alpha2.SelinuxProfileSpec) {
+				require.Contains(t, spec.Allow, selxv1alpha2.LabelKey("container_file_t"))
+			},
+		},
+		{
+			name: "ports and capabilities",
+			spec: &corev1.PodSpec{
+				Containers: []corev1.Container{{
+					Name: "app",
+					Ports: []corev1.ContainerPort{
+						{ContainerPort: 80},
+						{ContainerPort: 8081, Protocol: corev1.ProtocolUDP},
+						{ContainerPort: 999, Protocol: corev1.ProtocolTCP},
+					},
+					SecurityContext: &corev1.SecurityContext{
+						Capabilities: &corev1.Capabilities{
+							Add: []corev1.Capability{"NET_ADMIN", "CAP_SYS_TIME", "bpf"},
+						},
+					},
+				}},
+			},
+			assert: func(spec selxv1alpha2.SelinuxProfileSpec) {
+				require.Equal(t, inherits("container", TemplateNetContainer), spec.Inherit)
+				require.Equal(t, selxv1alpha2.PermissionSet{permNameBind}, spec.Allow["http_port_t"]["tcp_socket"])
+				require.Equal(t, selxv1alpha2.PermissionSet{permNameBind}, spec.Allow["unreserved_port_t"]["udp_socket"])
+				require.Equal(t, selxv1alpha2.PermissionSet{permNameBind}, spec.Allow["reserved_port_t"]["tcp_socket"])
+				require.Equal(t,
+					selxv1alpha2.PermissionSet{"net_admin", "sys_time"},
+					spec.Allow[selxv1alpha2.AllowSelf][classCapability],
+				)
+				require.Equal(t,
+					selxv1alpha2.PermissionSet{"bpf"},
+					spec.Allow[selxv1alpha2.AllowSelf][classCapability2],
+				)
+			},
+		},
+		{
+			name: "selected containers and templates",
+			spec: &corev1.PodSpec{
+				Volumes: []corev1.Volume{hostPath("home", "/home/user")},
+				InitContainers: []corev1.Container{{
+					Name:  "init",
+					Ports: []corev1.ContainerPort{{ContainerPort: 22}},
+				}},
+				Containers: []corev1.Container{{
+					Name:         "shell",
+					TTY:          true,
+					VolumeMounts: []corev1.VolumeMount{{Name: "home", MountPath: "/home"}},
+				}},
+			},
+			containers: []string{"shell"},
+			assert: func(spec selxv1alpha2.SelinuxProfileSpec) {
+				require.Equal(t,
+					inherits("container", TemplateHomeContainer, TemplateTTYContainer),
+					spec.Inherit,
+				)
+				require.Empty(t, spec.Allow)
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			tc.assert(PodSpec2Selinux(tc.spec, tc.containers, tc.fileContexts))
+		})
+	}
+}
+
+func TestFileType(t *testing.T) {
+	t.Parallel()
+
+	b := &podSpecBuilder{fileContexts: map[string]string{"/var/lib/app": "app_var_lib_t"}}
+	for path, want := range map[string]string{
+		"/var/lib/containers":                 "container_var_lib_t",
+		"/var/lib/containers/storage/overlay": "container_var_lib_t",
+		"/var/lib/container":                  "var_lib_t",
+		"/var/lib/kubelet/pods":               "container_var_lib_t",
+		"/var/lib/app/data":                   "app_var_lib_t",
+		"/var/log":                            "var_t",
+		"/data":                               "root_t",
+	} {
+		require.Equal(t, want, b.fileType(path), path)
+	}
+}
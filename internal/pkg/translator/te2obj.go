@@ -0,0 +1,395 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package translator
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+)
+
+// ErrNoProcessType is returned if the process type of a type enforcement
+// module cannot be determined.
+var ErrNoProcessType = errors.New("unable to determine the process type of the module")
+
+const tePunctuation = "{};:,()"
+
+// teBlockKeywords are the statements which end with a { } block instead of
+// a semicolon.
+var teBlockKeywords = map[string]bool{
+	"require":  true,
+	"optional": true,
+	"if":       true,
+	"else":     true,
+}
+
+type teToken struct {
+	text        string
+	line        int
+	start, end  int
+	punctuation bool
+}
+
+// teStatement is a statement of a type enforcement module.
+type teStatement struct {
+	tokens []teToken
+	text   string
+}
+
+func (s *teStatement) line() int {
+	return s.tokens[0].line
+}
+
+func (s *teStatement) keyword() string {
+	return s.tokens[0].text
+}
+
+// tokenizeTE splits a type enforcement module into tokens.
+func tokenizeTE(module string) []teToken {
+	tokens := []teToken{}
+	line := 1
+	for i := 0; i < len(module); i++ {
+		c := module[i]
+		switch {
+		case c == '\n':
+			line++
+		case c == '#':
+			for i < len(module) && module[i] != '\n' {
+				i++
+			}
+			i--
+		case c == ' ' || c == '\t' || c == '\r':
+		case strings.IndexByte(tePunctuation, c) >= 0:
+			tokens = append(tokens, teToken{
+				text: string(c), line: line, start: i, end: i + 1, punctuation: true,
+			})
+		default:
+			start := i
+			for i < len(module) && !strings.ContainsRune(" \t\r\n#"+tePunctuation, rune(module[i])) {
+				i++
+			}
+			tokens = append(tokens, teToken{text: module[start:i], line: line, start: start, end: i})
+			i--
+		}
+	}
+	return tokens
+}
+
+// splitTE groups the tokens of a type enforcement module into statements.
+// Statements end with a semicolon, except for blocks like require and
+// conditionals, and for macro calls, which end with their closing brace or
+// parenthesis.
+func splitTE(module string) ([]*teStatement, error) {
+	tokens := tokenizeTE(module)
+	statements := []*teStatement{}
+
+	for i := 0; i < len(tokens); {
+		start := i
+		switch {
+		case teBlockKeywords[tokens[i].text]:
+			end, err := skipBlock(tokens, i, "{", "}")
+			if err != nil {
+				return nil, err
+			}
+			i = end
+		case i+1 < len(tokens) && tokens[i+1].text == "(":
+			end, err := skipBlock(tokens, i, "(", ")")
+			if err != nil {
+				return nil, err
+			}
+			i = end
+			if i < len(tokens) && tokens[i].text == ";" {
+				i++
+			}
+		default:
+			for i < len(tokens) && tokens[i].text != ";" {
+				i++
+			}
+			if i == len(tokens) {
+				return nil, fmt.Errorf("line %d: missing semicolon", tokens[start].line)
+			}
+			i++
+		}
+
+		stmt := &teStatement{tokens: tokens[start:i]}
+		stmt.text = strings.Join(strings.Fields(module[tokens[start].start:tokens[i-1].end]), " ")
+		if stmt.tokens[len(stmt.tokens)-1].text == ";" {
+			stmt.tokens = stmt.tokens[:len(stmt.tokens)-1]
+		}
+		if len(stmt.tokens) == 0 {
+			// Empty statement like a duplicated semicolon
+			continue
+		}
+		statements = append(statements, stmt)
+	}
+
+	return statements, nil
+}
+
+// skipBlock returns the index after the first balanced open/closing block
+// following index i.
+func skipBlock(tokens []teToken, i int, open, closing string) (int, error) {
+	start := i
+	for i < len(tokens) && tokens[i].text != open {
+		i++
+	}
+	depth := 0
+	for ; i < len(tokens); i++ {
+		switch tokens[i].text {
+		case open:
+			depth++
+		case closing:
+			depth--
+		}
+		if depth == 0 {
+			return i + 1, nil
+		}
+	}
+	return 0, fmt.Errorf("line %d: unterminated %s", tokens[start].line, open)
+}
+
+// TE2Object converts a simple type enforcement module into a
+// SelinuxProfile. The process of the profile is the type named after the
+// module with a _t suffix or, if not declared, the first declared type which
+// is the source of an allow rule. Require blocks are skipped, because the
+// profile references the types of the system policy directly. The statements
+// which cannot be represented by the profile are returned and not part of
+// the profile.
+func TE2Object(module string) (*selxv1alpha2.SelinuxProfile, []UnsupportedStatement, error) {
+	statements, err := splitTE(module)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing type enforcement module: %w", err)
+	}
+
+	name := ""
+	declared := map[string]bool{}
+	allowSources := []string{}
+	for _, stmt := range statements {
+		switch tokens := stmt.tokens; {
+		case stmt.keyword() == "module" && len(tokens) >= 2:
+			name = tokens[1].text
+		case stmt.keyword() == "policy_module" && len(tokens) >= 3:
+			name = tokens[2].text
+		case stmt.keyword() == "type" && len(tokens) >= 2:
+			declared[tokens[1].text] = true
+		case stmt.keyword() == "allow" && len(tokens) >= 2:
+			allowSources = append(allowSources, tokens[1].text)
+		}
+	}
+
+	process := name + "_t"
+	if !declared[process] {
+		process = ""
+		for _, source := range allowSources {
+			if declared[source] {
+				process = source
+				break
+			}
+		}
+	}
+	if process == "" {
+		return nil, nil, ErrNoProcessType
+	}
+	if name == "" {
+		name = strings.TrimSuffix(process, "_t")
+	}
+
+	imp := newProfileImporter(process)
+	imp.spec.Inherit = []selxv1alpha2.PolicyRef{{
+		Kind: selxv1alpha2.SystemPolicyKind,
+		Name: systemContainerInherit,
+	}}
+	for _, stmt := range statements {
+		imp.addTEStatement(stmt)
+	}
+
+	return imp.profile(strings.ReplaceAll(name, "_", "-")), imp.unsupported, nil
+}
+
+func (imp *profileImporter) addTEStatement(stmt *teStatement) {
+	line, text := stmt.line(), stmt.text
+	args := stmt.tokens[1:]
+
+	switch keyword := stmt.keyword(); keyword {
+	case "module", "policy_module", "require":
+
+	case "type":
+		names, ok := teList(args, ",")
+		if !ok || len(names) == 0 {
+			imp.unsupportedStatement(line, text, "unsupported type declaration")
+			return
+		}
+		if !imp.selfTypes[names[0]] {
+			imp.types = append(imp.types, names[0])
+		}
+		for _, attr := range names[1:] {
+			imp.addAttribute(line, text, attr, names[:1])
+		}
+
+	case "typeattribute":
+		names, ok := teList(args, ",")
+		if !ok || len(names) < 2 {
+			imp.unsupportedStatement(line, text, "unsupported type attribute")
+			return
+		}
+		for _, attr := range names[1:] {
+			imp.addAttribute(line, text, attr, names[:1])
+		}
+
+	case "role":
+		const roleArgs = 3
+		if len(args) < roleArgs || args[1].text != "types" {
+			imp.unsupportedStatement(line, text, "unsupported role statement")
+			return
+		}
+		types, ok := teSet(args[2:])
+		if !ok {
+			imp.unsupportedStatement(line, text, "unsupported role statement")
+			return
+		}
+		for _, t := range types {
+			if !imp.addRole(args[0].text, t) {
+				imp.unsupportedStatement(line, text, "only object_r and system_r roles of declared types are supported")
+				return
+			}
+		}
+
+	case "permissive":
+		if len(args) != 1 || !imp.selfTypes[args[0].text] {
+			imp.unsupportedStatement(line, text, "only the process can be permissive")
+			return
+		}
+		imp.spec.Permissive = true
+
+	case "allow":
+		imp.addTEAllow(stmt)
+
+	case "type_transition":
+		const transitionArgs = 5
+		if len(args) != transitionArgs || args[2].text != ":" ||
+			!imp.selfTypes[args[0].text] || args[3].text != processType {
+			imp.unsupportedStatement(line, text, "only process transitions from the process are supported")
+			return
+		}
+		imp.spec.TypeTransitions = append(imp.spec.TypeTransitions, selxv1alpha2.TypeTransition{
+			Entrypoint: args[1].text,
+			Target:     args[4].text,
+		})
+
+	default:
+		reason := fmt.Sprintf("%s statements are not supported", keyword)
+		if len(args) > 0 && args[0].text == "(" && !teBlockKeywords[keyword] {
+			reason = "interface calls are not supported"
+		}
+		imp.unsupportedStatement(line, text, reason)
+	}
+}
+
+// addTEAllow adds an allow rule in the format
+// allow source targets:classes permissions.
+func (imp *profileImporter) addTEAllow(stmt *teStatement) {
+	line, text := stmt.line(), stmt.text
+	args := stmt.tokens[1:]
+
+	colon := -1
+	for i, arg := range args {
+		if arg.text == ":" {
+			colon = i
+			break
+		}
+	}
+	if len(args) == 0 || args[0].punctuation || (colon != 2 && (colon < 2 || args[1].text != "{")) ||
+		colon == len(args)-1 {
+		imp.unsupportedStatement(line, text, "unsupported allow rule")
+		return
+	}
+
+	targets, ok := teSet(args[1:colon])
+	if !ok {
+		imp.unsupportedStatement(line, text, "unsupported target expression")
+		return
+	}
+	rest := args[colon+1:]
+	classEnd := 1
+	if len(rest) > 0 && rest[0].text == "{" {
+		for classEnd < len(rest) && rest[classEnd-1].text != "}" {
+			classEnd++
+		}
+	}
+	classes, ok := teSet(rest[:classEnd])
+	if !ok {
+		imp.unsupportedStatement(line, text, "unsupported class expression")
+		return
+	}
+	perms, ok := teSet(rest[classEnd:])
+	if !ok {
+		imp.unsupportedStatement(line, text, "unsupported permission expression")
+		return
+	}
+
+	for _, target := range targets {
+		for _, class := range classes {
+			imp.allows = append(imp.allows, importedAllow{
+				source:    args[0].text,
+				target:    target,
+				class:     class,
+				perms:     perms,
+				line:      line,
+				statement: text,
+			})
+		}
+	}
+}
+
+// teSet returns the names of a single name or a { name ... } set. Negations,
+// wildcards and complements are not supported.
+func teSet(tokens []teToken) ([]string, bool) {
+	if len(tokens) == 1 {
+		tokens = []teToken{{text: "{"}, tokens[0], {text: "}"}}
+	}
+	if len(tokens) < 3 || tokens[0].text != "{" || tokens[len(tokens)-1].text != "}" {
+		return nil, false
+	}
+	res := []string{}
+	for _, token := range tokens[1 : len(tokens)-1] {
+		if token.punctuation || strings.ContainsAny(token.text, "*~-") {
+			return nil, false
+		}
+		res = append(res, token.text)
+	}
+	return res, true
+}
+
+// teList returns the names of a comma separated list.
+func teList(tokens []teToken, separator string) ([]string, bool) {
+	res := []string{}
+	for i, token := range tokens {
+		if i%2 == 1 {
+			if token.text != separator {
+				return nil, false
+			}
+			continue
+		}
+		if token.punctuation {
+			return nil, false
+		}
+		res = append(res, token.text)
+	}
+	return res, len(tokens)%2 == 1
+}
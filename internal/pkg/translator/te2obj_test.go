@@ -0,0 +1,153 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package translator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	selxv1alpha2 "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+)
+
+func TestTE2Object(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name                string
+		module              string
+		wantErr             error
+		assert              func(*selxv1alpha2.SelinuxProfile)
+		expectedUnsupported []UnsupportedStatement
+	}{
+		{
+			name: "simple module",
+			module: `policy_module(my_app, 1.0.0)
+
+require {
+	type var_log_t;
+	type bin_t;
+	class file { open read write };
+}
+
+# the application and its data
+type my_app_t, domain, container_domain;
+type my_app_data_t;
+type my_app_worker_t;
+role system_r types { my_app_t my_app_worker_t };
+
+allow my_app_t var_log_t:file { open read write };
+allow my_app_t { my_app_data_t var_log_t }:{ file dir } getattr;
+allow my_app_t self:capability { chown setuid };
+type_transition my_app_t bin_t:process my_app_worker_t;
+allow my_app_t my_app_worker_t:process transition;
+permissive my_app_t;
+`,
+			assert: func(sp *selxv1alpha2.SelinuxProfile) {
+				require.Equal(t, "my-app", sp.GetName())
+				require.Equal(t, selxv1alpha2.SelinuxProfileSpec{
+					Inherit: []selxv1alpha2.PolicyRef{
+						{Kind: selxv1alpha2.SystemPolicyKind, Name: "container"},
+					},
+					Permissive: true,
+					Types: []selxv1alpha2.TypeDefinition{
+						{Name: "my_app_data_t", Kind: selxv1alpha2.TypeKindFile},
+						{Name: "my_app_worker_t", Kind: selxv1alpha2.TypeKindProcess},
+					},
+					TypeAttributes: []selxv1alpha2.TypeAttribute{
+						{Name: "container_domain", Types: []string{selxv1alpha2.AllowSelf}},
+					},
+					Allow: selxv1alpha2.Allow{
+						"var_log_t": {
+							"file": {"open", "read", "write", "getattr"},
+							"dir":  {"getattr"},
+						},
+						"my_app_data_t": {
+							"file": {"getattr"},
+							"dir":  {"getattr"},
+						},
+						selxv1alpha2.AllowSelf: {"capability": {"chown", "setuid"}},
+					},
+					TypeTransitions: []selxv1alpha2.TypeTransition{
+						{Entrypoint: "bin_t", Target: "my_app_worker_t"},
+					},
+				}, sp.Spec)
+			},
+		},
+		{
+			name: "unsupported statements",
+			module: `module app 1.0;
+
+type app_t;
+attribute app_domain;
+allow app_t var_t:dir *;
+dontaudit app_t var_t:dir search;
+logging_send_syslog_msg(app_t)
+if (app_use_nfs) {
+	allow app_t nfs_t:file read;
+}
+`,
+			assert: func(sp *selxv1alpha2.SelinuxProfile) {
+				require.Equal(t, "app", sp.GetName())
+				require.Empty(t, sp.Spec.Allow)
+			},
+			expectedUnsupported: []UnsupportedStatement{
+				{Line: 4, Statement: "attribute app_domain;", Reason: "attribute statements are not supported"},
+				{Line: 5, Statement: "allow app_t var_t:dir *;", Reason: "unsupported permission expression"},
+				{Line: 6, Statement: "dontaudit app_t var_t:dir search;", Reason: "dontaudit statements are not supported"},
+				{Line: 7, Statement: "logging_send_syslog_msg(app_t)", Reason: "interface calls are not supported"},
+				{
+					Line:      8,
+					Statement: "if (app_use_nfs) { allow app_t nfs_t:file read; }",
+					Reason:    "if statements are not supported",
+				},
+			},
+		},
+		{
+			name:   "malformed statements",
+			module: "module foo 1.0;\ntype foo_t;\nallow foo_t self:file read;;\nallow;\nallow foo_t var_t:;\n",
+			assert: func(sp *selxv1alpha2.SelinuxProfile) {
+				require.Equal(t, selxv1alpha2.Allow{
+					selxv1alpha2.AllowSelf: {"file": {"read"}},
+				}, sp.Spec.Allow)
+			},
+			expectedUnsupported: []UnsupportedStatement{
+				{Line: 4, Statement: "allow;", Reason: "unsupported allow rule"},
+				{Line: 5, Statement: "allow foo_t var_t:;", Reason: "unsupported allow rule"},
+			},
+		},
+		{
+			name:    "no process type",
+			module:  "module app 1.0;\nallow other_t var_t:dir search;\n",
+			wantErr: ErrNoProcessType,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sp, unsupported, err := TE2Object(tc.module)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			tc.assert(sp)
+			require.Equal(t, tc.expectedUnsupported, unsupported)
+		})
+	}
+}
@@ -20,10 +20,14 @@ import (
 	"bufio"
 	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strconv"
+	"strings"
+	"syscall"
 
 	"github.com/jellydator/ttlcache/v3"
 )
@@ -80,3 +84,73 @@ func ContainerIDForPID(cache *ttlcache.Cache[string, string], pid int) (string,
 
 	return "", ErrContainerIDNotFound
 }
+
+// ContainerIDsForCgroups walks the cgroup v2 hierarchy below root and returns
+// the 64 digit container IDs indexed by the ID of their cgroups. The cgroup ID
+// is the inode number of the cgroup directory, which matches the result of
+// the bpf_get_current_cgroup_id() eBPF helper.
+func ContainerIDsForCgroups(root string) (map[uint64]string, error) {
+	res := map[uint64]string{}
+	if err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// Cgroups can be removed while walking the hierarchy.
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+
+		containerIDs := ContainerIDRegex.FindAllString(path, -1)
+		if len(containerIDs) == 0 {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return nil //nolint:nilerr // the cgroup has been removed in the meantime
+		}
+		stat, ok := info.Sys().(*syscall.Stat_t)
+		if !ok {
+			return fmt.Errorf("unable to get inode of cgroup %s", path)
+		}
+
+		// Using the last container ID in the cgroup path to support "docker in docker" use cases
+		res[stat.Ino] = containerIDs[len(containerIDs)-1]
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("walk cgroup hierarchy: %w", err)
+	}
+
+	return res, nil
+}
+
+// EphemeralRecordingAnnotation derives the value of the recording annotation
+// with the key prefix for an ephemeral container. Ephemeral containers cannot
+// be annotated by the recording webhook, which is why the value gets derived
+// from the annotation of another recorded container of the same pod.
+func EphemeralRecordingAnnotation(annotations map[string]string, keyPrefix, ctrName string) (string, bool) {
+	const expectedParts = 4
+
+	keys := []string{}
+	for key := range annotations {
+		if strings.HasPrefix(key, keyPrefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		// The value consists of the recording, container, nonce and timestamp.
+		parts := strings.Split(annotations[key], "_")
+		if len(parts) != expectedParts {
+			continue
+		}
+		parts[1] = ctrName
+		return strings.Join(parts, "_"), true
+	}
+
+	return "", false
+}
@@ -17,6 +17,9 @@ limitations under the License.
 package util
 
 import (
+	"os"
+	"path/filepath"
+	"syscall"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -61,3 +64,47 @@ func TestExtractContainerID(t *testing.T) {
 		})
 	}
 }
+
+func TestContainerIDsForCgroups(t *testing.T) {
+	t.Parallel()
+
+	const containerID = "af208fd68bf39a07a439ed0c9b6609b9ae63ecd8a5f1a2af3e0db48b945b320a"
+
+	root := t.TempDir()
+	containerPath := filepath.Join(
+		root, "kubepods.slice", "kubepods-pod26ba375c.slice", "crio-"+containerID+".scope",
+	)
+	require.NoError(t, os.MkdirAll(containerPath, 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "system.slice", "crio.service"), 0o755))
+
+	info, err := os.Stat(containerPath)
+	require.NoError(t, err)
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	require.True(t, ok)
+
+	res, err := ContainerIDsForCgroups(root)
+	require.NoError(t, err)
+	require.Equal(t, map[uint64]string{stat.Ino: containerID}, res)
+
+	_, err = ContainerIDsForCgroups(filepath.Join(root, "missing"))
+	require.NoError(t, err)
+}
+
+func TestEphemeralRecordingAnnotation(t *testing.T) {
+	t.Parallel()
+
+	const prefix = "io.containers.trace-syscall/"
+
+	value, ok := EphemeralRecordingAnnotation(map[string]string{
+		"other":         "value",
+		prefix + "app":  "recording_app_4bbwm_1234",
+		prefix + "init": "invalid",
+	}, prefix, "debugger")
+	require.True(t, ok)
+	require.Equal(t, "recording_debugger_4bbwm_1234", value)
+
+	_, ok = EphemeralRecordingAnnotation(map[string]string{
+		prefix + "init": "invalid",
+	}, prefix, "debugger")
+	require.False(t, ok)
+}
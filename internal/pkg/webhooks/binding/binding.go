@@ -27,8 +27,11 @@ import (
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -49,15 +52,20 @@ var ErrProfWithoutStatus = errors.New("profile hasn't been initialized with stat
 type podBinder struct {
 	impl
 	log logr.Logger
+	// mcsMutex serializes the MCS category allocations of this replica.
+	mcsMutex sync.Mutex
 }
 
-func RegisterWebhook(server webhook.Server, scheme *runtime.Scheme, c client.Client) {
+// RegisterWebhook registers the binding webhook. The reader is used to read
+// objects which have to be up to date, bypassing the cache of the client.
+func RegisterWebhook(server webhook.Server, scheme *runtime.Scheme, c client.Client, reader client.Reader) {
 	server.Register(
 		"/mutate-v1-pod-binding",
 		&webhook.Admission{
 			Handler: &podBinder{
 				impl: &defaultImpl{
 					client:  c,
+					reader:  reader,
 					decoder: admission.NewDecoder(scheme),
 				},
 				log: logf.Log.WithName("binding"),
@@ -96,28 +104,127 @@ func initContainerMap(m *sync.Map, spec *corev1.PodSpec) {
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profilebindings,verbs=get;list;watch;create;update;patch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profilebindings/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=profilebindings/finalizers,verbs=delete;get;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=clusterprofilebindings,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=clusterprofilebindings/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=clusterprofilebindings/finalizers,verbs=delete;get;update;patch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=seccompprofiles,verbs=get;list;watch
 // +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=selinuxprofiles,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=clusterseccompprofiles,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=clusterselinuxprofiles,verbs=get;list;watch
+// +kubebuilder:rbac:groups=security-profiles-operator.x-k8s.io,resources=selinuxmcsallocations,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
 
 //nolint:lll // required for kubebuilder
 // +kubebuilder:rbac:groups=core,resources=events,verbs=create
-// +kubebuilder:rbac:groups=coordination.k8s.io,namespace=security-profiles-operator,resources=leases,verbs=create
+// +kubebuilder:rbac:groups=coordination.k8s.io,namespace=security-profiles-operator,resources=leases,verbs=create;list;delete
 // +kubebuilder:rbac:groups=coordination.k8s.io,namespace=security-profiles-operator,resourceNames=security-profiles-operator-webhook-lock,resources=leases,verbs=get;patch;update
 
 // OpenShift (This is ignored in other distros):
 //nolint:lll // required for kubebuilder
 // +kubebuilder:rbac:groups=security.openshift.io,namespace=security-profiles-operator,resources=securitycontextconstraints,verbs=use
 
+// binding is a ProfileBinding or a ClusterProfileBinding which applies to
+// the namespace of a pod.
+type binding struct {
+	obj             client.Object
+	name            string
+	kind            profilebindingv1alpha1.ProfileBindingKind
+	profileName     string
+	image           string
+	mcsAllocation   profilebindingv1alpha1.MCSAllocationScope
+	activeWorkloads *[]string
+	// trackNamespaces is set if the active workloads contain the namespaces
+	// of the bound pods instead of the pods, which keeps the status of
+	// cluster wide bindings bounded.
+	trackNamespaces bool
+}
+
+// workload returns the entry of the pod in the active workloads of the
+// binding.
+func (b *binding) workload(namespace, podName string) string {
+	if b.trackNamespaces {
+		return namespace
+	}
+	return namespace + "/" + podName
+}
+
+// listBindings returns the ProfileBindings of the namespace together with
+// the ClusterProfileBindings selecting it. For deleted pods, the
+// ClusterProfileBindings which still track the namespace are returned as
+// well, because the labels of the namespace could have changed since the
+// pod got bound.
+func (p *podBinder) listBindings(ctx context.Context, namespace string, deleted bool) ([]binding, error) {
+	profileBindings, err := p.ListProfileBindings(ctx, client.InNamespace(namespace))
+	if err != nil {
+		return nil, fmt.Errorf("could not list profile bindings: %w", err)
+	}
+
+	bindings := []binding{}
+	for i := range profileBindings.Items {
+		pb := &profileBindings.Items[i]
+		bindings = append(bindings, binding{
+			obj:             pb,
+			name:            "profilebinding",
+			kind:            pb.Spec.ProfileRef.Kind,
+			profileName:     pb.Spec.ProfileRef.Name,
+			image:           pb.Spec.Image,
+			mcsAllocation:   pb.Spec.MCSAllocation,
+			activeWorkloads: &pb.Status.ActiveWorkloads,
+		})
+	}
+
+	clusterProfileBindings, err := p.ListClusterProfileBindings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not list cluster profile bindings: %w", err)
+	}
+
+	var namespaceLabels labels.Set
+	for i := range clusterProfileBindings.Items {
+		cpb := &clusterProfileBindings.Items[i]
+		tracked := deleted && util.Contains(cpb.Status.ActiveNamespaces, namespace)
+		if cpb.Spec.NamespaceSelector != nil && !tracked {
+			if namespaceLabels == nil {
+				ns, err := p.GetNamespace(ctx, namespace)
+				if err != nil && !(deleted && kerrors.IsNotFound(err)) {
+					return nil, fmt.Errorf("could not get namespace %s: %w", namespace, err)
+				}
+				namespaceLabels = labels.Set{}
+				if ns != nil {
+					namespaceLabels = labels.Set(ns.GetLabels())
+				}
+			}
+			selector, err := metav1.LabelSelectorAsSelector(cpb.Spec.NamespaceSelector)
+			if err != nil {
+				return nil, fmt.Errorf("invalid namespace selector of cluster profile binding %s: %w", cpb.GetName(), err)
+			}
+			if !selector.Matches(namespaceLabels) {
+				continue
+			}
+		}
+		bindings = append(bindings, binding{
+			obj:             cpb,
+			name:            "clusterprofilebinding",
+			kind:            cpb.Spec.ProfileRef.Kind,
+			profileName:     cpb.Spec.ProfileRef.Name,
+			image:           cpb.Spec.Image,
+			mcsAllocation:   cpb.Spec.MCSAllocation,
+			activeWorkloads: &cpb.Status.ActiveNamespaces,
+			trackNamespaces: true,
+		})
+	}
+	return bindings, nil
+}
+
 //nolint:gocritic
 func (p *podBinder) Handle(ctx context.Context, req admission.Request) admission.Response {
-	profileBindings, err := p.ListProfileBindings(ctx, client.InNamespace(req.Namespace))
+	profilebindings, err := p.listBindings(ctx, req.Namespace, req.Operation == "DELETE")
 	if err != nil {
 		p.log.Error(err, "could not list profile bindings")
 		return admission.Errored(http.StatusInternalServerError, err)
 	}
-	profilebindings := profileBindings.Items
 	podChanged := false
-	podID := req.Namespace + "/" + req.Name
+	podLevel := ""
 	pod := &corev1.Pod{}
 
 	var containers sync.Map
@@ -131,22 +238,25 @@ func (p *podBinder) Handle(ctx context.Context, req admission.Request) admission
 	}
 
 	for i := range profilebindings {
-		profileKind := profilebindings[i].Spec.ProfileRef.Kind
-		if profileKind != profilebindingv1alpha1.ProfileBindingKindSeccompProfile {
-			if profileKind != profilebindingv1alpha1.ProfileBindingKindSelinuxProfile {
-				p.log.Info(fmt.Sprintf("profile kind %s not yet supported", profileKind))
-				continue
-			}
+		profileKind := profilebindings[i].kind
+		switch profileKind {
+		case profilebindingv1alpha1.ProfileBindingKindSeccompProfile,
+			profilebindingv1alpha1.ProfileBindingKindSelinuxProfile,
+			profilebindingv1alpha1.ProfileBindingKindClusterSeccompProfile,
+			profilebindingv1alpha1.ProfileBindingKindClusterSelinuxProfile:
+		default:
+			p.log.Info(fmt.Sprintf("profile kind %s not yet supported", profileKind))
+			continue
 		}
 
-		profileName := profilebindings[i].Spec.ProfileRef.Name
+		profileName := profilebindings[i].profileName
 		if req.Operation == "DELETE" {
-			if err := p.removePodFromBinding(ctx, podID, &profilebindings[i]); err != nil {
+			if err := p.removePodFromBinding(ctx, req.Namespace, req.Name, &profilebindings[i]); err != nil {
 				return admission.Errored(http.StatusInternalServerError, err)
 			}
 			continue
 		}
-		value, ok := containers.Load(profilebindings[i].Spec.Image)
+		value, ok := containers.Load(profilebindings[i].image)
 		if !ok {
 			continue
 		}
@@ -159,12 +269,17 @@ func (p *podBinder) Handle(ctx context.Context, req admission.Request) admission
 		var bindProfile interface{}
 		var err error
 
-		if profileKind == profilebindingv1alpha1.ProfileBindingKindSeccompProfile {
+		switch profileKind {
+		case profilebindingv1alpha1.ProfileBindingKindSeccompProfile:
 			bindProfile, err = p.getSeccompProfile(ctx, namespacedName)
-		}
-
-		if profileKind == profilebindingv1alpha1.ProfileBindingKindSelinuxProfile {
+		case profilebindingv1alpha1.ProfileBindingKindSelinuxProfile:
 			bindProfile, err = p.getSelinuxProfile(ctx, namespacedName)
+		case profilebindingv1alpha1.ProfileBindingKindClusterSeccompProfile:
+			namespacedName.Namespace = ""
+			bindProfile, err = p.getClusterSeccompProfile(ctx, profileName)
+		case profilebindingv1alpha1.ProfileBindingKindClusterSelinuxProfile:
+			namespacedName.Namespace = ""
+			bindProfile, err = p.getClusterSelinuxProfile(ctx, profileName)
 		}
 
 		if err != nil {
@@ -172,15 +287,41 @@ func (p *podBinder) Handle(ctx context.Context, req admission.Request) admission
 			return admission.Errored(http.StatusInternalServerError, err)
 		}
 
+		level := ""
+		if profileKind == profilebindingv1alpha1.ProfileBindingKindSelinuxProfile ||
+			profileKind == profilebindingv1alpha1.ProfileBindingKindClusterSelinuxProfile {
+			level, err = p.mcsLevel(ctx, req.Namespace, &profilebindings[i])
+			if err != nil {
+				p.log.Error(err, "failed to allocate MCS categories", "binding", profilebindings[i].obj.GetName())
+				return admission.Errored(http.StatusInternalServerError, err)
+			}
+		}
+		if level != "" {
+			if podLevel != "" && podLevel != level {
+				err := fmt.Errorf("%w: bindings allocated %s and %s", ErrMixedMCSLevels, podLevel, level)
+				p.log.Info("rejecting pod", "reason", err.Error())
+				return admission.Denied(err.Error())
+			}
+			podLevel = level
+		}
+
 		for j := range containers {
 			podChanged = p.addSecurityContext(containers[j], bindProfile)
 		}
 		if podChanged {
-			if err := p.addPodToBinding(ctx, podID, &profilebindings[i]); err != nil {
+			if err := p.addPodToBinding(ctx, req.Namespace, req.Name, &profilebindings[i]); err != nil {
 				return admission.Errored(http.StatusInternalServerError, err)
 			}
 		}
 	}
+	if podLevel != "" {
+		levelChanged, err := setPodMCSLevel(pod, podLevel)
+		if err != nil {
+			p.log.Info("rejecting pod", "reason", err.Error())
+			return admission.Denied(err.Error())
+		}
+		podChanged = podChanged || levelChanged
+	}
 	if !podChanged {
 		return admission.Allowed("pod unchanged")
 	}
@@ -235,6 +376,50 @@ func (p *podBinder) getSelinuxProfile(
 	return selinuxProfile, err
 }
 
+func (p *podBinder) getClusterSeccompProfile(
+	ctx context.Context,
+	name string,
+) (seccompProfile *seccompprofileapi.ClusterSeccompProfile, err error) {
+	err = util.Retry(
+		func() (retryErr error) {
+			seccompProfile, retryErr = p.GetClusterSeccompProfile(ctx, name)
+			if retryErr != nil {
+				return fmt.Errorf("getting profile: %w", retryErr)
+			}
+			if seccompProfile.Status.Status == "" {
+				return fmt.Errorf("getting profile: %w", ErrProfWithoutStatus)
+			}
+			return nil
+		}, func(inErr error) bool {
+			return errors.Is(inErr, ErrProfWithoutStatus) || kerrors.IsNotFound(inErr)
+		})
+	//nolint:wrapcheck // already wrapped
+	return seccompProfile, err
+}
+
+func (p *podBinder) getClusterSelinuxProfile(
+	ctx context.Context,
+	name string,
+) (selinuxProfile *selinuxprofileapi.ClusterSelinuxProfile, err error) {
+	err = util.Retry(
+		func() (retryErr error) {
+			selinuxProfile, retryErr = p.GetClusterSelinuxProfile(ctx, name)
+			if retryErr != nil {
+				return fmt.Errorf("getting profile: %w", retryErr)
+			}
+			if selinuxProfile.Status.Status == "" {
+				return fmt.Errorf("getting profile: %w", ErrProfWithoutStatus)
+			}
+			return nil
+		}, func(inErr error) bool {
+			return errors.Is(inErr, ErrProfWithoutStatus) || kerrors.IsNotFound(inErr)
+		})
+	//nolint:wrapcheck // already wrapped
+	return selinuxProfile, err
+}
+
+// addSecurityContext adds the profile to the container. The SELinux MCS
+// level is set once for the whole pod instead.
 func (p *podBinder) addSecurityContext(
 	c *corev1.Container, bindProfile interface{},
 ) bool {
@@ -242,9 +427,13 @@ func (p *podBinder) addSecurityContext(
 
 	switch v := bindProfile.(type) {
 	case *seccompprofileapi.SeccompProfile:
-		podChanged = p.addSeccompContext(c, v)
+		podChanged = p.addSeccompContext(c, v.Status.LocalhostProfile)
+	case *seccompprofileapi.ClusterSeccompProfile:
+		podChanged = p.addSeccompContext(c, v.Status.LocalhostProfile)
 	case *selinuxprofileapi.SelinuxProfile:
-		podChanged = p.addSelinuxContext(c, v)
+		podChanged = p.addSelinuxContext(c, v.Status.Usage)
+	case *selinuxprofileapi.ClusterSelinuxProfile:
+		podChanged = p.addSelinuxContext(c, v.Status.Usage)
 	default:
 		p.log.Info("Unexpected Profile Type")
 		return false
@@ -253,10 +442,9 @@ func (p *podBinder) addSecurityContext(
 }
 
 func (p *podBinder) addSeccompContext(
-	c *corev1.Container, seccompProfile *seccompprofileapi.SeccompProfile,
+	c *corev1.Container, profileRef string,
 ) bool {
 	podChanged := false
-	profileRef := seccompProfile.Status.LocalhostProfile
 	sp := corev1.SeccompProfile{
 		Type:             corev1.SeccompProfileTypeLocalhost,
 		LocalhostProfile: &profileRef,
@@ -274,10 +462,9 @@ func (p *podBinder) addSeccompContext(
 }
 
 func (p *podBinder) addSelinuxContext(
-	c *corev1.Container, selinuxProfile *selinuxprofileapi.SelinuxProfile,
+	c *corev1.Container, usage string,
 ) bool {
 	podChanged := false
-	usage := selinuxProfile.Status.Usage
 	sl := corev1.SELinuxOptions{
 		Type: usage,
 	}
@@ -296,31 +483,113 @@ func (p *podBinder) addSelinuxContext(
 
 func (p *podBinder) addPodToBinding(
 	ctx context.Context,
-	podID string,
-	pb *profilebindingv1alpha1.ProfileBinding,
+	namespace, podName string,
+	pb *binding,
 ) error {
-	pb.Status.ActiveWorkloads = utils.AppendIfNotExists(pb.Status.ActiveWorkloads, podID)
-	if err := p.impl.UpdateResourceStatus(ctx, p.log, pb, "profilebinding status"); err != nil {
+	workload := pb.workload(namespace, podName)
+	if err := p.updateBindingStatus(ctx, pb, func() bool {
+		if util.Contains(*pb.activeWorkloads, workload) {
+			return false
+		}
+		*pb.activeWorkloads = append(*pb.activeWorkloads, workload)
+		return true
+	}); err != nil {
 		return fmt.Errorf("add pod to binding: %w", err)
 	}
-	if !controllerutil.ContainsFinalizer(pb, finalizer) {
-		controllerutil.AddFinalizer(pb, finalizer)
-	}
-	return p.impl.UpdateResource(ctx, p.log, pb, "profilebinding")
+	return p.updateBinding(ctx, pb, func() bool {
+		return controllerutil.AddFinalizer(pb.obj, finalizer)
+	})
 }
 
 func (p *podBinder) removePodFromBinding(
 	ctx context.Context,
-	podID string,
-	pb *profilebindingv1alpha1.ProfileBinding,
+	namespace, podName string,
+	pb *binding,
 ) error {
-	pb.Status.ActiveWorkloads = utils.RemoveIfExists(pb.Status.ActiveWorkloads, podID)
-	if err := p.impl.UpdateResourceStatus(ctx, p.log, pb, "profilebinding status"); err != nil {
+	workload := pb.workload(namespace, podName)
+	if !util.Contains(*pb.activeWorkloads, workload) {
+		return nil
+	}
+	if pb.trackNamespaces {
+		used, err := p.imageUsedInNamespace(ctx, namespace, podName, pb.image)
+		if err != nil {
+			return fmt.Errorf("remove pod from binding: %w", err)
+		}
+		if used {
+			return nil
+		}
+	}
+
+	if err := p.updateBindingStatus(ctx, pb, func() bool {
+		if !util.Contains(*pb.activeWorkloads, workload) {
+			return false
+		}
+		*pb.activeWorkloads = utils.RemoveIfExists(*pb.activeWorkloads, workload)
+		return true
+	}); err != nil {
 		return fmt.Errorf("remove pod from binding: %w", err)
 	}
-	if len(pb.Status.ActiveWorkloads) == 0 &&
-		controllerutil.ContainsFinalizer(pb, finalizer) {
-		controllerutil.RemoveFinalizer(pb, finalizer)
+	if len(*pb.activeWorkloads) == 0 && pb.obj.GetDeletionTimestamp() != nil {
+		if err := p.releaseMCSCategories(ctx, pb); err != nil {
+			return fmt.Errorf("remove pod from binding: %w", err)
+		}
 	}
-	return p.impl.UpdateResource(ctx, p.log, pb, "profilebinding")
+	return p.updateBinding(ctx, pb, func() bool {
+		return len(*pb.activeWorkloads) == 0 && controllerutil.RemoveFinalizer(pb.obj, finalizer)
+	})
+}
+
+// imageUsedInNamespace returns true if another pod of the namespace than
+// the deleted one still runs a container of the image.
+func (p *podBinder) imageUsedInNamespace(ctx context.Context, namespace, podName, image string) (bool, error) {
+	pods, err := p.ListPods(ctx, namespace)
+	if err != nil {
+		return false, fmt.Errorf("could not list pods in namespace %s: %w", namespace, err)
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.GetName() == podName {
+			continue
+		}
+		var containers sync.Map
+		initContainerMap(&containers, &pod.Spec)
+		if _, ok := containers.Load(image); ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// updateBindingStatus writes the status of the binding if mutate changed
+// it. Concurrent admissions update the same binding, so the binding gets
+// refetched and mutated again on conflicts.
+func (p *podBinder) updateBindingStatus(ctx context.Context, pb *binding, mutate func() bool) error {
+	return p.retryOnConflict(ctx, pb, mutate, func() error {
+		return p.impl.UpdateResourceStatus(ctx, p.log, pb.obj, pb.name+" status")
+	})
+}
+
+// updateBinding writes the binding if mutate changed it, retrying on
+// conflicts like updateBindingStatus.
+func (p *podBinder) updateBinding(ctx context.Context, pb *binding, mutate func() bool) error {
+	return p.retryOnConflict(ctx, pb, mutate, func() error {
+		return p.impl.UpdateResource(ctx, p.log, pb.obj, pb.name)
+	})
+}
+
+func (p *podBinder) retryOnConflict(ctx context.Context, pb *binding, mutate func() bool, update func() error) error {
+	refetch := false
+	//nolint:wrapcheck // errors of the impl are already wrapped
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if refetch {
+			if err := p.impl.GetBinding(ctx, pb.obj); err != nil {
+				return err
+			}
+		}
+		refetch = true
+		if !mutate() {
+			return nil
+		}
+		return update()
+	})
 }
@@ -27,9 +27,14 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/require"
 	admissionv1 "k8s.io/api/admission/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	profilebasev1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebase/v1alpha1"
@@ -168,6 +173,114 @@ func TestHandle(t *testing.T) {
 				require.Len(t, resp.Patches, 1)
 			},
 		},
+		{ // selinux success pod changed with MCS level
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.ListProfileBindingsReturns(&v1alpha1.ProfileBindingList{
+					Items: []v1alpha1.ProfileBinding{
+						{
+							Spec: v1alpha1.ProfileBindingSpec{
+								ProfileRef: v1alpha1.ProfileRef{
+									Kind: v1alpha1.ProfileBindingKindSelinuxProfile,
+								},
+								MCSAllocation: v1alpha1.MCSAllocationNamespace,
+							},
+						},
+					},
+				}, nil)
+				mock.DecodePodReturns(testPod.DeepCopy(), nil)
+				mock.GetSelinuxProfileReturns(&selinuxprofileapi.SelinuxProfile{
+					Status: selinuxprofileapi.SelinuxProfileStatus{
+						StatusBase: profilebasev1alpha1.StatusBase{
+							Status: "Installed",
+						},
+						Usage: "profile_ns.process",
+					},
+				}, nil)
+				mock.ListSelinuxMCSAllocationsReturns(&selinuxprofileapi.SelinuxMCSAllocationList{}, nil)
+				mock.GetNamespaceReturns(&corev1.Namespace{}, nil)
+				mock.ListNamespacesReturns(&corev1.NamespaceList{}, nil)
+				mock.ListMCSLeasesReturns(&coordinationv1.LeaseList{}, nil)
+			},
+			request: admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Object: runtime.RawExtension{
+						Raw: func() []byte {
+							b, err := json.Marshal(testPod.DeepCopy())
+							require.Nil(t, err)
+							return b
+						}(),
+					},
+				},
+			},
+			assert: func(resp admission.Response) {
+				require.True(t, resp.AdmissionResponse.Allowed)
+				require.Len(t, resp.Patches, 2)
+				patches := map[string]interface{}{}
+				for _, patch := range resp.Patches {
+					patches[patch.Path] = patch.Value
+				}
+				require.Equal(t, map[string]interface{}{
+					"/spec/containers/0/securityContext": map[string]interface{}{
+						"seLinuxOptions": map[string]interface{}{
+							"type": "profile_ns.process",
+						},
+					},
+					"/spec/securityContext": map[string]interface{}{
+						"seLinuxOptions": map[string]interface{}{
+							"level": "s0:c0,c1",
+						},
+					},
+				}, patches)
+			},
+		},
+		{ // selinux pod with a different MCS level
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.ListProfileBindingsReturns(&v1alpha1.ProfileBindingList{
+					Items: []v1alpha1.ProfileBinding{
+						{
+							Spec: v1alpha1.ProfileBindingSpec{
+								ProfileRef: v1alpha1.ProfileRef{
+									Kind: v1alpha1.ProfileBindingKindSelinuxProfile,
+								},
+								MCSAllocation: v1alpha1.MCSAllocationNamespace,
+							},
+						},
+					},
+				}, nil)
+				pod := testPod.DeepCopy()
+				pod.Spec.Containers[0].SecurityContext = &corev1.SecurityContext{
+					SELinuxOptions: &corev1.SELinuxOptions{Level: "s0:c2,c3"},
+				}
+				mock.DecodePodReturns(pod, nil)
+				mock.GetSelinuxProfileReturns(&selinuxprofileapi.SelinuxProfile{
+					Status: selinuxprofileapi.SelinuxProfileStatus{
+						StatusBase: profilebasev1alpha1.StatusBase{
+							Status: "Installed",
+						},
+						Usage: "profile_ns.process",
+					},
+				}, nil)
+				mock.ListSelinuxMCSAllocationsReturns(&selinuxprofileapi.SelinuxMCSAllocationList{}, nil)
+				mock.GetNamespaceReturns(&corev1.Namespace{}, nil)
+				mock.ListNamespacesReturns(&corev1.NamespaceList{}, nil)
+				mock.ListMCSLeasesReturns(&coordinationv1.LeaseList{}, nil)
+			},
+			request: admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Object: runtime.RawExtension{
+						Raw: func() []byte {
+							b, err := json.Marshal(testPod.DeepCopy())
+							require.Nil(t, err)
+							return b
+						}(),
+					},
+				},
+			},
+			assert: func(resp admission.Response) {
+				require.False(t, resp.AdmissionResponse.Allowed)
+				require.Contains(t, resp.Result.Message, ErrMixedMCSLevels.Error())
+			},
+		},
 		{ // success unsupported kind
 			prepare: func(mock *bindingfakes.FakeImpl) {
 				mock.ListProfileBindingsReturns(&v1alpha1.ProfileBindingList{
@@ -348,6 +461,88 @@ func TestHandle(t *testing.T) {
 				require.Equal(t, http.StatusInternalServerError, int(resp.Result.Code))
 			},
 		},
+		{ // cluster binding success pod changed
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.ListProfileBindingsReturns(&v1alpha1.ProfileBindingList{}, nil)
+				mock.ListClusterProfileBindingsReturns(&v1alpha1.ClusterProfileBindingList{
+					Items: []v1alpha1.ClusterProfileBinding{
+						{
+							Spec: v1alpha1.ClusterProfileBindingSpec{
+								ProfileRef: v1alpha1.ClusterProfileRef{
+									Kind: v1alpha1.ProfileBindingKindClusterSeccompProfile,
+								},
+								NamespaceSelector: &metav1.LabelSelector{
+									MatchLabels: map[string]string{"team": "a"},
+								},
+							},
+						},
+					},
+				}, nil)
+				mock.GetNamespaceReturns(&corev1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"team": "a"}},
+				}, nil)
+				mock.DecodePodReturns(testPod.DeepCopy(), nil)
+				mock.GetClusterSeccompProfileReturns(&seccompprofileapi.ClusterSeccompProfile{
+					Status: seccompprofileapi.SeccompProfileStatus{
+						StatusBase: profilebasev1alpha1.StatusBase{
+							Status: secprofnodestatusv1alpha1.ProfileStateInstalled,
+						},
+						LocalhostProfile: "operator/profile.json",
+					},
+				}, nil)
+			},
+			request: admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Object: runtime.RawExtension{
+						Raw: func() []byte {
+							b, err := json.Marshal(testPod.DeepCopy())
+							require.Nil(t, err)
+							return b
+						}(),
+					},
+				},
+			},
+			assert: func(resp admission.Response) {
+				require.True(t, resp.AdmissionResponse.Allowed)
+				require.Len(t, resp.Patches, 1)
+			},
+		},
+		{ // cluster binding does not select namespace
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.ListProfileBindingsReturns(&v1alpha1.ProfileBindingList{}, nil)
+				mock.ListClusterProfileBindingsReturns(&v1alpha1.ClusterProfileBindingList{
+					Items: []v1alpha1.ClusterProfileBinding{
+						{
+							Spec: v1alpha1.ClusterProfileBindingSpec{
+								ProfileRef: v1alpha1.ClusterProfileRef{
+									Kind: v1alpha1.ProfileBindingKindClusterSeccompProfile,
+								},
+								NamespaceSelector: &metav1.LabelSelector{
+									MatchLabels: map[string]string{"team": "a"},
+								},
+							},
+						},
+					},
+				}, nil)
+				mock.GetNamespaceReturns(&corev1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"team": "b"}},
+				}, nil)
+				mock.DecodePodReturns(testPod.DeepCopy(), nil)
+			},
+			assert: func(resp admission.Response) {
+				require.True(t, resp.Allowed)
+				require.Equal(t, "pod unchanged", resp.Result.Message)
+			},
+		},
+		{ // error could not list cluster profile bindings
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.ListProfileBindingsReturns(&v1alpha1.ProfileBindingList{}, nil)
+				mock.ListClusterProfileBindingsReturns(nil, errTest)
+			},
+			assert: func(resp admission.Response) {
+				require.Equal(t, http.StatusInternalServerError, int(resp.Result.Code))
+			},
+		},
 		{ // success pod deleted
 			prepare: func(mock *bindingfakes.FakeImpl) {
 				mock.ListProfileBindingsReturns(&v1alpha1.ProfileBindingList{
@@ -359,7 +554,7 @@ func TestHandle(t *testing.T) {
 								},
 							},
 							Status: v1alpha1.ProfileBindingStatus{
-								ActiveWorkloads: []string{"1", "2", "3"},
+								ActiveWorkloads: []string{"1", "ns/pod", "3"},
 							},
 						},
 					},
@@ -369,6 +564,8 @@ func TestHandle(t *testing.T) {
 			request: admission.Request{
 				AdmissionRequest: admissionv1.AdmissionRequest{
 					Operation: admissionv1.Delete,
+					Namespace: "ns",
+					Name:      "pod",
 				},
 			},
 			assert: func(resp admission.Response) {
@@ -386,7 +583,7 @@ func TestHandle(t *testing.T) {
 								},
 							},
 							Status: v1alpha1.ProfileBindingStatus{
-								ActiveWorkloads: []string{"1", "2", "3"},
+								ActiveWorkloads: []string{"1", "ns/pod", "3"},
 							},
 						},
 					},
@@ -397,6 +594,8 @@ func TestHandle(t *testing.T) {
 			request: admission.Request{
 				AdmissionRequest: admissionv1.AdmissionRequest{
 					Operation: admissionv1.Delete,
+					Namespace: "ns",
+					Name:      "pod",
 				},
 			},
 			assert: func(resp admission.Response) {
@@ -405,6 +604,7 @@ func TestHandle(t *testing.T) {
 		},
 	} {
 		mock := &bindingfakes.FakeImpl{}
+		mock.ListClusterProfileBindingsReturns(&v1alpha1.ClusterProfileBindingList{}, nil)
 		tc.prepare(mock)
 
 		binder := podBinder{impl: mock, log: logr.Discard()}
@@ -413,6 +613,145 @@ func TestHandle(t *testing.T) {
 	}
 }
 
+func TestAddPodToBindingRetriesOnConflict(t *testing.T) {
+	t.Parallel()
+
+	pb := &v1alpha1.ProfileBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "binding", Namespace: "ns"},
+	}
+	mock := &bindingfakes.FakeImpl{}
+	mock.UpdateResourceStatusReturnsOnCall(0, kerrors.NewConflict(
+		schema.GroupResource{Resource: "profilebindings"}, "binding", errTest,
+	))
+	mock.GetBindingStub = func(context.Context, client.Object) error {
+		// another pod got added concurrently
+		pb.Status.ActiveWorkloads = []string{"ns/other"}
+		return nil
+	}
+
+	binder := podBinder{impl: mock, log: logr.Discard()}
+	err := binder.addPodToBinding(context.Background(), "ns", "pod", &binding{
+		obj:             pb,
+		name:            "profilebinding",
+		activeWorkloads: &pb.Status.ActiveWorkloads,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, mock.GetBindingCallCount())
+	require.Equal(t, 2, mock.UpdateResourceStatusCallCount())
+	require.Equal(t, []string{"ns/other", "ns/pod"}, pb.Status.ActiveWorkloads)
+	require.Equal(t, 1, mock.UpdateResourceCallCount())
+	require.Contains(t, pb.GetFinalizers(), finalizer)
+}
+
+func TestRemovePodFromClusterBinding(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name                   string
+		activeNamespaces       []string
+		pods                   []corev1.Pod
+		wantActiveNamespaces   []string
+		wantStatusUpdates      int
+		wantFinalizerRemaining bool
+	}{
+		{
+			name:             "image still used in namespace",
+			activeNamespaces: []string{"ns"},
+			pods: []corev1.Pod{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "pod"},
+					Spec:       corev1.PodSpec{Containers: []corev1.Container{{Image: "nginx"}}},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "other"},
+					Spec:       corev1.PodSpec{Containers: []corev1.Container{{Image: "nginx"}}},
+				},
+			},
+			wantActiveNamespaces:   []string{"ns"},
+			wantFinalizerRemaining: true,
+		},
+		{
+			name:             "last pod of namespace",
+			activeNamespaces: []string{"ns"},
+			pods: []corev1.Pod{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "pod"},
+					Spec:       corev1.PodSpec{Containers: []corev1.Container{{Image: "nginx"}}},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "other"},
+					Spec:       corev1.PodSpec{Containers: []corev1.Container{{Image: "redis"}}},
+				},
+			},
+			wantActiveNamespaces: []string{},
+			wantStatusUpdates:    1,
+		},
+		{
+			name:                   "namespace not tracked",
+			activeNamespaces:       []string{"other"},
+			wantActiveNamespaces:   []string{"other"},
+			wantFinalizerRemaining: true,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			cpb := &v1alpha1.ClusterProfileBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: "binding", Finalizers: []string{finalizer}},
+				Spec:       v1alpha1.ClusterProfileBindingSpec{Image: "nginx"},
+				Status:     v1alpha1.ClusterProfileBindingStatus{ActiveNamespaces: tc.activeNamespaces},
+			}
+			mock := &bindingfakes.FakeImpl{}
+			mock.ListPodsReturns(&corev1.PodList{Items: tc.pods}, nil)
+
+			binder := podBinder{impl: mock, log: logr.Discard()}
+			err := binder.removePodFromBinding(context.Background(), "ns", "pod", &binding{
+				obj:             cpb,
+				name:            "clusterprofilebinding",
+				image:           cpb.Spec.Image,
+				activeWorkloads: &cpb.Status.ActiveNamespaces,
+				trackNamespaces: true,
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.wantActiveNamespaces, cpb.Status.ActiveNamespaces)
+			require.Equal(t, tc.wantStatusUpdates, mock.UpdateResourceStatusCallCount())
+			require.Equal(t, tc.wantFinalizerRemaining, controllerutil.ContainsFinalizer(cpb, finalizer))
+		})
+	}
+}
+
+func TestListBindingsForDeletedPod(t *testing.T) {
+	t.Parallel()
+
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}}
+	mock := &bindingfakes.FakeImpl{}
+	mock.ListProfileBindingsReturns(&v1alpha1.ProfileBindingList{}, nil)
+	mock.ListClusterProfileBindingsReturns(&v1alpha1.ClusterProfileBindingList{
+		Items: []v1alpha1.ClusterProfileBinding{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "not-selected"},
+				Spec:       v1alpha1.ClusterProfileBindingSpec{NamespaceSelector: selector},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "tracked"},
+				Spec:       v1alpha1.ClusterProfileBindingSpec{NamespaceSelector: selector},
+				Status:     v1alpha1.ClusterProfileBindingStatus{ActiveNamespaces: []string{"ns"}},
+			},
+		},
+	}, nil)
+	mock.GetNamespaceReturns(nil, kerrors.NewNotFound(schema.GroupResource{Resource: "namespaces"}, "ns"))
+
+	binder := podBinder{impl: mock, log: logr.Discard()}
+	bindings, err := binder.listBindings(context.Background(), "ns", true)
+	require.NoError(t, err)
+	require.Len(t, bindings, 1)
+	require.Equal(t, "tracked", bindings[0].obj.GetName())
+
+	_, err = binder.listBindings(context.Background(), "ns", false)
+	require.Error(t, err)
+}
+
 func TestNewContainerMap(t *testing.T) {
 	t.Parallel()
 
@@ -22,7 +22,8 @@ import (
 	"sync"
 
 	"github.com/go-logr/logr"
-	v1 "k8s.io/api/core/v1"
+	v1 "k8s.io/api/coordination/v1"
+	v1a "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -32,17 +33,119 @@ import (
 )
 
 type FakeImpl struct {
-	DecodePodStub        func(admission.Request) (*v1.Pod, error)
+	CreateMCSLeaseStub        func(context.Context, *v1.Lease) error
+	createMCSLeaseMutex       sync.RWMutex
+	createMCSLeaseArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1.Lease
+	}
+	createMCSLeaseReturns struct {
+		result1 error
+	}
+	createMCSLeaseReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateSelinuxMCSAllocationStub        func(context.Context, *v1alpha2.SelinuxMCSAllocation) error
+	createSelinuxMCSAllocationMutex       sync.RWMutex
+	createSelinuxMCSAllocationArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha2.SelinuxMCSAllocation
+	}
+	createSelinuxMCSAllocationReturns struct {
+		result1 error
+	}
+	createSelinuxMCSAllocationReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DecodePodStub        func(admission.Request) (*v1a.Pod, error)
 	decodePodMutex       sync.RWMutex
 	decodePodArgsForCall []struct {
 		arg1 admission.Request
 	}
 	decodePodReturns struct {
-		result1 *v1.Pod
+		result1 *v1a.Pod
 		result2 error
 	}
 	decodePodReturnsOnCall map[int]struct {
-		result1 *v1.Pod
+		result1 *v1a.Pod
+		result2 error
+	}
+	DeleteMCSLeaseStub        func(context.Context, *v1.Lease) error
+	deleteMCSLeaseMutex       sync.RWMutex
+	deleteMCSLeaseArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1.Lease
+	}
+	deleteMCSLeaseReturns struct {
+		result1 error
+	}
+	deleteMCSLeaseReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteSelinuxMCSAllocationStub        func(context.Context, *v1alpha2.SelinuxMCSAllocation) error
+	deleteSelinuxMCSAllocationMutex       sync.RWMutex
+	deleteSelinuxMCSAllocationArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha2.SelinuxMCSAllocation
+	}
+	deleteSelinuxMCSAllocationReturns struct {
+		result1 error
+	}
+	deleteSelinuxMCSAllocationReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetBindingStub        func(context.Context, client.Object) error
+	getBindingMutex       sync.RWMutex
+	getBindingArgsForCall []struct {
+		arg1 context.Context
+		arg2 client.Object
+	}
+	getBindingReturns struct {
+		result1 error
+	}
+	getBindingReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetClusterSeccompProfileStub        func(context.Context, string) (*v1beta1.ClusterSeccompProfile, error)
+	getClusterSeccompProfileMutex       sync.RWMutex
+	getClusterSeccompProfileArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getClusterSeccompProfileReturns struct {
+		result1 *v1beta1.ClusterSeccompProfile
+		result2 error
+	}
+	getClusterSeccompProfileReturnsOnCall map[int]struct {
+		result1 *v1beta1.ClusterSeccompProfile
+		result2 error
+	}
+	GetClusterSelinuxProfileStub        func(context.Context, string) (*v1alpha2.ClusterSelinuxProfile, error)
+	getClusterSelinuxProfileMutex       sync.RWMutex
+	getClusterSelinuxProfileArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getClusterSelinuxProfileReturns struct {
+		result1 *v1alpha2.ClusterSelinuxProfile
+		result2 error
+	}
+	getClusterSelinuxProfileReturnsOnCall map[int]struct {
+		result1 *v1alpha2.ClusterSelinuxProfile
+		result2 error
+	}
+	GetNamespaceStub        func(context.Context, string) (*v1a.Namespace, error)
+	getNamespaceMutex       sync.RWMutex
+	getNamespaceArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getNamespaceReturns struct {
+		result1 *v1a.Namespace
+		result2 error
+	}
+	getNamespaceReturnsOnCall map[int]struct {
+		result1 *v1a.Namespace
 		result2 error
 	}
 	GetSeccompProfileStub        func(context.Context, types.NamespacedName) (*v1beta1.SeccompProfile, error)
@@ -73,6 +176,60 @@ type FakeImpl struct {
 		result1 *v1alpha2.SelinuxProfile
 		result2 error
 	}
+	ListClusterProfileBindingsStub        func(context.Context, ...client.ListOption) (*v1alpha1.ClusterProfileBindingList, error)
+	listClusterProfileBindingsMutex       sync.RWMutex
+	listClusterProfileBindingsArgsForCall []struct {
+		arg1 context.Context
+		arg2 []client.ListOption
+	}
+	listClusterProfileBindingsReturns struct {
+		result1 *v1alpha1.ClusterProfileBindingList
+		result2 error
+	}
+	listClusterProfileBindingsReturnsOnCall map[int]struct {
+		result1 *v1alpha1.ClusterProfileBindingList
+		result2 error
+	}
+	ListMCSLeasesStub        func(context.Context) (*v1.LeaseList, error)
+	listMCSLeasesMutex       sync.RWMutex
+	listMCSLeasesArgsForCall []struct {
+		arg1 context.Context
+	}
+	listMCSLeasesReturns struct {
+		result1 *v1.LeaseList
+		result2 error
+	}
+	listMCSLeasesReturnsOnCall map[int]struct {
+		result1 *v1.LeaseList
+		result2 error
+	}
+	ListNamespacesStub        func(context.Context) (*v1a.NamespaceList, error)
+	listNamespacesMutex       sync.RWMutex
+	listNamespacesArgsForCall []struct {
+		arg1 context.Context
+	}
+	listNamespacesReturns struct {
+		result1 *v1a.NamespaceList
+		result2 error
+	}
+	listNamespacesReturnsOnCall map[int]struct {
+		result1 *v1a.NamespaceList
+		result2 error
+	}
+	ListPodsStub        func(context.Context, string) (*v1a.PodList, error)
+	listPodsMutex       sync.RWMutex
+	listPodsArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	listPodsReturns struct {
+		result1 *v1a.PodList
+		result2 error
+	}
+	listPodsReturnsOnCall map[int]struct {
+		result1 *v1a.PodList
+		result2 error
+	}
 	ListProfileBindingsStub        func(context.Context, ...client.ListOption) (*v1alpha1.ProfileBindingList, error)
 	listProfileBindingsMutex       sync.RWMutex
 	listProfileBindingsArgsForCall []struct {
@@ -87,6 +244,19 @@ type FakeImpl struct {
 		result1 *v1alpha1.ProfileBindingList
 		result2 error
 	}
+	ListSelinuxMCSAllocationsStub        func(context.Context) (*v1alpha2.SelinuxMCSAllocationList, error)
+	listSelinuxMCSAllocationsMutex       sync.RWMutex
+	listSelinuxMCSAllocationsArgsForCall []struct {
+		arg1 context.Context
+	}
+	listSelinuxMCSAllocationsReturns struct {
+		result1 *v1alpha2.SelinuxMCSAllocationList
+		result2 error
+	}
+	listSelinuxMCSAllocationsReturnsOnCall map[int]struct {
+		result1 *v1alpha2.SelinuxMCSAllocationList
+		result2 error
+	}
 	UpdateResourceStub        func(context.Context, logr.Logger, client.Object, string) error
 	updateResourceMutex       sync.RWMutex
 	updateResourceArgsForCall []struct {
@@ -119,7 +289,131 @@ type FakeImpl struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeImpl) DecodePod(arg1 admission.Request) (*v1.Pod, error) {
+func (fake *FakeImpl) CreateMCSLease(arg1 context.Context, arg2 *v1.Lease) error {
+	fake.createMCSLeaseMutex.Lock()
+	ret, specificReturn := fake.createMCSLeaseReturnsOnCall[len(fake.createMCSLeaseArgsForCall)]
+	fake.createMCSLeaseArgsForCall = append(fake.createMCSLeaseArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1.Lease
+	}{arg1, arg2})
+	stub := fake.CreateMCSLeaseStub
+	fakeReturns := fake.createMCSLeaseReturns
+	fake.recordInvocation("CreateMCSLease", []interface{}{arg1, arg2})
+	fake.createMCSLeaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) CreateMCSLeaseCallCount() int {
+	fake.createMCSLeaseMutex.RLock()
+	defer fake.createMCSLeaseMutex.RUnlock()
+	return len(fake.createMCSLeaseArgsForCall)
+}
+
+func (fake *FakeImpl) CreateMCSLeaseCalls(stub func(context.Context, *v1.Lease) error) {
+	fake.createMCSLeaseMutex.Lock()
+	defer fake.createMCSLeaseMutex.Unlock()
+	fake.CreateMCSLeaseStub = stub
+}
+
+func (fake *FakeImpl) CreateMCSLeaseArgsForCall(i int) (context.Context, *v1.Lease) {
+	fake.createMCSLeaseMutex.RLock()
+	defer fake.createMCSLeaseMutex.RUnlock()
+	argsForCall := fake.createMCSLeaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) CreateMCSLeaseReturns(result1 error) {
+	fake.createMCSLeaseMutex.Lock()
+	defer fake.createMCSLeaseMutex.Unlock()
+	fake.CreateMCSLeaseStub = nil
+	fake.createMCSLeaseReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) CreateMCSLeaseReturnsOnCall(i int, result1 error) {
+	fake.createMCSLeaseMutex.Lock()
+	defer fake.createMCSLeaseMutex.Unlock()
+	fake.CreateMCSLeaseStub = nil
+	if fake.createMCSLeaseReturnsOnCall == nil {
+		fake.createMCSLeaseReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createMCSLeaseReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) CreateSelinuxMCSAllocation(arg1 context.Context, arg2 *v1alpha2.SelinuxMCSAllocation) error {
+	fake.createSelinuxMCSAllocationMutex.Lock()
+	ret, specificReturn := fake.createSelinuxMCSAllocationReturnsOnCall[len(fake.createSelinuxMCSAllocationArgsForCall)]
+	fake.createSelinuxMCSAllocationArgsForCall = append(fake.createSelinuxMCSAllocationArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha2.SelinuxMCSAllocation
+	}{arg1, arg2})
+	stub := fake.CreateSelinuxMCSAllocationStub
+	fakeReturns := fake.createSelinuxMCSAllocationReturns
+	fake.recordInvocation("CreateSelinuxMCSAllocation", []interface{}{arg1, arg2})
+	fake.createSelinuxMCSAllocationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) CreateSelinuxMCSAllocationCallCount() int {
+	fake.createSelinuxMCSAllocationMutex.RLock()
+	defer fake.createSelinuxMCSAllocationMutex.RUnlock()
+	return len(fake.createSelinuxMCSAllocationArgsForCall)
+}
+
+func (fake *FakeImpl) CreateSelinuxMCSAllocationCalls(stub func(context.Context, *v1alpha2.SelinuxMCSAllocation) error) {
+	fake.createSelinuxMCSAllocationMutex.Lock()
+	defer fake.createSelinuxMCSAllocationMutex.Unlock()
+	fake.CreateSelinuxMCSAllocationStub = stub
+}
+
+func (fake *FakeImpl) CreateSelinuxMCSAllocationArgsForCall(i int) (context.Context, *v1alpha2.SelinuxMCSAllocation) {
+	fake.createSelinuxMCSAllocationMutex.RLock()
+	defer fake.createSelinuxMCSAllocationMutex.RUnlock()
+	argsForCall := fake.createSelinuxMCSAllocationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) CreateSelinuxMCSAllocationReturns(result1 error) {
+	fake.createSelinuxMCSAllocationMutex.Lock()
+	defer fake.createSelinuxMCSAllocationMutex.Unlock()
+	fake.CreateSelinuxMCSAllocationStub = nil
+	fake.createSelinuxMCSAllocationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) CreateSelinuxMCSAllocationReturnsOnCall(i int, result1 error) {
+	fake.createSelinuxMCSAllocationMutex.Lock()
+	defer fake.createSelinuxMCSAllocationMutex.Unlock()
+	fake.CreateSelinuxMCSAllocationStub = nil
+	if fake.createSelinuxMCSAllocationReturnsOnCall == nil {
+		fake.createSelinuxMCSAllocationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createSelinuxMCSAllocationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) DecodePod(arg1 admission.Request) (*v1a.Pod, error) {
 	fake.decodePodMutex.Lock()
 	ret, specificReturn := fake.decodePodReturnsOnCall[len(fake.decodePodArgsForCall)]
 	fake.decodePodArgsForCall = append(fake.decodePodArgsForCall, struct {
@@ -144,7 +438,7 @@ func (fake *FakeImpl) DecodePodCallCount() int {
 	return len(fake.decodePodArgsForCall)
 }
 
-func (fake *FakeImpl) DecodePodCalls(stub func(admission.Request) (*v1.Pod, error)) {
+func (fake *FakeImpl) DecodePodCalls(stub func(admission.Request) (*v1a.Pod, error)) {
 	fake.decodePodMutex.Lock()
 	defer fake.decodePodMutex.Unlock()
 	fake.DecodePodStub = stub
@@ -157,28 +451,409 @@ func (fake *FakeImpl) DecodePodArgsForCall(i int) admission.Request {
 	return argsForCall.arg1
 }
 
-func (fake *FakeImpl) DecodePodReturns(result1 *v1.Pod, result2 error) {
+func (fake *FakeImpl) DecodePodReturns(result1 *v1a.Pod, result2 error) {
 	fake.decodePodMutex.Lock()
 	defer fake.decodePodMutex.Unlock()
 	fake.DecodePodStub = nil
 	fake.decodePodReturns = struct {
-		result1 *v1.Pod
+		result1 *v1a.Pod
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeImpl) DecodePodReturnsOnCall(i int, result1 *v1.Pod, result2 error) {
+func (fake *FakeImpl) DecodePodReturnsOnCall(i int, result1 *v1a.Pod, result2 error) {
 	fake.decodePodMutex.Lock()
 	defer fake.decodePodMutex.Unlock()
 	fake.DecodePodStub = nil
 	if fake.decodePodReturnsOnCall == nil {
 		fake.decodePodReturnsOnCall = make(map[int]struct {
-			result1 *v1.Pod
+			result1 *v1a.Pod
 			result2 error
 		})
 	}
 	fake.decodePodReturnsOnCall[i] = struct {
-		result1 *v1.Pod
+		result1 *v1a.Pod
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) DeleteMCSLease(arg1 context.Context, arg2 *v1.Lease) error {
+	fake.deleteMCSLeaseMutex.Lock()
+	ret, specificReturn := fake.deleteMCSLeaseReturnsOnCall[len(fake.deleteMCSLeaseArgsForCall)]
+	fake.deleteMCSLeaseArgsForCall = append(fake.deleteMCSLeaseArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1.Lease
+	}{arg1, arg2})
+	stub := fake.DeleteMCSLeaseStub
+	fakeReturns := fake.deleteMCSLeaseReturns
+	fake.recordInvocation("DeleteMCSLease", []interface{}{arg1, arg2})
+	fake.deleteMCSLeaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) DeleteMCSLeaseCallCount() int {
+	fake.deleteMCSLeaseMutex.RLock()
+	defer fake.deleteMCSLeaseMutex.RUnlock()
+	return len(fake.deleteMCSLeaseArgsForCall)
+}
+
+func (fake *FakeImpl) DeleteMCSLeaseCalls(stub func(context.Context, *v1.Lease) error) {
+	fake.deleteMCSLeaseMutex.Lock()
+	defer fake.deleteMCSLeaseMutex.Unlock()
+	fake.DeleteMCSLeaseStub = stub
+}
+
+func (fake *FakeImpl) DeleteMCSLeaseArgsForCall(i int) (context.Context, *v1.Lease) {
+	fake.deleteMCSLeaseMutex.RLock()
+	defer fake.deleteMCSLeaseMutex.RUnlock()
+	argsForCall := fake.deleteMCSLeaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) DeleteMCSLeaseReturns(result1 error) {
+	fake.deleteMCSLeaseMutex.Lock()
+	defer fake.deleteMCSLeaseMutex.Unlock()
+	fake.DeleteMCSLeaseStub = nil
+	fake.deleteMCSLeaseReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) DeleteMCSLeaseReturnsOnCall(i int, result1 error) {
+	fake.deleteMCSLeaseMutex.Lock()
+	defer fake.deleteMCSLeaseMutex.Unlock()
+	fake.DeleteMCSLeaseStub = nil
+	if fake.deleteMCSLeaseReturnsOnCall == nil {
+		fake.deleteMCSLeaseReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteMCSLeaseReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) DeleteSelinuxMCSAllocation(arg1 context.Context, arg2 *v1alpha2.SelinuxMCSAllocation) error {
+	fake.deleteSelinuxMCSAllocationMutex.Lock()
+	ret, specificReturn := fake.deleteSelinuxMCSAllocationReturnsOnCall[len(fake.deleteSelinuxMCSAllocationArgsForCall)]
+	fake.deleteSelinuxMCSAllocationArgsForCall = append(fake.deleteSelinuxMCSAllocationArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha2.SelinuxMCSAllocation
+	}{arg1, arg2})
+	stub := fake.DeleteSelinuxMCSAllocationStub
+	fakeReturns := fake.deleteSelinuxMCSAllocationReturns
+	fake.recordInvocation("DeleteSelinuxMCSAllocation", []interface{}{arg1, arg2})
+	fake.deleteSelinuxMCSAllocationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) DeleteSelinuxMCSAllocationCallCount() int {
+	fake.deleteSelinuxMCSAllocationMutex.RLock()
+	defer fake.deleteSelinuxMCSAllocationMutex.RUnlock()
+	return len(fake.deleteSelinuxMCSAllocationArgsForCall)
+}
+
+func (fake *FakeImpl) DeleteSelinuxMCSAllocationCalls(stub func(context.Context, *v1alpha2.SelinuxMCSAllocation) error) {
+	fake.deleteSelinuxMCSAllocationMutex.Lock()
+	defer fake.deleteSelinuxMCSAllocationMutex.Unlock()
+	fake.DeleteSelinuxMCSAllocationStub = stub
+}
+
+func (fake *FakeImpl) DeleteSelinuxMCSAllocationArgsForCall(i int) (context.Context, *v1alpha2.SelinuxMCSAllocation) {
+	fake.deleteSelinuxMCSAllocationMutex.RLock()
+	defer fake.deleteSelinuxMCSAllocationMutex.RUnlock()
+	argsForCall := fake.deleteSelinuxMCSAllocationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) DeleteSelinuxMCSAllocationReturns(result1 error) {
+	fake.deleteSelinuxMCSAllocationMutex.Lock()
+	defer fake.deleteSelinuxMCSAllocationMutex.Unlock()
+	fake.DeleteSelinuxMCSAllocationStub = nil
+	fake.deleteSelinuxMCSAllocationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) DeleteSelinuxMCSAllocationReturnsOnCall(i int, result1 error) {
+	fake.deleteSelinuxMCSAllocationMutex.Lock()
+	defer fake.deleteSelinuxMCSAllocationMutex.Unlock()
+	fake.DeleteSelinuxMCSAllocationStub = nil
+	if fake.deleteSelinuxMCSAllocationReturnsOnCall == nil {
+		fake.deleteSelinuxMCSAllocationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteSelinuxMCSAllocationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) GetBinding(arg1 context.Context, arg2 client.Object) error {
+	fake.getBindingMutex.Lock()
+	ret, specificReturn := fake.getBindingReturnsOnCall[len(fake.getBindingArgsForCall)]
+	fake.getBindingArgsForCall = append(fake.getBindingArgsForCall, struct {
+		arg1 context.Context
+		arg2 client.Object
+	}{arg1, arg2})
+	stub := fake.GetBindingStub
+	fakeReturns := fake.getBindingReturns
+	fake.recordInvocation("GetBinding", []interface{}{arg1, arg2})
+	fake.getBindingMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) GetBindingCallCount() int {
+	fake.getBindingMutex.RLock()
+	defer fake.getBindingMutex.RUnlock()
+	return len(fake.getBindingArgsForCall)
+}
+
+func (fake *FakeImpl) GetBindingCalls(stub func(context.Context, client.Object) error) {
+	fake.getBindingMutex.Lock()
+	defer fake.getBindingMutex.Unlock()
+	fake.GetBindingStub = stub
+}
+
+func (fake *FakeImpl) GetBindingArgsForCall(i int) (context.Context, client.Object) {
+	fake.getBindingMutex.RLock()
+	defer fake.getBindingMutex.RUnlock()
+	argsForCall := fake.getBindingArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) GetBindingReturns(result1 error) {
+	fake.getBindingMutex.Lock()
+	defer fake.getBindingMutex.Unlock()
+	fake.GetBindingStub = nil
+	fake.getBindingReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) GetBindingReturnsOnCall(i int, result1 error) {
+	fake.getBindingMutex.Lock()
+	defer fake.getBindingMutex.Unlock()
+	fake.GetBindingStub = nil
+	if fake.getBindingReturnsOnCall == nil {
+		fake.getBindingReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.getBindingReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) GetClusterSeccompProfile(arg1 context.Context, arg2 string) (*v1beta1.ClusterSeccompProfile, error) {
+	fake.getClusterSeccompProfileMutex.Lock()
+	ret, specificReturn := fake.getClusterSeccompProfileReturnsOnCall[len(fake.getClusterSeccompProfileArgsForCall)]
+	fake.getClusterSeccompProfileArgsForCall = append(fake.getClusterSeccompProfileArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetClusterSeccompProfileStub
+	fakeReturns := fake.getClusterSeccompProfileReturns
+	fake.recordInvocation("GetClusterSeccompProfile", []interface{}{arg1, arg2})
+	fake.getClusterSeccompProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) GetClusterSeccompProfileCallCount() int {
+	fake.getClusterSeccompProfileMutex.RLock()
+	defer fake.getClusterSeccompProfileMutex.RUnlock()
+	return len(fake.getClusterSeccompProfileArgsForCall)
+}
+
+func (fake *FakeImpl) GetClusterSeccompProfileCalls(stub func(context.Context, string) (*v1beta1.ClusterSeccompProfile, error)) {
+	fake.getClusterSeccompProfileMutex.Lock()
+	defer fake.getClusterSeccompProfileMutex.Unlock()
+	fake.GetClusterSeccompProfileStub = stub
+}
+
+func (fake *FakeImpl) GetClusterSeccompProfileArgsForCall(i int) (context.Context, string) {
+	fake.getClusterSeccompProfileMutex.RLock()
+	defer fake.getClusterSeccompProfileMutex.RUnlock()
+	argsForCall := fake.getClusterSeccompProfileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) GetClusterSeccompProfileReturns(result1 *v1beta1.ClusterSeccompProfile, result2 error) {
+	fake.getClusterSeccompProfileMutex.Lock()
+	defer fake.getClusterSeccompProfileMutex.Unlock()
+	fake.GetClusterSeccompProfileStub = nil
+	fake.getClusterSeccompProfileReturns = struct {
+		result1 *v1beta1.ClusterSeccompProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetClusterSeccompProfileReturnsOnCall(i int, result1 *v1beta1.ClusterSeccompProfile, result2 error) {
+	fake.getClusterSeccompProfileMutex.Lock()
+	defer fake.getClusterSeccompProfileMutex.Unlock()
+	fake.GetClusterSeccompProfileStub = nil
+	if fake.getClusterSeccompProfileReturnsOnCall == nil {
+		fake.getClusterSeccompProfileReturnsOnCall = make(map[int]struct {
+			result1 *v1beta1.ClusterSeccompProfile
+			result2 error
+		})
+	}
+	fake.getClusterSeccompProfileReturnsOnCall[i] = struct {
+		result1 *v1beta1.ClusterSeccompProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetClusterSelinuxProfile(arg1 context.Context, arg2 string) (*v1alpha2.ClusterSelinuxProfile, error) {
+	fake.getClusterSelinuxProfileMutex.Lock()
+	ret, specificReturn := fake.getClusterSelinuxProfileReturnsOnCall[len(fake.getClusterSelinuxProfileArgsForCall)]
+	fake.getClusterSelinuxProfileArgsForCall = append(fake.getClusterSelinuxProfileArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetClusterSelinuxProfileStub
+	fakeReturns := fake.getClusterSelinuxProfileReturns
+	fake.recordInvocation("GetClusterSelinuxProfile", []interface{}{arg1, arg2})
+	fake.getClusterSelinuxProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) GetClusterSelinuxProfileCallCount() int {
+	fake.getClusterSelinuxProfileMutex.RLock()
+	defer fake.getClusterSelinuxProfileMutex.RUnlock()
+	return len(fake.getClusterSelinuxProfileArgsForCall)
+}
+
+func (fake *FakeImpl) GetClusterSelinuxProfileCalls(stub func(context.Context, string) (*v1alpha2.ClusterSelinuxProfile, error)) {
+	fake.getClusterSelinuxProfileMutex.Lock()
+	defer fake.getClusterSelinuxProfileMutex.Unlock()
+	fake.GetClusterSelinuxProfileStub = stub
+}
+
+func (fake *FakeImpl) GetClusterSelinuxProfileArgsForCall(i int) (context.Context, string) {
+	fake.getClusterSelinuxProfileMutex.RLock()
+	defer fake.getClusterSelinuxProfileMutex.RUnlock()
+	argsForCall := fake.getClusterSelinuxProfileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) GetClusterSelinuxProfileReturns(result1 *v1alpha2.ClusterSelinuxProfile, result2 error) {
+	fake.getClusterSelinuxProfileMutex.Lock()
+	defer fake.getClusterSelinuxProfileMutex.Unlock()
+	fake.GetClusterSelinuxProfileStub = nil
+	fake.getClusterSelinuxProfileReturns = struct {
+		result1 *v1alpha2.ClusterSelinuxProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetClusterSelinuxProfileReturnsOnCall(i int, result1 *v1alpha2.ClusterSelinuxProfile, result2 error) {
+	fake.getClusterSelinuxProfileMutex.Lock()
+	defer fake.getClusterSelinuxProfileMutex.Unlock()
+	fake.GetClusterSelinuxProfileStub = nil
+	if fake.getClusterSelinuxProfileReturnsOnCall == nil {
+		fake.getClusterSelinuxProfileReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha2.ClusterSelinuxProfile
+			result2 error
+		})
+	}
+	fake.getClusterSelinuxProfileReturnsOnCall[i] = struct {
+		result1 *v1alpha2.ClusterSelinuxProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetNamespace(arg1 context.Context, arg2 string) (*v1a.Namespace, error) {
+	fake.getNamespaceMutex.Lock()
+	ret, specificReturn := fake.getNamespaceReturnsOnCall[len(fake.getNamespaceArgsForCall)]
+	fake.getNamespaceArgsForCall = append(fake.getNamespaceArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetNamespaceStub
+	fakeReturns := fake.getNamespaceReturns
+	fake.recordInvocation("GetNamespace", []interface{}{arg1, arg2})
+	fake.getNamespaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) GetNamespaceCallCount() int {
+	fake.getNamespaceMutex.RLock()
+	defer fake.getNamespaceMutex.RUnlock()
+	return len(fake.getNamespaceArgsForCall)
+}
+
+func (fake *FakeImpl) GetNamespaceCalls(stub func(context.Context, string) (*v1a.Namespace, error)) {
+	fake.getNamespaceMutex.Lock()
+	defer fake.getNamespaceMutex.Unlock()
+	fake.GetNamespaceStub = stub
+}
+
+func (fake *FakeImpl) GetNamespaceArgsForCall(i int) (context.Context, string) {
+	fake.getNamespaceMutex.RLock()
+	defer fake.getNamespaceMutex.RUnlock()
+	argsForCall := fake.getNamespaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) GetNamespaceReturns(result1 *v1a.Namespace, result2 error) {
+	fake.getNamespaceMutex.Lock()
+	defer fake.getNamespaceMutex.Unlock()
+	fake.GetNamespaceStub = nil
+	fake.getNamespaceReturns = struct {
+		result1 *v1a.Namespace
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GetNamespaceReturnsOnCall(i int, result1 *v1a.Namespace, result2 error) {
+	fake.getNamespaceMutex.Lock()
+	defer fake.getNamespaceMutex.Unlock()
+	fake.GetNamespaceStub = nil
+	if fake.getNamespaceReturnsOnCall == nil {
+		fake.getNamespaceReturnsOnCall = make(map[int]struct {
+			result1 *v1a.Namespace
+			result2 error
+		})
+	}
+	fake.getNamespaceReturnsOnCall[i] = struct {
+		result1 *v1a.Namespace
 		result2 error
 	}{result1, result2}
 }
@@ -313,6 +988,264 @@ func (fake *FakeImpl) GetSelinuxProfileReturnsOnCall(i int, result1 *v1alpha2.Se
 	}{result1, result2}
 }
 
+func (fake *FakeImpl) ListClusterProfileBindings(arg1 context.Context, arg2 ...client.ListOption) (*v1alpha1.ClusterProfileBindingList, error) {
+	fake.listClusterProfileBindingsMutex.Lock()
+	ret, specificReturn := fake.listClusterProfileBindingsReturnsOnCall[len(fake.listClusterProfileBindingsArgsForCall)]
+	fake.listClusterProfileBindingsArgsForCall = append(fake.listClusterProfileBindingsArgsForCall, struct {
+		arg1 context.Context
+		arg2 []client.ListOption
+	}{arg1, arg2})
+	stub := fake.ListClusterProfileBindingsStub
+	fakeReturns := fake.listClusterProfileBindingsReturns
+	fake.recordInvocation("ListClusterProfileBindings", []interface{}{arg1, arg2})
+	fake.listClusterProfileBindingsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) ListClusterProfileBindingsCallCount() int {
+	fake.listClusterProfileBindingsMutex.RLock()
+	defer fake.listClusterProfileBindingsMutex.RUnlock()
+	return len(fake.listClusterProfileBindingsArgsForCall)
+}
+
+func (fake *FakeImpl) ListClusterProfileBindingsCalls(stub func(context.Context, ...client.ListOption) (*v1alpha1.ClusterProfileBindingList, error)) {
+	fake.listClusterProfileBindingsMutex.Lock()
+	defer fake.listClusterProfileBindingsMutex.Unlock()
+	fake.ListClusterProfileBindingsStub = stub
+}
+
+func (fake *FakeImpl) ListClusterProfileBindingsArgsForCall(i int) (context.Context, []client.ListOption) {
+	fake.listClusterProfileBindingsMutex.RLock()
+	defer fake.listClusterProfileBindingsMutex.RUnlock()
+	argsForCall := fake.listClusterProfileBindingsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) ListClusterProfileBindingsReturns(result1 *v1alpha1.ClusterProfileBindingList, result2 error) {
+	fake.listClusterProfileBindingsMutex.Lock()
+	defer fake.listClusterProfileBindingsMutex.Unlock()
+	fake.ListClusterProfileBindingsStub = nil
+	fake.listClusterProfileBindingsReturns = struct {
+		result1 *v1alpha1.ClusterProfileBindingList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) ListClusterProfileBindingsReturnsOnCall(i int, result1 *v1alpha1.ClusterProfileBindingList, result2 error) {
+	fake.listClusterProfileBindingsMutex.Lock()
+	defer fake.listClusterProfileBindingsMutex.Unlock()
+	fake.ListClusterProfileBindingsStub = nil
+	if fake.listClusterProfileBindingsReturnsOnCall == nil {
+		fake.listClusterProfileBindingsReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.ClusterProfileBindingList
+			result2 error
+		})
+	}
+	fake.listClusterProfileBindingsReturnsOnCall[i] = struct {
+		result1 *v1alpha1.ClusterProfileBindingList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) ListMCSLeases(arg1 context.Context) (*v1.LeaseList, error) {
+	fake.listMCSLeasesMutex.Lock()
+	ret, specificReturn := fake.listMCSLeasesReturnsOnCall[len(fake.listMCSLeasesArgsForCall)]
+	fake.listMCSLeasesArgsForCall = append(fake.listMCSLeasesArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ListMCSLeasesStub
+	fakeReturns := fake.listMCSLeasesReturns
+	fake.recordInvocation("ListMCSLeases", []interface{}{arg1})
+	fake.listMCSLeasesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) ListMCSLeasesCallCount() int {
+	fake.listMCSLeasesMutex.RLock()
+	defer fake.listMCSLeasesMutex.RUnlock()
+	return len(fake.listMCSLeasesArgsForCall)
+}
+
+func (fake *FakeImpl) ListMCSLeasesCalls(stub func(context.Context) (*v1.LeaseList, error)) {
+	fake.listMCSLeasesMutex.Lock()
+	defer fake.listMCSLeasesMutex.Unlock()
+	fake.ListMCSLeasesStub = stub
+}
+
+func (fake *FakeImpl) ListMCSLeasesArgsForCall(i int) context.Context {
+	fake.listMCSLeasesMutex.RLock()
+	defer fake.listMCSLeasesMutex.RUnlock()
+	argsForCall := fake.listMCSLeasesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) ListMCSLeasesReturns(result1 *v1.LeaseList, result2 error) {
+	fake.listMCSLeasesMutex.Lock()
+	defer fake.listMCSLeasesMutex.Unlock()
+	fake.ListMCSLeasesStub = nil
+	fake.listMCSLeasesReturns = struct {
+		result1 *v1.LeaseList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) ListMCSLeasesReturnsOnCall(i int, result1 *v1.LeaseList, result2 error) {
+	fake.listMCSLeasesMutex.Lock()
+	defer fake.listMCSLeasesMutex.Unlock()
+	fake.ListMCSLeasesStub = nil
+	if fake.listMCSLeasesReturnsOnCall == nil {
+		fake.listMCSLeasesReturnsOnCall = make(map[int]struct {
+			result1 *v1.LeaseList
+			result2 error
+		})
+	}
+	fake.listMCSLeasesReturnsOnCall[i] = struct {
+		result1 *v1.LeaseList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) ListNamespaces(arg1 context.Context) (*v1a.NamespaceList, error) {
+	fake.listNamespacesMutex.Lock()
+	ret, specificReturn := fake.listNamespacesReturnsOnCall[len(fake.listNamespacesArgsForCall)]
+	fake.listNamespacesArgsForCall = append(fake.listNamespacesArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ListNamespacesStub
+	fakeReturns := fake.listNamespacesReturns
+	fake.recordInvocation("ListNamespaces", []interface{}{arg1})
+	fake.listNamespacesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) ListNamespacesCallCount() int {
+	fake.listNamespacesMutex.RLock()
+	defer fake.listNamespacesMutex.RUnlock()
+	return len(fake.listNamespacesArgsForCall)
+}
+
+func (fake *FakeImpl) ListNamespacesCalls(stub func(context.Context) (*v1a.NamespaceList, error)) {
+	fake.listNamespacesMutex.Lock()
+	defer fake.listNamespacesMutex.Unlock()
+	fake.ListNamespacesStub = stub
+}
+
+func (fake *FakeImpl) ListNamespacesArgsForCall(i int) context.Context {
+	fake.listNamespacesMutex.RLock()
+	defer fake.listNamespacesMutex.RUnlock()
+	argsForCall := fake.listNamespacesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) ListNamespacesReturns(result1 *v1a.NamespaceList, result2 error) {
+	fake.listNamespacesMutex.Lock()
+	defer fake.listNamespacesMutex.Unlock()
+	fake.ListNamespacesStub = nil
+	fake.listNamespacesReturns = struct {
+		result1 *v1a.NamespaceList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) ListNamespacesReturnsOnCall(i int, result1 *v1a.NamespaceList, result2 error) {
+	fake.listNamespacesMutex.Lock()
+	defer fake.listNamespacesMutex.Unlock()
+	fake.ListNamespacesStub = nil
+	if fake.listNamespacesReturnsOnCall == nil {
+		fake.listNamespacesReturnsOnCall = make(map[int]struct {
+			result1 *v1a.NamespaceList
+			result2 error
+		})
+	}
+	fake.listNamespacesReturnsOnCall[i] = struct {
+		result1 *v1a.NamespaceList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) ListPods(arg1 context.Context, arg2 string) (*v1a.PodList, error) {
+	fake.listPodsMutex.Lock()
+	ret, specificReturn := fake.listPodsReturnsOnCall[len(fake.listPodsArgsForCall)]
+	fake.listPodsArgsForCall = append(fake.listPodsArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ListPodsStub
+	fakeReturns := fake.listPodsReturns
+	fake.recordInvocation("ListPods", []interface{}{arg1, arg2})
+	fake.listPodsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) ListPodsCallCount() int {
+	fake.listPodsMutex.RLock()
+	defer fake.listPodsMutex.RUnlock()
+	return len(fake.listPodsArgsForCall)
+}
+
+func (fake *FakeImpl) ListPodsCalls(stub func(context.Context, string) (*v1a.PodList, error)) {
+	fake.listPodsMutex.Lock()
+	defer fake.listPodsMutex.Unlock()
+	fake.ListPodsStub = stub
+}
+
+func (fake *FakeImpl) ListPodsArgsForCall(i int) (context.Context, string) {
+	fake.listPodsMutex.RLock()
+	defer fake.listPodsMutex.RUnlock()
+	argsForCall := fake.listPodsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImpl) ListPodsReturns(result1 *v1a.PodList, result2 error) {
+	fake.listPodsMutex.Lock()
+	defer fake.listPodsMutex.Unlock()
+	fake.ListPodsStub = nil
+	fake.listPodsReturns = struct {
+		result1 *v1a.PodList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) ListPodsReturnsOnCall(i int, result1 *v1a.PodList, result2 error) {
+	fake.listPodsMutex.Lock()
+	defer fake.listPodsMutex.Unlock()
+	fake.ListPodsStub = nil
+	if fake.listPodsReturnsOnCall == nil {
+		fake.listPodsReturnsOnCall = make(map[int]struct {
+			result1 *v1a.PodList
+			result2 error
+		})
+	}
+	fake.listPodsReturnsOnCall[i] = struct {
+		result1 *v1a.PodList
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeImpl) ListProfileBindings(arg1 context.Context, arg2 ...client.ListOption) (*v1alpha1.ProfileBindingList, error) {
 	fake.listProfileBindingsMutex.Lock()
 	ret, specificReturn := fake.listProfileBindingsReturnsOnCall[len(fake.listProfileBindingsArgsForCall)]
@@ -378,6 +1311,70 @@ func (fake *FakeImpl) ListProfileBindingsReturnsOnCall(i int, result1 *v1alpha1.
 	}{result1, result2}
 }
 
+func (fake *FakeImpl) ListSelinuxMCSAllocations(arg1 context.Context) (*v1alpha2.SelinuxMCSAllocationList, error) {
+	fake.listSelinuxMCSAllocationsMutex.Lock()
+	ret, specificReturn := fake.listSelinuxMCSAllocationsReturnsOnCall[len(fake.listSelinuxMCSAllocationsArgsForCall)]
+	fake.listSelinuxMCSAllocationsArgsForCall = append(fake.listSelinuxMCSAllocationsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ListSelinuxMCSAllocationsStub
+	fakeReturns := fake.listSelinuxMCSAllocationsReturns
+	fake.recordInvocation("ListSelinuxMCSAllocations", []interface{}{arg1})
+	fake.listSelinuxMCSAllocationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) ListSelinuxMCSAllocationsCallCount() int {
+	fake.listSelinuxMCSAllocationsMutex.RLock()
+	defer fake.listSelinuxMCSAllocationsMutex.RUnlock()
+	return len(fake.listSelinuxMCSAllocationsArgsForCall)
+}
+
+func (fake *FakeImpl) ListSelinuxMCSAllocationsCalls(stub func(context.Context) (*v1alpha2.SelinuxMCSAllocationList, error)) {
+	fake.listSelinuxMCSAllocationsMutex.Lock()
+	defer fake.listSelinuxMCSAllocationsMutex.Unlock()
+	fake.ListSelinuxMCSAllocationsStub = stub
+}
+
+func (fake *FakeImpl) ListSelinuxMCSAllocationsArgsForCall(i int) context.Context {
+	fake.listSelinuxMCSAllocationsMutex.RLock()
+	defer fake.listSelinuxMCSAllocationsMutex.RUnlock()
+	argsForCall := fake.listSelinuxMCSAllocationsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) ListSelinuxMCSAllocationsReturns(result1 *v1alpha2.SelinuxMCSAllocationList, result2 error) {
+	fake.listSelinuxMCSAllocationsMutex.Lock()
+	defer fake.listSelinuxMCSAllocationsMutex.Unlock()
+	fake.ListSelinuxMCSAllocationsStub = nil
+	fake.listSelinuxMCSAllocationsReturns = struct {
+		result1 *v1alpha2.SelinuxMCSAllocationList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) ListSelinuxMCSAllocationsReturnsOnCall(i int, result1 *v1alpha2.SelinuxMCSAllocationList, result2 error) {
+	fake.listSelinuxMCSAllocationsMutex.Lock()
+	defer fake.listSelinuxMCSAllocationsMutex.Unlock()
+	fake.ListSelinuxMCSAllocationsStub = nil
+	if fake.listSelinuxMCSAllocationsReturnsOnCall == nil {
+		fake.listSelinuxMCSAllocationsReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha2.SelinuxMCSAllocationList
+			result2 error
+		})
+	}
+	fake.listSelinuxMCSAllocationsReturnsOnCall[i] = struct {
+		result1 *v1alpha2.SelinuxMCSAllocationList
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeImpl) UpdateResource(arg1 context.Context, arg2 logr.Logger, arg3 client.Object, arg4 string) error {
 	fake.updateResourceMutex.Lock()
 	ret, specificReturn := fake.updateResourceReturnsOnCall[len(fake.updateResourceArgsForCall)]
@@ -509,14 +1506,40 @@ func (fake *FakeImpl) UpdateResourceStatusReturnsOnCall(i int, result1 error) {
 func (fake *FakeImpl) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.createMCSLeaseMutex.RLock()
+	defer fake.createMCSLeaseMutex.RUnlock()
+	fake.createSelinuxMCSAllocationMutex.RLock()
+	defer fake.createSelinuxMCSAllocationMutex.RUnlock()
 	fake.decodePodMutex.RLock()
 	defer fake.decodePodMutex.RUnlock()
+	fake.deleteMCSLeaseMutex.RLock()
+	defer fake.deleteMCSLeaseMutex.RUnlock()
+	fake.deleteSelinuxMCSAllocationMutex.RLock()
+	defer fake.deleteSelinuxMCSAllocationMutex.RUnlock()
+	fake.getBindingMutex.RLock()
+	defer fake.getBindingMutex.RUnlock()
+	fake.getClusterSeccompProfileMutex.RLock()
+	defer fake.getClusterSeccompProfileMutex.RUnlock()
+	fake.getClusterSelinuxProfileMutex.RLock()
+	defer fake.getClusterSelinuxProfileMutex.RUnlock()
+	fake.getNamespaceMutex.RLock()
+	defer fake.getNamespaceMutex.RUnlock()
 	fake.getSeccompProfileMutex.RLock()
 	defer fake.getSeccompProfileMutex.RUnlock()
 	fake.getSelinuxProfileMutex.RLock()
 	defer fake.getSelinuxProfileMutex.RUnlock()
+	fake.listClusterProfileBindingsMutex.RLock()
+	defer fake.listClusterProfileBindingsMutex.RUnlock()
+	fake.listMCSLeasesMutex.RLock()
+	defer fake.listMCSLeasesMutex.RUnlock()
+	fake.listNamespacesMutex.RLock()
+	defer fake.listNamespacesMutex.RUnlock()
+	fake.listPodsMutex.RLock()
+	defer fake.listPodsMutex.RUnlock()
 	fake.listProfileBindingsMutex.RLock()
 	defer fake.listProfileBindingsMutex.RUnlock()
+	fake.listSelinuxMCSAllocationsMutex.RLock()
+	defer fake.listSelinuxMCSAllocationsMutex.RUnlock()
 	fake.updateResourceMutex.RLock()
 	defer fake.updateResourceMutex.RUnlock()
 	fake.updateResourceStatusMutex.RLock()
@@ -21,6 +21,7 @@ import (
 	"fmt"
 
 	"github.com/go-logr/logr"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -29,11 +30,13 @@ import (
 	"sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
 	seccompprofileapi "sigs.k8s.io/security-profiles-operator/api/seccompprofile/v1beta1"
 	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/config"
 	"sigs.k8s.io/security-profiles-operator/internal/pkg/webhooks/utils"
 )
 
 type defaultImpl struct {
 	client  client.Client
+	reader  client.Reader
 	decoder *admission.Decoder
 }
 
@@ -41,11 +44,24 @@ type defaultImpl struct {
 //counterfeiter:generate . impl
 type impl interface {
 	ListProfileBindings(context.Context, ...client.ListOption) (*v1alpha1.ProfileBindingList, error)
+	ListClusterProfileBindings(context.Context, ...client.ListOption) (*v1alpha1.ClusterProfileBindingList, error)
+	GetNamespace(context.Context, string) (*corev1.Namespace, error)
+	ListNamespaces(context.Context) (*corev1.NamespaceList, error)
+	GetBinding(context.Context, client.Object) error
+	ListPods(context.Context, string) (*corev1.PodList, error)
 	UpdateResource(context.Context, logr.Logger, client.Object, string) error
 	UpdateResourceStatus(context.Context, logr.Logger, client.Object, string) error
 	DecodePod(admission.Request) (*corev1.Pod, error)
 	GetSeccompProfile(context.Context, types.NamespacedName) (*seccompprofileapi.SeccompProfile, error)
 	GetSelinuxProfile(context.Context, types.NamespacedName) (*selinuxprofileapi.SelinuxProfile, error)
+	GetClusterSeccompProfile(context.Context, string) (*seccompprofileapi.ClusterSeccompProfile, error)
+	GetClusterSelinuxProfile(context.Context, string) (*selinuxprofileapi.ClusterSelinuxProfile, error)
+	ListSelinuxMCSAllocations(context.Context) (*selinuxprofileapi.SelinuxMCSAllocationList, error)
+	CreateSelinuxMCSAllocation(context.Context, *selinuxprofileapi.SelinuxMCSAllocation) error
+	DeleteSelinuxMCSAllocation(context.Context, *selinuxprofileapi.SelinuxMCSAllocation) error
+	ListMCSLeases(context.Context) (*coordinationv1.LeaseList, error)
+	CreateMCSLease(context.Context, *coordinationv1.Lease) error
+	DeleteMCSLease(context.Context, *coordinationv1.Lease) error
 }
 
 func (d *defaultImpl) ListProfileBindings(
@@ -58,6 +74,49 @@ func (d *defaultImpl) ListProfileBindings(
 	return profileBindings, nil
 }
 
+func (d *defaultImpl) ListClusterProfileBindings(
+	ctx context.Context, opts ...client.ListOption,
+) (*v1alpha1.ClusterProfileBindingList, error) {
+	clusterProfileBindings := &v1alpha1.ClusterProfileBindingList{}
+	if err := d.client.List(ctx, clusterProfileBindings, opts...); err != nil {
+		return nil, fmt.Errorf("list cluster profile bindings: %w", err)
+	}
+	return clusterProfileBindings, nil
+}
+
+func (d *defaultImpl) GetNamespace(ctx context.Context, name string) (*corev1.Namespace, error) {
+	namespace := &corev1.Namespace{}
+	if err := d.client.Get(ctx, types.NamespacedName{Name: name}, namespace); err != nil {
+		return nil, fmt.Errorf("get namespace: %w", err)
+	}
+	return namespace, nil
+}
+
+func (d *defaultImpl) ListNamespaces(ctx context.Context) (*corev1.NamespaceList, error) {
+	namespaces := &corev1.NamespaceList{}
+	if err := d.client.List(ctx, namespaces); err != nil {
+		return nil, fmt.Errorf("list namespaces: %w", err)
+	}
+	return namespaces, nil
+}
+
+// GetBinding refetches the binding without using the cache, because it is
+// used to resolve update conflicts.
+func (d *defaultImpl) GetBinding(ctx context.Context, binding client.Object) error {
+	if err := d.reader.Get(ctx, client.ObjectKeyFromObject(binding), binding); err != nil {
+		return fmt.Errorf("get binding: %w", err)
+	}
+	return nil
+}
+
+func (d *defaultImpl) ListPods(ctx context.Context, namespace string) (*corev1.PodList, error) {
+	pods := &corev1.PodList{}
+	if err := d.client.List(ctx, pods, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("list pods: %w", err)
+	}
+	return pods, nil
+}
+
 func (d *defaultImpl) UpdateResource(
 	ctx context.Context,
 	logger logr.Logger,
@@ -105,3 +164,83 @@ func (d *defaultImpl) GetSelinuxProfile(
 	}
 	return selinuxProfile, nil
 }
+
+func (d *defaultImpl) GetClusterSeccompProfile(
+	ctx context.Context, name string,
+) (*seccompprofileapi.ClusterSeccompProfile, error) {
+	seccompProfile := &seccompprofileapi.ClusterSeccompProfile{}
+	if err := d.client.Get(ctx, types.NamespacedName{Name: name}, seccompProfile); err != nil {
+		return nil, fmt.Errorf("get cluster seccomp profile: %w", err)
+	}
+	return seccompProfile, nil
+}
+
+func (d *defaultImpl) GetClusterSelinuxProfile(
+	ctx context.Context, name string,
+) (*selinuxprofileapi.ClusterSelinuxProfile, error) {
+	selinuxProfile := &selinuxprofileapi.ClusterSelinuxProfile{}
+	if err := d.client.Get(ctx, types.NamespacedName{Name: name}, selinuxProfile); err != nil {
+		return nil, fmt.Errorf("get cluster selinux profile: %w", err)
+	}
+	return selinuxProfile, nil
+}
+
+// ListSelinuxMCSAllocations lists the allocations without using the cache,
+// because allocations created by previous requests have to be considered.
+func (d *defaultImpl) ListSelinuxMCSAllocations(
+	ctx context.Context,
+) (*selinuxprofileapi.SelinuxMCSAllocationList, error) {
+	allocations := &selinuxprofileapi.SelinuxMCSAllocationList{}
+	if err := d.reader.List(ctx, allocations); err != nil {
+		return nil, fmt.Errorf("list selinux MCS allocations: %w", err)
+	}
+	return allocations, nil
+}
+
+func (d *defaultImpl) CreateSelinuxMCSAllocation(
+	ctx context.Context, allocation *selinuxprofileapi.SelinuxMCSAllocation,
+) error {
+	if err := d.client.Create(ctx, allocation); err != nil {
+		return fmt.Errorf("create selinux MCS allocation: %w", err)
+	}
+	return nil
+}
+
+func (d *defaultImpl) DeleteSelinuxMCSAllocation(
+	ctx context.Context, allocation *selinuxprofileapi.SelinuxMCSAllocation,
+) error {
+	if err := d.client.Delete(ctx, allocation); err != nil {
+		return fmt.Errorf("delete selinux MCS allocation: %w", err)
+	}
+	return nil
+}
+
+// ListMCSLeases lists the leases reserving MCS categories without using the
+// cache, because leases created by previous requests have to be considered.
+func (d *defaultImpl) ListMCSLeases(ctx context.Context) (*coordinationv1.LeaseList, error) {
+	leases := &coordinationv1.LeaseList{}
+	if err := d.reader.List(ctx, leases,
+		client.InNamespace(config.GetOperatorNamespace()), client.HasLabels{mcsLeaseLabel},
+	); err != nil {
+		return nil, fmt.Errorf("list MCS leases: %w", err)
+	}
+	return leases, nil
+}
+
+// CreateMCSLease creates the lease in the operator namespace.
+func (d *defaultImpl) CreateMCSLease(ctx context.Context, lease *coordinationv1.Lease) error {
+	lease.Namespace = config.GetOperatorNamespace()
+	if err := d.client.Create(ctx, lease); err != nil {
+		return fmt.Errorf("create MCS lease: %w", err)
+	}
+	return nil
+}
+
+// DeleteMCSLease deletes the lease from the operator namespace.
+func (d *defaultImpl) DeleteMCSLease(ctx context.Context, lease *coordinationv1.Lease) error {
+	lease.Namespace = config.GetOperatorNamespace()
+	if err := d.client.Delete(ctx, lease); err != nil {
+		return fmt.Errorf("delete MCS lease: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,387 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	profilebindingv1alpha1 "sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
+	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+)
+
+// maxMCSAllocationAttempts is the number of times an allocation is retried
+// if other webhook replicas allocated the same categories concurrently.
+const maxMCSAllocationAttempts = 5
+
+// openShiftMCSAnnotation is the namespace annotation holding the MCS level
+// which OpenShift allocated to the namespace.
+const openShiftMCSAnnotation = "openshift.io/sa.scc.mcs"
+
+const (
+	// mcsLeasePrefix is the name prefix of the leases reserving MCS
+	// categories in the operator namespace.
+	mcsLeasePrefix = "selinux-mcs-"
+
+	// mcsLeaseLabel is the label of the leases reserving MCS categories,
+	// whose value are the reserved categories, like c0-c1.
+	mcsLeaseLabel = "spo.x-k8s.io/mcs-categories"
+)
+
+var (
+	// ErrMCSCategoriesExhausted is returned if all MCS category pairs are
+	// allocated.
+	ErrMCSCategoriesExhausted = errors.New("all MCS category pairs are allocated")
+
+	// ErrMCSAllocationConflict is returned if the categories could not be
+	// allocated because of concurrent allocations.
+	ErrMCSAllocationConflict = errors.New("too many concurrent MCS allocations")
+
+	// ErrMixedMCSLevels is returned if the containers of a pod would run
+	// with different MCS levels.
+	ErrMixedMCSLevels = errors.New("pod uses mixed MCS levels")
+)
+
+// mcsOwner returns the owner of the MCS categories used by the pods of the
+// namespace bound by the binding, or nil if the binding does not allocate
+// categories.
+func mcsOwner(namespace string, pb *binding) *selinuxprofileapi.MCSOwner {
+	switch pb.mcsAllocation {
+	case profilebindingv1alpha1.MCSAllocationNamespace:
+		return &selinuxprofileapi.MCSOwner{
+			Kind:      selinuxprofileapi.MCSOwnerKindNamespace,
+			Namespace: namespace,
+		}
+	case profilebindingv1alpha1.MCSAllocationProfileBinding:
+		if pb.obj.GetNamespace() == "" {
+			return &selinuxprofileapi.MCSOwner{
+				Kind: selinuxprofileapi.MCSOwnerKindClusterProfileBinding,
+				Name: pb.obj.GetName(),
+			}
+		}
+		return &selinuxprofileapi.MCSOwner{
+			Kind:      selinuxprofileapi.MCSOwnerKindProfileBinding,
+			Namespace: pb.obj.GetNamespace(),
+			Name:      pb.obj.GetName(),
+		}
+	}
+	return nil
+}
+
+// mcsOwnerReference returns the owner reference which lets the garbage
+// collector delete the allocation together with its owner. Allocations of
+// ProfileBindings cannot reference the namespaced binding and reference its
+// namespace instead. They get released when the last pod of a deleted
+// binding is gone.
+func (p *podBinder) mcsOwnerReference(
+	ctx context.Context, owner *selinuxprofileapi.MCSOwner, pb *binding,
+) (*metav1.OwnerReference, error) {
+	var ref *metav1.OwnerReference
+	if owner.Kind == selinuxprofileapi.MCSOwnerKindClusterProfileBinding {
+		ref = metav1.NewControllerRef(
+			pb.obj, profilebindingv1alpha1.GroupVersion.WithKind("ClusterProfileBinding"),
+		)
+	} else {
+		ns, err := p.GetNamespace(ctx, owner.Namespace)
+		if err != nil {
+			return nil, fmt.Errorf("could not get namespace %s: %w", owner.Namespace, err)
+		}
+		ref = metav1.NewControllerRef(ns, corev1.SchemeGroupVersion.WithKind("Namespace"))
+	}
+
+	// Blocking the deletion of the owner would require the webhook to update
+	// the finalizers of namespaces, and the allocation does not need to
+	// outlive its owner anyway.
+	blockOwnerDeletion := false
+	ref.BlockOwnerDeletion = &blockOwnerDeletion
+	return ref, nil
+}
+
+// mcsLevel returns the MCS level allocated to the pods of the namespace
+// bound by the binding, and allocates a free pair of categories if none is
+// allocated yet. Namespaces which got a level allocated by OpenShift keep
+// using it. The level is empty if the binding does not allocate categories.
+func (p *podBinder) mcsLevel(ctx context.Context, namespace string, pb *binding) (string, error) {
+	owner := mcsOwner(namespace, pb)
+	if owner == nil {
+		return "", nil
+	}
+
+	if owner.Kind == selinuxprofileapi.MCSOwnerKindNamespace {
+		ns, err := p.GetNamespace(ctx, namespace)
+		if err != nil {
+			return "", fmt.Errorf("could not get namespace %s: %w", namespace, err)
+		}
+		if level := ns.GetAnnotations()[openShiftMCSAnnotation]; level != "" {
+			return level, nil
+		}
+	}
+
+	ownerRef, err := p.mcsOwnerReference(ctx, owner, pb)
+	if err != nil {
+		return "", err
+	}
+	name := selinuxprofileapi.MCSAllocationName(*owner)
+
+	p.mcsMutex.Lock()
+	defer p.mcsMutex.Unlock()
+
+	for attempt := 0; attempt < maxMCSAllocationAttempts; attempt++ {
+		allocations, err := p.ListSelinuxMCSAllocations(ctx)
+		if err != nil {
+			return "", fmt.Errorf("could not list MCS allocations: %w", err)
+		}
+
+		if allocation := findMCSAllocation(allocations, name); allocation != nil {
+			return allocation.Level(), nil
+		}
+
+		used, err := p.usedMCSCategories(ctx, allocations)
+		if err != nil {
+			return "", err
+		}
+		first, second, ok := freeMCSCategories(used)
+		if !ok {
+			return "", ErrMCSCategoriesExhausted
+		}
+
+		// Reserve the categories first, so that they cannot be allocated to
+		// another owner concurrently
+		lease := mcsLease(first, second, name, ownerRef)
+		if err := p.CreateMCSLease(ctx, lease); err != nil {
+			if kerrors.IsAlreadyExists(err) {
+				continue
+			}
+			return "", fmt.Errorf("could not reserve MCS categories: %w", err)
+		}
+
+		allocation := &selinuxprofileapi.SelinuxMCSAllocation{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				OwnerReferences: []metav1.OwnerReference{*ownerRef},
+			},
+			Spec: selinuxprofileapi.SelinuxMCSAllocationSpec{
+				Owner:      *owner,
+				Categories: []int{first, second},
+			},
+		}
+		err = p.CreateSelinuxMCSAllocation(ctx, allocation)
+		if err == nil {
+			p.log.Info("Allocated MCS categories",
+				"ownerKind", owner.Kind, "ownerNamespace", owner.Namespace, "ownerName", owner.Name,
+				"level", allocation.Level())
+			return allocation.Level(), nil
+		}
+		if releaseErr := p.DeleteMCSLease(ctx, lease); releaseErr != nil && !kerrors.IsNotFound(releaseErr) {
+			return "", fmt.Errorf("could not release MCS categories: %w", releaseErr)
+		}
+		if !kerrors.IsAlreadyExists(err) {
+			return "", fmt.Errorf("could not allocate MCS categories: %w", err)
+		}
+		// Another replica allocated categories to the owner in the meantime
+	}
+
+	return "", ErrMCSAllocationConflict
+}
+
+// mcsLease returns the lease reserving the categories for the allocation.
+// Leases are created in the operator namespace and named after their
+// categories, so that every pair can be reserved only once in the cluster.
+// They have the same owner as the allocation, to be garbage collected
+// together with it.
+func mcsLease(first, second int, allocation string, ownerRef *metav1.OwnerReference) *coordinationv1.Lease {
+	categories := mcsLeaseCategories(first, second)
+	return &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            mcsLeasePrefix + categories,
+			Labels:          map[string]string{mcsLeaseLabel: categories},
+			OwnerReferences: []metav1.OwnerReference{*ownerRef},
+		},
+		Spec: coordinationv1.LeaseSpec{HolderIdentity: &allocation},
+	}
+}
+
+func mcsLeaseCategories(first, second int) string {
+	return fmt.Sprintf("c%d-c%d", first, second)
+}
+
+// parseMCSLeaseCategories returns the categories reserved by a lease.
+func parseMCSLeaseCategories(lease *coordinationv1.Lease) (categories [2]int, ok bool) {
+	_, err := fmt.Sscanf(lease.Labels[mcsLeaseLabel], "c%d-c%d", &categories[0], &categories[1])
+	return categories, err == nil
+}
+
+// releaseMCSCategories deletes the allocation of a deleted ProfileBinding
+// together with the lease reserving its categories.
+func (p *podBinder) releaseMCSCategories(ctx context.Context, pb *binding) error {
+	owner := mcsOwner(pb.obj.GetNamespace(), pb)
+	if owner == nil || owner.Kind != selinuxprofileapi.MCSOwnerKindProfileBinding {
+		return nil
+	}
+
+	allocations, err := p.ListSelinuxMCSAllocations(ctx)
+	if err != nil {
+		return fmt.Errorf("could not list MCS allocations: %w", err)
+	}
+	allocation := findMCSAllocation(allocations, selinuxprofileapi.MCSAllocationName(*owner))
+	if allocation == nil {
+		return nil
+	}
+	if err := p.DeleteSelinuxMCSAllocation(ctx, allocation); err != nil && !kerrors.IsNotFound(err) {
+		return fmt.Errorf("could not release MCS categories: %w", err)
+	}
+	categories := allocation.Spec.Categories
+	lease := &coordinationv1.Lease{ObjectMeta: metav1.ObjectMeta{
+		Name: mcsLeasePrefix + mcsLeaseCategories(categories[0], categories[1]),
+	}}
+	if err := p.DeleteMCSLease(ctx, lease); err != nil && !kerrors.IsNotFound(err) {
+		return fmt.Errorf("could not release MCS categories: %w", err)
+	}
+	p.log.Info("Released MCS categories", "binding", pb.obj.GetName(), "level", allocation.Level())
+	return nil
+}
+
+// findMCSAllocation returns the allocation with the name.
+func findMCSAllocation(
+	allocations *selinuxprofileapi.SelinuxMCSAllocationList, name string,
+) *selinuxprofileapi.SelinuxMCSAllocation {
+	for i := range allocations.Items {
+		allocation := &allocations.Items[i]
+		if allocation.Name == name && len(allocation.Spec.Categories) == 2 {
+			return allocation
+		}
+	}
+	return nil
+}
+
+// usedMCSCategories returns the category pairs of the allocations, of the
+// leases reserving categories and of the levels OpenShift allocated to the
+// namespaces, which must not be allocated again.
+func (p *podBinder) usedMCSCategories(
+	ctx context.Context, allocations *selinuxprofileapi.SelinuxMCSAllocationList,
+) (map[[2]int]bool, error) {
+	used := map[[2]int]bool{}
+	for i := range allocations.Items {
+		if categories := allocations.Items[i].Spec.Categories; len(categories) == 2 {
+			used[[2]int{categories[0], categories[1]}] = true
+		}
+	}
+
+	leases, err := p.ListMCSLeases(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not list MCS leases: %w", err)
+	}
+	for i := range leases.Items {
+		if categories, ok := parseMCSLeaseCategories(&leases.Items[i]); ok {
+			used[categories] = true
+		}
+	}
+
+	namespaces, err := p.ListNamespaces(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not list namespaces: %w", err)
+	}
+	for i := range namespaces.Items {
+		level := namespaces.Items[i].GetAnnotations()[openShiftMCSAnnotation]
+		if categories, ok := parseMCSCategories(level); ok {
+			used[categories] = true
+		}
+	}
+	return used, nil
+}
+
+// parseMCSCategories returns the categories of a MCS level with two
+// categories, like s0:c5,c26, in ascending order.
+func parseMCSCategories(level string) (categories [2]int, ok bool) {
+	_, list, found := strings.Cut(level, ":")
+	if !found {
+		return categories, false
+	}
+	parts := strings.Split(list, ",")
+	if len(parts) != len(categories) {
+		return categories, false
+	}
+	for i, part := range parts {
+		category, found := strings.CutPrefix(part, "c")
+		if !found {
+			return categories, false
+		}
+		value, err := strconv.Atoi(category)
+		if err != nil {
+			return categories, false
+		}
+		categories[i] = value
+	}
+	if categories[0] > categories[1] {
+		categories[0], categories[1] = categories[1], categories[0]
+	}
+	return categories, categories[0] != categories[1]
+}
+
+// setPodMCSLevel sets the MCS level of the pod, which applies to all of its
+// containers. It returns true if the pod changed, and an error if the pod or
+// one of its containers uses a different level.
+func setPodMCSLevel(pod *corev1.Pod, level string) (bool, error) {
+	for _, containers := range [][]corev1.Container{pod.Spec.InitContainers, pod.Spec.Containers} {
+		for i := range containers {
+			sc := containers[i].SecurityContext
+			if sc == nil || sc.SELinuxOptions == nil || sc.SELinuxOptions.Level == "" {
+				continue
+			}
+			if sc.SELinuxOptions.Level != level {
+				return false, fmt.Errorf("%w: container %s uses %s instead of %s",
+					ErrMixedMCSLevels, containers[i].Name, sc.SELinuxOptions.Level, level)
+			}
+		}
+	}
+
+	if pod.Spec.SecurityContext == nil {
+		pod.Spec.SecurityContext = &corev1.PodSecurityContext{}
+	}
+	if pod.Spec.SecurityContext.SELinuxOptions == nil {
+		pod.Spec.SecurityContext.SELinuxOptions = &corev1.SELinuxOptions{}
+	}
+	switch current := pod.Spec.SecurityContext.SELinuxOptions.Level; current {
+	case level:
+		return false, nil
+	case "":
+		pod.Spec.SecurityContext.SELinuxOptions.Level = level
+		return true, nil
+	default:
+		return false, fmt.Errorf("%w: pod uses %s instead of %s", ErrMixedMCSLevels, current, level)
+	}
+}
+
+// freeMCSCategories returns the lowest pair of categories which is not used.
+func freeMCSCategories(used map[[2]int]bool) (first, second int, ok bool) {
+	for first = 0; first < selinuxprofileapi.MCSCategoryCount; first++ {
+		for second = first + 1; second < selinuxprofileapi.MCSCategoryCount; second++ {
+			if !used[[2]int{first, second}] {
+				return first, second, true
+			}
+		}
+	}
+	return 0, 0, false
+}
@@ -0,0 +1,419 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"sigs.k8s.io/security-profiles-operator/api/profilebinding/v1alpha1"
+	selinuxprofileapi "sigs.k8s.io/security-profiles-operator/api/selinuxprofile/v1alpha2"
+	"sigs.k8s.io/security-profiles-operator/internal/pkg/webhooks/binding/bindingfakes"
+)
+
+func mcsAllocation(owner selinuxprofileapi.MCSOwner, first, second int) selinuxprofileapi.SelinuxMCSAllocation {
+	return selinuxprofileapi.SelinuxMCSAllocation{
+		ObjectMeta: metav1.ObjectMeta{Name: selinuxprofileapi.MCSAllocationName(owner)},
+		Spec: selinuxprofileapi.SelinuxMCSAllocationSpec{
+			Owner:      owner,
+			Categories: []int{first, second},
+		},
+	}
+}
+
+func TestMCSLevel(t *testing.T) {
+	t.Parallel()
+
+	nsOwner := selinuxprofileapi.MCSOwner{Kind: selinuxprofileapi.MCSOwnerKindNamespace, Namespace: "ns"}
+	otherOwner := selinuxprofileapi.MCSOwner{Kind: selinuxprofileapi.MCSOwnerKindNamespace, Namespace: "other"}
+	pbOwner := selinuxprofileapi.MCSOwner{
+		Kind: selinuxprofileapi.MCSOwnerKindProfileBinding, Namespace: "ns", Name: "pb",
+	}
+	profileBinding := func(scope v1alpha1.MCSAllocationScope) *binding {
+		return &binding{
+			obj:           &v1alpha1.ProfileBinding{ObjectMeta: metav1.ObjectMeta{Name: "pb", Namespace: "ns"}},
+			mcsAllocation: scope,
+		}
+	}
+	alreadyExists := kerrors.NewAlreadyExists(schema.GroupResource{}, "name")
+
+	for _, tc := range []struct {
+		name    string
+		binding *binding
+		prepare func(*bindingfakes.FakeImpl)
+		assert  func(*bindingfakes.FakeImpl, string, error)
+	}{
+		{
+			name:    "no allocation",
+			binding: profileBinding(v1alpha1.MCSAllocationNone),
+			assert: func(mock *bindingfakes.FakeImpl, level string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, level)
+				require.Zero(t, mock.ListSelinuxMCSAllocationsCallCount())
+			},
+		},
+		{
+			name:    "existing allocation",
+			binding: profileBinding(v1alpha1.MCSAllocationNamespace),
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.ListSelinuxMCSAllocationsReturns(&selinuxprofileapi.SelinuxMCSAllocationList{
+					Items: []selinuxprofileapi.SelinuxMCSAllocation{
+						mcsAllocation(otherOwner, 0, 1),
+						mcsAllocation(nsOwner, 3, 7),
+					},
+				}, nil)
+				mock.GetNamespaceReturns(&corev1.Namespace{}, nil)
+			},
+			assert: func(mock *bindingfakes.FakeImpl, level string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "s0:c3,c7", level)
+				require.Zero(t, mock.CreateMCSLeaseCallCount())
+				require.Zero(t, mock.CreateSelinuxMCSAllocationCallCount())
+			},
+		},
+		{
+			name:    "new namespace allocation",
+			binding: profileBinding(v1alpha1.MCSAllocationNamespace),
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.ListSelinuxMCSAllocationsReturns(&selinuxprofileapi.SelinuxMCSAllocationList{
+					Items: []selinuxprofileapi.SelinuxMCSAllocation{
+						mcsAllocation(otherOwner, 0, 1),
+					},
+				}, nil)
+				mock.GetNamespaceReturns(&corev1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{Name: "ns", UID: "uid"},
+				}, nil)
+				mock.ListNamespacesReturns(&corev1.NamespaceList{}, nil)
+			},
+			assert: func(mock *bindingfakes.FakeImpl, level string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "s0:c0,c2", level)
+				require.Equal(t, 1, mock.CreateSelinuxMCSAllocationCallCount())
+				_, allocation := mock.CreateSelinuxMCSAllocationArgsForCall(0)
+				require.Equal(t, "namespace.ns", allocation.GetName())
+				require.Equal(t, nsOwner, allocation.Spec.Owner)
+				require.Equal(t, 1, mock.CreateMCSLeaseCallCount())
+				_, lease := mock.CreateMCSLeaseArgsForCall(0)
+				require.Equal(t, "selinux-mcs-c0-c2", lease.GetName())
+				require.Equal(t, "namespace.ns", *lease.Spec.HolderIdentity)
+				require.Equal(t, allocation.OwnerReferences, lease.OwnerReferences)
+				require.Len(t, allocation.OwnerReferences, 1)
+				require.Equal(t, "Namespace", allocation.OwnerReferences[0].Kind)
+				require.Equal(t, "uid", string(allocation.OwnerReferences[0].UID))
+				require.NotNil(t, allocation.OwnerReferences[0].BlockOwnerDeletion)
+				require.False(t, *allocation.OwnerReferences[0].BlockOwnerDeletion)
+			},
+		},
+		{
+			name:    "namespace allocated by OpenShift",
+			binding: profileBinding(v1alpha1.MCSAllocationNamespace),
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.GetNamespaceReturns(&corev1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "ns",
+						Annotations: map[string]string{openShiftMCSAnnotation: "s0:c26,c5"},
+					},
+				}, nil)
+			},
+			assert: func(mock *bindingfakes.FakeImpl, level string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "s0:c26,c5", level)
+				require.Zero(t, mock.ListSelinuxMCSAllocationsCallCount())
+				require.Zero(t, mock.CreateSelinuxMCSAllocationCallCount())
+			},
+		},
+		{
+			name:    "skip categories allocated by OpenShift",
+			binding: profileBinding(v1alpha1.MCSAllocationProfileBinding),
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.ListSelinuxMCSAllocationsReturns(&selinuxprofileapi.SelinuxMCSAllocationList{}, nil)
+				mock.GetNamespaceReturns(&corev1.Namespace{}, nil)
+				mock.ListNamespacesReturns(&corev1.NamespaceList{
+					Items: []corev1.Namespace{{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{openShiftMCSAnnotation: "s0:c1,c0"},
+						},
+					}},
+				}, nil)
+			},
+			assert: func(mock *bindingfakes.FakeImpl, level string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "s0:c0,c2", level)
+			},
+		},
+		{
+			name:    "skip reserved categories",
+			binding: profileBinding(v1alpha1.MCSAllocationProfileBinding),
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.ListSelinuxMCSAllocationsReturns(&selinuxprofileapi.SelinuxMCSAllocationList{}, nil)
+				mock.GetNamespaceReturns(&corev1.Namespace{}, nil)
+				mock.ListNamespacesReturns(&corev1.NamespaceList{}, nil)
+				mock.ListMCSLeasesReturns(&coordinationv1.LeaseList{
+					Items: []coordinationv1.Lease{
+						*mcsLease(0, 1, "namespace.other", &metav1.OwnerReference{}),
+					},
+				}, nil)
+			},
+			assert: func(mock *bindingfakes.FakeImpl, level string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "s0:c0,c2", level)
+				_, allocation := mock.CreateSelinuxMCSAllocationArgsForCall(0)
+				require.Equal(t, "profilebinding.ns.pb", allocation.GetName())
+			},
+		},
+		{
+			name: "new cluster profile binding allocation",
+			binding: &binding{
+				obj: &v1alpha1.ClusterProfileBinding{
+					ObjectMeta: metav1.ObjectMeta{Name: "cpb", UID: "uid"},
+				},
+				mcsAllocation: v1alpha1.MCSAllocationProfileBinding,
+			},
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.ListSelinuxMCSAllocationsReturns(&selinuxprofileapi.SelinuxMCSAllocationList{}, nil)
+				mock.ListNamespacesReturns(&corev1.NamespaceList{}, nil)
+			},
+			assert: func(mock *bindingfakes.FakeImpl, level string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "s0:c0,c1", level)
+				_, allocation := mock.CreateSelinuxMCSAllocationArgsForCall(0)
+				require.Equal(t, selinuxprofileapi.MCSOwner{
+					Kind: selinuxprofileapi.MCSOwnerKindClusterProfileBinding,
+					Name: "cpb",
+				}, allocation.Spec.Owner)
+				require.Equal(t, "ClusterProfileBinding", allocation.OwnerReferences[0].Kind)
+				require.Zero(t, mock.GetNamespaceCallCount())
+			},
+		},
+		{
+			name:    "categories reserved concurrently",
+			binding: profileBinding(v1alpha1.MCSAllocationProfileBinding),
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.ListSelinuxMCSAllocationsReturnsOnCall(0, &selinuxprofileapi.SelinuxMCSAllocationList{}, nil)
+				mock.ListSelinuxMCSAllocationsReturnsOnCall(1, &selinuxprofileapi.SelinuxMCSAllocationList{
+					Items: []selinuxprofileapi.SelinuxMCSAllocation{
+						mcsAllocation(otherOwner, 0, 1),
+					},
+				}, nil)
+				mock.GetNamespaceReturns(&corev1.Namespace{}, nil)
+				mock.ListNamespacesReturns(&corev1.NamespaceList{}, nil)
+				mock.CreateMCSLeaseReturnsOnCall(0, alreadyExists)
+			},
+			assert: func(mock *bindingfakes.FakeImpl, level string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "s0:c0,c2", level)
+				require.Equal(t, 2, mock.CreateMCSLeaseCallCount())
+				require.Equal(t, 1, mock.CreateSelinuxMCSAllocationCallCount())
+			},
+		},
+		{
+			name:    "owner allocated concurrently",
+			binding: profileBinding(v1alpha1.MCSAllocationProfileBinding),
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.ListSelinuxMCSAllocationsReturnsOnCall(0, &selinuxprofileapi.SelinuxMCSAllocationList{}, nil)
+				mock.ListSelinuxMCSAllocationsReturnsOnCall(1, &selinuxprofileapi.SelinuxMCSAllocationList{
+					Items: []selinuxprofileapi.SelinuxMCSAllocation{
+						mcsAllocation(pbOwner, 0, 5),
+					},
+				}, nil)
+				mock.GetNamespaceReturns(&corev1.Namespace{}, nil)
+				mock.ListNamespacesReturns(&corev1.NamespaceList{}, nil)
+				mock.CreateSelinuxMCSAllocationReturnsOnCall(0, alreadyExists)
+			},
+			assert: func(mock *bindingfakes.FakeImpl, level string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "s0:c0,c5", level)
+				require.Equal(t, 1, mock.DeleteMCSLeaseCallCount())
+				_, lease := mock.DeleteMCSLeaseArgsForCall(0)
+				require.Equal(t, "selinux-mcs-c0-c1", lease.GetName())
+			},
+		},
+		{
+			name:    "too many conflicts",
+			binding: profileBinding(v1alpha1.MCSAllocationProfileBinding),
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.ListSelinuxMCSAllocationsReturns(&selinuxprofileapi.SelinuxMCSAllocationList{}, nil)
+				mock.GetNamespaceReturns(&corev1.Namespace{}, nil)
+				mock.ListNamespacesReturns(&corev1.NamespaceList{}, nil)
+				mock.CreateMCSLeaseReturns(alreadyExists)
+			},
+			assert: func(mock *bindingfakes.FakeImpl, _ string, err error) {
+				require.ErrorIs(t, err, ErrMCSAllocationConflict)
+				require.Equal(t, maxMCSAllocationAttempts, mock.CreateMCSLeaseCallCount())
+				require.Zero(t, mock.CreateSelinuxMCSAllocationCallCount())
+			},
+		},
+		{
+			name:    "error list allocations",
+			binding: profileBinding(v1alpha1.MCSAllocationNamespace),
+			prepare: func(mock *bindingfakes.FakeImpl) {
+				mock.GetNamespaceReturns(&corev1.Namespace{}, nil)
+				mock.ListSelinuxMCSAllocationsReturns(nil, errTest)
+			},
+			assert: func(_ *bindingfakes.FakeImpl, _ string, err error) {
+				require.ErrorIs(t, err, errTest)
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &bindingfakes.FakeImpl{}
+			mock.ListMCSLeasesReturns(&coordinationv1.LeaseList{}, nil)
+			if tc.prepare != nil {
+				tc.prepare(mock)
+			}
+			binder := podBinder{impl: mock, log: logr.Discard()}
+			level, err := binder.mcsLevel(context.Background(), "ns", tc.binding)
+			tc.assert(mock, level, err)
+		})
+	}
+}
+
+func TestReleaseMCSCategories(t *testing.T) {
+	t.Parallel()
+
+	owner := selinuxprofileapi.MCSOwner{
+		Kind: selinuxprofileapi.MCSOwnerKindProfileBinding, Namespace: "ns", Name: "pb",
+	}
+	mock := &bindingfakes.FakeImpl{}
+	mock.ListSelinuxMCSAllocationsReturns(&selinuxprofileapi.SelinuxMCSAllocationList{
+		Items: []selinuxprofileapi.SelinuxMCSAllocation{
+			mcsAllocation(selinuxprofileapi.MCSOwner{Kind: selinuxprofileapi.MCSOwnerKindNamespace, Namespace: "ns"}, 0, 1),
+			mcsAllocation(owner, 0, 2),
+		},
+	}, nil)
+	binder := podBinder{impl: mock, log: logr.Discard()}
+
+	err := binder.releaseMCSCategories(context.Background(), &binding{
+		obj:           &v1alpha1.ProfileBinding{ObjectMeta: metav1.ObjectMeta{Name: "pb", Namespace: "ns"}},
+		mcsAllocation: v1alpha1.MCSAllocationProfileBinding,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, mock.DeleteSelinuxMCSAllocationCallCount())
+	_, allocation := mock.DeleteSelinuxMCSAllocationArgsForCall(0)
+	require.Equal(t, "profilebinding.ns.pb", allocation.GetName())
+	require.Equal(t, 1, mock.DeleteMCSLeaseCallCount())
+	_, lease := mock.DeleteMCSLeaseArgsForCall(0)
+	require.Equal(t, "selinux-mcs-c0-c2", lease.GetName())
+}
+
+func TestFreeMCSCategories(t *testing.T) {
+	t.Parallel()
+
+	used := map[[2]int]bool{}
+	for second := 1; second < selinuxprofileapi.MCSCategoryCount; second++ {
+		used[[2]int{0, second}] = true
+	}
+	first, second, ok := freeMCSCategories(used)
+	require.True(t, ok)
+	require.Equal(t, 1, first)
+	require.Equal(t, 2, second)
+}
+
+func TestParseMCSCategories(t *testing.T) {
+	t.Parallel()
+
+	for level, expected := range map[string]*[2]int{
+		"s0:c5,c26":   {5, 26},
+		"s0:c26,c5":   {5, 26},
+		"s0:c1,c1":    nil,
+		"s0:c1":       nil,
+		"s0:c1,c2,c3": nil,
+		"s0:1,2":      nil,
+		"c1,c2":       nil,
+		"":            nil,
+	} {
+		categories, ok := parseMCSCategories(level)
+		if expected == nil {
+			require.False(t, ok, level)
+			continue
+		}
+		require.True(t, ok, level)
+		require.Equal(t, *expected, categories, level)
+	}
+}
+
+func TestSetPodMCSLevel(t *testing.T) {
+	t.Parallel()
+
+	withLevel := func(level string) *corev1.SecurityContext {
+		return &corev1.SecurityContext{SELinuxOptions: &corev1.SELinuxOptions{Level: level}}
+	}
+
+	for _, tc := range []struct {
+		name    string
+		pod     *corev1.Pod
+		changed bool
+		wantErr bool
+	}{
+		{
+			name:    "no security context",
+			pod:     &corev1.Pod{Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "c"}}}},
+			changed: true,
+		},
+		{
+			name: "same level",
+			pod: &corev1.Pod{Spec: corev1.PodSpec{
+				SecurityContext: &corev1.PodSecurityContext{
+					SELinuxOptions: &corev1.SELinuxOptions{Level: "s0:c0,c1"},
+				},
+				Containers: []corev1.Container{{Name: "c", SecurityContext: withLevel("s0:c0,c1")}},
+			}},
+		},
+		{
+			name: "different pod level",
+			pod: &corev1.Pod{Spec: corev1.PodSpec{
+				SecurityContext: &corev1.PodSecurityContext{
+					SELinuxOptions: &corev1.SELinuxOptions{Level: "s0:c2,c3"},
+				},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "different init container level",
+			pod: &corev1.Pod{Spec: corev1.PodSpec{
+				InitContainers: []corev1.Container{{Name: "init", SecurityContext: withLevel("s0:c2,c3")}},
+				Containers:     []corev1.Container{{Name: "c"}},
+			}},
+			wantErr: true,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			changed, err := setPodMCSLevel(tc.pod, "s0:c0,c1")
+			if tc.wantErr {
+				require.ErrorIs(t, err, ErrMixedMCSLevels)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.changed, changed)
+			require.Equal(t, "s0:c0,c1", tc.pod.Spec.SecurityContext.SELinuxOptions.Level)
+		})
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	admissionv1 "k8s.io/api/admission/v1"
@@ -147,6 +148,11 @@ func (p *podSeccompRecorder) Handle(
 		}
 
 		if selector.Matches(podLabels) {
+			if !item.IsActiveAt(time.Now()) {
+				p.log.Info("Not recording pod outside of the recording window", "recording", item.Name)
+				continue
+			}
+
 			podChanged, err = p.updatePod(pod, podName, &item)
 			if err != nil {
 				return admission.Errored(http.StatusInternalServerError, err)
@@ -22,6 +22,7 @@ import (
 	"errors"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/require"
@@ -91,6 +92,29 @@ func TestHandle(t *testing.T) {
 				require.Equal(t, http.StatusBadRequest, int(resp.Result.Code))
 			},
 		},
+		{ // success pod unchanged - recording window closed
+			prepare: func(mock *recordingfakes.FakeImpl) {
+				mock.ListProfileRecordingsReturns(&v1alpha1.ProfileRecordingList{
+					Items: []v1alpha1.ProfileRecording{
+						{
+							Spec: v1alpha1.ProfileRecordingSpec{
+								Kind:     v1alpha1.ProfileRecordingKindSeccompProfile,
+								Recorder: v1alpha1.ProfileRecorderLogs,
+								Deadline: &metav1.Time{Time: time.Now().Add(-time.Minute)},
+							},
+						},
+					},
+				}, nil)
+				mock.GetProfileRecordingReturns(&v1alpha1.ProfileRecording{}, nil)
+				mock.ListRecordedPodsReturns(&corev1.PodList{}, nil)
+				mock.DecodePodReturns(testPod.DeepCopy(), nil)
+				mock.LabelSelectorAsSelectorReturns(labels.Everything(), nil)
+			},
+			assert: func(resp admission.Response) {
+				require.True(t, resp.Allowed)
+				require.Equal(t, "pod unchanged", resp.Result.Message)
+			},
+		},
 		// todo: bad combination, selinux + hook
 		// todo: actually look at the content of the patches
 		{ // success pod changed - tailing logs